@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/awesomeProject/homie-search/app/metrics"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// adminConfigEndpoint shows the effective configuration after layering
+// profile, file, secrets, env and flags. Credentials are redacted. It is
+// rendered as YAML so it can be compared with the mounted ConfigMap.
+func adminConfigEndpoint(c *gin.Context) {
+	c.YAML(http.StatusOK, currentConfig().Redacted())
+}
+
+// metricsEndpoint renders the metrics in the Prometheus text format.
+func metricsEndpoint(c *gin.Context) {
+	c.Header("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.Status(http.StatusOK)
+	metrics.Write(c.Writer)
+}
+
+// adminReindexEndpoint moves the documents into a fresh index built with the
+// current mapping without taking searches offline; writes are only held
+// back for the last catch-up copy. The old index is kept so it can be
+// inspected or deleted by hand. A reindex takes longer than a request may,
+// so it runs on the job queue and the request is answered with 202 and the
+// job, whose outcome is logged and reported in GET /admin/queue.
+func adminReindexEndpoint(c *gin.Context) {
+	id, err := jobQueue.Enqueue(reindexJobType, nil)
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to queue reindex")
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"job": id})
+}
+
+// runReindex reindexes with the current synonyms and drops the cached
+// searches.
+func runReindex(ctx context.Context, client *elastic.Client) (*reindexResult, error) {
+	ec := currentConfig().Elasticsearch
+	synonyms, err := currentSynonyms(ctx, client, ec)
+	if err != nil {
+		return nil, err
+	}
+	res, err := reindex(ctx, client, ec, synonyms)
+	if err != nil {
+		return nil, err
+	}
+	invalidateSearchCache()
+	return res, nil
+}
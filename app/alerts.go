@@ -0,0 +1,89 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/awesomeProject/homie-search/app/alerts"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+var alertService *alerts.Service
+
+func newAlertService() *alerts.Service {
+	ac := currentConfig().Alerts
+	return alerts.New(elasticClient, alerts.Options{
+		Index:          ac.Index,
+		QueueSize:      ac.QueueSize,
+		Workers:        ac.Workers,
+		MaxAttempts:    ac.MaxAttempts,
+		WebhookTimeout: ac.WebhookTimeout,
+		Jobs:           jobQueue,
+	})
+}
+
+// notifyAlerts queues newly written documents for percolation against the
+// saved queries. Nothing is queued while alerts are disabled.
+func notifyAlerts(docs ...Document) {
+	if !featureFlags.Enabled("enable_alerts") {
+		return
+	}
+	for _, doc := range docs {
+		alertService.Enqueue(doc)
+	}
+}
+
+func createAlertEndpoint(c *gin.Context) {
+	type request struct {
+		Name    string `json:"name"`
+		Query   string `json:"query"`
+		Webhook string `json:"webhook"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if req.Query == "" {
+		errorResponse(c, http.StatusBadRequest, "Query not specified")
+		return
+	}
+	u, err := url.Parse(req.Webhook)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errorResponse(c, http.StatusBadRequest, "Webhook must be an http or https URL")
+		return
+	}
+	alert, err := alertService.Register(c.Request.Context(), req.Name, req.Query, req.Webhook)
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to create alert")
+		return
+	}
+	c.JSON(http.StatusCreated, alert)
+}
+
+func listAlertsEndpoint(c *gin.Context) {
+	list, err := alertService.List(c.Request.Context(), currentConfig().Search.MaxPageSize)
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to list alerts")
+		return
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+func deleteAlertEndpoint(c *gin.Context) {
+	err := alertService.Delete(c.Request.Context(), c.Param("id"))
+	if elastic.IsNotFound(err) {
+		errorResponse(c, http.StatusNotFound, "Alert not found")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to delete alert")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,273 @@
+// Package alerts lets users register saved queries that are matched against
+// every newly indexed document using an Elasticsearch percolator index.
+// Matches are delivered to the webhook of the alert through the job queue,
+// which retries failed deliveries.
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/queue"
+	"github.com/olivere/elastic"
+	"github.com/teris-io/shortid"
+)
+
+const typeName = "alert"
+
+// Alert is a saved query and the webhook notified when a new document
+// matches it.
+type Alert struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Query     string    `json:"query"`
+	Webhook   string    `json:"webhook"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// storedAlert is the source of an alert in the percolator index.
+type storedAlert struct {
+	Alert
+	Percolate interface{} `json:"percolate_query"`
+}
+
+// Notification is the JSON body posted to a webhook.
+type Notification struct {
+	AlertID   string      `json:"alert_id"`
+	AlertName string      `json:"alert_name"`
+	Document  interface{} `json:"document"`
+}
+
+// deliveryJobType is the queue job type of webhook deliveries.
+const deliveryJobType = "webhook"
+
+// delivery is the queue payload of a webhook delivery.
+type delivery struct {
+	AlertID string          `json:"alert_id"`
+	Webhook string          `json:"webhook"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// Options configure a Service. Documents wait for percolation in a queue
+// of QueueSize, read by Workers goroutines; deliveries are queued on Jobs
+// and tried up to MaxAttempts times.
+type Options struct {
+	Index          string
+	QueueSize      int
+	Workers        int
+	MaxAttempts    int
+	WebhookTimeout time.Duration
+	Jobs           *queue.Queue
+}
+
+// Service stores alerts and delivers their notifications.
+type Service struct {
+	client func() *elastic.Client
+	opts   Options
+	http   *http.Client
+	queue  chan interface{}
+}
+
+// New returns a Service. client is called for every Elasticsearch request
+// so a reconnected client is picked up.
+func New(client func() *elastic.Client, opts Options) *Service {
+	s := &Service{
+		client: client,
+		opts:   opts,
+		http:   &http.Client{Timeout: opts.WebhookTimeout},
+		queue:  make(chan interface{}, opts.QueueSize),
+	}
+	opts.Jobs.Handle(deliveryJobType, opts.MaxAttempts, s.handleDelivery)
+	return s
+}
+
+// EnsureIndex creates the percolator index unless it exists. The document
+// fields alerts can query are mapped like in the documents index. It takes
+// the client explicitly so it can run while a new client is being set up.
+func (s *Service) EnsureIndex(ctx context.Context, client *elastic.Client) error {
+	exists, err := client.IndexExists(s.opts.Index).Do(ctx)
+	if err != nil || exists {
+		return err
+	}
+	body := map[string]interface{}{
+		"mappings": map[string]interface{}{
+			typeName: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"percolate_query": map[string]interface{}{"type": "percolator"},
+					"id":              map[string]interface{}{"type": "keyword"},
+					"name":            map[string]interface{}{"type": "keyword"},
+					"query":           map[string]interface{}{"type": "text", "index": false},
+					"webhook":         map[string]interface{}{"type": "keyword", "index": false},
+					"created_at":      map[string]interface{}{"type": "date"},
+					"updated_at":      map[string]interface{}{"type": "date"},
+					"title":           map[string]interface{}{"type": "text"},
+					"content":         map[string]interface{}{"type": "text"},
+					"language":        map[string]interface{}{"type": "keyword"},
+				},
+			},
+		},
+	}
+	_, err = client.CreateIndex(s.opts.Index).BodyJson(body).Do(ctx)
+	return err
+}
+
+// Register stores a new alert for the query text and returns it.
+func (s *Service) Register(ctx context.Context, name, query, webhook string) (*Alert, error) {
+	percolate, err := elastic.NewMultiMatchQuery(query, "title", "content").Source()
+	if err != nil {
+		return nil, err
+	}
+	a := storedAlert{
+		Alert: Alert{
+			ID:        shortid.MustGenerate(),
+			Name:      name,
+			Query:     query,
+			Webhook:   webhook,
+			CreatedAt: time.Now().UTC(),
+		},
+		Percolate: percolate,
+	}
+	_, err = s.client().Index().
+		Index(s.opts.Index).
+		Type(typeName).
+		Id(a.ID).
+		BodyJson(a).
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &a.Alert, nil
+}
+
+// List returns up to size alerts.
+func (s *Service) List(ctx context.Context, size int) ([]Alert, error) {
+	result, err := s.client().Search().
+		Index(s.opts.Index).
+		Query(elastic.NewMatchAllQuery()).
+		Sort("created_at", true).
+		Size(size).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	alerts := make([]Alert, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		var a Alert
+		if err := json.Unmarshal(*hit.Source, &a); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, a)
+	}
+	return alerts, nil
+}
+
+// Delete removes an alert. A missing alert is reported as an error
+// satisfying elastic.IsNotFound.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	_, err := s.client().Delete().
+		Index(s.opts.Index).
+		Type(typeName).
+		Id(id).
+		Refresh("true").
+		Do(ctx)
+	return err
+}
+
+// Enqueue schedules doc to be matched against the alerts. It never blocks;
+// when the queue is full the document is dropped and logged.
+func (s *Service) Enqueue(doc interface{}) {
+	select {
+	case s.queue <- doc:
+	default:
+		log.Println("alerts: queue full, dropping document")
+	}
+}
+
+// Run starts the delivery workers and blocks until stop is closed.
+func (s *Service) Run(stop <-chan struct{}) {
+	for i := 0; i < s.opts.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-stop:
+					return
+				case doc := <-s.queue:
+					s.percolate(doc)
+				}
+			}
+		}()
+	}
+	<-stop
+}
+
+func (s *Service) percolate(doc interface{}) {
+	client := s.client()
+	if client == nil {
+		log.Println("alerts: no elasticsearch connection, dropping document")
+		return
+	}
+	result, err := client.Search().
+		Index(s.opts.Index).
+		Query(elastic.NewPercolatorQuery().Field("percolate_query").Document(doc)).
+		Size(1000).
+		Do(context.Background())
+	if err != nil {
+		log.Printf("alerts: cannot percolate document: %v", err)
+		return
+	}
+	for _, hit := range result.Hits.Hits {
+		var a Alert
+		if err := json.Unmarshal(*hit.Source, &a); err != nil {
+			log.Printf("alerts: cannot decode alert %s: %v", hit.Id, err)
+			continue
+		}
+		s.deliver(a, Notification{AlertID: a.ID, AlertName: a.Name, Document: doc})
+	}
+}
+
+// deliver queues n for posting to the webhook of a.
+func (s *Service) deliver(a Alert, n Notification) {
+	body, err := json.Marshal(n)
+	if err != nil {
+		log.Printf("alerts: cannot encode notification for %s: %v", a.ID, err)
+		return
+	}
+	_, err = s.opts.Jobs.Enqueue(deliveryJobType, delivery{AlertID: a.ID, Webhook: a.Webhook, Body: body})
+	if err != nil {
+		log.Printf("alerts: cannot queue notification for %s: %v", a.ID, err)
+	}
+}
+
+func (s *Service) handleDelivery(ctx context.Context, job *queue.Job) error {
+	var d delivery
+	if err := job.Decode(&d); err != nil {
+		return err
+	}
+	if err := s.post(ctx, d.Webhook, d.Body); err != nil {
+		return fmt.Errorf("webhook for alert %s: %v", d.AlertID, err)
+	}
+	return nil
+}
+
+func (s *Service) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return nil
+}
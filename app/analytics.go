@@ -0,0 +1,146 @@
+package main
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// Search queries are counted in one Redis sorted set per analytics bucket.
+// The keys share a hash tag so a window can be summed with ZUNIONSTORE in
+// cluster mode too.
+const topQueriesPrefix = "{top-queries}:"
+
+// QueryCount is a search query with its decayed count over a window.
+type QueryCount struct {
+	Query string  `json:"query"`
+	Score float64 `json:"score"`
+}
+
+// queryStats keeps the search term leaderboard.
+type queryStats struct {
+	client redis.UniversalClient
+}
+
+func newQueryStats(client redis.UniversalClient) *queryStats {
+	return &queryStats{client: client}
+}
+
+// recordQuery counts the query of successful searches. Only first pages
+// are counted, so paging through results does not inflate a query.
+func (s *queryStats) recordQuery(c *gin.Context) {
+	c.Next()
+	if c.Writer.Status() != http.StatusOK {
+		return
+	}
+	if skip, err := strconv.Atoi(c.Query("skip")); err == nil && skip > 0 {
+		return
+	}
+	// Recorded in the background so the response is not held up.
+	query := c.Query("query")
+	go func() {
+		if err := s.record(query); err != nil {
+			log.Printf("analytics: cannot record query: %v", err)
+		}
+	}()
+}
+
+func (s *queryStats) record(query string) error {
+	ac := currentConfig().Analytics
+	query = normalizeQuery(query, ac.MaxQueryLength)
+	if query == "" {
+		return nil
+	}
+	var retention time.Duration
+	for _, d := range ac.Windows {
+		if d > retention {
+			retention = d
+		}
+	}
+	start := time.Now().Truncate(ac.Bucket)
+	key := topQueriesPrefix + strconv.FormatInt(start.Unix(), 10)
+	_, err := s.client.Pipelined(func(pipe redis.Pipeliner) error {
+		pipe.ZIncrBy(key, 1, query)
+		pipe.ExpireAt(key, start.Add(ac.Bucket+retention))
+		return nil
+	})
+	return err
+}
+
+// top returns the n queries with the highest decayed counts over window.
+func (s *queryStats) top(name string, window time.Duration, n int64) ([]QueryCount, error) {
+	ac := currentConfig().Analytics
+	current := time.Now().Truncate(ac.Bucket)
+	buckets := int((window + ac.Bucket - 1) / ac.Bucket)
+	keys := make([]string, buckets)
+	weights := make([]float64, buckets)
+	for i := range keys {
+		age := time.Duration(i) * ac.Bucket
+		keys[i] = topQueriesPrefix + strconv.FormatInt(current.Add(-age).Unix(), 10)
+		weights[i] = 1
+		if ac.HalfLife > 0 {
+			weights[i] = math.Pow(0.5, float64(age)/float64(ac.HalfLife))
+		}
+	}
+	// Concurrent requests for the same window store the same union, so
+	// they can share the destination key; it expires on its own.
+	dest := topQueriesPrefix + "window:" + name
+	var top *redis.ZSliceCmd
+	_, err := s.client.Pipelined(func(pipe redis.Pipeliner) error {
+		pipe.ZUnionStore(dest, redis.ZStore{Weights: weights}, keys...)
+		pipe.Expire(dest, time.Minute)
+		top = pipe.ZRevRangeWithScores(dest, 0, n-1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]QueryCount, 0, len(top.Val()))
+	for _, z := range top.Val() {
+		q, _ := z.Member.(string)
+		queries = append(queries, QueryCount{Query: q, Score: z.Score})
+	}
+	return queries, nil
+}
+
+// topQueriesEndpoint lists the most searched queries over the window
+// named by window=, e.g. ?window=week&size=20.
+func (s *queryStats) topQueriesEndpoint(c *gin.Context) {
+	cfg := currentConfig()
+	name := c.DefaultQuery("window", cfg.Analytics.DefaultWindow)
+	window, ok := cfg.Analytics.Windows[name]
+	if !ok {
+		errorResponse(c, http.StatusBadRequest, "Unknown window "+name)
+		return
+	}
+	size := int64(cfg.Search.DefaultPageSize)
+	if i, err := strconv.ParseInt(c.Query("size"), 10, 64); err == nil && i > 0 {
+		size = i
+	}
+	if size > int64(cfg.Search.MaxPageSize) {
+		size = int64(cfg.Search.MaxPageSize)
+	}
+	queries, err := s.top(name, window, size)
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get top queries")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"window": name, "queries": queries})
+}
+
+// normalizeQuery lowercases a query and collapses its whitespace, so the
+// same search typed differently is counted once.
+func normalizeQuery(query string, max int) string {
+	query = strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	if len(query) > max {
+		query = strings.TrimSpace(strings.ToValidUTF8(query[:max], ""))
+	}
+	return query
+}
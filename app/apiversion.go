@@ -0,0 +1,64 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// The API is served under a version prefix, each resource a router group
+// with its own middleware. A breaking change ships under /v2 next to /v1.
+// The unversioned paths the API had before remain as deprecated aliases:
+// they are rewritten onto /v1 before routing and answered with a
+// Deprecation header and a Link to their successor.
+
+const apiVersionPrefix = "/v1"
+
+// legacyRoutes rewrites unversioned API paths onto apiVersionPrefix.
+type legacyRoutes struct {
+	roots map[string]bool
+}
+
+// newLegacyRoutes collects the resources served under apiVersionPrefix,
+// e.g. "documents" for /v1/documents/:id and /v1/documents:count, once all
+// routes are registered. Other paths, such as /metrics, are left alone.
+func newLegacyRoutes(r *gin.Engine) *legacyRoutes {
+	roots := make(map[string]bool)
+	for _, route := range r.Routes() {
+		path := strings.TrimPrefix(route.Path, customMethodPrefix)
+		if !strings.HasPrefix(path, apiVersionPrefix+"/") {
+			continue
+		}
+		if root := apiRoot(strings.TrimPrefix(path, apiVersionPrefix)); root != "" {
+			roots[root] = true
+		}
+	}
+	return &legacyRoutes{roots: roots}
+}
+
+// apiRoot returns the first segment of path up to a slash or the colon of
+// a custom method.
+func apiRoot(path string) string {
+	root := strings.TrimPrefix(path, "/")
+	if i := strings.IndexAny(root, "/:"); i >= 0 {
+		root = root[:i]
+	}
+	return root
+}
+
+// Wrap returns h with unversioned API paths rewritten. It must run before
+// custom methods are rewritten.
+func (l *legacyRoutes) Wrap(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.roots[apiRoot(r.URL.Path)] {
+			r.URL.Path = apiVersionPrefix + r.URL.Path
+			if r.URL.RawPath != "" {
+				r.URL.RawPath = apiVersionPrefix + r.URL.RawPath
+			}
+			w.Header().Set("Deprecation", "true")
+			w.Header().Add("Link", "<"+r.URL.Path+`>; rel="successor-version"`)
+		}
+		h.ServeHTTP(w, r)
+	})
+}
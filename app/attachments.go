@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// Attachment describes the file a document was extracted from. Everything
+// but Filename is filled in by the attachment processor.
+type Attachment struct {
+	Filename      string `json:"filename,omitempty"`
+	ContentType   string `json:"content_type,omitempty"`
+	ContentLength int64  `json:"content_length,omitempty"`
+	Title         string `json:"title,omitempty"`
+	Author        string `json:"author,omitempty"`
+	Language      string `json:"language,omitempty"`
+	Date          string `json:"date,omitempty"`
+}
+
+// attachmentScript merges what the attachment processor extracted into the
+// document: the text becomes the content and the extracted title the title,
+// unless the upload gave them, and the rest is kept as attachment metadata.
+const attachmentScript = `Map extracted = ctx.remove('attachment_extracted');
+if (extracted == null) { return; }
+if (ctx.attachment == null) { ctx.attachment = [:]; }
+for (entry in extracted.entrySet()) {
+	if (entry.getKey() != 'content') { ctx.attachment[entry.getKey()] = entry.getValue(); }
+}
+if (ctx.content == null || ctx.content == '') { ctx.content = extracted.content; }
+if ((ctx.title == null || ctx.title == '') && extracted.title != null) {
+	ctx.title = extracted.title;
+	ctx.suggest = [extracted.title];
+}`
+
+// ensureAttachmentPipeline creates or updates the ingest pipeline uploads
+// are indexed through.
+func ensureAttachmentPipeline(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig) error {
+	_, err := client.IngestPutPipeline(ec.AttachmentPipeline).BodyJson(map[string]interface{}{
+		"description": "Extracts the text and metadata of uploaded documents",
+		"processors": []interface{}{
+			map[string]interface{}{"attachment": map[string]interface{}{
+				"field":         "attachment_data",
+				"target_field":  "attachment_extracted",
+				"indexed_chars": -1,
+			}},
+			map[string]interface{}{"remove": map[string]interface{}{
+				"field": "attachment_data",
+			}},
+			map[string]interface{}{"script": map[string]interface{}{
+				"source": attachmentScript,
+			}},
+		},
+	}).Do(ctx)
+	return err
+}
+
+// uploadDocumentEndpoint creates a document from a file, either uploaded as
+// multipart form field "file" or sent base64-encoded as "data" in a JSON
+// body. Title, language and tags may be given alongside it.
+func uploadDocumentEndpoint(c *gin.Context) {
+	type request struct {
+		DocumentRequest
+		Filename string `json:"filename"`
+		Data     []byte `json:"data"`
+	}
+	cfg := currentConfig()
+	max := cfg.Documents.MaxAttachmentSize
+	// Leave room for base64 and multipart encoding; the decoded size is
+	// checked below.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, 2*max+1<<20)
+
+	var req request
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			errorResponse(c, http.StatusBadRequest, "File not specified")
+			return
+		}
+		if header.Size > max {
+			errorResponse(c, http.StatusRequestEntityTooLarge, "File too large")
+			return
+		}
+		f, err := header.Open()
+		if err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusBadRequest, "Malformed upload")
+			return
+		}
+		defer f.Close()
+		if req.Data, err = ioutil.ReadAll(f); err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusBadRequest, "Malformed upload")
+			return
+		}
+		req.Filename = header.Filename
+		req.Title = c.PostForm("title")
+		req.Language = c.PostForm("language")
+		req.Tags = c.PostFormArray("tags")
+	} else if !bindJSON(c, &req) {
+		return
+	}
+	if len(req.Data) == 0 {
+		errorResponse(c, http.StatusBadRequest, "File not specified")
+		return
+	}
+	if int64(len(req.Data)) > max {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "File too large")
+		return
+	}
+	if details := req.validate(); len(details) > 0 {
+		validationFailed(c, details...)
+		return
+	}
+
+	doc := req.document()
+	doc.Attachment = &Attachment{Filename: req.Filename}
+	source := newIndexedDocument(doc)
+	source.AttachmentData = base64.StdEncoding.EncodeToString(req.Data)
+	ctx := c.Request.Context()
+	_, err := elasticClient().Index().
+		Index(cfg.Elasticsearch.WriteAlias).
+		Type(cfg.Elasticsearch.Type).
+		Id(doc.ID).
+		Pipeline(cfg.Elasticsearch.AttachmentPipeline).
+		BodyJson(source).
+		Do(ctx)
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		if elastic.IsStatusCode(err, http.StatusBadRequest) {
+			errorResponse(c, http.StatusBadRequest, "Attachment could not be extracted")
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to create document")
+		return
+	}
+	invalidateSearchCache()
+	created, version, err := getDocument(ctx, doc.ID)
+	if err != nil {
+		documentError(c, err, "Failed to get document")
+		return
+	}
+	notifyAlerts(*created)
+	setETag(c, version)
+	c.Header("Location", apiVersionPrefix+"/documents/"+doc.ID)
+	c.JSON(http.StatusCreated, created)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// auditLogger writes the audit log, the application log until
+// setupLogging opens the configured file.
+var auditLogger = log.New(os.Stderr, "", 0)
+
+// auditEvent records an action taken through the API: what was done to
+// which resource, by which client, with details specific to the action.
+type auditEvent struct {
+	Time     time.Time   `json:"time"`
+	Action   string      `json:"action"`
+	Resource string      `json:"resource"`
+	Client   string      `json:"client"`
+	Details  interface{} `json:"details,omitempty"`
+}
+
+// setupAudit appends the audit log to path, or writes it to the
+// application log when empty.
+func setupAudit(path string) error {
+	if path == "" {
+		auditLogger = log.New(log.Writer(), "audit: ", 0)
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return err
+	}
+	auditLogger = log.New(f, "", 0)
+	return nil
+}
+
+// audit records an action of the request's client as one JSON line.
+func audit(c *gin.Context, action, resource string, details interface{}) {
+	line, err := json.Marshal(auditEvent{
+		Time:     time.Now().UTC(),
+		Action:   action,
+		Resource: resource,
+		Client:   clientIP(c),
+		Details:  details,
+	})
+	if err != nil {
+		log.Printf("cannot record %s of %s: %v", action, resource, err)
+		return
+	}
+	auditLogger.Println(string(line))
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// boostOverrides holds the field boosts set through the admin endpoint. They
+// live in a Redis hash so every replica uses them, and are refreshed
+// periodically like the feature flags. Fields without an override use the
+// configured boost.
+type boostOverrides struct {
+	client redis.UniversalClient
+
+	mu     sync.RWMutex
+	values map[string]float64
+}
+
+var searchBoosts *boostOverrides
+
+func newBoostOverrides(client redis.UniversalClient) *boostOverrides {
+	return &boostOverrides{client: client, values: make(map[string]float64)}
+}
+
+// Effective returns the configured boosts with the overrides applied.
+func (b *boostOverrides) Effective(sc config.SearchConfig) map[string]float64 {
+	out := make(map[string]float64, len(sc.FieldBoosts))
+	for f, v := range sc.FieldBoosts {
+		out[f] = v
+	}
+	b.mu.RLock()
+	for f, v := range b.values {
+		out[f] = v
+	}
+	b.mu.RUnlock()
+	return out
+}
+
+// Refresh reloads the overrides from Redis.
+func (b *boostOverrides) Refresh() error {
+	raw, err := b.client.HGetAll(currentConfig().Search.BoostsRedisKey).Result()
+	if err != nil {
+		return err
+	}
+	values := make(map[string]float64, len(raw))
+	for f, s := range raw {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || v <= 0 {
+			log.Printf("ignoring boost override %s=%q", f, s)
+			continue
+		}
+		values[f] = v
+	}
+	b.mu.Lock()
+	b.values = values
+	b.mu.Unlock()
+	return nil
+}
+
+// Replace stores values as the new overrides; an empty map removes them.
+func (b *boostOverrides) Replace(values map[string]float64) error {
+	key := currentConfig().Search.BoostsRedisKey
+	pipe := b.client.TxPipeline()
+	pipe.Del(key)
+	if len(values) > 0 {
+		fields := make(map[string]interface{}, len(values))
+		for f, v := range values {
+			fields[f] = strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		pipe.HMSet(key, fields)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.values = values
+	b.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the overrides at the feature flag refresh interval until
+// stop is closed.
+func (b *boostOverrides) Run(stop <-chan struct{}) {
+	for {
+		if err := b.Refresh(); err != nil {
+			log.Printf("cannot refresh boost overrides: %v", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(currentConfig().Features.RefreshInterval):
+		}
+	}
+}
+
+func adminGetBoostsEndpoint(c *gin.Context) {
+	c.JSON(http.StatusOK, searchBoosts.Effective(currentConfig().Search))
+}
+
+// adminPutBoostsEndpoint overrides the boosts of the given fields, e.g.
+// {"title": 3, "content": 1}. Fields left out keep their configured boost.
+func adminPutBoostsEndpoint(c *gin.Context) {
+	var values map[string]float64
+	if err := c.BindJSON(&values); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	for f, v := range values {
+		if !boostableField(f) {
+			errorResponse(c, http.StatusBadRequest, "Unknown field "+f)
+			return
+		}
+		if v <= 0 {
+			errorResponse(c, http.StatusBadRequest, fmt.Sprintf("Boost of %s must be positive", f))
+			return
+		}
+	}
+	if err := searchBoosts.Replace(values); err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to store boosts")
+		return
+	}
+	invalidateSearchCache()
+	c.JSON(http.StatusOK, searchBoosts.Effective(currentConfig().Search))
+}
+
+// adminDeleteBoostsEndpoint removes the overrides, restoring the
+// configured boosts.
+func adminDeleteBoostsEndpoint(c *gin.Context) {
+	if err := searchBoosts.Replace(map[string]float64{}); err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to reset boosts")
+		return
+	}
+	invalidateSearchCache()
+	c.JSON(http.StatusOK, searchBoosts.Effective(currentConfig().Search))
+}
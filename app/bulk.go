@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/olivere/elastic"
+)
+
+// BulkItemResult reports the outcome of indexing one document of a bulk
+// request. Status is the HTTP status Elasticsearch returned for the item.
+type BulkItemResult struct {
+	ID     string `json:"id"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// indexDocuments writes docs in batches of the configured size, flushing up
+// to the configured number of batches concurrently. Results are in the
+// order of docs; a batch whose request failed as a whole reports the error
+// on each of its items. When onBatch is not nil it is called with the
+// results of every finished batch, possibly concurrently.
+func indexDocuments(ctx context.Context, client *elastic.Client, cfg *config.Config, docs []Document, onBatch func([]BulkItemResult)) []BulkItemResult {
+	results := make([]BulkItemResult, len(docs))
+	size := cfg.Documents.BulkBatchSize
+	slots := make(chan struct{}, cfg.Documents.BulkWorkers)
+	var wg sync.WaitGroup
+	for start := 0; start < len(docs); start += size {
+		end := start + size
+		if end > len(docs) {
+			end = len(docs)
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			failBatch(results[start:], docs[start:], ctx.Err())
+			if onBatch != nil {
+				onBatch(results[start:])
+			}
+			wg.Wait()
+			return results
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			flushBatch(ctx, client, cfg.Elasticsearch, docs[start:end], results[start:end])
+			if onBatch != nil {
+				onBatch(results[start:end])
+			}
+		}(start, end)
+	}
+	wg.Wait()
+	return results
+}
+
+func flushBatch(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig, docs []Document, results []BulkItemResult) {
+	bulk := client.Bulk().
+		Index(ec.WriteAlias).
+		Type(ec.Type)
+	for _, doc := range docs {
+		bulk.Add(elastic.NewBulkIndexRequest().Id(doc.ID).Doc(newIndexedDocument(doc)))
+	}
+	runBulk(ctx, bulk, docs, results)
+}
+
+// runBulk sends bulk, one action per document of docs, and reports the
+// outcome of each in results.
+func runBulk(ctx context.Context, bulk *elastic.BulkService, docs []Document, results []BulkItemResult) {
+	res, err := bulk.Do(ctx)
+	if err != nil {
+		log.Println(err)
+		failBatch(results, docs, err)
+		return
+	}
+	for i, doc := range docs {
+		results[i] = BulkItemResult{ID: doc.ID, Status: http.StatusInternalServerError, Error: "missing from bulk response"}
+	}
+	// Items come back in request order, one action per document.
+	for i, item := range res.Items {
+		if i >= len(docs) {
+			break
+		}
+		for _, r := range item {
+			results[i].Status = r.Status
+			results[i].Error = ""
+			if r.Error != nil {
+				results[i].Error = r.Error.Reason
+			}
+		}
+	}
+}
+
+func failBatch(results []BulkItemResult, docs []Document, err error) {
+	for i, doc := range docs {
+		results[i] = BulkItemResult{ID: doc.ID, Status: http.StatusInternalServerError, Error: err.Error()}
+	}
+}
+
+func bulkItemOK(r BulkItemResult) bool {
+	return r.Status >= 200 && r.Status < 300
+}
+
+// indexedDocuments returns the docs whose result reports success.
+func indexedDocuments(docs []Document, results []BulkItemResult) []Document {
+	var out []Document
+	for i, r := range results {
+		if bulkItemOK(r) {
+			out = append(out, docs[i])
+		}
+	}
+	return out
+}
@@ -0,0 +1,346 @@
+// Package cache stores rendered responses in Redis. Entries are grouped in
+// generations: Invalidate starts a new generation, which makes every
+// existing entry unreachable at once, and the old entries expire on their
+// own TTL.
+//
+// Each replica also keeps recently used entries in process. Invalidate
+// announces the new generation over Redis pub/sub, so every replica drops
+// its local entries as soon as any of them invalidates.
+//
+// Fetch protects the backend from stampedes: concurrent misses for a key
+// share one fill, and hot entries are refilled shortly before they expire,
+// with a probability that grows with the time their fill took (the XFetch
+// rule), so they rarely expire under load at all.
+//
+// Lookups, fills and entry sizes are recorded as metrics labeled with the
+// cache prefix.
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/metrics"
+	"github.com/go-redis/redis"
+)
+
+var (
+	lookups = metrics.NewCounterVec("cache_lookups_total",
+		"Cache lookups by result: local_hit, hit, miss, refresh (an early refresh), or shared (waited for another fill).",
+		"cache", "result")
+	redisErrors = metrics.NewCounterVec("cache_errors_total",
+		"Redis errors treated as cache misses.", "cache")
+	fillSeconds = metrics.NewHistogramVec("cache_fill_duration_seconds",
+		"Time taken to compute missing entries.", metrics.DefBuckets, "cache")
+	entryBytes = metrics.NewHistogramVec("cache_entry_size_bytes",
+		"Size of the entries stored.", metrics.SizeBuckets, "cache")
+)
+
+// Cache is a Redis-backed response cache. Redis errors are logged and
+// treated as misses so the cache never fails a request.
+type Cache struct {
+	client    redis.UniversalClient
+	prefix    string
+	ttl       func() time.Duration
+	localSize func() int
+
+	mu sync.Mutex
+	// gen is the current generation while Listen is subscribed, so it
+	// need not be read from Redis on every lookup; -1 when unknown.
+	gen        int64
+	subscribed bool
+	epoch      int // counts subscription changes
+	local      map[string]localEntry
+
+	fills flight
+}
+
+type localEntry struct {
+	value   []byte
+	delta   time.Duration // how long the fill took
+	expires time.Time
+}
+
+// New returns a Cache storing its keys under prefix. ttl is read on every
+// Set so configuration reloads take effect; localSize bounds the entries
+// kept in process, zero disabling them.
+func New(client redis.UniversalClient, prefix string, ttl func() time.Duration, localSize func() int) *Cache {
+	return &Cache{
+		client:    client,
+		prefix:    prefix,
+		ttl:       ttl,
+		localSize: localSize,
+		gen:       -1,
+		local:     make(map[string]localEntry),
+	}
+}
+
+// Fetch returns the value cached for key, or else the value fill returns.
+// fill reports whether its value may be cached; values that may not are
+// only returned to the caller that filled them. Concurrent misses for key
+// wait for a single fill and share its value, and ok is false when there
+// is none to share.
+func (c *Cache) Fetch(key string, fill func() (value []byte, ok bool)) ([]byte, bool) {
+	k, err := c.key(key)
+	if err != nil {
+		c.error(err)
+		return fill()
+	}
+	v, result := c.get(k)
+	if v != nil {
+		lookups.With(c.prefix, result).Inc()
+		return v, true
+	}
+	v, ok, shared := c.fills.do(k, func() ([]byte, bool) {
+		start := time.Now()
+		v, ok := fill()
+		delta := time.Since(start)
+		fillSeconds.With(c.prefix).Observe(delta.Seconds())
+		if ok {
+			c.set(k, v, delta)
+		}
+		return v, ok
+	})
+	if shared {
+		result = "shared"
+	}
+	lookups.With(c.prefix, result).Inc()
+	return v, ok
+}
+
+// get returns the entry at k, or nil when it is missing or due for an
+// early refresh, and the lookup result for the metrics.
+func (c *Cache) get(k string) ([]byte, string) {
+	if e, ok := c.getLocal(k); ok {
+		if refreshEarly(e.delta, e.expires) {
+			return nil, "refresh"
+		}
+		return e.value, "local_hit"
+	}
+	var get *redis.StringCmd
+	var pttl *redis.DurationCmd
+	_, err := c.client.Pipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.Get(k)
+		pttl = pipe.PTTL(k)
+		return nil
+	})
+	if err == redis.Nil {
+		return nil, "miss"
+	}
+	if err != nil {
+		c.error(err)
+		return nil, "miss"
+	}
+	data, _ := get.Bytes()
+	if len(data) < 8 {
+		return nil, "miss"
+	}
+	e := localEntry{
+		value:   data[8:],
+		delta:   time.Duration(binary.BigEndian.Uint64(data)),
+		expires: time.Now().Add(pttl.Val()),
+	}
+	if pttl.Val() <= 0 {
+		// No expiry, or it expired since the GET.
+		e.expires = time.Now().Add(c.ttl())
+	}
+	c.setLocal(k, e)
+	if refreshEarly(e.delta, e.expires) {
+		return nil, "refresh"
+	}
+	return e.value, "hit"
+}
+
+// set caches value at k along with how long it took to fill. The fill time
+// is stored ahead of the value.
+func (c *Cache) set(k string, value []byte, delta time.Duration) {
+	ttl := c.ttl()
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data, uint64(delta))
+	copy(data[8:], value)
+	if err := c.client.Set(k, data, ttl).Err(); err != nil {
+		c.error(err)
+		return
+	}
+	entryBytes.With(c.prefix).Observe(float64(len(value)))
+	c.setLocal(k, localEntry{value: value, delta: delta, expires: time.Now().Add(ttl)})
+}
+
+// refreshEarly reports whether an entry should be refilled before it
+// expires. Following XFetch, it is true once now - delta*ln(rand) passes
+// the expiry, which gets likelier as the expiry nears and for entries that
+// are slow to fill.
+func refreshEarly(delta time.Duration, expires time.Time) bool {
+	gap := float64(delta) * -math.Log(1-rand.Float64())
+	return time.Now().Add(time.Duration(gap)).After(expires)
+}
+
+// Invalidate drops every cached entry, on every replica, by starting a new
+// generation.
+func (c *Cache) Invalidate() {
+	gen, err := c.client.Incr(c.generationKey()).Result()
+	if err != nil {
+		log.Printf("cache: cannot invalidate: %v", err)
+		return
+	}
+	c.setGeneration(gen)
+	if err := c.client.Publish(c.channel(), strconv.FormatInt(gen, 10)).Err(); err != nil {
+		log.Printf("cache: cannot announce invalidation: %v", err)
+	}
+}
+
+// Listen applies the invalidations announced by other replicas until stop
+// is closed. While it is not subscribed, for instance while reconnecting,
+// the generation is read from Redis on every lookup.
+func (c *Cache) Listen(stop <-chan struct{}) {
+	pubsub := c.client.Subscribe(c.channel())
+	go func() {
+		<-stop
+		pubsub.Close()
+	}()
+	for {
+		msg, err := pubsub.ReceiveTimeout(time.Minute)
+		if err != nil {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if e, ok := err.(net.Error); ok && e.Timeout() {
+				pubsub.Ping()
+				continue
+			}
+			c.forgetGeneration(false)
+			log.Printf("cache: invalidation subscription: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		switch m := msg.(type) {
+		case *redis.Subscription:
+			// Announcements may have been missed before (re)subscribing.
+			c.forgetGeneration(true)
+		case *redis.Message:
+			gen, err := strconv.ParseInt(m.Payload, 10, 64)
+			if err != nil {
+				log.Printf("cache: invalid invalidation %q", m.Payload)
+				continue
+			}
+			c.setGeneration(gen)
+		}
+	}
+}
+
+func (c *Cache) key(key string) (string, error) {
+	sum := sha1.Sum([]byte(key))
+	gen, err := c.generation()
+	if err != nil {
+		return "", err
+	}
+	// The "x" segment keeps entries carrying their fill time apart from
+	// the bare values older replicas store.
+	return c.prefix + ":" + strconv.FormatInt(gen, 10) + ":x:" + hex.EncodeToString(sum[:]), nil
+}
+
+func (c *Cache) generation() (int64, error) {
+	c.mu.Lock()
+	gen, subscribed, epoch := c.gen, c.subscribed, c.epoch
+	c.mu.Unlock()
+	if gen >= 0 {
+		return gen, nil
+	}
+	v, err := c.client.Get(c.generationKey()).Result()
+	if err == redis.Nil {
+		v, err = "0", nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	gen, err = strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	// Any later invalidation is announced to a subscription that was
+	// already in place when the generation was read.
+	c.mu.Lock()
+	if subscribed && c.epoch == epoch && gen > c.gen {
+		c.gen = gen
+	}
+	c.mu.Unlock()
+	return gen, nil
+}
+
+// setGeneration records a generation announced or started by this replica.
+// Generations only grow, so a late announcement cannot move it back. It is
+// only remembered while subscribed, since otherwise later ones would be
+// missed.
+func (c *Cache) setGeneration(gen int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen > c.gen {
+		if c.subscribed {
+			c.gen = gen
+		}
+		c.local = make(map[string]localEntry)
+	}
+}
+
+func (c *Cache) forgetGeneration(subscribed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen = -1
+	c.subscribed = subscribed
+	c.epoch++
+	c.local = make(map[string]localEntry)
+}
+
+func (c *Cache) getLocal(k string) (localEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.local[k]
+	if !ok {
+		return localEntry{}, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.local, k)
+		return localEntry{}, false
+	}
+	return e, true
+}
+
+// setLocal keeps e in process, evicting an arbitrary entry when full.
+func (c *Cache) setLocal(k string, e localEntry) {
+	size := c.localSize()
+	if size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for old := range c.local {
+		if len(c.local) < size {
+			break
+		}
+		delete(c.local, old)
+	}
+	c.local[k] = e
+}
+
+func (c *Cache) error(err error) {
+	redisErrors.With(c.prefix).Inc()
+	log.Printf("cache: %v", err)
+}
+
+func (c *Cache) generationKey() string {
+	return c.prefix + ":generation"
+}
+
+func (c *Cache) channel() string {
+	return c.prefix + ":invalidate"
+}
@@ -0,0 +1,43 @@
+package cache
+
+import "sync"
+
+// flight coalesces concurrent fills of the same key in this process, the
+// way golang.org/x/sync/singleflight does; that package is not vendored.
+type flight struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done  chan struct{}
+	value []byte
+	ok    bool
+}
+
+// do calls fn unless a call for key is already running, in which case it
+// waits for that call and returns its result. shared reports the latter.
+func (f *flight) do(key string, fn func() ([]byte, bool)) (value []byte, ok, shared bool) {
+	f.mu.Lock()
+	if f.calls == nil {
+		f.calls = make(map[string]*call)
+	}
+	if cl, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		<-cl.done
+		return cl.value, cl.ok, true
+	}
+	cl := &call{done: make(chan struct{})}
+	f.calls[key] = cl
+	f.mu.Unlock()
+
+	// Release the waiters even if fn panics; they see a failed call.
+	defer func() {
+		f.mu.Lock()
+		delete(f.calls, key)
+		f.mu.Unlock()
+		close(cl.done)
+	}()
+	cl.value, cl.ok = fn()
+	return cl.value, cl.ok, false
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// warmWait bounds how long startup warming waits for Elasticsearch.
+const warmWait = time.Minute
+
+// cacheWarmer fills the search cache with the configured and the most
+// popular queries. The searches go through handler like client requests,
+// so they are cached under the keys clients will look up.
+type cacheWarmer struct {
+	handler   http.Handler
+	analytics *queryStats
+}
+
+// cacheWarmingKey marks the context of the searches run by a warming.
+type cacheWarmingKey struct{}
+
+// cacheWarming reports whether ctx is that of a search run by a warming.
+func cacheWarming(ctx context.Context) bool {
+	return ctx.Value(cacheWarmingKey{}) != nil
+}
+
+// warmResult counts the searches a warming ran.
+type warmResult struct {
+	Warmed int `json:"warmed"`
+	Failed int `json:"failed"`
+}
+
+// warmOnStartup warms the cache once Elasticsearch is reachable.
+func (w *cacheWarmer) warmOnStartup() {
+	deadline := time.Now().Add(warmWait)
+	for elasticClient() == nil {
+		if time.Now().After(deadline) {
+			log.Println("cache warming: no elasticsearch connection, skipped")
+			return
+		}
+		time.Sleep(time.Second)
+	}
+	if !featureFlags.Enabled("enable_search_cache") {
+		return
+	}
+	res := w.warm()
+	log.Printf("cache warming: %d searches cached, %d failed", res.Warmed, res.Failed)
+}
+
+func (w *cacheWarmer) warm() warmResult {
+	var res warmResult
+	for _, q := range w.queries() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, apiVersionPrefix+"/search?query="+url.QueryEscape(q), nil)
+		req = req.WithContext(context.WithValue(req.Context(), cacheWarmingKey{}, true))
+		w.handler.ServeHTTP(rec, req)
+		if rec.Code == http.StatusOK {
+			res.Warmed++
+		} else {
+			log.Printf("cache warming: search for %q answered %d", q, rec.Code)
+			res.Failed++
+		}
+	}
+	return res
+}
+
+// queries returns the configured queries followed by the popular ones,
+// without duplicates.
+func (w *cacheWarmer) queries() []string {
+	cfg := currentConfig()
+	var queries []string
+	seen := make(map[string]bool)
+	add := func(q string) {
+		if q != "" && !seen[q] {
+			seen[q] = true
+			queries = append(queries, q)
+		}
+	}
+	for _, q := range cfg.Cache.WarmQueries {
+		add(q)
+	}
+	if n := cfg.Cache.WarmTopQueries; n > 0 {
+		name := cfg.Analytics.DefaultWindow
+		top, err := w.analytics.top(name, cfg.Analytics.Windows[name], int64(n))
+		if err != nil {
+			log.Printf("cache warming: cannot get top queries: %v", err)
+		}
+		for _, q := range top {
+			add(q.Query)
+		}
+	}
+	return queries
+}
+
+// adminWarmCacheEndpoint warms the search cache and reports how many
+// searches were cached.
+func (w *cacheWarmer) adminWarmCacheEndpoint(c *gin.Context) {
+	if !featureFlags.Enabled("enable_search_cache") {
+		errorResponse(c, http.StatusConflict, "Search cache is disabled")
+		return
+	}
+	c.JSON(http.StatusOK, w.warm())
+}
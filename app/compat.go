@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// compatTransport lets the Elasticsearch 6 client talk to Elasticsearch 7.
+// ES 7 reports hits.total as an object and only accepts typed mappings when
+// asked to, so once the cluster is known to be 7.x searches request the
+// numeric total and index, template and mapping requests opt into mapping
+// types.
+// Mapping types were removed in 8.0, which this client cannot talk to.
+type compatTransport struct {
+	major int32 // accessed atomically; 0 until setVersion
+	next  http.RoundTripper
+}
+
+// setVersion records the cluster version reported by the root endpoint.
+func (t *compatTransport) setVersion(version string) error {
+	major, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil {
+		return fmt.Errorf("cannot parse elasticsearch version %q: %v", version, err)
+	}
+	if major >= 8 {
+		return fmt.Errorf("elasticsearch %s is not supported, mapping types were removed in 8.0", version)
+	}
+	atomic.StoreInt32(&t.major, int32(major))
+	return nil
+}
+
+// seqNoConcurrency reports whether the cluster is 7.x, which rejects writes
+// conditioned on the internal version in favour of if_seq_no and
+// if_primary_term.
+func (t *compatTransport) seqNoConcurrency() bool {
+	return atomic.LoadInt32(&t.major) >= 7
+}
+
+func (t *compatTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if atomic.LoadInt32(&t.major) < 7 {
+		return t.next.RoundTrip(req)
+	}
+	var param string
+	switch path := req.URL.Path; {
+	case strings.HasSuffix(path, "/_search"),
+		strings.HasSuffix(path, "/_search/scroll"),
+		strings.HasSuffix(path, "/_search/template"):
+		param = "rest_total_hits_as_int"
+	case strings.Contains(path, "/_mapping"),
+		strings.HasPrefix(path, "/_template/"),
+		req.Method == http.MethodPut && strings.Count(strings.Trim(path, "/"), "/") == 0:
+		param = "include_type_name"
+	default:
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	q := req.URL.Query()
+	q.Set(param, "true")
+	req.URL.RawQuery = q.Encode()
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Responses are compressed with the encoding the client prefers in
+// Accept-Encoding. The body is held back until it reaches the minimum size,
+// so small answers such as errors go out as they are, and only media types
+// worth compressing are; others, such as images stored as blobs, stream
+// through untouched. Responses flushed early, like exports, are compressed
+// as they go since their length is not known.
+
+// compressor is implemented by gzip.Writer and zlib.Writer.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+	Reset(io.Writer)
+}
+
+var compressorPools struct {
+	sync.Mutex
+	pools map[string]*sync.Pool
+}
+
+// getCompressor returns a compressor for encoding at level writing to w,
+// reusing one put back by putCompressor if there is any.
+func getCompressor(encoding string, level int, w io.Writer) compressor {
+	key := encoding + "/" + strconv.Itoa(level)
+	compressorPools.Lock()
+	pool, ok := compressorPools.pools[key]
+	if !ok {
+		pool = &sync.Pool{New: func() interface{} {
+			var cw compressor
+			if encoding == "gzip" {
+				cw, _ = gzip.NewWriterLevel(nil, level)
+			} else {
+				cw, _ = zlib.NewWriterLevel(nil, level)
+			}
+			return cw
+		}}
+		if compressorPools.pools == nil {
+			compressorPools.pools = make(map[string]*sync.Pool)
+		}
+		compressorPools.pools[key] = pool
+	}
+	compressorPools.Unlock()
+	cw := pool.Get().(compressor)
+	cw.Reset(w)
+	return cw
+}
+
+func putCompressor(encoding string, level int, cw compressor) {
+	compressorPools.Lock()
+	pool := compressorPools.pools[encoding+"/"+strconv.Itoa(level)]
+	compressorPools.Unlock()
+	pool.Put(cw)
+}
+
+// compress compresses the responses of clients accepting gzip or deflate
+// as configured in compression.
+func compress(c *gin.Context) {
+	cfg := currentConfig().Compression
+	if !cfg.Enabled || c.Request.Method == http.MethodHead {
+		c.Next()
+		return
+	}
+	encoding := acceptedEncoding(c.GetHeader("Accept-Encoding"))
+	if encoding == "" {
+		c.Next()
+		return
+	}
+	w := &compressWriter{ResponseWriter: c.Writer, cfg: cfg, encoding: encoding}
+	c.Writer = w
+	defer w.close()
+	c.Next()
+}
+
+// acceptedEncoding returns gzip or deflate, whichever Accept-Encoding
+// rates higher, gzip on a tie, or "" when it accepts neither.
+func acceptedEncoding(header string) string {
+	q := qualities(header)
+	best, bestQ := "", 0.0
+	for _, encoding := range []string{"gzip", "deflate"} {
+		weight, ok := q[encoding]
+		if !ok {
+			weight, ok = q["*"]
+		}
+		if ok && weight > bestQ {
+			best, bestQ = encoding, weight
+		}
+	}
+	return best
+}
+
+// compressWriter holds the body back until it knows whether to compress
+// it: once it reaches the minimum size, is flushed or is complete.
+type compressWriter struct {
+	gin.ResponseWriter
+	cfg      config.CompressionConfig
+	encoding string
+
+	buf     []byte
+	started bool
+	cw      compressor
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.started {
+		if !w.compressible() {
+			w.start(false)
+		} else {
+			w.buf = append(w.buf, data...)
+			if len(w.buf) >= w.cfg.MinSize {
+				w.start(true)
+			}
+			return len(data), nil
+		}
+	}
+	if w.cw != nil {
+		return w.cw.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow is deferred to start, where the headers are final.
+func (w *compressWriter) WriteHeaderNow() {}
+
+func (w *compressWriter) Written() bool {
+	return w.started || len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+func (w *compressWriter) Flush() {
+	if !w.started {
+		w.start(w.compressible())
+	}
+	if w.cw != nil {
+		if err := w.cw.Flush(); err != nil {
+			log.Printf("compression: %v", err)
+		}
+	}
+	w.ResponseWriter.Flush()
+}
+
+// compressible reports whether the response may be compressed given its
+// status and headers.
+func (w *compressWriter) compressible() bool {
+	switch status := w.Status(); {
+	case status < http.StatusOK, status == http.StatusNoContent, status == http.StatusNotModified:
+		return false
+	}
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	for _, t := range w.cfg.ContentTypes {
+		if t == mediaType || strings.HasSuffix(t, "/*") && strings.HasPrefix(mediaType, t[:len(t)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// start sends the headers, compressed or not, and the body held back.
+func (w *compressWriter) start(compressed bool) {
+	w.started = true
+	h := w.Header()
+	if compressed || w.compressible() {
+		h.Add("Vary", "Accept-Encoding")
+	}
+	if compressed {
+		h.Set("Content-Encoding", w.encoding)
+		h.Del("Content-Length")
+		weakenETag(h)
+		w.cw = getCompressor(w.encoding, w.cfg.Level, w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeaderNow()
+	if len(w.buf) == 0 {
+		return
+	}
+	var err error
+	if w.cw != nil {
+		_, err = w.cw.Write(w.buf)
+	} else {
+		_, err = w.ResponseWriter.Write(w.buf)
+	}
+	if err != nil {
+		log.Printf("compression: %v", err)
+	}
+	w.buf = nil
+}
+
+// close sends a body that stayed below the minimum size as it is and
+// completes a compressed one.
+func (w *compressWriter) close() {
+	if !w.started {
+		if len(w.buf) == 0 {
+			return
+		}
+		w.start(false)
+	}
+	if w.cw == nil {
+		return
+	}
+	if err := w.cw.Close(); err != nil {
+		log.Printf("compression: %v", err)
+	}
+	putCompressor(w.encoding, w.cfg.Level, w.cw)
+	w.cw = nil
+}
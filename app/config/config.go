@@ -0,0 +1,868 @@
+// Package config loads the application configuration from an optional YAML
+// file and overlays environment variables on top of it.
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the fully resolved application configuration.
+type Config struct {
+	Server        ServerConfig        `yaml:"server"`
+	Elasticsearch ElasticsearchConfig `yaml:"elasticsearch"`
+	Redis         RedisConfig         `yaml:"redis"`
+	Couchbase     CouchbaseConfig     `yaml:"couchbase"`
+	Documents     DocumentsConfig     `yaml:"documents"`
+	Search        SearchConfig        `yaml:"search"`
+	Cache         CacheConfig         `yaml:"cache"`
+	Log           LogConfig           `yaml:"log"`
+	Secrets       SecretsConfig       `yaml:"secrets"`
+	Features      FeaturesConfig      `yaml:"features"`
+	Alerts        AlertsConfig        `yaml:"alerts"`
+	Jobs          JobsConfig          `yaml:"jobs"`
+	Queue         QueueConfig         `yaml:"queue"`
+	Stream        StreamConfig        `yaml:"stream"`
+	Mirror        MirrorConfig        `yaml:"mirror"`
+	Sessions      SessionsConfig      `yaml:"sessions"`
+	KV            KVConfig            `yaml:"kv"`
+	Analytics     AnalyticsConfig     `yaml:"analytics"`
+	Snapshots     SnapshotsConfig     `yaml:"snapshots"`
+	Docs          DocsConfig          `yaml:"docs"`
+	Compression   CompressionConfig   `yaml:"compression"`
+	CORS          CORSConfig          `yaml:"cors"`
+
+	// Timeouts maps a route group name to its request deadline. Groups
+	// without an entry use the "default" entry.
+	Timeouts map[string]time.Duration `yaml:"timeouts"`
+
+	// RateLimits maps a route group name to its rate limit. Groups
+	// without an entry use the "default" entry, if any.
+	RateLimits map[string]RateLimitConfig `yaml:"rate_limits"`
+
+	// ReloadInterval is how often the config file is checked for changes.
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// ServerConfig configures the HTTP server. Client addresses are taken
+// from X-Forwarded-For only on requests from TrustedProxies, given as IP
+// addresses or CIDR ranges, such as the ingress controller's.
+type ServerConfig struct {
+	Addr           string        `yaml:"addr"`
+	Port           string        `yaml:"port"`
+	Mode           string        `yaml:"mode"`
+	ReadTimeout    time.Duration `yaml:"read_timeout"`
+	WriteTimeout   time.Duration `yaml:"write_timeout"`
+	TrustedProxies []string      `yaml:"trusted_proxies"`
+}
+
+// ElasticsearchConfig configures the Elasticsearch client and index.
+// Documents are written through WriteAlias and searched through ReadAlias;
+// Index is the prefix of the versioned indices behind them.
+// Either Username and Password or APIKey authenticate the client.
+// The cluster is probed every HealthCheckInterval; failed connections are
+// retried after RetryInterval, doubling up to MaxRetryInterval.
+// Uploaded files are extracted by the ingest pipeline AttachmentPipeline,
+// which needs the ingest-attachment plugin.
+type ElasticsearchConfig struct {
+	URL                 string         `yaml:"url"`
+	Index               string         `yaml:"index"`
+	WriteAlias          string         `yaml:"write_alias"`
+	ReadAlias           string         `yaml:"read_alias"`
+	Type                string         `yaml:"type"`
+	Shards              int            `yaml:"shards"`
+	Replicas            int            `yaml:"replicas"`
+	Username            string         `yaml:"username"`
+	Password            string         `yaml:"password"`
+	APIKey              string         `yaml:"api_key"`
+	TLS                 TLSConfig      `yaml:"tls"`
+	Rollover            RolloverConfig `yaml:"rollover"`
+	AttachmentPipeline  string         `yaml:"attachment_pipeline"`
+	Sniff               bool           `yaml:"sniff"`
+	RetryInterval       time.Duration  `yaml:"retry_interval"`
+	MaxRetryInterval    time.Duration  `yaml:"max_retry_interval"`
+	HealthCheckInterval time.Duration  `yaml:"health_check_interval"`
+}
+
+// RolloverConfig enables time-based rollover of the documents index under
+// the ILM policy named Policy. The write index is rolled over when older
+// than MaxAge or larger than MaxSize; indices are deleted DeleteAfter their
+// rollover when it is set. Rollover needs Elasticsearch 6.6 or later.
+type RolloverConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	Policy      string `yaml:"policy"`
+	MaxAge      string `yaml:"max_age"`
+	MaxSize     string `yaml:"max_size"`
+	DeleteAfter string `yaml:"delete_after"`
+}
+
+// TLSConfig configures TLS towards a backend. CAFile adds a CA to trust,
+// CertFile and KeyFile present a client certificate. All are PEM file paths
+// and empty means the system default.
+type TLSConfig struct {
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+// RedisConfig configures the Redis client and its connection pool.
+// Mode selects the deployment: "standalone" connects to Addr, "sentinel"
+// finds the master through Sentinel and "cluster" discovers the cluster
+// from ClusterAddrs, or from Addr when they are empty.
+// Username selects a Redis 6 ACL user; without it only Password is sent.
+// Callers wait up to PoolTimeout for a free connection; connections idle
+// for IdleTimeout are closed, checked every IdleCheckFrequency. Redis is
+// pinged every HealthCheckInterval.
+type RedisConfig struct {
+	Mode                string         `yaml:"mode"`
+	Addr                string         `yaml:"addr"`
+	Sentinel            SentinelConfig `yaml:"sentinel"`
+	ClusterAddrs        []string       `yaml:"cluster_addrs"`
+	Username            string         `yaml:"username"`
+	Password            string         `yaml:"password"`
+	TLS                 RedisTLSConfig `yaml:"tls"`
+	DB                  int            `yaml:"db"`
+	PoolSize            int            `yaml:"pool_size"`
+	PoolTimeout         time.Duration  `yaml:"pool_timeout"`
+	IdleTimeout         time.Duration  `yaml:"idle_timeout"`
+	IdleCheckFrequency  time.Duration  `yaml:"idle_check_frequency"`
+	DialTimeout         time.Duration  `yaml:"dial_timeout"`
+	ReadTimeout         time.Duration  `yaml:"read_timeout"`
+	WriteTimeout        time.Duration  `yaml:"write_timeout"`
+	HealthCheckInterval time.Duration  `yaml:"health_check_interval"`
+}
+
+// SentinelConfig locates the Redis master through Sentinel: the client asks
+// the sentinels at Addrs for the master named MasterName and follows
+// failovers.
+type SentinelConfig struct {
+	MasterName string   `yaml:"master_name"`
+	Addrs      []string `yaml:"addrs"`
+}
+
+// RedisTLSConfig enables TLS towards Redis. The CA defaults to the
+// redis-ca.crt file in the secrets directory when that exists.
+type RedisTLSConfig struct {
+	Enabled   bool `yaml:"enabled"`
+	TLSConfig `yaml:",inline"`
+}
+
+// CouchbaseConfig configures the Couchbase connection. The bucket is opened
+// once and shared; while it cannot be, connecting is retried every
+// RetryInterval.
+// URL is the management URL of a node or a connection string,
+// couchbase://host or couchbases://host; https and couchbases connect to
+// every service over TLS, trusting TLS.CAFile. With TLS.CertFile and no
+// Username, the client certificate authenticates instead of a password.
+// Username and Password authenticate an RBAC user with the cluster; without
+// them access is anonymous. BucketUsername and BucketPassword, when set,
+// open the bucket instead, for buckets with credentials of their own such
+// as SASL buckets from before Couchbase 5.0.
+// Batch requests take up to MaxBatch keys and write them with BatchWorkers
+// concurrent requests.
+// Requests may select a bucket, scope and collection other than the default
+// collection of Bucket if listed in Keyspaces, as "bucket.scope.collection"
+// or a bare bucket name for its default collection.
+// Writes asking for durability fail once it is not reached within
+// DurabilityTimeout. View queries return at most ViewMaxRows rows a page.
+// Searches on the couchbase backend use the full-text index FTSIndex.
+// With AuditDeletes, deleted keys are recorded in the audit log with the
+// value they had. Transactions whose replica stopped midway are rolled back
+// once their record was not updated for TransactionRecovery.
+type CouchbaseConfig struct {
+	URL            string        `yaml:"url"`
+	Username       string        `yaml:"username"`
+	Password       string        `yaml:"password"`
+	Pool           string        `yaml:"pool"`
+	Bucket         string        `yaml:"bucket"`
+	BucketUsername string        `yaml:"bucket_username"`
+	BucketPassword string        `yaml:"bucket_password"`
+	RetryInterval  time.Duration `yaml:"retry_interval"`
+	MaxBatch       int           `yaml:"max_batch"`
+	BatchWorkers   int           `yaml:"batch_workers"`
+	Keyspaces      []string      `yaml:"keyspaces"`
+
+	DurabilityTimeout   time.Duration `yaml:"durability_timeout"`
+	ViewMaxRows         int           `yaml:"view_max_rows"`
+	FTSIndex            string        `yaml:"fts_index"`
+	AuditDeletes        bool          `yaml:"audit_deletes"`
+	TransactionRecovery time.Duration `yaml:"transaction_recovery"`
+
+	TLS   TLSConfig            `yaml:"tls"`
+	Query CouchbaseQueryConfig `yaml:"query"`
+	Feed  CouchbaseFeedConfig  `yaml:"feed"`
+	Blob  CouchbaseBlobConfig  `yaml:"blob"`
+}
+
+// CouchbaseQueryConfig limits the N1QL statements run through the API.
+// Statements must start with one of AllowedStatements, compared without
+// case, may only name keyspaces allowed by CouchbaseConfig.Keyspaces, and
+// return at most MaxRows rows, the rest being cut off. Timeout bounds a
+// statement on the server.
+type CouchbaseQueryConfig struct {
+	AllowedStatements []string      `yaml:"allowed_statements"`
+	MaxRows           int           `yaml:"max_rows"`
+	Timeout           time.Duration `yaml:"timeout"`
+}
+
+// CouchbaseFeedConfig indexes the documents of a bucket by following its
+// change feed (DCP). Each key under Prefix holds a document as JSON, shaped
+// like a create request, and is indexed with the rest of the key as ID; the
+// document moves to the trash when the key is deleted or expires. Bucket
+// defaults to the configured one. One replica streams at a time, the others
+// retrying every RetryInterval. Changes are indexed in batches of up to
+// BatchSize, at least every FlushInterval, and the position reached in each
+// vBucket is then saved in a Redis hash under CheckpointKey, so a restart
+// resumes there. Only read at startup.
+type CouchbaseFeedConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	Bucket        string        `yaml:"bucket"`
+	Prefix        string        `yaml:"prefix"`
+	CheckpointKey string        `yaml:"checkpoint_key"`
+	BatchSize     int           `yaml:"batch_size"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+	RetryInterval time.Duration `yaml:"retry_interval"`
+}
+
+// CouchbaseBlobConfig configures binary values stored through the blob
+// endpoints. Blobs of up to MaxSize bytes are stored in chunks of
+// ChunkSize bytes, below Couchbase's 20MB item limit, under keys starting
+// with Prefix.
+type CouchbaseBlobConfig struct {
+	Prefix    string `yaml:"prefix"`
+	ChunkSize int    `yaml:"chunk_size"`
+	MaxSize   int64  `yaml:"max_size"`
+}
+
+// DocumentsConfig holds the limits of the document endpoints.
+type DocumentsConfig struct {
+	// DeleteByQueryMaxDocs rejects a delete-by-query matching more
+	// documents than this.
+	DeleteByQueryMaxDocs int `yaml:"delete_by_query_max_docs"`
+
+	// Created documents are sent in bulk requests of BulkBatchSize
+	// documents, at most BulkWorkers of them at a time.
+	BulkBatchSize int `yaml:"bulk_batch_size"`
+	BulkWorkers   int `yaml:"bulk_workers"`
+	// MaxMetadataKeys limits the metadata attributes of a document.
+	MaxMetadataKeys int `yaml:"max_metadata_keys"`
+	// MaxTags limits the tags of a document.
+	MaxTags int `yaml:"max_tags"`
+	// MaxAttachmentSize limits uploaded files, in bytes.
+	MaxAttachmentSize int64 `yaml:"max_attachment_size"`
+	// Imports reject lines longer than ImportMaxLineSize bytes and report
+	// at most ImportMaxErrors failed lines.
+	ImportMaxLineSize int `yaml:"import_max_line_size"`
+	ImportMaxErrors   int `yaml:"import_max_errors"`
+	// RequireIfMatch rejects updates and deletes without an If-Match
+	// header carrying the document ETag.
+	RequireIfMatch bool `yaml:"require_if_match"`
+	// Deleted documents stay in the trash, restorable, for TrashRetention
+	// and are purged every TrashPurgeInterval once it has passed.
+	TrashRetention     time.Duration `yaml:"trash_retention"`
+	TrashPurgeInterval time.Duration `yaml:"trash_purge_interval"`
+}
+
+// SearchConfig holds the tunables of the search endpoint.
+type SearchConfig struct {
+	DefaultPageSize int           `yaml:"default_page_size"`
+	MaxPageSize     int           `yaml:"max_page_size"`
+	Facets          []FacetConfig `yaml:"facets"`
+	SuggestSize     int           `yaml:"suggest_size"`
+
+	// DidYouMeanBelow adds spelling suggestions to searches returning fewer
+	// hits than this. Zero disables them.
+	DidYouMeanBelow int64 `yaml:"did_you_mean_below"`
+
+	// FieldBoosts weights the text fields in the search query, e.g.
+	// title: 3 ranks title matches above content matches. They can be
+	// overridden at runtime through a Redis hash at BoostsRedisKey.
+	FieldBoosts    map[string]float64 `yaml:"field_boosts"`
+	BoostsRedisKey string             `yaml:"boosts_redis_key"`
+	// DefaultFuzziness is used when a search does not pass fuzziness;
+	// requests may ask for AUTO or an edit distance up to MaxFuzziness.
+	DefaultFuzziness string `yaml:"default_fuzziness"`
+	MaxFuzziness     int    `yaml:"max_fuzziness"`
+	// MinimumShouldMatch is how many query terms a document must match,
+	// in Elasticsearch minimum_should_match syntax. Requests may override
+	// it.
+	MinimumShouldMatch string `yaml:"minimum_should_match"`
+	// DefaultRadius is the distance searched around near= when no radius
+	// is given.
+	DefaultRadius string `yaml:"default_radius"`
+	// Backend answers searches: "elasticsearch", or "couchbase" for the
+	// full-text index Couchbase.FTSIndex. Requests may pick one with the
+	// backend parameter.
+	Backend string `yaml:"backend"`
+}
+
+// FacetConfig describes an aggregation returned with search results when
+// facets are requested. Type is "terms" or "date_histogram".
+type FacetConfig struct {
+	Name     string `yaml:"name"`
+	Field    string `yaml:"field"`
+	Type     string `yaml:"type"`
+	Size     int    `yaml:"size"`
+	Interval string `yaml:"interval"`
+}
+
+// CacheConfig configures response caching. TTL bounds how long a cached
+// search is served; writes invalidate the cache before that. Each replica
+// also keeps up to LocalSize entries in process, zero disabling that.
+// The searches for WarmQueries and for the WarmTopQueries most popular
+// queries of the default analytics window are cached on startup, when
+// WarmOnStartup is set, and on request, so the first users after a deploy
+// do not wait for Elasticsearch.
+type CacheConfig struct {
+	TTL            time.Duration `yaml:"ttl"`
+	LocalSize      int           `yaml:"local_size"`
+	WarmQueries    []string      `yaml:"warm_queries"`
+	WarmTopQueries int           `yaml:"warm_top_queries"`
+	WarmOnStartup  bool          `yaml:"warm_on_startup"`
+
+	// Routes maps a cacheable route name to its policy. Routes without an
+	// entry are not cached.
+	Routes map[string]CachePolicy `yaml:"routes"`
+}
+
+// CachePolicy configures caching of the GET responses of a route. Successful
+// responses are served from the cache for TTL. They are cached apart for
+// each value of the query parameters in VaryParams, every parameter when
+// empty, and of the request headers in VaryHeaders. Requests carrying
+// BypassHeader skip the cache. InvalidateOnWrite drops the responses
+// whenever documents change, for routes that serve them.
+type CachePolicy struct {
+	TTL               time.Duration `yaml:"ttl"`
+	VaryParams        []string      `yaml:"vary_params"`
+	VaryHeaders       []string      `yaml:"vary_headers"`
+	BypassHeader      string        `yaml:"bypass_header"`
+	InvalidateOnWrite bool          `yaml:"invalidate_on_write"`
+}
+
+// FeaturesConfig holds the feature flag defaults and where to find their
+// Redis overrides. An empty RedisKey disables overrides.
+type FeaturesConfig struct {
+	Flags           map[string]bool `yaml:"flags"`
+	RedisKey        string          `yaml:"redis_key"`
+	RefreshInterval time.Duration   `yaml:"refresh_interval"`
+}
+
+// AlertsConfig configures saved-query alerts. Index holds the percolator
+// queries; matches are delivered by Workers goroutines reading a queue of
+// QueueSize documents, trying each webhook up to MaxAttempts times.
+type AlertsConfig struct {
+	Index          string        `yaml:"index"`
+	QueueSize      int           `yaml:"queue_size"`
+	Workers        int           `yaml:"workers"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	WebhookTimeout time.Duration `yaml:"webhook_timeout"`
+}
+
+// JobsConfig configures asynchronous bulk ingest. Create requests with
+// more than AsyncThreshold documents become jobs on the job queue; zero
+// only does so when asked. At most QueueSize ingest jobs may be pending.
+// The status of a job is kept for Retention after it was last updated.
+type JobsConfig struct {
+	AsyncThreshold int           `yaml:"async_threshold"`
+	QueueSize      int           `yaml:"queue_size"`
+	Retention      time.Duration `yaml:"retention"`
+}
+
+// QueueConfig configures the Redis job queue running bulk ingest, async
+// reindexes and webhook deliveries. Workers jobs run at a time on each
+// replica. A failed job is retried after Backoff, doubling up to
+// MaxBackoff, until it ran MaxAttempts times; then it joins the last
+// DeadLetters failed jobs kept for inspection. Idle workers poll every
+// PollInterval. A job whose replica stops renewing it for Lease runs again
+// elsewhere. Only read at startup.
+type QueueConfig struct {
+	Name         string        `yaml:"name"`
+	Workers      int           `yaml:"workers"`
+	MaxAttempts  int           `yaml:"max_attempts"`
+	Backoff      time.Duration `yaml:"backoff"`
+	MaxBackoff   time.Duration `yaml:"max_backoff"`
+	Lease        time.Duration `yaml:"lease"`
+	PollInterval time.Duration `yaml:"poll_interval"`
+	DeadLetters  int           `yaml:"dead_letters"`
+}
+
+// RateLimitConfig limits requests per sliding Window: Global across all
+// clients and PerClient for each API key, or client IP without one. Zero
+// means no limit.
+type RateLimitConfig struct {
+	Window    time.Duration `yaml:"window"`
+	Global    int           `yaml:"global"`
+	PerClient int           `yaml:"per_client"`
+}
+
+// StreamConfig configures ingestion from the Redis Stream Key, read by the
+// consumer group Group as Consumer, which defaults to the host name. Entries
+// are read BatchSize at a time, blocking up to Block for new ones; entries
+// left unacknowledged by a consumer for ClaimIdle are claimed by another,
+// and dropped after MaxDeliveries attempts. The stream is trimmed to about
+// MaxLen entries. Only read at startup.
+type StreamConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	Key           string        `yaml:"key"`
+	Group         string        `yaml:"group"`
+	Consumer      string        `yaml:"consumer"`
+	BatchSize     int           `yaml:"batch_size"`
+	Block         time.Duration `yaml:"block"`
+	ClaimIdle     time.Duration `yaml:"claim_idle"`
+	MaxDeliveries int           `yaml:"max_deliveries"`
+	MaxLen        int           `yaml:"max_len"`
+}
+
+// MirrorConfig mirrors Redis keys into the documents index. Each string key
+// under Prefix holds a document as JSON, shaped like a create request, and
+// is indexed with the rest of the key as ID whenever it is set; the
+// document moves to the trash when the key expires or is deleted. Changes
+// arrive as keyspace notifications, which Redis only sends when its
+// notify-keyspace-events setting includes K, $, g and x; ConfigureEvents
+// sets it on startup. One replica mirrors at a time, the others retrying
+// every RetryInterval, and every key is resynced on (re)subscribing. In
+// cluster mode only the keys of one node are seen. Only read at startup.
+type MirrorConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	Prefix          string        `yaml:"prefix"`
+	ConfigureEvents bool          `yaml:"configure_events"`
+	RetryInterval   time.Duration `yaml:"retry_interval"`
+}
+
+// SessionsConfig configures browser sessions. A client presenting one of
+// APIKeys is given a session, carried in the cookie CookieName or as a
+// bearer token, which expires TTL after it was last used. The keys are best
+// kept in the session-api-keys secret file, one per line. When Required,
+// the routes the search UI uses refuse requests without a session.
+//
+// AdminAPIKeys, kept in the session-admin-api-keys secret file, carry the
+// admin role: the /admin routes need one of them, in X-API-Key or through
+// a session started with it. Without any, the admin routes are closed.
+type SessionsConfig struct {
+	APIKeys      []string      `yaml:"api_keys"`
+	AdminAPIKeys []string      `yaml:"admin_api_keys"`
+	Required     bool          `yaml:"required"`
+	TTL          time.Duration `yaml:"ttl"`
+	CookieName   string        `yaml:"cookie_name"`
+	SecureCookie bool          `yaml:"secure_cookie"`
+}
+
+// KVConfig limits the key/value API: values, hash fields and list or set
+// members are at most MaxValueSize bytes, hashes, lists and sets hold at
+// most MaxItems entries, and batch requests name at most MaxBatch keys.
+type KVConfig struct {
+	MaxValueSize int `yaml:"max_value_size"`
+	MaxItems     int `yaml:"max_items"`
+	MaxBatch     int `yaml:"max_batch"`
+}
+
+// AnalyticsConfig configures the top search terms. Searches are counted in
+// Redis per Bucket; a window adds up the buckets it covers, weighting each
+// down by half for every HalfLife of its age, zero disabling the decay.
+// Windows names the windows clients may ask for, DefaultWindow being used
+// when they do not. Queries longer than MaxQueryLength are truncated.
+type AnalyticsConfig struct {
+	Bucket         time.Duration            `yaml:"bucket"`
+	HalfLife       time.Duration            `yaml:"half_life"`
+	Windows        map[string]time.Duration `yaml:"windows"`
+	DefaultWindow  string                   `yaml:"default_window"`
+	MaxQueryLength int                      `yaml:"max_query_length"`
+}
+
+// SnapshotsConfig names the snapshot repository used by the backup
+// endpoints. When Type is set the repository is registered with Settings
+// on startup, e.g. type "fs" with a "location" setting.
+type SnapshotsConfig struct {
+	Repository string            `yaml:"repository"`
+	Type       string            `yaml:"type"`
+	Settings   map[string]string `yaml:"settings"`
+}
+
+// DocsConfig configures the API documentation. When Enabled the OpenAPI
+// document is served at /openapi.json and Swagger UI at /docs, with the
+// scripts and styles of the swagger-ui-dist release built into the binary,
+// or those at SwaggerUIURL when set. Only read at startup.
+type DocsConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	SwaggerUIURL string `yaml:"swagger_ui_url"`
+}
+
+// CompressionConfig configures response compression. When Enabled,
+// responses of at least MinSize bytes whose media type matches one of
+// ContentTypes, e.g. application/json or text/*, are compressed with gzip
+// or deflate as the client accepts, at Level from 1 (fastest) to 9
+// (smallest).
+type CompressionConfig struct {
+	Enabled      bool     `yaml:"enabled"`
+	Level        int      `yaml:"level"`
+	MinSize      int      `yaml:"min_size"`
+	ContentTypes []string `yaml:"content_types"`
+}
+
+// CORSConfig lets browser frontends served from AllowedOrigins call the
+// API. An origin is either exact, such as https://app.example.com, a
+// wildcard subdomain, such as https://*.example.com, or * for any origin;
+// none are allowed when the list is empty. Preflight requests are answered
+// with AllowedMethods and AllowedHeaders, cached by the browser for MaxAge,
+// and ExposedHeaders are readable by scripts. AllowCredentials lets
+// requests carry cookies, such as the session, which browsers refuse for
+// an origin of *.
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowed_origins"`
+	AllowedMethods   []string      `yaml:"allowed_methods"`
+	AllowedHeaders   []string      `yaml:"allowed_headers"`
+	ExposedHeaders   []string      `yaml:"exposed_headers"`
+	AllowCredentials bool          `yaml:"allow_credentials"`
+	MaxAge           time.Duration `yaml:"max_age"`
+}
+
+// LogConfig configures application logging. Audit is the file the audit
+// log is appended to; when empty audit events go to the application log.
+// Only read at startup.
+type LogConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+	Audit  string `yaml:"audit"`
+}
+
+// Default returns the configuration used when nothing is overridden. The
+// addresses match the in-cluster service names.
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Port:         "8080",
+			Mode:         "debug",
+			ReadTimeout:  30 * time.Second,
+			WriteTimeout: 30 * time.Second,
+		},
+		Elasticsearch: ElasticsearchConfig{
+			URL:                 "http://elasticsearch:9200",
+			Index:               "documents",
+			WriteAlias:          "documents-write",
+			ReadAlias:           "documents-read",
+			Type:                "document",
+			Shards:              1,
+			Replicas:            1,
+			RetryInterval:       3 * time.Second,
+			MaxRetryInterval:    time.Minute,
+			HealthCheckInterval: 30 * time.Second,
+			AttachmentPipeline:  "document-attachments",
+			Rollover: RolloverConfig{
+				Policy:  "documents-rollover",
+				MaxAge:  "30d",
+				MaxSize: "50gb",
+			},
+		},
+		Redis: RedisConfig{
+			Mode:                "standalone",
+			Addr:                "redis-master:6379",
+			PoolSize:            10,
+			PoolTimeout:         4 * time.Second,
+			IdleTimeout:         5 * time.Minute,
+			IdleCheckFrequency:  time.Minute,
+			DialTimeout:         5 * time.Second,
+			ReadTimeout:         3 * time.Second,
+			WriteTimeout:        3 * time.Second,
+			HealthCheckInterval: 30 * time.Second,
+		},
+		Couchbase: CouchbaseConfig{
+			URL:           "http://couchbase-master-service:8091",
+			Pool:          "default",
+			Bucket:        "default",
+			RetryInterval: 10 * time.Second,
+			MaxBatch:      1000,
+			BatchWorkers:  8,
+
+			DurabilityTimeout:   10 * time.Second,
+			ViewMaxRows:         1000,
+			FTSIndex:            "documents",
+			TransactionRecovery: 5 * time.Minute,
+			Query: CouchbaseQueryConfig{
+				AllowedStatements: []string{"SELECT"},
+				MaxRows:           1000,
+				Timeout:           10 * time.Second,
+			},
+			Feed: CouchbaseFeedConfig{
+				Prefix:        "documents:",
+				CheckpointKey: "couchbase_feed",
+				BatchSize:     500,
+				FlushInterval: time.Second,
+				RetryInterval: 10 * time.Second,
+			},
+			Blob: CouchbaseBlobConfig{
+				Prefix:    "blob:",
+				ChunkSize: 1 << 20,
+				MaxSize:   64 << 20,
+			},
+		},
+		Documents: DocumentsConfig{
+			DeleteByQueryMaxDocs: 1000,
+			BulkBatchSize:        500,
+			BulkWorkers:          4,
+			MaxMetadataKeys:      50,
+			MaxTags:              20,
+			MaxAttachmentSize:    10 << 20,
+			ImportMaxLineSize:    1 << 20,
+			ImportMaxErrors:      1000,
+			RequireIfMatch:       true,
+			TrashRetention:       30 * 24 * time.Hour,
+			TrashPurgeInterval:   time.Hour,
+		},
+		Search: SearchConfig{
+			DefaultPageSize: 10,
+			MaxPageSize:     100,
+			SuggestSize:     5,
+			DidYouMeanBelow: 3,
+			FieldBoosts: map[string]float64{
+				"title":   1,
+				"content": 1,
+			},
+			BoostsRedisKey:   "search_boosts",
+			DefaultFuzziness: "2",
+			MaxFuzziness:     2,
+			// All terms of one- and two-term queries, 75% of longer ones.
+			MinimumShouldMatch: "2<75%",
+			DefaultRadius:      "10km",
+			Backend:            "elasticsearch",
+			Facets: []FacetConfig{
+				{Name: "created_month", Field: "created_at", Type: "date_histogram", Interval: "month"},
+			},
+		},
+		Cache: CacheConfig{
+			TTL:            time.Minute,
+			LocalSize:      1000,
+			WarmTopQueries: 20,
+			WarmOnStartup:  true,
+			Routes: map[string]CachePolicy{
+				"suggest": {
+					TTL:               time.Minute,
+					BypassHeader:      "X-Cache-Bypass",
+					InvalidateOnWrite: true,
+				},
+				"tags": {
+					TTL:               5 * time.Minute,
+					BypassHeader:      "X-Cache-Bypass",
+					InvalidateOnWrite: true,
+				},
+				"top_queries": {
+					TTL:          time.Minute,
+					BypassHeader: "X-Cache-Bypass",
+				},
+			},
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "text",
+		},
+		Secrets: SecretsConfig{
+			Dir:             "/var/run/secrets/app",
+			RefreshInterval: time.Minute,
+		},
+		Features: FeaturesConfig{
+			Flags: map[string]bool{
+				"enable_couchbase":    true,
+				"enable_fuzzy_search": true,
+				"enable_alerts":       false,
+				"enable_search_cache": true,
+			},
+			RedisKey:        "feature_flags",
+			RefreshInterval: 30 * time.Second,
+		},
+		Alerts: AlertsConfig{
+			Index:          "document-alerts",
+			QueueSize:      1000,
+			Workers:        2,
+			MaxAttempts:    3,
+			WebhookTimeout: 5 * time.Second,
+		},
+		Jobs: JobsConfig{
+			AsyncThreshold: 5000,
+			QueueSize:      10,
+			Retention:      time.Hour,
+		},
+		Queue: QueueConfig{
+			Name:         "jobs",
+			Workers:      2,
+			MaxAttempts:  5,
+			Backoff:      time.Second,
+			MaxBackoff:   5 * time.Minute,
+			Lease:        time.Minute,
+			PollInterval: time.Second,
+			DeadLetters:  1000,
+		},
+		Stream: StreamConfig{
+			Key:           "documents-ingest",
+			Group:         "indexers",
+			BatchSize:     100,
+			Block:         2 * time.Second,
+			ClaimIdle:     time.Minute,
+			MaxDeliveries: 5,
+			MaxLen:        100000,
+		},
+		Mirror: MirrorConfig{
+			Prefix:        "documents:",
+			RetryInterval: 10 * time.Second,
+		},
+		Sessions: SessionsConfig{
+			TTL:          30 * time.Minute,
+			CookieName:   "session",
+			SecureCookie: true,
+		},
+		KV: KVConfig{
+			MaxValueSize: 1 << 20,
+			MaxItems:     10000,
+			MaxBatch:     1000,
+		},
+		Analytics: AnalyticsConfig{
+			Bucket:   time.Hour,
+			HalfLife: 24 * time.Hour,
+			Windows: map[string]time.Duration{
+				"hour": time.Hour,
+				"day":  24 * time.Hour,
+				"week": 7 * 24 * time.Hour,
+			},
+			DefaultWindow:  "day",
+			MaxQueryLength: 200,
+		},
+		Snapshots: SnapshotsConfig{
+			Repository: "documents-backup",
+		},
+		Docs: DocsConfig{
+			Enabled: true,
+		},
+		Compression: CompressionConfig{
+			Enabled: true,
+			Level:   5,
+			MinSize: 1024,
+			ContentTypes: []string{
+				"application/json",
+				"application/x-ndjson",
+				"application/xml",
+				"text/*",
+			},
+		},
+		CORS: CORSConfig{
+			AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+			AllowedHeaders: []string{
+				"Authorization",
+				"Content-Type",
+				"If-Match",
+				"If-None-Match",
+				"X-API-Key",
+				"X-Request-ID",
+			},
+			ExposedHeaders: []string{
+				"Deprecation",
+				"ETag",
+				"Link",
+				"Retry-After",
+				"X-Cache",
+				"X-Request-ID",
+			},
+			MaxAge: 10 * time.Minute,
+		},
+		Timeouts: map[string]time.Duration{
+			"default":   5 * time.Second,
+			"search":    2 * time.Second,
+			"documents": 10 * time.Second,
+		},
+		ReloadInterval: 10 * time.Second,
+	}
+}
+
+// Load builds the configuration from the defaults, the named profile
+// (skipped when empty), the YAML file at path (skipped when empty), the
+// secret files and finally the environment. The result is not validated,
+// so callers can apply further overrides before calling Validate.
+func Load(profile, path string) (*Config, error) {
+	cfg := Default()
+	if err := cfg.applyProfile(profile); err != nil {
+		return nil, err
+	}
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.loadSecrets(); err != nil {
+		return nil, err
+	}
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (cfg *Config) applyEnv() error {
+	setString(&cfg.Server.Addr, "BIND_ADDR")
+	setString(&cfg.Server.Port, "PORT")
+	setString(&cfg.Server.Mode, "GIN_MODE")
+	setList(&cfg.Server.TrustedProxies, "TRUSTED_PROXIES")
+	setString(&cfg.Elasticsearch.URL, "ELASTICSEARCH_URL")
+	setString(&cfg.Elasticsearch.Index, "ELASTICSEARCH_INDEX")
+	setString(&cfg.Elasticsearch.Username, "ELASTICSEARCH_USERNAME")
+	setString(&cfg.Elasticsearch.Password, "ELASTICSEARCH_PASSWORD")
+	setString(&cfg.Elasticsearch.APIKey, "ELASTICSEARCH_API_KEY")
+	setString(&cfg.Elasticsearch.TLS.CAFile, "ELASTICSEARCH_CA_FILE")
+	setString(&cfg.Elasticsearch.TLS.CertFile, "ELASTICSEARCH_CERT_FILE")
+	setString(&cfg.Elasticsearch.TLS.KeyFile, "ELASTICSEARCH_KEY_FILE")
+	setString(&cfg.Redis.Mode, "REDIS_MODE")
+	setString(&cfg.Redis.Addr, "REDIS_ADDR")
+	setString(&cfg.Redis.Username, "REDIS_USERNAME")
+	setString(&cfg.Redis.Password, "REDIS_PASSWORD")
+	setString(&cfg.Redis.TLS.CAFile, "REDIS_CA_FILE")
+	setString(&cfg.Redis.Sentinel.MasterName, "REDIS_SENTINEL_MASTER")
+	setList(&cfg.Redis.Sentinel.Addrs, "REDIS_SENTINEL_ADDRS")
+	setList(&cfg.Redis.ClusterAddrs, "REDIS_CLUSTER_ADDRS")
+	setList(&cfg.Sessions.APIKeys, "SESSION_API_KEYS")
+	setList(&cfg.Sessions.AdminAPIKeys, "SESSION_ADMIN_API_KEYS")
+	setString(&cfg.Couchbase.URL, "COUCHBASE_URL")
+	setString(&cfg.Couchbase.Username, "COUCHBASE_USERNAME")
+	setString(&cfg.Couchbase.Password, "COUCHBASE_PASSWORD")
+	setString(&cfg.Couchbase.BucketUsername, "COUCHBASE_BUCKET_USERNAME")
+	setString(&cfg.Couchbase.BucketPassword, "COUCHBASE_BUCKET_PASSWORD")
+	setString(&cfg.Couchbase.Bucket, "COUCHBASE_BUCKET")
+	setString(&cfg.Log.Level, "LOG_LEVEL")
+	setString(&cfg.Log.Format, "LOG_FORMAT")
+	if err := setInt(&cfg.Redis.PoolSize, "REDIS_POOL_SIZE"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func setString(dst *string, key string) {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		*dst = v
+	}
+}
+
+// setList sets dst from a comma-separated variable.
+func setList(dst *[]string, key string) {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		*dst = strings.Split(v, ",")
+	}
+}
+
+func setInt(dst *int, key string) error {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("config: invalid %s %q: %v", key, v, err)
+	}
+	*dst = i
+	return nil
+}
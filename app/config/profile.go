@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// profiles are bundled sets of defaults selected with --profile. They are
+// applied on top of Default and below the config file, so a file or the
+// environment can still override any value a profile sets.
+var profiles = map[string]func(*Config){
+	"dev": func(cfg *Config) {
+		cfg.Server.Mode = "debug"
+		cfg.Server.ReadTimeout = time.Minute
+		cfg.Server.WriteTimeout = time.Minute
+		cfg.Elasticsearch.Sniff = false
+		cfg.Log.Level = "debug"
+		cfg.Log.Format = "text"
+		cfg.Timeouts = map[string]time.Duration{
+			"default":   30 * time.Second,
+			"search":    10 * time.Second,
+			"documents": time.Minute,
+		}
+	},
+	"staging": func(cfg *Config) {
+		cfg.Server.Mode = "release"
+		cfg.Log.Level = "info"
+		cfg.Log.Format = "json"
+	},
+	"prod": func(cfg *Config) {
+		cfg.Server.Mode = "release"
+		cfg.Server.ReadTimeout = 10 * time.Second
+		cfg.Server.WriteTimeout = 10 * time.Second
+		cfg.Log.Level = "info"
+		cfg.Log.Format = "json"
+		cfg.Timeouts = map[string]time.Duration{
+			"default":   3 * time.Second,
+			"search":    time.Second,
+			"documents": 5 * time.Second,
+		}
+	},
+}
+
+func (cfg *Config) applyProfile(name string) error {
+	if name == "" {
+		return nil
+	}
+	apply, ok := profiles[name]
+	if !ok {
+		return fmt.Errorf("config: unknown profile %q, want dev, staging or prod", name)
+	}
+	apply(cfg)
+	return nil
+}
@@ -0,0 +1,202 @@
+package config
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SecretsConfig locates the credential files, typically a mounted
+// Kubernetes Secret. Each file holds a single value; missing files are
+// skipped.
+type SecretsConfig struct {
+	Dir             string        `yaml:"dir"`
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// loadSecrets reads the credential files from the secrets directory.
+func (cfg *Config) loadSecrets() error {
+	if cfg.Secrets.Dir == "" {
+		return nil
+	}
+	files := map[string]*string{
+		"elasticsearch-username":    &cfg.Elasticsearch.Username,
+		"elasticsearch-password":    &cfg.Elasticsearch.Password,
+		"elasticsearch-api-key":     &cfg.Elasticsearch.APIKey,
+		"redis-username":            &cfg.Redis.Username,
+		"redis-password":            &cfg.Redis.Password,
+		"couchbase-username":        &cfg.Couchbase.Username,
+		"couchbase-password":        &cfg.Couchbase.Password,
+		"couchbase-bucket-username": &cfg.Couchbase.BucketUsername,
+		"couchbase-bucket-password": &cfg.Couchbase.BucketPassword,
+	}
+	for name, dst := range files {
+		data, err := ioutil.ReadFile(filepath.Join(cfg.Secrets.Dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		*dst = strings.TrimSpace(string(data))
+	}
+	if err := secretLines(&cfg.Sessions.APIKeys, cfg.Secrets.Dir, "session-api-keys"); err != nil {
+		return err
+	}
+	if err := secretLines(&cfg.Sessions.AdminAPIKeys, cfg.Secrets.Dir, "session-admin-api-keys"); err != nil {
+		return err
+	}
+	if cfg.Redis.TLS.CAFile == "" {
+		ca := filepath.Join(cfg.Secrets.Dir, "redis-ca.crt")
+		if _, err := os.Stat(ca); err == nil {
+			cfg.Redis.TLS.CAFile = ca
+		}
+	}
+	cfg.Couchbase.TLS.CAFile = secretFile(cfg.Couchbase.TLS.CAFile, cfg.Secrets.Dir, "couchbase-ca.crt")
+	if cfg.Couchbase.TLS.CertFile == "" && cfg.Couchbase.TLS.KeyFile == "" {
+		cfg.Couchbase.TLS.CertFile = secretFile("", cfg.Secrets.Dir, "couchbase-client.crt")
+		cfg.Couchbase.TLS.KeyFile = secretFile("", cfg.Secrets.Dir, "couchbase-client.key")
+	}
+	return nil
+}
+
+// secretFile returns path, or when it is empty the named file of the
+// secrets directory if it exists.
+func secretFile(path, dir, name string) string {
+	if path != "" {
+		return path
+	}
+	f := filepath.Join(dir, name)
+	if _, err := os.Stat(f); err != nil {
+		return ""
+	}
+	return f
+}
+
+// reloadSecrets returns a copy of cfg with the credentials re-read from the
+// secrets directory and the environment.
+func (cfg *Config) reloadSecrets() (*Config, error) {
+	next := *cfg
+	if err := next.loadSecrets(); err != nil {
+		return nil, err
+	}
+	if err := next.applyEnv(); err != nil {
+		return nil, err
+	}
+	return &next, nil
+}
+
+func (cfg *Config) sameCredentials(other *Config) bool {
+	return cfg.Elasticsearch.Username == other.Elasticsearch.Username &&
+		cfg.Elasticsearch.Password == other.Elasticsearch.Password &&
+		cfg.Elasticsearch.APIKey == other.Elasticsearch.APIKey &&
+		cfg.Redis.Username == other.Redis.Username &&
+		cfg.Redis.Password == other.Redis.Password &&
+		cfg.Couchbase.Username == other.Couchbase.Username &&
+		cfg.Couchbase.Password == other.Couchbase.Password &&
+		cfg.Couchbase.BucketUsername == other.Couchbase.BucketUsername &&
+		cfg.Couchbase.BucketPassword == other.Couchbase.BucketPassword &&
+		sameStrings(cfg.Sessions.APIKeys, other.Sessions.APIKeys) &&
+		sameStrings(cfg.Sessions.AdminAPIKeys, other.Sessions.AdminAPIKeys)
+}
+
+// secretLines sets dst to the non-empty lines of the secret file name in
+// dir, if it exists.
+func secretLines(dst *[]string, dir, name string) error {
+	data, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	*dst = nil
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			*dst = append(*dst, line)
+		}
+	}
+	return nil
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// WatchSecrets re-reads the secret files every interval and calls onChange
+// with an updated copy of current() when a credential was rotated. It
+// returns when stop is closed.
+func WatchSecrets(interval time.Duration, stop <-chan struct{}, current func() *Config, onChange func(*Config)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		cfg := current()
+		next, err := cfg.reloadSecrets()
+		if err != nil {
+			log.Printf("config: cannot read secrets from %s: %v", cfg.Secrets.Dir, err)
+			continue
+		}
+		if !cfg.sameCredentials(next) {
+			onChange(next)
+		}
+	}
+}
+
+// Redacted returns a copy of cfg with every credential replaced by a
+// placeholder, suitable for showing to operators.
+func (cfg *Config) Redacted() *Config {
+	out := *cfg
+	for _, s := range []*string{
+		&out.Elasticsearch.Password,
+		&out.Elasticsearch.APIKey,
+		&out.Redis.Password,
+		&out.Couchbase.Password,
+		&out.Couchbase.BucketPassword,
+	} {
+		if *s != "" {
+			*s = "REDACTED"
+		}
+	}
+	out.Sessions.APIKeys = redactedList(cfg.Sessions.APIKeys)
+	out.Sessions.AdminAPIKeys = redactedList(cfg.Sessions.AdminAPIKeys)
+	// Repository settings may hold credentials, e.g. S3 keys.
+	out.Snapshots.Settings = redactedMap(cfg.Snapshots.Settings)
+	return &out
+}
+
+// redactedList returns a placeholder for each entry of list.
+func redactedList(list []string) []string {
+	out := make([]string, len(list))
+	for i := range out {
+		out[i] = "REDACTED"
+	}
+	return out
+}
+
+// redactedMap returns a copy of m with a placeholder for each value.
+func redactedMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k := range m {
+		out[k] = "REDACTED"
+	}
+	return out
+}
@@ -0,0 +1,542 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidationError lists every problem found in a configuration so they can
+// all be fixed in one go.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Validate checks the configuration for values that would only fail at the
+// first request. It returns a *ValidationError listing all problems.
+func (cfg *Config) Validate() error {
+	v := &validator{}
+
+	v.port("server.port", cfg.Server.Port)
+	v.oneOf("server.mode", cfg.Server.Mode, "debug", "release", "test")
+	v.nonNegative("server.read_timeout", cfg.Server.ReadTimeout)
+	v.nonNegative("server.write_timeout", cfg.Server.WriteTimeout)
+	for i, proxy := range cfg.Server.TrustedProxies {
+		if _, err := ParseIPNet(proxy); err != nil {
+			v.addf("server.trusted_proxies[%d] must be an IP address or CIDR range, got %q", i, proxy)
+		}
+	}
+
+	v.httpURL("elasticsearch.url", cfg.Elasticsearch.URL)
+	v.nonEmpty("elasticsearch.index", cfg.Elasticsearch.Index)
+	v.nonEmpty("elasticsearch.write_alias", cfg.Elasticsearch.WriteAlias)
+	v.nonEmpty("elasticsearch.read_alias", cfg.Elasticsearch.ReadAlias)
+	v.nonEmpty("elasticsearch.type", cfg.Elasticsearch.Type)
+	if cfg.Elasticsearch.Shards <= 0 {
+		v.addf("elasticsearch.shards must be positive, got %d", cfg.Elasticsearch.Shards)
+	}
+	if cfg.Elasticsearch.Replicas < 0 {
+		v.addf("elasticsearch.replicas must not be negative, got %d", cfg.Elasticsearch.Replicas)
+	}
+	if cfg.Elasticsearch.APIKey != "" && cfg.Elasticsearch.Username != "" {
+		v.addf("elasticsearch.api_key and elasticsearch.username are mutually exclusive")
+	}
+	v.tls("elasticsearch.tls", cfg.Elasticsearch.TLS)
+	v.nonEmpty("elasticsearch.attachment_pipeline", cfg.Elasticsearch.AttachmentPipeline)
+	if rc := cfg.Elasticsearch.Rollover; rc.Enabled {
+		v.nonEmpty("elasticsearch.rollover.policy", rc.Policy)
+		if rc.MaxAge == "" && rc.MaxSize == "" {
+			v.addf("elasticsearch.rollover needs max_age or max_size")
+		}
+	}
+	v.positive("elasticsearch.retry_interval", cfg.Elasticsearch.RetryInterval)
+	if cfg.Elasticsearch.MaxRetryInterval < cfg.Elasticsearch.RetryInterval {
+		v.addf("elasticsearch.max_retry_interval (%s) must not be smaller than elasticsearch.retry_interval (%s)",
+			cfg.Elasticsearch.MaxRetryInterval, cfg.Elasticsearch.RetryInterval)
+	}
+	v.positive("elasticsearch.health_check_interval", cfg.Elasticsearch.HealthCheckInterval)
+
+	if cfg.Redis.TLS.Enabled {
+		v.tls("redis.tls", cfg.Redis.TLS.TLSConfig)
+		if cfg.Redis.Mode != "standalone" {
+			v.addf("redis.tls is only supported in standalone mode")
+		}
+	}
+	switch cfg.Redis.Mode {
+	case "standalone":
+		v.hostPort("redis.addr", cfg.Redis.Addr)
+	case "sentinel":
+		sc := cfg.Redis.Sentinel
+		v.nonEmpty("redis.sentinel.master_name", sc.MasterName)
+		if len(sc.Addrs) == 0 {
+			v.addf("redis.sentinel.addrs must not be empty in sentinel mode")
+		}
+		for i, addr := range sc.Addrs {
+			v.hostPort(fmt.Sprintf("redis.sentinel.addrs[%d]", i), addr)
+		}
+	case "cluster":
+		if len(cfg.Redis.ClusterAddrs) == 0 {
+			v.hostPort("redis.addr", cfg.Redis.Addr)
+		}
+		for i, addr := range cfg.Redis.ClusterAddrs {
+			v.hostPort(fmt.Sprintf("redis.cluster_addrs[%d]", i), addr)
+		}
+		if cfg.Redis.DB != 0 {
+			v.addf("redis.db must be 0 in cluster mode, got %d", cfg.Redis.DB)
+		}
+	default:
+		v.addf("redis.mode must be standalone, sentinel or cluster, got %q", cfg.Redis.Mode)
+	}
+	if cfg.Redis.DB < 0 {
+		v.addf("redis.db must not be negative, got %d", cfg.Redis.DB)
+	}
+	if cfg.Redis.PoolSize <= 0 {
+		v.addf("redis.pool_size must be positive, got %d", cfg.Redis.PoolSize)
+	}
+	v.nonNegative("redis.pool_timeout", cfg.Redis.PoolTimeout)
+	v.nonNegative("redis.idle_timeout", cfg.Redis.IdleTimeout)
+	v.nonNegative("redis.idle_check_frequency", cfg.Redis.IdleCheckFrequency)
+	v.nonNegative("redis.dial_timeout", cfg.Redis.DialTimeout)
+	v.nonNegative("redis.read_timeout", cfg.Redis.ReadTimeout)
+	v.nonNegative("redis.write_timeout", cfg.Redis.WriteTimeout)
+	v.positive("redis.health_check_interval", cfg.Redis.HealthCheckInterval)
+
+	v.couchbaseURL("couchbase.url", cfg.Couchbase.URL)
+	v.tls("couchbase.tls", cfg.Couchbase.TLS)
+	v.nonEmpty("couchbase.pool", cfg.Couchbase.Pool)
+	v.nonEmpty("couchbase.bucket", cfg.Couchbase.Bucket)
+	v.positive("couchbase.retry_interval", cfg.Couchbase.RetryInterval)
+	if cfg.Couchbase.MaxBatch <= 0 {
+		v.addf("couchbase.max_batch must be positive, got %d", cfg.Couchbase.MaxBatch)
+	}
+	if cfg.Couchbase.BatchWorkers <= 0 {
+		v.addf("couchbase.batch_workers must be positive, got %d", cfg.Couchbase.BatchWorkers)
+	}
+	for _, ks := range cfg.Couchbase.Keyspaces {
+		if n := strings.Count(ks, "."); ks == "" || (n != 0 && n != 2) {
+			v.addf("couchbase.keyspaces: %q is neither a bucket nor bucket.scope.collection", ks)
+		}
+	}
+	if cfg.Couchbase.Query.MaxRows <= 0 {
+		v.addf("couchbase.query.max_rows must be positive, got %d", cfg.Couchbase.Query.MaxRows)
+	}
+	v.positive("couchbase.query.timeout", cfg.Couchbase.Query.Timeout)
+	v.positive("couchbase.durability_timeout", cfg.Couchbase.DurabilityTimeout)
+	if cfg.Couchbase.ViewMaxRows <= 0 {
+		v.addf("couchbase.view_max_rows must be positive, got %d", cfg.Couchbase.ViewMaxRows)
+	}
+	v.nonEmpty("couchbase.fts_index", cfg.Couchbase.FTSIndex)
+	v.positive("couchbase.transaction_recovery", cfg.Couchbase.TransactionRecovery)
+	if fc := cfg.Couchbase.Feed; fc.Enabled {
+		v.nonEmpty("couchbase.feed.checkpoint_key", fc.CheckpointKey)
+		if fc.BatchSize <= 0 {
+			v.addf("couchbase.feed.batch_size must be positive, got %d", fc.BatchSize)
+		}
+		v.positive("couchbase.feed.flush_interval", fc.FlushInterval)
+		v.positive("couchbase.feed.retry_interval", fc.RetryInterval)
+	}
+	if bc := cfg.Couchbase.Blob; bc.ChunkSize <= 0 || bc.ChunkSize > 20<<20 {
+		v.addf("couchbase.blob.chunk_size must be between 1 and %d, got %d", 20<<20, bc.ChunkSize)
+	}
+	if cfg.Couchbase.Blob.MaxSize <= 0 {
+		v.addf("couchbase.blob.max_size must be positive, got %d", cfg.Couchbase.Blob.MaxSize)
+	}
+	if cfg.Couchbase.Password != "" && cfg.Couchbase.Username == "" {
+		v.addf("couchbase.password requires couchbase.username")
+	}
+	if cfg.Couchbase.BucketPassword != "" && cfg.Couchbase.BucketUsername == "" {
+		v.addf("couchbase.bucket_password requires couchbase.bucket_username")
+	}
+
+	if cfg.Documents.DeleteByQueryMaxDocs <= 0 {
+		v.addf("documents.delete_by_query_max_docs must be positive, got %d", cfg.Documents.DeleteByQueryMaxDocs)
+	}
+	if cfg.Documents.BulkBatchSize <= 0 {
+		v.addf("documents.bulk_batch_size must be positive, got %d", cfg.Documents.BulkBatchSize)
+	}
+	if cfg.Documents.BulkWorkers <= 0 {
+		v.addf("documents.bulk_workers must be positive, got %d", cfg.Documents.BulkWorkers)
+	}
+	if cfg.Documents.MaxMetadataKeys < 0 {
+		v.addf("documents.max_metadata_keys must not be negative, got %d", cfg.Documents.MaxMetadataKeys)
+	}
+	if cfg.Documents.MaxTags < 0 {
+		v.addf("documents.max_tags must not be negative, got %d", cfg.Documents.MaxTags)
+	}
+	if cfg.Documents.MaxAttachmentSize <= 0 {
+		v.addf("documents.max_attachment_size must be positive, got %d", cfg.Documents.MaxAttachmentSize)
+	}
+	if cfg.Documents.ImportMaxLineSize <= 0 {
+		v.addf("documents.import_max_line_size must be positive, got %d", cfg.Documents.ImportMaxLineSize)
+	}
+	if cfg.Documents.ImportMaxErrors < 0 {
+		v.addf("documents.import_max_errors must not be negative, got %d", cfg.Documents.ImportMaxErrors)
+	}
+	v.positive("documents.trash_retention", cfg.Documents.TrashRetention)
+	v.positive("documents.trash_purge_interval", cfg.Documents.TrashPurgeInterval)
+	if cfg.Search.DefaultPageSize <= 0 {
+		v.addf("search.default_page_size must be positive, got %d", cfg.Search.DefaultPageSize)
+	}
+	if cfg.Search.MaxPageSize < cfg.Search.DefaultPageSize {
+		v.addf("search.max_page_size (%d) must not be smaller than search.default_page_size (%d)",
+			cfg.Search.MaxPageSize, cfg.Search.DefaultPageSize)
+	}
+	if cfg.Search.SuggestSize <= 0 {
+		v.addf("search.suggest_size must be positive, got %d", cfg.Search.SuggestSize)
+	}
+	if cfg.Search.DidYouMeanBelow < 0 {
+		v.addf("search.did_you_mean_below must not be negative, got %d", cfg.Search.DidYouMeanBelow)
+	}
+	for field, boost := range cfg.Search.FieldBoosts {
+		if field != "title" && field != "content" {
+			v.addf("search.field_boosts.%s: only title and content can be boosted", field)
+		}
+		if boost <= 0 {
+			v.addf("search.field_boosts.%s must be positive, got %g", field, boost)
+		}
+	}
+	v.nonEmpty("search.boosts_redis_key", cfg.Search.BoostsRedisKey)
+	if cfg.Search.MaxFuzziness < 0 || cfg.Search.MaxFuzziness > 2 {
+		v.addf("search.max_fuzziness must be between 0 and 2, got %d", cfg.Search.MaxFuzziness)
+	}
+	if f := cfg.Search.DefaultFuzziness; f != "AUTO" {
+		if n, err := strconv.Atoi(f); err != nil || n < 0 || n > cfg.Search.MaxFuzziness {
+			v.addf("search.default_fuzziness must be AUTO or between 0 and search.max_fuzziness, got %q", f)
+		}
+	}
+	if !ValidMinimumShouldMatch(cfg.Search.MinimumShouldMatch) {
+		v.addf("search.minimum_should_match is not valid minimum_should_match syntax, got %q", cfg.Search.MinimumShouldMatch)
+	}
+	if cfg.Search.Backend != "elasticsearch" && cfg.Search.Backend != "couchbase" {
+		v.addf("search.backend must be elasticsearch or couchbase, got %q", cfg.Search.Backend)
+	}
+	if !ValidDistance(cfg.Search.DefaultRadius) {
+		v.addf("search.default_radius must be a number with a distance unit such as 10km, got %q", cfg.Search.DefaultRadius)
+	}
+	facets := make(map[string]bool)
+	for i, f := range cfg.Search.Facets {
+		name := fmt.Sprintf("search.facets[%d]", i)
+		v.nonEmpty(name+".name", f.Name)
+		v.nonEmpty(name+".field", f.Field)
+		if facets[f.Name] {
+			v.addf("%s.name %q is used twice", name, f.Name)
+		}
+		facets[f.Name] = true
+		v.oneOf(name+".type", f.Type, "terms", "date_histogram")
+		if f.Type == "terms" && f.Size < 0 {
+			v.addf("%s.size must not be negative, got %d", name, f.Size)
+		}
+		if f.Type == "date_histogram" {
+			v.nonEmpty(name+".interval", f.Interval)
+		}
+	}
+
+	v.positive("cache.ttl", cfg.Cache.TTL)
+	if cfg.Cache.LocalSize < 0 {
+		v.addf("cache.local_size must not be negative, got %d", cfg.Cache.LocalSize)
+	}
+	if cfg.Cache.WarmTopQueries < 0 {
+		v.addf("cache.warm_top_queries must not be negative, got %d", cfg.Cache.WarmTopQueries)
+	}
+	for route, p := range cfg.Cache.Routes {
+		v.positive("cache.routes."+route+".ttl", p.TTL)
+	}
+	v.oneOf("log.level", cfg.Log.Level, "debug", "info", "warn", "error")
+	v.oneOf("log.format", cfg.Log.Format, "text", "json")
+	v.positive("secrets.refresh_interval", cfg.Secrets.RefreshInterval)
+	v.positive("features.refresh_interval", cfg.Features.RefreshInterval)
+	v.nonEmpty("alerts.index", cfg.Alerts.Index)
+	if cfg.Alerts.QueueSize <= 0 {
+		v.addf("alerts.queue_size must be positive, got %d", cfg.Alerts.QueueSize)
+	}
+	if cfg.Alerts.Workers <= 0 {
+		v.addf("alerts.workers must be positive, got %d", cfg.Alerts.Workers)
+	}
+	if cfg.Alerts.MaxAttempts <= 0 {
+		v.addf("alerts.max_attempts must be positive, got %d", cfg.Alerts.MaxAttempts)
+	}
+	v.positive("alerts.webhook_timeout", cfg.Alerts.WebhookTimeout)
+	if cfg.Jobs.AsyncThreshold < 0 {
+		v.addf("jobs.async_threshold must not be negative, got %d", cfg.Jobs.AsyncThreshold)
+	}
+	if cfg.Jobs.QueueSize <= 0 {
+		v.addf("jobs.queue_size must be positive, got %d", cfg.Jobs.QueueSize)
+	}
+	v.positive("jobs.retention", cfg.Jobs.Retention)
+	v.nonEmpty("queue.name", cfg.Queue.Name)
+	if cfg.Queue.Workers <= 0 {
+		v.addf("queue.workers must be positive, got %d", cfg.Queue.Workers)
+	}
+	if cfg.Queue.MaxAttempts <= 0 {
+		v.addf("queue.max_attempts must be positive, got %d", cfg.Queue.MaxAttempts)
+	}
+	v.positive("queue.backoff", cfg.Queue.Backoff)
+	if cfg.Queue.MaxBackoff < cfg.Queue.Backoff {
+		v.addf("queue.max_backoff must be at least queue.backoff, got %v", cfg.Queue.MaxBackoff)
+	}
+	v.positive("queue.lease", cfg.Queue.Lease)
+	v.positive("queue.poll_interval", cfg.Queue.PollInterval)
+	if cfg.Queue.DeadLetters <= 0 {
+		v.addf("queue.dead_letters must be positive, got %d", cfg.Queue.DeadLetters)
+	}
+	if sc := cfg.Stream; sc.Enabled {
+		v.nonEmpty("stream.key", sc.Key)
+		v.nonEmpty("stream.group", sc.Group)
+		if sc.BatchSize <= 0 {
+			v.addf("stream.batch_size must be positive, got %d", sc.BatchSize)
+		}
+		v.positive("stream.block", sc.Block)
+		// The client gives up on a reply after its read timeout, which a
+		// blocking read must stay under.
+		if rt := cfg.Redis.ReadTimeout; rt > 0 && sc.Block >= rt {
+			v.addf("stream.block must be shorter than redis.read_timeout, got %v >= %v", sc.Block, rt)
+		}
+		v.positive("stream.claim_idle", sc.ClaimIdle)
+		if sc.MaxDeliveries <= 0 {
+			v.addf("stream.max_deliveries must be positive, got %d", sc.MaxDeliveries)
+		}
+		if sc.MaxLen <= 0 {
+			v.addf("stream.max_len must be positive, got %d", sc.MaxLen)
+		}
+	}
+	if cfg.Mirror.Enabled {
+		v.nonEmpty("mirror.prefix", cfg.Mirror.Prefix)
+		v.positive("mirror.retry_interval", cfg.Mirror.RetryInterval)
+	}
+	v.positive("sessions.ttl", cfg.Sessions.TTL)
+	v.nonEmpty("sessions.cookie_name", cfg.Sessions.CookieName)
+	for i, key := range cfg.Sessions.APIKeys {
+		if strings.TrimSpace(key) == "" {
+			v.addf("sessions.api_keys[%d] must not be empty", i)
+		}
+	}
+	for i, key := range cfg.Sessions.AdminAPIKeys {
+		if strings.TrimSpace(key) == "" {
+			v.addf("sessions.admin_api_keys[%d] must not be empty", i)
+		}
+	}
+	if cfg.Sessions.Required && len(cfg.Sessions.APIKeys) == 0 {
+		v.addf("sessions.required needs sessions.api_keys, or no session could be started")
+	}
+	if cfg.KV.MaxValueSize <= 0 {
+		v.addf("kv.max_value_size must be positive, got %d", cfg.KV.MaxValueSize)
+	}
+	if cfg.KV.MaxItems <= 0 {
+		v.addf("kv.max_items must be positive, got %d", cfg.KV.MaxItems)
+	}
+	if cfg.KV.MaxBatch <= 0 {
+		v.addf("kv.max_batch must be positive, got %d", cfg.KV.MaxBatch)
+	}
+	v.positive("analytics.bucket", cfg.Analytics.Bucket)
+	v.nonNegative("analytics.half_life", cfg.Analytics.HalfLife)
+	for name, d := range cfg.Analytics.Windows {
+		if d < cfg.Analytics.Bucket {
+			v.addf("analytics.windows.%s must be at least analytics.bucket, got %v", name, d)
+		}
+	}
+	if _, ok := cfg.Analytics.Windows[cfg.Analytics.DefaultWindow]; !ok {
+		v.addf("analytics.default_window must name one of analytics.windows, got %q", cfg.Analytics.DefaultWindow)
+	}
+	if cfg.Analytics.MaxQueryLength <= 0 {
+		v.addf("analytics.max_query_length must be positive, got %d", cfg.Analytics.MaxQueryLength)
+	}
+	v.nonEmpty("snapshots.repository", cfg.Snapshots.Repository)
+	if cfg.Docs.Enabled && cfg.Docs.SwaggerUIURL != "" {
+		v.httpURL("docs.swagger_ui_url", cfg.Docs.SwaggerUIURL)
+	}
+	if cc := cfg.Compression; cc.Enabled {
+		if cc.Level < 1 || cc.Level > 9 {
+			v.addf("compression.level must be between 1 and 9, got %d", cc.Level)
+		}
+		if cc.MinSize < 0 {
+			v.addf("compression.min_size must not be negative, got %d", cc.MinSize)
+		}
+		for i, t := range cc.ContentTypes {
+			if !strings.Contains(t, "/") {
+				v.addf("compression.content_types[%d] must be a media type such as text/*, got %q", i, t)
+			}
+		}
+	}
+	for i, origin := range cfg.CORS.AllowedOrigins {
+		name := fmt.Sprintf("cors.allowed_origins[%d]", i)
+		if origin == "*" {
+			if cfg.CORS.AllowCredentials {
+				v.addf("%s must not be * with cors.allow_credentials", name)
+			}
+			continue
+		}
+		v.httpURL(name, strings.Replace(origin, "://*.", "://", 1))
+		if u, err := url.Parse(origin); err == nil && (u.Path != "" || u.RawQuery != "") {
+			v.addf("%s must be a scheme and host without a path, got %q", name, origin)
+		}
+	}
+	if len(cfg.CORS.AllowedOrigins) > 0 && len(cfg.CORS.AllowedMethods) == 0 {
+		v.addf("cors.allowed_methods must not be empty when cors.allowed_origins is set")
+	}
+	v.nonNegative("cors.max_age", cfg.CORS.MaxAge)
+	for group, d := range cfg.Timeouts {
+		v.nonNegative("timeouts."+group, d)
+	}
+	for group, rl := range cfg.RateLimits {
+		name := "rate_limits." + group
+		if rl.Global < 0 {
+			v.addf("%s.global must not be negative, got %d", name, rl.Global)
+		}
+		if rl.PerClient < 0 {
+			v.addf("%s.per_client must not be negative, got %d", name, rl.PerClient)
+		}
+		if rl.Global > 0 || rl.PerClient > 0 {
+			v.positive(name+".window", rl.Window)
+		}
+	}
+	v.positive("reload_interval", cfg.ReloadInterval)
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+var minimumShouldMatch = regexp.MustCompile(`^(-?\d+%?|\d+<-?\d+%?( \d+<-?\d+%?)*)$`)
+
+// ValidMinimumShouldMatch reports whether s is an Elasticsearch
+// minimum_should_match value: a count or percentage, possibly negative,
+// or conditional specs like "2<75%".
+func ValidMinimumShouldMatch(s string) bool {
+	return minimumShouldMatch.MatchString(s)
+}
+
+// ParseIPNet parses an IP address or CIDR range, an address standing for
+// the range of just itself.
+func ParseIPNet(s string) (*net.IPNet, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(s)
+	return ipNet, err
+}
+
+var distance = regexp.MustCompile(`^\d+(\.\d+)?(mi|miles|yd|ft|in|km|m|cm|mm|nmi|NM)$`)
+
+// ValidDistance reports whether s is an Elasticsearch distance such as
+// "10km" or "2.5mi".
+func ValidDistance(s string) bool {
+	return distance.MatchString(s)
+}
+
+type validator struct {
+	problems []string
+}
+
+func (v *validator) addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *validator) nonEmpty(name, value string) {
+	if value == "" {
+		v.addf("%s must not be empty", name)
+	}
+}
+
+func (v *validator) oneOf(name, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.addf("%s must be one of %s, got %q", name, strings.Join(allowed, ", "), value)
+}
+
+func (v *validator) positive(name string, d time.Duration) {
+	if d <= 0 {
+		v.addf("%s must be positive, got %s", name, d)
+	}
+}
+
+func (v *validator) nonNegative(name string, d time.Duration) {
+	if d < 0 {
+		v.addf("%s must not be negative, got %s", name, d)
+	}
+}
+
+func (v *validator) tls(name string, tc TLSConfig) {
+	if (tc.CertFile == "") != (tc.KeyFile == "") {
+		v.addf("%s.cert_file and %s.key_file must be set together", name, name)
+	}
+	for _, f := range []struct{ key, path string }{
+		{"ca_file", tc.CAFile},
+		{"cert_file", tc.CertFile},
+		{"key_file", tc.KeyFile},
+	} {
+		if f.path == "" {
+			continue
+		}
+		if _, err := os.Stat(f.path); err != nil {
+			v.addf("%s.%s: %v", name, f.key, err)
+		}
+	}
+}
+
+func (v *validator) port(name, value string) {
+	p, err := strconv.Atoi(value)
+	if err != nil || p < 1 || p > 65535 {
+		v.addf("%s must be a port between 1 and 65535, got %q", name, value)
+	}
+}
+
+func (v *validator) hostPort(name, value string) {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		v.addf("%s must be host:port, got %q: %v", name, value, err)
+		return
+	}
+	if host == "" {
+		v.addf("%s is missing a host, got %q", name, value)
+	}
+	v.port(name+" port", port)
+}
+
+// couchbaseURL accepts an http URL or a couchbase:// or couchbases://
+// connection string.
+func (v *validator) couchbaseURL(name, value string) {
+	u, err := url.Parse(value)
+	if err != nil {
+		v.addf("%s is not a valid URL: %v", name, err)
+		return
+	}
+	switch u.Scheme {
+	case "couchbase", "couchbases":
+		if u.Host == "" {
+			v.addf("%s is missing a host, got %q", name, value)
+		}
+	default:
+		v.httpURL(name, value)
+	}
+}
+
+func (v *validator) httpURL(name, value string) {
+	u, err := url.Parse(value)
+	if err != nil {
+		v.addf("%s is not a valid URL: %v", name, err)
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		v.addf("%s must use http or https, got %q", name, value)
+	}
+	if u.Host == "" {
+		v.addf("%s is missing a host, got %q", name, value)
+	}
+}
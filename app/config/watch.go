@@ -0,0 +1,46 @@
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"time"
+)
+
+// Watch polls the file at path every interval and calls onChange with the
+// configuration reloaded on top of profile whenever the file contents
+// change. Kubernetes updates a mounted ConfigMap by swapping a symlink,
+// which inotify on the file itself does not see, so the contents are
+// compared instead. A file that fails to load is logged and the previous
+// configuration stays in effect until the file changes again. Watch
+// returns when stop is closed.
+func Watch(profile, path string, interval time.Duration, stop <-chan struct{}, onChange func(*Config)) {
+	last, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Printf("config: cannot read %s: %v", path, err)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Printf("config: cannot read %s: %v", path, err)
+			continue
+		}
+		if bytes.Equal(data, last) {
+			continue
+		}
+		last = data
+		cfg, err := Load(profile, path)
+		if err != nil {
+			log.Printf("config: ignoring invalid %s: %v", path, err)
+			continue
+		}
+		onChange(cfg)
+	}
+}
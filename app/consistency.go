@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/couchbase/go-couchbase"
+)
+
+// Writes return a mutation token naming the vBucket and sequence number
+// of the change. Passing the tokens of earlier writes to a N1QL query with
+// consistency=at_plus makes its indexes catch up with those writes first;
+// consistency=request_plus waits for all writes made before the query.
+
+// N1QL scan consistencies accepted by the query endpoint.
+const (
+	consistencyNotBounded  = "not_bounded"
+	consistencyRequestPlus = "request_plus"
+	consistencyAtPlus      = "at_plus"
+)
+
+// mutationToken reads the token from the extras of a mutation response,
+// nil when the server sent none.
+func mutationToken(bucket *couchbase.Bucket, key string, extras []byte) *couchbase.MutationToken {
+	if len(extras) < 16 {
+		return nil
+	}
+	return &couchbase.MutationToken{
+		VBid:  uint16(bucket.VBHash(key)),
+		Guard: binary.BigEndian.Uint64(extras[0:8]),
+		Value: binary.BigEndian.Uint64(extras[8:16]),
+	}
+}
+
+// formatMutationToken renders a token as "bucket:vbucket:vbuuid:seqno",
+// empty for nil.
+func formatMutationToken(bucket string, mt *couchbase.MutationToken) string {
+	if mt == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d:%d:%d", bucket, mt.VBid, mt.Guard, mt.Value)
+}
+
+func parseMutationToken(s string) (string, couchbase.MutationToken, error) {
+	var mt couchbase.MutationToken
+	parts := strings.Split(s, ":")
+	if len(parts) != 4 || parts[0] == "" {
+		return "", mt, fmt.Errorf("invalid mutation token %q", s)
+	}
+	vb, err := strconv.ParseUint(parts[1], 10, 16)
+	if err != nil {
+		return "", mt, fmt.Errorf("invalid mutation token %q", s)
+	}
+	guard, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", mt, fmt.Errorf("invalid mutation token %q", s)
+	}
+	seqno, err := strconv.ParseUint(parts[3], 10, 64)
+	if err != nil {
+		return "", mt, fmt.Errorf("invalid mutation token %q", s)
+	}
+	mt.VBid, mt.Guard, mt.Value = uint16(vb), guard, seqno
+	return parts[0], mt, nil
+}
+
+// scanVectors turns mutation tokens into the scan_vectors of an at_plus
+// query: per bucket and vBucket the highest sequence number with its
+// vbuuid.
+func scanVectors(tokens []string) (map[string]map[string][]interface{}, error) {
+	vectors := make(map[string]map[string][]interface{})
+	for _, s := range tokens {
+		bucket, mt, err := parseMutationToken(s)
+		if err != nil {
+			return nil, err
+		}
+		vbs, ok := vectors[bucket]
+		if !ok {
+			vbs = make(map[string][]interface{})
+			vectors[bucket] = vbs
+		}
+		vb := strconv.Itoa(int(mt.VBid))
+		if prev, ok := vbs[vb]; ok && prev[0].(uint64) >= mt.Value {
+			continue
+		}
+		vbs[vb] = []interface{}{mt.Value, strconv.FormatUint(mt.Guard, 10)}
+	}
+	return vectors, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// cors answers cross-origin requests from the origins in cors, so browser
+// frontends served elsewhere can call the API. Preflight requests never
+// reach a route: they are answered here, with 403 for origins that are not
+// allowed. Other requests from such origins are served without CORS
+// headers, leaving it to the browser to withhold the response.
+func cors(c *gin.Context) {
+	origin := c.GetHeader("Origin")
+	if origin == "" {
+		c.Next()
+		return
+	}
+	cfg := currentConfig().CORS
+	c.Writer.Header().Add("Vary", "Origin")
+	preflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+	allowed, wildcard := allowedOrigin(cfg, origin)
+	if !allowed {
+		if preflight {
+			errorResponse(c, http.StatusForbidden, "Origin not allowed")
+			c.Abort()
+			return
+		}
+		c.Next()
+		return
+	}
+	h := c.Writer.Header()
+	if wildcard && !cfg.AllowCredentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+	if cfg.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !preflight {
+		if len(cfg.ExposedHeaders) > 0 {
+			h.Set("Access-Control-Expose-Headers", strings.Join(cfg.ExposedHeaders, ", "))
+		}
+		c.Next()
+		return
+	}
+	h.Add("Vary", "Access-Control-Request-Method")
+	h.Add("Vary", "Access-Control-Request-Headers")
+	h.Set("Access-Control-Allow-Methods", strings.Join(cfg.AllowedMethods, ", "))
+	if len(cfg.AllowedHeaders) > 0 {
+		h.Set("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+	}
+	if cfg.MaxAge > 0 {
+		h.Set("Access-Control-Max-Age", strconv.Itoa(int(cfg.MaxAge.Seconds())))
+	}
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// allowedOrigin reports whether origin is allowed and whether it is so by
+// the wildcard *.
+func allowedOrigin(cfg config.CORSConfig, origin string) (allowed, wildcard bool) {
+	origin = strings.ToLower(origin)
+	for _, o := range cfg.AllowedOrigins {
+		o = strings.ToLower(o)
+		switch {
+		case o == "*":
+			wildcard = true
+		case o == origin:
+			return true, false
+		case strings.Contains(o, "://*."):
+			i := strings.Index(o, "*")
+			if strings.HasPrefix(origin, o[:i]) && strings.HasSuffix(origin, o[i+1:]) && len(origin) > len(o)-1 {
+				return true, false
+			}
+		}
+	}
+	return wildcard, wildcard
+}
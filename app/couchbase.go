@@ -0,0 +1,594 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/couchbase/go-couchbase"
+	"github.com/couchbase/gomemcached"
+	memcached "github.com/couchbase/gomemcached/client"
+	"github.com/gin-gonic/gin"
+)
+
+// couchbaseHolder owns the shared Couchbase connection, opened once instead
+// of per request. Buckets follow cluster topology changes by themselves,
+// so the connection is only reopened when the configuration changes.
+//
+// The supported SDK is gocb v2, but it is not vendored and the move to it
+// is still outstanding; until then this uses go-couchbase, and the holder
+// is the one place the client library is chosen.
+type couchbaseHolder struct {
+	current atomic.Value // *couchbaseConn
+	mu      sync.Mutex
+}
+
+// couchbaseConn is a connection to the cluster and the buckets opened
+// through it. The configured bucket is opened with the connection, the
+// others on first use. Secure connections carry their TLS configuration,
+// the HTTP client using it and the gateway go-couchbase goes through.
+//
+// A replaced connection is retired: its buckets are closed once the last
+// user acquired before the swap releases it.
+type couchbaseConn struct {
+	cc       config.CouchbaseConfig
+	url      string
+	pool     *couchbase.Pool
+	services couchbase.PoolServices
+	tls      *tls.Config
+	http     *http.Client
+	gateway  *couchGateway
+	retired  chan struct{}
+
+	mu      sync.Mutex
+	buckets map[string]*couchbase.Bucket
+	users   int
+}
+
+var cbHolder couchbaseHolder
+
+var errCouchbaseUnavailable = errors.New("no couchbase connection")
+
+// couchbaseBucket returns the bucket selected for the request by
+// requireCouchbase.
+func couchbaseBucket(c *gin.Context) *couchbase.Bucket {
+	return c.MustGet(couchBucketKey).(*couchbase.Bucket)
+}
+
+func (h *couchbaseHolder) conn() *couchbaseConn {
+	conn, _ := h.current.Load().(*couchbaseConn)
+	return conn
+}
+
+// Connected reports whether a connection has been opened.
+func (h *couchbaseHolder) Connected() bool {
+	return h.conn() != nil
+}
+
+// acquire returns the current connection, which stays open until it is
+// released even if it is replaced meanwhile.
+func (h *couchbaseHolder) acquire() (*couchbaseConn, error) {
+	for {
+		conn := h.conn()
+		if conn == nil {
+			return nil, errCouchbaseUnavailable
+		}
+		if conn.acquire() {
+			return conn, nil
+		}
+		// Retired connections are swapped out first, so the next load
+		// returns their replacement.
+	}
+}
+
+func (conn *couchbaseConn) acquire() bool {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	select {
+	case <-conn.retired:
+		return false
+	default:
+	}
+	conn.users++
+	return true
+}
+
+func (conn *couchbaseConn) release() {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	conn.users--
+	select {
+	case <-conn.retired:
+		if conn.users == 0 {
+			conn.closeLocked()
+		}
+	default:
+	}
+}
+
+// retire closes the connection once it has no users left.
+func (conn *couchbaseConn) retire() {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	close(conn.retired)
+	if conn.users == 0 {
+		conn.closeLocked()
+	}
+}
+
+func (conn *couchbaseConn) bucket(name string) (*couchbase.Bucket, error) {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	if bucket, ok := conn.buckets[name]; ok {
+		return bucket, nil
+	}
+	var bucket *couchbase.Bucket
+	var err error
+	if name == conn.cc.Bucket && conn.cc.BucketUsername != "" {
+		bucket, err = conn.pool.GetBucketWithAuth(name, conn.cc.BucketUsername, conn.cc.BucketPassword)
+	} else {
+		bucket, err = conn.pool.GetBucket(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	conn.buckets[name] = bucket
+	return bucket, nil
+}
+
+func (conn *couchbaseConn) closeLocked() {
+	for _, bucket := range conn.buckets {
+		bucket.Close()
+	}
+	if conn.gateway != nil {
+		conn.gateway.close()
+	}
+}
+
+// Dial connects with cc, opens its bucket and swaps the connection in. On
+// failure the previous connection is kept.
+func (h *couchbaseHolder) Dial(cc config.CouchbaseConfig) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	conn, err := dialCouchbase(cc)
+	if err != nil {
+		return err
+	}
+	prev := h.conn()
+	h.current.Store(conn)
+	if prev != nil {
+		prev.retire()
+	}
+	return nil
+}
+
+func dialCouchbase(cc config.CouchbaseConfig) (conn *couchbaseConn, err error) {
+	mgmtURL, secure, err := couchbaseManagementURL(cc)
+	if err != nil {
+		return nil, err
+	}
+	conn = &couchbaseConn{
+		cc:      cc,
+		url:     mgmtURL,
+		retired: make(chan struct{}),
+		buckets: make(map[string]*couchbase.Bucket),
+	}
+	defer func() {
+		if err != nil {
+			conn.closeLocked()
+		}
+	}()
+	// go-couchbase reaches secure clusters through the gateway, in plain
+	// HTTP and memcached.
+	clientURL := mgmtURL
+	if secure {
+		if conn.tls, conn.http, err = secureTransport(cc); err != nil {
+			return nil, err
+		}
+		if conn.gateway, err = newCouchGateway(conn); err != nil {
+			return nil, err
+		}
+		clientURL = conn.gateway.URL()
+	}
+	client, err := connectCouchbase(cc, clientURL)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := client.GetPool(cc.Pool)
+	if err != nil {
+		return nil, err
+	}
+	services, err := client.GetPoolServices(cc.Pool)
+	if err != nil {
+		return nil, err
+	}
+	conn.pool, conn.services = &pool, services
+	if _, err := conn.bucket(cc.Bucket); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// ServiceURL returns the base URL of a node running service, such as
+// "n1ql" or "fts", picked at random to spread the load.
+func (h *couchbaseHolder) ServiceURL(service string) (string, error) {
+	conn := h.conn()
+	if conn == nil {
+		return "", errCouchbaseUnavailable
+	}
+	scheme := "http"
+	if conn.tls != nil {
+		scheme, service = "https", service+"SSL"
+	}
+	var urls []string
+	for _, node := range conn.services.NodesExt {
+		port, ok := node.Services[service]
+		if !ok {
+			continue
+		}
+		host := node.Hostname
+		if host == "" {
+			// Single node clusters leave the host name out.
+			u, err := url.Parse(conn.url)
+			if err != nil {
+				return "", err
+			}
+			host = u.Hostname()
+		}
+		urls = append(urls, scheme+"://"+net.JoinHostPort(host, strconv.Itoa(port)))
+	}
+	if len(urls) == 0 {
+		return "", fmt.Errorf("no couchbase node runs the %s service", service)
+	}
+	return urls[rand.Intn(len(urls))], nil
+}
+
+// couchbaseCredentials returns the user authenticating requests to the
+// cluster services: the bucket's own when it has them.
+func couchbaseCredentials(cc config.CouchbaseConfig) (username, password string) {
+	if cc.BucketUsername != "" {
+		return cc.BucketUsername, cc.BucketPassword
+	}
+	return cc.Username, cc.Password
+}
+
+// Run connects, retrying every retry interval until it succeeds or stop
+// is closed. A config reload may connect in the meantime.
+func (h *couchbaseHolder) Run(stop <-chan struct{}, current func() config.CouchbaseConfig) {
+	for !h.Connected() {
+		cc := current()
+		err := h.Dial(cc)
+		if err == nil {
+			log.Println("connected to couchbase")
+			return
+		}
+		log.Printf("cannot connect to couchbase, retrying in %s: %v", cc.RetryInterval, err)
+		if sleepOrStop(stop, cc.RetryInterval) {
+			return
+		}
+	}
+}
+
+func connectCouchbase(cc config.CouchbaseConfig, mgmtURL string) (couchbase.Client, error) {
+	if cc.Username != "" {
+		return couchbase.ConnectWithAuthCreds(mgmtURL, cc.Username, cc.Password)
+	}
+	if couchbaseCertAuth(cc) {
+		return couchbase.ConnectWithAuth(mgmtURL, couchCertAuth{})
+	}
+	return couchbase.Connect(mgmtURL)
+}
+
+// couchKeyspace is the bucket, scope and collection a request works on.
+type couchKeyspace struct {
+	Bucket, Scope, Collection string
+}
+
+const (
+	couchBucketKey   = "couchbaseBucket"
+	couchKeyspaceKey = "couchbaseKeyspace"
+	couchDefault     = "_default"
+)
+
+func (k couchKeyspace) String() string {
+	return k.Bucket + "." + k.Scope + "." + k.Collection
+}
+
+func (k couchKeyspace) defaultCollection() bool {
+	return k.Scope == couchDefault && k.Collection == couchDefault
+}
+
+// requireCouchbase selects the keyspace of the request from the
+// X-Couchbase-Bucket, X-Couchbase-Scope and X-Couchbase-Collection headers,
+// defaulting to the default collection of the configured bucket. It answers
+// 403 for keyspaces not in couchbase.keyspaces and 503 while the bucket
+// cannot be opened.
+func requireCouchbase(c *gin.Context) {
+	cc := currentConfig().Couchbase
+	ks := couchKeyspace{
+		Bucket:     headerOr(c, "X-Couchbase-Bucket", cc.Bucket),
+		Scope:      headerOr(c, "X-Couchbase-Scope", couchDefault),
+		Collection: headerOr(c, "X-Couchbase-Collection", couchDefault),
+	}
+	if !couchKeyspaceAllowed(cc, ks) {
+		errorResponse(c, http.StatusForbidden, "Keyspace not allowed: "+ks.String())
+		c.Abort()
+		return
+	}
+	conn, err := cbHolder.acquire()
+	if err != nil {
+		errorCodeResponse(c, http.StatusServiceUnavailable, codeCouchbaseUnavailable, "Couchbase unavailable")
+		c.Abort()
+		return
+	}
+	defer conn.release()
+	bucket, err := conn.bucket(ks.Bucket)
+	if err != nil {
+		log.Printf("cannot open couchbase bucket %s: %v", ks.Bucket, err)
+		errorCodeResponse(c, http.StatusServiceUnavailable, codeCouchbaseUnavailable, "Couchbase unavailable")
+		c.Abort()
+		return
+	}
+	c.Set(couchKeyspaceKey, ks)
+	c.Set(couchBucketKey, bucket)
+	c.Next()
+}
+
+// requireDefaultCollection answers 501 for keyspaces other than a bucket's
+// default collection: go-couchbase reaches keys through buckets only, so
+// just N1QL statements can use other collections.
+func requireDefaultCollection(c *gin.Context) {
+	if !c.MustGet(couchKeyspaceKey).(couchKeyspace).defaultCollection() {
+		errorResponse(c, http.StatusNotImplemented, "Key access is only supported in default collections")
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// couchKeyspaceAllowed reports whether ks is the default collection of the
+// configured bucket or listed in keyspaces, where a bare bucket name
+// stands for its default collection.
+func couchKeyspaceAllowed(cc config.CouchbaseConfig, ks couchKeyspace) bool {
+	if ks.Bucket == cc.Bucket && ks.defaultCollection() {
+		return true
+	}
+	for _, allowed := range cc.Keyspaces {
+		if allowed == ks.String() || (allowed == ks.Bucket && ks.defaultCollection()) {
+			return true
+		}
+	}
+	return false
+}
+
+func headerOr(c *gin.Context, name, def string) string {
+	if v := c.GetHeader(name); v != "" {
+		return v
+	}
+	return def
+}
+
+// couchGet returns the value of a key, with its CAS as ETag for
+// conditional writes.
+func couchGet(c *gin.Context) {
+	query := c.Query("query")
+	if query == "" {
+		errorResponse(c, http.StatusBadRequest, "Query not specified")
+		return
+	}
+	var values interface{}
+	var cas uint64
+	err := couchbaseBucket(c).Gets(query, &values, &cas)
+	if couchbase.IsKeyNoEntError(err) {
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get from couchbase")
+		return
+	}
+	c.Header("ETag", formatCAS(cas))
+	c.JSON(http.StatusOK, values)
+}
+
+// couchInsertRequest is the body of couchInsert. Couchbase keys are at
+// most 250 bytes.
+type couchInsertRequest struct {
+	Key    string   `json:"key" validate:"required,maxbytes=250,utf8"`
+	Values []string `json:"values" validate:"dive,utf8"`
+	TTL    string   `json:"ttl"`
+}
+
+// couchInsert stores the values under the key, expiring them after TTL
+// when one is given, e.g. {"key": "k", "values": ["a"], "ttl": "10m"}.
+// With If-Match set to the ETag of a read, the write only succeeds if the
+// key was not changed since, answering 409 otherwise. The new CAS is
+// returned as ETag, the mutation token in the body. With
+// durability=majority or durability=persist the response waits until the
+// write is replicated or persisted.
+func couchInsert(c *gin.Context) {
+	var postParams couchInsertRequest
+	if !bindJSON(c, &postParams) {
+		return
+	}
+	if details := validateRequest(postParams); len(details) > 0 {
+		validationFailed(c, details...)
+		return
+	}
+	ttl, ok := parseKVTTL(c, postParams.TTL)
+	if !ok {
+		return
+	}
+	cas, ok := ifMatchCAS(c)
+	if !ok {
+		return
+	}
+	durability, ok := parseDurability(c)
+	if !ok {
+		return
+	}
+	bucket := couchbaseBucket(c)
+	cas, mt, err := couchWrite(bucket, postParams.Key, couchExpiry(ttl), cas, postParams.Values)
+	if err != nil {
+		status, msg := couchWriteError(err)
+		errorResponse(c, status, msg)
+		return
+	}
+	c.Header("ETag", formatCAS(cas))
+	if err := awaitDurability(bucket, currentConfig().Couchbase, postParams.Key, cas, durability); err != nil {
+		status, msg := durabilityError(err)
+		errorResponse(c, status, msg)
+		return
+	}
+	resp := gin.H{"key": postParams.Key}
+	if token := formatMutationToken(bucket.Name, mt); token != "" {
+		resp["mutation_token"] = token
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// couchDeleteEndpoint removes the key, answering 404 when it does not
+// exist. With If-Match set to the ETag of a read, the key is only removed
+// if it was not changed since, answering 409 otherwise. The mutation token
+// of the removal is returned. With couchbase.audit_deletes the key is read
+// first and removed only if unchanged, so the value recorded in the audit
+// log is the one removed.
+func couchDeleteEndpoint(c *gin.Context) {
+	key := c.Param("key")
+	cas, ok := ifMatchCAS(c)
+	if !ok {
+		return
+	}
+	cc := currentConfig().Couchbase
+	bucket := couchbaseBucket(c)
+	var value []byte
+	if cc.AuditDeletes {
+		var current uint64
+		var err error
+		value, _, current, err = bucket.GetsRaw(key)
+		if couchbase.IsKeyNoEntError(err) {
+			errorResponse(c, http.StatusNotFound, "Key not found")
+			return
+		}
+		if err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusInternalServerError, "Failed to delete from couchbase")
+			return
+		}
+		if cas != 0 && cas != current {
+			errorResponse(c, http.StatusConflict, "Key was modified concurrently")
+			return
+		}
+		cas = current
+	}
+	res, err := txnSend(bucket, gomemcached.DELETE, key, 0, 0, cas, nil)
+	switch {
+	case couchbase.IsKeyNoEntError(err):
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	case couchbase.IsKeyEExistsError(err):
+		errorResponse(c, http.StatusConflict, "Key was modified concurrently")
+		return
+	case err != nil:
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to delete from couchbase")
+		return
+	}
+	if cc.AuditDeletes {
+		audit(c, "couchbase.delete", bucket.Name+"/"+key, gin.H{"cas": cas, "value": couchValue(value)})
+	}
+	resp := gin.H{"key": key}
+	if token := formatMutationToken(bucket.Name, mutationToken(bucket, key, res.Extras)); token != "" {
+		resp["mutation_token"] = token
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// couchMaxRelativeExpiry is the longest expiry Couchbase takes as a
+// duration; longer ones must be given as a Unix time.
+const couchMaxRelativeExpiry = 30 * 24 * time.Hour
+
+// couchExpiry converts a TTL to a Couchbase expiry, zero meaning none.
+func couchExpiry(ttl time.Duration) int {
+	if ttl <= 0 {
+		return 0
+	}
+	if ttl > couchMaxRelativeExpiry {
+		return int(time.Now().Add(ttl).Unix())
+	}
+	// Round up so sub-second TTLs do not become "no expiry".
+	return int((ttl + time.Second - 1) / time.Second)
+}
+
+// couchWrite stores v as JSON under key, only if the key still has the
+// given CAS unless it is zero, and returns the new CAS and the mutation
+// token of the write.
+func couchWrite(bucket *couchbase.Bucket, key string, exp int, cas uint64, v interface{}) (uint64, *couchbase.MutationToken, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return 0, nil, err
+	}
+	var res *gomemcached.MCResponse
+	err = bucket.Do(key, func(mc *memcached.Client, vb uint16) error {
+		var err error
+		res, err = mc.SetCas(vb, key, 0, exp, cas, data)
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return res.Cas, mutationToken(bucket, key, res.Extras), nil
+}
+
+// couchWriteError maps a failed write to a status and message, logging
+// unexpected errors.
+func couchWriteError(err error) (int, string) {
+	switch {
+	case couchbase.IsKeyEExistsError(err):
+		return http.StatusConflict, "Key was modified concurrently"
+	case couchbase.IsKeyNoEntError(err):
+		return http.StatusNotFound, "Key not found"
+	}
+	log.Println(err)
+	return http.StatusInternalServerError, "Failed to insert into couchbase"
+}
+
+// ifMatchCAS reads the CAS a write is conditional on from If-Match, zero
+// without one, responding with 400 and returning false when it is invalid.
+func ifMatchCAS(c *gin.Context) (uint64, bool) {
+	v := c.GetHeader("If-Match")
+	if v == "" {
+		return 0, true
+	}
+	cas, err := parseCAS(v)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid If-Match "+v)
+		return 0, false
+	}
+	return cas, true
+}
+
+// formatCAS and parseCAS convert between a CAS and its ETag.
+func formatCAS(cas uint64) string {
+	return strconv.Quote(strconv.FormatUint(cas, 10))
+}
+
+func parseCAS(etag string) (uint64, error) {
+	s, err := strconv.Unquote(etag)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
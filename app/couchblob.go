@@ -0,0 +1,222 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/couchbase/go-couchbase"
+	"github.com/couchbase/gomemcached"
+	"github.com/gin-gonic/gin"
+	"github.com/teris-io/shortid"
+)
+
+// Couchbase items are limited to 20MB, so blobs are stored as chunks of
+// couchbase.blob.chunk_size bytes under keys of their own, described by a
+// manifest under the blob's key. Every upload writes its chunks under a
+// new generation before swapping the manifest, so readers never mix the
+// chunks of two uploads; the chunks of the replaced upload are deleted
+// after the swap, cutting short downloads still reading them. A blob is
+// replaced by uploading it again and goes away with its ttl.
+
+// blobManifest describes a stored blob.
+type blobManifest struct {
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	Chunks      int       `json:"chunks"`
+	Generation  string    `json:"generation"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// blobManifestKey and blobChunkKey return the keys a blob is stored under.
+func blobManifestKey(key string) string {
+	return currentConfig().Couchbase.Blob.Prefix + key
+}
+
+func blobChunkKey(key, generation string, i int) string {
+	return blobManifestKey(key) + "::" + generation + ":" + strconv.Itoa(i)
+}
+
+// couchPutBlobEndpoint stores the request body under the key, keeping its
+// Content-Type, e.g. PUT /couchbase/blob/logo.png?ttl=24h. The body is read
+// a chunk at a time and may be at most couchbase.blob.max_size bytes. With
+// If-Match set to the ETag of a download, the upload only replaces the blob
+// if it was not changed since, answering 409 otherwise. The new ETag is
+// returned with the manifest.
+func couchPutBlobEndpoint(c *gin.Context) {
+	bc := currentConfig().Couchbase.Blob
+	key := c.Param("key")
+	ttl, ok := parseKVTTL(c, c.Query("ttl"))
+	if !ok {
+		return
+	}
+	ifMatch, ok := ifMatchCAS(c)
+	if !ok {
+		return
+	}
+	if c.Request.ContentLength > bc.MaxSize {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Blob too large")
+		return
+	}
+	contentType := c.GetHeader("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	bucket := couchbaseBucket(c)
+	exp := couchExpiry(ttl)
+	manifest := blobManifest{
+		ContentType: contentType,
+		Generation:  shortid.MustGenerate(),
+		CreatedAt:   time.Now().UTC(),
+	}
+	hash := sha256.New()
+	body := io.LimitReader(c.Request.Body, bc.MaxSize+1)
+	buf := make([]byte, bc.ChunkSize)
+	for {
+		n, err := io.ReadFull(body, buf)
+		if n > 0 {
+			manifest.Size += int64(n)
+			if manifest.Size > bc.MaxSize {
+				deleteBlobChunks(bucket, key, manifest)
+				errorResponse(c, http.StatusRequestEntityTooLarge, "Blob too large")
+				return
+			}
+			hash.Write(buf[:n])
+			if err := bucket.SetRaw(blobChunkKey(key, manifest.Generation, manifest.Chunks), exp, buf[:n]); err != nil {
+				log.Println(err)
+				deleteBlobChunks(bucket, key, manifest)
+				errorResponse(c, http.StatusInternalServerError, "Failed to store blob")
+				return
+			}
+			manifest.Chunks++
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			log.Println(err)
+			deleteBlobChunks(bucket, key, manifest)
+			errorResponse(c, http.StatusBadRequest, "Malformed upload")
+			return
+		}
+	}
+	manifest.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	cas, prev, err := swapBlobManifest(bucket, key, exp, ifMatch, manifest)
+	if err != nil {
+		deleteBlobChunks(bucket, key, manifest)
+		if err == errBlobChanged {
+			errorResponse(c, http.StatusConflict, "Blob was modified concurrently")
+			return
+		}
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to store blob")
+		return
+	}
+	if prev != nil {
+		deleteBlobChunks(bucket, key, *prev)
+	}
+	c.Header("ETag", formatCAS(cas))
+	c.JSON(http.StatusOK, manifest)
+}
+
+var errBlobChanged = errors.New("blob changed")
+
+// swapBlobManifest stores manifest under the key's manifest key, only if
+// it still has the CAS ifMatch unless it is zero, and returns the new CAS
+// and the manifest it replaced, if any. Without ifMatch a manifest changed
+// concurrently is read again, so the replaced chunks are always known.
+func swapBlobManifest(bucket *couchbase.Bucket, key string, exp int, ifMatch uint64, manifest blobManifest) (uint64, *blobManifest, error) {
+	mkey := blobManifestKey(key)
+	for attempt := 0; ; attempt++ {
+		var prev blobManifest
+		var cas uint64
+		err := bucket.Gets(mkey, &prev, &cas)
+		if err != nil && !couchbase.IsKeyNoEntError(err) {
+			return 0, nil, err
+		}
+		exists := err == nil
+		if ifMatch != 0 && (!exists || cas != ifMatch) {
+			return 0, nil, errBlobChanged
+		}
+		if exists {
+			newCAS, _, err := couchWrite(bucket, mkey, exp, cas, manifest)
+			if err == nil {
+				return newCAS, &prev, nil
+			}
+			if !couchbase.IsKeyEExistsError(err) {
+				return 0, nil, err
+			}
+		} else {
+			data, err := json.Marshal(manifest)
+			if err != nil {
+				return 0, nil, err
+			}
+			res, err := txnSend(bucket, gomemcached.ADD, mkey, 0, exp, 0, data)
+			if err == nil {
+				return res.Cas, nil, nil
+			}
+			if !couchbase.IsKeyEExistsError(err) {
+				return 0, nil, err
+			}
+		}
+		if ifMatch != 0 || attempt == 2 {
+			return 0, nil, errBlobChanged
+		}
+	}
+}
+
+// deleteBlobChunks removes the chunks of manifest, logging failures; they
+// expire with the blob, if ever.
+func deleteBlobChunks(bucket *couchbase.Bucket, key string, manifest blobManifest) {
+	for i := 0; i < manifest.Chunks; i++ {
+		k := blobChunkKey(key, manifest.Generation, i)
+		if err := bucket.Delete(k); err != nil && !couchbase.IsKeyNoEntError(err) {
+			log.Printf("cannot delete blob chunk %s: %v", k, err)
+		}
+	}
+}
+
+// couchGetBlobEndpoint streams the blob stored under the key with its
+// Content-Type, reading a chunk at a time. The ETag is the manifest's CAS
+// and X-Content-SHA256 the hex digest of the content. Once the first chunk
+// is sent the status cannot change, so a chunk missing midway, e.g.
+// because the blob was replaced meanwhile, cuts the response short.
+func couchGetBlobEndpoint(c *gin.Context) {
+	key := c.Param("key")
+	bucket := couchbaseBucket(c)
+	var manifest blobManifest
+	var cas uint64
+	err := bucket.Gets(blobManifestKey(key), &manifest, &cas)
+	if couchbase.IsKeyNoEntError(err) {
+		errorResponse(c, http.StatusNotFound, "Blob not found")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get from couchbase")
+		return
+	}
+	c.Header("Content-Type", manifest.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(manifest.Size, 10))
+	c.Header("ETag", formatCAS(cas))
+	c.Header("X-Content-SHA256", manifest.SHA256)
+	c.Status(http.StatusOK)
+	for i := 0; i < manifest.Chunks; i++ {
+		data, err := bucket.GetRaw(blobChunkKey(key, manifest.Generation, i))
+		if err != nil {
+			log.Printf("cannot read chunk %d of blob %s: %v", i, key, err)
+			return
+		}
+		if _, err := c.Writer.Write(data); err != nil {
+			log.Println(err)
+			return
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/couchbase/go-couchbase"
+	"github.com/gin-gonic/gin"
+)
+
+// couchResult is the outcome of one key of a batch request. Status is the
+// HTTP status the key would have had on its own; CAS is the value read or
+// written.
+type couchResult struct {
+	Key    string          `json:"key"`
+	Status int             `json:"status"`
+	CAS    uint64          `json:"cas,omitempty"`
+	Value  json.RawMessage `json:"value,omitempty"`
+	Error  string          `json:"error,omitempty"`
+
+	MutationToken string `json:"mutation_token,omitempty"`
+}
+
+// couchBatchGetEndpoint reads several keys at once, e.g.
+// {"keys": ["a", "b"]}, with one result per key in request order.
+func couchBatchGetEndpoint(c *gin.Context) {
+	type request struct {
+		Keys []string `json:"keys"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Keys) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Keys not specified")
+		return
+	}
+	if len(req.Keys) > currentConfig().Couchbase.MaxBatch {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Too many keys")
+		return
+	}
+	bucket := couchbaseBucket(c)
+	found, err := bucket.GetBulk(req.Keys, time.Time{}, nil)
+	defer bucket.ReleaseGetBulkPools(found)
+	results := make([]couchResult, len(req.Keys))
+	for i, key := range req.Keys {
+		results[i].Key = key
+		if res, ok := found[key]; ok {
+			results[i].Status = http.StatusOK
+			results[i].CAS = res.Cas
+			results[i].Value = couchValue(res.Body)
+			continue
+		}
+		if err == nil {
+			results[i].Status = http.StatusNotFound
+			results[i].Error = "Key not found"
+			continue
+		}
+		// The bulk get does not tell which keys failed; ask for each
+		// missing one on its own.
+		value, _, cas, getErr := bucket.GetsRaw(key)
+		switch {
+		case getErr == nil:
+			results[i].Status = http.StatusOK
+			results[i].CAS = cas
+			results[i].Value = couchValue(value)
+		case couchbase.IsKeyNoEntError(getErr):
+			results[i].Status = http.StatusNotFound
+			results[i].Error = "Key not found"
+		default:
+			log.Println(getErr)
+			results[i].Status = http.StatusInternalServerError
+			results[i].Error = "Failed to get from couchbase"
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// couchBatchSetEndpoint writes several keys at once, e.g.
+// {"entries": [{"key": "a", "value": {"f": 1}, "ttl": "10m"}]}, with one
+// result per key in request order. A failed key does not stop the others.
+// Entries with a "cas" are only written if the key still has it. The
+// durability parameter applies to every entry. Results of writes carry
+// their mutation token.
+func couchBatchSetEndpoint(c *gin.Context) {
+	type entry struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+		TTL   string          `json:"ttl"`
+		CAS   uint64          `json:"cas"`
+	}
+	type request struct {
+		Entries []entry `json:"entries"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Entries) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Entries not specified")
+		return
+	}
+	cc := currentConfig().Couchbase
+	if len(req.Entries) > cc.MaxBatch {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Too many keys")
+		return
+	}
+	expiries := make([]int, len(req.Entries))
+	for i, e := range req.Entries {
+		if e.Key == "" {
+			errorResponse(c, http.StatusBadRequest, "Key not specified")
+			return
+		}
+		if len(e.Value) == 0 {
+			errorResponse(c, http.StatusBadRequest, "Value not specified for "+e.Key)
+			return
+		}
+		ttl, ok := parseKVTTL(c, e.TTL)
+		if !ok {
+			return
+		}
+		expiries[i] = couchExpiry(ttl)
+	}
+	durability, ok := parseDurability(c)
+	if !ok {
+		return
+	}
+	// go-couchbase has no bulk write, so the sets run concurrently.
+	bucket := couchbaseBucket(c)
+	results := make([]couchResult, len(req.Entries))
+	slots := make(chan struct{}, cc.BatchWorkers)
+	var wg sync.WaitGroup
+	for i, e := range req.Entries {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(i int, e entry) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			results[i] = couchResult{Key: e.Key, Status: http.StatusOK}
+			cas, mt, err := couchWrite(bucket, e.Key, expiries[i], e.CAS, e.Value)
+			if err != nil {
+				results[i].Status, results[i].Error = couchWriteError(err)
+				return
+			}
+			results[i].CAS = cas
+			results[i].MutationToken = formatMutationToken(bucket.Name, mt)
+			if err := awaitDurability(bucket, cc, e.Key, cas, durability); err != nil {
+				results[i].Status, results[i].Error = durabilityError(err)
+			}
+		}(i, e)
+	}
+	wg.Wait()
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// couchValue returns a stored value as JSON, quoting values that are not.
+func couchValue(data []byte) json.RawMessage {
+	if json.Valid(data) {
+		return data
+	}
+	quoted, _ := json.Marshal(string(data))
+	return quoted
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// couchExportLine is one key of an export.
+type couchExportLine struct {
+	Key   string          `json:"key"`
+	CAS   uint64          `json:"cas"`
+	Value json.RawMessage `json:"value"`
+}
+
+// adminCouchExportEndpoint streams every key of the bucket, or those
+// starting with prefix, as newline-delimited JSON in key order, e.g.
+// {"key": "a", "cas": 1600000000000000000, "value": {"f": 1}}. Keys are
+// read couchbase.max_batch at a time, so the export is not a snapshot:
+// keys written meanwhile may or may not be included and keys removed
+// meanwhile are skipped.
+func adminCouchExportEndpoint(c *gin.Context) {
+	cc := currentConfig().Couchbase
+	bucket := couchbaseBucket(c)
+	prefix := c.Query("prefix")
+	ctx := c.Request.Context()
+	c.Header("Content-Type", "application/x-ndjson")
+	started := false
+	enc := json.NewEncoder(c.Writer)
+	after := ""
+	err := func() error {
+		for {
+			keys, err := couchKeyPage(ctx, cc, bucket.Name, prefix, after, cc.MaxBatch)
+			if err != nil {
+				return err
+			}
+			if len(keys) == 0 {
+				return nil
+			}
+			after = keys[len(keys)-1]
+			found, err := bucket.GetBulk(keys, time.Time{}, nil)
+			if err != nil {
+				bucket.ReleaseGetBulkPools(found)
+				return err
+			}
+			if !started {
+				c.Status(http.StatusOK)
+				started = true
+			}
+			for _, key := range keys {
+				res, ok := found[key]
+				if !ok {
+					continue
+				}
+				if err := enc.Encode(couchExportLine{Key: key, CAS: res.Cas, Value: couchValue(res.Body)}); err != nil {
+					bucket.ReleaseGetBulkPools(found)
+					return err
+				}
+			}
+			bucket.ReleaseGetBulkPools(found)
+			if len(keys) < cc.MaxBatch {
+				return nil
+			}
+		}
+	}()
+	if err == nil {
+		if !started {
+			c.Status(http.StatusOK)
+		}
+		return
+	}
+	if !started {
+		if err == errNoPrimaryIndex {
+			errorResponse(c, http.StatusNotImplemented, "Exports need a primary index on the bucket")
+			return
+		}
+		log.Printf("couchbase export of %s: %v", bucket.Name, err)
+		errorResponse(c, http.StatusBadGateway, "Failed to export couchbase bucket")
+		return
+	}
+	// The status line is already sent; all that is left is to cut the
+	// stream short.
+	log.Printf("couchbase export of %s: %v", bucket.Name, err)
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/couchbase/gomemcached"
+	"github.com/go-redis/redis"
+)
+
+// couchbaseFeed keeps the documents stored in a Couchbase bucket
+// searchable by following the bucket's DCP change feed.
+type couchbaseFeed struct {
+	client redis.UniversalClient
+	cfg    config.CouchbaseFeedConfig
+	bucket string
+}
+
+// vbPosition is how far the feed got in a vBucket: the vbuuid of the
+// history it follows and the last sequence number indexed.
+type vbPosition struct {
+	vbuuid, seqno uint64
+}
+
+// feedBatch collects changes until they are indexed. A nil document stands
+// for a deleted key.
+type feedBatch struct {
+	changes map[string]*Document
+	seqnos  map[uint16]uint64
+}
+
+func newCouchbaseFeed(client redis.UniversalClient, cfg config.CouchbaseFeedConfig, bucket string) *couchbaseFeed {
+	return &couchbaseFeed{client: client, cfg: cfg, bucket: bucket}
+}
+
+// Run indexes changes until stop is closed, while this replica holds the
+// feed lock.
+func (f *couchbaseFeed) Run(stop <-chan struct{}) {
+	for {
+		runLocked(context.Background(), "couchbase-feed", func(ctx context.Context) {
+			if err := f.stream(stop, ctx.Done()); err != nil {
+				log.Printf("couchbase feed: %v", err)
+			}
+		})
+		if sleepOrStop(stop, f.cfg.RetryInterval) {
+			return
+		}
+	}
+}
+
+// stream opens a feed resuming every vBucket at its checkpoint and indexes
+// the changes until the feed fails, stop is closed, the connection is
+// replaced or the lock is lost.
+func (f *couchbaseFeed) stream(stop, lost <-chan struct{}) error {
+	conn, err := cbHolder.acquire()
+	if err != nil {
+		return err
+	}
+	defer conn.release()
+	bucket, err := conn.bucket(f.bucket)
+	if err != nil {
+		return err
+	}
+	positions, err := f.loadCheckpoints()
+	if err != nil {
+		return err
+	}
+	feed, err := bucket.StartUprFeed("homie-search:"+f.bucket, 0)
+	if err != nil {
+		return err
+	}
+	defer feed.Close()
+	for vb := range bucket.VBServerMap().VBucketMap {
+		p := positions[uint16(vb)]
+		if err := feed.UprRequestStream(uint16(vb), 0, 0, p.vbuuid, p.seqno, math.MaxUint64, p.seqno, p.seqno); err != nil {
+			return fmt.Errorf("cannot stream vBucket %d: %v", vb, err)
+		}
+	}
+	batch := &feedBatch{changes: make(map[string]*Document), seqnos: make(map[uint16]uint64)}
+	ticker := time.NewTicker(f.cfg.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return f.flush(batch, positions)
+		case <-lost:
+			// The replica now holding the lock resumes from the last
+			// checkpoint; flushing could move it back.
+			return errLockLost
+		case <-conn.retired:
+			// The feed is reopened on the new connection, which cannot
+			// happen before this one is released.
+			f.flush(batch, positions)
+			return errors.New("couchbase connection replaced")
+		case <-ticker.C:
+			if err := f.flush(batch, positions); err != nil {
+				return err
+			}
+		case e, ok := <-feed.C:
+			if !ok {
+				f.flush(batch, positions)
+				return errors.New("feed closed")
+			}
+			switch e.Opcode {
+			case gomemcached.UPR_STREAMREQ:
+				switch {
+				case e.Status == gomemcached.ROLLBACK:
+					// The vBucket's history diverged from the one the
+					// checkpoint followed, so it is indexed anew.
+					log.Printf("couchbase feed: vBucket %d rolled back, streaming it from the start", e.VBucket)
+					positions[e.VBucket] = vbPosition{}
+					if err := feed.UprRequestStream(e.VBucket, 0, 0, 0, 0, math.MaxUint64, 0, 0); err != nil {
+						return fmt.Errorf("cannot stream vBucket %d: %v", e.VBucket, err)
+					}
+				case e.Error != nil:
+					return fmt.Errorf("cannot stream vBucket %d: %v", e.VBucket, e.Error)
+				case e.FailoverLog != nil && len(*e.FailoverLog) > 0:
+					p := positions[e.VBucket]
+					p.vbuuid = (*e.FailoverLog)[0][0]
+					positions[e.VBucket] = p
+				}
+			case gomemcached.UPR_MUTATION, gomemcached.UPR_DELETION, gomemcached.UPR_EXPIRATION:
+				batch.seqnos[e.VBucket] = e.Seqno
+				key := string(e.Key)
+				if !strings.HasPrefix(key, f.cfg.Prefix) {
+					continue
+				}
+				id := strings.TrimPrefix(key, f.cfg.Prefix)
+				if e.Opcode != gomemcached.UPR_MUTATION {
+					batch.changes[id] = nil
+				} else if doc, err := decodeMirrored(e.Value); err != nil {
+					log.Printf("couchbase feed: %s does not hold a valid document: %v", key, err)
+				} else {
+					doc.ID = id
+					batch.changes[id] = &doc
+				}
+				if len(batch.changes) >= f.cfg.BatchSize {
+					if err := f.flush(batch, positions); err != nil {
+						return err
+					}
+				}
+			case gomemcached.UPR_STREAMEND:
+				// Streams end when vBuckets move, e.g. on rebalance; the
+				// feed is reopened at the checkpoints.
+				f.flush(batch, positions)
+				return fmt.Errorf("stream of vBucket %d ended", e.VBucket)
+			}
+		}
+	}
+}
+
+// flush indexes the changes of batch, then saves the positions reached.
+// Nothing is saved when a change could not be applied, e.g. while
+// Elasticsearch is unreachable, so the batch is streamed again from the
+// last checkpoint.
+func (f *couchbaseFeed) flush(batch *feedBatch, positions map[uint16]vbPosition) error {
+	if len(batch.seqnos) == 0 {
+		return nil
+	}
+	var docs []Document
+	for id, doc := range batch.changes {
+		if doc == nil {
+			if err := trashMirrored("couchbase feed", id); err != nil {
+				return err
+			}
+			continue
+		}
+		docs = append(docs, *doc)
+	}
+	if len(docs) > 0 {
+		if err := indexMirrored("couchbase feed", docs); err != nil {
+			return err
+		}
+	}
+	fields := make(map[string]interface{}, len(batch.seqnos))
+	for vb, seqno := range batch.seqnos {
+		p := positions[vb]
+		p.seqno = seqno
+		positions[vb] = p
+		fields[strconv.Itoa(int(vb))] = strconv.FormatUint(p.vbuuid, 10) + ":" + strconv.FormatUint(seqno, 10)
+	}
+	batch.changes = make(map[string]*Document)
+	batch.seqnos = make(map[uint16]uint64)
+	return f.client.HMSet(f.checkpointKey(), fields).Err()
+}
+
+// loadCheckpoints reads the saved positions, skipping malformed ones.
+func (f *couchbaseFeed) loadCheckpoints() (map[uint16]vbPosition, error) {
+	raw, err := f.client.HGetAll(f.checkpointKey()).Result()
+	if err != nil {
+		return nil, err
+	}
+	positions := make(map[uint16]vbPosition, len(raw))
+	for field, v := range raw {
+		vb, err := strconv.ParseUint(field, 10, 16)
+		parts := strings.Split(v, ":")
+		if err != nil || len(parts) != 2 {
+			log.Printf("couchbase feed: ignoring malformed checkpoint %s=%s", field, v)
+			continue
+		}
+		vbuuid, err1 := strconv.ParseUint(parts[0], 10, 64)
+		seqno, err2 := strconv.ParseUint(parts[1], 10, 64)
+		if err1 != nil || err2 != nil {
+			log.Printf("couchbase feed: ignoring malformed checkpoint %s=%s", field, v)
+			continue
+		}
+		positions[uint16(vb)] = vbPosition{vbuuid: vbuuid, seqno: seqno}
+	}
+	return positions, nil
+}
+
+func (f *couchbaseFeed) checkpointKey() string {
+	return f.cfg.CheckpointKey + ":" + f.bucket
+}
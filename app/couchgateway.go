@@ -0,0 +1,287 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	memcached "github.com/couchbase/gomemcached/client"
+)
+
+// couchGateway lets go-couchbase reach a cluster over TLS while it speaks
+// plain HTTP and memcached. go-couchbase is given the gateway's loopback
+// address as the management URL. Requests are forwarded to the cluster
+// over TLS, and the server lists of the bucket configurations coming back
+// are rewritten to loopback tunnels, each leading to the TLS data port of
+// a node. The gateway belongs to its connection and closes with it.
+//
+// What comes through the gateway is authenticated with the credentials of
+// the connection, a client certificate included, so its listeners only
+// accept connections made by this process: a loopback socket is open to
+// every container of the pod. go-couchbase cannot be made to present a
+// credential of ours, since it starts memcached connections with HELO
+// before authenticating, so the peer is told by the socket instead.
+type couchGateway struct {
+	conn   *couchbaseConn
+	target *url.URL
+	ln     net.Listener
+	srv    *http.Server
+
+	mu      sync.Mutex
+	tunnels map[string]net.Listener // by data service address
+	closed  bool
+}
+
+func newCouchGateway(conn *couchbaseConn) (*couchGateway, error) {
+	target, err := url.Parse(conn.url)
+	if err != nil {
+		return nil, err
+	}
+	ln, err := listenLocal()
+	if err != nil {
+		return nil, err
+	}
+	g := &couchGateway{conn: conn, target: target, ln: ln, tunnels: make(map[string]net.Listener)}
+	g.srv = &http.Server{Handler: &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+			req.Host = target.Host
+			// Configurations are rewritten, so they must come uncompressed.
+			req.Header.Del("Accept-Encoding")
+		},
+		Transport:      conn.http.Transport,
+		ModifyResponse: g.rewrite,
+	}}
+	go g.srv.Serve(ln)
+	return g, nil
+}
+
+// URL returns the management URL for go-couchbase, with the credentials
+// of the cluster's.
+func (g *couchGateway) URL() string {
+	u := url.URL{Scheme: "http", User: g.target.User, Host: g.ln.Addr().String()}
+	return u.String()
+}
+
+// rewrite points the server lists in a configuration to tunnels.
+func (g *couchGateway) rewrite(resp *http.Response) error {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if resp.StatusCode != http.StatusOK || mediaType != "application/json" {
+		return nil
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(body, []byte(`"serverList"`)) {
+		dec := json.NewDecoder(bytes.NewReader(body))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		if err := g.rewriteServerLists(v); err != nil {
+			return err
+		}
+		if body, err = json.Marshal(v); err != nil {
+			return err
+		}
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return nil
+}
+
+func (g *couchGateway) rewriteServerLists(v interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if list, ok := e.([]interface{}); ok && k == "serverList" {
+				for i, addr := range list {
+					s, ok := addr.(string)
+					if !ok {
+						continue
+					}
+					tunnel, err := g.tunnel(s)
+					if err != nil {
+						return err
+					}
+					list[i] = tunnel
+				}
+				continue
+			}
+			if err := g.rewriteServerLists(e); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if err := g.rewriteServerLists(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// tunnel returns the loopback address of the tunnel to the data service
+// at addr, opening it on first use. Tunnels stay the same for the life of
+// the gateway, so go-couchbase keeps its connection pools across
+// configuration changes.
+func (g *couchGateway) tunnel(addr string) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return "", errCouchbaseUnavailable
+	}
+	if ln, ok := g.tunnels[addr]; ok {
+		return ln.Addr().String(), nil
+	}
+	ln, err := listenLocal()
+	if err != nil {
+		return "", err
+	}
+	g.tunnels[addr] = ln
+	go g.serveTunnel(ln, addr)
+	return ln.Addr().String(), nil
+}
+
+// serveTunnel connects every connection accepted on ln to the data service
+// at addr over TLS.
+func (g *couchGateway) serveTunnel(ln net.Listener, addr string) {
+	for {
+		local, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer local.Close()
+			remote, err := g.conn.dialData(addr, memcached.DefaultDialTimeout)
+			if err != nil {
+				log.Printf("cannot connect to couchbase data service %s: %v", addr, err)
+				return
+			}
+			defer remote.Close()
+			done := make(chan struct{}, 2)
+			pipe := func(dst, src net.Conn) {
+				io.Copy(dst, src)
+				done <- struct{}{}
+			}
+			go pipe(remote, local)
+			go pipe(local, remote)
+			// Either side closing ends the tunnelled connection.
+			<-done
+		}()
+	}
+}
+
+// close stops the gateway. Tunnelled connections end as go-couchbase
+// closes them.
+func (g *couchGateway) close() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closed = true
+	g.srv.Close()
+	for _, ln := range g.tunnels {
+		ln.Close()
+	}
+}
+
+// ownListener is a loopback listener dropping connections made by other
+// processes.
+type ownListener struct {
+	net.Listener
+}
+
+func listenLocal() (net.Listener, error) {
+	ln, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	return ownListener{ln}, nil
+}
+
+func (l ownListener) Accept() (net.Conn, error) {
+	for {
+		c, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		own, err := ownConn(c)
+		if own {
+			return c, nil
+		}
+		if err != nil {
+			log.Printf("couchbase gateway: cannot identify connection from %s: %v", c.RemoteAddr(), err)
+		} else {
+			log.Printf("couchbase gateway: refused connection from %s, not made by this process", c.RemoteAddr())
+		}
+		c.Close()
+	}
+}
+
+// ownConn reports whether the client end of the loopback connection c is
+// a socket of this process: the socket with the ends of c swapped is looked
+// up in /proc/net/tcp, and its inode among the open files of the process.
+func ownConn(c net.Conn) (bool, error) {
+	local, remote := c.LocalAddr().(*net.TCPAddr), c.RemoteAddr().(*net.TCPAddr)
+	data, err := ioutil.ReadFile("/proc/self/net/tcp")
+	if err != nil {
+		return false, err
+	}
+	inode := ""
+	for _, line := range strings.Split(string(data), "\n")[1:] {
+		f := strings.Fields(line)
+		if len(f) > 9 && procTCPAddr(f[1], remote) && procTCPAddr(f[2], local) {
+			inode = f[9]
+			break
+		}
+	}
+	if inode == "" {
+		return false, errors.New("socket not found")
+	}
+	fds, err := ioutil.ReadDir("/proc/self/fd")
+	if err != nil {
+		return false, err
+	}
+	for _, fd := range fds {
+		if link, err := os.Readlink("/proc/self/fd/" + fd.Name()); err == nil && link == "socket:["+inode+"]" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// procTCPAddr reports whether s, an address of /proc/net/tcp such as
+// 0100007F:1F90, is addr. The IPv4 address is printed as a number in the
+// byte order of the host, which is little-endian on the platforms we run
+// on.
+func procTCPAddr(s string, addr *net.TCPAddr) bool {
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return false
+	}
+	ip, err1 := strconv.ParseUint(s[:i], 16, 32)
+	port, err2 := strconv.ParseUint(s[i+1:], 16, 16)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(ip))
+	return int(port) == addr.Port && net.IP(b[:]).Equal(addr.IP)
+}
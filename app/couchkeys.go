@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Couchbase has no key listing on the data service, so keys are paged out
+// of the bucket's primary index in key order, which needs a primary index
+// (CREATE PRIMARY INDEX ON `bucket`).
+
+// errNoPrimaryIndex is returned by couchKeyPage when the bucket has no
+// primary index to list its keys from.
+var errNoPrimaryIndex = errors.New("no primary index")
+
+// n1qlNoIndex is the error code of statements no index can serve.
+const n1qlNoIndex = 4000
+
+// couchKeysEndpoint lists the keys of the bucket in key order, e.g.
+// GET /couchbase/keys?prefix=user:&limit=100. At most
+// couchbase.query.max_rows keys are returned at once; next_cursor, passed
+// back as cursor, continues after the last one.
+func couchKeysEndpoint(c *gin.Context) {
+	cc := currentConfig().Couchbase
+	limit := cc.Query.MaxRows
+	if i, err := strconv.Atoi(c.Query("limit")); err == nil && i > 0 && i < limit {
+		limit = i
+	}
+	after := ""
+	if cursor := c.Query("cursor"); cursor != "" {
+		values, err := decodeCursor(cursor)
+		key, ok := "", false
+		if err == nil && len(values) == 1 {
+			key, ok = values[0].(string)
+		}
+		if !ok {
+			errorResponse(c, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		after = key
+	}
+	bucket := couchbaseBucket(c)
+	// One key more than returned tells whether there are more.
+	keys, err := couchKeyPage(c.Request.Context(), cc, bucket.Name, c.Query("prefix"), after, limit+1)
+	if err == errNoPrimaryIndex {
+		errorResponse(c, http.StatusNotImplemented, "Listing keys needs a primary index on the bucket")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusBadGateway, "Failed to list keys")
+		return
+	}
+	resp := gin.H{}
+	if len(keys) > limit {
+		keys = keys[:limit]
+		cursor := encodeCursor([]interface{}{keys[limit-1]})
+		resp["next_cursor"] = cursor
+		setCursorLink(c, cursor)
+	}
+	if keys == nil {
+		keys = []string{}
+	}
+	resp["keys"] = keys
+	c.JSON(http.StatusOK, resp)
+}
+
+// couchKeyPage returns up to limit keys of bucket starting with prefix and
+// sorting after after, in key order.
+func couchKeyPage(ctx context.Context, cc config.CouchbaseConfig, bucket, prefix, after string, limit int) ([]string, error) {
+	statement := "SELECT RAW meta().id FROM `" + bucket + "` WHERE meta().id > $after AND meta().id >= $prefix"
+	body := map[string]interface{}{
+		"timeout":          cc.Query.Timeout.String(),
+		"scan_consistency": consistencyRequestPlus,
+		"$after":           after,
+		"$prefix":          prefix,
+	}
+	if end, ok := prefixEnd(prefix); ok {
+		statement += " AND meta().id < $end"
+		body["$end"] = end
+	}
+	body["statement"] = fmt.Sprintf("%s ORDER BY meta().id LIMIT %d", statement, limit)
+	resp, err := runN1QL(ctx, cc, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var res struct {
+		Results []string `json:"results"`
+		Errors  []struct {
+			Code int    `json:"code"`
+			Msg  string `json:"msg"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	if len(res.Errors) > 0 {
+		if res.Errors[0].Code == n1qlNoIndex {
+			return nil, errNoPrimaryIndex
+		}
+		return nil, errors.New(res.Errors[0].Msg)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("query service answered %d", resp.StatusCode)
+	}
+	return res.Results, nil
+}
+
+// prefixEnd returns the smallest string sorting after every string
+// starting with prefix, false when there is none.
+func prefixEnd(prefix string) (string, bool) {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1]), true
+		}
+	}
+	return "", false
+}
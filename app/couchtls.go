@@ -0,0 +1,151 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/couchbase/go-couchbase"
+	memcached "github.com/couchbase/gomemcached/client"
+)
+
+// Connection strings name the cluster by its data nodes, couchbase://host
+// or couchbases://host for TLS, which is required by Capella. The cluster
+// is then reached through the management port of the first host, 8091 or
+// 18091, and every service through its TLS port when secure. go-couchbase
+// itself only speaks plain HTTP and memcached, so it goes through the
+// connection's gateway.
+
+// couchbaseManagementURL returns the management URL of cc.URL and whether
+// the connection is secure.
+func couchbaseManagementURL(cc config.CouchbaseConfig) (string, bool, error) {
+	u, err := url.Parse(cc.URL)
+	if err != nil {
+		return "", false, err
+	}
+	switch u.Scheme {
+	case "http":
+		return cc.URL, false, nil
+	case "https":
+		return cc.URL, true, nil
+	case "couchbase", "couchbases":
+	default:
+		return "", false, fmt.Errorf("unsupported couchbase url scheme %q", u.Scheme)
+	}
+	secure := u.Scheme == "couchbases"
+	host := strings.Split(u.Host, ",")[0]
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		// Connection strings leave the port out; the default one is that
+		// of the management service.
+		port := "8091"
+		if secure {
+			port = "18091"
+		}
+		host = net.JoinHostPort(host, port)
+	}
+	m := url.URL{Scheme: "http", User: u.User, Host: host}
+	if secure {
+		m.Scheme = "https"
+	}
+	return m.String(), secure, nil
+}
+
+// couchbaseCertAuth reports whether cc authenticates with its client
+// certificate rather than a user and password.
+func couchbaseCertAuth(cc config.CouchbaseConfig) bool {
+	return cc.TLS.CertFile != "" && cc.Username == ""
+}
+
+// couchCertAuth authenticates with the client certificate presented during
+// the TLS handshake, so requests carry no credentials and connections to
+// the data service only select the bucket.
+type couchCertAuth struct {
+	bucket string
+}
+
+func (a couchCertAuth) GetCredentials() (string, string, string) {
+	return "", "", a.bucket
+}
+
+func (a couchCertAuth) ForBucket(bucket string) couchbase.AuthHandler {
+	return couchCertAuth{bucket: bucket}
+}
+
+func (a couchCertAuth) SetCredsForRequest(req *http.Request) error {
+	return nil
+}
+
+func (a couchCertAuth) AuthenticateMemcachedConn(host string, conn *memcached.Client) error {
+	if a.bucket == "" {
+		return nil
+	}
+	_, err := conn.SelectBucket(a.bucket)
+	return err
+}
+
+// secureTransport returns the TLS configuration and HTTP client of a
+// secure connection.
+func secureTransport(cc config.CouchbaseConfig) (*tls.Config, *http.Client, error) {
+	tc, err := newTLSConfig(cc.TLS)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tc, &http.Client{Transport: &http.Transport{TLSClientConfig: tc}}, nil
+}
+
+// dialData connects over TLS to the secure port of the data service at
+// addr, the plain address listed in the bucket's server list. A zero
+// timeout means none.
+func (conn *couchbaseConn) dialData(addr string, timeout time.Duration) (net.Conn, error) {
+	secure, err := conn.secureAddr(addr, "kv", "kvSSL")
+	if err != nil {
+		return nil, err
+	}
+	tc := conn.tls.Clone()
+	if tc.ServerName == "" {
+		tc.ServerName, _, _ = net.SplitHostPort(secure)
+	}
+	return tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", secure, tc)
+}
+
+// secureAddr maps the address of a service on a node to the address of its
+// TLS counterpart on the same node.
+func (conn *couchbaseConn) secureAddr(addr, service, secureService string) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+	for _, node := range conn.services.NodesExt {
+		if strconv.Itoa(node.Services[service]) != port {
+			continue
+		}
+		if node.Hostname != "" && node.Hostname != host {
+			continue
+		}
+		if p, ok := node.Services[secureService]; ok {
+			return net.JoinHostPort(host, strconv.Itoa(p)), nil
+		}
+	}
+	return "", fmt.Errorf("no %s port known for %s", secureService, addr)
+}
+
+// HTTPClient returns the client for requests to the services of the
+// cluster.
+func (h *couchbaseHolder) HTTPClient() *http.Client {
+	if conn := h.conn(); conn != nil && conn.http != nil {
+		return conn.http
+	}
+	return http.DefaultClient
+}
+
+// Secure reports whether the connection uses TLS.
+func (h *couchbaseHolder) Secure() bool {
+	conn := h.conn()
+	return conn != nil && conn.tls != nil
+}
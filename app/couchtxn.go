@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/couchbase/go-couchbase"
+	"github.com/couchbase/gomemcached"
+	memcached "github.com/couchbase/gomemcached/client"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+	"github.com/teris-io/shortid"
+)
+
+// Couchbase's ACID transactions are a protocol implemented by the SDKs on
+// top of staged writes, which go-couchbase does not implement. Transactions
+// here are compensating instead: every key is read first, the mutations
+// are applied conditional on what was read, and when one fails those
+// already applied are undone, again conditional on their CAS. Readers may
+// see the intermediate states, and a key changed by someone else between
+// the write and its undo is left as is and reported. While it runs, a
+// transaction keeps a record in Redis, so that one whose replica stops
+// midway is rolled back by another.
+
+// txnMutation is one change of a transaction. Op is "insert" (the key
+// must not exist), "replace" (it must), "upsert" or "remove"; CAS, when
+// given, must match the key's.
+type txnMutation struct {
+	Op    string          `json:"op"`
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+	CAS   uint64          `json:"cas"`
+}
+
+// txnKey is the state of a key read before the transaction and the CAS
+// it had after the transaction's write.
+type txnKey struct {
+	Exists  bool   `json:"exists"`
+	Value   []byte `json:"value,omitempty"`
+	Flags   int    `json:"flags"`
+	Expiry  int    `json:"expiry"`
+	CAS     uint64 `json:"cas"`
+	Written uint64 `json:"written,omitempty"`
+}
+
+// txnRecord is what a transaction in progress keeps in Redis: the keys as
+// read and the writes made so far, enough to roll it back when the replica
+// running it stops before it is done.
+type txnRecord struct {
+	ID        string        `json:"id"`
+	Bucket    string        `json:"bucket"`
+	Mutations []txnMutation `json:"mutations"`
+	Keys      []*txnKey     `json:"keys"`
+}
+
+// txnLog keeps the records of the transactions in progress, each under its
+// own key and listed in a sorted set by the time of its last update.
+type txnLog struct {
+	client redis.UniversalClient
+}
+
+const txnsKey = "couchbase-txns"
+
+var couchTxns *txnLog
+
+func newTxnLog(client redis.UniversalClient) *txnLog {
+	return &txnLog{client: client}
+}
+
+func txnRecordKey(id string) string {
+	return "couchbase-txn:" + id
+}
+
+// couchTransactionEndpoint applies mutations to several keys, undoing
+// those already applied when one fails, e.g. {"mutations": [{"op":
+// "replace", "key": "a", "value": 1, "cas": 123}, {"op": "remove", "key":
+// "b"}]}. This is not atomic: readers may see some of the mutations before
+// the others or before they are undone. It answers 200 with the new CAS
+// and mutation token of each key, or the status of the first failed
+// mutation once the others were rolled back. Keys that could not be rolled
+// back are listed in the error details with 500. Should the replica stop
+// midway, another one rolls the transaction back after
+// couchbase.transaction_recovery.
+func (t *txnLog) couchTransactionEndpoint(c *gin.Context) {
+	type request struct {
+		Mutations []txnMutation `json:"mutations"`
+	}
+	var req request
+	if !bindJSON(c, &req) {
+		return
+	}
+	if len(req.Mutations) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Mutations not specified")
+		return
+	}
+	if len(req.Mutations) > currentConfig().Couchbase.MaxBatch {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Too many mutations")
+		return
+	}
+	seen := make(map[string]bool, len(req.Mutations))
+	for _, m := range req.Mutations {
+		if m.Key == "" {
+			errorResponse(c, http.StatusBadRequest, "Key not specified")
+			return
+		}
+		if seen[m.Key] {
+			errorResponse(c, http.StatusBadRequest, "Key "+m.Key+" is mutated twice")
+			return
+		}
+		seen[m.Key] = true
+		switch m.Op {
+		case "insert", "replace", "upsert":
+			if len(m.Value) == 0 {
+				errorResponse(c, http.StatusBadRequest, "Value not specified for "+m.Key)
+				return
+			}
+		case "remove":
+		default:
+			errorResponse(c, http.StatusBadRequest, "Unknown op "+m.Op)
+			return
+		}
+	}
+	bucket := couchbaseBucket(c)
+	keys := make([]*txnKey, len(req.Mutations))
+	for i, m := range req.Mutations {
+		k, err := txnRead(bucket, m.Key)
+		if err == errTxnChanged {
+			errorResponse(c, http.StatusConflict, "Key "+m.Key+" was modified concurrently")
+			return
+		}
+		if err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusInternalServerError, "Failed to read "+m.Key)
+			return
+		}
+		if code, msg := txnCheck(m, k); code != 0 {
+			errorResponse(c, code, msg)
+			return
+		}
+		keys[i] = k
+	}
+	rec := &txnRecord{ID: shortid.MustGenerate(), Bucket: bucket.Name, Mutations: req.Mutations, Keys: keys}
+	if err := t.save(rec); err != nil {
+		log.Printf("cannot record couchbase transaction: %v", err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to record the transaction")
+		return
+	}
+	results := make([]couchResult, 0, len(req.Mutations))
+	for i, m := range req.Mutations {
+		res, err := txnApply(bucket, m, keys[i])
+		if err != nil {
+			code, msg := couchWriteError(err)
+			t.rollback(c, bucket, rec, i, code, m.Key, msg)
+			return
+		}
+		keys[i].Written = res.Cas
+		if err := t.save(rec); err != nil {
+			log.Printf("cannot record couchbase transaction %s: %v", rec.ID, err)
+			t.rollback(c, bucket, rec, i+1, http.StatusInternalServerError, m.Key, "Failed to record the transaction")
+			return
+		}
+		results = append(results, couchResult{
+			Key:           m.Key,
+			Status:        http.StatusOK,
+			CAS:           res.Cas,
+			MutationToken: formatMutationToken(bucket.Name, mutationToken(bucket, m.Key, res.Extras)),
+		})
+	}
+	// Dropping the record commits the transaction: until then, recovery
+	// would roll it back.
+	if err := t.remove(rec.ID); err != nil {
+		log.Printf("cannot drop couchbase transaction %s: %v", rec.ID, err)
+		t.rollback(c, bucket, rec, len(keys), http.StatusInternalServerError, "", "Failed to record the transaction")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// rollback undoes the first n mutations of rec, failed at key, drops the
+// record and answers with code and msg, or 500 listing the keys left as
+// they were.
+func (t *txnLog) rollback(c *gin.Context, bucket *couchbase.Bucket, rec *txnRecord, n, code int, key, msg string) {
+	failed := txnRollback(bucket, rec.Mutations[:n], rec.Keys[:n])
+	if err := t.remove(rec.ID); err != nil {
+		log.Printf("cannot drop couchbase transaction %s: %v", rec.ID, err)
+	}
+	if len(failed) > 0 {
+		details := make([]ErrorDetail, 0, len(failed))
+		for _, key := range failed {
+			details = append(details, ErrorDetail{Key: key, Message: "Could not be rolled back"})
+		}
+		msg := "Transaction failed and could not be rolled back"
+		if key != "" {
+			msg = "Transaction failed at " + key + " and could not be rolled back"
+		}
+		errorCodeResponse(c, http.StatusInternalServerError, codeRollbackFailed, msg, details...)
+		return
+	}
+	var details []ErrorDetail
+	if key != "" {
+		details = append(details, ErrorDetail{Key: key, Message: "Transaction rolled back"})
+	}
+	errorCodeResponse(c, code, codeRolledBack, msg, details...)
+}
+
+func (t *txnLog) save(rec *txnRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = t.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set(txnRecordKey(rec.ID), data, 0)
+		pipe.ZAdd(txnsKey, redis.Z{Score: float64(time.Now().Unix()), Member: rec.ID})
+		return nil
+	})
+	return err
+}
+
+func (t *txnLog) remove(id string) error {
+	_, err := t.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Del(txnRecordKey(id))
+		pipe.ZRem(txnsKey, id)
+		return nil
+	})
+	return err
+}
+
+// Recover rolls back, every interval, the transactions whose record was
+// not updated for that long, their replica having stopped midway. It
+// returns when stop is closed.
+func (t *txnLog) Recover(stop <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			runLocked(context.Background(), "couchbase-txn-recovery", func(ctx context.Context) {
+				if err := t.recoverStale(interval); err != nil {
+					log.Printf("cannot recover couchbase transactions: %v", err)
+				}
+			})
+		}
+	}
+}
+
+func (t *txnLog) recoverStale(age time.Duration) error {
+	ids, err := t.client.ZRangeByScore(txnsKey, redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(time.Now().Add(-age).Unix(), 10),
+	}).Result()
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	conn, err := cbHolder.acquire()
+	if err != nil {
+		return err
+	}
+	defer conn.release()
+	for _, id := range ids {
+		data, err := t.client.Get(txnRecordKey(id)).Bytes()
+		if err == redis.Nil {
+			t.client.ZRem(txnsKey, id)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		var rec txnRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return err
+		}
+		bucket, err := conn.bucket(rec.Bucket)
+		if err != nil {
+			return err
+		}
+		if err := txnRecover(bucket, &rec); err != nil {
+			return err
+		}
+		if err := t.remove(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// txnRecover rolls back the mutations of rec that were applied. A write
+// the replica made but could not record is recognized by the key holding
+// what the mutation wrote.
+func txnRecover(bucket *couchbase.Bucket, rec *txnRecord) error {
+	var mutations []txnMutation
+	var keys []*txnKey
+	for i, m := range rec.Mutations {
+		k := rec.Keys[i]
+		cur, err := txnRead(bucket, m.Key)
+		if err != nil {
+			return err
+		}
+		switch {
+		case cur.Exists == k.Exists && bytes.Equal(cur.Value, k.Value):
+			// Not applied, or already rolled back.
+			continue
+		case m.Op == "remove" && !cur.Exists:
+		case m.Op != "remove" && cur.Exists && (cur.CAS == k.Written || k.Written == 0 && sameJSON(cur.Value, m.Value)):
+			k.Written = cur.CAS
+		default:
+			log.Printf("couchbase transaction %s: key %s changed since, left as is", rec.ID, m.Key)
+			continue
+		}
+		mutations = append(mutations, m)
+		keys = append(keys, k)
+	}
+	for _, key := range txnRollback(bucket, mutations, keys) {
+		log.Printf("couchbase transaction %s: key %s could not be rolled back", rec.ID, key)
+	}
+	if len(mutations) > 0 {
+		log.Printf("rolled back couchbase transaction %s", rec.ID)
+	}
+	return nil
+}
+
+// sameJSON reports whether a and b are the same JSON text but for
+// whitespace.
+func sameJSON(a, b []byte) bool {
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, a) != nil || json.Compact(&cb, b) != nil {
+		return false
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
+
+var errTxnChanged = errors.New("key keeps changing")
+
+// txnRead reads the value, flags, expiry and CAS of key. The expiry takes
+// a second read, so both are retried while the key changes in between.
+func txnRead(bucket *couchbase.Bucket, key string) (*txnKey, error) {
+	for attempt := 0; attempt < 3; attempt++ {
+		value, flags, cas, err := bucket.GetsRaw(key)
+		if couchbase.IsKeyNoEntError(err) {
+			return &txnKey{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var metaFlags, expiry int
+		var metaCAS, seqno uint64
+		err = bucket.GetMeta(key, &metaFlags, &expiry, &metaCAS, &seqno)
+		if couchbase.IsKeyNoEntError(err) || (err == nil && metaCAS != cas) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &txnKey{Exists: true, Value: value, Flags: flags, Expiry: expiry, CAS: cas}, nil
+	}
+	return nil, errTxnChanged
+}
+
+// txnCheck answers whether m can apply to the key as read, returning a
+// status and message when not.
+func txnCheck(m txnMutation, k *txnKey) (int, string) {
+	switch {
+	case m.Op == "insert" && k.Exists:
+		return http.StatusConflict, "Key " + m.Key + " already exists"
+	case (m.Op == "replace" || m.Op == "remove") && !k.Exists:
+		return http.StatusNotFound, "Key " + m.Key + " not found"
+	case m.CAS != 0 && m.CAS != k.CAS:
+		return http.StatusConflict, "Key " + m.Key + " was modified concurrently"
+	}
+	return 0, ""
+}
+
+// txnApply writes m, conditional on the key still being as read.
+func txnApply(bucket *couchbase.Bucket, m txnMutation, k *txnKey) (*gomemcached.MCResponse, error) {
+	switch {
+	case m.Op == "remove":
+		return txnSend(bucket, gomemcached.DELETE, m.Key, 0, 0, k.CAS, nil)
+	case k.Exists:
+		return txnSend(bucket, gomemcached.SET, m.Key, 0, 0, k.CAS, m.Value)
+	default:
+		return txnSend(bucket, gomemcached.ADD, m.Key, 0, 0, 0, m.Value)
+	}
+}
+
+// txnRollback restores the keys written by mutations, last first, and
+// returns those that changed since and were left alone.
+func txnRollback(bucket *couchbase.Bucket, mutations []txnMutation, keys []*txnKey) []string {
+	var failed []string
+	for i := len(mutations) - 1; i >= 0; i-- {
+		m, k := mutations[i], keys[i]
+		var err error
+		switch {
+		case !k.Exists:
+			_, err = txnSend(bucket, gomemcached.DELETE, m.Key, 0, 0, k.Written, nil)
+		case m.Op == "remove":
+			_, err = txnSend(bucket, gomemcached.ADD, m.Key, k.Flags, k.Expiry, 0, k.Value)
+		default:
+			_, err = txnSend(bucket, gomemcached.SET, m.Key, k.Flags, k.Expiry, k.Written, k.Value)
+		}
+		if err != nil {
+			log.Printf("cannot roll back couchbase key %s: %v", m.Key, err)
+			failed = append(failed, m.Key)
+		}
+	}
+	return failed
+}
+
+// txnSend sends a store or delete of key, conditional on cas unless it is
+// zero.
+func txnSend(bucket *couchbase.Bucket, opcode gomemcached.CommandCode, key string, flags, exp int, cas uint64, body []byte) (*gomemcached.MCResponse, error) {
+	req := &gomemcached.MCRequest{
+		Opcode: opcode,
+		Key:    []byte(key),
+		Cas:    cas,
+		Body:   body,
+	}
+	if opcode != gomemcached.DELETE {
+		req.Extras = make([]byte, 8)
+		binary.BigEndian.PutUint64(req.Extras, uint64(flags)<<32|uint64(uint32(exp)))
+	}
+	var res *gomemcached.MCResponse
+	err := bucket.Do(key, func(mc *memcached.Client, vb uint16) error {
+		req.VBucket = vb
+		var err error
+		res, err = mc.Send(req)
+		return err
+	})
+	return res, err
+}
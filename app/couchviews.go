@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/couchbase/go-couchbase"
+	"github.com/gin-gonic/gin"
+)
+
+// viewKeyParams are the view parameters taking a JSON key.
+var viewKeyParams = []string{"key", "keys", "startkey", "endkey"}
+
+// viewStale maps the stale parameter to the values the view engine takes.
+var viewStale = map[string]string{
+	"ok":           "ok",
+	"false":        "false",
+	"update_after": "update_after",
+}
+
+// couchViewEndpoint queries a map/reduce view of the bucket, e.g.
+// GET /couchbase/views/users/by_email?startkey="a"&endkey="b"&limit=50.
+// Keys are JSON; reduce=false returns the mapped rows of a view with a
+// reduce function, group and group_level group reduced ones. At most
+// couchbase.view_max_rows rows are returned at once; next_cursor, passed
+// back as cursor, continues after the last one.
+func couchViewEndpoint(c *gin.Context) {
+	if cbHolder.Secure() {
+		// go-couchbase queries views through the plain port of each node.
+		errorResponse(c, http.StatusNotImplemented, "Views are not available over TLS")
+		return
+	}
+	cc := currentConfig().Couchbase
+	params := map[string]interface{}{}
+	for _, name := range viewKeyParams {
+		v, ok := c.GetQuery(name)
+		if !ok {
+			continue
+		}
+		if !json.Valid([]byte(v)) {
+			errorResponse(c, http.StatusBadRequest, "Invalid "+name+", must be JSON")
+			return
+		}
+		params[name] = json.RawMessage(v)
+	}
+	for _, name := range []string{"reduce", "group", "descending", "inclusive_end"} {
+		v, ok := c.GetQuery(name)
+		if !ok {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			errorResponse(c, http.StatusBadRequest, "Invalid "+name+" "+v)
+			return
+		}
+		params[name] = b
+	}
+	if v, ok := c.GetQuery("group_level"); ok {
+		level, err := strconv.Atoi(v)
+		if err != nil || level < 0 {
+			errorResponse(c, http.StatusBadRequest, "Invalid group_level "+v)
+			return
+		}
+		params["group_level"] = level
+	}
+	if v, ok := c.GetQuery("stale"); ok {
+		stale, ok := viewStale[v]
+		if !ok {
+			errorResponse(c, http.StatusBadRequest, "Invalid stale "+v)
+			return
+		}
+		params["stale"] = stale
+	}
+	limit := cc.ViewMaxRows
+	if i, err := strconv.Atoi(c.Query("limit")); err == nil && i > 0 && i < limit {
+		limit = i
+	}
+	if cursor := c.Query("cursor"); cursor != "" {
+		after, err := decodeCursor(cursor)
+		if err != nil || len(after) != 2 {
+			errorResponse(c, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		// Restart at the last row returned and skip it. Mapped rows with
+		// equal keys are told apart by their document ID.
+		key, _ := json.Marshal(after[0])
+		params["startkey"] = json.RawMessage(key)
+		if id, _ := after[1].(string); id != "" {
+			params["startkey_docid"] = couchbase.DocID(id)
+		}
+		params["skip"] = 1
+	}
+	// One row more than returned tells whether there are more.
+	params["limit"] = limit + 1
+	res, err := couchbaseBucket(c).View(c.Param("ddoc"), c.Param("view"), params)
+	if err == nil && len(res.Errors) > 0 {
+		err = res.Errors[0]
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusBadGateway, "Failed to query view")
+		return
+	}
+	rows := res.Rows
+	nextCursor := ""
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		nextCursor = encodeCursor([]interface{}{last.Key, last.ID})
+	}
+	type row struct {
+		ID    string      `json:"id,omitempty"`
+		Key   interface{} `json:"key"`
+		Value interface{} `json:"value"`
+	}
+	out := make([]row, len(rows))
+	for i, r := range rows {
+		out[i] = row{ID: r.ID, Key: r.Key, Value: r.Value}
+	}
+	resp := gin.H{"total_rows": res.TotalRows, "rows": out}
+	if nextCursor != "" {
+		resp["next_cursor"] = nextCursor
+		setCursorLink(c, nextCursor)
+	}
+	c.JSON(http.StatusOK, resp)
+}
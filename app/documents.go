@@ -0,0 +1,583 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+	"github.com/teris-io/shortid"
+)
+
+type Document struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Content   string    `json:"content"`
+	Language  string    `json:"language,omitempty"`
+	Location  *GeoPoint `json:"location,omitempty"`
+
+	// Metadata holds client-defined attributes that can be filtered on
+	// without mapping changes.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+
+	// Attachment is set on documents created from an uploaded file.
+	Attachment *Attachment `json:"attachment,omitempty"`
+
+	// Deleted documents are in the trash since DeletedAt.
+	Deleted   bool       `json:"deleted,omitempty"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// GeoPoint is a location in decimal degrees.
+type GeoPoint struct {
+	Lat float64 `json:"lat" doc:"Latitude, -90 to 90"`
+	Lon float64 `json:"lon" doc:"Longitude, -180 to 180"`
+}
+
+func (p GeoPoint) valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
+
+// indexedDocument is the source stored in Elasticsearch: the document plus
+// fields derived from it that are only used for querying.
+type indexedDocument struct {
+	Document
+	Suggest        []string        `json:"suggest,omitempty"`
+	MetadataFields []metadataField `json:"metadata_fields,omitempty"`
+
+	// AttachmentData is an uploaded file, base64-encoded, for the
+	// attachment pipeline. The pipeline removes it before indexing.
+	AttachmentData string `json:"attachment_data,omitempty"`
+}
+
+// metadataField is one metadata entry as a nested key/value pair, so any
+// number of distinct keys share two mapped fields.
+type metadataField struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func newIndexedDocument(doc Document) indexedDocument {
+	return indexedDocument{
+		Document:       doc,
+		Suggest:        []string{doc.Title},
+		MetadataFields: metadataFields(doc.Metadata),
+	}
+}
+
+func metadataFields(metadata map[string]string) []metadataField {
+	fields := make([]metadataField, 0, len(metadata))
+	for k, v := range metadata {
+		fields = append(fields, metadataField{Key: k, Value: v})
+	}
+	return fields
+}
+
+// validateRequests validates a list of requests, naming fields by their
+// index in the list, e.g. [2].title.
+func validateRequests(reqs []DocumentRequest) []ErrorDetail {
+	var details []ErrorDetail
+	for i, r := range reqs {
+		details = append(details, prefixDetails("["+strconv.Itoa(i)+"].", r.validate())...)
+	}
+	return details
+}
+
+// validMetadata returns a message describing why metadata is rejected, or
+// "" when it is acceptable.
+func validMetadata(metadata map[string]string) string {
+	if max := currentConfig().Documents.MaxMetadataKeys; len(metadata) > max {
+		return fmt.Sprintf("Metadata has more than %d keys", max)
+	}
+	for k := range metadata {
+		if k == "" {
+			return "Metadata keys must not be empty"
+		}
+	}
+	return ""
+}
+
+// DocumentRequest holds the fields of a document a client may set. Title
+// and content are optional, as uploads take them from the file.
+type DocumentRequest struct {
+	Title    string            `json:"title" validate:"max=512,utf8"`
+	Content  string            `json:"content" validate:"utf8"`
+	Language string            `json:"language" doc:"Language code the content is analyzed in, e.g. en; empty for none"`
+	Location *GeoPoint         `json:"location"`
+	Metadata map[string]string `json:"metadata" validate:"dive,max=1024,utf8" doc:"Searchable key/value pairs, filtered on with meta=key:value"`
+	Tags     []string          `json:"tags" validate:"dive,max=100,utf8" doc:"Tags, stored lowercase"`
+}
+
+// validate returns the invalid fields of the request, none when it is
+// valid.
+func (r DocumentRequest) validate() []ErrorDetail {
+	details := validateRequest(r)
+	if !supportedLanguage(r.Language) {
+		details = append(details, ErrorDetail{Field: "language", Message: "Unsupported language " + r.Language})
+	}
+	if r.Location != nil && !r.Location.valid() {
+		details = append(details, ErrorDetail{Field: "location", Message: "Location out of range"})
+	}
+	if msg := validMetadata(r.Metadata); msg != "" {
+		details = append(details, ErrorDetail{Field: "metadata", Message: msg})
+	}
+	if msg := validTags(r.Tags); msg != "" {
+		details = append(details, ErrorDetail{Field: "tags", Message: msg})
+	}
+	return details
+}
+
+// document returns a new document with the requested fields and a fresh
+// ID.
+func (r DocumentRequest) document() Document {
+	now := time.Now().UTC()
+	return Document{
+		ID:        shortid.MustGenerate(),
+		Title:     r.Title,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Content:   r.Content,
+		Language:  r.Language,
+		Location:  r.Location,
+		Metadata:  r.Metadata,
+		Tags:      normalizeTags(r.Tags),
+	}
+}
+
+// DocumentPatch is a sparse update; fields left out are not changed.
+type DocumentPatch struct {
+	Title    *string           `json:"title" validate:"omitempty,max=512,utf8"`
+	Content  *string           `json:"content" validate:"omitempty,utf8"`
+	Language *string           `json:"language"`
+	Location *GeoPoint         `json:"location"`
+	Metadata map[string]string `json:"metadata" validate:"dive,max=1024,utf8"`
+	Tags     []string          `json:"tags" validate:"dive,max=100,utf8"`
+}
+
+type DocumentResponse struct {
+	ID         string
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time        `json:"updated_at,omitempty"`
+	Title      string            `json:"title"`
+	Content    string            `json:"content"`
+	Language   string            `json:"language,omitempty"`
+	Location   *GeoPoint         `json:"location,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	Tags       []string          `json:"tags,omitempty"`
+	Attachment *Attachment       `json:"attachment,omitempty"`
+
+	// Highlights holds the matched fragments per field when the search
+	// asked for highlighting.
+	Highlights map[string][]string `json:"highlights,omitempty"`
+}
+
+// createDocumentsEndpoint indexes a list of documents. It answers 200 when
+// all were indexed and 207 with the status of every item when only some
+// were. Large lists, or any list with async=true, are indexed by an ingest
+// job instead and answered with 202 and the job.
+func createDocumentsEndpoint(c *gin.Context) {
+	var reqs []DocumentRequest
+	if !bindJSON(c, &reqs) {
+		return
+	}
+	if details := validateRequests(reqs); len(details) > 0 {
+		validationFailed(c, details...)
+		return
+	}
+	docs := make([]Document, 0, len(reqs))
+	for _, d := range reqs {
+		docs = append(docs, d.document())
+	}
+	cfg := currentConfig()
+	threshold := cfg.Jobs.AsyncThreshold
+	if c.Query("async") == "true" || (threshold > 0 && len(docs) > threshold) {
+		job, err := ingestJobs.Submit(docs)
+		if err == errTooManyJobs {
+			errorResponse(c, http.StatusServiceUnavailable, "Too many pending jobs")
+			return
+		}
+		if err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusInternalServerError, "Failed to queue documents")
+			return
+		}
+		c.Header("Location", apiVersionPrefix+"/jobs/"+job.ID)
+		c.JSON(http.StatusAccepted, job)
+		return
+	}
+	results := indexDocuments(c.Request.Context(), elasticClient(), cfg, docs, nil)
+	created := indexedDocuments(docs, results)
+	invalidateSearchCache()
+	notifyAlerts(created...)
+	switch {
+	case len(created) == len(docs):
+		c.JSON(http.StatusOK, gin.H{"items": results})
+	case len(created) == 0 && len(docs) > 0:
+		if timedOut(c) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to create documents")
+	default:
+		c.JSON(http.StatusMultiStatus, gin.H{"items": results})
+	}
+}
+
+func getDocumentEndpoint(c *gin.Context) {
+	doc, version, err := getDocument(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		documentError(c, err, "Failed to get document")
+		return
+	}
+	setETag(c, version)
+	// Caches may keep the document but must revalidate it every time.
+	c.Header("Cache-Control", "no-cache")
+	if notModified(c, version) {
+		return
+	}
+	c.JSON(http.StatusOK, doc)
+}
+
+func updateDocumentEndpoint(c *gin.Context) {
+	version, ok := ifMatch(c)
+	if !ok {
+		return
+	}
+	var req DocumentRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	if details := req.validate(); len(details) > 0 {
+		validationFailed(c, details...)
+		return
+	}
+	ctx := c.Request.Context()
+	doc, current, err := getDocument(ctx, c.Param("id"))
+	if err != nil {
+		documentError(c, err, "Failed to update document")
+		return
+	}
+	if version == anyVersion {
+		version = current
+	}
+	doc.Title = req.Title
+	doc.Content = req.Content
+	doc.Language = req.Language
+	doc.Location = req.Location
+	doc.Metadata = req.Metadata
+	doc.Tags = normalizeTags(req.Tags)
+	doc.UpdatedAt = time.Now().UTC()
+	cfg := currentConfig()
+	index, err := documentIndex(ctx, cfg.Elasticsearch, doc.ID, cfg.Elasticsearch.WriteAlias)
+	if err != nil {
+		documentError(c, err, "Failed to update document")
+		return
+	}
+	var res elastic.IndexResponse
+	err = conditionalWrite(ctx, http.MethodPut, documentPath(index, cfg.Elasticsearch.Type, doc.ID), version, newIndexedDocument(*doc), &res)
+	if err != nil {
+		documentError(c, err, "Failed to update document")
+		return
+	}
+	invalidateSearchCache()
+	notifyAlerts(*doc)
+	setETag(c, newDocVersion(res.Version, res.SeqNo, res.PrimaryTerm))
+	c.JSON(http.StatusOK, doc)
+}
+
+// patchScript replaces the given fields, leaving documents in the trash
+// alone.
+const patchScript = `if (ctx._source.deleted == true) { ctx.op = 'noop' } else { ctx._source.putAll(params.fields) }`
+
+// patchDocumentEndpoint applies a partial update in Elasticsearch, so only
+// the given fields are sent instead of reindexing the whole source.
+func patchDocumentEndpoint(c *gin.Context) {
+	version, ok := ifMatch(c)
+	if !ok {
+		return
+	}
+	var patch DocumentPatch
+	if !bindJSON(c, &patch) {
+		return
+	}
+	if details := validateRequest(patch); len(details) > 0 {
+		validationFailed(c, details...)
+		return
+	}
+	fields := make(map[string]interface{})
+	if patch.Title != nil {
+		fields["title"] = *patch.Title
+		fields["suggest"] = []string{*patch.Title}
+	}
+	if patch.Content != nil {
+		fields["content"] = *patch.Content
+	}
+	if patch.Language != nil {
+		if !supportedLanguage(*patch.Language) {
+			validationFailed(c, ErrorDetail{Field: "language", Message: "Unsupported language " + *patch.Language})
+			return
+		}
+		fields["language"] = *patch.Language
+	}
+	if patch.Location != nil {
+		if !patch.Location.valid() {
+			validationFailed(c, ErrorDetail{Field: "location", Message: "Location out of range"})
+			return
+		}
+		fields["location"] = patch.Location
+	}
+	if patch.Metadata != nil {
+		if msg := validMetadata(patch.Metadata); msg != "" {
+			validationFailed(c, ErrorDetail{Field: "metadata", Message: msg})
+			return
+		}
+		fields["metadata"] = patch.Metadata
+		fields["metadata_fields"] = metadataFields(patch.Metadata)
+	}
+	if patch.Tags != nil {
+		if msg := validTags(patch.Tags); msg != "" {
+			validationFailed(c, ErrorDetail{Field: "tags", Message: msg})
+			return
+		}
+		fields["tags"] = normalizeTags(patch.Tags)
+	}
+	if len(fields) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Nothing to update")
+		return
+	}
+	fields["updated_at"] = time.Now().UTC()
+
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.WriteAlias)
+	if err != nil {
+		documentError(c, err, "Failed to update document")
+		return
+	}
+	// A partial document would be deep-merged, keeping metadata keys the
+	// patch left out; the script replaces each given field whole.
+	body, err := scriptUpdate(elastic.NewScript(patchScript).Param("fields", fields))
+	if err != nil {
+		documentError(c, err, "Failed to update document")
+		return
+	}
+	var res elastic.UpdateResponse
+	err = conditionalWrite(ctx, http.MethodPost, documentPath(index, cfg.Elasticsearch.Type, id)+"/_update", version, body, &res)
+	if err != nil {
+		documentError(c, err, "Failed to update document")
+		return
+	}
+	if res.Result == "noop" {
+		errorResponse(c, http.StatusNotFound, "Document not found")
+		return
+	}
+	invalidateSearchCache()
+	doc, current, err := getDocument(ctx, id)
+	if err != nil {
+		documentError(c, err, "Failed to get document")
+		return
+	}
+	notifyAlerts(*doc)
+	setETag(c, current)
+	c.JSON(http.StatusOK, doc)
+}
+
+// deleteDocumentEndpoint moves a document into the trash.
+func deleteDocumentEndpoint(c *gin.Context) {
+	version, ok := ifMatch(c)
+	if !ok {
+		return
+	}
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.WriteAlias)
+	if err != nil {
+		documentError(c, err, "Failed to delete document")
+		return
+	}
+	body, err := scriptUpdate(newTrashScript())
+	if err != nil {
+		documentError(c, err, "Failed to delete document")
+		return
+	}
+	var res elastic.UpdateResponse
+	err = conditionalWrite(ctx, http.MethodPost, documentPath(index, cfg.Elasticsearch.Type, id)+"/_update", version, body, &res)
+	if err != nil {
+		documentError(c, err, "Failed to delete document")
+		return
+	}
+	if res.Result == "noop" {
+		errorResponse(c, http.StatusNotFound, "Document not found")
+		return
+	}
+	invalidateSearchCache()
+	c.Status(http.StatusNoContent)
+}
+
+// deleteByQueryEndpoint moves into the trash every document matching a
+// match query on the given field, or on title and content when no field is
+// given. It refuses to delete more than the configured cap; with dry_run it
+// only reports how many documents would be deleted.
+func deleteByQueryEndpoint(c *gin.Context) {
+	type request struct {
+		Query  string `json:"query" validate:"required,utf8"`
+		Field  string `json:"field" validate:"utf8"`
+		DryRun bool   `json:"dry_run"`
+	}
+	var req request
+	if !bindJSON(c, &req) {
+		return
+	}
+	if details := validateRequest(req); len(details) > 0 {
+		validationFailed(c, details...)
+		return
+	}
+	var query elastic.Query
+	if req.Field != "" {
+		query = elastic.NewMatchQuery(req.Field, req.Query)
+	} else {
+		query = elastic.NewMultiMatchQuery(req.Query, "title", "content")
+	}
+
+	query = elastic.NewBoolQuery().Must(query).Filter(notDeleted())
+
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	count, err := elasticClient().Count(cfg.Elasticsearch.ReadAlias).
+		Type(cfg.Elasticsearch.Type).
+		Query(query).
+		Do(ctx)
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to count documents")
+		return
+	}
+	if req.DryRun {
+		c.JSON(http.StatusOK, gin.H{"count": count, "dry_run": true})
+		return
+	}
+	max := cfg.Documents.DeleteByQueryMaxDocs
+	if count > int64(max) {
+		errorResponse(c, http.StatusBadRequest,
+			fmt.Sprintf("Query matches %d documents, more than the limit of %d", count, max))
+		return
+	}
+	res, err := elasticClient().UpdateByQuery(writeTarget(cfg)).
+		Type(cfg.Elasticsearch.Type).
+		Query(query).
+		Script(newTrashScript()).
+		Size(max).
+		ProceedOnVersionConflict().
+		Do(ctx)
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to delete documents")
+		return
+	}
+	invalidateSearchCache()
+	c.JSON(http.StatusOK, gin.H{"deleted": res.Updated})
+}
+
+// countDocumentsEndpoint returns how many documents match the query on
+// title and content, or all documents when no query is given. The search
+// filters are supported as well.
+func countDocumentsEndpoint(c *gin.Context) {
+	filters, err := parseFilters(c)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	var query elastic.Query = elastic.NewMatchAllQuery()
+	if q := c.Query("query"); q != "" {
+		query = elastic.NewMultiMatchQuery(q, "title", "content")
+	}
+	cfg := currentConfig()
+	count, err := elasticClient().Count(cfg.Elasticsearch.ReadAlias).
+		Type(cfg.Elasticsearch.Type).
+		Query(elastic.NewBoolQuery().Must(query).Filter(filters...)).
+		Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to count documents")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"count": count})
+}
+
+// getDocument fetches a document by ID along with its revision. A missing
+// document, or one in the trash, is reported as an error satisfying elastic.IsNotFound.
+func getDocument(ctx context.Context, id string) (*Document, docVersion, error) {
+	cfg := currentConfig()
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.ReadAlias)
+	if err != nil {
+		return nil, docVersion{}, err
+	}
+	// The client's GetResult leaves out _seq_no and _primary_term.
+	resp, err := elasticClient().PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodGet,
+		Path:   documentPath(index, cfg.Elasticsearch.Type, id),
+	})
+	if err != nil {
+		return nil, docVersion{}, err
+	}
+	var result struct {
+		Source      json.RawMessage `json:"_source"`
+		Version     int64           `json:"_version"`
+		SeqNo       int64           `json:"_seq_no"`
+		PrimaryTerm int64           `json:"_primary_term"`
+	}
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, docVersion{}, err
+	}
+	var doc Document
+	if err := json.Unmarshal(result.Source, &doc); err != nil {
+		return nil, docVersion{}, err
+	}
+	if doc.Deleted {
+		return nil, docVersion{}, &elastic.Error{Status: http.StatusNotFound}
+	}
+	return &doc, newDocVersion(result.Version, result.SeqNo, result.PrimaryTerm), nil
+}
+
+// documentError responds to a failed Elasticsearch call on a single
+// document: 404 when it does not exist, 409 when it changed since the
+// version the client sent, 504 when the request timed out and 500 with msg
+// otherwise.
+func documentError(c *gin.Context, err error, msg string) {
+	if elastic.IsNotFound(err) {
+		errorResponse(c, http.StatusNotFound, "Document not found")
+		return
+	}
+	if elastic.IsConflict(err) {
+		errorResponse(c, http.StatusConflict, "Document was modified since it was read")
+		return
+	}
+	if writesBlocked(err) {
+		c.Header("Retry-After", "1")
+		errorResponse(c, http.StatusServiceUnavailable, "Documents are being reindexed, retry shortly")
+		return
+	}
+	log.Println(err)
+	if timedOut(c) || elasticUnavailable(c, err) {
+		return
+	}
+	errorResponse(c, http.StatusInternalServerError, msg)
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/couchbase/go-couchbase"
+	memcached "github.com/couchbase/gomemcached/client"
+	"github.com/gin-gonic/gin"
+)
+
+// go-couchbase predates synchronous durability, so writes asking for it are
+// observed after the fact as the SDKs did before Couchbase 6.5: "majority"
+// waits until a majority of the copies of the key, the active one
+// included, hold the write; "persist" additionally waits until the active
+// copy is on disk, like the SDK's majorityAndPersistActive level.
+const (
+	durabilityMajority = "majority"
+	durabilityPersist  = "persist"
+)
+
+var (
+	errDurabilityImpossible = errors.New("not enough replicas for durability")
+	errDurabilityTimeout    = errors.New("durability not reached in time")
+)
+
+// parseDurability reads the durability query parameter, responding with
+// 400 and returning false when it is invalid.
+func parseDurability(c *gin.Context) (string, bool) {
+	switch level := c.Query("durability"); level {
+	case "", "none":
+		return "", true
+	case durabilityMajority, durabilityPersist:
+		return level, true
+	default:
+		errorResponse(c, http.StatusBadRequest, "Invalid durability "+level)
+		return "", false
+	}
+}
+
+// awaitDurability waits until the write of key that returned cas reaches
+// level, at most couchbase.durability_timeout.
+func awaitDurability(bucket *couchbase.Bucket, cc config.CouchbaseConfig, key string, cas uint64, level string) error {
+	if level == "" {
+		return nil
+	}
+	deadline := time.Now().Add(cc.DurabilityTimeout)
+	if err := awaitReplicas(bucket, cc, key, cas, deadline); err != nil {
+		return err
+	}
+	if level != durabilityPersist {
+		return nil
+	}
+	return pollDurability(deadline, func() (bool, error) {
+		res, err := bucket.Observe(key)
+		if err != nil {
+			return false, err
+		}
+		persisted, overwritten := res.CheckPersistence(cas, false)
+		if overwritten {
+			return false, couchbase.ErrOverwritten
+		}
+		return persisted, nil
+	})
+}
+
+// awaitReplicas waits until enough replicas of key hold cas for a majority
+// of its copies to have it. Replicas are asked directly, since the bucket
+// only connects to the active copy of a key.
+func awaitReplicas(bucket *couchbase.Bucket, cc config.CouchbaseConfig, key string, cas uint64, deadline time.Time) error {
+	vbm := bucket.VBServerMap()
+	vb := uint16(bucket.VBHash(key))
+	if int(vb) >= len(vbm.VBucketMap) {
+		return errDurabilityImpossible
+	}
+	copies := vbm.VBucketMap[vb]
+	// A majority of the copies, less the active one that acknowledged the
+	// write.
+	needed := len(copies) / 2
+	if needed == 0 {
+		return nil
+	}
+	var replicas []*memcached.Client
+	defer func() {
+		for _, mc := range replicas {
+			mc.Close()
+		}
+	}()
+	for _, i := range copies[1:] {
+		if i < 0 || i >= len(vbm.ServerList) {
+			continue
+		}
+		mc, err := dialReplica(vbm.ServerList[i], bucket.Name, cc, deadline)
+		if err != nil {
+			log.Printf("cannot observe couchbase replica %s: %v", vbm.ServerList[i], err)
+			continue
+		}
+		replicas = append(replicas, mc)
+	}
+	if len(replicas) < needed {
+		return errDurabilityImpossible
+	}
+	return pollDurability(deadline, func() (bool, error) {
+		n := 0
+		for _, mc := range replicas {
+			res, err := mc.Observe(vb, key)
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				return false, errDurabilityTimeout
+			}
+			if err != nil {
+				return false, err
+			}
+			if res.Cas == cas && (res.Status == memcached.ObservedNotPersisted || res.Status == memcached.ObservedPersisted) {
+				n++
+			}
+		}
+		return n >= needed, nil
+	})
+}
+
+// dialReplica connects to the data service at addr as the user the bucket
+// is opened with. The connection is unusable after deadline. Over TLS, addr
+// is a tunnel of the connection's gateway.
+func dialReplica(addr, bucket string, cc config.CouchbaseConfig, deadline time.Time) (*memcached.Client, error) {
+	conn, err := net.DialTimeout("tcp", addr, time.Until(deadline))
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(deadline)
+	mc, err := memcached.Wrap(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	user, pass := cc.Username, cc.Password
+	if bucket == cc.Bucket && cc.BucketUsername != "" {
+		user, pass = cc.BucketUsername, cc.BucketPassword
+	}
+	if user == "" && !couchbaseCertAuth(cc) {
+		return mc, nil
+	}
+	if user != "" {
+		if _, err := mc.Auth(user, pass); err != nil {
+			mc.Close()
+			return nil, err
+		}
+	}
+	// Bucket users are bound to their bucket, RBAC users and certificates
+	// select it.
+	if user != bucket {
+		if _, err := mc.SelectBucket(bucket); err != nil {
+			mc.Close()
+			return nil, err
+		}
+	}
+	return mc, nil
+}
+
+// pollDurability calls done with growing pauses until it reports true,
+// fails or the deadline passes.
+func pollDurability(deadline time.Time, done func() (bool, error)) error {
+	delay := 5 * time.Millisecond
+	for {
+		ok, err := done()
+		if err != nil || ok {
+			return err
+		}
+		if time.Now().Add(delay).After(deadline) {
+			return errDurabilityTimeout
+		}
+		time.Sleep(delay)
+		if delay < 200*time.Millisecond {
+			delay += delay / 2
+		}
+	}
+}
+
+// durabilityError maps a write that did not become durable to a status and
+// message. The write itself was done, so clients should read the key again
+// before retrying.
+func durabilityError(err error) (int, string) {
+	switch err {
+	case errDurabilityImpossible:
+		return http.StatusServiceUnavailable, "Not enough replicas for durability"
+	case errDurabilityTimeout:
+		return http.StatusGatewayTimeout, "Durability not reached in time"
+	case couchbase.ErrOverwritten:
+		return http.StatusConflict, "Key was modified before the write was durable"
+	}
+	log.Println(err)
+	return http.StatusInternalServerError, "Failed to check durability"
+}
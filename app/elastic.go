@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// elasticHolder owns the shared Elasticsearch client. Handlers read it
+// through Client, which is safe while a reconnect or a config reload swaps
+// in a new client; dials are serialised so two of them cannot race.
+type elasticHolder struct {
+	client atomic.Value // *elastic.Client
+	compat atomic.Value // *compatTransport of client
+	mu     sync.Mutex
+}
+
+var esHolder elasticHolder
+
+// elasticPingTimeout bounds a single health probe.
+const elasticPingTimeout = 5 * time.Second
+
+// elasticClient returns the current Elasticsearch client, or nil while no
+// connection has succeeded yet. Handlers should call it once per request.
+func elasticClient() *elastic.Client {
+	return esHolder.Client()
+}
+
+func (h *elasticHolder) Client() *elastic.Client {
+	client, _ := h.client.Load().(*elastic.Client)
+	return client
+}
+
+// Dial connects a new client for ec and swaps it in. On failure the
+// previous client is kept.
+func (h *elasticHolder) Dial(ec config.ElasticsearchConfig) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	client, compat, err := newElasticClient(ec)
+	if err != nil {
+		return err
+	}
+	if prev := h.Client(); prev != nil {
+		prev.Stop()
+	}
+	h.compat.Store(compat)
+	h.client.Store(client)
+	return nil
+}
+
+// seqNoConcurrency reports whether the cluster conditions writes on
+// sequence numbers rather than versions, as Elasticsearch 7 does.
+func (h *elasticHolder) seqNoConcurrency() bool {
+	compat, _ := h.compat.Load().(*compatTransport)
+	return compat != nil && compat.seqNoConcurrency()
+}
+
+// healthy pings the configured URL with the current client.
+func (h *elasticHolder) healthy(ec config.ElasticsearchConfig) bool {
+	client := h.Client()
+	if client == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), elasticPingTimeout)
+	defer cancel()
+	_, code, err := client.Ping(ec.URL).Do(ctx)
+	return err == nil && code < 300
+}
+
+// Run probes the cluster every health check interval and re-dials when the
+// probe fails, backing off exponentially from the retry interval up to the
+// maximum retry interval while the cluster stays unreachable. It blocks
+// until stop is closed.
+func (h *elasticHolder) Run(stop <-chan struct{}, current func() config.ElasticsearchConfig) {
+	var backoff time.Duration
+	wait := current().RetryInterval
+	for {
+		select {
+		case <-stop:
+			return
+		case <-time.After(wait):
+		}
+		ec := current()
+		wait = ec.HealthCheckInterval
+		if h.healthy(ec) {
+			backoff = 0
+			continue
+		}
+		if err := h.Dial(ec); err != nil {
+			if backoff == 0 {
+				backoff = ec.RetryInterval
+			} else if backoff *= 2; backoff > ec.MaxRetryInterval {
+				backoff = ec.MaxRetryInterval
+			}
+			log.Printf("cannot connect to elasticsearch, retrying in %s: %v", backoff, err)
+			wait = backoff
+			continue
+		}
+		backoff = 0
+		log.Println("connected to elasticsearch")
+	}
+}
+
+// requireElasticsearch answers 503 while no Elasticsearch connection has
+// been established, instead of letting handlers use a nil client.
+func requireElasticsearch(c *gin.Context) {
+	if elasticClient() == nil {
+		errorCodeResponse(c, http.StatusServiceUnavailable, codeESUnavailable, "Search backend unavailable")
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
+// elasticUnavailable reports whether err means no Elasticsearch node could
+// be reached and, if so, responds with 503.
+func elasticUnavailable(c *gin.Context, err error) bool {
+	if !elastic.IsConnErr(err) {
+		return false
+	}
+	errorCodeResponse(c, http.StatusServiceUnavailable, codeESUnavailable, "Search backend unavailable")
+	return true
+}
+
+// elasticHTTPClient returns the HTTP client carrying the API key and TLS
+// settings of ec. Requests pass through compat last.
+func elasticHTTPClient(ec config.ElasticsearchConfig, compat *compatTransport) (*http.Client, error) {
+	var rt http.RoundTripper = http.DefaultTransport
+	if ec.TLS != (config.TLSConfig{}) {
+		tlsConfig, err := newTLSConfig(ec.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		rt = transport
+	}
+	if ec.APIKey != "" {
+		rt = apiKeyTransport{key: ec.APIKey, next: rt}
+	}
+	compat.next = rt
+	return &http.Client{Transport: compat}, nil
+}
+
+func newTLSConfig(tc config.TLSConfig) (*tls.Config, error) {
+	out := &tls.Config{InsecureSkipVerify: tc.InsecureSkipVerify}
+	if tc.CAFile != "" {
+		pem, err := ioutil.ReadFile(tc.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", tc.CAFile)
+		}
+		out.RootCAs = pool
+	}
+	if tc.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(tc.CertFile, tc.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		out.Certificates = []tls.Certificate{cert}
+	}
+	return out, nil
+}
+
+// apiKeyTransport authenticates every request with an Elasticsearch API
+// key, which the client has no option for.
+type apiKeyTransport struct {
+	key  string
+	next http.RoundTripper
+}
+
+func (t apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "ApiKey "+t.key)
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/teris-io/shortid"
+)
+
+// Error codes name what went wrong independently of the message, so
+// clients can branch on them. Most follow from the status; the codes below
+// are more specific, e.g. telling which backend is down.
+const (
+	codeESUnavailable        = "ES_UNAVAILABLE"
+	codeCouchbaseUnavailable = "COUCHBASE_UNAVAILABLE"
+	codeRolledBack           = "ROLLED_BACK"
+	codeRollbackFailed       = "ROLLBACK_FAILED"
+)
+
+// statusCodes maps statuses to the code of errors answered with them
+// unless a more specific one is given.
+var statusCodes = map[int]string{
+	http.StatusBadRequest:            "VALIDATION_FAILED",
+	http.StatusForbidden:             "FORBIDDEN",
+	http.StatusNotFound:              "NOT_FOUND",
+	http.StatusConflict:              "CONFLICT",
+	http.StatusRequestEntityTooLarge: "PAYLOAD_TOO_LARGE",
+	http.StatusUnprocessableEntity:   "VALIDATION_FAILED",
+	http.StatusPreconditionRequired:  "PRECONDITION_REQUIRED",
+	http.StatusTooManyRequests:       "RATE_LIMITED",
+	http.StatusInternalServerError:   "INTERNAL",
+	http.StatusNotImplemented:        "NOT_IMPLEMENTED",
+	http.StatusBadGateway:            "BACKEND_ERROR",
+	http.StatusServiceUnavailable:    "UNAVAILABLE",
+	http.StatusGatewayTimeout:        "TIMEOUT",
+}
+
+// ErrorResponse is the body of every error answer.
+type ErrorResponse struct {
+	Code      string        `json:"code" doc:"Machine-readable error code, e.g. NOT_FOUND, VALIDATION_FAILED or ES_UNAVAILABLE"`
+	Message   string        `json:"message"`
+	Details   []ErrorDetail `json:"details"`
+	RequestID string        `json:"request_id" doc:"X-Request-ID of the request, for finding it in the logs"`
+}
+
+// ErrorDetail qualifies an error, e.g. naming the request field that
+// failed validation or the key a Couchbase write failed at.
+type ErrorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+}
+
+// errorResponse answers with the error message and the code of status.
+func errorResponse(c *gin.Context, status int, msg string) {
+	errorCodeResponse(c, status, statusCode(status), msg)
+}
+
+// errorCodeResponse answers with the error message and a specific code.
+func errorCodeResponse(c *gin.Context, status int, code, msg string, details ...ErrorDetail) {
+	if details == nil {
+		details = []ErrorDetail{}
+	}
+	c.JSON(status, ErrorResponse{
+		Code:      code,
+		Message:   msg,
+		Details:   details,
+		RequestID: c.GetString(requestIDKey),
+	})
+}
+
+// statusCode returns the code of errors answered with status, derived from
+// the status text for statuses without one.
+func statusCode(status int) string {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	return strings.ToUpper(strings.Replace(http.StatusText(status), " ", "_", -1))
+}
+
+const (
+	requestIDHeader = "X-Request-ID"
+	requestIDKey    = "request_id"
+)
+
+// requestID identifies the request by the X-Request-ID it came with, e.g.
+// from the ingress, or a new one, and returns it in the response header.
+func requestID(c *gin.Context) {
+	id := c.GetHeader(requestIDHeader)
+	if id == "" || len(id) > 128 {
+		id = shortid.MustGenerate()
+	}
+	c.Set(requestIDKey, id)
+	c.Header(requestIDHeader, id)
+	c.Next()
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// scrollDocuments calls fn for every document matching query, paging
+// through the index with the scroll API so exports are not limited by the
+// 10,000 hit window of from/size.
+func scrollDocuments(ctx context.Context, query elastic.Query, fn func(*Document) error) error {
+	cfg := currentConfig()
+	scroll := elasticClient().Scroll(cfg.Elasticsearch.ReadAlias).
+		Query(query).
+		Size(500).
+		KeepAlive("1m")
+	defer scroll.Clear(context.Background())
+	for {
+		result, err := scroll.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, hit := range result.Hits.Hits {
+			var doc Document
+			if err := json.Unmarshal(*hit.Source, &doc); err != nil {
+				return err
+			}
+			if err := fn(&doc); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// exportDocumentsEndpoint streams every document, or those matching the
+// optional query, as newline-delimited JSON. Documents in the trash are
+// included only with include_deleted=true.
+func exportDocumentsEndpoint(c *gin.Context) {
+	var query elastic.Query = elastic.NewMatchAllQuery()
+	if q := c.Query("query"); q != "" {
+		query = elastic.NewMultiMatchQuery(q, "title", "content")
+	}
+	if c.Query("include_deleted") != "true" {
+		query = elastic.NewBoolQuery().Must(query).Filter(notDeleted())
+	}
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+	enc := json.NewEncoder(c.Writer)
+	err := scrollDocuments(c.Request.Context(), query, func(doc *Document) error {
+		return enc.Encode(doc)
+	})
+	if err != nil {
+		// The status line is already sent; all that is left is to cut the
+		// stream short.
+		log.Println(err)
+	}
+}
@@ -0,0 +1,85 @@
+// Package features evaluates feature flags. Defaults come from the
+// configuration and can be overridden per environment through a Redis hash
+// whose fields are flag names and whose values are "true" or "false".
+package features
+
+import (
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/go-redis/redis"
+)
+
+// Flags answers whether a feature is enabled. It is safe for concurrent use.
+type Flags struct {
+	current func() config.FeaturesConfig
+	client  redis.UniversalClient
+
+	mu        sync.RWMutex
+	overrides map[string]bool
+}
+
+// New returns Flags reading defaults from current. client may be nil, in
+// which case Redis overrides are disabled.
+func New(current func() config.FeaturesConfig, client redis.UniversalClient) *Flags {
+	return &Flags{
+		current:   current,
+		client:    client,
+		overrides: make(map[string]bool),
+	}
+}
+
+// Enabled reports whether the named feature is enabled. A Redis override
+// wins over the configured value; unknown flags are disabled.
+func (f *Flags) Enabled(name string) bool {
+	f.mu.RLock()
+	v, ok := f.overrides[name]
+	f.mu.RUnlock()
+	if ok {
+		return v
+	}
+	return f.current().Flags[name]
+}
+
+// Refresh reloads the overrides from Redis.
+func (f *Flags) Refresh() error {
+	key := f.current().RedisKey
+	if f.client == nil || key == "" {
+		return nil
+	}
+	values, err := f.client.HGetAll(key).Result()
+	if err != nil {
+		return err
+	}
+	overrides := make(map[string]bool, len(values))
+	for name, raw := range values {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			log.Printf("features: ignoring override %s=%q: %v", name, raw, err)
+			continue
+		}
+		overrides[name] = v
+	}
+	f.mu.Lock()
+	f.overrides = overrides
+	f.mu.Unlock()
+	return nil
+}
+
+// Run refreshes the overrides at the configured interval until stop is
+// closed. On error the previous overrides stay in effect.
+func (f *Flags) Run(stop <-chan struct{}) {
+	for {
+		if err := f.Refresh(); err != nil {
+			log.Printf("features: cannot refresh overrides: %v", err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(f.current().RefreshInterval):
+		}
+	}
+}
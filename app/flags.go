@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+)
+
+// options holds the command-line flags. Flags that are set explicitly take
+// precedence over the config file and the environment.
+type options struct {
+	configPath   string
+	profile      string
+	addr         string
+	port         string
+	mode         string
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+
+	set map[string]bool
+}
+
+func parseFlags() *options {
+	o := &options{set: make(map[string]bool)}
+	flag.StringVar(&o.configPath, "config", "", "path to the YAML configuration file")
+	flag.StringVar(&o.profile, "profile", os.Getenv("APP_PROFILE"), "bundled defaults to start from: dev, staging or prod")
+	flag.StringVar(&o.addr, "addr", "", "address to bind the HTTP server to")
+	flag.StringVar(&o.port, "port", "", "port to listen on")
+	flag.StringVar(&o.mode, "mode", "", "gin mode: debug, release or test")
+	flag.DurationVar(&o.readTimeout, "read-timeout", 0, "HTTP server read timeout")
+	flag.DurationVar(&o.writeTimeout, "write-timeout", 0, "HTTP server write timeout")
+	flag.Parse()
+	flag.Visit(func(f *flag.Flag) {
+		o.set[f.Name] = true
+	})
+	return o
+}
+
+// apply overrides cfg with the flags given on the command line.
+func (o *options) apply(cfg *config.Config) {
+	if o.set["addr"] {
+		cfg.Server.Addr = o.addr
+	}
+	if o.set["port"] {
+		cfg.Server.Port = o.port
+	}
+	if o.set["mode"] {
+		cfg.Server.Mode = o.mode
+	}
+	if o.set["read-timeout"] {
+		cfg.Server.ReadTimeout = o.readTimeout
+	}
+	if o.set["write-timeout"] {
+		cfg.Server.WriteTimeout = o.writeTimeout
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Search backends, selected by search.backend or the backend parameter.
+const (
+	backendElasticsearch = "elasticsearch"
+	backendCouchbase     = "couchbase"
+)
+
+// ftsUnsupported are the search parameters the Couchbase backend cannot
+// honor; searches passing them are rejected rather than answered wrongly.
+var ftsUnsupported = []string{"fields", "cursor", "lang", "meta", "near", "filter", "minimum_should_match"}
+
+// ftsSortFields maps the fields accepted in the sort parameter to the
+// fields sorted on in the full-text index.
+var ftsSortFields = map[string]string{
+	"score":      "_score",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+// searchBackend returns the backend named by the backend parameter, or the
+// configured one.
+func searchBackend(c *gin.Context) string {
+	return c.DefaultQuery("backend", currentConfig().Search.Backend)
+}
+
+// requireSearchBackend answers 400 for unknown backends and 503 while the
+// selected one is unavailable.
+func requireSearchBackend(c *gin.Context) {
+	switch backend := searchBackend(c); backend {
+	case backendElasticsearch:
+		requireElasticsearch(c)
+	case backendCouchbase:
+		if !featureFlags.Enabled("enable_couchbase") || !cbHolder.Connected() {
+			errorCodeResponse(c, http.StatusServiceUnavailable, codeCouchbaseUnavailable, "Search backend unavailable")
+			c.Abort()
+			return
+		}
+		c.Next()
+	default:
+		errorResponse(c, http.StatusBadRequest, "Unknown search backend "+backend)
+		c.Abort()
+	}
+}
+
+// ftsSearchEndpoint answers a search from the Couchbase full-text index
+// couchbase.fts_index, for deployments keeping documents in Couchbase
+// only. It takes the query, paging, sort, tags, created range,
+// include_deleted, operator, fuzziness and highlight parameters of the
+// Elasticsearch search; facets and spelling suggestions are not offered.
+func ftsSearchEndpoint(c *gin.Context) {
+	query := c.Query("query")
+	if query == "" {
+		errorResponse(c, http.StatusBadRequest, "Query not specified")
+		return
+	}
+	for _, name := range ftsUnsupported {
+		if c.Query(name) != "" {
+			errorResponse(c, http.StatusBadRequest, name+" is not supported by the couchbase search backend")
+			return
+		}
+	}
+	if c.Query("facets") == "true" {
+		errorResponse(c, http.StatusBadRequest, "facets is not supported by the couchbase search backend")
+		return
+	}
+	cfg := currentConfig()
+	skip, take := pageRange(c, cfg.Search)
+	sort, err := parseFTSSort(c.Query("sort"))
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	fuzziness, err := parseFuzziness(c.Query("fuzziness"), cfg.Search)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	operator := c.DefaultQuery("operator", "or")
+	if operator != "or" && operator != "and" {
+		errorResponse(c, http.StatusBadRequest, "Operator must be and or or")
+		return
+	}
+	filters, err := ftsFilters(c)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	boosts := searchBoosts.Effective(cfg.Search)
+	var matches []interface{}
+	for _, f := range boostableFields {
+		m := map[string]interface{}{
+			"match":    query,
+			"field":    f,
+			"operator": operator,
+		}
+		// FTS takes edit distances only; AUTO allows one edit.
+		if featureFlags.Enabled("enable_fuzzy_search") && fuzziness != "0" {
+			n, err := strconv.Atoi(fuzziness)
+			if err != nil {
+				n = 1
+			}
+			m["fuzziness"] = n
+		}
+		if b, ok := boosts[f]; ok && b != 1 {
+			m["boost"] = b
+		}
+		matches = append(matches, m)
+	}
+	q := map[string]interface{}{
+		"must": map[string]interface{}{"conjuncts": append([]interface{}{
+			map[string]interface{}{"disjuncts": matches},
+		}, filters...)},
+	}
+	if c.Query("include_deleted") != "true" {
+		q["must_not"] = map[string]interface{}{"disjuncts": []interface{}{
+			map[string]interface{}{"field": "deleted", "bool": true},
+		}}
+	}
+	body := map[string]interface{}{
+		"query":  q,
+		"from":   skip,
+		"size":   take,
+		"fields": []string{"*"},
+	}
+	if len(sort) > 0 {
+		body["sort"] = sort
+	}
+	if c.Query("highlight") == "true" {
+		body["highlight"] = map[string]interface{}{"style": "html", "fields": boostableFields}
+	}
+	if deadline, ok := c.Request.Context().Deadline(); ok {
+		body["ctl"] = map[string]interface{}{"timeout": int64(time.Until(deadline) / time.Millisecond)}
+	}
+	result, err := runFTS(c.Request.Context(), cfg.Couchbase, body)
+	if err != nil {
+		if timedOut(c) {
+			return
+		}
+		if e, ok := err.(ftsQueryError); ok {
+			errorResponse(c, http.StatusBadRequest, "Invalid query: "+string(e))
+			return
+		}
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Something went wrong")
+		return
+	}
+	took := result.Took / int64(time.Millisecond)
+	res := SearchResponse{
+		Time:  fmt.Sprintf("%d", took),
+		Hits:  fmt.Sprintf("%d", result.TotalHits),
+		Total: result.TotalHits,
+		took:  took,
+	}
+	res.Documents = make([]DocumentResponse, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		res.Documents = append(res.Documents, ftsDocument(hit))
+	}
+	setPageLinks(c, skip, take, res.Total)
+	writeSearchResponse(c, res, skip, take)
+}
+
+// ftsFilters builds the conjuncts narrowing a search by tags and
+// created_at, like parseFilters does for Elasticsearch.
+func ftsFilters(c *gin.Context) ([]interface{}, error) {
+	var filters []interface{}
+	after, before := c.Query("created_after"), c.Query("created_before")
+	if after != "" || before != "" {
+		created := map[string]interface{}{"field": "created_at"}
+		if after != "" {
+			t, err := parseDate(after)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid created_after %q", after)
+			}
+			created["start"] = t.Format(time.RFC3339)
+			created["inclusive_start"] = true
+		}
+		if before != "" {
+			t, err := parseDate(before)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid created_before %q", before)
+			}
+			created["end"] = t.Format(time.RFC3339)
+			created["inclusive_end"] = false
+		}
+		filters = append(filters, created)
+	}
+	for _, t := range c.QueryArray("tags") {
+		for _, tag := range normalizeTags(strings.Split(t, ",")) {
+			filters = append(filters, map[string]interface{}{"term": tag, "field": "tags"})
+		}
+	}
+	return filters, nil
+}
+
+// parseFTSSort parses a sort parameter as parseSort does, into the sort
+// strings of a full-text query, "-" marking descending order.
+func parseFTSSort(param string) ([]string, error) {
+	if param == "" {
+		return nil, nil
+	}
+	var sort []string
+	for _, part := range strings.Split(param, ",") {
+		name, order := part, ""
+		if i := strings.Index(part, ":"); i >= 0 {
+			name, order = part[:i], part[i+1:]
+		}
+		if order != "" && order != "asc" && order != "desc" {
+			return nil, fmt.Errorf("Invalid sort order %q for %s", order, name)
+		}
+		field, ok := ftsSortFields[name]
+		if !ok {
+			return nil, fmt.Errorf("Cannot sort by %q", name)
+		}
+		if order == "desc" || (name == "score" && order == "") {
+			field = "-" + field
+		}
+		sort = append(sort, field)
+	}
+	return sort, nil
+}
+
+// ftsResult is the part of a full-text query response the search uses.
+// Took is in nanoseconds.
+type ftsResult struct {
+	TotalHits int64 `json:"total_hits"`
+	Took      int64 `json:"took"`
+	Hits      []ftsHit
+}
+
+type ftsHit struct {
+	ID        string                 `json:"id"`
+	Fields    map[string]interface{} `json:"fields"`
+	Fragments map[string][]string    `json:"fragments"`
+}
+
+// ftsQueryError is the reason the search service rejected a query.
+type ftsQueryError string
+
+func (e ftsQueryError) Error() string { return string(e) }
+
+// runFTS runs a query against the index couchbase.fts_index on a search
+// node.
+func runFTS(ctx context.Context, cc config.CouchbaseConfig, body map[string]interface{}) (*ftsResult, error) {
+	base, err := cbHolder.ServiceURL("fts")
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, base+"/api/index/"+url.PathEscape(cc.FTSIndex)+"/query", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if user, pass := couchbaseCredentials(cc); user != "" {
+		req.SetBasicAuth(user, pass)
+	}
+	resp, err := cbHolder.HTTPClient().Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var res struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&res)
+		if resp.StatusCode == http.StatusBadRequest && res.Error != "" {
+			return nil, ftsQueryError(res.Error)
+		}
+		return nil, fmt.Errorf("couchbase search answered %d: %s", resp.StatusCode, res.Error)
+	}
+	var result ftsResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ftsDocument converts a hit to a document from its stored fields. Arrays
+// of one value come back as the bare value, and geo points as [lon, lat].
+func ftsDocument(hit ftsHit) DocumentResponse {
+	doc := DocumentResponse{ID: hit.ID, Highlights: hit.Fragments}
+	doc.Title, _ = hit.Fields["title"].(string)
+	doc.Content, _ = hit.Fields["content"].(string)
+	doc.Language, _ = hit.Fields["language"].(string)
+	if s, ok := hit.Fields["created_at"].(string); ok {
+		doc.CreatedAt, _ = time.Parse(time.RFC3339, s)
+	}
+	if s, ok := hit.Fields["updated_at"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			doc.UpdatedAt = &t
+		}
+	}
+	switch tags := hit.Fields["tags"].(type) {
+	case string:
+		doc.Tags = []string{tags}
+	case []interface{}:
+		for _, t := range tags {
+			if s, ok := t.(string); ok {
+				doc.Tags = append(doc.Tags, s)
+			}
+		}
+	}
+	if p, ok := hit.Fields["location"].([]interface{}); ok && len(p) == 2 {
+		lon, _ := p[0].(float64)
+		lat, _ := p[1].(float64)
+		doc.Location = &GeoPoint{Lat: lat, Lon: lon}
+	}
+	return doc
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// Geo sets keep points in Redis for radius lookups too hot for a search
+// request. GEOSEARCH needs Redis 6.2 and the vendored client predates it,
+// so it is sent raw, falling back to GEORADIUS on older servers.
+
+// geoMaxLat is the highest latitude Redis can store.
+const geoMaxLat = 85.05112878
+
+// geoRadiusPattern matches radii like "500m" or "2.5km" in the units Redis
+// knows.
+var geoRadiusPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)(m|km|ft|mi)$`)
+
+// geoAddScript adds the lon/lat/member triples in ARGV[3:] unless the geo
+// set would then have more than ARGV[1] members. It returns the new size,
+// or -1 when over the cap.
+var geoAddScript = redis.NewScript(`
+local added = 0
+for i = 3, #ARGV, 3 do
+	if not redis.call('ZSCORE', KEYS[1], ARGV[i + 2]) then added = added + 1 end
+end
+local size = redis.call('ZCARD', KEYS[1]) + added
+if size > tonumber(ARGV[1]) then return -1 end
+for i = 3, #ARGV, 3 do
+	redis.call('GEOADD', KEYS[1], ARGV[i], ARGV[i + 1], ARGV[i + 2])
+end
+if tonumber(ARGV[2]) > 0 then redis.call('PEXPIRE', KEYS[1], ARGV[2]) end
+return size
+`)
+
+// geoStorable reports whether Redis accepts p; it cannot index the poles.
+func geoStorable(p GeoPoint) bool {
+	return p.valid() && p.Lat >= -geoMaxLat && p.Lat <= geoMaxLat
+}
+
+// geoMember is a point of a geo set. Distance is from the searched point,
+// in the unit of the radius.
+type geoMember struct {
+	Member   string   `json:"member"`
+	Location GeoPoint `json:"location"`
+	Distance float64  `json:"distance"`
+}
+
+// addGeo adds points to a geo set, moving members already in it, e.g.
+// {"points": [{"member": "a", "location": {"lat": 52.5, "lon": 13.4}}],
+// "ttl": "10m"}.
+func (h *kvHandlers) addGeo(c *gin.Context) {
+	type point struct {
+		Member   string    `json:"member"`
+		Location *GeoPoint `json:"location"`
+	}
+	type request struct {
+		Points []point `json:"points"`
+		TTL    string  `json:"ttl"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Points) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Points not specified")
+		return
+	}
+	values := make([]string, 0, 3*len(req.Points))
+	for _, p := range req.Points {
+		if p.Member == "" {
+			errorResponse(c, http.StatusBadRequest, "Member not specified")
+			return
+		}
+		if p.Location == nil || !geoStorable(*p.Location) {
+			errorResponse(c, http.StatusBadRequest, "Invalid location")
+			return
+		}
+		values = append(values,
+			strconv.FormatFloat(p.Location.Lon, 'f', -1, 64),
+			strconv.FormatFloat(p.Location.Lat, 'f', -1, 64),
+			p.Member)
+	}
+	h.write(c, geoAddScript, req.TTL, values, len(req.Points), "Too many members")
+}
+
+// searchGeo returns the members within radius of lat/lon, nearest first,
+// e.g. ?lat=52.5&lon=13.4&radius=2km&take=10.
+func (h *kvHandlers) searchGeo(c *gin.Context) {
+	lat, errLat := strconv.ParseFloat(c.Query("lat"), 64)
+	lon, errLon := strconv.ParseFloat(c.Query("lon"), 64)
+	if errLat != nil || errLon != nil || !geoStorable(GeoPoint{Lat: lat, Lon: lon}) {
+		errorResponse(c, http.StatusBadRequest, "Invalid location")
+		return
+	}
+	m := geoRadiusPattern.FindStringSubmatch(c.Query("radius"))
+	if m == nil {
+		errorResponse(c, http.StatusBadRequest, "Invalid radius")
+		return
+	}
+	sc := currentConfig().Search
+	take := sc.DefaultPageSize
+	if i, err := strconv.Atoi(c.Query("take")); err == nil && i > 0 {
+		take = i
+	}
+	if take > sc.MaxPageSize {
+		take = sc.MaxPageSize
+	}
+	key := c.Param("key")
+	reply, err := h.geoSearch(kvPrefix+key, lon, lat, m[1], m[2], take)
+	if err != nil {
+		kvError(c, err, "Failed to get from redis")
+		return
+	}
+	members, ok := parseGeoReply(reply)
+	if !ok {
+		errorResponse(c, http.StatusInternalServerError, "Failed to get from redis")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"key": key, "members": members})
+}
+
+func (h *kvHandlers) geoSearch(key string, lon, lat float64, radius, unit string, take int) (interface{}, error) {
+	cmd := redis.NewCmd("geosearch", key, "fromlonlat", lon, lat, "byradius", radius, unit,
+		"asc", "count", take, "withcoord", "withdist")
+	h.client.Process(cmd)
+	reply, err := cmd.Result()
+	if err != nil && strings.Contains(strings.ToLower(err.Error()), "unknown command") {
+		cmd = redis.NewCmd("georadius", key, lon, lat, radius, unit,
+			"withcoord", "withdist", "count", take, "asc")
+		h.client.Process(cmd)
+		reply, err = cmd.Result()
+	}
+	return reply, err
+}
+
+// parseGeoReply reads the [member, distance, [lon, lat]] items of a search
+// with WITHDIST and WITHCOORD.
+func parseGeoReply(reply interface{}) ([]geoMember, bool) {
+	items, ok := reply.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	members := make([]geoMember, 0, len(items))
+	for _, item := range items {
+		parts, ok := item.([]interface{})
+		if !ok || len(parts) != 3 {
+			return nil, false
+		}
+		coord, ok := parts[2].([]interface{})
+		if !ok || len(coord) != 2 {
+			return nil, false
+		}
+		member, _ := parts[0].(string)
+		dist, ok1 := replyFloat(parts[1])
+		lon, ok2 := replyFloat(coord[0])
+		lat, ok3 := replyFloat(coord[1])
+		if !ok1 || !ok2 || !ok3 {
+			return nil, false
+		}
+		m := geoMember{Member: member, Location: GeoPoint{Lat: lat, Lon: lon}, Distance: dist}
+		members = append(members, m)
+	}
+	return members, true
+}
+
+// removeGeo removes one member from a geo set.
+func (h *kvHandlers) removeGeo(c *gin.Context) {
+	n, err := h.client.ZRem(kvPrefix+c.Param("key"), c.Param("member")).Result()
+	if err != nil {
+		kvError(c, err, "Failed to delete from redis")
+		return
+	}
+	if n == 0 {
+		errorResponse(c, http.StatusNotFound, "Member not found")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// replyFloat reads a float sent as a bulk string.
+func replyFloat(v interface{}) (float64, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	return f, err == nil
+}
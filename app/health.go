@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/couchbase/go-couchbase"
+	"github.com/couchbase/gomemcached"
+	memcached "github.com/couchbase/gomemcached/client"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// readinessTimeout bounds the probes of one readiness check.
+const readinessTimeout = 2 * time.Second
+
+// readiness reports whether the backends requests depend on are
+// reachable, so Kubernetes takes the pod out of the Service while they are
+// not instead of letting it answer with errors.
+type readiness struct {
+	client redis.UniversalClient
+}
+
+func newReadiness(client redis.UniversalClient) *readiness {
+	return &readiness{client: client}
+}
+
+// endpoint probes Redis, Elasticsearch and, while enabled, Couchbase with
+// every bucket opened so far, answering 200 when all are reachable and 503
+// otherwise, e.g.
+// {"status": "unavailable", "checks": {"redis": "ok", "couchbase": "ok",
+// "couchbase/default": "ok", "elasticsearch": "unreachable"}}.
+func (rd *readiness) endpoint(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+	checks := make(map[string]string)
+	var mu sync.Mutex
+	report := func(name string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			checks[name] = err.Error()
+			return
+		}
+		checks[name] = "ok"
+	}
+	var wg sync.WaitGroup
+	probe := func(f func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+		}()
+	}
+	probe(func() {
+		report("redis", probeWithin(ctx, func() error {
+			return rd.client.Ping().Err()
+		}))
+	})
+	probe(func() {
+		report("elasticsearch", elasticReady(ctx))
+	})
+	if featureFlags.Enabled("enable_couchbase") {
+		probe(func() {
+			buckets, err := cbHolder.Ping(ctx)
+			report("couchbase", err)
+			for name, err := range buckets {
+				report("couchbase/"+name, err)
+			}
+		})
+	}
+	wg.Wait()
+	for _, v := range checks {
+		if v != "ok" {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unavailable", "checks": checks})
+			return
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ready", "checks": checks})
+}
+
+type readinessError string
+
+func (e readinessError) Error() string { return string(e) }
+
+func elasticReady(ctx context.Context) error {
+	client := elasticClient()
+	if client == nil {
+		return readinessError("not connected")
+	}
+	_, code, err := client.Ping(currentConfig().Elasticsearch.URL).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if code >= 300 {
+		return readinessError("unhealthy")
+	}
+	return nil
+}
+
+// Ping sends a no-op to every open bucket, returning the outcome per
+// bucket name.
+func (h *couchbaseHolder) Ping(ctx context.Context) (map[string]error, error) {
+	conn, err := h.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.release()
+	conn.mu.Lock()
+	buckets := make(map[string]*couchbase.Bucket, len(conn.buckets))
+	for name, bucket := range conn.buckets {
+		buckets[name] = bucket
+	}
+	conn.mu.Unlock()
+	results := make(map[string]error, len(buckets))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, bucket := range buckets {
+		wg.Add(1)
+		go func(name string, bucket *couchbase.Bucket) {
+			defer wg.Done()
+			err := pingBucket(ctx, bucket)
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+		}(name, bucket)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// pingBucket sends a no-op through a connection of the bucket.
+func pingBucket(ctx context.Context, bucket *couchbase.Bucket) error {
+	return probeWithin(ctx, func() error {
+		return bucket.Do("", func(mc *memcached.Client, vb uint16) error {
+			_, err := mc.Send(&gomemcached.MCRequest{Opcode: gomemcached.NOOP})
+			return err
+		})
+	})
+}
+
+// probeWithin runs probe, failing once ctx is done for clients without
+// deadlines of their own; the probe is then left to finish on its own.
+func probeWithin(ctx context.Context, probe func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- probe()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Imports read newline-delimited JSON, one document shaped like a create
+// request per line, and index it in bulk requests while the body is still
+// arriving. Reading stops while documents.bulk_workers batches are in
+// flight, so memory is bounded by the batch size and line length whatever
+// the size of the import. Bad lines are reported and skipped rather than
+// failing the import.
+
+// ImportReport is the outcome of an import. Errors lists the lines that
+// were not indexed in order, up to documents.import_max_errors of them.
+type ImportReport struct {
+	Lines     int           `json:"lines" doc:"Documents read, not counting blank lines"`
+	Indexed   int           `json:"indexed"`
+	Failed    int           `json:"failed"`
+	Errors    []ImportError `json:"errors"`
+	Truncated bool          `json:"errors_truncated" doc:"More lines failed than are listed in errors"`
+}
+
+// ImportError reports a line that was not indexed: 400 when it is not
+// JSON, 413 when it is too long, 422 naming the invalid fields, or the
+// status Elasticsearch rejected the document with.
+type ImportError struct {
+	Line    int           `json:"line"`
+	ID      string        `json:"id,omitempty"`
+	Status  int           `json:"status"`
+	Message string        `json:"message"`
+	Details []ErrorDetail `json:"details,omitempty"`
+}
+
+// documentImport collects the report of an import as batches finish.
+type documentImport struct {
+	maxErrors int
+
+	mu     sync.Mutex
+	report ImportReport
+}
+
+func (imp *documentImport) fail(e ImportError) {
+	imp.mu.Lock()
+	defer imp.mu.Unlock()
+	imp.report.Failed++
+	if len(imp.report.Errors) < imp.maxErrors {
+		imp.report.Errors = append(imp.report.Errors, e)
+	} else {
+		imp.report.Truncated = true
+	}
+}
+
+// indexed records the results of a batch of docs read from lines.
+func (imp *documentImport) indexed(docs []Document, lines []int, results []BulkItemResult) {
+	for i, r := range results {
+		if bulkItemOK(r) {
+			imp.mu.Lock()
+			imp.report.Indexed++
+			imp.mu.Unlock()
+			continue
+		}
+		imp.fail(ImportError{Line: lines[i], ID: r.ID, Status: r.Status, Message: r.Error})
+	}
+	notifyAlerts(indexedDocuments(docs, results)...)
+}
+
+// importDocumentsEndpoint indexes the documents in an NDJSON body. It
+// answers 200 when every line was indexed and 207 with the failed lines
+// otherwise.
+func importDocumentsEndpoint(c *gin.Context) {
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	imp := &documentImport{
+		maxErrors: cfg.Documents.ImportMaxErrors,
+		report:    ImportReport{Errors: []ImportError{}},
+	}
+	slots := make(chan struct{}, cfg.Documents.BulkWorkers)
+	var wg sync.WaitGroup
+	var docs []Document
+	var lines []int
+	flush := func() {
+		if len(docs) == 0 {
+			return
+		}
+		batch, batchLines := docs, lines
+		docs, lines = nil, nil
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			results := make([]BulkItemResult, len(batch))
+			flushBatch(ctx, elasticClient(), cfg.Elasticsearch, batch, results)
+			imp.indexed(batch, batchLines, results)
+		}()
+	}
+
+	body := bufio.NewReaderSize(c.Request.Body, 64<<10)
+	n := 0
+	var readErr error
+	for {
+		line, err := readLine(body, cfg.Documents.ImportMaxLineSize)
+		if err == io.EOF {
+			break
+		}
+		n++
+		if err == errLineTooLong {
+			imp.report.Lines++
+			imp.fail(ImportError{
+				Line:    n,
+				Status:  http.StatusRequestEntityTooLarge,
+				Message: fmt.Sprintf("Line longer than %d bytes", cfg.Documents.ImportMaxLineSize),
+			})
+			continue
+		}
+		if err != nil {
+			readErr = err
+			break
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		imp.report.Lines++
+		var req DocumentRequest
+		if err := json.Unmarshal(line, &req); err != nil {
+			if detail, ok := typeErrorDetail(err); ok {
+				imp.fail(ImportError{Line: n, Status: http.StatusUnprocessableEntity, Message: "Document validation failed", Details: []ErrorDetail{detail}})
+			} else {
+				imp.fail(ImportError{Line: n, Status: http.StatusBadRequest, Message: "Malformed JSON: " + err.Error()})
+			}
+			continue
+		}
+		if details := req.validate(); len(details) > 0 {
+			imp.fail(ImportError{Line: n, Status: http.StatusUnprocessableEntity, Message: "Document validation failed", Details: details})
+			continue
+		}
+		docs = append(docs, req.document())
+		lines = append(lines, n)
+		if len(docs) >= cfg.Documents.BulkBatchSize {
+			flush()
+		}
+	}
+	if readErr == nil {
+		flush()
+	}
+	wg.Wait()
+	if imp.report.Indexed > 0 {
+		invalidateSearchCache()
+	}
+	if readErr != nil {
+		log.Println(readErr)
+		errorResponse(c, http.StatusBadRequest, fmt.Sprintf("Failed to read the body after line %d; earlier lines may have been indexed", n-1))
+		return
+	}
+	report := imp.report
+	sort.Slice(report.Errors, func(i, j int) bool {
+		return report.Errors[i].Line < report.Errors[j].Line
+	})
+	status := http.StatusOK
+	if report.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, report)
+}
+
+var errLineTooLong = errors.New("line too long")
+
+// readLine reads a line without its line ending. A line longer than max
+// bytes is read to its end and dropped, returning errLineTooLong, so that
+// the next line can still be read.
+func readLine(r *bufio.Reader, max int) ([]byte, error) {
+	var line []byte
+	tooLong := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if !tooLong {
+			line = append(line, chunk...)
+			// Leave room for a \r\n line ending.
+			if len(line) > max+2 {
+				line, tooLong = nil, true
+			}
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF && (len(line) > 0 || tooLong) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		break
+	}
+	line = bytes.TrimRight(line, "\r\n")
+	if tooLong || len(line) > max {
+		return nil, errLineTooLong
+	}
+	return line, nil
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/olivere/elastic"
+)
+
+// documentIndexBody returns the settings and mapping the documents index is
+// created with, instead of relying on dynamic mapping. Synonyms are applied
+// at search time by the document_search analyzer.
+func documentIndexBody(ec config.ElasticsearchConfig, synonyms []string) map[string]interface{} {
+	searchFilters := []string{"lowercase", "asciifolding"}
+	filters := map[string]interface{}{}
+	if len(synonyms) > 0 {
+		filters[synonymFilter] = map[string]interface{}{
+			"type":     "synonym_graph",
+			"synonyms": synonyms,
+		}
+		searchFilters = append(searchFilters, synonymFilter)
+	}
+	return map[string]interface{}{
+		"settings": map[string]interface{}{
+			"number_of_shards":   ec.Shards,
+			"number_of_replicas": ec.Replicas,
+			"analysis": map[string]interface{}{
+				"filter": filters,
+				"analyzer": map[string]interface{}{
+					"document_text": map[string]interface{}{
+						"type":      "custom",
+						"tokenizer": "standard",
+						"filter":    []string{"lowercase", "asciifolding"},
+					},
+					"document_search": map[string]interface{}{
+						"type":      "custom",
+						"tokenizer": "standard",
+						"filter":    searchFilters,
+					},
+				},
+			},
+		},
+		"mappings": map[string]interface{}{
+			ec.Type: map[string]interface{}{
+				"properties": documentProperties(),
+			},
+		},
+	}
+}
+
+func documentProperties() map[string]interface{} {
+	return map[string]interface{}{
+		"id": map[string]interface{}{
+			"type": "keyword",
+		},
+		"title": map[string]interface{}{
+			"type":            "text",
+			"analyzer":        "document_text",
+			"search_analyzer": "document_search",
+			"fields": withLanguageFields(map[string]interface{}{
+				"raw": map[string]interface{}{
+					"type":         "keyword",
+					"ignore_above": 256,
+				},
+			}),
+		},
+		"content": map[string]interface{}{
+			"type":            "text",
+			"analyzer":        "document_text",
+			"search_analyzer": "document_search",
+			"fields":          withLanguageFields(map[string]interface{}{}),
+		},
+		"language": map[string]interface{}{
+			"type": "keyword",
+		},
+		"created_at": map[string]interface{}{
+			"type": "date",
+		},
+		"updated_at": map[string]interface{}{
+			"type": "date",
+		},
+		"suggest": map[string]interface{}{
+			"type":     "completion",
+			"analyzer": "simple",
+		},
+		"location": map[string]interface{}{
+			"type": "geo_point",
+		},
+		// metadata is kept in the source only; metadata_fields makes it
+		// searchable without a mapped field per key.
+		"metadata": map[string]interface{}{
+			"type":    "object",
+			"enabled": false,
+		},
+		"metadata_fields": map[string]interface{}{
+			"type": "nested",
+			"properties": map[string]interface{}{
+				"key":   map[string]interface{}{"type": "keyword"},
+				"value": map[string]interface{}{"type": "keyword"},
+			},
+		},
+		"tags": map[string]interface{}{
+			"type": "keyword",
+		},
+		"attachment": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"filename":       map[string]interface{}{"type": "keyword"},
+				"content_type":   map[string]interface{}{"type": "keyword"},
+				"content_length": map[string]interface{}{"type": "long"},
+				"title":          map[string]interface{}{"type": "text"},
+				"author":         map[string]interface{}{"type": "keyword"},
+				"language":       map[string]interface{}{"type": "keyword"},
+				"date":           map[string]interface{}{"type": "date", "ignore_malformed": true},
+			},
+		},
+		"deleted": map[string]interface{}{
+			"type": "boolean",
+		},
+		"deleted_at": map[string]interface{}{
+			"type": "date",
+		},
+	}
+}
+
+// addedProperties are the fields introduced after the first explicit
+// mapping. They are put into existing indices on startup, which is allowed
+// because new fields do not conflict with existing ones.
+func addedProperties() map[string]interface{} {
+	all := documentProperties()
+	return map[string]interface{}{
+		"suggest":         all["suggest"],
+		"language":        all["language"],
+		"location":        all["location"],
+		"metadata":        all["metadata"],
+		"metadata_fields": all["metadata_fields"],
+		"tags":            all["tags"],
+		"attachment":      all["attachment"],
+		"deleted":         all["deleted"],
+		"deleted_at":      all["deleted_at"],
+	}
+}
+
+// ensureIndex makes sure the read and write aliases exist and the index
+// behind them has every field added since it was created. A documents index
+// created before aliases were introduced is adopted; otherwise a new
+// versioned index is created with the explicit mapping, or the first index
+// of the series when rollover is enabled.
+func ensureIndex(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig) error {
+	if ec.Rollover.Enabled {
+		if err := ensureLifecycle(ctx, client, ec); err != nil {
+			return err
+		}
+	}
+	exists, err := client.IndexExists(ec.WriteAlias).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		_, err := client.PutMapping().
+			Index(ec.WriteAlias).
+			Type(ec.Type).
+			BodyJson(map[string]interface{}{"properties": addedProperties()}).
+			Do(ctx)
+		return err
+	}
+	legacy, err := client.IndexExists(ec.Index).Do(ctx)
+	if err != nil {
+		return err
+	}
+	if legacy {
+		_, err := client.PutMapping().
+			Index(ec.Index).
+			Type(ec.Type).
+			BodyJson(map[string]interface{}{"properties": addedProperties()}).
+			Do(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = client.Alias().
+			Add(ec.Index, ec.WriteAlias).
+			Add(ec.Index, ec.ReadAlias).
+			Do(ctx)
+		if err == nil {
+			log.Printf("aliased existing index %s", ec.Index)
+		}
+		return err
+	}
+	name := versionedIndexName(ec.Index)
+	if ec.Rollover.Enabled {
+		name = rolloverIndexName(ec.Index)
+	}
+	body := documentIndexBody(ec, nil)
+	body["aliases"] = map[string]interface{}{
+		ec.WriteAlias: map[string]interface{}{},
+		ec.ReadAlias:  map[string]interface{}{},
+	}
+	if _, err := client.CreateIndex(name).BodyJson(body).Do(ctx); err != nil {
+		return err
+	}
+	log.Printf("created index %s", name)
+	return nil
+}
+
+func versionedIndexName(prefix string) string {
+	return prefix + "-" + time.Now().UTC().Format("20060102150405")
+}
+
+// aliasIndex returns the single index behind alias.
+func aliasIndex(ctx context.Context, client *elastic.Client, alias string) (string, error) {
+	res, err := client.Aliases().Index(alias).Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	indices := res.IndicesByAlias(alias)
+	if len(indices) != 1 {
+		return "", fmt.Errorf("alias %s points to %d indices, want 1", alias, len(indices))
+	}
+	return indices[0], nil
+}
+
+// reindexResult summarises a completed reindex: the documents copied, and
+// those copied again because they changed during the copy.
+type reindexResult struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Created int64  `json:"created"`
+	Changed int64  `json:"changed"`
+	TookMS  int64  `json:"took_ms"`
+}
+
+// reindexMargin widens the catch-up copies of reindex, so that documents
+// stamped by a replica whose clock is behind are not missed.
+const reindexMargin = time.Minute
+
+// reindex copies the documents behind the read alias into a new versioned
+// index created with the current mapping and the given synonyms, and then
+// points both aliases at it.
+//
+// Writes go on to the old index during the copy. The documents they change,
+// told by updated_at or deleted_at, are then copied again: once while writes
+// go on, and once more, quickly, while the old index is blocked for writes.
+// Both aliases then move to the new index together. Copies keep the
+// versions of the old index, so a document is only copied over an older
+// copy of itself. Documents purged from the trash during the copy may
+// survive in the new index until the next purge.
+//
+// If a step fails, the new index is deleted and the old one unblocked,
+// leaving the aliases as they were.
+func reindex(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig, synonyms []string) (_ *reindexResult, err error) {
+	began := time.Now()
+	from, err := aliasIndex(ctx, client, ec.ReadAlias)
+	if err != nil {
+		return nil, err
+	}
+	to := versionedIndexName(ec.Index)
+	if to == from {
+		return nil, fmt.Errorf("index %s already exists, retry in a second", to)
+	}
+	if _, err := client.CreateIndex(to).BodyJson(documentIndexBody(ec, synonyms)).Do(ctx); err != nil {
+		return nil, err
+	}
+	blocked := false
+	defer func() {
+		if err != nil {
+			abortReindex(client, ec, from, to, blocked)
+		}
+	}()
+	copied, err := copyDocuments(ctx, client, from, to, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	caughtUp, err := copyDocuments(ctx, client, from, to, began)
+	if err != nil {
+		return nil, err
+	}
+	if err = blockWrites(ctx, client, from, true); err != nil {
+		return nil, err
+	}
+	blocked = true
+	last, err := copyDocuments(ctx, client, from, to, caughtUp.started)
+	if err != nil {
+		return nil, err
+	}
+	_, err = client.Alias().
+		Remove(from, ec.WriteAlias).
+		Add(to, ec.WriteAlias).
+		Remove(from, ec.ReadAlias).
+		Add(to, ec.ReadAlias).
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The old index is kept so it can be inspected or pointed back to.
+	if err := blockWrites(context.Background(), client, from, false); err != nil {
+		log.Printf("cannot unblock writes to index %s: %v", from, err)
+	}
+	log.Printf("reindexed %s into %s", from, to)
+	return &reindexResult{
+		From:    from,
+		To:      to,
+		Created: copied.created,
+		Changed: caughtUp.created + caughtUp.updated + last.created + last.updated,
+		TookMS:  int64(time.Since(began) / time.Millisecond),
+	}, nil
+}
+
+// copyResult counts the documents a copy wrote, and tells when it
+// started.
+type copyResult struct {
+	created, updated int64
+	started          time.Time
+}
+
+// copyDocuments copies the documents of from changed since the given time,
+// or all of them if it is zero, into to, keeping their versions. Documents
+// to already holds at the same version or a later one are skipped.
+func copyDocuments(ctx context.Context, client *elastic.Client, from, to string, since time.Time) (copyResult, error) {
+	result := copyResult{started: time.Now()}
+	src := elastic.NewReindexSource().Index(from)
+	if !since.IsZero() {
+		t := since.Add(-reindexMargin).UTC()
+		src = src.Query(elastic.NewBoolQuery().
+			Should(
+				elastic.NewRangeQuery("updated_at").Gte(t),
+				elastic.NewRangeQuery("deleted_at").Gte(t),
+			).
+			MinimumNumberShouldMatch(1))
+	}
+	res, err := client.Reindex().
+		Source(src).
+		Destination(elastic.NewReindexDestination().Index(to).VersionType("external")).
+		ProceedOnVersionConflict().
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		return result, err
+	}
+	if len(res.Failures) > 0 {
+		return result, fmt.Errorf("cannot copy %d documents from %s to %s", len(res.Failures), from, to)
+	}
+	result.created, result.updated = res.Created, res.Updated
+	return result, nil
+}
+
+// blockWrites blocks or unblocks writes to index.
+func blockWrites(ctx context.Context, client *elastic.Client, index string, block bool) error {
+	_, err := client.IndexPutSettings(index).
+		BodyJson(map[string]interface{}{"index.blocks.write": block}).
+		Do(ctx)
+	return err
+}
+
+// writesBlocked reports whether err is Elasticsearch refusing a write to an
+// index blocked by blockWrites.
+func writesBlocked(err error) bool {
+	e, ok := err.(*elastic.Error)
+	return ok && e.Status == http.StatusForbidden && e.Details != nil && e.Details.Type == "cluster_block_exception"
+}
+
+// abortReindex undoes a failed reindex from index from to index to. The
+// context of the reindex may be gone, e.g. when its lock was lost, so it
+// uses its own. Index to is kept unless the read alias is known to still
+// point to from: the alias request may have failed after moving them.
+func abortReindex(client *elastic.Client, ec config.ElasticsearchConfig, from, to string, blocked bool) {
+	ctx := context.Background()
+	if blocked {
+		if err := blockWrites(ctx, client, from, false); err != nil {
+			log.Printf("cannot unblock writes to index %s: %v", from, err)
+		}
+	}
+	current, err := aliasIndex(ctx, client, ec.ReadAlias)
+	if err != nil || current != from {
+		log.Printf("reindex of %s failed, keeping index %s: %s points to %q (%v)", from, to, ec.ReadAlias, current, err)
+		return
+	}
+	if _, err := client.DeleteIndex(to).Do(ctx); err != nil {
+		log.Printf("cannot delete index %s: %v", to, err)
+	}
+}
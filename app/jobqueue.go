@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/awesomeProject/homie-search/app/queue"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// jobQueue runs the background jobs: bulk ingest, reindexes and
+// webhook deliveries.
+var jobQueue *queue.Queue
+
+// reindexJobType is the queue job type of reindexes.
+const reindexJobType = "reindex"
+
+func newJobQueue(client redis.UniversalClient) *queue.Queue {
+	qc := currentConfig().Queue
+	q := queue.New(client, qc.Name, queue.Options{
+		Workers:      qc.Workers,
+		MaxAttempts:  qc.MaxAttempts,
+		Backoff:      qc.Backoff,
+		MaxBackoff:   qc.MaxBackoff,
+		Lease:        qc.Lease,
+		PollInterval: qc.PollInterval,
+		DeadLetters:  qc.DeadLetters,
+	})
+	q.Handle(reindexJobType, 0, reindexJob)
+	return q
+}
+
+// reindexJob runs a reindex queued by POST /admin/reindex. It fails, to be
+// retried, while another reindex is running.
+func reindexJob(ctx context.Context, job *queue.Job) error {
+	client := elasticClient()
+	if client == nil {
+		return errors.New("no elasticsearch connection")
+	}
+	var err error
+	ran := runLocked(ctx, "reindex", func(ctx context.Context) {
+		var res *reindexResult
+		if res, err = runReindex(ctx, client); err == nil {
+			log.Printf("reindex job %s: moved %d documents from %s to %s", job.ID, res.Created, res.From, res.To)
+		}
+	})
+	if !ran {
+		return errors.New("another reindex is running")
+	}
+	return err
+}
+
+// adminQueueEndpoint reports the job counts by state and the most recent
+// dead letters, up to dead=N of them.
+func adminQueueEndpoint(c *gin.Context) {
+	n := int64(currentConfig().Search.DefaultPageSize)
+	if i, err := strconv.ParseInt(c.Query("dead"), 10, 64); err == nil && i >= 0 {
+		n = i
+	}
+	stats, err := jobQueue.Stats()
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get queue")
+		return
+	}
+	dead := []queue.Job{}
+	if n > 0 {
+		if dead, err = jobQueue.DeadLetters(n); err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusInternalServerError, "Failed to get queue")
+			return
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"stats": stats, "dead_letters": dead})
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/queue"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+	"github.com/teris-io/shortid"
+)
+
+// Job reports the progress of an asynchronous bulk ingest.
+type Job struct {
+	ID          string           `json:"id"`
+	Status      string           `json:"status"` // queued, running or completed
+	Total       int              `json:"total"`
+	Processed   int              `json:"processed"`
+	Failed      int              `json:"failed"`
+	Failures    []BulkItemResult `json:"failures,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	CompletedAt *time.Time       `json:"completed_at,omitempty"`
+}
+
+// jobStore runs ingest jobs on the job queue and keeps their status in
+// Redis, so any replica can report it, for jobs.retention after its last
+// update.
+type jobStore struct {
+	client redis.UniversalClient
+	queue  *queue.Queue
+
+	// mu serializes updates, which batches of a job finishing together
+	// make concurrently.
+	mu sync.Mutex
+}
+
+// ingestJobType is the queue job type of ingest jobs.
+const ingestJobType = "ingest"
+
+// ingestJob is the queue payload of an ingest job.
+type ingestJob struct {
+	ID   string     `json:"id"`
+	Docs []Document `json:"docs"`
+}
+
+// errTooManyJobs is returned by Submit when jobs.queue_size ingest jobs are
+// already pending.
+var errTooManyJobs = errors.New("too many pending jobs")
+
+var ingestJobs *jobStore
+
+func newJobStore(client redis.UniversalClient, q *queue.Queue) *jobStore {
+	s := &jobStore{client: client, queue: q}
+	q.Handle(ingestJobType, 0, s.process)
+	return s
+}
+
+// Submit queues docs for indexing.
+func (s *jobStore) Submit(docs []Document) (Job, error) {
+	jc := currentConfig().Jobs
+	pending, err := s.queue.Pending(ingestJobType)
+	if err != nil {
+		return Job{}, err
+	}
+	if pending >= int64(jc.QueueSize) {
+		return Job{}, errTooManyJobs
+	}
+	job := Job{
+		ID:        shortid.MustGenerate(),
+		Status:    "queued",
+		Total:     len(docs),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.save(&job); err != nil {
+		return Job{}, err
+	}
+	if _, err := s.queue.Enqueue(ingestJobType, ingestJob{ID: job.ID, Docs: docs}); err != nil {
+		return Job{}, err
+	}
+	return job, nil
+}
+
+// Get returns the status of the job.
+func (s *jobStore) Get(id string) (Job, bool, error) {
+	data, err := s.client.Get(jobKey(id)).Bytes()
+	if err == redis.Nil {
+		return Job{}, false, nil
+	}
+	if err != nil {
+		return Job{}, false, err
+	}
+	var job Job
+	if err := json.Unmarshal(data, &job); err != nil {
+		return Job{}, false, err
+	}
+	return job, true, nil
+}
+
+func (s *jobStore) save(job *Job) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(jobKey(job.ID), data, currentConfig().Jobs.Retention).Err()
+}
+
+// update applies fn to the stored status of the job. Only the replica
+// running a job updates it, so a lock held across the read and write keeps
+// concurrent updates from overwriting each other.
+func (s *jobStore) update(id string, fn func(*Job)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok, err := s.Get(id)
+	if err == nil && ok {
+		fn(&job)
+		err = s.save(&job)
+	}
+	if err != nil {
+		log.Printf("jobs: cannot update job %s: %v", id, err)
+	}
+}
+
+// process indexes the documents of an ingest job. Without an Elasticsearch
+// connection it fails so the queue retries it, until the last attempt,
+// which records every document as failed.
+func (s *jobStore) process(ctx context.Context, qj *queue.Job) error {
+	var j ingestJob
+	if err := qj.Decode(&j); err != nil {
+		return err
+	}
+	client := elasticClient()
+	if client == nil && qj.Attempts+1 < currentConfig().Queue.MaxAttempts {
+		return errors.New("no elasticsearch connection")
+	}
+	s.update(j.ID, func(job *Job) {
+		job.Status = "running"
+		job.Processed, job.Failed, job.Failures = 0, 0, nil
+	})
+	onBatch := func(batch []BulkItemResult) {
+		s.update(j.ID, func(job *Job) {
+			job.Processed += len(batch)
+			for _, r := range batch {
+				if !bulkItemOK(r) {
+					job.Failed++
+					job.Failures = append(job.Failures, r)
+				}
+			}
+		})
+	}
+	var results []BulkItemResult
+	if client != nil {
+		results = indexDocuments(ctx, client, currentConfig(), j.Docs, onBatch)
+	} else {
+		results = make([]BulkItemResult, len(j.Docs))
+		failBatch(results, j.Docs, errors.New("no elasticsearch connection"))
+		onBatch(results)
+	}
+	invalidateSearchCache()
+	notifyAlerts(indexedDocuments(j.Docs, results)...)
+	s.update(j.ID, func(job *Job) {
+		now := time.Now().UTC()
+		job.Status = "completed"
+		job.CompletedAt = &now
+	})
+	return nil
+}
+
+func jobKey(id string) string {
+	return "ingest-job:" + id
+}
+
+func getJobEndpoint(c *gin.Context) {
+	job, ok, err := ingestJobs.Get(c.Param("id"))
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get job")
+		return
+	}
+	if !ok {
+		errorResponse(c, http.StatusNotFound, "Job not found")
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// kvPrefix keeps keys written through /kv apart from the keys the service
+// uses itself.
+const kvPrefix = "kv:"
+
+// kvEntry is a value stored through /kv. TTL is the time left until it
+// expires, omitted for keys without expiry.
+type kvEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	TTL   string `json:"ttl,omitempty"`
+}
+
+// kvHandlers serves a generic key/value API on top of Redis.
+type kvHandlers struct {
+	client redis.UniversalClient
+}
+
+func newKVHandlers(client redis.UniversalClient) *kvHandlers {
+	return &kvHandlers{client: client}
+}
+
+func (h *kvHandlers) get(c *gin.Context) {
+	key := c.Param("key")
+	var get *redis.StringCmd
+	var ttl *redis.DurationCmd
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.Get(kvPrefix + key)
+		ttl = pipe.TTL(kvPrefix + key)
+		return nil
+	})
+	if err == redis.Nil {
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	}
+	if err != nil {
+		kvError(c, err, "Failed to get from redis")
+		return
+	}
+	entry := kvEntry{Key: key, Value: get.Val()}
+	if d := ttl.Val(); d > 0 {
+		entry.TTL = d.String()
+	}
+	c.JSON(http.StatusOK, entry)
+}
+
+// put stores the value, expiring it after ttl when one is given, e.g.
+// {"value": "v", "ttl": "10m"}.
+func (h *kvHandlers) put(c *gin.Context) {
+	type request struct {
+		Value *string `json:"value"`
+		TTL   string  `json:"ttl"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if req.Value == nil {
+		errorResponse(c, http.StatusBadRequest, "Value not specified")
+		return
+	}
+	if len(*req.Value) > currentConfig().KV.MaxValueSize {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Value too large")
+		return
+	}
+	ttl, ok := parseKVTTL(c, req.TTL)
+	if !ok {
+		return
+	}
+	key := c.Param("key")
+	if err := h.client.Set(kvPrefix+key, *req.Value, ttl).Err(); err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to insert in redis")
+		return
+	}
+	entry := kvEntry{Key: key, Value: *req.Value}
+	if ttl > 0 {
+		entry.TTL = ttl.String()
+	}
+	c.JSON(http.StatusOK, entry)
+}
+
+func (h *kvHandlers) delete(c *gin.Context) {
+	n, err := h.client.Del(kvPrefix + c.Param("key")).Result()
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to delete from redis")
+		return
+	}
+	if n == 0 {
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// parseKVTTL parses an optional ttl, responding with 400 and returning
+// false when it is invalid.
+func parseKVTTL(c *gin.Context, s string) (time.Duration, bool) {
+	if s == "" {
+		return 0, true
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		errorResponse(c, http.StatusBadRequest, "Invalid ttl "+s)
+		return 0, false
+	}
+	return d, true
+}
+
+// batchGet returns the values of several keys in one round trip, e.g.
+// {"keys": ["a", "b"]}. Keys without a value are listed as missing. The
+// GETs are pipelined rather than sent as one MGET, so keys on different
+// Redis Cluster nodes work too.
+func (h *kvHandlers) batchGet(c *gin.Context) {
+	type request struct {
+		Keys []string `json:"keys"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Keys) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Keys not specified")
+		return
+	}
+	if len(req.Keys) > currentConfig().KV.MaxBatch {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Too many keys")
+		return
+	}
+	gets := make([]*redis.StringCmd, len(req.Keys))
+	ttls := make([]*redis.DurationCmd, len(req.Keys))
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		for i, key := range req.Keys {
+			gets[i] = pipe.Get(kvPrefix + key)
+			ttls[i] = pipe.TTL(kvPrefix + key)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		kvError(c, err, "Failed to get from redis")
+		return
+	}
+	entries := make([]kvEntry, 0, len(req.Keys))
+	missing := []string{}
+	for i, key := range req.Keys {
+		err := gets[i].Err()
+		if err == redis.Nil {
+			missing = append(missing, key)
+			continue
+		}
+		if err != nil {
+			kvError(c, err, "Failed to get from redis")
+			return
+		}
+		entries = append(entries, kvEntry{Key: key, Value: gets[i].Val(), TTL: formatKVTTL(ttls[i].Val())})
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": entries, "missing": missing})
+}
+
+// batchSet stores several values in one round trip, e.g.
+// {"entries": [{"key": "a", "value": "v", "ttl": "10m"}]}. Nothing is
+// stored unless every entry is valid.
+func (h *kvHandlers) batchSet(c *gin.Context) {
+	type entry struct {
+		Key   string  `json:"key"`
+		Value *string `json:"value"`
+		TTL   string  `json:"ttl"`
+	}
+	type request struct {
+		Entries []entry `json:"entries"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Entries) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Entries not specified")
+		return
+	}
+	kc := currentConfig().KV
+	if len(req.Entries) > kc.MaxBatch {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Too many keys")
+		return
+	}
+	ttls := make([]time.Duration, len(req.Entries))
+	for i, e := range req.Entries {
+		if e.Key == "" {
+			errorResponse(c, http.StatusBadRequest, "Key not specified")
+			return
+		}
+		if e.Value == nil {
+			errorResponse(c, http.StatusBadRequest, "Value not specified for "+e.Key)
+			return
+		}
+		if len(*e.Value) > kc.MaxValueSize {
+			errorResponse(c, http.StatusRequestEntityTooLarge, "Value too large for "+e.Key)
+			return
+		}
+		ttl, ok := parseKVTTL(c, e.TTL)
+		if !ok {
+			return
+		}
+		ttls[i] = ttl
+	}
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		for i, e := range req.Entries {
+			pipe.Set(kvPrefix+e.Key, *e.Value, ttls[i])
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to insert in redis")
+		return
+	}
+	entries := make([]kvEntry, len(req.Entries))
+	for i, e := range req.Entries {
+		entries[i] = kvEntry{Key: e.Key, Value: *e.Value, TTL: formatKVTTL(ttls[i])}
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": entries})
+}
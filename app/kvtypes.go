@@ -0,0 +1,272 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// Hashes, lists, sets and geo sets are stored under the same prefix as /kv
+// values, so a key holds one type at a time and DELETE /kv/:key removes any
+// of them. Writes check the kv.max_items cap in a script, so concurrent
+// writers cannot overshoot it. A ttl sets the expiry of the whole key;
+// writes without one leave it as it was.
+
+// hashSetScript sets the field/value pairs in ARGV[3:] unless the hash
+// would then have more than ARGV[1] fields. It returns the new size, or -1
+// when over the cap.
+var hashSetScript = redis.NewScript(`
+local added = 0
+for i = 3, #ARGV, 2 do
+	if redis.call('HEXISTS', KEYS[1], ARGV[i]) == 0 then added = added + 1 end
+end
+local size = redis.call('HLEN', KEYS[1]) + added
+if size > tonumber(ARGV[1]) then return -1 end
+for i = 3, #ARGV, 2 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+if tonumber(ARGV[2]) > 0 then redis.call('PEXPIRE', KEYS[1], ARGV[2]) end
+return size
+`)
+
+// listPushScript pushes ARGV[3:] to the head of the list and trims it to
+// ARGV[1] entries, dropping the oldest. It returns the new size.
+var listPushScript = redis.NewScript(`
+for i = 3, #ARGV do
+	redis.call('LPUSH', KEYS[1], ARGV[i])
+end
+redis.call('LTRIM', KEYS[1], 0, tonumber(ARGV[1]) - 1)
+if tonumber(ARGV[2]) > 0 then redis.call('PEXPIRE', KEYS[1], ARGV[2]) end
+return redis.call('LLEN', KEYS[1])
+`)
+
+// setAddScript adds the members in ARGV[3:] unless the set would then have
+// more than ARGV[1] members. It returns the new size, or -1 when over the
+// cap.
+var setAddScript = redis.NewScript(`
+local added = 0
+for i = 3, #ARGV do
+	if redis.call('SISMEMBER', KEYS[1], ARGV[i]) == 0 then added = added + 1 end
+end
+local size = redis.call('SCARD', KEYS[1]) + added
+if size > tonumber(ARGV[1]) then return -1 end
+for i = 3, #ARGV do
+	redis.call('SADD', KEYS[1], ARGV[i])
+end
+if tonumber(ARGV[2]) > 0 then redis.call('PEXPIRE', KEYS[1], ARGV[2]) end
+return size
+`)
+
+// kvHash, kvList and kvSet are the hashes, lists and sets stored through
+// the API. TTL is the time left until the key expires, omitted for keys
+// without expiry.
+type kvHash struct {
+	Key    string            `json:"key"`
+	Fields map[string]string `json:"fields"`
+	TTL    string            `json:"ttl,omitempty"`
+}
+
+type kvList struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+	Size   int64    `json:"size"`
+	TTL    string   `json:"ttl,omitempty"`
+}
+
+type kvSet struct {
+	Key     string   `json:"key"`
+	Members []string `json:"members"`
+	TTL     string   `json:"ttl,omitempty"`
+}
+
+func (h *kvHandlers) getHash(c *gin.Context) {
+	key := c.Param("key")
+	var get *redis.StringStringMapCmd
+	var ttl *redis.DurationCmd
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.HGetAll(kvPrefix + key)
+		ttl = pipe.TTL(kvPrefix + key)
+		return nil
+	})
+	if err != nil {
+		kvError(c, err, "Failed to get from redis")
+		return
+	}
+	if len(get.Val()) == 0 {
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	}
+	c.JSON(http.StatusOK, kvHash{Key: key, Fields: get.Val(), TTL: formatKVTTL(ttl.Val())})
+}
+
+// putHash sets fields of a hash, keeping the others, e.g.
+// {"fields": {"f": "v"}, "ttl": "10m"}.
+func (h *kvHandlers) putHash(c *gin.Context) {
+	type request struct {
+		Fields map[string]string `json:"fields"`
+		TTL    string            `json:"ttl"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Fields) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Fields not specified")
+		return
+	}
+	values := make([]string, 0, 2*len(req.Fields))
+	for f, v := range req.Fields {
+		values = append(values, f, v)
+	}
+	h.write(c, hashSetScript, req.TTL, values, len(req.Fields), "Too many fields")
+}
+
+func (h *kvHandlers) getList(c *gin.Context) {
+	key := c.Param("key")
+	start := int64(0)
+	if i, err := strconv.ParseInt(c.Query("skip"), 10, 64); err == nil && i > 0 {
+		start = i
+	}
+	stop := int64(-1)
+	if i, err := strconv.ParseInt(c.Query("take"), 10, 64); err == nil && i > 0 {
+		stop = start + i - 1
+	}
+	var get *redis.StringSliceCmd
+	var size *redis.IntCmd
+	var ttl *redis.DurationCmd
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.LRange(kvPrefix+key, start, stop)
+		size = pipe.LLen(kvPrefix + key)
+		ttl = pipe.TTL(kvPrefix + key)
+		return nil
+	})
+	if err != nil {
+		kvError(c, err, "Failed to get from redis")
+		return
+	}
+	if size.Val() == 0 {
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	}
+	c.JSON(http.StatusOK, kvList{Key: key, Values: get.Val(), Size: size.Val(), TTL: formatKVTTL(ttl.Val())})
+}
+
+// pushList adds values to the head of a list, in order, so the last value
+// given ends up first, e.g. {"values": ["a", "b"], "ttl": "10m"}. Lists
+// over the cap lose their oldest values.
+func (h *kvHandlers) pushList(c *gin.Context) {
+	type request struct {
+		Values []string `json:"values"`
+		TTL    string   `json:"ttl"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Values) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Values not specified")
+		return
+	}
+	h.write(c, listPushScript, req.TTL, req.Values, len(req.Values), "")
+}
+
+func (h *kvHandlers) getSet(c *gin.Context) {
+	key := c.Param("key")
+	var get *redis.StringSliceCmd
+	var ttl *redis.DurationCmd
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.SMembers(kvPrefix + key)
+		ttl = pipe.TTL(kvPrefix + key)
+		return nil
+	})
+	if err != nil {
+		kvError(c, err, "Failed to get from redis")
+		return
+	}
+	if len(get.Val()) == 0 {
+		errorResponse(c, http.StatusNotFound, "Key not found")
+		return
+	}
+	c.JSON(http.StatusOK, kvSet{Key: key, Members: get.Val(), TTL: formatKVTTL(ttl.Val())})
+}
+
+// addSet adds members to a set, e.g. {"members": ["a"], "ttl": "10m"}.
+func (h *kvHandlers) addSet(c *gin.Context) {
+	type request struct {
+		Members []string `json:"members"`
+		TTL     string   `json:"ttl"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Members) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Members not specified")
+		return
+	}
+	h.write(c, setAddScript, req.TTL, req.Members, len(req.Members), "Too many members")
+}
+
+// write checks the values, making up items entries, against the size caps
+// and runs a write script on the key, responding with the new size. overCap
+// is the message for a script reporting the cap exceeded.
+func (h *kvHandlers) write(c *gin.Context, script *redis.Script, ttlParam string, values []string, items int, overCap string) {
+	kc := currentConfig().KV
+	if items > kc.MaxItems {
+		errorResponse(c, http.StatusRequestEntityTooLarge, "Too many values")
+		return
+	}
+	for _, v := range values {
+		if len(v) > kc.MaxValueSize {
+			errorResponse(c, http.StatusRequestEntityTooLarge, "Value too large")
+			return
+		}
+	}
+	ttl, ok := parseKVTTL(c, ttlParam)
+	if !ok {
+		return
+	}
+	args := make([]interface{}, 0, 2+len(values))
+	args = append(args, kc.MaxItems, int64(ttl/time.Millisecond))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	key := c.Param("key")
+	res, err := script.Run(h.client, []string{kvPrefix + key}, args...).Result()
+	if err != nil {
+		kvError(c, err, "Failed to insert in redis")
+		return
+	}
+	size, _ := res.(int64)
+	if size < 0 {
+		errorResponse(c, http.StatusRequestEntityTooLarge, overCap)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"key": key, "size": size})
+}
+
+// kvError responds to a failed Redis command, with 409 when the key holds
+// another type.
+func kvError(c *gin.Context, err error, msg string) {
+	if strings.Contains(err.Error(), "WRONGTYPE") {
+		errorResponse(c, http.StatusConflict, "Key holds a different type")
+		return
+	}
+	log.Println(err)
+	errorResponse(c, http.StatusInternalServerError, msg)
+}
+
+func formatKVTTL(d time.Duration) string {
+	if d > 0 {
+		return d.String()
+	}
+	return ""
+}
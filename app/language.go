@@ -0,0 +1,66 @@
+package main
+
+import "strconv"
+
+// languageAnalyzers maps the language codes documents may carry to the
+// built-in Elasticsearch analyzer used for their stemmed subfields, e.g.
+// title.german.
+var languageAnalyzers = map[string]string{
+	"en": "english",
+	"de": "german",
+	"fr": "french",
+	"es": "spanish",
+	"it": "italian",
+	"nl": "dutch",
+}
+
+// supportedLanguage reports whether lang is empty or has an analyzer.
+func supportedLanguage(lang string) bool {
+	if lang == "" {
+		return true
+	}
+	_, ok := languageAnalyzers[lang]
+	return ok
+}
+
+// withLanguageFields adds a subfield per language analyzer to fields.
+func withLanguageFields(fields map[string]interface{}) map[string]interface{} {
+	for _, analyzer := range languageAnalyzers {
+		fields[analyzer] = map[string]interface{}{
+			"type":     "text",
+			"analyzer": analyzer,
+		}
+	}
+	return fields
+}
+
+// boostableFields are the fields a text query matches, in query order.
+var boostableFields = []string{"title", "content"}
+
+func boostableField(field string) bool {
+	for _, f := range boostableFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// searchFields returns the fields a text query should match for lang,
+// weighted by boosts. The language subfields add stemmed matches on top of
+// the plain fields and share their boost.
+func searchFields(lang string, boosts map[string]float64) []string {
+	analyzer, stemmed := languageAnalyzers[lang]
+	var fields []string
+	for _, f := range boostableFields {
+		suffix := ""
+		if b, ok := boosts[f]; ok && b != 1 {
+			suffix = "^" + strconv.FormatFloat(b, 'g', -1, 64)
+		}
+		fields = append(fields, f+suffix)
+		if stemmed {
+			fields = append(fields, f+"."+analyzer+suffix)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,200 @@
+// Package lock provides locks shared between replicas, held in Redis with
+// SET NX and a TTL. A held lock is renewed in the background, so it only
+// expires when its holder dies or loses Redis. Holders learn that their
+// lock was lost through Lost and must stop the work it guards, since
+// another replica may already have taken it.
+package lock
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// ErrHeld is returned by Acquire when another holder has the lock.
+var ErrHeld = errors.New("lock: held by another owner")
+
+// Every key shares the {locks} hash tag so the set of names and the locks
+// live in one Redis Cluster slot.
+const (
+	keyPrefix = "{locks}:"
+	namesKey  = "{locks}:names"
+)
+
+// releaseScript deletes the lock only if it still holds our value.
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// renewScript extends the lock only if it still holds our value.
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Locker acquires locks on behalf of Owner, typically the host name.
+type Locker struct {
+	client redis.UniversalClient
+	owner  string
+}
+
+// New returns a Locker acquiring locks as owner.
+func New(client redis.UniversalClient, owner string) *Locker {
+	return &Locker{client: client, owner: owner}
+}
+
+// Info describes a held lock.
+type Info struct {
+	Name       string    `json:"name"`
+	Owner      string    `json:"owner"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	TTL        string    `json:"ttl"`
+}
+
+type value struct {
+	Owner      string    `json:"owner"`
+	Token      string    `json:"token"`
+	AcquiredAt time.Time `json:"acquired_at"`
+}
+
+// Lock is a held lock.
+type Lock struct {
+	locker *Locker
+	name   string
+	value  string
+	ttl    time.Duration
+
+	once sync.Once
+	stop chan struct{}
+	lost chan struct{}
+}
+
+// Acquire takes the lock name for ttl and keeps renewing it until Release.
+// It returns ErrHeld when someone else holds it.
+func (l *Locker) Acquire(name string, ttl time.Duration) (*Lock, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(value{
+		Owner:      l.owner,
+		Token:      hex.EncodeToString(token),
+		AcquiredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ok, err := l.client.SetNX(keyPrefix+name, data, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrHeld
+	}
+	if err := l.client.SAdd(namesKey, name).Err(); err != nil {
+		log.Printf("lock: cannot record %s: %v", name, err)
+	}
+	lk := &Lock{locker: l, name: name, value: string(data), ttl: ttl, stop: make(chan struct{}), lost: make(chan struct{})}
+	go lk.renew()
+	return lk, nil
+}
+
+// Holder returns who holds the lock name, or "" when nobody does.
+func (l *Locker) Holder(name string) (string, error) {
+	data, err := l.client.Get(keyPrefix + name).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var v value
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return "", err
+	}
+	return v.Owner, nil
+}
+
+// List returns the locks currently held.
+func (l *Locker) List() ([]Info, error) {
+	names, err := l.client.SMembers(namesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	locks := make([]Info, 0, len(names))
+	for _, name := range names {
+		data, err := l.client.Get(keyPrefix + name).Result()
+		if err == redis.Nil {
+			// Expired or released; forget the name.
+			l.client.SRem(namesKey, name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var v value
+		if err := json.Unmarshal([]byte(data), &v); err != nil {
+			return nil, err
+		}
+		ttl, err := l.client.PTTL(keyPrefix + name).Result()
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, Info{Name: name, Owner: v.Owner, AcquiredAt: v.AcquiredAt, TTL: ttl.String()})
+	}
+	return locks, nil
+}
+
+// Release gives the lock up. Releasing a lock that already expired is not
+// an error.
+func (lk *Lock) Release() error {
+	lk.once.Do(func() { close(lk.stop) })
+	return releaseScript.Run(lk.locker.client, []string{keyPrefix + lk.name}, lk.value).Err()
+}
+
+// Lost returns a channel closed when the lock is lost: someone else holds
+// it, or it could not be renewed before it expired. It is never closed once
+// the lock is released.
+func (lk *Lock) Lost() <-chan struct{} {
+	return lk.lost
+}
+
+func (lk *Lock) renew() {
+	ticker := time.NewTicker(lk.ttl / 3)
+	defer ticker.Stop()
+	renewed := time.Now()
+	for {
+		select {
+		case <-lk.stop:
+			return
+		case <-ticker.C:
+		}
+		n, err := renewScript.Run(lk.locker.client, []string{keyPrefix + lk.name},
+			lk.value, int64(lk.ttl/time.Millisecond)).Result()
+		if err != nil {
+			log.Printf("lock: cannot renew %s: %v", lk.name, err)
+			if time.Since(renewed) < lk.ttl {
+				continue
+			}
+			// The lock expired meanwhile.
+			n = int64(0)
+		}
+		if n != int64(1) {
+			log.Printf("lock: lost %s", lk.name)
+			close(lk.lost)
+			return
+		}
+		renewed = time.Now()
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/lock"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+var locks *lock.Locker
+
+var errLockLost = errors.New("lock lost")
+
+// lockTTL is how long a lock outlives a holder that stopped renewing it.
+const lockTTL = 30 * time.Second
+
+func newLocker(client redis.UniversalClient) *lock.Locker {
+	owner, err := os.Hostname()
+	if err != nil {
+		owner = "unknown"
+	}
+	return lock.New(client, owner)
+}
+
+// withLock runs the request holding the named lock, answering 409 when
+// another replica holds it. The request context is cancelled if the lock
+// is lost.
+func withLock(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lk, err := locks.Acquire(name, lockTTL)
+		if err == lock.ErrHeld {
+			msg := "Another " + name + " is running"
+			if owner, err := locks.Holder(name); err == nil && owner != "" {
+				msg += " on " + owner
+			}
+			errorResponse(c, http.StatusConflict, msg)
+			c.Abort()
+			return
+		}
+		if err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusServiceUnavailable, "Cannot acquire lock "+name)
+			c.Abort()
+			return
+		}
+		defer func() {
+			if err := lk.Release(); err != nil {
+				log.Printf("cannot release lock %s: %v", name, err)
+			}
+		}()
+		ctx, cancel := whileLocked(c.Request.Context(), lk)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// runLocked calls fn holding the named lock and reports whether it ran;
+// fn is skipped when another replica holds the lock. The context passed to
+// fn, derived from ctx, is cancelled if the lock is lost.
+func runLocked(ctx context.Context, name string, fn func(ctx context.Context)) bool {
+	lk, err := locks.Acquire(name, lockTTL)
+	if err == lock.ErrHeld {
+		return false
+	}
+	if err != nil {
+		log.Printf("cannot acquire lock %s: %v", name, err)
+		return false
+	}
+	defer func() {
+		if err := lk.Release(); err != nil {
+			log.Printf("cannot release lock %s: %v", name, err)
+		}
+	}()
+	ctx, cancel := whileLocked(ctx, lk)
+	defer cancel()
+	fn(ctx)
+	return true
+}
+
+// whileLocked returns a context derived from ctx that is cancelled when lk
+// is lost.
+func whileLocked(ctx context.Context, lk *lock.Lock) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-lk.Lost():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+func adminListLocksEndpoint(c *gin.Context) {
+	held, err := locks.List()
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to list locks")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"locks": held})
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// setupLogging switches the standard logger and gin's request log to the
+// configured format and opens the audit log.
+func setupLogging(lc config.LogConfig) error {
+	if lc.Format == "json" {
+		w := &jsonLogWriter{out: os.Stderr}
+		log.SetFlags(0)
+		log.SetOutput(w)
+		gin.DefaultWriter = w
+		gin.DefaultErrorWriter = w
+	}
+	return setupAudit(lc.Audit)
+}
+
+// jsonLogWriter wraps every line written to it in a JSON object so log
+// collectors can parse it without a custom pattern.
+type jsonLogWriter struct {
+	out io.Writer
+}
+
+func (w *jsonLogWriter) Write(p []byte) (int, error) {
+	line, err := json.Marshal(struct {
+		Time    string `json:"time"`
+		Message string `json:"msg"`
+	}{
+		Time:    time.Now().UTC().Format(time.RFC3339Nano),
+		Message: string(bytes.TrimRight(p, "\r\n")),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if _, err := w.out.Write(append(line, '\n')); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
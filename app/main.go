@@ -1,252 +1,287 @@
 package main
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	"log"
+	"net"
 	"net/http"
-	"strconv"
+	"sync/atomic"
 	"time"
 
+	"github.com/awesomeProject/homie-search/app/cache"
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/awesomeProject/homie-search/app/features"
 	"github.com/couchbase/go-couchbase"
 	"github.com/gin-gonic/gin"
-	"github.com/go-redis/redis"
 	"github.com/olivere/elastic"
-	"github.com/teris-io/shortid"
 )
 
-const (
-	elasticIndexName = "documents"
-	elasticTypeName  = "document"
-)
-
-type Document struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"created_at"`
-	Content   string    `json:"content"`
-}
-
 var (
-	elasticClient *elastic.Client
+	currentCfg   atomic.Value // *config.Config
+	featureFlags *features.Flags
 )
 
-type DocumentRequest struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+// currentConfig returns the configuration in effect. Handlers should call it
+// once per request so they see a consistent snapshot across reloads.
+func currentConfig() *config.Config {
+	return currentCfg.Load().(*config.Config)
 }
 
-type DocumentResponse struct {
-	ID        string
-	CreatedAt time.Time
-	Title     string `json:"title"`
-	Content   string `json:"content"`
-}
-
-type SearchResponse struct {
-	Time      string `json:"time"`
-	Hits      string `json:"hit"`
-	Documents []DocumentResponse
-}
+func handler(c *gin.Context) {
+	c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 
-func errorResponse(c *gin.Context, code int, err string) {
-	c.JSON(code, gin.H{
-		"error": err,
-	})
 }
 
-func couchGet(c *gin.Context) {
-	query := c.Query("query")
-	if query == "" {
-		errorResponse(c, http.StatusBadRequest, "Query not specified")
-		return
+func newElasticClient(ec config.ElasticsearchConfig) (*elastic.Client, *compatTransport, error) {
+	options := []elastic.ClientOptionFunc{
+		elastic.SetURL(ec.URL),
+		elastic.SetSniff(ec.Sniff),
 	}
-	cl, err := couchbase.Connect("http://couchbase-master-service:8091")
-	if err != nil {
-		log.Fatalf("Error connecting:  %v", err)
+	if ec.Username != "" {
+		options = append(options, elastic.SetBasicAuth(ec.Username, ec.Password))
 	}
-
-	pool, err := cl.GetPool("default")
+	compat := &compatTransport{}
+	httpClient, err := elasticHTTPClient(ec, compat)
 	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "cannot get pool")
-		return
+		return nil, nil, err
 	}
-
-	bucket, err := pool.GetBucket("default")
+	options = append(options, elastic.SetHttpClient(httpClient))
+	client, err := elastic.NewClient(options...)
 	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "cannot get bucket")
-		return
+		return nil, nil, err
 	}
-	var values interface{}
-	err = bucket.Get(query, &values)
+	version, err := client.ElasticsearchVersion(ec.URL)
 	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "cannot insert into couchbase")
-		return
+		return nil, nil, err
 	}
-	c.JSON(http.StatusOK, values)
-}
-
-func couchInsert(c *gin.Context) {
-	type request struct {
-		Key    string
-		Values []string
+	if err := compat.setVersion(version); err != nil {
+		return nil, nil, err
 	}
-	var postParams request
-	if err := c.BindJSON(&postParams); err != nil {
-		errorResponse(c, http.StatusBadRequest, "Malformed request body")
-		return
+	if err := ensureIndex(context.Background(), client, ec); err != nil {
+		log.Printf("cannot set up index %s: %v", ec.Index, err)
 	}
-	cl, err := couchbase.Connect("http://couchbase-master-service:8091")
-	if err != nil {
-		log.Fatalf("Error connecting:  %v", err)
+	if err := ensureAttachmentPipeline(context.Background(), client, ec); err != nil {
+		log.Printf("cannot set up attachment pipeline %s: %v", ec.AttachmentPipeline, err)
 	}
-
-	pool, err := cl.GetPool("default")
-	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "cannot get pool")
-		return
-	}
-
-	bucket, err := pool.GetBucket("default")
-	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "cannot get bucket")
-		return
+	if err := alertService.EnsureIndex(context.Background(), client); err != nil {
+		log.Printf("cannot set up alerts index: %v", err)
 	}
-
-	err = bucket.Set(postParams.Key, 0, postParams.Values)
-	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "cannot insert into couchbase")
-		return
+	if err := ensureSnapshotRepository(context.Background(), client, currentConfig().Snapshots); err != nil {
+		log.Printf("cannot register snapshot repository: %v", err)
 	}
+	return client, compat, nil
 }
 
-func createDocumentsEndpoint(c *gin.Context) {
-	var docs []DocumentRequest
-	if err := c.BindJSON(&docs); err != nil {
-		errorResponse(c, http.StatusBadRequest, "Malformed request body")
-		return
-	}
-	bulk := elasticClient.
-		Bulk().
-		Index(elasticIndexName).
-		Type(elasticTypeName)
-	for _, d := range docs {
-		doc := Document{
-			ID:        shortid.MustGenerate(),
-			Title:     d.Title,
-			CreatedAt: time.Now().UTC(),
-			Content:   d.Content,
-		}
-		bulk.Add(elastic.NewBulkIndexRequest().Id(doc.ID).Doc(doc))
+func main() {
+	opts := parseFlags()
+	cfg, err := config.Load(opts.profile, opts.configPath)
+	if err != nil {
+		log.Fatal(err)
 	}
-	if _, err := bulk.Do(c.Request.Context()); err != nil {
-		log.Println(err)
-		errorResponse(c, http.StatusInternalServerError, "Failed to create documents")
-		return
+	opts.apply(cfg)
+	if err := cfg.Validate(); err != nil {
+		log.Fatal(err)
 	}
-	c.Status(http.StatusOK)
-}
-
-func handler(c *gin.Context) {
-	c.JSON(http.StatusOK, map[string]string{"status": "ok"})
-
-}
-
-func redisH(c *gin.Context) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     "redis-master:6379",
-		Password: "", // no password set
-		DB:       0,  // use default DB
-	})
-
-	err := client.Set("key", "value", 0).Err()
-	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "Failed to insert in redis")
-		return
+	currentCfg.Store(cfg)
+	if err := setupLogging(cfg.Log); err != nil {
+		log.Fatal(err)
 	}
-
-	val, err := client.Get("key").Result()
-	if err != nil {
-		errorResponse(c, http.StatusInternalServerError, "Failed to get from redis")
-		return
+	if opts.configPath != "" {
+		go config.Watch(opts.profile, opts.configPath, cfg.ReloadInterval, nil, func(next *config.Config) {
+			opts.apply(next)
+			if err := next.Validate(); err != nil {
+				log.Printf("ignoring reloaded configuration: %v", err)
+				return
+			}
+			reloadConfig(next)
+		})
 	}
-	c.JSON(http.StatusOK, map[string]string{"key": val})
-}
+	go config.WatchSecrets(cfg.Secrets.RefreshInterval, nil, currentConfig, reloadConfig)
 
-func searchEndpoint(c *gin.Context) {
-	// Parse request
-	query := c.Query("query")
-	if query == "" {
-		errorResponse(c, http.StatusBadRequest, "Query not specified")
-		return
-	}
-	skip := 0
-	take := 10
-	if i, err := strconv.Atoi(c.Query("skip")); err == nil {
-		skip = i
-	}
-	if i, err := strconv.Atoi(c.Query("take")); err == nil {
-		take = i
-	}
-	esQuery := elastic.NewMultiMatchQuery(query, "title", "content").
-		Fuzziness("2").
-		MinimumShouldMatch("2")
-	result, err := elasticClient.Search().
-		Index(elasticIndexName).
-		Query(esQuery).
-		From(skip).Size(take).
-		Do(c.Request.Context())
+	rdb, err := newRedisClient(cfg.Redis)
 	if err != nil {
-		log.Println(err)
-		errorResponse(c, http.StatusInternalServerError, "Something went wrong")
-		return
+		log.Fatal(err)
 	}
-	res := SearchResponse{
-		Time: fmt.Sprintf("%d", result.TookInMillis),
-		Hits: fmt.Sprintf("%d", result.Hits.TotalHits),
+	go watchRedis(nil, rdb, cfg.Redis.HealthCheckInterval)
+	locks = newLocker(rdb)
+	featureFlags = features.New(func() config.FeaturesConfig {
+		return currentConfig().Features
+	}, rdb)
+	go featureFlags.Run(nil)
+	searchCache = cache.New(rdb, "search", func() time.Duration {
+		return currentConfig().Cache.TTL
+	}, func() int {
+		return currentConfig().Cache.LocalSize
+	})
+	go searchCache.Listen(nil)
+	responseCaches = newRouteCaches(rdb)
+	searchBoosts = newBoostOverrides(rdb)
+	go searchBoosts.Run(nil)
+	jobQueue = newJobQueue(rdb)
+	alertService = newAlertService()
+	go alertService.Run(nil)
+	ingestJobs = newJobStore(rdb, jobQueue)
+	go jobQueue.Run(nil)
+	if cfg.Stream.Enabled {
+		go newStreamConsumer(rdb, cfg.Stream).Run(nil)
 	}
-	docs := make([]DocumentResponse, 0)
-	for _, hit := range result.Hits.Hits {
-		var doc DocumentResponse
-		json.Unmarshal(*hit.Source, &doc)
-		docs = append(docs, doc)
+	if cfg.Mirror.Enabled {
+		go newKeyspaceMirror(rdb, cfg.Mirror, cfg.Redis.DB).Run(nil)
 	}
-	res.Documents = docs
-	c.JSON(http.StatusOK, res)
-}
+	go purgeTrash(nil, cfg.Documents.TrashPurgeInterval)
+	couchTxns = newTxnLog(rdb)
+	go couchTxns.Recover(nil, cfg.Couchbase.TransactionRecovery)
 
-func main() {
-	var err error
-	elasticClient, err = elastic.NewClient(
-		elastic.SetURL("http://elasticsearch:9200"),
-		elastic.SetSniff(false),
-	)
-	if err != nil {
+	if err := esHolder.Dial(cfg.Elasticsearch); err != nil {
 		log.Println(err)
 	}
-	go func() {
-		time.Sleep(3 * time.Second)
-		for {
-			elasticClient, err = elastic.NewClient(
-				elastic.SetURL("http://elasticsearch:9200"),
-				elastic.SetSniff(false),
-			)
-			if err != nil {
-				log.Println(err)
-				time.Sleep(3 * time.Second)
-			} else {
-				break
-			}
+	go esHolder.Run(nil, func() config.ElasticsearchConfig {
+		return currentConfig().Elasticsearch
+	})
+	// Writes report mutation tokens for read-your-own-writes queries.
+	couchbase.EnableMutationToken = true
+	go cbHolder.Run(nil, func() config.CouchbaseConfig {
+		return currentConfig().Couchbase
+	})
+	if fc := cfg.Couchbase.Feed; fc.Enabled {
+		bucket := fc.Bucket
+		if bucket == "" {
+			bucket = cfg.Couchbase.Bucket
 		}
-	}()
+		go newCouchbaseFeed(rdb, fc, bucket).Run(nil)
+	}
+	gin.SetMode(cfg.Server.Mode)
 	r := gin.Default()
-	r.POST("/documents", createDocumentsEndpoint)
-	r.GET("/search", searchEndpoint)
-	r.GET("/redis", redisH)
-	r.POST("/couchbaseInsert", couchInsert)
-	r.GET("/couchbase", couchGet)
+	r.Use(requestID, cors, compress, negotiate)
+	r.NoRoute(func(c *gin.Context) {
+		errorResponse(c, http.StatusNotFound, "Not found")
+	})
+	limiter := newRateLimiter(rdb)
+	v1 := r.Group(apiVersionPrefix)
+	documents := v1.Group("/documents", limiter.Limit("documents"), requireElasticsearch, routeTimeout("documents"))
+	documents.POST("", createDocumentsEndpoint)
+	documents.GET("/:id", getDocumentEndpoint)
+	documents.PUT("/:id", updateDocumentEndpoint)
+	documents.PATCH("/:id", patchDocumentEndpoint)
+	documents.DELETE("/:id", deleteDocumentEndpoint)
+	documents.GET("/:id/related", relatedDocumentsEndpoint)
+	documents.POST("/:id/restore", restoreDocumentEndpoint)
+	documents.POST("/:id/tags", addTagsEndpoint)
+	documents.DELETE("/:id/tags/:tag", removeTagEndpoint)
+	custom := newCustomMethods(r)
+	custom.Handle("POST", "/v1/documents:deleteByQuery", limiter.Limit("documents"), requireElasticsearch, routeTimeout("documents"), deleteByQueryEndpoint)
+	custom.Handle("GET", "/v1/documents:export", limiter.Limit("documents"), requireElasticsearch, exportDocumentsEndpoint)
+	custom.Handle("GET", "/v1/documents:count", limiter.Limit("documents"), requireElasticsearch, routeTimeout("documents"), countDocumentsEndpoint)
+	custom.Alias("GET", "/v1/documents/count", "/v1/documents:count")
+	custom.Handle("GET", "/v1/documents:trash", limiter.Limit("documents"), requireElasticsearch, routeTimeout("documents"), trashEndpoint)
+	custom.Alias("GET", "/v1/documents/trash", "/v1/documents:trash")
+	custom.Handle("POST", "/v1/documents:upload", limiter.Limit("documents"), requireElasticsearch, routeTimeout("documents"), uploadDocumentEndpoint)
+	custom.Alias("POST", "/v1/documents/upload", "/v1/documents:upload")
+	custom.Handle("POST", "/v1/documents:import", limiter.Limit("documents"), requireElasticsearch, importDocumentsEndpoint)
+	custom.Alias("POST", "/v1/documents/import", "/v1/documents:import")
+	analytics := newQueryStats(rdb)
+	sessions := newSessionHandlers(rdb)
+	search := v1.Group("/search", sessions.check, limiter.Limit("search"), routeTimeout("search"))
+	search.GET("", requireSearchBackend, analytics.recordQuery, cacheSearch, searchEndpoint)
+	search.POST("/raw", requireElasticsearch, rawSearchEndpoint)
+	search.GET("/template/:name", requireElasticsearch, templateSearchEndpoint)
+	v1.GET("/suggest", sessions.check, limiter.Limit("search"), requireElasticsearch, routeTimeout("search"), responseCaches.Cache("suggest"), suggestEndpoint)
+	v1.GET("/tags", sessions.check, limiter.Limit("search"), requireElasticsearch, routeTimeout("search"), responseCaches.Cache("tags"), popularTagsEndpoint)
+	v1.GET("/analytics/top-queries", sessions.check, limiter.Limit("search"), responseCaches.Cache("top_queries"), analytics.topQueriesEndpoint)
+	alertRoutes := v1.Group("/alerts", requireFeature("enable_alerts"), limiter.Limit("alerts"), requireElasticsearch, routeTimeout("default"))
+	alertRoutes.POST("", createAlertEndpoint)
+	alertRoutes.GET("", listAlertsEndpoint)
+	alertRoutes.DELETE("/:id", deleteAlertEndpoint)
+	v1.GET("/jobs/:id", getJobEndpoint)
+	if cfg.Stream.Enabled {
+		v1.POST("/ingest/stream", limiter.Limit("ingest"), streamIngestHandler(rdb))
+	}
+	kv := newKVHandlers(rdb)
+	kvRoutes := v1.Group("/kv", limiter.Limit("kv"))
+	kvRoutes.GET("/:key", kv.get)
+	kvRoutes.PUT("/:key", kv.put)
+	kvRoutes.DELETE("/:key", kv.delete)
+	custom.Handle("POST", "/v1/kv:batchGet", limiter.Limit("kv"), kv.batchGet)
+	custom.Handle("POST", "/v1/kv:batchSet", limiter.Limit("kv"), kv.batchSet)
+	hashRoutes := v1.Group("/hashes", limiter.Limit("kv"))
+	hashRoutes.GET("/:key", kv.getHash)
+	hashRoutes.PUT("/:key", kv.putHash)
+	hashRoutes.DELETE("/:key", kv.delete)
+	listRoutes := v1.Group("/lists", limiter.Limit("kv"))
+	listRoutes.GET("/:key", kv.getList)
+	listRoutes.POST("/:key", kv.pushList)
+	listRoutes.DELETE("/:key", kv.delete)
+	setRoutes := v1.Group("/sets", limiter.Limit("kv"))
+	setRoutes.GET("/:key", kv.getSet)
+	setRoutes.POST("/:key", kv.addSet)
+	setRoutes.DELETE("/:key", kv.delete)
+	geoRoutes := v1.Group("/geo", limiter.Limit("kv"))
+	geoRoutes.GET("/:key", kv.searchGeo)
+	geoRoutes.POST("/:key", kv.addGeo)
+	geoRoutes.DELETE("/:key", kv.delete)
+	geoRoutes.DELETE("/:key/:member", kv.removeGeo)
+	sessionRoutes := v1.Group("/sessions", limiter.Limit("sessions"))
+	sessionRoutes.POST("", sessions.create)
+	sessionRoutes.GET("/current", sessions.current)
+	sessionRoutes.DELETE("/current", sessions.delete)
+	v1.POST("/couchbaseInsert", requireFeature("enable_couchbase"), requireCouchbase, requireDefaultCollection, couchInsert)
+	couch := v1.Group("/couchbase", requireFeature("enable_couchbase"), requireCouchbase)
+	couch.GET("", requireDefaultCollection, couchGet)
+	couch.PATCH("/:key", requireDefaultCollection, couchPatchEndpoint)
+	couch.DELETE("/:key", requireDefaultCollection, couchDeleteEndpoint)
+	couch.POST("/query", limiter.Limit("couchbase"), couchQueryEndpoint)
+	couch.GET("/views/:ddoc/:view", requireDefaultCollection, limiter.Limit("couchbase"), couchViewEndpoint)
+	couch.GET("/keys", requireDefaultCollection, limiter.Limit("couchbase"), couchKeysEndpoint)
+	couch.GET("/blob/:key", requireDefaultCollection, verbatim, couchGetBlobEndpoint)
+	couch.PUT("/blob/:key", requireDefaultCollection, verbatim, couchPutBlobEndpoint)
+	custom.Handle("POST", "/v1/couchbase:batchGet", requireFeature("enable_couchbase"), requireCouchbase, requireDefaultCollection, limiter.Limit("couchbase"), couchBatchGetEndpoint)
+	custom.Handle("POST", "/v1/couchbase:batchSet", requireFeature("enable_couchbase"), requireCouchbase, requireDefaultCollection, limiter.Limit("couchbase"), couchBatchSetEndpoint)
+	custom.Handle("POST", "/v1/couchbase:transaction", requireFeature("enable_couchbase"), requireCouchbase, requireDefaultCollection, limiter.Limit("couchbase"), couchTxns.couchTransactionEndpoint)
 	r.GET("/", handler)
-	if err = r.Run(":8080"); err != nil {
+	r.GET("/ready", newReadiness(rdb).endpoint)
+	r.GET("/metrics", metricsEndpoint)
+	admin := r.Group("/admin", sessions.requireAdmin)
+	admin.GET("/config", adminConfigEndpoint)
+	admin.GET("/locks", adminListLocksEndpoint)
+	admin.GET("/queue", adminQueueEndpoint)
+	warmer := &cacheWarmer{handler: r, analytics: analytics}
+	admin.POST("/cache/warm", requireElasticsearch, warmer.adminWarmCacheEndpoint)
+	admin.POST("/reindex", requireElasticsearch, withoutRollover, withLock("reindex"), adminReindexEndpoint)
+	admin.GET("/synonyms", requireElasticsearch, adminGetSynonymsEndpoint)
+	admin.PUT("/synonyms", requireElasticsearch, withoutRollover, withLock("reindex"), adminPutSynonymsEndpoint)
+	admin.GET("/search-templates/:name", requireElasticsearch, adminGetSearchTemplateEndpoint)
+	admin.PUT("/search-templates/:name", requireElasticsearch, adminPutSearchTemplateEndpoint)
+	admin.DELETE("/search-templates/:name", requireElasticsearch, adminDeleteSearchTemplateEndpoint)
+	admin.GET("/boosts", adminGetBoostsEndpoint)
+	admin.PUT("/boosts", adminPutBoostsEndpoint)
+	admin.DELETE("/boosts", adminDeleteBoostsEndpoint)
+	admin.GET("/snapshots", requireElasticsearch, adminListSnapshotsEndpoint)
+	admin.POST("/snapshots", requireElasticsearch, adminCreateSnapshotEndpoint)
+	admin.GET("/snapshots/:name", requireElasticsearch, adminGetSnapshotEndpoint)
+	admin.POST("/snapshots/:name/restore", requireElasticsearch, adminRestoreSnapshotEndpoint)
+	admin.GET("/couchbase/export", requireFeature("enable_couchbase"), requireCouchbase, requireDefaultCollection, adminCouchExportEndpoint)
+	if cfg.Docs.Enabled {
+		docs, err := newAPIDocs(r, custom, cfg.Docs)
+		if err != nil {
+			log.Fatal(err)
+		}
+		r.GET("/openapi.json", docs.specEndpoint)
+		r.GET("/docs", docs.uiEndpoint)
+		for name := range swaggerUIAssets {
+			r.GET("/docs/"+name, docs.assetEndpoint(name))
+		}
+	}
+	srv := &http.Server{
+		Addr:         net.JoinHostPort(cfg.Server.Addr, cfg.Server.Port),
+		Handler:      newLegacyRoutes(r).Wrap(custom.Wrap(r)),
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+	}
+	if cfg.Cache.WarmOnStartup {
+		go warmer.warmOnStartup()
+	}
+	log.Printf("listening on %s", srv.Addr)
+	if err = srv.ListenAndServe(); err != nil {
 		log.Fatal(err)
 	}
 }
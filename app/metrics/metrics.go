@@ -0,0 +1,226 @@
+// Package metrics keeps counters and histograms in process and renders
+// them in the Prometheus text exposition format. Metrics are created once,
+// usually as package variables, and registered for rendering on creation.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DefBuckets are histogram buckets suited to latencies in seconds.
+var DefBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// SizeBuckets are histogram buckets suited to sizes in bytes.
+var SizeBuckets = []float64{256, 1 << 10, 4 << 10, 16 << 10, 64 << 10, 256 << 10, 1 << 20, 4 << 20}
+
+var registry struct {
+	mu      sync.Mutex
+	metrics []metric
+}
+
+type metric interface {
+	name() string
+	write(w io.Writer)
+}
+
+func register(m metric) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	for _, other := range registry.metrics {
+		if other.name() == m.name() {
+			panic("metrics: " + m.name() + " registered twice")
+		}
+	}
+	registry.metrics = append(registry.metrics, m)
+}
+
+// Write renders every registered metric, sorted by name.
+func Write(w io.Writer) {
+	registry.mu.Lock()
+	metrics := append([]metric(nil), registry.metrics...)
+	registry.mu.Unlock()
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].name() < metrics[j].name() })
+	for _, m := range metrics {
+		m.write(w)
+	}
+}
+
+// vec holds the children of a metric by their label values.
+type vec struct {
+	metricName string
+	help       string
+	labels     []string
+
+	mu       sync.Mutex
+	children map[string]interface{}
+}
+
+func (v *vec) name() string { return v.metricName }
+
+func (v *vec) child(values []string, create func() interface{}) interface{} {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s takes %d label values, got %d", v.metricName, len(v.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	c, ok := v.children[key]
+	if !ok {
+		c = create()
+		v.children[key] = c
+	}
+	return c
+}
+
+// each calls fn with the label pairs and child of every child, in a
+// stable order.
+func (v *vec) each(fn func(labels string, child interface{})) {
+	v.mu.Lock()
+	keys := make([]string, 0, len(v.children))
+	for k := range v.children {
+		keys = append(keys, k)
+	}
+	children := make(map[string]interface{}, len(v.children))
+	for k, c := range v.children {
+		children[k] = c
+	}
+	v.mu.Unlock()
+	sort.Strings(keys)
+	for _, k := range keys {
+		var pairs []string
+		if len(v.labels) > 0 {
+			for i, value := range strings.Split(k, "\xff") {
+				pairs = append(pairs, v.labels[i]+"="+strconv.Quote(value))
+			}
+		}
+		fn(strings.Join(pairs, ","), children[k])
+	}
+}
+
+func (v *vec) header(w io.Writer, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", v.metricName, v.help, v.metricName, kind)
+}
+
+// Counter is a value that only goes up.
+type Counter struct {
+	v uint64
+}
+
+// Inc adds one.
+func (c *Counter) Inc() { atomic.AddUint64(&c.v, 1) }
+
+// Add adds n.
+func (c *Counter) Add(n uint64) { atomic.AddUint64(&c.v, n) }
+
+// CounterVec is a family of counters told apart by label values.
+type CounterVec struct {
+	vec
+}
+
+// NewCounterVec registers a counter family with the given label names.
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	v := &CounterVec{vec{metricName: name, help: help, labels: labels, children: make(map[string]interface{})}}
+	register(v)
+	return v
+}
+
+// With returns the counter for the label values, in label order.
+func (v *CounterVec) With(values ...string) *Counter {
+	return v.child(values, func() interface{} { return &Counter{} }).(*Counter)
+}
+
+func (v *CounterVec) write(w io.Writer) {
+	v.header(w, "counter")
+	v.each(func(labels string, child interface{}) {
+		fmt.Fprintf(w, "%s%s %d\n", v.metricName, braces(labels), atomic.LoadUint64(&child.(*Counter).v))
+	})
+}
+
+// Histogram counts observations in buckets.
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64 // per bucket, not cumulative
+	count   uint64
+	sum     float64
+}
+
+// Observe records v.
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += v
+}
+
+// HistogramVec is a family of histograms told apart by label values.
+type HistogramVec struct {
+	vec
+	buckets []float64
+}
+
+// NewHistogramVec registers a histogram family with the given upper
+// bucket bounds, in increasing order, and label names.
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	v := &HistogramVec{
+		vec:     vec{metricName: name, help: help, labels: labels, children: make(map[string]interface{})},
+		buckets: buckets,
+	}
+	register(v)
+	return v
+}
+
+// With returns the histogram for the label values, in label order.
+func (v *HistogramVec) With(values ...string) *Histogram {
+	return v.child(values, func() interface{} {
+		return &Histogram{buckets: v.buckets, counts: make([]uint64, len(v.buckets))}
+	}).(*Histogram)
+}
+
+func (v *HistogramVec) write(w io.Writer) {
+	v.header(w, "histogram")
+	v.each(func(labels string, child interface{}) {
+		h := child.(*Histogram)
+		h.mu.Lock()
+		counts := append([]uint64(nil), h.counts...)
+		count, sum := h.count, h.sum
+		h.mu.Unlock()
+		sep := ""
+		if labels != "" {
+			sep = ","
+		}
+		var cumulative uint64
+		for i, bound := range v.buckets {
+			cumulative += counts[i]
+			fmt.Fprintf(w, "%s_bucket{%s%sle=%q} %d\n", v.metricName, labels, sep, formatFloat(bound), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket{%s%sle=\"+Inf\"} %d\n", v.metricName, labels, sep, count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", v.metricName, braces(labels), formatFloat(sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", v.metricName, braces(labels), count)
+	})
+}
+
+func braces(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
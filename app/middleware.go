@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// routeTimeout bounds the request context by the timeout configured for the
+// named route group, so backend calls made with c.Request.Context() give up
+// instead of holding the client connection.
+func routeTimeout(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeouts := currentConfig().Timeouts
+		d, ok := timeouts[group]
+		if !ok {
+			d = timeouts["default"]
+		}
+		if d <= 0 {
+			c.Next()
+			return
+		}
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// timedOut reports whether the request deadline has passed and, if so,
+// responds with 504.
+func timedOut(c *gin.Context) bool {
+	if c.Request.Context().Err() != context.DeadlineExceeded {
+		return false
+	}
+	errorResponse(c, http.StatusGatewayTimeout, "Request timed out")
+	return true
+}
+
+// clientIP returns the address of the client sending the request. Requests
+// from server.trusted_proxies are attributed to the last address in
+// X-Forwarded-For that is not a trusted proxy; other clients could name any
+// address there, so their own is used.
+func clientIP(c *gin.Context) string {
+	ip, _, err := net.SplitHostPort(strings.TrimSpace(c.Request.RemoteAddr))
+	if err != nil {
+		ip = c.Request.RemoteAddr
+	}
+	proxies := currentConfig().Server.TrustedProxies
+	if !trustedProxy(proxies, ip) {
+		return ip
+	}
+	hops := strings.Split(strings.Join(c.Request.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !trustedProxy(proxies, hop) {
+			break
+		}
+	}
+	return ip
+}
+
+// trustedProxy reports whether ip is in one of proxies.
+func trustedProxy(proxies []string, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, proxy := range proxies {
+		if ipNet, err := config.ParseIPNet(proxy); err == nil && ipNet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// requireFeature hides the route behind a feature flag, answering 404 while
+// the flag is disabled.
+func requireFeature(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !featureFlags.Enabled(name) {
+			errorResponse(c, http.StatusNotFound, "Not found")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// customMethods routes Google API style custom methods such as
+// POST /documents:deleteByQuery. httprouter reads a colon anywhere in a
+// path as the start of a parameter, which clashes with routes like
+// /documents/:id, so registered custom method paths are rewritten onto a
+// separate prefix before they reach the router. Other paths, including
+// ones that merely contain a colon, are left alone.
+type customMethods struct {
+	group     *gin.RouterGroup
+	paths     map[string]string
+	originals map[string]string
+}
+
+const customMethodPrefix = "/_methods"
+
+func newCustomMethods(r *gin.Engine) *customMethods {
+	return &customMethods{
+		group:     r.Group(customMethodPrefix),
+		paths:     make(map[string]string),
+		originals: make(map[string]string),
+	}
+}
+
+// Handle registers handlers for a path of the form /collection:method.
+func (m *customMethods) Handle(method, path string, handlers ...gin.HandlerFunc) {
+	i := strings.LastIndex(path, ":")
+	rewritten := path[:i] + "/" + path[i+1:]
+	m.paths[method+" "+path] = customMethodPrefix + rewritten
+	m.originals[method+" "+customMethodPrefix+rewritten] = path
+	m.group.Handle(method, rewritten, handlers...)
+}
+
+// Original returns the custom method path a route was registered for, or
+// path itself for routes that are not custom methods.
+func (m *customMethods) Original(method, path string) string {
+	if p, ok := m.originals[method+" "+path]; ok {
+		return p
+	}
+	return path
+}
+
+// Alias makes path another name for the custom method registered as
+// target. It serves static paths that would clash with a parameter route,
+// such as /documents/count next to /documents/:id.
+func (m *customMethods) Alias(method, path, target string) {
+	m.paths[method+" "+path] = m.paths[method+" "+target]
+}
+
+// Wrap returns h with custom method paths rewritten.
+func (m *customMethods) Wrap(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := m.paths[r.Method+" "+r.URL.Path]; ok {
+			r = r.WithContext(context.WithValue(r.Context(), publicPathKey{}, r.URL.Path))
+			r.URL.Path = p
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+type publicPathKey struct{}
+
+// publicPath returns the path r was sent to, before a custom method path
+// was rewritten.
+func publicPath(r *http.Request) string {
+	if p, ok := r.Context().Value(publicPathKey{}).(string); ok {
+		return p
+	}
+	return r.URL.Path
+}
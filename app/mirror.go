@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/go-redis/redis"
+	"github.com/olivere/elastic"
+)
+
+// keyspaceMirror keeps the documents stored as Redis keys under a prefix
+// searchable by following keyspace notifications for them.
+type keyspaceMirror struct {
+	client redis.UniversalClient
+	cfg    config.MirrorConfig
+	db     int
+}
+
+func newKeyspaceMirror(client redis.UniversalClient, cfg config.MirrorConfig, db int) *keyspaceMirror {
+	return &keyspaceMirror{client: client, cfg: cfg, db: db}
+}
+
+// Run mirrors the keys until stop is closed, while this replica holds the
+// mirror lock.
+func (m *keyspaceMirror) Run(stop <-chan struct{}) {
+	if m.cfg.ConfigureEvents {
+		if err := m.client.ConfigSet("notify-keyspace-events", "K$gx").Err(); err != nil {
+			log.Printf("mirror: cannot enable keyspace notifications: %v", err)
+		}
+	}
+	for {
+		runLocked(context.Background(), "keyspace-mirror", func(ctx context.Context) {
+			if err := m.mirror(stop, ctx.Done()); err != nil {
+				log.Printf("mirror: %v", err)
+			}
+		})
+		if sleepOrStop(stop, m.cfg.RetryInterval) {
+			return
+		}
+	}
+}
+
+// mirror applies notifications until the subscription fails, stop is
+// closed or the lock is lost. Notifications sent while nobody listened are
+// lost, so the keys are resynced once subscribed.
+func (m *keyspaceMirror) mirror(stop, lost <-chan struct{}) error {
+	channel := "__keyspace@" + strconv.Itoa(m.db) + "__:"
+	pubsub := m.client.PSubscribe(channel + m.cfg.Prefix + "*")
+	defer pubsub.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-stop:
+			pubsub.Close()
+		case <-lost:
+			pubsub.Close()
+		case <-done:
+		}
+	}()
+	for {
+		msg, err := pubsub.ReceiveTimeout(time.Minute)
+		if err != nil {
+			select {
+			case <-stop:
+				return nil
+			case <-lost:
+				return errLockLost
+			default:
+			}
+			if e, ok := err.(net.Error); ok && e.Timeout() {
+				pubsub.Ping()
+				continue
+			}
+			return err
+		}
+		switch msg := msg.(type) {
+		case *redis.Subscription:
+			if err := m.resync(); err != nil {
+				return fmt.Errorf("cannot resync: %v", err)
+			}
+		case *redis.Message:
+			// A change that cannot be applied is picked up by the resync
+			// of the next subscription.
+			if err := m.apply(strings.TrimPrefix(msg.Channel, channel), msg.Payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// apply mirrors one notification: event is the command that touched key.
+func (m *keyspaceMirror) apply(key, event string) error {
+	switch event {
+	case "set":
+		if docs := m.load([]string{key}); len(docs) > 0 {
+			return indexMirrored("mirror", docs)
+		}
+	case "del", "expired":
+		return trashMirrored("mirror", strings.TrimPrefix(key, m.cfg.Prefix))
+	}
+	return nil
+}
+
+// resync indexes every key under the prefix.
+func (m *keyspaceMirror) resync() error {
+	var cursor uint64
+	for {
+		keys, next, err := m.client.Scan(cursor, m.cfg.Prefix+"*", 500).Result()
+		if err != nil {
+			return err
+		}
+		if docs := m.load(keys); len(docs) > 0 {
+			if err := indexMirrored("mirror", docs); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// load reads the documents held by keys, skipping keys that are gone or do
+// not hold a valid document.
+func (m *keyspaceMirror) load(keys []string) []Document {
+	if len(keys) == 0 {
+		return nil
+	}
+	gets := make([]*redis.StringCmd, len(keys))
+	m.client.Pipelined(func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			gets[i] = pipe.Get(key)
+		}
+		return nil
+	})
+	docs := make([]Document, 0, len(keys))
+	for i, key := range keys {
+		data, err := gets[i].Bytes()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			log.Printf("mirror: cannot read %s: %v", key, err)
+			continue
+		}
+		doc, err := decodeMirrored(data)
+		if err != nil {
+			log.Printf("mirror: %s does not hold a valid document: %v", key, err)
+			continue
+		}
+		doc.ID = strings.TrimPrefix(key, m.cfg.Prefix)
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// decodeMirrored parses a document stored as JSON shaped like a create
+// request. Its times are those of the change; indexMirrored keeps the
+// created_at of documents already indexed.
+func decodeMirrored(data []byte) (Document, error) {
+	var req DocumentRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return Document{}, err
+	}
+	if details := req.validate(); len(details) > 0 {
+		return Document{}, fmt.Errorf("invalid %s: %s", details[0].Field, details[0].Message)
+	}
+	return req.document(), nil
+}
+
+// indexMirrored indexes documents copied from another store, logging
+// failures under source. It returns an error when documents could not be
+// indexed for a reason that may pass, such as Elasticsearch being
+// unreachable, overloaded or blocked, so that the caller tries them again.
+// Documents Elasticsearch rejects are only logged.
+func indexMirrored(source string, docs []Document) error {
+	client := elasticClient()
+	if client == nil {
+		return errors.New("no elasticsearch connection")
+	}
+	cfg := currentConfig()
+	ctx := context.Background()
+	results := make([]BulkItemResult, len(docs))
+	for start := 0; start < len(docs); start += cfg.Documents.BulkBatchSize {
+		end := start + cfg.Documents.BulkBatchSize
+		if end > len(docs) {
+			end = len(docs)
+		}
+		upsertMirrored(ctx, client, cfg.Elasticsearch, docs[start:end], results[start:end])
+	}
+	retry := 0
+	for _, r := range results {
+		if !bulkItemOK(r) {
+			log.Printf("%s: cannot index document %s: %s", source, r.ID, r.Error)
+			// 403 is the write block at the end of a reindex.
+			if r.Status >= http.StatusInternalServerError || r.Status == http.StatusTooManyRequests || r.Status == http.StatusForbidden {
+				retry++
+			}
+		}
+	}
+	invalidateSearchCache()
+	notifyAlerts(indexedDocuments(docs, results)...)
+	if retry > 0 {
+		return fmt.Errorf("cannot index %d of %d documents", retry, len(docs))
+	}
+	return nil
+}
+
+// mirrorScript replaces a mirrored document, keeping the time it was first
+// indexed: the copies only know when they were last written.
+const mirrorScript = `def created = ctx._source.created_at; ctx._source.clear(); ctx._source.putAll(params.doc); if (created != null) { ctx._source.created_at = created }`
+
+// upsertMirrored writes docs with mirrorScript, indexing those that are not
+// in the index yet as they are.
+func upsertMirrored(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig, docs []Document, results []BulkItemResult) {
+	bulk := client.Bulk().
+		Index(ec.WriteAlias).
+		Type(ec.Type)
+	for _, doc := range docs {
+		indexed := newIndexedDocument(doc)
+		bulk.Add(elastic.NewBulkUpdateRequest().
+			Id(doc.ID).
+			Script(elastic.NewScript(mirrorScript).Param("doc", indexed)).
+			Upsert(indexed))
+	}
+	runBulk(ctx, bulk, docs, results)
+}
+
+// trashMirrored moves the document of a removed key into the trash.
+func trashMirrored(source, id string) error {
+	client := elasticClient()
+	if client == nil {
+		return errors.New("no elasticsearch connection")
+	}
+	cfg := currentConfig()
+	ctx := context.Background()
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.WriteAlias)
+	if err == nil {
+		_, err = client.Update().
+			Index(index).
+			Type(cfg.Elasticsearch.Type).
+			Id(id).
+			Script(newTrashScript()).
+			Do(ctx)
+	}
+	if err != nil && !elastic.IsNotFound(err) {
+		return fmt.Errorf("cannot delete document %s: %v", id, err)
+	}
+	invalidateSearchCache()
+	return nil
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// N1QL statements go to the query service's REST API, which go-couchbase
+// does not wrap. Rows are copied to the client as they are decoded, so
+// large results are never held in memory.
+
+// n1qlTail lists the fields of a query response kept after the results,
+// in the order they are written.
+var n1qlTail = []string{"status", "errors", "warnings", "metrics"}
+
+// couchQueryEndpoint runs a N1QL statement, e.g.
+// {"statement": "SELECT * FROM default WHERE type = $type", "params": {"type": "a"}}
+// or with positional parameters $1, $2... filled from "args". At most
+// couchbase.query.max_rows rows are returned, fewer with "limit"; cut off
+// results are marked truncated. Statements selecting another keyspace than
+// the default one run in its scope, so they can name its collections
+// without qualification. Every keyspace a statement names must be allowed
+// in couchbase.keyspaces, answering 403 otherwise. With "consistency":
+// "request_plus" the query sees all earlier writes, with "at_plus" those
+// whose "mutation_tokens" are given.
+func couchQueryEndpoint(c *gin.Context) {
+	type request struct {
+		Statement string                 `json:"statement"`
+		Args      []interface{}          `json:"args"`
+		Params    map[string]interface{} `json:"params"`
+		Limit     int                    `json:"limit"`
+
+		Consistency    string   `json:"consistency"`
+		MutationTokens []string `json:"mutation_tokens"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if strings.TrimSpace(req.Statement) == "" {
+		errorResponse(c, http.StatusBadRequest, "Statement not specified")
+		return
+	}
+	cfg := currentConfig()
+	qc := cfg.Couchbase.Query
+	ks := c.MustGet(couchKeyspaceKey).(couchKeyspace)
+	withContext := ks != (couchKeyspace{cfg.Couchbase.Bucket, couchDefault, couchDefault})
+	if keyspace, ok := n1qlCheck(req.Statement, cfg.Couchbase, ks, withContext); !ok {
+		if keyspace != "" {
+			errorResponse(c, http.StatusForbidden, "Keyspace not allowed: "+keyspace)
+		} else {
+			errorResponse(c, http.StatusForbidden, "Statement not allowed")
+		}
+		return
+	}
+	limit := qc.MaxRows
+	if req.Limit > 0 && req.Limit < limit {
+		limit = req.Limit
+	}
+	body := map[string]interface{}{
+		"statement": req.Statement,
+		"timeout":   qc.Timeout.String(),
+	}
+	if len(req.Args) > 0 {
+		body["args"] = req.Args
+	}
+	if withContext {
+		body["query_context"] = "default:`" + ks.Bucket + "`.`" + ks.Scope + "`"
+	}
+	switch req.Consistency {
+	case "", consistencyNotBounded:
+	case consistencyRequestPlus:
+		body["scan_consistency"] = req.Consistency
+	case consistencyAtPlus:
+		if len(req.MutationTokens) == 0 {
+			errorResponse(c, http.StatusBadRequest, "at_plus needs mutation_tokens")
+			return
+		}
+		vectors, err := scanVectors(req.MutationTokens)
+		if err != nil {
+			errorResponse(c, http.StatusBadRequest, "Invalid mutation_tokens: "+err.Error())
+			return
+		}
+		body["scan_consistency"] = req.Consistency
+		body["scan_vectors"] = vectors
+	default:
+		errorResponse(c, http.StatusBadRequest, "Invalid consistency "+req.Consistency)
+		return
+	}
+	for name, v := range req.Params {
+		body["$"+strings.TrimPrefix(name, "$")] = v
+	}
+	resp, err := runN1QL(c.Request.Context(), cfg.Couchbase, body)
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusBadGateway, "Failed to query couchbase")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		n1qlError(c, resp)
+		return
+	}
+	streamN1QL(c, resp.Body, limit)
+}
+
+// runN1QL posts body, the parameters of a statement, to a query node.
+func runN1QL(ctx context.Context, cc config.CouchbaseConfig, body map[string]interface{}) (*http.Response, error) {
+	base, err := cbHolder.ServiceURL("n1ql")
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, base+"/query/service", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if user, pass := couchbaseCredentials(cc); user != "" {
+		req.SetBasicAuth(user, pass)
+	}
+	return cbHolder.HTTPClient().Do(req.WithContext(ctx))
+}
+
+// n1qlError responds to a failed statement: 400 with the first error for
+// statements the service rejected, 502 otherwise.
+func n1qlError(c *gin.Context, resp *http.Response) {
+	var res struct {
+		Errors []struct {
+			Code int    `json:"code"`
+			Msg  string `json:"msg"`
+		} `json:"errors"`
+	}
+	json.NewDecoder(resp.Body).Decode(&res)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && len(res.Errors) > 0 {
+		errorResponse(c, http.StatusBadRequest, "Invalid statement: "+res.Errors[0].Msg)
+		return
+	}
+	log.Printf("couchbase query answered %d: %+v", resp.StatusCode, res.Errors)
+	errorResponse(c, http.StatusBadGateway, "Failed to query couchbase")
+}
+
+// streamN1QL copies the rows of a query response to the client, followed
+// by the status, errors, warnings and metrics of the response. Once the
+// first row is sent the status code cannot change, so a response broken
+// off midway is logged and reported as truncated.
+func streamN1QL(c *gin.Context, body io.Reader, limit int) {
+	dec := json.NewDecoder(body)
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		log.Printf("couchbase query: malformed response: %v", err)
+		errorResponse(c, http.StatusBadGateway, "Failed to query couchbase")
+		return
+	}
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Status(http.StatusOK)
+	w := c.Writer
+	w.WriteString(`{"results":[`)
+	tail := make(map[string]json.RawMessage)
+	truncated := false
+	err := func() error {
+		for dec.More() {
+			t, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			if key, _ := t.(string); key != "results" {
+				var v json.RawMessage
+				if err := dec.Decode(&v); err != nil {
+					return err
+				}
+				tail[key] = v
+				continue
+			}
+			if _, err := dec.Token(); err != nil {
+				return err
+			}
+			for n := 0; dec.More(); n++ {
+				if n == limit {
+					// The rest of the response is dropped unread.
+					truncated = true
+					return nil
+				}
+				var row json.RawMessage
+				if err := dec.Decode(&row); err != nil {
+					return err
+				}
+				if n > 0 {
+					w.WriteString(",")
+				}
+				w.Write(row)
+				if n%100 == 99 {
+					w.Flush()
+				}
+			}
+			if _, err := dec.Token(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err != nil {
+		log.Printf("couchbase query: response broken off: %v", err)
+		truncated = true
+	}
+	w.WriteString("]")
+	for _, key := range n1qlTail {
+		if v, ok := tail[key]; ok {
+			fmt.Fprintf(w, ",%q:%s", key, v)
+		}
+	}
+	fmt.Fprintf(w, `,"truncated":%t}`, truncated)
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/config"
+)
+
+// Statements are checked before they run: they must start with an allowed
+// keyword, and every keyspace they name, in FROM, JOIN, NEST, INTO, UPDATE,
+// MERGE ... USING and INFER, must be allowed like the keyspace of the
+// request. Statements of other kinds, and several statements at once, are
+// refused since their keyspaces cannot be told apart.
+
+// n1qlToken is a lexical token of a statement. Quoted identifiers are
+// never keywords.
+type n1qlToken struct {
+	kind int
+	text string
+}
+
+const (
+	n1qlIdent = iota
+	n1qlQuoted
+	n1qlString
+	n1qlParam
+	n1qlNumber
+	n1qlPunct
+)
+
+var errN1QLSyntax = errors.New("unterminated string, identifier or comment")
+
+// n1qlTokens splits a statement into tokens, dropping comments.
+func n1qlTokens(s string) ([]n1qlToken, error) {
+	var toks []n1qlToken
+	for i := 0; i < len(s); {
+		ch := s[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\r' || ch == '\n':
+			i++
+		case strings.HasPrefix(s[i:], "--"):
+			end := strings.IndexByte(s[i:], '\n')
+			if end < 0 {
+				return toks, nil
+			}
+			i += end + 1
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return nil, errN1QLSyntax
+			}
+			i += end + 4
+		case ch == '\'' || ch == '"' || ch == '`':
+			text, n, ok := n1qlQuote(s[i:])
+			if !ok {
+				return nil, errN1QLSyntax
+			}
+			kind := n1qlString
+			if ch == '`' {
+				kind = n1qlQuoted
+			}
+			toks = append(toks, n1qlToken{kind, text})
+			i += n
+		case ch == '$' || n1qlIdentByte(ch, true):
+			j := i + 1
+			for j < len(s) && n1qlIdentByte(s[j], false) {
+				j++
+			}
+			kind := n1qlIdent
+			if ch == '$' {
+				kind = n1qlParam
+			}
+			toks = append(toks, n1qlToken{kind, s[i:j]})
+			i = j
+		case ch >= '0' && ch <= '9':
+			j := i + 1
+			for j < len(s) && (s[j] >= '0' && s[j] <= '9' || s[j] == '.' || s[j] == 'e' || s[j] == 'E') {
+				j++
+			}
+			toks = append(toks, n1qlToken{n1qlNumber, s[i:j]})
+			i = j
+		default:
+			toks = append(toks, n1qlToken{n1qlPunct, s[i : i+1]})
+			i++
+		}
+	}
+	return toks, nil
+}
+
+func n1qlIdentByte(ch byte, first bool) bool {
+	return ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || !first && (ch == '$' || ch >= '0' && ch <= '9')
+}
+
+// n1qlQuote reads the quoted string or identifier at the start of s, where
+// a doubled quote or a backslash escapes the quote, returning its text and
+// length.
+func n1qlQuote(s string) (string, int, bool) {
+	q := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case s[i] == q && i+1 < len(s) && s[i+1] == q:
+			i++
+			b.WriteByte(q)
+		case s[i] == q:
+			return b.String(), i + 1, true
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, false
+}
+
+// is reports whether t is the keyword kw.
+func (t n1qlToken) is(kw string) bool {
+	return t.kind == n1qlIdent && strings.EqualFold(t.text, kw)
+}
+
+func (t n1qlToken) name() bool {
+	return t.kind == n1qlIdent || t.kind == n1qlQuoted
+}
+
+// n1qlCheck checks a statement run in the keyspace ks, the query context
+// being set to its scope when withContext. It returns false when the
+// statement is refused, with the keyspace that is not allowed if that is
+// why.
+func n1qlCheck(statement string, cc config.CouchbaseConfig, ks couchKeyspace, withContext bool) (string, bool) {
+	toks, err := n1qlTokens(statement)
+	if err != nil || !n1qlAllowed(toks, cc.Query.AllowedStatements) {
+		return "", false
+	}
+	for i, t := range toks {
+		if t.kind == n1qlPunct && t.text == ";" && i < len(toks)-1 {
+			return "", false
+		}
+	}
+	start := n1qlBody(toks)
+	if start == len(toks) {
+		return "", false
+	}
+	head := toks[start]
+	switch {
+	case head.is("SELECT"), head.is("INSERT"), head.is("UPSERT"), head.is("DELETE"),
+		head.is("UPDATE"), head.is("MERGE"), head.is("INFER"):
+	default:
+		return "", false
+	}
+	aliases := make(map[string]bool)
+	for i := 1; i < len(toks); i++ {
+		if toks[i-1].is("AS") && toks[i].name() {
+			aliases[strings.ToLower(toks[i].text)] = true
+		}
+	}
+	for i := start; i < len(toks); i++ {
+		t := toks[i]
+		at := 0 // index of the keyspace path t introduces, if any
+		switch {
+		case t.is("FROM"), t.is("JOIN"), t.is("NEST"), t.is("INTO"):
+			at = i + 1
+		case i == start && head.is("UPDATE"):
+			if i+1 < len(toks) && toks[i+1].is("STATISTICS") {
+				return "", false
+			}
+			at = i + 1
+		case i == start && head.is("INFER"):
+			at = i + 1
+			if at < len(toks) && toks[at].is("KEYSPACE") {
+				at++
+			}
+		case t.is("USING") && head.is("MERGE"):
+			// Not the USING GSI of index hints.
+			if i+1 < len(toks) && !toks[i+1].is("GSI") && !toks[i+1].is("FTS") && !toks[i+1].is("VIEW") {
+				at = i + 1
+			}
+		}
+		if at == 0 || at >= len(toks) || !toks[at].name() {
+			// Subqueries, arrays and parameters hold no keyspace name.
+			continue
+		}
+		namespace, parts := n1qlPath(toks[at:])
+		if namespace == "" && len(parts) == 2 && aliases[strings.ToLower(parts[0])] {
+			// A path on an alias of the enclosing query.
+			continue
+		}
+		target, ok := n1qlKeyspace(namespace, parts, ks, withContext)
+		if !ok {
+			if namespace != "" {
+				return namespace + ":" + strings.Join(parts, "."), false
+			}
+			return strings.Join(parts, "."), false
+		}
+		if !couchKeyspaceAllowed(cc, target) {
+			return target.String(), false
+		}
+		// The name after a keyspace is its alias, unless it is a keyword,
+		// which no later keyspace path starts with anyway.
+		if k := at + n1qlPathLen(namespace, parts); k < len(toks) && toks[k].name() {
+			aliases[strings.ToLower(toks[k].text)] = true
+		}
+	}
+	return "", true
+}
+
+// n1qlBody returns the index of the statement behind the parentheses and
+// the EXPLAIN, ADVISE and PREPARE prefixes wrapping it.
+func n1qlBody(toks []n1qlToken) int {
+	i := 0
+	for i < len(toks) {
+		switch t := toks[i]; {
+		case t.kind == n1qlPunct && t.text == "(", t.is("EXPLAIN"), t.is("ADVISE"):
+			i++
+		case t.is("PREPARE"):
+			// PREPARE [FORCE] [name FROM|AS] statement
+			i++
+			if i < len(toks) && toks[i].is("FORCE") {
+				i++
+			}
+			if i+1 < len(toks) && (toks[i+1].is("FROM") || toks[i+1].is("AS")) {
+				i += 2
+			}
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// n1qlAllowed reports whether the first keyword of a statement is allowed.
+func n1qlAllowed(toks []n1qlToken, allowed []string) bool {
+	for _, t := range toks {
+		if t.kind == n1qlPunct && t.text == "(" {
+			continue
+		}
+		for _, kw := range allowed {
+			if t.is(kw) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// n1qlPath reads the keyspace path at the start of toks:
+// [namespace:]name{.name}.
+func n1qlPath(toks []n1qlToken) (namespace string, parts []string) {
+	i := 0
+	if len(toks) > 2 && toks[1].kind == n1qlPunct && toks[1].text == ":" && toks[2].name() {
+		namespace = strings.ToLower(toks[0].text)
+		i = 2
+	}
+	parts = append(parts, toks[i].text)
+	for i+2 < len(toks) && toks[i+1].kind == n1qlPunct && toks[i+1].text == "." && toks[i+2].name() {
+		parts = append(parts, toks[i+2].text)
+		i += 2
+	}
+	return namespace, parts
+}
+
+// n1qlPathLen returns the number of tokens of a path read by n1qlPath.
+func n1qlPathLen(namespace string, parts []string) int {
+	n := 2*len(parts) - 1
+	if namespace != "" {
+		n += 2
+	}
+	return n
+}
+
+// n1qlKeyspace resolves a keyspace path as the query service does: one
+// name is a bucket, or a collection of the scope of the query context; three
+// are a bucket, scope and collection. Only the default namespace holds
+// data; system keyspaces describe every bucket and are never allowed.
+func n1qlKeyspace(namespace string, parts []string, ks couchKeyspace, withContext bool) (couchKeyspace, bool) {
+	if namespace != "" && namespace != "default" {
+		return couchKeyspace{}, false
+	}
+	switch len(parts) {
+	case 1:
+		if withContext {
+			return couchKeyspace{ks.Bucket, ks.Scope, parts[0]}, true
+		}
+		return couchKeyspace{parts[0], couchDefault, couchDefault}, true
+	case 3:
+		return couchKeyspace{parts[0], parts[1], parts[2]}, true
+	}
+	return couchKeyspace{}, false
+}
@@ -0,0 +1,498 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ugorji/go/codec"
+)
+
+// Handlers speak JSON; negotiate translates for clients that prefer
+// MessagePack or XML. Request bodies of those types are converted to JSON
+// as the handler reads them, and JSON responses are converted to the type
+// the Accept header rates highest. Both carry the same values as the JSON
+// would: MessagePack maps, arrays, strings, numbers, booleans and nil, and
+// XML in JSONx, the IBM representation of JSON, e.g.
+//
+//	<json:object xmlns:json="http://www.ibm.com/xmlns/prod/2009/jsonx">
+//	  <json:string name="title">Hello</json:string>
+//	  <json:array name="tags"><json:string>news</json:string></json:array>
+//	</json:object>
+//
+// so fields keep their names and types whatever characters they contain.
+
+const (
+	formatJSON    = "json"
+	formatMsgpack = "msgpack"
+	formatXML     = "xml"
+)
+
+const jsonxNamespace = "http://www.ibm.com/xmlns/prod/2009/jsonx"
+
+// formatTypes lists the media types of each format, the first being the
+// one responses are sent as.
+var formatTypes = map[string][]string{
+	formatJSON:    {"application/json"},
+	formatMsgpack: {"application/msgpack", "application/x-msgpack", "application/vnd.msgpack"},
+	formatXML:     {"application/xml", "text/xml"},
+}
+
+var msgpackHandle = func() *codec.MsgpackHandle {
+	h := &codec.MsgpackHandle{RawToString: true, WriteExt: true}
+	h.MapType = reflect.TypeOf(map[string]interface{}(nil))
+	h.Canonical = true
+	return h
+}()
+
+const verbatimKey = "verbatim"
+
+// negotiate converts MessagePack and XML request bodies to JSON and JSON
+// responses to the format the client accepts.
+func negotiate(c *gin.Context) {
+	if format := mediaFormat(c.GetHeader("Content-Type")); format == formatMsgpack || format == formatXML {
+		c.Request.Body = &convertingBody{raw: c.Request.Body, format: format}
+	}
+	w := &negotiateWriter{ResponseWriter: c.Writer, c: c, format: acceptedFormat(c.GetHeader("Accept"))}
+	c.Writer = w
+	defer w.close()
+	c.Next()
+}
+
+// verbatim exempts the request and response bodies of c from conversion,
+// for routes that store and serve bytes as they are, such as blobs.
+func verbatim(c *gin.Context) {
+	if body, ok := c.Request.Body.(*convertingBody); ok {
+		c.Request.Body = body.raw
+	}
+	c.Set(verbatimKey, true)
+	c.Next()
+}
+
+// mediaFormat returns the format of the media type in a Content-Type
+// header, or "" when it is none of them.
+func mediaFormat(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	for format, types := range formatTypes {
+		for _, t := range types {
+			if t == mediaType {
+				return format
+			}
+		}
+	}
+	return ""
+}
+
+// acceptedFormat returns the format Accept rates highest, JSON winning
+// ties and answering when none of the formats is acceptable.
+func acceptedFormat(header string) string {
+	if header == "" {
+		return formatJSON
+	}
+	q := qualities(header)
+	best, bestQ := formatJSON, 0.0
+	for _, format := range []string{formatJSON, formatMsgpack, formatXML} {
+		weight, ok := -1.0, false
+		for _, t := range formatTypes[format] {
+			if w, found := q[t]; found && w > weight {
+				weight, ok = w, true
+			}
+		}
+		if !ok {
+			main := formatTypes[format][0][:strings.Index(formatTypes[format][0], "/")]
+			if weight, ok = q[main+"/*"]; !ok {
+				weight, ok = q["*/*"]
+			}
+		}
+		if ok && weight > bestQ {
+			best, bestQ = format, weight
+		}
+	}
+	return best
+}
+
+// qualities parses a header of comma-separated values weighted by q
+// parameters, such as Accept or Accept-Encoding, returning the weight of
+// each lower-cased value.
+func qualities(header string) map[string]float64 {
+	q := map[string]float64{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name == "" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if f, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					weight = f
+				}
+			}
+		}
+		q[name] = weight
+	}
+	return q
+}
+
+// convertingBody reads as the JSON equivalent of a MessagePack or XML
+// request body, converted on the first read. Bodies that are never read
+// are never converted.
+type convertingBody struct {
+	raw    io.ReadCloser
+	format string
+	json   *bytes.Reader
+	err    error
+}
+
+func (b *convertingBody) Read(p []byte) (int, error) {
+	if b.json == nil && b.err == nil {
+		var buf bytes.Buffer
+		if b.format == formatMsgpack {
+			b.err = msgpackToJSON(&buf, b.raw)
+		} else {
+			b.err = jsonxToJSON(&buf, b.raw)
+		}
+		b.json = bytes.NewReader(buf.Bytes())
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.json.Read(p)
+}
+
+func (b *convertingBody) Close() error {
+	return b.raw.Close()
+}
+
+func msgpackToJSON(w io.Writer, r io.Reader) error {
+	var v interface{}
+	if err := codec.NewDecoder(r, msgpackHandle).Decode(&v); err != nil {
+		return fmt.Errorf("invalid MessagePack: %v", err)
+	}
+	return json.NewEncoder(w).Encode(v)
+}
+
+func jsonToMsgpack(w io.Writer, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	return codec.NewEncoder(w, msgpackHandle).Encode(msgpackNumbers(v))
+}
+
+// msgpackNumbers replaces the json.Numbers in v by integers where they
+// are whole and fit, and by floats otherwise.
+func msgpackNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = msgpackNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = msgpackNumbers(e)
+		}
+	}
+	return v
+}
+
+// jsonToJSONx writes the JSON value read from r as JSONx, keeping the
+// order of object members.
+func jsonToJSONx(w *bytes.Buffer, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	w.WriteString(xml.Header)
+	return writeJSONx(w, dec, nil, true)
+}
+
+func writeJSONx(w *bytes.Buffer, dec *json.Decoder, name *string, root bool) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	open := func(element string) {
+		w.WriteString("<json:" + element)
+		if root {
+			w.WriteString(` xmlns:json="` + jsonxNamespace + `"`)
+		}
+		if name != nil {
+			w.WriteString(` name="`)
+			xml.EscapeText(w, []byte(*name))
+			w.WriteString(`"`)
+		}
+		w.WriteString(">")
+	}
+	scalar := func(element, text string) {
+		open(element)
+		xml.EscapeText(w, []byte(text))
+		w.WriteString("</json:" + element + ">")
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		element := "array"
+		if t == '{' {
+			element = "object"
+		}
+		open(element)
+		for dec.More() {
+			var member *string
+			if t == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				s := key.(string)
+				member = &s
+			}
+			if err := writeJSONx(w, dec, member, false); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		w.WriteString("</json:" + element + ">")
+	case string:
+		scalar("string", t)
+	case json.Number:
+		scalar("number", t.String())
+	case bool:
+		scalar("boolean", strconv.FormatBool(t))
+	case nil:
+		open("null")
+		w.WriteString("</json:null>")
+	}
+	return nil
+}
+
+// jsonxToJSON writes the JSONx document read from r as JSON.
+func jsonxToJSON(w *bytes.Buffer, r io.Reader) error {
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("invalid XML: %v", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if err := readJSONx(w, dec, start); err != nil {
+				return fmt.Errorf("invalid JSONx: %v", err)
+			}
+			return nil
+		}
+	}
+}
+
+func readJSONx(w *bytes.Buffer, dec *xml.Decoder, start xml.StartElement) error {
+	switch element := start.Name.Local; element {
+	case "object", "array":
+		if element == "object" {
+			w.WriteByte('{')
+		} else {
+			w.WriteByte('[')
+		}
+		for n := 0; ; {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			switch t := tok.(type) {
+			case xml.StartElement:
+				if n > 0 {
+					w.WriteByte(',')
+				}
+				n++
+				if element == "object" {
+					name, ok := jsonxName(t)
+					if !ok {
+						return fmt.Errorf("member of %s without a name", element)
+					}
+					key, _ := json.Marshal(name)
+					w.Write(key)
+					w.WriteByte(':')
+				}
+				if err := readJSONx(w, dec, t); err != nil {
+					return err
+				}
+			case xml.EndElement:
+				if element == "object" {
+					w.WriteByte('}')
+				} else {
+					w.WriteByte(']')
+				}
+				return nil
+			case xml.CharData:
+				if len(bytes.TrimSpace(t)) > 0 {
+					return fmt.Errorf("text in %s", element)
+				}
+			}
+		}
+	case "string", "number", "boolean", "null":
+		text, err := jsonxText(dec)
+		if err != nil {
+			return err
+		}
+		switch element {
+		case "string":
+			s, _ := json.Marshal(text)
+			w.Write(s)
+		case "number":
+			text = strings.TrimSpace(text)
+			if _, err := strconv.ParseFloat(text, 64); err != nil || !json.Valid([]byte(text)) {
+				return fmt.Errorf("%q is not a number", text)
+			}
+			w.WriteString(text)
+		case "boolean":
+			text = strings.TrimSpace(text)
+			if text != "true" && text != "false" {
+				return fmt.Errorf("%q is not a boolean", text)
+			}
+			w.WriteString(text)
+		case "null":
+			w.WriteString("null")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown element %s", element)
+	}
+}
+
+func jsonxName(start xml.StartElement) (string, bool) {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "name" {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// jsonxText reads the text of an element up to its end.
+func jsonxText(dec *xml.Decoder) (string, error) {
+	var text []byte
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			text = append(text, t...)
+		case xml.StartElement:
+			return "", errors.New("element in a scalar")
+		case xml.EndElement:
+			return string(text), nil
+		}
+	}
+}
+
+// negotiateWriter holds JSON responses back to convert them once they are
+// complete, unless the client asked for JSON.
+type negotiateWriter struct {
+	gin.ResponseWriter
+	c      *gin.Context
+	format string
+
+	decided bool
+	convert bool
+	buf     bytes.Buffer
+}
+
+// decide is called once the headers are final, before the first byte of
+// the body.
+func (w *negotiateWriter) decide() {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	if w.c.GetBool(verbatimKey) || mediaFormat(w.Header().Get("Content-Type")) != formatJSON {
+		return
+	}
+	w.Header().Add("Vary", "Accept")
+	w.convert = w.format != formatJSON
+}
+
+func (w *negotiateWriter) Write(data []byte) (int, error) {
+	w.decide()
+	if w.convert {
+		return w.buf.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *negotiateWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *negotiateWriter) WriteHeaderNow() {
+	w.decide()
+	if !w.convert {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *negotiateWriter) Written() bool {
+	return w.buf.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// Flush is a no-op while the response is held back for conversion.
+func (w *negotiateWriter) Flush() {
+	w.decide()
+	if !w.convert {
+		w.ResponseWriter.Flush()
+	}
+}
+
+// close sends the converted response, or the JSON when it cannot be
+// converted.
+func (w *negotiateWriter) close() {
+	if !w.convert {
+		return
+	}
+	var out bytes.Buffer
+	var err error
+	if w.format == formatMsgpack {
+		err = jsonToMsgpack(&out, bytes.NewReader(w.buf.Bytes()))
+	} else {
+		err = jsonToJSONx(&out, bytes.NewReader(w.buf.Bytes()))
+	}
+	body := out.Bytes()
+	h := w.Header()
+	if err != nil {
+		log.Printf("cannot convert response to %s: %v", w.format, err)
+		body = w.buf.Bytes()
+	} else {
+		contentType := formatTypes[w.format][0]
+		if w.format == formatXML {
+			contentType += "; charset=utf-8"
+		}
+		h.Set("Content-Type", contentType)
+		weakenETag(h)
+	}
+	h.Set("Content-Length", strconv.Itoa(len(body)))
+	w.ResponseWriter.WriteHeaderNow()
+	if _, err := w.ResponseWriter.Write(body); err != nil && err != http.ErrBodyNotAllowed {
+		log.Println(err)
+	}
+}
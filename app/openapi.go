@@ -0,0 +1,574 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/alerts"
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/awesomeProject/homie-search/app/swaggerui"
+	"github.com/gin-gonic/gin"
+)
+
+// The OpenAPI document is generated at startup from the registered routes,
+// so every versioned endpoint is listed, and from apiOperations, which
+// describes them. Request and response schemas are derived from the Go
+// types the handlers bind and render: field names and optionality follow
+// their json tags, descriptions their doc tags and limits their validate
+// tags. Routes missing from apiOperations are listed without schemas.
+
+// apiOperation describes a route. Request and Response are values of the
+// types read and written as JSON, nil when there is no JSON body. Status
+// is the success status, 200 when zero.
+type apiOperation struct {
+	Summary  string
+	Query    []apiParam
+	Request  interface{}
+	Status   int
+	Response interface{}
+}
+
+// apiParam is a query parameter.
+type apiParam struct {
+	Name        string
+	Description string
+}
+
+var pageParams = []apiParam{
+	{"page", "Page to return, counting from 1; the Link header links the pages around it"},
+	{"page_size", "Items per page"},
+	{"skip", "Items to skip, instead of page"},
+	{"take", "Items to return, instead of page_size"},
+}
+
+// filterParams are the search filters, which counts take as well.
+var filterParams = []apiParam{
+	{"query", "Full text query"},
+	{"tags", "Only documents with the tag; may be repeated"},
+	{"meta", "Only documents with the metadata key:value; may be repeated"},
+	{"filter", "Only documents matching the field:value filter; may be repeated"},
+	{"near", "Only documents within radius of lat,lon"},
+	{"radius", "Distance for near, e.g. 10km"},
+	{"created_after", "Only documents created after the RFC 3339 time"},
+	{"created_before", "Only documents created before the RFC 3339 time"},
+	{"include_deleted", "true to include documents in the trash"},
+}
+
+var searchParams = append(append([]apiParam{
+	{"lang", "Language the query is analyzed in"},
+	{"operator", "and or or, how query terms combine"},
+	{"minimum_should_match", "Terms that must match with operator or"},
+	{"fuzziness", "Edit distance allowed per term, or auto"},
+	{"sort", "Comma-separated fields, prefixed with - for descending"},
+	{"fields", "Comma-separated fields to return"},
+	{"cursor", "next_cursor of the previous page; empty to start"},
+	{"facets", "true to count tags and languages"},
+	{"highlight", "true to return matched fragments"},
+	{"backend", "elasticsearch or couchbase"},
+}, filterParams...), pageParams...)
+
+var apiOperations = map[string]apiOperation{
+	"POST /v1/documents": {
+		Summary: "Index documents",
+		Query:   []apiParam{{"async", "true to index by an ingest job, answered with 202 and the job"}},
+		Request: []DocumentRequest{},
+		Response: struct {
+			Items []BulkItemResult `json:"items"`
+		}{},
+	},
+	"GET /v1/documents/:id": {
+		Summary:  "Get a document, or 304 while If-None-Match names its ETag",
+		Response: Document{},
+	},
+	"PUT /v1/documents/:id": {
+		Summary:  "Replace a document",
+		Request:  DocumentRequest{},
+		Response: Document{},
+	},
+	"PATCH /v1/documents/:id": {
+		Summary:  "Update fields of a document",
+		Request:  DocumentPatch{},
+		Response: Document{},
+	},
+	"DELETE /v1/documents/:id": {
+		Summary: "Move a document to the trash",
+		Status:  http.StatusNoContent,
+	},
+	"GET /v1/documents/:id/related": {
+		Summary:  "Find documents similar to a document",
+		Query:    pageParams,
+		Response: SearchResponse{},
+	},
+	"POST /v1/documents/:id/restore": {
+		Summary:  "Restore a document from the trash",
+		Response: Document{},
+	},
+	"POST /v1/documents/:id/tags": {
+		Summary: "Add tags to a document",
+		Request: struct {
+			Tags []string `json:"tags"`
+		}{},
+		Response: Document{},
+	},
+	"DELETE /v1/documents/:id/tags/:tag": {
+		Summary:  "Remove a tag from a document",
+		Response: Document{},
+	},
+	"POST /v1/documents:deleteByQuery": {
+		Summary: "Move the documents matching a query to the trash",
+		Response: struct {
+			Deleted int64 `json:"deleted"`
+		}{},
+	},
+	"GET /v1/documents:export": {
+		Summary: "Export documents as newline-delimited JSON",
+	},
+	"GET /v1/documents:count": {
+		Summary: "Count documents",
+		Query:   filterParams,
+		Response: struct {
+			Count int64 `json:"count"`
+		}{},
+	},
+	"GET /v1/documents:trash": {
+		Summary: "List documents in the trash",
+		Query:   pageParams,
+		Response: struct {
+			Total     int64      `json:"total"`
+			Page      int        `json:"page"`
+			PageSize  int        `json:"page_size"`
+			Documents []Document `json:"documents"`
+		}{},
+	},
+	"POST /v1/documents:upload": {
+		Summary:  "Index an uploaded file",
+		Status:   http.StatusCreated,
+		Response: Document{},
+	},
+	"POST /v1/documents:import": {
+		Summary:  "Index the documents of a newline-delimited JSON body, reporting the failed lines",
+		Response: ImportReport{},
+	},
+	"GET /v1/search": {
+		Summary:  "Search documents",
+		Query:    searchParams,
+		Response: SearchResponse{},
+	},
+	"GET /v1/suggest": {
+		Summary: "Complete titles",
+		Query: []apiParam{
+			{"q", "Prefix to complete"},
+			{"size", "Completions to return"},
+		},
+		Response: struct {
+			Suggestions []string `json:"suggestions"`
+		}{},
+	},
+	"POST /v1/alerts": {
+		Summary: "Create an alert",
+		Request: struct {
+			Name    string `json:"name"`
+			Query   string `json:"query"`
+			Webhook string `json:"webhook"`
+		}{},
+		Status:   http.StatusCreated,
+		Response: alerts.Alert{},
+	},
+	"GET /v1/alerts": {
+		Summary:  "List alerts",
+		Response: []alerts.Alert{},
+	},
+	"DELETE /v1/alerts/:id": {
+		Summary: "Delete an alert",
+		Status:  http.StatusNoContent,
+	},
+	"GET /v1/jobs/:id": {
+		Summary:  "Get an ingest job",
+		Response: Job{},
+	},
+	"GET /v1/kv/:key": {
+		Summary:  "Get a value",
+		Response: kvEntry{},
+	},
+	"GET /v1/hashes/:key": {
+		Summary:  "Get a hash",
+		Response: kvHash{},
+	},
+	"GET /v1/lists/:key": {
+		Summary:  "Get a list",
+		Response: kvList{},
+	},
+	"GET /v1/sets/:key": {
+		Summary:  "Get a set",
+		Response: kvSet{},
+	},
+	"POST /v1/sessions": {
+		Summary: "Start a session",
+		Status:  http.StatusCreated,
+		Response: struct {
+			Token   string  `json:"token"`
+			Session Session `json:"session"`
+		}{},
+	},
+	"GET /v1/sessions/current": {
+		Summary:  "Get the current session",
+		Response: Session{},
+	},
+	"DELETE /v1/sessions/current": {
+		Summary: "End the current session",
+		Status:  http.StatusNoContent,
+	},
+	"GET /v1/couchbase/keys": {
+		Summary: "List keys",
+		Query: []apiParam{
+			{"prefix", "Only keys starting with prefix"},
+			{"limit", "Keys to return"},
+			{"cursor", "next_cursor of the previous page"},
+		},
+		Response: struct {
+			Keys       []string `json:"keys"`
+			NextCursor string   `json:"next_cursor,omitempty"`
+		}{},
+	},
+	"GET /v1/couchbase/blob/:key": {
+		Summary: "Download a blob",
+	},
+	"PUT /v1/couchbase/blob/:key": {
+		Summary:  "Upload a blob",
+		Query:    []apiParam{{"ttl", "Time until the blob expires, e.g. 24h"}},
+		Response: blobManifest{},
+	},
+	"POST /v1/couchbaseInsert": {
+		Summary: "Store values under a key",
+		Query: []apiParam{
+			{"durability", "majority or persist to wait until the write is replicated or persisted"},
+		},
+		Request: couchInsertRequest{},
+		Response: struct {
+			Key           string `json:"key"`
+			MutationToken string `json:"mutation_token,omitempty"`
+		}{},
+	},
+	"POST /v1/couchbase:batchGet": {
+		Summary: "Get several keys",
+		Response: struct {
+			Results []couchResult `json:"results"`
+		}{},
+	},
+	"POST /v1/couchbase:batchSet": {
+		Summary: "Store several keys",
+		Response: struct {
+			Results []couchResult `json:"results"`
+		}{},
+	},
+	"POST /v1/couchbase:transaction": {
+		Summary: "Apply several writes, undoing them if one fails",
+		Response: struct {
+			Results []couchResult `json:"results"`
+		}{},
+	},
+}
+
+// apiDocs serves the OpenAPI document and Swagger UI.
+type apiDocs struct {
+	spec []byte
+	ui   []byte
+}
+
+// newAPIDocs documents the routes registered on r under apiVersionPrefix,
+// so it must be called once they all are.
+func newAPIDocs(r *gin.Engine, custom *customMethods, dc config.DocsConfig) (*apiDocs, error) {
+	spec, err := json.Marshal(newOpenAPI(r, custom))
+	if err != nil {
+		return nil, err
+	}
+	assets := dc.SwaggerUIURL
+	if assets == "" {
+		assets = "/docs"
+	}
+	var ui bytes.Buffer
+	if err := swaggerUIPage.Execute(&ui, assets); err != nil {
+		return nil, err
+	}
+	return &apiDocs{spec: spec, ui: ui.Bytes()}, nil
+}
+
+func (d *apiDocs) specEndpoint(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", d.spec)
+}
+
+func (d *apiDocs) uiEndpoint(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", d.ui)
+}
+
+// swaggerUIAssets are the files of Swagger UI served under /docs, with
+// their content type.
+var swaggerUIAssets = map[string]string{
+	"swagger-ui.css":       "text/css; charset=utf-8",
+	"swagger-ui-bundle.js": "application/javascript; charset=utf-8",
+}
+
+// assetEndpoint serves the named Swagger UI file from the copy built into
+// the binary.
+func (d *apiDocs) assetEndpoint(name string) gin.HandlerFunc {
+	data, _ := swaggerui.Asset(name)
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=86400")
+		c.Data(http.StatusOK, swaggerUIAssets[name], data)
+	}
+}
+
+var swaggerUIPage = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>API documentation</title>
+<link rel="stylesheet" href="{{.}}/swagger-ui.css">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="{{.}}/swagger-ui-bundle.js"></script>
+<script>SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui"});</script>
+</body>
+</html>
+`))
+
+// newOpenAPI returns the OpenAPI 3 document of the routes registered on r.
+func newOpenAPI(r *gin.Engine, custom *customMethods) map[string]interface{} {
+	schemas := openAPISchemas{}
+	errorSchema := schemas.of(reflect.TypeOf(ErrorResponse{}))
+	paths := map[string]map[string]interface{}{}
+	routes := r.Routes()
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Path < routes[j].Path
+	})
+	for _, route := range routes {
+		path := custom.Original(route.Method, route.Path)
+		if !strings.HasPrefix(path, apiVersionPrefix+"/") {
+			continue
+		}
+		op := apiOperations[route.Method+" "+path]
+		status := op.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		success := map[string]interface{}{"description": http.StatusText(status)}
+		if op.Response != nil {
+			success["content"] = bodyContent(schemas.of(reflect.TypeOf(op.Response)))
+		}
+		operation := map[string]interface{}{
+			"responses": map[string]interface{}{
+				strconv.Itoa(status): success,
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content":     bodyContent(errorSchema),
+				},
+			},
+		}
+		if op.Summary != "" {
+			operation["summary"] = op.Summary
+		}
+		var params []interface{}
+		segments := strings.Split(path, "/")
+		for i, s := range segments {
+			if s == "" || (s[0] != ':' && s[0] != '*') {
+				continue
+			}
+			params = append(params, map[string]interface{}{
+				"name":     s[1:],
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]interface{}{"type": "string"},
+			})
+			segments[i] = "{" + s[1:] + "}"
+		}
+		for _, p := range op.Query {
+			params = append(params, map[string]interface{}{
+				"name":        p.Name,
+				"in":          "query",
+				"description": p.Description,
+				"schema":      map[string]interface{}{"type": "string"},
+			})
+		}
+		if params != nil {
+			operation["parameters"] = params
+		}
+		if op.Request != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  bodyContent(schemas.of(reflect.TypeOf(op.Request))),
+			}
+		}
+		path = strings.Join(segments, "/")
+		if paths[path] == nil {
+			paths[path] = map[string]interface{}{}
+		}
+		paths[path][strings.ToLower(route.Method)] = operation
+	}
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "homie-search",
+			"version":     strings.TrimPrefix(apiVersionPrefix, "/"),
+			"description": apiDescription,
+		},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": schemas},
+	}
+}
+
+const apiDescription = "Paths without the " + apiVersionPrefix + " prefix are deprecated aliases. " +
+	"Bodies may also be sent, and asked for in Accept, as MessagePack (application/msgpack) " +
+	"or as JSONx (application/xml)."
+
+// bodyContent describes a body by its schema, the same in JSON and
+// MessagePack.
+func bodyContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json":    map[string]interface{}{"schema": schema},
+		"application/msgpack": map[string]interface{}{"schema": schema},
+	}
+}
+
+// openAPISchemas holds the schemas of named struct types, referenced from
+// the schemas using them.
+type openAPISchemas map[string]interface{}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// of returns the schema of values of t as encoding/json renders them.
+func (s openAPISchemas) of(t reflect.Type) map[string]interface{} {
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]interface{}{}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return s.of(t.Elem())
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": s.of(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": s.of(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		if _, ok := s[t.Name()]; !ok {
+			// Claimed before the fields are read, for recursive types.
+			s[t.Name()] = nil
+			s[t.Name()] = s.object(t)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	}
+	return map[string]interface{}{}
+}
+
+// object returns the schema of struct type t.
+func (s openAPISchemas) object(t reflect.Type) map[string]interface{} {
+	properties := map[string]interface{}{}
+	var required []string
+	s.addFields(properties, &required, t)
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if required != nil {
+		sort.Strings(required)
+		schema["required"] = required
+	}
+	return schema
+}
+
+// addFields adds the fields of struct type t to properties, and those
+// required to required, including those of embedded structs without a json
+// name.
+func (s openAPISchemas) addFields(properties map[string]interface{}, required *[]string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			s.addFields(properties, required, ft)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		schema := s.of(f.Type)
+		if addConstraints(schema, f.Tag.Get("validate"), ft.Kind()) {
+			*required = append(*required, name)
+		}
+		if doc := f.Tag.Get("doc"); doc != "" {
+			if _, ok := schema["$ref"]; ok {
+				// Siblings of $ref are ignored in OpenAPI 3.0.
+				schema = map[string]interface{}{"allOf": []interface{}{schema}}
+			}
+			schema["description"] = doc
+		}
+		properties[name] = schema
+	}
+}
+
+// addConstraints adds the length limits of a validate tag to the schema of
+// a field of kind, and reports whether the field is required. Constraints
+// after dive apply to the elements and are left out.
+func addConstraints(schema map[string]interface{}, tag string, kind reflect.Kind) bool {
+	required := false
+	for _, rule := range strings.Split(tag, ",") {
+		if rule == "dive" {
+			break
+		}
+		if rule == "required" {
+			required = true
+		}
+		if !strings.HasPrefix(rule, "max=") {
+			continue
+		}
+		max, err := strconv.Atoi(strings.TrimPrefix(rule, "max="))
+		if err != nil {
+			continue
+		}
+		switch kind {
+		case reflect.String:
+			schema["maxLength"] = max
+		case reflect.Slice:
+			schema["maxItems"] = max
+		case reflect.Map:
+			schema["maxProperties"] = max
+		}
+	}
+	return required
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+)
+
+// List endpoints take the page they return either as page and page_size,
+// counting pages from 1, or as the offset skip and take. They report the
+// total, page and page_size in the body and link the pages around it in
+// an RFC 5988 Link header, so clients can follow rel="next" instead of
+// computing offsets. Endpoints paged by cursor link the next page only.
+
+// pageRange returns the offset and size of the page asked for. The size
+// defaults to search.default_page_size and is capped at
+// search.max_page_size; page and page_size win over skip and take.
+func pageRange(c *gin.Context, sc config.SearchConfig) (from, size int) {
+	size = sc.DefaultPageSize
+	if i, err := strconv.Atoi(c.Query("take")); err == nil && i >= 0 {
+		size = i
+	}
+	if i, err := strconv.Atoi(c.Query("page_size")); err == nil && i >= 0 {
+		size = i
+	}
+	if size > sc.MaxPageSize {
+		size = sc.MaxPageSize
+	}
+	if i, err := strconv.Atoi(c.Query("skip")); err == nil && i > 0 {
+		from = i
+	}
+	if i, err := strconv.Atoi(c.Query("page")); err == nil && i > 0 {
+		from = (i - 1) * size
+	}
+	return from, size
+}
+
+// pageNumber returns the page starting at offset from, counting from 1.
+func pageNumber(from, size int) int {
+	if size <= 0 {
+		return 1
+	}
+	return from/size + 1
+}
+
+// setPageLinks links the first, previous, next and last page of a list of
+// total items of which the page at from of size was returned.
+func setPageLinks(c *gin.Context, from, size int, total int64) {
+	if size <= 0 {
+		return
+	}
+	addPageLink(c, "first", 0, size)
+	if from > 0 {
+		prev := from - size
+		if prev < 0 {
+			prev = 0
+		}
+		addPageLink(c, "prev", prev, size)
+	}
+	if int64(from+size) < total {
+		addPageLink(c, "next", from+size, size)
+	}
+	if total > 0 {
+		addPageLink(c, "last", int((total-1)/int64(size))*size, size)
+	}
+}
+
+// addPageLink links the page at from of size as rel, by page number when
+// it starts on a page boundary and by offset otherwise.
+func addPageLink(c *gin.Context, rel string, from, size int) {
+	addLink(c, rel, func(q url.Values) {
+		q.Del("cursor")
+		if from%size == 0 {
+			q.Del("skip")
+			q.Del("take")
+			q.Set("page", strconv.Itoa(from/size+1))
+			q.Set("page_size", strconv.Itoa(size))
+			return
+		}
+		q.Del("page")
+		q.Del("page_size")
+		q.Set("skip", strconv.Itoa(from))
+		q.Set("take", strconv.Itoa(size))
+	})
+}
+
+// setCursorLink links the page continuing at cursor as the next one.
+func setCursorLink(c *gin.Context, cursor string) {
+	if cursor == "" {
+		return
+	}
+	addLink(c, "next", func(q url.Values) {
+		q.Del("skip")
+		q.Del("page")
+		q.Set("cursor", cursor)
+	})
+}
+
+// addLink adds a Link to the request's URL with the query changed by
+// edit.
+func addLink(c *gin.Context, rel string, edit func(url.Values)) {
+	q := c.Request.URL.Query()
+	edit(q)
+	u := url.URL{Path: publicPath(c.Request), RawQuery: q.Encode()}
+	c.Writer.Header().Add("Link", "<"+u.String()+`>; rel="`+rel+`"`)
+}
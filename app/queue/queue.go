@@ -0,0 +1,402 @@
+// Package queue runs background jobs from a queue kept in Redis, so jobs
+// survive restarts and are shared between replicas. Failed jobs are retried
+// with exponential backoff and moved to a dead-letter list once they run
+// out of attempts.
+//
+// A job being handled is leased to its worker, which renews the lease until
+// the handler returns. Jobs of a worker that died are handed out again once
+// their lease expires; that does not count as an attempt.
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/teris-io/shortid"
+)
+
+// dequeueScript moves due retries and jobs with an expired lease back to
+// the ready list, then leases the oldest ready job and returns it.
+//
+// KEYS: ready, delayed, processing, jobs. ARGV: now and lease, in ms.
+var dequeueScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1], 'LIMIT', 0, 100)
+for _, id in ipairs(due) do
+	redis.call('ZREM', KEYS[2], id)
+	redis.call('LPUSH', KEYS[1], id)
+end
+local expired = redis.call('ZRANGEBYSCORE', KEYS[3], '-inf', ARGV[1], 'LIMIT', 0, 100)
+for _, id in ipairs(expired) do
+	redis.call('ZREM', KEYS[3], id)
+	redis.call('RPUSH', KEYS[1], id)
+end
+while true do
+	local id = redis.call('RPOP', KEYS[1])
+	if not id then return false end
+	local job = redis.call('HGET', KEYS[4], id)
+	if job then
+		redis.call('ZADD', KEYS[3], tonumber(ARGV[1]) + tonumber(ARGV[2]), id)
+		return job
+	end
+end
+`)
+
+// buryScript moves a job to the dead-letter list, dropping the oldest dead
+// jobs beyond ARGV[3].
+//
+// KEYS: processing, dead, jobs, pending. ARGV: id, job, max dead, type.
+var buryScript = redis.NewScript(`
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('HSET', KEYS[3], ARGV[1], ARGV[2])
+redis.call('HINCRBY', KEYS[4], ARGV[4], -1)
+redis.call('LPUSH', KEYS[2], ARGV[1])
+while redis.call('LLEN', KEYS[2]) > tonumber(ARGV[3]) do
+	redis.call('HDEL', KEYS[3], redis.call('RPOP', KEYS[2]))
+end
+`)
+
+// Job is a unit of work. Payload is the JSON value given to Enqueue.
+type Job struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload"`
+	Attempts   int             `json:"attempts"`
+	LastError  string          `json:"last_error,omitempty"`
+	EnqueuedAt time.Time       `json:"enqueued_at"`
+	FailedAt   *time.Time      `json:"failed_at,omitempty"`
+}
+
+// Decode unmarshals the payload into v.
+func (j *Job) Decode(v interface{}) error {
+	return json.Unmarshal(j.Payload, v)
+}
+
+// Handler runs a job. A returned error schedules a retry; ctx is canceled
+// when the queue stops.
+type Handler func(ctx context.Context, job *Job) error
+
+// Options configure a Queue. Workers jobs are handled at a time. A failed
+// job is retried after Backoff, doubling per attempt up to MaxBackoff,
+// until it has run MaxAttempts times; the last DeadLetters failed jobs are
+// kept. Workers poll every PollInterval while the queue is empty, and lease
+// jobs for Lease.
+type Options struct {
+	Workers      int
+	MaxAttempts  int
+	Backoff      time.Duration
+	MaxBackoff   time.Duration
+	Lease        time.Duration
+	PollInterval time.Duration
+	DeadLetters  int
+}
+
+// Stats counts the jobs of a queue by state.
+type Stats struct {
+	Ready      int64            `json:"ready"`
+	Delayed    int64            `json:"delayed"`
+	Processing int64            `json:"processing"`
+	Dead       int64            `json:"dead"`
+	Pending    map[string]int64 `json:"pending"` // not yet done, by type
+}
+
+type handler struct {
+	fn          Handler
+	maxAttempts int
+}
+
+// Queue is a job queue stored in Redis under its name.
+type Queue struct {
+	client redis.UniversalClient
+	prefix string
+	opts   Options
+
+	mu       sync.RWMutex
+	handlers map[string]handler
+}
+
+// New returns a Queue. Every key shares a hash tag derived from name, so
+// the queue lives in one Redis Cluster slot.
+func New(client redis.UniversalClient, name string, opts Options) *Queue {
+	return &Queue{
+		client:   client,
+		prefix:   "{queue:" + name + "}:",
+		opts:     opts,
+		handlers: make(map[string]handler),
+	}
+}
+
+// Handle registers fn for jobs of jobType. maxAttempts overrides
+// Options.MaxAttempts when positive.
+func (q *Queue) Handle(jobType string, maxAttempts int, fn Handler) {
+	if maxAttempts <= 0 {
+		maxAttempts = q.opts.MaxAttempts
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[jobType] = handler{fn: fn, maxAttempts: maxAttempts}
+}
+
+// Enqueue adds a job of jobType with payload, marshaled to JSON, and
+// returns its ID.
+func (q *Queue) Enqueue(jobType string, payload interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	job := Job{
+		ID:         shortid.MustGenerate(),
+		Type:       jobType,
+		Payload:    data,
+		EnqueuedAt: time.Now().UTC(),
+	}
+	value, err := json.Marshal(job)
+	if err != nil {
+		return "", err
+	}
+	_, err = q.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.HSet(q.key("jobs"), job.ID, value)
+		pipe.HIncrBy(q.key("pending"), jobType, 1)
+		pipe.LPush(q.key("ready"), job.ID)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return job.ID, nil
+}
+
+// Pending returns how many jobs of jobType are not done yet.
+func (q *Queue) Pending(jobType string) (int64, error) {
+	n, err := q.client.HGet(q.key("pending"), jobType).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return n, err
+}
+
+// Stats counts the jobs by state.
+func (q *Queue) Stats() (Stats, error) {
+	var ready, delayed, processing, dead *redis.IntCmd
+	var pending *redis.StringStringMapCmd
+	_, err := q.client.Pipelined(func(pipe redis.Pipeliner) error {
+		ready = pipe.LLen(q.key("ready"))
+		delayed = pipe.ZCard(q.key("delayed"))
+		processing = pipe.ZCard(q.key("processing"))
+		dead = pipe.LLen(q.key("dead"))
+		pending = pipe.HGetAll(q.key("pending"))
+		return nil
+	})
+	if err != nil {
+		return Stats{}, err
+	}
+	s := Stats{
+		Ready:      ready.Val(),
+		Delayed:    delayed.Val(),
+		Processing: processing.Val(),
+		Dead:       dead.Val(),
+		Pending:    make(map[string]int64),
+	}
+	for t, v := range pending.Val() {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			s.Pending[t] = n
+		}
+	}
+	return s, nil
+}
+
+// DeadLetters returns up to n of the most recently failed jobs.
+func (q *Queue) DeadLetters(n int64) ([]Job, error) {
+	ids, err := q.client.LRange(q.key("dead"), 0, n-1).Result()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	values, err := q.client.HMGet(q.key("jobs"), ids...).Result()
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]Job, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		var job Job
+		if err := json.Unmarshal([]byte(s), &job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// Run starts the workers and blocks until stop is closed. Handlers still
+// running are canceled.
+func (q *Queue) Run(stop <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := 0; i < q.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx, stop)
+		}()
+	}
+	<-stop
+	cancel()
+	wg.Wait()
+}
+
+func (q *Queue) work(ctx context.Context, stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		job, err := q.dequeue()
+		if err != nil {
+			log.Printf("queue: cannot dequeue: %v", err)
+		}
+		if job == nil {
+			select {
+			case <-stop:
+				return
+			case <-time.After(q.opts.PollInterval):
+			}
+			continue
+		}
+		q.process(ctx, job)
+	}
+}
+
+func (q *Queue) dequeue() (*Job, error) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	keys := []string{q.key("ready"), q.key("delayed"), q.key("processing"), q.key("jobs")}
+	res, err := dequeueScript.Run(q.client, keys, now, int64(q.opts.Lease/time.Millisecond)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s, _ := res.(string)
+	var job Job
+	if err := json.Unmarshal([]byte(s), &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// process runs the handler of job, renewing its lease meanwhile, and
+// records the outcome.
+func (q *Queue) process(ctx context.Context, job *Job) {
+	q.mu.RLock()
+	h, ok := q.handlers[job.Type]
+	q.mu.RUnlock()
+
+	done := make(chan struct{})
+	go q.renew(job.ID, done)
+	var err error
+	if ok {
+		err = safeRun(ctx, h.fn, job)
+	} else {
+		err = fmt.Errorf("no handler for job type %q", job.Type)
+		h.maxAttempts = q.opts.MaxAttempts
+	}
+	close(done)
+
+	if err == nil {
+		_, err = q.client.TxPipelined(func(pipe redis.Pipeliner) error {
+			pipe.ZRem(q.key("processing"), job.ID)
+			pipe.HDel(q.key("jobs"), job.ID)
+			pipe.HIncrBy(q.key("pending"), job.Type, -1)
+			return nil
+		})
+		if err != nil {
+			log.Printf("queue: cannot complete job %s: %v", job.ID, err)
+		}
+		return
+	}
+
+	job.Attempts++
+	job.LastError = err.Error()
+	if job.Attempts >= h.maxAttempts {
+		now := time.Now().UTC()
+		job.FailedAt = &now
+		log.Printf("queue: giving up on %s job %s after %d attempts: %v", job.Type, job.ID, job.Attempts, err)
+		value, _ := json.Marshal(job)
+		keys := []string{q.key("processing"), q.key("dead"), q.key("jobs"), q.key("pending")}
+		if err := buryScript.Run(q.client, keys, job.ID, value, q.opts.DeadLetters, job.Type).Err(); err != nil && err != redis.Nil {
+			log.Printf("queue: cannot move job %s to the dead letters: %v", job.ID, err)
+		}
+		return
+	}
+	delay := q.backoff(job.Attempts)
+	log.Printf("queue: %s job %s failed, retrying in %v: %v", job.Type, job.ID, delay, err)
+	value, _ := json.Marshal(job)
+	_, err = q.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.HSet(q.key("jobs"), job.ID, value)
+		pipe.ZRem(q.key("processing"), job.ID)
+		pipe.ZAdd(q.key("delayed"), redis.Z{
+			Score:  float64(time.Now().Add(delay).UnixNano() / int64(time.Millisecond)),
+			Member: job.ID,
+		})
+		return nil
+	})
+	if err != nil {
+		log.Printf("queue: cannot schedule retry of job %s: %v", job.ID, err)
+	}
+}
+
+// renew extends the lease of job id until done is closed.
+func (q *Queue) renew(id string, done <-chan struct{}) {
+	ticker := time.NewTicker(q.opts.Lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		deadline := time.Now().Add(q.opts.Lease).UnixNano() / int64(time.Millisecond)
+		err := q.client.ZAddXX(q.key("processing"), redis.Z{Score: float64(deadline), Member: id}).Err()
+		if err != nil {
+			log.Printf("queue: cannot renew lease of job %s: %v", id, err)
+		}
+	}
+}
+
+// backoff returns the delay before retrying a job that failed attempts
+// times.
+func (q *Queue) backoff(attempts int) time.Duration {
+	d := q.opts.Backoff
+	for i := 1; i < attempts && d < q.opts.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > q.opts.MaxBackoff {
+		d = q.opts.MaxBackoff
+	}
+	return d
+}
+
+func (q *Queue) key(name string) string {
+	return q.prefix + name
+}
+
+// safeRun calls fn, turning a panic into an error so a bad job cannot take
+// the worker down.
+func safeRun(ctx context.Context, fn Handler, job *Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx, job)
+}
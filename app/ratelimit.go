@@ -0,0 +1,122 @@
+package main
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// Requests are counted in Redis so the limits hold across replicas. Each
+// limit uses a sliding window approximated from two fixed windows: the
+// count of the previous window is weighted by how much of it still overlaps
+// the sliding window.
+
+// rateLimitScript checks the global counters in KEYS[1] (current window)
+// and KEYS[2] (previous window) and the client counters in KEYS[3] and
+// KEYS[4] against ARGV[1] and ARGV[2], weighting previous windows by
+// ARGV[3]. It counts the request and returns 1 when both allow it, and
+// returns 0 otherwise.
+var rateLimitScript = redis.NewScript(`
+local weight = tonumber(ARGV[3])
+local function allowed(current, previous, limit)
+	if limit <= 0 then return true end
+	local c = tonumber(redis.call('GET', current) or '0')
+	local p = tonumber(redis.call('GET', previous) or '0')
+	return p * weight + c < limit
+end
+local global, client = tonumber(ARGV[1]), tonumber(ARGV[2])
+if not allowed(KEYS[1], KEYS[2], global) or not allowed(KEYS[3], KEYS[4], client) then
+	return 0
+end
+for i, limit in ipairs({global, global, client, client}) do
+	if i % 2 == 1 and limit > 0 then
+		redis.call('INCR', KEYS[i])
+		redis.call('PEXPIRE', KEYS[i], ARGV[4])
+	end
+end
+return 1
+`)
+
+type rateLimiter struct {
+	client redis.UniversalClient
+}
+
+func newRateLimiter(client redis.UniversalClient) *rateLimiter {
+	return &rateLimiter{client: client}
+}
+
+// Limit enforces the rate limit configured for the named route group,
+// answering 429 with Retry-After once it is reached. Redis errors let the
+// request through.
+func (l *rateLimiter) Limit(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limits := currentConfig().RateLimits
+		rl, ok := limits[group]
+		if !ok {
+			rl, ok = limits["default"]
+		}
+		if !ok || (rl.Global <= 0 && rl.PerClient <= 0) {
+			c.Next()
+			return
+		}
+		allowed, retryAfter, err := l.allow(group, rateLimitClient(c), rl)
+		if err != nil {
+			log.Printf("rate limit: %v", err)
+			c.Next()
+			return
+		}
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			errorResponse(c, http.StatusTooManyRequests, "Rate limit exceeded")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// allow counts a request of client against rl. When it is refused it
+// returns how long until the current window ends.
+func (l *rateLimiter) allow(group, client string, rl config.RateLimitConfig) (bool, time.Duration, error) {
+	now := time.Now()
+	window := int64(rl.Window)
+	index := now.UnixNano() / window
+	elapsed := float64(now.UnixNano()%window) / float64(window)
+	// A script may only touch keys of one cluster slot, so all keys of a
+	// group share a hash tag.
+	globalTag := "ratelimit:{" + group + "}:global"
+	clientTag := "ratelimit:{" + group + "}:" + client
+	keys := []string{
+		globalTag + ":" + strconv.FormatInt(index, 10),
+		globalTag + ":" + strconv.FormatInt(index-1, 10),
+		clientTag + ":" + strconv.FormatInt(index, 10),
+		clientTag + ":" + strconv.FormatInt(index-1, 10),
+	}
+	res, err := rateLimitScript.Run(l.client, keys,
+		rl.Global, rl.PerClient, 1-elapsed, int64(2*rl.Window/time.Millisecond)).Result()
+	if err != nil {
+		return false, 0, err
+	}
+	return res == int64(1), time.Duration(window - now.UnixNano()%window), nil
+}
+
+// rateLimitClient identifies the client a request is counted against: the
+// client of its session, its API key when it is one of sessions.api_keys or
+// sessions.admin_api_keys, or its IP address. Unknown keys are ignored, or
+// every request could claim a fresh allowance with a made-up key.
+func rateLimitClient(c *gin.Context) string {
+	if s, ok := requestSession(c); ok {
+		return s.Client
+	}
+	sc := currentConfig().Sessions
+	if key := c.GetHeader("X-API-Key"); validAPIKey(sc.APIKeys, key) || validAPIKey(sc.AdminAPIKeys, key) {
+		return apiKeyClient(key)
+	}
+	return "ip:" + clientIP(c)
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// maxRawQueryDepth bounds how deeply compound clauses may nest.
+const maxRawQueryDepth = 8
+
+// rawLeafClauses are the leaf query clauses /search/raw accepts. Their
+// bodies are passed through; they cannot contain further queries.
+var rawLeafClauses = map[string]bool{
+	"match":        true,
+	"match_phrase": true,
+	"multi_match":  true,
+	"match_all":    true,
+	"term":         true,
+	"terms":        true,
+	"range":        true,
+	"exists":       true,
+	"prefix":       true,
+	"ids":          true,
+}
+
+// rawCompoundClauses are the compound clauses /search/raw accepts, with the
+// keys each may use. Keys mapped to true hold queries and are validated
+// recursively; the others hold plain options.
+var rawCompoundClauses = map[string]map[string]bool{
+	"bool": {
+		"must":                 true,
+		"should":               true,
+		"filter":               true,
+		"must_not":             true,
+		"minimum_should_match": false,
+		"boost":                false,
+	},
+	"nested": {
+		"query":           true,
+		"path":            false,
+		"score_mode":      false,
+		"ignore_unmapped": false,
+	},
+	"constant_score": {
+		"filter": true,
+		"boost":  false,
+	},
+}
+
+// validateRawQuery checks q against the clause whitelist.
+func validateRawQuery(q interface{}, depth int) error {
+	if depth > maxRawQueryDepth {
+		return fmt.Errorf("Query nests deeper than %d levels", maxRawQueryDepth)
+	}
+	clause, ok := q.(map[string]interface{})
+	if !ok || len(clause) != 1 {
+		return errors.New("A query must be an object with exactly one clause")
+	}
+	for name, body := range clause {
+		if rawLeafClauses[name] {
+			return nil
+		}
+		keys, ok := rawCompoundClauses[name]
+		if !ok {
+			return fmt.Errorf("Clause %q is not allowed", name)
+		}
+		opts, ok := body.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("Clause %q must be an object", name)
+		}
+		for key, value := range opts {
+			holdsQuery, ok := keys[key]
+			if !ok {
+				return fmt.Errorf("Option %q is not allowed in %q", key, name)
+			}
+			if !holdsQuery {
+				continue
+			}
+			queries, isList := value.([]interface{})
+			if !isList {
+				queries = []interface{}{value}
+			}
+			for _, sub := range queries {
+				if err := validateRawQuery(sub, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// rawSearchEndpoint runs a query written in a whitelisted subset of the
+// Elasticsearch query DSL, for callers who need more than the multi-match
+// of /search.
+func rawSearchEndpoint(c *gin.Context) {
+	type request struct {
+		Query json.RawMessage `json:"query"`
+		From  int             `json:"from"`
+		Size  int             `json:"size"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	var query interface{}
+	if err := json.Unmarshal(req.Query, &query); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Query not specified")
+		return
+	}
+	if err := validateRawQuery(query, 0); err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	cfg := currentConfig()
+	size := req.Size
+	if size <= 0 {
+		size = cfg.Search.DefaultPageSize
+	}
+	if size > cfg.Search.MaxPageSize {
+		size = cfg.Search.MaxPageSize
+	}
+	if req.From < 0 {
+		req.From = 0
+	}
+	result, err := elasticClient().Search().
+		Index(cfg.Elasticsearch.ReadAlias).
+		Query(elastic.NewBoolQuery().Must(elastic.NewRawStringQuery(string(req.Query))).Filter(notDeleted())).
+		From(req.From).Size(size).
+		Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		if e, ok := err.(*elastic.Error); ok && e.Status == http.StatusBadRequest {
+			errorResponse(c, http.StatusBadRequest, "Invalid query")
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Something went wrong")
+		return
+	}
+	writeSearchResponse(c, newSearchResponse(result), req.From, size)
+}
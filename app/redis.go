@@ -0,0 +1,118 @@
+package main
+
+import (
+	"log"
+	"net"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/go-redis/redis"
+)
+
+// newRedisClient creates the Redis client. It is created once at startup
+// and shared, since every client holds its own connection pool. New
+// connections authenticate with the credentials currently configured, so a
+// rotated password is picked up without replacing the client. With
+// Sentinel the client follows the master across failovers; in cluster mode
+// commands, and the commands of a pipeline, are routed by key slot. TLS is
+// only available towards a standalone server.
+func newRedisClient(rc config.RedisConfig) (redis.UniversalClient, error) {
+	// The client would send SELECT before OnConnect, where AUTH is, so the
+	// database is selected here too and left at 0 in the options.
+	onConnect := func(cn *redis.Conn) error {
+		current := currentConfig().Redis
+		if current.Password != "" {
+			var cmd *redis.StatusCmd
+			if current.Username != "" {
+				// The client only knows the single-argument AUTH.
+				cmd = redis.NewStatusCmd("auth", current.Username, current.Password)
+				cn.Process(cmd)
+			} else {
+				cmd = cn.Auth(current.Password)
+			}
+			if err := cmd.Err(); err != nil {
+				return err
+			}
+		}
+		if rc.DB > 0 {
+			return cn.Select(rc.DB).Err()
+		}
+		return nil
+	}
+	switch rc.Mode {
+	case "cluster":
+		addrs := rc.ClusterAddrs
+		if len(addrs) == 0 {
+			addrs = []string{rc.Addr}
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:              addrs,
+			OnConnect:          onConnect,
+			PoolSize:           rc.PoolSize,
+			PoolTimeout:        rc.PoolTimeout,
+			IdleTimeout:        rc.IdleTimeout,
+			IdleCheckFrequency: rc.IdleCheckFrequency,
+			DialTimeout:        rc.DialTimeout,
+			ReadTimeout:        rc.ReadTimeout,
+			WriteTimeout:       rc.WriteTimeout,
+		}), nil
+	case "sentinel":
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:         rc.Sentinel.MasterName,
+			SentinelAddrs:      rc.Sentinel.Addrs,
+			OnConnect:          onConnect,
+			PoolSize:           rc.PoolSize,
+			PoolTimeout:        rc.PoolTimeout,
+			IdleTimeout:        rc.IdleTimeout,
+			IdleCheckFrequency: rc.IdleCheckFrequency,
+			DialTimeout:        rc.DialTimeout,
+			ReadTimeout:        rc.ReadTimeout,
+			WriteTimeout:       rc.WriteTimeout,
+		}), nil
+	}
+	opts := &redis.Options{
+		Addr:               rc.Addr,
+		OnConnect:          onConnect,
+		PoolSize:           rc.PoolSize,
+		PoolTimeout:        rc.PoolTimeout,
+		IdleTimeout:        rc.IdleTimeout,
+		IdleCheckFrequency: rc.IdleCheckFrequency,
+		DialTimeout:        rc.DialTimeout,
+		ReadTimeout:        rc.ReadTimeout,
+		WriteTimeout:       rc.WriteTimeout,
+	}
+	if rc.TLS.Enabled {
+		tlsConfig, err := newTLSConfig(rc.TLS.TLSConfig)
+		if err != nil {
+			return nil, err
+		}
+		if host, _, err := net.SplitHostPort(rc.Addr); err == nil {
+			tlsConfig.ServerName = host
+		}
+		opts.TLSConfig = tlsConfig
+	}
+	return redis.NewClient(opts), nil
+}
+
+// watchRedis pings Redis every interval and logs when it becomes
+// unreachable and when it recovers.
+func watchRedis(stop <-chan struct{}, client redis.UniversalClient, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	healthy := true
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			err := client.Ping().Err()
+			switch {
+			case err != nil && healthy:
+				log.Printf("redis unreachable: %v", err)
+			case err == nil && !healthy:
+				log.Println("redis reachable again")
+			}
+			healthy = err == nil
+		}
+	}
+}
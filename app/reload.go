@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log"
+
+	"github.com/awesomeProject/homie-search/app/config"
+)
+
+// reloadConfig swaps in a configuration read from the watched config file
+// or carrying rotated credentials.
+// Tunables take effect on the next request; a changed Elasticsearch
+// endpoint re-dials the client and a changed Couchbase endpoint reopens
+// the bucket. The listen port and the Redis address and
+// pool settings are only read at startup.
+func reloadConfig(next *config.Config) {
+	prev := currentConfig()
+	currentCfg.Store(next)
+	log.Println("configuration reloaded")
+
+	if next.Elasticsearch.URL != prev.Elasticsearch.URL ||
+		next.Elasticsearch.Username != prev.Elasticsearch.Username ||
+		next.Elasticsearch.Password != prev.Elasticsearch.Password ||
+		next.Elasticsearch.APIKey != prev.Elasticsearch.APIKey ||
+		next.Elasticsearch.TLS != prev.Elasticsearch.TLS ||
+		next.Elasticsearch.Sniff != prev.Elasticsearch.Sniff {
+		if err := esHolder.Dial(next.Elasticsearch); err != nil {
+			log.Printf("cannot re-dial elasticsearch, keeping previous client: %v", err)
+		}
+	}
+	if next.Couchbase.URL != prev.Couchbase.URL ||
+		next.Couchbase.Username != prev.Couchbase.Username ||
+		next.Couchbase.Password != prev.Couchbase.Password ||
+		next.Couchbase.Pool != prev.Couchbase.Pool ||
+		next.Couchbase.Bucket != prev.Couchbase.Bucket ||
+		next.Couchbase.BucketUsername != prev.Couchbase.BucketUsername ||
+		next.Couchbase.BucketPassword != prev.Couchbase.BucketPassword ||
+		next.Couchbase.TLS != prev.Couchbase.TLS {
+		if err := cbHolder.Dial(next.Couchbase); err != nil {
+			log.Printf("cannot reopen couchbase bucket, keeping previous one: %v", err)
+		}
+	}
+}
+
+// debugf logs only when the configured log level is "debug".
+func debugf(format string, args ...interface{}) {
+	if currentConfig().Log.Level == "debug" {
+		log.Printf(format, args...)
+	}
+}
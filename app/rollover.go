@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// With rollover enabled documents are written to <index>-000001,
+// <index>-000002, ... through the write alias. An ILM policy rolls the
+// write alias over to a new index by age or size, and an index template
+// gives every new index the mapping, the read alias and the policy, so
+// searches through the read alias span all of them.
+
+// rolloverIndexName is the first index of the rollover series. The pattern
+// of the template only matches the numbered indices, not versioned ones
+// created by a reindex.
+func rolloverIndexName(prefix string) string {
+	return prefix + "-000001"
+}
+
+// ensureLifecycle puts the ILM policy and the index template. Both are
+// overwritten on every start so config changes take effect for indices
+// created afterwards.
+func ensureLifecycle(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig) error {
+	rc := ec.Rollover
+	rollover := map[string]interface{}{}
+	if rc.MaxAge != "" {
+		rollover["max_age"] = rc.MaxAge
+	}
+	if rc.MaxSize != "" {
+		rollover["max_size"] = rc.MaxSize
+	}
+	phases := map[string]interface{}{
+		"hot": map[string]interface{}{
+			"actions": map[string]interface{}{"rollover": rollover},
+		},
+	}
+	if rc.DeleteAfter != "" {
+		phases["delete"] = map[string]interface{}{
+			"min_age": rc.DeleteAfter,
+			"actions": map[string]interface{}{"delete": map[string]interface{}{}},
+		}
+	}
+	_, err := client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "PUT",
+		Path:   "/_ilm/policy/" + rc.Policy,
+		Body:   map[string]interface{}{"policy": map[string]interface{}{"phases": phases}},
+	})
+	if err != nil {
+		return err
+	}
+
+	body := documentIndexBody(ec, nil)
+	settings := body["settings"].(map[string]interface{})
+	settings["index.lifecycle.name"] = rc.Policy
+	settings["index.lifecycle.rollover_alias"] = ec.WriteAlias
+	body["index_patterns"] = []string{ec.Index + "-0*"}
+	body["aliases"] = map[string]interface{}{ec.ReadAlias: map[string]interface{}{}}
+	_, err = client.IndexPutTemplate(ec.Index + "-rollover").BodyJson(body).Do(ctx)
+	return err
+}
+
+// documentIndex returns the index to address document id through. Without
+// rollover that is alias itself; with rollover it is the index holding the
+// document, since single-document APIs cannot go through an alias spanning
+// several indices and a write through the write alias would create a copy
+// in the newest one. A missing document is reported as an error
+// satisfying elastic.IsNotFound.
+func documentIndex(ctx context.Context, ec config.ElasticsearchConfig, id, alias string) (string, error) {
+	if !ec.Rollover.Enabled {
+		return alias, nil
+	}
+	result, err := elasticClient().Search().
+		Index(ec.ReadAlias).
+		Query(elastic.NewIdsQuery(ec.Type).Ids(id)).
+		FetchSource(false).
+		Size(1).
+		Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Hits.Hits) == 0 {
+		return "", &elastic.Error{Status: http.StatusNotFound}
+	}
+	return result.Hits.Hits[0].Index, nil
+}
+
+// withoutRollover refuses requests that need all documents in one index.
+func withoutRollover(c *gin.Context) {
+	if currentConfig().Elasticsearch.Rollover.Enabled {
+		errorResponse(c, http.StatusConflict, "Not supported while index rollover is enabled")
+		c.Abort()
+		return
+	}
+	c.Next()
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/cache"
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// routeCaches caches GET responses of routes by the policies in
+// cache.routes. Each route has a cache of its own, so its TTL and
+// invalidations do not touch the others.
+type routeCaches struct {
+	client redis.UniversalClient
+
+	mu     sync.Mutex
+	caches map[string]*cache.Cache
+}
+
+var responseCaches *routeCaches
+
+func newRouteCaches(client redis.UniversalClient) *routeCaches {
+	return &routeCaches{client: client, caches: make(map[string]*cache.Cache)}
+}
+
+// Cache serves the named route from its cache while it has a policy,
+// setting X-Cache to HIT, MISS or BYPASS. Responses are stored with their
+// content type ahead of the body.
+func (rc *routeCaches) Cache(route string) gin.HandlerFunc {
+	responses := rc.get(route)
+	return func(c *gin.Context) {
+		policy, ok := currentConfig().Cache.Routes[route]
+		if !ok || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+		if policy.BypassHeader != "" && c.GetHeader(policy.BypassHeader) != "" {
+			c.Header("X-Cache", "BYPASS")
+			c.Next()
+			return
+		}
+		filled := false
+		entry, ok := responses.Fetch(routeCacheKey(c, policy), func() ([]byte, bool) {
+			filled = true
+			c.Header("X-Cache", "MISS")
+			w := &recordingWriter{ResponseWriter: c.Writer}
+			c.Writer = w
+			c.Next()
+			contentType := w.Header().Get("Content-Type")
+			return append([]byte(contentType+"\n"), w.body.Bytes()...), w.Status() == http.StatusOK
+		})
+		if filled {
+			return
+		}
+		if !ok {
+			c.Header("X-Cache", "MISS")
+			c.Next()
+			return
+		}
+		i := bytes.IndexByte(entry, '\n')
+		if i < 0 {
+			c.Next()
+			return
+		}
+		c.Header("X-Cache", "HIT")
+		c.Data(http.StatusOK, string(entry[:i]), entry[i+1:])
+		c.Abort()
+	}
+}
+
+// get returns the cache of route, creating it on first use.
+func (rc *routeCaches) get(route string) *cache.Cache {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if c, ok := rc.caches[route]; ok {
+		return c
+	}
+	c := cache.New(rc.client, "route:"+route, func() time.Duration {
+		return currentConfig().Cache.Routes[route].TTL
+	}, func() int {
+		return currentConfig().Cache.LocalSize
+	})
+	go c.Listen(nil)
+	rc.caches[route] = c
+	return c
+}
+
+// invalidateDocuments drops the responses of the routes that serve
+// documents.
+func (rc *routeCaches) invalidateDocuments() {
+	routes := currentConfig().Cache.Routes
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for route, c := range rc.caches {
+		if routes[route].InvalidateOnWrite {
+			c.Invalidate()
+		}
+	}
+}
+
+// routeCacheKey is the path plus the varying parameters and headers, with
+// parameters and repeated values sorted.
+func routeCacheKey(c *gin.Context, policy config.CachePolicy) string {
+	params := c.Request.URL.Query()
+	if len(policy.VaryParams) > 0 {
+		varying := make(map[string][]string)
+		for _, name := range policy.VaryParams {
+			if v, ok := params[name]; ok {
+				varying[name] = v
+			}
+		}
+		params = varying
+	}
+	for _, v := range params {
+		sort.Strings(v)
+	}
+	var b strings.Builder
+	b.WriteString(c.Request.URL.Path)
+	b.WriteString("?")
+	b.WriteString(params.Encode())
+	for _, name := range policy.VaryHeaders {
+		b.WriteString("#" + strings.ToLower(name) + "=" + c.GetHeader(name))
+	}
+	return b.String()
+}
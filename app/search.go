@@ -0,0 +1,582 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+type SearchResponse struct {
+	Time      string `json:"time"`
+	Hits      string `json:"hit"`
+	Documents []DocumentResponse
+	Facets    map[string][]FacetBucket `json:"facets,omitempty"`
+
+	// Suggestions are corrected queries, offered when few documents match.
+	Suggestions []string `json:"suggestions,omitempty"`
+
+	// NextCursor continues the result list when passed back as cursor. It
+	// is only set when cursor pagination was requested and more hits may
+	// follow.
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	// Total counts the matching documents, of which page Page of PageSize
+	// is returned.
+	Total    int64 `json:"total"`
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+
+	// took feeds TookMS of SearchResponseV2.
+	took int64
+}
+
+// responseVersionHeader selects the search response format. Version 2
+// reports numbers as numbers and adds the page; any other value gets the
+// original format.
+const responseVersionHeader = "X-Response-Version"
+
+// SearchResponseV2 is the search response for clients sending
+// X-Response-Version: 2.
+type SearchResponseV2 struct {
+	TookMS      int64                    `json:"took_ms"`
+	Total       int64                    `json:"total"`
+	Page        int                      `json:"page"`
+	PageSize    int                      `json:"page_size"`
+	Documents   []DocumentResponse       `json:"documents"`
+	Facets      map[string][]FacetBucket `json:"facets,omitempty"`
+	Suggestions []string                 `json:"suggestions,omitempty"`
+	NextCursor  string                   `json:"next_cursor,omitempty"`
+}
+
+// writeSearchResponse renders res in the format the client asked for.
+// from and size are the offset and page size the search was run with.
+func writeSearchResponse(c *gin.Context, res SearchResponse, from, size int) {
+	res.Page = pageNumber(from, size)
+	res.PageSize = size
+	if c.GetHeader(responseVersionHeader) != "2" {
+		c.JSON(http.StatusOK, res)
+		return
+	}
+	c.Header(responseVersionHeader, "2")
+	c.JSON(http.StatusOK, SearchResponseV2{
+		TookMS:      res.took,
+		Total:       res.Total,
+		Page:        res.Page,
+		PageSize:    res.PageSize,
+		Documents:   res.Documents,
+		Facets:      res.Facets,
+		Suggestions: res.Suggestions,
+		NextCursor:  res.NextCursor,
+	})
+}
+
+// setSearchLinks links the pages around the one of res, or the next page
+// when paging by cursor.
+func setSearchLinks(c *gin.Context, res SearchResponse, from, size int) {
+	if _, paginate := c.GetQuery("cursor"); paginate {
+		setCursorLink(c, res.NextCursor)
+		return
+	}
+	setPageLinks(c, from, size, res.Total)
+}
+
+// FacetBucket is one value of a facet and the number of matching documents.
+type FacetBucket struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count"`
+}
+
+func searchEndpoint(c *gin.Context) {
+	if searchBackend(c) == backendCouchbase {
+		ftsSearchEndpoint(c)
+		return
+	}
+	// Parse request
+	query := c.Query("query")
+	if query == "" {
+		errorResponse(c, http.StatusBadRequest, "Query not specified")
+		return
+	}
+	cfg := currentConfig()
+	skip, take := pageRange(c, cfg.Search)
+	debugf("search query=%q skip=%d take=%d", query, skip, take)
+	sorters, err := parseSort(c.Query("sort"))
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	source, err := parseFields(c.Query("fields"))
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	filters, err := parseFilters(c)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	cursor, paginate := c.GetQuery("cursor")
+	var after []interface{}
+	if cursor != "" {
+		if after, err = decodeCursor(cursor); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		skip = 0
+	}
+	if paginate {
+		// search_after needs a total order, so break ties on the id.
+		if len(sorters) == 0 {
+			sorters = append(sorters, elastic.NewScoreSort())
+		}
+		sorters = append(sorters, elastic.NewFieldSort("id"))
+	}
+	highlight := c.Query("highlight") == "true"
+	facets := c.Query("facets") == "true"
+	lang := c.Query("lang")
+	if !supportedLanguage(lang) {
+		errorResponse(c, http.StatusBadRequest, "Unsupported language "+lang)
+		return
+	}
+	fuzziness, err := parseFuzziness(c.Query("fuzziness"), cfg.Search)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	operator := c.DefaultQuery("operator", "or")
+	if operator != "or" && operator != "and" {
+		errorResponse(c, http.StatusBadRequest, "Operator must be and or or")
+		return
+	}
+	msm := c.DefaultQuery("minimum_should_match", cfg.Search.MinimumShouldMatch)
+	if !config.ValidMinimumShouldMatch(msm) {
+		errorResponse(c, http.StatusBadRequest, "Invalid minimum_should_match "+msm)
+		return
+	}
+	esQuery := elastic.NewMultiMatchQuery(query, searchFields(lang, searchBoosts.Effective(cfg.Search))...).
+		MinimumShouldMatch(msm).
+		Operator(operator)
+	if featureFlags.Enabled("enable_fuzzy_search") && fuzziness != "0" {
+		esQuery.Fuzziness(fuzziness)
+	}
+	search := elasticClient().Search().
+		Index(cfg.Elasticsearch.ReadAlias).
+		Query(elastic.NewBoolQuery().Must(esQuery).Filter(filters...)).
+		From(skip).Size(take).
+		SortBy(sorters...)
+	if source != nil {
+		search = search.FetchSourceContext(source)
+	}
+	if len(after) > 0 {
+		search = search.SearchAfter(after...)
+	}
+	if highlight {
+		search = search.Highlight(elastic.NewHighlight().Fields(
+			elastic.NewHighlighterField("title").NumOfFragments(0),
+			elastic.NewHighlighterField("content"),
+		))
+	}
+	if facets {
+		for _, f := range cfg.Search.Facets {
+			search = search.Aggregation(f.Name, facetAggregation(f))
+		}
+	}
+	if cfg.Search.DidYouMeanBelow > 0 {
+		search = search.Suggester(elastic.NewPhraseSuggester("didyoumean").
+			Text(query).
+			Field("content").
+			Size(3))
+	}
+	result, err := search.Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Something went wrong")
+		return
+	}
+	res := newSearchResponse(result)
+	if paginate && len(result.Hits.Hits) == take && take > 0 {
+		last := result.Hits.Hits[len(result.Hits.Hits)-1]
+		res.NextCursor = encodeCursor(last.Sort)
+	}
+	if result.Hits.TotalHits < cfg.Search.DidYouMeanBelow {
+		res.Suggestions = make([]string, 0)
+		for _, s := range result.Suggest["didyoumean"] {
+			for _, o := range s.Options {
+				res.Suggestions = append(res.Suggestions, o.Text)
+			}
+		}
+	}
+	if facets {
+		res.Facets = facetBuckets(cfg.Search.Facets, result.Aggregations)
+	}
+	setSearchLinks(c, res, skip, take)
+	writeSearchResponse(c, res, skip, take)
+}
+
+// relatedDocumentsEndpoint returns documents similar to the given one
+// according to a more_like_this query on title and content.
+func relatedDocumentsEndpoint(c *gin.Context) {
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.ReadAlias)
+	if err != nil {
+		documentError(c, err, "Failed to find related documents")
+		return
+	}
+	exists, err := elasticClient().Exists().
+		Index(index).
+		Type(cfg.Elasticsearch.Type).
+		Id(id).
+		Do(ctx)
+	if err != nil {
+		documentError(c, err, "Failed to find related documents")
+		return
+	}
+	if !exists {
+		errorResponse(c, http.StatusNotFound, "Document not found")
+		return
+	}
+	skip, take := pageRange(c, cfg.Search)
+	mlt := elastic.NewMoreLikeThisQuery().
+		Field("title", "content").
+		LikeItems(elastic.NewMoreLikeThisQueryItem().
+			Index(index).
+			Type(cfg.Elasticsearch.Type).
+			Id(id)).
+		MinTermFreq(1).
+		MinDocFreq(1)
+	result, err := elasticClient().Search().
+		Index(cfg.Elasticsearch.ReadAlias).
+		Query(elastic.NewBoolQuery().Must(mlt).Filter(notDeleted())).
+		From(skip).Size(take).
+		Do(ctx)
+	if err != nil {
+		documentError(c, err, "Failed to find related documents")
+		return
+	}
+	res := newSearchResponse(result)
+	setPageLinks(c, skip, take, res.Total)
+	writeSearchResponse(c, res, skip, take)
+}
+
+// newSearchResponse converts the hits of result. Highlights are included
+// when the search asked for them.
+func newSearchResponse(result *elastic.SearchResult) SearchResponse {
+	res := SearchResponse{
+		Time: fmt.Sprintf("%d", result.TookInMillis),
+		Hits: fmt.Sprintf("%d", result.Hits.TotalHits),
+
+		Total: result.Hits.TotalHits,
+		took:  result.TookInMillis,
+	}
+	docs := make([]DocumentResponse, 0)
+	for _, hit := range result.Hits.Hits {
+		var doc DocumentResponse
+		json.Unmarshal(*hit.Source, &doc)
+		doc.Highlights = hit.Highlight
+		docs = append(docs, doc)
+	}
+	res.Documents = docs
+	return res
+}
+
+// suggestEndpoint returns title completions for the prefix q, for
+// search-as-you-type.
+func suggestEndpoint(c *gin.Context) {
+	prefix := c.Query("q")
+	if prefix == "" {
+		errorResponse(c, http.StatusBadRequest, "Query not specified")
+		return
+	}
+	cfg := currentConfig()
+	size := cfg.Search.SuggestSize
+	if i, err := strconv.Atoi(c.Query("size")); err == nil && i > 0 {
+		size = i
+	}
+	if size > cfg.Search.MaxPageSize {
+		size = cfg.Search.MaxPageSize
+	}
+	suggester := elastic.NewCompletionSuggester("titles").
+		Field("suggest").
+		Prefix(prefix).
+		SkipDuplicates(true).
+		Size(size)
+	result, err := elasticClient().Search().
+		Index(cfg.Elasticsearch.ReadAlias).
+		Suggester(suggester).
+		FetchSource(false).
+		Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Something went wrong")
+		return
+	}
+	suggestions := make([]string, 0, size)
+	for _, s := range result.Suggest["titles"] {
+		for _, o := range s.Options {
+			suggestions = append(suggestions, o.Text)
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"suggestions": suggestions})
+}
+
+// encodeCursor turns the sort values of the last hit into an opaque cursor.
+func encodeCursor(sort []interface{}) string {
+	data, _ := json.Marshal(sort)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var sort []interface{}
+	if err := json.Unmarshal(data, &sort); err != nil {
+		return nil, err
+	}
+	if len(sort) == 0 {
+		return nil, errors.New("empty cursor")
+	}
+	return sort, nil
+}
+
+// sortFields maps the field names accepted in the sort parameter to the
+// fields sorted on in the index.
+var sortFields = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"title":      "title.raw",
+}
+
+// parseSort parses a sort parameter such as "created_at:desc,score" into
+// Elasticsearch sort clauses. Fields sort ascending unless ":desc" is given;
+// score sorts descending unless ":asc" is given. An empty parameter sorts by
+// relevance.
+func parseSort(param string) ([]elastic.Sorter, error) {
+	if param == "" {
+		return nil, nil
+	}
+	var sorters []elastic.Sorter
+	for _, part := range strings.Split(param, ",") {
+		name, order := part, ""
+		if i := strings.Index(part, ":"); i >= 0 {
+			name, order = part[:i], part[i+1:]
+		}
+		if order != "" && order != "asc" && order != "desc" {
+			return nil, fmt.Errorf("Invalid sort order %q for %s", order, name)
+		}
+		if name == "score" {
+			sorters = append(sorters, elastic.NewScoreSort().Order(order == "asc"))
+			continue
+		}
+		field, ok := sortFields[name]
+		if !ok {
+			return nil, fmt.Errorf("Cannot sort by %q", name)
+		}
+		sorters = append(sorters, elastic.NewFieldSort(field).Order(order != "desc"))
+	}
+	return sorters, nil
+}
+
+// filterFields maps the field names accepted in filter parameters to the
+// fields matched exactly in the index.
+var filterFields = map[string]string{
+	"id":       "id",
+	"title":    "title.raw",
+	"language": "language",
+}
+
+// parseFilters builds the filter clauses narrowing a search: a created_at
+// range from created_after and created_before, and an exact match for every
+// filter=field:value parameter, and every tag given in tags=a,b. Documents in
+// the trash are left out unless include_deleted=true.
+func parseFilters(c *gin.Context) ([]elastic.Query, error) {
+	var filters []elastic.Query
+	if c.Query("include_deleted") != "true" {
+		filters = append(filters, notDeleted())
+	}
+	after, before := c.Query("created_after"), c.Query("created_before")
+	if after != "" || before != "" {
+		created := elastic.NewRangeQuery("created_at")
+		if after != "" {
+			t, err := parseDate(after)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid created_after %q", after)
+			}
+			created = created.Gte(t)
+		}
+		if before != "" {
+			t, err := parseDate(before)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid created_before %q", before)
+			}
+			created = created.Lt(t)
+		}
+		filters = append(filters, created)
+	}
+	for _, m := range c.QueryArray("meta") {
+		i := strings.Index(m, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("Invalid meta %q, want key:value", m)
+		}
+		filters = append(filters, elastic.NewNestedQuery("metadata_fields", elastic.NewBoolQuery().Filter(
+			elastic.NewTermQuery("metadata_fields.key", m[:i]),
+			elastic.NewTermQuery("metadata_fields.value", m[i+1:]),
+		)))
+	}
+	if near := c.Query("near"); near != "" {
+		geo, err := parseNear(near, c.DefaultQuery("radius", currentConfig().Search.DefaultRadius))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, geo)
+	}
+	for _, t := range c.QueryArray("tags") {
+		for _, tag := range normalizeTags(strings.Split(t, ",")) {
+			filters = append(filters, elastic.NewTermQuery("tags", tag))
+		}
+	}
+	for _, f := range c.QueryArray("filter") {
+		i := strings.Index(f, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("Invalid filter %q, want field:value", f)
+		}
+		field, ok := filterFields[f[:i]]
+		if !ok {
+			return nil, fmt.Errorf("Cannot filter by %q", f[:i])
+		}
+		filters = append(filters, elastic.NewTermQuery(field, f[i+1:]))
+	}
+	return filters, nil
+}
+
+// parseNear builds a filter for documents within radius of near, given as
+// "lat,lon". radius is a number with a distance unit such as "5km".
+func parseNear(near, radius string) (elastic.Query, error) {
+	parts := strings.Split(near, ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid near %q, want lat,lon", near)
+	}
+	lat, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lon, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err1 != nil || err2 != nil || !(GeoPoint{Lat: lat, Lon: lon}).valid() {
+		return nil, fmt.Errorf("Invalid near %q, want lat,lon", near)
+	}
+	if !config.ValidDistance(radius) {
+		return nil, fmt.Errorf("Invalid radius %q", radius)
+	}
+	return elastic.NewGeoDistanceQuery("location").Lat(lat).Lon(lon).Distance(radius), nil
+}
+
+// parseFuzziness validates the fuzziness parameter: AUTO or an edit
+// distance up to the configured maximum. An empty parameter selects the
+// configured default.
+func parseFuzziness(param string, sc config.SearchConfig) (string, error) {
+	if param == "" {
+		return sc.DefaultFuzziness, nil
+	}
+	if strings.EqualFold(param, "auto") {
+		return "AUTO", nil
+	}
+	n, err := strconv.Atoi(param)
+	if err != nil || n < 0 || n > sc.MaxFuzziness {
+		return "", fmt.Errorf("Fuzziness must be AUTO or between 0 and %d", sc.MaxFuzziness)
+	}
+	return param, nil
+}
+
+// parseDate accepts an RFC 3339 timestamp or a plain date.
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
+
+// sourceFields are the document fields that can be requested with the
+// fields parameter: those of Document except the trash markers.
+var sourceFields = map[string]bool{
+	"id":         true,
+	"title":      true,
+	"content":    true,
+	"created_at": true,
+	"updated_at": true,
+	"language":   true,
+	"location":   true,
+	"metadata":   true,
+	"tags":       true,
+	"attachment": true,
+}
+
+// parseFields turns a fields parameter such as "title,created_at" into a
+// _source filter. The id is always included. An empty parameter returns the
+// whole source.
+func parseFields(param string) (*elastic.FetchSourceContext, error) {
+	if param == "" {
+		return nil, nil
+	}
+	includes := []string{"id"}
+	for _, f := range strings.Split(param, ",") {
+		if !sourceFields[f] {
+			return nil, fmt.Errorf("Unknown field %q", f)
+		}
+		includes = append(includes, f)
+	}
+	return elastic.NewFetchSourceContext(true).Include(includes...), nil
+}
+
+func facetAggregation(f config.FacetConfig) elastic.Aggregation {
+	if f.Type == "date_histogram" {
+		return elastic.NewDateHistogramAggregation().
+			Field(f.Field).
+			Interval(f.Interval).
+			MinDocCount(1)
+	}
+	agg := elastic.NewTermsAggregation().Field(f.Field)
+	if f.Size > 0 {
+		agg = agg.Size(f.Size)
+	}
+	return agg
+}
+
+func facetBuckets(facets []config.FacetConfig, aggs elastic.Aggregations) map[string][]FacetBucket {
+	out := make(map[string][]FacetBucket, len(facets))
+	for _, f := range facets {
+		buckets := make([]FacetBucket, 0)
+		if f.Type == "date_histogram" {
+			if items, ok := aggs.DateHistogram(f.Name); ok {
+				for _, b := range items.Buckets {
+					key := fmt.Sprintf("%.0f", b.Key)
+					if b.KeyAsString != nil {
+						key = *b.KeyAsString
+					}
+					buckets = append(buckets, FacetBucket{Key: key, Count: b.DocCount})
+				}
+			}
+		} else if items, ok := aggs.Terms(f.Name); ok {
+			for _, b := range items.Buckets {
+				buckets = append(buckets, FacetBucket{Key: fmt.Sprint(b.Key), Count: b.DocCount})
+			}
+		}
+		out[f.Name] = buckets
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/cache"
+	"github.com/gin-gonic/gin"
+)
+
+var searchCache *cache.Cache
+
+// cacheSearch serves GET requests from the search cache and stores
+// successful responses in it. The key is the query string with parameters
+// and repeated values sorted, so equivalent searches share an entry, plus
+// the flags and headers that change the response. Concurrent misses for a
+// search run it once and share the response.
+func cacheSearch(c *gin.Context) {
+	if !featureFlags.Enabled("enable_search_cache") {
+		c.Next()
+		return
+	}
+	filled := false
+	body, ok := searchCache.Fetch(searchCacheKey(c), func() ([]byte, bool) {
+		filled = true
+		c.Header("X-Cache", "MISS")
+		w := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		return w.body.Bytes(), w.Status() == http.StatusOK
+	})
+	if filled {
+		return
+	}
+	if !ok {
+		// The search this request waited for failed; run it again.
+		c.Header("X-Cache", "MISS")
+		c.Next()
+		return
+	}
+	c.Header("X-Cache", "HIT")
+	if c.GetHeader(responseVersionHeader) == "2" {
+		c.Header(responseVersionHeader, "2")
+	}
+	// Only the body is cached; the links follow from the request and the
+	// total and next_cursor both response versions carry.
+	var res SearchResponse
+	if err := json.Unmarshal(body, &res); err == nil {
+		from, size := pageRange(c, currentConfig().Search)
+		setSearchLinks(c, res, from, size)
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+	c.Abort()
+}
+
+func searchCacheKey(c *gin.Context) string {
+	params := c.Request.URL.Query()
+	for _, v := range params {
+		sort.Strings(v)
+	}
+	var b strings.Builder
+	b.WriteString(c.Request.URL.Path)
+	b.WriteString("?")
+	b.WriteString(params.Encode())
+	if featureFlags.Enabled("enable_fuzzy_search") {
+		b.WriteString("#fuzzy")
+	}
+	// The configured backend may change without the parameters doing so.
+	b.WriteString("#" + searchBackend(c))
+	if v := c.GetHeader(responseVersionHeader); v != "" {
+		b.WriteString("#v" + v)
+	}
+	return b.String()
+}
+
+// recordingWriter keeps a copy of the response body.
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// invalidateSearchCache drops cached searches, and the cached responses of
+// other routes serving documents, after documents changed. Elasticsearch
+// only shows writes after its refresh interval, so a search racing the
+// refresh can still cache an older result for one TTL.
+func invalidateSearchCache() {
+	searchCache.Invalidate()
+	responseCaches.invalidateDocuments()
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// Sessions let browser clients of the search UI authenticate once with an
+// API key and carry a session token afterwards. Sessions live in Redis, so
+// any replica can serve them, and each use extends them by the session TTL.
+// The routes of the UI check the session a request carries, and count the
+// request against the session's client rather than its address.
+
+const (
+	// sessionPrefix is the Redis key prefix of sessions.
+	sessionPrefix = "session:"
+	// sessionKey is the context key of the session checked by check.
+	sessionKey = "session"
+)
+
+// Session is what is stored for a session. Client identifies the API key
+// it was created with, hashed like rate limit clients, and Admin tells
+// whether it was an admin key.
+type Session struct {
+	ID        string    `json:"id"`
+	Client    string    `json:"client"`
+	Admin     bool      `json:"admin,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// sessionHandlers serves the session endpoints on top of Redis.
+type sessionHandlers struct {
+	client redis.UniversalClient
+}
+
+func newSessionHandlers(client redis.UniversalClient) *sessionHandlers {
+	return &sessionHandlers{client: client}
+}
+
+// create starts a session for a client presenting a valid API key in
+// X-API-Key or as {"api_key": "..."}. The token is set as a cookie and
+// returned for clients that send it as a bearer token instead.
+func (h *sessionHandlers) create(c *gin.Context) {
+	type request struct {
+		APIKey string `json:"api_key"`
+	}
+	key := c.GetHeader("X-API-Key")
+	if key == "" {
+		var req request
+		if err := c.BindJSON(&req); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Malformed request body")
+			return
+		}
+		key = req.APIKey
+	}
+	sc := currentConfig().Sessions
+	admin := validAPIKey(sc.AdminAPIKeys, key)
+	if !admin && !validAPIKey(sc.APIKeys, key) {
+		errorResponse(c, http.StatusUnauthorized, "Invalid API key")
+		return
+	}
+	id, err := newSessionID()
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to create session")
+		return
+	}
+	now := time.Now().UTC()
+	s := Session{
+		ID:        id,
+		Client:    apiKeyClient(key),
+		Admin:     admin,
+		CreatedAt: now,
+		ExpiresAt: now.Add(sc.TTL),
+	}
+	data, err := json.Marshal(s)
+	if err == nil {
+		err = h.client.Set(sessionPrefix+id, data, sc.TTL).Err()
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to create session")
+		return
+	}
+	setSessionCookie(c, sc, id, sc.TTL)
+	c.JSON(http.StatusCreated, gin.H{"token": id, "session": s})
+}
+
+// current returns the session of the request, extending it.
+func (h *sessionHandlers) current(c *gin.Context) {
+	s, ok := h.load(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, s)
+}
+
+// delete ends the session of the request. Ending an expired session is
+// not an error.
+func (h *sessionHandlers) delete(c *gin.Context) {
+	sc := currentConfig().Sessions
+	id := sessionToken(c, sc)
+	if id == "" {
+		errorResponse(c, http.StatusUnauthorized, "Session not specified")
+		return
+	}
+	if err := h.client.Del(sessionPrefix + id).Err(); err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to delete session")
+		return
+	}
+	setSessionCookie(c, sc, "", -1)
+	c.Status(http.StatusNoContent)
+}
+
+// load reads the session of the request and slides its expiry. It responds
+// with 401 and returns false when there is no live session.
+func (h *sessionHandlers) load(c *gin.Context) (*Session, bool) {
+	sc := currentConfig().Sessions
+	id := sessionToken(c, sc)
+	if id == "" {
+		errorResponse(c, http.StatusUnauthorized, "Session not specified")
+		return nil, false
+	}
+	var get *redis.StringCmd
+	_, err := h.client.Pipelined(func(pipe redis.Pipeliner) error {
+		get = pipe.Get(sessionPrefix + id)
+		pipe.Expire(sessionPrefix+id, sc.TTL)
+		return nil
+	})
+	if err == redis.Nil {
+		setSessionCookie(c, sc, "", -1)
+		errorResponse(c, http.StatusUnauthorized, "Session expired")
+		return nil, false
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get session")
+		return nil, false
+	}
+	var s Session
+	if err := json.Unmarshal([]byte(get.Val()), &s); err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get session")
+		return nil, false
+	}
+	s.ExpiresAt = time.Now().UTC().Add(sc.TTL)
+	setSessionCookie(c, sc, id, sc.TTL)
+	return &s, true
+}
+
+// check is the middleware of the routes the UI uses. A request carrying a
+// session token must carry a live one, which is extended and kept for
+// requestSession. Requests without one are refused when sessions.required
+// and served as anonymous otherwise. Searches run to warm the cache are
+// let through.
+func (h *sessionHandlers) check(c *gin.Context) {
+	sc := currentConfig().Sessions
+	if sessionToken(c, sc) == "" {
+		if sc.Required && !cacheWarming(c.Request.Context()) {
+			errorResponse(c, http.StatusUnauthorized, "Session required")
+			c.Abort()
+			return
+		}
+		c.Next()
+		return
+	}
+	s, ok := h.load(c)
+	if !ok {
+		c.Abort()
+		return
+	}
+	c.Set(sessionKey, s)
+	c.Next()
+}
+
+// requireAdmin is the middleware of the admin routes. They need one of
+// sessions.admin_api_keys in X-API-Key, or a session started with one, which
+// is then kept for requestSession. Requests with neither are answered 401,
+// and those of other clients 403.
+func (h *sessionHandlers) requireAdmin(c *gin.Context) {
+	sc := currentConfig().Sessions
+	if key := c.GetHeader("X-API-Key"); key != "" {
+		switch {
+		case validAPIKey(sc.AdminAPIKeys, key):
+			c.Next()
+		case validAPIKey(sc.APIKeys, key):
+			errorResponse(c, http.StatusForbidden, "Admin role required")
+			c.Abort()
+		default:
+			errorResponse(c, http.StatusUnauthorized, "Invalid API key")
+			c.Abort()
+		}
+		return
+	}
+	if sessionToken(c, sc) == "" {
+		errorResponse(c, http.StatusUnauthorized, "Authentication required")
+		c.Abort()
+		return
+	}
+	s, ok := h.load(c)
+	if !ok {
+		c.Abort()
+		return
+	}
+	if !s.Admin {
+		errorResponse(c, http.StatusForbidden, "Admin role required")
+		c.Abort()
+		return
+	}
+	c.Set(sessionKey, s)
+	c.Next()
+}
+
+// requestSession returns the session checked for the request, if any.
+func requestSession(c *gin.Context) (*Session, bool) {
+	s, ok := c.Get(sessionKey)
+	if !ok {
+		return nil, false
+	}
+	return s.(*Session), true
+}
+
+// sessionToken returns the session token of the request, from the cookie
+// or an "Authorization: Bearer" header.
+func sessionToken(c *gin.Context, sc config.SessionsConfig) string {
+	if auth := c.GetHeader("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	id, _ := c.Cookie(sc.CookieName)
+	return id
+}
+
+// setSessionCookie sets the session cookie to expire after ttl, or clears
+// it when ttl is negative.
+func setSessionCookie(c *gin.Context, sc config.SessionsConfig, id string, ttl time.Duration) {
+	maxAge := int(ttl / time.Second)
+	if ttl < 0 {
+		maxAge = -1
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     sc.CookieName,
+		Value:    id,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   sc.SecureCookie,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// apiKeyClient identifies the client of an API key by a hash of it, so
+// keys do not end up in Redis.
+func apiKeyClient(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return "key:" + hex.EncodeToString(sum[:8])
+}
+
+// validAPIKey reports whether key is one of keys, in constant time.
+func validAPIKey(keys []string, key string) bool {
+	if key == "" {
+		return false
+	}
+	ok := false
+	for _, k := range keys {
+		if subtle.ConstantTimeCompare([]byte(k), []byte(key)) == 1 {
+			ok = true
+		}
+	}
+	return ok
+}
+
+func newSessionID() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// snapshotInfo is the part of the Elasticsearch snapshot description
+// reported to operators. State is IN_PROGRESS, SUCCESS, PARTIAL or FAILED.
+type snapshotInfo struct {
+	Snapshot  string            `json:"snapshot"`
+	State     string            `json:"state"`
+	Indices   []string          `json:"indices"`
+	StartTime string            `json:"start_time,omitempty"`
+	EndTime   string            `json:"end_time,omitempty"`
+	Failures  []json.RawMessage `json:"failures,omitempty"`
+	Shards    json.RawMessage   `json:"shards,omitempty"`
+}
+
+// ensureSnapshotRepository registers the configured repository when a type
+// is given; otherwise it is expected to be registered by the operator.
+func ensureSnapshotRepository(ctx context.Context, client *elastic.Client, sc config.SnapshotsConfig) error {
+	if sc.Type == "" {
+		return nil
+	}
+	settings := make(map[string]interface{}, len(sc.Settings))
+	for k, v := range sc.Settings {
+		settings[k] = v
+	}
+	_, err := client.SnapshotCreateRepository(sc.Repository).
+		Type(sc.Type).
+		Settings(settings).
+		Do(ctx)
+	return err
+}
+
+func snapshotPath(repository string, parts ...string) string {
+	path := "/_snapshot/" + url.PathEscape(repository)
+	for _, p := range parts {
+		path += "/" + url.PathEscape(p)
+	}
+	return path
+}
+
+// adminCreateSnapshotEndpoint starts a snapshot of the document and alert
+// indices and answers 202 without waiting for it; poll GET
+// /admin/snapshots/:name for its state.
+func adminCreateSnapshotEndpoint(c *gin.Context) {
+	type request struct {
+		Name string `json:"name"`
+	}
+	var req request
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&req); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Malformed request body")
+			return
+		}
+	}
+	if req.Name == "" {
+		req.Name = "snapshot-" + time.Now().UTC().Format("20060102150405")
+	}
+	cfg := currentConfig()
+	_, err := elasticClient().SnapshotCreate(cfg.Snapshots.Repository, req.Name).
+		WaitForCompletion(false).
+		BodyJson(map[string]interface{}{
+			"indices":              strings.Join([]string{cfg.Elasticsearch.Index + "*", cfg.Alerts.Index}, ","),
+			"include_global_state": false,
+		}).
+		Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		snapshotError(c, err, "Failed to create snapshot")
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"repository": cfg.Snapshots.Repository, "snapshot": req.Name})
+}
+
+func adminListSnapshotsEndpoint(c *gin.Context) {
+	snapshots, err := getSnapshots(c.Request.Context(), "_all")
+	if err != nil {
+		log.Println(err)
+		snapshotError(c, err, "Failed to list snapshots")
+		return
+	}
+	c.JSON(http.StatusOK, snapshots)
+}
+
+func adminGetSnapshotEndpoint(c *gin.Context) {
+	snapshots, err := getSnapshots(c.Request.Context(), c.Param("name"))
+	if err != nil || len(snapshots) == 0 {
+		if err != nil {
+			log.Println(err)
+		}
+		snapshotError(c, err, "Failed to get snapshot")
+		return
+	}
+	c.JSON(http.StatusOK, snapshots[0])
+}
+
+// adminRestoreSnapshotEndpoint restores the indices of a snapshot in the
+// background. An open index cannot be restored over, so indices are
+// restored under rename_prefix ("restored-" unless given) and without their
+// aliases; the operator points the aliases at them once they are green.
+// An empty rename_prefix restores over the original indices, closed by the
+// operator beforehand; cached searches are then dropped as the restore
+// starts and again when it finishes.
+// An explicitly empty prefix restores under the original names, which only
+// works for indices that were deleted or closed.
+func adminRestoreSnapshotEndpoint(c *gin.Context) {
+	type request struct {
+		RenamePrefix *string `json:"rename_prefix"`
+	}
+	var req request
+	if c.Request.ContentLength != 0 {
+		if err := c.BindJSON(&req); err != nil {
+			errorResponse(c, http.StatusBadRequest, "Malformed request body")
+			return
+		}
+	}
+	prefix := "restored-"
+	if req.RenamePrefix != nil {
+		prefix = *req.RenamePrefix
+	}
+	ctx := c.Request.Context()
+	name := c.Param("name")
+	snapshots, err := getSnapshots(ctx, name)
+	if err != nil || len(snapshots) == 0 {
+		if err != nil {
+			log.Println(err)
+		}
+		snapshotError(c, err, "Failed to restore snapshot")
+		return
+	}
+	body := map[string]interface{}{
+		"include_aliases":      false,
+		"include_global_state": false,
+	}
+	indices := snapshots[0].Indices
+	if prefix != "" {
+		body["rename_pattern"] = "(.+)"
+		body["rename_replacement"] = prefix + "$1"
+		renamed := make([]string, len(indices))
+		for i, index := range indices {
+			renamed[i] = prefix + index
+		}
+		indices = renamed
+	}
+	_, err = elasticClient().PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   snapshotPath(currentConfig().Snapshots.Repository, name, "_restore"),
+		Body:   body,
+	})
+	if err != nil {
+		log.Println(err)
+		snapshotError(c, err, "Failed to restore snapshot")
+		return
+	}
+	if prefix == "" {
+		invalidateSearchCache()
+		go awaitRestore(name, indices)
+	}
+	c.JSON(http.StatusAccepted, gin.H{"snapshot": name, "indices": indices})
+}
+
+const (
+	// restoreWait bounds how long awaitRestore waits for a restore.
+	restoreWait = 6 * time.Hour
+	// restorePoll is how long each wait for the restored indices lasts.
+	restorePoll = 30 * time.Second
+)
+
+// awaitRestore waits for the restore of indices from snapshot to finish,
+// once their primary shards are all active, then drops cached searches,
+// which may hold results from the indices being restored. It drops them
+// too if the restore has not finished within restoreWait.
+func awaitRestore(snapshot string, indices []string) {
+	defer invalidateSearchCache()
+	deadline := time.Now().Add(restoreWait)
+	for time.Now().Before(deadline) {
+		client := elasticClient()
+		if client == nil {
+			time.Sleep(restorePoll)
+			continue
+		}
+		res, err := client.ClusterHealth().
+			Index(indices...).
+			WaitForYellowStatus().
+			Timeout(restorePoll.String()).
+			Do(context.Background())
+		if err == nil && !res.TimedOut {
+			log.Printf("snapshot %s restored", snapshot)
+			return
+		}
+		if err != nil && !elastic.IsTimeout(err) {
+			log.Printf("waiting for the restore of snapshot %s: %v", snapshot, err)
+			time.Sleep(restorePoll)
+		}
+	}
+	log.Printf("snapshot %s not restored after %v", snapshot, restoreWait)
+}
+
+func getSnapshots(ctx context.Context, name string) ([]snapshotInfo, error) {
+	res, err := elasticClient().PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   snapshotPath(currentConfig().Snapshots.Repository, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Snapshots []snapshotInfo `json:"snapshots"`
+	}
+	if err := json.Unmarshal(res.Body, &out); err != nil {
+		return nil, err
+	}
+	return out.Snapshots, nil
+}
+
+// snapshotError responds 404 for a missing snapshot or repository, 409
+// when Elasticsearch refuses because of a running snapshot or an existing
+// index, and 500 with msg otherwise.
+func snapshotError(c *gin.Context, err error, msg string) {
+	if err == nil || elastic.IsNotFound(err) {
+		errorResponse(c, http.StatusNotFound, "Snapshot not found")
+		return
+	}
+	if e, ok := err.(*elastic.Error); ok && e.Details != nil &&
+		(e.Status == http.StatusBadRequest || e.Status == http.StatusServiceUnavailable) {
+		errorResponse(c, http.StatusConflict, msg+": "+e.Details.Reason)
+		return
+	}
+	errorResponse(c, http.StatusInternalServerError, msg)
+}
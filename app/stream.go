@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis"
+)
+
+// Documents can be queued on a Redis Stream and are indexed by a consumer
+// group, so ingestion survives restarts and is shared between replicas.
+// The vendored client predates streams; the commands are sent raw.
+
+// streamField is the entry field holding the document as JSON.
+const streamField = "document"
+
+// streamEntry is one entry read from the stream.
+type streamEntry struct {
+	id     string
+	fields map[string]string
+}
+
+type streamConsumer struct {
+	client   redis.UniversalClient
+	cfg      config.StreamConfig
+	consumer string
+}
+
+func newStreamConsumer(client redis.UniversalClient, cfg config.StreamConfig) *streamConsumer {
+	consumer := cfg.Consumer
+	if consumer == "" {
+		consumer, _ = os.Hostname()
+	}
+	return &streamConsumer{client: client, cfg: cfg, consumer: consumer}
+}
+
+// Run reads and indexes entries until stop is closed. Entries are only
+// acknowledged once indexed, so entries of a crashed consumer are claimed
+// by another after ClaimIdle.
+func (s *streamConsumer) Run(stop <-chan struct{}) {
+	for {
+		if err := s.createGroup(); err != nil {
+			log.Printf("stream: cannot create consumer group %s: %v", s.cfg.Group, err)
+			if sleepOrStop(stop, s.cfg.ClaimIdle) {
+				return
+			}
+			continue
+		}
+		break
+	}
+	lastClaim := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+		if elasticClient() == nil {
+			// Leave the entries in the stream until there is somewhere
+			// to index them.
+			if sleepOrStop(stop, s.cfg.Block) {
+				return
+			}
+			continue
+		}
+		if time.Since(lastClaim) >= s.cfg.ClaimIdle {
+			lastClaim = time.Now()
+			if err := s.reclaim(); err != nil {
+				log.Printf("stream: cannot reclaim pending entries: %v", err)
+			}
+		}
+		entries, err := s.read()
+		if err != nil {
+			log.Printf("stream: cannot read: %v", err)
+			if sleepOrStop(stop, s.cfg.Block) {
+				return
+			}
+			continue
+		}
+		s.index(entries)
+	}
+}
+
+func (s *streamConsumer) createGroup() error {
+	cmd := redis.NewCmd("xgroup", "create", s.cfg.Key, s.cfg.Group, "$", "mkstream")
+	s.client.Process(cmd)
+	if err := cmd.Err(); err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// read returns new entries, blocking up to Block for them.
+func (s *streamConsumer) read() ([]streamEntry, error) {
+	cmd := redis.NewCmd("xreadgroup", "group", s.cfg.Group, s.consumer,
+		"count", s.cfg.BatchSize,
+		"block", int64(s.cfg.Block/time.Millisecond),
+		"streams", s.cfg.Key, ">")
+	s.client.Process(cmd)
+	reply, err := cmd.Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// [[key, entries]]
+	streams, ok := reply.([]interface{})
+	if !ok || len(streams) == 0 {
+		return nil, nil
+	}
+	stream, ok := streams[0].([]interface{})
+	if !ok || len(stream) != 2 {
+		return nil, fmt.Errorf("unexpected XREADGROUP reply %v", reply)
+	}
+	return parseStreamEntries(stream[1])
+}
+
+// reclaim claims the entries pending on any consumer for longer than
+// ClaimIdle and indexes them again, dropping those delivered MaxDeliveries
+// times already.
+func (s *streamConsumer) reclaim() error {
+	cmd := redis.NewCmd("xpending", s.cfg.Key, s.cfg.Group, "-", "+", s.cfg.BatchSize)
+	s.client.Process(cmd)
+	reply, err := cmd.Result()
+	if err != nil {
+		return err
+	}
+	pending, _ := reply.([]interface{})
+	var claim, drop []interface{}
+	for _, p := range pending {
+		// [id, consumer, idle ms, deliveries]
+		fields, ok := p.([]interface{})
+		if !ok || len(fields) != 4 {
+			continue
+		}
+		idle, _ := fields[2].(int64)
+		deliveries, _ := fields[3].(int64)
+		if time.Duration(idle)*time.Millisecond < s.cfg.ClaimIdle {
+			continue
+		}
+		if deliveries >= int64(s.cfg.MaxDeliveries) {
+			drop = append(drop, fields[0])
+			continue
+		}
+		claim = append(claim, fields[0])
+	}
+	if len(drop) > 0 {
+		log.Printf("stream: dropping %d entries delivered %d times: %v", len(drop), s.cfg.MaxDeliveries, drop)
+		if err := s.ack(drop); err != nil {
+			return err
+		}
+	}
+	if len(claim) == 0 {
+		return nil
+	}
+	args := []interface{}{"xclaim", s.cfg.Key, s.cfg.Group, s.consumer, int64(s.cfg.ClaimIdle / time.Millisecond)}
+	cmd = redis.NewCmd(append(args, claim...)...)
+	s.client.Process(cmd)
+	reply, err = cmd.Result()
+	if err != nil {
+		return err
+	}
+	entries, err := parseStreamEntries(reply)
+	if err != nil {
+		return err
+	}
+	s.index(entries)
+	return nil
+}
+
+// index bulk-indexes the documents of entries and acknowledges the entries
+// that were indexed or cannot be decoded. The others stay pending and are
+// retried once reclaimed.
+func (s *streamConsumer) index(entries []streamEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	var done []interface{}
+	var docs []Document
+	var ids []string
+	for _, e := range entries {
+		var doc Document
+		if err := json.Unmarshal([]byte(e.fields[streamField]), &doc); err != nil || doc.ID == "" {
+			log.Printf("stream: dropping malformed entry %s", e.id)
+			done = append(done, e.id)
+			continue
+		}
+		docs = append(docs, doc)
+		ids = append(ids, e.id)
+	}
+	if len(docs) > 0 {
+		client := elasticClient()
+		if client == nil {
+			return
+		}
+		results := indexDocuments(context.Background(), client, currentConfig(), docs, nil)
+		for i, r := range results {
+			if bulkItemOK(r) {
+				done = append(done, ids[i])
+			} else {
+				log.Printf("stream: cannot index entry %s: %s", ids[i], r.Error)
+			}
+		}
+		invalidateSearchCache()
+		notifyAlerts(indexedDocuments(docs, results)...)
+	}
+	if err := s.ack(done); err != nil {
+		log.Printf("stream: cannot acknowledge entries: %v", err)
+	}
+}
+
+func (s *streamConsumer) ack(ids []interface{}) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	cmd := redis.NewCmd(append([]interface{}{"xack", s.cfg.Key, s.cfg.Group}, ids...)...)
+	s.client.Process(cmd)
+	return cmd.Err()
+}
+
+// parseStreamEntries parses a list of [id, [field, value, ...]] entries.
+// Entries deleted from the stream while pending come back without fields
+// and are skipped.
+func parseStreamEntries(reply interface{}) ([]streamEntry, error) {
+	items, ok := reply.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected stream entries %v", reply)
+	}
+	entries := make([]streamEntry, 0, len(items))
+	for _, item := range items {
+		parts, ok := item.([]interface{})
+		if !ok || len(parts) != 2 {
+			continue
+		}
+		id, _ := parts[0].(string)
+		values, _ := parts[1].([]interface{})
+		fields := make(map[string]string, len(values)/2)
+		for i := 0; i+1 < len(values); i += 2 {
+			k, _ := values[i].(string)
+			v, _ := values[i+1].(string)
+			fields[k] = v
+		}
+		entries = append(entries, streamEntry{id: id, fields: fields})
+	}
+	return entries, nil
+}
+
+// sleepOrStop waits for d and reports whether stop was closed meanwhile.
+func sleepOrStop(stop <-chan struct{}, d time.Duration) bool {
+	select {
+	case <-stop:
+		return true
+	case <-time.After(d):
+		return false
+	}
+}
+
+// streamIngestHandler queues documents on the stream. It answers 202 with
+// the IDs the documents will be indexed under.
+func streamIngestHandler(client redis.UniversalClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqs []DocumentRequest
+		if !bindJSON(c, &reqs) {
+			return
+		}
+		if len(reqs) == 0 {
+			errorResponse(c, http.StatusBadRequest, "No documents given")
+			return
+		}
+		if details := validateRequests(reqs); len(details) > 0 {
+			validationFailed(c, details...)
+			return
+		}
+		sc := currentConfig().Stream
+		ids := make([]string, 0, len(reqs))
+		_, err := client.Pipelined(func(pipe redis.Pipeliner) error {
+			for _, d := range reqs {
+				doc := d.document()
+				data, err := json.Marshal(doc)
+				if err != nil {
+					return err
+				}
+				pipe.Process(redis.NewCmd("xadd", sc.Key, "maxlen", "~", sc.MaxLen, "*", streamField, data))
+				ids = append(ids, doc.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Println(err)
+			errorResponse(c, http.StatusInternalServerError, "Failed to queue documents")
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"ids": ids})
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/couchbase/go-couchbase"
+	"github.com/couchbase/gomemcached"
+	memcached "github.com/couchbase/gomemcached/client"
+	"github.com/gin-gonic/gin"
+)
+
+// Sub-document operations read or change one path of a JSON document on
+// the server. gomemcached only knows the lookup used for extended
+// attributes, so the single-path commands are built here from the memcached
+// binary protocol.
+
+// subdocOps maps the operations of PATCH /couchbase/:key to their opcodes.
+var subdocOps = map[string]gomemcached.CommandCode{
+	"get":          0xc5, // SUBDOC_GET
+	"upsert":       0xc8, // SUBDOC_DICT_UPSERT
+	"array_append": 0xcb, // SUBDOC_ARRAY_PUSH_LAST
+}
+
+// subdocMkdirP creates the missing parents of a mutated path.
+const subdocMkdirP = 0x01
+
+// subdocErrors maps sub-document statuses to responses.
+var subdocErrors = map[gomemcached.Status]struct {
+	code int
+	msg  string
+}{
+	0xc0: {http.StatusNotFound, "Path not found"},
+	0xc1: {http.StatusConflict, "Path does not match the document"},
+	0xc2: {http.StatusBadRequest, "Invalid path"},
+	0xc3: {http.StatusBadRequest, "Path too long"},
+	0xc4: {http.StatusConflict, "Document too deep"},
+	0xc5: {http.StatusBadRequest, "Invalid value"},
+	0xc6: {http.StatusConflict, "Document is not JSON"},
+	0xca: {http.StatusBadRequest, "Value too deep"},
+}
+
+// couchPatchEndpoint runs one sub-document operation on a key, e.g.
+// {"op": "upsert", "path": "address.city", "value": "Berlin"} or
+// {"op": "array_append", "path": "tags", "value": "new"}; "get" returns
+// the value at the path. Mutations create missing parents, honor If-Match
+// and durability like whole writes and return the new CAS as ETag and
+// their mutation token.
+func couchPatchEndpoint(c *gin.Context) {
+	type request struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	opcode, ok := subdocOps[req.Op]
+	if !ok {
+		errorResponse(c, http.StatusBadRequest, "Unknown op "+req.Op)
+		return
+	}
+	if req.Path == "" || len(req.Path) > 1024 {
+		errorResponse(c, http.StatusBadRequest, "Invalid path")
+		return
+	}
+	mutation := req.Op != "get"
+	if mutation && len(req.Value) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Value not specified")
+		return
+	}
+	cas, ok := ifMatchCAS(c)
+	if !ok {
+		return
+	}
+	durability, ok := parseDurability(c)
+	if !ok {
+		return
+	}
+	key := c.Param("key")
+	bucket := couchbaseBucket(c)
+	res, err := subdoc(bucket, key, opcode, req.Path, req.Value, cas)
+	if err != nil {
+		code, msg := subdocError(err)
+		errorResponse(c, code, msg)
+		return
+	}
+	c.Header("ETag", formatCAS(res.Cas))
+	if !mutation {
+		c.JSON(http.StatusOK, gin.H{"key": key, "path": req.Path, "value": json.RawMessage(res.Body)})
+		return
+	}
+	if err := awaitDurability(bucket, currentConfig().Couchbase, key, res.Cas, durability); err != nil {
+		code, msg := durabilityError(err)
+		errorResponse(c, code, msg)
+		return
+	}
+	resp := gin.H{"key": key, "path": req.Path}
+	if token := formatMutationToken(bucket.Name, mutationToken(bucket, key, res.Extras)); token != "" {
+		resp["mutation_token"] = token
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// subdoc sends a single-path sub-document command. Mutations carry value
+// and are conditional on cas unless it is zero.
+func subdoc(bucket *couchbase.Bucket, key string, opcode gomemcached.CommandCode, path string, value []byte, cas uint64) (*gomemcached.MCResponse, error) {
+	extras := make([]byte, 3)
+	binary.BigEndian.PutUint16(extras, uint16(len(path)))
+	if len(value) > 0 {
+		extras[2] = subdocMkdirP
+	}
+	body := append([]byte(path), value...)
+	var res *gomemcached.MCResponse
+	err := bucket.Do(key, func(mc *memcached.Client, vb uint16) error {
+		var err error
+		res, err = mc.Send(&gomemcached.MCRequest{
+			Opcode:  opcode,
+			VBucket: vb,
+			Key:     []byte(key),
+			Extras:  extras,
+			Body:    body,
+			Cas:     cas,
+		})
+		return err
+	})
+	return res, err
+}
+
+func subdocError(err error) (int, string) {
+	if res, ok := err.(*gomemcached.MCResponse); ok {
+		if e, ok := subdocErrors[res.Status]; ok {
+			return e.code, e.msg
+		}
+	}
+	switch {
+	case couchbase.IsKeyNoEntError(err):
+		return http.StatusNotFound, "Key not found"
+	case couchbase.IsKeyEExistsError(err):
+		return http.StatusConflict, "Key was modified concurrently"
+	}
+	log.Println(err)
+	return http.StatusInternalServerError, "Failed to update couchbase"
+}
@@ -0,0 +1,9 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package swaggerui
+
+// compressed holds the gzipped assets by name.
+var compressed = map[string]string{
+	"swagger-ui.css":       "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec\xbd\xfbs\xdb8\xb2/\xfe\xfb\xf7\xaf\xd0\xcd\xd6TMΘ\nI\x89zXw\xb6\x8e\x13;\x19g\xe3d\xec\xbc&ٙ\x9a\xa2DH\xa2\r\x90\xbc$\xf5\xb0u\xe7\x7f\xff\x16\xf8\x12\x014\b\x90\xf6\x9e\xb3\xb7\xeal6\x19\x9b\xf8t\xa3\xd1h4\x9a\x8d\a\xfb\xc9\xce]\xadPll\xfc\xc3\"\xc4a|\xfa\xb7\xc1|h9\xd6l\x19\x06\xa9\xb1t\x89\x8f\xefO\x137H\x8c\x04\xc5\xfe\xf2\xc5\x7f\xfc\xaf^\x10\xc6\xc4\xc5\xfe\x03\xea/\x92\xa4\xb7\x1d\xf7;\xd9\xfb\xbf\xbd\xab\xcbO\xbdw\xfe\x02\x05\t\xea\xfd\xdf\xde\xcaOכy\x7f\x11\x92\x17\x01Z\x84\xd8M^\xb0t\xff\xf1\xe2\xafZ\xe5\xbduJ\xf0\xc1 \x89\x91\xa2}j$\xfe\x032\\\xefv\x93\xa4\xa7\x96i\xfe03vh~秒R\xec\a\xc8X#\x7f\xb5NO\xad\xbe\xe50\x9c\xe7\xa1w\x7f n\xbc\xf2\x83S\x93)q\xe3\xd4_`t\xc2<K|\x8f}\xb2\f\xc3\x14\xc5̣5r=\xeeQ\xe0n\x99\xdf\x13\xb4H\xfd08x~\x12a\xf7\xfet\x8e\xc3\xc5\x1d\xdbb\xeb\x90阶\xe6\xd4FdV\xc8\xd8\x1f\x8d\x11鱒.\xfd\xd5\u008d(\xc3\x13\xee\xf1&f\xa5%\xae\xdfTiNQ\xaa\xc3B\xa474\xa3=+W|\x98\x87{*\x96\x1f\xacN\x17a\x90\xa2 5\xe6\xe1~Vh\u061c\x85[\x14/q\xb8;\xdd\xfa\x89?ǈ\xa1\x8fbt\xa8\x1b\x0f\t\x830\x89\xdc\x05:\xa9~\x9a\x1d\x1bn!\xc2\xf6Ɂ\xe9j\x0f-\xc2إ\r7\x92;?:\r\xe7\xb7h\x91&\xb3\xb9\xbb\xb8[\xc5\xe1&\xf0\x8c\xdcl\xd3\xd8\r\x92ȍQ\x90\xb2\xfc\xe6\xf3\xf8\x9f\xa9\x9fb\xf4\xc7a\x1e\xc6\x1e\x8a\x8dy\x98\xa6!9\r\xc2\x00\u0378JN7\x81\x87bjN3\x89\x18GD\xcf\v\xd3\x14y3\x15\x805F\xd6H\xd28\fV\xb9\xb6v\xb9r\xfd`\x8db?\x9d՟\xcdC졘\xe1\xb3\b9+\xbd\x9b{,k\x97D\x8f\xe8\x06o\x19\x14֙\xdect\xea\xa7.\xf6\x17\x7f\xb1\x86\x16\xdf\x1d\x84n\xf8\xdbriΊ\x1fM\x935ℸ\x18\xd7l~b\xfe\xc0\x96o8\xedl\xa2\x1az\xec\xb0\xe3ܜEa\xe2g\x1a\x8f\x11vS\x7f\x8bf[DG\xb4\x8b\r\x17\xfb\xab\xe0t\xee&\x88\x92\xf0\xb5\x1c\n\v0\xfa\xb6õ\x9b֙\x86ѩ\xd1\xe7K܍燌|[\xdfCa5\xd6\xfc \x93N\x1cr\x19\xe1i\x10\xa6?\xfe\x93\x0e\xa68\xc4\xc9\x1f\xcf+\xb2\xcc\n\xcb&1t>Y\x95\x16\x9bw\x02\x85\xb2\xc2nW\x19\xdf\xd38\f\xd3\xe7\x87jT\xae}\xcfC\x01kw\x9b4圇\x1fD\x9b\x94y\x12F)\xed̈sd\x18-X\x1c\xb5x7F\xee\x01\x9e#\xeaf\x05y\xe7\x19\xe8\x8ce\x12\x1e\x1a\x9d\r@\x95\v|Ȇe\xe6\x15\x96aLD\xe5\xfd3\xbd\x8f\xd0\xcf1JP\xfaǉX\x90l\xe6\xc4\xe7J\x80\xca\xe8\xb4UP\xe4\xa5\x7fT\xfeˍ\"\xe4\xc6n\xb0@\xa7y\x11P}Aszj\x90\xf0\xc1X\x86\x8bMb\xf8A\xc0\xcd-uQ\xb5\xa0\x85\xf0\nl^\xb9\b\x12\xadn\x16\xb9\x9eGg\x03\xb3\xa1\rG6\xb1\x1f\xac\xe4\rP\xe2J\xe9\x1b\x80\x85\xe8,\xe2\x10nRjk\xa7V\xb4/|o\xefe\x06\xfc\x84\xf6)7\r\"\xec%(=\x94\r\xeb\x0f\x1cDz\xfdq\xf6\xefHp\v\x18\xadP\xe0\xd5\xe7\xc5j.\xd9\x17\xee\xaet\xdc\xe5\xc0N\xdd9F3\xe2\ue35d\xef\xa5\xeb|(Tz\x9c\xed\xd6~\x8a\x8c\xcc\r\x9f\xe6\x81\x117\x89\x86\xab\x18%\t\xe8^\xb4\xfc\\5H\xab\xf1\xe3n\xd2\x10\xe8\xbf\xc5\x1a-\xee\xe6\xe1\x1e\x1a\x05\xb1\xeb\xf9\xe1\x1f\x92v7\x19E\xb0!s\x14S\v,FCfYF\x12\xf9\x81\x01\f#\tQ\xb8IY\xa2C\xe1F$MI\x90\x1b/\xd6\xe0\x10\xa4\xea\xc8z}VX\x89\x11.\x97\tJO\r;\xda\xcbY\x1de\xc9\x1f\x18\v\xca\r\xcb\xdb \xa3;\x06\b\x90t\x82w:\xd2/}\x8c\x8cM\x84C\xd7+\x95 \xf50\x99\xe7--\x91\x9d\xcfQ\xea\xfa8aCE\x14l\x1aB\xc5dC\x88\x1b\xdfW\b\xec'\xa9\xe1\xa7\xdc\xc8X\xb8\xc1\xd6MԳ\xe0?\xf3I\xe9\x0fn\"!\x11vS\xc4̆\fY\xdfC\xf3ͪ\xf7\x1f\xcc\xd8NB\xec{\xbdU\x88=\x00kd#\v\xa4\xf8\xdbr\xb9\x84(\xe6\xd8]\xdc\xc1\x14|\fSP\xacb߫\x05?\xf5賷\x89\U0004f79b\xba\xa7>qW\xe8E\x14\xacft\x80\x8e\x86'\xfe\x97\x97\x1fnv\xe6?ެ³\xb3\xb3\xb3\xf7\x1f?\xaf/>\xaf\xe8\x8f\xd9?\x97\xafξ\x9d\x9d\x9d\x9d_\x9c\x8fG\xef\xe8\x837\xbfݼ\xfe\xfa\xcbͧ\xb9\xfd\xdd\xf4\xec\xd7\xf7߯_\xbe\xfc\xfef\xea\x7f\xff\xf8\xf2\xed\xfc\xeb\xeb\xe0\xfb\x97\xb7\xf8\xdb\xd7\x1bg\xb1\xc0\xf8WJp\xbf\x8e\xbe\xbc^\x9b_/\xac\xab\x0f\xe4\xfdv\xfe\xd1Y\xe7xg8\xff\xed,\xff\xdf\xf9\xee\x05\xfa\xe5\xe5\xfa\x9b\x9db\xef\xd5K\xff\xfbW/\x9aߚ\xfex\xbcyq鿌\xbe\x9f\x9b\xfe\x97\x87/\xef\xaf.\xacݵ\xfd%t?\xafG\v\xf2\xe5\x13\xbas>\x7f\x1bD\xf1\xb7\a|wy;\xf9\xe9\xf2|?\xfc\x10\xac\xd3\xc5\x1b\v{o.V荕̃\xab\x11:7\xfdo_o\xb6\xdf\xc8\xe7\x11\xfd}\xfe\xf5\x8b\xf9\xed\xe3Ŀ\xfce5Bo\xac\x9d\xf7&\x99^\u07bd\xbe\x9b\xdbo\xf1\xe5\xeb\xf5\xfbϯ^\x9e\xcf\ao\xf1\xe5\xf9\xe7\xcd{ߺ\xbd\xfat\xb1\xbf<_8\xefn/\xac\x0f\xe7W\xf7\xef?\xeeVW\xb7g\xfb\xf7\x1f'\xbb\x0f\x1f'\xfb\xabW\xe6\xee\xeaS\xb8\xbf:\x0f\xef\xaf^\x9d\xad.˿\xb7\xc3կ\xbf\xbc\xbd\xfb~\x1b}\xbc\xb9\xf8Vɳ 7\xe4\u05cfoC\uf5db\xdd\a\x7f\xb2\xf5\x06\xde\xe0]\xb0xxG\xa6\xf7\xdf\xef'\xfb\x0f\x9f\xee\x9cw\x0fg\xf7\xef\x1e.\xef\xdf\xfd\xf6\xf6\xee\xbbo=\xa0\xaf\x8e\xf9\xed\xb7U:\x0f\xaenk|/\xbe\xff\xf6\xfevA\xf0\xce{\x83\xb7s\xff\xe5\xfd\xf77\xdfF߾\xbe\xddz\xbf]O/\xfdˣ\x0e\xdeX\xbbO\x9f\xcd\xe9%Y\x9b\xde/g\xa3w\xf7\xd3\xcd\xe2\xbe\xea\x8b۹mnћ\u05fbw\x0f\x17\x9b\xabW\xd3t\x9e\xe9g\x9d\xce\xdf8\x0f\x1f\x82\xf7\xe6g\xf2\x85\x91y\x1e\\m\n\x9dn\xbe\xd9\xd3\xf4\xdd`\xbd^\xbc\x9a\xec\xdfݞm\x17֍\xb3x\xf3y\xfb\x99|y\x98\x0f\xbe\xdc\x7f\xb3\xbf|\xfc\xfe\xf5\xdb\xed\xa5\xffr8\xff\xba\xdf,\x1e\"\xda\xf7\x9a\xb2\xe4r,.\xac\xf7\x1f\xeen\xb6\xdf\x06_\xd2\xef_\x1d\xf3\xe3\xe7\xeb\xe9%\xed\xebWΝ\xfb\xf5zt\xf5\xe9\xe6\xfcço\xc3\xf7\xe6g\xfb\xbd\xf9\xe5\xf5էׯ\xdf߮쫇\xef\xe7\u05f7w\xbb\xf7w\xd7ë\xdb\xd5\xee\xea\xe2\xb2\xc6\x0fo\x16\x83\x9b\xf5\x9c\xbc\xc75~\x11\xcb\xefZ\x8f\xdfyt\xbe _\xd6ޛ\xe9\xfd\x977\xd3\xed\xfc\xdc\xf4\xafs\xfd\xac>\xbfYo\xbd7\xd3\a\xf7\xcdtwy\xf1\xfe\xfc\xf2\xfcrw\xf5\xe9\xf3\xea\x1f\x17\xd6\xfa\x9b\x8d7Y٫;\xffW\xfflR\xf4\xd3\xe8\xe6͗{\xf7\xb7\xef\xf8\xfb\xc5\xf7\xfb\xb9m\xae\x16\x83\x1b\xaaÑ\xfb\xd5y\xf0\u07bc\xde|\xb3\xbf\xbc\xbd97}\x8a\x7fGp\xf4\xfd<\x1c\xbc\xbf]\xdc_\xdf]\x0f\xde\xdf~\x1b\\?|\xde_}\xfel_߾}um~\xbe\x7f\x7f~6\xbc\xfat\xb6\xbb\xba\xbd\x18\\}\xbc\xac\xf8}\x7f3\xbd\xf5\xbeZx\x1e\xdc\xd4\xf8ݰ\xfc\x1e\x94\xfc\xb6T\xf6w\x03\xc0&\xa9\xad\xbe\x9afv\xf9\xf9\xee\xe6M\x8e\xcb\xc7]6\x0e?\rW\xbf\x9eO\x87\x8b7\xafo]\xfb\x8by\xf9\xe6ˆ\x8e\xf7\x85\x7f\xf9\xe2\xd7\xe4\xe5ǟ\xde\\Q\x0fq\xfb\xf1\xf3\xcd\xcb/\xbfܺ߶\x0f/^<L\xce\xdf%\xe7\xc3\xd5\xe2\xcdo\xfe\xb7\x8b\xb37//\xff\xf1\xe6e\x80^\xbcX\xbe\x8evg\xab\xdd\xd9\xcb\xe9\xe4\xcc}mG\xb7\xf8\xf35%\x7f\xf9\xf6\xe6\xb3s\x11߽]\xadV?\xff\xfc\xbc\x17\xa3\b\xb9i\xcf\xecI=\xa9a\x8d\x9eЙ\xbe\xa4\xbf\x9e]\xe7\xce\xf4l9y1]\xff\x8f3\xfd\x1fg\xfa\xef\xeaL?\x9c\x7f\xbb\xbf\xb9]_ܜSg\xfa\xed\xfe\xb1\xce\xf4\xc8\xefZ\x8f߿\x913\xbd\xfe\xf4\xb4\xce\xf4\xfa\xf6\xbfən_}4\xadKꌮ*gJn\x86/\x9c\x9f^\xbe\xfe\xf5\xe5\xf2\xec\xea5yq\xf5r\xb5\x9fܜ\xbd\xf9\xfa*\xb0\xce\xce\xc8\xc7\xd5`x=\xba{y\xfe\x8f\xab7W\xe7\xafv\x97\xaf\xd0\xd5%\"\xaf_8\xd7\xee\xee\u008fξ\xee>\xafw\x17\xb7W\xe7\xdb\xf9\xd9W\xfc\xf5n\xfb\xe5\xe5n3\xd9^_\x9e\xbd<\xbbؽ|\x15\xad'\x9fG\v3\xf3K\x17\xf8\xf5\xa7\xbb\x8f\x9bk\xf2ꕖ\x03\x9e\x18Y\xbc[\xf7\xc24X\xe6\xdd\xefm\x84*\xff\xfbbz\xfbbx}\xf6\xed\xe6\xb7u\xf4}uv\xf6\xf1\xf3\xdd\xff)\x02\xd9\xe3\xff~}\x91\x9c\xbd|}\xe1}}\x1f\xa3k꙯K\xc7\xf9bx}\xb5w\x7f\xb91\x17\xe7\xe1\xf6\x9d\xed<\xbc#\xb9\x1f{\x97\xf9\xda\xe9\xf0\xdbog۫\x8f\xc3ݻ\xdd\xd9\xe4\xd7\xc1z\xf7\xed\xeb\xfb\xf8\xfbo\u05ebo\xe4K\xe0~\x1dN/7?\x8d\xb7\xf7\x97+\xf7\xeb\xf5\xf4\x12/\xacO\x9f^\xbe_\\\xbc\xc7\xee\x1b|\x89\x82\xb7\xf8\xf3 \xfa\xf0\xe5\xcd\xfb\x91k_9\xdf_]\xbe\xc8l\xf4\x9c\x8eߗ\xe9\xf7\xdfn\xd6G\x1f\xb1\x9a^\x16u\x7f \xce\xc3\a\x92\xf9\xe0-\x1d\xf3\x99O\xfe\xe5&\xfe\xf5\xe3\xdbr\xae\xf8\xf6\xe9\xcb\xd9\xeaڞ\xde\x7f\xffxf\xbd\xbb\xfd\x96~{\xb8\xd8_}<\x1b|\xf88ܿ\xff\xb4z\xb8\xba\xfd\x9c\x14\xe3d{u~\xb7\xbd\xfat\x96^\x9d_\x8c\xae>\x9d\x8d\xaenk>\xf6\xd5%\xf5\xfb\x95}\x1d塶h\xfa\x95n\x06\xde\xc0\xbbw\x06W\xf7\xcevA\x16۫Ow·\x8f\x93ݕ?\xb9\xbf\xf2-J\x9f.\x06t,\xbe\x1e\xbe\xfb\xea<\\\xde\x1f\xf9\u07bc\xf9\xf2\xf0m\xf06Z\xfcr\x13\xcd\xed\xe1\x8a\xfa\xef\x0f\xe4\xb5?\x1f|1\x7f\xfdxY\xf3\x93\xd4\x0f\x98\xbeV\x7f\xdc\xff\x1b\xfa\xe6\xdf\xceF׃\xb7\xf8\xdbo7\xdb\x05\xbe\xd9\xce\xed]m^|\x19\xce\a7ۅ\xbd\xde.^\xbd<\xbf\xbe?\xbb\xbf:\xbf\xb0._\xad\xdf\x7f\xfb\xfa>\x9a\a\xb4l\x15\xe5|^\xbe\xff\xf4)z;\x0fޛ߾:\xb7\xdf?\xe3\x8b_?\xbe\xa5\xfc7\xeeW|\xf7\xe1\xeer\x7fu{i~\xf8\xb4xx\x7f뽺z\xb8\xd8\xdf|\xfa\xfe\xea\xea\xee\xed\xf9ͧK\xf3\xea|\xb5\xbf:?\xbb\xbf\xfa\xb4\xd8\xd7\xf8]\xcc\xed\xf7\xd6\xfc뗍wq\xe4\xf7\xfd\r\xc3\xcfT\xf2\xcb\xe6\xcej>\xc1\v\x82\xed\xef_o\xde,\xc84\xbd\xfc%\xd7\xe5\a\"\x9f\x17\xaeo/\xee\xafn\xaf\x9d\xf7\x0f\x17\xf6{\xf3\xf2!\x9b\x17\xee.\xef\xaf\xef\u07bf\xbe\xba\xbd\xde}8\xbf\xd8]\x9d_\xee\xdf?\\\xf8G~\xf2y\xeb\xc8\xefR\xc9\xef\xdd\xc3їr6\xf9\xd3\xe5\xf9n\x9b\xd9%~{q\x93\xfb\xdcz,\xf4\xd3%\x1bc\xe19\xa1r\\\xfa\xbf>\x8c_\x8cWg\x1f\x8a\xb9\xea\xec{\x16\xbb\x9e\xbd|1M\xce\xd6gg\xf37\xeb0\xfa\xe5#~\xf9\xd6\xff\xfe\xf2ڟlߙo\x7f\xf9\xf5a\xf8ӯ\xa6\xf7\xcbMǿg7f\xf4\x8fO\xd7\xe4\xfd\xf9$\xfc\xc7\xf9\xf4\x97_\x1f>\xbf\xb8y\x04\xbf\xfc\xef\xf2\xc5\xee\xec\xec\xe6\xd5\xd9\xd9\xe5\xd9\xd9\xea\xfc\xec\xe3\xe5\xd9\xd9\xfa\xe2\xe5\xd9\xfe\xe2\xe5\x8b\xc9\xf5\xd9W\xea7\xafπ\xff}{yv}\x01<\xbf\xbc\xb88\xbbxyvv\xf5\x92+xyv~\xf1\xea̼\xb89;\xbb8\x7f)\xf2\xbc\xb9\xfc\xf4\xfa\xe5\xe7\xaf\x17\x1f/\xb7/ܳ\xb3\xdd\xf9\xd9\xf5٫\x8b뫛\xb3\xf3\xc9Y\xf8!\xf8\xf4ź\xfet>~\xfb6Y\xff:\xd8~\xbc\x1a\xfc\xfa\xe2\xc5w\xcd\x00_o\x82i\x15\xdf\xd3\xf9\xf5\xd5\xf5\xddו\xfd?\xf1\xfd\xff\xdb\xf1\xbd\xee\x1c\xc0\xc6\xe1Էj\xceY\xb6\x95\xd6\xe7\xad\"\x86ԝ\xb7\x1e\xbe\xfc\x82w\xdf?N?~\xff\xed\xfd\xd6\xfb\xed\xed-\xb5\xa5\xef\xfeՓ\xc5\xcf:q=M\f\\ݽ\xbdȓ.WV\xb3\xffV\xbfw\x1c\xf9}S\xf2{\xb2\xf8\xfe\xe1i\xe3\xfb\x9b\xf3\xff\xae\xf8\x1e\x8d\xde\x0e\uea07x\xe0\x93%\xe6\xe7\xf3\xe4jwu\xfb\xf1&\xfc\xf6\xab\xf3\xe6\xff\f?\xfe\xfa~~s\xfb\xe5\xea\xe2\xdaZ\xbe\xba9_\xfd\xe4\a/F?\xbd\xb5\xce\xde~\xde'\xab\xb3\x8b7gg\x03\xeb\xe5\xd9۫\x8f\xe6\x9bo\xb9?\xff\xf8\xf9ˇ\x9b\x7f8\xaf\xbe]^6$P\x8eK1\xec\x0e\x96\x13\xd5\x1e\x17\xba#\xe6\xa4q7\x81\xc7n&\xf0|vs\x8b\x87\xd9_\xd3\x13hqM\xb5\x8b\x86\xae˞\xb0;b\xd8_m\xf6\xd7\x01\xfb\xeb\x90\xfd\xd5a\x7f\x1d\xa96\xecЕ[q\xb59_\xc4A\xc4\xf5\xf1\x1f\xb2\xd2b\x99JV\x1c\xb9I\xb2\vcO\nH\x11n(ۧ\xd2\xc2M\xcc\x11\xe6˒\xec#_\xd8\x0e\xc4<\b\xd9F\xb3k\xfdQ\x8c\xa0ML̳l]\x93}⁻\x03؇k\xf6W\xb6/6\x18^bd\xed\xddM\"\xb4H\x8dl\x15\xed \xdf\x05\"'2\fk\xb4\x9f\x96k\xbe\xe5> gԷ\x9d\x1f\x9a\xa8\xa6{k\xc4SY\xe3q\x7f<n$\x1b\xee\a<ո\xb9\xa2\xc1~(\xd43\x18\xf4\a\x83F\xaa\x91H5\x1a\xf5G\n\xd9\xc4\x169f#\xc9d\xef\b\xd5\xd8\xfd\xe6\x069\xfb\x89Pͨ\xb9\x9a\xb1X\xcd\xd8\xea\x0fmE=c\xa1\x9eas=\xd6\xde\x12H\xccf\x92|\xf3Y\xb9{\xc8,\xb7\xed\xe4\x9b\\В\xb1Fw\x9e\x84x\x93\xa2Y\\\xd8)\xddUd\xcej{\x01\x1e\f?\xf0О\xfe\xf2\xd7\x7f\x12\xe4\xf9n/Y\xc4\b\x05=7\xf0z?\x12?(v\x0e\fLD\x9e\x1f\xe4r\x05\xc9#F\x03\xa5\xee4 \x00B\xbd1\x01\x10\xaa\x87\x05T\x9b\xd6\xc8\x00\bu\x06\aT\x9fz|@\x95i\f\x11\xa82\xf5(\x81\xf4\xa83P\xa0\xda\xd4c\x05\xa2\xd2\x1a.\x94\xf0_0b4\x86L\xfe\xacڀ3R\f#\xf2\x98QD:\x0e\"\xd2q\f\x91\x0eC\x88t\x1cA\xa4\xd3\x00\"]\xc6\x0f\xe94|H\x97\xd1C\xba\r\x1e\xd2e쐎C\x87\xfc\u05cf\x1c\xd5(\xc1\x8f\x19%\xb8\xe3(\xc1\x1dG\t\xee0Jp\xc7Q\x82;\x8d\x12\xdce\x94\xe0N\xa3\x04w\x19%\xb8\xdb(\xc1]F\t\xee8J\xf0\xbfd\x94\xf0[\xb1ٝ\x9c\xacX\v\xbaǲ\xbe\x19>\xdb\xfc\x9c=\xfd_>\x89\xc28u\xb9c\t}\xba\x15\x9c\x1e\xd5\x10\x89\xb2\xe75\xb2\xb6\xb1aVm6\xebv\x90\a\xa6\xe3Ez\x92\xc97\x97\x93t\x13\x93<ZJ\xa9D\xb8\x9bDXG\"\x86r\xbe2\x16(HY˩\xcc\xd51\x7f\xa8\x9frɓ@\xa7AX\xfc$\xb0J\xc3\b䓆Q+>\xd9\xe8\x009ec\xa4\r\xab|X\x82\xbc\xf2\xa2V\xdc\xe8x\x06y\x99\x8dl\xda\x0e\x9f\xaaW\xb8\xa1𘎑\xb1\xea\xd472f\x1d\xbbGƮc\x0f\xc9\xd85wғ8\x94cϑ'\xeb8\xf2\x84\xfdF\x9e\xb4۞t\\\x19\xe4\xc9;\xad\xb1\x83\xf0\x93u\x10~\xc2\x0e\xc2O\xdaA\xf8I;\bw\xe9 \x86S\xb1\xbb]\xdc\xe5\x0e\u008c\xda.L\x91\xa4';nY\x91\x9b\x15Q\xfb\xecV\xc9#H:\b\vRi\xc8[\xa7{\xa2\xf4Bɛti\x06\xe9\xd6\n\xa2݈\x06\x81q\x17\x81q7\x81q]`\xd6\xf4]\xf6$Z\xc6rV<*Bq\xee\xecN\x7f\x9e\x964\xd49\x00t\xf4\xb1\x8c\xb6:\xf9\x96\xbb\x03\x80:/\x90\xd1\xcf\xd9\x13\xc6\x10\x83\xa2D\xc6\x01\x97\x1c\xb21\x0f\xd0g\xcfe\xd4\x01pr\x8fQW\xfb\xa18w\xf3\xfc]\xebn\xa8\x91u\xe9\x89\x1ay\xd7Ψ\xb1\xe8\xde\x1f5&ݺDP\x1f\xd4+O\x13\xfe\xb8Y\xbe\xa8}O\x91Gu\x14yt?\x91'\xe8&\xf2\xc8^\"\x8f\xed$\xb8Cp\xa7\x0e\xc1\x8f\xea\x10\xfc\xe8\x0e\xc1O\xd0!\x8f\xf4d\x06\xd6\xe8\x10\x96\xc6\xc8\xcf\xf1\x95t\x92K\b(.@n\f\x82-\xcb\x12\xc0\x9e\x1b\xdf\x19\xabؽ簃\xc1@\xc0\x12߃\xa0\x8e\xe3\bP\x006\x1e\x8f\x05X\xe2\xe3-\x8a9\xe0t:\x15\x808\xefl\b\ueeae(i\x18\x06\x90\f\x8b\xc5B\xc2\x1a\x00#\x84`\xddfG09\xf0rH\xff\bx\x10\xba\\\xc28cj\xb2\xd0u\x82\xdd\x1f\xcd\x13\xf3\x87\x13\x1a\xa5\x9f\xf4\xa7\xcf%\x84\x13\x05\xe1DF8V\x10\x8ee\x84#\x05\xe1HF\xe8(\b\x1d\x19\xe1PA8\x94\x11\x0e\x14\x84\x03\x19\xa1\xad \xb4e\x84\x96\x82В\x11\x9aN3\xa1)Վi\xabHm9\xad\xa5$\xb6\x00%e.F\xb0\xd9x5\xcfHOL\xd0^s\xa2I\x03\xd1DF4n \x1aˈF\rD#\x19\x91\xd3@\xe4Ȉ\x86\rDC\x19Ѡ\x81H\xaar\xbb\x81Ȗ\x11Y\rD\x96\x8c\xc8t\xe4D\xa6T\x13\xa6\xddDf\xcb\xe9\xacFB\xc8\x06\xb3\xe9+F\x1e\xef\xba\xc7\xe6\xd0\x14\xbd\xac\b\\.\x87\xd6`$\x99\x13 \xf8\xd8v\xc4)$\x8c\xdd`%\xba\xf9\xd1\x00\x98\x9d\xe9\xb9~\x019\x1f\x03\xc8{\x84q\xb8c\xb1\xe2\xad\x00\x95\xb4\x10\xfco\xcb\xf9\xd2r\xc5\xe94\xda\xc4\x11\xe6\x05v\x90\xbdp\x1d\tw\x90\xc2\x1d\x8e\x06K\x1b\xee\x94\xc8\x0f\xf8\xf8\xc3sLs\"\xf6\xca:L!4횹8\xa3\x82ȉ)\x9d\xd8A\xbc;\xf0ƲP\b\xa1\x80\x8f\x9b\x06\xe3\xb1#6\x13\x84N\xdd\xe9x(\x8d1D\x82)B\U000c5a13\xc0\xdd\xde\v\xa1\x9e5\x1f\x0ea\xa9\xe7x\x83\x04\xf8p8E\xc0H\x13\x90\x03g\x8c<\x99U\x01\xf8\xe9h\xb1X.a<J@\x92\x85\x87 \x92\x9d\x9b\xac\x91\a\x11,G\xcb\xe5\x12\xc9\b E\xa2\xc9\xd2[:2\nxp,\x97\v4\x97\x91@\xa3\xdf[zb+\xea\xa9D\x86@\x9a\x1e\x9a\x1bFq\x8b\tK\x00]mҟǕ\xe7\x8e]\xcf\xdf$\xa7\xbc\xab\x88-\x0eзl'\xe6n2\xe9\xcfc\x9b\x87\x81\xa8\x01\x8f\x82@C\x0ed\x01\x18\xc32y\xc9ŵ\xe5ylD>\xc6\x1cn:\x9dN\x81w\xbfj\xc9\xed\xf8\xa2\x98\xbdg\x95z\xa9\xbfA\xe6o\x812\x8d\x19\xf9\x82\"\xf3\xb6\a\xb2*\xcaT܊eE5?Af\x91W\xbe\x12\xd8P}C3['\xc0b\xb3\x9e\x8b\x92ٗ\x88\x91\x99\x18\x80\x84\xad\f\x00\x82\x86&\xe2d\xb6\x06 e\xe6&B\x9b-\x8eK\xf7=\xc6\xe8ļݣ\xedN\x93\xa5\xa6\xe9\x89ܴ\xad\xefi\x12}\xb1Y˺I-\x92\xe8\x1a$ѴG\xa2g\x8eD\xd7\x1a\x89\xb61\x92v\xb6\xf8d\xfeOHM>\xde\x12\xc9S\x1a\"\xf9\xd7ء\xc4\xe6\xb0\xda氮\xcdaM\x9b\xc3z6\x87um\x0ek\xdb\\\xbb\x19\x97\xcd\xd9>\xca\xe6\xf0\x93\xdb\x1c~J\x9bÝmN\b̳+\x1a\xd9</pen\x06\xcd\"N\x0e\x9a=\x13\xa0\xc59c!\xed.\x00iJ\x19\xb8`\xb5u\x80P6DX\xe9il\x8b\x88nh\x0e\xbc\x06\a\xb6\b\\oz\xa2y\xa7j)i\xd3PҢ\x9dD\xbb\x99\xa4u+\x1b[\x84۴\b\xb7h\x11\xd6n\x11\x86Z\xc4\"w\xd5\xebB\xb9\x06\u0095[l9\xec~w6\x87\x02A\x03\x0e\x04a\x86,\x06\xf0\xba;\x87\x85\xd8\"$5̃\xb0\xb0%z\x1f\xf3\x00\xac^\x99\xc2ڕy\x80V\xa8La}\xca<\x88\xebP\x1d\xde\x0ev\xf5\xa0Z\xd6)\x02D\xd6/\"\x10\xee\x1a\x11\a\xf6\x8e\x00\x03;H@\xc1}\xc4E\xf8\xd2n\x12V\xee\xa5=%\xc6\xd1\xd2\xce\xe2\x97\xe1\x9fv\xe1|W\x8bE\xa5}H4\xbb\x90\xe8\xf5 \xd1\xea@\xa2\xd3\x7fD\xab\xfb\x88^\xef\x11\xdd\xce#\xda}G:t\x9d\xa4\x9b\xb0\xb2\x9b\xb0f7a\xbdn\xc2Z݄u\xba\tku\x13\xd6\xeb&\xac\xdbMX\xbb\x9b0\xdcM\f0Y\xbb^\xb83\xac\xfc\xb8v\xf6˩\xd93{\xc3h߳\xa3}\xafi\xa1\xa5\xa0\xb5yډ>\xed\xa0Nk\x17t-\xea\x1eB\xf4\xb4~S\x87کS\x0f\x8b\x9aA\xea\xb6\xd3H\xa9\xd6\xdc\xcdu\xd6,@\xdeF\xb9\x1cy\x17\xfdJX諘cШ\xe5'q\xfe\x95\xeaɣ4O\x1e\xa5x\xf2h\xbd\x93G\xaa\x9d<\x91֛4\x8c\x1f\xa5a\xfc(\r\xe3Gk\x18?R\xc3X_\xc3\f\x83(F\xd5\x15\xfe\xc6>\xbb\xf9\xbe\xfa\xfe\x8eq_|\xeb\xa3zr\x9a,\xe2\x10\xb3\xdf\x12\xe8ө\xc4\xcc>k\xc2\xf9\xfd|\xf20\x0f1\xf0\xf9\x91~1a\x98\xd5\xe9:\xb68\x9b%\xcc\x03\xceNى\xf5YY}\xe2L\x98Wi\x15U\x8a\xe5E\xadVY\xab\x88\xc8*\xb6\xf2\x8a\xc5RZ\xb7\x9d\xd5mK궋\xbamY\xddvY\xb7\r\xd7m\xe7u\xdb`\xddE\xc3\rY˫\xa6\x1bҶ\x1f\x1boHZ_6߀\xdb_(\xc0\x90i\xa0R\x81!\xd5\xc1Q\t\x86D\v\xa5\x1a\x80\xf2\xf2\xa4\xa5A?^P;\x9dY\x9c\xc8d\xce_\xb6\x9e+3c\xa6\xd3\x04`ϹM\xd2B\xc8,\vk\xa7ō\x06_\xbf\xb1\x000\xec\xb2n\x99eV\xd5K\x8d\xff(\x81\xdc\xfekBH\x8c\xbc\x94Cf\xa5\x95\x1cҁp\x94C>\x16jr\xc8\f\xbe\x14\xa4\xc1\xe6\x8f55\x99}\xad.\xb9\xe5W͒\x1a\x7f%\x8f\xdc\xfek\xf24\f\x81\xba<\xd2Qp\x94G5\x10\x98\x9b0\xc0\xb1\xf0$\x11M>@H\xc3\xf8 \x8dÃ(F\ai\x1c\x1c\xa4yl\x10\xd5\xd0 \xea\x91AT\x03\x834\x8f\v\xa2\x1a\x16D=*\x88rP\x10\xe5\x98 :C\x82h\x8c\b\xa2\x1a\x10D9\x1e\x88\xcep \x1a\xa3\x81\xe8\x0e\x06\xf2\xa8\xb1 \xb5{\xdc`\xf7\xb8\xd1\xee\xb1\xc2\xeeq\xa3\xdd\xe3f\xbb\xc7*\xbb\xc7j\xbb\xc7*\xbb\xc7\xcdv\x8fUv\x8f\xd5v\x8f\x95v\x8f\x95v\x8fu\xec\x1ek\xd8=V\xd9=V\xda=ֱ{\xaca\xf7X\xd7\xeeU\xf1\x10K\xbcX\x9e\xbaK\xfe\xe2D\xfat\x8e\x96a\x8c\x0e\xc5\xe79O\x9f\xf5\x9e\xb1_\x1e\x83\xd9\x1c\x16\x18\xb91=\x84\xba\xe6\x01\x87\x87\x90\x9a\x16\xf7\x18\x17\x14TT\xae\xa8d\x96\x89ϕ\xcd\xe5\x15\x05EQ\xa7ŶE6\x89\xcaE:\x96\x82R\x1d\x8b!\xc1\x8e\xa5O\xb7f\xb6\xa0\x8e\xaeA^\xd2(.i\x92\x96\xe8\v\v\v\xd6Է\x06n\x14\f7\t\x86\x19\xc1\x98\xd2%F\xfb\xeac_\xf4\x17\xb6\xb8\xf8z\x18\x83\xaa=\x13y\x19\xf4u\xf8@\x7f:\xb5zV\x8f\xfe6\xa3ͮ\xee(:\xea\xc0\x04\xa8\xb3%\xe0\x8cZ\xfc\xf0X\x06X\x84xC\x82\fbx~\x9c_\x87y\x9a?\x05\xe0q\xb8\xe3\xb1q\xb8\x03\x80\xbb؍\x0e\xd5O\xa7\xf4\x1fP<\x0e\x17\x84\x12$}l\xc4h\x8b\xe2\x04q|\xcb\xc7\xd2ֱt|+\x1b\xc8\xe3p'\xa3\xad\x15q=\x9c\"\x92\x18I\xea\xc6\xe9!\xfbxa\xf6E\xb9$\xb3\x85\xfc1\x84G\x81'\xa2Q\xe0A\xd8\xe2J\x95:<\x7f\x04\x81\xcb/'2p\xf0s\x8a\x95\xe41J\x17k\x06_<c\xe1\t\xc2K\xa6\x9d\U00101d19\x19\xfa\xd8\xca#Vhd\x86dژ\x81\xa1&fP\xae\x85\x19\x18n`!q\xbd}\x19\x1al\x1e\xfd\xf6\xb6\xbf\xbc/ZX\xfeV\xceF\xb2f\x968\xdaR\x90Fhn\x85\xca[\xcc\x13A\xcd.1s\x94\xee\x10\n\x04\x9a웛e)L\xeaf\x17*H(\xf3B\xf1\x12 \x14\xa4\x85:r婔Q\xd2\x1c\xbb\xbdY\x15%\x9e\xe9\xfc&E\x94\x04\xa5\"X\x8a\x065\x94\x84\x85\x1a \xbaf%\xd4m\xa8\xa2\x83\xcc(_p2\x0f\xd9\x7fy\x17\x9d\x17ZE\xa1\x05\x15\xdaE\xa1\r\x15\x0e\x8a\xc2\x01T8,\n\x87P\xa1S\x14:P\xe1\xa8(\x1cA\x85\xe3\xa2p\f\x15N\x8a\xc2\tT\x88\xdd$-\xca\xe9V\xb3)\xe0pWԭ\x9a\x87\xeagpN\xcb@V\rd\x01\xa0d\x1d\xfb\xc1]\xc9+\xff\r\xe4V\x00-\x06h\xb5\x8e\xdb2\xea ћ\xfa\xeb@\xf5\xecOя\n\x00*\x06\x8d1@\x89\xd2\f\x03\x00\xb8<\x12\xa8\xc0\xea`\x80\x85j\xc5\x03\"w\xed\x90@\xdah\xbd\xa8\x00V\x8220\xa0d\xadb\x03\x90\xa01<\xe0)\xd4\x11\x02O\xa1\x11$\xf0$\x8a8\xe1\b\xd7\n\x15 xS\xb4\xc0\xe1\x95\x01\x03\x87W\xc7\f\x1c\x81:l\xa0\x04\x1d\"\a)\x99\"x\x80\xe84\xe2\a\x88L;\x84\x90\x13+\xa3\x88\xa3:[\x04\x12\x12\"E,!Ri\x84\x13\"\x91vD!#\xd5\b*\x00҆\xb8\x82\xa2\x9bB\x8bc9\x1c]\x1c\xcb\xe1\x00\xe3X\x0e\xc7\x18\xc7r8\xcc8\x96ÑƱ\x1c\x0e6\x8e\xe5p\xbcq,\x97\x86\x1cGHs\xd4Q\xf9rE\xe0\xc1\xe2\x9ab\x8f\n\xa9\x11~\xf0X\xebi\xb23\x19K\xa2\x19\x95\x906A\tydLBtB\x12\xd2*\"!-\x02\x12\xa2\x1d\x8f\x90\xd6\xe1\b\xe9\x1c\x8d\x90G\x05#\xa4S,BZ\x86\"\xa4m$B\xda\x06\"\xa4u\x1cBZ\x85!\xa4U\x14B\xda\x05!\xa4]\fBZ\x86 \xa4e\x04B:\x05 \xa4[\xfcA:\x85\x1f\xe4\x11\xd1\a\xe9\x1c|\x90\x0e\xb1\a\xe9\x12z\x90\x0e\x91\a\xe9\x1cx\x90\xceq\ai\x13v\x90樃4\a\x1d\xa49\xe6 \xcd!\ai\x8e8Hs\xc0A\x9a\xe3\r\xd2\x1cn\x10e\xb4A\xf4\x82\r\xa2\x17k\x10\xddP\x83\xe8G\x1a\xa4U\xa0!\v*\xb0fP\x81\xdb\x04\x15\xf8\x91A\x05\xd6\t*p\xab\xa0\x02\xb7\b*\xb0vP\x81[\a\x15\xb8sP\x81\x1f\x15T\xe0NA\x05n\x19T\xe0\xb6A\x05n\x1bT\xe0\xd6A\x05n\x15T\xe0VA\x05n\x17T\xe0vA\x05n\x19T\xe0\x96A\x05\xee\x14T\xe0nA\x05\xee\x14T\xe0G\x04\x15\xb8sP\x81;\x04\x15\xb8KP\x81;\x04\x15\xb8sP\x81;\a\x15\xb8MP\x81\x9b\x83\n\xdc\x1cT\xe0\xe6\xa0\x027\a\x15\xb89\xa8\xc0\xcdA\x05n\x0e*psP\x81\x95A\x05\xd6\v*\xb0^P\x81u\x83\n\xac\x1fT`>\xa8`\xb0^PE\x01\xe2\xdc\xec\xf9\\\x88\xc0\x15ϫ\xe29\x0e\x17w<\xf1\x9c\xa36@Tʣ\x80=D\xde\x11\x04\x96.\xd8bc\x81\xf8s\b^\x9a\xed\x90`aB\xc0\x90\xa3\xa8\xfe7\x91\x88͟\v\x14\xc5F\rN\x02 r\xa9\xb0`\x05\xf5\"\x81\xceX\xfa{\xe4\x1dr$v\xef\xc3Mz\x9a=\xaa}C\xa8\xf5ʘ\x17ԗ\xbb\xa0\xee\x17\x97\xc3x\v\xa8#`#\x10yH\xec\x00\x00\x82\x9d]\xc7\xc1\xd6  \xa4\x06!\"\x1bm\x02\x847\x9a\x05 \x8a\xda2dD\x8d\xc6A\x89\x9a\xed\xe3I\xf2\x96^`\x90f\x9b!*\x93!*\x8b!\x9a\x06C\xf4셨̅\xe8Z\vie,\xa4\xad\xad\x90\x0e\xa6B:X\ny\x94\xa1\xc0F\x81\x9b\x8d\x02\xab\x8c\x02\xab\x8c\x02k\x1a\x05\xd63\n\xac2\n\xack\x14\xb8\x95Q\xe0\xb6F\x81;\x18\x05\xee`\x14Xe\x14\\\x8cq\xf8\x93\xd5\xf3l\x89C7\x05vt.c\t\x14\xd8߹\f\x0eyY\xa7-\xba\xcbl\x8b\xae\xbe\\r4(Z\xbe\x12TI\xf7D\xcb@\x06i#1i%0ї\x17\x96\xadM/\x1b\xb8\x95l\x98\x91\x8d)M\xdc 1\x12\x14\xfb\xcb\xc32\fRc\xe9\x12\x1fߟ\x1an\x14ad$\xf7I\x8a\xc8\xc9K\xec\awW\xee\xe2c\xf6\xeb\xeb0HO\xdc-\n\xfc\xb8\x17\xa0}\xf9\xf3\xc9\x1a\xe1-J\xfd\x85{\xfc\xa9\x17\xa0\r:\xd9\xcc7A\xba9\x89\xc3y\x98\x86'\x01\xfd'A\xab\x10\xf56\xfe\x89\x1b\xfb.>9J\xc1I'\b\xb6Ba\xbc\xf2\xdd\x13\b\x9cIgHZ$\xad\xa2\xa0\x12\tD\xec\"\xf4\xd8o\xe2\xd3\a\fѫ0HB\xec&'$\f\xdcEH\xff\x13f/\x8a<\xa3M죘#͞\xf5\xdeS\x95\x16\x00\x19}\xa5`\x86\x03\xa7\xf6c\x7f\xc8ڞw\x1c\xc3\x03\xe8W)u\xbaF\xd8MX\xf2\xfc\xd9IC7\x15E\xba\xbd\x9a\xfa\x04\xb1udO \xe8<\xf4\xc2\xc0g\xb0/\xb3G\xbd\xabO\x10~\xe1b?IC\x86\xe0U\xfeLB\xb1rc\x97\x84\x81\xc7\n_<\x04Er\x93;\x14o}\x8cY;\xa9=\x87ȊF\xe4WD\xf9\xa9\x8b}\xeeZ\xe7eb\x04aL\\\\\a\xe6OZ\xbb\xf2\xec\xd5C\xb7>\x0e[T\xf9$\xfe\x99\x86\xb3\xdab\x90\xd6R\x805b\xfd\x1a!U\xb3\xd8z\x8f\xec\xf2\x84\xfdP\xb8Ϝ)\x16\xae;_\xee,\x06`\x89\x00\x9b\x01\xd8\"`\xc0\x00\x06\"`\xd8,\xe2r\xe70\x00G\x04\x8c\x18\xc0H\x04\x8cU͜0\x80\x89\b\x982\x80\xa9\xd9\xfe\b9g\xb0\xd2\x1e\xe1\x11P\xa7\xf0\x18\xa8_x\f\xd45<\x06\xea\x1d\xa5\xc4˝\xc3c\xa0>\xe21P7i\xb4}\xc2c\xa0\xce\xe21ӧ\xfav?;\xdc\xe5}H\xd4]H\xd4=H\xd4\x1dH\xd4\xfdG\xd4\xddGԽGԝG\xd4}G\xd4]GZ\xf6\x9c\xbc\x97T\xbe\xcf\xc0*\x81-\x03\xab{\t\xab{\t\xab{\t\xab{\t\xab{\t\xab{\t\xab{\t\xab{\t\x8b\xbd\xc4-7G\x9bԈQ\x82҃\xb1C\xf3;?\xa5A<rc7X\x14_\xd62H\xf8\xc0?\xe4\xbah\x93\xa6a\x90\xb39=\xcd\xf0\xcbp\xb1I\f?\b\xf8㩵\x1aEhq\xcb\x18\xfd2{\xfe\xb5w>s\xbe\xb6\x0e\xe5g܅\xb3\xb4k\xbb,\x13\xcfٮ\ae\xd9P,\x1b\x96e\x13\xb1̩\xea\x1b\x89\x85\x86]\x15\xdb\xfc7\xf6\xd7\xf4;8\xeb\xd2\x06\x84\xc2qE9\x16)\xe9\xd5\xff\xb5\xafճ\xc5\xd9\xc2~\x06\xa9-\xf1\x8b\xb0-+\xddv-\x14\xd7\xe5\x03\x8a\xeb\x12\x02Ŭ\x8c<\x80\x8a\xb6\x85\xa4\xe4\x81\xeb\xfc\x94f\x01\xa0?\xf3\xe5\xe5\xb7\x1e\nH\xf1k\xeby}\x9d\xcd\xc6\r\xc6S+\x86\xec\xa7V\f\x99P\xad\x18\xb2\xa2zݰ!ի\x87l\xa9V\x0e\x9aS\xad\x1c\xb6\xa8\xba\br\xa3\xa2(\xb5]1\u0082\xa6ň\vZ\x17#0l`\xac\xc8r\x1b\x13\x85\x86ͬƯ\xc1\xd2j\xa8\xd2؞$(Y\xd3`\xa2\xc9\x00I\xa3\xfd\x91F\xf3#\x8d\xd6GT\xc6G\x9am\x8f4\x9b\x1eQX\x1e\xd11<\xa2cwDevDeuDitD\xcf戞\xc9\x11\x1d\x8b#\xed\f\x0e6.\xdch\\\xb8Ѹp\xa3q\xe1F\xe3\xc2*\xe3\xc2\xcdƅ\x9b\x8d\v+\x8c\v\xeb\x18\x17\xd61.\xac2.\xac2.\xac4.\xacg\\Xϸ\xb0\x8eqa\xc1\xb8\x18`\x1a\xbb\x8b;\xe4\x1d0JS\x14\x1b4oH\x03\xaf\xbe\x85\b\b4Rʌ\x87\x1b}ӑ\x11\x10\xb4r\x05\xf66\x85\xb7\xbe߭\xe0\x18$\x02\xbffq\x01\n\x95\xc4P%\x99\xd0Os\x11WYSۆ\x90\xd6\xed ]\x9a\xd1 2n+2n-2\x96\x88\xccP\xe0u\xf1=\x87l\x11\xb1\x1c+\x02&\xf5S\x8cXL\xdfv\x04\xd8\"\x8c\xee9\x94\xd3\xda>K\x912\xdbQK%\xc0d\x82\x89@\xe7ḭ\x12\x98h\xc9K4\xc5%-\xa5m\x92\fkI\x865%âd,\x8e~\xf00E\xfb\xd4\xf0\xd0\"\x8c\xddl߮\xf8\xc6Ka'\u0093Sw\x91\xfa[\x04\x14d\xef\xb8\xc0\xf35\xbd\x9b\x15x.\xe1\xbf\xf5\x13?\xa5یb7H\xfcrGr\x18\xf7\xfa\x96\x93\xf4\x90\x9b \xc3\x0f\xfe\x92T\x7f\b7)m<\xfd\xa4v/\xff\x88Eo\xb1\x89c\x14\xa4\xaf(\x13\x9e.I\x0f\xf4\x9f<wm\xa4\xf7\x11\xf4\xeaOv\xf9K^ed\xc0,\xbc\xb3\xea\xe5B\x8c@vv\xad\xdc\x06\xca\a\xb5\xf2!P>\xac\x95O\x80r\xa7^\xff\b\x00\x8cj\x80\x01$\xc1\xb8.\x01TŤ\x06\x18A2Nk\x80)$C~\xe1\xd0\x11\xd4i\x81=\xef\x8e\xec}\xa8\xb9G8\b\xd4),\x04\xec\x17\x16\x02v\r\v\x01{\x87\x93\x05\xec \x16\x03\xf7\x11'\r\xd8M,\x06\xee)\x16#\xef,\x16\xf7t[\x0e\x8aN$\xca>$\xca.$\xca\x1e$\xca\x0e$\xca\xfe#\xea\xee#\xea\xde#\xea\xce#\xea\xbe#z]G\xda\xf5\x9c\xbc\x97\xb0\xb2\x97\xb0\xb2\x97\xb0\xb2\x97\xb0\xb2\x97\xb0\xb2\x97\xb0\xba\x97\xb0\xba\x97\xb0\xba\x97\xb0\xba\x97\xb0^/a\xa1\x97\x18\xd8\xce:\xc8Ժ\xb3\x0f2}\xee\x06\a\x99\"wÃL\x83;\xe7 U\x1d\xb5\x84\xb2\x94\xb7\x80\x9da\x97e6PVr\x15^\x8dw\xf4\xeb\xe6\xa5\xdb\x10\xcb\xca6\f\x06bYو\xc1P(\x1b\x96<\x87\"O\xa7,sĲQY6\x12\xcb\xc6e\xd9\x18(+\xdb7\x16\xdb7)\xe9&\"ݴ,\x9b\x8ae\x96Y\x16\x8a\xe3mg\xa4k?\xf6*\xe5\xf4\a\xd5\xffD\xe4.\xcc\xd1IٶQ\x7fT\xfeo,\xc0\xb3<u\x0e\xa4?\xb6\x9e\x9a\xf39Wn\xaf\xc7R\xc8d\x8f\xa5\x90\xd5\x1eK!í\xd5\v\xdbn\r\x00\x99oM2ȂkŐ\x11\x1f\x8bA;\xae\x15C\xa6\\+\x86\xac\xb9\xa6\x16Ƞ\x8fŠM\x1f\x8bA\xb3>\x16\x83\x96]+\x86\x8c\xbb\xd6'\x90}\x1f\x8ba\x13\xaf\xf7\x89\xc4\xca\xeb\xaa\xd33t#з\xf5#\x96\xfe\xf64\x91M\x16\xb34\f\x01\xd24\x02H\xd3\x00 M\xf6O\x14\xe6O\x1a\xad\x9f4\x1a?i\xb4}\xd2h\xfa\xa4\xd1\xf2I\xa3\xe1\x93F\xbb'\x8dfO\x1a\xad\x9e4\x1a=i\xb4y\xd2h\xf2\xa4\xd9\xe2\x89\xd2\xe0I[{'\xfa\xe6N\xf4\xad\x1d\xb6l\xdcdٸɲq\x93e\xe3&\xcb\xc6\n\xcbƍ\x96\x8d\x1b-\x1b7Z6n\xb4l\xdchٸѲq\xa3e\xe3F\xcbƍ\x96\x8d\x1b-\x1b7Z6n\xb4l\xdcl\xd9Xiٸ\xadec}\xcbƌe3\x88\xeacA4\xb34\xc7ǯ\t\x9d\x16\x0f$\xf0\xfc\xcbBGt\xfe\xbb\x04\x9c\x7fv\xe8\xd0\xf8\x19\xa2\n\x9c\x85Y\x15T\\ר\x80{Afc\xaf\x90z\xcf\xcbm\xec\x9b%\xdf\xf3\xb2\x1b\xfbf\xe9\xf7\xac\xfc\xc57\x99$\xe0{\xb1\x05\xf7\x8a\x16\xdc\v-\xb8on\xc1\xbdЂ\xfb\xe6\x16\xdcs-\xb8\xef\x16\xed\xf2\x86\x95]%\xd6ƶ\x18\x02\r\xf3b\xf0J\vc\xd0:FV'ж3\x8eH\xcb\xd48\x1a\rk\xe3(t\f\x8e!ѵ9\x8eH\xcb\xec8\x1a\r\xcb\xe3(\x9e.\xf6\x14,\x92\xb44H\xd2\xce\x1eI\x1bs$-\xad\x91t0F\xd2\xde\x16I[S$\xad-\x91t0D\xd2\xde\x0eI[3$-\xadP\xc7\xe2pK\x8b\xc3\xed,\xae\xcd\x14[\akY\x1c\xee`q\xb8\xbdŵ\x9djY\x02-\x8b\xc3\x1d,\x0e\xb7\xb7\xb8\xb6S.K\x00\xc4jI\xea\xa6\xfe\xe2\x10\x85\xc5\xca_\xfe;\x8b\x89\x11v\xe9\x12\xe4\x11U>\x81\xbf;sĕOX\\~\xabA\x05\xca~m\xff\x05\xdbLP\xeaصdg\x80\xcd\xe23І\x160\xb8\xbc\x11O\xf3\x81ؼeD\xb3aD\xbb]D\xafY\xa4]\xab\xe4-\xc0\x9a-\xc0\xda-\xc0z-\xc0B\v\xd81\x92e{C\xba\v$\xbd\x17\xae\xaf1\xa6ǲ\xfe\x94/\x9c\xd4\n\xf9\xfba\x8cq\xad\x90\xbf[\xc6\x18\xd5\n\xf9{i\f\xa7V\xc8\xdfic\fk\x85\xfc}8ƠV\xc8ߥcصB[T±PP\x82\xe9\x1c\vMA\"Ӯ\x17\xdbbyU\xca\x7f\xfa,L\xdd\x14\x19C'\xdfm\xb0\fcr\x9a?\xfbq\xe8xh\xf5\x1c\x84OM\x11>5\xa5pk\x00\xb0\xb7\x06r\xfe\xd6\x04\xa8\xc0\x9a\xc8k\xb0m\xa0\x06ۖ\xd7`\x8f\x81\x1a챼\x86\x81\x05\xd40\xb0\xf2\x1a\xda\xfa\xcaJ\xeb\xd9m!\xad\x14\x0fR4\xeb\x1e$Q\xa8\x1f\xa6i\xee\x01\x90F\xd1\t0Ms?\x804eW<\x89\xc7?\xf6\x0fi\xdb=\xa4u\xef\x90\xf6\x9dC\xda\xf7\ri\xdf5\xa4}ϐ\xae\x1d\xd3\xd8\t\xb8m'\xe0֝\x80\xdbw\x02n\xdf\t\xb8}'\xe0\xf6\x9d\x80\x1b:\x81\xa1\x99cwqG\x9dy\xb6\xbd\xec\xb4\xfe\x11\xf2\xe9s\b:\x81\xa0\x13\x10:\x86\xa0c\x10:\x82\xa0#\x10\xea@P\a\x84\x0e!\xe8\x10\x84\x0e \xe8\x00\x84\xda\x10\xd4\x06\xa1\x16\x04\xb5@\xa8\xe9\x00P\x93o\xd7n\xed\xe7so\x8e]'8\xc3\xfepB\x13\xceb\x97\xe5\xf0\x89\f>\x01\xe1c\x19|\f\xc2G2\xf8\b\x84;28\xdcԡ\f>\x04\xe1\x03\x19|\x00\xc2m\x19\xdc\x06\xe1\x96\f\x0evi\x81\xfd\x9bɟ\xd7\f\x90\x1b\x1b\fĲ\xb8`\xcfs\xe3;c\x15\xbb\xf7%b0\x18\xf0g\b<\x06\xe08\\\xc4W/\x1c\x8f\xb9\xd07\xf1\xf1\x16\xc5e\xb1pa\"ζs\xb3 \xd7u9\t\xc20`DX,\x16\x10\x9b:\x04!\x04\xe8\"\xd3n\tY\x0e\xe9\x1f@\xfd\x15`\xb9\x04\x94\x15#\xaf\xaadl\x0e\xcd%\xffb\xe3\x1d\xe9\x87\xd6`\x04Iʀƶ\xc35'\x8c\xdd`U\x13c$\x1c\x1c^\x85\xb8\xc6a.\x1c\xe6\xbdG\x18\x87\xbb\x02A\xc1\x90\x14\f\xe8o\xcb\xf9\xd2r\xb9\xee\x896q\x84+A\x1cd/\\\a\xe2\xc4\xe2\xdc\xe1h\xb0\xb4\x01\xd5Et+t\x01\xf2\x1cӜp\xba[\x87)\x83\xa1\n\x9cs=ĖOL\xd8\x14X\x94;\xf0Ơ\xd9#\x14T#c0\x1e;6o\xd9u\xc0ԝ\x8e\x87\xb0\xdd\xd5`S\x84\xe6\v\xae]\x81\xbb\xbd?\x0eRk>\x1c\x02\xd2\xcc\xf1\x06\x1dA\xc3\xe1\x94?\x12]+\x1f8c\xe4\x81}ZGMG\x8b\xc5r\t\xa0P\xc2\x02\x17\x1e\x12\x80;7Y#\x8f\x81-G\xcb\xe5\x12\x810F\x05h\xb2\xf4\x96\x0e\x88\xe3Ln\xb9\\\xa09\bdF\x88\xb7\xf4\x84\x1b\x90p\x18W\ays`\xf1\x1b\xa7\xb5\x95QE\x1eswq\xb7\xca/\xd1\xd5\bBJ\u0089\x82p\"#\x1c+\b\xc72\u0091\x82p$#t\x14\x84\x8e\x8cp\xa8 \x1c\xca\b\a\n\u0081\x8c\xd0V\x10\xda2BKAh\xc9\bM\xa7\x99\xd0\x04\xb4SEA\x02\xa5* \xaa\x88'\x1a\xc4\x13\x19\xf1X\x83x,#\x1ei\x10\x8fdĎ\x06\xb1TaC\r⡌x\xa0A<\x90\x11\xdb\x1aĶ\x8c\xd8\xd2 \x96\x99\x97H)\x86c\xf3\x95Q\x8b\xc8D\x02!8\x9b\xaf\x8cc|&\xe2\x85Pm\xbe2\xaahM\x84\v\x81\xdb|%\x83\na\xdc|U\x06i\"X\b\xea\xe6+\x83\x89\xebD\x12!ģ\x92WQ\x9e\x88\x17\x02\xbe\xaa\n\t\x81\x10\xfe\x95\xba\xcf\x03<\x91\x00\n\x06\xe7+9\x9c\x9f.\xe7+#{\x11\x8e\xdc\x18\x05\xa9HQ+\x84\xfb\x98Nx@;\x80\bs\xbe\x92\x80\xa1x\xb3R\x94\x8cD\x8c>\xe7+\xa3\b@!\xbc\x18\x8bR3\xa2\xe1(\x84\x16#\xd3\xf9\xaa\f\x02\x04\xbc\x18\xa7V\xd2\xcbH\xc0\xa8u\xbe*\x03R`\x10\x001lU\x8b\x94\n\x8ahˎ\xcbBM\x91\x04\x8ao\xe7+\xa3\nq\xe1\ue6cb&(E\x8b\xb1\xef\xb1!2\x1a1\x12>\xfa\x18\x1a\xc1\x01N\t\x88\x8b\xe7+9\x1c\x88\x92k\x83\x15&\x82bf:`i\xd8\f\xf9U1\x82.[\x91\x85\xab\x10\t\x10O\xafdh(\xba\xae\xda \xa1\x81b\xed\x92\x06%R2(\xf2\xa6n\xa7\x16|\x03\xdd\b\xc4\xe1G\"\x99\x92\xa1\xa8\xfcH%\x1f`@\x8c~$\x93y\x151b\x9f\xaf\xaap] \x00#\xf7\xec k>WB\x87]\xeb\xc5\xd9\xcf\xd2,H\x8e<μrn5\f\xc3R\x98\x98sx57\xcb9\x1e!\fCa\xe6\xce\xd1\xe5\xe4-\xe7W!\x18v\xc2̞\x83\x9bY\x89l\x84Y?\a泸\x9cQQΰ\x12b\x82\x1cZ\x0f\v\xe4\f\x19\x14\xc3V\x88\x1b\n\xad\x94\xa1C\x83\xe2*\b\xc3P\b,\xea\x124s\xaca\x18\x96B\xe8Q\xb3\xad,\x9cP\xd8_\x8eaXB\xc1IN\xa1`\b\xf1Z.\xa5\xe3̘\x9a\x8a\xa1F\x11uvMo\xd0u\xb2\x89\x92\xf1D\xcex\xd2\xc0x\xacd<\x963\x1e70\x1e)\x19\x8f\xe4\x8cG\r\x8c\x1d%cG\xce\xd8i`<T2\x1e\xca\x19\x0f\x1b\x18\x0f\x94\x8c\arƃ\x06ƶ\x92\xb1-gl70\xb6\x94\x8c-9c\xeb\xb9t\xa45\x0e\x90\nQg\xac\xca\x13\xd4I'J\xe6\x93f\xe6\x93\x06\xe6c%\xf3q3\xf3q\x03\xf3\x91\x92\xf9\xa8\x99\xf9\xa8\x81\xb9\xa3d\xee43w\x1a\x98\x0f\x95̇\xcḋ\r\xcc\aJ\xe6\x83f\xe6\x83\x06涒\xb9\xdd\xcc\xdcn`n)\x99[\xcd\xcc\xe1AT\x04zr\xde%\xa0κ):\\)\x03\xc4\x15\x13\xd0idi*:\x9d\x88\x91\x85\xc9\xea\x90ď\xf5\xf4Nc\x15|\x14\xa9\x91\n\xaah\xd51e\x1d$\xe3/\x890\xe7+5\xefF\xbe\x92\x90\xb3J75rf\"D\x8d\xbcTE\xa8\x17\x86\xf2@Y=\x92\xa0\xb4\x9e\xd2jV>\x17\x9aj\xa4\xbf8\x01\x95U\b\xb1\xaaFƌ5pE\xa0\xc9\xc2du\xc8\xe3\xd8\xf9J\xa3\x06\x05sI`\xcb$\xe6\x1a\xf9\xd7q\x92Z\xa4i<\xd6\xdb4\a\xd2+>\x96n\xb50\xc51\x99\xe8\xd44Ѫi\xd2\\\xd3X\xa7\xa6\xb1VM\xe3\xe6\x9aF:5\x8d\xb4j\x1a5\xd7\xe4\xe8\xd4\xe4h\xd5\xe44\xd74ԩi\xa8UӰ\xb9\xa6\x81NM\x03\xad\x9a\x06\xcd5\xd9:5\xd9Z5\xd9\xcd5Y:5YZ5Yϛ\x1c\x90j\xe4rA~\xebuA\x8e\xd1D\xa7\xb6\x89vm\x93\xe6\xda\xc6:\xb5\x8d\xb5k\x1b7\xd76ҩm\xa4]ۨ\xb96G\xa76G\xbb6\xa7\xb9\xb6\xa1NmC\xedچ͵\rtj\x1bh\xd76h\xae\xcd֩\xcd֮\xcdn\xae\xcdҩ\xcdҮ\r\x1e\xdd\xe5\n\x9b\"QK\x11l.\x0fX~\xcb\xf1\x8d\xcc\x04>\xd0\xca\\=\x8b\xd8\xc8\xed\b\xe1x\x8aKw9A\xbez'gX\x94s\xdcą\xbd\x1cMW\xe5伲R\x8e\x93\xb8\xe8\x97c\xf35\x069\xaf\xa2\xbc\xceM\\\x12\xac\xebDŐA\xb1B\x02ˆ9M\xbe\x06(\xe7Y\x94\xb3\xf9w`Q\xb1.\x81\x8a'\x83b8C\v\x8f5\x83\x8d$7\x81\xf2\x10\x86'\xbc\xf3\x8e\x12\x94\x8b\x93r\x96\x15B0\xef9\x18\xd77s\x838\x89\xab\x9a\x8c\x8e\x1a\xf9\xd50\x1cWqݓY\x9fA(P\xae\xe1P\f\xbb*\x04\xac\x8c\x96K+\x8d\f!^\xc0\xb2)\xbb\xcc\xd0ȱ\x0eb\xd7a\x80\x95՜\x84.\xae\xca\x19f\xa5ܲ\x9a\xb8\xeaZ\xd3\x0f]\xaaT\xa80\x83p<\xc5e\xd92I\xdb\xc4N\xe4\x04-\xd9\xd6U\xd3̯\x86a\xb5\a,\xea\xd6(P\xa2Ÿ\x82\xb1+O\xc0\xcaoNT[\xfc\x95s\xae\x83X[\a\x16\x87\x19\x12\x85)1(\x863\xb4\x80\xccШ\xdc1\v\xe3W\xa5\x84Uf\x86\xa8q\x86\xaca8\xae\xe2:4\x9bTkd[\aI\xd3&\xd2\ba\xbeRrob,\x0f\x19\xea[z4\xb2>͕\xc8b\x88j\x13Pc\rL$\xa1\xb7[\xa8\xa2m\x8e+J\x80\x9c\xb5,ʨ6\x1852g\x8cPc'\x12\xa7T\x8d\x1a\x80\xe0Co\xfbR\xc5A\x156\x1c!Ҵ\xad40\xe1\xf6<i\xb4DQ\x95<R\xa9o\x94R\x0f\xb6\xda쭷\xa9\xaa\"WG/uPӸ\x9b\xcbr\x94J\xf6\nֲ\xe0\x86ٸ\xa5\xd3\x17\xcd\xd5Ȣ\x1df\xaf\x97Ί\xc3q\x0e\xd0\xdb\x18v\x1c\xbb\xca\x1a\x14̥\xf1\x10\xbb\x99L+\xeb\xddT\x91<@*6\xa05\xd6P\v\x93\xf4\xf6\xa9\xb1\nVD9+!n\xd2\xdb\xd9VK\x8b)\xf87\xb2\x96\xc7T\xccf8\x8d.h\xacF\x1ed\xf1\xfb\xe7\xd45\xa1DQ\x99<\xeabw\xdd5V%\xc6^z{\xf4x\x06j\v\x06\x821\xbd\x8d}<\a\x8d\xf9\n\x8a\xce\xf4\xb6\x03\xf2,Tq\x89\x10\xae\xe9\xed \xac蕫\xc85\x8c\xa4\x02pM9r̓\xe4+\x91\x91kUE}\xdb\x11\xaeƌ\\\xfbX\x0e\x15\x0f\xaab\v(\x1dV\xa56P\xeaT\xa5C\xa0tT\x95N\x80\xd2\xf1\xb1^\xf1B\xcf\bW\r6\xe8\xe7\xfd\x85Vc\x8b-\a\x9b\x8em\x0e\x04a\x06,\x06P\x02\x1e\xb2\x10@\x13\xd8a!\x80:\xf0\x88\x85\x00:\xc1cN\x16@1\xf1Q1q\xf6]\x17^3\xb1\xc5\x01@\xd5\xc46\x8f\x82@\x03\x0e\x04('\x1er\x18@;\xb1\xc3a\x00\xf5\xc4#\x0e\x03\xe8'\x1e\xf3\xf2\x00\n\x9a\x1f\x154\x0f\xd34$\x82\x86\xe6\x16\x8f\x00U4\xb7\x05\x18\x84\x1a\xf0(@I\xf3!\x0f\x02\xb44wx\x10\xa0\xa6\xf9\x88\a\x01z\x9a\x8f\x05\x99\x00E\xa5GE\xa5a$h)\xb5\x98bPE\xa9\xcdb Ȁ\x81\x00\xcaI\x87\f\x02\xd0L\xea0\b@-\xe9\x88A\x00:IǬ\x1c\x80B\xb6\xa2\xe5̚4\xb4\x95\xd8\xd1L\xa9\xb7-lZ3\x952\xb7\xa0\xb1\xcd\x14\nނ\xd67S(}\v\x9a\xe3L\xd1\x11[\xd0>g\x8a\xce\xd9\xc2\x06;S\xf5ؚ\x9f%f;q\r\xce\x1a3\r\x7f\xb9\x86\xa6\x92\x99څ\xae\x81\xe9e\xa6t\xaak`ƙ)\xdd\xec\x1a\x98\x84fJǻ\x06楙\xd2\x15\xaf\xa1\xa9j\xa6\xf4\xceĥ\xdfϊW~\xc0\xf7\rq\xad\xb2\x04\xea\x00\xe2\xdaU1T:(K-\xa0pX\x16\xda@\xa1S\x16\x0e\x81\xc2QY8\x01\n\xc7U\x9d@Cq\xd9P0~!\xd8b\x8a\xc1&c\x9b\xc5@\x90\x01\x03\x01\x1a\x8f\x87\f\x02\xd0\x00v\x18\x04\xa0\x06<b\x10\x80.\xf0\x98\x95\x03PH\\)\x04\x1c\x9b$\xb6\xd8rP%\xb1́ ̀\xc5\x00J\x89\x87,\x04\xd0J\xec\xb0\x10@-\xf1\x88\x85\x00z\x89ǜ,\x80b\xe6\x95b\xe0x\x85\xcc-\x0e\x00\xaafn\xf3(\b4\xe0@\x80r\xe6C\x0e\x03hg\xeep\x18@=\xf3\x11\x87\x01\xf43\x1f\xf3\xf2\x00\nJ+\x05\x01\xb30I\xadz)\xa8\x9a\xd4f \x10bPG\x00JI\x87u\x00\xa0\x91ԩ\x03\x00u\xa4\xa3:\x00\xd0E:fd\x00\x14\xb1\x15,e֠\x98-l63\x95\xb6\xb6\xa0!\xcd\x14\x1a\xdcB\x965kV\xea\x16\xb2\xb4Y\xb3\x9e\xb7\x90\xe5͚U\xbf\x85,q\xd6\xdc\x1b[\xd02g\x8a\x1eZs^\x7f\xd6\xe8\xf2\xd6\xd0$0S{\xc1501̔~q-\xce\x153\x95\xa3\\\x8b\xb3\xc7L\xe59\xd7\xe2|2S\xb9ҵ8\xc3\xccT\xbeu\r\xcc93\xc0۶\xbd\x962r\xb3\xfb\x18\xe5\xf9\x96z\xa9$\xe5\xc2@\xc0\xacK\x1d\x01&^\xea\x000\xf7R\a\x80\xe9\x97:\x00\xcc\xc002\x80I\x98\x1aB\x96\x87\x11 \x92T\x8c\x88\x03\xb31\x02\fL\xc8\b(0'#\xa0\xc0\xb4\x8c\x80\x0233\xa2\\`r\xa6\x0e\x93\xe5gD\x8c$E\x03\x00\xc1,\x8d\x88\x03\x135\"\f\xccՈ00]#\xc2\xc0\x8c\r \x1b\x98\xb4\xa9\xe3\xa4y\x1b\x00$I\xdd@H0{\x03\x00\xc1\x04\x0e\x80\x03s8\x00\x0eL\xe3\x0080\x93\x03\xc9\a&s\xea@8\x9f\xc3#$)\x1d\x01\x06fux\x14\x98\xd8\xe1A`n\x87\a\x81\xe9\x1d\x1e\x04fx\x04\x99\xc0$\x0fdi\x8a<\x8f\xd4\xeetR=2S\xd4\xc8\xf6H\x8cS\x9d\xf0\x91X\xab:\xe7#1_u\xdaGb\xcf\xeȁ\xcc\xc05\x92?\xe2\xec\xa4\xcc\xffHf+\xbd\x14\x10<\x85ie\x81\xc0iM'\x11\x04\xcet:\xb9 p\xf2\xd3I\a\x81\xf3\xa1NF\b\x9e\"\xb5\x92BF\xf6yiI^\xa8V(I\r\xd5\x11`v\xa8\x06\x00\x13D\xb5r0GT+\a\xd3D\xb5r0ST\xaf\x1fL\x16\x1d\x01\xb2|\x11\x8f\x90\xa4\x8c\x04\x18\x985\xe2Q`\xe2\x88\a\x81\xb9#\x1e\x04\xa6\x8fx\x10\x98A\x12d\x02\x93H5\x94,\x8f$@$\xa9$\x11\af\x93\x04\x18\x98P\x12P`NI@\x81i%\x01\x05f\x96D\xb9\xc0\xe4R\r&\xcd/\x89\x18I\x8a\t\x00\x82Y&\x11\a&\x9aD\x18\x98k\x12a`\xbaI\x84\x81\x19'@60\xe9T\xc3\xc1y'\x0e I=\xf1(0\xfbā\xc0\x04\x14\x87\x01sP\x1c\x06LCq\x180\x13\xc5\xcb\x03&\xa3\x00\xcbj\xceG\xc9\xccL#%%1<uV\n\xb6Deb\n\xb6Len\n\xb6Tez\n\xb6\\e\x86Jb\xc9\xea$\x950ۨ\xf2T\xf0䣕\xaa\x02'$\x9dl\x154Gi$\xac\xa0YK#g\x05\xcdc\x1ai+hf\xd3\xc8\\\x81s\x1d\x94\xbcz\x92\x0fyЌ\x16iJh\x11e>\x8b\xa8\xd2YD\x91\xcd\"\x8ad\x16Q䲈\"\x95ET\x99,\xa2Ld\x11\xbd<\x16\xd1Jc\x11\x9d,\x16\xd1Ib\x11\x9d\x1c\x16\xd1Ia\x11\xad\f\x16Q'\xb0\x88f\xfe\x8a襯\x88V\xf6\x8ah%\xaf\x88V\xee\x8ah\xa5\xae\x88^\xe6\x8ah$\xae\x88nފh\xa6\xad\x88^֊\xe8%\xad\x88^Ί襬\x88fƊ\xa8\x12VD+_Et\xd2UD#[E4\x92UD#WE4RUD'SEZ'\xaa\xc8#\xf2T\xa4s\x9a\x8at\xccR\x91\x8eI*\xd21GE:\xa6\xa8H\xe7\f\x15i\x9d\xa0\"\x8f\xc8O\x91\xce\xe9)\xd21;E:&\xa7H\xc7\xdc\x14阚\"\x9d3S\xa4!1ETy)\xa2HK\x91\xe6\xac\x14iNJ\x91\xe6\x9c\x14iNI\x11EF\x8a\xa8\x12RD+\x1fEt\xd2QD#\x1bE4\x92QD#\x17E4RQD'\x13E\x94\x89(\xa2\x97\x87\"Zi(\xa2\x93\x85\":I(\xa2\x93\x83\":)(\xa2\x95\x81\"\xea\x04\x14\xd1\xcc?\x11\xbd\xf4\x13\xd1\xca>\x11\xad\xe4\x13\xd1\xca=\x11\xad\xd4\x13\xd1\xcb<\x11E\xe2\x89\xe8䝈Fډ\xa8\xb3ND\x9dt\"\xea\x9c\x13Q\xa7\x9c\x88FƉ\xb4L8\x91\xce\xf9&\xd21\xddD:e\x9bH\xa7d\x13\xe9\x94k\"\x9dRM\xa4c\xa6\x89\xb4L4\x91\xcey&\xd21\xcdD:e\x99H\xa7$\x13\xe9\x94c\"\x9dRL\xe4)2L\x92l\x12n\xca&ae6\t\xab\xb2IX\x91M\u008al\x12Vd\x93\xb0\"\x9b\x84U\xd9$\xac\xcc&a\xbdl\x12\xd6\xca&a\x9dl\x12\xd6\xc9&a\x9dl\x12\xd6\xc9&a\xadl\x12Vg\x93\xb0f6\t\xebe\x93\xb0V6\tke\x93\xb0V6\tke\x93\xb0^6\tkd\x93\xb0n6\tkf\x93\xb0^6\t\xebe\x93\xb0^6\t\xebe\x93\xb0f6\t\xab\xb2IX+\x9b\x84u\xb2IX#\x9b\x845\xb2IX#\x9b\x845\xb2IX'\x9b\x84[g\x93\xf0#\xb2I\xb8s6\tw\xcc&\xe1\x8e\xd9$\xdc1\x9b\x84;f\x93p\xe7l\x12n\x9dM\u008f\xc8&\xe1\xce\xd9$\xdc1\x9b\x84;f\x93p\xc7l\x12\xee\x98M\u009d\xb3I\xb8!\x9b\x84U\xd9$\xac\xc8&\xe1\xe6l\x12n\xce&\xe1\xe6l\x12n\xce&aE6\t\xab\xb2IX+\x9b\x84u\xb2IX#\x9b\x845\xb2IX#\x9b\x845\xb2IX'\x9b\x84\x95\xd9$\xac\x97M\xc2Z\xd9$\xac\x93M\xc2:\xd9$\xac\x93M\xc2:\xd9$\xac\x95M\xc2\xeal\x12\xd6\xcc&a\xbdl\x12\xd6\xca&a\xadl\x12\xd6\xca&a\xadl\x12\xd6\xcb&aE6\t\xebd\x93\xb0F6\t\xab\xb3IX\x9dM\xc2\xeal\x12Vg\x93\xb0F6\t\xb7\xcc&\xe1\xce\xd9$\xdc1\x9b\x84;e\x93p\xa7l\x12\xee\x94M\u009d\xb2I\xb8c6\t\xb7\xcc&\xe1\xce\xd9$\xdc1\x9b\x84;e\x93p\xa7l\x12\xee\x94M\u009d\xb2IX3\x9b\xc4\x10\x06\xc7C\xfd\x06\xa4\xe8\xe0x\xaa\xdf\xe8C\xc5ձ~\xc3\x02J\xabs\xfd\x86\r\x94V\a\xfb\x8d!PZ\x9d\xec7&@\xe9\xf8X\xafh\x88\x01wx\x1fn\x1awz\x1fl\x1fw|\x1fj$w~\x1fj)w\x80\x1fj.w\x82\x1fj3w\x84\x1fl8\x7fF\x1fn9\x7fH\x1fl:\x7fJ\x1fj;\x7fL\x1fj<\x7fN\x1fj=\x7fP\x1fj>\x7fR\x1fl\xbfp\x12\x1fV\x80p\x14\x1fԀp\x16\x1fR\x81p\x18\x1fҁp\x1a\x1fR\x82p\x1c\x1f҂p\x1e\x1fT\x03{\xe4\x1e\xd6\x01{\xe6\x1eT\x00{\xe8\x1ej={\xea\x1ej:{\xec\x1ej7{\xee\x1ej4{\xf0\xde\xe8vj8`Ωȼ\x1d\x03\x01\x1d^\x1d\x01\xfa\xbc:\x00t{u\x00\xe8\xf9\xea\x00\xd0\xf912\x80\xfe\x8f߿*s\x81\"\x0e\xf4\x82\x02\ft\x84\x02\n\xf4\x85\x02\nt\x87\x02\n\xf4\x88\xa2\\\xa0S\x14Nb\xc8\xfc\"\x00\x04]\xa3\x88\x03\xbd\xa3\b\x03\x1d\xa4\b\x03}\xa4\b\x03\xdd$ \x1b\xe8)\xc5\xcd\xe92g\t!A\x7f\t\x00A\x97\t\xe0@\xaf\t\xe0@\xc7\t\xe0@\xdf\t\xc9\a\xbaO\xeex\x85̃\n0Љ\xf2(Џ\xf2 Е\xf2 Л\xf2 С\n2=\xddf\xf6\xa0\xbe\xefJ\xeag\x89\xca\xcd\x12\x85\x97%\n'K\x14>\x96(\\,QyX\xa2\xe7`\x89\x96\x7f%:\xee\x95\xe8xW\xa2\xe3\\\x89\x8eo%Z\xae\x95hzV\xa2\xe7X\x89\x96_%Zn\x95hyU\xa2\xe5T\x89\x9eO%\xba.\x95hzT\xa2\xe7P\x89\x9e?%z\xee\x94\xe8yS\xa2\xe9L\x89\x96/%:\xae\x94hxR\xa2\xe1H\x89\x86\x1f%\x1an\x94\xb4\xf6\xa2\x12\x8f\x89\x95\x1e\x13\xab<&VxL\xac\xf0\x98X\xe11\xb1\xc2cb\x95\xc7\xc4z\x1e\x13kyL\xac\xe31\xb1\x8e\xc7\xc4:\x1e\x13\xebxL\xac\xe51\xb1\xa6\xc7\xc4z\x1e\x13kyL\xac\xe51\xb1\x96\xc7\xc4Z\x1e\x13\xebyL\xac\xeb1\xb1\xa6\xc7\xc4z\x1e\x13\xebyL\xac\xe71\xb1\x9e\xc7Ě\x1e\x13kyL\xac\xe31\xb1\x86\xc7\xc4\x1a\x1e\x13kxL\xac\xe111\xe41\x19\xd4\"\xc4؍\x12t\x98\x87\xb1\x87b\xa3\xfc\xfd\xb4\xfcaV\x14$\x91\xbb\x00\xb6\xb8%i\xecG\xc83\xd8o0\a\xe9\xdaX\xac}\xec\xfd\x18z\xdes\x9d\x8f1W|\x8e\x9fYV2\x11\xbe\xb7\xcc\t\xa3\xc7E\xf8\xa2rť\xf6\xa9d%\x17\xe8\x9b\xc99\xa3\\\x18%\a\xd5\x17\x12\vf\x9e\x1b\xdf)y5}G\x95\xf2\xb9C\x87\x14\xedS\xc3C\x8b0vS?\fN\xb1\x1f #]\xc7\xe1f\xb5f\t6\x81\x87bZ,\xd0T%\x9cᅆ\x9c&\b\x03\xd4:\x9d\x94\xcbL_\xa3:\x88\r\x91iH\x0e\x91e\xc2?\xc9{[\xd1\"ҩA\xa4S{H\x97\xe6\xc8EǝDǝD\xc7\x12\xd1\x19\x8a\xb4@\xb9\xd8_\x05\xa74(\xe0\xca\xe3zy6Or\x80E\x1d\xb0@A\x8ab\x0eq[G\xdcn\x92\xd4_\u07b7\xb6\xe6\x14W\xb6%\x97\x95\x83\x80\xe2r\x18Xb\x0eT\n\xfd$V\x9cb\x83ԙ\x83\r!\xeav\x10\x8df\x90\xf6\xad\x80%V\x9a\x89\x81\xd5\x12c\r\x891(1\x8b*m.\xfb`\xfd2\x8c\xc9\xe9\u008d\xfc\xd4\xc5\xfe\x03⡘\x87\xe2p\x87⅛\b\xc8\r\x8f\xdcD\x11\x8c\fxd'\xff\x9c\xd6lQ\xab%\x00Z\xde\x18\x00,o\x0f\x00~:\xaf\x9d\x1emU\xaf\x99\xa4E+I\x8bF\x92\x0em\x94\xb4\a\xb7iO\v\x134p\x8b\xf6`\xb8=\fni\x8cN\xb8\ak\xe4zY\x94\xb1\f\x03\x1a{>\xa0S1\xc4_\x1a\x0eO\x97l\xe6\x00\xa9\x18\xd2/\xadZ\xf1@,\xb6k\xc56\xf4\xe2\xb0\x1c\xd4\x10\x16\xf0Ұ\x1c2\x00\x88\x85SG\x88ţZq\x7f2\x06\x18\x8c\xeb\x88\x1c\xd0v\x80/\r\x9a閩\x9f\x8e9E\x0f\x00ԵN`\x19@\xfd\xc0\"\xa0\xae`\x11\x92\xde`Ap\x87\xf0\x18\xb0O8\x10\xd0-,B\xd23\x1c(\xc7<\x89\xab\xa2=F\xa4\x1dF\x94\xfdE\x9a\xba\x8b\xa8z\x8b\xa8:\x8bh\xf4\x15Qw\x15\xd1\xe8)\xa2\xea(\xa2\xd1O\xa4m7I\xba\x04K\xbb\x04+\xbb\x047u\tVu\tVu\t\xd6\xe8\x12\xac\xee\x12\xac\xd1%X\xd5%X\xa3K0\xd0%\f\x86 7\xd9\xc4\xe8p\x1c't\xec\x80\x18Z\xce\x00\x872`\xe0\xc6q\xb8\xabAm\x81\xa7\x1fx(H\x1bwSβ\xe9.\a\x9eZ<\x83\x84\xb8\x18\x1b\v7*\x9c\xc3\x12\xb9)\xad;Ai\xea\a\xab\xe4\xf4YB\x16ѳYV\xb8uc\xdf\r\xd2\xd3#\x11\x1f\xcfn\x82\x85\x9b\xa2C\xb8E\xf12\xfbf\xa0\xefy(\xc8E\xa8\x1e\"\x8c\xfd(\xf1\x93Y\x96\xefȲ=\xe84\bw\xb1\x1b\xb5\x9e-*]%ں\xe7\xb0\n\xf5\xb3hY\x0f\xa8n\xe4\xd3\xed\x04#x\xca~\xa0ܺwœ\xcc\r\xa52\x89~\xf7\x906\xbdCt:\x87<Mߐ\xa7\xec\x1a\xf2/뙆^\xc0\xfa\xbd\x80\xdb\xf4\x02\xd6\xe9\x05\xfc4\xbd\x80\x9f\xb2\x17\xf0cz\x81aY\x92\x18\x8b0H]?@q\xc5ڸ?M\x16q\x881K\x91\xbf\xca3+K\xee&\r\xd9ͽ\xf4\t\xa7\xfaؠ\x0f\x0f*\x18f`\x15\xfb\xd6\x1e6\x17\x93ߐ\xd5BRaߒTX\xa8\x92\xa7qCE#H\xe76\x10\xdd&\x90\xb6-\x90K\x8b;K\x8bu\xa5ŀ\xb4\xac(؏\x0e\xf4\x9f\xd3\x18-\xd2\x1f\xadh߫\xfd}>c\x8aNj\x7f\x9fϢ0\xf1\xb3\x04\xea\xd2\xdf#\xef\x7f\xf9$\n\xe3\xd4\r\xd2ٟU\x89;OB\xbcIѱ\xb0\xbdyb?\xa2v\xf3_)\xe3Ә$\x15\x9c\xfc\x1b\xc9-\x93\x11\xff\xd7\xca\xc8\b\xb0K\x8c \x8c\x89\x8b\x0f\xac\x03\xa6\x8f\xf8$>u\xca\a\xc0O3\xb0(F\f&\x8aۧ +\xa1\xa8\xdd\xe9\xc9%\"A\xd1x\x18\x95\xeeI\x8c\xed(2ѕ\x98h\tLZ\xca\xdb(\x9bn/\v@X6,\xca\xc6`\xb6\xc6\xdcM\xd0a\x8b\xe2\xd4_\xb8\xb8Ȟ\xd3g\xe2\x1a\xd1\xd6 \xbe\xc7C\x89\xefyX\x00\xa6a\xc4\x03\xd30\x12\xaaN\x89Ps\x16\x17\xb56Ǽ\x19\xd4t\xf4[\x02\xa0\xa5\x8d\x01\xb0p{ \x11\xf2&=\x89\r\x17\xed$m\x9aI\xf4[I4\x1bI:\xb5Q\xde\x1eܦ=X\xbf=X\xb3=X\xd6\x1e\x06\xeb\xf9\xe4\x10F\xee\xc2O\xefO\xadY\x96<\xcf]x\xf1\xb0\u05f7\x9c\xa4\x87h\x83\xfc@ \x85\xbe\xcbO\x1f\xe7\x9f\xe2/\xf9\xf6\x9d\xf6\x8c\xddE\xeaoёŤ\x99E\xb8\xe1\xd6\xd7V8\xdc\x1dZ\xd5J)\xa0\xf6d\xcf\xd9\x06\xb5T\xd4\xda\xf7P\xbeע\xd7\xcf\xfeS12\xbb2*\xf4S\xf0;\x91\xa1\xb2\xe6\xa8@Y\xdbx\xc9Z6\xf1\xb8Ξq\x83\xf4\xc8C\xb2\x7fu\x97\xf2W4af\x90\xf0\xc1\b\x93\xbd\x91'\xedH\x18\xa6k?X\x9d\xd2=:\xc9\xc2\xc5hf\xec\xd0\xfc\xceO\r\xba\x9be\xe9.\x90\xb1\xf5\x13\x7f\xeecڢ\xe2=\xb0\xa1\xe8\xb8t\x94\xfd\x84\xdd\x14}\xff\xd1|^\xd7D\x05\xe9\xf5m\xb9\xed\xc5\x12K\x8a+K:V\x95\t\xfe\xa3\xd57\x9d\xe7\x00\x97b\x18\xf0\xf0\xfe\x14\x00\x1b؍W\xe8\xdfUK~\x00+*\x97Z\xa6\xae\xa2T\xa64[\xaa\x06\xb9\xe6x=G\xa1O\xdfʊ:\x16\x9b8\t\xe3\xd3\xe2!\x8bL֮\x17\xee\f\bx\f\x8ac\x84]Zs]\x1f.ƽ\xbe\x93\xf4\x16\x9b\xb9\xbf0\xe6\xe8\xc1G\xf1\x8f}k\xe4\x9c\xf4'Ó\xfepxb=\x97Wu\xea.i>\xa1\xd8C\x17\xbb\x9e\xbfIN\xfd`\x8db?\x9d\xcdý\x91\x83O͞ٳFѾgG\xfb^}\xfb\x96\xfd|F\x93\x174\x01\xf3\xec\xd9l\x8d\xf2\xd3Ŧ\xf9ì8\xf4}tGB\x04?+\x928\x803P4h\x96O\x90Y=\x0fY\x02h\x7fjX\r\xcd\xccL o\xec\x89\x1cUWI\xe5\xacX\xae\xf3\x95\xe1\x06>qSt\"y\x0e\x99[\xad\xb4fny\x9b\xf9\xddq\x8c'\x14\xed\xfa\xc10\x0fe\x93M\xbeȪ\x8a,\xbeȮ\x8al\xbehP\x15\r\xf8\xa2aU4䋜\xaa\xc8ዦ\xd3iU8\x9dN\x81b\xa6\\\x00\x10w\x7f\x94\xd6\x1a\x8e\x87\x93\xc1h8\xe6Q\x85\x99V\xc8\xe2w\x11槾\x8b+\x98\x98\xd8x06A\x82\x8e\x8c\xb2\xdfXH\x80\x92\x14\xd1\x0e\x8a\xee\x8d|\x9e\xc9,\xbd\x17\xe2\x13-\\\xa4\a\xdb\xe0C\xedW\xba\x84\x05\x8aq\\U\xabѮ\xad\x13}\xac\xdd\x02;h\x81\x1d\xb6\xc0:-\xb0#N/6\xac\x18\xec'\xa9\x11\xa3\x04I{\xa6\x86Ȕ\x9d\xa4F\x92\xdecd\xa4\xf7\x11ʶu\xcc\xd8K+\x9an\x8e\xacwc\x9e\x85\xeeE?E\xfa)뾐\xb3\xaesLP\x84b7\r\xe3:S\xca\xc4\xea;\x12:\x9f\xacz>Y\x1d<?\x89\xb0{\x7f:\xc7\xe1\xe2nv|C\xca\x1c\xe6\xf1G\x89\x0e\x83\xbb\xa4\xe7\x1e\x8a\x8d\xc3\x03g\x8c<\xaf\xee\xa4E/\xd5\xc4\ar\x87\x1c\xa2\x98\xf4\xf2\xfa\xa6\xa3\xc5b\xb9lS\x1f}\x91\x8f\xb2IlOW[i<R\xccg\xf3p?+o\xf0\xea\x15\xb9\xd0J\x15Ñ\x19\xedg\xd5ͨ=\x9b\xfe*\xd3M\x18e\xaa4Rwe$hA媔\xbc\xc4h?\xa3\xff\x18\x9e\x1f\xa3E)\xf3\x86\x04\xfc\x02\xc2=u\xe7\xfdy\x1a\x18\xd4\xe1G\"\x87ӾճsI%W\xb6\x16L\xfe\xfcs\x9e\x06\xec%\x1f\xd0ZvM\xecC\xf6\xa2f\xf8)\"I\xb1#pV\xa9)\xbf\xd6%\xda\xf7\x92\x10\xfb^>\xc1;ӓ\x91s2\xb1N\xfa\x83\xe73.\"a\x04/%\xb5L\x1a\x1f\xd0\x7f\xac\xf2\x1f!P\xb1\x13\xa9\x80\x85\x19\x1c\xa7Cf\x9b\xb8i?\x97RV\xa6:\x1fZ\x8e\x95\xaf\xe6,]\xe2\xe3\xfb\xd3\xc4\r\x12#A\xb1\xbf\x9c\xd5v\x0f\f\xa3\x9a]\x98='\xdaKy\xf7\x83\xd0\xf0P\xb2\xe8%\x91\x1b\x1c\x96X\x9cZk\xe0^\xb2]\x1d\xf8&\x0f\x93\x06<]k\xe2ŧu\xd8\xeaVX\xb4\x15ٯ\xbb\xdc+\x0eM\xb3f\xceT\xfdҊ\xff\xee\xf9ۢ1=\xabg9&\xc0\xea\xb1\xeb\xf1\xf5\xc4\xccЌ\xf6\xcf\x0f2ir5\x9ch\b\xfb\x97\\\x95}?X\x86\x7f\xfe\x89\xf6)\x8a\x03\x17{\xe1B\xb5A9rc\x97\xa0\x14\xc5\x7f\xfeI\x9d\x7f\x83\x15\x910\b\xb3\x16\xd6\xd5os:\x1b\xd5\xd4\xefD\xfb\x9e)\xa9-[\xec\x8bC\xcc~hp,\xb8t\xb4wI\x84Q\xf2矩\x9fb\xc4\xf9\xf4\x9a t\x16a$\x19\x9b՜S\f\xedF\xf6\xa5?k\x9ea\x04|6S\xa3\xb8\xbe\xcbe\x1a\xab%qb\x19g#A\x18-Ҫ\xa1~\x90\xcd\xf9\xe5\x1c\xa6ٞ\x82\x8b\xf0\xc0@\x18\x11\xba\xefE\xe6\xe29\xfc\xb1\x9d؝#\xac\xdbL\xe9uS\x05\xfb\x96\xea~zmo}\xb43\xca\x00\xeb\x8e\x7fլ\xbc\xa2\x13\xed\x8f/l\xc7\xf7\xce0:\x1d\x00n\xddIf\xe5B\xbe\xc4\xeb\x14\xaf\x98\xb5I\xe6o\xa6i\xce\xd8\x17O\xeaѸ\x97\xce\x01\xf7\xbeiM\x9f\xd7]\xb7%\xf3ݽ~\xea\xceK\xa5\x89\xf3\xac\xa5$\xca\x7f\xa6ӥ\xf0:^\xf9ء\x19\xed[0:]\xfaq\x92\x1a\xe12\v6\x0f,\x9f\x9e\xd93[\xf0\xea\x17I\xba\xf50\x9f\x99\x84\xbe\xeaΫ\xcc\t\x1c'b\x9aޙU\xa7\x14\xa9i\x88\xef\xfc\xb4\xef\xb2@\xc41\x7f\x80^\xf5\xc5\xcc\xceo?\x1a\x8e\xf9C\xf5.oKb\xae\xbe\x9f\x18a\x84\x82ꁑl\bq\xe3\xfb\x83,|\xa1\x96%i~ł\x1dUPltl>\x7f\xf4m\xf2\x9c\xb5R\vȋX\xcf\xd9 \x89f\xf5\vM9\xf5\xb8sR\xc4L\xad\xe4\xfd{\xee\x92\x00\xa9\xd99\x8c\xb5{e@\xc1\xcfhG\x7f\x92\x8d\xb5<\x84\xee \xe8\xdfs\veB\x93\x06s\x87Y\xf5\xd6C(P\xb24\x03%\xf8\"_\xb1\xceܴ\f\x82\xd2u\xe8\xd5\xc7\x00\xe0\xae\x06\xd9@\xc8DZ.\x97\x1d\"\xb6L\xc1\xd5rϤn\x174\xd7V\xbc,\xd6\x0f\xf1\xe4O\x18\xcb3{M\xc7)\xa5-\f\xb3\x97K\xaa_\xdf;\xd1#\x89\xdct\xdd\x02\xfa\xe7\x9f\x1e\x8ab\xb4pS\xe4=\xc2V\xc1\xe0k\xd4\x10|\xe5\x066ۅ\xb1g\xccc\xe4ޝf\xff\x1a\xf4\x81\x18\x9b\x8eG\x13Yl\xfa߮4vl\xfe\xf5\x97>\x9b,^6\x92u\xec\awY\x06\xa2l\xee\xc2ŋ\x1f\xa9\x1b\xeb\x19=+\x1b\x88FϢQ\xc2\xf3Va\xbbF\xad\x16\x97w\xf8믎Z\xd0?ǩ\xd5o\av@jr\xa0\uf031\x1fe\x91\x1b\xe4\x86zV\xfeڮv\x02\x03\xa9mjI\x02\x8e$\x8d\xb7sG\xb7\xa9\xba!\xa2$<\xb4\x9b\xc2CM\xf7[.\x1br\x820\xc1iaX\x93h\xff\x18\x9e\xfd,Ӗ\x86\xc6\x02\xfb\xd1<tc\xafx?\xb0e\xa6Q\xf1\x8d\xc2$\x15\xb2\x15\xe3\xc1\x89m\x0eO\xac\xe10\v\x01\x8e\x97\x16P\x83\x19N\x17\x8b\xa9\xa9\xe6\xaa5\x1dufv\xe8*T\xa7\xd0Q\x8f\xffFԤ\xed\xd8'\xd6\xc8:\x19N\x00M.\x17\xae5P3\xd5RdW^\x87\x8e\"uS\xa3\x0e{\x0fa\x94\"Q\x93\xc3\xe9\xc9Ȧ\xff\a\x149\x1d\xa0\x01\xd2a\xab\xa7\xcb\xee\xec\x0e\xdd\x05\xeb\xa8Q\x8d\x1aVH4\xcc\xe9\xf8\xc4\x1a;'\xb6\x03\r\xf1\x91\xe5.\x97j\xa6Z\xca\xec\xca\xeb\xd0Q\xa4nj\xd4a\x1f\xb9\xe9b-(rb\x9e\xd8\xf6\xf8ĚB\x8atL4X\xd8\x1al\xb5Tٝۡ\xb3X\xddԩS\x01\xe5*h\x93N9\xd6Db\x96SӲ\x97H\xcdUK\x99\x9d\x99\x1d\xba\n\xd5M\x95:\xfc\xc3,\xa2KDm\x0eN\xa6\xe6\x895\x1a\x03\xca4=\xc7u\xc7Z|\xf5^,\x1f\xc1\xef\xf0\bѺiU\xa7\x8aZ\xf0\x0e\xa6Q\xa6\xf6\x0f\x80^ќ\xfe\xa96\x8c\xf4G\xba\x95hi9\xe7ޙ\xe5\x01\x92U\x9f[7]7\xd4R\x13p\xb1F\x04%\x87\xb6\x19\xa5\x9c\xac\xd7/~0\xf2U\x06U\x96f\xe9\xe3\x946\xe2\xf8F\x95?1\xfc \x8b\xe2\xf2,\xaf}\xcc\xc5y\x13\xcfC\xe3\xf2\xad\xc1Θ2K\x86\xd25W/\xdc\x058t=c\x13c\xa3X\xe4\xed\xf5\x97\xae\x8f\x11\xf7\xf2]\n\x95\x97\x15\xefg1\xf2t\xf8\xd1'~\xb0\x82\x19\x16\x85\a٭`$\xf4\x106\x8a\\=\x93\xd1\xe7\xd3\xee\xa9;g\xd3\xd0ǭ\a\xf9\xae\x03\xd9z\xaf;\xefa\x9f{\xe5\xe4\xde\xc8\xf42{\xf5}\xc5Ѿ\xb9>8S\r\x7f\xc7,c\xaeH?C\\E{\xd7\xdbW&\xe6\x96s9hJ(\xdfbQ\x18T\xb4\x87\x84(F݁\xcb\xc3A\xd0\xde|\x93\xa6a@\x7f˶*\xd4e\xcd\xfa\xac\xb0v\xb3He\x95\xfb\xe6\xea\xfd\xc1<+\xaa+\x9fI\xba\xe0\xe8?\xaa\x94Ci\xae\xf0\x1ai\xb9\xe6i\xd0E\xcffh6\xc8\xff,\x8eǴ\\6ϭ\x88Y6?\x0ed\xa7\xc9\xeb@M\x11\xf6\n5\xb6F\x8a\xae7\bH\x11wh\x93~\v\xa26\r\x884\xe4\x8fZ\x8b\xafLmK\xd5\xd9\xf8I\\\xb4G\x8bM\x8aJ\xf8\xf1\xfb\xbdٞ\x8e\xc6Uu\x8e\xb4G\xb7\xbb0sӰ\xc9\xe7\xcfC\x8ff\x00c\x97T\xa1Y\xdbm6\"\v\xe6\x19\xf2\xfc\xf4\xc0lY1\x95\xf4\xf9rK\xbd\x11\x9a4\xbdbI\xbb\xe8\xa2\x014\xa3\xc6(\x89\xc2 A\x89\xe1\a\x01\xa7\xebF\xa40&\x84r\xe71á\x98\xff\x1d\x95\x14\xfd\xc5&V\x1f\xc2*\xa9h\x00\xf5g\x92\xba\xe9&\xe9b\xecJ\xa6\xb5\x87\x9b\xc0\v\x17\x1b\xba\xe6_\xc5\x04\x7f\x9b\x9a\xf4\x8fz}\xc1\x12\xd7\x17\x1a\xaa\xceg\x87nc\xb7L\x91\x0f\xcd\xdaW)\xf3\x0fK!\xa2\xaa\U000ffbf5\xf5\b\xb0\x96\x9c\xad\x16c\xc5\x1d\rj\x1e\xbd~=\xcb\xce\xefd\x90\xedm\xa3c\xef\xf8[\x11\xb1\x15ۭ\xeb\xdb\xf4\xea\xfbHN\a\x88\xc8W3s\x96Q\x8c\xfa\xc4_\xc4av?\xea!K\xd9S\xa7V\xcb\xd8\xd7\x17\x89\xff6\x18\f\x80\xed\f\x92\xf5A\xed\x1dE\xe5y\x8a\xf5}\xb4FA\x92\x9f\xe8f~9\xe6㙠\x9a=ŘI..;\xb8\x18\xb7X\x8b\x80\xf4\xd2\xeb\xe7\xaf5\xd9]e\x8c\xaa\xf9\xe3E\xabuF`,B\x0f\xa9\xb6+\xb0\xe8\xbf\xd7\xfb\x81\x8e\x98u\xb5K-bV\xd6G\x88\xccj\x17(\x88\x1d,\xe7\xdb\xcbĂ\xb4V;\x84\f鏭\x80\xfaBc\x11\x12\xe2\x06\x9e\xaa\x91\xd5\xfbH\x11\xe8&\x8am\b\x7f\x1b{\x13{:\x00\xf7\xcc\xe4\x83\xced\r\xaeiY\xa8\xfdb5\xb5\xc6B\xcf\x03ZQqcd)})pm\xbdI\x1a\xaa[\\\x18\x01]P\x99\xbf\x96\xd6n\xc6`r\xb7\xcb%\xbc\x05\x83[\fw\x98\xcdBv\xfd\xbdg\x00L\xfd|\x9d\xe5\x13\xb6g\xb2\x18\x04\x05\x1e\xa3PMF\xc5\u038d\xa6\xa5o(\xc2y\xdc\xe6\r#ߔ\xeb@чB\xd2*\x80\xa9^\x1e\xadA\xd5yʫ\x13Kg|\xecD\xc0\xc25Z/15\xf6}\xbb\xee\b\xacZG\x17\x9b\xabFB8%HW=R\x8d\\9e\xf1R˽\xae\x97/\xb2\x05\xe8\x99Fw\x9a@w6m\xaf\x92\xf4G\xf6FLI\x80\xfdW\xd9\xf6\xab\x13\xfa\xcfs\xed\xe6\xcd\xd12\x8cѡ\x9c\x95\xaaY\xf64\x0e\xd3쇞\x95\xf4\xe8\\\xe0\xc6=?X\xfa\x81\x9f\xa2\x93ک\xaeY{\x8aǝ(\x14\x12R\xf9҃s\x92\xff\xbf\x96\x85,\xfci\x96c(\x1e\xa5a\x04\xdcX>bR\x13lxQNDf}7\\9\f\xb3\x91s<\x04\v\x9f\x87s\xaa\x13\x1a\x99\xc5\xfeg\xd9\xfc;t\xbf\x8c]\x9a\xb1+\x15wH\xc3\xda\x11\xc4\xec)\xfa\xd1J7q\xf0\xfc\xaf\xbf\xfe\xb3%^\x16\xaa\x15[\x95\xa1-\x05p\xa2\x8b\xa75\xb2]%y\x1e\x89\xfd\x04i\vJ\xc3p\x17\v\x14\xa5e\x11Fq陘\xbc\xec*F(\xd0\xe6\xfa\xe7\x9f\x05W\x82\x92\xc4]\xa1C\x8dɬ~y\x9fRRn\xaf\xf6\x89\xbe\x04\xcd{\xbbi\xd5\xfc\x96^\xfaZ\x00XE\x96\xa2B\xf1\xc11\x7f8\x1e\x01g\xec@\x82\xe0\x8fn{\xe8\x183g\xd7\xdc\xf2\xf7\x9e\xe7\xf2\x94\xa9^\n)\xcd\x1e\x8eG\xf9\f2\x0e\xdd4\xb7\x81C\xf63\x94EH\xb6+\xc3M\x12\x94&\x87\x827t~\x14\xdaTR\xec\x1a\xec\xad\aZ/d\f\xad\xdb\x0f\xc2,%\xcb\xedA\x87A'\xe0\xd3Sꃎ\xef`e\xae\x8f\x8b\xc5\xf8=M\xe0\xd9ť\x8b1um\xb5\xf4\xf31\xc8\x17\xf6u\xa38\xf1\xc3\xc0\x88bwE\xdc\x03\x931-C2Dzf\x13՟\x7f\x96#A\x8c\x15\xcb\v3\x11a\xb2\xb1\x92\xa9\x99;\xc5x|\xb5=5{}\x1a\xa6+\xa2?\x89\\\xb5\xa3 \xb5Wg\xc7Y\xac\xb5\xc8\xf3 _\xfc8\x86\x87\xe8\x9fc\xc4@\xb3Ty@ɿG\xc1\xefE\xd9\v0w~F\x8e\xec'\xebp\x174&\xa0\xe9zP?\r\xefP`$i\\[\x81p\x1cG\x06\f\xc2Ԡ\x9e\xddMk\x19\x00\xc7qfM\x15eٹZd\x9d\xcd\x0f\x91\x1b\xa3 \x15\xa7\xceb\x1bx\xe3\x8e}\xc9^\xe8.\xb9\x11>\xfc\xa9\x1f\xae\xb1\xa1S\b\x83Dh\x1b\xfdk$\x84\xdbI\xc9\xf44e%\xd0\xfd\xd3\xf3\x13w\x8e\x91\xf7G\xb9\xfd\x8d\xaa\xd7\xc58\xdc!\xef\xb8D9\x10\b\xcbSl\xec)\x06\x87\xd3\xc8\xe0\xb9(\xe9\xc2\r\x16\b\x8b\xd6)vI\xb5Gf92G\xe6\x8c\xfdM\xc3\xcdeչ\x9bt\x1d\xc6\xfe\x03jSc\xbe\x99k\xc6\xfe\xc6\xfaJv\xe87\xd4[\x9e\xa8\xa33\x00\r\x95\xd8N\x01\x17\x1f9\xfa\xed\xea\xb0\xf41\x86\xf7\x98Ql\x91\x8b\x06F\xfcp:5\x91-\xb4.{x|\x83\x168B\xe76\xeb/\x94\x12\x82\xecG\xf0\xf0 \v)\x16\xc8\xf2\xdbR\x84\x81F\xf5\xd1\x1b6Wr\x8a]\t\x83\x8c4\xfb\xcb)\xaaTh6\x11\xe5\xe7J%\xeb\\\xec:e&\x8ex\xcaPdק\x9e\x0fy\xb2\x1b\x15\x00\x82M\xc0\x91\xf4\x87к+\x1ddELu\"\x16'p\x19\xbfU\xa0\x00\x1d\\\x8ck\x17_\xd4\x0f\x96\x98\xc2zk>\x01I\xe2\x1bA6\xe8\x044+!\x84\x90șC\x0f\xe1&\xa5\xe3\fHq\xa1}\xe4\x06^\xb1\x03\"9\x81ʪ\xackSG7q\xa5C\xaf\x99s68\v'P;X-\xaei\xb0\x8c\x0fM\xe7WYh\xb1\x9f\xb7\xe6\x04L\xfaG%v\x01\x1e\x9b\xf4\x8fj\x06\xc9\xd7\x7f\x9b\xeem\xc9\x11}?غ\xd8\xf7\x80\xf7\xe2d\xed\xde!z\b\xb8g\xcd\xe0\xa7L^\ve[`\xd4[!\xc5m\xcbmr\x87\xf5Ζ\xe5\x11\x19\x1f\xa7\x93\xf7\x93\xe6\xfa\x8e\xdd/\xbaH\xa1\x1d\xa5\xcek\xb2ob\xfc\xe33\xcfM\xddS\x9f\xb8+\xf4\"ٮ~\xda\x13<[\xac\xdd8A\xe9ϛtiLN\xfew\xb2]\xf5\xf6\x04\a\xc9Ͽ?[\xa7it\xfa\xe2\xc5n\xb7\xeb\xef\x06\xfd0^\xbd\xb0MӤ\x94\xbf?\xebe\xb2\xfc\xfc\xfb3k\xf4\xfb\xb3^\xde2\xfa\x9b\xf3\xfb\xb3\x9e\x1b\xfb\xae\x91\xbf\x03\xfd\xfc\xfb\xb34ޠߟ\xfd\xfd\x7f\xd3\x13\t=j7?\xff\xfe\xec\a{\xb0\\.\x7f\x7f\x96\xfdn\xc4\x1b\x8c~\xfe\xfd\x19ڢ \xf4\xbcߟ\xf5\xbc\x9f\x7f\x7fv5\xecY\xf6z\xb8\xb5~\x19n\r\xeb\x818\xc6\xe8\x97\xe1\xd6Z;_F\x0f\xc4\xee\r\xbe\x8c\xb11\xe8e\x7f\xb6\x86\xbdv\xbeL׆\xf3p5\xea;\xbdI\x06\xb4\xfbΗ\xc9\x03e\x93\xfd\xbc5('\xeb\x81L{\xd6\xda\xda\xda\v\xa3o\xda}{b\xf4-\xab\xef\xd8F\x7f\xd0\x1f\x1b}kڷ&F\x7f\x98\x97\x8c\xfb\x83_\x06\v\xa3\xef8=Ӱ\x8c\xfe\xd01,\xc3\xfa2X\x98\xf4Y\xf6k\xcf2\xac5}`Q>\x93\xa9a\xf7l\xc3\xee\xd1\xdfh\xae\xb4ןL{v\xcf^\x0f\x16\x19\x97\x9e\xd5\xeb\x0f\x1dzZ|\xeb\xac\r\xeb\x8b\xf3\xcb`;][\xe6ְ\xa9\xa8\xc3\xf5$\xe7]\xd6eXk\xc3\x12$H\x8eņ\xd5+\xe5\xc8\x18ӟ~q*\x8a\xb2\xf0\xe1\xf7g/\xfe\xfe\xbfi\xd7\xfd\xfd\xd9\xf3\x9ec\xfe\xd0\vB#F\x11rSƚ\xb3\xccav\xaf\x955c\x8f\xa1\x94nHXɬ'\xeb\xa1!U\f\a\x9aɮ\xbb\xb2Z\x0e[\xe6\xd5\x14\x9cK#/\xa3\xa4I׳\x19\x80Х\xa4\xa3z\xfe]\xbcѦȞT\x0e+\x8a\x90\x1b\xd3\b4\xd7fv\xd1\x17\xff\x90\xff\x9d\xf1\\c\xfa\xa7\xf7\xd4c\x956\xf7e\xb8\xff\xf9\xf7gy\x02\xbfg\x9bՈ\xccƚ5\xe8\x0f\xadIoܟ8S\xb7?\x9a:\xf4o\x1e\x9a\xf4\xfa\xd3\xf1\xa4G\xdf7'\xfdѤxf\xf6\xfa\xd3\xd1\x14\x1b\x83\xfeԜ\xf4\x06\xfdɀR\x8d\xe9\xdf\x1ca\xf4\xa7\xe3i\xcf,\x10F\x81\xa8s0(\a\xb0\xaaw\x96ٳ,\x9c\x89d\f\xfa\u0590\xb3\xde\xccr\xf2\x8du\xb9\u05ec\xdb\xf21F\xcd/\xa90\xa3}i\xe0\xb5\xedtCk8\x1c\"ݷ0v9\xc4v\x9e\xfeE,ˮ;\xc5u\x1f\x80\x85\xfd\x93lp\xeaG\x18\xfdq\x10ͥ\xcc\x159̦@\ad\xf4_?\xc72\xab\x9e\xc2:\x88\x9dMfm\x8e\x13J\x18Y\xf4\xdc\xe7_\xed.͐p\xe2n\xf7a\x9a\x03-<=\xfaH0\xdfU\xd9&\xd0\x7f\xd2\xcc\xe6ψ\xb8>\xfe\xe3DR\xba\xf41\x92\x16Fn\x92ХV) An\xbcXK\x8bin\xe9\x0f\xf6\xc2(\xad\x8e\xfaw\x92\xfe(\xa85v\xf8\xb3\x9f\xffF\x822\x85\xf4\x89\x1b#\x17X\xab\xe5/\x7f\xf0\xa6\xf4\x0f\xe0\xc7\x18\x97\xc0\xd86wj^p8\x82VJ\x97Ѩ\x1d\x15\xa8Ғ\nXhK\x05˴\x06\x82J\xed\xfd\x97{\xba\\\xba*\xe3u\x02xq\xb8\xb0\x94\xf8X\f}yإ\x7fʉg2\x99\xccČ\xda_MUr[\xef'\x93\xc9_\xad\x85(\xa6\xcdZj\aXB\xc9\xf4x\xb0\xe8\x01\x05\xf3\x87\x03\xb0b:\xf0~4\xe8U\xf5\xe6\x89\xf9\xfc/\xdb\xfc\xe1d\"\xc5\xd9%l`\xfepB\xd7Y\xc7r\x96\xc3\x12;4\x7f8\x19Iq\x15\xec\xaf\xff\xfc\x7fPf\x95\x9f\x00/\xd9hzY}\x82\xeb\x9aj;\x06\xec\xec\xf6\x852\xad\xc2d\xba\x9a\x0e)\x94-)\xd22b\xbc\x06\x1c\x8f\xab\xc6y\xb6q\x929++\v\xee\x1e\xbb\x8b\xacZ\x93\xab\xb5\x98\xf5\xa8Y#c\x94\xb1\x10\xd3?\x8b5Z\xdc\xcd\xc3}\x15?\x98\xf4\x0f{ݕp\xe1[m\x11\x1f\xe6V\xc4$\xf2m4\x150jq9W\xb6\b\x97}!t\xc1\xdfiV\xdb\xca%\xd3\xd0\x11\"\x11\xa5\xe6\xca\xcbg\x7f4\xac\x976\xd2\xfd\x94_\x8f\xd2\xcfn\x19\xaa\x1b\x02\x9a\xd0?\xfc\xce\x04\xf1v$3\x8b\xf2K\xb8d\xbf\x17s\x8fV\x96\xbb\xac\xaf\xd9fWiT!\xdd$O3û\xb8\x84k\xa0\x8aA1\x8a\xf6\x1d\x1b\xad}\au#\xc7\xd3\xec'\xe4)\xd4\xd9\xe6\xe5\xb4\xca\x11\x99\xf5\x1cфy#\x1d\xf4\xc6=\xcb\xecч:\xef\xb2B\x1eiHo\xb6\xbdhN%\x8d\xfa\x83\xc1\xa0g9\xbdAϲ\xfa\xa3\xd1\x18[\xf4\x89A\xff\x1d\xd2\xf4̻\xfc1\x15eЛв\x86\x84\t\x7f\x15\xbe\x8bÕ\xb1\xd9\x1f\xaaDwy\xc0\x87\xf9\nK\x91\xf00\x8b\xd35\xf2\x8b{+\x86\xbd>վ\x17\x87Q\xc6\x1d>\xe1\x939x\xfdz\xa5U\x91\xd0s1W\x8f$\xe8,N\x18\xaa^\x9e\xe9+\xed\xc0\x14_\x9f\x9f\u05f7\xf4T\xafi\x8e\xc9\x1c\xae\x1a\xe4nU\xba\xb7\xa7y#\x16t\xb1u\xa3\xa2\xcb֗\xe9\xd8\xfa\xfeX';j\xc1m\x88\x9d\xc9\xcf\x174\xb0=z`6\x92z\xb2s6Muw8\xd63\x91O\x82\xf0>H\xa8\xfe\x86\xcb\xc1d7\x8f\x15\x16\x06ߏjG{\xfdj釔\xc2\x04\x19\xd9\xe3'\xcb\xd35,\xe7YZ\xf6P\b'\xee\xaeѼ\x8c\xcb6\x9b\xc2\x13\xf66^`]\xed\xb1\x97t\x0eL\xb3\xf9\xa4CVK\xaf_;\xbbK\x97\xa9O\x14\x98\xb4\xdap\xe1\x9a\xf4\x8f,\x8a\x10(\xff\x9ez\xdcYK\xfdێ2fF\x1a\xaeV\x18\x1d\xb4\xe6~v{i\xa1u\xea\x14z\xfd\x01\"G\xa7\xc5L\xf4\xa3h_sZŶ\xc1\xa9\xe9\xa1\xd5\xf3\xe3s#\x8c\xfd\xec\xb5\xdd\xfc\xa1Wy9\xe1\x83\r\xd2+\x9c\xebM\xe9/B\x8c\xdd(A\x9e\xb8[1\xabUN*\x1e)\xfdW\xad\x1d\xd9\xc3z\\`\x0f\xab\xe9=OJ\x9b\xbdQo\xd2w\xa6\xbdq\x7fh\xf5\xacA\xdf\x1a\xf7,\x1b\x1bþ3\xe9\r\xfb\xce4\xcb\x14O\xf0\xc8\x185\xaeop9\xe1lZP\xed=m\\n͵u\xbb!\x91\x91\x86\xf9\x9d]\xaa\x8fM\xa8\x18\xa8\ue2c2\xa7B\xa3/~\x1f4\xef\xc9\xfc\x18\xba\x8e\x14\x19\xb4\xbc\xd7)\x7f\xb4\xf6\x83\xf4P\xdb\x05\x9c\xfd\x88A\xea\f*\x89M\xc6\xcf\xe5/a\x85\x8b/]\x15\xbd}\xb7\x97\xefp\x83\x1bj\xf5'\x88\xccĝɪ\xaf\x91\xe5\xbe\":\xd4o;\x05U\xd6\xebGq\x18\xa18\xbd\xaf\xce}M\xa7\xe2\xcbP#e?\x8a}\xe2g\xb1x\xc1c4\xa7\x7f@\"\xe6H)\x9b\x10\xa2\t\x98\x02\x96\xc6}\xe0Ҵ\xd1h4k\xda)'\xe7Ы\\\xa5\xf8\xc9!\x8e\xaal\x95\x11\x87\xbb~\x8c\xfe\xcfƏ\x91\xc7\xd17\xee\xbfk\xe0G}\xbd\xe2#Y\xcdԌ\x10\xecQ\xfa>\x7f䯉S?I\xddXr\xd7\x01GG;,Hj\xbd0\x1e\x8f\xb5\xf0T\xdcڎ\xa2v\xedf̤\xbb\xf6{\xfd\x18\x05\x1e\x8a\x91w\xe5\xc6w\xf4\xd0T/bX\xd5?\xaf\x00m\x05\xce9%\xe2\r\xc4\xe25\xf7\xd2t4pZ\x88\xe5^}\xeb\xa7i\xb7\bKR^l{\xa8o\xa9\xb2\xc5e4\x88\x86\xc6\xc4\xfa\xb7\xf77\x04\xde\\+\xd6Æ\x9b:Gf\xbe\xdf\xf0Q\xc7\xca\xea\xe9\x86\xc7|:@\x10\\\xe7\xf2}\x80Hr\xad\xbf\x80l\xf7y\x02\x9e\xbc:\x89]l<j\xa7'h\xa3\x1do~\xc0\xcc\x0e\xc7s\x8e\x16#\xf0\xdc\x1d\xd3b\xa7a\xb8\x98\xbd\xeaș\xd6\u05eb\xda\b\xa4\xe8\x88\xf1\xf3V\xcc\xd8\xe0\xbbvKM+.\xd8\x15\x99\x98\xbd\xb6l\xaam\x81L/\x1e\xefCr\xa4\x9b\xac\x9c\xe2\ue4c9V\x854\x91˽\x13J\xb60ʴlA}\x0f\xbes\xd4G\xb8\xa4\x92\x16\xb6;\x942\xa9\xbdSվ\r)\x0f0\xab\xfb\xdc\xe8\x1f\xbd\xe1(e\x96}r\x87\xff\xdaO\xfeQ\x16\xe8\xad\n\xe2s\x94\xdeعqP\xdb\xf1\x9f\xaf\xd7i:\f\xf8\xc5Zr\xe0\x8a\xba\xbe\xecj\xee2\xae\x9b\xc7\xee\x02\x19Y\xda\xe1\xa0\xda\xe6KC\x11#p\tj\xfc\\\x03\\qN\\\xff\xe6\x86\xe3\xb8\x00\x02\x05\x1b\xd2t\xa6<\x03\xe5G\x1e\x0e\xcc\x14\xc5\xc2\x12\x14\xd3\xf3 \x7f\xef\xbe\x03B\xe7\x1cm\xbd\x92\xe3\xb5\x1ḟ\x97\xf8C\xb4\xb2KP\n^\xbd\xf5\xb0_\x9e\xc7)\xe3\xc4\xc2ʄ\xab!J\x92,t\xea\xa5\xf1\xe1\xf8\x95\xdcF\xa0'\xeb\xbeJ\xf0\xda\xd9\x1d\xa3\xbe?\x949\x04H\x1fh|d\x96\xaf\\r͔\xc4jx\xea|-\x809\xe9\xa4\xd2h\x7f\x11\x92hCG\x19]z+\xbd\xbdx,\n\xc4秇T\x99\x15f\u07b6\x8e\xca\x13}WQIᐚ7\xdd\xf0;\x93\xcak3\x00Sa\x8f\v\x82o\x14\xb5\x15\xf5,\xd3rZ\xfe ܡ\x0e\xeb\x93\tг\xbdHiLm\xa9\xa2\x9e\xb5yS82\x90~ \x83\xfe\xa1'\xcf\xca\x1d1\xbc.s~yn2\xa9e\xe3\x9e0M\xa8a߬\x14\xc5\x0f\xd5\xedp\xda\xef\xe6\x85}\x03z-\xaf\xe4\xd3Ro\x83bkW\xc1\xd5\f\x14\xb8\f\xa9`D[\x923:i*]뾒\xd8\xcfgO\xb1\x11\x8dM\xac\u05cf\x14\xd2\xc9W\xf2\xf9\xa5$\xbb\x7fF~\xd1\xcc\xd1̦\xd3\x1fty\x14\xae\xe8\xe86\a\x8d\xb7\\50bO5Kn\xb2k\xa0\xef\x93\xea%\xf9\x00_\fV\xfb\xecU6\x7f\xb7\xed\x89\x11\xf0\xad0\xf6\xe3G\xe2Ǚ\xb8:\xab\x9cL\xf3-|2\xaa\xfc\xa5Mrˣ\x8c\x88\xb9\x99\xa1\xb8a\xdc\x11\x0f\xf4\x97\xf0g|\xb6\\\xbd\"n\x8c\xa4\xfd\xf4\xe7\x9fUJ\xe5D\x86\xf0\x83\xea\xfc\xb9{\xdf\xc2eI7<\x8c\x1a\xf4Y\x8b\x96+=\xfe\xab\xebD$J\xef\xffܺx\x83\xfe,V-\xa4\xdf4\x1b\xd4\"\x8cқ\xd8\\\xd4\xe1D\xfb\x16uUC\xb4f\xa9\xe3V\x1c\xfa\xe5\x06\xb3\xe3\v\xc6X\xb86\x12ׯ\xacQ_\xe5&8#\x89\xeb\x91\xe15\xc6;pS\x19\xe3\xffat\xcdb\xff\xd5f\x99\x86\xd1܍\x81M{֜\xfei\x9a\x9f\n\xda\xf2\xbf\xf0ݗ\x05\xc6U]>\xf3\x17LU\xdf{\xa5\xfbšl=@\xdc7\xbdg\xf6\b\xf0+}\xe2{x)C\xee\xf0\x80UR\xf1\xbd\xacT\bt\xd9-\xf0\x1d\xb4\x81p\x9c\xab\xbc\xddH\x9f\xad\xb8y\x19\xd8\x06g\xbb\xe6`9\x93\x9ei=\xa6\x04\x99-x\xb2\t\xb4Q\x9c~\xf1\xc1?\xd5G\xb2\x96&\xfd\xc3}\xa6\xab\x14\xa3\xb6\xc1\xc3l\x9a\xca\xf5%\xa9\xa7\x1a\xf9\xf9T\xbcuYq\xcbf\x9bj\xeb\x11\x05\xd8'\xd5ޗ\xea թ\xfd\x14\xfd\xc0<\x15\xcf\xf5\xb1F\x01m\xf3\xac\x9dYn\xf7\xa1\xadQC\xb48,v\xf7\xb0-\xf1\x83eX\x8e0\ap4\xb4\xbc\xb8_\x9a\x0e\x95\xa5\xbfb\xbe\xbe[\xbb\xf9\xa4\x98[\xb8\xcf\rO&\x1aw\x9e\xd1Jzk\xaa\x9e\xa8O\\?8p\x97\x975\xa3{.\xff\xc1(\x11\x1eŨ\xf1\xfbG\x19\b\xfb'\x00%\xf0,\x7f\x99|\xf45\x9eyC,\xa0\x82\xb5\r=\x1c@\x0f\x87\xd0C\xa7\xfd\x1d09\xa5{`\xef\x04\xd0j\xd5L\xf5\xe9ߜ5\xfb\xbdkk9\x9a.\x00cˮ:i\xda1\x951\xeb\xcf\xdd\x04eɌǿ\xf1j쎅$`\xf2\xa9\xbaF0\x18E{5\xd7\xe2\xfb\xc8\xdc9\xe6\xe1\xd4\xe6\xfc\x843\x96\xa5\x9f\xe1-/yB\xa1~\x7f\xb5\x9d\xfb\x19Yd\xef\x88\xf9\xadd\x13\xa1X!yu\x0fM\x92\xba$\x02\"\x9c\xc9t\xbe4\x87\n.٠U\xbb?սK\xee&]\x1b\xf34\x80\xa3\x01\xc5m\x8eP\xec\xc53\xcc\xef\xa2\xf0\xc2@u\xd1WF(\x8fI,͘\xa4ΦW\xbbτI\xc0\x1f{ݨ\x9d\xdbe3\x8d6x\x89E}\tL\xb1ݏ[\xa7\x13\x975\x9b\xb8\xb3\x8bGՕ\xf2\xf25\xd2&ft\x01\xb7~\x82*\xcbV\xcbv\xa3q\xa4Շ\xf4\xb5,\xe9H\xa7<R\xd6@P\x1cv\xe3NU6J\x89\xe28\x8c\x13\xe8\xa0\x11\x92\x1f\x9d\xa0\xaf\xb7Oq`\xa4\xd8B\xcae\xcdt\x04\xeeͅQ\xc1\xdfڸp\xa3\xec}\xe9\x81O\x9b/\xc2\b%\xbd\xb5\xfd\xf8\xa9\xb6b%\xccp\xd07%\x84\v\x93j\x83\x8a\x7f{\xd9\x04\xc5-\xc0@\x85\x86\x87\x96ͻ\x1c\n%U^\x018\xfa\xb6p1\xfa\x1cq\x97H֟\xea|\xf7\xac\x1e\xff\x16+k\xd2El[c(\xb3b\x17\xbf\x1b\xfc@\x02W\xd0@Ҥy\xb33h\xa8Æ\x1d\xb5ZU\xe6\xb3l\xc3\n\x9a\x84\xaeZr\xe0\xafM\x16\xcd@\xc1\xa1\x9f\xaecz'\x1a\xf7\xc5P-\x16\xd9\x7f\xb3*\x0f\x8aK\xf6$&\xca1\xce#\xeav\xc9\x02\x90E\xf7/\x16\xdb\xf5X\x05<\xa9\x98\x9b\xfd\xa1~\x93\xe3L8D3y\xfeW\x1a\x1e\x8e\x17\x8e\xf2\x00\x8b;J\xf8dL\x19\xdd\xdcd\x87\xca\xe2~\x19?\x1d\xd3h\xf2SSǤ։\xe4y,\\\xb2\xc9\xef\x11S\x95\xc7\xd5N\r\xab\xb8\f\xbe\xf3\xfd\xe8mĊ\x91p\xebRa$\xa6i\n\x89\xf5\xe3\x8a\x1at5\xb9D\n\xbaN\xa9\x10\x83\xbb\bQcg\x0f\xf3ѶnN\xa9~\\PH\xbd\xd6u\xf8w\x8d\x16\x940n\x83\xc0\xff\xf7\xff\xbd\xf8\x8f\xbf\xf5\x92p\x13/Е\x1bE~\xb0\xfa|\xf3\xee\xe7#\xab\xfe\"I\xfač\xfe\xe3\xc5\xff?\x00\x86ł\xabF6\x02\x00",
+	"swagger-ui-bundle.js": "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xd4\xfdks$\xc9u \x88~\xbf\xbf\"\x11leG\x10\x8eD>\x00$2\xd1\xd1P\xe2\xa5.\xaa\xbb\xab\xa6P\xc5\x16\t\x80(\xcf\b\xcf\xcc(DFdyx \x91\x05\xcf5\x91\x14%\xea5\xb3\xb33\x1a\xcdH\x9a\x1di\xa7ER\x1c\x91j\xeaɇD\x99\xed\xfd\xbeּfc\xd3=\xf7K\xedmvS\xbc;\xd7\xec\xfe\x84\xb5s\xdc\xe3\x95\x0f\x14\xaa\x9b\xd2\xec\xb6u\x05\xd2\xdf\xc7ݏ\x1f?\xe7\xf8\xf1\xe3\xeb\x9f^)\x1d\x85\xbc\xe4{\x0e\v\"V\xf2\x82^ȇTxaP\x1a\xf9\x8cF\xac\x141V\x8aƴ\xdfg|-\xf6ֺq\xe0\xfa\xac\xf28\xaa\xbc~g\xff\xf0\xcd\xe3Ê\xb8\x12\xa5O\xaf\xff?Vzq\xe0@A\x93\x11a]\x1ba\xf71s\x84a\xdbb2ba\xafĮF!\x17Q\xb9<\x972\f\xdd\xd8g\xbb\xeaOE糅i\xb5\x8d\xa4\xce,\xb3\xcbz^\xc0\xcae\xf5\xb7B\x87\xee\xae\xfai\x9e\x9c\x11a\xb5\x97\xb5\xbb\xab\xffV\x8eU_\x1e\xde\xd9Þ`;lQ\xec\xd4\x14\x03/\"f\xda/\xeb\x9a3\x11\xf3\xc04-\xfb\xd5\xebK\xcaK̾\xae5[[\xcd6\xf6\xd9~\xf5ڈa\xc4\x04\xf7\x1ca\xec\x88ʛ\xf6e蹥\xea\x0ed\xe6\xf6\xfa\x17̓/\x9c\x8e\xcf>m\x99\x8f\xe9%\x8d\x1c\ue344t\xa9\xa0\xf2\xb2\xabBֺ7$\x81\xbd^\xfe\x94y:^\xb5\xcc/\x9c\x8e厵\xbb\xde'\xa1\xbd~r\x1aW\xab\xd5\xea\x1a\xfc\xa9\x1d\xc1\xb7y\x84\x81\xd6\xd1i\\W)\xf5j\xf5\xe04>:<::[\xef{CBU\xab\xed\xb3U\xab\xbd\xde\x1f\x12\xcf>1*\x061֍3\x040\x9b5\v\xbb$Hd\x9b\xc2fR\x1a\x06\x11\x15\xceF>u\x98\x19\xe4\xc6\x01\xe7W\rE\xe9Xp/\xe8Wz<\x1c\xee\x0f(\xdf\x0f]f\nkjYVZ4$\x86aU\x04\xf7\x86\xa6\xb5\xe3\xf5̕\xc8R\x85\r\xda\rc\xd1\xee\xfa4\xb80 %\x0f\x8b\xae߫x\x81ˮ\xee\xf6LvR=\xb3^]\xabM\xcd\xc8\xd2\x15\x94\"\x1cXߎ*C*\x9c\x81IU\x03~1=\xb6\xfd\x93\xeaَ\x8e\xe3\x15\xc1\"a\xc6\xd6n\xa1\xfdv4\x9d\x92\xcdF\xb3\xb5\x89\x93I\xf8\xdct\xf2\x8ak\nr\xfd\xf96L\xff\xbd\xa9\x85u\a67\xeb\x8dZ\xb5f\x91\xd0\xe6\x95\xc0\f,Bmnn\xd5j\xf5M\x8bx\x18G-\x12\xd9ܬն\xb7\xeb\x16\xf11.\xb2Hls\xb3\xd5ܪnY\xc4\xc1\xb8\xd8\"#(۬\xb76,ҳ\xb9\xb9\xd1h\xb4\x1a\xd6N20\xa5Anh\x06\xf6\x82\xe5q<\x19vC\xbf\\6\"\xfc1\x9bP\xf1\x04\xe3T\x84|w\xc1X\xeb\x9cl\xda^\x90\xc8\xca\xe5\x1b\x9ac\x15'\f\"\xc1cG\x84ܶ\xed4~%\xf9]\x19\xf1P\x84Pl7\x81\xad\x9d6H\xa0_Ӵ\x97\xaeB1\x98J\x13hR$h\xe0@NaYb\xc0\xc3q)`\xe3҃Ɉ\x1dr\x1er\xd3اA\x10\x8a\x92C}\xbfDK\x8eO\xa3\xa8D\xa3\x12-\xa5\x10\xe7j\x1f\xaa\xda{!7ղ\xac\xee\xf0WD\xc5gA_\fv\xf8\xea\xaaZ\b\x81-N\xf8\xd9NPaA<d\x9cv}f\xe7\x03R\xae\xd4H\x00\xfd\xeey\xfdX\xa5\xafT\x89qI\xfd\x98\x19^P\n\xcae3\xa8\x8c\xb9't\x9aE\xee\"m\xaa(\x82u\x8f\x87#\xc6\xc5\xc4d$\xa8\\\xb0\t\t\xaci\x06e_ca:9%/(\xb1\xdde5\br\x8d\r\xb79\xc9@l\xafTI\x1e>\b'\xf0\xb4W\xaaS\xab\xcdNę\xcd\t\xcbڽT\xa3\xa3FF\xb7v\xc1&\x91\xc9pm\xe9\x98>\x13w\xc7AҾ\x9a\xe1(\x19\xb7\x9b\xf2@5\x02\x06\xc6\x0e*=\xcf\x17\x8c\x9bٲ\xcf\xc8\xca\xc2*\x0e\x98\xa2\x91!G\x18ss\x01\xf4\x86\xf0\xca(\x8e\x06\x15:\x1a\xf9\x13\x93\xc3h&+>\xeb\xdd\xc4d\xd9\xcc\v\xbb\xb6#^\xa1\xbc\x1f\x0fY \xa2\x04\x03D\x82\x01\xdc\x0eb\xdf_\xb1\xd3\x1c'\xe2l7\x1fh_Ow\xc4\xcf\xd5w/MNV\xaaV\xa5\x17\xf2C\xea\f\x8a=\xd2\x13y\"\u0380,\xb6\x9fӳh\xe1\f{,2\x19y^Q\x93[V\xfb\xd2\xe4K\x00Y\x8e:\xcf\x1bmN\x84\x05\xc0'\x03\x9aC\x97n\x8eDt\x93\x99\x8f\x98\xb8\x97\xac\xf6\xbb\xbdݬ\xfa\\\xecB\x1aS9?G2q~.\xe5\xc2R@&H\xb7@,ƅ\xfdh\xbc\x18\x06)\x17\xee^\xb9\x06mAؔ\xa8ʲ\xca\x0f\xf3\xd9W\x84\x94\t{\xb1\x92P@\x91\xa7\x8bYl\x81\xbez=S\xf1\x01\xb6m\xb3\x1c\x05\xbb\xcfz\x8c\xb3\xc0I\xc8\x18\xf0\x1b\xa5\x01\x8d\x82\x97E\xa9\xcbXP\xf2\x02Ox\xd4\xf7\"\xe6\x96\xd6JQ<bܴ\n9\x80\xe41װvҩ1\x99\xd5\x16S\xc0\xdf+\xfbz\x9am\x1d\xe73\x04e\xc1\xf8\x03\xba\xdb6V\xb1\xcb\xdbK2\xac\xd86\xcb3q6\xd0\ue15b\x03\x83\xc9[\x922\xa0\x116\x83\xbfL\x01?\xfaL\x98\xc2j\xf3\xf6\x80F9<\xac@\x17q\x1av\x81Z\xb5s\xab\xf98\xe9R\xb2\xa2\x03\xbb\xba\x13\xac\xd8vJ\xcd-\xafg\x9a\xccƾ\x9f\x04\xab\xabg\xe4ʲl۾J8\x04\xbe3\x8fӝ\x94\x17\xb2g\x89ë\xb5rYM劝\xa3\v\xb5<]\xa8\x01] |\xbel}a\xd9z\xbel\x1d\xcb\x06\xf6\x85\t\xfd\"!\xf0ay\"<1\xa1jr=\x85\x15\x99\x80\x1eV\xd8%\xe3\x133\xc8!\xeeE\x01\xcfө䈌F\x84\\[6\x1f\\\x0f\x87Y%\xbd\x8a\x17Y&l}\x84\x9d\xf03$\xfa\x1d\xce\xe9\xa4\xe2E\xf8\x17\xa8\xccL\xeec\x05-L\a\xb7\xac\x9d\x85[\xf4\x9d\xe0\x92\xfa\x9e[\xba`\x936\xf0\xe4\xcc\x11\xcc-a\x8d\xa5\x90\x97\x14D풱ʭ)b\xef~\x815M;&R6\xbc\xe4*\x06]X\xe4P\xfd\xea\x9a\xc2\xd2\xf4\x1f\xc3\xe9\xb8Z\x16V\xc9I@\u009d9\xfc\u05fcƢe\\.k\x94\x17\x8b\x19\x8fcX\x92\xd0\x1d\u03a2\b\x99\x8b8\x12%\xe6\x89\x01\xe3\xa5.\xc3\x05\x03\xdd\xcbs\";,\xe3\x85\x12j\xe5pF\x053E\xb9,\xb2Dr\x9dc\xaa\xdaz\x7fg\xf9\x1d|vw\x9fN-\"\xcaeM\xc5LA\x98E\xb8-H`\x9f\\\xc3\xc0\x1b\xd1 \x8c}w?\x1c\x8e\u0080\x05\xe2\xe1ȥ\x82\x19DU='\n||\xf4׃\xbc\xd2\xc1\x89\xa8\xc4\xd8\xce]\\\xd3\x11\xc1\xa8\x11\xfed\xc4(\xa4\x19\x96\x94\xb3\x85\x8e\x05\x15L\x97\x8a\xe07\x11\xc4(&\x1a\xd6tzF\x82ryh\xf2\xdc\xf8\x05\x16\t1\x8e\x84\x16\x11SsTI{n\xed\xe0ؖ\xee\xda\xfb\xc8\xc7?F>\xbeըZ\xe4\x0e\xf2\xe2\x8f-\xf2&0\xe0\x9b[\xad\xa6E\x1e`ܛ֎\xe2-\xef\x95ؕ`\x81\x1b\x95\xee\xe6\xe7ȴ\xae\x15\x8d\xaeT*\x19\xee\x11ϴ\x14>\x1a}&\xde\b]\xe6\xbfI\x87\xcc &\xb3_]\xab\xadضoZ&\xb3\x12Bg|j\x1d\xf7h\x0f\xe6\"Z7\x806&\xb2\xd4\xfa\x17*\x9f\xfe\xd4i>\xfdt}\x1d\xe4\xab\xf6⊜\xa4\xbf\xd1z\xe4\fؐ.\xae/\xcbv\x9a\xe4K\xeaU\xd3m\xcd\xf4\xe2>\xeb\x1dc>Ջk\x9f\x89\xebhĜc\xe63\x18\x89\xa8-\xa6v6\xcfɚ\x13\x95\x9e\x17\xb8\a)\xf4&\xd3lE\xe0\xc2Ά\xd5\xf4\x99Hg\xa9\xcd\b\x06\x01ɣ\xb6 \xc5&\x02\xa2`mS\xc2ٓ\xd8\xe3\xccm{$\xa0C֎\x88\x17\xddg\xbd\xb6\x8fE\xeeQ1h\xc7\xc4\xf5\xa2\x91O'0\xf8m\x87x\x81\xe3\xc7.\xbbϨ{7\xf0'\xed^\x12\xf3\x16\xf7\x00\xb3\xfcI{P\xe8\x83B\x18\xd7f\xa6\xa1V-N\xa5a\x91!D!%Kb\xfa\x10s\x8f{COx\x97L\xc7\xee\xf8L\x94.\xedd\xe7$\x13\x9b\x96\xcb\x14\xb7=㥗8\xeb\x19Z<.\x97'\xe5\xb2\x19\xa9\xb6\xf3(cN,\x8b\xacP\x95N\xd3\xf4t2@2&+4\xd9\xdbF\x9a\xac\x1c\xfa\f0\xd14\xa2\x11\r\fr\x8d8\x8c\xa3`\f\xa1\xea\x12~ׄ'|fL\xc9m\x8b\xa9\x02\xe7\xe7\x82]\tcJ\x1c)#k\xbe\xb07\xec\x1b\xe4:\xe2N\x9b\x9b\xf5\xcdZ\xd3\"\x03\xe6\xf5\a\xa2mԫ\xa3+\x83\x8c=W\ft`j%˲k\a\x15/\xba\xdb9n\x98V:H.\x1bq\xe6P\x81\xfcN4\xf6@\xe2\xf7\xed\x94\x1e\xf9\xbb~{eeB.s\xe3\nd\x00h\xca%\xb9\xb4\xae\x1d\x1a\xb1d\xf4\xdbK\xc6\xc8%\xa1i\x99\xf9\xbe\xea\x02\xd3<ٺ\xceaU\x11A\x13\x84\xd4X\x98\x81\xdc\xee\xa6(y+̃\xa1\x00x)\xe0\xd5Rp\x87s\xe0\xaa\xfcEho\x0fbn\x1d\xdd\x16J\x97\xf5h\xec\x8be\x10\xf6\x15\x84\v\x00Z\xb6\xc6o\a\x1fЍ\xe9\x14\x88\xd2=b@\xb5\xb0+G\x80j\xaa\xbccZ\xe6\x1dӲ*0\xea\xaa\x10)4\xfb\xc0\xb4*\xb0\xe3\xcdeP\x80,J.\x92\x1fȡ\x90#\x9f\a\xa1\x86$\xc5\xd1\x14\x88N.Z\xe1\x02Dt\xc3\xd0\xcf\xfa\x95ư\xab\x11\x05R9\x12\x03\x8c\f\xe2a\x97q\xe2\xceF\xa4\x98xǴ*\xbe\x17\x15\x80\x99\x9dô\xf6\xb9\xa9LR\xa6֔ln\xd5\x1b\xb7R\x82\xf5rJ0\xad\xf0*(\xc1\xea\xdb\xf5z}F\t\xa6\x95[\xbe\xcd\xcd\xed\x8dͭ\rT\x83U\x02\xd3G%\x98٪\xd67\xeaJ\rVonV7\x92\x1d\xb7\x97\xee\xb8Q\xb6\x89\x17\xf6^d\xe3\xd4\xf6\v?\x11\xed\xd2\xfd*\xdbr\x1er\xdf J\x83;\xbfi\xb1E\x9bV\xc0\xc6flZ\x96\xc9*1\xf7M\xa0q\x9f\xaf\xf8\xa1\x83Zr\xab\"B\xa5\x035a+\xdbIw0\x85E|j3\xd4\xccx.\x15!\x7f\xc8\xfdv0\xb5\xb9i\xedd\xec\x8c}\x1ds\xbf\x9d\x10\xf3\x02\xa4\xa6E\neS)2\xd85\x06B\x8c\xa2\xf6\xfaz\x9a\xa1\xa2\xb5\xf4\x15/\xcc\"\x8dv0\x9d>|\xf3\xb8stx\x9e\xee\xf2oy\xbe\x7f\x9f9̻D=\x00(e\xaeg\x00\x17\xf3\x80\xf3\xa9-R\xc0\x99@\xce\xcb\xfc\x18\xb0\xf3\xdb\xc3Χ\x8b\x98\x03\x05aa\xae\x14EF\xa8M`y\xcd*q*o\x0e-3\x1b\xe6J\x1e\x9aDv\x9a;-\x10\xe522:\x96\xe6xw\x81\x83o\xe7j\x89\xb9_.c\xf5\x83\xb7\x96W\xbf8K\xcc}k7z\xfe\xf6\xda\xf3C*\xd68l\x93Ɣ\xcc\x15\xa0\x06\xb9\x16\x94\xf7\x99h\x1b\xe7J{M8\xf3\xdbF\x10\x86#\x160^\nB\xcez\x8cs\xc6\r2\xe0\xac\xd7~\xf4\xd25\x9f\xae\xbb\xac\x1b\xf7wc\xee\xdb/]\xb3\xc0\t]\xf6\xf0\xfe\x9dt5\xcdB:}4\xdf\xf6@m\xe5X\xdd\vTD\xa8/\xda\xc6\xdd\xc0\xf7\x02VJ\a\xaaԥn\x9f\xc1\xaeo\xb5a\x98\xa7S\xb5\xd6\a\xcf_\xeb\xe9J\xb7H~!\xf9!u\x99\xdb^\xa9\x11\xc6y\xc8\xdb+\xb5\xe94\xc5\xfa\x03\xcf}#\x8c\x03\x10 \x15\x93\xc1l\x90\xe6\xee\fi\x9f\xed\xb0J\x18@i\x1b)\xc3\f\x8e'\xd5V\x81:BN\xac}aVݮ\xce\x19q'\x87\xa4\x10\xbc\xd5Z\xf4z&\x96]\xb1gJ'\x90\x8b\x1c\xe4\xe2\u0590\x8b[C.\x10r\x84a\x9a-\xc0\x84\x89\xcff\x17\xcb\xecF\x8by>\x9cs\x94\x93\x8d\xa9\x95_B\n\xa8e\xc5\x00\xbf\x8a\xbdF\xec\xc9EQ_L\x13\x8c\x99\x92\xed\xadj\xadu\xab\xedj\xc8H\x84?\xfa,\xbfq\xa9\r)\x84\r\xa9ժ7s\xa7-4\xd5\r(\rH*Y&\x87RV\xaaG\xd4\x11\xa6\xb0vr\a\v$\xb0Yz\xb0\xf0J\x80\x87\v0\xb9'\xfc\xcc\x06\x9d\u008c\x12j\xad\x96)p\xbc\xd9S\x8a\xa4\xa2\xb5\xda\x0e\x7f\x15\xce,\xd6֬\x15\xa0\xa7\x02\xeb\xb3\xe0\x10&\x1a\xc1A\xae\xc9I-\xa7\nB\x94ʴ\x17Zs\xf10\x18P8\xe9tK\xc0f\x96z!/)-@\xe9ec\x95\xad\x1a/\x1bJg\xe2۹\xf3ϴN\x86:\x82T\xa7Y.\x9b̾\x9e\xea\xc5(h\x1f\xe8\x98m\x18*L\x85\xe0\x91\x9d\xb0\x80^\x100\xfeڃ7^O\xd3\xc7\x03O\xb0hD\x1dv\x9f\xf5ٕ\xbd~\x1a\xad\xae\x17\xabb\xc9/u,\x99\xd5\xca\xd4_)\xe7\xabg\xfa\xb7\x18\xfaR\xb2,\x01\xaaH5ٹ9\x8d\x98x\xa0\x9b[t8V\x00\aۚ\x92|\xf1\xfe\x82\xe2\vKc\xfbd\xa6\xe5\x8e\x10\xdc^\xa8\xa3N6W\xc8\x11\x99\xd6\t;\xb3\xc5\xe2\xe6g긡\x86\x85\xcdG\x8b\xcaj\xfd\x14\x8d\"\xaf\x1f\x9835\x11M~\x17B\x12-\x1b\x06=af\x017\xac9\x90\xf6a/X\xda\x1d\r\xb3i\xe0\x96a\x106S\x01u\xdd\xf9\n҃\x1fP\x8a\xe9\xae`.\xd3\"\x81\xbd\b\x17\x81,\xecr\\W\xc2\f\xac\xf6\xc9\x19\xf1l\x96\x86w\x84\xedU\xa2\x81\xd7\x03\xc6\xc8Z\xabٶM͐\b\xab\\\x0e\xf14\n\xe8\xc1\xe2Y\xa8 \xe0vXy\x1cz\x81i\x94\x8cEC\xc9\xd90\xbcd\xff\x14\x1d\t5u)\x97\xcd\\\x9f\xac\x1d\xa5\t\x8ct\xb7vP\xcb\x14A\xef4\xa9\x89\x80\xd4|\x82\x1e\xf6\xe7&ں\xbe\xa9\xba\xf9\xc53\xa0\xd12TAh\xa1\xe5ՙAZ\x05hR\x9a\r\x19\x18F\xcd!\u1754\x9a,C\xc4\x1c\xbdYн\xb4\n1\xf4o\xc2\xe5\xb4\x1ds\x16\x91\xfb\v\x81X\x02\xc3\xfc\xe8\xf4\x17\x0207\xc6Y\xfb3͋\xb0\x138\x83\x90+\x19'_\x83\xb2\x7fI*Фϴ\x88Pq\xdd\xd8\xf3]\x9c\xbac\x01\xf2\x8ej\xf0\xc4x\xc5 \f\xf2\xecⴴ\r\x83\x18\xaf\x1a\xa4\b\x87\x18\x82\xf4`\xbc\xb2\x0ey\x8dW\x8d3\x8dB\xb3\x13Th#\x0f\x1b\xa8\xd12\U0009261b\x18;E\x98\x13*&쓳l\xd7\xc6sv\x8bU\x8a\xa7P&\xb7P7\x0fk\x9a\xaf\xbel\x1b/\xaf®\xbb\xfa\xb2\xf1r\xb6\xc23L\x9f\x1265-m}\xf2\xc2\x1bh\xc0\xc6oy\x81\x1b\x8e핚\x8a\x11<\x0e\x1cdq\xf56\x97J\v\xe9.\x9a\x15b\xd9o4R(\xd6\xc0ҟR\xce\xd7Ʋ\xdf\xcb\xf6I\x1c\xf5E\xc8\f܅o^\xebm\xae\rr\n\x8e\xbf\xe2\xdd\x14\xb3\xa7ƜY$ݛS\xce\xceaQ\xa4\x90\xed\x01\xbb\x12\xa6\xdaH\xb20\x9c@\x17\xa7?W\xe3\xbc\x19\x93}\x8d\x82O\xae\x96\xd78\xeb\x01zk\x92\xa9J\xefG\x8az\x80]\x82\xee\x06\a\x1a\xa8\x89\x17\x9f\x9d\x11LS\u0097\x9d\n_\xa2\u0099o/\x14\xbf\xac\xe2\xe8\x97˅`Jt\x17ž27\x029\x1a]A\xe5\xab=\xd7=8\xe7X0HI7\x17\x8cS\x11\x01@\x03]`;O\xc4\x19r\xb1@<u%\xc7q\xaf\xe7]1X:\xa1]%\xd4\x0e\x12\x1e7|\x85\ue12b\xab\x16כߪ\xb1f\xac\x06'\xe1Y6\xb8\xb95\x92,\xcb\"\xc0s\x98\xb0\b\xd5\xf4\"v\xc3\az\xcc\xe6\xe8f\x96\xb4\xac\xcf\xc9p\xa3\xd2]H\xb9\x92\x9c\x1a\xa7\\\xbd6\xdbK\xe2akM\x15?\x89\x02!\x1aR\x8e\xc7\xe0\xc1n\x9e}\x83Si(\r\a\x8e\xeaX\x9d\xef\x9a\xdc6\xca\x03\xe6\xfb\xdeh\xc7 \xa1\xdd \x81\xbdm\xb5MЙ\xa5-$?\x15\xf1\xa0\v\x807\x8c\x9dtU\xa2\xb2\x93\x01\xeb?\b#\xb0\xab\x11\xabv\x12\xbbj\xb4\xd7\xd7\r\x8b\x14\xd3\xe07č\xa8B\xa4U\xdbX7VU\x18\xe2\x9fČOt®\xb1\xaa# \xa5\xc7i\x1f\x045\x9d\xf8)c5\x8bC\xb4\xf3f\x18XmE\xb5^'\xa1\xfd\x06\x15\x83\x8a\xc3<_\xe9\x06\xd7j\x9fƘ\x9e\x1f\x86\x1c\xa2\xbc\\\xb7\xe8+\xd5r\xd9D\xf6$\xeeF\x82\x9bԲH\x1a\xa8\x92\xd0Z\xe5\xab\xdet\a\xc5d\xbdVlQ\x9c\xb5\xc8\x16k!\xf1\x17\xd1\x05\xa0\x00\fGZ\x19\r\x82}$]{\n\x06\x92\xa7\xeb\xa7\xeb\xeb^\x8a\xac\x01\xae45\x9avpR;#\xdc\xe6\t\x1c\xc1I\xf5,\x99+\x8b\x98\x85\xfa*\x9f\u07b5\xcc]\xdb<ݕ\x9f\x92\xa7\xeb\xf2%\xcbZ\xf7,\v\xab\x83\tx\x91\xcaN\u05cb\xd5\xe5\xeb\x82I{\xa1\xbavӺ\x8a\x15\xe1$\xbfHM\x9f\u008a^J+H\x10\x01\xeb\xc0\xc3Ve/\xe6k\xfc\xb9ֆ\x98*\xb8p\xa4N?eYi\xad;q\xb9\x9c\x14NK\xa5\x18\x10\x9f\xd4R\xc0\b\xb3\xa9\xe9[\xd6t\x19: \x14z\t\xa8\x1f\xb6\xfa\x93;\x05\x1d\x8fǧ\x15<\xf0L\xaa#KQ\xcb\x01T\xcdW\xea\xac\xea\n-\xe2$\a\xe5v\xc2u\x94t[*\u07b6Ů.\x98\xf5F\xac\x01F[YD\xb4\x1aXm\xcftH4\x13\x89\xed\x8f\xd2\xf6\xf5\x1a\x1e\xa95\xec\xeb5\xec\xa7kx\xa4\xd6p2\ad\x84\x9c\x91鬎\xac98\xf3\xb1\b%\x863\x18\xad\xb6\xe9\xacz\xe6\x88DkN\x8a\x133\xd09\xab\xf6h\x8a\x90\xa5t\x01罇\xd4\"\x8b\xddQP\xf4\x16Bћ\x81\xa2\xb7\x00\x8a\xdes\xa0\xe8)(\x12\xea\xa8g\aa\x19\xd8~\x81>jX\x06I\xab\xaf\xa4\xa0\fV\x9dB\xd3P\xa73\x8f\x14\x0eN\x8a\x9b\xa3_ѫ@\xbf\\\xdbIJ\x17\xa9]\xb4^\asE\xd3\xc9*Ϩ#&\xae\xf2Uw\xa6WS\xb4\xadi\x1bC\xcfu}\xb6x\xbf\xb9\x89\x1e.߇\x02{\x1b\xf6\"\xabmf\x9b\x0f\t\xe7\xf6! \xa7\x05\"\xfd\xea\f\x91.v\x92\xeaN\xb2E\x9d\xa4\xba\x93^\xae\x934\xeb\xe4l\xbf\x16\x18\xec\xa4\xdbk\xb6\x15jTB\xe3\xd2%\x1dmX\xed\\\x1fY\n;\x9c\xde\xc02\f`\x19\x02\xe7\xaeZP\xe0(V\x9e8\xcb\xd9x\xe4&\xce\xcf\x1fGn\xec\\\x1c\xc4\xc3\xe1\xe4 t@p@\xfbQ\xc5\x00\"\xc1c.\xf23\t\xc7\x1e\xf6z\x11\x13v5Uu\xed\x01g\u0378\xcdr\x81\xf9\xe2,\x1f*\xd4\xc4\xf4\x8fE\\;\xd8\x11\xe4*Y\"\n\xe6j\x9e\x13d\xef\xaaF\xf2;j\xa1\xf19\xc1s\xb6@\xb1)\r\xeal\xa1Y&s\xbe\xf8I*sNF̴\xce\x16Ȅ\x0fh\xff\x06%\x9c\x1eY\x95\x15\xd5P\xc9$\x8f\x16\xab\xe0FˌJ\xafS\xf3\xd1\xf6\xc9\xd94g\xa8\x8e\xfa\xe2r\xb9X[\xc1\xdat:k\x92Z\x10@\x85%\x16\t\xa0Js\f\xc6y֔\x8c\xb0\\\xce³\xa7+J\u00a0\xb3W\xdc}\xce6\x9fMU\xb9\xfc\xa0\xd9j\xc1\xd8bw\xc6\x1c\xcdj\xe7\xed\xa9\xec\xbcm\x1a\b{\\\xa9\x88\a\v\xc6z`\x174\x87\xf9\xce\x16\xd5W5\x12̙\x99\xa5\xba\xf2$c\x88c\x92\xe5\x83\x01\xd0\xf5\x17\x14Asf\xa3\x82\x84j\xd8B\x18\xb6\x9c\x1c\x02w\x0e\x16\x9b\nN\x15='\xc3e\xf6\x87\xa9u<\xd3m(#D)\xe1G&\xe7\xb0!\xf5|X\xeb\xc9O\xa1\xfe\x12\x9e,Ϟ2\xd0\x133Jd\x18\xe9\xb9\x015\xb0\xac1\x9d\xcd}\x88U/\xc1u,3W$\x13\x17\x174\x03%D\xd86V\x9f[\xc1Md$)75\x1d0\x86\xfad\x03\x191~\xe99,Qvp\x98聠}\x15ȧ\x8a|(\x97Q$\xbf>\xe6\xd8\xeb\xd2\xf3\xa3\x7f\x9ck|\xc9P\xe4 \x9a+\xfe\x9a\x86oIQ\xdd\xec-'0\xa7\xdbʏ\x82\xb0\xf3u%fSL[C\x89\xb1'\x04\xe3\x89}Qz0\xaf\xe3+N8\\\xd7E\u05cdU\xa1L\x92z\xd4a\xdd0\xbc\x98+5\x1e\x8f+I\xe2\xe2\xa2H\"\xfb\x9c\x0e\xe7ʦ)X\x90]\x8d\xfc\x90\xb3uA\xfb\x11\x96N\xac\x8c\x16\x9cc]\x04\xe18(\xe9>\x97\xc0\xfe\xa6$\xc2\xd2(\xf4\x02Q\xd2\x00\x94D\b\xa6\xc5̚\xbe\x102\x03\xd7:3\x13\x88ї?K\x8cN\xa6\x80\xf0\n\x10 /\fl#\x1f\x10ɯ\x9b\x91\xfdc!\xb6\xaey\x1e\xb1\xdfЍ/c\x13T\xf2\xcfz=,Fl\x8d\xb2\xb3\xa5_\x00\x83\x8d\xd5<\xd4/\x86\x89\x8b\xcaFa\x1c\xb8\x8e\x1f\xc6\xee\\\xe1,iQ鏉ź\xb8\xc6⹁xA\xa4\xfe\xf9\"Ps\x85o\xe2\xc0\x94\n\xe59,[\x86\xfbV\xee|*\x87\x1bf^ך(\xd5Q\x85\xa4\xd6\xd7\xe4\x13\xae/e\x1a\xa7\x96\xd1ȏ\xa3c\xaf\x1f\x80*=M\x11\xfaG>]\xa4??\xe6b\x1a\r\u0080\xcd/\xa5{\x10\xfd\xa6jwɚP\xc0̕\xbc\xb9P\xb1j\xd3z\xb1}^\x80\xbdЪZTI\xbfw\x8dU\xa3m\x18\xd6\xeaM@=\x7f\xf3/\xc8\x10jB\xbb\x9fpB\xc1\xd2\bg3\xe6>J18\x05\x86\x92\xe3q\x96\x01D'\xf4\x1f\xeat5\xdbI\xec}\xe6S4\xc4Β@\xea\x1b\xdd\xe3\xac\xe7]\xd9\xcadTݎ\x1c\x8f\xc1\x04&\xc9\xf0\x80S\xcf\xf7\x82\xfe\xb1O\xa3\x01\xdc\xee\xe4\x15\x97\x81\xb9\xd3=\xc6\x1d\x16\x88C\xb0}\x82s1LRըJ\xb5%\xc3\x17L$\f\xbb\xa8Y\xb4v\xd7=\xc2+\xe3\xf1\xf8\xa6<&\xea\xa2t\xde\xd9\x1e$EPQ\x99K\xbf\xc7\xd9\b\xcct\\ջ\xc20\x89BP\xa5\xaa\xc8E\x03'梖\x0e\xa4X\x1c?3\xba\"\x1fZ<\xb0bA\xe4ҡ\x16\x8b\xe3?梍\xf9\x02\xa6\xfaa~\xf8\x96`y~L\x17U\xb0\x845\x8b\xb9_0\bX8\x82R\x16\xd2\x12pf\xa2\xd39\x97\xd2\xccj\xb7q+\x02\xcd\xd6*#\x8b\xf3\xe3Ud\xf6B\xf4\"%9hؙ)N\xcbt8\xdaY\xef\x13\xa3lX\xb7$\x15\xc53\u061cN´\x9e?2xN\x8a\xc9\x1a\xa9\x8ay\x14\x92\x99̲H>\x13D\xa6\xca\xc0B\x05ǹ%\xbb\xb8\xd8x<\x9e)\xf3\xd6x\xbc\xb0@\x01}\xb32ʆ\xa3\x90\x98\x15\\\x88ʳeg\x92\xb1\xf4\xcc\xf4\xcduf\xe1\xa1Y:o\n\xe8YrE\f\xc3ZPm\xda\xdf[\xd4Y\xa4m\xc4x\xa9\xb6\xb0\xca\xc5\xf3v\x8b\xfa\x17\xd2\xc3y\xb8\x17\x8c\xf9\x82ʍuУ\xb2\x8a3\xa0\xbc#\xcc\xcc\xc0\x0eU\x06\xa0\x1dD\x13\x97*Y\xab͏\xf8©Ypܔ\xbbk\xf5s\xf5\xfaz\xdf#F\xf9I\x1c\x8a\x1d\xc3ʧl\xa9\x14XNń\xa6J\xf8T\xa3ULh\xec\xab\x04\x7f\xa6\xa6ơ\x8a\xefC\xfc\x8e\xe0\x93\xf4\xb6$\x9b\xb7ӵ\xa6\x0e\xd5\xe2\xa0\xce%\xa6ɞ=\x9e\xd7\xf4=GǹD\x839%\x87\xf6\xfaIg\xed\xf3pȶN\xae\xc0\x8f\x89{\xb6N\xce\xe1\xc7\xc1\xd9:9\x06\x8b\xbfuұ\xd7O^6\xce\xd6\xc9\x05$\\U\xabk\xa7W\xb5\xa3ӫ\xe6\xd1\xd9:ٷ\xd7U\r\xa7W\x9d\xce\xe9\xd5\xde\xe6\xe9\xd5^\xe7\xf4j\x1f2\x1dl\x9d^\x1dl\xaf\x9d^\x1dm\x9d^\x1dm\xaf\x9d\xc6\xd5\xfa~\r\xbf[\x188\xc0\xc0!:N\xa9\x1fn\xe0w\x1f\xbf\x87\xa7q\xb5\xd1ĄFs\x03\xbf[\xf8mⷣ\x12\x0e\xf0\v\x0eW\x1aۘ\xbc\x8d\x8d4\xb6;\xf8\xdd\xc7\xef!Fuj\xf8m`\xe0h\x13\xbfM\bll\xd7\xf0\x8bUn֡\xb2\xcdF\r\x03\x9b[\xf8m\xc1wKEmC\xfb\x9b\a\b\xd8\xe6!4\xb3y\xa4\x02G\xf5Ӹ\xbaU\xc7\xc0\xd6\x06\xa4lm\x1d\xe2\x17\xaa\xdcjb\xf9\xad\x83\x06~\xa1\xfd\xadC\xf5\xdd\xc2/f=ĬG\b\xca\xd6\xd1>~!\xaaY\xab\xe2\xb7\x0e\tM\x84\xb1\xb9q\x80\x81\x0eT\xd2܃>4\xf7\xb1`\x13\xc1j\x1em\xe0\x17\x93\x8f f[9\xa8ٮm\xe2\x17\xa3\xea\x1b\xf8݆\xef\x86J\xde\xc4@G\x05\xf60}oK\x05`\xb8[\xd5\r\b\xb4\x1a-\xfcb\xd4f\x15\xbf8\xf6\xad-\x00\xa5\xa5\xba\xdb\xdaƔ\xedM\x15\xd8\xc7/@\xdfjaB\v\xa7\xa3\xd5\xd9\xc6/B\xdf\xdaÔ\xbd:~\xb7T\x14\xb6\xa5\x9a߇\x81j\x1d`U\a\x18s\x80\xaevZ\x87\xd8\xee\x11\x96>\x82ߝ*6۩v\xf0\v\xcdvp\x1c;5l\xb6\x83\xdd\xeeԱ\xd9N\x03S\x1au\xfc6\xf0\xbb\x89\xdf-\xfcbV\xecsg\xb3\x85\x056\xf7\xf1\v\xe0t\x9a81\x1dDԎ\xeamg\xfb\x00\xbf\b\\\xa7\x85\x00\xa9\xeev\xb0\xbb\x1d\xd5\xdd\x0ev\xb7\x83\xdd\xed\xeca\xbb{\xaa<v\xba\x83\x9d\xee\x1c`\xa6C\xf5Ū\x8e uO\xf5p\xaf\xba\x8f_\xe8\xe1\x1e\xf6pO\xf5p\x0f{\xb8\xa7z\xb8\x87=\xdc\xc3\x1e\xeea\x0f\xf7\x1a\xaa8vk\x0f\xa7r\x0f{\xb5\xb7\xa9~#\xec{8\xa1{M\xfcnc9\xd5\xc3=\\e{j}\xed\xe1\x84\xee\xb5\xea*\xb0\x89_\xac\xb7\x85\xb9ZXo\xeb\x10\xbf\bh\a\xab\xeal\xe0\x17Qg\xaf\x83Y;\xaaB\xec\xff\x1e\xf6|_\xf5s\x1f\xfb\xb9_\xc5\xf4}\xec\xe8\xbeZ\x12\xfb\xd8\xd1}\xd5\xd1}\xec\xcf>\xf6g_!\xe5\xfe&Լ\xbf\x85E\xb0?\xfb\x88\x99\xfb\xaa'\xfb\x88\x99\xfb\xaa'\xfbؓ}Փ}\x9c\xab}5W\xfb8?\xfbj~\xf6\x11\xbe}\x9c\x9f\xfd\x03\xe8\xd7>\xce\xcf>\xce\xcf\xfe\x91\xfa\xc2`\x1f(\xe8\x0f\x10\xfa\x03\x05\xfd\x01B\x7f\xa0\xa0?ht\xf0\vU\x1dl@U\a\x9b\xb8\xc8\x0e\x90\x00\x1d\xa8y8@\xb8\x0f\x14\xe9;@\xa2w\xa0\xc0?ha\xb6\x96JARp\xb0\xd7P\x81=\xfcb\xcd\xfb\xb8\xa4\x0f\xf6!\xf3a\x15\xd7\xe7!\xe2\xc4!\xe2\xc4!\xe2ġ\xa2\x02\x87\x1b\x98\vI\xe3\xe16&oo\u0dc9\xdfm\xfcv\xf0\v\x95\x1f\xb6\x10\xe0\xc3\x16&\xb7Z*\x000\x1evTK8݇H\xad\x0e;\x98\vg\xfb\xb0\xb3\x87_$f\x87\xb8\x18\x0eq1\x1c\xe2`\x1f\"\xe8\x87\n\xf4\xc3}\x04Au\xe0`\x1f\xa3\x0e\xa0\x8d\xa3*\x94;R\xa0\x1fm4\xf1\x8b0\x1cm\xc1\xa0\x1f\xa9m\xe1\b\xa6\xb9\xa6\xbcqժ\xf5\x0e|\x1bG\xf0\xddTQ\x9b\x9b\xf8\xed\xa8\xc0\x01|a\xd0k\xd5-L\xd8\xda\xc2\xef!&7\xab\xf8\xdd\xc4\xc06\xe6\xda>\x84oGյ\x8fE\xf6\x9b\xf8Ū\x0eT\xc2\x11\xb6{\x04\xe0\xd7\xea\x1b\xdb\xf8\xed\xa8\x00d\xab+X\xea0\xf5\xb5\xfa&\xa6+\x88\xea\bQ}K\xa5ocʶJ\xd9Ɣ\x96J\x81A\xac\xd5\xf7\xea*\xb0\x89\xdfm\x15\x00\x00\xeb\xfb\x98\xbe\xaf\xd2\x11\xcc\xfa\xbeJ?\xc06\x0f0\xd0\x00\f\xad5\x10Ck\r\xd83j\x8d\x9aJ\x81\xc5Tklck\r 絆\xea4\ueaf5Ƒ\xcav\x04@m \xa2ն`\x1ej[[G\x18\x00\xe4\xadmm\xab\x14\xa0\x0f\xb5-U\xc1\xd6!\x06\x8eT\xca\x11t\xb1\xa9\xa6\xab\t\xab\xa7\xd6\xc4\xd5Sk\xd6`\xbc\x9bu\x95\xd2\xc0\xc0\x86\nlb@\x8dQ\x13\x1bm\xaa\tk\xe2\x845\x15\xd4M@\xaeZ\xf3\xa0\x89_ȵ\xad*\xdb\x06\xfe\xa2\xb6\xadrm\x03\xca\u05f6\xb7\x9b\x18\x00JP\xdb\x06\xa4\xadm\xef\xa9t\xecnK\x01ت\xc1\xe0\xb6\xd4쵶\xa0ﭦ\n\xc0\xb6Pk\xa9:[\x80ﵖ\xaa\xa0\xb5\xdf:\x8dk\x1dUA\xa7\x06\xa3\xdfQpt6\xa1L\a\x16JMQ\xf9\x1a\xd2\xec\xdaކ\nl@5{\xdb\r\ft\xaa\xf8=\xc4/\x8c\xed\xde\x1e\xe2\xc5\x1ep\x18\xb5}U\xff~\x1d\xca\xef#\xdbP\xdb߀l\xfb\n\xb1\xf6\x81\x8f\xaa\xed+\x00\xf7\x11\xb1\xf6\x0f[\x18\x00n\xac\xb6\x7f\x88#\x884\xad\xb6\x8f\x9d\xde?\x02`\x0fT\xcd\a{P١\n\x1c!\xaa\x1c)T9\xaaA\xcdG\xaaOG\x1b\x98\xb2\xa1R\x10ُ\xd4p\x1dm6\xf1\xdb\xc2\xef\x1e~U2\xe2\xcb\x11\xc2w\xa4\xe0;\x02\x96\xa4v\x84\x9cA\xedho\x1f\xbf\xd0\xf3#\x85\xd1G\xfb\x98\xbe\xaf\xd2\xf71]\xad\xbc#\xe0\xbdjG\a*\xe5\x00\x9b9T)\xd8ͣ#U\xc1\x11Vp\xa4\xb2\x01\x13V\xaf\xc2\xe6W\xaf6љ_K9\xf3\x83\r\xad^\xab\xd6\xf1\xdb\xc4/\ff\xbdVk\xe0w\x13\xbf-\x15u\x00_\xe0\xb5\xea\xb5\xfa\x16~\xb7\xf1\xabJ\xd4U\xf2\x11\x06`\xf3\xaa\xd7\x1a\xfb*\x00-\xd6p\xca\xeb\xb5\rL\x81\xfd\xa1^\xdb\xc6F\x00?\xebjz\xeb\xfbuH\xd8o\xa8\xc0&\x06\xb6T\xe0\x10\xb3\x1d\xee\xa9\x00\xa6\xc0\xc6T\xdf?\x82Z\xd4$\xd6\x0f\xea\x9b\xf8m\xe2\x17 :Pu\x1dla\x14p\xb1\xf5\x83m\x15\x05\x1bN\xfd\xa0\xa3\x02\x1d\x15\xd8V\x01\xa8\xfe`O\xa5\xecaʞJ\xd9Ô}\x95\xb2\x8f)\xfb*e\x1fS\x0eT\xca\x01\xa6\x1c\xa8\x14\xd8W\xeb\x87\xc0\xef6\xaa\xd5M\xfcn\xc1\x17\xf9\xf3F\xb5\x81Q\x8d=\xfc\xee\xc3wC%\xb40W\xeb@\x05\xb0xG\xa5\x00\xf5m(\xeaۨ\x02oݨ\xe1\xf2j\xe0,4j\xaa\xe6\x1a\x90\xf2F\r;ب\xedA\x99ڑ\n`\x99\r\x1c\xb3\x8d\x03\xa0\xae\x1b\n\xf1[G\xc0\xd4w\xd4\xee\xd2ـ\xad\xa6\xb3q\xa0\x02@\t;\x9b*e\v\bYg\xab\xa6\x02\xe0,\xb2\xb3\x05[Qg\xab\xbe\a\xdf\r\x95\x00\xa2Cg\v\xbdHv\xb6ZP^\x91\xc8\x0eJ\r\x9df\r\x88R\xa7\x89\xe5\x9b\xf5:\x06`\xe5v\x9a\xdb{\x18\x80\x89\xe84q\":ͽ&|Q\xde\xe9lWk\xf8m\xa8\xc0&~uJ\a\xbf\xfb\x18\xa8\xd7\xe1\xab\xc0\xd9n6ໍ\xedl\xefa\xe0H\x05\x8e\x9a\xf8\xdd\xc3/\xc0\xd9\xc2u\xd0i\x01>uZ\x88C\x9d\x16\xb0\x13\x9d֖\n4a\x04Z\xdb\x1b\x18\x80;\xd3ڇ~\xb4\x0eU:\xe0k\xa7u\xb8\xa5\x02\x98r\xa4\xea<\x82N)z\xd9A\xf6\xbd\xd3Q\x00v6\xea\xf8\xddP\x01\x80\xa6\xa3Z\xeb\x80\xdc\xd8\xe94;\xf8=\xc4(\xa0\x91\x9d\x0e0J\x9d\x0eLa\xa7\xb3\x87\x99\xf6Z\x98\f<G\xa7\x03\xdbd\xa7\xb3\x8f\xf5\x1e\xe0\x98v\x0e0AA\xd99\xc4\x1a\xd5(t\x80ft\xf6p\xaf\xeb\xec\x01\x8ev\xf6\xaa-\x15\x00\x90\xf7j*\xa5\x86)H\x0e;{u\x15\xd8V\x01̦Fk\x0f6\xd8\xce\xde&\xce\xc3\x1e\xf0\x1e\x9d\xbd\xa6J9\x04pԊ?h\x02Ku\xa0\xa6\xf8\xa0\t+꠹\xbf\x87\x01\x98\x8e#\xb51\x1du`/:\xea4U\xe0\xa0u\x1a\x1f\xed\xa9\x14\x04\xf4H\xc9\nG{\xb5&~\x0f\xf0{\x84Q0\xc2GJ|8\xdak`\xe6ƶ\n\xec\xe3\xf7\x10\xbe\x1bU\xfc\xd6\xf0\xdb\xc0\xef\x06~\xb70\xeb\x1e&\x1c`#\xc8\xe3\x1e\x1d \xd5?:\x00\xd6\xe1\xe8\x00\x19\xed\xa3\x03\xe0\x94\x8e\x0e\x8eT\n\xc2\x7f\xa8@>\x84\xed\xf3谉\xb5\x1d\x02=>:\xaa\xc3p\x1e\x1d\x01\xdf|t\xb4\xa1\x02\x9b\x18\xd8\xc2l\xb8#\x1c\xa9\x1d\xe1\xe8\b\xab>B\xe1\xf8\xe8\b0\xed\xe8\xe8@\xa5\x1c`ʁJ9\xd8_\xafDa\xcc\x1dF\xee\xda\xfb\xab\xeb\xa7q]1\x1f\xf5f\xb7w\x1a\xbb.\x83\xba]\xb7\a\x01%L\xbb]\f8*Ѓ@\x8fU\x19~{\xa0b\xa8*\r\xc6\x16&\xe0\xbc\xf7\x18\xec\xc3\xf5\xaa\xab\xb6\x91^\x15*k8X\xbc\x9b\xd6R\xafV\xddӸQ\a\x11\xaaQ\a\xae\xbaQm\xb8\xf8\xad\x9e\xc6\xf5\r\xa7\x9e\x16sq\x98\\\x17\xf6(\xd7m2\x15@\x80\xb7\x19|[5\x8cj\xd1\xd9>0\xc4W\x97\xc1&\xe6\xb2\x1a\xa4\xb3:&4\xea\x98\xd0\xc0(\x9c-\x97m\xe2\x1e\bM֫\xb8\x15mR\xba\x86\x7f\xba\xf0\xed\x02\xdd\xdet\x00\xbaM\xecH}\xb3\xc7N\xe3j\x95\xb6\xf0\xcbp\x8f\xab'[\x9c\xebTaW\xdaR\x03\x8cZ\x8ez\x17\x88Q\xbd\vXY\xef\xe2\x9eڭu\xf1\v\x8dvA\xcbP\xef\x02\x8f^o\x00\xb0\xf5\x06\xa66p/m80j\r\x86\x1bn\xa3\xd7\xc0\xef\xb6\n@/\x1cLo\xc1\x8eQo5p'V\x1by\xad\xd7K\xa6}u=?eG\xa8\x15j(\x15\x11\xaa\x80ZJ\x05\x84\"\xc9&\xf0<\xd5M\xd4fm\x02\x91\xa8n\xa2t\xb2\t\fuu\x13P\xae\xaa\x88|u\vU-[\x1b{\x18\xd8T\xda \x10X\xb6\x90\x15\xa9n\xa12cK\xa91\xb6P\v\xb6u\x88\xe5\x0f\xb7\xf1\xab\xd4A\x87\a\xa8\x02BE\x0f҉j\x13\xb5L\xcd\x0e\xd6\xd2D٩\x89\xbb|\xb5\t;{u\xbb\xb6\xa5\x14>-\xfcb\xcav\x1dS\xea\x9b*\xd0\xc4oK\x05\xa0\x81m\xa5\xdd\xd8\x06.\xac\xba}\x80R\xdd6\x8a\xb4ۇJYS\x85\nZ\r\xa5\xaci\xa0\x1e\xa6\x812mk\x035;\x9bJ\xf3\x03,]\xb5\xb5\x85j\x9c-,\xb2\xad\x12P\x85\xd0\xdaÂ{\xaa \x8e\\\vǬ\xb5\x8f\xba \xa4c\xd5־R\xf0`\xc2!Vu\xd8@eNMiv0\x800t\x14\f\xb8\x0fT;(\x02v@\xb8\xaavԸw\x80߬v6Qy\x82\xa3\xdfi\xaaߨ\xe5Q\xb0u\xb6\x95\x02\x06+T\xb0up>;\xfbM\x15@\x9d\x8c\x02\xae\x83\xc0u\x10\xac\x0e\x82\xa5\xe8\x7fu\x0f\xc1B\x12Y\xddS`!E\xac\xee!X{\b֞\x02koCiZ\xb6\xf0\x8b\xc98d{[Jт\xbf\x15${\x88e{Js\xba\x87ô\xa7\x14~{\b\xc9\x1e\x0e\x93\xda\x1b\xaa\xfb\bþj}\x1f[\xdfGz\\\xdd\xc7\xe6\xf7Q\xe4\xac\xeeo(%\xc9&~\x01\x88}l~\x1f\x9b\xdfW\xa3\xb2\x8f\xa3\xb2\x8f\xa3\xb2\xaf`\xd9\xc7\x19\xdbW\xb0\xec#,\xfb\n\x96\xfd}\xa5\x10\xc1\x1a\x0f\xb0F\x1c\xa1}\x1c\xa1\x035B\a\b݁\x82\xee\x00\xa1;P\xd0\x1d t\a\n\xba\x03\x84\xee\x00\x87\xe5\x00\xe1:@\xb8\x0epX\x0e\x10\xac\x83}ԝ\xec+\x15\xc9\x01V\x85\xcd*\x16\xb2z\x80\n\x82\x03\xa5\x88\xc1Uq\xd8@\xa5FC),P\xf5r\xb8\x81\xd3{\x88\xba\x97CT\xa0\x1c\xee\xa9t\xd4\xf2\x1c\xa2\x1a\xe5\x10G\xe0\x10\x99\xd6\xea!\xf6\U000e8d8d_\xc8t\x84\xfa£\x06j\x1f\x1a*\xe6\x10\xbf\xa8\xa2P\n\xd1#Ԣ\x1c\xa1\xb2\xfa\bu)Gۨ\xfa8B\xcdɑҜ\xa0XS=\xdaG}C}\x0f\xd5\x06\rT.l\xa2TREI\xa9\xba\xa94\x11[\xa8\x89ت\xab\xc0\x06~\x9b*\x80z\al\xb9VE)\xb4\xba\xad\xb2\xa1\u00a0\x8a\xb2{\xb5\xa5\xf4\x1d\xc0d\xd6\x1a\x9b\aJ\xc4?B1\xbb\xae\xa4m(\xd9l\xa8@\x03\x03\x9bu\xfc\xa2\x14\xdd\xc4\xdfM\xfc\x8d\xa3Vk\xa2\xa4\xd5\x04v\xa9\xb6]E\xf8\xb7\xab\x18\xd8\xde\xc4/tl\xbb\x03\x9dh)ٰUG\xb1\xb8\xa1\x02\xc0\xd1\xd7:\xc8\xe2\xd6:5\fl\xa2؋\xea֚\xe2\xecj\x1d\xe0#k\x1dT&t\x94@\xddAIP\xb17\xb5=\xd8aj{8\xd15\\\x81\xb5\xbd-\x04f\x0f\x81\xddS\x02%.\xb2\xda\x1e\xca\a\xb5\xbd\x0e\x80\xb9\x87\xbcgm\xef\b%\xe6:V\xb0\x0f\x13[\xdbW\xa2\xe4\xfeA\x1d\xbf*\xe5PI\xcc(D+\x19r\x1fe\xc8}T`샪\xb6\xa6\x04\x9f\xda\x01J\xcf\aG{*\x80\x02\xa5\x12|\xaa\xa0\xb9\xae\xef\x1f\xa2\x14\x88\xa2v\x1d\x95|\xf5\x83C%\x18\xa1\xe0QEf\xacQU\"\x11r\a\xd5\x16J\x0f[JT\x80\xc9\xe8l57T\x00\xe5\x86\x16\x8a\x12-\x944\xa0\x91\xce֑\x92\x00\x90\xc1Gfu\xbb\x8a\xfc{]\xc9\x04ud\xe9AA\xda\xd9\xdeƬ8\xad\x9d\xed}\x14\x16\x14\xff\xbb\x8d\x95\xb4\xea\x8aK\xaf#\xe7\x8f˨\xd3\x02\xb4\xe8(}G\a\xc9}\xa7\x85\xea\xc8N\vy\xea\x16J0\x9d\xbab\xb6\x1b\xc8y\x03\xdf\xd8\xe9l\xec\xe3\x17\xb9\xec\xa6b\xb9\xb1\v\xa8$\xeft\xf6\x14\xaf\r\n\x80N\aE\x9a\xce\x1e\xca\x00{(\x16\xec!W\xbf\x8f\\\xfd\xa1*\x8d\xc2C\xe7\b\xdb;Bv\x1b7\xb1\xce\x1e2\xef{ \xf6w\xf6\x0e\x15\xcb\v\x1c\xa3\x12\xe5\x8e\x0eA\x95~tXW\x81\xfaQ\xca\x17>\xb6\u05ebk-<\xd4Q[\xfb\x16\x06\xd4\t\xd0\x16\xea\xe4\x9bJW\xd9ĭ\xa2\xb5\xa5\x0e0\x90\x99P\x12M\x15%\x9ajG\xa5t0\xa5\xa3R\x10\xdc\xea\x9eJ\xd9\xc3\x14\x85\x89\xd5=L\xd9W)\xfb\x98\xb2\xafR\xf61\xe5@\xa5\xa0\x90^=P)\a\x98r\xb8\xa9T\xa7x\x88u\xa8έ\x0eA\b\xa8*\xadL\xf5\xa8\x8e\xb4D\xa9ϐ\xb1\xab)\x15G\rѫ\xd6T*\x92\xe6!\x04\xb6\x91\xa3\xa9!GQk!ɮ\xe1\xb6_k\xa9\x85т\xaak\x1d\xb5\xb2:\xdb\x18P\xb5u\xb0\xb6=\xa5\xf0\xd9C\x02\xb6\xa7\x16-j\xfak\xfb\n\x82}\x84`_eۇl\x1du\xa6\xd6\xd9\x02@;\xdbJ\xa4ކv:J\xc2鴪\x18P)-\x95r\xa4\x020%\x9d\x0e\xd6\xd6\xe9`m{*e\x0fR\x8e\x8e\xb0?GG\xb5V:\xc5w컫\x8fɛ\xf8}`\x1b\xe6n\xfb\xc4X}\xbcj\x9c]\xd7HczzZ\xb1\xae\x1b\xd3|\x94A\xee\xd9Ɖ\xb1\xfa\xe6\xaaq\xa6r\xbf\xb9j\x9c\x9e\xae\x9d]W\xc9Vm\xaaS\xac]\x83\xdc_t\xd4\rw\xec\x8c\xd5{\xab\x86e\x9d\x9e\x1a\xablJ\xdeX\x9c\xadm\xac\xde7\x99\xb5\n?OO+\x18Z\xadY\xab\x86u]%\xb5\xfa\xd6T\x1a\xab\x0fV\r˘\x92\x03\xdbT^\xac\xfb\x87W#S\x03W\x01\x98>\x9d\x81gXd>әaY\xe4u{\xdd\xdcm_\x05kk\x97\x8c\x0f\xfb,\x88\xba\x8cS\x11\a\xfd\xb5Ѹ+\xe7\x12\x18_s\x84\x8aw|g\xe0T\x19\xadv\xeb\xfd:m\xf5\x1d\x17\xa3\xc7\x1b|{\x93\xf9۽A\xbc\xe9\x06\x9c\xca \xe4b0f\x91`<\x18\xc6\"\xa6\xbe\x14\x9c^2\x9f\xf1\xc8\v\xa2\x98\xd3\xc0a\x12Z\xf9\x7f\xfeu\x1e\x00\xac\xad\x11>\xa9m_\xfaۣ\xa0\xb1E1f3\x8a\x1b\x1b\x8f[\x8d\xadn?Ry\xba\xceE\xadۢ\x9b.g\x1b\x0e\xc6\f\xfb]\xea\xb5\xe8\xd3\xfe\x93\xd1\xd6\xe3$\x86\xf1\xd1\x06\xddt7(ǘ\xab\v\xa7\xee\xfa\r\xba\xc9Xu0\xdb<SyzO'Ѷ\xbbՊ/\xfbô\xe2\xa6S\xedv\x83*Mk\xa1~c0au*\xe9\x90qϡ\x81vE,/\x18\xe7\x93Q\xea=^F4p/\xbd\v'\xe4\xe1\x90\x06\x02+\xf0jݭn\x8dn\xd1:\xc3\xf0\x85ï\x9aM\xb7v\xb5\xa1\x1a\xf0\xfb\xdd.\x155\xean\xa7\x1d\xa1\r\xbaѫm\xd14L/\x9c\xa6{\xd9K\xc2N\x95\xb6\xe8SG\rN\xf0\xe4\xb2ً\xaaU6\xa4\x92\xf6\xe8\xd0\xf3'\xe0\xf6\x8a\x06\x93\x14\\\x15+\xbb4\xa0\x01\xe5l\x14w}ϑ\x0eL\v\xe7,b\x94;\x03\xe9\x84\xe1\x85\x17\xf4\x9d\x01\r\x02櫮\xf9aߋ\x84\xe7Dr̨\x180\x9e\xa4\xe2<mt\x9b=A\xab\x8e\x9a\x92\xad'OZ\xdb[\xddƕJݮ\xd2'\xccqyM\xf5\xa2\xbb1ުn\xf6\x18W8\xd4\xf3\x9e\xd4\xeb\xdb\xce\xe6 \xc2\xe0\xa0\xde\xe5\xac\xdf`\x97j\x88\x1e\xfbO\xb6jqk\xdc\xec\xe6F\xa4\xc1\x1e\x8b4H\x87M\xba=H\x83\x93A\xb3?J\x87\xab\xdb\xeauGaZ\xb6;\xa8\xd1f\x8d%A\x876ݧn\x98\x04\xbd\r氫Q\x12\xbc\xdap\xdc*Ue\xf9\xa5ScU:l0\xe9\x05\x80\xdcx%\x9d\xfa\xd2\xf7z,C\xech\xc4\x19u\xbbL\b/\xe8k\xccO\xc6i\x1c\xfa\x82\xf1\xe8\u008f\xc7\x1a\xe3\x98sq\xe9\n\xa7\xa5\xc7a\xe4\xf0\xc7-\xa7\xa1CO\x9d\xba\xd3bu'\x1d\x94\xc7-g[\x85\x84\xf7d\xa3u\xf5d\xa2\x90d\xd2\xe3ᆷ\xd5Tݘ\xf4\xbb^\x9d\x0e\x87W2\xbd\x83䅁\xf4G~\xcf\vh\xe0xԗ\x913\b}ʣ\x817\x8a`1D\x9e3`<]\x85\xac\xdamV\x9bj\x9066\xbb|ә\xa4\xf3\xe8^\r.\xa2$\x10\xb1\x81\xab\x86g{R\xa5խ\x86\x1a\xf6\xbes\xc1\x1b\xbdj\x8a\xa2-:\xee\xa6\x01ڭw\xdd$Џ\xb7\xeb\xe9T\x8d\xfcz/\x9dF\xd1p\a:\xdbE\xad\x1bo\xe8\xb5\x1e\xf4\xbb\xce&}\xea&\x01\xd6bzm\x86\xfd\uea37\xddS\x80>q.hm4t$u\x9c0\x0e\x04\rD$\xbb\x94;>\x9d8\x94\xbb\xb2\xebS\xe7\xa2\xc7=\x97Nd\xd7\x0f\x9d\x8bn\f$Kv\xb9\xe7\xf6Y$\u0080I\x87\xfa\x97^p\xe13/\x80\xb1\x14\x9c\xa2\xcbJ\xe9p\xe6z\"\x0e`XY\xd0\xf7\x02\xc6\xe0>\xa3d\x80\x17#\xeeE,\x92\xbd0t\x03&\xc6!\xbf\x90^p\xc9\"\x81W\x9d\xd4J\x1a\x84\x82\xf9\x91\xf4\xe9\xb0\x1b\xf2\xfe\xc0\v<9\fEȝ\x89\xe3\xb3H\x86>\x9dР\xcf\xc3x$G\x83P\x84}NG\x83\x89\x04\xa7\xa6\x91@\xbc\x93#\x1e\xbajf#\xf8\xddGg\xe8\x97Lr\xe6\xc6\xc3.g\xbeO%z3\xa4b\x10\x0e\x99\x1c{\xbe\xef\xd1\xe1\xc0\xf3\xd5\x00\xd5jݍ\x04\xd3j\xceE\x9d\xd5\xd4<֞<\x19\xd7\xf54\xd6#\xc4G\x85\x13\xddHT\xab\x8a,6\xdchc\xa3\xa1qe\x90\xe5٨;u\xb7ES\xb4I\xe277\x9f\x8c7\xea*\xffV\x8f?\xddֿ\xb7\xab4\xack\x92кࢪg\xd2q.\xea݆\xdev\x9e\xf2\xadֆ\xfa\xed֨\xf3Xǳ\xde\xe5d[/z\x16\x89\xcb榪\xb3爍z\xebB\xfd~\xfc\xa4Y\xd7u\xf6\xfcqcS\xaf\xfb~\xfd\xeajC\xaf\xa4>\xcb\xe0\xef_4\xa8\xa8\xb1\xfczS\xbf\xaf\xc4vmC\xc5{C\xbeYk\x04\x8a2m\x8dk-=\x0e\x8f/ym\xbb\xa5\xc6\xe7b\xc4\xc75=\xb6\x17#>\xd9l&\xbf\xe3fm\xab\x97'E\xc9oqU\xd7$ʻ\xdan\xd5T\x9e`\xf2dRׄ?\x14q\xb3\xb5\xa5\xea\x19uE\xab\xd9T\xb0\x8d\xfaݨ\xea\xaaqx\xd2z<\xe9\xeau\xc2\aO.[[\n6\x1e^\xc6\xdb۪\xfe\xa8\x95\xf5+b\xd1\xe6\xe6\x86\xca#\xb6\xaa\xddM\xdd\xd6e\xfcd{K\xe3\xc3x\x83G\x1bU\x853W\x83'\x9bu\x1d\xff\xb4\xc7k[\x1b]\xf9\xecۿ\xf7\xec\xdb\x7f\xff\xec\xdb\xff\xeeٟ\xfd\xf6\xb3o\xffγo\x7f\x13\x7f|\xf3ٟ}\xe9ٷ\xdfy\xf6g\xbf\x9d[\x81\x92\x8e(\xd7\xeb\x80FQ\xe8xT0X\x96\xd1\x05\x13]\xea\xfb\xb2\x1b\x8cF\x94{]\x1a\xc9n\xc8\x06\xb0\xaa\x18\x97\x0e\x1dy\x82\xfa\xb8$\xc3 \x8a}\xa4\xadj\x15\xe2bvb/\xf2\x02\xc4y\x16\xf3\xf0ҋpi^\tN\xd1\x1d\x97\xec\xc1\x15\x11\xb5p\x06\x8c\xfab\xe0PΤ7\x1c\x86]\xcf\xf7X \xbd\xc0\x8d#\xc1a\xdb\x1eҀ\xf6\xd1=\xa2\x1cz\"\x8a\xbb^4\xf0\xa4\"\xf7c\xcfe2`\xe3A\xe8\xfb4pe\xc0\xae\x84\xebq\xe6\b\x19\x06\x930\xe6\x11d\xc8\xf1\x00#\x1e\n\xe6\xe8\x15\x1b\xbb,\x10@~9\xa3>\x83\x95\xccd\xb2\x01\xd3@r\x88\x82mD\x00}\xa6\xac\xd7\xf3\x19\x97\xd1\xd8\xeb\t'\xbcd\\\n*(҉H\n\xe6\f\x82\xd0\x0f\xfb\x13)\x98\xcfza\xe09TƁ\x87\xc4\\L\xe4\xa5\x17\t:\xe2^ \xe4\xa5Oi\xe02\xce\x02y\x19\xfa\x17\xe0\xf6\x95)\x04nT9oN\xd4\xcf\xd1U\xbc}\xa1\x97\xee\x93ZM\xaf\xe8~\x97{\xc3d\x11_m*\xfc\xdb\x14O\x87\x9b\xe9\x12\x8e\xc6\xeag\xabJ\x1bT\x15k\xb9\xdd'\x9a\xac\xb7\x98ج\xc7\xf8өwyS\xe5u\xfa\x1b\xdd\v/Y\xe0QU$?\xe3z\xca\x12l\xe9U\xdf\xf3\x9eD\xdbQ\xf2\xf3iK\xfd\xf4\xc2*mzɲ\x12\r\xf5sx%\x9e\xd4\x14\xbca\xc3\x19o\xa8u1\x8a\xa2\x89\x86!\x0e\xe2ц\xea\xf1\xb8\xdf\x1d\xe8n\x8e\xfb]_\xe3\xfe\xa4E\x1b\xf4\t -\vD̙\xa4~\x8f\xf2p\xc8BI}\xb5\x83>\x95t\b{\x85K\x87\x92\x06ԟ OD\x9f\xc4\x14\xc8.\xc3m\x86\xf9a@ac\t\xe1FG_:\x03\xeeEbH#\xe9\x84\xc3a\x1c\xc0\x14)\xc4\t\xf9D27V\x1en$x\x91\x1e!\xee\xf5\xa8\xc7\xc7^\xe0F2۶{\x1e\xd7[S\x8f\xb3\x88\x05^\x1c\xc9\x1e\x0f\x03\xe1\x86!\x97\xbd\xf8\xb1w\xc5xx%{1\x0f<\x84\xbe\x1f\xfa.^h\x92\x03/\xa2\x88\xcf\x12\xf6\x03\x97\x8dB\x81\xbf\xfaa\xe8F\xf8+bA\xc4$\xdco\x99\x8c\x99\xefK/\x88\x84'b\xc1d\xc6\xde\\\xc4\xe1\x85ژ|\xeavyx\xc1`\x1b\v\x1c\x8a\x9b'\xac\t\x8e\xa8\nLQ$&>\x93C\xca/\x18.\xd8!0\x1c\xd4\xf7#9d~7\x8cy\xc0\xe4\xd0sx\x18\x85=!G4\xa0\x11\xa0\xb1\x1c\xd1(\x02\x14\x85գ\x99V\xc9=\xb0\x18v}OF\xce\xe3p\x10Da \xa3\x01l\x92\x9eOe\x14\xfa\xb1\xda\nqQuip\xa1~\xf5(\x1f\xcaH\x84\xce\xc5 \xf4\x87\x99 \"/\xa9\x1a\xf0(A]O\xfdrj\xf4\xb2\x9fl6\xbe\"ìF5Q\xed\r\xbaL!\xda\xe3\x1ae\xbd\xe4\xd7P\xa1\x99_\xa3Nʤ\xf0\xab\x8c\x1f\xc7_\xa3\x1au\xd4/\xe1\\\x8c\xd5fr9x\x12_\xcaI\xe8\x86]\nD\x86vcw@\xbb\x9e\xa4\x1e\xef\x85\xdc\x01\xec\xf3\x15\x9d\xa0B\x84<`\x93\x84\x89An\x86\xf5\xc2P\xc0\x8f>\xf5\x02\x88\x89\x98\"\xa3a,\xbc'1\x93]N]\x169\xa1\xec\xf2\x90\xbac\xe0ux\x1cE\xc0|tc\x97\x8eX$dW\x99\fC\f\x10\xd1(\x02z\xcbD8\x0e\xa4C\x85\xe2l\x1c\xe0\"PL\x18\xf0I\x04d\xc9\xf1Fܣ\x81'\x1dOL\x18\x15\x91t|F\x03\xcc\xec{\x01\xb6\xee\xf8\xa1\x18`L8\x1c⤀,\x12\x03\xa6\xb8\xcc\aR5\x81\x1f\xa1'\x04\x93.\x1b\x86\x0e\xa7B\xba\x1e\x1d\x86\x80\xf7\xae\x17\xe1Ρ~\x00V\xb9\xe1\x18\xfd\xee\xa6\\\x97\x04\xec\x88\x00\x19X\x82\xa1Lx\x11\xf5\xa9\x90\xec\x92ql\x94]\x01\x03\xdeg\xb2ǘۥ΅\xecyо\x98\xc0\x82\x1a\xbaԇ\r\"T;\x10.'\x8fq\t\xebb\xc2(\x97}N\xd56\x84\\\x18,\xf5~L\xb9\xeb\xd1@\x0eܞ\x83M\f\x98\x1fy\xc1\x85'\a\xa1\x0fF鰠\"ܴ\xa4\x17(\a\xdd\xd2\x1by\x9c\x06}*\x812\a\xddؗ\x8fGÐ\xf7i }`\xd4`\xa0\xfc8p\xbb̹\x80\xb5½P\b9\xa4\x11\xe3Txr\xe8\\xA\xc4&rȆ!\aj0dܹ\x18F\xae\x1c\x86\\\xf4i\x9f\xc9a\x88d\x9f˰\v\x97\x15\x19\x97\xb0\xe1\x060\xb9\xa3\x01\xe5C\xeaL\xe4\xc8sD\xccak\xf2\xe3a\x17\x1a\xd5\xdb\xd5\x04xGE]8\xf3=%\xcdP\xcaq\xa3\x8b\xe80r\xfc\xb8+#\xe6\xc4\x1c\x86N߆\x8cd4\bG#\xa8'\x1a\x84c\xe1\r\x99\x84\xf5\xac\xd6`\xd8\x13c\xd8i#\xe1(\xaa\x11ţ\x91\x8f\xc2\xf1\x04db\xe6\xc0\x9a\xf4\x10mb'\f\x02\xd8I/i\x80#,/\x15\tF\t\xc5C\x97\x06c V\x9e\xabVO\xabJՏ \xd41\xa3\x1aU\xcb\xf3\xc9\x15\x1d\xcaIx\x11\x0e\xe8\x90\xca\xf7\xbe\xfe\xfe\xaf\xbc\xf7\x17\xef}\xef\xfd\xaf\xbe\xf7g\xef\xff\xc6{\x7f\"i7\xecS7\x94ԡ.\x1bN$\xedӋ\x01\r$\xf5\xbd.\xedR\x89d\xccs%\x15\x03\x9f\t*\xa9\x96\xa1h\xecz\xf0\f!\x8d\xa3Q\x18\tI/a\x98(\xca\xd2Cv%\xbb4\x1e\xd08\x92]\x16\b\x1f\xd6*\x8bD7\x9eȮ\x92\xa6a\x15\x82\xdc,\xbbq\x9f\n\xe1%\x8c\x8dt(\xa7\x97\x14\xd6\x1bg0Y\x0e刃\x89\xe0\b\xb4\x0f\xc6\xdc\x19x\x81\xa0\xb8\xe6\xa8\v\xf1~\xdc\x1d2W:\xa1\xef\xb3>pG~\xd8G.i\b\x14Y&\xa2?\xfe\xe5\f\x85\x18\xea\x88D\xba\x97N\b\x92\x1f\x95\xb8\xcc8\xe4\x8bG@\x13\x1d\xe0d\x18\b:\x9es\xc1\x84tx\x8cB\r\xf2/\x11,\xc8>\xc2\xed\x86C$<\xf0\xcek\xc4\\\x99hB\x80\xecB?z@\xd5\xc2@\xf6\x18\xe7\x94{\xf8\x97\xf1P\xefhL\xf6\x80\xb5\n\xfa\xb2\xe7\t$<=\\\b\xf07\x84\xed\x12\xfe\x8e\xb1\x9e\x90G\xb8JyاA\x84;\x1d\xecd}x\x89\x8dOd\x1f\xe0\n\xfa\xb2\x1f\xba\xd4u'\xb2\xcfC\a\xe3cOP\x1e\xc9\x01\x1dvcޗ@\x06\xc2\x18\xf6BA\x9d\x01\xaeV\x14\x00\aa\x84\xe5Q.c\xb0x\x05xS\x90\x83\t\xb0\x8d\x9e\xf4\"|\xae@z\x11D{\xf21\x1b3\x9fO\xe4\xe38\xf0F\x8c\xcb\v\xb8-\xcd\x02y\x11\x0e)\xc0\xe5S\x87zW\x14wF\x10\xbe|\x1a\\A\xff|\x1a\x01\xc4ҧ\x82\x87]&}\xe6\xf8\x8c;\xd2\xf7\xa8\aḊ\xd7k\x98+}/pB?\xd0{'\xec\x97\x026T9\x04\x81\x9eq\x190\xb5\xbe\x02&z\xbew%\x13A3`\xb1Z\xfe\x17^@\xc7T\x86\xbe\x1b\xd0ˉD*\xe392\xe4^\x1f\xe6k4\xf0|\x90\xfcG^\x88Tt\x14\xfa\x9e\xf0\x18\xf2\xa5\"\xe4\x923\xc7\x1b\xb1Hr\x16\b\xea\xc3\xdfK\x8f\x8d\xe1\xef\x15`0R\x03P\x16h\r\x17\xb0\xabC\xcfE\xb6uL\xf9S\x199\x1eC\xf21\xf0.\xa2\x01\x95р{\x9c\x0ee\xe4\x05}\x18_`M\xf5_>\x00\xa2\"B\x0e\xf4\v\xa8Cȅ\x8cbއ\xf9\x8b&\x91`C\xe0v\x81\f^H1`\xa8\xacÿ\x9cI\x81\xe8\x19I\xe1\xf5z\x80\xe7\"\x8c\x06^\x97\x02M\x01*,\xc7\xd4\x1fR.\xe4\x98\x06\xfd~\x18\xcb1\xde|K\xf5Wr̺\x91'\x98\x1c3W\xe5\x1f\x84\xd1x\x10\xca1\xfa\x9d\x8c\xe0/\x12\xcf+$\xe1\x139\xa1Cz\x15\ar\x12\xc6\"\xee2\xf94\x86\xfdg \xdf\xfd\xfe\xbb\xef\xfc\xe8K\xef\xfe\xf0\xdd\x1f\xbc\xfb\xddw\xbf/\xdf\xfb\xfa{\xdf|﯐\xea|\xfd\xbdo*\xf2\xf3\xa7\xef\xfd\xf9{_\x7f\xefk\xef}G\x05\xbf\xf7\xfe\xaf\xbc\xff\x1b\xef}\xfd\xfd_\x95\xef\xff\xf0\xbd\xaf\xff\xd7?y\xef/\xde\xfb\xa6\n~\xf9\xbd\xaf\xbf\xf7\x9f\xde\xff*\xa4\xbf\xffe\xf9\xfeW\xde\xff\xea{\xdfx\xef\xeb\xef\xff\x06D\xc8g\xdf\xfe\xb5g\xdf\xfe\x06JXo\xa3\xd8\xf5gϾ\xfdCI\xbb\x94\x8b\x81\xa4\xdd.\xec\x18\xb4۽\xf4\x98\xa4\x0e\\֒\xb4\x87\xfc\x13\xf0S\xce\x04\xf8\x8an\x1c\xc1\x1f\xc1| w#:\x91ԏ(\xb2\x1a\x91\b\x81\xa3}\x12\x03)\xe4t脒\xc6b\x10r٥\x13\xc6\x03\xd9e4\x16@ָ\xef\x05\xb2;\x002\x05\x9a\x94\x008\fXC\x17\xf8'\f$\xb2\x86 \xb9\r\x19\xa7\x9a\xaeI\x87F\xcc\x1b\xc0\x1f/\b\xa5\x83\xea\x12\xa4qH\xe2\x80ɖ\xce F\xf5\xa3\xc7\x1d\x1f\x18\b\xea\r#\xc5P8\xd2\t{=\x86\xc4-b\x10\n\xdc0\xd2$K\v\x83\x9aP\xc1\xf3\xce0\x9d.,\xc6@\xba m\x01\xcb\xd1\xe7\x8c!\r\x03\xd2\xc5pC\xd1\u009b\x1b\x02#.\xdd8\xf0Ñt\xa1J!\xdd\x18\xf8\a\xc9p\x9f\x95,`\xbc?\x91Zlc\x97(\xc5£z\\H\xadR\xed\xf9\x9es\x01|\xb8\xe8\x86>\x12\xa7x$\xfb\x94\xbb,\x90}\x16\xf2>\x93}\xef\x12\t\x95\x1fv\xa9\x0f<F\xdfg\xc0X\b/Ғ\xa9\x1c0>\x04\x1a\xe4\x8d\x06\xe1H\x0eB\xe7\x82M\xa4\xd6\x18\r\xe2>\xe0\xaf7\xa4C*\x14[\x8e\xea\xc8\xd8\x13\xf21\x85]S>\x0e\x91\xd4=\x8eY?\x8c\xe4\x05\x8d{@\x98\xc0\xef2\xc7?>\x93\x17a4Pܺ\xe3\x01\x91\x120\x1d>\x1dO \x92\xf58\xbd\x90\xbe\x82\x13\x14c\x10\tc\x1dH?\xbe\x8a\xf9D\x0e\xa9\xcb=W\x0e\x15\xcd\x1a\xd2\v\x16\x8f4\xa9\x92C*\x00\xb3P\xaef\xea\xcf\x04\xc8\x16\x8d\x06\xc0\xa6\f\xa1@\x189\xe1X\x0e\xe3\x88\xc5C\xa9\x8f\b\x02\xda\x0f'\x14\xa4\xec\x18\xce\x10\xbc(\xa2\x81\xfa3\xc1\x13\x850\x90A8\xe6\xf1S\x19\xf6z\x9eô\x8eL\x86\xf8\xbe\x85\f9\x05|\t9\xf2y\xa1\x88\xe2\v*G=\xef)\xe3J\x83\x064o\x12y\xa1\x1cy\xb4\xcf\x04rAL\xc8'1\xeb2Gr\xea@o\x91\xfc\x01\x1b\xe4E\f\xc4\xf1\x11\xf5\x80\x16\"IR$P\xf2\x10\x14\xa5\x92\x87}\xa0\v|\x12_Lb\x19\xd1\x1e\x03\x8e\x88^\x00\xf0\x11\r\u009e\x87*\xd6\x105\xad\xb1ϤV\xab#\xf7Ĥ\xdeN\xa2A\x18?\xf6d\x14:\x0e\b\xfa\xa1R\xcd\n\u0380T\x8a\xd8\xf5B\xc5/Md\x14?\x8d/<\x19!\x01\x93\xd1\xc4\x05I@Po\xc4<)hإ\xa1T\x0er\xa5\x00A!\x94\x82\x05\x81\a\x84\x91\x05.\x95\xe2\xf1\x90^]Iq\xa1\xfe\x84\x93PP-\f\x81\xce\xc0\t\x87\xf2ң\x8f\x81\xd3\xf2\x901\xb8\xf4|\x9fB\x88\xf7\xbd@j}\xcae\x88\xab\xea2\x9c\x00\xad\xbe\x8c\x99\x1fF@ba\x15\x8f\x81\xbb\f\x80\xa0:t(\xaf\xbc\x01А\tu`7\x9f\x80\xf6\xe1J>\xa5\xa3Q\x18\xc9w\xff\xf6\xdd\x1f\xfe\xe8\x8b\xef~\xff\xdd?\x7f\xf7\x1d\xf9\xee\x0f\xdf\xfd\xbbw\x7f\xf0\xee;\xef~\xefݿ\x03\xb2\xf9\x8d\xf7\xbf\xfa\xdew\xdf\xfb\xc6\xfb\xbf\x01\x81\xef\xbc\xf7\xf5\xf7\xbf\xf2\xfe\x97\xdf\xff\xaaԤ\xf4;\xef\xfd\xd9\xfb\xbf\x8a\x81\xf7\xbf\xf2\xde\xf7\xdf\xfb\xce{߀\xc0WT\xd6\xf7\xbe)\xdf\xff\"\xf2y\x7f\xf3\xfeo\x00!\xfd\n\x92ؿ\x002+\x9f\xbd\xfd\xadgo\xff\xf0\xd9\xdb\xef<{\xfb\xedgo\x7f\xfb\xd9\x1f\xff\xb6\"\xa5\x7f\x9e)\xae\xfe\xec\xab\xf2\x83/\xff\xce\a_\xfa\xa3\x0f\xbe\xfc\xe5\x0f\xbe\xf47\x1f|\xf9\xeb\x1f|\xf9/$j|%uc_H\xcaD\x00\xa7>=\x90\xfb\x87HUG#\xe0\x069\xf0\x12\x14\xe7\x8bƀk\xf4)\xccr\x97zn\f4\x13T\xcf\xc87\x02\xbb\x1d*ճ\xec\x86\x18\x1fF\xce@\x89`p\x04\x13\"\x0f\xe8F@\x15#\xa0\x86\x8c\x8e\xa4\x03\xe2\x0f\xf0|@\x03\x81\xc4Htv\"\x9d\x90\xe2I\x8d\x8b\x9c\x1a\xcak\x93!\x8f\xa5K\xbb1\x97.rX@\xfb\x80o\xf3\x05\x95n\xe8\xf6\x99t9l\vnܥ\x9ed\xb8o0\x97]P\x89\xfe\xa3$C\xbe\x96\x8d`e\xf7\xa8'\x06\xb2\xc7`\xf2\x80c\xf3\x81\x0f\x83\xdf!\x8f\x87H\xdeB٧@\xab\xfa^O\xc0\xf7\x12~\xfb\xd4e\xf0\x8d\"$s\xa1\xecc\xcd@|\x03\xd9\xe7ވI%\v\xf4c\xc7\xf1\x80Gs\x99\xd2?\x80\xee\xc1\xa5r\x10r\xd4C\xc4\xf0\x9dP!\xa4wA\x83\x10\x8f]|\xe9q/\x1aH\xef\x929\xa1|\xcc\xc4S!/B\xe6\a\xf2b\x12\n\xa0bC$b0z>\xeb\xc3\xf1\f\xbb\x8a#\xe9{\xbe?\x01\xbe\xcae\x12X\x1f\xf8}\xe5\xf9\xd2\x0f\x81\x9f\xf4C\a\xf2\x84B0\xfc\x86ҏG\x1e\xd06g\x82\n\xc1~(\x87\xcc\xf5\xa8\x1czt\b\xcazX\x7f\xc3pD9\xca\\L\x06\x14F)\xc0\xb6\x02\xef\x02ȕ\x17<\x86\xd3\xd0\v\x0f\xbecq)A\xd9Be\x18Q\xa0M\x94{\x11|\x85\xfaN$\xba2Q\xc4J\x8e\xbc\xa7O\xa9\xc4\xeb\xc5r\x84\x1b\xe9\x88\xd3+O*\xeez\xc4A\xc8\x1a\xf1p\x18\x02\x01\x8b\x84\x04v'\x94\x9c\rhWQ/З\x84\x03\xa0V\x17\x91\xe4\xa1\xcbB\xc9\xe3~\x17h\x94\x0f\x9a\x13\xd8\xccd\x04\x9b\x18hQ\xf8HF\xe0\xb2\x11\b\x12pe\x17\x93\x11\x93\x91\x8f2\x1d\xf2O\xd1\x10\xa8y\x04GFR)T#ŧ\t\xeaR\xe4\xde\x18\x12\xac\x89T:\x9f(\x86v\xa3\xb1\x17E\xa8\xb4\xe4Rx \xc7\t\x8f\x87\xbe\x14C\x10\xb4E\b\x9c\xb7\b/&\xa1\x14a\xe8G\x12\x98@\x88\x81\xedY\x80\x04\x82|\x1c\x93\x82Ǒ\x90\"\x0e\xa0\x868\x1a\xc42F\xee\xf7\x92\xf5\x91FA\xef.C\xf7\x82\x82z\xf32\x04\x92\x04<\x1e\x92\xca1\xeb\"\x9f\xe7uC\t,r\x04_ߕJO6\xa1\x830\x94O\xbd\xd1(\x94\xef}\xfd\xff\xfd[Hr~U\x02Å\xec\xdaw\xe4{\xdf\xf8\xaf?D\xfa\xf2M\xf9\xde_\x80\xfc\xa82\xc0\xcf\xef\xa0(\xf9\xfe\xaf\xbd\xff\x15,\xf6k9\"\xf3ǿ\xf9\xec\xed\xb7峷\xbf\xfb\xec\xed/={\xfbw\x9f\xbd\xfd\x87\xcf\xde\xfe\x86|\xf6\xb5?}\xf6\xb5\x1f>\xfbڗ\x9e}\xedϟ}\xed\x87\xf2\xd9;\xdfz\xf6\xce\x0f\x9f\xbd\xf3γw\xde~\xf6\x9dߖ\xcf\xfe\xf2[\xcf\xfe\xf2\x87\xcf\xfe\xf2\x9dg\x7f\xf9\xf6\xb3\xbf\xfc\x92\xfc\xf0\xdf\xfe\xfaO\x7f\xed\xb7>|\xfb\xeb?\xfd\xca\xff\xf4\xe1\xf7\x7fWR\x98(|\x06\x9f\xbaԑ\x14$)\xea\xf5CI/\\\x90]}<\xe3\xbd\x02֭+)\a\xb9\x96\x8f\xa8\xa4\\0I#\x97J\x1ay\x14\xe9\x14\x92)٥]<\x06v%\x0eg\xb7{Ie\x97\x81x\x8aj!\xefB\x11,\xd0b\xc2\a\xd4Ia\x8f\xcan\b%\xc2\xf0Bv\xe3\xa7O\xa5C{xR\x06R\xecp\x84\xac\x1e|@\x88\x8d\x80\xf3\x032\x06\xbb\xbfӅ\x04\xe0\"\x9d\x01\x15H\xce\xe03A!\x16$R\x1f>#\xe9LB a@\xaf\x80\xd1r\x1d\xff\x02\xa9\x18\x101ŶI\xd7c\xa8\x14\x1a\x00\xdf\x16I7\x1cP\tw\xe4\x81\x7f\x8b\xa4{I\xfb\x92\xf1\xc0\x93=اz@|z(5\x822\xb0\arqϣ\xf0qA\x12\xf5\x87\xa8VEY\x1488\x8eGw@\xe4\\в2ً\x03\x17\xe9\x9c\xecw\xbd\xa7(k\xca~\x9f1\xa4z\xb2?\xec\x0eP\xcb\n\x9f\x1ert\xb2\x1f\x03\xa7\x17\xf3X\x0e\x80\x83@\x95\x00\xa8\x8a@M4\x02\xfe\x8e\xc9A_\\\xa2\xd8)\aQב\x9e\x03\x1f\xc6\xe0L\xc2\xed\xe2\xc1\x04(\x8eB\xe9\t\x1a\xcb\xc7\xf4\x92\xcaǌ\x8d\x80\xb9\x8b\xe4\xe3\x11\x8f\xe4\x85\xebz\xf2\xc2\x1b{\xf2b4\xec\xcb\v\x0eb#̥\xcf\xfa\xa1\xf4\xfb]!}\xcfU\xa7\xd3҇\xb9\xf4\xbda\b\x14\x10\x99;\x86\x94O\xfa\xa0y\xf5C\b\n\x97\x02\x83\a\n[\xaf'\x87\f\x9896drȂX\x0e\xf1\x88\x12\xd4\xc8\xc0\xd2\xc9a\xe8R<\xb1\x94\xa0\xf7\x90(c\x06  \xc2y\x88\x04\x86\x02\x88 0i~(\xc3\x11\v\xe4\b\xa6b\x04h1r\x9c1\xb0_ }\x82\xf2\t\x00\x82\x83M\xd0GEr\x14\x0e|9\ny q#\x82SN\xf8\xf4\xe4\x13\xe0\xf29\xf5\\\xe0\xd2\xe0\xe3\t\x14O\xf1\xd4\x04\xd5Ò\x0f!.\f\x87\x92G\x97#\xc9\xe3\x01G\xd6L\xa2\xee \xa2ܗ\x11\xbd\x84_W\xa1\x8c\x9c\x90\xc3G\x00k\x06\x1fv!#v5\x01z8\x06\xc1\x95\xa2b\v>c\x19y\xfe\x05\b\xafT\xa2\xb8\x18]x\x81\x8c\x02\xa7'\xa3p\x10\xcb(\x04B\x19\x06\x13\xa0\x8aH\x149H\xb0=)\xa8\x7f!\x05\x10n\x01,\x1d\x9c\xd9H\xc1.\xa9\x14\x1e\x85\xcf\bh\xde8\x00\x86\f([\x97\x81\xea\x8b\x02\xbb\x05\x9fK\xfc\x00i\x13\f>!\x8a\xb1@˸\x1cÙ\xd5ػ\xf0@DeH\xd9\xe4U\x17(Zا\xf2)\xe5T>\x05\xf2\xf0\x14\xf6\x94w\xff\xf2ݿ\xfaї\xde\xfd\xae\xfc\xd1\x17\x81\xd3\xfaї\x14\x81C\x9a\xf6\x8d\xf7\x7f\xe3\xbdo\xbe\xffe\xf9_\x7f9\x89\xf9\xe6\xfb_}\xffW\xdf\xfb\v\xf9\xde_\xbe\xf7\x8d\xf7\xbf\xfcޟ\xc8\xf7\xbe\x87\xf4\xedK\xf2\xbd\xef\x01\xaf\xa5X\xab\xaf\xbe\xff\xa5\xf7\xbeW`\xab䳯}\v\xc9\xdb;Ͼ\x96\x06\xbe\xae\x02\xdf\xf8ֳo\xfc\xf0\xd97\xdey\xf6\x8d\xb7\xe5\xb3o~\xeb\xd97\x7f\xf8\xec\x9b\xef<\xfb\xe6\xdb\xf2ٟ~\xebٟ\xfe\xf0ٟ\xbe\xf3\xecOߖ\xcf\xfe\xfc[\xcf\xfe\xfc\x87\xcf\xfe\xfc\x9dg\x7f\xfe\xb6|\xf6\xd7\x7f\xf7쯿\xf4\xec\xaf\x7f\xef\xd9\xdf\xfc\v\xf9\xc1\x97\xde\xf9\xe0\xcb\x7f\x8b\xdf\xff$?\xf8ҟ}\xf0\xe5?\xf9\xe0K_\xfb\xe0˿.?\xf8\xf2\xbf\xff\xe0Ko\x7f\xf0\xe5\xbf\xf8\xe0\xcb_\x95\x1f\xbe\xfd\xf5\x1f\xff\xed\xef~\xf4\x9d\xbf\xfb\x87\xbf\xfb\x9a\xfc\xc9\x0f~\xe5'?\xf8\xb5\x8f\xfe\xe0\xfb\x1f\xfdϿ\"\x7f\xfa\xfb?\xf8\x87o\xfc\xc6O~\xff\xab?\xf9\xfa\xbf\x94?\xfdڿ\xfb\xe8\x0f\xff\xf6\xa7\xbf\xf6[\x1f\xfd\xe6\xafKJ)H̒v\xc1V \x94ԍ$e}I{>\x10W\x89\xc7=\xa1\x0f\xac\x1ePRIǑ\xa4W\xa0\xf2\xe3\xb2\xdbud\xb7+d\xd7\xe9ˮ\x03\xe21\x90MWv\xbdP\x02\xa9\xe8\x0e#\xd9\x1d\x8ee7\x00\x95\xfc\x10\x88\xa6\xec\x82\xc2>\xbc\x92\xa8\x16|\n\xc2p\x17\xa8'\x10O \x9b\xa0i\x97N\x97J\xa7\x1bH\xa7\x1bI\x87u\xa5\xc3B\xe9\xf4\xa8tz\xc0\xf4\r\xa5\x03\xd45r\xa4K]\t\x9b\xa8\v\xcarv)݁/]o\"\xdd`$ݰ/\xddPHW\\J\xf7\x92K@r`\x99\x98\x1bK\x16=\x91\f\x8e\x8eh\x00\xaa7\xd9\x03\xd95\fe\x0f\x8e\x8e\xb8/{\x02\x84\xd8@\xf6&\x1e\xb0y\xb2OG\xb2\xef\x82\fK%ʫC`ꮀ\xd8\xc9~8\x92\xfdP\xc8~x)\a\xddP\x0e\xbcK9\xb8\x80\xa3%\xf87\x96^w(A^\xf3\x9cXz\xbd\xa1\xf4\x02Gº\x87e\x0f\xf4\x04\xd4|\x9e\xb8\x94\x8f\x9d\xae|\xec\x8c\xe4c/\x94\x8f}_>\x1e\x8e\xe4\xe3\xe0\xb1|\x1c\n\xf98\x9cȋ\xde@\x02+u\xe1\r\xe5\xc5(\x90\x17\x1c\xe8\x9d\x00\xb1U\xfan$}ߑ~胙\v\xd02`\xda䐎\xe4\xb0K\x81u\x032&\x87\x9e\x0fG\xbar\xe8w\xe5\x10\x8e\xa1\x86@\xc5@L\x05\x926\x04VN\xa2>]\x04r(\xb8\fhW\x06]*\x03\xe6Ȁ\t q2\xe8\xf9\x12\xd8\xc1`p\x01l\x9dD\x937>\x96\x81\x102\x988\x12\xc8c\xd8\xebIXx@\x17\xc2\xc0\x97a\x18\x82\nN\x86B\xc8\xf0r A\xe92\x02!t\xe0ʑ\a\xff\x029\n\x1c tpJ,GqW\x8eƎ|r\xe9\x80N\x1e\xe8\x9c\xe4\x9e/90y\x1eP\xb6@\xf21\x10\xb2\x91\x8ch$\xa3\xae'\xa3n$#\x87\xca\xc8\x01\x1d}$#6\x06z&\xa3\x1e\az\x05L\x9d\x8c\u0089\x8c\x80\n\"\xeb\xe6HA\xbb@\x9e\xa4p<)\xdc\v8I\x96b\xe0J\xf1\x18\x84đ\x14\xfcR\x8aؓ\xe22\x92q7\x92q\x10\xca8\xf4e\x8cFCB^z )\x82|8\x02\x15\x1a\xd0\"9\x06[\x93p,\xc7\u0091cѓW^ \xaf\xae\xae\xe4\xd5\xe4)\xa8\xcd\xe4$\x0e\x80\xe1\x92\xef~\xe7ݿz\xf7\a\xa09\xfb\xe1\xbb\x7f+\xff_\xbf\xff\xee;\xef\xfe\rȆ\xdf\x7f\xf7/QD|\xf7\xef\xe4\xbb?\xfc\xd1/\xbf\xfb\x17\xf2G\xbf\xfc\xa3/\xff\xe8\x8b\xf2G_\xfc\xd1/\xbf\xfb\x1d\xf9\xa3/\xbf\xfb\xfd\x1f\xfd\xb2\xfc\xcf\xef\xfc\xe7?\xfa\xcf\x7f%\xff\xcb\xd7\xff\xcb\xef\xfc\x97\x7f\xafH\xd47\xe4\xfb_z\xefo\xde\xfb\x8e\x04\x91\xf1\xfd\xaf\xc8\xf7\xbf\xf2\xde_\xbd\xf7\x1d\xf9\xec\xed\xdfy\xf6ǿ\xfe\xec\xedo\xcbgo\x7f\xe3\xd9\x1f\xffڳ\xb7\xff\x83|\xf6\xdd_y\xf6\xddo=\xfb\xee\x1f\xc9g\xdf\xfb\x95g\xdf\xfd\xddg\xdf\xfd_\xe4\a_\xfa\xde\a_\xfe\xea\a_\x82_?\x00j\x03\xa4\xe6\x8b\x7f\xff\xc1\x97\xfe\xd5\a_\xfc\xa6\xfc\xf1w\xbf\xf5ѿ\xf9\xb5\x9f\xfcݿ\x94\x1f\xbe\xfd'?\xfe\xee\x0f>\xfa\x9d\x7f'?\xfa\xea\xbf\xfc\xc9W\xbf\xf3\xe3\xbf\xfbC\xf9ѿy\xe7\xc3\xdf\xf8\xc3\x0f\xff\xfd\x1fɏ\xfe\xe6\x8f~\xfa'\x7f\xfa\xd3\x7f\xfdG\xf2\x1f\xfe\xec\xfb\x1f\xfe\x87\xef\xff\xf8\xfb\xbf'\x7f\xfa\x1f\xff\xe7\x0f\xbf\xfa'\x1f\xfd\xd5\xd7$pm\xae\xa4\xa0\x95\x93\xb4/\xa9'\xa9/A\x9a\f%\x9c9sI#I\x85\xa4\xb1\xa4cI\xaf$}*\xbbTv\xbb\xb2\xeb\xca.\x93ݞ\xec\xf6ew \xbb\x9e\xec>\x96ݡ\xec\x06\xb2\v\x87{\xb2\x1bI D\x97\xb2;\x96\xc0\xd5\x01_&\x1dG:\xaetzҁ\x03h\xe9x\x12eG\xe9\f\xa5\x03\x16M\xd2\xe1҉\xa5s)\x9d\xb1t\xae\xa43\x91\xceS\xe92\xe9>\x96\xee\x85t\x87\xd2\r\xa5\xfbT2G2&\x19pU\x92E\x92\t\xc9b\xd9\xf3d\xef\xb1\xec]\xc8\xdeP\xf6B\xd9\xe3\xb2Oe\xbf+\xfb\xae\x04\xee\xa7'\xfb}\xd9\x1fȾ'\xfb\xbe\xec\x0fe?\x90\xfd\x91\xec?\x91}.\xfb\x91\xec\vُe\x7f,\xfb\x139\xb8\x90\x83\xa1\x1c\x04r\xc0\xe5@\xc8A,=WzLz\xbe\xf4\x80bH/\x94\xde\x13\xe9q\xe9E\x124]L>\x1e\xcaǡ|<\x92\x17L^\xf4\xe5\x05\x10\ay1\x94\x17\x81\xbc\x18\xc9\v./\xc6\xf2b\"/\x9eJ\x9fJ\xbf+\x81:xҿ\x90>\x97`y%\xa4\x1fK\xffR\x82v\x8aʡ#\x87@\"\xe4\xb0/\x87\x039\xbc\x90C_\x0e\x87r\b\xda*9\x1c\xc9\xe1\x139\xe4r\x18ɡ\x90\xc3X\x0e/\xe5p,\x87Wr8\x91ç2\xa02\x00\n!\x83\x9e\f\xfa2\xf0d\xe0\xcb \x94\xc1H\x06\\\x06\xb1\f\x9e\xcap(GT\x8e\x98\x1c\xf5\xe4\xa8/G\x039\x02\xbeG\x8e\x86r\x04\xb6!\x12\xd4\xf1B\x8e\xc6r4\x91O\xa8\xe4L\xf2P\xf2H\xf2X\U000b1328\x8c\xba2rd\xe4ʈ\xc9\bN\xdbd\xe4\xc9豌.d\xe4\xcbh(\xa3@F\xa1\x8c\xb8\x8c\x84\x8cb\x19]\xca\xe8JF\x13\x19=\x95\xb0\xd8])zR\xf4\xa5\x18H\xf1X\x8a\v)@\x14\x93\x02\xf8\x0f)\xb8\x14B\x8aK)\xc6R<\x951\x95q_\xc6\x172\x8ed<\x91\xf1S\t܈#/\x99\xbc\x845//\x03y\x19\xcbqO\x8e#9ar\"\xe4S*\x9f\x0e\xe5ӱ\xfc\xdf\xfe\xea\x7f\xfb\x01,\uffd0\xef\xfeՏ\xfe9,\xdf_\x91\xff\xfb\x97\xff\xa7\xff\xfd\xcb\xffZ\xfe\x1f\xbf\xf97\xffǯ\xfc\r\xfc\xf9o?\xf8K\xf9߾\xf4\xb7\xff\xedW\xbe#\xff\x7f\xbf\xf3\a\xff߿\xfa\x96\xfc\xe0K\x7f\xf1\xc1\x97\xffP\xfe\xf8\xbb\xff\xe6'\xbf\xf3[\xb0\xde~\xfc\xf7\x7f\x04\x7f>\xfc\xfd\xbf\xc3?\x7f\xf0\x9b\xf2\xc7\x7f\xfb\xc5\x1f\x7f\xf7\xf7\xe4\x8f\xff\xee\xf7?\xfc\xcew\xe4\x8f\xff\xfe\x8f>\xfa\xe2\x9f\xc9\x0f\xbf\xf8\xc7\x1f~\xffo\xe4\x87_\xf9O\x1f\xfe\xf6\xd7\xe4\x87_\xf9\xd3\x0f\xff\xc5w\xe1\xcfO~\xff7\xe4\x87\xff❏\xbe\xf7C\xf8\xf3\x93/\xfeG\xf9\xe1\xef\xfc\xea\x87\xff\xe1\x9f\xe3\x9f\xef\xff.\xfc\xf9\xe8\x0f\x7fM\vY\xf2\xc3?\xf8\xc6O\xbe\xff\xf7\xc0A|\xf4\x9b\x7f/?\xfc\xc6w~\xfc\xbd\xffQ~\xf8\xed\xbf\xfe\xe9\xef\xff@~\xf87\x7f\xfc\x0f\x7f\xf4[\xf2\xc3\xef\xfd\xfd\x8f\xbf\xfb\a\xf2\xc3\x1f~\xfb\xc3\xdf\xfe=\xf9\xd1W\xbe\xfa\xe1\xbf\xf9\x15\xf9ѯ\xff\xe6G\x7f\xf0}\xf9ѯ\xff\xe6?\xfc\xd17\xe4G\xbf\xf9\xfb\xff\xf0\xc5\x7f+?\xfa\xd7\x7f\xff\xe1o\xfc\x11\xfe\xf9\xfe\x1f\x00q\xf8\xe9\xef\xfe@~\xf4\xbb\x7f\xfd\xe1;\xbf'?\xfa\xfd\xef\xfe\xe4;\xbf-5_\xf2\xd1w\xbf\xfb\xd1W\xff\x85\xfc\xe8\x87\x7f\xf1\xd3\x7f\xf3\xcb\xf2'_\xfa\xdeO\xfe\xe07\xe5O\xfe\xc5\x1f~\xf8\xed\x7f/\x7f\xf2\xf5\x1f|\xf8\x1bߐ?\xf9\xbb\x7f\xf9\xe1\xbf\xffe\xfc\xf3\xfd߅??\xf9O\xff\x0e\xff\xfc\xe0\x0f\xe4?|\xf1_\xff\xf4\x97\x7fO\xfe\xc3;\x7f\xf3ѷ~K\xfe\xc3_~\xeb'\xbf\xfe'\xf2\xa7\xbf\xfc{\xff\xf0\xe7\x7f\"\x7f\xfa\xfb\xbf\xfa\xe1\xef\xff/\xf2\xa7\xff\xf1?|\xf8\xaf\xbe(\x7f\xfa\xf6\xff\xf8\xe1?\xff\n\xb2>\xdf\xfd3k\x9d\xec\xd9\xf3\x16\xe2+\x9fz\xe9\xe7\xca/\x7fzu\xdd\xde\xfd\xc2\xf9\xa3k9\xfd\x1fД\xfc\xa8\x90\xf5\v\xc6\xea\xebɥJ\xe3%\xc3\"O\x97\xf9\x19M\xbc\xb1\x81\xb3\xb2\x15|o\x8d-\xf6\xfe^\xf4<\xaa\x9e{\xf2\xefQ.\xf6\a\x94+\xef\x9b{D\xf9\xabt\xc4\x03\xdfUQGD\xdc\xe0}\x12D\r徐-y\xc6ў\U0005f5b8͝o=yޱ\b\x00\t\xed\x933B\xd37b\x89\x87\xe3\xf4\x90\xf8\xf6\xf5P=\xc4\xd66<\x83xm\xc37\x88\xdf6\x84AD\xdb\b\r\x12\xb6\x8d\xb61%\xb1]%\x8e]%#\xdbۉ_\xa1;ɻ-\xa9s\xbc\xd8J\\\x87;\xca\xf1r\xa9\xdav͞\xb5\xd3\xe5\x8c^\xa0[\xe4R\xad\xdd7\xb3\x02k5\x8b\x14\xd3\xeb\xed˙\x12\x8d\xf6d&f\xa3ݝ\x89\xd9l\x8fgb\xb6ڇ31\xcd\xf6U\x16\x938Y\x8eLǚƫ\xab\xc9\xcctི\xec\xb5\x04\x17f\xc0\x18\xa2\v\xc0\xdds\xb3f\xb5yE\xb0H\x98\xcc*\x97\xcf\xcd\xdc\x03\xba}\xf5\xae\x82\xd1Vyu6a횎]'#5\xd4\xe6\xc0\x1c\x98\xd7S2\xb2\xc8\xf5\x80Fo\xa0\x1f}\xe5\xd7\x10\x1fU\xb6\xac\xf6\xb1i\xb5}x\xbfն\x85\x94fV\x8fc\xd7\xdbF\x05j\x17\xbb\x8e\xddh\x1b?\x9f\xfcހB9P.\x11\xe8\x8a\x02$\xcb\xca0k\n\xbf\x94\xc7y\xf8'\xb9BR&%\x00\x9a\xb4\x04\x82P(ԅB\a\xb9\xf4\xcdb\xfa\xb8\bI\xb3m\xac%\xbf\xb7\xda\a\x19$\x9d|\xa1C,\xb4\x96@\xa2KwL\xab]l\xa9P\xe8\xaa\b\xfeڢB\xa6co.\x99\x89\x03\xb4\v8\bE2\r\x85\xca\xcf\x17\xbc\xc3X\x87\xd7^XZݵ\xe7^\xb5\xe3i\xae\xd01<!\xaeVK\x16\xd9Q\xefN\x8e*\xf963\xf7\xc6ʗ\xe4\xa8\xe2\xb9W$\xb6v\xd6O\xd6*g/\xad\xab\x0e\xe0\xd3\x1e\xf8\xeeV\xce\xe1\xa4~wgT\x99\xc1\xa6\xdd$[\xfaVC\xf2RO\x9b\xef\x98\xf3\x0e(\xcd\xe4\x95W\xa3bX\x95Q82-x\xd9Ѫ\x88\xf0u\xb0hاQ\xce!w\x90 \xe5\xd42i\xf6\x8c-\x8c\xc4м\xce(T[\x90\x9c\x9f\xe76'\xea9\x97\xb6\xea \xea\xef\xdbtjYS@\x1at)9\xb6\xc8â\x7f\xcc%\xaf_z\ue56dҀRCp\x17\xbf\xed5\xfd\x94\xe5̀\xd8++l6.͘\xceD\x92+\x8d\x98\x92'K\x9e\xf4\xb1\xae\xb3\at\xbc\xe8\xb3\xf0\\\xfd\xc2waVLQ.\xabwFu\xb6\x87\xdcSNX\xcdću\xea'\xf8 dћ\xa1x\x8d\xe2;\xef\xe8\xc4\xf4.?\b\x05V\xb8<oG\xbc\xceh$\xee\x06쭐\xbb\xb0\r`\x81\x99v\xef\x8c:\xae\xcb\x19>c\xa9\xebB\xf3\x1a/\x88ވ}\xe1\x8d|v\x10\nH\xc5W\x12gK-p[\x9a\xdbf\x93\xa1<\x8a}?s\xdc\xdagW\xc9֫ \x19\xe5\xe32|\xd2\x1b\xae~\xf1M`\xfb\x8b`[\x00\x03ۙy\rc\x1e\x82\x94`\x9b\"}\xcdX={\xa8\x9e\xa1K\xa2 \xa2z\x96\xbd\xf8[\xa9\x18֫k\xb5\xdc`\xa4S\xb7\b\x90\x04z5I:#B\x80\x1e\xee\xcbe\x01\xb5/ZP\x06\xe8K#\x87{#\xd16Vl\x9b\x97\xcb\xc6e7\x1f\x010<\x0fI\x96\xa0\xdf\n\x93R\x94\xcb\xcf\x19\x1e@/|\t\x99\xe5\xfaoX֒\x86\xe71\xee\x86\xd6W\x00\x15͕\xe7B\x90\xe0\xeb\x80FI\xad\x9d\x9e`|\xe1l\"d\x8bj\xb3\u05ff\x90\xf8\x91=Y\xab\xac\xaa\x9fյ\xd6٧\xd5ˍ\x84\xcd\xcc\xce\r\x05\xd6\t\xbb\x19\x9a\x02\xa7\xd9>\xf9\xc2\xe9it\xf6\xe9\xdd\x13cu\x1f\xef/\x12\x96\xa0\xbc\xbd~\x02U\xe2gW}O+\x1f3\xcal\xe3\xdfO[\xbb\xa7\xeb\xbb/\xad\xabW\xa9>k\x9bn\x91E^\xdf\xfdT\xe1\x12\xe8j\xf9\xe7?\xb5\xfes\xf6\xffpnZ\xf2\xe5\x97>}zzrzzv=\xdd]i\x93\xca\xce\x17\xfe?\x7f\xf0\xaf\xce>\xfd\xbc\xbc\x90\xc9\xda-\xde\xd6<\xc1\xfb\xa0\xc40\r\x02W5\x17\x0f%\xdc?mL\xdb\xe6\xeeʍ\xe90A\x96\xb9\xbbrꮞ\xaeÛ\x8f*f\xd7J\xafľa\xd6-bX\x061\xa4j\xd30\xd7\u05ed]նr\x8a\x9f˻\xb58\xef\x1bf\xadjA?+\x06Id\x03b\x00pk\xc6\xea\x1d\xb8!k\xa8r\xf0\xcf\xdcm\xab\xf1^\xb5vU\xd0XuS\x81\xc2\xda\xcd=<M\x8c\xbe\a\x97V_\xb2\x17\xdef%o}\xd2'a\xfe\x11\xde#P\x1b\xb4\x96Z>\v/\x0fhdW\xe0\xdb/\xfd_\xd0S\xff\xf3e\xa5D6\xca\xf7./\x15%\xf0\x87\xf6\x12\xd7\xec\x84\xda\xcb}\xaf\x13oN\x16\x8b@\xb6\x9a\xf3\xe7\xcfm\xa0\xf9ķ\x05<\x9a\xea\xd8\xe2d㌌lq\xb2yFz\xb68i\x9d\x11\x18.\xa4\xb8ĵGR\xf6\xc80\x93\xa7\xc0\xcb8><\xfc$ـLN|+y\xaa\x1cvH/\x88\x19>O9\x80\xa7\x16\x15\xdb>\\\x9e\xc1-\x97\xe3\x99\x19V\x04u\xb8\xb8\xd6\xf5\xd3\xddy\xfe3y\x8d\x91g\xbe\xd0-\x12\xab\xb1~\x8dF\x0f\x83.\x05K-\xe6\xee\xfb!\x18Pޣ\x9c\x05&\xb7\xace\xa5w\x98\x1f1\x1c\xb0\xbe\x9d\xd5s'\xb8\x84.\xa7\x84\xf7\x81\xaf\xba\xbf\xd3\x7fu\xad6\vK\xdfR\xcf\xcc]\xda'\xe9\xdb\x06$}p\xc78\xab\xf4\xbc\xc05\xcd\xf9\xdb\xec++~\xb9\x8c;\x9f\x9f\xee|̚Z8\xf0\x97j\x1a'6O\xd3.\xad\x9d\\\xc3\x13\x8b\xf8\xb6\x9f\v\rV\xed\t\x022\xb6\xfd\xdd\xe4푶\xb3k\x8c\xc7c\xa3m\b\xdf5ȡ\xbd\xb2\xe2\xefD\x19\xd3\xdc-0\xcd\xdeb\xa6y@\xf2\xafI\xb4\xc7\x10ls2\xfb\xfeC\xfb\x90,|\x14\xa1\xbd\xb2\xe2\x92\x1c\xea\xb7\x032\x8f\xf5\xed\x90,Dxť\x93\x18\xd1~G\xb3k\xa6\xb0y\x85]1\av\xe3\x1d\xcb\xcf䃨\xe8\x05\xfffĘg\xa5\b_\xe8o\x1f&İ\x00\xc1\xb9%l\xc34\x10mJ\x10{\x96ƞ\xa8X\x10\xb2\x8c)\xb2N\x1a\xafWj;\xc26\xae\x8diB#\x02\xbb\xaa_&\xcf\xdaH\xdf&G@\xbc\f\x8c\xd0\xda\xf1P\xda\x0eVW\xdb\xf0\v\xde\x7f\x0fԃ\xd5Cze\x06k5R\xb5\x92\xd7\xcaQ`\t\x16\x0f\xc2\x02\xac\x9ea\x9d`\xc13\r\xf5ZM?/^\xdd\x11\x88\xf29\xf6\xac\x8d\xef\x10'B#\xd7\xc2`PT~\x99fe\x176\xbd\x93\xb5\x8aچ>}\xb2\xa6~\x9c\x9eV\x92\x9f\xab\x96a%3\x99\xe7\xc9\xf1\x01ٵZ\xdb\xe4\xab\xf8ds\xe1QTl5ȿ\xb1\xbc\xfe\x85\x93/\xacU\xd2M\xbd}\n\x1c\xc8z*~\xf3\xf6Z\xcdJ\xa4\xbc\xd7\n`~\xea\xe4\\Ar]#\xb5Fk\n[\xb1\x8e\x81-\xb8oX\xe43\xc5=\xf5\v\xe9\xa6\xfa\x8b\xffX\xef\xac\xe57\xc6\xd7\xe0Y\xa80x+\xa3\x9c\xec\xca\xfe\xcc\xc7~l\xed\x93m`\xb3\x80\xa4\xbbX\xaeu:\xb7Cy\xf6\xc9ْ\xa5{\xad\x1fC\xd7\x1b\x91\x9f\xe1}\xa4\xd7\x1d t$\xa5\x16\xfc}+y\xaa\x1b\xb77\a\xffh\x8c\x80\xfc\x19e\xeb\x17(\x1b-P\xb68\xa1l\x11\xc9A\xde\x0e\x89~H\xaf\xed\x00\xd1IF\xd1K0\xe7\x17\xf2\x88\x00\\\x9f\xfe\xfft\xd5\xda=u\xd1\r\xca\xc9\xdaiu\xa3Z9۵vOM\x88lLO\xad\xdd4\x16#\U000a1369%u\x15\xe6n\xbbu\xd2jn\x9d\x9d\xbar\xfb\xa4\xb5\xdd\xdclT\xe1\xf7\x16\xfc\x86\x1f\x9b'-\x8cب\x9f\xba\xb2q\xb2\xdd܄P\xfd\xa4\xb5\xbd\xb5\xb9Ѩ\xd7 \xd4:\xd9\xc6\xdf\xd53\xb9}\xb2\xb5Q\xaf\x9dɭ\x93\xad$j\xf3d\xbb\xa9\xf3ʍ\x93\x96\n@B㤵\xa5\xf3\xd4O\x9a\xd53ٔ5+\x85\x13\x98a7\xeb\xd9\xf5\x16\xa9է\xa7\xee\xaae\x99'd\xe7lU\xf1\xa8\x9fڵ>\x9d\xfa\x104\xa4\xb1\xbanV͓\x1a\xb0ص\xe9\xda.\xfe\xc2\xfeK\x15Y\x9f\xae\xed\x16\xc30\x86*\xb2^\x88\xd49kSkM\x8d\x99\xac\x9e4\xb7[gU\x1dLc7gb2\x86\x1c\x17\xf2\xe7\x7f\xe6\xea\xf7\xc2J\xfd\x05\"\xfe\xd1\x16^n5\x85\xcfYMT-\rϦٳ+'_\xa8\xae\xb5\xc8Χ\xce\xe0-$\xc3\"\x91\xbdb\xae\x00g\b\x82\xefI\xfd\fx\x89\xaa\x9d,\xc3]\xc3h\xa7\x0fc븵\x1a\xa9Y$\xb6g\xe3WiB\x9ak\xa0\x96\\\xf1\x93\xb7\xf3O\xddu\x10\xab\xe3|xG\xf5\x85E\xea]%S\x9c4άry&\x16\xf4zNA\xb57)\xac堰\x96i\xb2\x96\x13\x1a\xa2\x1e\x86k{$y7\xae\x1dM\xb3\xfd1,\xee\x8di\xab\x8b\x1e\xf79O6\x90\x84\x00|\xae\xb0\x13\xfc|n\xebج.\xda9\xfe\xd9l\xfeJZ\xa0\xb1\xb0\xc0/\xcd\x14X;\xaf\xcc6\xb16S\x84\xb1eۓ`\xff\x04\xfb\x13\x8b\xeck\x9d\xd4\xfe\x1cIߦl\xff3\x92=5\xd9\xfe\xa5\xe9\xa2M\x8c\xb1\x9f\xfd.VXH\xf9ڂ\xf9\xb5Ţ\x93\xd9lgɆ6\xb7\xd1-\xd9Հc\n\x92\xa7\xec\xbd\x1dX˹\xb5\x19|\x9c\x9d.\\\xb4\xd3eK\xf9\xb4\xb2\xfa\x92^Ŏ\x1d/\xdc\xfa._|\xeb\xe3D?\xb7\xb9x\xebˎ\xa58\x9by\x0e\x1cF\xb6\x12\x06wG,x@\xfb0~\x950\x00.\x9bA\x90b\x10Z&\x9eJ\t\x87\xd0\x12\b\xa6\x9508\b\x1d\x98O\x12#\x0e\aL\x1f\xf2\xa5\xe7\x84=\xbbJ\\\xbbJ\x86v\xbc\xe3\xbc2ң\xd8\xcf\xc6\xceI\xcf\xfdz\xe9\xb9ߥٟ9\xf7\x9b\xcc\xc4\xd4\xdb㙘F\xbb;\x13\xb3\xd1>\x9f\x89\xd9l\xef\xcf\xc4l\xb5\xef\xce\xc44ۏgb\xb6\xdbwfbZ\xed7g!\xac\xb6\x1f\xccF\xd5\xda\xf7f\xa3\xea\xed\xfb\xb3Q\x8d\xf6\x1b\xb3Q\x1b\xed\x83٨\xcd\xf6\xeb\xb3Q[\xed\xbd٨f\xfbh6j\xbb\xfdt6\xaa\xd5~8;\x9a\xd5\xf6\x13\xb3?\x7f\xbcٳ\xa6\xce\xea\xea\xec\xe1\xe0+\x86:Ryi\xfe\bpE\x1d\x9d\xf5\xecZ\xa3\xad\x1f?\xdb5{v\x9d\f5z$GgC\x8b\\{\x91\x16\xe5\x92s3]\xf3\xeeK\xa6\xd5>\xccN\xdezvcIy@\xd9\\y\xb3\xa7\x10m\xf6\x90\xf18\xabkQ=`\xd5\xdb~\xcd\x04\xb9\x9d\xf4\xec\x8d\"\x1ci/\x9e_\xb2V\xb7\xdaƫ\xb7\xcc\xfe\x96i\xe5:)\xe5U\xf6S\x1f\x03K\xf9\xd9\xf9\xe3P]\xfdg\v#Գ\x1b\xed\xcfΝ9\x1eg5\x9aI/\x00\xc8\x14Ʒ̙\xaeں\xddNV\xf2\"m\x04\x9a\xecٛ\xb9V\xf6\x8bc۳\xb7\xda\xc5v\xec$\xd0\\\xdaf';`)\xf4\xe0\xee\xcd=x\x91\x9a\x17@\xfex\xb6\xf6\x97\x8d\x97u\x85\xdbm\xe3\xe5\xa4\xf2V{\xfd\xe4U\xfb\xd1\xd9z\xa1\xaa|#=\xbbV\xcd\xd5{\a\xea\xd5u\x95\xcbfϮ\xd5r\xa9o\xe2\f\xbel,N}0;\x9a\x1b\x8b\xba6\xb3\xea\xee-(t\x9b\xa96{\xf6\x06q\xd6\xd6rU\xdd\xcf\xe3\x97y\x8b\xf5\xaaP\xb8godu\xbc\x01|\x89\xb1\xa6\x0e\xd0\x136\xd3!u8>_]B\x05`\xf7h\x1b\x8e\xdaT\f\xbd\x92`\x11\x1e\xdc\xdd\x7f\xf0\xb9{\x873u5\xe1X\xf9\xe1h\x94\x9c\x82a\xcd\xcd\x1bjv\xd5\x06\xa5k\xaeW\xad\xe2R9\xc8\x19\f\xc0\x98m\xb6\xf3k\xacg\u05f6\xb2\xbc\xaf\xcf\xe4ݾ!\xef^.\xaf\x9a\xecf\xae\xd5#H\xed\xd9I]\xb5\xedv\xbe\xec\xd3\xfcT\xe0\xd4et\xb5\xd5N\xad\x1b\xa0ҭ\\\xa5\x0fg\xc0+\xae\x8d\"xO\xe6\x9aX\x88_\x9f\x85skET\xb3ȗ0\xb2\x96\x8d9\xda/8y\xfb\x85\xb7r/y+\xfe\xc6%C8b\xb7@\x15EMA\\\x8b\xa4\x93\x0ej\xe7\nLҮg\xaa\\ٴei~\x92\x06\x7f\x13\xd2_.\a\xe6\xb0\x02\x84U\xd7\x0f\x7f\x12<-\x97\xc3b\xa2E>kZĵ\x9d\xd5Z\x06\xebk)\xac\x98i\xd5\xccհ[o\xd7R\xadV\xd2\x15A\x9c\x19ۆ\xa9\xfb\x8a\xa35lIo\x1d\x8bP\x93\x13W7\xa7\x14\xcc\x01\xfb\xd9\xd8'\xc0x\xd8\f\xffHi\b\xda7T<t\xd5f\xf8\a,0tm\xc9X\xa1\x81B\x1aJ\x12uOu\xa2\x0eMwЛb)dK,\x18D\x0ez\x81\x87\xe3)\xf4\xe8\x93+\fl\x96\xfc\"\x89\xe9Ad_OU\x00\xcd7\xe0\f\tCx\x190\ri\r\x0e\xbc3\x9d\x7fE>\r\al\xfc\x16z,HK<\xe7hk\xc9)MZ|\xe9\x01\x17\xa6\n\x1e\a\x0e\x15̾V\x1cm\xbbJ\xc0h\x0e9m\x83\x05\xae\xa1\x1bp\xe0\x0e\xa8\x12\xb4\xf4\xc0k\x86\xff(Ƚ`\n\a$\xf0\\\xb1\x1a<\r\x1a\r<\xe1=e\xaf\x89\xa1\x9f\xf5Z\x899\xd1\xe2\xd7O\xb3H\x1cV-\xf0\xf0!\xf5\xbd\xa7\xec!\xf7\xa3\xfd^\xdf\xc4\x17\xb8#+?\xe2F7\f}F\x03ö\x01y\xc2^I\xa8\x94]\xfd\xb7\x9de\xce\xcf͢r\x98\xb2\xab\xff\xb6\xb3\xcc\xc5Y\x14\xc9/m5\xa2Cũ\x15\xc9/\x9dI\x87f\xe7{\x11\x14i\xean\xeew\xbbXp\x1eK\x8a\x03v\x9c\xa5\xa8q\xcbe]\xfa\xfe\xf2Bh\xe6\xb3\xed.\x8al/\xa9\xf3&|\\\xd4\xdc\u009c\xbbK\xe2\xdb\xcb+_\x80\x87\xa2\x10\x94r%9L\xc8O\x10\n\xcf5mt\x92\xa8\x17t0U$$\x11\x996\x01c,1\xe0!l\x1f\xe3\xd2!\xe7!7\rO\x9dn\x94\x1e\xe9\xda\x1f\x95\x9c^\xbf\xb4V\x8a\x18+\xc1\xd5G#9\xa4\xc8#R\nBP\x04\x01\x82=\xea0\xb8\xba\x99\x84\v \x057\x00\xa0\xeb^\x00@\x91$\x14\xd1聎V8\x94d\xb2fɃ\xc8~'\x96TIx\x96n\x88\xec\xb7Κ\x86\x8b\xe4D$\xbfT\xb6̺\fn@f$\\\x10\x9e\xaaƠ\xdfp\xf2\x83Y\xb4\xd9\x14*e\x9e\x93\x1d\xf3$\xf9Su\xce,\xf1Y\xa4\x8cS\xa7Bj\xaf\x83\a\xe2\xe71\x9a\xedj\xf2\xad\x15Cm\x06D<\v\b\xdfM\x03\xd3\xf6L\u07b4\xba\x95\xb4\xbaJ!\x87\x943\x11\xf9\xca\x17\x95Β\xa5̇\xf2p,*\x97%K\x99\x0fM\x97\x8cY\x91\xfe|¡Sc\x96\x1b\x9f\x1b\x06&\x1b\x11,\xb3d\ft\xe7\x97A\x9fC\xfb\x05\xa0\x1bJ\x99[\x00To\xa4lv#m\x17\xcf2S\x05U\xc9\vJ\xc2\u009d\xe4\xee8\xb8\xa7\x1d\xb8\xa1QA\xc6B\x9d\xf03\x18\x9e\x13~f\x8b\x13~\x96qmS\x93Iy=%I\xb3o\"@\x95{w\x8f\xef<\xb8\xf3\xd9\xc3\xf3;o\x1e\xddy\xf3\u0383\xcf\xcdB3\x83\xe13k#5\xddC\xcd+\xb7O\xc0\x06\xde@\x87\x00\xf0\x17\xcd\xef\x8c3\xa2\x0e\x8aO\xce\x12hP\xf9v\x9d*\xdbڅ\x01\xcb\xd9\x10\xbcjW\xcb\xe5`uuJ\x94\xee-?4\x1c\x8fy\xa1\xd3Ar^0\x7f\b,*\xca\xeb\xce<\x95{\x84\x16\x8b\xa8\x13}T\x1aƑ(\r\xe8%+\x89\x01+\xbd\xdc\x7f\xb9\xd4\xf3i\xbf\x141aX;y\r\xe1\t(V\xab;\xdc\x16\xe9a\xaf\x15(\x85e\"\x9dyA\xdf\f\x89\xee\x85e\x11\x9d\xccO\xaag\x16\t\x133\x88U\bk\xed`f\x13<_\x11T05\x19Y7\xcbA7\x1a\xed\xc8\xf2\xa7j[\xd5\x1d\t\xef\xd1\xcb\xf2\xa7\xf0g_\xfd\xac\xefH\xf5\xe2\xbd,\x7f\xaa\xb1\x81\xdf֎\xb5\xde\xf7,\xe2\xd9|\x87Vz!?\xa4\xce\xc04\xe7F\x91\xff\\ݶ\xabj\x18\x81\xde\xe2,À\x9b\x8cx֎:\xc1\xd0'I!\t\xac\xa9\xb7\x9a\xea6Q\xcdJ2U\xe9͓\xb9\xe8ğ\xa4\xda\xd4B\xd9)I\xf5\xaa\xc5\xf8\xa9\x95h\xb7\xd1ǝ#4a1\xc34\x81\xb3ax\xc9\x1e\x06c\x1a\b\xe6f\xe9Ed.\x16/`5\xabD!\x17\xa6\xb9\xc8$\xb3\xc4*}&\xee\xa2\xfaٴ\xd6D>4\xb52\x84\x11`r\xf0J\xce4B$f\x11\xdcf'\x02\x96\x05ϗE\xec\xe83\xf1F\xa6\xe66-]\x9aP;X\ra\x9b\x17\xab\xb5\xb4N\x9c<v\"Vkg\xf9\x8a\xb25\xe1\xd9Y\xea\xa2j_\rwE[\xac\xd6v\x94Q\xaf\xc3L\x8fԬ\x9dĀi:_\xf7+\xb4\\N3\x8bU8MK\xb5\xec\xac8\xbc\v'a\x11]\xcfs2Rz&#\v\xac\x8c\f\x9dAiB\xfaL\x80\r\x8f\xd2\"f\xdc\xfa\xf2Ҙ\xbc\xac\xac\xf2ò\xb4,&/+\x9b\xf2\xec\xcbJ\xeb\f\xcbʃt2\xbbC/\xab*\xe6\xb3]\x00\x8e\x12˦\xf1\x0fs&N\xb3\xcd\xe4\xb7\xf2\x17j\x03L\xafn\xd3@~\xcf\x7f\xa1\x06\xc0\xaaky\x03l\xc1&\x04x<C\xf1gd\xf1\xaaE\x04\xdc\x00\xaaf$\\Q\x87t1\xf0ȴR\xaaN\xa8\x9d\x18\xd4eFLII\xcf\xe6'\xe1Y\xe1\xbc\xcedp\xfe\\%\xbe\xed%ŢW\xfc\x9dhu\xd5\xf2N\xa2\xb3J\x94.\x1a\xb1\x8a\x11\xb9Ud\xed\x04y\x9a\x1a\x10/=\xd8\r\x8a}-\xb2\xae\xach\xdbd\x18;s2\vrG,;g{\x05\x0e\xd9`\xbb0\xac,\xf2U\x8c\xecCd\x9e^\xa9ŀ\xac-\x03\x8b\xfb\x93d\xeb\xae\xe2\x01\xd8\xecਁ\x11'\xe1\xd9\x0e\x9f\u07fb\x02\xe2\x15\xbal\x11\x9dI\x91nΨPCy\x1f\xfb\xf2Y\xea\x9b\x1elwv\xa1ت\xb7\x8cvM\xd3\xf3\xdd\xf9\xa6\xb1\xb1Ħx\x86\xe8/hx\x9e\xa1)\\\x89H\xc5\rD\xabbTv\xf0\x9c\b\x1f\x84Y\x161\x14$91uW\xb4Wjh\x00\xc7\xe6z\xd4\x16%\x94\xca\x02\a\xb2\x82\x1aB\x84\x9d\xc0\x19\x84\xfcXu\xc8j\xb3\n:\f{@\xfb\xa65\x97Z\xeca\x0e\xbd\xf3z+\xaf\xa7\xc0Lt*V\xbe\x8bI$ʖ,]&\x0fRe\x8b\t\x8b\xe9\x04\x18\xa8_,\x9cʲ\xc2\xf1k\x9e\x92O\x95\x8d\xfb\xd3bv\x1d\xfb\xf9\x85\xb1\x82=\xafnMLu\xfe\xb7f\xb3\xe7\x8c3\xe7\f\x95_@\x01\U00062683\xa9u\xb6\xb3h4m173و\xceZ:\xb3ٓ\xf9\xa4JPr\xcf%\xa2\xd696\xaf\x97k{\xb4@\xd2.\b\xeb$\x95\xb2\xdbE\xa1;%\xb3\x89\xe2\xd2hTj\x1b\x95\x86AXE![\x0e\xf2\x98\xb0\nК\a\xb4o\xfb\x84%\xfd\xb5\xaf\x0fQ{\xf6\x94\xbc\xa6\xed\xcf~\x91hLl\x8f\xc9\x1b\xfa`^0r\x0f\x95e\x9f'\x0f\xb9\xdf~k\x9aT\x90\x14\x1f\xa6\xc5\xfb\xaax\xdbI\v_\xea\xb2\x13,\u06dd\xe2\xcd\ru\x85\x8f\xd9\xeb \xa6\xfd\xbc<U\xb7T\xb2\xb3~\x8fe\xdb\xcf\xfa\x17^9]\xa7\xa7ѧ_]\xf7R\xb3\x984g\xc4\xd2\xc989#\xeazV\xc8\xcc\xeb<b\xad\xd4\xd0nx\xa5\xaa\xaf\xdf\xc1\x1d\x80\x84\x1ad\x8c\xaa\xb0\xae\xf5\x81\xbe\xc86:u\xb4\x8f;`\x9b)\xb2u\r$\xa3\x9dX`!=\xc0\xea\x85\xde\ta\x03\xcc\x1dRk>\xe6y\xa5\xd3;\x8b\xabX\x0f\x8e\xab\x99\xa3\xff_\xd0\xe9\xeb\x1e\x18`L\x93\xcdg\xa56\x9d&\"\xe35\xec<\xa0n\xa0\xb1\b\xe17\\F\x9cfC峜\x9d\n\tHH(\xf1HD|\x12\x13\x87\x8cH\x8f\f\x88\x8b\xa6\xf8\"\xbc`AD\xfa\xa8\xaf\xc5݆\xdbU\x12\xd8\xc3T\xfey%\xd8\xe1\xb0m\xf6@\xfb\xe4{\x8a\xd9\x1a\x9e\xf03T\xee[PddW\x89\xb0\xcdPE;\x03\xcfw9\v\xac\x1cs\xfd*\xb0\xdbkkX\v\xc0{\xee\x80D\x02J.\x93\xda\xe1\x898\xb3Tmh\xd8<\x10C_3\x93\x14\xa3\xcbeӵ\xa9\xa2\xe1\x81 \xeb_x\x85\x9e\xbcz\x1a\x9d%X\xe2Z\xe5\xf2\b\xec\xffGkk\xc4cf\x9a\x15\xe2WW-\xb2b\x8e^\xad\"+îD\xbe^\xaa\xac\xb3r%\x10\x84>\x1e]\x99}\x1bpN\xe9\x9f\"2\xb0\xfb\x95l\xbc-4~K@\xea\xe9\xbe\xdaU2\xc0\xec\xa6g\x91\x95$\xd6Jxv\x1c`\xbcK\xe1\xd8`\xd3v\xc9|0\x8a\xdb\xf1_I\xb2\xee\xf8\xab\xab\x16\xab\xa8\x91\xae\xa0\xb2\x8f\n\xf6:\xd4\xd8;\xf1π\x93\x83\x8b\nfl{\xa9\xf8\x86\t\xd03\xcb*\x97\xa3\x04\xf9\xf0h\x11\xfbK4\x94m/\xbd\\\x1b[\x04[\x8731R,\x82\xd3\x13\x8eX`\x90\x01g\xbdv\xd2,\x11\x9e\xf0Y\xdb0\x92\x92\xab\xabse\x8bͥp\xddܖB\x05\x9dgm\rryv\x02j\xbc\x9a֒\f&\x98!\xa9\x9f\xcf\xe9m\xae\xd9\x02bڠ\ue029s\xa80\xc3tL\x84E\"\x92\x04\xc5j\rdg4\xc1\x87I\x13kk;\x80\xa7j\xd2V\xecd\xfa\xca\xe5܀\xad؈ˈ[;\x96X[\xcbVd̔\xe4ꄜUx\xec3\xcd\x19ai\xaf7\x81\xfe\xab\x138\x87\xd9ܬ7\xb77\xb7,2b6\xaf\x04\xa6\xc3,҃\xe8\xd6Fm{\xc3\"\x03\x15\xddc9\x9b)\x17\x1b\xf0\x99\xb8V֨m\x91\xdbN8\x9c\xf8\xf4\x99\xd8\x0f\x83\x9e\u05cf\xdatj3\xbco\xa09\xa1T\x9b&\xac\x9c:O\x9f\xb0)?\na\xe5ܼ\x86\x95\t4ULF\xea\r,\xc6!\x88$0\x82_Л\a\xe8\xf8\xb6m\x9c\x83#\xea\vcjU∙1\x83y\xcbu\xdf\xf5\"\xf0\x9dh\x9e\x18\x9a\xf2\xa1\xf5+h\xa6\x86\x94\vP\x96\xb0\xc88\xb3\x14\x10\xd7q\xc4\x1e\x06\x11\xed\xb17(\xbf\x80g'\xda\xd1Ԧ\xa6\x85\x1c>g\x01pA\x02lG\xfb\xcc\xf4\xc9\xc2쩾M\x94\xcb~\xb9\x1c\xef\x06\x9a\xdf<\xf4\xb1m\xd3p\xbdK\x83\\g\xc36`\xa6erb\fu%\x86E\\\x1a\xf4\x19\x0f\xe3ȟ\x1c3q'\b\x18\x7f\xed\xc1\x1b\xaf\xb7\xaf\xcf\xcfqp\xe2\xe9\xd4j\xc3\xe0MaO\xa9P\xd7}-\f/\xca\xe5|\xc84\xba\xac\x17rv\xac%\x03\xdd~d,\x12\xc9J\xac\x02\xcb\x10\x05{&:Bp\xaf\x1b\vf\x1a\x9c\xf9\x061\x82\x100\x8f\xf1R\x10r\xa6\xdc\xf9\x83\xbd&p\f.\xabh;)\xd0GF\xf6u\x0e\x01L\xcb~\xd5\\0J+\xb5\xa9\x95\x9c\xac\x0e\x99\xed\xb2\f\xc1\xfa)\x82\xcd\x17\x13\xf6Jmj\xa7&̉\x02\xa3\x96h?W\xec,\xf1\xa4v\xb6\x9b\x0f\xb4\xaf\x93\x06\xb9-\xc0\xd0o\xf7\xe4\xac}\x92\xea)q6\x8c\xb3\xdcܭ\xf4խU\xca\x03\xe6v\xbaa,\x0e؈3\xa5\x19-\x97M\xa8,\xf4YeLy`\x1as\xc0\x96\\/\x02\xff\x86%\a\xc7\"\xe6X\xae4\x02\a\xebL0^\U000a24ab+dn)\xf2\x02\x87\x95^mT\xea[\x95j\x89\x06n\t^n+uYI\xa9P\\P\xfd^nT\xaa\x95jŰ\xc8M\xb0\xa1^\x1c\x11!\x91\tA\xd3\xda988\xef<xp\xbf}b(\x97\xd1\xc6\x199\xba{\x7f\xef\xce\xc1\xf9\x83\xce/\x1c熢\x17\xf2\xa1qF:\xaf\xbf~\xf7\xad\xf3\x83\u0383\x8e*Ǔ\xec\x18\n\xa6\xd6\xf4f jS\xb2\xb1٨n\xb7A0'ܲ_\xbd\x86Q*)w*\xc6\x0e\xaf\xe0R\xe2\x15\xd7\x14\xe4:1\xb5\x03\x8c\xe9M\xf5\xf9\x16h\xc3\xcc\xed-\v\xc5\xf2\x00t{\x1eD4ku\x10\xbb!ʃ\x95\xc9\xcdV\xb5\xbeQ\x87e\t$m\xab^\xd3˹\xe4@\xdaf\xadZ\xb7\xc8\b\x8e\xdc{\xf6h\x87\x9a\x96\x19ؑi\x99\x8e\xa5$7\xc7\xd2?\x82\xe2\xca\x00\xcaUY\xc7\xed\xaf\xf28B݄\xa6[;>\x13%a\xa3\xf2wtbV\x89_\xf9\xbco\x99\xcc:Û\x98ؕ\xdd\xf4W[\xc0:\x19U\x8ei\x8f\xddG\x1ab\xc7Iڔlnn\xd7\xea\xb7\x1b\xa4\xe3\xd7\xee\xbeu\xdey\xf8\xe0\xb5\xf3{w\xef=\xbc\x87\x835\"\x10q\xf7\xfe\x9d\xcf\x1f\xaa\xc1#\xaf\xdf\xfd\x85\xbb\x0f\x1f``@\xee\xdd?<O3\x9c߅\x9fuLr\xc9\xc2\xe8!\xf9l\xe7\xf5;\a\x9d\a\xaa\xb6>ٿ\xfb\xe6ѝ_x\xa8\xab\xc1\xc8Kr\xff\xf0\xf8\xc1\xdd\\͝\aw\uef89i\x13\x02\x9e3\x0f\x98z\xfc&\f\x14\r\xe8\x12\xf5\xa0\x80\xf7\x94ax\x9c\x85\xdf\xf2\xc4\xe0\x1e\xc8\x1b\x91\xb8;B\xfe\x1e2\x1c\x12?쇱\u0087+\x1dX\x9c\xf5\x9c\x8c8\xeb$\xd5\xdd\x19\x82Z\xd2S\x05\x8f\xb3f\xee\xc2/\xd5\xc5\xcel\xec\xe2z/\xb2l\xf7h\x14\xc1uO\x8c\xdf\xcf\xe2;\xf0\xa8\x8d>*\x81\xa4\xbb\xb9$\xc7aQ\xb4\x1f\xba\xd8\xc1\xa3\x90\x0f\xef\xc1\xcaW\xc3\xf1xY\xbe=\x1ay\x0e\xf4\x05D\x9d\\\xc5w\xb2\x02\xf7\x19\xfa~\xc6\xe87IB\\p\x000\xee\x01\xc1\xb7²!\xc9j\xb9GF\xaa\x97\x85\xa4;\xbd7\x19s\x99\xea\xdc}l\xe8^8\x8aG\x18~#Y\x8a67\x1b\x9b[\xf5\xa6\xd2P\af\x80\x8b\xd2ln\xb5`}z\x18GqU\x9a\xdb\x1b\x9b[\x1b\xb8.+\x81\x19%\xabr\xb3\xba\x01V\xde\xc9Z\xd5\vtd\x1b\x80/\xe7#h\xd1 =\xdbH;j\x90\x81m\xa8\x897\x88k\x1b#\xce\xce\xd3\xc4\xf3\x10g\xce Cۘ\x8f\xec\xdbF\xc2\xd2\x1a\xe4\xd26\xd2Q\xc2\n\f2\xb1\r=H\xe74?\x14\xc6N\xd1\\VKA\xc8\xf5\x8dȈN\xe0\x11\xaa6\x9b\xceآ\xe6\xb3\xf5\xf2\xd9T\x1f\x0fmf\xbf*\xecWqS\x83\xf6:\x8eZ\x19|j\x8b\x1d^I\xe1G\x85[\xe5\xa692\xad\xe9N\xd1YO\xbe\xed\xc1|\xdb\xe7\xcfi[\x8d\xef\xad\x1a&\xc7K\xeb\"\x8c\xf3$\x10LmA0\xb9M\t\x8a}m\x8f\xe8{\xd7\xc0L1\xa2Nci\xdb'h\x06\xecLmJF\xb6\x0fB\xaai\xc0\xd3?\x86\xb5\xe32\x9f\tV\x8a+\x9f\xaf\xc0\xbb\x85}\xc6\x1f\u07b9\xcfԫ\x1dj\xd1\x12\x83\xa6K\aH\xf3HJ\xbcZ\x17\xb01\xf4\xe0\x90s\x13\xa1\xb8\xe3\xb6\x1d\xa2yUCM\xbf\xe2\xd7\rظ\x81+%C\x86\x0fϵ\x8dB\xd7KC:\x81\xfd7\xc6m\x9d\x94\xe0y:ب\x05\x15\xac4\xa6QI\xbd.\x86\x1b\xb3zu\xabto&K\xf0\xb2(\xa9\tbn\xa9\xc7\xc3a\t\x00й\r\x10@*\x8c\xf3\x90\xef\xbe\b\xd8X\"\x03:4-ӳ\xa6\xe0`\xeayd͜\x99\x96i\x1e\x9b:\xb3\xd84\x9cǦ\x8b\xdbb\xb2\x02\xe0vh\xb5\x7fS\xa5)\xb2\x04\nYB\x12G\x8c\xe3OJF\tY\x8eҟx\xa9\xdb'\x8e\xef\xb1@\xdcq۱\xfey\xcc\x1c\x0e.\x93\x00\xffz\xf6u\x9f\xd3@\x9c+i.)j\x90\xc8\tG\fn\x8b\xc1\xdfH\xeb\x8eK\x86\xb5\xb8\xcd)\x19\xd8\xd7\xd3\xe4ʆ\xaf\xf5:\\\x91\xe7\xb5n\xe8N\x8c\xf6J\xfep\x02\x0eZE\xb9\xec\x99\x16\xf0c\n\xaes\xcf\x05\xee`\x87\xcf\xc6G\n`>\xb5\xa6f\x8f\xc4dT\xd0\x00ua\x9f0ڃJaTm\x03\xf7\x8f\x92\xb1jV\x89S\xe1\rˌW\x8d\xb6\xb1:\x9a\xbd\xd0P\xe0]\x1f\xbd\xa5\x16B\xbb\x94\x98\xfd\xe4G\xb3\xf4ҵ?E|W\v\x80\x94\x82\x10TՎ\x1f\x83ڳ\xa4\xe0-y.2\xad\n\xecGV\xa6\x98\x9fݸ\xcck\x18\x9a6B\xf8\v\x9f\xb7̞\x85ڪ@-\x7f\xc4̇\xdc\a\xe7(\xfa\x1a+\xa3.\xe3Q{@\x9eČO\xda\xd7Sܢ@_\x9c0\xf4wo\x89Aj^\xdb!\xd1s\x99b\x89W\xc4\x12\xa4R\xbe}]\xdcA\xe7\a\xd7\xc3\xc1\x8d\xf0J\x7f\x01\xa5\xf4\x1c:\x9c\xe9\x97_\xa3\x04\xb9\xc2\f\xab\xa6;\xb7\x1e\xa3\xd8J@^<Tj@ҡ\xf2\xc1b\xe3\xb1\xcdr#\xd2\x16\x84k\xf2\tJS>\xcd<>\xb1\x05\x03\x17\xe4\xe9\xf4\xf3ǋ\x80>\xfc\xb3\x8c{=\x8f\xf1\xb6\x0f\xa3>3\"\x85m\xf6\x1c\xb2\x1bX\xa8\r\xf6Z.#\xd9j\xf0Hq\x05D)\xe0\xe71\xf7\xda\x1c\x8b\x9d_f\x8de\xa6\x13/6\x8c\xe1\xe2a\x14S\xb0e\xb8\xf3\xdf}\xf4n\x8f|\xa3\x8f?\xd4?\xb3\xa1\x1d\xddjhS\f\x8dq\x90\xdf̭\xdb\x12'\u05fd\xa0\x1d\x14\xd4G$?\xaaQ\x9eˈIH\xa3\xc61\xbe\xa7\x14\xf2\b\xf6\xca\x11s\xb2\xf0\b\x8bf\xe1\x1eR\x02\x849!$.\b\x84\t@C\b`\x0f\xfa\u0603K\x05\xf1\x04g\x92\xba\xae\xa7\x1e\xfd\xffgPR\x9d\xb8iv\xbe;\xb5{\x95\ffp\xf8w=\xddA\xe7\x84^t\xb7s\xdc\x00\x8d?\xf4\x8f\xd9NEm\xfc\x87\xbd\x1e\xc3G\xda>K\xfd\x98\x99\x10\r`2\xf7\x18\x93\xe1d\x98۾i\x99\x97\x84\x91\x95\xaa\xa5\xb4\x83I\xd4\b\x94\xa4\xa6\x05\t;F\xd8}\xcc\x1c\x91\x9d0v\xc1\xf6\xbe\x82\xfd\xb3a?\xb9\x9e\x12\x1d$]+\xe1\xb9\xc76\xaf\x88097DQ\xf6P\xe5\x06a\x04|\xa5\xd1L\xaeY\x7f\x1c\x85\x01)\x81\xcas}\xe4S/ \xa5O\xaf\x7f\xda ƾR\x7f\xae\xc1\x1ea\x14\x8b\\\xad\x8d\xc7\xe35P#\xac\xc5\xdcgp@\xc6\\\x83\x18\xbf\xb4\xa6\xb1\x87\xb9k\xc0\x9c\x18m\xe3\x97\xdex\xfd5!F:ހ\xeb*;A\xa5\xc7`K\xbd\x86\x89\x18\x93!\x13\x83\xd0m\x1b\xa30\x12F:a\x87\xc9,\x12=\xa9z\xe3\xbd\x13\bơ\x17!oS<\ue60d\x06\xf9\b^\xbbd\xf3Y\xe7\xe2\xa7V\x05d\xb1\xa2\xc7\x1b%\xfb\x7f\xe6\xf8\xee\x9b\xc99y\x05\xde-\xd1\xee\xe9L\xa1x:t\xfcH\x02\x1d\x85\x19\x0f\xd3\xf8\x1dV\t/v\xb9\x94\xc1n\xbc\x88\xf5\xeb\xcf0{EF0\xcf\xfa\x81\xf7\xc8vt[\xd6o\xa2Y?1\xb5ڟ\xa0aV\x01\x167\x8e\xe0\xaci\n\xd6\x19\x15\ao\xbb\x9b\x9a\x1aj\xa7\x86\xd8_\x93Y\x15]\x0e\x96\x05K\x87\xb9\\\xce~\xab\x01\xbcN\xf4s3\t;\x82Ot\x1a\xb3\xe7N\xd5\xf9nn*8x\xe6dj\x06`\xe0W\xedG\xa4\x84\xa1v\xe9\xa5k\x9d0}d\x11\xfd\xf3\xdce\xcaBQ\xe9\xf4T\xfe\\\\\xaeT>\xeb\xf4\x915U]Fc\xb4O0\x94j\xfc\xa6;\xc5\xdboy>\xfcr\xa1\xe0yo6\xdbd\x9e]\xbfo\x83\b\x9fߡ2\x82(\xf2\x84\x96k==7\xad\x85캕\x8e\xbe\xc80\xcd\x05\xda\x01N\x93\x8f\xd5+\x9e\xa07\xbe#\xd8\xd0\xcc\x04r\xd7 \x80\xa3p\xfc\xf7\x19p\xae\vD\xff\r\x1b\ru\xecW\x01\xb6\xeb\x1b\x04<\xd8\x06+\x9f\xaf\x80\xde\x19\xceg\xa7\xa4\xd5hV7_\\\x9b\x18\x83~(\x05\t\xf7Q\x8cw\n\xf1\x9d\x91\xf7\x8bl\x82\t\xa3\x9cΣV\xabm\xb7ft\x1e\x1b\x8d\xd6V]\xa9\"Qw\xa7\xb4\x1e[\xd5jcSi#7\xb6\x1b\xd5z\xee\xcc$6ө\xa2=\xc1\xf8\xeb!E\x8f\xc8ʊ$\fŝ\x00\xc8xd\xcfF\xa0\xb5\xeeld\x054kwa~lV\xc9m\x90\x95\x82.h\xbe\xd8|om\x98\x9c\x91\xd6}\x8e\b^\xa5a\xd6\xcd%q\xfc\xec0\xaf=M\nN\t\n\xbe\xf7\xfc\x18\x1e\x9bm+\x8as͙\x1b;@\xb1i\xa2\xf2$To\xe2\x1e\x89R|\x8c\xa6\xb8\x83\xb7\xafǜ\x8e\x92]ޟNs(﨩'\t:\x16x\xae\xebL\xc1\x18Ng\x98\x81k\b~&\n\x836%jSn{S\xd8\xd9#\xd8\xf6vO\xe0\xfa\xdd(\f\x92s!\xfdҵ\xf2m\x19\x19xJ\xa0\xe3rzM\x03\x1c\xd2\xc1\xb6ч\xf3\x19\xf3\xa4R\xa9DDd\xd6\xd6\xfenh^\x9f\x88\xb3\xf6\xf5%l\xf3\xed\xebT\x88\xe4\x99\x10\x19LI\xa2\f\xd1k$=\xd7I\xbb=Jd\xc7\x1b;\x1d,\xeft\x98t\x9ab\xa7=\x9b~\x92NGvX贗\xeft\xb4\x1b\x14:͓\xeeE\xb3ݛ\x12\\F/\xbe\x9c\x9d\xdc\xea\xdcޚY\x9a\x8bԑ\x1b\x8dF\xab1wL\xa0VnzLp}\x12Wf\xb4\xebgmM\xa8\x90z&\xc4Ui:\xd2k\x90\xc0\xe6\xce(\xbc\r\u00ad)9\x89+\xa9~=\xab\t\x00\xe7;\xf9\xfaP]Fm\xb3J\xa2\nh\x88>slAg<e\x06Y\xa0\xa5p=\x1b\xb2\xbdAGV\xe6%\x0e\x96\"\xb7i\x85\x05\x82O\x8e\xd9\x1339\xcc\xe0\xc4Լ\xf5\t'\xc1\x99-\xf0\x82\x10\x9eU\xbc\xe5[f\xa0\xe6в\x8a\x9d\xc9\xd3nO1\x87\xa1\x1d$\xf3\xad\fM\xc1\xbe\x1f\xaf\xa4\x9e)or\x14\xa9\t(\xe1B)\xd1[ \xfe\xb6\xf0x\x9d\t\x13\x96l\xe6eN\xe9.0\x83f\x8c\xb3\xea\x11k\fb$k\xc5\x00\xc7\xc2\v\xd2S\x9d\r@\xa0Z\x81tNt\x8e3\x92-\xb7D..\xc8P>\xcaP\fe(a%\xa6\x00i-\xba\x9bgD\xcb\xda:\f\x1b\x18\xd8H-\x1a\xaa\xe2\x94\xeb#\x95\x02\x0e\x81\x88S\x98vE!C͓ѩ\x1d\xec\x84\xcaR&aޑ\xa9,\xe0F\xa8\xe6Ŀ5~\xa0cE\x98\x04\x95\x1fG\xd5\"|a/|\xd5\vu\x80\xb4\x1c\xffI\xb0\xa8\xf5\xca\xd8\x13\x837b\x81\x1cC\xa4xAD\xce\">\xb2\x8a\xd2\xed\"\xe7\n\xff\xef,G\xc0@\xc1S<\x82\xba\xf5\xbaT\xfba\xb6\x1e\x17\x1eZݺ\xb6<\\\x85\x19ɩo\\@\x90)AV\xe0v\x94\rhG0{Z6!n\x16\xf3 \xec\x14\x8eκ\xc0\xb8\xe5J\xecM\xc0\x86 \xd2\xc7j\xb9\x82G!\a\xb1\xca\xe3\xea\xd4_\x1f\xabe\xb0\xea\xa35/\xea\x14\xa3\xce\xc9\xcc\x01\xfe\xf1M\x14w\xb3\xb6\xd5l\xcdP\xdc\xda\xc6Fm{\xe6\x00\xa8V\xdb\xdeFg\xff\x10\x17\xc3q,7[ͭ\xea\x16\xf8\"\x80\xb8\x91E\x06pP\xb4]\xaf\xd7\xe1\xaa8\xc4\r,2\x84\xb8\xeaf\xb3a\x91~J\xcc5پ\x04\xfd\x01#\x13X!\xc3ʽ\x1a\x88ǀv\x1a9g\xe92\x98\x1fw\vy\x13\xf6X\x99\xfb\xa2QKa\x0f\x05\xbe\x18oh,\xd8\nM\xb5\xdc\xfaj\xb9\xc1\x9do\xa4\xe2\xfd\xca\xeb^$f\xe83\x90\xb09\xfa,H\".\x9d\b\xc2ϰ\xa5\xacBk'\xb0\x03\xc4<@\"Bm\xaa}\"\xa11<8\x12%ㄓf\x85\x9d\xa5\xd8\x05\xd0E\xed\x14M\x15\xde\xea\xdc\x7f\xf3Λ\xbf\xd0.-D\xa4\x19\xbb\x84%\x96\bpG*`W\xa24\xa4\x8fC^\xd2\x06\xa3\x15CQ'j\a\x8b\x87\x8cxK\xc7\b\xeeS!\xf1\x9e\xd9\xc3\n\xf3S\xe2\x85!Rc\xcbn\x1aے '\x01\xf1ΐۣ\x88\x18\x81Bg\x7f\xc7\xd0\xe7\x85\xe0\xabJk\xac`W\xb3\xcae\xaf\x12yO\x19\x9azG\xc9\x0e\x00\xbac\xc3B\x81\x06.\x05_\xb0B\x8b\xbej\xd1K\x1d\xee+w*\xc2\x16\t\xbdcH\xedHdG\x9a\xa0\xf8~8f\uec6a\x98\bK\xbb\xc4\x05\bI\x84yaS\xc2Y\xe7*8%\x87\xf6\xbc&\xe2c\xda\xc3,\x9a\x88Ų\"OpH\x00\r\xa8,&N\xe9zH\xaaT\x88\xb0l\xba\x83\xcc\xe4\"\x99)\x8e\xbb\x9eȦPد\xa2\xe9\xaa\xc9\xd2Ѯ\xf4<\x1e\xe1M\x02<31\xa1*\xa6K0b\x9a\x82\x04@q\x81\xcf\xc8&W\xe4'7\x91e=\x9b'\xd8\x00\x8dG\xb6(N\xb42(\x06\xfeF\xc1_\xf1\"\xf8czV\xb9\x8c\bX\xf1\xa27\xe8Ȍ,\v\x80\xa0v4\x8b\x12t1J\x00\x02\x14Q\x02\x1d\xc1ⴋJ\x94\x83\x81D\x9a\xe1HW\xbf.@\xa7Sr\xb5\x84\xea\xcd1\x04\xc9j\xb1,r\xbe\x98b\x14g\x1b\xb5\xd7\xc0\xf3\x15$}=uű\x10\xd6\xeeʊ\xaf\x17\xaf\x96\xf1\xe7\x17.I\xac\xd8\xd7\xe0\ue0c3k\xb6\x87\x85\\\x9c\xbdB\x91\x95\x15\xa5<f\x96\x95$\b\xb2RC%\x93\xbe\xb6\x8a\xf2\x039^\xd2\xffd\xd7\am\x03AI\xfcv;1\xdc包\xdac\x83\xa9\x95\xe2{\x9e=\x98Y\x16d\x8e\xd4fwFR\x86\x029<\xadä$\x1c1e\b\xd6\xf6\b\xbb\x12\x9c\x82\xe4k\x83\v\xb9\xeb\xdc\xce\xcc\vc_.\x17\xc3z\xa4\xf3{}{\t\xbd-\x97\x97$$u\xa8\x1e\xa8\f\xb9J\x8a%\xd3\xec\xe9\x81\x003o\xec\x99.豨\xed\x13\x10\x8c\xa7\xc8\x175\xab\xcd\xda\xc6\xedf\xe3\xe1=0A:W\x9c߱\x9a\x14\xf2\xe0\xee/\xfc\xc2\xeb\xc5Ȑ\xc4#\x97\xeai\x83\x83\xed~\xdfW\x01\x8f\x00'\xa7y\x9a(7\xa1\t\x86\x9c\xab\x92\x06\t\xb3(U>g\x06B\xf3\x97>\x95\xa2-H\x15m\xd7'\xec\f\xec\xe13Yݛ\xd5ʅ\xf3Z\xb9\xa8\xa0\x95\xcb\xf1Z\x82̜]\xa2NN<W'WP\xc2\xf5\x17(\xe1\xac\x1d\x068\xb4\xc8.\xc8̣]^\xa5m\xa9)k\xd5\xeb\x9b[\xb7\x9b2,\xf89:\xf4U\x7f\xd4\xf4\xe4Ui\xf5f*p\x87\xe9\xb2\x02\xd5nz\xca\xf4\xf9{\x15_\xe9\xc62\xedjf\x90\x89\x8a\xf5D\xd9\x15\xb0\xf1\x03\xb8\xcf\x1d\x80\xca5\xafl\xb6\xc85h\x8dHmk\xab\xf6ɴ\v\xb5\xcd\xdaV\x03\xd9]\x13\x87A\xab\x18\x00\x89\x95\xf6\xaf^\xdfjmk\xed_\xab\xaaY^\xb3\xd1ln\xa4,j\x8c氯\xc3\f\xe5\x86Ŭ\x92\xb023^\xb0%\xe6\xd4\xc0N\xa63,jՔ\x96l\x91\n-\x9ejs\xb0(\xa7u\xf3\xe7\xb4n\xb4\xa0u\x83\xff\b\x82\xfc\xe2\x83Es\x83\xa5\xb5,a:Bz\x00\xa8}}\x12V\x8a\xcb9\x95\xb9Xe\xc8x\x9f\x99f\x95\x04\xa9<%*z\xc9X\x169\t+\xc5E\x9f\x16M\xcf\n\xd2\xec\xa9T\xcagDJNV\x02%\x97\xc1$ݮ\x9b}6\xdf\xc5\xcd\xedF5\xa7\vN;\x98vFi)ԩ̮h\xc3u\x93)A$\xb9\xe5؆\xe3\x00z\x92_@\x990\xb67\x81\xb3\xe7Y\xa0\x14f\xa6\xcbJ\x1f\xa8b\x10\xceS\xaf\xf1(\xadͧ\xb9\xbd\tVɔ\xa4psM\x89\x00\xb1\x12\xe2\x13Nm\x8e\xa75\x89v(\xac\x14\x813\x99>\x19\xa3\x84Zyrɭk\x9e\xbfȨϼ\xb8>1z\xd5ިVwͰ\xa2\x88/h\xc2\xe1\xc6\x1d\xa6\x99\x06\xbc\xd9\xcft\v\xc0j\xbfP.\xb8(\x85\x9ed\xb5\xb0\x83\xc7.&\xcf\x1dU\xad\x1a%c\x15\x1fb\xb2\x880\x81\x8d\xb0\xac\xb6P\xd87\xb7\x1a\xb9\xbe\xea\x82\xeb\xa3\xd6jVo)\xd03\x017\xd7\xe6\xd8\b`Pv\a\x1eP\xe0\t\xf2r\xd0\x1b\x86@(\xfd\xf9\xa3O\xbdtͦ\x8f\xac\xf6\x18\xef\xeeU\x12\x1f\x1cx\x95\xd26\x8c)il\xb4\xb6\xab/\xbeJ\xbd\xfc*\xadm\xb6\x14\n\x9b[\xd5\xedfS\x91\xb4\xc6\xc6\xe6\xf6Fn\x16\xbd\x94\xf6\x9c\x04)\xf9\x98!C)\xa5\xc9\xeb믓-WaV\xeer#3+\x95J\xe6{<\xb5\xc4WW)\x1f\u07bf\xb3\x9fh\xa3\xcdE\xfd\xb7vDŧ\x930\x16\t\xfd\xc7\xf9:`l\x04w\x96^\xa3JF\x82\xb9\x02x\xd2ʢ\xf6uƗ\x84i_\xee&q\xe0\xab\"=\x97\x98N\xcf@-\rCt{\xe5\x8d\xd6\xc9D\x0e\x0f}\xffA\xa8\xd50s\xd0i\xed\vgԝ<\b\x8f1\xb7V\xc0$E\xf5\xf5\f\xad\x83q|F\xf9q\xbe\xd6c\x92\x9f\xd5\xcer\u0084sZߨ7\xb7g\xf42Z\xdf\xf2\\\xbd\f\xa2;\xeae̍\xcdZ\xb3\x8e\x9a\x19\xb8\b\x84z\x99D\x8b>̨~\x1f\x93\x87\x99^\xc6P\x93u\xae\xfav.B\xb4\xb9Ց\xd85\x9dd\x80F&\xc7a\xe7\x0f%\x89ʿ\x98\xc1\xce;\xe3K\xef\xae\xcfJ\u0084\xe29t\x87s:\x81y\xf3\xec\xea\x8e\xf7\x8a\xd8\xf1VW-z\xe2\x9d\xe5\xa4c\x0f\xfd_+4\xb5\b7\xad\x8a\xab\xa7\xcf\v\xfa\x16p)\xa0\xa8aD\x9c\xd9t\x87\xd9J\xee\xdce\xed\x13v\x96\"s\x00\xa4\x05f\x1b\x1b<\xe2\xe1\xf0Nt\f\n\xbe_d\x13\x93\xa97\x88\x12\xf7\x02\xc9E\x02,{\xe2\x91\xe8LQܕ\xe4j\x94Y%\xa3\x8a\xa6&\x16\xbe\xaa\xb7S\xb7\xed\xd4=\xc1n1ݬ\x12\xb7\x12~\xc62\x1f\xad\xbft͂\xb9E\xe5Y\xd3\xc5\t\x915}dY\xedZ\xae\xear\xf9\x85\xeb~d\xe58\xb6\"\x01fHA\xc7@\x00\xcd\xd9\x03\xed\xfc(N-R0\x94.,\xf7EȐWS\x86Su\xbc\x11\x16\xe7\xad\\\xd6\xd7S\xa9\xbe\xb0\xe1\x15\xd4\x055kG\xf9\x13\x05\xaf\xe4\xa8\xec)* \xe0A&c\xfd\xe6\f\xe9\xce\xeb\xa3\x12 \xff\nbA\r\x80n(Q\xfd\x13T\xbc\x14+\x00G\x1e\xe6p\xc6\f-r\x12\x93\x11ܣ\xebنqfG;\xa8\xcbH\b\x16\xdeB\x893\xde\xff\xc6\xcaNFp\xaf\xadp\xc6k\x9c\xe3c\x8csחR\x13P\x169t\x04v\x9d0\x8c%\xb8gW\x1a\x0f<\xc1\xa2\x11uX\tT\xfc\xa5G\xe7\x8fR\x95`\x1cD\xf1h\x14r\x91]P\"%\xa0\x98\x8f~\xae^}\x84\x8c\x00\xa3n\xc5\x00\x9a\t\xe4\xd2\xf4\v3\xa0\xe4\xf6\xf4J\xf29\xb8\xa4(\xa1\xa2v\xa5j\xa5e\x94\x15\x94\xb9\xb0'RBtOG\xf7\x92h\xeb\xbfo\x0f#\rOt\xcb\x1eF\x10\x82\x80&\xf8\xb0,Qœ\xb1h\x99\xfemf%\x00ۙl\x14@h\xac\x9d\xbeiU\xbc\xc8\f\bjH\x12\xbe\x9a\xc1\xb5]\x93\xcf\xec\xa33\xbb\x8f\xda݊Y\n[\x91\tj\x95\xf3<`@\x1b\xd1\xc7\n\xaf\xf4\x82\f\x18|\x95\no\x16\fLK߀T)x\x85\xb2\"\u0082\x987O4@\xc3c\xa5Dc\x92\xf2R\x1d\x1b\x19ۙ\x86f<\xa6\xa5\xfcE\xe8 \x81\xaf$?t?\x91\x1e\x046\x92\x90\xe1(\x06\xe3;\xb8\xfd\x06T:Y\xd0\x06\xedF\xa1\x1f\v\xbc]\x10TFa\x84\xca\x13Bm\xb1\xbbn\xd2X\x84R\r\x9e\x1cx\xae\xcb\x02k\xbd]\x88\xb6\xd6q\xe9\xf6\xbc+\xe6\x16\xabH\x98j\x8e\xf7\xb4\x15eb;B9\xdd\xca\xc1\b\xd7\xd8\x17\xc0(,b\xae\xc0I+pd\x9ec\xac\x14\xea.\x97\xa9\xbak\x1eT\xc2K\xc6\xe1>\xc5j\xf6\xf3s\xb9߿\x94\x9e\xfdf\xa2\xc1tֈC\xa1B&p^ϲ+\xe7\x19\xef3\x9e\xe1Z\x8eg\xf8\x9d\xab\x05|\xd1\xe14'\x8e^\x17Q\xb9\x9d;\xcb\xc9b\r\x8b\xdc@\xf3\xb2\xd9ON\xbduׂݓ<\x19%\x90\xda\xe6\x85\xc85\xf4\x1f\xcc\xcf\xda'gSr\xd3V\x0em\xe8su\x12fm\x14\xc94\xdf=\x81\xd4\xf6l\x03*\x05\x1b\x99\x92TN\xbf>\xb9̉\xc5\xd1l\x9f\t\xaci\xb5\x92\x8b\x02\xf2\xe4L\x8d\x92\xd6(\x17\aJq\xc3)w\x8e\xfcjW\x8b\xfc\xc0\xf3\x7f2ab\xabV\xaboΰ\x9d[\xcdz+\x95\xfd\xbd\x84L\xe0\xe5\xda\x12\xbb\x12,p\xa3\x12\xad\xa4\xbc\x83\x9a)\x1e\xc3\xf4\x9b\xd6u\x14\x8f\x18/J\nxނ\x9e\x05\x8d0\x00A\xd0P\x9ae,\b\x9e_\xf0\xac\x16}\x16\xc1\xedc\xf0\xe4\xb4`F\xe7ć\x02b\x9a\x01\xc1\xb7\n\xa7\xfa\x82w\xaat\xa2\xb37\xb7\xa3\x11\r\fr\r\xfe\n\xb0M\x05Ҕ\xccfd$\x03)9\x13\xden6\xff\xd1ƛ\x9b\xf5F\xabQ\xfd\xa7\x1b\xf8L\xaa*\x0e?\xceH\x90)\x83︨K\xa8\x88\xf0.\x9a*\xabs\xa1\xebl\x01\xb7\xe9L2\xb5\xa9\x94ť\nˈ\xdc<\x83\xf4\x1f\x7f\x067\xb6\xab\xb5\x8f\xa1Qts3\xa8͓\x8a3\xd8\xd8ت\xce\x1e\xa0+\xa1\xac \xa8\xe9ۗ\x05AM\x1f\x8c\xf72Il\x90\u07b5̹lH.\xd4\xf7\xc0\xf07\xbd\xbap\xa3^1\xd5E\xb5\x99\xda\xe5\xa1|\xfe\x96\xdf\xcc\xd9\a%y\xf5Q\x94\xe7\xcd\xfd\xa9͉\xd6C\xc5\xc0\xa7'6W\xbe\x99\x03rd*w\x9db\x81\xf2H\xe4\x95Gɞ\x15ݤ\x1c2,\x12\x14U\xc4x昪\x89M\x91\xd8$g\xb5\x83\x86\xc8R*\"\x8b$\xde-\f\x84\x1b\x9fL\x80#Us%\xc9].\xcfCZ.\xe7\xa4R-2\x96P.1\x1e\xde\x7f\xdd\xf0\x82Ҡ\xf2\xf9]\xb4\x8d6=ӲLf\xb5M\x91\xf1(3\xa8J\r\x8b\b\xf4\xca`3+y\x85\x15v{\xfdz\x94\x13\x82[\x10\x1dm\xdb\xf6\xa0\xf2\xf9Lk\x92\xe4\xc8D\x85\xe2\b<\xba\x17F\x91\xd7\xf5Yi\b\fʚ\xf6fR\xf2\xa2(f\xbb\xa5\a\x03V\x1aѾ\xba訴:%\xe0\x1bJ\xc9[\xb0\xa5n,J\xb4\xf4\xd2u\x06\xcct}\xbd\xf4\xf0\xfe\xebX\x04\xb0\x01n\x91\xb8\x95\xd2\xfe\x809\x17%1\xa0\xa24\t\xe3\x12\xe5\fo\x90Q!\xd8p$\x80\x11\x17!6\xa1 )iH*\x8f\x16\xccB\xb2\x99\xe3\xf1\xf6\xcc\f3k\n\xf8S\t\xb9\xd7\xf7\x82\x95\x99\xf1P\xb1\xcf\x1f\r\x87\x87Q\xb4\xa6r\x97\xcc\xfd\xbb\xf7\x8f\xad\xfc\x98@\xff\x92D\xe8\xbb\xfa=\xb5Jn\xc8\"\xec\x17\xbaa*\x89d\xfc̗\xae\x17\xc01\xb5\xb2qa\xc9\xedQշ\b\xa3\x9c\x90s\xe6\x88\xd2\xcb\xea~\xf8\x1aܶࡿ\xd6\x01\xa3\x81\xb5O\xbf\\\xd2\xf7 \x16\x8f\xd2\xfc\xc8̞\xa7L\xa7\xa6e\xed,YCQ\x8c\x8d\x1a\x16I2\x1c\x8f\x98c\n\xa5\x11%T\xdd@Q\x8f\vF9\xed+\xb3\xa6\f\x9e\xe3\xd0\x19Ȓ\xea}\x15\xc45\x8a\f\xa39\xd7\x03\x12\xab\xdb\x1f\f\x0f\xf1\xa0u\xe5bk\ue287\xb3\xe0\x82\a\xbaJ{\x95Y\v/z8\x8b\xaey\xa4%r7\xf6\xdaFD\x87LcBv\xefd\xf9\x1d\x19\xb8\x163M.\x8c\x8c\xc8\b\xae\x05\xcew\xbf\x9d]\x918A\xbdo:\x1c$\xa1]$\x9d\x00R\xd4u\x9f\xe5\xcf\xd1\xfd\x82&\x02\x9e\r*\x8aP\x8f\x0e\xd3[\x0fӒ\xa7p3\fX)\xec\x95^\xba\xc6CxP\xdb$o\xc3\xc0z\xd5\a\xa2\xe7\x1a\xa0sE\xe8\x8c\xfc\tf\x9ea]^$\xe1c\xe7\x1d &\x9c\xeb\xaefq\xfd\xfc\xd0\x18$M\xb7ڬ \x17\x14\xf2\xb5Q=\x05\a\xffZ\xb1bVI/1rț\x02\x14k\xb7\xa4T*\xffi\xc2\x01\xb7\xb6ny\xb4\xf9\xe6\xe1[\xe7\x0f^\xbb\x7f\xf7\xad7\xcf\x0f\xef\xdf\xd7\a3\xc5\xc8\xf3\xbd\u0383\xfd\xd7\xf414$\x1d\xdf;\xdcOs{\x85\xa8\\\xde\b\x13\xd0\xe88\xc9\xeb\x93\xfd\xd7\x0f;\xeaw\xac~\x9f\xef}\x0e\x83\x0eɯk\xed\xfe#\x1f\xb5\x87\xae\xf2\x94\x1b\x90\x80\x8da\xe1$\x19\a\xb9\x88,\x9bK\xb2\x9b+\xda\xff\a.I\xed\xfc\x03\x7f\xef\xa9k\x11\x97yF\xa6Y\xab\xe6$e\xc6\xf9y\xc0\xc6\xe7\xe8a;8g\x9c\x1b\x84.\x8a>\xefB\xcb\x06\xf1\xb2DD#,\x11\xcdG&\xf9\xfd,\tΫU\xfeXE\"\x8c\x06qr\xa1\xf3\xee\xc4ȳ\x16K\x0f\xe5\xf1\xe8'b\xdcC\xff\xed\xb8b,$\x97i\xe1\xdela\xba\xf0:\xce`6\x9b\xb70\x9b;\x9b-Z\x98m8\x9b\xcd_\x98\xado&\x16\xd4s&`Յ&`ռ\tX\x15]\"\xe5[\x89\x17\xb6r\xf9I[\x01\xe4Y\xa9\x16Zr\x8aDes\xab\xb5\xfd\t\xaf\x02h\x9b\xd3\x02k\xad\xcf;\n\xac\xf5\xe6Fu\xab\x96\xb1\xd6\xe91\xfd\t\x8aPu\x8bp\xb3^\xdbnlZg\xf9\xb3\xf8\xd4%\xac\xb2S\xbb~\x1c\xe1\x9et=\x9d\x92\x00)qL̼E\x83\xd2.\x89\x8a\xe04\x88\xe0\"$\xbaY_n\xff\xb6\xb2\xc2R\rz\xe6c\xbcHЍ\aIe\x8c\xeb+m\x06\x11\x16a\xe0\f={q\x1c5\xd4\xf6\x92&\n\xe6\x91\xe6JB'\xbd\x00\xad\x1eu\x10,{\f\xa8\x12\xefk\xc1\xa8\xdcnj\xd2\xce\xceݢ\xd2\x13Q\x98\x1c-\xe3\x14&G\x1fZ\x15=\xc7l\xd4\xf3\aT\v\x97u)\x9c\xd3*\x17\xe6\xcbH\xf6\xbf^\x89\x96\x00\x01\xcd\xc82\x88\xb2\xb2\x17\x89-\xbb\x16\b\x8cl\x908\x1e\xd9\x04\xa0N\xc6\xeaB\x92\x9c#\xc0\x94\x87\xcb\n\x86$XM\x8fy\x92\xf3\x00b\xcc\x1a\xba'ň:9XR\x19%U\x12X\xab\v\x1c\xb69\xc5>\xa7\x97\xa3@^\x03\x8d`\xe2\x15\xb3\\N~\xbfZ\xdbe\xabF\xc8Kƪh\a'|\xb5v\x96K\xac\xef\xb2U\xb1j\x90\x92\xa1\xd3T\xb8d\xb4٪\xb0\b\x98SǾ\v*qjXSSd\xef\x103\xe5\xff\x1f\xd7\xcd\xc7A\x158'O\x91$\xc5\f\x10d\x1b\xb0VS\xab\xeft\xea\x83T\x05\x97\xacĂ\xc1>\x98\x9a5\x9b\xad\xc6'S\xb7\xb4\x1a\x9b\xdaݑ\x89\xac\x82B\xdb\xed\xe6\xd6V\xb3pVΖ\x18\xea0\xcesv8\xb8\xd3\xe8\xa6`\xdeRs\x9c0\xc7\xe7Př`\xcb/\x0e\xfc\xe5s4\r\x8b\xc8a\xa3U\xad\xcfj\x1a4)-j\x1a\xea\x9b\xd5\x19K}=(n\xa6|\x80\x13a$\xe5ʤ\xb6o\x83\xffX֮\xce\\\x84M\xdd\xed<\f.\x02\xf0\x94\xa7\xe2\xa7;\x85-G\x8f\xe9ɠRd\xb4n\xbc\x96\x02t\xa0Ox\xc2\xd5*\xc6#'72-\x91\x98\n\x96H\xe9\xb4L\xc5C\xba\x89\x9d\xb2\xa5\x8cm1*\xbb\x93\x05\xd4sai8d\xc9\xcd,\x1c\x90\xccA\xadX\xbe\xe7_6Q6м`\x12[\x80C\xf5\x90\xcd\xf5\xd0\xc2{I\x8b\xe0+:3\xf7\x15ɛ\xedp\xb2-\xe4\x9b\xe2hl\xfc\xa2}N\x98\xdc\x1b\xe7i\xa6\x9d\x04\xb8\xe4ʃ2\xd5&(\x95\x18\x16\xf9D\xb3\x86\x8fx\xecM̼\\\x80\xfb\x9de}\xfc\xce\xfdcM'v\xf8\xffB\x93\x99\b&\xb7\x9f\xccP{\x9d\xb0\x96N*\xde\x7f\xff\x84\x93\xfab=A\x19\xeay\x970\xd5\xf3\xe7R&L\x91\xae7\xbb\x1f\xa9\xefB¶\xcb\xed\xd9L\v\xae\xab\xa4/\xbe+\x1ec\xe6r\x80\xbaސ\xf3x\x00\xf5\t\xa0\xd8\xc1\x89H\x04}8\xe5\xe3\xe0\xedw\xf6\xa6\x9c2\x9b\xbcVͷ\xc3i\xae\x9f\xe7{\x9f\xbbmW\x8d\x84\xe0f\x8ey\x83\x17\xefn\x80\x03}\x13l\xb0\xa7\xe1\xbey\xbb=\x8d\xfa\xfe\xa1*\xac\xa4i\x9fF\x02#\xe6\xcd\xd92\xa3S\xa5\n\xcfl2\xc1\xc26U\x11\x14\xb4\x02)\xc2\xc0\x8e\x9c\xa0\x99\xa5\xaf&\xa9B\xdad\xa3\x02-\x9b\xea\"A\xab\xd5\xfc\x18\x9eL\xc3\x02\xb0\xd5V\x8eq\b\xb3=\x0e\x8e\xb3\xc3Qt\xe4\xf9\x82\xf1v\x90٣\xe1-\xe9j\xeb\xc5ۍ\x9e#\x19\xcd0\xdf\x19TQᑣp\x8e\xc5\x04 \xd6\xe0\x91\xc1\"]\x13ZV\xd8\xdch\xbe\x98\x81\xff\xeb\x9d\xcf%\xbeJC\xa2\xe3\x8e\xee\xbc\xfe\xe0\xf0\xbe\x9e|\x1d\xf7\xc6݃C\xadI\x81kڪ\x93\x89~M\x1dQ+݉\x8a\xd2C\xa9T(\xa9\x99\x9eC\x94˾7\xc0\x1bҜ\x8c\x92wK\x19\xa6\xc6j\x89\x82\vC\xa8\xd5(&\xf4\xb0%\xd4h\x14\x13\x86\xe8x)J\xa3\x01\x8a\x9cR¿\xf1\xa6@\x9a-\xbe\x9d\xfa\xc1\xc9n\x83\xad\x98\xb7\xbe\x0ffI\x99\x0ff\x97\xc0pit\x8e\x90O\x9d\xd5S\x80\u05ca\xa0\xdff8\xacA\xf1\xfa\xc3\xe8\x13_J3\x8c\x9b\xa0\xf0桀an\xab\xa5R\xdfڮ\xd7>\xd9Z\xd9\xdcj\xd65=\x01T\xd6\xfe>\xe0\xd4I\x19\xfco\xb7\xb6[\x85ղ\x84\xf7O\xac&R\xf6?\x983\xc3/\xf2\xfd9\xe7\x17١\x9a\xa7\xc4\x01\x00\xea\x93uKs\xf93^L\x90vzi_w\x92\xeb)\xd7'^\xa5\xb0<g\r\x13\x92Ő\xa9kIVD\xad\xde\xd9\"\xc92)\x16\x81\xa5|\x83E?\xdaF\x05\x8aà\xf3\xf7\x03*\x88\x03\vx{,f\x90B)\xb8\xa0k\xb2\x04\x9e\x80p\xc5\x1cx\x95\x1c\x81Y\xb0o\xea\xa7\xf0r\xed\x11\x9a\x8b\x01\xec+\x88\xf5\xda\xfe\x1f^A\x844\xf0,\x9f\xd0\xc8\xc0\"&E+\xc0U\xc3P'\xca\x1b\xd5[\xdas;1\xe7\x89m\xb0Gt(G\xe4\xa2\xc4$FS\xc1\U00040294\xca)\nx\x1c\x0f\x87\x94O\xe6\x14g\xfa\f9\xcc\f{\xe9\xec=k\xcf\xce\xf1\xcej\xeeAz\xcc\"\xf5\xec\x82\x1c\x99v\xc7\x14j?\x85\x05,,\xa2s.\x99\x1at(b\x16b\xa1\xf3\x16\b\xa4E\xc3.\xa5Й\xa3.\xf5\x85ԥ\x9e\xa7.\xf5<uY\x00\x1c:\x98P\x93\x06w\xd6\xc4\x19\xfa\rp\x14\x19\x9aa%V`?4\x98뉐\xab\x9b\x86H\x1an7\x99\x02\xdc\x1d\xb9\xa9\rz4w\xa7C\xab\xc1\x96]4I\a\x84g\xb6\xcf\xc1Ͷ\xcf\xc1\xab\xb5\xdd`\xad\xd6F:V\xdb\xf1^\t\x12\x1b\xe8\xb5ڌ\x15\xb4\xba\f\xcbLN\xd0\x14z'\xbdJ\xe2ϙ\xe0\xc6\xf9c~g\xaan\xd0\x1eO\"\xc1\x86&\xa8\r\x1c\xd3\"\xd7Czu\xa0<\xe13x\xb9\n]\U0004dc15\x81\x1dW\n\xa8\x9c]3\x1d\x94\xcb+0\x95\x03xK\x85\xc7\xccп{ԏ\x92\x80\x19\xd9~%e\x9b̈\f,\x8b\xf4\xca\xe5\x15/z\x93\xbei\xf6\xacr\xb9\xa7ޛD\x9759\x9b\xcc*\xe9Y\x16\x81Gh[\xb5\xcd\xea'\xbb\xf74\xe3 \xaapsPi\xa8\x92\x9b\x12\"\xbbE\xcd\xedk\x97u\xe3~\xbbJ\xbc\xa0\x17\xb6k\xe0\xe0\xbc]'`\xa9\xdan\x10\xa5\xdc\xdd\x00\xdd2X_\x9c\xb03)\xd7j\xc8\xc6\xfba\xffuT\xa6P\x90\xbd<{\x8e{\xbb\xd1\x1c\x9e\xe7PB\xbcZ\xdb\x15\n%B\xbb\xb6\x13\xbe\"\xf0A7~\x12\x16Q\"<\xdb\t\xf0\xe5N/=a<agf\xa5R\xe1\xa9\x020B\x1bۙQV\xa7\x9b\x90\x80\a\xc9ة\x85Y0\x05\xf3\xc0`,\xcc\x02\t\x98\x03\x87ma\x16L1,r\x9d\xf3\\\x05\a\x87}uɍl5\xab\xb7\xbd\n\xac\xb7\x84\xe3\xc3\xd7\x0f\xf7\x1f\x1c\x1e\x9c\x1f\x1f\xde\xff\xacfH\x83\x84!\xbd\x7f\xf8\xcf\x1e\x1e\x1e?8\u07fb{\xf0\xb9\xf3\xcfv^\x7fxXdb\xe7\xd3\xcf\xef\x1f>\xe8\xdcy\xf3\xfc\xe8\xf5\xce/\x14\x99\xdbB\xde;o\xee\xbf\xfe\xf08q\xa7\xef%y:\xfb\xf8D\xef\xe1/u\u07b8\xf7\xfa\xe1\xf1\xf9\x1b\x87o\xeci\x98\xa2\xd9z\xf6\xef\xbe\xf9\xe0\xf0\xcd\a\xe7\x0f>w\xefPo\bi\x8e\xe3{w\xdf<>\x9c\xcf\x12\x93\xb4\xd7\xd0\xd9\xf3\xcfv\xee\xdf\xe9\xec\xbd~\x98\xeb\x9bC\x8e\x0f\x1f\xccu\f\xdf\n\x00\x1d\xc1\xdd\xfb\xfa@R\x89\xa1\xcf\xcb\xd7[\x92O76 \x11\x13\xc7\x05ǘ\xfa\xc02bB\xbb\x8b\xdc\v\xdd\t\xfa\xd0\xd4'\x97\x98\"\xa8\x17̦\x1f\xf9\xb4\xafO4\x8b\xa5\xef\x04\x8e\x1fG\x89\x7f\xfcKH\xed\xa0k\xce\xc3+:\x1c\xf9,z\x83\xc1\x13\xc9\xc9\xcb\x06iY\xed\xfc\x12\xbdM\xeb\v@\x90\xa6\f\vf\x13Ǫ+Ѕ\xcfR\xee\xc1k4\x19ԇ\xf3\xfdA\xbf\xf5\x99\xc0{\xa5Nao\xccsN\xbc\xc0\xbb\xb9\x9a\xe3E\xd5\xc4,\xbdL\x94^\x8f\x06Ǫ\xe7\x11\x13\xe7\xca,%\xc2\v\xd2i\xa46\xb78\a\xaf\x9b\xe7\xda\x1d\x13]\x96\xceq6\xce\xe1!e\x94\x90\x16\xe7\xf2\x92I@q)\xcdCq\"Ι\x9e\x89\xf3!N\x05\x9e\x05\xcfգm\x86Ε\x8a).\xe4P\x932\x93ř\xeb\xe7\xf9\xa5\x9e\x9b\xa4W\xa3\x1bz\x8dc{\xae\xf5\xd9=\x9dQ\x1d=ߘupc\xd68\x7f\xf3ܽ\xf1\xe6y\xd1e\xecC4\x95\t\xe8P]b(\ne\xc3d#\xc2\x16ڂ\xc0\xf9\xde\x1b\xea\xda~Γ\xf1\xac\x14\xba8{r\x89\xbd\x9f\xfaG\xbeM\xad\xf4\xb9\xb5\xe6u\xff7\x80\x8b}l\a\xb3\xf5{7ן\x94\xca\r\xca$i\x05\x93f\xb2\xebG<\x1f\xa81\xd7!|\xa9)\x9cm9'\x17\xbfhM\xd3i\xf1\xb1\x89\xdb\x0e\xa6\xff\xdc\xc1,\xbeN1[o\x9b'N\x1b\xe6\xc61^\\u\xbeD\xae\xf6äv\xb5\x80\xda\"\x87\x81\x9c\\\xb0I; \x97ԟ\x1f4'\x8f\xc7\xcf)\x9a \xdcU\x8ap\b\x91H \xe2D\xaf1/\f\xb4\xd6p\xae[\xd9\xd3\x1d\xb7)<\x85\xfb-K\x1a\x9b\xad\xb9\xb7\xb4\xe6\xe9T?\x98\x91V\xa3'H<\xb7\x8e\x93\xeaYR\x8d8\xa9\x9dAM\x9d[\xd54(֔f\x83\x1a\x9a\x8df\xfd\xf6\a\xa2˜\x8f\xf5nr\x05\xa6\x15\x8d\xb3'\xfas\x96\xccZ\xe2t2%\xc4\bM\x8a\x9a\xadd\x17\xea\xd9\xe6\x00\xe4\xaexV\uee9ewp\x95\x1b\x85\x92X\xec\xec\x04D\xffE\x92=z/\x9f\xf1M$v\xcd\xc4\x1b\xd5\"\x8f\x8aE\x8f]\xa9d\xc6\xf3\x1e\x86p\x84\xf4\xbd\xbd\xd4\a\x11-\x97\xa1\xde(\xc9\n\x17p\"Úo$\xca\x1a\t\tU~\xab\x10\xd0T\\\x86\xa2\xed\x90\x14\xfc\xbc\xc3\x0e@\x8b\x0e\x80\x92x\xc3\"\x89#\xf6$K\xce1\xbb~\x13\x81\xcex\xb9B|*\xf4\x8a\xe4\xbd\"\xeb\x94\\\x94\x01o\x94\xc2Q\x8f\xf2R\xc6\xc6%\aL\xf2\x947Po\xeeJ\\*\xac+\x8fFSK\x1b8\x83lG\xcb\xe5ĥ$\x84\xa44\x97\xd5˧\x16\x14\fG,\xb8\x03F\x92\x81r\x86\x0e5h\b\vI\aTP#uǾ<\aI\xad12\xbf\xfa\xd1yz\r\x10,\tO\x16\xba\xd97<\xfd\xfa\x94q\x86\xbe\xc9hv\x054ԸG\x13\x01;?\xe0\xf9\xba\xcb\xe5\x82=\xc8|\x86\xac\u0382\x8aM\x10\xbc_\x9a\x8e\x10\xac\xb5\xdb\xe1\r[\x807\xe7,pG\xa1\x17\x88<\xf6\xb0\x1c\xf6\xe43h\x1c\x8a\x14\xd6$HO\n#\v\xe5\x17\x8d8b\xe1sP'\xd6]\x8e\x97\xa0\x0e\xfa\xc8\n,x\x93\xce\"zPr\x96\xf8\x99^1\xa7\x9e\xa8\x14\xa8H%q\x96kZ;7\xa8T¼J\x05Ф\xbaC_\tv\xe8\xea\xaa\x15\x9eм\xd4L\xf1:9\x1a\xaa*\xe7\xbbx\x02\xab1O\x01\x82\xfe\x1f\xac̳*\x1c\xfd\x12\x83\xb3(\xf4/\x99{\x1cw\x05g\xa0\x8bz\x8e\xa3\xdeLgb\n\xc2@i\x13f\x869\xa6\n*\x924\x98\x92Fc\xe3\xe3X\x96\xc4y\xe5T\xa3V\xad\xdd²D\xddL\x02\x8d`r9)5\xe4ɬ\xee\x8a\xce\x17a\x92\xbbԹ\x80\xeb\xde\xfa\xd6#\xf6[\xdf6\xb9\a\xbb#ʹ(\xb1\x1d\x98F\xaaF\xdbWN\xd6@B\x80\xa7\x11\xf0\xae}vWd\xe1- \xa58x3,\xa5\rk\xff\x85\x8e\xb6\f\xe3\v=\v\x8a\xfc6r\x12\x10'w\xcb0Z\xfc\xd6'r4S2\x97:\xa8k(\x02\x8bx\xb8\xed8K\x1d\xf9fM:d\x945i\xbc\xf4\x12g=\xa0z\xce.Tվ\x01\x04g\xaa[\x19=\xc7]\xb0\xa7\xdd\x05g\x95\xf3\u008b\xad0H#\x1b\xfd\xe8\xa6\xe4%\xe7\x00,3\xb8\x99\x05&&\xda@\x80\x91\xebp\xd4\x1e!\xaf\xc7\t\xdc\x1a3\x8c\x8c-CF\xc8\xc9ͺ\xa6\r\xc4Ag\x98p\t\xe2\x01\x9f\xdc\x11wc\x81o\x89\x02\x15H\xfe\xdd<\x158\xda\x0e\x1cVno5\x9b\x9b\x9f\xe0q\xca%7\xf2\x16\xb9\xa0^\xe4\x10U\xaf\x99\x82\x95Ur{\x12o\xed\xf5\xd2{{\xa3%\xf7\xf6P@TW\xf7\xe0g\xe1\xc6\xde>\x9et\x1a\x19הD\x01Ô\xbf\xb0\x978\xeaN%\xab\x8az\x1e\x94\x84\x89\x13b̍\xe7[\xcaA\xa7\xb5\x13\xec\x86'\xc1\x99\xcdۡ\xcdurB\xd0t}\xe1TyW\x15fVZOO*u\xa5\xce\xc1\x83\x02DT\x05\xfaL\xa8\xf7Q\xac\x9d\xac\n{\xb6$Q\xcd\xd1\xe94˟\xc9u,\xef\xee\xb6\xd0\uf77c[1M\x82Y\xea=:w\x8f\x10\xa6\x1am!\x92\xf7|D\x914\xe1\xdbwy\xaf\x14\x81\x96\x13sm\xa5\\\xa2i\xdc\tFxz\xe2A\xe0><u\x87\bb쇾\xa1\xf0\x02\xf7\xe1C\xad\x12\x85\x9b}F\xf2\xa2,\x1264\xbd3>\x13\x0fG\x0fBX\x1a*ҵM\x91\xf3T̀E1\f\xab\"\xc2\xd7\xc31\xe3\xfb4b\xda#\xe7pvtI\x1f\xadY\x99\x1dTR\xc3\v\xd3*\xd8\xd2\xe6\x18\x84;\xaea\xa1\xebnk\xa7\xe0\xcb\xdbU#u\xb9\xa3v\xb8\xe1\xeeP\x95I\x1dy+\xb7\xefɰ\x8f\x96\xaf\u0379\xa4\xc1Ʋ\x14\xc5taZ\b\xf7\xf8r\x0e\xae-b\xfc\xaf\x7fX2\x81\xa5$%\xf4\xfbm\x19s\x15\f\x88\x92\xb8\x16\xbb\xba'\xe1\x19:\xe7,\x97\xe7A\xdaJ6\x8fN\xce\xcb\xde\\\xfd\xdeb\xb8{\xe9\x8d-\xb1\x90\x8d\xbeuE\x86O\xbb\xccO@y\xa8\x87\xbamX\x84\xed\xde4XF\xc9 \f\xbeV{6[\xb4\xb8%J\nO\x9fs\xe5W\xdam\x1b\xc9/C\xe1}6\xdf\xc4\x00uښ\x82P=z\xb2\x86ز\x96e\xc9H\x92\xba\x89\xabB\xb0fãЉ\xe1\xa5od\xf0?\xd6p\xa4o\xb6\xdeb8>\x8d\xff\xbd\xd8x\x00\x94@\x06|&X\xdb\b\xd8x-{HP\x8dE\x16\x9e}hp\xe9\xd8dY\x16\x8f\r\x0e\x87cZ\xe6\xa5ݟ\xf7\x96|\x99\x8a\x04\xa3\xb9mW\xd9c\xb5\x19n\xb8\x02\xeaQ\xcf\xc0O\x126\xa2\xcb(g\x1c\xd7\xf2\xee?\xf1\xf2Ė?\xee\xfa\x1c\xfe\xdfe}*u\xbba\x91\xe1?\x026\xe6W\xe7\"\xec\xc2!^Ӛ\xedۮ;@\xb4\xc9\"D\x9b\xbc\x18\xa2\xb5_\x04\x9f\xd8pYJW'\x84\x161J\xaf=xp\x0f_Sͽ\x94\\\xf0գ6\u0092A\x1e\xbd\xfcҵ;}\xf9\x91\xba\xc5\xdf\xdc\xda\xd8\xfa\x18\x12P\xdeR\f\x85(e1\xb1Q\xdf\xd8\xdc\xd6گ\xcd\xe6fS\xbf\n\xb4\xb5Ukj\x97k\xad\xbav\v\xba\xb1٨7\xd5\x16\x8f\x9c\xa7~\fy\xab\xd9\xda\xca\x1eC\xbe\xdeOE\xa0\xccb\b\x1eH\xc3\xe7\x9d\xd3G\xc3I\xee\x14'\xe7\xd6N\x1d\x06Dmo6&\x95\x8d\xdaѢ*\x0eю!\xe7*4\x15\xaa\x92\x1a\x9d4)\xe5\xf2\xc1\x83K\xdew\x1e\xc1\x01\xf8d\xb2\xe5\xa2\x17\xa6\x97˖9\xaf\x17\xc80\xfb)\xc3\x1c\xe5\x18攓S\x86\f\xe0\xefI\x9f\x95̱r3\x8eDfY/\xedќ\xa5Z\x8c\xc4\xc5\x05p[\xb3\xb1\xf7Y\x0f\xeda\xd2;U\xfa\xd9\xff\xa8p\xd1*\x8bL\x1c2\xc76[@t\x9e#^b\xff\xf1X!\x03`\rzjL\xc9\xf3\x8b\xe4[Z\x90?Yv\xd1\rDKX\x16\x89wgs\x04)!\x8d\xf5\xb3?\xd6|-#\x9eR\xbe\x14\xedJ\x8f\fB\x89\xf1\xc8X\x00\r7Ȓ\x9a\xb2$\xe3^:\xb2%\x83\x14\r\x88\x94\x18\r̫\xbe\x9d\x9c\xda>'\x9e\xf72+!Ok\x82\xf5\x1d\xae\xd3 o\xfal*<\xe7\xafVw\xb5\xeb\xa1՚\x95=\xef\xba*\xda\xc2J\xc2PrjV\xd5\xe3\x01\xaa\xb7u\vx\xf4\xeb\xe9M}\x04\x92\x95\xe8:\xfc)Ab\xf1O\xe0C\x06W\x93\xf7\x89\xdd\xc6\xcc\x1d\xe0\x17<\xc8̝\xac,\x10\xce \xa6\x17r\x87=T\x96}V\xce)Ke\xaez\x93\x91G/]\x8bi\xfb\xa5kx\xe2oj\xcd\x013w\x04\xff\x8f\x00\xd1\\\x1b\xe6u\xa5Rɟ\xce怜\xce@\xd9_0d\xa6U\x84\x90%\x10\x8a\xa5\x10*p\xe6*3\x1f\x813\x8064\xffhQ\xc3yЍ\xec\xc8$\xd7v\xfe\xe8\xf0\xf9m\xe7\xeb3\xaf\v\x03\xc0\x01\x8c`\xfa\x88\xe8\x03@6%b\x1e\xa4\xf4\xa5Ԥ\xae\x8f;e)P\x8bj4\x93Sț&i:\xb3\x8f\x84\xb3[$C\xa5U\x12\xbaikA\xdd$+\x97WDA\xa7\x96:\xf83\r]\x8b\x01\v\x15<\xd4\x10\xcff\xbb\x19i7\xda\xea\x8e\xee\xce2'G\vv\x85\xae\x1f:\x17k\x11ӗ\x0f\xd2]\"1\xf2\x98\x92\x17\xaeeM\xf9ĸUQA\xbb7d\x1fl\x14s'\r\tO\xf8̘\x92l@,\xeb\x05\xe0\xccmlk`Y=\xba]\xeb\xc9\x15\xdc)1\x1e\fX\x04<\xa4G\x8c5\xbc\xc4X\n\xb1\xba\b\x9d\xe1p\xcfe譥\xef\x87]\xea'~\\t\x96\x8a1\x0f+\xec\x88\x1aC\xc2Č\xf7X\x1f\x81\x0f\xbcEK\xd6Z`\a\x95j\xdc\n\xd1ˬ\x8c\xb2\xdcsidn\x99\xe6\xc0\xc8G\x93%K';(\x11\xf5\xb1`\xe6\f\x9c\xef'\xbbݿ|\xdbB\x86\xbb\xb5Q\xdb\xde@ְ\x12\x98^\xfe\xad\xbf\xf4\xa8\xe0H3\x01\xca[\x11\xec\xf8z\x9fsr\xfbܽ\x98\xb3\x17U\xb5\x82\x1f\x98Ɂ\x82{\x9e@e\xa2\x16qsyВ@\xca%\xd4jV\x99ʧ\x16\x11\xfa\r\xa0\xa5:ެ\xaeT\xa23QA\x7f突\xbc\x1b\x93/|\x10\x0e\v\xe5\xd5@\x89T\xff\xab\xa4\xbe\x9d\x85\x00\x89D\xbb\x9b\x97\x1fM\xa1\x14\xbc$\xaf\xaeU\xf938T\xad\xc8/\xe7\xc7cJXVO\x92i\xfa\xf0\xcd\xe3\xce\xd1\xe1yz\xfe\xf5\x96\xe7\xfb\xf7\x99üKv\x0f:\x9a\xbe\xa1\xaa\xba\x8d\xa5\xe0\\P\xfd*\x97\xf5\x0f\xf0\x985\xa3\xc2.\x97\x17\xf6k\x0eΩEVr\xd5\xe5AN\xaa\x98\xabt\x0e!L\x96\xdb=R'\xd7\xc9\xd6\xc0\x88\xbe)\xb7X/?\xcd\xc1N\x02\xe0E\xbd\xa7\xec\xd5j\xe6\xf0\xdf4\xc0qY\x873\x9a\x89\t\xcf'\x91`r\xb6\x86\xf2\xc7\x02\x8a\x18\xe6\xb3F\xa6e沟\x9f+%õ\x17\xa0\xed\f\x1c\xba\x12\xa4\xd3m\x05ۮ\xd0</\x01\r\x86ah\r=\x9f\xd3:\xa4\b\x88'\xb8\x80\x9a\x0eX\xf1\xe2\xb8\xe1\xf4\x1a$[>1\x89#\xc6_\xa3\x11Ȫ\xccE\xc1\x17\x0e~\xa6\x04E\xf0[?\xea\xa0\xe7e\x89\x01i\x9e\xfc\xf4?\x86\xff\x8a\xcd\xed\xda\xdc9\x8fvξ\xe0\x9c\x87\xf4\x16\x9d\x8d\x92AJ\xbc\xf0n}}\xb3\xb6\x9d\x90\xb1av\xbd\"\xf5\a\x9c\xdd]\xd0\x16\x8e\x06<\xc8J\xf4\xa3\x9d\xd9ӕ\x89\x80I\xed\xf4\x00]\xe7H,,\xf1X\xa2\x18\x87\xc7\x13t7{\x803\xcdK\xb2\xb76\xad\xb6\aǼU2\xa8\\y\x96\x19\x12\x01\xd8\xe1\xf8\xb1\xcb\xde\xe2\x9e`w\x03\x7f\x02*%\x12%\b\x8aya\x9d\xf9֔d\xa6\x84\xf3S,\x12\x9f_\x18\xe2\x84\xcf\xce[@\xf8\x02k\xdec&\xc0\xb1\\\x9b\xe6S\xb5\x81WT\xe4\xcc\nw\x18\xfa3^\r/I/hO\x88\x933\xe8\xed\x12/:\xd4OE\x8d\xb3\x03\xca\xc3\f\xbd\xafҟw\xd4 \x1c\x0eGb\xd2>'\xb4`Y\f\xbe/\x8f\xf5u\xc5\xce\\J\xe79\xc6\xcc\x17\xd91\xf8>\x8eߕ\x99\x12\xee\x9e\xe73p\xbccM\xc9];s7\x86'\xc0xm/\xf6]\xb8\x92A\x853\xb8\x13xp\x82ZܜV\xaa\x89_ #\bKj\x96\xc1\xaa\x06Q\x83\x91,\xd2·\xed\xe7k\xb4\xe1LJL\xc9c;\x9e=\xbe\xba\x03Qp\xcb\xc6?LQ\xecM\x88\x9b\xd3G\x19\x16y\x00\t\x03\xaf?\xf0\xbd\xfe@샾\xc1\"\xf7 2\x19+5W\b\xb6\x1a-\xbc\x86t?\x97Ű\xc8\x1b\x10LU\x02\xf9Y\x81\xfb\x02p%i?\x1c\x0e\xc3\xe0\x10\x18\x81\b\xaf\xe4\x1cL\xed\xbei\x91\xd7m\x9e\x9a\x12\x15\x142ʗ\x18\xd9˥'\xcb\x0f\xd2ظԫ\xdc\xe5.\xe3\xcc}\x83\x8ev\xbavWʽ\xb9W\xec\xe0\x90N\xcf⑽\x87\xd5t\tz3\xcbʢS\xb3\xa7\xf6Qa5/\xcc\xf40ɔ-R\xa5\x0eyb\xc3_\xdb~\xb8\xab\x96>\xfa\xdd\x7f\xa85\x19\x0f\x8b\xb6qA\xba\xb3\xa82\xb6\x19ذ_\xab\x92\xb6m\aI%\x9aRh\x9d2\xb6\x94:8*\x97\xcd\xe4Q\xbb$\xc3\xd0䤋\xac\x93e\x11\xa0\xf9 \x06Ev\xaf\xf0\xa0\\d\xed\xa2\xab\x94^b\x96GV\x8epWY )}\xd66Bt\x1a\v\xb8y\xb4\xf4\x85b\xf2\x92mt\xbd\x80\xf2ɒ|\xe0\x86\x86\n\xc8\xf9\x96\r\a\x9alk\xe3y9\xf5\xabǙ\x03\xc0\xd0\x11L\xacE\x823:\x84\xc2])a\xac<\xd32\xbbz\x9c\xbb`\x1dF\xfblݰ\x16&\xd2\xd8\xf5\xc2e\x89\x97\x9e\xcbT\xe2KR\xbe\x95y\xb1\x8c\xd3\x13\xe5d\xe8\xc7s\x87\v\xa9s\t\xa0\v9\xe5\xff\xfet\x81b\xdeKtqz\xf5\xa8\x85\x1ee^;/\xa9\xe7\x83DPꅼd\xdcx\xe8\xd3\x05=\xfd\x90\xb9\x9e\xf2\xb9\x04\x02\x11x\xe8\xfcl\xb9\\\x1c\xbc\xab\xb5\xf1x\xbc\x06\x83\xbb\x16s_=0\xe1.\x1f\xc5a\xec\voD\xb9\x80'\x16\xca\xe5\xa7Z\x97\xcaA\xa6\x15\x1e\x8b\x16.\x0e\xcd6)\xf5\xdfk;\xb9\xf1\x03\xab/4\xa6\xa2G!\x1f\x1a\x16\x11\x05jqx%\f\x8bp\xfbv\xcdd>/z\xb9'\"\x03k7h\xcf\xe7&\xa3ۈ\xcd>[sRӦ)y}\xfe\xb8\xebq\xaaf}}\xba\xa0R\xa1\xd49\x8b\xcfT\x04pv:1\x0f2\xb7\x94\xbd\xeak\x8bl\xa0^#&\xd7\xe4\xc2#.\x9a$\rI_?`\xd2W\x03\xc5\x19ua\xe3O\x1dh@\xb6ҥ}\xb0\x8b\x9b\xff(\xb4̾\xb6\tP\x93\xd1E3\x04/\x19\xe8\xec`9O\x0e\x12\b<2\x84\xe7B\xfa\x05\x03\xd5\xe3$\xacשE:v\x7f\x01\xed&\x17ً\xe2Ì\x8b!\xfb\x85h\xed&\x02.\xc9G\xe4\x8e\xde\xfc\x86\x96\x94+5\xf2\xa6݇g\x89̄]\x85\x85\xa9cR\xc6I\xc7 \x05\xf1\x04\x1b\x82\xf1^\x92x\xb605\xa9\xec\f6\xbe\xa4\xba \x1e\xe26\v.?ugT\x1cb\xb4\x94]\xd8\x10ߔ\xf2\x01\x8e\xef}\xdb0v\f\n\xeah\xb0\x9d=\x94\xf2\x9e\x94\xe6}\xfb\xe4\xcc\"f\x8e\\B\x02\xd4z?c\xdb\xfad\xa5\xb6\x98o\xb3,2\xa7+\xbf_.\xe7\xab˪\x02\xae\xee~\xae\x84\x9d/\xa1\x00K\v\xe4\xde\x1b\xbc\x9f\x1a\x05\xbengE1_\x8ez\x1fK\x99\xa3\xd0\xc7\xd6Rs\x12\xc1\xb5\xc5ې\xe4VS\uef05\x18.\x15tM/\xe7\xc9\x1a\x1eK\xb7\x87\xd3\x05+\xc4-.ɬ\x925'\xf4ϱ\xe0\xf4\xf9K\xb9\xbb\x9b\x95<\xc7R\xa5\x14\xc5۳IƔ\fIwwt\x93\xd9\xe2\xff\xfa\x87\x9f6\x92C\x95\xe7\x13\x92\\\x03\xb8Z\xa6\xe4\x90\x1c\x97\xcbKZ(\x94\xe4\xe1hM\xaf\xa8)1̗\frL\f˰\xc8A\xb9|\xa9D>\xa0\x14\xae}9O)\xdc̘\x1d_c`\xcbfL\xa8\xe9B\xcd\xf0\x9a\xd2\f_\xfd\"\x9a\x06^}\x96\xfa dN\xad\x8f\xd5\xdb\xec)kcJRj\x90\xc6Y\xbb\xf9\x90j\xc0za$(\x9e\xa0-\xa7ϝ\xa9E\xc6/b]\xc1\xc85J n\x8e\xb9\xf6\xa8\xaf\xd9\xf4\xd7\x133\xb8~\xc18\x7f8+\xe1(\t<\xe5\xe0.v\xef\xb7/2\x1b\x9en\xa2\x80\xd8\xcfx\x03-Q\x90\x93\xe1\x19XW\x93\xae2-\x9d\x05\uf35c\x84\xce\xecW\xcf\xcd!a\x16\xf1\"\xcdb\xbb\xed;\xb9\xc0ݑ\xba\xed~\xd7\x1cB\x9e\x03/\x82\x8d\xc9m;\xa6e^X\xbb \x90^$O\xfc\xae -\xb9[\x87\x94t\xe2-4&\xb0\xf4\x89\xd8gl\xc5Q\x1e\xabs\xd9_\xb4s\xf6e\xe8.\xea\xaee~\x06h\xdc/\xda\x06\xf8g6\x96!\x0e\x0e\xfcs\xb7\xd5's\xd3v\x8f,\x14X\x13\xe6\xbb\xfd$Q\xf0*IO\a\xc0J\vY\xb6D~\r\x03%\xc3\xe0\x98w\xf0iF%\x14\xaa\fW\x89h\xf6 <\x02\xa1A\xf3e\xe0\x0e{f\x96\x9f'0\xaaQ|1\xfc{3\xd1C\x05\t\x92DEQ\xf13y\xb9\xb7\b\xd0t\x81\xe1\xc7\x1dr\xfd\"\xd27\xbb\x1a\xd1\xc0=`#1h\u05ca\x82\xb7B\xfc\xa2Td\xe5\xe4qe0\x9c\xe9D\xbaV23s@=X\xa2\x19;O\xee\x9e\x1aE(}\x1a\xf4cp`\xf8\x8b$U\x1b\xaa\x8d/\xb0\xa4\xfc\xcc\xd4\"\vw\xd1\x05(t\x9f\\\xeb&\xdaO\xb0'\xc7\xd6M\xda\t=\x85`\xbc\fv%\x9f\xcc\x1dU\xc1\xd68L\x95\xe0\xc1rӉ\xe2\xec0\x027Y\xb3\xb0\xc0p\xf6^[\xa1\x1fጅ/\x88\x86x\fb\x82\xce)̝x\xa5*Lx\xc7\b7\x97`9\xba\x06\xcf߾t;\v\x8e\x92\xe6J{\xd9\xe1\f\x9d=\x9c\xa9D\xcf?\x96\xe1\xb7?\x93\xe1/p S\x89\n\x11i\xbe\xc5\xe71:w\x9a\x98\x9cĨW\xd3ћ\xd0V\xed\x13\x1a@-:\x8d٬m5[\xb7xoR\xab>\xb3\xcb\x7f\xb3v\a\xa3\x14\x15\xe3\x8f\xfd\\\x8d\x1a\x91\xf9\xe3\x91t\xd0\xcd\xe2)\xc7\xdc\xe1Ȃ\xa9\x993\xa8_2\xb5b\x06uf\xad\x8aҖ\xfbLt\x84\xe0^7\x16\xccT\xechr\x05]\x1d\xfc\x16\x0fb2g}\x99;\xfdrY\xa4\x87\xd7\xc5k\xb3ˬ\x1ff\xe1/\x9e+\x16N\xa9\x85\x89\xe32M\x8f_\x0e<\xf7\x8d0\x0eD\xd1*>=\xf0\xbe\xa1\xdb;\\\x9fue\x13\x90h\x97\x98-\x12mX^\xcb\xc4\x12-\x93\xb6\x86\x8a\xb9oX\xb7;\x11*B\xb5l\x01.XiA\xf2\xe2z\nw\xa5\xd0'<Z*\xc4\xe4O\xf0\x12\x8a\x86\xb9\xf4o5P\xa16\x1c+\xbe\x1d\t\xb7mD\xaes\xf6\\\xed\xf8Z\xa4\x87\x9e\xa6\xe7\x9bI}M\xe7\f\xf4\x93z\x96u\x00F\x18\xaf\x04\x16\xd4\x10h\x8e@\xad\xd9\x03H5M\xe9\xe4\xe4\xa7\x01\xfb\x13\xdbQ\xa2\x00T\xe3\x17\x19\v\xab'#ۜ\xbfė\x1a\xb9i\xf9y1h\xd6L.ҳ\xe9\xed\x1a\x1d\xa8F\xb3w!?~\xa3;~\xb1\"S\x90\x10\bg`\xce\xcc\x17\t\xadry\x04\x0f\xf1H\xc9\xd3u9\x9b\tVi\x88\xabT\xcc4\x04\xda`ek2s\xfd\x84\x88\xc2R\xe33K-X\x80\xcb\xe1\xd2\x1d\x82\x16\xe8Qd\x9bEO\x8e\v\xd0)\xb8q\x9c\x9e?\xfd\xc0\xd9G\xb8\x97'\x9b{\xfc\\\t.3K\x89\x97\x18U_\x0f\xc4\xd0?\n\xf9\x8dyծ\x9d?Hԇ\x8f\xf9\x1dBsq\xc1\x94\xa8\x8b0|\xde\xe0Y݃\x99\xab^Yz\x18\t\x93\x9c\x1f7\x9c\xe7|Ĕ\x14\x92\x17Yy\x96ˏJk\xf0`˂4xbת\x88P\x99\x1a⭈\xddx9g\xf4\xfc\x11v\xf4\x9b\x92\xa0s\x05\xbe(yc\x12\x9e8j\x1b\xf3\x15\xe4\x14\xbb\x14]\xf0\x92x\x91!\rf\xd0LV)\x87\x1b$\xbeI/\x19ߤ\x97T^\x8e\xa3\x85w1\v\x17HO8^\x98_\x8ae\xa9V\x88/@\x15\xe1\xea\xe6\xb8uC\"ͫ\xe0v\x97\xe3\xdb\xcc~\xbe\xe0\xf8{)\x8b1\xd5\x1d.4U\xe8\xf1r<L\xb8\xd46\x83KZ\xe8\xe6P!\xa2\xc6q6\xc5g\x1d\xac\xf6\\\x15\x1e\x1e#\x14/\x12\xa82\xaa\x1a N\xb7\xef\x03\x99\xeb\x7frA\xd3Je\x18\xe5\xa4\xffv\x9c\xa8\xbaC\xad}Yy\xd1\xf1\x18\\\xe8\xf1:F\xc4\x04\xd2RV\xf1-NGG\x14\x18\xc5\x05\xbe\x0e\x17\xdc]\xae7\xab\x1b\x8d\xc5\xf6\xe5Eg\xad\x99\x81Lz\x8b\x9d\x8e\xbcTb\x99\x7f\xec\xa8\\6\x8b[\xbeѨT+\xb0\xccE\xe2\xb6pÚq\xf5:\xd3H\xa4:zc#3m\xd4+UÚ\xf1\f\xab\nk\x83\xe5\xc0~\x15\x1e\xdaÃ˂H7\x17\x91ފOj(\xf9\xe6\xb2,\xa6e\xcd\xd9\x7f\xb3\xe4\xb2o@8\xb9\xbe\xcb=\xbc\x0f2wAY\x90 \xbd\xb1^|\xb3\x19&\xb6\xa4-\xf7\xda%\a\x8e\x99\x83\x97E\xa9\xcfDI\xbb\x86O\xa4\x9aVsc\xb3\xf6ɤ\x9aV\xbd\xba\xb1\x81\xc8a\xa2\xb3\x14}\xfd\xa0\xd6ܨ!z\x98xuD?\x9b\xd0l\xeaWdL\xf4+\xa7M=\xaa[\xfaZ\xc7V\xbdVp\xf6<\xca\x1c\xc5f\xb7\xf9s\xf74\xa0\x8f\xfbYBvKc\xd1\x1b\x8dE'\xb1Aя,\\\x89\x99\xcd\x12NQ6\xcf^w\xf4\xb3g\xbc\xb2\x9b\x1dY5\xb1\xf2;\x8b\x9d\xf8dc\xbaݬ\xcd\xfa\x9d\xdd\u07ba\x85\x8b\x18\xed\x17\xc6I\xc77\xbb!s\xe2T\x16\xfb\xe7[\xec\xa3~\x81\xa7,\x9e3\xf3\r\xa6ً\x94\xa1\x1d\xc0\x93\xbdF\xb1\x84q\xd6>\x99\x8b\x9a\xf3\xf9\x8a\xbeB3\xd0\xe6\x9d\xda-\x81.1\x8e\xca\xf9\xebA\x90\xc8\t%љ\x1d s\x1e\x17\x0e欙\xb6O\fm\xe4\x82\xceK\b%\x11A%\x962F<#\\\xf1\xeb>qr\xb6B7\x16Q\x8c\\\xac\x9fT\xdb\xc97\xef@\x9ac\xe7\x92\xf5ԜT*\x95љ\x1d\xe6\x99\xc9\u009bD\xd9\xcb\xedff\x93\xc2\x13\x80\x18\xbcT\x8fG^\xf0\x92]\xbeE\x01-\xfa\xb6S\x89foi\x13\xa1\xec\ro;\x10\xfes\xa6(\xef\xa0\xf1\x85\xa7\v}\xf5\x04;7\xcfM\b\xb7Z\x94S\xbc\xbd\xc2\f-\x05,\xf5\x06\xf9\x02\x00%W\xd05\x1ayχ\x8b\x12O\r՝\xcc\x15_8\v\xd7b\x0f\x94K\xe0\xd2\xd7\xf4\v`坡\x85\x05ghT\xc1\xea\x91h!\xac\x99=\x8bG\"5\x88\x05[\xaa\xa5C\x98\xf7r\xf9\x8f:\xa3\xb3N!\x17@\xb4\xc0\xf1\xe6-@j\a\xc9\xe5\x06\x9c\xcf\xe7@\x13\x90\x90\x18\x89\xc7Û\xc0Y\xe8\xe4s)\x01\xd5\xfa\xa5\x1c\xd5\xccI\xb14GA\xbd\x94\x82\xce\xf1\x85h1\x18*Z\xba,q\xb6w\x1e\xa1\n\xea\xe7\xf8\x1d]\x02\xf8̍\x95y_oj\x89P\xfb\x04\x9d\xf5h\x1f#\xc6}}0V\xeay\xccw\x93\xa73G<\x04#\x1c\xb8F\x1cV\x86^\x14yA?]\xc3\xcf!ɜ\x04\x99=\x01AڙxM\xa1\xca\x0e*\xad2i\xfc\x17\xd9$*\x97\x17F\xa7\x8f\xef%z\xf9\x05y@\x95\x17\xce:F_\x02X\x9eD\x16`K\\\xa6\x17_\x1a-x\x9d\x82*\xc5\r}ӯ\xc8-d\xee\xe2\x80]\x8dpW-q\x16\x01\xf7Pz\xce<\x83،\xf8\xf0\\\x0f\xb3\xb7È\x1c\xe6\xd2e{\xe3\xec\x10Ys;2\xb5>\xfe\xf4\x9f\x9c\xe5\xf7O\xef\xcc.x\r\xcb\xf6\xcfݟ\xc5\x1cz\xa9\xf1\xcc\xed\xe7\xf0\xe4,\x99\xc4\xf6MC\xff\xf0\xf0\x86\x11\xcf<S\x02Y\x85\xc7\xdcm\xfe\xbcaGJV\x18\xf6\xe4\\h\xb78\xf6\xbb7\x91¹a\xd1,K\xfb\xb6\x85\f\xc3\xd2/TV\xb7n\xe9+(\x9a\xf7Yܟ\xb7[\xd6\xee\x86\xd1rv\xf1\xa9m\xeas\x18\xaa\xbb\xc9l\xbdK\x064\x82c\xe5\xdc1\xb4r;|\x93i\xb4\xf2=<o\x1e\xad<\x0e\xd3eN\x90\xcf\t_\xec\x01\xf9\x98\xf0%\xee\x8f;$Z\xe2\xfb\xf8b&E\xb5\xbeO\x16\x1daa\xd2݄\x86\xb3=\xd6\v9K\u0383!\xedq\x9av<@\aQ\t=\xc4\xd47\x9fc\xcd?/\x95l\xd7\xe7\x1f\xc6S&\xff\x05S~-\xa9\xf4\x12\x0f\x96h\xbe\xaf\xdd\x02\xb8\xd95\xa4EO\xbb\x96r>\xean\xeb\xb4\xdd\"\x81]\xdd\t^\x11;\x01:k\x0f\xf2N炳\xecy\x83\xec\x82\xc0-\xddޥ\x96\x13\xbd\xd4c]`\xed2\xe5\xe8=9=L\x9c\xff\x0eMs\xeeՎ]\xa0\"/$;\xa9\x95ϵ\xe2\xdb\"\x97I\xbd\xb8ȖP\x06A\xf8\xac\xb0\xa2\xadv&\xb7.>ǂ\xa3Y\x9fe\x91nv\xc1\"w\x87\xabp\xce\x1d\xccX)\x84S\x9b\xe5G\x98P;̏\xabr\x03\x98\r*\xb52\xf3ݠ2\xbf\x9a\xd2wG\x13\xae\x02\xad\v\x96_]\xb1L\xd5\xdc\xfd\xa2\xe3@\xf3\x04\xef\x88Fi\x8f\xd3R`_\xc8HPY\xb4\xc6\u0379\xcc\xc5P\xb6\x97#B\x90\xf1?\xcdx)\xa7R+\xb5t\xd7X>p\x90\xd5\xcb\xd2\xd3aJ\x87u\x94\xdb><\xb0\x0f\xf2\xd4\x03v`\xbf\xe1U\x86tt\x18\b\xee\xb1\b\xdd\xce\xe633x1i\xf7\x84\x81{^\xf8\x9d7\x7f\xb7\xce\xda\xccJ\xaeؠ\x01Yު}\xae\x19\xcb\"4\xc1\x82\xc8\xfeǘ`\xfa\xb1'x\x87\xd9++^\xb9\xec\xc1\xa9M\xf6\xc2ka\xda\x0f_h\xa1fB\xa5\xd6,\x8c\x92}\xd8\"W\xb7\xae)g\x8f\xab\xd7\xfbyV\x16v\xeeB\xf9Ldԩs\"cV\xcf\xf1\vЍyA/\xab\xa7\xf3\x02\xf5,\x10Ѳ\x8a.\n\x15!\xf6\aK<V$\xa69\xf3\x17\xd8\xc1\xe4f'\xb0\xc3ݓp\x99@\xc6\b_.\x90A\xe2\x94\xf9\x11\xbb\x0e\xecey\x04\xe4\x99!\xe7\x81\xee\a\x10\xf4\xfd\xfcF\x01\xdd\xe0/\xdc\r|\xaf\x90\xdf(W\xb2\x1b:\xa1\xbb\xc0\x97w\xe1l\xba`?B$-\x9ccBw\xee\xe6\xbb\x03\xbb5'\xb7\x9b\x970\xd7!\xaa\xe7\x85p\x9b\xee\xa6HB\x97u.8\xb3\xda\x19*-݄̓͞p\xfb\x16\xd9w\xb8\xcd\x17\xf5R[*\x04\xf9\x97\xcasg\xc3IߩM+\x9c\x8d|\xea0\xf4\xd7{\x9f\xf5\x0f\xafF\xe6\xa3kp\x9a0}D\x8c>\xbe\x1f\x0ecF\xe1\xf3\xd86\xef$\xaf\x17%\x95\x98\xc2\x16R\x9e\x9c\x91\x95\x95%K\xa5R\xa9\x88\xe2VoYX\x98,`\x9c\xe6_\xa7'\"\xc781\x8bp\xbb\xba\xc3_a;|u\xd5\x12'<\xcf8\xf1\xdc\xdbw3or>\x9fq\xc2\xe5i\x83\xfc&\xceNjg\xd0']\xdbJ\xaa\xdeLHu\xa5R\t\x16\x91_4\x03\a\np\a\xf8-aM5\xbbzG\xefxo\xda\xf3/z\xc26\x9b\xf0\xb8\xb9=#\xcf~\a$\xc94ñ\x87\xf9\x84L\x9c\x00\xdc$\x91և\xac\x8c\x16\n\xb9\x91\xb6\xfe89\xcbkw9\xde\xdeX\xb4-\xcf8O\a\xc6\x06\x1dHj\r\xf0\xa2\"'\xeclg\xc6X\x06\xe7\xa5`E¬W\xaa\xa0)F\xa5\rK\xdd@ϛ\x9a\\\xd3\x05\x0e>\xa5\x8cr%\xf5[P͍\xdame<e\x89\xa1\x8e\x1fg\xce#G\x8b\x1fWӂ\x02\x9dqu\xaf\xdeVõ\x18\xeam\x91Dٛc^\xde \x03͘I.'\xec\xa0\xfe\x92̉#\xe9\xb9\x02\xb1m\xce<jv\x9d<j\xcf27\xf8\x15G\x99z\xbdR\x83;\x9evd2\xcb\"b\x9a{I5Ga\xa2dg\xcf\xda!f\xde\t\xf7\xec\xc9\xe6\xf3W\x95b\x03i\xca\x06\n+[\xeb\xf3\uffd1 \xb7\xd6\xf9\xab\xb5]\x9e\x7fي\xe3\xcbV\xc1\x82\x97\xadҧ\xf4aa\x16y[5\x89Nz𔐭L\xe6a/,Z\xd1J\x86+\x16\xa0ޔ\xe0\xa9\xe3\v\xbfˠ\x9fH\x1a\xd0\xe8\xb50\x12\xe9\xc3HsN\x12\xf5\xbd\xf6A\x92\xa9O\xe0\xfa\fZH+\r\x04\xf4$\x1ej\xc9{BF<\x84s\xc1(y\xd4H9\x19O_1ʰ\xfe\x90\xe4\x8e\xe4\xaff\x97\xc0\xf9\xe2%\xd0hlo\xd7\xe6^\x9fT\xab\xa1\xf8\x86Y\xe1\xc0zΕ{`\xf3\x17\x1cxoN\xa6M\xd1\xc0j\x8bb8\x91r\xfdle\xd2de\xc6vdfK\a\xba\xaa\xfdt\xe5\xdf\t\xf4\x17\xaeY\x9a[\x82\xa3%\x99\vk6_\xa0\x97\xbb\xc7=\xbaa\x99:z\x99\x92A\x1e\xd0ެ\x87\x8f\xccc@\u07b3<L6\xcd{\x94\xa7\x85c\xb8]\xd1\xceA\x85\x0e\"][\x89I \xac\xa6\xd7\xd9\xd2g\xa3\xaa\xa8;\x1c\xe6!\xc9$\xbd\xb7<1\x98\x91h\xa2\x19\xda\xf2\x1c\xbf\xf7\t\xbb\f\x1e\x8ccri\xc7dbǤk\xc7dl\xc7\xe4p~\x00\x96R\xad\xfcH_\xfdL\x16z\x86o\xf91d\xd6.ˏ\xa1\x05/\xcd\xfc\xac\x9a\xcb\xe8\xca\xcdMNI\xb3\xda\xd8\xdc\xfa\xd9\\\x1e\xd0\xe7\xe6f\x95\xe8u\\Yf\x8b\x93\xed4\xca\x10\x04X!\x9e:1H\x9ck\x87\xc5k\x03\xb4\xe8\\\xdb˻\xf3\x8eH\x18\x80WLm\x91\xe4\xabS\xcfxjs\xe2\xd8\xd44\x12\xaf\x99\x99\xed\f\xbe/\x02\xbcG\xfe\xd6\xe8\xdc\xf5\x02G\x99\x88\xc5$\x05IU|#(3P\xa7vR>\\٘3wAO>S\x82F$\x9f\xf01\x86\xfa\xc6VU۬\xc0\xacj\xcfH\xad\x8d\xed\xc4\x15i\xb5\xba\xb9\xad4\x9a\x9b\x8d\x8dV+\xf5\x8d\xb4\xdd\xcc|#]'.\x1arNGa\xe8\xee\b6̹\x17\xcdnJ\x9f+\x81'\xe71\xf4\xb3\x8c\xa3vY\xd0\xe1(\xf3\n\x8a\xaf3\xfb9s\x960\xf0\xbd\x80\xe9\xc7\xe9B\xbeG\xdd>\xcb\xcc``;\x04\xc8\xfe\uf0db\n\x15\xd2\xe9\x8e\x00\xfb|;\x84\x93\xbb>\x13\xbba\xf1>\xb2\xba\x80\x15ϥ+LT\xa9\x88\xbb3w\xfa\xe3r9w'6.\x97\xfd\xe2\xb5X\xbfp-\xd6_\x88\xd3y\x17\x00\x99\x15\xa8\xa7\xf9\xa7]C\xbb\xf31\xc0c\x8fr瓦y\xed\xbc\xe9\x1f\x8cT\xb4\xd0و\x9b\xdc\xe8\x13\x95\xecz\xdfM\v\x00\xb1\xf7v\x93\x9db\xa8z:2?\xf7\x83\xbc\xbdQm\xab\xda|1?aۭV\xe2\x97W)\xf0\x1d<\x0e\xa8\xd6Z\x99\xd1\x11\xf0\x96~\xe5\x1c\x8c`\xc1OȐ\xf2\v\xc3\xda\x19U\xd0\xcd\\\x85\xc7>\xe3\x15\x16@\x7f\xcd\x13m\xd7z\x06J\xc0\x88\t\x13=\xce>\xc0\xc1j\x1b\xe7]\x9f\x82W\xd6졫\xf46\x86\xf68\x9d\x9b\x1cn\x1b\x85\x9bg\xc9\xfd\x84\xa5\x8eK\x95w\f\xb8\xc1\x90\xe8\x1f\xe2\x88=\f\"\xdac\xe9\xf8\xb1\xa9\x1d५QE\x1b\x99\v-C8\x95\bD\x88\x85e\xb4\xc9\xff2+D\xc0\xc7\x18\xcf.}-z\xf9\xcb\x1d\xf5\xb9\x80F<\x8c#\x7fr\f\xfbq\xc0\xf8k\x0f\xdex\xbd}}~\x0e&\xdd\xedxJ\x8aާPa\x16h-\x85\x02ɰ\xa6En}\xa7Wɻ\x8e\xb2\xafs\xe3\x06Hb.\xe8\x16\xfa\x8f\xd2\x13\xa1\x9f\x1b[N!z֔ \x05\xfdٿ\x9e\x93CP\x85\x81\x8aZ7[\x9b\xf3\x9e\x8d\xe9\xa2\xeby\xa9O1\xd5_\x96l]E\x8fbɛ\xe4\xfeZ7\xbc2\xce@d\xcan̮\xc0\xa5\x1b1\x7f=\xba\\6\xb9\xb6\x8b\xc8G\x93\xc0\xa6Ͽ{\xa7Z\xcbʭ\xc1\xc1? Δ\x18\ail۸\x8dSG\x9ey\xd6\x05{\xd2\xd9\xfcQ\xe5\xf3\x89\xb1i\xae\xcb\xc5Qq\xf5=\xd2\xfc\xad\xd2,s%\x17-euZp\xbe\x8b<\xd6r\xe4\x00\xcb2\xdci?\xd9[\xd2j\xf6C\xf4 ^o\xe4\xde\xfd\x06>vy\xeba\xe5\xf3֔\xe0\xa6\xff\xdfi\xe7T\xc4F\x19\xf8f\xf6\xf7\xc1\r\xee\v\xe6\xf7\x046\x7f!ӈ\x86\xd4\xf7\x8b8u\xa9\x18\x8d\xb5\b8\rcJ\x82\x85\xfe\xa4\x17\x94\x00\xa4\x83n\x18\xca\x10\x1d^l\xdfܪ~2o\xca\xdb\xcdZ+\xffP\xbbҞ\xae\xd42i\xd6\xcb\f\x7f\x97\x99\xf2&Wf\xaf\x95\x85\xc71$\xb0\x82ԛ\x88c\xf6J\x95\xcc\b\xaf\xc9eq\xe4͠\xe4s\x9e?ӫ\xc1\xb4\xc0ݢ~\xbd\"w\xe5v\xc1%C^.\xab\x17\x11I\x15\xc8\xd5J͚\x83A\xfdGZ\xf5Z\xe3\x96F\x12Z\xe7w\x1cx\xa3\x11\x13\xbf\xc0\x02Ɓ\x1f<wb\ue7cf\xe0ɚh\xc0|_\xdb\x0fܘ\xbbK\xa3\x81\xb6&\xb81\x9f3T\x96\x00\xfb\x85\xc7\xdb\xf1@\xbfh\t\xa0X\x88\x821\x80\xf6K\xff\xdc\xf7\x9bj\xf5Zk\v\x8d\x01*\x819R\xb6\x00͍\x06\x18\x17\xb8\x187\x00y8\xf5\x13\xd8Ǹ!\x1c|\xc3=\x84\xcd\xea\x06\x1cb\xa7\x17c\x13\xbf8\xd9\x1d\x97d*\x8d\xf3O\x7f\xfa\xe4\xcc\xc8k\xe9YzS\x89[\xafTwY[\xbd`ƴ\xefrn\x01\x8f\xa6\xb2\b\v\x0en\x99\xfd\xaa\xb1斀cdR\xae\x7f\xe1\xe4\xfct}\xedl\xbd_\x11,\x12Jn4^6VY\xaa\xe8_\x7fy\xbdO\x8c\x97OO_~ٰV\x8d\x97\rr\x98\xaf\x04\x1d~\xa5yO\xbf\x00\x99\xbf\xf0\x05\xc3\xcaŝ\x1a\xeb}\xf2\xf2\xe9\xe9\xe9\xa9\xf1r.\x1ec\x8dB\xd4i\x80\xc5\xd1\xe1\xfan\xae\xda5\xb7\x04\tkn\t\xd3\xda\v\xc1~\xd9xy\x95\xad\xbel\xbcL\xae\n\xbd\xdcem\xa88\xcd\xf9\xf2\xcf\x1b\xa7\xc1˫l\x06\x92\x19\xe8\x1eA\x83\x8f\x1e\x15:\xf2\xd2:1\x1e\xbddX\xab/\x9f\x06\xc6Ͽܾ\xcd\xe8-\xe8\xa5\x1aP=\x9a\x19\xcd8\u05f7XK\x02\x94\xec\xa0\xf4L\xfc\xa7\x84\xbd\x92\xd6\x14\xe1\xf5\xa8\xc2\xeb\xa1\xfa\x90\xbckrk'(yA$h\xe0\xc0\x02\xbe\xac|\xber\xe4\xf9\xe0\x98\x137\xf2G\xa5\x92\x01~\xc0\x8dv\xe9\xfa4(\x95J%\xf5(K\x1b\xa2\x83\n\xfc\x9e\xe2/ \x00\xbb\x8f\x88\xce\x03\xa1$\x0f\xfc\x9e\x1a\x8fچ1\x85\xd4\xe9#\xab=_\xf9K\xd7x$\x90x\xbbϺm\x9e\xf2\xd3@\x9ery\x1aX0\x02P\x05^p\xd3\x1cݣ\xeb\xd3\xe0\xa5\xebԍ(L\xf4\xf44\x98>һ\xf1\xb1\x9dw\xe8O\xb8\xea\xf9\xfck\x8d\xaf6\xca\xe5\xd4\xd5e\x9ax\xd28\xdb\xcd\aP\xb6\xb1Wj\x84ک/>Ͼ\xdd\x01\x13/\x1e0)\xcb\x1cv\xb3e\x0e]\xb5\x8d\x92\xb1\xea\x14\x0eք\x95\xe7n\xa2\x7f\xdc\xe6oj:\xb6\x91'Y\xb5\x1f\x95\xd0\xf59\x19\xe5aIo}/0Sz\xb5\xbap\xb4\xab\xf9Ѯ\x9e\xb5k9@zx\xdbҀ\xc9Oh\x97\xb0\xa6X\xff \xb9\x00\xa5|\x86\x83_\b\xb0\xd0]\xb5\r\xa0\xe5\xc6j@\x98\xf2\x8f\x05A\xedX\x06\x98U\x0fv&]\xb4\x90d\x11\xcf4\xd6~\xc9H\xee]*\x13P\x88\x8fM\x8b\x8cL\x8bD\xe8\x1d?\x7fa\xf3\x91E\x06\xe5\xf2@\xf9\x1f\xd4˰$Ja\xaf䚖9\x9c\x03Q\xf7bh\xa9\x91\x1a\xee\xe8\xaa\xf1j\"\x1c\xa7\xdbb'2\x8d\xb5\xd7\f\xa2\x1c\xf1\xab1\x86\xfd'\x04\n\xac}\x95\xac\xc1\xeazi\xddKHI\xb9\xbc\xfe\x85\xd4\xe7\xdd\xe9:\xfaɃ\xdbui\x16\x9e\xb8\xc4\x19\xdb\x05\xea\x80\xdb\xdc!\f\xdc\xd8\x02\x1b\xe3r\xb9oZ\xe6\xa1}bܻ{\xfc\xc0 ƽ\x87\xf8\xed<\xd8\x7f\xcdH6\x87ù\x11J\xba~\u0088\x00\n4\x9e\xa3:\xdc\xee\x82N:\x1bIc\xedȰ\x88XH\x85<\x13\x9d<\xd9?\x0fK\x1c\x89\r\xfcP\xb4f\a\xfe\xd8Ix\x8a\x04\xe6\x91\xd5N\x8a\xe8\x87\v\xf0\xa8\x1bz\xb5\xa8~+7\x9fk8NkJQRz\xf9\xe7_\xba\x1e\xab\x16_~d\xed@-\xd7y\x90ݒ~\xfdS\xd8\xe3\x9dIQ\xf5\x1d\x99@\x94\xad\xf6\x02\xd9\x1b\xf5\xee\xcaD\x1a\xea\x11\x96\x06p,\xa5\x1agt\bP\x18R)\xcdbð\adZw\xfd\xdc\xf7\xb1\xc9\xc8\x151\x1e\x9d\x06\x061*슡\xe7:\x9d0&\xc6\xe9)PF\xb2\x9fD\x1d\xea\r\x13^\xb6\xdcln~2\xce\x16y9}p\xb9\xb5\xd5Ҭ\xd1F\xbd\xba\x95\x1d\\*\xb9:w\x9f\xed\xfa~\x81\x01\x8br\xcf\x03\x81#\xdd`\xe66\x1b\x9f\xc9}\x1d\xe5\x8cĦ\xe0\xdb\x19\x1b|\xf1\x8e\x8c\xf3,\x9ezz\xb3x\xe5s\xb3V\xabΰx\xdb[\xb7~\x9f\x13\x8d=\xeb\xcd\x06\xdc\x00\x1c`rO\xbbjnl\x826v\x88q.\xf2wfsc{sSqx\xf0nT*^M\xeck'\xe6\x11\xdc_\xc7g\x94\x197\b\xe8F_c\xe0\xea\xb6]C\x97_>\x9d\xb4\r\x0fu\xa6k=\x9f]\x19\x04ކ\xea\xf30\x0e\xdc\xfdЇ¼\xdf5\xeb\x9bURJ>\x96AF\xd4u\xf1z\x82\x10\xe1\xb0mTӘ\a\xe1\b\x83ݐ\xbb\x8c\xb7\x8d\xda\xe8\xaa\x14\x85\xbe疠\x9a\xcd\x1a)\xa9\x7fV\x92\xe5>u\xbd8j\x1b\x1b\xa3\xab\x12\xfc\xab\x96\xb0\xf4\xd5\xf1\x80\xba\xe1\xb8m\x04a\xc0\x92\xbcIs\x187%ݟa\ag [\xd8\xfc\x8d\xbd\xb9\xe5\x88,\xec\xee\x90\xf2\xbe\x17`Ƶ\xcd\xd1U\x12q\x1f\xbbQ\x88z\x9d\xf5Ҙ\xa7w\x02\x97]\xb5\x8dV\xab\xd5Z2B㌳'ʤC\xaf\x88̫u\xb26\xb2\x83\a\x9a\xd7\bF\xf9ǐ\x87\xf8\xc8\xfandjMr\xcf^\xa9\xad\xd8\xf6\xc0\xb4̘\x18\xd1$\x10\xf4\xea\xb5ė2l\x96\x8bS\x94\xd9 \xaay\x92\xa7E\xc1L(\x8e\xd8}ֳL\xe5\x82\xefdL\x0e\xcf\xd2\x04\xf4\xa1o\xa5.]\xf4\xd5\xf5Y1,*zx\x11\x89\x87\x171\xe7\x16\xd9\"'W\xe4|I\xfd4)Gs6\x93\x87\xa8\xbba\xaeiY;I)e?\xae\x8fi\xe1\x1e\x04:\xc1\\\x98\xa8\x18\x9b\xe4|\x14\xc5)t\xbc\xe2&\xbe;*\xce\xc0\xf3\xdd7C\x97EE\x97\x10Z0\xd3J\xb4\x15V\tB\x97\x81\rK\xb9\x9c\x1b\x0fVA}\x04:1]<\x04\xdaū\xe6h\xd6@\xcfL\x03\xd8}s\x0f\x1a\xfbs&1\xacB]\xf7\xf0\x92\x05\x02\xaa\x86\x816\x8da\x18Gl<`\xcc7\xc8cx@1\x8a\xbcK\xa6^IVV\x19\u05cb*\xe2l\x18^\xb2\x9b\xeaB\x85\x98EN\xc0|,\xe1ėM4\xe9\xd8ǐd\x8ea\xcf\xea\xe8c\x90\xc0\xb0\x80\x04\xef\xe3\xceq}n\xae\\%.ʙm\xdb\xe3\xddn{B\x1eۙBI\x1d1\xe0\xd3\x19\xbe\xa0\x9f\xc3G3\xc8u\xe4\xf0\xd0\xf75\x19\tH\xd8\xebEL\xe8`HT*\xacVeh\xf7*\xf8\xa2\x86\xc1\xa6\xe52\x7f\xa5*e\xb8J_\xb5\x83r\x99\xbfZŗ\xd8F\x9cA\xa75\"\x99ܱ֔{s\x9e\xda.+n\x83\\\xa7\x9e\xdft\x8f\xd4\xd21\xac\xbc\x93Q\x98\xbf\xd2\xd0\x030p\xa1\x91HL|<\x83\xbd\xac\xec\xd7-sɪ\x13\xea\xd9^kJ.\x16\xb8e\x86\x9b\x92\x943j\x90\xebı.x\x00\x9ci6\xf1\xd8p1\xb5n~h7\xaf\x1eӔf-Ҥ\xc6 \x9c\xf5\xda\xeeR\x97\xa6\xaa;\xd7c\xcf\x15\x83\xb6Q\xabV\x7f\xce\xc8H\xb9\xa2\xe1\x8f\xe3Hx\xbd\xc4\x12Mł\u008e\v\x83P\xdf\xeb\ap\xb0\x18\xb5\r\x87\xa9mAQτ:ր~N\xa7\x8b_\x1a\x05G.\xbe\xe7\\(U\x0e0P\n\x9c٭f\n\x9eޒ\x1e-\xf0\xfc؍\x85\b\x83\xe5\xf5%{\x8a\xdea\xd2M)%\xde\xea\x8c\xecj\x17^n\xf6\xe9(b\xa5\xfc\xf3L\x8a\x1e\xe5\xa2\x16t'\xba\xec\x17g\x82r\x1e\x8e\r\x92\x8e\xacA\x06\n\xab\xe1\xf7\x82\n\xe2\b4\x9d\x03\x98\xae\xab]\xe3S>\xac\x965\xacd\r\xcfHڅ8\x83\\\xc1I\xd4k\xb7\xcd>U\xe6\x10\xe5\xf2\x12<\x98\xc1\xbd\x94d=\x0fq\xf4ƫ\xf6J\x9c\xebd/\xbe\x9ftvt\x95\x1b\x85y\xfc\x9aN\xf1eRn\x1f/|\xc5>q8\v\xc6\xc9\xcb\x1d\xce\x16\x80\xbak\x8a\xc2\x1a\xee\x8a\xc0P\uf5d2<~`\xd5c\xe0\xe7\xcb\xe5C\xb4\x1cD]\xdabDU5\v$l\u05ce\xe2e\xc6\x03O0cھ\x9eN\x89~5\x00\xf1H\xeb\xa4o\xe3\xda\xd6\tG\x935\x11\xae9\xbe7ꆔ/\x1a\xf0~e?\x1cM\x1e\x84\xfbI\x1er\r\x04\xa4}1]\xbe\x10\x12[\x9a\x1b\x9c\x83\xde\xc1\x1d\x80\x80<p\xeb\xf7V\xb2MA\x9f\xdc.\xda/\xb45\x1b8\xc4C\x1b\xfd\xd7\x132\xab\xec\xda\xe6\xb7yL\x18>\x87\xcb\xd7J\xd6\xe7z$\xd4\x06jά\xe2u\x94\xd9\x7f9\x89\xfdWO\x1d\x13\x82M\xd1h\x81Q\x95i$;D\x84N\xe3ud?\xed\xa9Ar\xb5%DzE\xc0\xeb\xc8^\x84/R\x98\xd6.oϛ]\xc3P\x17Mb\xb9\xc2\x192\x000E\xce \x1d\x18\xc9^\xa0\xb6\xbf\xbc\x19\xb7\xb2е{fj\x92\x1b\x90\xb9[\\\xd0'z\xf2h\x99&\x1d\xd4\x16\x8f\xce\x00\f]uv`\x90J\xc4\\\xf9\n֯?\xf4\x02C\x1d\xf7\x17\x16(\x83\x8dΝ\x1dL=\\\xb4\x80\x04\x862\"[\x9c\xd5-\xe2\x05\xae#\xd2ت\xb5n)\xfe\xe2\xa5\xc7= \xecT\xbb\v\xf2\n\xb6\x05\xd1\xdc\xe9\x98>\x9e\xabmk\x99\xb8\xdeڨ6\xe6\xdf\xcc̻Q\x05\xa9\xd7sJ\x88\xc6\xdc\x03{7A\x05;\xe2\xe1\x10\x9b\xcf\xec\x1d\xaf\a4\xc2(\xd8\xdb\xf53\xca\xd3\xe9\xf3]_\xe6\x1f\xdcʪ\xa8\xe9*\xd4ս\xbc\x17\xc7}p%\x8d3\x9f\x7f>\xab\x17T\x16g\x9a}\xfap\xe6\xf9*ť\xbd\xa9\x1e\xd4E>\x99\xb3`\xfe\xc5\xc3\f\xc8J\x02cv6\xc5L\xe3H\xbf|\x9c\x99\xbd\xcc\x1e\xedq\xa2\xdcJ\x88\xec\xec\x9fO\xa7\xde\xcc\xc1\x7f\x0e\x1c\x03\xda,\xa5\x9d2\x8a\x06<\xa8\xe9L\n\x83~\xe2z\xae\xfb\xedp~H\xa6Y\x1f\x95\xe5Ar\x8d˛\x12D\x86\x9f\xe9\x19lb\x1e\xa2;~\xc3\xe1\xea\xdcN\xd1K\x06tJ\x8c\xff\xff\x7f\xf8\xb7\xdf)\x19$X\xfa\xdc\xc7>\\DFG\aj\xb2K\x061е\xbf؝\x1dŶ \x06)EL=}\x98\xdc\xe97po\xc0\x85q\xbb\x01\x98\x1fl\xb5\xfcƞ\x18\xcc/\xcb\xe8V6\x14\xa9j*\x81\nW\x00\x89\x12\x12\xb9\x00\x7f9j\xa6\xa663\x95\x05\x86Qh\x1b߿\xc2W!\x0e\x14\v\x02C\v\xd4\xefVv\x19K^c\xf3H\x1eI#\xb2\b\x9e9\x03\a\xb1\xc0\xbc\x01\x7fj?*\xb87\xc3\bũ\xb8Xq3\x98\xedGo͎\xab\xf9\xd2u4\xb5\x1e\x113\xb6\x85\x95=\x8b\x88w*\x92@ŋ\xee3\xead\xf0\x95˦\x9fK\x1e\xd2\x11\x12\xb3\a\xa1Z}by\x9aE\xfc\xe9\x94ԛ[\xf5\xda'{\vr\xa3\xb9ќ\xf5>ܬoϝ\xd9\xc2.\xa0L\xbc\x14\x8dV.\xba\x90rk<q\xf2\x87\x1b\xb0\xcaR\x9c\x04\x11\xb8\xcd\xec\x933ҋ}\xff\xae~\xeb\xb3-\xec\x95\xda\xf4\xe3\x1e}\\'\x8fj\x95x\xfa֒\xb6\xdcQ\xa6\xbe\xb0e\xf3\x144\xb1\xcb\xda'Fg42\x88\xb1G#\xf6:\x9d\x84\xb10\x88\xa1M-\xefq\xda\x1f\xd2#\xcfG\x11ʸ\x13\xf4\xc2\xf4\x99w#us]\x88R\xa6\xd3\xf9\xa8Nbĺ'\x82|\xbc\xaa6\x1f\x93\xbe\xcd\x1d\xe5\x03\xf9\x1c\x85\xd75\x92\x1b\x8e\x85\xdcǉe\xb8\xf1\x06X\x06\xa5?\xde\xd2o\xb3\x82\xdd%;##Խ8\x88\xf0\x81\xadη\x1c=\xd6\vX\x17\xcd\xef\xf0\x1c\xbf\xc3+sTD]\xf4I\xf6\x96\xeb\xc5\xe4>\x9e'\xf7\x8b\xe8\x11\xb0\"sіI\xad)\xc9kË\xa52#\xd8d\xa7\xcb\xccg\xa7\xb3\x0e\xe0FhP\xb1٬n\xde\xd2B%\xc2[\xad\xc0S\xe0,Sd\xd9<\a\x17\xcf\x11\xf1\x82\x1e\xe3*\x01c\x9e\x12E\\~\xe9\x8dד\xf7\x04 \xfa!\x99\xad\x06\xa3\x9f\x90!\x1b\x86`鼿\xa8\xd4Ki\xf2\xf1\xa2\xd2o=\xcf\xdebk\xee\x11E|0q\xe6\x11E\xe4\xe5\v\xca\xf8V\xbd\xdah\xcd\x18[\xd47\xea\xcd\xedYc\v噼`l\xd1hU\xc1\xbb\xc3\x04\xe3.\xe1\xde\x7f*\x1b\x8c1\xae\v\xcf\v\xa5\x86\x1fW\x18w\bW\x8eю\x1a\xea;Ƹ\xf3\xff\x93\xbb?ak\xe3X\x1e\xc5\xe1\xaf\"\xe6\xe7\xa3LG\x8d,\t\fx\xe4F?l\xe3ĉ\xb7xɦ\xe8\x90Aj\xc1\xd8bF\xeei\xb1\x04\xe9|\xf6\xf7\xa9\xeau\x16\x01vr\xee\xbd\xef?\xcf\x133\xea\xb5z\xab\xae\xae\x15X]\xea\xfd\xf1\x10X]\x90v\x00,.\x11nww`\x1c\xaf1\xed\t\x18Aڸ\x8e\xcf\xe1\xc6\xealu\xed\x8d\xf5\x8a]k\x95j\xa4m籔\\\xa4\x03\xe5\xb9\\\\\x99\x9b$\xe5\x17᧐\xa0\xb6\xc7\tOC\xb2\x1a+*\xcd\xdc5F<\xb5Z\x91\xd06C\"\x93L\xd5\xdf#~\x16'J\xb9\x06\xb6\x94\xf8_m-\r\xbb?0\x91{\x8e&\xb1\xe4\x9b2\x01\xcd\x00(\x89\x86\x9eOc\xc9I[f\xcf߽~\x87\xa5\x90cb\x8b\xdfX\xb0\x9d/\x8eUٰCA\xfe\xa2+.\x16\x89z\xd0\x05[\xd3x\xef\xc1tg{\xf3\xc1nwws\xfb\xc1No\xf3xk:\xde\xec\x8d\x1f\xeelMwv\xe2i\xbcc\xc7\x006MH\x15a\xd5\xc2\bt\x89d~\xbe\xadr\xbb\x0f\xf7\xda\x0f\xba\xedn\xa7\xd3\xde\xee\xf9\xf9;*\xbf\xd7\xe9t\xa3\xce\xe4x/zp\xfcp'\xeat:\x1d\xf5\xcfvog\x1a\xed\xf1\xeen\xb4\xb3\u074b\x81X\xb2\x9eP:\xfa\xc7\xd1t\x96\xc5R'%\xa9\xe4'\xb6\xc0q\x96\xcdx\x9c\u009a\x06\xfa\xdb=\x93l4Y/\x16\ue2beG\x8a\x8f\xc3s\xe7c\xfb\f#\xf7\xe2%\x01\x95\"I\x95&\xb9b\x84\xa6\xec\xd5\x10\xa3\x94\x1f\xa1\x18y\xb4\\\xbe\x1aʑ3\n\xf9\xd8\xfee\x86\xf6Ni\xc8I\x14|H?\xa5\xd9E\xda@\xfb\xcf\x06\xa8\xa6@\x9b+\xfa\x06z\xc4\xe2\xbf\xfeLBN\x83{\xf7\x04\x9f*?\xf1\x15E_\xdel\x16\x95\x8d\x82\xff\t\xc8\xfe&\xb8\xcex\v\xea\xa5\xf1\xe5\x1b/@Zp\x96\xa4\xde\xef\x11}\te\x12\xc5\xfe\x83\xec\xf8R}\x8e\xe8S\x95\x93\x9c-\xceT\x86\xfe\xe2\x97\xe8;\xe0\x9c\xbfL\xd2J\x92.5\xa2/T\xed\x17xE\xa8\xfa\xfa{D\x1f\x17\xd4E\x883\xa0\xadS\x19\xe9\xd5\xde\xe4=\xff&\xef\xe1MnT3\xf1*\xb7\xfc\xfc\xa1\x18y\rp\xfc\x19B*~\x93\x15\"¼\xaf\\n3\xeb\xa5\xc0\x8b\xfdE\x95\xbf_\x1a\\\x9e\xcdL\fC\xb8\x16\xdf\xc2?/៧\xf0ϋQ\xe19\x1d\x87\x9c\x10B]\xcfmcT\xdcl\xa2Ok\xfb\x1b\"慶\x9c\xf4ʹo=\x19\xcbe\xe8\xd20\x82\x18\x80\x9d{\x8dk\x18r'\t\x11}ř\x93\xe52\x82B\x10I\xaf\x0fg|\xab\x9c\x9d\xb4]d=r-\xbd_\b\x86\xfbɮ\xb52\xba\xf0Έ_\xd9(L5\xe2F\x926\x84Z\xf0\x05\xf4\xf1\x1a\xe3\x95y\x14\xeai\x9c\xbf\xbe0;\xf4\xca\x00\x1a\x13Ж\xd8\x10\xc3x\xb4\\⟶Sa\xaed\x19v\xfcr\x99\xb6uؗ\xb7:\xa9R\xf6\xc2ă\xf1\n\xdb\x181X\xda\x1f\xe90\x1e\x91\xd2o\x06\xcd@D\xea\xb5\xcb\xdbln\x82\xd0\x0f\x0e颺R\v\x1a\xab\x90\xad&}P^\x91\x98D\xfe\xa2\xc7#tF\xaf\xfd#`\xa0:\xac\x8f_˥\xf9\x82E\xa1\xe6\xfbq\xa8K\x9a\x14\x9a*\x9b\xc4g̷\a^\xa3Fԭ=\x81]\xff\x04v\xe1\x04R\xf1\xb5\xa7W\x15\xa1_\xa00\xd6l\xfa\xbf\xfa \xdb3蕣\xcf\x17\xa2\xe3\x9dj\xff/Z\x0fض%\x96K\xee5\xcd\xdb\xfaܗ\x935\x12\xb0\x1c\x85\x8d\xb8\xd9ĸ\xe3Y\xca_O\xed\x87}vЅ+\x12\xa7W\xaf\xa7\xf6\xc3\x16Q\xc1\x1d\x9a\xcd0_.\x17\xc41\\\xec\xd9\xc9\a\xbaUx\xa2\xe8ڠ*\n\x15\x1fñ\xa5\x12c\xa0_\x9e\xcd\xc0?4\xfe\t\xf1'\xc3_\x84V\xc7\xe5\rJ\x984\x02\xda\xcb}\xa3\xba#\nǝ\x17\x8f;\xaf;\xee\xa9\x03Y\xac?\xee\xa9:\xee\xf3;\x1f\xf7\xd4\x1c\xf7T\x9dӴ\xe6\xb8\xfbY\xee\xb8\xcb\xda\xe3\xee\x97\xf5\x8e\xbb\xac?\xee\xbct\xdcK\xbfY\x8a\xc7]\x94\x8e\xbb\xa8=\xees&\xca\xc7}\xae\x8e\xbbC\x03\x03^=\xee\xbc|\xdcW\xc6\"\xe6\x1au\xf1\xae/\xcff\xd1\x19E\x02\xe4\xdc\xc6\xd0:\xa6\x0e\xd2萂\x00\a\x0ev<s\xf7}tI\xf1\xf8GG+\xd00\xbe^\xd1\xebҌE晴\xb2\x0e\xe0\x19w\xc6ΖK\xd5}\xe3\x93\xe6\xfa=\xa1s\xc1\xa7\xc9e\xf4\xdcs\xa6\xf2j\xc5\xce\xe8\v\x804\x99\x86i\xb3\x19>aO\x96\xcb \xcdd|\x02\xa5\x02\xfa\x89\x85\xcf\a\xcf[A\x04\x96m\xa4\xf5\x84\xbe\"\xe4\xfat\xf8|\x007l\x9aGA\xeby\xa4>\x83\x11{\xb5\x826^\f?\x8d\xe0\xbe\xd3\xe7\xf0/&\xc1¹\x1c\xbb\xe5N\xdb\vN\x0f!\x8016Λ\xcd\xf0p\xb9\xbc\\.\xff\nߒ\xc1\xb9\x8d^\x1c\x1dA\xd2KLR\x012\xa3\xbf§d\x10\x9e\xb3@\x91\x98\x01UĚ\xfdM\xa2\x18\xa8F \x15\x96K(g\xe8{SN\xff\xb6A5?0OB\x80Nk\x93>0\xfe6\x18\xb3j\n\xa2\x18\xe7Y\x18\xe5\x04\xd16t\x1a\xf1\x0evx{xh\xafZ(٤0\x83\x1dj\xea'\xc5\xfa\x89\xa9\x9f\xb8\xfa\x88\x8c\xca\xe0f\xc5z\x99\xa9\x97\xb5\r\x85Y\vn\\\xac\xe6i\x93\xd8jF\xa9\xb1\x83\xb8\xa5o\x9c\xf5?\xb2m\xe4\xc56r\xd3F\xee\xda\xe8+D\x94\xf7\xb5^\xb4\x1c\x8aV\xeb_\xa6)\xefV\x95+\xfa١\xb6C\x85\xec~\xa6\xf7XG/\xdd/\xa8-\xc1\x9bM=\x01\xbc]\xa0\xb2\v\x17H)\xe7\xde~9\x8d~\x8f\xad]#\xf2Y.=J\u0098\x10v\xb4\nd\xa7\xafvK\xd6O\a\x8a\\\xe5U\x9a\x0e\xe8>\xd9bv&^\f\xf9hЉ\xba\x84D*nQ\xa5N\xe6ӊf\n\xbc\x16\xbc\xdd\bǰvC\x9e\x14\xa5c\x92\xed;\x82\x16\x1c\x89\x13\x020x\x8a3\xbcL\xdan\xca\x15\xfd\xc1\x1d\t#\x87\v\xf1&uȶR\x0f\xdc\x04\x8e\xd7M\x06\x88\x82\x7f\x84Faf\xf50J\xd3\uf366\x92\xb3\xf1K\b\ae\xe3\a\xf4x^\xca\u07bc\xb7\xf9}H\xf6;x\x12~f\xe9\xc0RRZ\xfd=\xfbZJJ\x15\x81fy\xb3\xf9\x19^)\xb09\xe0\x03\xefx\xf3\x81\xf8\xdb\xfch\xc7&n\x99se\b\x17\x13\xe6\x03R\"\x03\xfb9\xec\x98>\xa8T\xad\xe9+\x84\xa6\xea\xa7!z\xf4ZA\xd9\xf0th\xbb\x02,\xbe\\\x8a\x91\xa3\xa6\xe4@F\xf6G:H\xdd\x0f\xb0\xf0x\x8fP\x10\xb2*\xb4\xc0\xca\xed)\x1d]H\x05Ϗ\x8c\xb1\xcb\xe5\x12k2\xbc\xefԕ#V+C\xcb=3\xd3c\x96\x90J\x9a\xd1T\xb1\xb6\x92\xfe\x8f\x180;\xbc\xd7ja\xf0\x96\x98\f\xf0\x12\xb9\u0088\xd6\xf0i]\xef\xc6$\x82\xdf\xe6\xfa%\xab(\x14*\x9eT2\r\xa1\x19\x9c\xff;^.\xc1$\xc9\xc7\"9K\xd2Xf\"@}\xa6BR\xb3y\xb7\x96\x8a\xb5\xe0\x01=\x9f\xe3\x05Ri\xb1\xad\xb3\xeeڲ\xe1'`K\xf8Ym\xd2DaFA\x05cBY=(m\xfb$m\x94\x8bk\b\x80\x82B\xeaG\xb7\xdb\xcey,\x80\x19V_|(G\x84\\\xbf\x18\xa2\x1a\xfe\xb1\xe0\xf1\xa7\x95\xda\x04\x98\xf4\f\x17\xdf,\xea\xbdVk\xb5\xa2\xb1>Zp82\xf6\xc6=\x9b\xc5@\xb8Mw<8\x8e,\xe9N\xe8F\x8a\vhnj\xcb9\xc9\n6\xfc\xe7\x1a\xdd\xfey\xef:[\xfdYkԝ-\x975\x15\x1aY\xdf\xe3\az\x01\xb4\xb3\n/\xb0\x91\xad \xdc>G\n\x01veF\x06\x86\xc00}\x10\xeabr\x9f#\xe0\x1b\xaa\xa4\x1aD\x99\xfd\x939(26\xccF++\xbf\x1d\xe87\x9f\xc1'\x02\r\x87\xed+a\xb9D\x82\x8e\nw\"\x85w\x1c\xcf\xf0\xaf9k\x17\b\x81\x7f_<\x03\xf4J9<$\x9d\x9a<\xfb\x10Ƅ\x9e\xb5U|\x98\xc9@\xd1m1}\x1d\x92\x100\xb5;f\xd7G\x80\xb0\xa2\xd3\x15!\xd1\v\x16\xd3\x170/^\xf8\xf2\xf3[G[؏\x19\xb9\xd3\xd6ϨD\xea\x1b\xf0\x86\x1c\xa9\x7f\xed\xfb\xa1\xd9\xdcx\x87\xcf?/Ͼ\x17\x9a͍\x03x\a\x15r\xf1\xe5e\xbe\x1c\x06\x1e\x9c\x0em\xa2\x9aL9b\xd9P\x8e\xa2\x9fCI3\xdc\xf5v\xcen\x9a\x1a\xfa\u0090$8\x8f\xaa\xe8 \x8b\x86\xb6\b\x84\x84\xa8\x99:37\x1c\xe6\xe6\xf3\xdd\xe6\xe63\x05\xbb\x96\xf0\xf3\x90\xe3\xf0\xb8\xff\xe6Z.\xbd\xe4\xe2|y\x19\xa5\xc9\xfa9\xe4\x9aLl6O\x9b\xcd\xda\x01\xfe\x12Z'v/\xf0]\f\xf7\xf0%I\a^\xe9\xe2C&\n\x0e\xd2+y\x9a\xa4'\x8dq\x9c6\x8ey\xe3\x94\v\x1e\xacH\xf4\xa2],\xd9e\xd7+z\xafղ/\xdc˚\xb7\xf6%\x88>\xd4^6L\b\x03\xb3\xf0\x1eظ\x8eͦ\xf7\x8a\xc1\x87\xb4\xc9!\x0e\xdcL\x9b\xb1\x18%$G\"\xfa\x8c\xd7\"\x89X̹\xf7\xa8\x944(\xfd\u07bc\x17m\xd9\xed\xcfY\xb7\xcf\x1f1\xd9\xe7\xad\x16\x9e\x99\xf2\x94\xa6\xce\xcf\xdb\xf5\xaa/\x87Aq\x96\x82\x16\x1f\xb1\xacm\aF\xddP$1ظ\xb6\x8eB\xc9v\x87\xe2.,c\xae#\r\x8ab\x0f\xe2\xcd\xfc\x1d\xfd]\x81u\x84\xb8\xe8H\xe1\"Kd~W$\xe4\xbf3\x14\xe6wP\x8e \xc6:r\x18먂\xb1\xa0m\xc0\x97G\x8aoB\x88@\xe4\xf5;3\tj\xb7\xff\xae\x91\xd8\xe3\xf0H\xf1S\xbc\xf5'\xc4n\x19\xd5\x122c\xb4\t\xd9o}\xd5\xe0o̤\xab\x06\x7f\xbb\xb1A\x9cH\x9c\x91p#].\xd3f\xd3\"I\xbbZ\xcf£\xc2.\x14lXJ\xb2~Q\x05\xfb\x10\nB\xfdf4\xae\x15\xf4f\x84B\"\xb1\x82\xb5\xf8I\x93\x97\x8aW\x89\x04\"\xf9I\x1d\x8b\x83g6i\xd8\x19\xf5-\xe4\xc6\xf95\xd4\xfc\x89\xbd\x0f9\xa1\x95+\xf5'\xc3D\xc4-\v\xa2\x00\xfd\xa6\x95\xc8\xf4(\x8b\x0e\x9aM\xd9jџ\x984\xfcc\xa4\xb1\xbdj\xa2TMe6\x9bbs\x93\xfe\xc4\x04Y\xd5]\x12?iE\x7f\xf3\xfeRr\x87\xf2\xab̤\x86?\xe1;\xf8'\xbd\x8e?\xd1\x0e\xf5\xf2\x89\x8agf\xcei]S&\x95\x98\xb1\xebG\xeaO\xe6\x91\xea\x95铟Z짡l\xb5\xfee\xb2GH\x12(7@\x1b\x1eU\x91\xea\x15\xd5X\xff'\x1f\xeb\xff4\x82\x95\xfciE\xffBI\x11o+\xef\"\x9a\xe5\xa9~\x10곮p\x1e\x15#B\xdf\x14\x84rB?8g\xe1֑\xdf3\x95\x04\x96&\ua82e\xf10\x03t\xfe;4'\xbe\x9e\xf0\xf1,V\n\x05\xa0'\x97\xa4\x13T2\xffCB\xf0TxL\x9bNl\xdb]B\x7fv\xc9CN/\x91\x17\x81\x7f\x04\x19\xd1{\xb0\x17\x9f\xb7\x7f'\xe1\a\xfa3\xa1\xbf؟\x9f\xe9\xcf`\x85\xb6\xb7\xf77\x95\xbaP\x90\xef\xf98̜W\b\xd4\xf6\x01Y\x7f\xb7w\u05c8\xee&\x86ϛ\xc3'Zl\xafS r\xa7\x12\xd8\xeb\x04\xa0\x0f1\xe5g\x93\xf2\xe6\xe0\xed\xc1K-\xb6\xd7I\x87/\u07fc\xffMe\xb8pSJtOmT\x15\xcc~\x87\xa9\xdfS\x15\xa6E\x054¤\x1f\xa8\x17\xb9\x05S~Ĕ\x97\x1f\xde\x1f@\x806?\xe7;\xfa\xe2\xf5w\x85\x94ߩ\t*\xe25\xf9\x1b-D\x1a\xc1\xb4\x9ftZ\x1dT\xbf\x9a\xe1\xbc~s\xf8\xf6\xe0\xfd\xf3ׯ\x8e^\x1e\xbe?P\xc1I\xb0\x04\xe7\xd4\x05cz\xfd\xe2\xe7ç\x98,+\xc9G\xef><~\xff\xf6P\xd5\x12\x1cG\xf2\xee\xc9\xf7\x87/UJʩ\xe7\xa9\x03\x97ڤ\x18\x87\x86\x98\x1a\x9bT\x889\a\t\t\xaf8\xeb\x00\xcd6N\x91\x82\x7f\x9fA2&\xcd8՞ m\xa917&a%\xa7\x89\x98{\xca\xe9\x18\xbds\xbd\x01\x1d\x1cL\x9a\x14\x92\x1e_=\x87S\x92H\xa5MwV\x06\xd8o\xec\x84S\xed\f\xacZ\xe0I<>U\xa5ι\x8d\xb7\x81=h\x0f\xa2\xa6a\xd4e\xc6\f\xeb\xfd\x1d\v\x1cs:\x9e\xf1X\xfc\\\xad{a ~\xa2}\x92\xba\xb8\x1f\x87v0\xda?\xa9˺\xe44\xe7\xf2\xadV7¤#\x9d\xa4l\xe9 \xe5\x1d\xa6\xbc\\H\xa0/\xfd\x8c\x03Ngى\x9f\xf2\x89S\xae\xa2\x8a\xf8\xa9Ol*\xfe|\xadGQ\xe8\xf6\xa3Mt\xf5\x9ec\xc7J\xf1\n\x13^q\x1f-(M\x97\x82:\x8c\xd6B)j\xad\xef\xec\x82\xf6KQk]EM,h\xc4\xf4\xc0\xfc\xb3\xa8\x10\xb3\xd3땝\x8fh]\xf9\xa2>\xcc\xce\u0383\x92:\x8c\xd6\xd5)\xa8\xc3\xec\xed\x94tat\x94\x94\x82.\xcc\xee\xceý\x9d\x92.\xccn\xe7\xc1\xdeNI\x17\xa6\xdb\xdbժ0\xda\x19\xec+41~\xb0\xb3M\xe8{,\xf8\x8a\xd07Py\x17\xb4E\xdeB\xc1\xdd\xce\xd6.\xa1/1\xf7-\xa1OQ\x1b\xb4\a\xea8/0\xed)\xa1\x8fq8\x0fw\xf6\b}\x86i\x8f\t\xfd\xcb\x05b1\xfc\xf0\x00\x1c\xf0\x1c\xa9\xcdz\x04\xdf\x01\xfd\\LD\x83\xaa\x9f\x8biʛ\xeb\xbdb\"*\xbe\x05\xf4\x97b*\x87\x13\xa0\xf2\x8e\x12\x17V\xef{]\xca\x1c\x1eS\xfb\a\x9d\x9esyd\x94\xe7\x02\xfac!\x15\xf7U@\xbf\xf3\x12\xcfԞv\x99\xbf\xeb\xccYv\xe2\x12\x7fӉ\xb8?\xbd\xe6\x7f*\xa5\xeb\xe2\xbf\x16\x92ːr^\x1c\xa85r::\xe32>R\x1e\xb6\xa9,\x95r\xaemŚ\x9c\xa3\\ᘀ\xa6P\x82\xcb#\xe5~\xd8\xf3\x8c\x92qߓt(\x18\xa7/\xf1\x0e\x1f\b\x90\xeex\x1eZ$8\x16\x01\xcf\x12\x8a\x8dݯ#\xdd\f\x8d\xa9\x94k>P\x13\xb4J\xae\\\xf8ܘ{\xea\xf9XNr[\x90{\x05\x93J\xc1ϵ\xe5\xf2J\xb9\x9f\xfdrjl3n4\x96\xd15\xe0\x9c\x8f\x8d\x93(Q\x8a\xa6\x92R.\x84\xc9\xccV\fT\x01\xa1\x80\x14\xa0M\x9a\xd2D9y\x03\x9e\x11\a)\\\x1c\x12\x9a\xb5qaC\xe3s0\xc0\xfbG\x04+@;\x1fۿ\xbfi\x034!\x9aZ\xa2\x1a\xdf\xc7\xf6\xc1ު\xca_*(Y\x87\x1c\x1aN\xf9\x05\\Y\x87\xa2\xda:\x9d\xf1s>\x8bT\x1c\x8f\x80\x9e\xf1<\a\x13\x1f\xde\x16<γ\x14m\xc3# \x86ŧfS\xfdmC\xda\xc0\xfbnu5\x7f\xc9Y\x00$ͦcG\xe8\x85M\x06\xa2]\xbciÄ\x80ѕ\xf2b\xe8E\x92\x19s\xe3\x7fW\xd4Lv9tM\xecOv\x8e&\x03S\x8ez\xa3澎\xc6\xf4\xe0\xdd\xfbh\xce\xc0\xc2\xcb3Ԟ\x82f\xef\x02Xq\xc5\x18x^om\xefʇ\xa0\x83\x8e!\xd2ȓt\xcc\x1b\xe7[\xedn\xa7\xddi\x80a\xe1E2\x9b\x01;BY\xedN\x80\xf3r\xbe\xdd\xee\xb4;\xfd\x06Ѝ\xf5\xa4\x02\xba\xcb\xe0\xf1d# \x14&\xa1\xa3\xb1\xe15\xfa\xe93\f\x9a\x97\xf1Xd\xd1)\xb5\xea\xbc*ab\xe8\x8f\xe7\xa9\xe4b\xcc\xe72\x13љ\r\xfa姞\xac\xd84$}'\xea\xc0\xa7B쇊\xa66S\xf9ψ\xdb\v13\x9a\x13\xe7l\xde>\xe1\xf2E\x92\xf2W\xf8\xe4{\x96\tpY>\xa8O\x8e\x94}7\xbd\xd2]\xbc\x93\xc2\xfaFn\x8cC\xbbD\x90\x17qt\x80\xfe4\x1bG\x92\xfe\xe3\xc3&my\xcaS\xe7L\x16{\x94\xc6O\xac\xd0\xee;ss\xfct\xa8\xf5S\x01֗+B\x95\xd5\x170\x81\\\x84G\xc5uI\x8a\x12/x\x86U\x0e\x1f\xc7\x13\xc2x\x1b4\xd6q\xba\xce\xc3+\xea~j\xaf\x00\xa8\x15z\xea\x17s\x9f\xda\x13P;\xb0e\xf1\xcc2sfͣN\x83Ly[\x9dqf\x1c\x97\xc3)\x0fQ\x89P\x1f\xef\x80^\xc3۞\v\xf0\x89\n\xaf5\x1d\x86\xbe\xad\xf3W*\xb6`?\a\xcc\xf1\x1e\x9b=\x14\xe2\xb1F4+k\xebR\xa4VC\x89\x1e\xfcp\xb3\xccAK\xdfxS\xe5\xecEH\xc20ί\xd2q\xc1\xa1\xf6\x9c\xb7sԱ\xef\xfb\xcc\x05\x146\x95\xd0X0\xe1Ǻ\x1f}l\xf2\xa81\xc9 \xec\xf8i|\xce\x1bqC5Ԑ\x996\xef\xe5\x8d,\xa5\x8d\xf88\x13\x10X\xaf\x1d\x98S\xe5\xe1\nYt\x1e\x8d6\x1eע\xd0K\x94R\xb5Qc\xc4 9b\x90\"\xfaQ[ش9\xd7\xdbi#\xbdi4\xca\x16\xac\xa1\x9d\x83o>\x99%<\x95\x8dI2\xf1\x83\x9a6\xe2ƟEh\xfel(_5\xb41ɀ\xfb\x99f\xf2T\x0fN\xb9g\xca\xebOh~\xd3\tիt\xc6\x16\xee\x9c\xd2:\xe4s^>\x85\xc7u\xa7\xf0\xa2\xf6\x14\x1e\xea\xf0b\xd6\xd1!\xde}@\x04\\\xb2\xf8\"Ndc\x1c\x92pn4X\xe7\x9c\xea\xed\xc2inw̵\nH\xfa2\x9eGc\x9ct0\x9ey\xa2\\A\xd8]1_\xe9\x069\xbd\xd6G\xfcR!\x99#\x93\x93\x86s\x9a\xd3k\x83r\x16\n\xcb\xd1\x7f|\xc8\xc8;\x11\xedx6\xc3\xf5\xceC\x82N\xab@\xa9\x13q\xcd㫰\xe2\xac\u009cb\xe7\x9eH\xb9\xa1^.\xddivy\xea\xa0C\xee\xc6T;\x16\xc4t\x83:\x02R\r\x10\v\xaa\x11C9\xf25%P\x1c\xb1\"\n\xd7]\x92f\xf3\xb2\x16\xd7]\xea\xc6.\x15\xae\xab\xa2\xb6\xd3\xf0\xec\xff_P\x9b\xacGm\xb0\x14\xef\xe8A\xff\b\xec\xadT\xb0\x00\x90\xff\xfbA\x0fp\xc2:\xa3f\xb3\x12\xfc\x00s\xba\xa0\x97\x88\xdbl\x12\x926J\x97C\x12\xbec'!\t\x0fP\xf4|d\x16\xe5\x00\xe71\xc8\xe6<}>y\x92\xa5\xa9\x96ިQ[\xdb\xdcw\xfa,4|\xc3\xe6\xeb\x85\x00c\xdeB\xdd\x0fb\xf6%\xdb\x13\x8f\xa0\x91\x86(\x90c4!\xf6\x1dx\xe1\xce].\x05ڈ.\xf2}\xb6\xdd\xe9\f\x8a\xd8\xcc\xe4\xbd痲\x05^\xf4$\x1c(R\x86\x0e\xa2G1O4*\xdah \xe7\xe8\xd7\xf2ݹ\xf2,\x83\x1a\xcf\xd0\xf6(\xa7G\x84\xc2\xe7\\}\xde\x11#\xac\b\x85\xc5\xd0{&\xf4j\x85\x8b\xfa ~:\x04\xc5sc\x13\xaeuH\xd7\xf6\xb1\xf0\xc8(]\x164]&|\xc6%o\xd8{\x8e\xe2\xbdxÐ\xe7\xedZ\x0eP8\x1c\xd1˶\x05\x9b\xac\b\x05?`\xa7\xbc`e\u07bf@%\x98\xa4\x8cI\xd1xE\x9d\xb4\xff\xfd߀xF߅\xe4\xfd\xcd\xeer\x19ιQ\x02\xa7\x16l&锇p\xb7\xdb\aӄ{!\xfe\n\x0f\xa7{\xb4\x18ؙ\xeb#\x98*\\\xaa-\x92\xe7\x8a\t\x15\t\x9a俞\xcdе\x98m\xfc\xcc6~[\xd3\xd8L$\xa9\tή\x1aK]LT\xfb\x92Є\x9d\xe0k\xc0\x93\xb0K\xce9\vo(;\x1c\xe9\xc2\xdeրjW\xe5^\xbe\xa7u\xa1\x96\xb9\x89\u0383}\x1dsfGi+\xfe\xb2\xa6⚩Sj\x9a\xc8\xd5S\xbc\xb7\x14tc\xec<^T^\xb5\xbfַ\xefO\xfe!N~\xb1\x1a_;k\xe8\x8f#0\xf1\x8a4\x9b\xc1o\xee\xf2+\x9a3\xf1\x8e\\sj5\x8f\xb8\x93\x17\x84\xd7\xe5&t\xfcp\t\xe8.\x12+\xa5\x1b\xfbÊ\xd0ww\xad\xf6\xd9V\xfbqE\xe8\xc1\x97W\xfbnE\xe8'<\x94\xae\x02WY\xbf\xaf\b}R` \x80u$\xbd\xf9a\xeb;\x15\xa3\xc5\x00\xae3\xe4*\x000\xafT \x14\rИZ\xce\x0f\x12\xa3֫\xb3\x8f\xfc\xa7\xb5W\xc2\xe9\x8a\xe5!\xb0%\xe7\x1a\x85!\x97挞\xf7\xe7\xe0o\x06)\vϜ\x14\xe8\x04\xf4\xb8\tW\xdb9\xab)\xa0\xf1̹BA\xcd\xe6\x86R\xb1\xc3r\x18\f\xda\xed\\\x0f-\x9d\xa1\xf2ൊ\xfdoZ+G\xca~\x96\x89p\xb8\xa0\xe3\x11\xd5\xee\xf1Q\"O̯$\x85\x06\xaf\xb9k g\xfe\x0f\xd4c\xf64\x01\xfej\x8f\xff\"\xa1\xa4~\x19\xd2\x0f7\xe0\xeak6\x01l\xa1\x1c\x8e\xa2\x10͕\x19\x16z\x1f\xb1 \xc0kK\xd9\xceh\x1b\xf0\x0fb\xc6އ$4\xaf\xe9\xb6̬\x95ޤٜ\xb4\xed\x82=\aUp\xef\x17+\xe4E\x93fs\xd1l\x8e\x11\x04\xbf\x94hg\xf3\xe7\x93pB\x17t\x8cN\xfb\r\xe1\xe2\x98r\x7f\u07bb^\xac\xa2{\xd7\xe3՟}\xdeV\x81\x96ج]\f\x02\x8d*\x8e\x95Db\xe7i\xd6.\x05\x06\x0fmhP\x9d\xe3\xe9\x80˕\xbd4\xd3;Դ\xa5\xfb\xbc\\\x96\xbdS\xefl\x1d\xf7D\x00w\xad&<!\x9b\xd5$\x860%X\xbc\x12ڕ\xcd\xeaR\xb1\xc2r\x19|{\xff\xdb\xc0\x9a\x93ͪq\x8b\xb1ݼ\x98SޣX\b\xcf\xd0\x15l\x88\xb8ٌq\x90\x84\xfb\xb5\xf0\xf8\\\xe1\xad\x1d\xeb\x13\x10\xe3\x81y^\n\x18U\x88l\x1c9\xfa\xf0\xca\xd2\xf6\xa1\xb2\x02\x1c(\xa9\xb4\x9a\xafh\x03\xb7\xf7k\x8cl\x87\x01\x16\xa1\x19I\x88\x9bpPt(\x82\x14\xa3\x82\xc01\xfb\xa4\x9c\x1bp\xd2?f\xa2}\xbcHfFN\x03\xb2\x87\xb4\xed\x04:!o\x1b\\\x04\xb2s\xf5L=&}ۮ\x87h\x98\"g\xa5\x89>\xab\x89\xcfi;\x9e\xcfgWZ\xa1\x8f£\x84f\x06\x04\xe1\xf9\x1f\xa9\b\x8dj;\xcf\b\x15+o\xe9}\x00N\xfb\xc65/\x88։3P\xd7⤐\x1b\xa6\x11\x00)ۓ\x85:l\xaa\xf8\xe6\x85\x19\xbaj\xb9\xb6{d\x86\xb4\x15\xa5\x87\xad\x04\xcf\xe2d\xc6'\xc0\xa1@\x96\x02\xba\xf10\xcf\x124\xdfBu\x96 \xa06\x95}\xf3\xed\xb7\xa5Z\xedo\xbfm4\xfeH\xbf\xfd\xf6M\x96\xe7\xc9\xf1\x8c7\xde\"\xa36\x8f\xbe\xfd\xb6\x01.\xbb7\x1bO^\xbf}\xa7?_qy\x91\x89O\rhd!\xb8N\xfd\xf0\xf6EC1\xf1\x1bg\v\xf0e\xcf\x1b*\nW#\x13\xea+\x0f\x1a\xd3L\xa8\x96\xf4\xfa\xb5\xbf!w\x18\xb5zrkG9@&\xbd\x81Ӝĳ\xe4/\xae\x9c\xcb \x97\b#=\xbc\xe6eG\x0f\xe5\xabU\x05|\xfaێ\x1dRw\xe1j\xb6c\xb6\xaa\\\xb5\xfeE\x9c\x00\x8f>\xf7x\xa3ua\xf1,\xa1Og,v\xf8X=\x06\xf1@ګ\u05cf\xf1\xba\xa05X'\x1a\xaf r\x8bKPA\x81Ct\xeel\xfb\x99\xb3\xfb\x97g3\xe3_z\x012E\xef\x86\xf4\xabй=݆!\xdf.\xcaO\xc3k\x98\\\xcd\xdc\xca\x14\x83$\xa7\x96\x9c\xf0\x16\xc1]vH6\xd4\fH\xed\xa5h\xb6fdd\xe5\xbd\x1b>\u0590\x83\xbf\xd1uĕOH>\xaf\xa9\xf9ӝj\xbe\xe2\xc6%\xbd_7\xf5\x88P=\x02\x8e\x13\x10Iӊ@\x7f\x0f[\xbb\x9d\xad\xaf\b!S\xf0\x8d\xd3\xe9l\xf5\xb4\x93*\xd0(QBe\x1dxSE\x18z\xd0\xd9+\x84ۼs\x80\x92\x84\n\x0e\xd42\x88\x9d\xac3\x8bXgf\xd4yo\x8eU<\x10\x84\xe5ˇ3\U000478e4ܷJȵ̹ !\xd7R\xe5\xb1s\xbb0wa6\xa6nVN\xed\\i\\=a\xd7\xc3Ӷ\xa7e32QU*\"D\xd9\xd6+;\xd0\xeeΔ$\xd9&\x93\x88S\xd7և\xb7/lS\xba<\n\x99m\xf1V\x10\x10\xaf<\xf00\xca\x15l<\xd1q;\xfb\x8e\x84\xae'\xbf\xa2\xd1d)V\xd6\x11\xb6\xb5\x1cvt\xd7F\x8c:\x8cmL\xf3H\xcdK\x18wr\xbab\xb6\x15gvS\xee\xd4`4\x15\bۃ@\x00\x9av]\xa3^\x8f\xebOalu\x82\x84}\x8d\x14\xde\xf9\x99}\xac\xc6\xea+J\xf4K/\xd7O\xf5ي\t\xba`\xc9\x00;\xfd\xf9!\x01\x99\xe0\x9f\xf7\xae\xe3U[)\xe1\x1b\xaf\x7f\xb3\x81\"}\x8e\xc0\x04?R\xdfAuL \xec\x0eh1\xb0\xb7\xef\x02gA\xe7#\x9a\x17\x86U\xabiu\xdb0\xf5\xcby\xdd`\xab/\xf3\x04d\x8d \x18Ȗˍ\x98\x94\x84\xb5J\xee\xf8\x8b\n\xc4\x155n\x82\xac1\xb6N\xc1\xb4\aC\x90\x14`\xd7?\xf2\xabv@(\xb7\xa6\xca\xfeD~\xd9T9\xe5\x88<\xa0\xf9\x88&j\xcaJ:^\xf5\xb3\xe4O\x900<\x8ft\x05&\xa4^\xec\xac鍷\xabv\xa6R\x0e\xd3.\xc0߁\xaa]\xba\xf8Hȩ(\xdfz\\\xb3\xd3\xea\xc7+\n[\x03\xf7\xfd\xa2=\x15\xd9\xd9\x0f\xef\x80\xe6$\xea\x1d\xaa\f\x06=\x97\x8d9\x8b+O]U\x17=I\x87\x960\xcfi\x18JeE\xe4\x8d{\x8cO͌&:\xa2\xf8\xf4\xc6G.\x0e\x8bf\x857n\xe6\xbdqM\x90\xc0矠͘^\x1f_\x81oi\x13\x81\xfe\xc9)\x1f\x7f\x8ar\xb7\b^\x8cb\xbd\x0f\xec\xc1\xcb\bU|\xf7\xca\\\xcc\bp\x1c\x95x\x0fvA\xad\xbe\xdf\x1d\xf6\xc2\xcas\x06\xf57\x96\x06=\x86s\xe5y\x93\x17\xfc~kl\xacGQ\xa9\x85\xff\xe1\b|]\xc9\x02\xe0\rA\x1d\xec\x82\xe7x\xc2%\x90H\x9a&\x88\xd5 X\xaa\x98\xb6\x83\x19\xbcO,Ջ\x06j\x98\x85ԼէPI\xfa\x17U\xfc\xf2'\xd9\xc4d\xb8\x84\x15U)\x86\x94\"QJE[\a3a\xf6\xcb\xdaa'\xac\x80ύw\xaf\x8c\xc6E<\xee\x1c\xee\xfe\xde~<ˎ\x9bʹ=\x89e\xec\xf3\xfa]^\x98\xb0dM\xb3\x14\xbd^C\xa0B\xacO\bM\xbc\x19E\xad\xd05;\x01\x19e\xfa^2\xb3\x99\x15\xb7\x84\xeb\x12\xc9\xcc<\x00\xf6rͅT\xa3\xc5\xfa\xf7;=+\xbc)\xd7\xf6}\x83B\xeb\rG \x12\x96\r\x0e,N\x04\x81Ƭ\x88\xdbh\xc2\xea\xce\xc2\xc8\xc1j0\xa2\xa27\xda\xedv<B\xb3ԓ2\x1dqC^ẏGd`\xa7\xa0\xddn'z\xcc\xeeܤ\x84D\xdc;\xf6\xb5Ǧ2X=\xd5iq\xaa\x95<\xa4\xbc\xab \x86\x16)\xf4pӊ\u07bd\x03\xb3\x8e\xae}\xa73\xbc\xa6q\xfd\x10\xb8qǤ\xcdf\xe6\xe6L\x05h\xe7z\xb7\b\x12\xa5˥\xb5z\xaf\x96\n\x8e4Q\xadމ\x01T\xc1G\x06P\xbdw\xa3\xcagq.\x95\x02\x01*\xbd҅Vd\xfeH\x8d\x8e\x19\xfcz\xae~)m/\xd4q\xa5\xe6\xbeş\xef\xa9/\xf8¤7\xb4F\x16\x869o\xe9Mw5\x16y\x8aE\xf0\xf3\x85\xb9n\xe0\xc7cps\x97i\x8fw\xfcRr\x91Ƴ\xa7\xd98\xd7.\xeft\x1cH\xe3鎟\x9dkG]\x9fq\rr\xad(o\xdf\u05f9V\x937\"\a\xad\x12oD\x06Z\x17ވf\xb5\x1e\xbc\xf9\xf9\x94O\x934q\xcd\xfcH\xa7I:q\xa9Z\x15~R*\xf6;\xaa'Y\x9d\x89\xdf(x9Ӻ\xefjas\xad\xed\xee\xc0ĉ\xca2\xf9<=\xe5\"\x91z\x8a9\xa72\xd6\xc1o%~?\xe52Nf\xb9\xd1j/6\xf0ޔM\xb1\xec\t\x9f\xbc.\xceC\xc6\xed\xa3;7\xea\xedf\xe3\x1b\xed\xf6\x12^3\xea\xed\xa6\u07b3L8\xf5v,bR\x16\xe5\xba&c\xcc)\xb2\xefߋ\xab\xe7\xf2\xf5¦Ϲ\xe3\x16\x00\xf8/\xb9\x8cK:\xeeSNo\"w\x8c\xde|\xa5\x19\xa3=o秐q\xc6AT\xf2\xc6T2\n\xf3\xa7q\xfe\xbdY\xa9sNK\x14\xa3Q\x8d\xb7\xc9\xf9sE\xb5?\xb7J\xf1\x95,di\x18\xb5\xf82\xbb\xc6*\xc5+\xe1\xb0ъ7ú\xe4v\x97\xea\x00h&\xe7\x88\xdb\xed\\\xcay\xe7\x8d\xd8\xd3W?\xe0t\x1c\xa7\x87\x8a\xa3\xe3\xa5\x7f\xb2\xea\xffI\x96*\xa5\x13\xa3(\xaf\xd3\xf9c>\xcd\x04?,\xaa͟p\t\a\xd6P\x8do\x1d+\xd8g\xe5hu\xfa$\x7f\xc9'I\fI\xd8u\xec\xcc\x12\x0f?/b\x85\x8c\x9e\xf3\"\xbf`w\xef6\a\x93Z\xb3\xfd\xf6h\x9eJQ\xbe\xa0O\xaf\x9dI\x16\x14\xea5\x93\xa2\x18\xcd\xf3\xc1\xee^I\xa1^\xfb\xab,(\xd4k\v\x80\xa2N\xbd\xf2G\xff\xce\xf1-\x0eʮ\xe9?\xb1ap\xc2%\\\xde\xe8\x12v\x9e\xe5\x12\x9d\xc8\u0378\x04ԟ͍\xa7V\xa0\xe2\xd4\x1d?\x06\xc7xR\xc4c\xb8\n\x9e8\xdf\xf6\aƷ\xfdk\xa0\xef\x8f\xd0\xc7\xfa\x13\xdfǺ\xbd\x05\xd0\x13\xfb\xc75\xa50d\x1e\x01}\xfe\xfa|d\x8c\x80\xbc\x03\x9e\x12\xafn(\xf4\xcej\x1a\x05i&7\xf9$A\xd7\x06`\x13P_\xc9rD\xccH\x00\x8c7k\n;\xb2\xa5T\xe1-\xb3\xac\x92\x1b\xc9\x189Һ\xab`\x86\xa0\xab\x1c\x14\xe4'\xcd\xe6A1x\x9c\x96\xdci?\b\x03\x19aׯ\x85\x8eh\x0e\x10\xb4ϸ8\xe1\x80i\u0097h\xd1\x19I\xfa\xb4<\x84۪y\xd3Ahi\xbcv\f8\xd8\x17\xb0\xfchT\xf9\xd8u\xf2\x82b0\xa4\rp\xe6\xf8\xac\x90\xcc\xc1nE9G\xd1\xef\xc1i\xa6\x1a\xfa\xeb\xc6r\xfe%\xac\xca\x7fp\xe5\x9f\x19Y\xad.l\xc24C\xb1Ϯ؇j\x98%|\x1bKv\xff|\x10\x0e;\x9b\x0fGߒ?\xdaկ\xfb\x8a\x11\xed\xa2\x1dH\xdaEճ\x9f]\xe3O\xfd\xad\xa1\xe8`\xe8\xfe\x9e+\xf1\xb3\xea^\xfb\xcb\xe1(\x9a}\xd4%\xd6\xc9\xe6\x81y\x8ak\xb79\x85$\r.o6\xd5\x03r\x10\x96\x1e\x92:\xb0\x83uǣ\xb2u\xeb\xe0ԪR<5\xf1\x9b>\xe9\xe4O4%\x10\xd6(\x94L\xbb\x1aB\x10,s\xa1D\xc4z2{N\x93\t0\xc0\xd2\xd5&:\x10]\xe9\xc0\u0604\xc0\xfe\xf3\a\xb2\"\xda(\xd2_i,\xf0\x8eK\x12\xea\xf93\x17\x8bZ\xe9\xefo-o\xae(U\xfe\x87ry]\xca\x10T\x01\xf5!\x82\x1a?\xdeR\xc3#\xc1pU\xbfc\xe5`\x177\x1d\xf5\xc0#\xcd\x02\x10t\xa8\xc0g)+?\x8d\xea\v\x9a\xb5\x17\xcbe\xaa\xbc\x01\xad\xe8\xefe\x80\xcb\xc1C\xfc\x96l\ved\x12y\x88\v\x16\xe6\xb75\xd3`\bI\x1c\xfbOk\n\x01y\x89\x05~]7\x99Z㰌.9w\x15\xee\xd1_\xe8\xf7J4\x8c\xdby\\\xc3-Q,\x980\xb0\xdb/\xb0\a\x8b+\xeb\xfd\"\x125\xe6\xdf\xf6\xbd\x05\xfe\xb6Q\x16\v\xb3\x13j\xb5\xd0Ҿ[.]G6\xb5\xbc\xf94\xd2D\xc14\xadlE\xbf\t\x9b\xba\xae\t\x81M\xc0\xb1\xf1\xf0\x81]\x19\xf8\x8fJ~ۢ\x03\x95^\xda\xdcn\xe9!\xa5\x9d`\xe08X\xfci%p[i֪\xe7VX\x8d\xb1\x82\xe7[\xc9CN\x96K\xbf\xb4\xe9u\x82\xba}\xd0Uj\x9d\xad{ݔ\x02\xb9\xf8\xfcB\x903\x93\xc2NY\xd1ԛ\x00Ω\xe4V\x87ं\xdc\x1ck\x8c\xb9ٖ\xf6\xbcy{G\xcdڈx\x02\xf5q\xb6HeH\x1eu\an\x05\xadW]\x7f\x9c\x1an\xedu\x82\x90\xb2?1\x8d\x94m+\xe2\xc6ڰ\x01V\x84\x9e\x14W\xa6 \xb3)\xea\r\x15\xd1\x18\xad^\xea\xf0\x1f\xcdJ\n\x97:P\x80\xd1\xd1JQ<\x00\x93\xf0.\x13\x92\x8b(\xf3\xdes:\t\xec\xbf|C\x18XՔÔ癐\xa0\x12\xae\xa1\x845+ξ\x8b\xea\xe3\x9c\xe1\f\xb2\xe8]\xfb\xe2\xb4\xed:\x1df\x96_$\x06\x02[P!Q\xfc`?\xa1f3\xa8mW\xd3p<\x88U\xc3\xe5\x01\x80\xefˌ\xa5\x83\xab\xc2Ԧ$\x92\xfd\xf2q\xbb\xf6\x9e\xb7\x82\xe3\x02\xfa\xef\xf8L\xeb\x01\xc4|=UhXD%<\x97\xac\xafb\x98>\xa5\x1a\xf9\xba\x1a\x15\xae_\xa9\xe2\xcc\xd3\x02\x8c\xb9'\x95\x90T\x98\xebg\xe1\x95I֔\x19{e\xf25e\xe6J\xfdt\xa3C\xa7\xbc\xc6+\xc3\xd3z\x99\x88\xac\xf2\xca\xcb{\x97f\xde\x05Ya0ޥ\x81\x98\x8d\v\xa8\xa7\x803\x95@\x02\xa2A;\xc9Īm\x7f\xaa#\x06!\xb5\xd3;\x16\x05\xefD\xa7@\xff\xe0\a\xf0\xc5C\xa8\xef\xef\xb0\x1a\x8a\x1bg,\xf5csN*JU\xdcu\x8bJ}\xf6\x97\xa5x-\xd2,\x80T\x8b\x12V\xa0\x81\xed)\xf1^\x9b\xf8@H\xbf\xb5\x91~\xab\xf2no\x9c\xff\x82\xdc-\x1b\x81\x97\x8c\x15\x9d\xd4\xf7\xf27\xf6C\xcc&\x15w^ũIk&\xa4v\x12\xcc\x00\xa7j\xfec\xb2\xa2g\xfe\xeeU!Un\x82\x19O@=\x94\xebfmm\x95D\xedS\xbd\xcf\xca\"\xba\x83\xb2\x88.\xa5a\nL\xa9\x90S}\x10\x9d\xcd\xc1\xdam\x96ј\xb4\xf3r\xf3\x89\xaf\x1e\x7f\xe2i\xb0K&\x97\xcbᨯ\x1cB~\xe99\xf4\xc5W\xfe\xb6.\xae]\xf9\xad[Y:\uf568\x96\x97\x90\x02\x9fAkX\x9f\xdfJ\x18)ڴ\xbf\xc6!\x8c\x04\xe3&\xa7\xa6\x15\xdc\x0f\xd0\xfdgg\x04\x87\xd2\xceΕU\xc8\xf1\xe7&e\xa87\x05\x13AnY=3\x11'E\x9c\xe4_\x97)\x8cY\x85\xdcGE;;x\xf3\xf0w\xda\x04$\xf2\x93\x02R\x10\r\xc1\xc3\xed\x1d\xcaG%\xbdF\xae\xe7\xf7q~\xcas\f\u074bئ\xb8N\xd7p\xa7ّ\x1e\xf3\xbf\xedT=\b@m\xa0HtZJ\xbcqQ\xa1\xea\xd7l\x05\xbd\xee҇\xee\xe2\xff\x19蔑[\x19>c\x8a\xe0\xef\x12\xb1\x16퍪\vA\xd3\x1b\xcf[]\x8d\x8ci\x8b\x05\x17L\xa2\xb2\x19\xc9r\t\xd1TԨ\xc1\x98\xbf\xf8\xe4\xd1f\vd\xb0&=\xba\xe0aL\x95\xbb'2\b\xce\x163\x99\xccc!\xefC\x1c\x91M\x90\xaa\x06\xa6H&\xce\xc0n\n\x8a\x81rl2F\xda\xe9\xfe\xe5\xe6\xc5\xc5\xc5&\x16_\x88\x19O\xc7لO\x02\xeb\xe5ѡ/;\xb4\x1aŽ\xa4V_/[y\vpYZ\x00\xf3D_\xbf\x04ꙭ\x1dC㝡\x9f\x8c\x86\x88\xb9\x05\xff\x15-?t{\xc89\xb6\xdd\xd9\xf7_\xa7\xf4\xaa\a\xc9\xdbrY\x98(d\x06\xb8\xf1\x1c\xdd0\x1e\x9a\xfa\x9d\xdc4\xa6\xb40\xa6a6BQnZz\xb4jГj\xab\x99\x1b\xa7\x1da^7\xc2\xd2\xf8\xe2\xe52Y.s7\x9cw\xff\xfd\xe1\xb8\a\xfbMñ\xa5\xea\x86S\xce,\x0fGA\xed[\xdbh\x04\xee\xf3\xb0\xdbg\xa8I}\xff\xdf\xe10\xde\xfck\x04\xfft6\x1f\xb6\xfe\xd8l\x8f\xbe%\xd1}<\xb7\xa1\x8a\xab\x03h\t:\xaaR^F\b\v7}A4\xbeV:KԖ\nV\xf4\x93\x0f\x9f\xa2i\xbc\x00\xf0)\x1b*\x15j\x8a\x7f\xe0\x11l\x1f\xe9\xe6\xb2\x03\x03\xbb\x15\xd8\x00\x99k\xaa\x88\xd6\xfe\x16a`N\xd7о\xfd\xaa\xce\t\xac[\xbe\x13OG\x86\xf4\xf1\xca\xd6\xcft\xc5\x12-\xf22Rc\x0f\xa8\x98\x90)*n\xeb!\x80a\xcc\x13\xb5\t\xf5\xb5A?\xd6s6\xae=\xf3\x02\b\x82Z\x83\x8f\xc0\xb4>\xbb\x99\xfbW\x98\x12\xafś\x88\xa5Ls\x87\x97\xcb0\xf3\xdaV\x92\xae`\x04\x16=i\xc1\xf8\xa1\xb6\x94\n\xb3#\xbcL-\xfe\x83\x95^\x17\xefڒN\xe0D1\x99\x86|\xd8\x1d\x15\xf7\\\x1c\xd0B/\x8e\tz[Q\xa3\xe9\x96\xd6X\xbd\f\xe5\x88\t4\x9f\x85\xe5z^|=$\xd30\x04\xfe'\xb8\xb4\xf0N\xffF\xa7B\xa0~\xc9\x02P7\x1f5K\x91LC\xbd\f=\x88\xba\x03\xa18L\xb7]\xed^\xd8N\xac\xa0n\xbcs?LU\xa5Y\x9a\xb8Z\xe9\x9dk\x19\xb7\xf2\x1bq\x9b\x83\xb82\x0f\v\x94\xfb+\xcff\xb2qP2\xc1\x89Ե\xff2\x9e\xafV\x14u\xb6零Q\xf2L\xb7\xa8\xf387\xae\xf3p6\xafs\xe76\xf5E\xab\xca\xd5\xd7͢\xd5\xde\xee\xd6N\u05c9V5\xb6X0_\x05\xa6\xe0\xce\xc8\xd3v\xf1L4x)\xc0\xb1h{.\xf2\x8ay\x10\f\xfeK\x9b\x9ff\"\x84aUiQ\x9a2\x9cx\x8c9)a\x90\x9d~\xfcH\xf6\xe3V\x8b@0\x16Wa\x18\x8f\xfa\bf\n\xe0\xdd\xec0\xcfX\xb6\r\xf3\x11K\xe9\x02\x9dq\xe7\xd4\\k#\xe5hv\xac\x826\x11\x14\xfa\x8c\xf5\xf1\x1ek|\n9\x14b\x1b\xb4\x95\auѮw\xf1\xe3\xeeJ>R\xec\xdf\xf5\x05=O\x03\xb4\xe2a\x00\"T\xd0\xf9\x9d&V\xb2\xfdP\xb4\x9d\x1b=\x987d\xaf\xaf\xe8\xb4Ҁ\xe7\x04\xa5\xd8\x04\x0f%̣\xb1'\x04E\xa5\xed\xbd\aw4\x1f\x00\x95*\xad\xf7\x92\xae\xf4d7R\xd3\xf9\xfa\x8de\xe9\x18Yp\x19\x82\u0086'\x82\xa3:I<\xcb\xfb\xce\xf3;\x9aOMӶ2\x91*\x95c\x95W\xaa\x18\x04R,8<\fE\xb4\xb1!0~\xfe\xde\xeeï0\x8b\xf8,\xeca\x04\x83\xa3\xb6\bSU.\xa5\xd7p,\xde\x00\xd9ⴵ\x9eqz\x14\v\x8ez\x12\xea0\x7f\xc7)\x9a\xbdY\xc5\x1e\xa5ߤS\xdf\xd8\x10̿蔷\xca\xd8C\xe99q:\xe1|\xfed\x96\xa5J;\xe3/T\xe5@m\x94\xc7Wo\xb2$5\xca0?;\x05\x10\xa5\xf8d\x7fk\xc0~0\xfa\x1a\x99\x19G\xa6Ƒ\xa9\x98бP\x15SI\x8d\xd69\xfe\x96\x92f\xc7h\xb0\x89?\xb9\xa4\x8b\xd4O\xf8մ\x1b\x9bvc\xd5n\f\xedf\x9f\x12\xad\xb8\"\xa8\xd2l\xc5_\aZ\xff\x0e\xbe/\x05\xfd\xbc\xe0:\xde\xf4\x91\x99\xed\x04\xd0\\\xa7\xf3pK\x91\x9ai\x98 z\v\xb7:\x88\x0e\x17\x986Sf&[;\x0f B\xfc\x1c\xd3\xc6\xcaȤ\xd7\xd9\xde\xddFݑv\x1aNQs$\xdc\xd9݂\b\xc6g\x986A\xcd\x11\x13\xe1\xf4\x1c\xd3NPu$\xdc\xdd\xde\x02\f{\x8ciW\xa8:\x12\xf6\x1et\xc1\x1b\xe2!\xa6]\xa0ꈉRz\x84i\x97ZuD)\xb4\x1c`\xda;t\xc7hP\xf8\x13L\xfb\x84\xee\x18M$ԏ\x98\xf6Z\xf9c\xecu\x00\x94W\x98\xf4\x1c\x9d1\x1a]\x987\x98\xf6\x1e\xd5%D\xb8\xd5}\xd8y@(LP\xb7\xf7\x90\x94\x1c3\xa2\x7fG}\xc2^\xb0`\x91\xa2x\x93O\\\x88\x83\x93Yv\x1c\xcfޟ&\xf9\xc0}Fu%s>\x9b\x0e\xe0\x9f\xe8\"I'\xd9\x05\xbd~\xa6\x1f\xac\xd1c\n\x9a\xc4\xd13\xfa,\x99\xf1\xe8\xaf\x15{\x81\xeb\xf6\x01 x\xb0\x03a\xa1?#T\x1f@\xf2o5\x84\xeea\x1a:\xfb\xf5\x83\xd2ifSt\x7f\xf0?\xc3\xd1\xffn\xdck~\x13\x92o[\xb4ςv\x92N\xf8\xe5\xebi\xc8\x15u\xfd}M\xbd\xfb\xff֯\x85?6\xdbG\xff\x19\xb5\xee\x19\v:\xee\xdf\xfb?@\x05\xbc~\xa8\xf8Zf\xc8\xf5\n_]<\x1f\xc7sNk\x82\xbf\xf4\xeab\xde\x19^Zّ6\xb7\xe1댹8\xa1u\xa1?\x8d\xa5o\xb3\x99\x0e\xb2\x81\xe7a\x85\x93\xe8\x00u2>+\x05\x06K\xd5\xfbs\xa4\x87\f$\xda\xf7\x1e\xe7\x06\x9dX\xfd\x82,\x9a`\x91\xe6\xf1\x14\xb1e\xea\xb2\x15\xae\x80k\x19\xfc\xbe\x1c\"\v\xc2\xc6\x0eR\xdd\x1e \xc5|\x18\x920k+\x1eE\xc8}!T\x05\fS\xfd\x1eV\x0f:\x01\x98D\x8ec\x19z\x93\xd0\xdd\x01\xd2\xf7\xc3|\xceœ8玕+\xe9f\x8f\xacȚa\xea9\xfe\x97k\x12\xcaj\xf7'A\xe1;\xe2\xee\x85\xfd\xa3\x85\x8d\xf16\xf2$\xec\x10\x81\x18i'\xb9!J\x06\x95\xd10\xde\xfeį\xa805\x91\x19\x95˫\x19\xc7g\x0e\xbf\x9cϲ\tG&\xb7\xde1I\xcd\ue14dI\xafU\x81(^\x91\x95r\x9a\x99`\x84R\x7fI\x0e\x8a\x0f\xbe\x9a\x96\x92\u00a0i\x80ty0\x8b\x8f\xf9\xcco)h\xdbI\xfa\xd26ہ\"\xf6\x83\xb3X\x8a\xe4\xf2+\xe1S\xf6\x8c\xa1W\x84ꀡ)\x86H\xd3\x04<\aE\xeb\x8f!\tc\xf6\x11#\xfa\x04nq\xe1\xcdN\x83\xbeuA\x91PI\x03f\x7f\xc6T\x90\xe8#\xbe۽J4\xa0\xb6DJ\x05\xb0v\x03=I\xc0jS\xa3A8r\x96\r\x82\xa6\xad\xa9ڎ\xa0ǯ[\x8c\\{\xb1\x9f\xc7c\xfe\x94ϒ\xb3D\xf2\x89\xd7\xdf\f\xfa\xabt\xf7\xb5\xbd\x05\r\xdbԌ\xa8\x9e\xe7ɼ\xb6\xe3\xc5?\xdb\xf1\xd26\xb5\xb0\xea\x19+\x8c\x83\xecb\x97\x9c\x86\xe4\xff\xe4q\xa29{\x82\xa3Xw\xae\xf2\xba\xfd(\xdd~\xcc\xe9\x8c%\xa1@w\x058],\x80\x9d@ǌ\x0fʛ˛:\xb5\xfb\xdc\xf6ͽ\x9d8v\x16d\xd2mمۛ3\x7foV\x0e\xf0ׁܮ\x01\xb9\r \xb7\xff\v0{\xf8\xa1\xd9\xcc\xee\x06wFc\vu^\x86\xb4\x0f\x90\xf6k \xcd<\x18sb\x85f\x05t\x90\xd2x\rp7B\xa6\xcf\b\x18E\xe5\bY:\x02*\xb4n\xd9\xfb\xa53\xe4Ù\xe9\x19\r\xfcSi&0\xf5\x91RFs\x1fN\x87\x94\xee\xbe\xeeta\xe7\x10V\xbb\x82\x03ݔ \x86\x8b\xf4\xae\xa5s\xb7\xb3}\xd8\xcd>\x80\x7fgu\xbba\xe6vCN\xe7\xfe\xc6\xd0\x03\xf1\x90@\rY\xa2\x0f}j\x0f}f\x0f\xfd\u07fc7\xed\xa6K´t\x8e\xb2\xeaE\b\x85ʛö\xf0Q\x91+n\x8d\x85\xbf\xb9$u\x95͊U;\x87w\xdck\x8b\x03\xfd|z\xbd\xc28\x1cn\xa24\xc7\xfc\xbbR\bu\xd9\x06i'\xe3*\x9e\xf9\xef\xec\xdaz\xdex\xcb\xf3Hr\x8a\xd2\xeb\xe7\xe9O\xf0\x9cz\r^@Ϣ)\xf7H\xe0\u07fc\xd9\xfbI;c\x91\xa7IN=\xbe\x8e-\xfcS\xe8ʲ<$\xe1\x02\xe4\xe3\xb1\xf8\xe46_\x83\x87z\xf3\xd9\xf0\xa64wD\xb1\xd9GP\x11\x1c\x1a\x84\x85\xfb\x03\xe3\xa7\xf4I~\x91\xa0\xaf\xbc\xf6\\\xf0s\xc6\xdb)\xbf\x94\xe4z\x1c\xe7\x1c\x82\x91NC\xc1jit\xa4\xcd\rM^\xb9\\йq(\x98$\xe8\xa9p\x9d\x81%\xfd\xbd]\x9d\xb2Px\xe5\x9bM\xbc;\xccOҞf\xe20\x1e\x9f\x16\x87\xa2\xae/[j\xc8G\xfd:\xc1y\xe8\x97`\xd2s\x9b\x9e\xb6\xd0oz\xa08\xf0\x1b\xa2Ƨ\x0e\xb9Vsú=\x1dF\xcfJ?0y\x8f\xd6Ղk\x0f\xe7r/B\x8fU\xb2\x03ǋ\xa7\x92·k\xb3\xab\xdb\xc4\x12BU\xe9v#8\x9e\xb2\xa3\x7f\xf6\"\xebn\xc5\r\xc4p\x857a\x98\xc1h\xb9\xf4\xb24\xc3z\xf3=f\xd1\xfbV\x0e\xfa\x87\x13\x84\x9a\xa7\x1ar\xabq\x7f\xfb\x06\xab\x8f\x9b\xcdP\xbbX\\\xdb%\xad\x16(v\xac\x82\xe4\xf0s\xd6ݦf\xbc\xbb4\x14\xedE\xce\xc53`(-\x97\xc8W\x82\x85^\x88\x195s\xd6\xdd5#\xceܬa\xfd^\x87\xfe\xde\xf6O\x1f^8\xa6^\x0f7\xae\xad\xb3!\xea\\\x14ٹ\xef\xedծgo\x9b\xd6\xd6\v3\xd3˶^Ү\xb7\xa4]\xd7\xec\xae[\xd2.\xcbt\x9d\xdd\b\xc0\x92]\xfds/ҥ\xb7\x1e\xaa\xd2*}\xab\xa3\x9a\xc6Y\xdb\xea@\xc3=Ƶ\xaf\xa3\xee6\xa1\x99\xedfk[w\x83\x1e\\\x1bPR\xb7\xb1\x1daR\x18\xe3\x1b\x129da\xa6\r\xa1\xe1E\xa9\x1c\xde\xe2\xf0\x1a*\x15ܯ[\x14k\x8a\x12B\xf4\x173I4\xf6\xec\xa9\xfdT\xd3\x1ev\x06\xe3\xec\xd1XC\xf3\x10\x97\xa4\x9d}\xb2\x90o?\xf0!\x0f\x93\x7f\x1e̤\x16\xccĂ\xc92\x9a(\xf0\xb6\x1fDv\xf5\xe3c\xb1\x98\xa3\xa6#$\x04Ԭ\xf7\xf6N\x04\x7f\x03\x9eN\x02W:\x97\xd9\\\xf9\xa8\xe4(|\xa5\xc3aw\x9bnuFȇ&t\x1d\x9a\a\x94\xf5\xab\xef\x9e\t\x12\xf8\u05fab\xb2T\xef\xfd\x10\x04\xf2K\b\xf7v\x15\x9f͖\xe8\x01\xf6\x8f\xe3\xfb5\xfc\x18^p\xf7\x83\xf8\x11\x1d\xd3[\x8eOU\xceO\x96K/\xbb\xa5\xd2\xf6;\xa4\xc4\x13y\xeab)x\xf7\x99\xe4\x8eV\xaaa\x00\xf5j\a\xda\x1b\x15\x19:\x9a\x01\x04\x8d\x83\\\x87f.>n\xdal\xa64f\xd7٧\x88\xb7\xb3OT\xf9\xef]\x88\xd9r)\xb5C\x80\x88\x9bM\xe0\xf6\x97M\x83\x1f\x9aG\x9aG)\x0f\xb9\xbdtV4a\xf1Z\xf4\x97\xb3l\xb9\xfcU\xf1G!\x8c\xa0T\x8d\x1eϲc\x90\x80\x1f/\xa6SǷ\x99iU\x1d\xe3\xfd̿\xc5\xd0\x1f#\xd4f\x9c\xc6h\xfb\xcf8͉\xf1\xe3-\x19\xaeXB\xfa\xb1\xa2C$\x8d\xdb\xd9\xf1G&\x9d\xbb\xdbX-\x82>\x7f\x06\xa3\xc5\x05\x8d)\xe1\xcb\xdf\xce\v\xfaC\x01m\x04h\xad>\x9f%2\xc4_>\xaf&\xe5\x7fw\x9fZ\x97aN!\xda\xf2:\x8f\x95\xad\xd0\xf5*\x02\x86ֱ\x0fX=\xcb\xc2\ue973\x90\x84\x92\xf6P#c\xd8\x01\xedi1\xec:\x9b\xfea:b\x82\x87\x19\xa1|E赯~\x93\x95\xce\xc0r\xe9qa]\x10\xb6\xf8<9\x89%\xb8i\x0e%\xb3\xbf\b\x95\xcdf\xf0\x96\xc7c\xf9*\x96\xc99WzpJ\xb9D\x0e6жɐF\x1b\x8a4\x02\xe3\x83J\xc8[إ\tq\xa1u\xffj6\xb9\x7f\v\xff\x05\xa1lM\xe6\xb3R\xe6\xb3\xe52\xdc\xd8@&\xd9c\xdcj\xed$\xff9\xe1\x17ؕ\t\xab\xe7\a\v\xd1p4\x9b5J\xe9\xbc\r|\n\x7f\xb7\xc4\xc5\x19*2\xe5\x94~Y\x9e\x9d\xf1\xb0\x8e5\xa1g\x17v\x1f\x8a\x148\x03\xa2\xf3\f\x1f\xefENL\x14\xfc\xab\xd7\th\x81I\x12\x05\xcb`Esή\xc7\xf9\xb9\xaa\x93\x9f\xeb\x82\x12\xbf:\x0fU\x95\x1c\x8b:\xcc6\xfb:L\xd3l\xfa\xbfh\xca@kc\x06r\xbb$K\x812\x8d%͘l\x97\x1c\xabҘIK\x8b \xb2T&\xc64aRqe\x93\xf4\x84欴\x13\xc0\x15h\x85\xc5)#\xa9\x9fa3&\x065S\xea3\xabWQ]\x01\xe8\x90\x7fx\xfb\xfc\x89\x11s\x02\x12F\xe1+Ǉ\x90sJ\xef\xf8\x02\xc3\xe1\x82\x06\xc1\bU(2\x1e\xe6d\xb9\x8cᏗ\x9d\xab\\\xebWi\x01s\x9cSAcl5A\xf45\x84\x81%\xea\xf9H\xa8\xfa\xa1\xd9F\xe6'N\xde[\x8e\xf2\xab\t\x19\xad\xdd<\x05i\b\xe3p\x97\xe2z\x8e\x99n\x9fΙm\x9cNY\xa9\xe5~\x19\xcck\xac\x14\x8d\xa9\xae\x12\xcdi\xa1B4]!\xa3.\xf1\x9d\x04⠪\xd7 c\xacT\f \x9bTŠ\xf9 \x8f\x8eB\x02\x1a\x01n&gᄌF+?!\xf7V\xd5\xe5\x956LN\x06\xba4\x19\x8d\xa2\xe2\xd6\xc9I\xb3\x99\xb7\xf99\x17W\xb5\x93YFB+\xa2\x1a;\xc0v\r\xf7`\xe6q\xaeG\xa3H\xf5\xa6\xe0\xffJ\xa8\xb4\xee%\xf2Pj;\xd4\xdd\xd4Ñ\xf3a\xba\\\x06\xe3\xfc<\x18\x99\x92\xb0M\x1dvZxZ\xe0\x86S\x04O\xe0T\xed\x91\xe5R1\x04\xe8\x8c\xd9m\x9f\x9aM3\xb0̂<\xb2\x89t\xc16@\xd8\r\xc4DqG\r\x8c\x94&\n\x84N\n\x80\xc5s\vcd\x01\x1c\xd0\xf9\x9aü\x8a\xeeP\xbb_;\xef\x12\xe6}\x1erBǡ\xacN\xbd$\x83\x99)pP\xd08\x1b\xab\x99\xac\xcdQӞ\xf0a\x8e\xf3]\xe2\x99\xe4\x83\x03dH=\xc1Z\xce\xe8Î\xc1\xc8\x10\xccf\x9f\x875\x12\x80\xa1/\x01\xa0\xc1( 8\x84\xa1\x18\x11\xd0a\x8ff\xd8KR\xec%\xa9c}\xdb\xe1\x0f\xb9\xae\xeb\r++6\x90U\x85a\x86&\x1b~D)\x9a\x03\x12\xdb\xf0\x98\x82\xc2\xf4\x81\x9d\xf8G\xc4mıOT\xbdR\xb4\xcb\r$\vM\x1dђ\xb1\x14\x88\x96\x98\x81^%M\xd8<$\xe1\fm \x80+\xa5\xe2\xb8\x00\xaf&i\xe7!\xe9o\x84\x82%\xed4$\xa4=\xc9R\xde7\xe2\x12hN\xe8\xbb\x03\x9d<\x00\xbdE\x17ȧA\x05\xf2\xc2\xf6X\x18lJ\xa7\xd8\xdf\xc2u3\xd5\u074cٴ\xd2\xcd)\x1bk\x19\x9di\xb1Hr\x9c\x12\x83\n\xe1-\xf7,\x1c\x9e\x8eH\x9f\xc3\v\x88\xa7\x93pF':ص\x97tJV\x8e|\x9d\xb69>\x19\x924\x9eͮ\xae\xa7\xed)<\xadL\x9c\xe8\x9a\x0e\xcd8NL\x87\x8bb\x87'\xd5\x0e\x17~\x87I\xb1\xc3\x04;\xb4\xa7\x93Ph\xf5\xb1G\r\u0379c7=Y\xb3\xca\xd2\xc9\xd22\xb3\x98\x82r\xd8\xe0n\x963=\xcb`GS\x9e\xe5\x18\x173u\x8b\x99\xb0\x18\x163g1,\xa6\x1c&#\x96{\x83Ȋ\x83\xc8\xfcAHE\xf1\x9a\xeb\xf0e\b\xafd\xb8h\x92\xe9U(\xe9\xb5\"\x17@_4I'\xc9X[]\xa0B\xae\x1d\xf4\xf4\x1f \xfb\xa9d\\\xf1v\x1c&\x96\x83 \x88$\xb2\x9eQ\x01\x05Yπ\x93i\xccj\xb4֪\xbdS\xe9i\xadqB\x05\xeb\xf4\xc5#\xde\x17\xad\x16\x01\x84\xe2A$FZ\x87\xe8MQ\xfd\xb6\x16+\xdbC\xdetr\x84t\x10\f,\x86H\x81c\x8f\xfc\xeeL͌BW\x19\xa9!g\xd4f\xc9 v\x7fA\xbc\x9dq$\x01c\xae\xa2zќ\xf1\xf5L=\xbe\x8e\xb7\x86\xb4\xd7ry#k/'F\xc69桚a\xd2\xff.\x9cQnO\a\xbe'\xe7\x1cx[\x9a\x9b\xa7ʭTl{%\xa8\x14\xe6]8\xc0\x8b\xef\f\x95\xf3н\xec\x18\xb6甍\x01\x81\x9d2e\xb125\xa8\x00\xf6\x9cb\rLA\xe5\a&*%\xfd\x93zN\xae\xb1\xbfR@L\x86\x1cP.=eō;q\x1b\x17B\"B?\xe7,\x0eO霣$\x00\xb7\x1a\x9b\xb7Ω\x1d\r\xee0w\xb4\x11\x9bq:ᨂ\xb4\a\xc1bϸ\xd2K℞pt\x93\xdb\xed>Ĉ%\x90|\xc21\n\t\xb8\xb3فH\xad\xc7*\xf9\x8a\x13z\xc1\x9d'\xdeC\x95|\xc1\t\xbd\xe4\xceoΑJ\xbe\xe4\x18+Ä\xa7=P\xa9\xef8ƴ\x00\x9d\xa4\x87\xdb\x1d\fb\x81\x8aJ\x9c\xa0\"\xf8)/\x89!\xf4 N9Sws;\xe7\xe0\nIf\xb0[^O\x97\xcb룣9\xfc>:\x8a\x86\xa3\x95\xf7Vē\xd2l\x16\x9b\xe3m[\x9c\xc9\xd5rY\xcc5\xa7O@\xacEI$؋\xbe\xbeHM\x14/\f\xdc\x17\x02rcx\x85\x83\xc1&V\\\xd1R;\x96\xfd\x10\x92k`\x86\xb5Q\xc6\"\x16c\x89\xac\n]M\xc5SWCa\xda|B\x0e\xf48ǂǒ\x87\x92D\xa1\xf0\x8aI\xf7\x8d\b\x1b\xf5\n>\xdaٱ\x99%\xd0\xfbk\x1c_7>r\xc3\x0f\x91dUR[\xaeܤ\x9c\xf8z\xb5\x1e:\xd28\xca`%c\xb4\xe7\x90S\x10\xb4,[H\xc2A\xd3\xf0~\xe2W\xb9yeyI\xf0|S(l8\xea\x9b\x1e3X\x15Np\x00\x19i6\xb5)A\xe6Ж\x1b\xc0{\x1c\x80\x16\xf7\xd8P\xb0(\xe91\xb4\xa5akz\xec<\xfct'\x8f\x13\xc5\r\xf5\x9eg\xa6\x12Z\x84\x18\xcdM{\xd0\\\xffo\xb8\x91:\x19\xd1c\aq\xbf\x99\x97Gi_y\xc4\b1\xcc\xda\xf84\x16\xc0\xb8=\x90\xa1 d\x9fm\xef5\x9b\xf2\x11{\xb0K\x9cb\xbbh\xb5\xf4\x91\xde踎\xdez\xb4\xd8f\x17\x03\xa2X\x9e\xe5}\xb0C.'\xfe\a\x98^\x11w\xf2\xa0\xff\x804\xe8?\x9dBd\xdd\xfb\x98\xd6\r\xbc-\xf1\x92\x17^\xe6\xaez\x17\xca\xde\xf7\xab\xff\xa7\x83\xd5\xfd\xdaO͆\xb2\x17\"w\x96\x02\xe8\x1f\xe4\x96\xcd֡\xc2M\x9f|$\xfa\xb2Ղ:\xd00\x06\x9c\xb3Sc(\xa8J\xc4XNLs)\xc3\r\x0eԨ\xb9]\x95\x12x֏\xbdfA\x19\xdck\xd9h\nuݰ^\x14\x98/ \x8a\xb3](\x14\x829\x19\xab\xc0\"\x87\xe9hP\xdan\x90\xa6\xd8\xea=\x12\xc1\x0f\xa7\x92\x9c\x81\xbc\nw{\xda\n\xa2F\xd0\xca,\xd1#\xf4\xf5\xfd\a\x98\xbeCo\x8fy\xe1\x99fa\x95\xa1\x0e\xb0Ecs\x8f\x97Q\x13\xdeˊZ\x00\x0e\xfe\v\x0eT\x13:\xa1\x15\x147P\xc1\xb7PF\xa5\xe0(\r\aV\xf9ibE\xb0\xb9\x8a=!h\xae\xb6\x1dKi\xee<?\xb0\x8c\xe6m\xa8\xc9bZ\x8b\xd3C\x10[XDFhn\x03X\xdc\x19 \x9a\x9b\xe9y\rU8\xa1r\x15\xaa\x88\x11\xf4\x19g\x8f9\xfd\x8b\xb3\xf7\x9c~\xe0\xec:\x9eL\"\x1f};\xcf\xfe\r4}\xc1IR\xe4\xe9u\xca/\x9efc$\xb3\xc0\xff0U\xa1y+\xd5\xd5\x1e\x83\xda\xfd\xe2\x1d\x0fI\xa5Vt\x1b\xe8{\x94\xea#t\x87\x06o\x06\xad\xd0\xe8\r \xfe\xccCA\xb1\x15А&\xfd\x14\xb8\x01\xec\xbd\",\xd4ֽ\aE\xae\xb3y\x14\xa86\x03\x1d\xd5\x00*\x81!̊\xb4U\x86eC\xb9\x1a\xf1d\xe2\x17\x87/\xed\xb76[\x91\x9b@\x1eg\xf3\xab[A\x86\xdeɗt\x8a\xe3\xaat\xbcZQ\xc9s\xb9f\x0f\x94\x80Ē\xdf)\x9cC\xdd\xf4\x83\xca\xff\xd1\t_\xd7HÕd5[\x00<\xb6~\xbey'\xbe\xe1\xa14\u008a1l\xe9\x8e\xdf{t\xcbnPGE\xae߰\xb5c5\v\xe2\xf5\xda%`|\xff\xdfܧ\x1fx\x1b\xfe\xaa\x1d\xf0\x81\xb7ᯚ\xf5\x0f\x1c)|5\xcf\x1fx\x1b\xfez|\xf0\x9f5*\x06\xcc\x0fx\xb6\xa8[,\x18\xee\x8d#t\xa8\xa86\xc7\xca\xdb:\xe8LFs\x14\x1cv\xbf\xe7E%\xa4q\xf1\xfa\x02\xbe\x99`\x1b]/ʵ:<\x1b\x1d/)k6ì\x98\x147\x9ba\xcc:\x84B\v5R\t1\x10\xb8\xbc\x1c#F@\xdc\xd3\x1fp\xbd\t\xa1\x81\x96\xb9@\xaa\xc6\xe0\x85\xe9\xe4J+\t\x0e\x00\x16\xcc\xe6\xc4\xc6k\xf1\xca1\xc3vO\xb0\xb8^\xcb;V1\x87\x9d\x99\xea\xf0\xcb\xd4\x05\xfee6\xbf\xba\xb9)\xb5P\xea\xf4\xd2Bu\xf0\x14\xee\xda'\xba\x03Xt\xdb\xe25r\xad!\x89}\xa7\xdaQ'\x80nt\x91Q\r9Di\a \x93\x84\x87\xc1{\x9eˆ\xbd$\x1aS\x8c\x80\x14\xd0\xe0\xfd\xe1\xbb\xf7\x9e\xdf\xedg\a\xcf_\x1c>\rhL%\xe5\xa4_\v:\xa7\xc9JM\x99\x0f\xb6\x1b\xa677\x85zx\xa9\xab\xd1\xe0\x0e,U\x94\x065\xa82\xa24\x00k\xcb\xd3\xf83\x9b\xff\xd9\xd0\xf6\x88W\xa0\x19\x90f\xb2\x91\xa5\xbc\x01\xbe\x9bL\xa9\xbc\xa1\x89U\xa0@\xde>{\xb2\xb9\xf3\xb0\xd3\vh\xe0\x86\xfa\xfa\xcd\xd1\xf3W\xe8o\xbf2\xdcU\xba\\\x86p5\"\xf1\xab\xb8m\xa1\xdar\xe8\xd6Ӽ\x8a\xef\a\xa8\x9bH\x17\xacK\xc7Vi\x8a\xce5ÃN\xcdǩ\xfe@\xc2\xe8\x94\xd5o\xf8\xe8\a\xdeW\xf4p8e9\xf0\xb5\x80n\xdd`\xd3\x02\xd9\xdal\x86S\xf6\x12^ք\xc2\xc1\n\xecS.`l\xba\\\x06\x96f\x80\xdf\xcd\xe6\x02}\x86x\xe4M\x00\x9a\\\x8b\xcd\xee\x88x3\f\xac\x05\xa5\x88\x11\x00=\xb6\xa9l\xa4\x1ag\xd9$\x99^AX\xec?m7\x7f62\xd1\xf8\xd3k\xf0\xbe\xedQ-\v,\xc9q\x9c\xc2\xdcO3\xd10\xf6v\r\xa1\x82j\xd1F2ū\xa0q\x11\xe7\r\xe0\xb0-\xc4<\xcb9m\xccg<\xcey#\xe7\xb2\xf1\xe7q\x9cZb\xe8%@\xa1\xc51\xf9\x9f\x8d\xe9,>iLc p\xe3tҀ\xd0\f\x8dD6d\xa6Z5s\xdbn\xbc\xcc\x04o$\xe9\x14\x9fL\xd38\x97\x9b \xcb\xd9D\x0f\xb1\x8d\xb7\x87\aO_\x1e*\xadOaXZ\x8c18\x7f\xf6\xc7l8\x1d\r\xe6,o\xe7\x88\xfa;ta\xf8D\xf7\x03\x12-\x18\x1bov\x9b\xcdpn\xf0\x11\xb5\xb4\xea\xbc\xd9<\xd5\x18lN\b]\xb4Z\xb4H\xd8ψ\xc2қp\x0e\xa6d\xcaf\x86\xae糜_+\xa86\xde\xe0B\x17O\xc2\xe1\xe5\x1cc\t6ⴱH\xf3\xe4\x04f\x1a| nv;\x8d$\x95\xfc\x84\x8b\x86BT\x8d\xb3\xf8\x13\xac\x9e<\xe5X]\xf0)\x17<\x1d\xf3\x89*\x80\x191\xc0\xd3\xe03\x0e\xa7\xb4\x01\x86~\x98\xfc\x17\x17\xd9&4\vGh\xc2/\v\x87\xe7\xcd\xc1\xfb\uf3de\xbfxq\xf8\xdd\xc1\x8b\xa3\x83\xb7o\x0f~;z\xfe\xea\xe9\xe1\xaf\xf6(!\xe0\xb8W\xff\xf3\x9f)\x8a\xd9\x16\xfblL\xf4\xb8|\xec\xdclN\xf7\xcd\xd8\xcbH\xeb\x947\xf29\x1f'\xd3\xc4@\xd1x\xf9\xe1\xdd\xfbƫ\xd7\xef!\xc2\xda\tr\aDC\x9eƩ\x1a#\x9a\xfb\x00\"\xd0\xc3\xc9\xf1\x01b\x06Y\x18\x02F\x178z\xfd\xe1\xfd\xd1\xebgG\x8f_\x7fx\xf5\xf4\x9d\x85\x1eޤ\x80JÄ}\xe6C\x80rd\xf8\x873:\xa5\x9c\x90\x7f\x1e\xc7:\xae\xb7\x9d)\x03Ç\xffc0$\xd3p\x86\xbb\x1e.\xe6\xc5#\x88\x8b\xb91\xf3U\x1a4\xba\x9a\x957\xe5\x938\xc50\xfb\\\x00\x13у \x968\xff\x13\x9e'\x82\xc3i\x95\xa7՝\xf4\xe1\x95\n\xc1t\xf0\xf8š\x01\xca\xe3#\xfcR\b\x8c\xec\x13 7R\x1bp\x80\xca2\xb2\x12؈\xe7\x1a9(q\xa6c\x17\xba/N\xed\x86y\a\x81\x13^=9<z\xf5\xfa\xfd\xd1\xc1+\xb5\xd9\x03\xd2/\\\x11\xe6\xbd\x1b\xfb6Ֆ\x1d\x94\xb0\x0e͙\xf9\xddO\x1e\xe5\xfd\xa4\xd5\"1p\xf5\x15u5LFTЍ\x0e\xc5\x106\x9cA\x96\x7fw\x9a\x05\x8aK\x17q\xec\xe6\xe8{^$?\xefi\x8f;f\x1b\xa5\xff\xc0n\x11\x85ݒ\xfa\xc08@~\xf0\x04\xb4\x1e\x03\xc2n\x1d\xaetT\x18c\xbc\xac\x0e\xc1\xc9\xdaK__\xf3q\xda\xd0\xed\xf9{H/\xcd\xeb\xc7?\x1c>y\x1f\x00\x90Tٞl|\xe0C\x0e'\xe7\xff$1ắQ\xfb\xc1kb-0\x90[\x05'n\xe8v\xaa\x18\xb8ڧ\x8aS\n\r\x95X`:\xd1Hq\x8a@|\xb3\x1e\b<\x13\xb9\x8c\x85\xbe\x1b\x82\xfb\xc17w\x01\xc4\x11żH\x14\xc3O\xd0E\xaaN\x0eR\xc3k'\ar\xab\x933\x17<\xe7\xa9l\x84Zg\x03\xc2w&i\xe3O\xe8\xfcO$\x10\xfe\x84\x8e\xff\xf4V\x93\x14&\xf2\xd9\xdb\xd7/1<\xca\U000f71e5\x01\xe8[J\xc3\xef=\x11t\x8a!\xca\xf5\x88\x1cWO\xd3\xe3k\x87\x82\xd9w\x1fK<\x99\xfcI\x1b\x7fj\x00\xf4\xa8\xa0\xf3\xf5\xa3R\xb7\xdb?2,\xe0\x02\xea\x01\xfd\xdf\x1fѓ\x83Wpڟ\xbc~\xf5\xfe\xe0\xf9\xab\xa3\x0f\xaf\x9e\x1e>{\xfe\xaa8BA\xdc\x03\x10Ǡ\xf9\x8f\xfa\x048\xe2ݱ=\xd3j*\xb4\x94\x81\x8c\xb3\xd5m6\xf1\xe3\xe6+/\xd6\xc3\xfaһO\x8f\xe8\xe0\xa9\x1d\x83c\xdeV\xd7\xc6=\xbat\x82yJq\xf30T\xa3\x04\xed؛\xe1\x05\xc8\n\xa0\xc6\b \x10S\xb21ɸZ@~\x99\xe4\xf2\xb6\v\xbb\f\xf6M\a_?\xd9\x7f\xe4\xe1\xb0̌P\b@\xb3\xa9\xd4aZ\x8d\xf4\x9a&\xcd\xe6\x8d0\xe0\xbb\x17x\xa2_2h\xe8\xee\xae\xc3F4Q;lϲ\xd9\xde\xc1 \xf4GW\xdf7\xdfo_O~$\xd3P\x92_x\xf8\x9e\x87\x92P$C(\xf8\x00\xea\xa8\xd8\xd3ׂ\x89\xe5\xf2\a\xee8\xf1\xac\xd3O\x1fY\xd9A\xdaj\x11\x01\xcc\xfd\x11M\xa94q\x1e|5\x89iX\xd49厕\xa4\xc6\xe0\vz\xbesl'\xce\x1c\xdbIK5\x9aM\xe9k\xa3:+\xfbJZѴ\x89\x95g\x8f&\xacL\xcf\xe9 \xe0J\x191t\xc2%\xb2a\t.'<\x82\xc9\x10,\xedw6\x98\xd8\xdc\xec\x03\x9a\xd8@6\xa6\x18Q\x94e\xba\xa2V\x90\x02\xedo\xb0\xc4\xe5(v@IL\xa7:\xcf]\xe7\x85\x02֓\xd7͠T$\xady\x11\xa6\xb5\xe0g\fJ\xc2\x18\xb2\xda1\x98\x95\xdb\x00\xb5`\xb9\xc1$\x8aH~\xe7H\xad\xfe\xc2\xe3O/\xe39\xfd\xad(1An\xa5\xf6:\"r,\t\xa5t\xf2G\xc6W\xf4\xa7\xb2\x98\x1a3\xe1\x8dr\x1c\x8f?1N\vm0\x9fO\xf9\xab\xde0\xb2m]\x9b\x84\x9e\xa0\x8cˢ\xe6T\x8d\xbe\xc0\xef\xca\xe7\x1a'+=\xffF\x1f\xb0^t\xce\xddX\xb0\x9e$\xab0\x05\xfaX\xc0\x83!\xb3\xb9\n\x89)\xdfH\xbf\xe1\xa9\xfa]\xb9,\xe54\u0557\x8bn\x11\xdd;\b\x06\xe6\t\x9as\xa5N!\xf4\xe9&AR\xa1l_PV\xaa\xde\b\x9e\xa6\x8b\x94\xa1@c\x03/m<\xe3\xb1x\x9f\x9c\xf1l!CU\x9b\x98Vr.MNLV\xfd:\a\"\xcamH\xb3\x19\xaa\x8fv<\x99\x1c\x9e\xf3T\x82_M\x9er\x01\xe8y\x91\xf3\xc5\x1c\xdc\xebҵ\x85>\xf1\xabۊ`;\x93\xec\"\xbd\xb5\xa5\xdb\v\x8dO\xe3\xf4\x84C\x19O\xa6\x87\x9c\x1a\x9e\xb3ሊ\xb6\xc2\x15\x8cS\xe1\xf6Le*i\xed䕵\x1f\xdcVP\x12\xa9P\xda\x05\xc3m!\b\xbd\xcb̪\xbb\xf8\xf6ɭ-W\x99\xdf\xf5\xad\x95go]{w*\xe7O4M\xbd\xa9\x80M.Q\x9b\xe2'\xf4PE\b\x15\xeeDJs\"\xad*\x17\x1a|nt\x89\x96,\x98\xf3\xa8\u05c9\xf4\x85\xb4ډ&\x91r\xb3\xa44\b\xb4\xea\x87\xfamݭ\xfc½Z:\xd3hA\xd8\x04\xf7\x02u:i!\xf7\xb7\v\xb7\v\xdal\xbao\x10\xe8QO?B\xc8\x12_A\xc2\xe3\x89\\\xd7\xf0g%\xfa\x06|\xfd\nGm~\x84\x85\xa7\xff+\xbc\x8a\x13-U\xcf\xd9F\x97\xceX\xa2A\xdc\xec\xf6g\xfb\xacӟmn\x1a\xe5*>\x9c\xb3d8SZ\xf6\x1b\xcfa\xd2\xe7d\xb9t3<\x9c\x8f<]\xbbE\xb3\x89\x0f\xf9\nG\xa3|K֔\x19\x84Nv\x8eT\x17\x92\xf8: c+\xb8\x1f\xb4\xder\x88\xfbe%\x84hJ\xeeW(\x88=\xbd*+\x1ci\x87D7\xf5\xa0\xdb\xe5\x956\x15u[,\x05\xfa\x8b\x96\x8cQ\xfa\xff0\x13\xfd\n\xb1\xb0h6\x95\xb1ˢ\x86\x92\x18\x9b\xccq\xb3YV\x84\xadLИ\f\x84\f\x17t\f[\xc1\x9b\x8e\x8cD\x8b\rƐ\v֡\x7f\x7f\n\ví\xab3F\xcb\x19\xa09\xf2\xe52\xd6[g\xc3n\"Tݘ\xc1.zd2\xfb\xb3VKm\xa8y\x1fu\x82\xe6,\x1e\xceF\xe5}\xb4\\\xfa\x908\xe1p-\x14P\x81\xac\x88\x1f\xee?\x95\x96\xbbT\x91\xfd9\x1d%\x83\xb8U\xe1\x14\x0e\xb9\x80\x03\xa7i\xa18\afu\b7&\xcd*\xae\xdc\x1e\xfb\x8e\xd7\xdes\xaa\xf4\xe1!\xae\x8f\xb5k\x89\xder\xbaH\xeb2^ZWk\x12\x14\xf6\x1e>\xdc!4\x96\xa8T\x97Y\xb7Ӊ\xacJ\x97\xadԷ8/\xdcnF'\xe6\x9dɪ\xe0\xb8P\xbdpF8\xc8r\xb98\xe1\xf5\xdd\xc16\x8dT\xa0\f\f\xf3\x02\xf5\xb1x\r\x00\x98\xfe\x94\xf3\xf9\x176\x05Uj\x9aCM\xd3KySc\xbaHMet\rZ[\xb5!ר\xbf\xebl\x8b\xc8d\xb3\xc9\a \x11G\x84\xc0!Z\x89s\xcbW\x96\xa5#\x8d%\xd0s\x80\x9e\x1f`\x89Kv\xc6C\x12\xe2?\xa0i\f\x1a\xcf:T\xbe\x92\xc44\x9b\xb9\xd4\x12;8R\xf6ᙲCܜ*\xa7\x7f\b\r\xa4N~\x8a\x8c\xe6\xe1\xccT\xa5)\x19\xf9\xcfZ;\xa9V\x10\xab(N\xbfM\x1a\xb3X\x82f\xb0m\xb5\xcfY\xb9ݘ\x8cH\x81{j\xfb(\bF<Qw\xb3y&CӢ\xaf\x81l\xd2\xd6xx\xb2\xe4o\xdd\xe9\x0f\xee;7.\xa8sg\x16Z\xb59\x14\xa3\x95\xb2\xa0\x1c\x8eH\xff\x17e\x8aZ˜\xa8\x80kl\x14\xa4рV\x965\x10\\\U0010dea5\xc1C\xf2\x19\x97q\xb3y,\x95\xfb\x88ҥ\xa5ǵ\\\xfa\x03o6ü\xb8\xf69\xa1\xaa!B(g\xb9\x05OM9\xf8d\xf9\xa7\xfa6*Յ\xd5^\x14\xa1\x99Yh\xfa\xe55_\xc0^\xb2J\x92t\x1ec<S\x88\xe4^\xd9\xf8\xa4\x86w K\x0fAEuu\xd0f\xd1ӧ\x14-օ\x87!<g\x81\x98\x81\x17d\xf5AH\xa7\xb3XJ\x9eF\xa7\x92N\x17\xb3\xd9\xd5+\xb0u\x04\xc7\n\xd8Y\x9d\x8d\xd2D\x86\xa72\x9cJ崚\xa6\xa5\n\x92&\xf9\x1b\x91\x9d%y\x1dzl\x9cI\xb4\x1c=A\xb7s\xa7<\x05ea\xa5\f\xfe\x8a_\x94\xc6\x0e\xbc\x13]m\x01S=\x97\xa0\x95k9\x13N\xcf\xd4k\xe2\x8d\x00\v\xd2\xe4\x9c\xdf\xd2\xd6xm[In\xef\xa3\xe8\x1cF\xf3D\xe1\xc02Z\xb2\x95.Ԑ,\xaeD\x06\x17\xa0OhKպ\x80vL(\xb7\xe8\n~\x1dL&\b\xa7M=\x86\xd4\xef\x94\xfb\xcfL\xd4t\x14\f\x15mӰ\x85\x9e\xe92#賢\xf2l\xec\n\x8d!5\x80cjD'Л\xaa\x12\x9d\xc1\xb7\xba{k\x86W\xe0\xfe`\xa9\x95\xf7~ϥW\xb4L\x80\x0e\f\x13\xe6Q\x17\xec=<,sP\x8c]Q\xed6䭠\xa0D{\xa3Z.\xf1\xa4\xf1\x05\xb3\xf5\x99,\xe9tU\xe9/{\xa9Q8\xae\xa0~fk/dA\x94\xd6/\x9c\x80\x03\xb4o{S\x18ȱ\xf4b\xdf\xd4̥4\ft*(\xb7\x97\x12\xf8\xa9\xf7\xed\xae\xc7%\xa0\x1bx\xf1\rG\xb42\xbfk\xa7\x91\xa6\xb6\xb2n\xedc\xc1\x9b\\\x8a\xden\xa23iZI\xb5MS\r\xf8Ŧ\x80S\xb8\xa65\xe8H\f\x85}\xe8 \xcb\x16g\u038dm^\x9cR \x16\xd1g\xba\x1a!qR)}\xa3ֶ\x99\xa1\xb0\xf7c!>M\xa6\x9c\xedU\x95\xa6՛\xec.35_?S\xfd\xb8\xa6˘\xb8K\xf0L\xda\xce\xc0\b\xd5\xd91\xf2:;\xc6b\x9f맴\x9f\xd7t\x9b;\xceH\xcaN%(q:{\xad\x9b\x8fc\x04F5\xb6\xf0\xa9\xac8\aU\u07b6`!\xb4\xeb\x12\n\xeeMo=\xadծ\xb0\xed\bm\xb7=\xf0&>xonm\xd5R\x8a\xbc\xe0\xc5\xe2\xcco\xa5ο\x81+zR.Z}\xd0{\xd8\xe2\\j\x8e\xf7\x85[M\x89\xd2\nc\x88k\xa8\xb2\x82\x00F\x16\x85grUՌ\xbf\xf2\xe1\xc0n\x96KsoX\x82\xdd]\x1c\xb6ޱ_\xefJU\xb8]ng\xe9c\xff\xb7\xa1W!\xcd\x0fSt\xf7\xc9<\x94\x95\xbbT\xab\x99V.Rxge3\xde\xe6\xa8JƁ,_!\xaf\xf9R\xa2S\xe6=0\x98:R\xef2`̽\x83\xe4\xadݝ\a\x0f\t=P\xc9\xef$\xa1\x9f y\xef\xc1\xee\x03/\xbe\xd5\x13YcU\x84\xf7R{\x1c\xcf\xe5B\xf0w2\x1e\x7fz/\xe21\x1f\xacIW\n\xfde}\x7f\xa2T\xa4s(\xc6B뒈\xa8\x94\xfe\rƈ\xa2h\x8c\xa8e,J\xb8\x02.Hl\x050c\xc0N\x8c\x0e?:-\xc1(!\x9e\xaf \xe1\xb3>\x9dI\x94\x01\x88z\xa9ʶ\x80\x17\x92\xbc!1A\x05\xce\xfbk\x98\xc9\xee\xd6\xceCp|\xad&\xf8\xb5$\xf4\xb9d\xc3bH\x87W\x95\x94\xf7\x90R\x88\xf8[\x88\x1d\xe6G\x92\xac\rDL=\xb7\xfb\xf7U\x1c\x89R\xa2߂\x97\\\xe8\xc5o\xa3츟\xbe\x01\x10U\xd3\xf7\xf9e\x8cn\bi\x90H~\x96\xdb\xdf#\xb7\x85\xdeJ\xcf\xf1\xe9\x90\xfb\x97\x8b\xff\x1b\x1d\x81pGY\x18$\xf9\xdc9B\x92\xe0\x19[[\x15\xbdrɂ,\x97\xef\xdd\xcf\x14J-\x97o\xe4z\x7f)i\xc9ٶ\x8f\xef^\x96\x84 \xd6I\xcf\xff\x04`\xb4\x83\x16\xc3`\x8c\x19\xb3\fvS\xc220\xc6\xcc\xd9'\xd9\xd6QF\xc2\x18}\xf6\x02\xa2r\xc3H\x06e\xeb\xf7\x9cB\x93\xf6\x0eJH\x94\xe3\xe6y*Y\xc5\x13\x06m\xf8)\xe0p*\xa0/$31\x84Z\xd1\x1f\xf7\xff\xb8\xbf\x84\x7f\xc8\xfd\x84>\x96\xec\x89T*\xa5o\xf9T\x85\xd6/\xdc\xc2JX\x8ab\"\x91\x9c$i<\xc3BLP|\x9a\xab\xf3\n\xaf\x7f4\x98}&A\xdc\xf2\x17\x1a݅G2\x840\xeb\x92\rkhf\x15\x1a\x02\xd0ް3j6\xbd͊i[\x90\xa6w\x88Nz0Zѯl\xc8Di\xd1\xed\xb8\xa6U\xca\xee\x7f\xa1\xe5\xdc4\xddl\xea\b{\xf8\xfb\xe1]\xbbrAf\xd6t\xb6]\x19\xc6\xce\x7f\xa5\xed\xdc4^\x1c\xc8\xde\xdd:\xf3P\x05&\xf6*Po\x7f]C[\x95\x86\x1e\xfc}\x88ro\xf8\xdeXw\xfe>\x88\xb9\xb7C\xbc\x96w\xff\x06\xcc\xff\xccn\xf8\xb2\xa6\xff\xd9\xcdp\x97M\xbd\xfbO5}\xb7\xc39\xa2\x9f%\xbb\xfeį\xa2\x00\xa2\xdb\x04t>[\x9c$i\x89\xebi]ۨ\x80q\xcf\xf5;<\x04T\x7f\xaf\xf0\\\xe8\xd0ͮRt|\v\x02\xe1fs\xa3\xe6\x8e\xf9P\xb9\x7f\xa4\xff2E\x91C\x18[\xa6b\xaa\x03\xc4\x00S\x03\xba\x02\x15\xf0\xa7ٸ^\x9f\xd1\b\xc3\xd1k\x86\fqT\x91\xd3cъ\x8b\r\xb4)\xde|˧$\xa0\xd7X\x86S\xddn\x94 \a\n\x18a\x91\x89D\x82>\x95\xe9\x98\xfd\x00\xe0\x15\x9d\r\xc0U\x86.5r\x96,\x97\xf3\xc1/2\x9cÍ\x85Ѹ\x159\xeaF\xf7\xbd\x04Kɹ\x8e\xd92\xa5^\xcf\xd8_^\xe8\x1aޑk\x16\x82\xc64a\x7f\xa9\x88\xa6\xa0\x06\xb0\\\x86\x89\xba\x8aPl\x9a\x824UA\xceJ\xee\xfd\x94:`A\xfb\xc3zn\\\xcb\x18\xf9U\xfa\x8c\x8d\x15ȵg\x15\xc7\xdbp\xab?\xca\xe7||\x16\xcfQO\x7f?\xf0\xef\xf1\x8cr\xe0N\xe6\x8eq\x12\xcff\xaf\xa7\x7f\xdc\xffc\xd2\xfa\xe3\xfe\x00x(\xe8\xd3!mk\x06\xd6{\xc1\x95\b\x17\x9e\x81ޢK\x86\x120.\xc2\x05\xe5\x9e\x1d0ʜX\x10\xd0)\x13\xeb\x9c`\x18\x9acδ?g\v\xfd\x1c\xad\xa5\xadW\x8e_\xf1\xe5E\x13\x14y¿\xebI&.BNg\x10jP(\x87\x16\xea\xc9\f\x8bW6RƗd\x98\f\x17#\xed\x0e\x1c>\x15\xc3A{2\x02_\xfd\xe1\x94\xe64\xa6\xe0\x0ew#k/r\xfe$\x11\xe3\xc5,\x16\uf41a^\b\x9e\x1b_8H\x92Y\xd7^\r\x0e\xaf\xa5\x01l\xbd(q\xbe\x85\x05x\xb8\xb1\xa1 \xf3A\xb8`\xbfI\xf0\x84aEg\xe1L\x9d\xb2p\x81\x1c\xed\x193\xc7\xe7I\xb6\x98MP\x01MSp\xceb#jx\x0e\xc5\xd7l\xea\xeaq\x02\xefJL\tB\xc3\x19\xfb\x11h\xbd)!\xed#\x15\vt03_\xd1L\xbb\x9a- \t\xa5\xe8u\xc3)\xaav\bdڬ\xc2:4^\xe5p\x96\xe0\xfd\x8c[Z9h\x99\x98Y\xa5g̛Ř\xce\xe8\xf5=\xeck\xb2\xc2\xe5͛\xcd|\x83YU\xac\xe1\x19M\xa4ټ\x10\xfa\xc8\x00\x94\xafȨo\x14\xf0\xea\x9cG\x82\x85\xba\x95Y\xa1J荂+-9E\x03{\n\xff\xa2Ԋ\xa6V2\xe0^\x18\xfe>Md۰Z\xd5\xfb]\xf8\xb4>\xeb,\x97ڝ\xd0Z䜪>a\x15W\xab0E\xa7\xa5\x9c\x9eA\xfc\xcc5\xdbTW<\xab<\xcc\x11=\x82\x85\xedϒ!e\xfd\x19bnec\f\xda\x16=\x934>γ\xd9B\xf2dz\x15\xfd\"\x95\x1a\x8d\xca,\x1cj˙\x19h[\xeeg\xe0&+\x82\x91<\x93k\\u\xfb%\xe1\xb0R\xff9\x10=\x96\x14\x1c\x95\xab\x1f\xdfK\x100\xc2\"~')\xbev\xa2\x1f$\xe5\x97R\xc4c\xf9Ldg\x90\xf5\xa3\xa4\xe8;\x1a\x9a\xa9\xe3\\cf\xc8\xe9\xb5\xf1\xecz}0\x1e\U000f931e\xca\x155ndэL\x8dGV\xe7t\x11v\x15\xb2\x98\xd7\x16*8\xbd\x85\x81i@\xa3\xdf%\x85\xb7\x92\x0e\x16\xf6>S2\x92ߤ\x15^\xff\xe0\xe7~\xe2i\xf4\x93\xb4\xc1\xdc\xeeI\xf6\xb3t[\xea\x17\xe9$B/\xa4q\xeak\xfc\x99A\xaao\xd4\xf1X\x86\xfau\xf7^\x80͔\xcc,&\x89\x1b\x82\xcf\xd0ii\xe3\xc3\xdb\x17\x14\x95\xf9\xb3\x85l\x9c\xc6\xe7pE\xc7\rs-\xb6Q!6j|\xe3\xfbp\xfb\xc6fC\x86{,J\x1a\xc0Os\x9e\xbc\xb7'\xda\xf0X\x01\x8b\xb3S)\xbej\xfb\x18lɼ}\xfco\xf4P4\x10\xac\xe2P\xaeX\xa2=\xce&\\9r7\x9c\xd2r\t\xcdt!\xe8[]\xff\xe8\x97\b\x97\xbba^A\x94i\x923v\x91\x15م{\xa4{\x8a\xc1\xfe\xa0\x19\x9e\x04mw\xb8\x81hBK\xce@\x04k|\xff\xa6\xecw\x89jX\x06R<\xb6\a<$v\x86SB\xaf\r\xfeNWUn\x9c.\xac1\x90Y\x8b\xefd\xbd/b\xa3W)\xd5\xca\xf9\x9c\x88\xef<Ɖ\x02^\x17\x96\x83\x02\xf8\xe8δ\x00\"8\xee\xc1\x1a\xecgٶ\xa7\xb6\x06\x00\x87\xf5TqI\xa5z\xf1\x83\x17>\a\xc9\uf149\xfcMj\xddOa\x05N֚Xk\xa5$RG3\x95Z\x91ܙ\x8e\x15\x8fM\xcd\x0e0W^\xc3?\b%\x1d\xf1F\x926&Z\x8a\x1fx\xf72_\xd59\xe4\xf9\xcdc\xbd\xd7\xd3ӵ&\xb9\xce\xe2S\x13Դq\x82vA\x160ų\xb5\xe70\xb8\xef\x1e/\x18\xef,_\x1c\xe7R\x84]c?\xcf\aÑ\x0ed\xc6=\xd3\aK\x8f\xfd\xe4\xfbA\xfa\xc9\xdb\xe4U\x90U\x04\xd7\xf0\x00\xd81a\xc0\n\xe7\xf4\x0e\xdeq\bQ\xb1\x93\xfd\xe3\xf2\xab\xf4Õ\xb8և\xc3 \xa0wu\xda3\x1a\x91b\xec3\xe1\xbb\\5\x03\xedz\xddr\xe1P-F\xb2X.\xd5D\x8a\xe52\xf8\x9f\xc0\x8b\xbc\xae\xc9_ŕ\x1b\x9f\xc6\xe2@z\xf6~\x19\xbbWp9\ao4\rI\xa7\xe0\x86\bU\x0f6R\xd3Mj\xba\x01R4\xf8\x1f\xf0\xfb\x99\xe9\xb8$R\xe8g#\x12\xf1kߍZ\x8dq#C\x1d\x84\xe5R\x7f\xb4\x91\x8e22\xb1\x1b\x9f\x90\xa4\xdel\xc1\xbc\r\x8b\xfb\x12\x81\xa9Z-x~\x03\fi\xc8\x04MVꁄj\x91\x99s*\x19\xd7S\r3$\x8cgC\x1d\xddu\xc6<\x15\v\xa0@\x80\xe4\x98Y\xbdzCd\xcc\xda\b\x13\xae\x0e\xe8\xf3͵\xa4q\xae\xfd\x06y\xe4\xe554\xcb\xeb:7{ \xf5\t8LʉGO\xf5A\xf1я\xd9\xe7\x1dX\xcf\xfa\xb88IJ̒\a^(mo\x8e\xe6ƙ\xd7\xca\x02l\x858aL\x8d7\x82\x84Ց\xb5\x7fϿ\xbe~Ib\xd4\xe3\x13.\x1f\xc79\xff f\xcf2\xf1*\x9b\xe0\xbdOc\xe7\xc71\x1b\xacs\xad\xda(\xb0\x0f\x14-b\xdf[\x02Ŋ\x92P\x1d<Ѿ3WQ\x86ѕe,N\xb8\xfc\x91_\xe54w\xbd%\x83\xa1f\x97\x04\xb8\x91\x83Q\x94Й\xa7p\xf8Qb{5k\xa9\xac\x85\v\xae\x86\x91\xdb\xfc\x89_y&\x88N\xcb\x17\xf2\xd2l\u008d\xf7K\xe7<G\x11\"\xeeP\xdb\x1c8\xef/eh\xab\xd2\x18M\x82g\xe5\x1d'PV\x8d{y\x15rZs\n\xe9u\xed\xc4Wb8ek\xe7:ss\x9d\xe1$\v\x02:P\xd5\xf9\xa6z\xb9\x81n諽\xa6%Qs,\x9c\xa8\xb08\xb3\xb6fi\x11\xef\x00\xe1\xfb\rz[\xb8\xde\x164\xa6\x96\xb3F\xd4s\xbcv\v\xcf \xd8\xceL#\xa4\xbaFǮ\xd114\xaaV\xdc]m\x8d9\xbccVT\x18\x8c苋ֲ\xd3jT\x04\\\x90O\xab\xc6\a\x18\x06\x94\xc7\xd70\xdahb\x91\x90%+R<84v\xf0\xadE)@\xd9eC9jk\x8fy,m[\xd8_\xc6c\x91\x85\t\xe5\x1e\x15\xe7\f\xca\x0f\xb58ՙ\x81{8#\xc6=U\xe0?8\xbfh\x85dtД\xdays¾\xdbؐf\xd4\xdcSwWn\b\u0558b\x7fLgلό\t\x93\x1a\x17\x94(\r,\xa9\x1dX\xe9¨\x1bDFV*\x18W&\n.c\x8b\xf1\xb1\xac\xf0\x88\x13uRE\x96I\x16\x8bP\v\x93t\xcb\xc7P\x8eӡ\x9a\x91\x9c\xcb@k\x05\xd5\xe1Wl\xe7\x84\xcb7\xa8\xb9\x17r\xd4RG\x8b \xeb\xf1ɗ)\x02\"\x1d\x9f&\xb3\x89\xe0i?\x03Ow\x89\b3\xa5\xf4!H\x94)\x011ҥZ\x9dD\x84\x16T*\xd1\a\x1e,\x98\x82\xed\xa4\x0e6e\xf4\xc6\xc1\x90\xde)\xd1x4\xb0iM_\xb6\x9e#F\x9a2\xd7W\xc6:\xfd\xec\x91\vy\x1b\x8284\x1b\xd1\x10\x96\xd3\f\x81\x80&a?\x03\xf5\xc2\x14\x1d\x7fZ$\x84>(E&3\x8c\x8d\xe0C\xacf\xaa~N]\xc0QO[\fYi\xf6wo`a\x8c\xee\x15\x18\xa4x\x1c\xd7h\xbc\xc2\xcd͋~\xaf\x14\x95\xa6\x97Bw\x9c\x0e\xc5\b\r\x13\xc3T\xf9\rF~\x1d\\\xb14U\x8a\xaf\xb6s\xb2Z\x01\xe7g\x15z\x9c\x9eX\xf8À\xb5\xbb6=D\u05eb\x15-z\x12MD٧\x9eZ\x12\x86ۑro\xfe\x98\x18\x144J\x85\x97G\xa8\xaeG\"\xfdA\x91\x87\xe4\x16\xa9\x06\xf7\xd8\xfd늁g\xb2\xc2/\x96\x88PPkx\x83\xb8_y\xcc\xcd\v\xa7lEg7\x9d:c\xba\x89{\xab_8\x84N\x9c\x8b\xc1\xf1!\xfc\xe1\x84\x1f/N^\xf0s>\x8b\x82$\x9df\x06\r\xe5\xd1p\xa4?\xbfOr\x99\x89+\xa0QP\xa5\x04\xb3\x8c\xe2\x8c*\xa7\x9e\x9e\x13\xad\xd9\vI\xc8&\x83*s\x97ftޯW\xd4cw\xc3å\x91\t\x9a\x9ff\x17O\x01\x1a\xf0\x8a\x1d#\x022\x15\x15\x1c\x80\u03a2\x00\x00\x7f\x19\xcf\x03:K\x8e_ry\x9aM\xf2\b\xc7UrV{\x9a䀐\xe9\xb5'\xbc\x89\xbcY3\x94\x1f\x84\x90*\xab&\x833n*\xf5\xe6;\xe1R\x1dS0\x92n\x1f'\xe9D7nr\xf5\xdd\xef\n\xe9\x84J\xd9\xd38\x7f\xbb\xd0g\xfeH\xfd\xa8\x94\x016ܜOިE@\x15G\xc1\x94\x92 \xe6\xebձl\x16[I\xd5\xf0\xda\xf3t\td\xdbi\x9f\x12K4\xc1H\xd5ݟH0\x06\x84[\x17\xf3`\x8aɚr\x86\xd5k\xca\xeaߦ\xf8b>\x89%\xd7\xd3\x18\xfa-\xacA\xf7\xb8\xff\xd6 U\xac\xee6(zj-8\xb2\xad\xe8\x02\xa5\x9e.\x90\xd8\xef\x0e\xc4f7\xea\x00m\xd8\xedg\x8fD?k\xb5H:\xcc6\xbb\xbeVP6ꇒi\xa5)Ҟe'V\xff.%+\x8bIϹ8\xcer\xbe\x06T\x9b\xcd\x18+\x81]\x00\x99\xa6U\xa03\x0f\xe8t\xbf;H\x15\xd01\xeb\xf6\xe3G)\xba\x81͆q\x11\xe8x=К\xdc\x0f\x86>\xc3d\xd4h4\x02\xe2\x9e\x00\x19\xf1\x86\xe6\xb6\xcf\r7\xaf\xb6\x06\xb7\x14\xf7\xd3$\x1f\x83\xbew\x1a\xcbL\xd0D\x99\xd6:O\x8bn\xaf±\x1d\r\u0084q\xd4\xf0)琨\xb0=A\x7fQ0\x1eU\x18\xf7)\xe3\xb4ެ\xb8\xf2P\x86۬\x12\x93\xc5\xc7\xfbh\x8f%\x14\x1f\\\xb0\xfa\xf8\x9eFM6\xc1\xe0\xb2c:\xa5\xa7\x7f?\xae\xe7ia\x00tf\xa2A\xcc\xe9\x94N\xe8\x19=\xa1\xe7\xf4\x8a\x1e\xd3\vzH/\xe9\xd1\r\x1d&\xe5\x0e\x13\xd5aR\t$Z\x9cIr\xadJ\xb0\x1d\x1d\x05\x10dd\xf0\x12\x03\x9b\xa0\xa1t\xa4\x93-\xb8]\f\xd3hRi\xaaW1\xe4\x14\x1b\xf0\x02AnG\xba\xd4V\xc7\x0f\xaf\xb8\x13\x8d\x99\xeb\x81Ι\x1c\x8eA\xa4\xecŶ\xf3\xe8aB'\xcc\xff\xad\xdc\u008d\xc1Q\x1b=cu\xf6$\xf9\x90\xab7͈\x9e\xb0\x0e=\xd7:\xc06\x8c'\xb2\x94N\x1e\x9d\x9b\x17\a\xb9.\x81\t\x93q\xc5·'#z\xcc\xf8\xf0jD/J\x0f\xce+B\x0f\x99?\xa3Ǆ^2\xdd-=\xb3-\xf6z\xaeō\xc3j\xb2\x95jUGq\t\xe65\xc3\xcb\x11z\xcfJ\xd08\xfa$\x96\xfc\xb7\x84\xcf&\xe1i\b\x9bcFh ;\x01\xed\xf5LLL\x1c\xdcd\xb9\x84e\xc4\xd5p]\xee:H\x8eX\x16\xc6p\xb1\xc5\xcd\xe6\xc6Q\xb5Hq\x89{\xbbn\x8d\x8f\xe9\x15\xbd\xa0\x82Έ\r\xa8y\xd2jѤ\x18\x9a\xd5FѬ\x04lL\xbc\x80\x8d\t\xda\xda\xdbSw\nƫ@\xd4`Ўb\x9c\b\x9c鲵\x06\xb1\x81M\xb7\xe9\f\xe3y\xe8\xdd\x05t\xf8\x98\xcd\\`\x8fj\x9cW#\x9d2\x01U(\xafL\xf0T\xe7L\x15\xcba\xaa\xe7\xfa\xa1\xee\xe6a\xc4\v\a\xc8ƌ5]\x15ӷ\"\x13\x84u\x8b꘥\xea\t\xb6\x8d\x0f{\x8ccjv\xe8\x8e\vo\xa9\xaa\xec\xd0\x19\x04\x15\x01\x06Y\x92&\xf9i\xd8\xdd1e\x1f\xde1(\xe66\xedn\xd1\xee\x0e\xed>4\x911\x91X\x12\x8aPH\x80Y\x8e+\xfc\n<Y+\x8d\xd6\xe5\xb2h\r\x83\x8b`\x7f\x86\x02\xadR\xf5\xd5\x01C^suh\xee\rU\x84\x91AeGлf\xe9\x14i\x9d\x1b\xb5\xd1\xf1\xa5o6@\xfe,\x13o4\xeeqV\v+R\x02\xcb'J\xd7A\xd77dF\x89\x84\xb5\xad\x92\x82\x18\a^\xbf\an\x00\xa5J7\x8e@\xbf\x15V\xc4\x03\x13^g>\x89]G\\(\x81OY]\xbam\xab\xc2\xc2y\x8fw\xefzՍ\x0e%j[T;}\xbbH\x9fd\x8b\xb4\xf6I\xeb\xb7V\x86\xd2M\xfaځ\xbcO淌\xa5\xa6Ѿ\x8b\x88Z\xb8\x89\xe0uV\xed\xc9l\x86\xe7\xca-\xe6\x17u\xf6>\x99\xc3 \xce\xfc&t\xef\x81\xf2^\xe9D+r\xb0ٍ\xa4\xed_\x13\xb87\xafZ\x89]q\xe3\xba\xd5,\x98\x18\xb1\xdaTXFZ\x9b\xa3\xc8sI\xca`\xaa}y\xf3\xec\xf4\x13\xd9.\x1a\x12\xd6sqk\xfc\x11)\xb5\x12\xa9,\r\xf4\x1b\x81+\xa7\x01\r\xa3\xfbQ\"?|\xeb\x12\xa9\xa8\xe4\xb0\fm\xf0\x1d\xca\xd5\xd2,\xdd\xd4Vwsu\x86\x95\x9cQ\xb6\xedS\x11u\xf7\xedS\xd1\x00@\v\xf2P\xeb6\xcdi\x9b\x94κ\xa9\xa6\x81*d\xbf?婖ob\xab\xbeAbu<\xb9\xe3\x04+\x133\xcb+\xb0\r\x98}[\xad\xacf\xc1\xe4\xe7\xa1φ\xac\xd8t\x94'}壿RKu\xeb_65\xd1`V\x9cu\xba\f\xdcxUrŔp\xfcI3\xdf}\xb5\xc7\x12m^\xa1\xa6\xccXyHN9\xbd\xae<\xbck;Y\x91>::\x81<\xcbw\xb0\xab\xe6Zd\xde\x01P\xcc\xec\x9b\xef\x00O\xbcY{\a8M\xa1\xbex\xd4\x19\xb8w]\xe8+v@?\xe0\xbf̯\xac\x1c\x99%y\xfa\r:\x9f\x13|# \xd1\x13\x8e\x12\xd0\x1bn\x0ex v\xdd\x18*\x1b\xf1\x96\x83\\bk\xe9\xbfe!\xbf\xe5\x94\x168\\\\Y\xef\xab\xf6A\x05T\xb6k\xa6P\xed\xbc\xe2#?E\xfd\x9c\xb2\xe2\x9a \xd7wnA(V\x97\xbe =L\x7f\xc3\x0e\xb6(֎\x1a\x18\xb0\x1dZ\xc5\xdfͦ\x99t\xb7w\x8c\x90\x18\xe5\v\xa5L\xfbF\x86>|h\x9e,\x84\xe0\xe9\r@U\xae\xcd\x1a\x82\xc52\x8dTc:\xb5H\x13T\xab\xdd\xf5~+\\\x8ae\xaa\xc0o9\x94\xadn\xdd\xe0\xd6\xd2r~Cc\xbf\xac\xdfȋ\xe4\xf8\x96\xaa\x8eig\xeb\x1d\xad\xe1\xaa;\xb9\x83\xd6\x1d\xf10\a)\xd4~b\x1ce\xdcLǔUl\xbdV\xf2\x1b\x1a\xa1r}3\xb9\xf6\x91\xe6\xc0Ql\xbd;\x93T\x86\x06[\xbb/\xf6C\xd8֮\x83I\x92\xcfo\"h5\xb9\xadQ\x9a\x91082=Tj\x06V\xaa\xe4\xb2\v\xa7\x94\xa8 {V<^\xc2!n4m\x03PX\x8b\x06\xd6\x15\xd4N\xd8\x14\x1b\xda_Y\xc5`\xc6\x14\xf5\xbd*\xeca\xef\xe6\x0fc\xebk\xca\xdc\x1a\x06\x9b҂\xfaS\x8c\x8a\xc0B\x13M8ݬ\xf0\v\xd9\xff\x85\x14\x10\v\x85\xe5\x14X\x87V\xb7Rp\xbf\xdb)\xbd\x12t\xbfjp\xa28\xb2\x8f\xf8z\x10zp\xf6\xc5\xe0\xe4p\xc1/\xfc\x1bT|\x8bǧ\xa8lt\x1a\x8bI\x03cs\x83;d\xcb\xd4\xebv:4h(P\x1ab\x91\xe6 \x9eU:\xba\xe9\x06cՃ\xaao\xf0\xfa\x87N\xd9z\xfa\x86K*\xb9\xe5y\xd3\xf7\xe7\x02*\x14\x83\f\xd7W\xc6\xfd\x95\v\x9a\xe3]@\xee\xba\xdf\xec{\xde+X\x1c5K\xfbJ\x89@\xb4kPxH(\xec\x85\xf2Ű\xd95\xaa˩\xff\xf4\xb5v\bȩ@\x9eb[a\xbd\x90x1Fc\x1dc4cq!\xc6\xe8,\xccl\x88\x1eK\xd8\xc5\xc5 \xa2\xb1\v\xbdz=\xabt\xb1\xba\x81 \x9c\x85\xc3\x1a1\b\xb7\x0f|P2\x18\xb9\x8eD\xbb\xe6%\x13\xa6\xf4\xba\xf0(\x02?`\x8eW\xe5M\xbd/\x85\x9b\x01<\x1cu\xdc\x12ٮ\xf1d\x02P\x88ҝ\xcf\xd1\xca~\xb5\x1a\x19A@\xe1at\x03\xfe\xe4mǰ(\x8a9\xb1\xe1\xd7S\f\xd0y\x13\x16/ھ\xfb\x82EXޅ`ׂO\xf3螤\xa85\x14IA\x9dzC$\x04uL\xc9(\x15+:\x16`V\xbd\xd5}\xf0Л\x94\xb9pwtE'\xa8[\xab\x13\xd4\xf5u\x82\xba\xa8\x13$\x98lk[\xbe\xe7\xa9\xe4b\xcc\xe72C\xb9\xb1ծ\xf5\xd33\xc6۠Q\xfcD\xf0\tOe\x12\xcf\xf2A\x90\xa4\xe3\xd9b\x02\xf1\xb3\xf3\xf8\x8co*\x13Ϡ_>9n~xx\xbd\x10\xb3H\xfajڴ\n\x05\x86L\xaa\xc0\x10\xa5\xb4N\xe3{\xec \x82\x80[7\xe9|\x83\xb60>f\xec\\N\xbd\xb9\xe4J\x81\x15C\xf0\xa1\xd2G\xaa\x03\xdc\xc2\xd8\xcfP\xf3\x87\xf1\xca\v\x82&N\x91)^.c:c\xbcF\x8a1f\xbcFs\x82α\xb0\xaf$B\xa7\x8c\xd7-\xcc)\xe3\xb5\v3\x01h?%ss.\xf0\x84\xd13\xc6\xebM\b\xe8\t\xe3\xedS)\xe7\x14$\b\xc6\fȼ\x16\xd9\xf9r\x99\xd2\x13\x10[\x9f,\x97\xbfQ1\x80p\xa0\xd1\\\x84'\xf4\xbaf\x9d\xa6\xb5\xebt\xba\"\xe1\xb9^\x88+o\xe3^\xe1\\7\x9b\xe1B\xb4\xe1\x1c\xb4\x8d\x81\xc2\xf0|\xc48\xa1&\xd9\xea\x11\xb3/\xef\xb8o\x9e]Cݚ\xd1c\xa8q\x111\xb7\x0e\xff\x16\u00adBNhM\xd9q\xa1\xac=\xa5\x84\xa2\x8e\x99\n\xe2\x9e\xf9e\xf0\x80\x13\x1aJM\x89p'\xa36\x86,\xe7++\x11\x17UAqB+\xbb(\x9a\xd1\xe2f\x89洺\xa9\xa21\xad]\xfa\xe8l\x85\xc4\xc0L\x00\x1b\xdca\\\xbdR\x93A\x99\xe0c9(\x81Պ\xb0\xec\xa1\xfaz\x81\x95=\x95\xf1\xb1X\xcce\x18\xe8u\x02\xa7\xbd\x8a\x11}\v\x1f\x1a\xaf\U000db40d\xef{\xc1\xa2?0\xb5!\xd1X\xb4\x7f\x84W\n\x8c\xf3\x14\x90\xec\xde\xde\xf6\xd6\x0e\xa1\x13\x81>\x14N\x05\xa1g\x88{w\xb7v\xba\x84\x9e\xa8\xe43A\xe8\xb9\xc0\xd0\xc0\xdb{>J\xbe\x125\xfe\x9c\xd4uyG'N\xb6\xa9c\xe11\x0fl0\xce\rư\x0f?x\x91\x8a\xa9\xea\xf9\xb9X.MA\xe1G\xd0\x05\xce\v\xa4\x8b\xb2\xfb\xe9\x009Y& %X\"bk\x8d\v\xc1\xae\x01Uּ\t\x115!\x86T*GRř\x16+\x8d\x9ao\xacb\xf7.M\xbd\x06TŜ\xd9/\xa4\x99\xed%\x06\x11`l\xd6P\xa0\x18a\xc4R\xb2\xa2\x18\xff\xf9v\x18i\xeawݗ*n4\xd3\x7f\xb13\xf4\xbb\na\x8b\x8e\xb3l\xc6\xe34P\xf1T\xae\xe6\x1c\x95\xa5\x02\fF\x15\x90\xbev\x8b94\x9c\x00\x1a\xe8pL\xc1\xc89\x85\xc0z\xca\x7f\x04\xd4\xed\xe8\x10TDw8L\xf5\x10\xae\xc7\xd9l\xc6\x11\xf6g\x80\xbbe\x94\xb6\xcbI\xd40M\xfa\xc6!Q\xaa.\xa0ó\xb9\xbcB\x9d!\xef\xbe\x17ά\x18\xba0C\x1df#\xe6>q\xc0\xeeg\xb99\xb6\xd1Y\xad\x94\a\xad/\x9fم\x981\xfcט\x1c\\\xbb\xf8\xea\b\x12\rV\x81q\xea\xa5\xc2}\x7fx\xfb\xdcz\xf9\f\x05Q~\xe3Ξ\xc62\xfe\xe2\xfeC\xb1\\V\xa6\x87\xe0\xf6\x816\x99\xfa\xa3\xc7\x0f\x9fn)\xf44\xd3R\xe5\xa8\xd2\x1c\xbdâ\x81\fˡ\x86â\"\xd9yA\bYq\xbf\x11\x90AEi\x19,0\x8e\x90\xb0\x8c\xb8g\xe2\xe0Pƥ\xb8\xe3\x14Q\xb35TD\fy5\xe34ai\x9b_\xceg@\xdf\xe4\xc6Z8E\xf3\x95\xdcxD|\x82Ϭagt\xf3\x1ag\xfe\xf2\xfe\x10\x1e\x8a\x10\xa4\xba\xf4Z\xd9ġE\x9eR\x85\xd4\x0e\x92\x7f\f\x91\xc4\xd6 \x995@\xb0\"\xf0l\x92'\xca\xe3\x8b\x06/J\x00\xc1Q\xaf\xb5/\x00g\xe1S}Gw\x9d0%\x13\xa8\"\f;M\xe6\xc4\xc1\f\xb9D5S\xc5㎳\x919\xcfb\x06\xe7x\xf8\x85:\xb7\xbb\x806\x8c\x9a\xc8\xfa\xb5\xc2\xcd\xf9\x96\xa3\x87\xefI\xa5G\xbb\xabs.\x12K\x1a\xbe\x9eÿѵ\x9ef7\xb9\xb4\xd0Z\x94\xaf\\\xc0\xb2; \x9dY\x19\xe9\xcc\x1cҙ\x15\x91ά\x1e\xe9@+\xef@\xbb(F\xda>\xa0A\xbc\x90\xa7\x99\xd0p\xa3\xaf \x9c\xdeM\f~\xe8\xf9\xfd9\x10w\xbdm\x92\xa9\xbbKJ\x17\xceF\xf8\xce\x19\xf0\xaak\xa6-\xb3\x17\xd9\x05\x17O\xe2\x9c\x03\xbfl\xb3K\b\xb2{j\x16_\x94\x16\xbf|a\x1d\ntf\xa7\xa6\xf4\x96\xcbM\x9f\v់T\x9f\v\xa1\xb6B\xcd\xe9p+b\xf6H\xb3i?\xed\xa9)\xba \xfa$\xfe\xe6=\xad\xcdc@Σ\xc3'\x14''\xa6\xc9M\xd3\xd3~\x92e\x9f\x12\xae\xad\xf7\x9d\r\xa8\x1ez\xc0\xac\xc9ZLq\x06\x13\xcf7_\xc1\x85?ˌKZO\xfc\x94UdN)\xf1&\x05\x9dW\xd6\xf5Y\x050o\xd5/\x8a\x9d\xd6\xca\xf2\xc0\x05s\xc7\xc5YiB\xf4\t\x90\x96\x0f{[{]B_+\x8a\xf3\x890f\xc3\x1f\x05\vm+\xafEH\x06\xf0OT\x17\xc7 \xe7\xb3\xe9\x00\xfe\x89T\xa0\x00\xd2>\x96Y\xec\xce\xcbs\xe1ˑ\xb9\vZ\x8e\xeb\xed9\xf8\xc1\x97\xafv˕\xf0\x1c߿\xf82N\xe0!,e<>}\xa2\x96\x158#\xcf2\x81G\xfaM|\x05\xcf{\x9a\xbbƼR}\xc9\xd6\\\xecP\x90\x8ab\x8f\xa9{\\\x8b\xc1\xf5*R\x8c\b\ap\xc1f\xe9Z\x99\x19i\x18s湏\x06\xf4d\xd0\t\x9fЅ\xab6\x83j3:f\xb1\xe9\xf7j\xb9L\xbcoP\x12F\xe7\xfc\x1b\xb0\x91\x176\xa8Ԕ\x9d\b`hҡ\xe7\xc4\xcc\xf3\x95fݘ\x11<(p\x9f\xda3\x94\x17PO\xaeo\x98\xdc'I\x9f\xe0\x91ҝ-\x97\x1b\xf3\xe5rc\\\x0e\xa8\xe7`\x06\"\xdf\xfd*:$q~:\xc6\xf5\xc2\xf7'\xeb̫\xec\x02-\x86\x1c\xbc\xa8M\xb5a\xb34\x82>mz\x00z=\x19\x13H\xfa\xaa|8\x9f\xf1<\xf9\x91\xa3\x97\xa8\x8c\x0484\xf8\x06,\vzh\xfa6p\x14=\rԤ\x14\nU\t\x7f\xb8\a\xe0L\x16\x8a\xa9$\xbf\x98\xa5\x98\x03\xe0v( \x80\xedz\xff\xdf\xc7q\x9e\x8c\xef\xddWa\x94C\xd1F\as֥(^\xb2\x8b\x9c\v\xa5\xaf\x14\x04\xb8w \b\xedE&&\x980f\x1fEXFY\t\r\"\x0f[\xcd\xc0˧\xc5\"\a\xfeMƂ\xc7\x00\x81㷏\xc9\xea\xfe\xbf\x8fy,\xb8\xa8\x82\xe5\xcfB\xa5\x1d\xac\xe3\x1aJ}\xec\x98\xc1~\xef\xe1\xc0!\xc0眧\xcf'O\xb245\xb8Q\x8dwNO1\xdc\xc5'\x9e⾛\xb0ӡ\x18\x06\x97\x9b\x98\x84\fS\xf0\xc9\x03wr\x9e\x1fab0\xa2g\xecT\xd59\xc2\x15?\x83\xa7\x13\xc2\x02\x98\xf8\xacxo.\x97\xe1\x99\x015 t\xdd``>\xe7\xde|\x9e\xf96\xffs:\x01\x8e\xae2Z\xcbW\xa1a\tE\x92:d\x11e\xd4\"\x06\xa0z\x80\xeb\x12k\x8eЌ\t\x1f\xb1\xe1P\x17\xca\xc6\xd4\xdcL\x90F\xe8\x98\xe5\xcd\xe6\xc2\x12\x029\xdc\xfa(\xf8X.U\f\xaa\xbc\xd9\x1c\x13wg\a\x1a\xb5m\"ox\xc4r\xbb\xef64\xf9<g\v\xb8\xf3慫\xbe\\mNs6\xd7\xdc\xf9\x06tqc\xe9\\I\xda\x1cC\xd0ïp\xaf\x98d\r\xc1\x94NP\xdb\x7f\xca^\xe1U\xec\xb2\xf5\xf4Ni͑?C]\xfa\x1e\xa1\x82I\xf0\xcf\x04VAh\xf64\x8f\x05\xb2\xddBA\xbb\x1dg\xe7\xba\xcfz\x9dN\xb3\x99=ڂ?\xc7£\x87W\xa4֘\xc7^AЕ\xa4=2\xec\x8e|\x8f\xb5\x8e\x93^$!\x88\xf6X?\xf1\xc3ø+[яl\xa2],Q\xd8F\xa8\x8bLԛ\x06\"\xf0\x16\x17Xc+\xf7\x14\xbbܼ\xb8\xb8\u0604+|s!fꥊ\xbeYsp\xbb\xba\x98\xc9d\x1e\vy\x1f\vLb\x19c\x16\xf1\xa2\xa1jݗTc\x95\x13\xe6\xf6\xd90Gr\x98\xb4\xb1\xd9$=\x81_}\xfdF\xbd^Q\x8d\xf8k\x91\xb1~\xb7:\xe2>\x05\xbcl\x1a\x8aN\x86\\\xab\xb4\x19D\xa0\x9bM\xfb\xfa\x17rk\xd2Uᗙr/\xc6\xeb+QR\xcf7\x97j\\\xb8}\x93\xe2e]\x7f\xe1#C\xbcf\xa3\xd2\xc5\x1d\x88\b\xe5\xa0\xea\xffM\x82A\x99\x86\xbe\xd3W\xae\x8d\n\xcf\x18\x1b\x83\xf7\x851\x9d\x16h\n\xc0\xb45dĄ%\xed\x1a\xf7\xaa\x7f\x9b^\x80\xff\xa7\x7f\x97^\x98\xfe\x1dz\xc1#\x14\x84\xb9ch\x89d\x98\xc0\xfe\x8dY\x86\x94\x03\xba8\xbd\xf9\xea\xa13x \v\x88\xa7<\xc6/\xff\x1a\xaa!<b\x83\x83\x1d\xfd\x91\xb5\x93t\xa0\x7fF\x9a\xe4ȃ~>\x9c\x8fX\x8e\xa1t`\x8a\x87\xf3\xd103Ą\xbbT\x91vP\r\xe3\xbbXU'\xeeR+\xbcY\x99)\xe0\x82\x1dM\xe9i\x99\xbe8+\xd1\x17\xfd\x14\xe52;ۆ̘z\xd7\xe2\xa9Of\x80Y\x00\xa1\xeb:/\x93\x19\xa6\xd9Z\x1a!n6\xb5\xc9\xc8I\x7f\xccƅ\v}\\\xbc\xd0\a\xe3\xc8\\\xe7k\xfb\x06\xb0O<\xb0\xc7\xfem~Bgw\xb8\xcd\x13\xef6\x8f\xd5m\x9e\x81\xd5&b\xad\xe5\xd2p\xf3\x16\n\xab\xdf\xe5F.\x9f\x05xX-\xce\xe0\x1a\xd4q\xa8\xe0\x1erɴK\xfa\xeb\x9b\x05\x9f\x85\xab\xfa\x96\xb3r\xcbsl9\xbbc\xcbs\xdbr\xecI\xa5\x9aMe\xb0\xe7\xa7Y}\xb2\x1aqg0M\xb4\x13L\xe5{\x9c\x18\xdc0X\xdfs\xed\xd5\x16U\xa1H\xeb\xa0H\xeb\xa1\xd0\xdc\xdc@;Fqp\xdcH\xe1\xdc\xe16v\x14\xbe%s\x00\x9bV\xa1\x9d\xd6A;\xad\x85\xf6\xd8x\xb5-@\xbaߡ'5\xed\x9eֵ{\xfa\x85\xb3\xb0\xdf\xe9\x83q\xcd\t\xb9\x85\xe03\xce\xe7g\xe5pg1\bz\x80\xb4\xcaI\xb3\xe9~\xdcH\x1ay\xc5<\x1a\x89\xaa\xb0\xa1\xef\x81\a\x87o&\x1a\xa0$\x16\u07b4s>\x0eh`\x0f\xe4\xf3\tz\v\x97\xa7J\xa3\"8CŻ\xb2\aqw\x98\xc1\x97\xb7`w\t0\xc0\xa3\xe1hE\xdf\n\xf4+m\xe3O\xbf\xca\xe4\xb3l\x91N\xfe\xb6\x87\xe9\x97 \xdaZ$\xb3\xc9[\x8dr^\b\x8fW\xff\xb4\xc0\x0eC)\xb9\xb0j\x01\xa9\xa1\x13\n\x04\xc5\xf3\t\xd2Df.\x90\x9aP\xb3\x81䐛\x0f$\x83<Be\xc1&\x02)\xec\xf7\x02^\x1dr\xb9\x14\xcb\xe5o\x10\x90\"\x01?\x95\xcdf\x98\xb1\xb0CǢ\x9d\x8eI\x18[\x0f\xa4s\xf6R\xb4\xfd!h=[D\x90)\xf5\x00\x8b2_\xb9$\xf7q\xeb\x8c\xc2\xe0\xa2\xf1\x8a.<\xc7\x1b\x88Y\x9b\xcd\xf0X\x84ꛔ\xe9\a\x9d\f\x9bU}2\x10L\x98T:\x0e\xfd\x10\x04/|I\xe6\xfa\xe9K\u0590\x870}\xea\r\xac)ȊJ\xc4b\x8dJ\xc4X\xc9EA\xd8\xfeA\xccP\xc7\x02.\xdcg\xb8\x8cS\\\x06qΕJ\x85\xfa\xfc9\x16\t\xc4.\xcfQ\x9d\x02\x17\x1e\xf4$ \x9c]<CM\to!\xaf\xfc\x9f\x8fa% \xf5X\xaf\xd6_;\xc8\n\xbdZ.\xc3+v<\x88\xa3\v\x1d-傡\xeeK\x10\x144V&\xcd\xe6䮊5N\xfdeE5\xc3\x03|\x11\xf4Ac\xf1B\x03\xcbN\b\x9d\xe1\xefꄱ\x19\xa1\v\x9dW\x995\xb6 t\x8e\x99v\xae\xd8\\A~\xa8\x87vO\x10db\xf7\x95\xe5\xa1se\xf6V\xf8\xb1\xe2}\x01\f:R\x9b\xc2\xd1\r\xf4\xfe\xbd\xa4G\xec\xd0#\xc8\xdf9\xb2\xf9\b(\xeb#\xfa\x89\x1d\x9a#\xf4\x9a\x1dڣ\x05\xbd^\x80\xa4\xa7\xc5\xc2Kf\xf6\xbb\xda!QN݂Gc\xaaV5\x9a\xd2\xd2\xf2F\xa7\xd44\x17\xbd\xa6\xaa\x93\xe8ӊ\xba\xa5\xbbT&ꃺ'\a\xe4h\x06\xb9>\xed\xa9;\xed\x99\xdbWqq\xff%5۬\xe0\xfd\xe8z\x85\xfe\x8e\x90\x9d(\xe9P{\xa7\xa6\x82\x02\xd7!\bH\x91\xfc\xa2\x81jLq\x16\xab\x95\xea\xb3]\"]0\xe4a\xa1U5\xa8\xe26\x9b\xb3fsV\xd0֜\xa3\xae\xe6L\xdfi\xb35\x9a\x95\v1\x03\xa5̹}LgZ\xec\xbd`\x19\x1d\xb3\xd9\xd0\xcf\x19\xe1\x1b|cQ\xeam\xc1f\xc3\xce\b\x9a\xc2\xdd1EJi\x06\x04ҘM\x81\x10ҽ\xb9\x17{p\x1d\xa8n\xca\n'V\xf3\ax\x15\xf7\xaf\xc3\xe1\xbfW\xa3\x16Y\xdd?\xe9K\x94\x9b\xf11\xa8\xad\x13\xad\xa2\x03\xcc\f\x8b\xfa\xc4*\\\xacy\xcf$\xd3\x10\f:\xf5\xc25\x9b\xde\x0f\xf0]\xa67G1\x95\n\x96\xe3S\\\x1a\xbf9\xda}\xc0[~rx9\xf7\x85\x94J$N\x83\x93\x80\xf4\x17la\x1d\xe1\xa4\x18y\x9ex\xb1\x8d\xad\x16v\x8dw\x02\xb8\xe6k\xd4\xfe:\xbe\xda_g\x04\xb8'\xfdZ\x95\xc1 \xa0\x19\xbc\xb8\xd2\xc1'\xd9F\x16P\xe89\x03\x05pIds\x04\xa11\xb3\xbfRB\x13\xf6X\x84\x99\xd2I\x19g\xe0\xdd\xf9\xb1\bc\xffw\x10Мe\xed\xd3,\x97\x10\x1a\x15\xfe\xa2\x1f9\xd8\xd7\xe6\xa9T\xf0~\x18r\x964\x9b\xf9\xa0\xecy\x1a\x18\xb1\xf7}_\x87ykF\xa2\x19\xf1\x9d\xf7\fj\xdc\xcdD|\x15.hLV\xe1%\xa93\xe4)\xde`\xfaf\xaa\x1cu\xa7\v\bҥ\x98\xe6n\x1a\x12\xe0*\x94\xc4Q\xba\x9d\x9c\f\xec',\x14Z\xd8.\x80q;Ù\xca\v3\xa5\x883`@\xa8\xd9\xca\xf5\xdf \xa0s\xa6^y\xe0b\xa9:c\x12\x18\x10\xe3J|\x90Eq\xc6\x04\x1d\xb7\xe6$\x9a\x93\xa1,͘S\\P3&a\xae\bݰ\xda\xfc\xdaK߅\xe1\xc7Ӌ\xbeFٯ\xe9\x85Ɣ^ǟ\x00\xb5}\x98\xcf-jC\x05D-U|\x8e\xcfby\x9a\x0f_㛼\x9f\xe0\xedT\"e\x13u\xab\xbc\xaa\xe1\x11]\xaf\xfa|\r\x1bm\bD\xf8h\xb9\f\xf5\x17\x03֯\xfe\x1er\xfd\xec\xe7Ƙ@x\xae螨iX\xcb\x16A\x7f\xa65\xd9)\xb9\xb6\x88\x87\x8f\x86\xe9H\xfb5\xa7b\x15\xaa\x1d\\\xf1\xa5\xf7F\x84\xef\xfc\a\x86\xdb\xd1oD\xf8\xbc\x98\xd3\x7fu\x037\x86\n\x9a\xb1+5:tK\xea?s\xc0M\x1a\xee\xbc\xd8}y\n\x8eh\xe2t\xee\xfb6\x97\f\xdd\xf8\xc2\x1c5\x9b\xe7f\xb6\b)\xa5\x977\x19\xf4E\x83\xb6\xb7\xcbTY2\xb2︵*\xd5n\xdf\xd5\xc68\xc0v\x98\x0e'\x16\xaaf\xe9+\xfb\xc2\xeaj\xc9N\xdf(\xb6_\xc4\"5\xf2\x9b7f\x16}wȪ\x85\xe0\x9bF\x927\xe2\xb3\xe3\xe4d\x91-\xf2\xc61\x1f\xc7\v\xe0\x9b\x9e\U000865b96\x00\x1f4N\xe3\xbcq\x96\tȉ\xd3F\x96\xf2\x86]\x1b\xf4\u008c5\xa0IߵrL]7q:\xc12\xc0\x05\xe2\x93\xcd$\xf5\x1a@fYޘ$\xcaw\xad\xea\x18|q~\x93\xa4ߨܶap\xa3\x1fx\xa6\xbc[Z\x94\xce\xcd\x1ddм\r\xeens\xbc\xe5\x95\xcaE\xf3\xe7E\"\xf8\xa4\xd9\xdc\xe0\x15\x85*G\xf6i\xab\x92\xb7\xba\xb4\ate*a&\x01\xfc\xb9\xc8\xce\x13|\xbd#\x15y\xec\xef>\x8fwn\x12\xb5\x0e^Տ#:\xa6\x93\f\x94\x845v\xf5\xa3\x95U@t\xbe\x7f\xb1p\xc3\xda\xfe\x16f\xd9D\xb3\x88\xf3\x064\x1c\x90U\xd6lfȞ\x8a.\xdc[\xca\v-\xe98T\xef\x14\x87\n\xdc4\x1b\xb4\xf1\xbe\xd6\fҫ\xa2Lj\xc2\vv<x.\xc2\xf7\xf4\x82D\xaf\xd4_b\x10h\xb3\tH\xc5\xe2SR\xa0\xd2\u07b2R\xe6M2\x16[\f\x9c\x83\x19\x12\xae\x15\xf2A\xd0\x04-\b\xd2:\x17m\xa3(\xa4|Ԭ\b\x85\xb0R\x17e]\x88\xb7\x86&\xbbpPV\x10\xff\x94\x87\x17\x84^ G\xe1q\xedӟ\x0f<O\xbf\x7f\xfc\xa2\x02V\xa88\x1f\xeeE\xfe\xac\xa8=\xf7\x97X\xa3\xd3k+\xfc%B\xaf\xf4\r\x1a\xcc6V%\xc5\xe8\x1f\xda\x15\x0fx\xcb9U\xfer\x1c-\xd4\xff\xa7t\x9dSvR\xe7\xbb\xf5\x04|\xb6\x9eh_\xad\x19K۪\xf8a*\x13\xc1\xc1\x18\x90\xc6\xfaZ\x7f\x9a\x8dQ>]\xf3\x18\xceYZ\xf7\xac\xd3\xd2낅\xc1\xb8\u058b#\xd2\x0e\xf56\x03Sv]\xd5E\x176BD\\g\u0091\xd4j\xe8\xe7e\x05\xf6ٗ(\xb0\xcfW\xf4T\xbfE\x7f\xec\x1a\x9e\x87\\\x81;\x9fSE\x9a\x19\a*t*\xc2\xc2雪Ӈ5&5^\xd9:\xb4bD\xa1\x14\x16\x8d\a\x18\xbd\x84g\xf8\x98K%\x05\x16M\x91\x98\x13\xa8[d\x99\x9bg\b\x91zթo\n*ڰ\xc1\t\xadj\xbd\x9fY'0w\xd0z\xa7k\x0f\x02l\xe9\x0f\x02\xa2.n\xef=\xe8y*ꟑ\x1b3\xe3\xf2z\x9c\xa5\xd3\xe4$\x8f0\x16\xc3\x13\xfdC\xac\x98\xf1\x99\x7f=M\xa3k\xe4yEa\xca~\xa3\x19*\x93s\xc5>\x89\xe1G\x96K\xf3+^.\xeb\xce6\xec\xe3l]V5\xb5\xaa\xf3\x8a6_\xc80\xe1+B\x7fW>f\x9f\xa7?\x81\xd8\xe6\xb5\x00Eې\x13\xcaY\x06\x7f\xe2\x10\xda\x02\x84U\xe2\xedQx\xf5-$\x8f\x9e\n\xaa\x9f*\xd1\xd4~\xbe[\x1cK\xc1\x8b\xa6\\ڙ\xac\xe7E\xfeZ\xe9_q&B\xd2O\xd9u\xcd~\xad7\xed)n\xf4\x8a\x9dO͙\xa9\xe5r\xd5\x1d\xa3Z\xbe\xd7je^\xc1Y\xd5\x13\\\xecy\x82\xcb\xf6\xb7\x06\xd9\xe6\x16x\x82K\xd8V?y\x94\xf5\x93V\x8b\xc4\xc3ds\xcb\xf7\x04\x97؋B\xa1a\x9a\xd2v\xbb\x1d\x93\x95\xd5(\xe5oy\x1eIN\xb3\xf9\xf3\t\xd8T\x9c\xfc\xb2R\xee\x19\xb5\xe3\x9d\xc8n\xb6k@\x9c\ac\xe5\xd7\xf1\x1aD\xbb|\x12}\x10m\xf5\x05\x1e\x7f\xfb\x16\x1b\xafV\xf4\xe1ރރH\xb3w\xd9\xfeu\x00\x84\x97\xb6\xb4鋶\b%\xa1\xa2=\t!ʉ\xa2a\xa2\x90\xb0\xfdL\xab{\xa4\xa0H\xd7\xe9m\xfb\a \xb3\x17\x03n\xf0\xfcT\x116\x9f\x17\xf1\f\x1cRGi\xfb\x98\x03\x1ct{ok{\xf7n}C0\x93$\x9f\xcf\xe2+d)\x01\b\xa9\r\xf4\x912\xce\xf6y{\xe2\n,\x97\x8a\x1eZ.\x03\xab\xf4\x1e\xac\xe8\xee\xd6v\xaf\xf3\xe5\x83]x\x83\xddz\xb0\xd3\xdb%\xa8\x83\x05o\r\x1a\xdb\t\xa0\t\x13\xe1\x83\a\xbb\xbb;\x84\xe6L\x848:x\x89\x8ap\xa7\xb3\xd5\xdd6\xd0.\x00Z\xc4\x0f\x88\x154t\x1aK\xbc\x93\x99\xe0\x91\xc0ϫ\\\xf2\xb3(\x05|aj\x86c\xc0\xcaIۯ\t\xacB\x01\x92\xf4\xb0C\xe3\xf6\xf7oH8\xa6\xbe\xd5\xed\raWe1\xec\xaa`\x9d\xbex\xc4\xfb\xa2\xd5\"R\xf9r5;\xd4y\xa9\xcd\xf0\x99\x80\x16A\xd0\xeaX\xc36\a6\xc1~ء\xb3\xf6\xef$\xe4\xffM\b$t\x1e\xe2<\x00\xd1\xff\x12\x1dP\x01C;NR.\xd4t8n\xfb\xb5\xc82\xf9<Eg\xf1Qaʣ\x05=\x8b?\xf1R\xfdhN\x05O\xc1\xc2\x06{Pߪ\xd1⼃\xd5)\x85\r^ڛy\xbb\x98\x80[\x1d\xf7ŝ\xb7\xba\x03\x117:\xb7\x10\x11\xb6/8\xad\x195fI\xeem\xd4\xdeV\xb7\xd3-m\xd4\xde^\xaf\xa76j;\rc\xb5Mwv{\x0f\xb7\xd56\xdd\xddz\xb8\xf5\x00ؖ\"|\xb8\xbb\xbb\xfb\x10\x95\xb4\xb4\x15\xb2q\x89\x83\xb7\xaa\x96\x8f\xf8\xd7\v\x0fa6X\xd5\xda\x1bI\x89\xeb4\x93\x10\x10\xc9\xcb\xd6xh\xb2\xc6\v\xaer\xf4\xad\x9c\nd\x823n\\\x99\n\x9e\xcaw\x8bc\xa6\x1d\xc7/R\x88\xc81\x16\xc91GF\xabJ\x9d%\xb9\xe4)\x179;5n[\xd3Ɍ?9\x8d\xd3\x13\xfe\v\xba,\x13l]\x86\xe7\x7fue8\xd2\xd6\xc6\xcbnB\xf0\xb6\xfa\x8a\xe7\x92O\x00\x9auN\"\xa4\xb8zg\xe0\vI\t\xb8\xb6\x05\x1d\fӨl\xabY\xb2\xad\xe6\xfeܔ\xaa\xaa\xa2!V\xab\x1b\\\xb9f\x96\xbe\x83\xebB\x15j6\xabi\xaa\xa9$\xb7\xe0Nj\x16\xf3\xb12\xdb\n\xcbS\x8fu\xfd\xb1V\xfa\xf7\n\x037\xa8\xbct\xc5\xc5\x1d\x14\x7f\x16\xa6:\\\xb7l6\xfat&\xb87\xb3k\x8b\x97wJ\xc5(\x13\xbc\xf6I\xb5\xa9\x84\xef\x1a\xf5\x1acz\xf9{\xb9PjE\xab\x9b\x9d\x87\xf5\xf8P\xf6y\x9fp\xe4S\x1c\xc7\xe3O!\x10Z\x8a\xb2F\xbf\xa6'\\F\xb5\xf5\x86\xc0\xf9\x96}\x01\x95\x91\xd7%\x00w\n\xac\xd9w\xf4\x9f\x9d\x85\n\x9b5e\x1b\x1d\xc0\r\xec\xda\xf4\x1dq\n\xb5\x15G\x14\x9eV`\fg}\x87@\xc2@\xfd\xc1NX\x16I\x96\xf9<s\xf0^\xae\xd9\"\xe8\xe9u\xa3K3,:P\x7f\xb0.SMDB\x7fк\x96\xf1Od>@\x81e\x15\x12\xb3\xc3>x\x9b\xe6\x86=f\\MyI\xe6\xf8݂1ڸ\xba\x95\xb3\xcaN\xc9\n\x1d\f\xd03Vg\n\xa0\f\x00\xbc\xb7\xa6Jh\x9b\x90F\xebs4\x82ՑN\x069<\r_\xc4W\xd9B\x1eN\xa7|,#LQ\xdf\xfa\xb2=\xa9U\xd4ÝO\x85c\x94S\xdf];E)7\xb6\xf5\x92\x9fe\xa4\xb0#ծ\x82\xabx\x12J/\x00C\x01C\xc0\xce,!(z\x8d\x9d\x82>\x91\x9aSe\xf3\xc4\xe1\x01\x85Q\xfe\xe2\x1b:\xf5\xddBb7!\xd1\xd5\xfag5\xd0em\xbf\x0f\ac\t\xc5ư\x03\xbd&\x9b\xcd*\xd8aA\xda\xc3K\xfb\nNai\xe4*\xea\x1f\xa1Ì\xc6#\x13:F,\x97֠?/\xaeb\x98\xb4\xdf(\x06\x9c0\xfe\xb8\xb2\x15M\x89\xba\xf3\xce\xc1\fzw{\xa7G\xe8\x15\xdc\xc0[[;;\x84\x1eC\xea\x0eܺ\x17x?\x1f\x83\x9bZ\x11\xee\xf6\x1e\xeen\x81\x83\xda\xe1\x88\x1e\xb1!\xeeX\xf8\xc73\xcbzW\xb02q\xba\xbdC\x10\x88(\x13\x11\xd1\xea\x8e\x1c\xef\xe6\xc0\xa8s\x9c\xd5-\x881\xe7V\x1b\x16\xc8\x1c\x02L*g\xcb\xe4\x82$\x01C\a&P\xbbka)\x95\xf6;\xa3֍\v \x83\xd8\xfc@\xaf?\xb6\x02\f'\t}S\xa9'\xc5\xe6\x91_\xb4P~\v\x8d\xfa\xd8\x068\x1eƪ\x05\x8a\x03Ğc#\xbd\x13tZ\xd8\a\xe8څ\xb34\x9c\xd2\xcct\xefGzc\x1c\xf4\x17Vb\xb9\fUۀ\x8b\x19\xb3\xa0\x0e\x12\xf3\xb5\\\xceB\x12y\x83\xe04\xf7\xbe\x137\xea\x0e]\x84׀ \xa2\xe0\xdd\xfb\xd7o\x0f\x8f>\xbcyz\xf0\xfe\xf0i@\xf5\xbaD\xd7\x1c\xc35\n\xe5\xfa\xaf/J\xfbnJEy\x7fOê)>\x0e}\f\xfd\x89\xea^\x165{\x99\xce5w\x19b\xc0\xc0|\xbd\xae^\xf6j\xabuF\x1e\x93\xf0\xa3\xdehE\xee\xf6\xf5\xcaHo$\x06@*\x92\xbf4s\xa2\xbatPÑЦ\x12\a\x93\xf38\x1d\xf3I\x18\xb4x+ \xc1*J\x81fղ,\xad\xf2\xe3\v\xfd\xc6\xc5z \x03T\xc6\a@*\xa3c'`\x13\x14\x15\x8a\xd5'xw`\xa2\x9d\x9f\x02\xb3\xfa{$\r\xbc\t\x02.\x9c\xad2W\xea\xc4B!\xd7\x1f\xf9\x15=s\x99\xa7`B\x9c\t\x1eD\xa7\xf4\x84\x85\x02\x9f#o9,\xda4\x13\x17\xb1\x98@\xa0fz\xcc\x1c\x03\xb2\xd9<\xa1\x1f\x99\xb0\x18\xfa\xb9k\xed\xe3`\x1c}\xa4\xaf\x00a^\xc1\x1bJ\xd0aP\x9cJ\xab\xff\xa5\x7f\x94\x86\n\x8a`k\x86\x14P\x05)(\xeb\xd2@\x83\tjg\x16Lc\xf5y)As\xe1={^\xe7\xefA\xafr\xf1\xa5-\xab/m\x9a\xb2L\x89\xa6\xc3\x0e=\x87\xc1\\\xaf\xe8\xab\xca+>\xa3n8\xc8\xc6,\f'Z\xd0u\xc3\x01}\x13=\x9c\xe8\x8cz\xd0D)\xd5\x0e\x89-0\x98,\xe8/\xa5d\xe4f\xceث\xf6|!Tl\x931\x9b\r\xecMU\xeb\xb5($\xdeY\x98;'\x8c\xb7ݫ\xb0)\xe3\xb1|\xcb'\x8b\xcbgj\xc69\xcc9\x95\x85Ů/\x14X剡\xdb6\x9cb\xf0\\\x88$C3\x96\x82\xd5H\xc2R\xb0\x1a\x99\xb1t\xd8\x1b\xd1\xc5\xed\xf7.\x88\\\xdaO\x94\xa2,\xd8Bw\xe8\xa1\xf3\x8dj\xd2IX\xbe\xd9\\\x1d\x15X\x87\f\xb2轺\x1aߏ Ƹ\xe9X\xb7\x04*\xf8\xf4\x94\x99\x97\x83>I\xa4\xd9,\xa5XT]\x93e\\\xa8\x90\xbeə{\xa5\xe6\xbaI\\\xc53v:\xd0\xd5\"\x9dAO,Lk'\xa3օ\x92sT\x86\xda\ngH\x9e\x9c\x8d\b=\xbe\xa1A\x8c\x95l\xc4\xf4G}\x9f\xb2:\xa3:\xc6\xf5\xbc@\xca\xc0+\xa3J\xa3\xa8W\xa8u\xff84K~FO\xe9|\x04D\xc21,\xfbGv\f\xcb\xfe\xfc\xf6!\x9e\x0e\xe6\x91w\x1c\xe7\xf4\xba@\xb3]\xacpx\xa7tN/F\x84\xbe\xb2\r\xbeEY\x97 \xe1;zI_\x13\xfa\x86\xbd\x1avF\xd0\xf7[\xf6\n\x88\x8dd\x1a\xbei6\xdf(7f\xfa^ѿp\xf8/\xbd\xa6\xa6$$\xf4i1aF\xe8\x8br\x91\xc7ń\x8d.\xa1\xcfظnX/\x1c]1c\x8c=\xb5\xf7\xb5͈N\xc23\x8f\x10\x00\x9dx\x9c\xc77t6\"\xfd\x83\xf0\x13\x1d>\xa5/\xe9c:\xa3\xcf\xe8\v\xfaqD\xe8A\xf8\x84\x0e\xa7\xf4\x8c^\xd0\x13\xaa2_Џ\xf4\xed\b\xea]\xd0\x13M\x03\xfeu\xfb\xac\x97\x8f\x8f\xa4\xde\x1a<\xa3ׂO\xa3d\xa5,\xad\x12*\xe93{\xdco߳\x83r\xe3\x8b\n\xd5\xf9|E\xff\"\xd1_\xd0\xfa\x82\xfeE\x9f\x8f\x14K\xe3\x14q\xdd\x19?\xcbBP@\x81%<m\x97Q$\x93\xf4\xd4G\xf5l^\xf8\x95\xd2ccs\x95{\xf7ݚ\xd8\xdfePO\xfdy\xe0\xf4\xba\x1e\xf9ER\a\xa5\u05ed\x9c\x94 8\xa9\x03\xfa\x02L\"\x80l5\xd2SP!\x87ߎ\xc6|^\x80\rc\x89\fP\x84\xbf\\\xc2\x1f\xb9\\v\xefsƺ\xf7e\xc47@\xddBn0\xdfjˆ\xb6T\r\xb9`\xa6\x9ee\x9a\v\xec\xaadQ\f\x9b\xaf\xe4J\x9b+M3]MNigF\x9f\xf8U\x0e\xccѴ\x90 \v\xf1z1dD\xc1\xb6h\xa3\xdbwR\n\bKf\x8ab\x04\x1d\xc0R\x15_IE\xafE֝\xf10\x03\x05Ǎ\xe7!\x1f\xc2\xf7\x88J\xf5\x97\xb8\x9e\xcc\xf8\xdd\x14\xbd\xf7\xe3\x88[\xe4je\xc1L\t\x86\xeb\x84\x06\x8d\xd4,\\֞\xf09O'\xf9\xebTÅAO3o!ߔB\xb3k5\x8cR-\xef\xf1]\x93;0\x17IM\x1e\x89\xbaXIM\x9d\xeb\xf7ma\x03\x15\a(\xfcM\xaa\xf9\xb1\xb5g\"\xad\x81%m\x9f\xc5\xf3\xf7\x19\xfe\xc0\xc2Q1\x85\xac\xfa\xebk\xc3\v\xc0/Ί\x80\x15\xb28\xadk\x00\xe6\xd3Db\xf5\x83f\xd78j\x03\xcd\xf9B\x8bٚ\x163BMk\x84\xc23x\xa5\xdd_\xbdd\xc3:\x13\x8eJO|\xf06\xe4D\xbbv\xa8\x93j6\xb8\xa1\xec\xdf\xd79$\xbc6\xd782&H}\v\xbeZ\x8c\xed\xf7}m`\xed:\xad\x0f\f\xfe\xea+\xa1\xa9I\xe4\xc3t\xd4_3y\x02<\xd4\xd6\xf0C¬\xf8\x00wBg\x88\xda\xe1N\xb5\n\x1c\x99\x86\x99\xaf]\xcb\xf1\xbdn'k\xa49FO\xff\xbbSmg\x1a\xe7\xd7cM\xbc(\x86\xeb\xf3p\xbe\xf6ͭ\xc0{|g\xf0j \xba\xf5\xf4\xa1\x10\x04\xe8||PƂ\xbf\x04\x19\xf7\x04\xf7'\x8a%i\xc2,\x12+4G\xadg&\xae\x7f\x9aR\xc9 k6\xe3pFS\xb0\xbbOٌDa\x82\xe7\x0f\x94\xffS\xe0Y\xdeq\x1ekH\x9a\x95?\x85\xcf\\\x10\xd12\x84\x19\x8dm\xa2\b9\xfe\xa62L\xe1O\xec\xdfw\x7f\xf9q\xad\xb5\xf7\x05\xc5X\xa1c\x96\xc1\x94 q\xa4gc\xae\x92\xcc\x11V\x89S\xaf\x9c\x97|\n3g\xfb\x99\x84\x19\xd5:SJ\xb5gc\x1e\x9e\xd2\x04\xf4M7\xc6\b\x9e\x99\xbf\x98e4a\xa7\xf4\xa4\xd9<\x1f\x849\xe3hyCe\x1d\xf2\x0egL*\x974(\x9b\x02\xb8\x13B\xa2\x93AX\x8b\xebmk_\xd6\xdc\xf9 \x9c\x180\xce\xd8\xc64\x9c\xd0\x1cDd\x13z\x86\x9a\xf6\xb6$]\x90h\xb1\xaa\xae\xc5\xd8#\xb5'\xf8;R\xa0\xe0PǄ\x9a~\xbdna\xfe:\xb4\xe03냏Vd;I\x13\xf92\x9e\xe3\xbckLKS\x97\xfeT\xa36\x93\x95\x99,\xd8\xe2*)\xb6\xafL\xb0O\xa8i/\xa0&\xb5Ԛɰm\x05#%\xe6\xe6\x14\xa5\x87\xa9\xfa\x98\xb1,\xe4nmA\xdd|!\xf8\xe0\xaf\xe8\x19\t\xd56\x83\\7\xc2\xcf\x06)\x18T\x96z!\xc5\xfa\xe9>\xeb\xf4\xd3\xcdM\x83B\xe50\x1d\xe9\xe8\x1dΙ\xf9\xaazX\xd3\x1au\xc4\xe7\xe9y<K&Z\xeb\x10\xa8\xad\xab9o\x04-\x83NZAc\x9a\x89F\xd0\x12\xad\xa0a\xf0l\xe3┧\r\xcd^\x025E\xebu\xa6\x11\xb4\xd2v\x1d\x83\xa1\x05\xfa\xb6\xde\x1a\xfe\\\xa5/]\xe6=Ǵ\xb7\xcc\x1f\x0e\xb6)\x1c}\x05뎿\x7f\xfd\xa4hc\xff\x11M\xece\xfb\xac\xb8x\xcf\xe2\xb1̄r\x1a\xe4Y\xdc?E\x83{,]ZTW\xa1`\x1b\xf3\x12Mc\xa4\xd2\xff)\x15,\xf0\xd0\x1e\xa3M\xbel\xe7|ơ\x80*V2\xcb\xff\x10\x8d\xfb5\xb7%\x15\x88\x80,\x98h|g\xf8\x87\t\xcb\xe8\x8c%\nS\x8f\xbd.\x97K@?I\x19M\x9d\xba\"\xd3\xc1\xcf\xd1\x14-\xfc+x\xcb\xe3\xd7M\x06\xaf\xa2\t=\xf1Z\xf2\xca\x1d\xbbr'\x83W\xd1\t\xbdP\xe5*wš+x1x\x15]\xd0K{\xbe\xc0E\x11@\x1fР\b\xabJ(\x00\xe6\x95)'\x96\xbb\x84Sw\xc4\xe0\xd4\xc44(-\x7f@\xe8;\xf69\x944\xa7Au\xad\x03B\x0f\xd8\xe7P\xd0\x05\r\xdcº\x98\xf9i8w\xef0\x8f\x19g\x98\xab\x01-\xb1\xec\xd6\xf3o-\xdfv=\xd7\xce\xd2ل\xd6`\xa1\xe8\x88\xd6c\xa1\xe8\x1d-b\xa1\xe8\x80\xc2,GcZ\x9c\xe5蔖g9:\xa3\xd5Y\x8e\x8ei\xcd,G\x87+z\x89\xfeّ$\xf9\x05\x02\x97\xe3\xbe\xfc\xbe\xff=\x9b\x81\xd8P\x82\xd9\xd1\xd11\x80\xc6'\x1f\xd0\x15zN\xe7\xec{,\xf5\x03h\xff\xec>x\xb0K\xe8\x8f(\xbf\xf9\x81\xd0\xef@\xba\x83I\xbfc\xd2wF\a\xe87\xd0\x01\x92l_\xa9\xbc\x81\x9a\x94X1\x10R\x8cgq\x9e7\xd2\x06\xbf\x94pm5\xf2\xb6\xc53\u05ca?z\x13k!\xd3\n\xd0Fz9G\xfc_\x8c\xf1\xbaZ\xb9\xf7\x83\xf7\x96\xfe\xf3\x97D\x9e*\x95\xa3\xf0\xdeu\x99\x89\x8f\x1a?\x7f\xd2tE\x7fb\x88\xdaؾ\xf0\xa1O}\xe8㯂\xfe\xc4\xca\x12W\xb4\x9c'\xcc\xc8֎\xca\r+\xae\x0e\xebm\x96\xc9\xf0\xdeuZ\x1e\x94\xc0A\xc5+\xfa+\xb3\x9a9a\x87.ڟ\x9f\x90P\f~\u0080\xa3\xd1\xef!\xa1\xbf\x84a\b\x14\x18\xdbW\x97\x92^Ƙ]\xb7\xdbmT\xd6C\xfd\x89\x84\x85\xfa-\x1ff\x05\xc7\xc0˥\x87\x9a\xd5g\x94\x9519\x10\x92\xa0Cu\xad\xe2.\xfbq\x18\x92P\xc0\rJ\b\xfd-䄄\x92PΙ%\f\xd9>^\xa5\n(|\x17H\xa3M\x193\t\xd1qkh\xe9\x18\xe8Wx\xc8\xd3\x14\xfe\xe1*\x80\x8d\xe4\xde\\Hն]\xe6\f\x97\x19U\x89$\rD\x96\xc9\xc0,\xfa\xacv\xd1=\xa7Ɋ:\xcf\x17s\xae>)\xe7!\xa7)\x95\x14,\xc6?\xbczw\xf0\xec\xf0\xc8\u07b4\xbf$\xb3\xd9[>\xe6ɹ:\xb7\xf0\xfa\xb2\x15\xdc. +\xbb\xad\x8c\xf2\xe8\x8f\xda\x16]o\x93t\x90\xa0\xbf\x96h\xe8\x8c\x10\xcb\xdb+\xc6`4:sV\xdd?%]\xab\xf0\xdeuV\xdeJ1n\xa5ي\x8a²df\xf6\x1a1Ӛ\x9d$\f\x0e\xe6\xf3\xc0N\xdfLK\xac\xc2*TJ\n\x99\x91\x15M\xbdUq\x8fL\xb8Kg\x1cڮ\x98\x1a\xf6jM\r{\xbe\xa9!j\xc1#\xc3J\xeb\x04[\x96TꙞ\x80\x85\xb8&\xa6^q\x8ce\xa2Jӆ\xcc\x1a\xa8\xbb܈\x1d\x81\xd4n\xfc\x12獓䜧\x8d\xd8\xd1Y\xa9\xc1{@;\xa6\xfe\x1bj\xa0\xa6\x01\xcfů!\xa7\t\x95!!\x11~\x92(\x01)\xeb4Nf\xef\x92\x19O\xe5\xecj\xb9䡊\x94\x8c\xa6E\x9e\xb9\xc94I'\x0e\x8e(\xa0)\xb1\x81\xff\xb7\xb6\xb6{\xdb\xeb\x95\uf53e\xe7\x16\xaa\xd0=\xa5Oz\xf8\xc1\v\xbatJo\xae\xa0K\xf7`\xaf\xdb\xdd+\xebҡ$\xbf\x10U\x03\xc8@\x8d\x14\xe4r)\xf7\r+\x89h\xc3 \xfd˾\xea\x05\xeb\x14\"`\x836\xc6#\x89\n\x91\x18^\x9f{\x8a\x90\xa9\xa3)\x17u\xefaeT[\xf6\xfc`hh\U00106391\x96K\x15\xea\xd4[\xde]\x9d\x1dg3\xa3\xe2Ç\xeaw;\x91*\xaa\xcah\xb949\xc1\xff\xfe\xafI\rF\xa4\xe0}b*\xb2\xb3\x9aN5+Uw^QP\xf7\xe0\x05\x8f\xf5\x05\xd6\xe8z?\xef\xed|\x96\x8cy\xb8\a擆\x87\xf5ښ=\t\xd0\n\xf1\x90\x13\xba\x02.\xa4(\x939\x1a@\x98z\xa8\xb0\\\x06︪;(\x8c&\n\x0ȇ\xd2\x05\xef\xff;\x1cD\x1f\x92\xe5s\x92\xcap\x10\xed-\xbb;˭\x1e\t\aѓY|6\xe7\x13\xa2Z\xb8w_{ $\x0356\xc3.(M\x10\xb9\xae=\x8e\xe6m\x13K\xc9\xcf\xe6\x12\xcec>\x17<\x9e`\xd0E\\\x83\xe3\x99\v\xf5\xd8\xfe#}\x9e621\xe1\x02\x8a\x1e\xf3\x86)B\xb1B\f i{\xad\xbcq\xb6\xc8e\xe34ƨx\x95\xc5\x0eICъ\xf0\xe8\t\x95Da\f\x1a\xcf\x0fA\rz\xee\x94J\xa7V\xd1ŝ\x89S\xffy[\xe4p\x032\xd2)'\\z\xach\x05@nx\xc77\x95\x81f\x94\x02Zڞ&3\xc9E-\v\xaf\xb6\x89\xa7\\\x89\xc32e\v\xd5\xe6\xe9\xe2LM\x11\x9a\x82(\xb3R͛\x03\xfc\xbe\xb2l7\x8f\x01\xe2\xdbȳn_>*\xe3\xe6\xbel\xb5\xcc\xf8աq8Y\xfa\bZ\"\x82\x96\xff\xea\rN\xf5\xb4\x80L}\xa3Sg\xf5\nX\xa6C\xc7J\xf7\x1ax\xf6\x12\xed^\xa3[ƙ\x0ft\x01u\xe0\xdfXkԐ\xd3۪\x86`\x88\xeeA\xb6\x06\xac\xba\x0e\xae\x10\xc8\xdb\xd6@P\xf4@h\xa7\x99\xaf\x94\x80\xf7ړ\u009fh\xb4\x81oh듭\xeb\xfd2\xe8\x83\xf7}\xf3r卧\xed^Bg\x18\x1c,\x84?\f\x1a\v\x05\x1a\xe0Z;@ը\x18Ȩ\x87\x7f\x87\xe8\xaa\x11\x1d5:\xd3[L\x83V]BwD\n\x05\xba\xe5\x02\x9d\x11\x19E[\xe56%\b\xf1\xbf\xa8\xe1J\x81\xde\x1dz\xbe\xb9@\xb9\x85\xdem-\xf4\xbe\x18\xc8[\xfb\xac\x19֗\xcf\xf0\x97\xce̗\x0f\xf4\xd6\xe5\xe9\xdd:r\xdc\bb\x9fmW\xf6\x81\x1cn\xfdӛ\xa1R`\xeb\x1f\xdf-\xddۺ\xf8\xfb۩w[\x17[\xb7u\xb1u[\x17[_<\x93ݿ_c\xebo\x1f\x82\xdbklݾ#\xbe\xb4\x8f\xad\xaf@A\x7f\xbb\xc6\xd6\xdf>\xcc\xdd/\x9e\xab\xee\xad#\xef|q\x8d\xde?\x8fb:_|\x02\xbb_q$\xbf\xb4\x8f\xad/\xee\xe3\xcb\x0fi\xe7\xef\xd7\xe8ݎ)\xbe\x1c\xb7|i\x1f\xbd/\xee\xe3\x1f\xb9\x82\xbf\x14U|\xf9\xee\xbe\vF\xfb\xdbH\xf1\x1f\xc0`ݯ\xb8H\xff\x1b\x04˗\xdf\xd6\x7f\x1b7\xf7\xfe\v\xa8\xf7\x1f\xc0\x93\x9d\xaf\xa0;\xfe\x1b\xe4ݗ\x137_\xba;\uf09d\xff6\x82\xff\a\xb0q\xe7+ȴ\xbf\x8dK;\x7f\x1f\xb9~9U\xb5\xf5\xe5+\xd2\xfb\x8aK\xe1o\xe3\xfc\xceWP\xb5wz\x9e(\x06\x15\xa1\xf0LvL\x8f\xf3\x7f&Ln\x95\x89]\xe4V\xabv\xf0\xf9^\xe1\xec8q\xa4r_\xbe\xa1\x9cTь\x9dx\f\xe7l\x8d\xe3\x1e\x9d=\t'\xda\x7f\x90柀\x82R1\xa8\xaa5\x91R\f\x84F\xe0\x158\xf6\x8d\xf1\xd2l\xa2l\xf10LQ~ʹ2\xc7ßE\x9b\x98keꂾS\x9f\xa7\xb3$\xe5\xef\xb0\x0e\xfa\xb3\xfcįh\xaedI\x9a5\x83Rz\xa9\xfc\xfa˶\x8cO\xd06flܽ#G\x1f\xed8@tn\xb8\x1fcH^(\xf0N\xe9\x19\xabSa\xebT$\xf6N\xe5H\xb4XW\xa9\x16\x86aQ\x00\xa1\v\x1c\x87\xd70d\x13>\v\a\x1cqZ\x1aR$)\xc6z\x1eg\x13\xbe\x99\xf3\x13X\xdbM\xbb/\x05\r6ݖ\xcb\bz\x12^\xadP\xf6\x05\xf0\xc7F\xdf\xd8g\x1b\nr\xf3\xa2J\x13\xaf\xae\x1d\xac\xe1P\xf1\xb6\xf6\x02\vR&\xf0\x1c\xa1\xbc\xc7A甫\b\x18\xf4\x02\f\xe1A\xd0\xf5\n\xbd\xaey?\\e\xbd\xf5\xc8`\xa8\xbfF\xd1pD\x0f\x8b5/\xcc\xf0\xfc&\xd6o\xe7\x8d\x13\u05fe\x8e\xc3z\xca\xf4>͕\xa7\x1d\xdbLt\x15\x1eZI\xa3Z\x86\xe8\xdc\xef\xc7p\xdc\xe2\x1c\\\xd8b\x13f?\xa2\x85\x9f\xf6W\x7fS\a^kD\vH.\xd9Y(\xad\x99\xa9\xe7两\x9b\x0e\x8a\x06ST4\x80-\x90\xac\xe8)\xa1\x97: \xd7\x05\x1b\x06\xb38=Y\xc4'\x1c,\x91tch\xb6t5äE.\xb3\xb3w\xe6W6\xe1\xef\xe3\x13\xa3\xa4T\xdae\xca\x10\xea\xe2E\x92\xf2W\x18?Ԥ\xa8B\xa5t\x19\v\xd0\xf2q\xa9\x01\rf\xf6\x87\x95\xfa\x99\xae]\x96I\x01\x85 \xa8\x9d\x9b\xef,=1\xbfg\x9a\xe9\x99[s-l\xff\x8d\x00\xf0\x03\x1a<Q\x03\xd1C\x02\xf5\x8f\\\xdaX\xf5~\xe8\xbd\xc3\xffO\xb3\xcd/\xff+l\xf3\xc3\xffg\xd9\xe6\x87\xff\xc7\xd9\xe6G\xec\xfe\x1f\xe9\xfd\x93\x82Ѱ\xbb\xad`\xf3)\xf9\x19\x15\xf6' \x06\xb4 /\x1c\x81\xd2\xdd5\x9de\xb1\x8c\x82\x19\x9fJ\xb0j\x9d@x\x9c\xb7\xc9ɩ\x8c\x82ng~\x19\x98\xbb-uG\xa6h\xc7y\xbdB筼]=\x88\xebP\x89>+:\xb2f\x05\xa9a\xf4OZg!\x0f\xa71\xa7\xa2\xb63w;;\x17\x1f\xc5\xeb\xce\x1e\xc0\x8c\xc9\xd6z\xe0\xf2y\x9c\x06\x14\x91\x1c\x1e\x7fw\xbfIB\x1d.U\xe6~\x9b\xf9U*\xe3\xcb\xcd\xd3\xe4\xe4t\x06\xd3\xc6\xc5&V2\x81\x8f\xd5\x18k\xd47\xd2A\x1af$JW\x1ea\x97\xd1\xe0\x8f4P\xab\x1f^\xe3h#\xe9\xf9\xfeK\xefݧA@̍\beu\a\t\xad\xceH\x94\x17BX\x1e\xf8ת\xb6j\xe6j\xd0\x01\xd5dH\xa4G凞ț\b5\xa6M7!ܟ\x10\x8c\xee\xa7Z\xc3\xe2f\x06\xee6Q#=\x10\xb9\xa2\xe6\xf6\x88\x86\x1aJ\xa4\x86\xb4+I\xbe\x1a\xad*\xa6\xec\xd6A!\xbb\xd6\xca\x1fQ\x90\xe0]\xb1y<\xcbƟ\x02z\x96\xa4\xbf$\x13\x89\x8eڄ7\xe3\xa9\x17'\xd8\xf84\nڽ\a\xfc, \xa4|\x1e\xf8Y@\x01\x96\x83Yr\x92F\x81\x80\xe4\x00H$\xf1\x0eu)\xa3 \xcdR\x1e\xach\xccju\xdfy(Id\xb7\xe7ex\t\xbb=#\x05\xdd\xda'\x85c\xadg\x02\xb7\xfc\xac\xb8\xd5K\xf7\x18\xfaZ\x9e\xc5\xe2\x84\xe7\xd2;\x14ptkoN$N\xed\xedV\xebf\x9d{\x94GA\x83t8B\x15Rղ\xdf\xe6\x9c\xf1v\xe1\x06\xa5Ӻ\x99\xc8\a\x80E#\f\xdd4u\x9d\xb0\x05\x15ͦ&\x12O\xd9'\xf4ٔa\xec\xe24?M\xa62<\b\x05=u\x98q\x8e\xd1ߦ\xea\xd43=\x9f\xfa\xa7\"}\xecn\x98\xce\xf8e\x00\x04\xe1\xdd7\xfe\xd4mD\xe9m\xb9\xd7ū\xee+\x1fL\xe8\x00\xe6+\xb5~\xd0m{\xa7\x9f>2\"\xd2~\xdaj\x15\fV\xfc'\x84\x8a\x18e\x9c\xb9?\t\xaf\xdd\xf1\xcaF\xde\xf1]\x84\xa0\x9f\xf0\x8e\x03\x9aC\x93=\xe3l8s\xb4\xa1\x8e\xb2,-\xbe\xf2\x9e5\x1ea\xd9\x7f\xedU\xa2\xf1\x1a'І\x8aP\x80q\xf5V\xa8\x92\x16\x1f\xab\xee$\x14\x1c`\xfa\xf0:\xd4awAma8\xa2S\xb6٥\xa7\xac\xe3[4X\xb4\x1f\x7f\xfd\x84;\xa7\xd6n\xfa8uG0\x92\xb4t\x1e\xa3\x9cVN#`麳\x18eԞ\xc4Hxk\x12\xd3\xd2\t\xd3\xf6\xf1\xf6|E\xb3\x95\x87;ά.N\xdal\xcaf33\xf4֧0\xa7\x92&\xa4Ͻ\xa3\x84\xf6\\\x8e\xc8\xf0E\xf2\xce\xcc\xefo\xcf\x18\x98G\x9a\xba\x9d\x01\xac\x06\x90\x90\xd1\x19vb\xfd0\x9eW\xbd7\x8d\x87\xa7#\xea#A\x88~\xe0\x05\xa7>C\xcf\x1fG\xc4YZx\xb7b_\xd4Qe\xc6x&\x81p\xd2s\rU\v\xa8\x97\x9a\x8b\xc6c\xb7\xa8[\x19u\xed:. 茝\x84c\xad\xa64mu\xe9\xa9}\xf9\x16\x0eY\xee\x1f2^{`\x00/%\x10\x1e\x02O\xc2̅\x1d\xcb0r\xab\xb1\xac\xd0\xdb\x1ef\xa6\xd5\x1d5\x9bꯝ\x9fJ\x02\xb0\x91&\xb7\f\x8d\xac<\xc6\xc2\x19+@>\xb9\v\xe4\xfd1\xce\xfb\x98\x87\xa7\xad.\xed\xd03/p\xfd9;\t\xa1\x95\x84\xf25\x88B\x0f\xf9\x9c\xac\\\xad+\xa8\x95ߡ֕\x8e\xfb\xc0NW\xa7\xad֪\x7f\xfahl\x10\"9\x0f\xf1Y5Šl\xc5\t\xbcb\xfe\xaa\x8d\xad\x06_2\r\xaf\x9aͫ\x82\xe3\xe8cv\x12^\xd1\xc2n\xb1\xdd\x1f;t%\xc14\x7f\xa1\\\xd2\xe3\xc4\xea\xd7҂\xfaa~\x9e\xfbW\xbb\xc8.\xf2:\xfeR\xe9)|#-[b\xddp\x9fu#*\xac\x9b\xb4ȺQ\xf7\x9f\xb7\x11H\x81Z|U\xb4\xad\xf4\xeco-\x05w\xb0\x90\x99\n\x84E\xdfз\xe0tpg{\xb7C\xe8K\x16\xbego\xe9\x1b\xb0\xa9\xac\xa5\xe25\xa3@=U\xcc5\xb1\x86\xb9\xf6F\xbf?<\xfeA\xcd\xfbc\xa6\x1f=\x86\xa7P\nC鶱\x1cX>\x85O\xd7G\x05\xa6\x8b\xa1̆\xdf@\x9bC\xac\xfd-\xf3*\x8e\xbe\x19\x91\xf5\xd9\xdf8\xc4\xf1M0\xfa\x06\x02\xbahz\xa9\xcc \xf4\x1cՌ\x97˱\x8a\xb3T\xa2\xa9&\xce\xfb\xcci\xb3\t\xbc\xbfu\x14݉k\xedl\xb9<\xc3hL5\xef\xa4+W\xec|Ѝ\xce\xe9q\x81\x9a,2L\xe8a\r\xa9y\xe4Z8\x84\xf9?\xa4\a\x86\xf0C\xa2\xefS\x85\f|\xe2\x06\xf1\xa9\xd9\xfcD_\x17\x88Ϸ\xae\xbd\xd7\xd0\xdek\xfa\x12N\x88f\xb7Ч\x8c\xb7\x15Å\xbep%\x9f\x0e\x82\xb9\xe0A\xf4\x94>f\xbc\xad\xf90\xf4\x99+\xf0x\xa0ޙ\xd1c\xfa\x97\xde\x1e\xf4\x83\xcb\xfek\x10\x96\xbdR\x0f\x04\x84\x14\x11\x18c%\xfa\x8b~f\xbc\xed\xb3s\xe8Ϭ\xa2\xf4\xaa4\x83\x8d\x8e\xab\x0eȡ\x02\xc1\xa2\xff\aŘ\xb8#\x8b'\xbe\x95\xc5\x03\xf7\xa5\xa2\xfdb\x9f\xf6\x13,\x1e\xa6#*m\xe8\x1fA\xf6Yg\xb9\xach\xd9j|z\xf5<?\xb4L\x1e\x13\xdb\x05\xbcr\x87\x99\xd1Q\xb6\x94A\x066\xc9\x17\xa4\xff\x99}^.\xdf\xe3\xf8\xee\xb1ɠ\xfcl~G\xaf\x8b|\x86\xe8\x98Z6D\xb40\xd1\xfe\xc1\xcc\xda#\x92\x8eꞭWԱ3\xa2\x0f+\xe5\xea\x9e\xfe²\xf6\xe9\xecc\xbe\\f\xc3o\xe6bͱ\\.\xaf\xc1\x19\xe2\x89\xc8\x16\xe9\xe4I6\xcbD\x14\xfc\xcft:\rV\xf4{\xf6*\xfcL\x06\x014\x12D\xc1\\$\xf9\xd9\xc7<\xa0?\xb0\xe9\xa0\u0083\xf8y-w\xe2\x17\x9a\x90\x15\x89\xeaj84\U000f3eee\x06\xee\xb6\xfd\x1ec{\x9a_^\x19\x12}O\xd7t\a\x9d\xe1\x15]|\xe5,\xbcWΓ\xc1\xf5\xc5i\"\xf9\xbby<\xe600\xbe\t\xa7/XE\xe5\xf4`E\xe8\xc6g\xb2\x86\xf3\xf1\x82\xfe@\xef\xd1r\xea3\xba\xa0\x1f\b\xe9;O\xdf\a\xcd\xe6\xcb\xe5\xf2\tl\x97\x03\xb6\x81\b\xff\xe5r\xf9\x1c\xb7Ə\xac\xee\xd1\xfea5\xa2\xdf\xd5\xfa<,\x9c/\xe1_\x0e\xa99\xb3\x99\v\xbf\x01\xa7\xfcgC\x02\xbe\x82\x87\x8a>7u\xf7\xe2\xa6\xf6\xfc\x90\xe4\xf2\x85n4\x0f\x89=\"\x92\xac\n\x0e\x12\xcc{I\f\x8c\xc3?j`уYE1\x84\x045\xd7\x1f\xf2B#Y\xbc\x0fÔ\xac\xc0Q\x9dy\xc74\x9b\xaa\xee\x86װ\xdf\x06F\xaf\x8aL\x8f+ϟ\x9dB(6c\x15^\xfbs\x15}v\xd0I</\xd1\aZ\x9e\xa5\xe8\xc7\x15\xe9k\x83\xa6\xef\xec\xcc6\x9b\xe1w\x8a\x90f?*\t\xc0\xef\xecc\xf8\x1d=\xa0o鄞\xd0+\xaa\xb3\r\xc9sE\x8f\xe8\x13ҿa\xd7\xd4홍\x93f\xf3\x1e}\x19^\x03\xa5\x13\xfd\xee\xd3&Y\x856\x99\x02\xa7yE\xfa/ۂ\x9f$\xb9\xe4\xc2,\x19{[I\xb2.\x11^\"\xf4/\x90\xf2\xd8ڶ\x0e\xba\x1f\xa3I\xc7\v\xa2\xcd\xff\x9e\x81\x12{\xaf\xd3\xdb1\xf9\x7fa\xfe3\x93\xff\x014\xdf{\xdd\a\xbb&\xff3\xe6\x7f0\xf9?\x83>|\xf7A\xf7\xa1ɿ\x87\xf9?\x9b\xfc_\xc0\x98d\xfb\xc1\x9e\xad\xff=\xe6\xffb\xf2\xc1\xb0p\xab\xb3\xbbg\xfb7\xe6\x85:\x1f\x8d\f\xc1\xf2\xc4\xe4\x1b[Ð\xd0\xdf\xd85\xa0\xab\xc8124?+;\xe7b:\xcb.~\x8d\x82x!3˺\x8d\x82N\x1b\x98W\xd4a\xc1(\xf8\x9f\xad\xad\xad\x80\x8e\x156D\x94\x10\xac(\xe2\xc1\xcd4>\xe3At=\xcdR\xf9\vWl\xae\xe3l6\xb1\xf9\xb9\x14YzrS\tu\xc7b\xbe\xc2\xe9A\"\xe3Y2\xb6\x1d\xfe\xcf\xdeޞ-\xcd\xcf\xe6\xa7q\x9e\xe4\xb55L!\x19C\x8f\xa6\xfaNo\xbc7ݲ\x99&|\x9cW\"\x9e\xf0\aS\xaf:?\x9b\xcfb\xc9\xefP\xd4\xd8@o&\x93;\x14B|}C9e\xf9\xe2\xe5\xf7\xa6\xe3\xb8g\xf3\x8f\x17\xb3\x19\x97^\xfedkgkǍ\f\xf0\xa6\x97;\x9d\xc6.+\x91\xb3uy9W\xec\xb5\xfa\xdcXJ\x91\x1c/\xe4\xbaڟ\x17\xd9\xda<\x88\x11!\x8f\x92\xf4\xa6\xec$5{\xa8\xae\x88f\xee\xae\x1dr\x8e\xa4\xcd\xfa\xfcO\xfc\n\x82}\xfb\xad\x8fǽ\xbd\x9a\xb5)\xee\x99R\xa9\x19\x9a\xbe\xcc\xd6\x170\x9c\xeb\xe8\xban\xd3b̞\xe2\x14㉪\xa14\xc6\x0f\x8f\x1f\x1eۊ\x82\x9f\xf0˹Wm\xbcs\xbc=\xedxp\xa5\x9f\xd6\xe7\x9eq\x19\x17@.\xb4\r\xe7]\x03U\x85C\xef<ꃻZ\xf5\x9fVpi\x18|̳4\xa0\x7f\x11Z\x9f\x19\xd0\xc7\xf5Y\x97g\xb3\x80~\xaeϻ\x8a!\xf3\xfb\xfaL\x15\x94\xef\xc7\xfa\xcc\xe38?\r\xe8\xbd\xfa\xccyv\xc1E~\xcag\xb3\x80\xfe\xbe\x06\xe0\xf8<V\x924\x00\\#ԟ\xd8u|\x12K\x1e\xfdFc!\xe3\xe8\x1f\xc1\xa9\xbd\x9e7\xbfq\xec\x9d\xc7\xc5q\xee\xef\xa48\xbe\xf9\xacN\x03z\x03r-\xef\xcb\xed\xed\xed\xb5g\xd7\xcf+m\x1e?\xab\x82\xa7\xa6\xd3\xf1x\xeb\x86CYʯ\xe0\xb1R~eח\xf2+H\xa1\xd3\x19\x8fwv\xeav\xf6\x9a\x125\x88i\xab\x17ǜ߆\x9bJ\xa5\xaax\xa1T\xa0\x84\x92˹7\\4\xa5\xa2uh\xb8\x02L\xfai}n\x15\x13\xee\xeclo\xc7\xf1m\x98\xb0T\xaa4\x1f\xeb\xdaH\xee\xd2Q\xf9:,\x95+\xdfY\xc7\xc7ݮ\xb7\x865sV*Q\x83vK%\xec\x02\x14\xc7\\n'\x1b\xab\xfc\xbfA\xe5\xdcL\xb7\xac\xe8Y\x96f\x9f\xe2\xe4\x1fB-\xbb\xbd=\x1f\xbbL&\x935\xb4\xd1\xf4aog\xb7w\xd3u\xa9\n\xac\xc70\xeb.\xd0\xdb\xea\xd5\\\xa9\xb7vefy\r\xf4e:\xa2\x98\xab\xe9L\xb7\xd9&\x0f\xf9\xd4\xe5\xc2^l\xb4\xeb6^\x91\xe0\xad;\x88\xc7\xd3݇\x93\xe3\xf5\xf8\xaf\x94_\xc1o\xa5\xfc\xd2A.\xb7^\xa1\x12wx\xaf\xc7\xd7c\xd7R~\xe5z)f\x97O\x9dξi\x03\x94\xaf\xa4[\xabx\x87a\x1d\x14E\xbcyk\x8b5\b\xbd2-\xb5\b\xbd<9f7\xc32ߡ\xd8<\xe7\x8bI\xb6\xbe`\xcdUT*Q\xf7\xc6(\xcdE=\x92ZW\xea\xf6\x06\xabD\xc1\xee\x83\xdd\xee\x03\xbe\x96.(e\xd7\xe0\xd5R\x89\x12\xf1P\xae\x7f;>-\xdc#\xd5b\x10bEL\xfe!dy\xb8\xb5\xbd\xddq\xc8\xf2\xe9\xde\xd3\xc3Çk\x8fK9\xbb\x1e\xfd\xedu\x0f\xbaO\xba\xb7܋{\xcf\x1e?y\xfc\xf8.\xb8\xb5|Sꚷ\xed\xdau\xc5*\xbbvo\xefI\xe7i\xe7NO\x03qr\x1c\x87ݝ-\xda\xe8>\xec\xd0Fw\xbbC\x1b\x9d\xf6\x03R\xb7;\xd6T~إ\x8d\x87\xbb\xb4\xd1\xed\xec\x94\xea\xd6\x1c\xe3\xd2\x18J\x88\xa1\x94{\xcb<YM\x92\xb5#7%\x1a\xfb\xf5wA\xb9|\xf5\xc2,\xad|\xf5\x9a+o\x8d\xf2eQʯ\x10\xbb\x8f\xb7\xf7\x0e\x0f\x9e\xae\xbfL\x0e\x1f?y\xbc\xf7x\xfduq\xb0\xf5\xf8p\xef\xc9Z|_\x9a2\f\xa5\x98\xaf\xdf\xff\x95ۦ\x04~\xe9\xd6-\xb5~'n\xce4\x13g\x8bY|þ\xbe\x95\xfa\x82\x1b5:\x05\xbc\x10D\xd7\xc0O}\xcaǙ\x0e\x0e\x8c\xdeN\x04\xf0\x12\u05ff\x8b\x9e<\xd8\xd99\xb8\xe9QU,`\x11\xdc\x1a\x80K\xe8\xf1\xc1\xe1^\xf7\xe0I!\xb7fcՕ\xbamqo\xc6\au\xa4Leyko\xcd\xf2\x1e\xbd1\xb7J \x94\x0eэ\xa8\xb3\xe6:+\xc1x\xd3ͷ\xaeh\xb1K7\xb5\xf1q:m\xb4\xd7Ύ\x03\xdc+X9\xc1\xee\x04\xc6\xf3x|\xca\xef֠_\xb4f\xca\xec\xac\xc4b\xb2HҬ\xd1v\vT\u0098\xae\xcd|\xce\xc7\xf2c\xa3mw\x8c?5\x9d\xbd\xdd\xddB\xb1\xdbq\xde-\xb3c\xb7\xd7x\x96}\\\b3\x9a\xf2\xf6\xb0\xcd\x15\x8b\xdd0\x8f\xe3\xec\xf3]\xc6;\x9e\xcf\x1b\xed\x1bN\x87\a`\x9eߩA[\xacz\x1e\xdd\xfcM\x92\xe9\xb4~\x8e]\x87\xfc\xaeSw2\xcbgw\x01\xedDd\xd9\xf9\x95\xd7m\x94f2\x8c\xa6\x89\xc8\xe5&*E\x90Z`O\xe3K~ۆ\x00&\xd8mef\x93\xe4n\x03\x9a%\xf9\xfc\xb6\x8d0[\xc4w\x19\xf3Y\x96\xa5\x8a9w\x97\xd2\xe9I\x92^\xde\xe9\xec\xf9%\xabG\xcfa\x87\xf9\xf4.\xfd\xceE6\xe6y\x0e\x8ec\xefP:\xbfi\x8f\xb9S\x0fүŝ\n^$Sy\xdb\xe2\x9d'g\xb7\xc2Fkod\xe0\xcc\xde\xdc\xfa\x8af\xc7y2I\xe2\xf4\x1f\xa2\xcf\xf7z\xc7\xf0\xf63\xfd\xf0\x0e\xef\xf1\xed\x1b(\xb0\x87[\xe3ݝ\xad/gY\xdcZ\xafJ\xcbݽ\xab\xa4\x06µ$\xdet:\x9e\xf4z7\xde\xd3;;{\xc7ǝ5dV\x91cqg\xd6Fe\xeb\xab\xec\xf5\xa3\xabО\x93\xad\x87\xbb\xdb\x0f\xd612J\xb9e*\xe8\xc1nw\xaf\xb7\x8e(\x18\x1f\x1fǓ\xb5\x04\x87ʽ\x89=P\xa6R\x8b\xed\x95\x1fy\xa5\xec\x1a~E\xa9D\xf9Yr;@\xd5cW\x02iݛnM\xb1ꛮX\xb0\x86\x13Q*QC>\x95GYO>\xad+u{\x83\x95\x1b\x9a\x8fww:\x9d\xf5\x8f\xa3R~\x8dح\xbb\xc7\x1f\ueb25\xe4K\xd95\x9c\x8cR\x89\xb5O\xf0\x83\xce^\xef\xf1\xd3/\xe0i\x98\xa3\xf75L\xe4\x15\rdv\x96\t\x91]l\xa6\x90\x11D\xd7\xebf\xe0\xe1\xceý\x1bf\xa0\x94]\xb3F )\xa9\xe3\x91ߡh|\xb2>\xb3tfK\xb9\xf5\x88r]\xa1\xf2b\x94\xcaUŦ\xc5\xfc\x9ae/\x03[\x91;\xf3\x87[\x0f\xa67\x9dߺ\x12\u0557S\xa9T\xf5J)\x15(!\x96Rn\xe5u^\xca/\xa1\xd8J\xe7\xe9\xa7\xf5\xb9\xb5\x82\xff\xcexg\xf7\x06A\xe0\xf1\x83\xe3\xc9\xce\xde\r\x8c\xf0b~\x05-\x97\xf2kpV\xa9D\xe5\xa9ҍ{\xc7\xfc\xa6\xa7g\xb1@\x95z8\xee=\xdc>>\xbe\x8dV\xb0\xa5\xeeH\xe1\x14(\x9a\xee\xa4;\xedu\x1dE3~0\xde\x1b\xefTȠ/b\x92܄<\xc0\xbfu\x16\x92\xf0't\x1a\xcd\xd9~\x12\x92\xf0W\xa2\xf46\x7f\xa5\x9c\f~\x1a\xf2Q\x84\ue8f3\x19W\xaeu\xff|\xcb?/x.\x1b\xa8w\xd5\xf8\xe6\xde5_}\xd3Hrt\xb6\x1b\x9f\xc7\xc9L\xb9\x0eUZ]@\xecj\xed1t8\xca\xe3ɟ\x84\xfeFV\xf4a\xa7\xb7ݻ\xc5\xf7\xee\xd9_\xe8rw\xcei\xf6\x1d~M9=x\x86_\xa7\x9c\xbe\xf8\t\xbf&\x9c\xfe\x98\xe2\xd7\x19\xa7\xbf\xcc\xf0\xeb\x84\xd3O?\xe0\xd79\xa7߿\xc1\xaf+N\x0f\xae\xf0\xeb\x98ӟ\x943\xdf\vN\x8f\x1e\xe0\xd7!\xa7\x89j\xef\x92ӓ9~\x1dq\xfa\xf4-~\xbd\xe3\xf4w\xd5\xf2\x01\xa7\xcf?\xe1\xd7\x13N/\x13\xfcz\xc3\xe9\a\x05\xdf[N\x85\xf2\x17\xfc\x92ӋS\xfcz\xca\xe9w\xbf\xe3\xd7\vN\x8f9~=\xe6\xf4\xd5\x19~=\xe3\xf4\xf4\x17\xfc\xfa\x8bӟT+\x1f8\xcd\x14\xf4\x9f9\xfda\a\xbf~\xe64\xfd\x15\xbf\xeeq:\xcf\xf0\xeb\x17N\x7f\xfd\x19\xbf\xbe\xe7\U0010d6ab\x1f8}z\x0f\xbf~\xe4\xf4\xe7\x87\xf8\xf5\x1d\xa7c\x95\xfb;\xa7\x1f>\xe2\xd7o\x9c\xfez\x8c_?q\xfaZ\xcd\x06\x97\x9ek\xe3\a{[\x9d\x87%\xd7\xc6\x0fww:;%\xd7ƻ\xdb[{;\x04#\xac\xa6a\xaeB\x98@\xc2\x18\x13\x16\xca\xedkw{\x1b|\"O1m\x0e\xc1M\xac\xef\xe4\t\xa6\x9dBh\x95P\x84\xddnw\xef!\xa1\"\xecm\xf7\xf6z\x84\xd0\x13\xcc>\x83@1\"\xdc\xddy\bM_aڹ\x8a\x8c\xdc{\xb0\xbbW\x8a\x8c\xdc\xdb\xeeA\xda%\xa6\x1dB\x88\x84P\x84[\x0f;Н\b\x1f\xf6:[\x0f\tDG\x80\xec#\b\x84\x10Z\xaf\xcd\"\xdcz\xb0\xd3\xdb%\x84~\xc2\xec\x03B\x9f\x00\xfcݽ\xbd\x1e\xa1\xaf1\xed\t\x81H\xad\xe1\x83.\xc6d~\x8ei\x1f!0\xa2\b{\xbb\x9d\xed-\x88\x92\ni\xaf $\xa2\b\xf7\xba;\x9d]\x02v\f\xed4|\x03J\xad\"\xdc\xde\xdaz\xb8\x05\xf1\x0e!\xed%\x849\x14aw\xf7\xe1\xce.\x048\x14\xe1\xce\xde\xc3\xdeC\bl\bُ\t\xfd\vA\xd8\xedt\b\xfd\x80i\x7f\x11\xfa\x19\x9a\xdeۂ\xf5\xf8\x19\xd3>\x13z\x0f\xcammu\xbb\x84\xfe\x82i\xf7\b\xfd\x1e@}\xb8\xdb\xd9&\xf4\aL\xfb\x1e\xe3\x10\x84\xbb\xbb{\xdd-\x8cD\xd0N\xc3\x1f1\x10A\xd8\xdbz\xb0\xd3\x01\xe5@H\xfb\x9dП\xa0\xeen\xe7A\x87\xd0_qt\x0f\xa0\x19Ρ\xeb\xde\xceCB%Ǣ\x9c\x13* \xb5\xfb\xb0\x03\xc9)|?\xec\xc1\n\xd2L\x15I9\xa11V|\xd8\xd9\xed\x11\x9a\xa8\xe4\x98\x13\x9ac\xcd\x1e\xa4\xce\xe0s{owg\x9b\xb4\x1f/\xa6S.\xb4\xa6͂\xb3@㑀\x8e\x11Y=\rI\xfb\xb9\xf6T\xdcNr\xf3\x89\xda\xec6\x16+\xf7\xacM\xce\xe0\xc7`\x8c\xff\xf2\xb6\xcc~x\x17\x92\x88Gמ\r\xe6\x94[\x1db*@\x17\x18K;O\xb1\xc94t\x8e\x93\xb3i\xe3\xd7\xf6\xef\xedgɌ\x17Jl\x9cU*\x01\x96uI\x80f\x05\x03\xf8\xdf\xf1ϐ\xae0\xae\xa0SN\xda2{\x91\xe4RY\x19\xfd\x16\x92p\xa6\xaa*\xa8R=\x1d\x92\x95\\so\xf8EmϦ0z\xd1\t\x8e\xbe\xfdv8\nh\x8a~r3\x11θld\x10\xfbF\xd53\x0e\xb2!\x04\xa0\x1cf\xc3\xce\b\x9cw\xab\x8ffS\x7f\x18\x9b\xff\xfc\xe5b&\x93\xf9L\xf7\xaesɵ\xfe`\xd7\xe5r\xd1F\x87*\xb5ߨ\xbb\xa2r\xf8\xe7\xbdk(\xb9\xbaw-V\xf7L5\x13M\xef\xcf\x11\xd3\x10P$\xc9xC\xff\\\x15\v\x82\xef\x99ۛʆݑ\xb2|ӭ`\x8a5\x80Z\xb9X\xaa\xb82)\xae\xcck1\xe1\x82O^\xc6\xf3P\x9a\x05Ja\x81V^QY.j\xd7RbQ\xe7\xdb\xda߇j3\fx4䞯\xa4\t\xbf9\xc0\x99+y\xe6\x95\xdcب\x8dO\xe7\n\x9fܹ\xd9\xf3*\x8c:\xe2\xc9\x15g?\x87ީ:\xe6\x9e\xcf\x113u'\xb8\xa9'!q\x93 \xa8\t\x8e\xa1B\xda\xc9\x10\x8c\x88iJ0\xe0ߵoaz\xf1\xc5Mb\x80\x83̵\xd9wы+\xf3\x915\x9bW\xd0\x16\xba\xb3Y\x95\xba>\xf4\xc7-U\xc3.0\xa0\xa0&@,\xc42\x91}WN\xb0\xfd\x9a\t\x15\x03\x11r\x88T C\xe1\a~\xbat\x98\xa5\x0f\x90\v\xe5\xc2\t\x10\x80\x05]\xd8\xd3\x15.8\x19,xt\x81\x9bl\n\xb0\xbb\t\xe0l?\xe8\x05\x8c\xb1\x90\xb7\x82\x80\x80\x03.\xbb\xedH\x1b\x19\xbd\xa17\xbe#n\x8d\x8a6\xd6\xe3L\x834\xa0\x84F@\x06\xcc\x13.\x9f\xa7\x88\xc2$\x19\xc8\b\\\xa1\xf4K\xa5\xdbI\x8e\x95\xc0\xd4)rM8 \xde\xe1\xf0\xa1EI\x05\x1bޟ&3\x0e/\xa0?\xbee\xc3\x7f\x7f3j}\xf3\xc7ŷ\xdf\x04\xe1\xf0\xdf\xc1\xa8E\x82\xfe\xe0~B\xeb˄\xc3\x7f\xf7G-R,\xc1\x02L\xfe\x96\xf4\aA!\xdd&\xdfO\xd0x\xfd]q&\x05\x842a\xa2\xcd/\xf9\x18\x03\xa9\xaa@\xa1\x92\x10\xf7\xddlJk\x81O<\v\x8c\t\a\xdeڇ\xb7\xcfmP\x13\xe5N\xcdYZ\x18\xb2\x19\x03\x1e\x87\x0es@˞\xf7\x8c\xe2\xf6\xe3\x9eW\x8e\xf6\xf0\xdf\xed\xfb\xa3o\x95o\x0e\xfa!$\xe13\\\x04E\xa4y\x81\xc3>qk\xcb\x1e\xab\x856!c\x87#\\\xc6\x1cl\xd8g\f\r\xc0\xc2\x00\x00\xc0\a;\xa1\v\x93&\xf8\xe7E\"\xf8$\x00\x82M\xa7\x9dŗ\xc9\xd9\xe2,\x00z\xcd$%\xa9N\x9a\x98$|\x89\x02\xf9\xa6\x7f\x83X6\x96\x01\x90n\xae\x9d\x178\x83\x01\x90q\xae%\x9bxe\x12\x17i\xf2y\xc1\x9fK~\x96\a@\u07b9\x06LڅWߤ\x1d\x9a\xb4y,%\x17`\xa6\xad\x10\xd7%D]\xd8\x00Ӣ\x05=\xd2\u0381\xf0>\xd6\x01+T\xe4ݍ\xc9r\xb9\x11^.\x97G\xcdf\x80\xd1\x0e\xe0pa\xe2\xc6e\xb3\xb9qDHq6\x0f\x98\vF\xc1&\xcd&\xa7\x9f\x98W\xb1\xd9T\xd8\x13BI\xa8\x9dC\x9f\x14\xf3\xcb\xc7F\x95\x1dg\x8bT\x86\x06\xf6\xd7lx@?\xd1'\xb4P\xb3\x1a\x05\x03\xba\x0f`\xc3\x1b`\xea\xe8\x13\x1a\x1c\xab\x10[\xbaP\b\xc3Fg\xf8\x84\x06\x9a\xa7\xe1r::#I%?\xa9ͱ\b\x16a\xaa\\?&\x1c\b\xe3\xe5\xa2u\xe0C\xacr8\x98\xaf\xf5\xc1|\x8d(nc\x83\x13$\x82`\t>6\x9b\x1b)\xb1Qz\xd0L:x\xab\xb7lc\x9a\xf0\xd9\xc4<A\xe7*\xd05l\xe4\u070f\xba\xac\a\xa1\x97=^.\x030\xa5N\xc6(쿏\xca\xea\x90N\x14\x96\x12j\x9fT\xe1U(\x80\xfd\xf0\xee\xf5\xab\xf6<\x169\x0f\xb9w\xe4K\x10\xbe\x016\f\x97\\\xe8\xd88:\xdc \xc6\xd08\xe6\r\x15\xa6\x03\x9a\x02`\xd1\xe2\xf99\xf4\x8a8\xe74\xce\xfdS\xd9l\x9e\xf0p\xa17\fi6\xcdgH\x9a\xcdqH\u0085\x9e\xbc\x05N\x9e\x8b\xa4'\x86|\xd4lj\x80\xae\xc1L\xf3G~\x15q\x8aH)rsh\f8q\x0e\xa7\xc0\x90\b\xd0p\x9b:`\x9c\xcd|@\b\xf4\xf9ܞ;?G\xc1\xf1\x9c\x86:\x1e\x98\x8es\x94\xb1O<\x04?Z\x94Ӎ.b\xaa\xbe\x06\xab\xddn\x035\x95\xe9\xaa\x19UѮ\f\xb0R\x03\x8b^\xc3\x00\xa6U2\r\x0f\xf5m\xc2l7H\x87\xf2\x8b\xc6[~rx\tT\x9b\n\xa2b\xaf\xb6\xe0g7\xf9\xd3l6\xcb.\x1a\x1a_@<\xa2\x15\t9=D_g\xb9\xf5\xf3\x97LË\x02N\xa8\xed\x14\xa2\x80\xef\xb3\xeer\xc9݉\x7fd\x10\xf0\x9fh\x03\xaczշ{#\x96\x8d\x19\x8f\xc1\xe0\xebZ\xae\x1a\x89\xe4g\xf7\xae\xbb\x18j<\b\xa2 \x0fV\x7f\"4\x17Uh\x8eo\x85ƃa\xbf\x0e\x864sp\x9ce\x827\xe4)Oo\x01\xe4\xd8\a\xe4:\xe5\x10\x81\xfd,;G\x9a^-\x8d\\!xWw\x01/\f\xa4X \xb6\x92\x1a9\x83\xb9\xa5y\xaa\x84\x1d\xfa\x12#\xe8\xfc\xf0\x0ep(\x06\xbe\x94\x19x\x8fAd`\xc7&\xday\xf2\x97&)\xb8\xaa\xf5\x8eK\xa2J\x8d\xf1\x9a4\x94\xb8\xa5\x18\xa7\xc5d\x0e|\xa2\x90\xb79\x04\xf9\xcb\xc9\xc0|\x85\x82D\x1c\x8e\x0e!\xd8\tx\xde\t9ؔN&a\x8a;\x90*\x8f\x05\b\x82\xf7\x1e\xb0\x8f(\xbd\x83\xd1$\xd4\x1d\xb6WYc\xb2P8\x87\xe7\x8d\x18\xb6 \x9f\xb4\x03\xd8\xd6m\x99\xe1\"\x85d\xb5\xc29\xbf\xf2\xe7\xbc\xddn\xab\xf5?Q(\xf8\xa4vj+\xcb\xfes\x01ۤYc\x96\xa5'\\4\xe4i\xac\x97||\x1a\x8bx,\xb9\xb8w\rF\xadr\x10\xe4A\x14\x98u?\xa9n\xc0\xf3\x9bz~\xb4\xa6\xe7\xe2~\xbf\xb9\xcf\xf3j\x9fs5\xe8ym\xd7\xebF;\xe3y\xee\x06\xaaڞW\xdb>Um\x9fֶ\xbdn<'h\x93*\xca͟V\x9b\xf7\xe9\x04\xdd\x05\"x\x16LP\\\x96\x9c\xe1A8\x1b \xd2N\xa6a\x92\xbf\x8a_\x85Oc\xc9\xed\xedR\x87\xbf`R\x1bP\xea=\xb4\x00\xbd\x93(X,\x92I\xb15ش\x9e\x1b2\x99\xbd\x00C\xa7'q\xceCB7\xee\xff{x\x1d\x8e\x06\xc3\xce\xe6\xc3xs:\xba\xde[m\xda\xef\xed;|w{\xab!Y\x8d\x06\xf7\xee߀i\x11\xd2\xef\x002\x05\xa5\x05\xcd\x11\x03\x1b\xe5\x88^\x95\xfa\xba \xb6@-m\xdb\xc8\xfbn\xb2\x8dg\x9b\x02\xa1cWUw\xaa0\x0f\x1c\xddf3\x98Ƴ\xdc\xfc\xd8\xe8述#ׂa\x9aF8\xfa>\x99]\x0f\x8aGX\x95!ٸ\xff\xef\xcd\xc1\x1f\x93V\xf8G\x1b\xfe\x90;L\xa2n\xed\xae\x9d\xfb\xc4ۺ\xdeo\xed4m\x98f\xeeڭ\x8f\xfe\xa1\xf4\xa7fs㉫\xc1\x15\xb5\xe2!Jt\x10Q\xa4\x128\xd5tE\xa2\t\xfc\xbb\xd1\v\nۊ\n\xb5`a\xb3Try>`\xe5\xd70\xf6֭\x06\xb6uˤ\x98$\xcdFy\xc2K\xee\x04\x80ѐ\xe4\xaf\x0f\xdemE\x82mt\xe9\xf1\xd5<\xcesC\x92=9\xe5\xe3OQ\xca6\xba\xab\xaf\x0f\xe3\x88N\x0e\xca\x0f\xbc\xeb||\xca\xcf\xe2(\xa6sC\xa0\x82s\x16\x9eʗ|\x92\xc4\x10J.JVp\xa9\n\xae\x1c\x8dX0W\xf6\xe9\xff\x89\x87\x92\xc64\xa3)\xb8\x92\xa0\xaf\xbd@\x94fJy\xfb\xf2l\x06w'\xfc\x85h\xceԦ\xaa(z\x8a|j\u07fb'\xf8\x14\xcb\x01\"\x00G˸\xf0\xf0\xe1(K\xf8e\xe4~\xf1\xccyV5+\xf4ͣ\xc1\xe5٬q\xceE\x9ed)\v\xba\xedN\xd0\xe0\xe98\x03\xd1\x19\v>\xbc\x7f\xb6\xb9\x17\f\xf6\xffH\xff\xb8\xdc\x1aoln6~}\xf9\xa2\xc1/\xe3\xb3\xf9\x8c7\xc6q\x9af\n\xb7+G\b|\xd2o\x88,\x93\r\xedϯ\x01\xf0\xc2\x13Æ@lln\xfeq\xb9ſQT\t\x0e\xc1l+\xfdS{\x12\xbb\xffǻo\xff\xb8\x1f\xfe\xf1\xaeE\xee\xdd'}7|\x06\xfc\x02\xe3\xea)\xecП\xdag\xfc,K\xfe\xe2\x93'x\xc1\xfc\xfa\xf2š\x82O;\xa1%+\xfa\x91\xb3\xe15\x84!\x8f\xf0\xc9r_\xc7TV(>\x99^\xc1\xda\xe5\xd1Р\xd6\xd1jD\x9fs64\x8f\xa0\x11}U\x8c\x0ekN]\xa1\xfbw\xd8\xe93\x91\x9d\xbdÍBt\x80R\x1a[\x86\x1aM\x18p\xe7>\x9a\x83\xfc\x91[z_\xb6\x01>\x1b\xc1p\b1p!\x10\xael\xd7\xc1:\x8a8\xa1\xcf\x1d\a\xf6\x87\x90\x84\t\x1eh\xae\x9ec\x83Opԑ\x1dC{$\xcaV\xf4}\xfd\x10^Y&\x88b]\xc5}x\xaa\xc5,\xe7\xed\xdfߴ'\x8b\xb3y\xa8>gY<\t3B\xd1\x0f\xaar\u07b9\xd9]\xd9S\x91\xf3\xf6\xc1ފ\xa0o6\x00a\x18[?_\xa3f3\x8c\xd9eH\xc2X\x8f;\xa6\x1d\xea\xf2I\xf5)Xf\x02\xd1\x00\xbf\xa2\xc6\xd8F(5[\xae\x81:tzK\x06.l\xb1c\b\xc9\xfb'4h4\x02\xb2\xa2oxA\x04\xa06\xdeW\xfa\x8c\f\x82\xaf\xf7\x19\t~w\xaau\xb7j\xebn\xf9u\xb7\x8c\xdf\xfe\xbes\x06\x86\xe48\bf\x88\xa6\xb8\x95\x90\x86\x80\xa3\xb4\x13\x1e\xa66/e\xea\x1b\xf2\xee_\x9e\xcd\xf4=&\xc9\xe057\x9e\x00\xef\x870\x9f˫\xb3\x19q\xd9\xefU6\xec\xe6\xe8\x95\xfb^ѷ\x9c\x85\x86\xab\xccA`\"\x19\xdc\x01\xb3L1\b\xda9\x8f\xc5\xf8\xb4\x80\xebu\x90\\\xa0_\f\xd3@\xb6QcL\x84]\xebK\xb7\x19\x10+m\x01\x19xC\x90\x8a\xf7\xa4\xd38\xf7\xdc2\x19\xf6\xa4T\xa1`\xe1\xcdl\x1ac\x01\xa1|X\xcbz\x840\f\x88Q\x9a\xcdu\xacIp?\xe59f\xa4/Q\x8e\xa6\xc9Rǁ\xf4\xef\xc1\x19\x1f\xf0h\xc6uLS\x8f\x98\xa4\xc1BN7\xf7\x02B\xa5K\x05+{\xbe\xb3\r\xfb\xf3)g\xd70\x1c\x9c\xbd\xfc]&$\x17\xd1u<\x9b\x9fƑF\x14\xdc2\xedN\x03\x823=\xe3\x00q,x(\xfd<BUT\xd1RE\x95\xb8\xae\xaa\xc9%+*\xe3\x93u\x10\x94\xaaBP\xe0\x17ެ\xb0\xe1\xc8.\x9e\x80\xc5\xd3G-U\xe1v\xed\x1eO\x9b\xcd P\x7f\xa5\xba\xfe\x87\x82\x06,\xa0<\xad,EJ܉\xfeW\xaf\x03G\xba\x15\x90\x91\x0e\x1c\x118יR'5a>\x1f{\xf7\xeb\xc6\xc6/!\xd1\xfc\xeb\uf01a\x1a\n\x88\x05(@\x0e\xe0\xa44\xcf|\xc9O\x85Ԇ\xbd\x00\xcc<x\xe9\x87\x1d\xfa\xa2\xfd\n\x05>\xb6\xf6_\xbe\x90)\xdc\xe0\xcb\xe5\xeb\x02\xdd\x16\xe0̝f\xb9\f\xb4K\xb0bv\xb7\xb7\xdb\xee\xb4;\xed\xae\xceV\x1e\x8c\xa1G\xaf\x93\x0f܈/\x8br\xb4v\x92\x17\x85j\x1e缯h\x06\xff\xe9\x8dɆ\x81\xf0\xbcB^\xc2J\xbf/<\xfd\x83\x1e0\xd4P\xb0\xa46\xcbX\x91?\x01\x01Gbĺ\x15%\x84\nC?\x19\xe93Y.\x8b\xc0\x86\x84\xa6,\x14\x95\x86ʥ\x88\x95\xf7hԥ\xbb\x19\b\xf4C\xe6\xfb5\xd5\x04\xe3g܉U\x1e\xe4rY8\xa3\xea\xf0\r\xde\xfa\x03\xf7\xf6\xd8\x1f9l\xb1\x7f\xf5:\x01\x01L\xff36*yH\xc2\xcf<\xe4\xd5\xcdx\x04\a\xee\x1e\x96\x9a\xd6\xce\xe5\xfd\x7f_nZ\x8cJ\b\xfd\xe5Ʋ\x9aǶ\xb4B\x80\xa5\xe5\xfc/\xb5\x80a\xa9\xa5\n^\xa3n\x1b\x7f_\x90\f\xaa\xc3\xf7\x95w\x15 \xf8\x8d\x8e\xcf\x14\xf6σbګx\xd5Z0 ˢ\xf4\x98\x81\x1c\x11C\xc1\x98\xf5\x1a[!eL\n2\xbak `d\xb3\x99\x86\xf1\x90\x8f@\x9bJ˲\xe1g\x04\xff\xb0\xef\xb9\xca\xc3+\x88\x10\x1a\xbb\xa3\xf1\x03\xbf5\xee5\xef\x1b~\x1f\xec\xa7ҍy\x13S\xde\x17c\x01u\xc5\ru\xa5\xa9\x17\xe7\x01T\xe3\xf5\xa2\xecJc\r\xff6pp\xff\xe8c\x1d\xfb\x00v\x9eʽJ\x91'|\xfe\xce\xc8\auՃ\xd9,\x92\xf0&B.\xd9\xf7q~\xcasx$uV_\x1fI\xa8o\x10\x8d\xc20\x1a\xb5\xb8\x10\xda:|6\xbe\x89\xdeg\xcf'<\x95\xc94\xe1\"j\b>\xe6\xc99\x06\xb9\x87\x88\xd8\xcfϠ\x89\x86}<5⼑\xa4\U000c5d02\xa7\xd4`\r\xd4\xf2$\xee\x15\x96\x80pJ\x85\xe6w\xae\x8cq\rO\xe3\xfc\x14\x1c\xac5\x9bY\xb3\x996\x9b\xe0\xb1\\]%\xa0߰j\u07fbNWXh\xf3\u07b5+\x1d\x92՟\x84\xaa\x1a\x95\xe2\x7f\x12\xaa\xd3RB\xe5 \x8e\xe2ag\x04\x88\xdf\xcd\xfb\xef\x85\xd3e\x80\x87ՠ\xdeJltܻ\x0f\x85\xd1\f\x15%\xacZ\x04lw9\xe4#R<\x01vU8\x01)\xb5\xeb\xf57\x1e\xda-\xf6+\x0f3@F[=RCHx;\xeb'_P\xfa+\x0f\x13\xa8\x15\xe4\xa7q\xef\xc1N@ڋ9\xb0\xd4\x00\x05M\x92\x13@#um\xfc\xca\v\xb1\x9a\x1c\x8el\xdd?Q1\x86\\\xd2}\x8d\f]\x12\x83\x94\xc0hEp\th\x0fnƍp\x03\xf5\x84\x96\xcb\r\x90\xaa\x1f\x9e\xcd\xe5U\b\x84G\xefAwo\x9d\x8a\xa3\x85)]\x13\xa1\xdf\x1dҍ\x8d\x9b\xc5M(P\x065\x96\x81r\xba\x84\xf7\xc9J\xebP\xbeF\xb4\x97\xae\x00\x1c\xd0ߺE\xe5\xf2wS\xdcn\x86\x8a\x03\xedkC\x14\x03\xf1\x7f\x9a\xe42\x13W\xf0\x99\u0379\xd2ü^\xd1\xf1,˹\xf9\x01\x8c\x94\xc8kf\xa5\bg\xfb\x94v\b\xe2\"I'مCp0\a\x9c\xa9Ծ\xf5\x8d\xdfȦ\xc3\x00\x1a\rh\xf0x\x96\x1d\a4x\x96\x89\xb3\xa7\xb1\x8c\x83\x11A\"[U\x01\x9c8\x94#\xdd\x00\xc6p\xb9U:\xcf!p=EE\xb6;M\xd5\xd8ә\xd4j\x8e\x05\x9dI\xadXXЙ\xd4\xea\x7fFgRO\xf5\x82\xcdP%L\xb6\xb3\xa9\x16\xabS#M\xa7\x9a\x17F\x9d\x16\x9c\x15\xcfӀ_\x8eg\x8b<9\xe7/m\x92\x11\xd4\xfb\x996\xc9\t\xe4\xa9'\x87\xa7V~N\x9dĝ:A;-\x88\xe7i\x00\x818 _kx\xbd\x9e\x06\u07b5=6\xf8\\s\x8e\xe4\xdfEܳ2\xe2֏0\xfdj\xd7\xd9!\xb9\x81\xa7\x85Ǣ\xf0\x84\v\x8e\xb3\tr+=\xf4<0M\xea4\xf5+\xa0\xb6\x87[\xbb\x88L\vY-M\x94\x14ĵ\x92\xdcޠ\xe21\x15\tL'2+\xe5X@\xafW>ͩ\xf5\x82\xfa\xc5i3\xda=CW]R3\xe4\xd1\xdd\xc6,W\xab\x9aF\xedđA\xf1\xb7\xd7\xe8\xddWm\xb5\xa2;\x9d\xad\xeeݰ\xd7\xe5-j̽ރN\xe9D\xf6\x1et\xbb\x9d\xb2\x16\xf3n\xb7W\xd2c\xd6:\xbf\x05=\xe6nokw\xab\xa0\xc7,\xc2\xee\xde\xf6\xc3^IyY+\xeb\x1a\xe5e}菑\x1ag\xfbFe$Ç\x8a\x13\xe2\x02\r\xa9?\x9bͤ\x96u\x8et&\xbe\xfe腏\xad\r\xbe䕓G%\x03\x82GI\x1cQ\xc8\xda\xe9\x8bG\xbc/Z-\x02-y\xa7Qx\x8a\x92}e1x\xd8\xe0\x97\x92\xa7\x93<<\t\t\xb9VTm\xc8݆\x04}R\x14\xc1\x9e&\xb9\x86\x16?\xa1\xa3\xa2\f\x16\"#Z\xca\"_̹h\xeb\xe6\x04Y\xc1\xa6\xf9\xa7Z\x85\xb6\x04Y\x81\x1e\xc3]\x9a\xec{\x9e~'նW+\xa3_\xf4\x8f\xf1\xe0.\xd4~\xb8~\x02\xd6\xfe\x91X\xc1C\xa3\x7f\x15\x926&\xb0C{\x1d_\x85\xda\xf3\xb7\x1e\x9f+#h\xbaZ\xd1݇\xbbڮ\xa2rHd\xfb\xf8Jr\x85\xedk\x9c&ϴ\xbc\x1d\xc2;\xa4\xc8J2}l}\x1b\x8aVJ\xeeoo\xa6+\xe4\xf7<\xbe\x92\x1cwO\xb5\x19*h\xac\x9aJ\x18Л4g1\x04\r]\xe0\x96\xcb\n\x91\x06\xa8\xb04\xccַ\xa1l\t\xe8B\xac\xc2\x0eM(,\xec\x98u\xe8\x9c\xe5\xfb\x9dA\xb2\xb9\x1d%H\x01\xa8\xbd:\xef\x8b\x16\xdb&\x92\xa5Cp\xf5\x1f\v \x86\x0f`\x8dG\x8f\x1eu\xf7\x96\xe5\xe4V\x173zՌ\x1ed\xecTӷȈ.\x86\xe3Vk\xc4\xe4\xfe~w\xa7\xd9{\xf0\xc0K\xd8\xf3\x7f\xf7\x1e<h\xca~\x8f1\x96#ˮ\x0e\xa8^-L\xfb\xfbۅFH\xbf{c+\xddΚ\xa1m\u05cel\x7f\xbfw#\xccv\x17-`]\x81\xc9W\xbf\xb2\x96\xf4R\x11\xa2\x14\x12\xc9X\xfa\xaf-\n\x0f\x19\x9a\xb0\xee\xce\xd6\xde\x16\xcdY\x87\xceX\xba\x99\xf5\xf3G\xb3~\xdeb\t\xd1/\x90E\xc8iN\xf3V\xb2?\x1b̢\xbc\x95\x105\xd4l\x10J\x88-\xb4\xd9\x1d\x99Ǌ\x18\xca\xfd\xfdި%\x86\xf2ѣ\xed\xe6\xce֨\x150\x16\x10\x12\xc1\fg87\xa0\xf5\xbb\xd9\x1b=z\xb4GZ5\xb5\xbb\x1d\xac\xbe\xbf\xaf\xaacK=\xddR\xe0pCl\x99n+K]\n\x86a\x90\x86#\x9a1\x8fD\xb5\x9c\x82\x0fI*\xf7p\x86\x06\xee3\xc2\x7fî\x83\xc7O\x9e\x1e>\xfb\xee\xfb\xe7?\xfc\xf8\xe2\xe5\xab\xd7o~z\xfb\xee\xfd\x87\x9f\x7f\xf9\xf5\xb7\xdf\xe3\xe3\xf1\x84OON\x93\x8f\x9ffgi6\xff,r\xb98\xbf\xb8\xbc\xfa\xab\xd3\xedmm?\xd8\xd9\xdd{غ\x1fЄu\xe0\xcc\x187\xfcɣ\xbc\xdfj%D\f\x93\x11\x8b\x87Ɉ\xa6\xc3\xd8_脌X\xe2\b\xafY!\"\x86nc\x1a\xca\x7fm\xefw\xaa\x8f\xdd\xe7\xa9RBSL\x86vC!\aO\xcei\xa8;\xb0\x13\xd8\x0eԽ\n<1\xe3\xed\x1c\xe6\xd3bKƘh6C\xc1$\xa1CA\x05\xaa\x12u\xa2\xedM\xf1\xafm\xef\xf9\xb7\xd0\xf2$\xbb\xad0\x00\x13Ly\xced?\x7f\x94\xc2\xce\xd9\"\x19\xacr\x0e\x9b~\xa7\xd9\xdd\xd9\xedvw\xf6:\xa4\x05i\xad.\xac|s\xe7A\x0fS`+C*\x84TN\xcc.\bc\x96\x91\xfd\xfd\xee\x9e\xde\x01\xf1\xfe~\xb7\xe7\xbew\xf4\xe7\xceV3v\xfả\xdb\x10\xe90\xd8\f\xfcy\xee\x90\x11\xdb\xe9\xd1t\x18\x1cUӷV\x14\xcdV\xd6\xd1'\x06{\x8b\x10\xf13\x12$\xe1^gg\xfb\x01\xa9\x0f\xea\xa6b34\x9bk\xb3 \x0e\xd1\xc0}\x86\x01\x84j\xf9\x98\xb7\x172\x99\xb5\x93\x14]\xb7\xe8\xe0&\x81\x8atЗڦ\x86ͨl\xbf\x9be\x17\xfa\xa7\x7f\xd2[|\x03\x14!B\xce:vVfm\xe0\u008c\xc3\x16\afz\xfb\xf9\xabwo\x0e\x9f\xbc?zy\xf0\xeb\xd1\xe3\xdf\xde\x1f\xbec\x0f:z\x80\t\xebu\xb7w\xb7\xf7\xb6v\xb6wݖ\xcc5+\x8b\xef'\xde\x0e|\x1b\xa7'\\m\xc3oޟr\xad\x1b\x19|\xd3\xe2\xado\x02\x10\xb5&zoN3\xd1\xc8\xe6\xd8R\x00l\xdf\xe0\x1bb\xb9\xbdА;\x86\x9e%\x87\x96\xb1\xe4\\\xbe1b\x16\xf0\xcdOgN\xecB\xa8\xa7B=3\xf7QAqò\xdcj9q\xd2\x1b\r\x90\xaa\xde`Lن\xb9\xe4푂zWsnO\xdc[\xc3[\xc2T\xd5\xf37v\x18s\xefe\xba0R\xe0\xd2A\x12\xb7\xf0\t\x8bJ\a\xd5Qhم\\.C\xc9@\xac\xb3\x17(\xa5\x86\x1903\xb4\x04\x1d\xb8\xb2u\xa3\r>\xa4\x9f\xd2\xec\"\xb5\xa2\xf6\b\xb4)\xcd\x02\t\xd6Y\xaa\xe0a\x9as\x9b\x87\xc2\xe4e,m_\x88D*\x0eT?\xdbP\xc8\x03D{*\x8aR\x87f\x0eU\xa7+\x1b\xef\x04\x97Zm\xddv\x92\xff\x9c\xf0\vODP\xb2\x8b\xfa.\xe4\xd4\xed\x0f\xefѴv㜆\xb2}\x8c\x8dSE/\xbd\x9eNs.\xa9O<\xd9%\x99\xc2\xf2h\x1d\x10\x13\xb1\xa5v\x96`O\xe0\v\xacfC\xa4\xe5MA\x1bjt\xb4\xe1\rU\xff\xa0\x8dL\xa8\xaf\xcdY\xf2\x89\x9b}^\xdaFA\xcbn\x82\xbe\x9e\x05\xaf)\x82\xaa\xaa߅\xdc\f\xd4\xcf#v\x1e\xd4\xde*\xb1j\xec=\xf8\xee4\x16|\xe2Մ\xf0\x0f!\xa7\x95\xf4Jo\xd5\x12\xf5\x9dVO\xe1\xfa\xe3\x86ȣ|ڴJ\x87\x99\\\xd5\xde\xda\x13\xe78\xb7\xd8\xd8\xebi\xb3i?C\xbb\xc2\x1b \xe3K=-\xb1\xc6L\tGS\x05\xb8\xd9ۥ}\bGI\r6\xe4\xde.\xec,'V{\x12h\xef<t$Yǋ\xd1\x06\xca/\xe3l\x0ea\x92;\xb4C%\x981\x816\xa6\v\x9e\xa5\xdb(2\xde\xedbq\xdb\xce﮿A\x1evH4\xd5\xdb7P\xe0)f\bTk6\x8ba\x8cx{\x12\xcb\xd8\x1d4\x93`\xf6\x7ffr\xb2\xb5[F\xdfij\x1eկ\xb6\xccވ\xe4,\x91\xc99\xaf\xbd\xed\xf8\xb0ZpT\x9a\xfb\xda2\x16#\x12\xb52\xff\x8f\x9d\xcbU\x91U\xe6m\xf8\x8d[6\xbc\xba\x04\xd7\xdf,vG\xa3\x0ek\xe7\x1f\xbar\x1d\xbcs_\xbd\x05\x1ez9t3\xe8D\xb8\x9b}v\xfb\xd4\x7fl[-a[\xb4\xbc\xf1\x8d\xa4]\x05\xa2\x92\xfd\xb4ź\x04-\a\x15\x85\x97\x8e\xfa\xd50\x9e\xa7\xde\x1d(\x1fu\xe0\xa48D\xfdH\xae\x19}\x90!V\xc7Ag\v\x99'\x13\x9c?\x85\xa1\x1a\xc7`\xff\x90\xeb\x19,4\xd7\n\xc5r\xd9!\xebZU\x03\xba\xadU\x1c\xa3\xbd\xd8\\\xe40i8\x05\x18-\xa8rCE7dRI\xa2j\x1a\x15\x84\xd6\x13Bi\x91\x10JW~\\SM\xac\xb15\xd4Zp \xc1\xf7\x8bl\xc8\f\x95\xd8Ǳ\xe4\xfad404\xa9\u058c\xd6\xfc\xe9\x06l\xa0\xa8ѹ\fZ\x89\x93\xf0twH+h\xc0\xd4\xe6\x81CyK^\x17t\xb4\x88<\xad\xf4\xc6=nj)\x82\xe5\xb2|\xed\xb9\xaanI}+\x9f\x8d\xbb\xdc4\xeb\t\xbb\x1b\xf0\x85\xc1\r\x1a\xc6\x12V\xf8ƻ\xad\r\xddd_\xd9\xf5\xd2n\xb4\x9b\xf0\x85\xdbH\xb0\xa5\xcdf\a\r\x17\xcc|\xf6\x95\x91\xecF\x17\xcfV\xbfO\xf2\x8bDKx\xc7q\u03838\x1f'I\x10\xe1\xf7,\x96I\xda\xd5?\x8e\x934\x16WAd\xce[\x1fS\x916\x8c\xcc\xe7\xe6\x9e\xcd\xff\x1eؒz=T\xf68\uf652\xe3|\xb3\xe7juwf\xdckC\xfd\xd4\xcd\xf4\xbe\xd5\x1d\x9d\xf2K\xd7\xf7\xfe\xfe~W%k\x89^du\x05]\xafZ,\x12\xf9\x17Q:\xd8\xecF>h\x92\x85\x01P\xa7%M\xf8\f\xe4\xca\xc58\xb5\x88Q\x14.\xda\xe8\xc2ܺ(er\xb9\x94\x8f:J7\xabC\xa8\xdc\a\x96a\xe9\xfe\r\x8aU\xc4r)\n\xc5\xd4#\xd9K\xa0\xe2\x11\xeb\x14køY\x87<b\xa1T_.\x1b\x16\x93/\x97!\xb7\xe4\xba]YN\xae+3\xf8\x1c\xb9\x9a\x9a>\xb9q\x1d?\x95K\xea\rb\x1eU\xe5\xec\x9b\xf6\xcc\xc7r\xe1\xd2\xea\x1dT\xa0\xfa\xaa=\xf3\xcak\xc6\xdb\x04\xe9\xdd_+\x9c\xf4\xb9\xb6\xa5.m\x8c\xb4\xb81\xce\xcdư\xa4\xe2P\x8e\xfa\xf0\x0f\xaa\x83Q\xf8\x87y\xa8\xf4\xcaE\x98F<\xd6a\x15Jm\xb3[kf(\x06\x18N^\xb0\x0e\x89ľ{S\x0f\x84\xf7\xc0\x8eģM\xfbk\x0f\xb7\x94\xf7\x9b\xd0\xdfC\xc1ZB\xed\xb5lЉ\xb8\xd3S\xa5\xe2Q\a\xd3MZK\x10*\xf6\x1dt\xd7\xde9\xda\xec\xf6]\xc1ͮէ\x17\x8f:Xn\xa3P\xb0\xb3\xaa\x1b\x90\xc4\xe3\xa2\xc95\xe0\xfd\x10\xeaauiQsǓw\xc0\xe9=\xf6\xa6\xb0\xf6Q`Utd\x13\xa8\x04ZCA\xba;\xd1S\xb9\xd4\xfc\xabA6\xb8)ۈZ`գڂ\xb38\x97\xcfk\n\x03\xe0`\xec\xa8A\xaf\u074c\xe7\xf1\xcc\xde\x1e\xe6\xc6P\xe3\x83\vC\xd3\xe3\x1e9\xe5O\x06\"'\xe0\x9aui\xee.\x8b\x99\x9d\xbc\xbe\xff<H\xc1\xea\x0e\x8f\x17c,L\x99\xbe\x83\xd3\xd2~\a\x05Qu\xf2\x18c\xe9ri\x8f\x9e\xfb\xb9i\x7f\x13R0\xfd\xea-\x97\xd2~{\x9b\x9b\xf5h~\x9f\xf5\xe8\f\xfe\x11\xf7Y\xafĽ\xb0\x94$0\x0f\x93\x01\x1c\xa6\x88\xb7\x05\x8f'\x1f\x9e\xa7\xb2\xbb\xf3\xf80\x94\xdf&hL\x95\x19\x8c\xbc\xa9\xae\xb3\x98\x89~\xfc(\xefǭ\x16\xf8,\x81\xe6b\x02\xb6ޡ\xa4ȋL\a\x9d(\xdeԐ\xaa\x14d0Ąƛi\v\x12f\xf6\xae\xf86\xc1\x8d\x8d\"\x1f(\x16o2\xa8K\xa1;\xcc\x01\xa28\x14\xad\xd9~\x8e''ߜ\xa14\xb1\x1f\xef\xb3N?\xde\xdc4:\xc0\x1b\x9d\x12!;\xeb\xa7\x1e\x84\xad\x94l(\x18\x010\xb0\xfb\xe8\x1f\v\x1e\x7f\x82\x11ڋ;^\x99\x19\xf4\\d\x98ՇZ*\xa2i\b\x8f\xeb\x8e}s\xda3*\xfa) \x10](%d?Ág$JYfu\xd9\xec^\x01X\x95t%ݏ\xef\xf7\xd4$\xdd\a/=\xac\xd3O\x1e\xa5Ȁ\xb6X\x0f\xf5P\x9e\xa72\xb4\xdaνo\x13\xda#\xb4\xbb\x83g\xf4w\xe8Q\x0f$\xe9\xf3\xa1h%\x80\x16M\x8a\xe7y\xc3\x1b\x91\xca\xfc1\xfc>\x94ԍ\x83P\xa5\xcc\xed\xea\\\xd6\xd4\xf1\xdf\xda\xe6\xb5\xe1+\xee*\xe9\xa6\x19l\xab%\x88V\xd2\xc5gEQ\xc6B<\xbf0\xb2\xda\xfdQM\xf7?\xd4\x15|w#\x9c\xd4h\x8c\xc3Y\xb6\xeb\xe1\xc1\xac&\x9e[\xc9\xefF\x18\xcaM\xd6#\x8f:\x04\x17C\xb0\x02\xdc\t\xecS\xb1\xbf\xbf\a|\xe5\x7f\xf5\x1e\xec\x18iH橀\xf5\xed\xe6\xbae\x8e\x0fJ\xb29\xfd6\x11\xde\xf55H\x8b\xd2\"x\x9eT\x924\x1f\x0f\x9a\xf2Z\xffd[g/cy\xda>KR\x8bF\x1c\a\x05$0\x9az\x95\x8ax\xcd\x1e\x89\xbe[_>\xccFZ\x91\x0e\x95\x17\x13&\xf7{[\x0f\aۑ\xdc\xef\xf5\xb6\x06[\x91\xdc\xef>\xec\x0ez\x11ްY+yĄ\x9b\xf4\x9c\xce\xfa\x9abJ\x14\xc5\xd4\xe8F\xf2Q\xb7\xb7\x87&\x1e\x92\xa8C\x89\x84I\xa3\x17\t\xe8\xaf\xd5\x1d\xd1no\x8f\xb1\xb0\xfb\xb0\xd7ċu\xc6\u00adnS\x12\x90 \xeel5\x05\x9d\xedw{\xbb\xd8Č\x14\xda\xd8rm\xa4\xf8\xd1+7\xe6~\xa5\xba\xe9\xee\x03l\xba\xdb[\x86и\xe9%\xa5\xb3\xfd^g\x1b\xba\x99=z\xf0\xa0\xf7pg\xb9\x9c\xed?\xd8\xdd\xda\xde\"u]o\xd7t\x9d\xe3\xc7\xd6\xcd0\xb8_y\t\xa2=\v\x91\x06.5\xc0\xe5t\xb6\xbf\xf3\xe0\xc1փfs\xf6\xa8\xdb\xednw\xbb=\x03\xd4je\xbc/\f\u0098A\xa9-\xb8\xc0H\x14\x9b*\x80u\xe1s\x87\xa6j\xd7\xc6@\xfcw\x9a\xddNok\x89C\x05\x9c\xfb`g\xab\xd7YBZ3&\xb6$\xa1Y\x8b%\xab\x1a>p\x99\x03\x81\xf2\xb0G̚%\xaa\xeb\x10w\xef\x13}\xa6t\x9c}\x95\x03ھ\n\x91\x04\x01\x05\x9c\x8e\xfb\x118\x14D\xb4\xd8\xed\xb5\xf59Hi\xdabO\x1c\x86\x11+\f\xea\xdb\xfe\xf4Ҩc\xb1\x84\xce\xda\xef\x7f{s\xf8\xf4\xe8\xe0\xedۃߎ\xde}x\xf3\xe6\xf5\xdb\xf7\xbeV\a\xa8ĩ\x01\xf12\x13\xbbK\xa8d\xd7\xd3,\xf3\x95\xedtg۽\xd5\xea6\xd9H\x1d\x81\xb3\x8e\x81\x00(\x8cn\xf7\x10%L\xb3,\xach%ntW\xab\x90ԏg\xb9\xf4\u0603\x96N\xd3\x1ay˥\xa3\xe16JyJ[o\xb9,\xfc\x04.^\x927\x8e!\x1e0\xb2 Ɵr|XO\x1ah\x8d\xda\b\xdd\xc0\b\xe8\x83\xcc3!\x1b\x17\xa7\xc9\xf8\x14\x185\xc60\xb2q|\xd5\xf8S\xb1j\xfel\x9c?h_\xb6\x1b\x1fr\xee%m\xb7/\x1bɴq\x95-L\x9dF6\x9b\xd8~u\xc3\xed\xc0N\x99\x1a\xa11\xeb\t=\x8e\v\xa8\xda\tԶ\xba\xe66^:\xb8S8\xe1\xd2_\xbc\"\xf3C馘\xfb\t^\x8f\n\xb6\xd5\xeaN]j\x96\xd7\xdf\xedҊD\xa0\xdbY{\x9ee\xb3w\xc9_\x9c\xedu\x1f\xf6\xa8\xa2\xf0\xd9\x1a%\x0f+\xc1Z\xb3\xa9|p\xbfd3μ\xd2\x00\x13r\xa5\xd6\x01\xb1&\x199\x99\xfc\x11\xeb\fr\xc7k\xd3\xfe\t*\xaf3(Ҟ&\xa8\x11$H\xe4\xfdT?Vv\xa0\x1a\x98\x0fi\x1eO\x8b&{\xbe\x84\xafX\fD\xb37\x155\xabSWF;9\xd2\x1c\"\xde>2\x85\x9bM\xbe\xc1\x987\xc3\xd0\xd2X\x19>\x95쒫r3\x14\b\x1bf;\xe5m\xb5\x95\xa8\xcfH#\x84~\x17\xcaR%\xef\xc9'\xdbY\x8d \x8dЍ\x02wo\xb9\xdc(\xbe\v\xd73\xe2\x8e\x17\xd3n@\xf1oϱ\xe3\xf2Z~\x9cj\x0f^U\x0e>\xcd\xdeE\xff#\x05~Y\x81\xf9fid\xeb1\x91uh\xec(\x1747\xcd\x1e\xc5\xfdV+\x03\x1a\x1f\xe8\x12\xd85\xc3l\x04\x14\x0e\xfc\x84V\x80ZQd\xbe\xeeJ<B\xeeT\xfaH\f\xbaQgE}Yka]5\x95b-1JO6\x8f۳\x86\xb5s7F_\x81C\xf3\xf5옍\x8ee¸k\x007Z:\x8eeu\x9fm\x94dLk\x16<\x98%\xb9\xac\xe1\xb9Ʃ\xe2\xab\xc2B\xab5\xd6\\\xfb\x1aV\x8bUc\xe8\xe8\xdb\xdc=\x91q\x0f\xc0\x02K֡uυ\x162xL\x92\xa1P\v\x876\xd42\xeeL\xd3\a5\xcd\x18\xf3pP\xaa\xc43V8.$kYOs\xa9!\xb3\v\xb8\x98,\x97މ\"`z\x89BA\xe0\a\xacaO\xe4\\\x1a#\r\t|\bx\xc8^\x1b\xd9\xfe-\xa7\xec\xcb&݃e\x95\xb5칱\x86C\xb0\v\xdc\xc1gg\xbe\x00\xc2a)\xb6\xd1)d\xe4\x17\xf1\xbc\xbb\xe3\xd3?\x86\xf6\xf1\xb8\xa7x\x8e\xff\xd5\xdb`kd]Z\xb4\x89\x82\x885\xaaMݝ\xcd\xe3D澭.\xeb\xf4\xe5#ޗ-\xd6#\xe7\x86\xc9([]\xf7B<M\xf2U\x05ڭޝ\xa0\xdd\xfe;\xd0n\xf5n\x80vۇv\x8bP\xf3\xabե\xb2ջ\x05\xfa\x9d\xed;A\xbf\xf7w\xa0\xdfپ\x01\xfa=\x1f\xfa\xdd\x12\xf4;\xde\xef\x1e\x95\xad\a\xde\xef-*[\xdb7\x8c\xceH\x9en\x1b\x9f\xf7\xe6E3\xb7\x82\xa0\xc5\x1cK\xcd'\xefPN\xa2\x13M\xedc\x8a-Iʽ\xbf@Kc\rC\x1d`\x85\xf2\xca\x13U\xc5\xcboQ\x12V\xcbT<\xa8\x1cW\xcbI\xf4'GY8\xc2\xe0,\x15\xa0F\xc0K\x90kU2\x7fڔ\x8d|\x10XaU\x8dN\x98\xe9KO\xae\xb3삫\x8av\xa8\xf0\xec\xaa\xc2\xf6uoE\xc0\xb8\xea^\xb7\x01Lx\x91\x9c\x85\x84z\xab\xb2\x0f\xda%\xbcłF\xbb\xddn\x04\x84\x06\x8f\xf4>\vZ\xbc\x15\xec\a+\x1a\xc3\v\xd5\xc1=\x8cG\xacf\x18\xa40\xb6:\xf2\xc7\xe3\xd7\x7f\x15\x11t\x875R\xf4\x8b\x04Q\xa9\xbcE\x90XG\xb8\xdc$>,\\iFDeS\x94r&Xa\xaai\x8d\xfcL\xc5\x1b\xf5S\x80i\x98\x15\xc5T(a\x17\xfbN\x9f$\x85\x84\xac ܪ\xc7\v\xd9\x02\xec\xb6\x1a\x02\x92\x1a\xc8^WZf\xe9>\xf4#\xf7}\xa9\xa5N.\xc8H\xfc\x12*A\xef\xe2\"\xf1\x16\xb30S\xe2\xb2\xcd0U\x1f4aF\x98\xb6idiz\xeb掐\x8bi\x82\xeePa 潞\x81\n\xba\xcfƲ\x18\x8b\xb3N\x9f\xa3f.G\xce\xee\x90\x03\xd57\x06\x1b\xc8\xeb\x98\xc1O\x9a\xe0\xcf\"\xd5\x17?J\x80\xeaK\x1eņ\xea\xf3w\xe8x\xb6\x98\xf0|ͻ\x05\x19\xd3\b\x9dQ\xbduO\x8c\x8a\xdcbM\x1b\r\x8d\xa50\x91ntJ\x95=Y\xc6\xdd\x1a\xe8\x96\x1a@u\xbe\xcai³\xe4\x11Z\xa9\x96Z҂\b\x94\xc2%`DK\xfe\x8e\xadQ\xbbL\x99\\S\x19\xb1d\x92?K\xd2D\xf2\"eS\xb8\x9f\x14\xa8\xa1\x91\xba\x18q m\xa8C=\xa4\r\xd5\xf0\x88(\xbf\xa2\xc6u\x9e~\xe4\x83\x1b\xa5\xbe\xdaJ\xd4\xf6&\xc8@\xef\xb3\xf2\xa1R\xe3%$\xd2R=\x95m\fI\vGlSֈ\x8e3-\xc4#\x96m\xbb\x8f\xe2\xbbG\x1d%\x8b^.\xe5\x1dN\xa0\xa7\xb6\x81\xa3\xb7**\x05\xfd\x94\x80\xf4\xd3\xe5\xd2\x01\xadOUE\x85 \xad\x114_x\xbb\xe36Q\xf3a\xb5\xec]\xb5\x11.\xabUK\xb2\xe5\xa3\x1a@\xbeJ\xba\xfc\xaeА'_\x8e\xef._NI?EŃ\xb2\xbc\x8d\xc6(_.\x11\n`\xeb\xcb*\f\xbckȍ\x8c\x92\x1e\x05ͻ\xa8B\xec\x03\x9erfL\xed\xa3X\x88\xe5RQ*d\xb5\xd28\xef\t\xdb\xee<\xdcq\xdaٯ\x8b\xea\x0eA\xd0_Øw/`\x89\xccxx\xf1\xa6\xb5\xfcO\x88\xe6Є\xa7\xaf\xa7\xbek\xfb\xfb\xf8_\xe8o]_\xcf+\x12{C\xe6\x85\x1b%=\x8ep\x03\x8e\x9a\xba\xdeRu\xdeR\xf3\xa2\xd3*\x17\n`\xd9O\x11\x98\x94d-\xf6\xdb\x10\xd4Ҭ^Z\xe6\xba~U\xedڻG*\xed\xaa\v%\xb5R\xf6>\x87wGV?\xdct\xc8G\xadރ\x9do\xd3!ouG\xa4\xa6\xfb\xf7\x9eZ\x1c\xffWw\x03|\xb0\xacU\x06ԬA\xe3By\x91\xa4R]μ%\xf7Ś:\xef\xc5\x15\xb84\x96Y#\x1e\x8fy\x9e7\x8e\xf9U\x96N\f6\x99i\xe7\xde\x0e\xa47\x8e\xbc2n\xca\xef@+\x05\xaa\xbd\xbaw\xa8\xa1\x8e\x8c/\x14\xf5\xf4\x97\xfb\x19\xacl\xbcNG\xaf\xac*\xact\xf5PO\xcfS\xfb\x13\xad\xd4R9k&\x00\xafʆO\xd7\xf8\xa3}\xeb\x13\x93\x9fC\t\x1f(i\xdb5(U\x8bge\xf3qr\x02\xe2\xd4\xed\xde\xc3\xed\x87;\xbb\xbd\x87\x0f\bA\xb1ik\xc4b\x1a\xef\xef\xb3=z\xb7\x9f}%:4\r\xef\xef떷z\xa4\xb6\x13C\xa3\xab\xea\tM\xfc\xd6n\xfb\xe9\xe9`\xbe\xfc\xfbc\xdd-\x8ef\xa7\xf8\xf3A\xf1\xe7\xf6\xdf\x1b\xebVq4\xbd\xe2\xcfn\xe1'\xfc\x10\xad=7֧\x95]\xfc\x95[\xa5\xafUh\xbefs\xbd\xf0\xfd\xfd[\xcf]-I5\xfd\x11\x83\xfb!\x0e\xcf,\xbaMh\xe6\xec,\xb0No\vREk\xdb5\xf8\xf8\xee\r\xeeU\x1b|ЃT\x98\xa6Y\xf9:*\xb1\xfc\xec\x8b\xd1{q\x87\x9c\xfd\xe7?\x9c<\xea\f\xe0\x89'ȣ\x8e\xb6?\x8a\xb8z\xf71Ah(==ف\x88\xfe\xf3\x1f\x895\xa4\xad\x01X<\x92XCB\r\xf9\x88\xe3'w\"e\xec5_\x1c\xc7Vi\xf6f\x91XEK\xd6\xff\xad\x94T\x92T\xbe8d\x95\xe4\xe7\x98\\&\xa2\xb9\x9aLM8\x8a\xe5Rai\x9ft\xd3v2\x90\x04\x8f\x88\x8cuil$\x8e\xadV\xfc\b\x1euٷ\xac\xf7`\x87\xf4\xe16T\x05[\xf1\xe8۬\xef\xb3ڪ`>\xae\a\xf3\xf1\xdf\a\xb3\xb5\xb9)\x11T\x05\xa6\xdc\xef\xd4\x03\t\xc5n\x05s\xaf\x16ʽ\xd2>2\xa7YC\xaa@\xec\xd2\xe2[U\xcda}7ݝ5ˆ\x19w\xe8\xacW\xdb\xd9R\x0f\x15\r\x14\xd7\xf5\xfcx]Ϗ\xffFϏ\x1e\xed\xb9\xce\xeb{\xde\xea\xad\x193fܡ\xe7\xedb\xcfaݠ͏\x1e\x1an\x92Vwgww\xb7\xd7\xdd\xf9V\xa7o\xad\x05\xee\xf1:\xe0\x1e\x7f\rp\xa5~G\xadЃ\xb2\xbb\xe3\x83ia\xde\x1a\x91*p\x8f\x93\x13\x00cg\xfb\xc5!\xfb),(\x1b\xfd\x1cj\x10\x8cl\xd5\x19&\x9a\x03,\xcc\xe6\xdf\xf5\xdc\x05J\xcf%\x80X.\xef\x85܇}s\xcf\xc3XH\xe6a\x13\xad\x16\x1f\xb5P9\xc2\xfbm\xbf\xbe\xed}\xfbmo\x9bf̦\xdcRS\xa8\x1a\xe64\xea+3%\xadP\x7ff\xe4\xd1#w\xa7\xa2\x7f\xb2\xb5S\xf3\xf8\xff\xc6Ԩ\x01T\x06V\x1c\xb6\xfd\xf2\xa7\xe6N5M\x18\xaa\xd0NMa>Zv\x9a\xeaf\xe6\xff&\xfeϾe\xdd\xde\x1eU\x8c\xb50\xddT\xaf\xabyv\x11\xf6\xe8\u07b7\x92\xd4^d\x7f\xfb&РZT\x9f*e\xb6~Z\xbc\r\xe2\x96_\xa4\x02t\xac\x80\x8ek\x80\x8ek\x81\xfe\x9a\x8b\x01\xb4\xbc\xf4\x04\x0f6\xbb߂\x1a\xe0\xa6\xc1\x12]\x12\xad\xbd7\xeao\x87\x9b\x90sߧwʘ\xd2\f}\xab\xb7\xbb\xb3\xd7\x14\x03$W;\xddݝ\xceRDbM\xf7\x8f\xffF\xf7\xad\xae\x01\xe0+\xbb\xff\xca{\xe2\xf6k\xc2!\xe0G\x8fz\xdbk\xfa~\xfc7\xfa\x86f\x97w\xb9\x00j\xf1\xff\xff=\xf4\xaf*o{\xb8\x89\xb7\x1e\x14\x909o\xed\x8cZ\xa1\x80\x01\x92\xbba\xac/\xbcS\xd6a\xfe\xffk\x88?\x948ػ\"\xfe;Nʗ\xdd\r\xb5\x93\xf2l\x96\xc5\xf2\xabNH\x86\xf5\r\xafq\xa3\xa3\x9eh\xab\xfa\x1e\x1e\xff\x03=t\xd7\xf5\xf04[\x1c\xcf\xf8\xdd\x06\xb1w\xcb \xf0Y\xb8\xae\x8b\xc7\xff@\x17ݺ.\xf0iZ\xf3.S\xe9u\x17\xb3\x16Qp\xd6\xe2\xf6\xaaS\x7f6Rr\xfd\xc6\x17y\x14n%A6\xbb\xc0YU\xcc<wQ\xe3>\x91\xdaj\x15om\xe1_\x81*\xbb\x15\x8f\x18\xbf\x9f\x81K\x1e+\x97m\x895Cy\xbcf(\x8f\xff;C\x11\x9b0\x98\xae7\x98Vf\x86\xb3\xb9\x99\xed3\xb8\xd2\xe3\xd2x2\x18O|\xb7\xf1\xec\xd5\x0fgo\x9dة0\x9e\xe5\xd2\x1bG\x97\x82\xd5O\x87\xd0¤\x83\xbe\xc0\x9a\xae+\x0f?\xdb{\xe5n\xbf\x13\x00=\x8a\xba\xcb5 \xa8i\xe9\x8e\x18l\xe9=PYX\v\xd2\xe3\xb5 =\xfeg@\xd2 X\x90\xcc,\xad\x03\xa9\xf2T\xb4 Uh\x80;\x81\xb4M\x1d3\xce\xc1\xd5\xdaR\x90\xf5\xb6MBO%tw\xaa\xd3WZ\xdf\xed\xb5\x90?^\v\xf9\xe3\x7f\f\xf22\xdc\xdd2ܽҜo\xdd\f\xf9\xdaG&H6-\x94o\x1dX\xfa\xda\xea\x10\xf3\x15t.\xa7\xa5\xff\x82ꫭ\xd8\xd9\xe3\x9b:{\xf9\xcftvG\x84\v\xe8\xc9\\\xf0E̴\xd9%\xfd\x02\xe2J7\xbbt3%+\xa7\x8d\xd9EC\xa5:ԛ=\x12>\xaa\xe2\xc0)\x04\x16b\xd2lv\xb4<\x1dP\x97\n\xed\x01\xb6\b\xd4a\xb3\x90ߏ\xf7\xf7;d3\xb9\x1d\xa9\xdd\x11\x17\x7f\xf5(5F.\x8d\xf3&\xac\xbcn\xa8\xad\x7f`\xa8_\x85\xa7\xbb\xbd]\xba\xd9\xed큖\xb5\xe2\xf0\xf6\x1e<h\xf1V\xf7n\xb8\xfbo hx\xe1\xec\xd2M\xf8\xb3\xf7uX\xfao\xa0\xe2\xfaο\x04\x1f\xff\r\xa4댅i\xc14\xf8\x969X\x83\x8b=l\xdd\xda^\a\xe8\xe3\x7f\x14P\xb3U\x1c\x0e.\xee\x98\x7f\x1c\v\x7f\x11\x12\xdet\xe8p\xafS\xfc/\xf0q\xe5\xee\xdd\x11\xf3\x17\xe1\xe5\x7f\xba\xff\xba\a\x8c\xb7\x80/\\\xcf\x1b\x9d\x8a\xea\x91k\xe1\xf1\x9dZ\xe8ַP\xfb\x00\xf1\x9ax|;\x10\xb5\x0f\x8c5MT\xa1\x005\xe6zL~\x17\xf5K+VV\xc1\xaeJ\n\x98 \xf2[.A7\x9c\xd0T\xa9_*e\x9b\"\xcf\xc2\x19\xe2+\t\x96\xcbA\rp\xa8\f\\\xb5\xf4\x91r\x98&\bM\x8bn?J\x1a𪧪\x9f\nUR\xae\x15B*\x15\xc5w2\x16\xb2(#\xb7\xd2KЖ`\xb7\xab\x1e\xad\x93\x7f\xa6k\xbbγ\x85\x18\xf3\xc3tR\xe98\xf5U\x9dp\xfc\x85ɳ\x86\xad\xf2Q\xba\xa9\xe6\xc7%\xb5|\xefs\x9b\xa2\xac\x10[뀪V\xaf\x1e6\xc9/\x89<M\xd2\x01\xf6\xee~\x87j\xbfܢ\x8e\xcfiQ\x16\tU\xa8$4+\xeeE\xb0)\xaaߋ5\xee\xfej\x93%\x18\x82K\xaa\f\x1b\xfc\x89\x8a*E\xf5f*\x95\xa3\x85 GeO9k\xdcl\x18\xf5\xa7j\fOR\xeb\xef\"m6\x8b\xde\x06S\xf2E\xfaU\xc94\xec\xfa\x16\x1f\x9e\xc9g\xc1Sg?T\niJ3\x0f\xed}\x97K\xa3k\x06\x89J\xc9\x17\xe2@q\x88\x0eZ\x13\xc0\x04\xddLD^\x98Q\x17_%\xe4F\x1dA\x87.GmYo2\x1f\xc9\xe2\xcfu\xaa5\xaf\xd7\xe8ʊG\xceV\t\xdaQjDؓ\xbeV\x99\xe7#\xca\xeb)\x02&@\x87*\x9f1\x1d?\xe6\xbb\xdd=@U\xfa\x9aVxc\u009b^\xe9U\x1a\x1b\xf5\x02\n\x84\xd8`F\x1f:E\x87Ϟ\x95\x00Ҟ\xeb\xf5\x9fo\xf4=f\xb1\xa8\xd6\xd6\xf9Fi\x83\x81mM\xf6HlJ\x0f\u0096\x04\xb7\xb8ٿ\x92\xd1\xca\xd7\xfd\xd7\xc7\xfc\x19\xbb^9U\xb7\xbf\xccE\xf0\f\xa2\xef(o\xe6ڗyC\xa8!\x8a\xc5Xf\"$\xd7\xe87<\\gX\t}\xd0\xe0\x8c\xe7y|\xc2\x03z\x8d`F\xb2\xde\x14\x80\u00add\xcc-\xc7Y:MN\x16\xc6\xfcr\xa5\xf5\xdc1 \xe4\x9f\xf7\xae\xed\x8fUcx\uf6afF\x7f\xaa\xfc\\\xc6\xe3OTG\x10r\x85N\xb8l\x8c1\x0e\x8c\xa5\xb2V\xb9I\xe3\xe4\xfa&\xe8\xa1L@\xafK\x00Ѣu\xa8\x1a\x18\xf7\x87\xb0\"+\x17\xc4Gw[\ay\xd4Љz\x9aV\x7f\xae<g:\x1f\x9c\xcbt\x8c?\xc8\xcb\xd6U\xc1&\x9cH>\xec\x8c@WZ\t\x93\xc4>K[\xdb}\xb1ɶ\x88d\x7f\x1eݻ\xd6j&\xa1\xd8܂\x9b\x1c\xa31\xf7-L&\xb7c\xf3\x1c\x00\x9f+\x8a<|_\x80\x92\x9cto\xb4\xe089IR\xe9\xa3\xd3 \x85h\xd5\xfa\xe4\xa9ݝ\xb1x\x7fk`|C1\xc6\xec{y\xf0\xe7>kt \x16O#N'\x8dG\x8d\x1e~\x7f\xfbm\xe3\xde\xf5\u07b7a\xdc\xea\x02X\xe9\xea\xcf\b\nn\x86\xd5\xec\xcd.\x16 \xa6~]\xa6\xaa\r\xc3s=\xc1o\xa1r)\x9c\xbeg\xed÷o\x8f^\x7fx\x7f\xf4\xfa\xd9\xd1ۃW\xdf\x1d\x86\xfalьr\xb2\xda(\xd3J?\x87ұ\xf0\xdd%\x00\x0eZ<N=\x1fʖ\x18\x01\xbb\xdes)\x81>\xc9\xc9*T3\xebf\xfcgk\xf6w\xa3\xabC\x05\xea\xf3W?\x1f\xbcx\x0e\x86\xe3\xdf\x1d\x81\t9\x80\xa3kQ\xdf{\xe2=O\xd1\x11\x9f\xd4\xd3Y\x86\x01]6\x9c3֟1Rºy\x10˥\x19(\r\xbc\x90ǔ\x93*y\xa4\xea?\xfe\xf0\xec١m\xe6\xf1\xeb\x0f\xaf\x9e\xbe\xeb\x97\v\xad\xedD\xad\x962\xfct\xab%W\x7f\xc2\xc0\xfe\n\x83u=\x044\xac\xb1\xf8\xe5\x03\xd8諛|\x0e\xfe\x19\x15|\xf6)uM\x88\xf6*\xae\xd6(\x81\xaf\bu\x17\x12\xa1\x1a\xa8\xf2\x9a\x14\x00r<\xfc?Ѿ\x05\x80\xba\xd5Keٖ\xe5\u07b59h\x10T\x9d\xda;Â\xe0Oj\xa9{\xa7S\xfc\xa7\xbb`\xb2\xa9\x81\xc4\xe9y\xe2\xbd\xda\xfe\x93f\xccR\x80\xea\xden'\xf9s\xb5\xf8!x\xc3\xc0\xed\x14\x1f\xe7\xa1 \xfb\xbdo\xbf\xdd\xea\r2\xf6\xc1X\xe2\nB\xa2\nz@>?\xb3%h(\x8cZb\x8f|\xfb\xad\x13;\x81\xa6\xf1fX\x9bE\b\xb6\xf1!\xcc\bz\xb4\bҀд\xc5\xfel<w\x93\b\xbbś:\x88\xf8\xf5'M\x8b\x8b\xa6\x11\xe9/\xec\xfe\xf0߭\xfb\x9d͇\a\x9b\xbfǛ\x7fm\x1e\x8d\xee\x9fx\xe1\xf5<g4}\t\b\xac{\xbfӯ\xa8K+.\x94\x17h\xb1\xc6[\x8dv\x13\x04tJ\xc5;\x8d\xd8\a\xef\x1d\x0f\x9aM\xf1\b\x1c\x95l\x1b\xc7aXz\xff\xc1\xceV\xf7!\xb9\x06\x177[d\x7f\xb3kc\x99\xf5\xb6\x1e\xd2\xee\xc3.\xed\xee=\xc4\xf1\xc8$]p𔔠\xf7\xa6\xf4\xeeu`\xad\xfd\x06\xc4#\xf4\"r[\x03\xb4PO(\xe7$\xad0ۄ\xe1\xec`<\x06\xb1\xa9ZRN\xa20<\xc1\x8d \x817\x1a\x9cI*\x80\xfe\xc4)\x80*]p\xee\xa3ܶ\xe8j\x82x\xbe\xd6z\x9dmW\xb6W-\xbb\xbf\xbf\xb3\x04\x0f\f\xe0\x97e\t\xedzu\x11j[y\xab\xaer\xb7\xb7\xec\xf5\xb6\xa9P\x9e\xf1\xa1\x81RK\xb8`\xa10>]\xc8\xfaX\x02@W4\xe6\x99U\"\x87>\xb7k\xfb\xdc[\xf6\xb6Q@\x85\x9e\xf9\xb1\xd3z\x00\xac\xfd\x94\x17 \xd1C\x81\xa9\x1f\x92$,\x19M\x86\x9cadD\x17B\x17b\xe5j#Ck\x84\xf8\vDv#e\x0ffZA\xbfo\xce\x01\x9a\xed\xf6\tX\"\xb2\xa0\xef\"\x86\x15\xbc\xde\xfe\xe8\x1ej\x8aJp\xa4+\xbcs¬\x05of\xfb4\xcf<\xb7{\x8a\xae\x1df-1R\x1e\x8e\xaa\x8a\xfd\xdf\x15\xa5\xa5~ P\xb9\\Z\xf7\x0f\xfa\xa3\xed\x11\xb5\xb5\x89H\xb15\x9b\xd54t\xc4\a\x1f^\xb0@\xff\xd6\xd9`\\\xdb/\xfdVgR\x1b\xb8\xa0\x16*\xf0EP\b:\x84<ꢃ\xae\xee\x8e2\x927\xb8\xa7\xbb\xf3\xad\xf0\xbd.\xf43U\x04&(Ei\xe3P\x8cZ0K+\xe7\xb2ˋ@\xf6\x93\x83\xb6\xceی¸\x83_\xfd(\x94\xbf\x86\xe4\xbaj0\x86\x05\xd10³\xfeZ\xadh\xaf\xfb\xb0\xb76\xe0\x83\x89?\xb5\xdd\xe9u;*\xdcÃ\a\x0f\x1e<$4fY\x98\x86\x81\xb6먘\xeeAx\x12\xde\xe6\x97\xd0Q^\x12\x91\xab\xe0\x1b\xf0cc\xc3\xea.װ, vd\xc8i\xe0\xb5\x1e\x00B\x1ad\xa1 \x91X\xad(\xc2r\x1b\xec\x0f\xf6v\xba:T\x85\x1e\x06\xc0\x1e\xfc\xeb\x99\xee\xd0k\x1e\xdf=\xff\n\xe0\r\xb8\xa6\x04\xb0=\xa0@\x8e\x05\xde\xf2\xe9\f\x1e&\xba\x1e\xd8\".\x97)\x16\n\x13\x1a\x13:\xc3b\xfa\xf9r¥\x17\x1f\xfb)\xcf\xc7\"\x99\xcbL\xa8\x8a\xff?\xe6\xfem\xb9q$k\x14\xc6^E\xc4\xf4`2\x87)\x8a\xe7\x03XY\xfc\xd4U\xaa\ue6aeӔ\xaa\xa6{\x86\xc5ц\xc8$\x89\x16\b\xb0\x01P\x87\x16\xf9\xc5\xecm\xff>\x87\xaf\x1d\xbe\xf1\x7f\xeb+\x87/|\xe9p\xc4~\x82\x7f?\xc2?~\x00\xbf\x82c\xad\xcc\x04\x12 (\xa9\xfa\x9b\xedpt\x17\x05$\xf2\x9c+W\xae\\G\xb61\xf3\xe6\xaf:*\xc7\x14sH+#\xf7\xf6\xf7\x12/m0\x0e\xea\x06¹Znz\x87k\xec\fWF\x1bDԻ\xb2\xf5\xd0AVb\x120ϸ\xecA;mo\xf4ja\xbcam\f3\x8cj\xe6u˶7\xc6״\a\xd5)\xa9\x1b\xd4t\xd1\xfa\x1c\xe3\xccgQ\xa2w\xb8^\xeb}\x93\xb1#\xe8\x9aktm7܌6$\x1d\f\xb3p\xf6\xd3v\xd7;\xea\xa4\x1f\xe5\xca\xf0\xf5\x8e\rڍ~;\r(\x85\x83\x1aV\x8c\xc6J@\xe7~W\bi\x9e\xed\xca0\x17\x98l<a\xd2\xf6\xbf8\xc6aR\xad\xea\x19L\xbf\x81\xb7U\xf4\x92\x88\x1f\xdc\x14\xda\xf3,%\xeeB\xb4i\xcd\xdf\xe0.\x15\xfa4M\xcdK\xf3NG\"\xe9 2J\x99GP\xbb\xc7Cu\x99\x97G5\x1dz\xb6\xadj\xf2\x14{\xe8Ȉ$\x8c-a-\xa9\xa3\x01\xce\xf7\x02\xbd\xeboTOA,#\xc2\a:l*8Z\x8b\xc6\xf1$m+V\xbdV\xaf\x91\x11?7;\x16\x85\n[sd\xd1\fVG$\xac)\x83E\x1e\xb24\x19\x9c@\xa6L\"\x12\x95\x80M\xb8S#O\xd8x\x02NA3\x90\xe1\x11\xc5\xf0\x98\xbdn\xbb?8\x14d\f\xfdC\xee\xbbzy\xa2\x97m\x83\x83\x9eDe\xfcC\t\x14\xf7;\x16p\x92\xc8x\xdd2\xe0\xfdv\x1bf\xba\xb2n\xe6zE.h\x9c\x0fZ\xc4\\:\xd4^AcE\x99@6\xdf\xcc6\xcce\xf3\xa9\x9f\x11\xc4z\xfd\xfdg1X|猧\xb1`|ܠ\xd5FF;B\xe5\x9b\xd4\b\xd0e\xb1&Ar6\xfb\xd1x3\x91\xfd\xcd\f\xcf\xe3j\x83\xf9i\xeeV\x9bs>ͳ5m\x9bL\xf9Teo0@\x10\fj\xe2\x1e\x99\xb2\x80\xee\\\xeeW\x1b\xbb\xd4\xed\x1cKj\xb1\x88<\xd7\xf7~ͯ\x13\ve\xdb\x1e\x0fabY̽\x9a\x8c`\xbfݺ\xb8\xcb\xf6=\xb3\xc5嫨\xa2\a\xc8\xd2\x06{O\x85\x96\td\xa8\xefG\xa5(@\x80\xe4K\xcbE\xc2x\x01\x12\xd3\xea\xba\xfc\xc7\xea\x02w\xbb{Um\xb8\x80\x98`U?\v\xf0\xe1\xc1\x19q\xba\x10z\x1d\xf4\xfb1JK\xbc\xf8\x9d\xfb\x8eL\xc1SUj\xf9=\xa5\x0fN\x82,\x9eo{S\xe5\xd6\xf0\xe8\xad{{|\xba\x10ܪ\x1a\xac\x8b)z\xbb\xf5j\xb3p\xe5zJ\xf2\xa4Ƙ&>؞\xccS\xd6\xdeK\xfc\xc2\xc1\x13\xbf\xcc$[Z\xbbɲ\xd0\x0e&=\xd8\n\xe4(kホ,\xb1\x05\xc8 \xeb\x17\xb7k/\x12\xb1\x9c\xd05O\x13\x10\x14\xf6Y\x1a\xd6X\"գ\x97n\"&\x16\xc6AQa\xed\v\xa1\xef!Î\xac\xe9v+\x97e\x9d\x05ly\xb8\xf3\xaa\x03e\xfd?\x93\x9f\xb8U]ג\xf0\xf3\xa7\x17i\x90s\xaf\xb6L\x92\xf5\xfb\xc0\xbf\xb3m\"3\x7f\xaf\x12\x00\xack\xb1\x98n\"\x91~;\xc7W\t\xf0^m\x1dya\xe4%w\xa9G\xaf=!H\x96g\x94=\xe6-\xc6\x1d\xb3\x1ei'\x1f\xdeX\x8e\x9ax\xf5\x85\xbf\to,\xc3\x01\xa8\xb5\x123o\xb3\xda\xcb\xf6V&\x9b9\x97\xdeb\xb9\x97\xef{HT\xb9\xb4\x05\xfc\x83\x80\xa1\n\xe6&w'!!vW\xe2\xdcK\xc4C\xb3\xa0\xf3\x8c\xb2ǽY\xc8\xea\x81~W\xea\xaa\xcf\xe7*\x99\x9f\xab\xd3\xc8\x18\x9b\xef\xdeZ\xc5lo\xdc\xdb\\\x1eu\x88=\xa1\xb6 \f\xc4^\xbew\x90\xf8\x153\xa5GQ\x98\xa94&\xa3:\xeb\x0e\x92\x11,\xe0'\x7f\x1f\x7f\xd9\xd4\xeb\xf5\x01\xfc6\xeb\xc7\xf0\xa7'\xe0\xb7/_\xe6\xf3I\xf5\x9b\x13\x93\x00\x139\x97\"\xc6q\xf7{\x8b\x8e\xe4Q\xfa\xf9\xe3\xeb\x17\xe1j\x1d\x06\"\x00$mޑ\\\xf3\x12\x18\x94dβz\xf2\xec\xcf\x02\x99\x1f%Y\xf8r\xe3\x0e\v\xd1}\x9b\xf5n\xbb\xfe\u0605\xa4\xd1P\xb1\xf3\xee\xadD\xdc&'k\xdf\xf5\x80\x1d\xffI\xdc\x02\xef\x16Ӗ\xc9ʷ\x1c\xebs\xe4[L\xaf\x81̰{\xf8^\xc5\\汘\xf9læ\xe0\xeeB\xca\xda\xefw\x18\x13\xb56\x13\x97\x9b\xc5v\v\xe9\xd1\x1d\xa0J\x8f\a\x04n3\xb3p\x8a\xc7Km\x1a\t7\x11Ȁ#p\x7fI?\x80\b]\xf8B\xb6G\x19\xd9\x14˜\xf9\x02ވ\x15\xaf\xdd\x00\xb8\x100\x10\x15\a\x99\v\xb6\xa9\xc5ɝ/\xc0\xd9\x1cD\xa4D\x16\xb2N[\x87\xb1\a\xf5rk\xee݊Y\xf6!\t\u05fc\x9e\xbeM}oͭHL\x13RgG\xea\x7f\x9a\xe5\xbeYz\x898_\xbbS\xc1\xadu$\x8c\x0f\xe2\xf2\xcaK>\xc7\"\x92C\xe08\xc9\xd9\xf7\xb7ᯇ?\xae\xe2\xc3\xdf6%_\xdc\xd9\xec\xecZ\x04\xc9\x1b/ND \"\x88i\xbd\xbe3\xb9\xbc\xf24\fjq\x12\xae\xe1n\xe1.\\5\xabIMFJ\xa7\xf8}\x1d\t\xa8\xe8\xa5\\\x7fbz9¹\xb8\f\xddh\x06\xf1\xe2\xe9}d\xdbڛ\xec\x8d\x1b\x05\x10\xb8\n\xaef\xc0(\xdf ݝ\xcboQ\xb6W \x91\x8e\x10^\x9f\x1d\x81\x8b)o\xeeM\x8f\xe2d\x03Z6L\x06\x9e\xcfWQ\x9b\xfa\u008d\xe0\x89H\x12\xc4\xe5\xe1Xw\x7f\xb2ݦT\xfb\xb0\xb4t,\x12,낰\x00\xa9\xd0\xe0p\xfd,8PZ\xb7\a2\x8e\xa4\x16\x06/\xc25\x1cj%3\xa7\xbf\x92\xe2̡\nQ\n\xc9\x10H\x1d\xae\r\"\x98\xbdXz\xfe\x8cl(\x8bk1\xae\xf0\xbbp&\x148ǐ\xee\xc3Jˍ\x12SVI\xeb\x10\xb7b\xfa\"\\\xad\xdc`\xa6\x96\xbe\x84\xcb\b\xe9GS\x99\xeb\xe8ƍ\x8f6A\xbcA\x89\xc6|ヱ\t\xf8T\x91(&ȭ\xae\xf2\x15\x9c-/ִ\x89a팖\x9d#\x8b\x05_\xb5ʈ\x11\x1e\\+=\xdbۭ\x82u\x91ͫm\xeb'RV\ae\xbfi@\xb9:\xf6\x87\xa4\x8a\xce]߇ܗ\xee\xf4\n*XG\xe1j\r\xe2>\xb7\x84\xd1ANV\xee\xf4(\x8c\x8fnO<I%\x06\ued77p\x81a\b\xbb\xf9t!\x82\x84\x8e\xac\x7f\xfe\x1f\xfeO\x96c\xbdH\"ߢU\xab\xfa\"\xe3\x93f\x1e\xdf~w\xff%\xfe㕸\xfb\x12\xffqw\xb2`\tݑT\x98\xee\x05G\xc9(\xd1Bcǂ\xa9\xc1\xd1\xe9!9G\xbf\xbb\xbf\x12w;vt\x16$\"ʶ\x99\xec\xbe\xdc\x19\xbb\xb9\x17\xb8\xbe\x7fw\xefC\xec\x8b}\x16\x99_\x8b\xc4*\xbc\x96\xf8z\x94{#1ut©\xefcZL(e\x1b\xdb\xceû̓»GR\xae\xcct\xb7c\x83Ns`\xf2\xd8\xf4)\xd6l\xf7\xdb}\x93\xc9\x17\xecX\xbf\xd5\xee\xd4K\xf2\xf6[\xadn\xab\x90\xb7\xdb\xed7\xcb\xf2v\xba\xcdv1o\xa7\xdel\x95d\xed5[\xddA!\xeb\xa0^\x1f\x94\xe5m\xf6\x1b\x83n!o\xab\xdb\xef\x97dm4\x06\x9d\xce\xde\xc8\xfa\xad\xb2\xbc\xedn\xb3W\xecB\xa3\xd3\xed\xb7\xcb\xea\x1d@\x88\xfdBw\a\xfdf\xb7\xb4\xbb\xedf\xaf؇v\xb3UVo\xbfY߫\xb7\xdbiu\x9b%y\xbb\xadV\xbf\x98\xb7\xd5l\xf6\x1ae\xfdm\xb7{\x8db\x7f\x1b\xcdNY\x1f:\xbd\xd6\xde\xf4\xb6[\x9dV\xd9\xd8ڍA\xa3^\xecC\xaf\xd5*\xebCo\xb0?\x0f\xdd~\xa7Y:\xb6f\xbfS\xcc\xdbk5:e\xf5\x0e:\xad\xf6\xdeRt\xba\x9d\xb2\xac\xddN\xbdXm\xbbSo\x94u\xa1٪w\x8a\xe0\xd0\xebw\ae\x80\xde\xe8v{\xc5ihv\xba\xe5\xe0\xd0kw\x8by\xfb\xf5f\xbfth\xcdN{\xaf\xbf\xf5z\xe9\xf4\xb6\xbb\x9dz\xb1\xbf\x9dv;\xb7\x83\"\xd2\xeb\rz\r\xca\"\xd2i5\xdbM\x9aq\xd6\xdb\xf5N\x1e\x05\xd4$O\x12\x94\xa9v\xacٮ\xe7\xe0\x15\xba\xd6k\xf5\x9eP^\xf14a+\xb5\xba\xbd\\\x15\xfdΠ\xdeͪhuz\xf5<<\x83\xef\xd0Ƚ\xb3\xa8\xf2ּ\x03\x95\xfdF=WI\xb7\xdb\xec\xb5q@\x9dA\xb7\xf7\xb4\xdaD\x90D\x9e\x88w\xac\xd3h\xe7\x84\x0e\x11i\xf7\xfb\x9d\xc6\x13k\xb9\x16\xd1ݎu\x1b\xbdf~X\xf5\xe6\xa0\xfe\xb4*@\x9brǺ\xcdV?\xbfJ\xcdF\xa7\xdexr\x15\x89\x88v\xac\vW\xae\xfcPڃ\xe6\u0a55\x043d\x15\xeeX\xa3\xd7\xed5\n\xe3\xe9\xb7ZO\xaf\av_\xab\x99\a\x96f\xbb\xf5ĥ\x99\x87љ;]\u0096\x18\f\xf2\xfd\x18\xf4\xbaO\x9dW\xed\xb9r\xc7\xfa\xbdz\x1e^\x06\x83z\xaf\xfb\xd4Z\xf0\x1a\n\xe7\xe6\xa0>\xf8\x0fCݕ\xb8\x8bw\xac\xdbn\xe7\xc1\xa5\xd7\x194:O\xab\xc1\xe0\xe8\xeeX\xb3\xd5\xefv\xf3\x9d\xea\xf7\xfaO\xec\xcb\xca]\xef\x18P\x03\xf9a\xf5\x1b\xfd\xa7NN$f\x9b\xa9\x80S\xa4\xdf\xcbw\xa3\xd1h?u\x9d\x80\xb4\x8fb\x01\xf8ePX\xa7n\xbd\xd1\x7fbW\x90˼c\xad~\x01\xeaZ\xddz\xf3\xa9U\x84+\xe8Š\xdd\xcfo\xa1\xc6S\x97&\x0e\xa3\x04·f\x01P\x06\xfdn\xe3\x89;9^\xcbq4\xda\xedf\xeb?\fm\xc8v\x84\r\xd0h\xd4\xf3\xb5\r:\x8dn\xfdA\xcc\rL\xccZ\x10\xde\xecX\xb3W\xdc?\xbdV\xab\xffȀ\xb4\xacբ\xb5KD\b\x8dV\xbfu\x00k\xcb\xf3(\xad\xae\xd9\x1cԛ{tJ\xfe\xc0\x8bH\xa7\xd9\x19t\xa8!A\x02\x8c\xd9\x1f\f\xe8\x8e5\xba\xcdv٩۫\xb7\xbbRp\x8cC\x82\vžHx\xf8\x90XU\xe0`2/\xd2(\xd6\v\x90\xb6\xb7\xed\x04^1\xc3(t\x12\x10gw\xeb\xedփ\xfd\xc0\xf3\x10\xfaQP\xc9\x7f\xa4\x13\xf2$|\xb0\x1b2\x8b\xeaH\xa3\xd5\xe8\xd6\x1f\xec\b\x1e\x82_\xdf\x11<\xfe\x1e\xec\a\xe6P\xdd\xe8\xd7ۏ\xac\v\x9c\xa3_\xdf\v8A\x1f\xec\x04dP}h\xb6\xfb\x0f\xcf\x04\x1eĿ\xa9\x0f\x89\x88\x1e\xebE\"\"Տ^\xa3\xdd{\xb8\x1fp\x96\xff\x96~\xa8S\xfc\x91\xae\xa8\\\xaa7\xadf\xb3\xf5\xf0\xca I\xf0ۺ\xf3hO\xf4v\xe9w:\x0f\xcf\t\xd2\x03\xd0\t\xd8\xeb\xddV\x832\xaf\xd8\x1f\x16\xf3\xa2\x0e\xcb#]\xd4t\x82\xd9M\x0f\xba\xe9\xa5\xdd\xf4\xd2L\xa3p\xdfBEl\xb7P&\x862qZ&\xceʸr\x8e\u06dd\xdeà\xd7WH\xe9\xeb\xa6XQ(\x0f\xcer\x1aWTNt\xaf\xd3m?\f|\xed\xf6o؇\x06i\xf2`o\x8c|\x1a3\xf4\x9b\xfd\x87\x97\x1e)\x9d\xaf\xef\xd2\xca]?ؕ\x95\xbbV]\xe8\xf6\xeb\xcd\xce\xc38\x12H\xa5\xaf\xef\x82$\x92\x1e\xec\x85̢Wg\xd0|t\x1b\xb4;m\xe8\xc8WBz$\xd6\"=6\x0e\x81qI研\xeaܠQ\x7f\xe4$AZ\xf0\xb7\xcc\x12R\x81\x8fL\x13\xe6\xd1\v6\xe8\xd6\x1b\x0f\xc3\f\x10\x94_\xdf\x15$%\x1f\xec\b\xe6\xd0\aJ\xbf\x9c\x9f\x96u\x03h\xd2\xdfЋp\xf5H'\xc2U6\x15\xad\xceð\vT\xedo\xe9C\x94<҇(\xa51\xfa\xad\xd6\xc3\xf3\x80\x84\xf1o\xe8\xc4\xfa\xf1\xf5X\x1b\v\xd2kt\x1b\x0f\xc3E\xab\xd9\x1d\xfc\x86\xed\x13'n\x94\xc4`O\xf9\xb5[(+\xa9\x17\xac\xd9\xeb=\x8c\x81\x1b\xadV\xbb\xff\x1b:\t\xfa8_\xdb=(\xa3\x11q\xbb\xdd\xcc\xdf\xe4Z\xcdncP\xbc)\xc8\xf5\x1c\xf4Zu:\fj\xe0\x1b\x1e\xec\x85\xf1\x81\xdf\xcbf\xbd\xf9\x9d\x03\xef\xb5\xf4uGYY\xdfsFС\xaa%+\xc5P\xd3\xcdP\x17D$\xd4\xe8\x97_%Z=`\x9a\xa4\xb7\xa4\xfd\xcbE\xc9M\xe7-܃\u06dd\xe2=\xb8=h6\x1f.\xa8$\xe0n\x1c{\v\xe0yv\xf2\xbcT\xe0\xaf\xf5\xfb{\xfc5U\xec\xa0\xd87\xd5\x1e\x97bXL\x04\xb0\xee\xd5\v<\x83\xf6\xa078P9\v\xf9\xa3\xd5\xe7TR=\x11ˆ\x86\xfb\x1fj\xf1\xd2]\x81\xf9\a>p\f\xd0\xd1\xee7\x8a\x1c\xa2n\xbbS\xff\xda\xee\x18+\x1f\x14\xcd\x01\xe5\xe7\xbd\x0eݕu\xa7ת\xf7\v\x8c\xa2v\xab\xdb}\xca\xea\xa5|\xc0f?\x0f\xf8\xed\xee\xa0\xd9.\xd6\xf0\x15\xf3{H=8\x9d\xe7C\x19\xca\x068h\xf6\n\x8c\x9e~\xbf\xddo>e\x80\x87\x9a\x89w\xacUo\x0f\xf2\x1b\xa9\xd5\xe97\xdb__\xeb\xf9\xdd\xea2\xf4c\xb8i\x0e\n\xfdl\xf4\xda\xf5\xce\x13k4\xbc\\\x03\x88\xb5\a\x05\x0eb\xa3\xf7\xb4\xceI.[\xab\x9e\xe3\xffGdPo\xd4\xfbO)\x1f\x17\xba2\xe8\xb7\v\xbc\x8bf\xb7\xdbxRW4\v\x06\xec\x8c\n\xf0\xd5\xeb6\x11C\x158;\f\x18F\xfd>b\xb1v\xa7[ǄF\xab\xdeĄv\xab=x\x1aZ\xfb\x10\x85+\x0f\xf9jpU*p\xe7\xea\xadGxZ\xe7J\xb1\xd5d\xa3\x02\xd9Y\xe0\xcf\r\xfa\xdd'\xd6#\xc9H\xb8jv\vܱv\xaf\xdbxb%\xd9A\n\xa0\xd6*p\xea@h\xd5\x7fbMp\xe6\xc1M\xa8\xddk\x95\xc8#\xccŐ[\x82\xc1\xa7N\a\xbf4\xbb\r|h6z\xad\xa6\xcc2\xa8\xb7\xe0\xa1\xd1o\xca/\xfd֠-\x17p \xf3v\x1b\xbd.\xd6\xdbE\x99\tT7\x184p\x91{\xed\x96\xca\xd3j\xb40\xa5\xdb\xee5\xb0x\xaf\xde\xed\xe0rw\a\x8d&BBo\xd0nȦ:\xf5\x87\xb7\xbfܓ\xc0\xf1h6{\x0f\xb1\x12\x99!\x11\xc2\x01\x18\x9aM\xed^^\xf4T\x9b\x13\xcbKD\xe4&adQX\xcdV\xbd\xfdH\xe5\x9dv\xab\xf5\xf0^\xf9Q\xb8Wx\n7{\xad~\xa9\xcc\x0e\xee^EyY\xa3\\l\x88B\xeb}\x91h\xbd\x8c8E\xa1u1o\xbd\xdf\xcf\xc3ՠ\xdb5\xd1F\xbdY\x94\xb86ڍҮ\xa0\U0003a437\xddn\xb7\xcb\xf2\x82\U0003a435\xd3kt\xcaG\xd8o\x15\xf3v\a\xedR\x86\x12\n\xaf\x8b\xdd\xeduze\xb3\x81\xc2\xeb\xbdYn\x97\xca\xdfQx]\xecC=\xbf\xb7\xd3Y\x06\xe1u!o\xaf[\x1f\x94Q\xc0(\xbc.\xe6\xadwze}@\xe1u1o\xab[\nA(\xbc.\xe4m\xf6:\xad\xb2zQx]\x84\x8aA\xbd\xf4V\x8e\xc2\xeb=\xc1~^@\x99R\xf7 \xbc.\xf6\xb7ݪ\x97\x8e\r\xa4ׅ\xbc\x83V\xaf\xf4\xaa\x89\xd2\xeb\"\xect\xcaA\x12\xa5\xd7\xc5y\x18tJ\xa5\xc9(\xbd\xa6C@U\xbd\x96\xa2\xaa{\xf5><\xb4\xeau\x89\xa1\xfa}\xfc\x94\x1f\x7f\xb7[/U\x1b\x01\t7\xd4\xd7\xec\xf7\xfa\x88\xc4ڭAO\xa2\xbeNo0\x90\b\xb3-+nw{\x12\x8f\xf4\xeb]\x89\xfaZ\x92;\x9c\x9f\x92^\xa7tF@>^\x1ce\xbb\xdf/\xd5$A\xa9\x81\xe4\xf0\xf4ڽ\x8e\xe4/vq/0\x8f\a\xb5TC\xf6\xc0\xd5\vLg\x89H\xe3\x91\ver\xef\x11\x97\bZ\xb5t\xfc\"7\x8d\xf1\x8dvz\x8d^\xfb\xe1\xce4\xdb\xcd~\xfb\xbf_g\f\xcbJٟF\xbf\xd3x\xb8G\xe9\xf4\x045\xa5\xe9\xfb\xc4\x1ee\xe6H\xd9M\xb4\xbc\x97\xd6\v7\xf8Cr\x14\x8b\xe4Ȫ\xaa.\xbb\xf1\x91{\x94\xde\x7feg\xfb\xed\xde\xc0\x11\xfc\xf9}I\x9b\xf4\x1ey\x9c\xedG\x16[\u07b5\xddGG\xa0\f\x85B\x92\xb0\xfd\x1e\xbb``<\r\xa3HL\x13P\x96\x0e\xa7n\xba\u0083n\xbd3x\xb8\x0f\x8d\xfa\xa0\xdd\xf8-3\xfa\xa45\x0e\x8eԼcoz\x8dV\xf9\xd1:\xe8緊\xe1\xe3M\xae\u07be\x9e\x1arp\xa4\xc3\x1d\xc9z\x10\x99\x15\xadL&}:T$\xb0\x17\x9f\xdd&\"\x88\xbdK_\x10\xe0:\x94\xbb\x7f\x11\xa6\xbdc\x7fGw;JQ\xb4\xdc}T\x03\xbb?\xe8\xf6\x14S\xa2#\xe9#\x17'\xb7\x9b?\xa9\xcd9Ԧn\t\x0f\xd0\xf1\fe\x11wI\x02\x9b\xach\xf5\x87ơ\xde\xf3\xc6(\xfd0nL\x94\xb5\x1a\x8b@\xa9\xda{\xde4>6ӏ\x9b\xcc\xf1\x91?\x8a\x9c\x90\xa0\xc9\xde\xe6y<\xa4\xc98\x86\x80U)_K\xca\v\xfb\xad\xdeccmu\x1b\xf5TEB\xb2\xb3ڍA\x9b\x12K\xa5Y\xe6\x90\xc3\xd1x\xa23;e\x81\xf9\xb5ӹ\xe2\xa0ˇ+\x11E\xab\U000e8071\x01\xe3\xfdn\xbf\xadV\xa4\xd7\xef\xb7\xda0\xc5钁ض\xd7\x01U>\xe6\x03\xa2\xeb\xf5\x9b`9\x9bb\xbfi\xba\x8el\x8d\xc6\xcam\xb8\xfe\xcc\xe1\xb1\xd5\x06>\xf32\x15\x18\xb3\x99\xd67z\x90E\xe6\x11\x01k\xbdQ\xab\x1e\xec/\xf7\x8a\a\a\x16{\xc1S\x173\xab\xe1¶Ɋ\x87dł\xf2姒f\xbcfw\xec\x92ݰ3v\xcb.8\x98\x18\xb0s\x8eF[\x95\v\xe9x\x8b\xf3\x99m\xfb\xe4\x82J\x03L>\x85<w<\x1a\xc1\x9e\x82\f\xe4\x9a:3rM\x87\xd7\xcfχ\xe7\xd5*\xbd\xe5\x8bъ$\xe3\xf3\t;\xa7\x0e\xfe]\x93;v\xcen\x95a&Tt\xc6\xc9\r\x9f\x93\x84]PZ\v\xc4m\x92\xab\xd3\x19O\x86\x15r\xc9]r\xc6n(\xad\xcd\xc2@\xa4u\xc7䆭\xd8\xf8R^_\xd9\xf9\x04\xec\x95\x1d\xfd\x9a6\xa5ï\xaa\x99\xe3\xe7\xec\x0e\x04]\x8d\ue814\xf4hw\x9a\x83\x03\xbb\x94y\xbc\x04:Ӥ\x84E̓+\x183\x1f\xb6,@\x89K|iD\xed\xb1\x8d\f\fh\xdbQ\x85Grw\x0f7ϧCP\xbc'1\xf7\xc7\xd3juB+<VH\xb3Rߥ\xf3\x84\x19\xa7\xd5*\xe6\x15\xdb\xed\xf4\xc8\v\x8e|j\xdb\xfex:1=\x18·\xed\xb6\xae\x86]\x11\xb6}\xdcؙ\xf6\x1b\xf7\xfa\xb2\xecx\x04\f\xbc\x95\xd8\r\xde\x1a\xb0u`\xef\x96\xd1Er\x87\x84R\x8f\xb19\x90\x13\xd3\xea՛ݽ͢\xa6\v7\vp\xa8\xa4\x9d\xf9x\x82\x86\xb80\x1b\xfb\x10\xdf\xe0\\\xb0\x887\xe1O\xc8[\xf0g\xca\xdb\xf0gͻ\xf0g\xce{\xf0g\xc9;\x18~;\x95\x98\xa5U\xcd؊-\xd8u\x865\x11\xa2\xb9Gf\x94\x9dq\x97\xdcPv\xcb\x03\xb2b\v\xca.xL\xce\x10\xc4\xd9)\xbf\xden}vœ\xd1)\x99\xb1\v\xeaD\xdb\xed\x1c\x9f\xeb\xda\xdcwx\xa1@\x1a&\x7f\xb9ݞ\xc3䟁\xed\xe8%\xbf%w\xfc\fa\x9c\xddP8|\xbd9I\xe8\xd5\xf8|\xc2/SS\xd7K\x1d\xfaUY]\x1d\xb5\x1c\xbd\xc4h\x13u\xd4q4\x98\xca\xf7\xae~?\x97\xefMgC\xae؝2[(T\xd6֕5d\xe6\x9eά\xeaXC\xe0\xe4p\xbb\x9d\x8e\xa6\xceU\x1e\x164֝\x92:e+w\xedLI\x832\xa9\x04\xe0LI\x932\x90\xe08SҢ\f\xb5$\x9c)iC\x86`\xe6LIG>\xa1\x98ԙ\x92\xae.\xf9Q\xc0\xd9\xe9LI\x0f\x00\xaa\xdbk\xb4;\x8f\xe1bɧga\xb6\x05\x91\xa6l\xb6[\x1d\t^\n\xa6\xe2\xf4,a~\x1a\xfb\x94m\xf8xbJlA\U000ffcb1\xed\xc6ɸ\x91\xfb\x00&\xbeu\xfa\xac\xce\xd6<&\x96\xf1\xc1\x02\x84=\xddn+k\x131\x8f\n\x14͔\xa6G҆\x15\\\xd5\xc1\xa6\x93\xb0\x1c\xa6\x87\xb5\a8 \x06\x17\xd4hZ\xbd\x7f~\xd96l|\x123\x97\x98\xb8\x9cR\xcab\xf4\xdf\x1b\xf3\xa8\x1a\xd3a\xfc\x9cׇ\xf1\xf11@\x17\x1a\xbe'\xb6\x9d\x8c\xe3\x89\x19\xc5;ζ\xfdqc\xe7l@\x85\xbd\xd3\xed\x1f$\xa7\xe4^\x1e\xf45\x92\xeb\xb4Z\xfd\x8erC\x81\x86\x1a\"\xb6\x0eQ&\xaaI\xf79\xef4\xb6\xdbJ\x9e\x1c\x93\xb30NÇ$\xa6\x87\x140\v\x1b{\x93\x920\xbd\xf30t\x1apv\x83\xcb\xed\xb1\x98\x90o\xa5\xa3H $B\xa0\xb3@=\xa11x\xf4X\xdf#\x16\xcb<\x81\x8c'\xe34\xbeI\xa5\x02\x0e^rCPV\xb3(\xd6I\xb6[\xe3\x8b$c\x1b;֠\xb2O\xedn{0x\xec\x96\xd4\xef\xabIVX\xd2\xcbpg\x0ea\x1at5\xdb<z\xda\x04lC\xefC\x12\xc93|*i\xc35\x98\xae\x034\xc7\xf0g\xc9\xc5h~\xdcp\xeal\xc6\x05\xe0\x81\x86t\x1eۤ*&5\x80\xf5\x12 j\r>;\xd6\xe3\xe5\x84-\xab|\xa6\x82\xaf\xc3\xc7*\x9f11Z>\xab;\xf3g\\\xbb\xa9\xf5\x89\xf5\x11\x15\x02\xc0+\x18\xb8G\xbb;Bw\xacG7^\xb2<\n\xc2#/\xf0\x12\xcf\xf5\xa5/1\b\xc0\t͉\xd1\xf29\xaf;\xf3\xe7\xcb!TKe\xc3`\xf6\xcb#\xb2a\xb2u6\xcdb\x8en\xf2\xe8\xca\x17\xf3\xc4\xd9\xc0A\xc5\"o\xb1\xc4gI\xefuz\x83\xfaËPv\x9c\xa7\xc4Z\xaa\x06\x9eb\x15\xf7vxX\x1c\xa4\x8f\x98\x80\xc3m\x06O\xb7\x84\x05\xf2\x9c7܈\x06\x0e:\xa7\x05\\\xe3\x93\xe9\xf1\x86\xd5),L}\xb8y6\x1dn\xaaU6\x873\x85\xacٜ\t\xf0^\xa0G\xbdִʜ\xadw\xc8R)7\x12\xc0s8w+\x1aO\xa4ܟ\x82\xbaw\xab\xdc\xd2\x04\xa6\n\xa6$\xb3\x9bgn\xe9\x1eI#J\x83G\x99褙\xf6/z\xd6\x1fI\vU'&\x82\xb9$\xc0\x00\xa7>\xfa\xe1\x95o\xf29\xa1;\xe6\x15\xea\xd6s\a\xe0\x1bfm@\xd0\x14\xf7Y8\x94\x19\x02\uec88\x8b\xb1;\x19\x82\xb7Y\"\xc6\xc1qc\xc2\"\xfa\x1c|H\x8d\x03pa\x84\x81҂\n\xe7n\xb5\n\x1ed!5J\x0f=\xb1cq\x89\xff_\xddz\x98\xba\x91\x02\x88Џ\x1e\xaf\xb3\x18=\xb2\x85\xdbm\xfc̅\xb6\xbcj<\xe1\u07b3ж\xe3g\xee( \xc9؛0p4B\x9f\xf1\xfa(\x19{\xd5\xeaĉ\xf0\xa6\xe4x\xcf\xc2|J\xe6\xe8\xcaX&\x17nQ\x83\xd6c\xb7\xeeNSi\x91\xc9[\x86\x97]\xc5\xe3\x14q\x1b\x98\x9a\xf9\x1a\x86\x1f\xbaZ\fS\x19\xb6\xa0\xcaC\xb6\x81\x9c\x19\x01\x14\x82\xe9\x9c\xfb\xc0\xff0l\xa7\xc1\x9f\x89\x87\x9f\x01-r\xceI\xc2\xe1\f\xa2\xb2\x80\xbeO0#ج/\x15\t\xda\xe5\xb46L\x02}T\xc4,n\x00\xa0(%\xb2ʺ#%\xcdp\x1f+5\x9c\x01c\x18IGt\xeb\x83Cճ\x88\xb99\xf3jw\x94\x90\x80D\xe0\xe0\x8cEp\x02;\t\x89\f\x83\xeb\x10\xae\xfe\x88\xfa,\x14u\x83\xc4\xea\x80\x18@-L&\x81`!W\xc6\xcf\xd2f\xb5\xce<~\x0fw\x1dg\xff\x10\x84\x1b\x8eS\xa9\xb8\xd5\xean\xc7d\x9a\x99+İ\xf7C\x0f`}\xaf\xb0t\xdf\xcb2s\x18\xe21\xb2wt5wT\x8fK\xd0\xfb\xdd\x03ܤJbە\x90\xa6ĥ\xc4\n\xb9\xa1\xdc\xef\x86ni_\x1e\x1c_\x84\xa3\xd8\xed\x98 \xaeٗ\xd4\xcdʎ\xf5\x9b\x9dV\xf3 \xcec!\x0f\xc8\xfd.s\n\xc4\\\x10\x9cY\n\xf9=B\xb4 c\x8a\xf5\xc1\xd9\vr\xc0\x06\xbdG\x8e\xeff\xbf\xaf8\xae\x8a\xbb\x88\xf7\x1d\xe8a~/\xea\xee|r\x17j?*I\xfc\x86[\xa7\x9a\xbc\xb38\xf7\xc8\xfeڥ\xe4ߎ\xd0<s\xc4s\xf6v1\x8bX\xa0wr\xba\xdd\xc4\xc8\xfa\x9c:ks\xd4\x1e\x15#\xeb\xdd\xc6\a\xb3\xfe\x82\xa2\r\x89\n\vnl\x06\xf0Tk,ˎ$܇)\x8b)\x1dE\xcef\x04X\xc0\xb1\xdek~)\t\x90Х\xe0:M:i\x17\x82\x8e\x8c\x11;\x01\xd0n\xfd\xee\xa0}pEa\xf1\x94\x8d+L\xba\x9cG\xa2\f\x97\x7f\xbd\x9d\xba\xf1L\x8aV\xa7W\xb0\x12'_\x82/\xf1\x1f\xdd\xe4h\xfcwg\xf2Gg\xfc\xf7/\xc1\xe4\x8f'\xe8F\x1b\xedkC\xfa\x10\x9bԳ\xed\xe2t(7\xde\xc3\xe4\xf8xH\x05:\x8as\x99e\xd1\f\x81\xefX\xbf\xd3mt\x1f\xa3>\xbb\x9dA\xbfOks\xa5\xd1\x06\x16V\x92\xf0\xebu\x9a\x9a\xad$\xafұ\x84'uQ\x1e\xb4\xea\xa06\f\x84P\xbb\xd7\xeb5$[i\xd0n\xb7\x1a\x9a\xad\xd4\x03^\xf0\\\xea\x15\xb7{\xb46w\xe3\xe4\aq\x87\xec\xa5v\xa7-\xd9KKP\f`+\xbe\x04]\x85DD\xafr\x1c\xda\xfb\x85H^d\xf8\xde)\xe0\xc4M\xea@)\x17C#\xa0\xf7p\xce/)\x9b\x11\xc1\xd0Ϭ\x93HƁ\x13\"\x91\f7\xbf(N4\x0f\xcaw\xb3\xe7\xd8\xfbU8\xe0\xac{\x8d\xee\xb0\xe0\x15\xc30\xa0à\x00xJ\x01R?\xec>Y\xba\x89#\xd8\xe9\xf9\xc5ٻO\x1f_\x9f\x9d;\xd1\x0eM\xe5\x97|\x9a\x1dCl\xc1W$\xa1\xecz\x8f*\xc3%`!s\xf9\x02\xa0\xd5\xe3\xf9@\xe5\xdeȓ\xbc!\x1e9D*\xf8r\x8f߫a \x13\n\xf8!W\xe2\xceI\x94\xf3\ue201Q\xbf\x17nb'P:\xc1\f\x11\x9b\x1a\x9b\xb4b\x9e9\x95Ǝ\x81\x9ax\x14\x83\xeb\v\xf5\xc4=\xca\x02\xf4\f\x00E\xe0m=rq\xfcժ#\xd4\x03\xb3^Y\x15.#\xb7J\rh\xf0\x1c\xefQ\xcaĎݕ:\xdb\b\xe4\xf0\xb0\xc72&\x80\xac\"\xbd\x9b\xa6\xf5\xe0\xa53\xe2\x81\xec\xcf0\x1aF<\xc2\xceHwkW\xe2\x8eg^\xf1\xa3\xdd0E\x90Kv\x8f\xae\x10L\x04\x9e\xb9\x9e[\xa8\xfbm\xaa؍>\xc8u\x1b4R\xc6\xdd3\x0eQFjz\x06m\x9bd/<{\x94\xb3\xa3\xa8\x87\xd4M\xfb8\x92UO\x98\xee\xf3P5\xa1t\xb8U\t\xb6\x1ei\x98r\xa4\xb7w|ީ\x8a\xf6Q'Fh`\x11\f\x82\xb2\x80ߡ\xc4\x04\xefD2C\xc8\xe5z\xa1\xbcC\xf7q\xa8\xba\xa5:5\x0et\xe7\x02s\xac..\"\x0e'\xa4,D\xed\xa8t\xc0.e\x91\x1a\x00@=\xd1/!\xa4\xfbn\x96\x8c\xcf.\x00K\x84\x839>v\x12\xf5\xb0\xd3\xf7\xd5`\xc7\xca\xf8\xe5z\x89\x12\x16\xa5\x8b\x04\xb8\xf9ɜ\xf3a\xc2\xc1\x81\x00\x82\xb8\xea\xe0\x10\xf1\"\\\xe2%_\x15\x15\x97\x90\xf7A\x87\xc0\x84\xd0\x130\xa4\tO\xd2\xe1\xee\xd8ҍKx\xf9\x95\x8ar\xa1/@t\xc2\x00Ң\x11 \xa5\x92\x85Js\xa6\xe2\a\xdbV\xbdر8WĠ\x17\xafe)y \xd6\x1d\xa9\x98\xe8ܻ\xb3Y\x99hA\xe5\x16<\xcb/{\xb6\x06\x8e\xc0\x92Y0\xf1\x16\xcb\xf70-\xad\xe6\x18W\a\nM\xb1_\xe7I\x14\x06\v\xa7\x14;\xf1\xa4j\x1d\xbd֔!\v%*s\xf9\x8a\x04t\xb8\xc1\xac\x05\xbf\xe0*d\xa7D\xba\x01\x93qe\x1c\xc1\xe2\xc4M\x84\x83\x94\xcc\x15p\xe3\x12\x13\xe9\x02\xdȃ~\xd9\xe6u\x8d\xcd\v\x05e\xfc\x00\x17\xb6\xaemG\xd9jF\xc6\x16\xcd\xdcP\xc8\xe6\xe1\xb6%\xe14\xe2\xd1HnO\au\x8f\x13\xb5I\xe9\xc8\x02\xfd68^GJZ\x86\xf8\x86I\xfa\xb6\xb1s\xa4\v\xfdB\x0e\xfc\x9b\xe5Q\xe9cYT}\x9ed߉\xee\x90F\x06\xaa\x80z\x93\xf9\xe4dD#K)QZiӬ\x12I\x17\xa7DR\xf4\xfd~\xa7\xfe8s\xa9\xa5\xe5\x03\xeaDw\xb3\x03y!\x12\xd0\x12\x02\xc7!\xcc\xcb.#qvs\xf3\xd33\x7f\x93\x9d\xf9S-1\xc3s~P\x1ftZ\xf2\x9cW\x87\xfa\x92\xcf\xf1P\x9f\xf1yv\xa8\xb3\x15\x9f\xa2a\x12[\xa8\a\xe4d\xb2k\xc5\x05@ms\x90\xd0\xd4\xd9e\x19\x15,\xe0\x86\xf0\xab\b\xf0P\x96\x8f(\x1e\xbd\xa1;v\xc3s\xb7\x06/\xd6\n\xa8|<ٱ\x03\xd1gWD\xe8l\xe5\xce\xf6\xc7u`W&p\xfd\x18\xded\xe79?\xb0\xfd\xcf\xd2\xed\x8f\x1cuUK2nL\x0e#\x97\xb4L\x19\x82\x90ח\xac\xdah\x04\x17=\x9e8\xe6\b\xa5\xafб`Ʉ>p\x88,\x88Y\xc8\x18o6\x1f\x89\x1c\xafإܬ\x7f\x87@\xcd\xf9\x82\tkPV\xa9\xfc;\\\x93\x9fL\xa5\x05\xe5TZH\xef}\"\u061c\xb2\xa5I\xa5͜;\xe04\xe1\n\xa7\xe8A\x91_!\xb8\xd1\r\x19\xb0N\x1e \xbf\xe69\xf2k\xc5g$\xa1lQN~\xf1\x95\xa4M\\\x82\xbc\xefJ]\x0f\xbe\x02\x186\x1c]\x92\x80\x020\x13(\xe2\x84p\x92\xce&<bb\x97q&\xe6\xec\xfe\xe0į$\xfaB\xc9d\x9c\xe9\x17\xa4\xb7S\x97\x88\\\x8b\xd1\xe8\x92$\xb4&\xeb#\x82:\x91m\xafIĒ\x9a7\xa3\xb6\xad\xcf\xf51\xbc\x97\x00\xd6\x7f\xa8ѥ\x1b\x17[\x84S\x02\x06x\xf0\xd03\x9a\u0096\xee\x1fla!\xd0\xc7\x1c@2\x0e@\xaf\xef\x03\xe7\xe3\"\x8d\xe0\xf6й\xb8\xc8bQSy\xb2\xa1Yj\xb7\xdfz\fA\xf6\xba\xfd~Oݛ\xe5\x15:C\x90\xccK\xd9\xf3R\x90Ӭ7\a\x12)\x1a\xd7\x1f\x89\x1f\xa7\xd9m{\x9d\xe1ϹD\x90\xf5\xb6\x14\xaa\xa7\x97\xadٞ\xd2\xc1*\xbb2-2Tz\xcd\x17\x88J\xef\xf8\xa2\xf4~T\x06\xd3\vvɋ\xfe\xfe\u07bakp\x9e\xb4\x97\x0e\xe8\xdf\x02y\xe3\xe5ȊEb9\x96;\x9bY얇\x10\xbd\xfd\x82\xdf\xda\xf6\xad\xb1\x97\u0381\x81\xe2\xcd\xc9ʶ\xa7\xe4\x16\xf8h7\xdb\xed\x85\x1e\x83mW\xbc\x9cT\x82\x00\x82\xbeM݂\x10)1'\xb0I\x15\xa4\x9cr\xb2\xe0\x89QH\x8e\x82lH\xc2N\xa9B\n\x82MC_y\xd5s\xb0\xca\x14!Dp\x1f\x8bX2>\xd3\b!1\x11\xc2%\"\x04j\x8c\xe0\n.Yt8#c9R\v\xef\r\x16\xb3\xe4βX\xaa\xf1\xc1\xac\x05\x86\x89Y\xba\xb1\xc5,\x192\x06i\x04\x96\x9e\xc7\xe9\x11=ɟ rk`\x03(\xfc\xc5Ҝ\x8ba\x85\b\x90 \\\xd0\xed\xf6ƶUۘ%&\xa7L\x18\xb5\xa4X3\xe4W\x8aj\xcb&a\xa8\x98]7\xb6]Y\x93H\xefo\xec0ƶSb`\xedrNL\x88܄ud\xf0\xa7\xf4)\xc6\rsܝt\xf0v\xb3\xdd.\xc9iJB\x16\x83E\x95\x93\x94\xfb\x9d\xd3ԥ\x14\xfd.xT˟\x0e$a\x82]\xb23\xcaܚ@\x87f$\xedќ,d\xb0w s\xce!rׂ\x05\xe4~ᇗ\xae\x0f\x9d\x98\x87\xd1\x14\xee\xaf\xf5\x1d;\xc7\x1eG\xb5\x94\x82Ų\x97\x94-`뷴\xd7\xea\xa2\xf20\x12+\xa8\xe1\xd3h\xb4\xbar\xb7C\xbcQ\xb5\xdb\xe5\x0e}\x82(\x05\xf4\x97\x12\xd4S\xaa\xcdAm\xa2\x06B\xdc\xfap\xfa,\xf5k>\xd5~\xcd\xd7<\x1eO'C\xa8\x02\xfcע\xf0.\xc2G8\xff\xd6\f\x80}M\x91\xa4\xeb\xf6z\xbd\xe6\x03L\xda\x150\xb9\x0eJ9%ܝ\xd4N\x90\xe3i\x9d\xd4N,X\xfbD\xf3,\xa3\xbc\x87N`\x84V\x1al?\x1f2\xd1\xf4\x99\x81\f\xf1rW\a{\x92˂`U?\x1e\t\x92qKE.\xb6b&gE\xd9e\xa9\xd9\n\xa2\x11\x19\xe5)+\v@\xcbZ\x8dz\xfbQ\x0eW\xab\xd3h\xb7hM\xdfbҊ\x8b\x1c\xafV\xa3\xdfWP\xa0P6\x12\xc2\xcd:\xc0\x86\x7f\x88}}\x98a\x9f2\xa7rw\xa8\xe1\xfe4\xf0\x90\x04L2\xa0]R\xadlX\x047\x00\x8f\b6\xd5{\x01\x00\x88\xfbL\xa0[\x81z\xb3\f\xb4\xd5\xe1\x11\xa6@l\f\xca\xe8d0:d%X\x9bc\x8aK\x1a,\xa2t\xe7\x1c\xc8\a\xccV$\x80`\xfa\xfb\xfd\xde!\x8dQ\xe3\x10\xbf7\x03͑\x86-h!6\x1eiBZ\x16x\x8e\xb4\xe1]E\xda\xdba\x10\x8bv\xfb\xd1 \x16\xfdV\x7f\xd0~|\x06\xca\xceL\x0f՟\x86\x1e`g1\x92S\xe11\x17\xa3\xc9QG\x80\x02\x00p\xf9\x91\xd3\xf9\x15\x14\x85\xd2\xcds%\xfb\xbd9\x90\xd0\x05\xe6&\xbd\x94\x9f\xda\xebH\x8a\x02aY\xeb\xe9\r$A\xd1\uf0e7)\xb6\xce@r\x9e\x91!@P\f\x06=\xb0Z\x9fe\x9a\x11\xab\ff\x17)\xf0\xb3k\xee\xd5>||\xff\xe1\xec#\xbb\xe3^\xed\xc5\xfbw\xaf^\x7f\xf7\xf9\xe3\xe9\xb7o\xce\xd8%_\x94l\x8e\x1b\xbe\xa8}\xfb\xf9\xbb\xef\xfezq~\xfa\xea\xf4\xe3\xeb\x8bן\xce>\x9e~z\xff\xf1\x9c\x9d\xf1YN\\t\xcb\xd5\xc1x\xc1ӣ\U0005c9c7#;\xfd\xfa\xbd\xe3\xb1\x19[\xb0+\xb8!D,a\x9e\xd4\x18x\xc1\u07b3\x9f\xd9\xeb\xa2ޮ\xe0\xa8\xec\xf7V߹\xde\xe2\xf9xc\xdbx\xd8~\xd0\xc9\x1f@\x83\xa2\xa0\x8at\xeb\xe0\x9f\v\xf9\xe7\xdc)j-\x98b\xbd\xa3(c\x05=!\x1f\xc8j\xde\x15\xf8'\x9f\x00\xdf~0\xd1\x18\xfb\xc8?\x8c\xcf&\xdb퇱\xf5o\xff\x96N\xead\xbb\x9d\xd9\xf6\x87\xf1l\xc2\xder\x18\xca\xc7\xed\xf65\x99Q\xf6\x92+GD\x9c'\xb6\xfdA\x93V\xdb\xedG\x18\xf4K\xdb&/\xf8\x86\xbcT\xea \x887)`\u03a2ci\xdb~\x81\xc4\x18\xb0\x00\xb7\xdb\ryA9\xe7\x97\xdb-\x99\x8e\xa6\xe4\x05\xbb\x04I\xf9\x8b\xf1ل\x02Q\xf0\x82\x9d\xb1SJٚ\xbc`\xef \x163\xa0$\xe0\x1e\xae\xc6\xef&\x1c\xbe\\\xdb\xf6\x8c\xf3\v\xdb\xfeh\xdb\x1f1\x96P\x05_Iŵ\xed\xbbќ|`\x16\xa4Z\xa0\xb0F>\x01\xf7\xf1m\tT\x84\xe4\xa3dա\xc3Y\xe0\xfa\xbe\xe7\x92G\x12;\xaf\xc9\x052\xbbcg1z\xeb\xbc&\xb7\x94\xa9\xc1;\xaf\xc99ݱ+\xe4\xfc\xfd\fk\xfe\x9e\x02a\xfai\xbb\xad\xc8\xf7\x0f \xecY\x92\x0f\xecg\xf6~\xfc\xf3D\xe9s\x06\xe4^\xb1\xa7\x12\x86\x13cP\x18P|\xc7ާ\x94\x89kە+X\xa53\xb8$\xbf\x85Y\xf9\xc0\xce\xd8[v\x0f\xc3rf;\xcaV\x80\x18߲\xf7p`v[\xedR\x1c\x9d\x19z+J\xc4M-\xc2\f\xf2\xa36\x7f\xf0\x80\xd7fhh\x1e.\x1fAKi(\xb5\xe9Q\xe8̈́\xe6-\xb9\x80\xcb(h~3<!~\xc31\xae\xa6\xa0W\xe1\xe5J\xe6\xf7;\xd68\xc4r\xec\xedv\x14X Tjx\xb4ZO\xd6\xdd\xd7\xfeWQ\xc1˥\xb6\x1d\x127\xefE\xfb\x11\xb9\xa97*䇫\xe7=RY-\xf0\n\x99?\xb7\xee_\x9c\x9f\x7f\xdc\xf8\x02<R;u\xf6\xe2\xfc\xfc\x1c\xbcW\xbf\x14Sߍ\xd0\xfe@\xa6\xfe\x05fUg\xf2=\x11$\x1f\xc54Q\t/߿ͿI\xb9`\xf6\xfe)\xbc\x12\x01\xbe6\x18\xb0\xdd>En\x10\xcfE\xf4:\x11+\x95땗v\xe2\xfbOoߜ\xfa\xfe\x8b\xec\xf6#\xd3\xf6\x12^\x85\xd1J\rR\xa5H\x87\xdbY\x1aD\x00pU\xb5o\xbd\x95\x00\xb5-D$N\x9d\xbdsWb\x06\x9e\x9bߺkx\rgBu\xf1\x83\xeb\xc1\xf8~وX\x0fꃿYxA\xfa\xa0\xeb8\xff\xcbwo\x90\xecU\xd9\xce\xff\xf2\x9d\f\xb4\x98\xbdC\xb4\x88s\xb10\x12B/H\xb2\xd7\xdc\\\x9d\xff\xe5;97a\xa4'\xe6\x1c\xe3\x84KK\b\x9d\x04Kt\xbe\x14BW\x03\xde\xdf?E\xee\xf4\xea\xc5F\x14\x93\xf4{\xb8\x99\xaa^\xa2\x86^\xab\xd4r\xb0\xd9\xef6\xc0\x9b\x1f\x1c\x16's/\x12\xf3\xf0\xf6\xcb\t\xf92\xab\xd2\x13/\xb7W*\x81mW\x03d\xf35[\xadf\x11\xae\xf6\xcct\xa4\ac0\x02\xac\xb7\xbb\x87Z6\x1a8y{\xfe\xfal\xfb)\xf2f\"HN\x94;f\xf0\x16\xd0\xee\xd5\x0f\x157\xd8\x19fM\xdeڝm\xbd\xf52\f\xc4\xd6[\x87\xb3Ի35⼆\xb5\x0f\xe2\xf2\xd2\a\x1b\xe7f\xaf=8ԄY/\x199Ūi\xed\x8f\x10YHH\xaf\xf2YC`\xd0XZ\xa9RO(\xed\xb8\xb5\x8eBp\xfbmq\x1e\xa0\x8c\n\xdf(x\xb7\xaa\xb7;O\xe9፸\xac\xc7dT\xa9\xfdq\xba\x8c\u0095\xa0f\x8f w\xa9\xab\xdcn\xc1\xd09\xf5\x82m1+\xf5\x83m\xd1\xedֲv\f\xd5Q\x8bՠt7\xc5p^\xba>1w\xf50\x98\xcf\xdd\xdaK\x11\x84l\xc3cێk\xe0\x8f\xc7\v\x83x\xbb\xf5m\xdbׯl\xca7\xb6\xbd\xa9]\xf7\x87S\f\x1bJ\x02>Ց\x0ek\x16\x05M\xa0\xe7u\xdb\x0e\xc6\xf5ɳ\xf6\xa8\xe1T\t<\"dR\xca*\xa1m{p\x1e\x93\x80{\x1a\xae\xcff\v\xa1\x81\x1a\xb4\xa5 \xefs\xdekS\xdb6\xb3\xbd\xc0)\xcb2b\xf3\xb2b3\xd8\x14X\x90\rJ\x1d@\xe76\xd3)\x80ŏ\xe2\xf2\a/QU~\xa9\x9d\x1c\xdaQhn~\xf0 }\x18\xff\acQ\xb5R\x82֚\xa01\x10\x986\xe67\xe8\xd82\xad\xf6\x90\xdfc8a\xb0\x98\xe5\xc5\xc6]\xd4b\xd6Z}z\x1d\x9f\xa5\xd7\x1a\x8bY\x10\xb7d\xea\xfaBYóT\xbbF3\x8c\xdeϭ\t\xccQ\xaf_\x7fX\xc7y\xef\xc6R\xa2\xaf,\xb8\x8ey\x94\xea|T\x88\x85\xfa&\xe0V\x1d\xc8\x00r\xd0$Lfc!i\xb0\x1e\xa5\xac\x877kLD\x1da\x867\x98\xaf\xb0FR\xca\xf0n&o\xf2\xb2\xbbM\x9c2Xjsy\xd1\xe95;\xca I\xd1C\xd3L\xc9d\x9d\xddo\xe6\x19\x95\xb4,\xa1\x82LV\x99\x8a\xea\r\xa4c.Jf\x1a{\xa6(OV\xe4\x7f\xdd1\xc8v!\xad\x11\x1a\xb94\x12Q\x99\xdc,$\xb3\x80\xee\x8a)\xccM\xd3B\x98\xbd\xbc\xc2}*10\xc8\xf0\xdcU y,lIȔш\xb4~\xe2Z\x9c\xc8θ\xa8I&\x19\xbb\x95K\x99\xb0\v]7;\xe7g\xa3\xc0\xb9\x1d\x05㛉C\xe0W\xc6R\xcbZ>\xe5g\xa3\x8d\xb3\xc1/k\xb2a7\f\xb4\xdeo&슟f\xd9P=c\x86\xda\xfc4\xe2\x15\x9f\x9c\x8df\xceM\x95\u070e\xac\x9a\xe5X\xbf\xb3hu\xc6DMR\xd0Զ\xcfm{N\xce\xd9\fn\xa2\xa7p\x95\x89l\x9b\\sQ\v\xc2O\xd8\xef\xefD2\"\x97<\xc6LԶ\x95-\x94s>\x9eM\xf0&k\xdbףk'Qe\xd5\x01\xbaH\x8f\xd2\xd5vK\xee\x94\xffPێFS\xb2b\x01uD\xed&rא\xb0$+\xea\\ضGVt\xe4\xc2ˊ\x81N\xcf\xd2]m\xb7+\xdb^\xa9ǅm/\xf0\x91\x82\xec\xe3\x8eY\xf0,\xe3I\xae\xc9)\x9b\xb1;\xca\xe063'\x1b\x16\xf2\x1b\x13\xadP9c!\xcc\x18[\x93\xcd8\x9c\xc0*\x01f\x8c\x84\xeb\xdb\xf6\x15\xdc\x1cƳ\t\xd4|\x85\x9fP\r\x1f\xf7\xfbAމɨ\xabT\x0418s\xa9\xad\x15\xf0#:\xddz\xf3\xb7S\xf4e\xb6\xa4\xe9E\x11\x83\x83\xa8\x0fa\x10\x93{\xc9&\xde1\xdc\xed%\x8d6\xfaMP;\fK\xfc\xbf2W\xc7X\x04j\x1e/\xa7\xc3\aH$\x152Զs\xb1C\xe1\xba3\xf2p\xb1\x89KK\xee\x1a\x9e\xccGr\x810)\x98\xce\xf7K\xed\x96\rٸa\xf1\xa0\x86\xe1\xf1\x80\x04\xd8أ*\xbf\xa8\xd4`h\x11\v\xc7\xd5\xea\xe6\xfb\x9d\x14i\xc4E\xa3\x93h}\xdd\xecw~\xc3R\xca\xc3\xc0H\xd8\xc9\x19ڏ\x19[1]\x96\x15\xa2v\x12˴\x05G\x1b\xe1~\xab\xde\xff\x8a3\xc00\xb2S\x93YT\x00\x97\xa8\\\n\xcfzݎ<\x01\xd4lOyP\xd3`\xc3\xd6\xd2\xf8Nzۅs\x00_!\xee%\x9c\x04\xf7;P,(\xf0\xf0\xa4\xa4s\xc9\x12j\x1a;\x8c'LF\x13N\x86a\xb5J\x03PU\xb3ܱU\r\xab\xd6\xc4\x1a.\xe1\xc2>%\xd6\v\xe6Z\xcc2\x90\xf9\vbU\xe7$`\x16\x83\xb8&\xd4J\xb1:\x94 \x02=he\v\xb2\x19Mq\xcaK\xa4\xa3njYe\xe0|\x16p?;\x90\x18\x1e\xfa\x85\x05\x8d\xf8\x9a\x04\xccȕY\x98\x14ϸp4#\tK-\x02\"\xea\xa8\xed\xa2\xfa\xa9\xf7\x06\x86\xb9'a\xd6\r\x1eQ\x16\x82f:\xf0(\xbfn;\x177p0\n%Ѕe\x10\xafv>\ts\x10ϐ\v\xfa0\x1f;\xe5\x91\xecw\x01\xf6\xa7m\x97\xfa\xcdʼq\xa1\x91\xb9\xab8O\x00z1(҆+\x91,U\x00\xd9ܾ\x81l\x92\b'\x95`\xbb\r\xe0\xdcHK\xe7\xa2\xf9\x9a\xfb\xf1\xfe\xec\xa7\xd7\xe7\x9fΝ\x98I\x06\xab\xe33\x93\xbd\xeal\x10ٷ\x9a\x83\xaf\x9bcD\x990\xa9)\xc6d1\fY:\xc3&\x1e\xf3\x0eq\xf53Tc\xdb1\x119N\xbe\xf9\xed0\x02\x15\x05\xdcT\x1eo\xc3`f\x19\x92\xf3T\xe3\xbcT\x93]БVw\xda=\x86V\x8b4۳\xe6\xc8#\xc1XL\x90\xc5\x05RM\xea\xc0+\xdcy\xc4d\x8c\x01\xb5\xf05\x94\xaf \x06\x04#\xf6R\xab\x8b\x81\xe2\xd07\xdaM\x8d\xb6\x14\xd7\xdc+\xb5\x898\xec5BEZ\xa7\x06\xe1\xe7Qt\xc5\xc1L\x8e.\xddn\xdd1\x94\xc1{\b\xd8\xd9?\xe2I#\x13\x1d\x18\xf8\xd4\xd0\xd0R\xfeK\xfc\xccV\x7f\xf3$\xb7\x1b\x85H\xcb\xd9\xe4\xfaDP'\x016\xb2\x9bɔ\x8f<\x12\x92\x88\tJ\x95C\x8c\r\x89s\x0e1\xbcD݀Ь\rf\xb3<\x0eR\xbf\xf5\x98\x89#\b\x964\xba\x92\xd6\x00\xd1H\x82\x8a\x13\x90H\x86a\uf5d8.\x96C\xb7\xa8\x81\xbd\x18G\xab1\xdb\xce\xd93\x05\xfb\xbeS$\xf1\xfci\xe9Ŷ\x9d=Õ\x98\x1c`\xe0ض\xfc[\x9e'\x16\xfeܶ\xe1\xb7\xfc{T[\x80\xf6\xe2\x82\xe6l5M\xd9\b\xa1ۭ\xc6\n\xc42\xbeX\x94\xc0\xf1\f\xa8\xf1a\x9aF\x99n\xba\xd2\xf4$\x7fޛk\xa1\x90\xa8̰\xdd\xe6\x97&o\x83\x82\xa6L\xe0f\xb5_\xe4\x9e\"+\xad_Ό)\xb2s\xca\x16?\xa8\xa9\x80eR\xad\x13\xa3\x96\xd96i\xf0=H\x1d\xa9\xaf\x00\xad\xe9#K\xa84\xablw[Ob\xe5h\xee\xb2\xc5\xd2G\xc5,\xb5\x90#Ԯ?r4\xc9\xeb:\x1a}\x03[;O\xa1\x81\x1d\xd2W\xb1\xd0]bͼk\x8bJw-\x87Y\xe95Wʽ\xc1\x14\xf7a\xfca\xd0c\xaa\xaf9#\xa0\xd4\xd4\a\xa3\xef[\x92\x91d\x0e\xc2-\xe9\x7f%&֯\x16^\x1c\xf78 \xa4\x0etc\xc91\xa4\xdd\x03r\xf4\x12\x02X\x06\xecT\x1cy2a\x1c\x8dV\xbd\xf90$\x9b.\xa4Z\xf5V]R\xe7陙ZU\rC4J\x00\x13\xc3Dr\x8b\xe9v[L*\x15\x15@gLfV\xa1\f`\xec^\xa95\x90\"pÔmq\x10\xce\x034E\xb4\xa6\xee&\x96\x91\xef@\xa2!\x98J``\x88\xb4\x89\x85Ds\xdd\xf28\x06\x99`٤\xb6a;>Dm\xa7Jg\x1b\x95\xa5+9.\xe0\xc3N\x8a\x96\x1b\x9d\xbe\x12-\x0f\x06\xedF\x9f*\v\x9d\xae\xb4Щ4؊\xcf!j\x1f\x06\xc5\\\xf0zΖEjq\xae\xb4\xbc\xe9^\xa2\xba\xd7/\x1d\xeb\xbdU]T\xab\xecF\xa9\x18\xa3셂\x95\x88\x817\xa4\x06\x90\t\xedwJ+(G\x9bA7\xa4>\x93\xe0\x9bڜ%\xf2N\xa0\xb5\x86\xc1J\x7f\x18\x8dW\x13\xde`\x82DT\xa1\n0Ԯͳ\x8ar\x96А\x0f\xae\a(l\x92\xf9\x87\xe13\x17o\np\xac\xc3e\x81\xf3\x15\xbdOH\xc0B֠\xca\xc4\\\x9fP;\x96\xc9\x0f\xb5m\x19j\xb9\x17\xf4\x94\xee\xf3\xd4)\b[bgZ\x9b\xe3FV&Qξ-\xa3\x97\xe9mZ1\xca\xf8\xb2\xd3C\x8c\x84\xb3\x1fNY\x8c\xacs˱>X\xb4*\xb4\xea'p\x1b\xb0\xba\xb5Q\xdd+\v?k\x1de\xeb\xcc\x1a^\xc3\x06H\x15<V\x93\x9a^\xc5\x1d3\x94\xc4K\xbay\xa0\x8dJ=\xd7D\xa5\xb1߂\x06\x8b\x1d\v\x83W\x91\x10\xbf\x8a2:ui\xdb3\xe0\x9c\x80\xf5\xafj\f\x94\x92\x05\x05\x05\x94\xa1\vK\x0e\x93\x8c\xea\x92e\xccw鹠_o(\xaaIaH?\xdbC\x9bl\xe3L3\xe6cA\xb3]\xa1\x1d\xdc\x17\xedf\xb7)U.\xd4\xd6[q\xb5\xfeG\xae\x1f\twv\xa7\x9d\tx\xbfB\x9c\xde\x05\x8f3\xb2\x8c]\xf3X\xbb\xe6\x84)\xf2\xb6۹4D\x90'\xe1\x1dW\xaf\xdb-QO\xa8\xe6~M\xd9%\xf7\xc9\x1d\xdcu@\x91\x13\x1e\x97n\f\xaa\x9b\xf0\x18\x8b\x84\x0e\x83}\xcb\xc1\x1br\xc7r\x91\xf7\x17d\x95]%ksw\xea\xce\x04\x17\xec\f\xf2\xa1\x05\xfcμ\x8df\xebp\x89\x15\x01\xdfp\xc7ܲ\f\xb2\xa5\x1dj\xfd\xe1Hn9\x86\xfcv\x13\x88\x8b>\x1b\xdf\xc2B\xb1\x92.\xae\x89`\xb7O\xe8\xe2\x14\xf2=\xd4EY\xd1H\x8co'\xce\xc1n\xcaL;Ì\xf8\x1eT\x8f\x03To\fQ\x9f\xdae\"\xc0\xfd\xeb\x1c\xba\u0084@{\xc0\b\xee\x01\x9f\xa5z\xb9\u0383\xfe/\x14\xa5\x83\xfbbC\x128\x99\"\xd4!\xa45\xd8\xc0\xc0\x83W\x93\xb0\x90\xfe\xefVk7\x01\x96\xdcQ$\xa6»\x16\x11;\xb2\xaa\xa2j\x1dE◍\x17\x89Yf\xed\x19\xed\xa4\xf2`\xff\xb0\xee \v\xb3+\x0e\x1a\x1f\x1b\n<\xde\x13\xa3\a\xe4,x+\xa8iJBi\xc3\xcfQ\x8b\xd5\x1b\xbb\xd2\xc5\v\xd0b\xf5r?\xabH\x81\x18\r\xe4\xa2\x19n\xb7\xfb\xed\xa5\n/\x90&\xbd\x10\xf6:\x0fpN\x8b|\xaf\fA\xa2Nx\xb3\xdf~\x98\xda0H;\xc3|Z^\x19cEL\"\x02\xe9K\aқ\xfcI5\x05\xb6\x13\xba\xebQ\xdcK\x8be\x12&t\xdbs\xf2\xf7/\xf1\x1f\xc9ș\xfan\x1c\xa7\x03\xa6_.Oؒ\ad\x8eё\xf1䕏d\x038\xa6p\xed\xac\xb89\xc5~C\xaf\x13\xd8\xd1S&\xc0\x98a\x8fk\fڜ\x8bG\xaaR\"\x13<\x80PLb\x9d\xc6w\xc14\r>\x86\xbaR\xd6w\"\x90\xf0SHǼ%\x1f\xd3\x0e\x18=\x9dm\xb7\x95ʒ\xcc\x19\\=KY\xdcÅ\xf6\xd7n\x10g\x955\\\xaf\xf7\x98\xa0\xc3\u0530g\x85\xfc\x12\xba\xddVV\x8a\x9f-\x9f\xcd\"\x02\xce\r\x10z\n [\x17\xce\n\xa8\xeaf\xa7\xf9\xb0h.\xa5DO~\xb7\xfd\x92\xed\x97/\xb5\x93=\xbf!\xf2^㏁\xd0M\xef\xb5\x11\a\xa7MQ\x05d\xb9\x046\xff( \tu*\x95\x04L\x80\xb8W\v z4\x1c\x1ce\x1bOY\x99\v\x9a\x86Wv\x19\xc8~k {\xbc\x11\xd1\v7\x16p9\x04\xa5䙛\x80\xbb\x03\xb6\xe1^\xed\xdd\xe9\xa7\xd7\x7f9\xe3\xd6;\x8bMA\x11\xf0\xfd\x9b\xbf\xbez\xfd\xe6\r\xb7>X\xc6F\xf4`\xcf\x0eڍ\xd2ȵ0\xec\a\xb1¾;ґ\xe4\x8cpᨍ\x8b*\x90\x85\x8d\v\x87w\xb7^\xee^ؠ\xadս\xc5\xfd:U\xe8a\xea\xfd\n\x9d\x87\xa4x\x8b$誅\x8e*\x95ı>\x8a\xc5\xd9\xed\xda\xe2<D(\x04)q\xb7\xfb\x88\x1fY\xc4\x01\x05\f!}\x9fJ;\x11D\f\x99C\x05\xa3\x93q\xd9\x15i\x8f\xc0ە0\x93CbIe\xaf\f\xefK\xef'\x9e\xe9\xf5D\xed%\xf0\xc2S\x1f<\xe2{\xb6܋d9\x8f)\xf3\"\xa9\xbc\x11M\xd3!\xaf3ϑ\xf3\x8c\x1b\xb5\xd4.MЇdF\x00\xad\n\xfbDZA'\xe1z-f\\\nMk\x91\x887~\xc2\x13@V\xabG\x8e%Ӗ>\xef\x16\x129\t`\x14\xc2\xcey\x85T\xa2\xed\xb6\x12\xd52\xeb\x10\xcaN\xb3\xe4\xd7穂+eWF\xfa\xbbOg\x1f?~\xfe\xf0\xe9\xec%e/\xe0\xbcf\x17\x94\xbd/\u06dd\x01\xa8\x18\x06̒[\x18#\xbf\x039\xb3\"\x80\xbc\xe8\x8e\xfd\\\xba\xa5G\x88h\xd9\xd5\xe8\x05\x01\x8bC&ƍ\t{O\x1d\xe3\x95R\a?\xabd\x8a&8\xa74\xe0\x02\xf5\x18\x11\x85\x93k>\x87\x85W$\xc4\xec \x97\x0e\xca\xfa\xe4Z\xc9I\xa4\xb9\xe5\x86\b:\xbc|~7\xbcS>\x03o\xf8\xcfD\x8c\xef\xd0UΔ,\xd8M\xca\x0e\xbc\x19\x1ar\x92\x15\xba`\f8\x10U\xd7\xd2e֙2G\x1fV\xc8-\xba\xc1\f\xb4\x1bL)҄\x9ao\xa5\x98\xd7@\xf88o\xca[\x8d\xa0\xbb2\xef\xc67h\xeeR\xe8\xccn\xaf3\xd2\tz\xa9\x8fn\x04\xf00\x03p7e\xff>\xa6\x1c\xce\xe2!\xe0\x06<dq\xb2=\xee\xc2\xd5]\xed^*\xef?j\x00 \x02T\xab\x10e\xf4\x99\xc7e\xec\xbbl̀\xfb\x98\xc7\xc5\xee@Q\xb0\xa8S/^fk\xe8Q\x06\x8a\xe8\xa8\xd6\xfd\xa0t.\xbd\xfc\x18\xf6k\x86\xb6\xb9\xb2{\xc8i\x9b+e\xf2uF3\xce3\xc5\xf5%_\xe7hF\xe0\v\f\xc7\x13\f\v\x02q\xf7a\xd1-/ .W\x89\x84\xd2\x11\t\xf9\x86l\x88{H\a\x99\x04<\xa4\x0e\xdc\xed\xa5A\x17\xe7\x01\xf0\xf6\xf7\xe5\x9b\xf7\xa9 +\x18/'R\xbbYZ\x84\xc0\xe9\x1d\xf0\xfb\x9d3\xc7\xea|\x12P\xcab\x02\xd9\xe8v;%\x01[2r\x80\xc7JM\x96\xcf\xfd\x9e\x0e\xbc\x13\xb0r\rxg\x06\xf4-\xd0\xd2%lPD\x8f\xa5\xce\xf2\xeb\x9d\xdec*KD\xfb\x17CG\x94\xddF\xeb\xc0\xa5\x96\xc5\xccg@\xe6\xad3\x8c>\xcf0\xfa\xd2Թ\x81\x9bj\xbb\tG\xa9r\xb5\x02(\xba\xd7\x1eH\x03\x01P#\x04\xfb\x80\x88\xa02\x1d\xfa\xc8%\xa0\xa3\a\x17\xceu\xed\xed&A\xe5\xd7\xf7\x97\xb1\x88\xaeE\xb4ݮkRy\xab\xf8\x85\xdd\xf0u\xa6\xb4{\xc6ש\x82\xdb-_\xeb\xc0)\xe8\xacwͬ_6b#\xdez\xd3(L\xdc\x18,\x10\xcfA[\xfcB\"\x86\xe19\x88\xfa\x8b2Q\xc1\x12T@\xb9\x03k~~V\x9b\x85+\xd7\x03\xcdE\x98Q/!t\b\xce\xd8\x12\x1e\xd6\xe6\x01\vy(\xd1\x10l\xd9\xc4T\x9f\x90;*\x1cy\x84:\xae\xb6\xc3\x17\xbb]\xf6\x8c5\x06\x89\x88\x80\x94Zm\xb7w\xdb\xed\xf5v[\xb9\xdcn+7\xa3\xca¶\xd1(2\x12q\xe8_\x8b\x11!\x1b\x9e\xbe)\x12\x83Ҝe\xd3-x\x90%\x9bZ\xb2\x14\x01\xdb\xe4dc\xf4~J\x02\xba\xa3\xce\xdd(\x97z\x86\xbd\xff\xe4M\xaf\xe0\xb3Cf|Nfl](;\x93e\t\xa2\x12\x9f\xdf(ug\xd0x\x05%^bY\xf2\bB#\xe6P\xae\x13\xf1\xd9\xfdt\xe9F\xee4\x11\x112u\x80\x0e\xce\xd7\xebK\xea1\xe6\x95\xc2\x1e9\xcf\xdf\xcf$]r?\x0f\x1ca\xba\x99\xd9\r\r\xcf\"\te\xe1vKB\x9e0\x8fP\xc0\xba\x18\xd8n\xd0|\xc0\x8bUyȝ^\xb3]ZF\xf9\x01\rK\x95%*\x0f\x85V\xdac\xd3Kd#\xbf\xa6\x1a\x14\x95\x94\xec\x06{\x02Y\x1f\x11Ԑ\xc1\xcb\x02\xf0Y>\xa9`S\x81m\a\xcf\xda\rd\xf45\xfb\xed\xafpm\x9a\x1a\v\x85\ad\x90\xa5J \xb0ԟ?\xbe!\xd6\xe5\xc8\xe5\r\xfb\x927\xed)o\x81Jc\x92\xac\x9d\x93\x13גn4b\xe1F\xd3\xe5\a7rW1\x8b\xb8eڤ\xb9\xc9\x12\xe4\xddܚ\xfe\xbeY\x9f\x01[Z\xd9\xc3\x16\x1d,%\xdaFݺ\xb4(\x8b\xaa<\xa8\x02.\x06\xb3\x90\x8a\xa8%\xa1\x8c_WI0\x9c\xe1v\x9b\xf6\xe1\x04+\xc6\x0eB\xe7P\xf5p\x19\x89\xf9vk\xe1\x1b.\x15\xb1\xa6\xa8V\xeb\xf2\x06\xa4\xa9\x05P\xe3;7\xbaO,\xa8ɂ{\\\x05\x1c\x1dB\x19(\x91N\x054\x1bC\xbb\xffvi\xd1\x1a(\xed\xc2\xf0\xb6[\xeb\xd2Ȗ\xab\xb1\xb4\x15\x97_ZTگ\x83\xc6\xe5vk\xdd\x06\xc7Ǣ\xe1\xde]N\xf7\xdasNN\xfe\xdb\x7f\xf9\x9f\xfe\x1f\xff\xed?\xff\xb7\xffb\xd1\xda2\x04'K\xd6\xef~\xff\xb2\xfe\xfbo\x1be\x99\xdd\xdf\xfdO\xffw\v\xcd\xef\x97\xd0\xff\xc6\x14'\"\x82Fʲ\xdfZJ\xd9H\xd6-\x05B\xc0\xe1|r\x1c\x0eŸ\xf0\xb2(A\x06h\x01\x8da\xdb'\x81\x9bx\xd7\xe2h\x1a΄R:w\x89\aMu\a\x9d\xe6\xa3nG\x94\xc8:\xe4%\xae\xe0\x86H\xe2\xaf\xe5\xb6\xe6Ry\xb2\x00\\\xde<\xbb\xac%\xdbm\xfa\x1c)\x82(\xbdM\x10\xeb[wv\xa4PD>\xfc\xc60A\x0f\xdd\x01\x00\xa5\xbeT\x00\x8e\x96\x8e\xceU\n\xece\x8e\xd2\xe5l\x06j\xf3ңYܠ\xa2\x17J\xb9\xbb\xe5*\xec\x9d~\xfdq\a\x91\x86\xff\xbb\x12!\xbce9\x89\xbe/\xf7\xea\xad\xf6c\xf7P\xb8:?%\xe0F\x16\xe0\"\x8d\xb1\xf1i)\x8e@\x15&\x9c\x1d\xcdB\x11C\x88\x10w:\x15\xeb\xe4(\x12\x8b\x8d\x0f(\xe5v\x1d\x89\x18\xd5\xff\xf2\x9e\xe5\x9a\xed\xf6\xe30\x90\x93\x96\xa6\xe2*\xf3\xa2\x99Q\xa6`\x01\xa5\xdc\xcd\xf4{\xfdNOR\xa6\xadn\xaf[\x97\xb4\xa9\x12f\xaf3?\xc4s\x9e\v\x15ɖ\xe5\xa2U6\xcbi\x93\x998t^\xa45A\xecc\xdb\xe0\"aN\xee/\x9dƎ\xcd\xc9\x12̛P*k\x1a\xb1\xee\x99\xc3/\xa5m\xa0u\x99F\xdbh1\xb3@\x03co\xb0\xfbK\a\xfcY\xd6.39\x89&pY\x02?Qz\xfa\xb0\x90[\xee\xe5t&拥\xf7\xf3\x95\xbf\n\xc2\xf5/Q\x9cd\xd8z\x1cMx\x8f\x85\xda\b\xc0\xa2e\xb8\x9aރoj\xa4\x93A\xd1{\x0e\xc3\x11t\x1cM\xc0\xe7\x00\xbe%\x94\xa2r\x1d\xd4P\xe1a^\x8a\x9b\xf3\x80˧\xcaYJ\x01n\x99\xc7\x1bl\xce\xfdڜ-A<7\f\x9f{C\xaa\x8b\xad\u0602\xaf\rw\xe1\xe0n\x16\xdd\xe1\x8d\xe0\x96\xba\xa0lN\x16\x14\x04\xc2\v >\xafu\x9d\x97\xbc>\xbc{~9\xa4+~=\xbe\xacV'\f\xc4\xea.Y\xb2\x05[!\xf7\x1c\x84?\x8b\xf1jb\xb0\xc1\x9d9D^j\xee\xfbV.^\xfc:\x83AKIM\xd1(@I\x87\xa4@\xc7G\xb7\xc3\xed\xaer{$\x05\xfcl\x9a\t\x7f֠6\xf8\xfa\xec\xe2\xc3\xc7\xf7\x9f\xde#O7\xc7\x02^\x96\xf1ǞI\xad\xb4\xe7\xc8\xc3\x7fvbU-\x9d\x90Wh\xa4\xf7\xa0\xbf\xec%\x82,aE\x80\xfc\x9a\xfaa,\b\x1d\xeax\xb4k7\x12A\xf2#\xea\x9fh\x0e\x93\x06\niG\x9f\xecL>\x8b\xbczK\x7fI\xa7S\xc0\xeb?\xc9Rp\xa6\xac\xfc\xb9\x87\xea;\x99\xed\xbf&\xb7W\xdcڤ\x1e:S\xf5QM\xe3\x8f\xf4\x83\"\xc5m;\x18\x01E\xea\x10\x92\xf0\r\xb1\xbcy\x84\x9as\xb4\x16\x83\x99Vm\xe6\xc5k߽\xe3V\x10\x06\xc2b>(\x9b\x89`\xf6b\xe9\xf9\xe8\xa1'\xa9\xc5\xd1T\x9f\xf5\xd6\xcf\xee\xb5+'ȱ(#\x82\xa3W\xf6$\x1b\xb7n\x9d\xd6µ\b\beƴ\xa5={\xa5\x90Bn\x1e\x99\xa8\xbd\x82\xd8\x1f\x01\x1df\xa0\xedi\x19p\x04\x1e5\x95\x9f\x1d\x83\xf74\xf6\xc6\xd1d\x92\xf1\x98\xe9n\x18\x8fד<gZa I\x86\x17Ui\xa4\xf8\xc7Pp\x02\xdagD\xe69G\x00(̆u\x9a3\xf3\x03.j\x04\xed\t\xeaD|Er\n\xc7\x11ZyFR=\a!\xfba\xe5\x95~k\xd0\xea)}\ni(_\xe0\xfb\xa9\x90\x06~\x8a\x99\x87Im\xceQ\xadeT\x86e\xc1\b7?X\x8f\bcvY\xc0cX\xe2\x90\xfb\x04\xa3\x8c\x84z\x97\x83\xcf\f\x8c)\xe2\xa2\xcd}\xc4C\x8c*\u0082q4\xc9\x1d9\xd8\xd1G(\x1c9BT\x8a\xe8\xb7\xebJ\xe3E\x8e5\xce\x06\xe8g\xae\x02r\x1aslz\xe0\x04Y\xf3\xc7\xf4L\xe7\xdc\x12\x86-В[\xa6\xb6\xa8\xc5f\xdc\xd2\xce\r,\x9c\xcap\xe4\x8dJt\x98cX\xffDNRL\"\xcaʤX\xb6m(ks\xb4\x03\x97\x06F\xa0U\x12\xd96ZhD\xb6]\x89j\xbaQ\xed\xecj-\x1d\x8c\xc2VM\xbf\xa1+rp頼\xd9E<\xef\xfa\x05}ރ\u05fc\\j\x90{5\x8f\xba\xb9\xceo\xa4\x05Ƌ\xe1\xe6\x01\x0eE\xb5\xc4S5\v;g\xea<eb\\jȑ\xd2\xc2\x19\n\x03\xa6\x1a\xb8Á\xbe(\a<\xf0H\xb5\x12\xa3u:\x05\xdeD\x18I\xfeh\xbcY\xc3\x0e6d\xaa\xb9)\xcd\xcd\x10\xaa\x1e0d\xaf<\xbc\xcd\f\x15NE\xc9x\x99\x9b\x91\xc26K\x012U;\x98\xa6`\xfc(\xfc\xc9\xdd9Z\xef\xebg\bn\xceܔ\xe6Ą\x90)?e\xe8\xd1\xd1P\xfd\xac\x80B\xd5\\j\x06\xc0\x14 W\xaaT~j\x98o\x1a\xf1P\xa4\xb6Qm.\xb5\x93\xa4#\xff\x98?\xa2Qy@\xad\x1b\x15gF\x87?\x11Y\x9aB\f\xa6GhyP\x03\x97\xa7\x88\x92*\x8f\xca-oP\xda_\x14K\x1ey\x04\xb4\xa2\x89\xa0\x8eԊ\x94\xc2\xeeA\xa91o\xa7\xd3M\x95ؐ\xccPt(\xb1$\xfe\xb3\x98\xb1\x97%\x96=<\xc2\xed\xb6\x9ce\xc8B\x94\xae\x01\u074c]\x80\x0e\x1c\xac)\x8d*\xddl?ra4<\x14\x98nĳ\xb0I\x8a\x18\x92a\x93\x1a]d'\xc79\xbah\x9a\xe9\x19\xaeM\xa7|\x06\x15\ue3e6\x05w?Ny|\xb1\xa1\x8a\x17\xb8\xa1\x99o\xc5\xcdDy\xb4˅lɤc`\xf3\x90\x98\xd6\x12\x80\x97\xd2^8\xb9O\xd3\xd1\x1a\x9d\x90c,\xfc\xce\x01%\x81\x94Y\x93:*HA\x1f\x8fP\x88\a\b\x93SbY\x85!\xb6\xcc\xdbFL0$\x8b9\x17ۭ7*\xf3\x13\xa9\xa2\x1f\x90\x8a\xb7\xddZF@@\xab\x82\x9e\xf6\xe0[%\x06\x82^P*\xb5*{\xf5\x03\xae\x1e\xf6Bp\xdc\xefj9\vW0\xab\x06\xa8}DI\"\x03\x0e\xb5\xdf%n\x83\xe8Yڽ\\\x9e\xa4\x0eҀZ\x8fX9\xa6AJ\xea\xa8K!\x9dBá\x12\xd0JHb\x16\xa1/\x88\x00\xff\xfad\xca\"Ih\fu\x80\x8c\xe7\x9b!\xc5\xef<\x19o\xe0\xaea\xdb\xe4\xdf=\xcc\b\x1e\xb7\xe0oJYL\xf1:\x9fw\xeasp\xef\xee\xebL\x83\x9c\xe3\xe1]\x89\x88?ݕ{\xf7dP\\,U\xa7e\xc1\xa3DG\x88\x04\x99\x8a\xfcs\xdfp\x9a\x10\xe3GQ\x17\xfb[((x'\xaeT\xd0=qv.\xef\x9c\b\\\xca\x0e\x1e]yCz\x06\xe1MK\xa6%\x1f\xea}\xbb%\xd6\xc5\x05\uee8b\v\xcb\v\xeew\xa3}\xd6>h\x99\xa2\x16'`_\"x@\x1e\x19>)J\x93\x98\xd1\bJ:(%\x11\x1bO\xe0\xf4\x8b̍\x8e\xfbg?\x8aC\xde\xf5^*p\x8b0B\fe\xc9H\x1a\xfd:Q-m\x87\a \x8c#4\r\xe4\xc8 \xc6\xfc\xa3\x9a\xe3)/Dq=\xbcl\x13\x01\xfbMS\v\xb59E\xedl\xbdm\x1e\v\xb0d\x86\xaa\t\xb9\xa7\xc3\xf9\x85\x80\x867\x9a\xde^\xf3:\x9bî\x9a>_\x0fi\xc47㵾Z\xc7$T[d\xce\xc4h\x1c\xb1\x10n<NhR\xe2\xf3||#\xed\x80\a\xc3\x1a1\xe5\xa8g\xa3\x04\xb1\x83N^\x10V\xce(\x94\xb1*B\xada\x95\xb3i2\"f\xec\xeb\xc3[cIF\x1cYU\x15\xc0\f\x8c\xfa`\xcb\r\xfa\x8dƓ\x8dk\x8c\xa3-U\x9f~\xb2\x19\rCW\x8c\x99&.\xd0\xe2.\x89\xb8H\xfbM\xd1)f\xc0\x95\x11\x8d>\xba\x02ef\xc3EM\xf9\x06x(\xa3n\xa0\xf25\rHJ7\xd6\xd1\x7f\xa7ap-\xa2DE\xaf=J£u\xe4\xad<d\xf2\xea\x98W\xe0ѭ\xd1\xea\x1e0\xa0\u0603^\xa9J\xeee<\xbb\xdc%\xeb\x00\xd7-0\xf5\xe2\u009b\xe0\a\xf0\x1cF\xcb\xe4Jp#\xc4\x13\x8d\xc1\xed|\x9e2yF>IX\x04\x1f0Z\x0f\x9a\xa1\x95\xc8a\xdb\xf5z\xbd\xf9$\x03\xeb{4\"q*\r\xe5qN\x10\xba+R}:O]\xe7A\x8d\xcbN\xf7\x00\xa1\x90E\xf51\b\x05\xe4\x9c?\x18\xe8#@\xfe\x1a*\xf5\xe4\x83\xd0\x19a\xf3\x12\xed\xc4\x17\x1d;\xa9Y!\x10\xb9@1\xb8)\xec\xfcH3\xd8Ѵ\xb85\x90\x92\xea\x82\xe7\x04\xa5|\xb3\x14\xeeL\xb1\x8d\xe05q=\x1f_wC\xd3S\xc1\x9e\xeb]Yٽ\x97\x88\x95\x96\xff\xa9R\xba\xd2QZ\x9f\x92\x05:Y{\x89\xd1X\xb2+\xb2S%S4\xcd\r\x9b \x9b\x80\xb4\x0e\x81\xd5\x1a\x15I\x95\xd4\xfc((\x135\xe8c\xcecu\x02\xf4r\xa7Y\xbav\xa8\x05\xf1\x04\x0f\xa0\xa1\xf2\x84\x00ZF\x9b v\xe7 9\x1e\x87\x93\x11\xfc\xf0d\x1cNPG8d\xf0\bT\x8a\xc1\xcd\xc0FJ#\xf5\xd7\x1f\xd2Ga!\xbd\x0fm;4\x8e푼\x9a:\xba{\x00\xf9\xfd\xe6\x03\u00a0\xa7\b\n\xa4.\x86\xd6G\x0eR\x1c\xe2\xfa\xbe\x16\x19\x84\x01h#\xe7\x06\xd5\x1e<\x8cwK\xb9+\a\xb7\x03\xecϐ\x04\x99'\xb3\x84\x15]\xd2f\x9c\x04p\x9a\x9f\xfa8\x05sP\x9e\xec\x8c \xd0\x18\x96\xe6\xd1H8\xcd}\xf7\x99J&\xebeG8XF=\x1anR\x93\\\xc8\xcd\vk\xf3\xa1g\xdb\x10\x9c\n\u008f\xd9vD\x12\x16?\xd5\xc1.*\xaa\xa0\xfb6\xf4\x00Y6\xbf\xd9\x11\x9a\x9a\xfa\xb8),1/\xa3\xd6\x11?w\xd2X\xb7\xa5a\xa0\x1e\xf4g\x8a\xdbPy5\x8dF\xc21\xbc\x97\f\x81\xc2\xf6\xd1\xf0u\xca\xfc\xfdѩ5\xdcQ\xb6\xb1m\xb0\x90s\xc9\xd4\xf4\x91#-\x8d\xf1JYv\xfe\xf4{\xe9\t$M\x94P\x87\x1d\xddN>\x16>k,&`\x05\x06@\xa1\xaf\xebh\xce\xf10\x89\x00\xc0\f\xadX\x17\x17\xd30\x12\xc7?\xc7\x17\xf1ҍ\xc4\xec\xe2\xc2b\x1e\x0f\xc6.\xd8\x1b\x13\x17l\x00\xf2J\xb4\xd8\xd7r\x16\x89\xa6\xa1\xb1}:$\x8f9\x96\x90]\x87?Y\x90\xecd\x948`w@\x89\xa5=EY@\xe6\xcaH\x00\xf7*ͱZ\xb5f\xbdֲ\xd8*\x9c\t'\x18Y\xebM$,ǒ֭\x16\x9b\x86\xeb;\x19\x93\xd2\xfa\xaf\xffףf\xbd\xd1>n֛ͣ\x97\"\xf0\xe2\xa3\x0f\x9bxy\xe5F\xe2\xfa\x88\xfc\xea\x87^\x14N\xafjцZ\f쭂X8\xa9`}\xe1%\xcb\xcdem\x1a\xaeNt\xce\x135a'\x97~xyr-;r\xf2\xe6\xf5\x8b\xb3w\xe7g\x16\x8bѤ\xeeI5X`_ѫ\xb7\xfb\xbdG\x0e\xd9^;\xaf\x1c\xfc\xf0\x06\xcd\xe87\x0f7i\x19\xf3 e\xaf{ۭD\x87$\xe2\x01\xf1(*\x0e'N(M\x92\xbb\xedn\xb3\xfe\xf0\x85I\x05\x05vS\t1nID\xd2Hl\x82\xf9%h\xe4\x9c&\xf2\x9a\xac^_\x843q\x8a4\xbc\x11)\xae\x10\x8b\xba$ȪV\x85\x05\xd50\x19\xd4@zЈP\x85/\x15r\xa8\x82\xcf\xea\xdb\xed\xfc9_\x8e\x04H\x9e\xe5\x98\x1dT\xa3[\xb39\xa5\xcf:\x9d栻\xdd\x06\xcf;\xddVc\xb0\xddΫ\r\xce\xf9\x12\xfc\x84\xca<\xd5\x06\xe4궚\xf5\xedv\xfa\xbc\xd3k\xb5[#1\x8a\xb1\xb8\x138b\xb4\x81G6\xaf6\xa93=ƌU\x12\x1cc\xbdϞ5\xea\xb4\xda\xedtZ\xdd\xfc\xb5b\x8aCw\xa6\x188UN\r\xbc`\xe4\xd4^\xab9h\xfc\x16\xbf$\xcdF\xbb\xd7\uedfa\xed\x1e\xf3\xf8\xc9\xf8\xef_\xea\xc7_6\xf5z\xeflr\xc2b~2\xae}ٴ\xea\xf5\xe6\x97ͫW\xf53\xf8\xed6&'\v\xe6s\xeb\xfd\xb5\x88\xe6~x\xe3\x1cy\xc1z\x93\x1c\x05B\xcc\xe2\xa3\x1bo&\xe0^\x99\x88\x85\x88bIPK/u(V\xf8\xe8\x06\x8bT\xae\x10\x92X\x19u\xac\xcd0\xa6s%\xed\xc2\xe0\x87/Ԛ\xb3\xa5\xb4\xc85a`f\xba@Y\x99w\u0085̜\x86\xa2\xbb6\xcdyٝ|3\xcc\x03\xe8\x81\xe8*\xd5f\xb3\xda\xeb\xfc\x91\x88g\xcdn>\xa2J.ZF\x1dY-\x82G\xa35\x11'\xbdz\x9d:\xe2\xf9\xf3\x06\x13U\x90-\x9c\x00\xa5\xff\xbc\xdd\xe9\f\xa9\xc0w\x80\xfb\xa0\xca[\xdd4R,\t\xaa\xad\xee\x1f\xc5\t\x11\xd5V\x9f\xd2|t\x16#\x14s\xde\xfe!\r\x14\x05\xb65\x11\a;2\x91\x82\xf2\xb3`\xa8]\xe5/\x89`Q\xb5*y\x85\xcf9B\x99m\x87\xcf8\x82\xafmGϔW}7ˊ0\xc99\xe9\xb6;\x8d\xa6\xed\xd2ъ$\x8c\x90F\xbdٲC*aT\xbe\xb9\nX\xa9C OHYt|\xac\xfc\xdc˄\x8c\xe6\x00Ҽ$j\xac\xc7\x1b\xcd>\x84leS\xdek\xaaxg\xf5a\xf4,\x1bN\xb5JI\xc0A\xb8O\x9f5\x9a}ۆ\xaa\xe7$\xa0\xb2\xc2E\x16\b\xf6\x9a/\xb0\x86\x85\xccc\x1d[tx\x8d!i\xa5%\xa1\xfbX\xfdϹ\a*j\xa0Qy\xc7\x03Y\xff\x19\xbf\xaeb\xcc\xe5\xbbc\xef\xf9\x9a\x10\xf78\xa6'g4\x15\x9c\xf8\x92\xdb\x16W9\xe4\xa0\x7f<\x83\xb8u\xac\xa4\x11 \x16ҁx\xb6]\xad\xc6\xcf]\xb3\x1a\xa039\xf7\xb2ս\xe51\xbb\x00`Q#8\xe7\x17\xcf\xf8t\xd4p.\x9e\xf3i\xb5\xd9\x1d5\xbb\xce\xc5\xf1\x14\n\xde>;\xa7h\x8e;\x94Q$n\x8f\xcf\xd9\x15ou\x8fχr\xba.\xc9y\xf5\xf4\xf7W\x94RP?%\xa7'W\x94]\x00$\xee\xf4\xf7[\xf86\xe57$fg\xec\x9a\xf3\x05\xc5u\xb9\x86H\xa6q\xb5ʼjU/\xe7\ffע\xbb\x87\xa8=\x161\xe5p\xe8\x9a,\xc8\x1d\x86\x9fD\xe3\x1c\xfc\xc19Kx}\x98<Ӣ\xcfaR\xadR\x90w&\x13\xb6\"\x01\x9b\x12\x8fEt\x84\x8aeV\xf5\x8cD\xd4\xc9\xee\f3\x12`=p\x9b\xeb6z_\xe3\x98\xe9\xb1\xe3\xc7\xc0T\x0fR\xb3x\x98\x00\x9b\x85\xa2\xce \v\x90\x9a\x92ѹ\xeb\xdbm\xc0y\xe3\xa4NS\xaeÏQ\x18,\x8e\x02\xf4\x01\v1\xb7#\xb1\x16\x89\x97(]\"d\xd8\x06\xcf\xebC\x12<\x7f\xfe\x9c70$o\x95'\x946l\f+\aφu#Z\xb3\x94iUK_\xeaڷy\xc1|\xaf\xd7j\x1fr;bp\xf8J\xfc\x1cT\x80Z\x04\x0f\x1c\xd6?\xff\xf1\xbf\xff\xaf\xff\xc3\xff\xfc?\xfe\x1f\x81\xf7\x92\xbd\xa8\xaf@Ŏ\xc5D\xfb\xbb\x162\xc0z\xaf\xdd\x7f\xcc5\x87\x9a\xfd\u009a\xc8\xeej\x92 E\xe9>\xb7\xc6V\xd5\x03\xee\x16\xdbpi\xadD\xac\xbf[U\xbf\xeaW\xad?\xa2\x98E\xa5ʔo,8g\x9eb\x8c\n\xecA\x92d\xec\xf0\x86\r\xf7\xe7\x88\xc7$b\x1b\x86\xda'M#i*\x93\xa2\xfci\x1d'n\x948kҠL\x043gM\x9a\x94%\x91\xb7r֤\x85\xd7\xd1f\x89Q\x99Vcϛd+G\x92\x9bL\x93\xbd\x10V\xc70\xc9V\xeb\xbc\xcctuf\x99k\xb1U\xa6\xb6\xb3\xc8\xf4ݯS\xd5\xf6;\x1e\x03\x8b\xf8\xf5j\x05~\x91\x13\xc1.y\\\xc3p,Y\xd2\r\x8fS\x15\xf63\x1e\xd7^z\xf1\x1a\xae\x98\xec\x96Ǚ\xb3\xb2\v\x1e\xd7ފ8v\x17\xe2\xc5\xd2\r\x02\xe1\xb3s\x1e\xd7\xe4\xc1\xceNy\x9d]\x81^\xd7\vn\x85\x01\x1a\x87\xa3I\xf4t\t\x9b\xce\x1aJ\xbd\x9c\xb8\xe6\x87ST\xa6/(\xe1\xbc/^\xcc\xc1\x85\xa0\x8e\xb2\x94\xf0+\xf0h\xafTU\xe0\x99%\xc0\xb9*742\x80\xfc\xbdT/\xcc\xfb\xd1\x7fO\x04j}S\xf0Zo~\x88k\xeb0N\xd4\x10\xc99\x11\x94)7U\xd3ЯZ''V5@ET\xba\x1b\xde\xd9\xf6%\xfad\xdcG\x1f\xb3\x9c\xcb3\rpW\xe3j\xf54\x17\x8e\xd9'S\xe9%\xea6\xf3\xaa\x87q\xd9CrJ\xd9\xe9\xae@\xbf\x18\xa3߱\xebQ^\xe1\xf4&Ӣ\xff\x19o~Ιm\x9fՂ\xf0\xa6\x90\x11\xd3t\x9e\vۮ,F\xc4\xe3\xc4EM\x9c\vZ\x03Ho2\x17\xff6ja\xb0\x92\xb3\xc1_30p\xf1\xcc\x19b\x1e\xe8\xb2\xd5\xdc\xd9\xec\xecZH\xf7\xd6\"\x10\x11\x98.\xe5f\x12\xfd\x19Լ\x15Ty\x8e2\x8d\x185\xc6-\xd0\xc6rP\xef7\x9deۮ\xcc\xc9;4\xa7y\xc7\xf6\xeb\x06\xd7\x1cX\xa7\xc5^\xb3J\x83R'\xe4/\x809\xb5\"J\xc7\xcc\xcaK\xb8\x979\xd5+#\x17\xad\xed\x03\xa9\xb98K\x15oQ\x16\xc4ӀIh\xcaq\x06c\x91|\xf2V\"\xdc$8\xa7\xac\x9ew\xae\x02ߝ;&#\xb6^\xa2\xcaR\xbbQ\xee\xb6\a\x8f.\x15\xfa\x7f\xe5\xde2W=z\xc1c\xfe\x842\xde\xe8Q\xf4\xac>\nITMX\x1dd\xf6JO\n%.e,8\xa9^\x9a\xa1\xe9\xc7LvR\xa6\x02\xf4\xd6`\xb3bO\xa7\xc2\xf3Yd\x10\xfd\x0f\x9c\xb1\x01\xaf\xa6F\xccA\x05L\xa1\xe0\xfe\x19\x8c\xe0F\xf6\xbc>\x8a\x9c\x84\x92\x001*\x98\x12=>_^\xf0p\xd7\xc5s\x98\x17Ha\x83z\xbd\xd7\x18\f\x9a\x9dv\xaf]\x1f\f\x1a\x14\x9d\xa3\xa3\xac\xff\x116\x89>\xc9JL]\x0f\xc62\xc71t\a\xadΓ\xa54\x8a\x8b.\xd55\xbb]\x1d\x9bG:oC\xa7\xc2 \xf4Q\xfa3\x92g7\xcd\xe9v\xadA#1\t?h\x99\x87\xf5 \x1f\x1e-\xe2A;8\xb5\x8b?\x12\xc3L\x93M\xb0\xb5\x0e\xc1\x9bj\xaaK\xe1\x0fI\xb85\x13sw\xe3'\x16\xf2\xfe\x90\x81\x99PVqI\x845f\x9e\xd5\"%\xa2\x99~\x8d\x88\xa6ȝPmJ:ˢ\xcc'2R\x1eC\x85\xa2234\x98w-Ն\x99|\x8c\x85ɴ\xf4\x89fV\x88\tp@\x92\xaae\xa1\x8f\xbd~\x89\xf5\xf6\xfdn\x18\x8c\x0f\xb0\x18'\xdc\xfa\xd52\xf0A*\xc9\xfbu\x0224m\xed\x01\xe2T \x8e\x1e\xd1RA\xbf\f\x00\x7f\xb2\xdca\xa6\xb6\xb6\x9e\xe6\xca\xd2{ϔ\xe0\x85\x1b\x04a\xb6\f\xae\xb2\xeb\x91s\x0f\xcb\xe1\x1e\x15\xa7\u0095G)\xdaI\x1f\xe6\xb8?\xd83C\xdd((Q7Ү\x85v(5h7\xfa\x0fQ\x96u\x8d #7\x98\x85+\"\x1de5j\x86\x8b\xac\am\xf8倉U%f\x94~\xcb\x01SbzaU=R\xad\x86U\x97\xb5\xba(,l\x96{\xeeBC-\xb3%\x90.\xe7,\xa3\xf6\f\xde\xd5Wm\xeb\x04\xe0;h\xf5\x9e\xe2\x82-\xdfL\xa9\u05f5v\xf3\x90\xdb53\U000ce6baXZ\xe8\xd0n\xe6\xf5\x15\v\x01\xc8\x1a\xfdV\xbb\xff[E-ZG\xefY\x92\tZޅɑ\b\xc2\xcdb\x99\xf9\xbf\xcc\xdb^`\xb4\x98\x83n^\xa4* \x80J\xbf\xf1\xc8\xceQ<u7#\xab\xbd\x8c\xbd\x1e\xa7\xeb(\xd1kS\xb9:\t\x89us\x15+\xd5.\xb9j\xa0\xdae\xdbS0>\x00#\x80\xd1\xd4\xc1\xd7\x1b/Y\x86\x9b\xe4\\$\t\x98\x8dz\x877f\xc5%\x1bt.TA\xff\xacZ֭@c\x03\x8e>uHE\x05'VU\fc)B\x10t\x049\xf8t,&\x0e>\xf9\xb6\xbd\x1e\xadIB\x9d9IR\x8c\t\x9f\x90}\xd8\uedca!:\xbe$_\x82/\xd7_\xe6_\xa2\xa3\xff\xfa?\xfe\xcf\xff\xe7\x7f\xfc\xf3\x1f\xff\xf8\xe7?\xfe\xf3?\xff\xf1_\xfe\xf9\x8f\xff\xc5?\xff\xf1\xbf\xfc\xe7?\xfe\x87\x7f\xfe\xe3\x7f\xf5\xcf\x7f\xfc\xaf\xff\xf9\x8f\xff\xcd?\xff\xf1\xbf\xfd\xe7?\xfew\xff\xfc\xc7\xff\xed\x9f\xff\xf9\xff\xf2\xff\xfe\xc7?\xbel\x9a\xf5f\x1f\x7f\a_6s1\x9f[\x10%\xac\xdb\xec\xfd\xb6\xb8\xa3ҡ\x82\xa7\xad\xb2\xe3,\x06\x18\x1amCTCe\xb4-\xed\xb7\v\x1e\xab\x94n)\x06\t\xebw\a*\xea(\xfa\xae\xd3\xd7#\f[\x8aA\xc2\xc0\x8aI^\x8fԉ\t\xd7#\fO\x00\xf7\xa3E\x01i\xb3K\x1e\xd6\xe4Yz\xc3\x15\xfbq/\xb4r\xaa\xc0U\f\xdf\xde4·7u\xd8W\f\xbb{\xabb\xb3\xc7#\xb8c\xa2\xb5*\x03\xeb\\Lun\xa9C\"\x1e\xca@\x95\x1b\x88\xf94%\x11\xbbc\x16\xf6\x04.\xa3\x99\\\x04H\xec\x88e\xb4\xf0\ny\xdf\xd7*Y\xc5<\x98\x93HF:`\rJ\xd9\x12UzpM|\xe0:\xa6\xdc\x16\x01.\xf01\x9e\xa8\xbf\x93mZ\xa8\x00\x10[̇\v\xf00\x1e\xc5\xe4\f\xc2d\xf9\xf0GE\x81\x021\xe4Pr\xb2\xce\f\xc9\xf9\x86\\fo\xec\xde\x106\xc0\xad\x19\"a\xaa>\xe3+\x1a\xed\xba+\xf5r\xbaXDb\x011\x8c\xe4\x80wth\x06\xc4ܱ\xfb|\x0e\xe7\f=\x9ew\x06\xf5\xeeo\x83@\xc3Y$xpڋ\x06\xae\xf4?\v\x8e@0>\x9f\x84\xc1n\x1bt\x00\x11\x06;\xf5N\xb7\xaf\x03\xd5!\x88\xcd2{\xdd\x15\xf8\a\xf3\xe2\x17\xa8!r\xbe\x86k\x87\xf4P\xc1\x16\xbcH\x8e\xb2kn\xbduo\xbd\xd5fu\xe4\xfa~x#@\xc9}&n\x8f\xc4\xedT\x88\x19\xb8Y\xbb\xe3\xa1A\xef]\xf2\xd9s\xdeil\xb7\x15\xb7\xc4R6[j\x81\x1e\xe4\x1aLh\xc5\\\x88\xa3\xa2x9\xc0\x05'\x96L\xc7 \xb9E\xbc\xb5\x1f[\x19\x1cˬ&â\xa3\xac\x04=͠\x97ɡ\xe15P:\xb6ڋ=&M\xcdw\b)S7qJy\x8dʿ\x83\xafna1_\x13\x8f\xd5a\xde\xeb\x8a\xd9x\xdc(ى\xc3\xe4Y\x80\x9cGoNΈˏ\x1bhA\xe29\xd9\xfeL&ҟż\x8a\xee\x1b\\J\x9f/\xd4\x01uG\xae\xa9\xc1T\x0e\x81\xd1\xcb\xe6\xc0Ǆ릋\x97\\6g.\xf0|w\xda7\xc9\xfc9ϕ\xc7<ժ\x11,Cw\x8e\xcf\x19ʺY\xbb\xdf\xef|M\x9cE\x19JY\\\x8b\xe8\xee)\xf3\x1b\x91V\xbb1hSba\x11\x8b\xee\xd8=>9\xa5\fB\x15Zz\x0f\xa95\f\xa4֘\xa4J\x80\xb8\x01\xeb%6h\xf9>\x0f\x1a\xfdn]\x87\x1ch\xf7\x06\xf4\x81\x9e\xef\xd8\xfd\xdc\xf3}'\xdcQ\xe6\x12`\x11\xf8\xd0\xe9f\xa3S\xff\xfai\x9a{~\"\xa2\xa7͓\xdc\xc1\xd8b\x02\xf7\vّDD\xff\xb2\x99j\xb7\xf3\x1a\xa7O\x1dC0{\r\b\xc0\x98?\xe6q+M\xb7\x188E\xc0\x80\x9e\xe3\x89m\xe3\bI\xc3(\x98W\xc5\xe6@\xdbQ\xf6\xf8\x9c\xc48\x03\xaa\x8e\x7f\xd1$0\x97x\b3\xfdV\xeb7\xcdD\xc9$<4\xfe\xff\xe0\xd0\xff\xb5\xa3n\xb6[_C+u\xba\xfdV\x8f>\x01x\xc7\x13m\r\v6\xad\xec^\xbd\xc0\x16\x02o\xbe\xcdҨoFC\x8dF\xab\xd3.k\xa8\xe8\xe2\x15NO8\xd0\xf2F\xb7\x98\x19庀\xf4)n\x9c(\\\xc9\xe6\a\xbd\xee\xa0\xfe5+\xad\xd5\xea\xa7\xfef&\xe2'\xe3\f]\xe0_\xb7b\x96\xae\x12P\xc1`P\xef}\r\x95a\xe8\x8e\x16\f\x05<\xaeQ2j@\x8f'\xfa\v\xd0\x1c\x15\xb8\x164Nb\x02\x9e\xb1\x1a\xec\xb8N\x9f\xd5ن{\xc4R\x99\xac\xa7\xc0\x83\xbf\xddV68'X\xa6,XǓ&B\x9f\xed\xfe(\xd63\b\xde\x05뎛\xbd\"b\x1b4{9\xc8\xd6Sq\b\xa0\xa0o\xd2\t\xa6\xa3H/\xa8\xa5\xdbm\xf6ڏ\xceq;\xd5,RX\xa0\x10\xcf\x00\xdd\xe3*\x97nZE\f\x889\x8cg\xac\xe4-R;i\x9aݱ\xd7*Ԭ\x11\xbfv\xce=\xf4!\xb4\xe4^-u{J\xd69\x1b\x16\x82\x85\x98\x1e\x1d)ؒ\xcbY\x92\x81\xfd\xd7LM\x05\xac\x02Õq\xea\xec\npL\x02\x1aNl\x9fr[*z'ɂ_E\\Ԡ\f*\x05`%ժ6rH@w%\x95\x98\x8f\x88.\xa4\x9d\xfd\xa8[\xbez\x03?eH\xc4;R\xb3\f\"\x10\xa8\x1c\x81\xfa\xd8\xd89:Ʊ\xf1\x15B\xa9g\x19T\xe28`\x90\x9e}\x00\x0e\x83*+\xaf\t3\xee\xd6N5\x88\xe1s\xe4\xdeI;'\xd9\x01\xcaB\x92\x16\x81g\x1dS\x99\xb2\xcaƶ\xa7\xda\xf23\x06y\xc1\f\xe5\x90h\xe2\x17\x93\x99\x8e\xb7\xab:\xa3\xf3\xedr1\xdeY\xaf3ht\x1eF\x84\xdd^\xa3\xddy\xca\x0e\v+\x1c.\x86\xbe\x1b'\xaf\xe5&۱{\xe3M\xe2\xben\xbf\xd7\xef}\xf5)\xb7r\xd7_G\xb0\xac\xdc5R++w\xfd\xaf#\xea\x1a\xfd\xaf\xc2wp\xc1\x1eК/\xe6\ts3\f\xe7ew\xa0X\v$\x9f28\x97X\x91\x98m\xa6\x18s\fp\xa2\xf7\xbc7\xb0m\xefY\xbf\xb5c\xf7\xf2\xd3\x13\xd0ڰ8\x05\tK\x1e\x1au\xa3\xd1\x1e\xd4\x7f\x1b\x96\x97\x17HOb\xf4\b(\xecX\xc0\xa0\xc7\r֜<aȊ\x13\x1cӌ+\x1c\xebz\b\x9e\xa9\xea\xa5DoV\xa2c\xaa\xd5\xc1\xd5\x05\xc3x\xa6\xcc\xd3q\xba1*i\xa3\xff\x1bo\xccz\x94\xc0\xb3i\xf6\xdb{7f\x94n\xed\xbbΔ8{\x9d]\x9e\xe7\xd95y\x99]\x9esBm\xf0\xa8\rz\x80xI\x9e\x1bj\x8e\xa0\xf7%\xd1\a\xbbK\xa5eO\x01\xaaՎ\xddc\x95N)'\x87\x85lΧ\n\xe7.\xf9\x86̡\x1b>\x11l)\x1d\x92\x1b^\b\x96N\u0096T\x1a\xb6\x809\v\x89\xf8\xdcԬdD\x05\xa1\x8a8\xe7\xd7ۭK\f[L\xf0\xe0\x14\xe3g\xa9l\xc9Iģ\xf1\x02=P\x92H\xa1k\xcaTѴ\xd5L\x9a2#s\xb6b\x97\xf2z\x8a\xae.\xb2\xbeE\xa3k'\xa2\xe4\x8e\\\x1e\xafX\x9d\"\xa7|\xb8zv9\\U\xab\f\xfc\xfd\xaf\xe0\xfa:\a?\xf38bӧ\x88\x8e\r\xc0C\x16 \xa4\xb4\xba\xf5\xe6\xd7@\x8aD`\x10u\xea뮦P\x02Q\x18<\xfc\xeb\xae[\x8dF\xe7+:_\x1e\xd5Ͱ\x01V\x10\xedg\x9a,\x9b\x8c\x894\x95Z\x18}\xed\xb8G\xe2?\xe4NB\xd8e\t\xe7\x18\a\xb9\xc0\x19\x02:\x06\xfd\xb3,@\xa5\nt\x1a\x17\xe8GLj4.\x94\xee#8.5)\x04\x99\x87\xe8\xe1\"\x03\xa74\a\x80\x18~?\x03\xa7\x96\x90d\x81\x9aXeS\xf4\x064K\xa1\xebYOF4 s۞?oI\x0e\xc92\x17\xed`\xa53\xaf\x9eu뭡2l\x94\xbc\x1ap\xb2&5'\xbb\x9d\xa1x\xd6\xeb\x0e\x05\xa8\xc8)W\xdbI\x99\x1e(\x11\x94\t\x15\xa7\xb4\xdbu\xe4߁\xfc۫\xab\xbfM'\xe2-\x19\x98B\x86)\xed\xf6\u0557\x86\x13\xf1\xb6\xfa\xa2$\x92Ně;\xb9=\xea\xc3\xe0Y\xbb7\f\xaaU\xbaP\x8a\xe3WNR\rص\x13\xed\xa4'W5W\xa4T3=\xa9]\x1f\x8b\xda5^\x1c\xb1\xb2\x85>]\xa0ʄ/\xc6\xc1\xa4v\xa5ԚI\x9d\xb2P?k\xf5\xb7\xe3\x06\x95L[\x12\x1a\xba^\xd6\xcb\xef\xbe={\xf5\xfd\xe9\x8b\xd7\x7f\xfa\x01h\x9ap\a\x16ҏ\xef\x1b\xe4\x98m\xb7\x95\xb3\xed\xb6r\x8b{&*\xf0\xcd\f\x9f\xf5\xaeV\xceL\xb8:\x02P\xa5\x90\xee;\xe8\xba&\tu\xaeI\xc2rڜ\xe3\t\x1a\xba'\x1a\xd7\xc0\xf878\xf0\xe0H\x06v\xb9#!\xd2~2ǔ\x84\xecQ\xad\xedb\xe3\xd1\xe8\xb8\xe1\x18ص\xe1dC\x18U\x05\x16\x82k\x87O\x12\xfa\xdc'\x11\x1d5\x9c\xe3\x06\xfa%\x90F'r\x9a\xd5\xf2DC\n\xfd\xe1\xe18\xa8V'J\xfd\xee\xd9&ut\x93\xc8\xf4\xccd\x06#@v\x1b\x8d\xdfv$\xaa#\xcf\xcbt\x12\x8a\xb8\"\xe3\"+~q\x81\x8b\xac\xe98\x19\xe1\x05],\x99\xec\xdde\xa6\x122S\x8f^\xc0V\xfb\x8c\xe3\xc5>\xe3XNL\xc69~\nZ^\x03L\xad\x1f<'\xd7\xd2\xfbtʑ\xbd\xe11\xb9\x04\x14\xe3\x824\x01\xf0\xcb\x1e%\xe6\xcd\t,\xee\xed(\xe2\x01\xaf;\r|&R\xbf\xf9\xe6\xf8\fe\x1f\xb7\xc7M\x16\xf0\x19Y\xa2.?\xabS\x06_(\xbb\xa9F\xc7\xc1\xf3\x95b\xac\x82\v-\\U\xe0\xd8^\xb2\x00&\xb1>\\?\v\x86k\xd0\xf7\xbd\xe6g\xd55\xf5\x82\xa3K`\xd1B_/\xc7\xd7\x13\xa9&\xadϷ\x80\xa1v4T\xb2\xe6g\xc3\xf5\xb3\x9bcY\xfa\x8e\xaf\xab\x11#\xd7|]\r\xb0\x8e\xd1\xe5\xf8n¡\x06G\x81\x0f$\feɛ\xe1\xfa\xf9\xcdqP\x8d\x86\xeb\xe3c\x9a~_\x1f7&RSڛ\x93\xe8y@Unh\xe3\xf9\x19\xe6\xc5v\x8e\x1b\xaa\xa5\xe3\xc6\xe3m\xc1\b#\xec\xe3\xe5x]=\x9bd3<^W\x9b)8_\xea!b\xbfX(\xa1\xbb\xd3\xe8>¡\xcdIETX\xeb\xb0\xf6\xd2M\x04Z\x81yF@̅\xd4xʡ*\xc8h\xde\xee\x83\xf0\xa6\x84N\x952/O\x9eνV\xab\xdfx\x84m\fqYs\xed\xa41\x16\xf6\xc0V\x7f\xc1\x90\x91\x88M\xd9=<*&X\xb33\xe8\x942(L\xf1R\x16\r\xcf\xd13\x82TP\xb3[jcX>}hZ\xe7e\n\x9eq6\xa5~F.lR\x1av\nFv\xe0A\xd4\xd2\xda'\xde\xfc\x0e\xb5ZcrR;Q\xb6\x14s\x1e\x9b\xb63\xcb\xec5\xb3\x9b\x88s\xaa\xb4+\x1e\xe7\xb4!\u0602\x9f\x8c\xbfl^\xf6\xeb`\f\xf2\xf2իW`\xf2q\xcdO\xfen\xa4~\xfb\xea\xd5\xe4\x9b\x13v\xa7R_dy\xbf9\xc9i#\x9a\x86\x12\xe8\x8e\xebX\xc6v\x85\xc7j\xa6\xf3\xb8&\xd7\f\xd5\xff \xd2t\x88\xf1\xa3\xeft\xc25\v\xe8\xc8\xfa\xf2ecUWdI\x04\xec\xf7F\x97:b\x871\x7f\xf6\x95\x1b\xfe\x00\x99g\xf3z\x17\xfe\xf4[m\xeb\x0f\x15\x0e\xce\xe9T\xa2L\xa3ۭ\xcc'ܙ\x91\x01\xde\xd0\x03\xc9Զ\rx\x92\xf3^`s\x828*]\t\xe7\x90Il\xc0\xebe\xee\x01]\xbe!!\x1d\x06\xcfB<%]8\x89\xb2\xad\x1aH'.1\xf7\xc8\x14\xbd\x8037%\a\x8a1\xaeb\xf0\x19\xc8\x16 x\x95\x92\xa2\xee\xa0\xd1<l\xc97DՆz\x9b\x92\xa0\x06\xc3bjp`J\xc4Z\xbd\a\xa4'@\xefv\xfb\xa0\xba\xf4\xd6]\xe7\xb8Xe\x1a\xb5*E\x90|\xc8w\xcdv\xca\xc6Z\xcf\xf1S\x81f\xee6\xba2\xa86\xaa@B?\xc0\x90\xb6\xd9|\x04\r\xa0\a\xce\x1c\x1a8\x10\x82,\xe7$S\xe1\x00\xf9\xa2Y\xe1\xfd\\Ԡ}6e\xb1bP\xe1q*\x9aM\bbJtw\xe7h\xa5\x04Dd\xedA\xef\x11\xf4`xk\x93\xce\x18k\xf3\xa7\x0f\xa7\xe8}\xae¹\xab:\x06\x03,~v\\\x19\a\xbcݩ?\xb9S\x9aQ\xfa\x1b;u\xf7P\x97\xeed\x87\x06\xedV\xb7\xfbp\x87С\b\xad)\xbe\xdf\x03}\x01\x11\xa6\xccT~W\x14\xea\xfe\xd7\x1d4\xdb\x0f7i\x1aPd\xae}Rw\xf2q6M>\xcf\xeb[yʣ\xd1\xe33\x06ފ|5;\xf1^\x8c\xbc\xccˋSz\xfb\xf0\x88\xab\xa3\x91\xa2ϕv\xbf\xf9\xe4eE\x0f\x17\x05\x9f+zt\xccO\x89ЇƐ-\xea\xa1n\xe7\xd7 \xb5^C\x1b!O\xfaP\x8d1 #\xba\x96\x99\x82\x81\x00P3\xdaK\v\xb8fɂ\xd5H-\xd2D\xb9j\xa1\xca\xebQbx\x14\x98JF]\xaf]\xef<yi\x15\x05\x9eS5\x92^\xbc\x9e\xb0|\xa5˞_\xceC\x0e\xbdrK(\x17\xb0\xd9\xe8=\x06\x93i,\xdb\xd4!\xe5\xe3\x9d\xd4\xc3\xcd\xf5Օ}ݱ{`\xb0;\x0f\xa9(c\x94\xddF.\b\xfe\xa3\xc8\x11N\xc9\xfcص\x02\x17\xe2\xdaΠ\xdb\xd3H\xbe\xd9\xed6\xdaO\xda\xfc\x92u\xff\xf0ޗy\x1e\xde\xfa\x9d\xee\xd7\xf0q\xcb\x03PK\x1f&rπ\x83\x15\x1d\xd0\x1f\xd4\xca\xcc\x1e*\x97\xdcf\x17]\xdf\ae@_\x94\xb9\x11\xc1\x83\x13\xfd\xbc\x90\x84\xb2\x80\xa7\xdeL\xe0\x8e\xa8\x1cu\xb3)\x8f\xf7\x94y\"\xee\x92Dg\x86>\x8e'hǷ፡OD\xfe䖦\x9f`\xdd\xe7C\xf8\x92\r\xb0\xffH\xc4\x12&(Fg\xc8\xcbp\xfd\xed\x96\xf8\x18\xa5\x05\xc2ځ\x9dS\xb2\x89\x1dk\xbe\x01\x8f\xb9>\xe8\x1ci_0\xec\xf8x\x03q\x9e=\x9a\x97Z\x1d\xaeD\x8e\bꈄ\x1b\x87A\xb1\x12\xf8?K\xd8\x19\x1e\xcbtl\xe4\r\x99j\x7f\x94\x86\xb3\x17\x00\xdaB@\xddG\xef\xedru\xf3t\xba\\\xfc8[q?[\xf1M\xba\xe2lʭw\xe1Q\x18\x88#Ճ#\xb5\x123\xeb1h\b\xee\x0e\x83\x81K\xf6\x14\xdf\x18\x98I!p\xacy\x90\x02\xc7\x1c\x9f\x118\x96\x05\xfaX\x8e44\x81\xc3\xd5\xc0\xe1\xf3\x06[\"\x04\x94AH\x88\x10\xb2\x81\xef>\x18\x82\x92\xe0\x10\x84l\xb6[\xb0\x85_\xc2\xfab\xf4\xd3\xe2\xea\xab\f\x1b\xc8\xe0\x82[\x18\xc1\x8e\x8f\xfd\xedv\x8e\xb7\xbe\x88\xb8lJ\x8d\xe5.~1\xd6}\xa9\xd7}N\x96z݃ܺ\xb7ۭ\xf6\xd7h\xd2(\x89/\xe2T\bǱ\xe7\x94=\rt\xa8\f\xf06RA\xb5\xdfSܚn'\x8d ,}\xe6\xa0\a\xa3\xfdEO\xaf\xa5\x91p}\x03AW*.\x04/ˡg\xe3N=\xf7\x02\xd7\xf7\xef\x94\x7f;\x98|\xf3\xfa\xbc۱\xbc\xf6\xb6ֲ\x90\xa5J`k#\xa9\xf3\x98\xa4=CN\x99oX\nI\xcfA\xb0\xcc\xd1Ȍ\x1a\xac\xf7\x1eI\x98 t\x0f\x12\xd2\f\x11\xf4\xc1\x11\xec+K\xab\x90O\xb20\xde\f*!\x9c\xf2\xae\xb2\xf4\x9bs\xb3\xd3\xfbS4,\x996pb\x0f\x02\r\xe3\x13\xb3\xd47\x8b\xcdٽtY$]\xe7\x00\xb3\xaf\xdfo<!\xa0\x14\x8b3 \xf23 \xdad\xf7\xfd\xa9\x86\x9cu\x86G\xe6\x19\x8c-SpA\x96?za\x92,\x7f\xa5\xe8\xbc\xe0\xfa҆\n\xc9\xe8\xbaGF%R\x88\xea2\x83țL\xd2v&S[\xc0hK#\\\\d\x98\xea\x9ckE\x1dv\x9a\xc1\xf1U>6\xd2\v\xe8;D]\x82\x10o)\x84\xff\x8c\x02\x8b>\x04Gz\x9d!\xc4w\x19B\xfc\x84\x02\x8b\x16\f\xefC\xa6m\xf1\x11\x8aAHI\xca\xdef\xa2\xbd\x97\xa8\xc7\xddj6({\x93\x9a\xa3~\x9b\t?^\xf1\xb7\xa6\\\xefW\x9e\xed\xa3\xcf\xfc\x83\xa1u\xf1+e\xbf\xf0\x0f\xd8\xed\xbf\x14?|\x03ko\xf8\x0fg?\xf29\xfb\x9e\x7f\xc3\xfe\xc47\x06\x17\xf5\a\xbe\xc9\"6}\xc77\xa9\xb9\xeb\xdf\xf8\xebڜ\xfd\x95\xff\x8d\xfd\x99W*\xe4\a\xdb\xfeA95G\xc3C\xdbޠ7wP$\xc0\x04\xca~\xe2\x97d\xa3c\xf5|D\x8c셁\xfa(\x048\x92_\xba\xc1\xcc\x17\xb3H\x7f\xb4X\"\xd0\xed\xa3\xe0\x1fɯ%\xba\x1e\xb7\xe4GT\xf4\xc8\"\xc0\xfcHeXi\xdb\xeev9\xe7\xdf\xe6\xe4/`&\xf9\xbd\x06\xffܗoA\x15\xb84\xa6IQ\x7fW\xfa^\xff1\x8f兢o\x83\xbc\x97\xfe\xa2\xf5H\x11\x1d)\xa4B\xa2\x9c'\xb7\xfb\x1d\x1d\xbf\x9a\xf0\x80UH\"x\xb4\x8f\fv\xd4\f-\x14`d\x1b\xdb~iە\x9f\xb0\x13\x82G\x02\"\r\xe5\xfb\xf8cM\x86D\xabI\xed\x8e}\xd4\xc8Bq0@f\x85Tndx\xa3K\x82\x8a5K\x11\xc0=#\xd91W\xf0C\fo\x97'\xf2 \x02\xaf\x16\x9c'2Z5\x8b\xb97\x12\xb5\xf0\xca\x11\xb5\xb9\xeb\xf9\xcc\xe7\u00a0\xe5DF\xcb\t\x157\x00\x8d\xa0\xe3\x11\x84\xc2&M\x90u\xd4R\x10\xb1\xed\xa9\x90\xa1\x01\xd2$ހ\xc0\xb6\x9cs0\x18p\x1d\x82\xb6&*\\\x17\x8bxL\\ʦ\xb6M\xa6**\x18\v!\xba\x9c\x94\x1c\v}^\x8e6\xe4O)F=\x9e.]/8\x9a\xdeMA\xfb\x9c:$\xe0\xa1 \x11\xa5#\x10\aG\xccg\x1bP\xf3\x8f(u6\xc45\xf4g\xa6\xe0\x0f߶Ӗ6\xd2\x18\xcc+N\x99\xa8\x05a\xe2\xcd=1\xdbnI\xf6\x024\xc1\x8b\xdcJ\x99\xfe\xf2a\xa2\\\xfc\x10\x0f#\x1e\xc0\xfe&tH]A\"\x90F\x99\xd54X\x82A\x97\x8ci\xf3\x85T;\xa4;\x16\x8b\x03\xc12\xc3\xe1\x9fG\x84\x04<\xb7\xb5\x89\x85\x7f\xc0\x14X\xcd\x15OXP\x93D*\x8fXP\xf3\x02/\x91Y\x05\xab4\x80\x95\xc6\n\u0600\x04\x94:\x01\xbfW\x158\x89\xa6q\xa3\x1d\xab\xfc\x04\x12?\xbe\x19[a`U\xc5\x04\x82i\a\xd4\x11\xb0:¶\x7f&\xd6g\x8d(\f\x922\xc5\x18\x11\xdd1?\x0f\xc8`\x12\xbf\xd9\xc3\x1eR-L\x06\x0f\xc7ٔ\x91\xe5\xbc9\xd9\b\xe9L9\xe1\xefr\x93\xfff\xf4]M\xac\xbc\x84d\xa8\xeac\xd6p\xc0\"\xeaĂ\b\xc1\"\x16P\x19\x16-\x03\xca7\xdb-\xd6;j:\r\x96HRM[\x18\xaa]\x82\xe4ɦ4\xaeo\x03\xa3`\x19\xcbW\xd11>vl\xfa\xa4Ѧc\x1df\xc3H\xeb\xfb^\x8e\xc6b\t\x0e!\xfb\xb0L?0\xa1crB/\xd7\xfb S\xe4y\x06\x80\x00\x13\x9c\x96ݎ\xcd\xf7\v\b\x8c\xfa\x89\x00\x0f\x0f\x00\xec\xe8H\x9fGT7ơY\x19\xe2\xbe\xc9<\x01\xf0T\x87\xe6\x97\xfb\xb5\x01֗\x15\xd1\xfb\xfd\nu`N=\tF\x1c\xcdl\x9b\x1fM\xd1\xc8\xf62\xbb\xa2\x1cyI,\xfc\xb9R\xc6\xc3]\x9f\xd0a0zQrɼ\xd7J|\xd8\xd6\x1a\xaf\x04kA\x96\x00\x0e\x82\xc2\xe3\\>j\xfc\x00\xba\x8eB\xdd-A\x88\xed\x90\xfdQ7Ԩ\x1b\x85Ric\xaa4\x06|\x8d\xc0\x0f\xc7\xf7\x9c\xfc\x98\xf7\\ \x89\xda\xef)C\x973\xd81\x94hK\xb0\xf8\xac\xe4\xdb\xd0iAd\x87\x13\xdd\xddČ\xb9=\x17Du\xd5 \x1bH\xfe\xc8\xfb\xc5T\xde\xfcU\xeb52\x8d\x89\xe0Y\x82\xad\x83ǺB_\x0e\x1c\xa9\x9fX\nu\xf0\x11\xc7\xefh\xaf\x80*l 5\xdb\xe63\xf2=+P\xfd\x86}\xff_\xe4\xc0X\xc0\xffFN\xf1\x99\xfd\x98]\x96\"\xb5}\x00H\x82Zx\xc5+\x97x\xbe\t\x16\xe0\xa1\xc4/\xa5\x8fU\x16\xa83\x88ö\x91\x8f\xaa7\xac\xcey$\xd7i\x14e\xb8\x18\x9c/\x00\xc2\xcaÈ+\b\xee\x04j\xde\xc9\x18\xcem\x19K&\xbbf\xa8\xb6$\xd1\x1f\xf2Ґ~\x01\x83u\x144\x1f7M䃚\xa5Kk\x066\xd3˼c\xafks\xfe\xb72\xd4\x03{\xe5\xc7\xed\x16\xfe\xb8#\x1d\xe3\xcc\xf9+\x9c\x1e\xac\xe2\xdb\xf6%\xaar}S\x12\xbd\x95\xd2{\x8f\x7f\x83\xc3`\x10\xec\x86,\xc97̂\xd7=\xa5_\xb9d\xd033z\xf0\x8fEݒ5\xf1`\vA\x87\xf5-I\xbe\xe4.+\f\xdb\xc1\xb9\xb5\xd8\xf7\x92\xde\xc9e\x90Ю\xa4\xc5ߘė\xa1\xfa\xba\x02\x9fY߰\xef\xe9.6\xe4\x9d\xec&r\xd7&\vQ\xecؽ\xc2\x1fΏ;\xca\x16\xe4G\xf6\xab>\xf6\xae\x81\xd4v\xf9\x14\xfe\xc4\xe9\xbd\xf7\xd7=>\xa4@EE\x98\xbd\x92[\xe9\xdf\xd4\x0eM\x85\x83\x9a\xd6a)l\xb0ĸ\xe7?Ԓ\xbfݪ\xc6\x10(\xca@\xef=\x90\xc9\xd0\".\xf8\x8f\x8er\n\xffHŁ\x90\xfc\xbb\xc3\x1c\x9b\xbf\x15\xd9vaƶs\v'\xad\x04\x87\xbb=Ό\xc7\xeb,\xe6\x8d\xe1E\x19S\xc6\xe7\x9ef\xca\x00wf}\x98m\xb7\xc9\x18.\xbed\xb8\xc4\xc0Es\x91;\x13*N\x8bJ2\x18,\xaef\xb0\x84\xc4\xddg\xac\xb1ȝ\x8a'\r\x1f\x87\x1c\x96\f9\xcc\ry\x7f\x94k\x12\x1aC\xcaf2P\xfc!\xdd\xd1Pw4 a9\a\xb0\xd1\x01\x06\xa0d37\x1a\xf5\xd6o\xd4\xf8\xc3\xf8\x83\x05wa\xa9\x9a\x9f\x8c\t\xdf\xebi\xabp+5\xd90\x19\x7fڻ\xeb\x9eB\x8d\x9f7%y\xc8T\xa5R\xf9\xf7\r\x893\xefc\xb1\x14\x1d|\x95\xdac\xaf7\xe8=\xee\x00\x18|yR\xa5O\xc0\xc2l\xacnv\xa9\xf72\xab\x89\x98\xab\xe1\x19\x06\x12>w\xf1F\xbe\xe1\xaeq#\a\x053\"\xf3\xb2tJ\xf2\\b\xf3d\x8d\x99\x14{;\xa1a\x16Qn\x0f\xc14[\x8b\xb2\bo`\xd8F\xc8\x13\xb9\x1a)\xc8<\xe7\x91\x16F\x97\x9b<8\x18s!b!e\xaal5\xf3Z\xa8\x8a\x88ԒA\x92\x89\xdd\xfa \xa73\xbd/\x1d).?⦵p\x130q\x01?vT\x8aA{\xdd\xc7xO\x19\x90\xe6\xd4q\nrI\x19c\xbb\xa8\x8d\xaa\xe0x\x9a\xc1\xf1:\x03\xde\\\x88s\x17\xe0\x18\xfd\x99\xc5?z\xc9\x12XT\xae\xe1|u\x95\xa9\x9a-Ps4\xcdi\xd1a\xf8\x04\xa8\xaf\x90\xf9v\xbb\xc0\xe8\xd6\x1a L\xf6\x9cY\x1f\xab\x04\xdbm\x16\xa5\v\xae\xe3\x95\x05*a\xe8<%\xd8\xc8'JW\x9b\x0e7\xa9\aJ\x1e\x93\x15y\xdafa\xdaR\x06\xb9\x1d&gt9Z*rߙ\x91\x84E,\xaaj\xcdh\xca9\x97\xaaѝ^k\xd0\xff\x1a\x11\x13xʣ\xb5$\xf2VOA\x1a\x92\x97\x87\xeed\"o\x85h\x03\x1eJ\x94\xaaBC\xb3\xbf\xdf\xe9䵜\xba\xddV{@\x89\xe5\xc6w\xc14ݺ\xa8\x10\xd5\x05\xe5h%\x9ek\xf4Z\xcd\xd2rK7~\xad\x184P\xaa\xd5\x19\xd4[\xa5\x19ˌ\xdb\xc1eH\xb3\xbc?\x9eѕV\xa7\xdfl\xff6\xdd\xcb2]+ņ\xf5\xb3\xads\xc8\xe4+\xf5\xfaQp\xb1\xa7<\x84\xccR{\x87Uƅ]d\\\xd8\xebԇ\xc5]\xe6\xaa\xe92s\xd6|\x93\xc9r\xcf2\t\xfcm\x16\x00\xed\"ۺ\xe7\x99C\x8b\xd3\xcc\xe3\xc5U\x167\xe5E\x16\x84\x02\xb8\xb5]ͫU\xd1(^gr\xfdw\x99W\xf7OY\xf0\xcf\x0fY`\xb6\x8f\x99\x95\xc5یA\xfd2\xf3\x92\xf2&\x8bw\xf5m\x16\xd5\xe8U\xe60\xe5\u05cc\xc5\xfb\x19\xcdG\xdb\xd0\xf0/\\\xaf/\xfbK\xc6\xd7\xfe&;O~\xcc\fy\xa5\x81*\xfb\x9eC\xa8go6\x13\x81Eٟ\xb4\xcf\x13\x8b\xfd\xc0\x7f-\xb8\xb0b\xdf\xf1o\xf0\xb8\xf9\x1b\xff\xc68n\xfeD\xd9_\xf7\xc8u\xf6g\x1ej\x87-?\xf1?\xdb\xf6\x9f\x8doB\xe4\xb4k\x13x\xfd\xb3\xac\x81E\xe2\x802^ \x90K\x1c\n\xfe\x0e<\xce\v\xfe\x1e\x02\xbd\t\xfe\x01\x90\xb1\xe0~\xe6\xa4\xd8\x17\xfc%Q>\x7f\x80\xa9\xbd\xc1\xf7p}\x9c%Me\x16\xac\xfe8\t\u0557\xe3H,\xbc8\x01\x13}\xb6\x969d:\xe4\x90y\x8d\x1cs\xcc!\xdd\xd3,\x05\xaf\xc0\xad\xa4\x92\x18>\xea\v\xafh\f\x8d\xae\xec\xd8L\x80\x0f\x9by\x99L\xa7W\xe1\xa7$\x14i\x18\xe0r7\xfd\xa1\xd2\xfd\xc2,\xf2\xb8\xec\xedhͅ\xab\x7f\xcd-\xc6\xd4\xcd\xf4\x04\x03A\xfe\xaaB2\xaa\x1b\xcb_\xc1!l(T6\x88\xcc\x03\f\xf0\xbf\xdav\x88Y\x81\x1ctB\xc1V\xa5\x8cY\xee\vp\x81sJ~J1\xf7w$RtşX\xe2.\xe0\x14Wz*p\x17Ov\x94M!\x98n\xcdH\xe5\te\x11\xc4\xe4\xd8\xe7\xeap\xec\xcaB\x90\x8d\xeaߥ>g\x02~K2\x9f\xad\x97\xe8p\x9c\xf8\x02\x14\x1a\x89\x19\fr\x84z\x8d\xdfS\b\x96\xf1\xfdd\x1cL0\xbc\">q\xf0\xf7\x1d\xf1S\xe8qV\xc09'u\xe0\x91 \x13E\x96\xddnq\x82\xbeg\xe7\xa4\xc1\xee\x91\x19\xa7k\xa8S6\x83opTQ'ԏ;v]\x9c\xb0\xcb\xec\x84<\x93\xe4\xfb\x15\x89\xd2\x00}g\xc8\x02\xd6\xc3\xf9\x91\x04\x06\xf1\x95H\xdeoL\xee\x04\x8b\xd0\xfcw\xa1\xd6\vc$Bwv쮄\xdf\xceoeH\x88\x98x\xf2\xfe\xce\xd2\x19\xab\x10u+\xfb\xabm\xe3\xbc\x01\x83\xa2\xb2\xc4y\x96\x01\xdeH\xb4\xddVT\x9cih\xb3\xa2\xb2m\xb7K\xcd\x04\x92W;\x98\x8adB\xb7[\x18\xf6e9\x9c\x9cAGԒ\x01s\t@LW\x19`\x95\x1bx\xd07\x97@\x06\xbf\xd2}\r\xf3Y#l:\x92K\x00\x01\v\x8c\xe5\xc6\x15\tw;vS6\x1f\xae g\xcab!\xf3\xc9\xf2#I\xf2\xd416%\xb0\xa9o\xf1o,\xb9\xe00\xd3ю\x9d\x95Ռ\x90\xca\"h!\x19m\x84#\x9b\tr\xcdD\xf9f*i;\tN\xfd_u[\x01\xc3m\x85\xed\x05\xbb!Yo\xb7\xe4\xcf<o*tM~b\x92\xf4\x92\x8cG!\xb4ù#O\xc6\x18u\v\xf1\xe9%\x87\xa7H\x98\xd9v\xaa\xf9e\xaa\x8d\x8e.\x88\xf9JS\x82\x8d\xbf\x92 e\x0e$\x83$t\xe4\vcb\x06\x8c\xc8\xc7\xf1\xf7\x13\x841\x92\x81\f\uefd9\xc2d\tn.\x91\t\xb2\x8e\xa6\xb6\xbd\x14\x80\xa7\x10\x0f\xedE\xf8\x88E\x02\xc6Cl\xa58\x89\xec-\xf9\x89\x9b\xa7\x8c\x11\xed\xa3\x04\xcf*FH-q\x170\xcfoɟ\x99\x95\xf3!V\xae\x85\xbb\x12\xe4\x954\x95\x82R\x1fjs~'ػڜ/\x04\xfbT\x9b\xf3k\x81̯K\xc1^@H\xccڜ\xdf\b\xf6sm\xce\xcf\x04\xfb\\\x1e\x1et%ȯ\xaaJ\x14%\x85\x82\xfc\xc4,\x03?ZO\rޢ\xc7d\x94\x05N\xcbf\xbb}K\xfe\x8a\xee\xe6\xf6\x02\xffY\xecN\xe4\xd9Y\xe8\x9b\xe3 \x8b\xaa\xb2Vzw`\xa2\"\x01\xce\xf9\xf3\x8e\xb2\x1f\xc9\x15\x99\x8b\x82:\xca/R*d8\xfb\xf8Ӟf\xe6Z:\xcb(\xb9]\\(\x7f\xdfK25\xc3\xc4N!\x02\x91B\xa4\x7f6\x8e\x00L\xe7\x11[c\xa0\xfa\x04vꕸ{U\xa8\x1a8\xfbw\x99;F!\x88\xa8ZٖQ\x8e\x02U\xbb@\xaa\xa4\xed\xae\x05:\xfd\xdd\xc4B\u00879\xffp\xfc\xd7\xe57o\xb5\xf6E\xf1\x1b\x1a\xe3?AMUO\xee4Ss.\xd5H5\f\xb5N\x89\xa0ε \xa7JE\x95\x154\x7f\x17\x82\xed\xa9'_\vvP\x03\xf6R<\xb1\xab{\xfa\xa8\x184ֹ)\xd6-\x81$vΞVq\x9e(\xfa\xb96O5(˫-\xd9PP\xe8F)U\xb2\b\xa4o\x8f\x99\x1bT\xd6\xdbm\xbea\x89-\xffLRӀ1\x98\nL\xac\n\x8f\x04\x01\xe4\xbc\xddZ\xf7;\xf9z\xef:bg&ȡa\x0f\xb0\xe7\x87-\x19$%\xf31C\xb6p\xbfJ\x00\xfaȂ\xc0Y\x9bY\xe1\xc1\xf9|gx\x88\x9d\xe1w\x92\xec{x\\\x91PJ\x1cc\x12\xb2\xd4K\be\x95;\x92m#\xa0Ăq\x03v\x8aG\"!-!\xc0ݯ\xd4y\xf8i\xfc\x83r\x83x+\xf8O:j\xe0\xf0-\xf9\x89\xfdP\x8a\x18cr+i\f\x1c\xf3_ȟٟ(\xfbv\xfc\xfd\x04wF\xbb\xe0\xf8!\xbb\x86\xae\x80G~\xaa\x1cL\xf5[\x83\xf6\xe1\\\x90\xa5\xdb\xe8u\xbb\xa5Y\x94\xd5\rf\xda\xf3\x85\xa23\xc5\u008ddE\xfdA>|\x98\x91GkÀ]A\xeb@\xb71\xe2\t\xb6\xd5j\xb4\xca\xeb\xc9ݝ tO\xaf\xd1>\x90\xd1p|\b\x01\x88\xba\x9dAi\xc6M\x10O\xc35\xa0m\xec]\xa7\xddj\xb5\x1fgd\x95ٕ\xa5\x9aP\xd2#~\xb7\xad\xd8X\xd2\nE^\xd7\xfb\x1d\xe5\xf1Q]\xb6Q\xf3\xae\x81*J\xf3\xf4FY\x13\x01\xee!\xbc\xb5\xb7\xfau`w\xcdx%\xac\x9dNa\xe0?ɭ`\xdbV\xee\x1d✇l\xc5\xff;\x98\xb9\xb0\x05\xf7\x89\xf5\xa3p\xafВf\xc56\x12\x99\xdb\xf6\x8c\xde\a|\x03\x17\xd7\x17\x19aDV,\xcb\f\xb4c\\\x13\x01\xcc0\x91\x14\xd357Yfw\xdc%\xd75ys\x02F\x03\xbc-]\xb4\xbe\x84ǅH\xa4\x05\xe65\x06\x84\x1dz\xe4\x9a)\xc9P\xf1\x10\x9a\x12e\x82\x95\xb9ן\xe7E3\t\x98g\xff*\x02\xd8\xe3\xeaQ\xca\xe7@\xf6\xaa\xe4(ۭ\xfe\xa4:\x05\a\xae*\x9ffڱ\xa5\x1b\xffKۿ,i\x7f\xe9\xc6F\xe3\x97Y\xe39R\xe5_\xd9\xf8\xe8F?9i/\x16\"1zq\x93\xf5\"\xce\xf5\x82%\xe5\xfd\x88\xb2~d\x1d\x88\x0ev\xe0,\xf3\xc1\xe4\xe8\\\xb0\xf2\u06004?5\xb2\f\xf7B\xec\xf5\a\xbd\x02\xee@_\xae\xc0\xa3\xeev\xf3\x86\x00h=\x89:\xfd\xbd\x82\x89\x00\xe8٣YJ\xb1.T\xcc\x06\xe6^\xbd\xde\xf8\x1a\xe6\x9eR8tS\x85\xc3GT\xab\x93\xa8L\xfd5\xac\xcdSI\x81kD\x0e\x8d\xa48i\xa4\xe5\x8eN&\xa3\"\x91\x96/%9\xf9R\xb3\xdf\xeb\xb7\x0e\xf3T!PF\x18#\x8em\xb7\x06\xbdr|=\xcb2u;\xbd\xc1\xe0\xf0)#\xfd\x0e\xb6;\xed\x03\xd8z%\x12\x17\"W`\xaen\xafW\x9e+\xbc\x8cEt\xad\x99\xb0\xfdz>l[\x96o\xed&\x89\x88\x82\xb7\xfa|ku\x1b\xbd\xe6C\xe7\x9b:*{\xddV\xb1\xe1f\xafM3\xe1R\xab\xa9cǛ\xf8\xbf\xab\xf5\xae\x95\x17\xdf8s\xdcu8D\xa3\xd6\x13\xdbȨ\xe8* c8\xdeL\xb4/\xe6,,\xe3\x1a\xfc\x1d\xac\xc1ׂ\x0f\xca\xd6kTgc\xf1x3ᱴ\xb6\x057P\x8df\xfb\xe9ּ\x8a\xb1\xece\x1c`<\xb4\xfa݆\xb6\x06AFꆟ\xbc=\x7f}vT\xfbRSږ1\x1c_a\x16;e\xfd\xc4`v%\xfe\x84Yȃ\x91\x9f\x91i\xacI\v\x0e\xda\x04\tF9\xc3\x1fO\xba\xad\x9f\x12\xa5%\x01\xb2`G\x86L\xcd\xf9\xd4eh\"\x9dѢ\x1biԣ\"Z8k\x12ֲW\n\x88\xecu\x90\x00l\xf9\xfa\x9b~\x97ҭN\xab\xde>lfZ\x00\x93\xc7x\xfa\x99]\x86a?\xdd췻}\x1d\xfc\x009\xd7ӌ\xa0Xg|g\xf4\xbc\"ݭ,3\x16\xf4,՜.\xf0\xf4\x15\xcf\xff:\x8b\xc6s\x97\x82l\x81\xa7o(a+\xee\xfdmƲ\xbf\xc8\x18\xf9\xc0\xd3\xef\xb4ڽ\xae\xe2\xe97\a\xf5\xa6\xe2\xe9\x83'u\xc9\xd3Wl\xf4\xf7\x99\xf7\x98\x9f\xf9\vS>\xc2^s\xeb\xf3\xc77\xe7H;~p#w\x15[\xec\xdd^Z&\x96\xfdė\xb0.\xec\x03_\x1a|\xf2\xd7\xc0\xf77\x13\xde\x01\xf7\x1f\xfc\xab\n\xdc\xfc\xec%\xbc|\x14\xbflD\x9cX \x03p\x89\xf5\xbdpg\"\x02\x16\xf3\xb7\xfc\xa5m\xbf4\x88\x91W\xfc\x8dm\xbf1\x12~\xe5aMFfb\x9fs\f\xf6_xX\x9b\t\xd0C\xfe\xfc\xf1\xf5\x8bp\xb5\x0e\x03\x11\x80\x1e7p\xce\xf6\x92\xbf\xc9۹\xff\xc8\xe3,\x18\xe0\xf7<\xce\xf8\xec\x7f*\x98\xbb\xff ?\xc6Ko\x9e\x80\xb0@\xbe\xad\xa50\xf3o2\xb3\x8a\x14\xf8W\xf5&\xbf\xfd\x99\x9f|\xa9\x9e,\xd8O\x1c1\x04i\xa3\x0ew\xc9f\xfdi,\x8e\x1b\xc0\xf2\x93\x0f \xa1 d\xe4\xfc~\xfc\xe5\xcb\xcc=\x9eO\xee\x9b;zoUE\xd5\xdaQ\x8bY\vς\xfbW\"\x0eE@\xf8%\x8b\x80\x90]\xae\xc5nǢ2\x1eߟ\x88`\x7ff\xd6\x11\x86\xdah\x0fs\xf5$\xa6n[\x18\x91a4\xa4P\"aB\x10\x88\xdb\xc7\x12\xc3\xeec\xb7c\x81\xe0'\xe3\xca\x1f\b\xfd\xf7\xc9\xf6\xf7\xcd\xfaɂ\x85\x82\xdf[\x15˱~\xdflX\xcc\xfa\x03>\xf5,f\x11|\xea[̢\xf84\xb0\x98\xf5\xef\xf0\xd4;\xb3\x98\xf5\xfbf\xddr\xac\xaaUP\x9e6D\x05ȷ\xf0J?\xfe\x89\xfc\x059\xb3\x82\xb9B)\xef\x16դ>\x99\xba\x02\xef\x98\xde\x16\xce9\xf9@Dj*LM?\x8aV\xb6\x15\xf6\xef\xd4\x1f\r\x97\x8aP\x065gu\xad\x18A\x89H\xdd\x16\xa9B\x9b\xaa\xd8)\xfdN\x95ε\xcbD\x9e\x8c\x82ڕ\xb83\xfc$b\x92v\x8a\bߘz\x9dP\x16\x81\xb5K\x9d\x16\xb5z\xa1Kz(\xa0\x1b\x84\xef\x9bH\x86\xf4f\xa67\x1f\xe4,\xc8H\xe3k7\x8aEz\xd7w\xb2\xb4?oDtG\xf6\xbc\t\x88\x915\x82\xee}\x83\x8e\x16F\x7f%\x825\xa8\xa3x\xc5t7\xf4\x85\x19\xff\x1c~\x9d\xd7x:|\xfe\xf8\xc6\xd9\xeb-\xf4Ni\xe5m\xd637\x11\x04Ti\xb3.=\xe0\xc7;\x86S\x99\x9f*\xfeچJ\x17\xdb\xe0\x17\x8a\b\xb6\xa1\x14WaXA\xb5\x81\x88%\x94\xe2\xe4\x0f\x91fv9\t\xf99\xb9$jN\xa9\xca\xceH\xcc=\xe2\xb2P\xe5F;\xc4|BE\xbe\xe2\x9b\xe2\xbf}&\xd6\xd9\xed\x1aM\x13\x8fb\xc0|\xc1T\x1c\x01\x1bV\xbb\xcdiZt\xf8=1\x97\aMi\x9d3\x12\xab\xf6\x95R\xe7\x19\xf1S\x9dbIzk\xb2e\nd\x8b\xa0\v\"ؔ\xdavYmӴ\x121\x9e\x02\xe3}ǲ\x85,\xde^\x04\xcd[X\xff\x8d\bfٖt\xf3\x16>\xd3z\nCJ\x12\x1e\x8cC0\xa2N\x99\xed\x04u\xb7\x98\xc5-\xca\xca:\x12\t\xf2\x03\x89\xa8\x1eT$\b\x88\r \xbbd%\xc5\"\xf2\\\xdf\xfb5\xc7a\xd4\xdd\x11,\xe1\xb9*Aʡ<2%i\xaf\x04\x97ޗ\xd8\xf7$B\x85`\xd8!\xb4jq\xab\x8aojUM\xb1\x05\x8cn\xc7$\x949\xc5p\xfe\xaa1U?\xaf\xb3\xe2>\xd0\xd0Z\xfb\x05^ӊr@\x9d\xc1\xb4m\xeb\xa7\f\xacwH\xaflr\xb1\xc1d<06\x15\x87$\x1b\xcf\xebe\x1c\x82\xa1Bgp\x97C\xab\x05\x8a:\xef\x9b\\hq\xb8\x19N\x05\xbb\x97a\xcb\n\xf7ƫ=\xe5\x16\xd6Ԃ\xbd\x0f\xea\xf6\xf8=\x89\xf4\xbchp5\x00\x15uL\xa3Z:\r\x04\xb9\xb6{\\\x82\x92\x86\x1a\x191\x9e\xf0\x0f\x86&\x96n,\x90\x926\t\x89Q\xba\xe6\xd18\x9c\xc02c|/\x90І\x80y\xc2ju\x98\xe4\xbbQ\xbc\xac?\xb1\x0f\x06\xc4)I_\x0e\xe6\x02\x19\x00 \x19\a\xba\x17Qʆ\x84\xb4\x1c\xa6\a\x8c\x8b\x1d9\xf5\xfd\x7fM_\xc6\x135!i\x87\xc0\tc\x92\xcdI\x04X\x01\xdc熪/)\xf4\a\xfb̓\x7f͔\xa8\xf9\xa8V\x8b3R\xd17\x8aJ\xa3\x8ck\xf1p뀎4X\x84<H\xfb\xe0\x82Վ';\x12K\xf1\xb3\xcf\xebC?\x8b\x0e\xeb\x83\xdf.\b\x0f\xebO\xa8\xea\x91g\xdb\xc4\x1d}GB\xe6\x1f\x1f\x03\xbc\x10\x17\xad \xd4\x01\fZ_\xeev\xfb=\t%\x88{\n\xbec`,\xe7\xa1*\xef\x1eO\x13\x02z\xb3@(H\xdd\xd1r\xf7\x7f\x88\xa2\x9e'\xf0+\xddΡ9L\xae\t\xedo\xfd\x80#\x8c\xbdU\t\xf85y\xb2+˽\xfd\x14\x10\x92\xf0H\xe1v\x1c6\xc3\xde)\x068\xcb;{H\xc7\x01H'֚\x1b\xd2\xe3\xf2\x8e\x15])\x94\xe7\xd6^\x99wl\xcf\xedJy\x81\xd4u\xf3n\x97\xd3n@\x9d\xf6\r\xa0\xb7\x9f\xd94\x9bx\x19Q&-\xa5\xf3<,CՓ\x9a\x9eJ\x04\xb5狭\xad\xc9F\xb0\xd7\x05\xb9b\xaa\x99\xbbc\xf7\x85\x8b\x94\xb3\x11@\xa5\xf9\xa03\xffFq\xe6ւ\xc7\xe4\x95d\xb6\xce\xe5s,\x12\x9a7\xde\xc1\xe3\xf9&\xe3*\"iy\x19\xce\xd0\xc9\xf5\x1d\x89(\xe7\xfcu\x8ay\xb8\xa8-\xe5\xed\n-\x11ސ\xf4\x9d\xa2\r\xc9\x1b\xb6\x16pVO\xc3 \x11Ar\x8c\x81\xba\xe8v;\xdfOe\x96\xbb\x86{\x0e\x06U=\xb9=\xbe\xb9\xb99\x9e\x87\xd1\xeax\x13\xf9\xf2\x865\x1b\xc2}*\x16\t\xff\xfc\xe9\xd5q\x1f\x1c\x80\x12\xc1\xee\xa1s\xce\x05\xa9\xb33<\xf5U\xfb\x98\x02\xa7\xc4.\xbd\x92\fQ\xae\xf3Vʲ\xb2Y\xccMu:\xa7\x18\xc0C\x1c\xb0\x06y[\x0e\xf7KALЧ\xce\xfd\x0e\xe5g+\xf2RM\xe7\xac\xf4\x06\xa1\xce\xe1o)\x1e\xa9/\xbf\xa2\xf2\xe1\xb79K\xd5\x19\b*\r\x02\xf8\xdbrx\x81\xb1\xa9;\xb23\x13\xd0G#\xd2\xe7> \xc1Ar\x8e\xd6?\x1f \x84\\\xab\xfb\x90\x0f.Tۦ\xe5:\xc0Je\xd1\xcb\xf8\x1f\x85`\u008ag\xb1\xc9x%S\xc3ה᷀\xcdS\x0eHA\xd5Qz\xd9b\xab4\x1e\x84\xd4u\xec\xf4\x06u\xc9\x17\xd1*\xe3\xe8o\x0e\xd9#\xbdVsА\xfc\x11\xc5\t\xb9\xc9\x180g\xb2/\xf5\xb6\xe4\x8f(\x06\xcc\x05\xbfE\xee\xc49\xbf5\x98\x11\xc0Ͱ\x80MrV+\xcc\"\xbb\xe2g5=\x8d\xec\x05\x8f!\x03{\xcfc\x83\xc3\xf03W$\xdf\xeb a\xaf\x8d\xf8\xa3\xec\x9d|Y\x877\xec\x13ߘ\x8c\x85\x0f|cx\xd7\xfb\xc87\x19\x9b\xe1-\xdf\xe4\x1d罔_\xd7\xe1\x1a\xf8\"\x9b\x8c\a\xf1-\xdf\xe4x\x10\xaf\xf8\xc6\xe0A\xfc\xca7\x06\xd3\xe13\xdf\x18L\x87_\xe4[\x12\xbe\toD\xf4\u008d\x05(Mb\xe9M\xa0\xca\x7fí\xd7\xc1\xb5\xeb{\xb3\xa3x\xba\x14\xe0\xe0\xfe\xc7,i\x19Ɖž\xcf\x12\x00\n-\xf6'~2v\x8f\x7f\x9d\x9cx\xec\a\xf0\xf77\xab\x1e\xd7\xd4\xfbw\xfc\xe4\xcb\xec\x84\xfd\x8d\x9f\xfc\xbd~{Ɀ\x82\x93\xbf\xfaqoR\xfd\xe6\x04\xb8 \x7f\xff2\x83\xa7\x9f Yr4\xaaߜxL\x00\xaf\xe0K\x1d\xe3\xc6EG\xbf\xfb\xfd\x89\xf3\xec\xf9\xe8\xdf\xc6_\xbe|\x99\xfc};9aI\xfe{\xf1s$\xb0\xbeM\xbd\x8e\xbe\x04\xeb\xf5f}R\xdd\x16\x13\xbe9YH\xa6\x84\xacf\"9\x12\a.\x91\x80\x8et\x14\xd0\xec~+\x8f\xdc\x04\b.\x19\xb1\xa9\x8d\xa1\xf9\xffB\x12&~\xdf\xect)\x13\xfc5\x11'\xf0\xa8)\xac\x8f$\x91\xa1\xe6\xa1F\x19\x98\xb3\xa4F\f\x00_z\xb6\xcb\x1bz\xc4!\xfa\x14>ʰ\x94\xf5\xa1\xfb\xac?t\xabU\x8a\xf7\xf6\xb1;\x19\x91\xf0y\x84b\xf1\x80E<\xa4F~\xea\x10\xe5\x06\x1c\x82\xc1\aܥ\xacZ\rifΐ+\x97\xec\xa4\n\x14\f\xb0\x8f\x03\fm\x1b\x1d\xf8\x8e#TGC\x93c\xd0m\nб.D\x9a\x8fF\x96\x03\xff9\x964\x10w \xf1-\x81\x12\xe0p\x91E\xcfz2$\xbd\xe5X\x94fJ\aV5\x81@\xec\xd99\xc0\\\x01\xee\xbb<0X\xbc\x877vo\x1dYN\x83\xfd\xc1\xfa\x83\xd3`\xd63x\xb6\x9e\xe3\xef\x7f\xb2\x9cƎ\xb2X\xe5\xf5 \xef\xef\xf0\xcb\b\x7f\xef\xf1w's\xf9*W\f\xb9N\xf0\x8b\x83\xbfC\xfc\xe5\xf8\xfbo\xf8;\xc6\xdf/_\xf0\xcf\x04\x7f\xff\x8e\xbf[Yզ\\\a\xf0\x1a\xd9\x1ezR\xa3筦mG\xcf\x1a\xcd\x1ej\xbf%\f\xc3p\xef\xf3#\xd16p*\xf8\xfd<Y;\xcd\x06Àոr\xcb$Y;\xfd:\xfe\x8d\x9dv\xbb\xc5nbx\xbf\x89\xf1mϰXuD\xf7\xa0\xc9S\x93\x13\xdb\xfe@\xfe\xc4>a\aAu\xc2r,\xf4\x84\xf6\tY4\xca'\x82\xb5\xb5\x80L/\x98\x1f\xe7]\x1b\x1c\x89\xf4г\xed\xb5 \x9f\xa1J\xd6\xc4J\x8d\xf6\xb6[\xeb\x048A$\xc1&\x9a\xd0\x04L(\xf0\xae\xb6[k\xa4\x1f~\x87\x0fE\x1b\xe54~j\r>\x8b\xed\xd6\xfa}S\xc0#\xb23A\xb9\xf8~\a\n\xbb\xf7\xa8S{\x8f\xba\xa8\xf7\xa8!z\x8f\n\x9a\xf7\xa8\x1dy\x8f\x9a\x8c\xf7;v\x8b\xbf\x17\xf8{\x8e\xbf\xa7\xf8{\x85\xbf/\xf0\xf7=\xfe\xfe\x8c\xbf\xaf\xf1\xf7\x1d\xfe~\xc2\xdf\x0f\xf8\xfb\x11\x7f\xdf\x1e\xb4\xff\xc7\x00r\x97\x8a\xe9$\x05\xb0!Ϙ\x05\xc4\xd3JY\xefI\xa8\xacOc\xe3\x18\xc2ݚFyKYr\x11\xeeX\xa0=\xde\n\x12\xa1I7\xcbW\xabU[\xccډ˯\xd0\xf3\xd1)\xa55\xc5b\xd3|ɽ\x86\xdd\xddn\xf8v\x9f7\x87'\xa6\xe4\x13\x95\xea\xf5(\a6\xc8k\x93\xbc\nXә\x00\xdf{l\x0e(\r\x9d)\\\xc3\xcf\x1d\x18\x1aJ\x8f헨\xc8\x05F\x855y\xe6@\xceMm\x13\x8b(p\xf5\xdbڍ\xe3\x9b0\x9a\xc978\x88$2\xdb`\xcc\xf8\xf4\xd9M\x96\xe8>\\\xb2^t\xf2<r\x17@\x8a\xe9\xf7)\x06\"\xfeV\x9c~\xeb\xc6p\x9f\x82\x0e\t\xfe-\x11,\x12̲(Uo\x01\xbe\xb1\x90\xaf`\x11\xd7\xcfR\xaf\xdf\xc3Ե\xbc\xcb\xc3\xf1z¦ʑ\xfcL8\x18pu\xbb\xad\xc0\x06s\xa5\x1f\xfbD\x93\xe0\xdf\f\xa7|!\x86@J{\xc1F\xec\xe6U\xfe\v:\xd4\xe0+az\x99_a5\xaem\x93\x0f\xe4\a\xe6\xc2f\xa9Z\x9c\xbbۭu\xac\xfe\xc2Vp)U5\f5\x98X\x10\xda\xde-\xb6\x8a\x93\x0fM\xa3\x1bĴy\xc8d\xdbdS\xf3\xe2s\xd0\x01r}B+\x1c\xd5\x0f\xe7\xd0&`\x1e\v\xfd\x1cA\x1eeA\xf8\"\x123\x11$\x9e\xebǄn\xb70\xa5\x15\xce\xe5:\x18\x85\xf4b\xdavE\xae\x97V\xc4B\x0e\xac^\xe99KL\xb7\xf0\xf9\xae\x80w\x92\xb1\xce:\xd1m`g\xd2E\xa7T\x0e\xae\xd8\xech\xca_\b\xa7P]dۑn\xd9\xccy]\xc89\x9a\xf23\xe1 \xc6\n\xc7\xebjc2\"SP{\x05O\xd8\x0e)\x83\x9f:{C$\xf8!\xc4L\xf9'AwƂ.$\\D\xdbm\xb4Wڶ\xad\xdfᢥ\xcb\xe5\xcdɁl\xb0\xe6\xf7\xe9\xec\xe9\xc1h\xc8_\x90\b\x1fh\xba\x01\"\xf9\xd7\xdc\x03\xb8\x81\xcaF0\xe5\x1f\x15\x10\xee\xa6\\ϧnb\xf4B8\x97\x19\xe4\xcaQ]㨬\x13\xe8=b\xf8\x8a\x9a/z?\xe5F\xeeݔ\xdf\xe2\xec\x990~\xa7\v㘦\xfc\"k\xfc]\xb1\xa1K̻?n\x97\xf3\x80\x1a\xa8\"J\x1fM\x94\x11\xa5\x8f\x1auD\xf8G#\x8f\b\xff<:\x89C\xed^]\xf6Y\x1f`\xaem瀇N\xf9\x8d\xc8\xf2\x8ep|\xff\xdd\xfb\x88\xfb\xfb\x83\xc8\xf0\x80\x04\xa9\xfb\xff\x8e\r\xc3_\x1dl\xe2\x98\xe5Vm\xf7\xff\xab5)\x006\x80\xb0\xb9\xedn\xe4\xb6˭\x8f\x02T\u05f6a\xfd4\xb2\xb4N\xfe\xb5\xf3\x95\x9f\x0e\x80n)\xa3\x81\x9d`\xee\x823\xec!\xa4\xb2\xdc>\xfaD\xe6l]mP\x9an\x84\xc2\xf6\xb95\xf6\x9e1\x14\xdcGi\xbbF\xfe\v\x99\xff\xdf\xe4v\x9b\xd96\x99s\xeb\xf7\xed\xbaU\x9d\xa3\x02c\x9dy|E\xe6\x19k\xf5\x86ׇ7\xcf<}\xe2\xdd@<\x15H?\xe3\xde\xf8f2\xd4g\xcd\xd9v{'?\xdc\xf2\x8d g\xcc\x17tx7\xcaf\xac\xcao\x9dlV\xab\xfcVN\xc4\x1d\xa8\xc9\x02\xeaN\xa3\x16\xc0fVt\xa2\xabhBW\x91\x84\x877\x1b\xac\xdd̶-8\xa6\x14\x02Mo\xa7\xee&Y\x86\x91\x97\xdcY\xc3\xf51\x0eN\r\xa6\xda`\xeaD<W\xcb2\xafrל\xdds!ü\x9c\xe2\xac\x01-\\8_`\xa6\x7f\x16\xb9\xa3T\x1e\xbd\xdb\xed5\xbd\xff\x8f\x8c\xa6pf\x99#;\xfaq\xa8z\xf3u\xa7\xb2q\xf2Ɛ\x06\x14\xdc\xf7a\x9c\x90\xb9\xfet\x14\xc3G5)\xafEz*g\x03\xb4\xc6\xd0\xe1\xd15\xaf\xd4\x1dk\";O\xae\xf1\xd6\a\x93\x97ҩ\xfb\xfd}J\x93W\x82%\x9c\x9f\n\xf5\xcd\x04\xdc+\xb9\x87?\x90\xef49\xf5[\xa6v\xbb\x95\x04\xb8eU\xf8\\\xc2\xeb\x05\xff\x99\xccY\xa3\x8e\xe4\xf9\xc5\xf3n\xa7\xd3\xea\xe8\xbe}?T۹\xb0\x1a\x17\x9cs\x93(\x19\xc1D;\x17\bN;\x83\xe6\x1a\xc2,f\x93\xa7k\xdd\x15\xe1\xecE\xfe`\x93P\xc6\n\a\f\x9d\xf2\xf7\x19f\a\"B\xe6\xab\xf0Ȁ\xc6wyhTGc\x01C=\xf1\x80Ӈ֓J\x1f:z\xe6\x82|$\v\x12\xb25E\xa2\x1a)\xfcGj\x8c\x97a\x94\x88\xe0\x83\x9b,\t\xa5{\x87\xca\u05cc\xa6\xech0\xe1\xea\xbdA\x7fdS-\xb7\xb5$D\xa2l\xdbG\x195[\x1c\x96m\x93\xb5P\x9d\x18\xd7'p!\x1b\xa5\xc4`\x9aL\x9d\\\xef\vC\x93=\xfaY8\xfb\xd0\xfd\xe5K\x19\x98K\xbb\x9e\x04\xaf\xdcs 9\xde\x19$\a\xee@\x89J\xb0I\xcbb9\xb8L\xf7\xea\x83;\xd3\xf2é\xebC\x05\x88\xf6\xe0\x011\x8e\xaa\x92fu\xa6hcg^lrp\xfeZ\xc1y\x11\xd9A\xc7Y\xf1\x10Kk\xd1#\x92,\x02\xf8\xa4^~\x97\x9e\xdbn\x05YXF=\xb4P~\x1f\f\x86*=\x0f\xbeFo\xdf\x1d^\x88=\xb4\x02\x8b@\n\x8b#1M\r\xb9\x15\xc4\xe7\xbf\x10\x9fϑ\xe3\xf1\xfb\xa6\xc0D\x1f.p\x8a\x87\xe1\xcbt\xfd6\"\x85]\xc0\x1e\xeb\x81y\xf3\xa0\xce\x12\x00b\xf45e\x1cRzg3\xc8;\x05f\xe9\xf2\xe7\xe1`\x8e\xc4J\x9dV\x81\x95\x97݃攲ҫ\x99m\xeb\xa9-\xcc\x1a\xaa\x91\xe5\x1a\x06\xa6\x12\x803\xdf\xe8}4\xa4\xafT\x03t(ˏHa%\xa9#+\xc4^\x16מ\xa6\xe7\xfdF\x10\x97ł\x9a\v\xffI8\x8fT:*\xab\xd3QP\x98\xf6R\xd5\xee\n\x9a\xab\xfe\x83pR\xe8\x1d\xa92\xd6\x1fJ\x16h\xa4Z\xafrԈs\xb2W\xd9\t\xeb\xf7͖\xe5\xa4m8e\x9d2\x1b\xfe(\xb2.ꌪ\x8f\x1eh\x84\xac\xab\xd5ݎ\xa5\xa8\xa0\\\x93\n\x91\x02P\x01\x8am\x88@>AZU0\x91\x85\x9d\xcb\x13+\xfc\xb0V\x16\xf2\x8a\xf8\xb8\xcer\xffA\xf07\xb8\x8c\"\xafFr\x8e\xf6\x05\xb1\xd0\xe4\x9a\xee\x14\x15\xca\xf9\\\xe1\x13\xa4\xc1\x14\x13Sa\xa7u\x957ٔW\xab\x1b\f\xbb7\x9c\x83\x9bO\xc8\xdb\xe7|cPFX\x14\xeb\x91\x1cx\xc5\xd0\aN\xe9O\f\xd2A\xbf\xb1\xd1\xfdcR\xfd\x99\xcc\t\x86\r\x82+-\x8b\xaaU,^3\xbaQ\xcf\xd4\x1b\xe0\xdb\xfa\x98Gl\U000fcad3tX\xbb\xb4+\xa1\x1cm\xf0\xbc.\x11{Z\x9bm\a\xcf\xda\xc6P\xaa;M\vASf}C\x9d\xa8\xb5\xd6T/\xeb\x94)V\x7fHC\ue9a7t\x1d\x12T\xf9\x907\xea\x7f\f\xab.T\x1e>ov:f\x83>\xa8\xb27;\xdd?\xc2C5d\xcd\n\xafV\x03\xdbnW`\x0fod\x97\xe0E\x17\x92\x87gqe`\xaa*\xf0\xa2(<\x8d۳\xa4\xa1?\xdeT\xab\x13\x9e\xa4瓢b\xa7Fw\xd4J\xee̯0|\x8fo\x8e\xa7l\xc3{\xc3z\x85o vo}Hc\x0e}f\xfexs|<\xe1\xfexZ\xf5\x8e\x1b\xf0>\xad\x1e\x1f{\x13\x1e\xa7gf\xbf\x92\xc1\x82\xfcs\xe4\uf419\xdd`\x00Ҭ\x92d`\x8d\fSD\x7fIvDAZ\xf1t\x13\x1c\x9dF~ ´\xab}\xe2\xbe\xf8\x95\b\x94\x13An?EĀ\r\xfd\xb1\x9f\ued89m\xfb\xd9%\x9c$\\\xbf\xd1\xe7mݠ\x18J}\xc7L7\x0e\x15\x944\xb5NB\xeeC,\xc5,;\xc2O\xa3\xceB\x13\r\xd7q燬N\x11\x81\x7f \x7fc!\x1d5\xbaN\x9f\x85\xfc3\tY\x1f0S\xc3iR\xca\x10g\x87\xd4\xe3\xf5\x8cl\xfd@\x1au\xc8\xf1g\a3\xfe\xd5\xf9\x89\x85f\x9b\xfcg\x122\x97\xeeސ\x88yԈ\xa3\x99hu*\x8fG\x10\a<\xe0\x1c\"\x7fA\xa5\xdes\xfe\x8e4;]\xd69\xce\f=S\xb6\xf9\x11\xe6\xc8\xc0\x19\xbf\xe0\\\xc4\xfc%\x89\xb4\x8aRdjm\xc5Ul䏲\xda\xd6q\xea\x93\xe1(F)\x98\xdaK\x0f@\x03\xf4\xeb\x03I\xf2+\x9e\n\xf5\"\xe4%\x97\xb5,\x112\x8e\xd0\x154W\xed\x8eI~\xdd\xf7\xee\xb5\xf8\xac.\xd2\x1f\xd4\xf5\xfaCA\xd5H\af\xd7ŷ[|,2\xfc2F-~\x96'\xf3\x8e\x95\\'\xf7+\x87;\x14\x96җ\xfa\xed6Mҷ\xfe\x1dK7\xc3~\x05G\xd2\xc2=k\x18T\xa62\x9ag_sJU\x9d,Q\x89ZMZ%)\x1d\x84m70\xee\xe9Z\x10\xa1\xe8\xf1\xed6=\x9e\x8e\x0f\xa8\x94f\xed`\x13\xb2*T\xa7уD/\xc7zx,\xe4B^C\\.$\xc7\xc7\xc3\xcfɒ\xc5\\\xa8\x1b\x88\xcfEzܲ\rO\x80B2\xb5\xfe \\؈l\xaa\xdc:9\xb1\x98(\xbf\xccá]\xe5Q\x95\x04 R\xad\x06\x8eeѪ\xf5o\x16e\x9b*\x0f\x05\t%PV\x14Ń\x12ժK\xa9\x93\u038dJ>9\x91e\xc4\x1e0\x8c<\xd0\x17լ\x1e \x1e\xab\x1f\x89\a\x84'u,KW\x1f\xabzFV5N\xdb\xf4U\xe2שׁO\xd9\x06f7\xf9>\x12\xf3\x03\xae\x1c\x95\x88J\x8bĴ\x80*)\x11\x7f\x19J\xde`\b\x1fy\v/8\x00\x19j\xb5t\x13a\x84\x06\xe5֥\x1f^Z\x9cː\xfd\x86\xca\xd8KADz/\xab\x85Xsf\xcc 3Z0>-\x86\xd6\xd7m\xa1tuMJMT-\xe7\xe4Ī*\aE\xf2vWՇS\x82k\x96\xc0\x9a9\xaaJ\xa6\x837Mò\xada\f\b\xc0\x05't?\xbb։\x97\xd3\t\x124\xc8\xccf\xca\xfeT#\x89C\xf5k\x98\xc6\xda\xf73\xa7K\xb6ªUP\x80\f\x8d\x1c\xc2Cp\xec\xe5\xea\xd7a\x99\xa5<\x1c\xe8\xa9T\x1f\x14\xd4\bry\x11\xfd%\xa0\x1fࣛw\x98&U\xf7\xa1ad\xc8&.\xcb\xfc\x1f\x1e\x86!t|t\x18\x19\xbbso\x18y\x92:\a\xb9\x88BL\xb85\xb0\x03FH\xb6,G='\xa3\x106\x0e\xba\\\xc2ծ&r\xb7\x15\t\xf6\x03\xf8\xde\xdc~\xec\\\x01\n\x14.\x02J\xa1{e]\xc2>\xa4\xad\xef\x01\xcfSzp\xaaz\xf0\xa1TU\xf6\xc1\t\xb9ԍ\x7f(F\xa1~\xd2\xe2n\xb7\x92쑒gmڢ\x05\x8aN\xae\x97W\x82\xaan\xba\xc9\xf2\x81\x89\x02l\x92\vr\xb3\x87b\xe1,rD\x1e\xc5\n\x8db\x15\x00\x17\x9b8<\x95$m55\xde\xd1]~\xad\xbb,U\xda\x0etX2\xfcT\x8f\xd1N\xa7*tG\x8a\x05\x05\xbdߟ\xb0L\xc0\xeeH\xf6\x87\xbc\x15\xa2\xc7\xf8\xcf\xf2\x02ƌ\x8c\x96\x95\xef\xe5\aA){\x04\xe7\xe74\x1b\x0faJ\xb3\xb4\xf4N/\x01ۍ\x0f\r]\x9f\xc8\xc6\xe8\x7fg\x8c>_\x12\xc7^\xc9\xc6N\xac\xdf=0V\xf3\x12\x9e\x1b\xeeG\xb8\xa5\xe7\xb3H\xb5\xff\x036&r\xd2\xf6Gh(\x1eK&\xbf2\x15yYf\xbe'o%\xec\r*o=\xd1Ai\xc0/H\u0094v\t\x06\xa0\x88\xa4\xda;Ij\xcbH\xccy`\xf6\x81%\xea\xf8\x94\x014\xe4!M\x949;\x1eY\xf2\x83>\xc0\xf0Sz8\xe0'\xbdGe)\x8dpe)\xf5\x86\x9f\xa4\xcaGM\xe1\xac4)\xabHc\"Y\x11n\xe7\x9a\xc2/\xaan\xb9\xbdt\xdd\xf2\r?\xc9)\x96\x1f$\xd0\x19\xc9Z\x19\xa7V\x80H\xd9\x057V\xe5\x00l\b\xec\xbc7\x82\xbf4tgطE\x9d,\tv\a\xfc\x1c\x9e\xe3\x9aѱ\x98\x10\xe5\xc4!\xb1\xed\x12-d\x9d/\x99Hu\xb1\x82\xbb\xac\xbcF:\xf2I\\\bM\xfeF\xb0{XF\xe7[A\xact!-f)\xb2͢L.(fH\x97ԢL/\xa8\xfe\xa0\x97T\x96M\xdf(\xd3˫3\xea\x05\x96\x19\xd37\xca\xf4b\xa75\xaawU\xa3~\xa3\f\xd6Yg\xfa\x1eUP-u\xf2\xa8\x8ffs\x1a\f\xb2L\xba98,tS\xa8\xb7j\xa9#\x04\xfb\xa2po\xda\x17\xf9\xae\xfb\xa2\xde(\x930\xa1\xb3Ix\x90\x99Գ\u03a2\xd0V.\xa3L\x83N\x03\x8e\xd1\x1dvcU\x03>Q\xb4\x1fx\x83\x96\b\xd2u\x139\b%\x8f\xdb!\xbcy̢\xe1\x895\xbd\x908\xe5\x95\xe0/T4\x1bis\t\x8aѿBb$\xaeë,q\xf8J@p\xb4\x97\x82Y\x85\xec\x16\xf3\xc9+\xc1^P\xca~M\xf3\x14JC\x9e_1\xcf\xee\x06\xbf\x7fFU\xed\xb0L=\xdeS\x1e\xc4\\iV\xe1\xbc\x14\xe8\x14\xa0?hg\x91@\xdb\xfdv\xbf\xc4\xfb\x02\x04\xa2m\xd1a\xa6N\x1f\xecX\xbf\xd5\xea\xb6J\xf26\xdb\xf5V\xbb\x90\xb7\xdb\x1c\xd4\xcb\xeam4{\x8dz!o{\xd0l\x94\x85\"\x1e\fZ\xcdb\xbd\xe0ַ\xac\xdeAgP\x1f\x14\xf2\xf6ڽ\xd28\xf1\x8dv\xbbY\x1c[\xa7\xd7\xeb\x97ED\xed\x83\x0f\xfb\xbd>4\xdbe\xf3\xd0h\xf5[\xa0\xd3J\xc0\x1f\ae\xf9\xbe4[ݲ\xbe\f\x1a\xf5V\xa3P\x7f\xb3\xdf\x18\x94\xcdG\xa3\xdblw\x8bkR\xef\x96\xc5\xdd\xedt\xeb\xed\xfd!\xb6\xdbŀ\xb2-\xc3\xfd\x83t\xae\x10fV\bn\xeaP\x19\xa3\xaf\u008aR\x16K\xb3|\x03\x89\xfb\xfc\xfe\xe5\xfb\xb7\x9f\xc2+\x11\xbc\xf1b\xf4\xa4\xf6.\x9c\t\xf5\xbc3:\xb1\x7f\f\xa76GC#&H\xbcݺ$ư\xf4\t\xbc\xea<\xdbmH|\x16 \xad\xe19\xc0\xfai\x0eڝN\xe9J`0\xe1<D\x0ez\xa5\xab֯\xb7\xf7f\xb5\xd1\x18\x94\xd6\xdbl\xf7\x8b\xd5\x0e\xba\xf5ni(\xddF\xbbW\xecA\xa3\xd3\xeb\x95dm5\x9b\xadb\x0f\xda\xddfo\xf0\xdb\x17\v\xb7Կ~\xb1\x94a݃\x8b\xa5\xf2\xec/V\xab\xd5땢\x9a~\xa7S\x9c\xaaƠ\xd5+[\xacV\xbb\xd3+\xae@\xaf\xd5o\xfc\a\xa6\n1ʿ~\xaa\xc0\xa2\xef\xc1y\x82\f\xfb\x93\xd4hԛe\x01\xc5;\xbdNw\x0f\xc76z\x83\xb2\t\xed\xf7\x9b\xfd\xe2\x84v\x9a\x9df\x19\xecu\xfb\xf5f\xa7\x90\xb7\xd5\xedw\xca\xf2v\x06ͽ\x85j\xf6ۥ\xf5\x0e\x1a\xf5\xbd\x1d\xd8o\xd6K\x17\xb5;\xe8\u058b8\xb0\xddi\xf6\xcbp`\xb3\xdf\x1c\f\xf6Κ~\xa7[Zo\xabS\x1c[\xb3Uz\xdc5\xfa\xadV\xb1\xdaV\xa7Q\n\xaf\xbdF\xb7Q\xecn\xaf\xdb\xea6J\xf2v\xc1Y{1o\xa3\xdf\xe8\xfcvx\xc5S\xed_\x0f\xaf*\xd0\xf7C\x10+\xb3\xec\xc3l\x7f\xd0*E\xac\u0cfe\xb8\xaf;\xfd\xf2\xe3\xbbQo\xf4\x0f\x1c\x9d\xddV\xab_V\x7f\xbb3\xd8\x03\x85F\xbb\xdd+[\x87V\xa7\xd9\xd9#%z\xad\xd2c\xb6\u05eb7\vYۍA\xa3^օ~\xa3W\x04\x85~\xb7Y/\xf5gժ\xf7\xf7\xc0fP\xbe{\x9a\xfdn\t\x8c\x97\xee\xb3f\xaf[\xcc;\xe8\xb4\xcaN\xa4V\xbd=\xe8\xef\x9d^\x9dz\xaf\xf4\x00\x1d\xecU\xdbl\xd5;\x83\xd2Ih\x0f\x8as\xdb\xe8v{\xf5\xd2>t\x8b;\xb2\xdd\xeb\r\xca\xce\xdaA\xbf\xdd*b\x90f\xaf\xdd-\xab\xb6\xd3\x1ct\xba\a\xc0\xa7\xd9\x1b\xf4\v\xc7\x03x\x1c\xcb\xf6[\xa7]\xef\xe4\xe7\xc5\xf0\xb1\xb5c\x83f\xa7]\x8a\r{\xed^\xeb@\x9b\xedn\xa7\x14\x06\x9a\xedf\xb3w\x88Bl\r\x9ae\xe0\b\x01j\x0eQ\x95\x9d^\xb7\xdd('>:{\xd4p\xb3^\ní&\u0604\x1e\x18G\xaf\x9b+\xa3-H\x1f\x9a\xbb\x82\xc1(\xe0\xbbv\xc1\x85)Z\xdeR\x06k<\x00g\xa3O\xacv\xc7Z\x8dA\xbdcr\x06*9\x1fꦓ|ɏ\xdes\xd5\xe5\x05G\x82\x05i`\vx\xb5\xed̽\x97\x17\x1c\xc9/,\xe4\xd6+\xcf\x17\x1f\xd1\b[\xe7\xfb\x16\xa4&\xf2\xd9\xe8DA\x84\x02\x99X\xa5^\x94\x9a\x80\xdfg\x02\xc8\xdcz\x15F\xab\x97n\xe2\xca\xcex\xdcBL\xfe\xedf>W-\x01K£0\x82\x98\x8f\xad\xb14\x84<z\x1d$}\xcc9\xb1X\x9a\xf8\xd9;\x98\xfa\xc2wWk1\xdb\xfb\xf8:H\x1a\xdd\xd2\"%ɯ\x83\xa4\xd5,\xcd\\\x92\xfc\xca\x0f݃\xe9ݶJ\x9f0\x9f\x1b#\xaey\xf1_<q\xb3ݖE\b\xb4\xedX\xc7\xf6\"Ũ\"\xa9E\xb0\x8c\xd2.(}~\xdc\xd8\ru5G\x1b\xe5\x03@\xbb^\xaa\xa4\x86\xa4Ȝ\x94e\xa1\x18;\x19\xff\xdd=\xfe\xb5~<\xf8r\xfc\xbbo~o\xff\xe1\x8f\xd5\xda\xdf/\xfe\xd3\xf6\xdf''^\x1a\xaaY\x8a\xe30\x9c\xa46t&\xa965X1\xbb\xd3DD\xe0]H\xda\xed\x1f\xcd=\xe1ώ$k$3\b4,\x8c\tݥ}\x9df\x83~\xbc\xbb\"+\xb7Ύ\xf0\xfb@\xdc\xeeI\vP^\v\xe6˩\xefj\x19\xdf3\xf3\x19\xae\x1cu$\x10\xe9S\x031X\x9f\x8e\xe5>H\x9dqMJ4\x8d\x92\x1d\x18\xc0\xa6\x9d\x99\xa7L\xf9\x95\xbb\x06\xf3?qdD\x93\x9e\x8f\xd2\xfbCѫ\x18\x96\x91\xfeu\xa4\xfb~\xc92\xa6\x8e$q\xbc\x18\xff\x82\xab\xad\xd2*\xf2\x15\xa0`[\x80c\x81\xb4\x1aa\xdb\nxJ\x9c\x94\x13AK*\xdd\xef\xd58\xc9j\xccƼT@&]K|\x8e\xc5L+6(\xe7\xa8ʳ))@Ω\x1f\twvw\x04\xbf\x16\xa5ì<\xea\xfe\xeb\xeag\xc6f\x80\x1aT\xa5fGe\xc0\xdb0\xf0Cwf\xaeQB\xa0\xedx\xe3\x83\xc9(d@W\xab\xb9U$BE\v\x86H1Y\xa3\xab\f\xa8\xa0\xcd\f\r\xb2\x88\xcf\f'\xfbI\rz\x7f\x1a\x1b\x9b\x19\x8d\xa1\xb3\x9a\x16\xe9\xecH;\xfbt\x1b\xe0+\xa9\xd3a\xd6L\x86\xc9`2\xef\x12\xf1F\xea\xead\xad\xc5\"!Ŝ\x94\xb2\xa4v\x89mg\xcd^\x17\x04!\xd9\xd46\xa4\xe8\xe1\xc2\v\xbc\xe4\xdbpvWb\xbb+3@\x91ׁ\x97p\xc1Ĩ\xc4y[\x96듸M\xb8pB\xdb\x06\xc4o\xa0'/\xfe\xa0\x9f\xdf\xcfS?qX\x06rrḶ\xadς\xa7\x95ӹ\xb9p\"\xdb.\x9cmO\xabBv7ŝ\x84:\x9em\x83\x95:IЭ<T\x0f\xf8\xf8`m\tHz\xb2\xfa\x8c\xc5\xe7\vX9|\xa4,ˁӨ\x0fF2.-:\xa1\xd8\x0fb$=0\x9c\xed\xd6τme\xbd\xa0N\xf6I\x0e\x98?zz\x14\x8bh1\x95rÂޤ\xf7ܿ\x90C\xa0\xa1K\xc5{\xa5\x98\x95\x88\xdb\xe4d\xedC\xf8\xf9\x82\x1f\x18'\x0f\"\xb6\x9d\x7f\xafA\x05\x8f\xd6_R\x86~\x1d\xb8\xd8\xf6cm|\xbd\x8b\x1b\xbac\xa1\n\xf8R\x03\xe5\x93\xfdx\xc3K\xe5\x02\n\xbd\xea\x99\xfav\xf9\x01\xed\xe3Wt\x18]̕/h\xc0ǡ\xf2\x8fCh\xbeJ\xbd\x17\r\xca@\xe1\xf6i\xb8\x81\x93?L\x10\xbb\x1f\xe9\x8cGP\xeaȍ\x8f`\xf8\x19M\xf0\x94~\x00@N`\n1\xc95\x80\xfd\x80\xe8\xb7\xd8\xfb\x91\x9a\xdc\xed\xf6\xf0\xb4\x99s\xe4\xa4\xebDTLؕ>\xfcj\x00\xbe|?\x18(\xba?4\xd6P\xeb\xbc\x1e\x05\x87\xd7P\xf0|:;p\xe20}\xd4\xc0D\xe0\x19\xf3\xd5\xcb{\xc0_H\xf18a\x11\xa6\xc9\xd74\"e\x99\x0f;\xd0FTTYm\x1e\x85\xab\x17K7z\x11\xce\x04z\xb63\xa2\x81\x83\x13\x1bbYtw\xa0\xbf\xff2\xb0\x82u9\fVy`\xa2;\xe6\xea\xdd8ׇ\xca\x01X\x82z5\x14ܤP\xf0s\x1c\x06O)\x01r:)\xeaW\u0edb\x1b\x98G\x92W\x05!\xb0\xe0@\xba\xb3\x84O\x81\xd8\xc8\x02x\x03Y9\x16\x93\xa1\xf1̣QT\xb5\xd8\x11\xe8\x91%;fV-\x9d(\xe6\x0ex\x99t\x94\x96\x87f&\xf9R\v\x91\x94\x87*\x97]B\xb4\x88\xc1\rFF/\x1c\xa9\xaf`ֳtc~(\xfa\xfa\xca]\xc3w\x83\x10%\x1b\xe9|Ҭ!\x16\xc5h.\xf9~\xe3\xec\xe4\x8b(2\xb6PLC;^Nt\x1d\xf4PO\"\b\x8e'\xcfĄ\xa5-F\x13\x16i\x7fvf\x8b\xc0\xc4\xde\xc7\xe4Y\x9c3\r`E\xeaZ\x12\xad\u0d49\xc8\xd0\xf5k\"\nUKn\xe3o\xa9<\xad:9P\xb5\xf6\xed\xfb\x1f\xeb\xf88b\xc9\xc4h\x01\xaeMF+%\x17\xa8\xfd.H\xf0\xbe\xe3c\xeb\xe5ٛ\xb3O\xe0\xc2\xf9\xbb\xb3O\x16\xb3\xbe?;}i1\xeb\xfd\x87O\xaf߿;\xb7\x98\xf5\xe1\xfd9\xa4\x7f\xf8\xfcɚdw\xdcKÕ\r\vX\xc8I\x02~E\xeew4uu\x97\xbb\x84]\x16/+\xa5\xd7\xda\xfc\xe5d\x98y\x18\x86_\t\x14\xd3L\r\x97\v\xf3\x8d%\x9anКX\xea\x15\xf1\xea\xdc𫧶\xd3J$\xcbpƅzP\x89\xe1L@R8Ӻ\xd0\xde\"p\xa1\a\xf2\x81\x85RŇs\x91\x11\x99\xe0n\xc9|g\xb9K\x95\xb2nJ\a\x93^\xa8S\xfck\x8e)1ߴ\x9e\xb8\x99b\xc5\xeeJ\x1cK\x85\x10\x8bU\xd2Q\xe7i\xa7\xf29H\x0e\xcc\x01\x89x\xa2\x9eU\x93\xfaEBE\xc0\xa3Z\x12~^\xaf5\x03\x81ݥܑ\x00\xb8\x1f\xa3\xc0Ѥ7\xce`\x82\x7ft]\xf8\b\xb3\x96\x9b\xd2D=\xa8\\\xf2E\xe6\x88\xc4\\D\x91\x88\xa4\x05\x0e\xc1Np\xce\xf3=CHͧR\xdb\x0e\xcb!\v\xee\\2\xba\x9a\xef\x877b\x06~\x99\x8f\xbe;\xfbt\x14FGP\xd1Q$\xbd\xf9\xc5\x1a\xecқ\x1a\t\x8d\x1b\xeaMᆪN0\x83\xcd\x12y+B\xa5\xaf7b\xd9\x16-\xe7\x1bxs]Qą\xce\xcd-\xe9r\\\xf1M\xb4;9\"\xddң\xc7\xf7\x90\xebs\x9d[e\x19\x86\x89f\x1c\xec{\xda'\x01e%\xa9!F(\xcb\xf1S\xce\x14\xda\a \xe2\xf7)\xf9\x05N\x87\xac\x99\x98\xbb\x1b?Q\xd4~\x9c\xb8\xc9&\xe6\x19WG\xa5\x8c\x9a\xf5\xba\xa3_d\xd6\xf0\x8a\x1bE\x9e\xf3f\xbdn\xdbFʳV\xbdnV*\xefB\xd930(\x93Qb|u\xac\xf7?\xe4\xefJ{`\x9e\xb9PO\xe0\x17l\"\n\x17q\xc0\xa0\xe6\xe5yꇁ\xe0\xe5nMU\xcc\x1d\xe9/3\x7f\xcf\x04\xfd\x96kyz\x19\xb5Q\x9dvf\xa6\x9d=\xb1\xb93\x92o\x03\xe3\xa0'\x9b\xd81f\x89\x19\xd3Q\x98\xbcԏ\xa7\x9a\x14c\x9e(\xdbDJ\xa9v\x13\xf9\xd0\xf7\xb3\xda\x1e\x97F\x9eL\xb2#\xb8\x0fu\xf3u\xb3Q\xcbڙ\\F\x84\x11\xac\xcabB\xaaA\xde\xf2q\xab\xde`\xadz\x93\xb5\xea-֪\xf7X\xabޟ\f\xcfj\x91\x98y\x91\x98\x96\x04\x8f;np\xceoS\x1c\x93\x98lЏn\xb0(\xf2Ae\x87\x8e\x00\xb83J\xb4\xa4\xeb٤܃%5\xb4\xe9\xc8\xf0>I\xed\xe5\xfb\xb7g\xb7S!\x83\x01\x8b\xdc+FY\x80\xea\xf2\xb92N{\xa1t\x19\xf9$\xe2\xd8]\b\xed,\x1f\x95\x1e\x13\x1d\x89\x17\a\xa3\xed\x8b\xe2ĝ^\xf1H\xfe-v,\x03\x1d\xcdX\x90\xaa\\\x04\xeb0\xa1\xecP\xb9\x9c\xbb\xd3\xc2xR\fpA\x04\x10\x1c\x0f2\x00\x03\xe6J \xf1\xb8\xdc\x1cP\x04%\b\n\x97۶~\xaa\xb9\x97a\x94d,J\x97\xecO&\xb1Ne&\x8b\xc9'\x1c\x11\xb0(\xa5<\x02J\xfc\xf4\xf6\xcd\xf7I\xb2V\x9eW3j\xc4'\xf4>\x96\x8d\x10\xba\x8bK\x98\x92\xe9]\x91kP(\xdbA\xa5ۇ\b\x8eL\x98S\xdf\xff(\xe2u\x18\xc4B\xc5CA\xbf5\x96\xba;Ι0\x10r4\xfa\x12\x8c\xbf$G\x13\x8d\x99\x15\x92\x97_N\x0e\x1c\v\x85\x03\xc1)\x1c\b\xf2p\x91w\\\x15\xa3X\x1f\a\x8e\x95~N\x8f\x80\x80\x85\n\xb5C8[ĂV\xa4F\x00\xca{^p\x14\x8f⚑\xe4Dy\xae\xd3O\xc7j\xaa\x8f!\xbf\\\x890\xab\xa4X\x83\x93=\xc2\f\x0e\x03\"\xb7`\xc8\"\f\x1bP\xc6\vv\x8b\f\xeaw\"\xb9\t\xa3+} \x1f\xcd]\xcf\x173KW\x90H\xf1\xe8\x7f\xa4\n\x84\x93\xfd\n\x9e\b\x8eX\xc7Z\x04\xc4ӄ\xa3\x87\xd4)\xc4\U000f0531\x18\x90%\x9eI\xb6\x8d\xe2\x1aē0l\xc8\xd0eK\xb8\U00092f7c\xb6MJr7(K\xe7\x1d\x86\x8as/\x19\xa8Ƭ#\xfa\x95l\x1e\xe6\xa5ky@\xfa\x81\f6\xb5\xc0\x12\xa2\x95\x10\x842\xbdqm\x9bd{x6;\xbb\x16A\x02z\x1d\"\x10\x11\xb1p\"-\xe6S\x9cV$\xdda\xff\x88\xe9\x12P\xb49\xc3m\xd4\xe3\xc0\x1c\xe8\xc0\xd7@\r\x91X\x85\xd7\xe2P\xcd\xe09\xb4\x16\x034\xa74\x86\x97\x9d\x88\xd2Ӌ\x91\x00\x9d\xdf]\xd4֡\x7f7\xf7|\x1f<(\x89\x1a:\xa1\xden\x89z\xe2\x17L\xd4\xd4\x0e\xe6\xb0k\xd5\x14\xf0K|\x963\xc9\xcf\x00\x83f\xb9\x12#Wb\xe4bIZ\xa9\xc2\xc7\xf9ȷ$a\xd6Ņ\x88߆\xb3\x8d/\xd20\xf9\xa0\xbf\xbb#\xe0\x7f\x9aX\x9b@\x16\x99e\x92\xb7X\xf8\xf3\x11\xfc8\xea\xea\xdbov\a\xe5a[\x87\x01ϼP\xd6\x16\xa3\xa8\xb6\xc0B\xac\\\xeb\x06/a/\xce\xcf\xe1\xae\xfd\xe2\xfc\xbc&⩻6\xe4#Y\x9a\x92\x90\x14\n\xd7\xf9\x9e\xd1B9\xc1\xfd\x9f\xb2\x9a\xfe\x13nD/\x12\xf1\x91\x1b\x1c\xe9\xe25#\xa6\x1b\xe0\xe5\xf4Z\x84(OV\xcdB~\xdc`.\xb7,\xe6\xf1\xa86U\x9c\xae\xd3\x04$8\xd5j\xf8,\x18\x82g]\x92\xe4?\x86\x94\x8e\xdc*O\x9e\xf3\x86m'\xcfx\xab\xb1\xdd6\x9a=\xf4f\n&\xf6\xb6\x9d<\xe7\xed>~\xeb\xf4\xb6\xdb\xc6^\x9am\xb7;\x9c{#p\xd5QM2\xa1E\xa3K\xab\xe0\xe9V\xd6\xd2@W\xbd\x903\xd9n+\x04\xdak\xf6\xb7[\x950\x90\x7f\xf2M%\xcfy\xb7\x83o\x83:\xbe\rz\xf8\xd6h6\xa9l-R\xae\xa9IH\x1d\xf3٭r\xeb\xff\xf3\xff\xfa\x7f\xa6\xb6\xaa\xeenh\xae\x1a\xc2\xf7\x8b\xf3s\xa4\xd7\xcdu\xe4\tKv$\x80\b\x86\xbd\xee\xa0\x7f\xc8\xefx\xa6T\xd3\xeb\xb6iM\xf2\b\xb3\x1364yR\xe6\xc5>\xd8ns\xef/\xddD\x14\x92d4\xb0\x8c\xb8p5 \xe6\xeaI\xefU5\xb8\xa2MG\xea/\xd1\xe6Y\xd2\x1d~\x90\xbdgß\x86\xeb;d\xd6\ue2b5Bo\xa8ie\n\xa4\x122\xdb@͆H\x1ehIg\xcd22E\x92g\x05\xce\xe8\xe7@\xa4q\x83\xbcd\x83\xf4\xa4e\\\x17\xbd캘qxʥ\xd1\x11\xbd\xdfw<m\xdb\xcadU\x8c\xf6$\xd2`\xce\xc8=4>L_af\x1d|\xf2\xc1\x8b\xb2~\x11\x85\xfb]lڼX\x17\x17H\x1b^\\ȠU\x12\x9b8 v\xdeA\xd7}^\x82Ip\xfd\x8a\x88\xe0Yc\xbb\xd5cHQY.\x80\xbfVH\x19\x96\x95n\xa6ԡQd\x98\x11nf2\v\x8aZ\x88R\xb2+o[iN\x88J\xa2W\xb2d\xd6\x03z\xbf\xd7\xdf@\xf3w\xc0\x91F~\xd2\x03\xba\xdd*$\x0f\xecG\x12\x94Qq\x9b\x8c\xe5\xcac\x12\xb1\re@@\x92\x80m(EO\xdcj\x82\xf7Z\x16i\xcb\x02\xf3\x90\b\x9c|\x88\x12e\x84\xec+\xac\xbf\x02\x80,\xd1\xc5Ľ\xfa\x93\xb4\xfe\xa48\xb2\xc4,\xae@\x87:fR\xc2T\x1cq\nr\xf6\x1d\x1b\f\x06]G\x94!\x91\xe4\xc1\xf8\x96iR\xa5\x02zM{\x10\xbf\x93\xc1p\xf7\x15E\x1f\x15\xa3\xa6\xde\xcae\x9dj\xdbN\xb4\x13k\x9d\f\x18@'\x96tTԾ\xf9F\xf6\x05\xd6\nz\x83\xff\xd4E\xcd\xd2%\xb2\x1eKf\xabm˿\x00\x10\xa3\xec\x91X\x91p\xa7IM\xf8\x02\xe0ѢN\xb7ި\xb72\xb4\x9a\xb3>\xab4\xc08]\xb2\x04l;\xa9y\xf1[\x11-\x04\x18\xfc\xa4\xf1\xddF>!\x11\x17,\xbf~\x11\x1d\x8d'\x0eb~\x96PG\x9e݆^B\xa8I\x06\x03e\x06S7!\t\x05.;)\v\xa5.\x91RN?\xc35>\x9b\x1bAP]_I=\xa5\n0r\x8a\xe2\xd1C\x1f\xa1ڹ\xe7'\"\xca\xf3\xd8\xd31\xacU\xfe\xd7\xf1Yj\x19%Y\xef\xcex\xb2C\xc1\x86\x89\x89Y\x92ӘK0 [\x99\xb2\\\x1eO\xa6\x8e\xb4\xf9\xfd.\x13\xae\x95\xad\r\x04\x9d=\xa4\xd3\x13\x8e\x93\t\x0f\b`U9\xab\xcc%IY^\x97ޗ\x87\x1f\x92#\xb0\xed\x8a\xd6G\xcb\xcbO\xd46\xc0,\xd9^ٛ\x9f,\x1b\xddQ\"Ѝ5\xf1\xf0\xc1\xb6ˆ\x95\x8c\xdd\t\x1d\x85cw\xb2ϥ\xa9$\xb5\xe9&N\xc2\x15\x16\xd2\xe8\xdbג3\xf3\xa3\xb1A\xf7\xf7P4\x8a\x1c\x7fG\\\x16Q\x02A\x12\x184\xca\"\xea`\xb3\x01Q\xaf8m\xe1θ\xf5\v\x16\x01\x13\x82\xb8ܕ\xb2\a\x94Pc\x83\xdc5^\xb6ې\xb9\xfb\x83\xe3%i\xdbm\xc2\\\xb9\a?\a\xbe\x88\xe3\xf7\xc9RD7^,н\xc4\xdc\x133\x1e\f%ۣ\xb8\t5xx\x9c\xf3=\xa4\xed\x8d\xcc\x1e\xa9\xae;\xb1~\b\b\xfc\xd9\xf9@\xf5\x94\xcct\xb1\xba})\xed܋\xe2$=;\x8f\xe2%Jm/\x85\xa4\xb9#\xf7\xce\xd4\x06\x8c\xc4l3\x15E\x02D/ \xbca\xe4\x1f\x86\x11r`\xb0\x1b\xee\x1bZ\xb1\x1b &\xc1\xf6\xc1\xdc\xef\xa5dB\xeexHWN\x98\xfb\xf8!\xacj\xc5\xf8P\xfc\x90ʶFe\xa2N}\x948劝\x0f4\x97g\x8eq\x9e\xa6W\xf4sv\x00\x8dtߜ\xb4A\x86\xe8'\x1de\x92\x9b\xd5\xf4\bӖ\xb9R\xebe\xbb\xcd/v\xbaD)Y\xc6\x13&vLV\x96U\x1eI\x1a̸=\xa6\xa3\xf9(\xe6>BrE=e\xa3\xcaQ`{_k`\xb5\x99\xcbR2W\x1f\xa2\xf0\xf6.\v\x13g`\xd4o\xc3\xd0\x17nP\x14\xa5\xbe\x9fK\xa4\xb3\xd7\x1cQ\x05\xd8x\x92\xb3\x82\xddQJ\x0f\xe81\xe7\x0eΐ\xb9\xfa\x1b^]\xe8h\xaf\t\xf3\xce\x1c\xb1\x94k6\x06Bh2\fQ\x9e\n\xec2\xff\x0e\x99T\b\xea.\xb0\xf3\x88\x8e\u05cdq\x19T\x16\xc1\xc2,$e`\xdb\tqY`\xf2\\\xdd\x1d\vT^h!\vBEw\xa57)\x17u\xca<\xfc\x8d\xf1wC\xe8\xfeEio\xf3g\x1b\xb5x\xd9ȃD\xa5\b\xe0\xf2:\xb1/\"\xd6Ή\xc5\xd8\xfa\xb7\x7fө\x96&إV\n*\x9b\xe4Z\x8cS\xfc\x94Wx6\"\xe7d=e\xa9Jţ\xa4\x9c\xd2\xd2샗7}j\xbcה\"\x8f\n\xfb\x14\xe3\x99\xe6R\x90\xbbN\x99\xf5\xd6]c\x81\xed\xd6:\x17\xb2\xec(7\x14\xc7:\xd5+\xa42\x9e\xfc\x9d\x8c\x9c\xcf\xde\xf65\r\x122r\xfa\xdbFw\xdbjR2r\x94V;\x955|\xa3\xa2\xbbGt$Ǧ4\x9bw\xf9\xe3)\xa1\xf7*\xa8\x0fr\x02һ+F\xf2I\xdfL?6,0\xf5\x80(\xb8\xb5A\x7f6\x01^\xe4\xc6\xd1$\v\ni(\x9c\xd3\xfb\xec<(Q\x13w\x93D\xac\xd6\xc9Q\x12\x1e\xc5kT\xe2\t\xc2\xe0\x18\xd7\xf9\xd2Ϯ\xbf\xb5/\xc1\xeb\xe0(\x8c@\x8b<\t\xe1\xec\xd0Y\x18\x16\xc0c\xe4H\x12\xd2\xf1\xd1j\x13'GK\xf7Z\x1c\xb9G{\x00E\xe8\x91\xe4\x8eBP%\x19\xaa\xbf\x94la\xebr\xb4\xc8\xe6:\u074b_E\xe1\xaf\"`K\x9eQ\x8bf\xce\x19/\xa5\"_\x8ax\x1ay\xeb$\x8c\xd8J\xe7\x98GB\xfc*\xd8\"k\xd3\xf5\xd9u^\x92\xc2\xeex\xd9\x0eR\xa8Ŷ\xd5\x03\xbb\xe4wr\xab\xb3\x1b~\x97A\xdf\xf0r\xbb%\x97{\xd1gR\xb4\x8eEP\x9d\x03\xc2\xdam\xb7dU\xaa\xf6\xb3\xa3l\xb1ݒš\x8f7\xdb-\xb9)\xf5a!Q#A\x02o(}ÿ#\xc5˲\xa2;!\"\xdb\xfeG\x8covQ\xf6\x01\x83\x9d\x9d\xf3wD\xedWs\v\x1b\x01\xccN\xcbr\xac\x00\x9bC\xd8\xfb\x92oi\xe0\xb4\x17e_\x95\x14\x10\x82㗵\x1cy+\b\x96\xff\x8e\xc8\x1b\x9f\xf9I\xc4\t\xc4\xcd\xffD\xd2=A3\"\xe4]\xd9\xfd\xd4T[\xda\xe3t\xe6\xf6f\xf4\xbc1\x8a\x8e\x1b\x0e\x06\xe6l@`N\f\xe8\x1a\x8cC\x88\t\x9f1+\xc2tǢ\xce\f\v\xa8\x81#>\x95\xf5\xc1\xd4\x13\xdc\xebBNM\x90\xe6|G\xa2b`\xd6p\x906|#i\x87\xac\xd9\x0f)X\x02\xce;gk\xf0\xf9 \xd0}\\\x86\x8c\x02\x9e\xa9\x1d\x1e\x1f\x0f\xf5\xd9\tZ\x86\xc32d\x1f\xca\x1c.\x8f \xb6\x91[A\x16)\x01\xe1-\xe8\x11`\xdf`\xaa\\\xba\x13\xe3p\x02\xd6\x06)<\xa7\x1d\xfbh8\xde\xe4\xd7$됼\xad\xd1K2e\x82\x81E\x04`}\xb8S\xa1y\x84\x1e\xa8q\xe5}\x9b\xe9\xa0\r5\x13m\xa8%l3y\x1cys\x12\xe1\xc1\x15\x01\xee\xd0g\xd5;\xf5z\x80\xfeQ\x91g\xcd\xdc2a\a\x9a\xc4\xe6\xf1h\xeeY\xc0\x0f\xa1/j7n\x14\x10k\xee\xfa\xfe\xa5;\xbd:\u0092\xa0\x8bb1\xe5\xc7r\x97\xa2wt!\xc4Wdl\xb9\x16\xb3\xdc\xcb\xcb\b\xfeL\xa30\xb8[\xc1\xd3l\x16\x898\x86\xa7H`\x8e(\xf1\xa6\xe8\xa9ލ\xbd\x19\xfe\xdd̼\xd0b\xd6%\xfc\x9by\xf8\x8b\xef\xde\x02~}/\xb8¿\xe1\xf4\xea\x97M\x98@\x11\x10\xa4\xc0\x1fh\xebr\x93$a`1k\xea\x06\xd7n\x8c\x0f(\x1b\x83'\x11$\x022M=,\x87\x92wfI\xdf3\xd3\xd0_D\xe1fm\xa5\x01J-f\xcd\xdc\xc4U\x7f|\x0f\xfd\xc5\xccf\xf0#\xa6\xa1\x8a\xd2ö́\x8f\xbf\x89\xeb\xf9\xd0\xdcl\x0eM\xcd<\xd7\x0f\x17\xf8\x80\x99\xbck\xf8ŜP\x8b\xe6\xad0K\xacЯ\xb5\xf0g\xb1H\xf0q\x91u\x18=\xf1@\x17\xe7!f\x9e\x87\xa1\x1c\x00h\xa1Z\xccZ6\xe0\xa7\t?-\xf8i\xc3O\a~\xba\xf0#ܙ\xfa\x83\x85\x96z|K|KV\xd0\x1d\x98`o\x05=\xf5\x82\xf5&\xc1\xbf0\x8c\xabK(뻗8<_,D\x80\t\x90\x7f\xe5z\x01\xfeY\xe3ot%\xff\xfc\xb2\x11\xd0ו\b6ꏗ\xe0\xf0VB\xf6:pa\x16\x82\x10\x17\n'=\\'\xbaS\xa1\x1et\xb8IdG u\xedM\x139\x05k\xf5\x1b.\x14\x04\xfdb1+\x82<\x11d\x8e6\x97\x00\x03\xf0!vW\x90\x1c\x8b\xa9\xaa1\x16p\xf8\xc1\xc3ҝ\x857\xf0\xb0r}h?\x0e7\xd1\x14\xea\x8d\xd7\xee\x14\xfb\x18\xaf],\x92Dޕ\x90\x0fa\xb0\xc0\x87;\x84\xd3xs\x89\xbf\xab\x95\x1ba\x8b\xd8\xfb\x04\b\x0e\xf8\xab`1\x81\xc9\x02\xfa\xc5w\x13mŠ@>\x81U\x84\xbfK\xfc\x91\x8b\x04\x12b\xf8\x13\xe1\x8f;\x85)M \x17L\xe5\x06\xbaz\xed·ko&`7\xdc\\\x02\xa5\xcb\xde\xe0V\x8b\xaf\xa1\x83\xb8\x9d\xfcd\xe1߭\x97\xc6\xe3L̍7\xb5\"n\xe0\xad\xdcDLC?\x8c\xb2\xd7U\xa8&L\xbd'\x91\x1b\xc4\nԦ^$\xf7\xe9\xd4\xf7\xd6\xe0\xe4\b\x81~\x8e\x10/\xe2)´\xef\xadc!ݴk0E\xb0\x85\xde\xe9n\xe1\xdf\b\xfb\xb4\xbc\x12Q\x80\xe0\xe7.\x04\xc2V\xa0\xff\xb8\xd1\"rg\x9e\xdc\"\x00aX\xddʍ\xaf$8\xb9jc\xaeTO\xb2?\x89\xac\x13\x84\xa9\x8bP?\xa9\x9a\xa1J\xd77j\x8e\x14X$\xe1\xda\\a\f\xc8\x06\x0f\xf2\xae,WO\xfdQ-%^\"\xd7[\x0e%Q`s\xed\t\x00\xafk\x1c\xd9\x04b\xac\xc2\xfa\xccŷ\xbe\xdc>s\xf1\x02\xa6\xfc\xad\x9bDޭzW:m\x9fp\xb2E\x94\xa5\xc6\x12K\xc1[p\rj\xe9F\xb1\x97\xde|\xbe\x89\xc5\x1bo\xb1L\xbc`\xa1\xd2b\xa4H\x00\xb3\xbcu\xd7:-qAH\xbdX\"\x02\x11\xaf\xfc0\x94=\x81\xcb\xe2i\xfa\xf4m\xfa\xf4]\xfa\xf4\x11\x9f\xbes7q\xec\xb9\xc1\xb7\xfeFv\xee\xb5Z\xaf\xb9@\xaeP\xf6\xf4N\xe2ӹx\x1bF\xebe臋;|}?\x9f+\xe4&>\x84\x9e\xd9\x1b`Om|7ʍ\xe3|\x1d\x1aY>\xc9@&s\xf1i\x13]n|\x11L\x05\xcc\xec+y\xc8H@\x95\xe8;\x8c\x8e\xd7Q\xa8\x02\x9fL7Q,\xf1\xb3\x17O\xddH\x0ey\x16\x85\xeb\x14\a\x00p\x1e\xcfݩ0\x9f\xd10\xc7MrI\xca}W\x96\x10G\xd3\xdc\xfb&\xf2\xf0=\x12\xde\"P\xd2\x01f-]\tE\xf8W\x81\xcdJ\xc4\xfa\x8f\t\xe0\"^\xaeUnx\x8e\xb0\x7f+/\x8e\xbd`q\xacw\x8e\xbc\x19\xc0\x03\xcee\x1c\xfa\xdeLo\xe0Mp\x15\x847\x00\x81\x9b\x18\xa7\xe7W\x9c\x9e\x95n5\x98\xfaa,\x91\xb3Ts\xb3Vs\x98\xc9\x19>E.\x8cg\xa5\x1bZ!\x8eG\xd4/f\x88\x91V\xab\x8d\x9fx\xb2\x03\xb0\xe3W\x88\xfeC\xfc\xb9\x96[s\xed\xcef\xb2\xba\xf5\xd2\r\x92\x10\xf1~$1\xddJ\r\b\x8b\"\xa6Ň_\x10q\xaf\xf4\xb6[I̺\x92\b\x15\xdeԓƭ+D\xaa+\xb5\x19W\xb2gp\xeb\xc9\x1e\xb07\x13\x88W,\x87\xafO\x80\x95\xeb{\x8b@\x1f5\xf2M\x1f\\~\x18,\xe4\xf9\xbc\x02H\xc1 \xee\xfa\xf9\x0e\x9f\xd2r\xa8݆\x0f\n\xa3\xacb9\xb4X\xac\xdc \xf1\xa61\"\xcf L\xdc\x14\x93\xea\x97\xe3[<oW\xebHd\x13\x19\x84\x01.\xd7/\xd8\xdf\xdf\xe1\xd0&\x10L\x19^\xdd\xe9T\xe0\x82\xa7\xc3\xc0~\xe3_L\xde$\xe1\xd4]{\x89v\xbd\x87\t\xe1j\xed\x8bD\xbf\xaa\xf3\xd3\v\xb2\x83\x14\x93}\x17)&wz\x05\x83C\xdct\xb9\xd0\xd0t\x89\xb7iE:\xc9BS\xe1\xfb\xb0\xc4r\x8f\xc2\x1b\xac\xa3z[\x8a镘\x19t\x95\xef\xe2\t=\xf5\x05\x1eZ\xba\xdei\xe8\xc7\xea\x8fD\x97@hE:Q>*2k\x1a\x86\xd1\fӣ0\x8e\xb5\xe28\x10bB\x1d\x95\xa8\x15,;\x90\xaa\xf7\xa6\xd4V\f 3\xcb\x1eK\xa6A}\x89\xe0ԃ\x9b\xdcݥ\\\xdbYx\x83\x8ax\xf0\x18\xb9\x8b\x85\x02>\x11L\x951\x1f\x12\x91W\xe2n\xe9I\x9aLc\x90(\xa5\xb5b|R\x18l\xe9\xcdf\"\xc0\x87\x05\xa2\x03u\xf8Eb\xee\xbb\xd8}o\xa6\xa9\xaf\x95D\xa0^\x90\x88\x05\x86\x1bc\x96\x17K2\xeb\xca\vr\x04\x99,\xaaf\v\xfa+\xa7\xc2\x0f\xf1(\xf3\xe5\x86so导\x11\xc1\xb3\x98yxn\"\x9b\x03\x1e$%\xe7\x05Y\x16\xd8\xeak\xb9\xe16R\x95L!\xc0 \f\xa6\xf2/\xe0P\xf9\x84\xac\x1a\x89\x81\x83\xf0&r%%'\x02EЭ6\xab\xfc\xb1\f\xe7\xd32\xf4%x\xc1\xac\xc7^\xa0\xf6\xd2:\x8c%հ\x8e\x84Z\x80\xf5\xe6RU\x0e\xc82\xd4\xfb\x10\x98Aa\xe0\xdfᣏ\xbf\xa8\xaa3\xc3\xc7k\xf9+\xa2X&\x848\x96(\xbc\x89\xd5\x1fEᭅ\xef#\xe8\xc2\xcb4\\\x8b\x94R\xc4r\xf1ҕIޯ\xfaOlЇ\xd1T-A\x9c\xb8\xd2\x05$\x1e\nq4U\xe89\x119\x9a\"\xa5\x17\x13\xf7\x12y\x039\"\xc2\rbM(J \xdb\x00FY#٧\xf6<\u07ba\x80\xf2\xf3f\xb8N\xb7+\x1f\t\xf5\xd8\x0f\x11g|\x93\xe2\x8c 9N\xa1ϝN7\xab\x8d\xaa\x1b\xf6o\xe2]\v\x8dI\x80P8\xbetc\xa1V\xc0\x8d\xa7\xf2@r\x93$\xf2.7\x89P+\x9f\xbe\xab\u07b9\xbfz\xab\r\xf6\x02J\xcfa\xfaE0\xbdS\xefP\xdb1\xaa\x7fB\x82\x90;\xf7\xd2\xc3\xcb\xd8\xe5]\x0e?xk\x83\xa2\xdc\x04^\xa2\x93\x8fՁ\x89\xcf\xd1\xc6\x17y4\x12FǰI\xa2u\xe8kL[\x92z,\tѸ\x8c<\xc0\xf7H\xc0\xa1\xa1\xb0\x18\xac\b\x8e\x01\xb7>\xbc!%?\x93\x94\x16\xf2\xca\xdc@!\x99\xf4j1C\x9aK\xe6\xbb\xf6\x14Łt\x92\x98-\x84\xda\xcf\xc2\x17\u05fa\x9b\x8a\x0e\xf4|_\xfd9\x0e\x01\x91&w\xfaU\r6\xa3\xa1\xf1A\xcf\xcd\x1cȷc=\x13\xf2ͨ@\x92$+\xcfO\xdf\x14\xf8\xa6\xcf\xc7\xee\xec\xe7M\x9c\x128q\x12\tIx\xa8W\t\xae\xf8r\xedF\x9e\x1b\xa4Yo4L͑\x04\x85\x16\x16p\xff\\45Y\xaf)%\x83\xc6\xd7T\x8e\xee\xbf~7\xaf\x16\x19\xaa\x94e$>\x04\"3\xb7@\bF^\x00\xad\xc1\x96\xbd\x82\xb6\xaf\xf0\r\xee\xbeWp\xf7\x05\xb2[f\xbe\x12wk 5c\xf9\f\xbaɁP/p\x80\xc4\x06\x0eE̗N\x8b/\x92DD\xc7\xd9\xf1\x06\x95\n\x7f\xa5io\xf9\n\xe3IQ\xa6\xaf\xa8\xd7t]0\xe0Yzs9\x96k\xae^V\x9e\U00062447|M'B\xbe\xea9\x93oz\xdf\xc3%H1(\xb2\f\xf1U\xf6|kޔ\x8a\xe8^\x02\xa4\xc4\xfa\x1a\xadoV\xe14q\xafqNBM\xaa\x03?Zq82\xf8\xd2tA\x18)\xcaU>h\xd8N\x8fi \xc3\xe6\xf2\fZ\xbb^\x90\x1c\xeb\x82\xc6u-\x9d=u:\x14ƤRM8QIi\x0e\xbd\xbe@Q\x89\xe8Z\xb8\xfez\xe9\x9a\xef\xf1ZL\x93\bz\x87\xa9\xde\n\xb1\x9f.\x0f\xfd\x89`\xb2\x10-\x03\\n0Y |G\x02!<\x12k\xe1&\xd3p\x13$\xe9\x9b\xdcߑЋ'\xfd\x86\xc0\x03\x90z\x88\xed\xa7\xae\xc4\xfa\";Jr\xb0\x1c\xab{\x8f\x81Ut\x92\xb8\x95\xf7AL\x82\x93.]<l/]\xa08\x99\xcdĵ\xa7\xe7>N\xe0Қx\xbe<\xa5\x92p\x9dB#\xbed\x8b\x18'Qx%\x8egn\xbc\x94\"\xf6\\\x92Q?\xa6\xc1\xbe\x99\xba\xeb|\x02\xa8\xefg)+/\x11\x91\x0f\xb3\x9b\xa5\x15\x9b˾h8\xceN\xc6\bH\xa8t\xca\xee\xe2D\xac`kn\xe4-\xd3</\xddh!\x92\xec\xe9N\x9f\x9d\n@\xd2\xe7\xd4\xd2\x10\xaf\xec\xc7n0]\x86\x91~S\xcc;9m\x98b\xae\f$\xa4\xa0\xa9\x0fc@5\x1b@5\x9b\xc0SLCip\xab\xae\xfc\x97\xe1->\xc5ޥ\xe7\xcba\x03\xfd!\x9b\xb8\x16Qr\xecή\x8f\xef\xf4\x8b\xec\xdd\xf1m\xe1\xfd\xce8\xdd\xc1a\xb5\x81\x83\x14eu\x13y\x88g\xd46\xbe\x05\x9d\xf5@\xf8\x92j\xc1\x11\xde\xed'A3\xb70\x82ۦA6@cw\x90z\a\xa9\xbf¿0\\\xb9\xc1\f\xe8\x9b\te?\x1a䄤#ps\xcam\xaci\x92Kq-|Ih\xeb\v\xe64\xf47\xab \xd69\xe4\xabF\xbe\xea\xa3$\xa1f\"\bW:\xdfL\xac\x93\xa5I\xc0\xa7\xa7*>i`Aw\x19\x9aO \xa9\xd1y$\xd1X\xe9)\xe2\x03\x9cH\x8a8CցH\x96\xde\xf4*\x90lD__H}\xcdN\x86[s\xeef\x04\tz3\xc1\xb3:S\xe11;#W\xee\xadN\xf7\x02\xfd\x14^\xc3\xf5\x01\xf7\x86\xbc\ue9782ؤcW\xf4r\x14\xde\xe8\x94(\xbc\xd1S&\tV\x05\x06\x19\xf5\x1a\xe9^G\xba\xd7\xf2R\xe9Ê\xa4oYO\xe4;\xbc(\xfaޓ\xdcN\x04\x13\xcd%]\xbb\x1a\xe5\xa7\xcf\x12\x99 \x8d \x99\x9d\x97\xaa&E\xdeśu!\xe1n\xb5\x12I\xe4M\x01\xb8Sd\x92'Z'\x94}\x8f\xe0u\v\xdc|G-\xd8\xed\xcawp\xd1d\xaa&\x8e!Y\x0f\x16\x8b;\xf8\x1d*\xf9\x13_\x90\x93/\xf7_\xee\xc7_n\xbe\xfc8\xf9\xe3V\xfd\xfd\xb2\xfb\xb2;Y\xac(\xfb\x012<\xfb}\xe1\xf3\xef\x9f\xe3\xc7\xef\xe0\xe3߁\x91x<\xfer\xfc\xe5\xa6\xf6eS\xaf\x7f\xdb;\xfe\xb2y\xf5\xeaի\xc9\te\x7f\xc3\x1c\xb0\xc22Ǥ\xfa\xcd\te\x7f\xc5T2r\xe4\xff\xf3\xed2\xa1\xc9:\x1emW\xae\xe7'\xe16\x11\xfe\x16t\x02\x92p;\xf5f\xdb\xdb\xd5zM\x9d-:M\x9bl\xc7\xee\xf1\xaf\xd5ڗ\xe3I\x95\x8c\x9c\xf1\xdf՛3\xd9~C\xe9\x89Gٟu\xe5_n\xaarb\xb7\xd0C\xea\xc0ǟ\xe0\xe3\x18\xbaY\xaf\x1fßf\x1d~O\xeb_6\x8dn\x1f~\xfb\xf5\xb3/\x9b\xa6\xfcܬ7\a\xf0\xdby\xf5eӪ\xd7듓\x05eB`\x03\xc0\xfc\xff\x06\xaaLJ\xec8\xcb\xd4sn\xbc`\x16\xdeH\xd3\x15\xf9\xbcc\x91Q\x16\x05s {J\x15x\xb9@!Z&\x8c\xcatz\x95\xd0\xfaC\xe8{ӻ\\H5)\u0083G\x16r\x14\xbb\x1c'\xc9\xf1\x1a3\x1eǛ\xf9ܻ\xb5\x86Qm\xba\x89\"\x11$\xe78A\xb6]H\x00i\xfd\xa9\xbe\xb1\x90\x10\xa4n\x01/\xe6\x01\xdb8#\x8f֢\xb1f\xe1j\xbd\x89\xbc\xf9\x9d\x85q\xb2~\xa7\xe2d\x99*C\xf9\xfe\x13\x97\xdd\xcb\xf7\xef?\xbd}S\xaa\xbe\xb5c2\x83l\xfa\xf3\xc7\x03\xb9v\xb4\xa8=T\x90\xc0}\x8a6q\"f \x17\x8e\x8f\xe4\xa4\x1cYU\xb7j\x1de\xdeN.ől\f\x94\x18\x98\n\xec\x91I\xf7D\xea\xean/\x8cGݶS\xa3\x1cSm}d\xbe8\x89 \x94Ee\x12\xfe\x00C\xf9\xa0|\xb2\xa6N?n5k\xadZ\xa3n\xb1H\x99L\xcd \xca\f\xc4[\xab$\xb5Y8\xc5z\xb7\xdb\x01\xea\x10\xeb\xf7Z\x10\xce\xd0*\x8c\x1a\x9a\xab\xe7\x9b\xf5\x1aM\xdb\xc0-Y4Բ^]\x86\xb9\xe6\x8b\a6\xa2\xea\xe5\x95\x0e\x9b\x16\xf3\xa4\x06K\xf4IIeΤ\f\x8e\x81\x995p\xaeن'5\x9d8U\x89\xaf\xf0\xf6\xc5\xd6\xf0\xea\xae\xc4\f\xd2\u07bak6\xcf\xcc\xc7ף\xfc\xb7\xed6\xa9\xbd\r\x7f\xc5$\x00\xb1\xb7\xee\xdaY\xb3\xa5j\x1d\xac\xefu#3i\xca\xfa\xc1\x8db\x11\xb1\x05Oj\x89\xb1\xc2\xec\x9ao2\xbd\x00v\xc7ߒk<g\x03\xc9h\xa7\xecR&\x05\xe269\xf7@2\xba\xb0(\xbbQ\xf9\x96\x9e\x8f=\x8a-\xca\xdeɴ\xb5\v\x1b@\x16> (\x8e%x|\xe2\xae\x02r\xd5W\x92\t\xb3\xe8\xf0SM\x11붝>\xd6\u009b@Dz\xd21\xb0\xe3\x81oR\xb5&\x14<\x12d\xc1\x02\xca\\\xc1C1\nE-\xdbFĒ\x01\xe3<\xc1]\x16\v\ue25a\a|͕\xbem0\x1f\x13e\t\x18\xd1k\xa5\xc4\xc36Ƈ=\x18\x98\xe2ǅH\u0530\xe2o\xef>\xb9\vX*\xb6\x16<\x80F\xc2\bg\x88\xcd\x05\xe8Nö\x9f\v\xfe\x91\xb84\x05\xaf\xb7\xe1L\x8c\xdcܫs\xbf\xcb\xf6.\x8eo\x89\xc5\xf3\x90[2\xdf\xefl;\x16\xc6Ƌ\xcdY\xc8f\x136\xc8\\j\xc8\xcf\x04[\t\xb6\x10\xfcO\xecZ\xf0\x1f؝\xe0߱K\xc1\xff\xc6n\x04\xff3;\x13\xfc'v+\xf8_م\x90\x88\xf4\\\xf0\x0f`\x1d1\x9eh\x85\xf7\x90\xbc\xa4,$o\xe0\xe7[\xf8\xf9\x15~~\x01\r\xc6SU誤\xd0_ \xd77\xf0\xf3#\xfc|\x0f\xf9_\bS-\x89\xe4ͻ\xb1\xa6\xfbDN\xf0\v`\xb59\xf7@\xc2\xea\xd82\xf9X3\x8d|\xac\x19\xe5jS:\vJ\x19P\xff\xf1\x9a\xc0\x95\xc6\v\xd4\xf1\xf6~\x15\xb3o7\x9e\x9f\xbc\x0e48|}\xad\xa0\xe0I){\xaf\xe6\xedg\xf5\xf7\xb5\x003\xcew\xf8\xfbI\x00\xce\xfa\x80\xbf\x1f\xf1\xf7-\xfe\xbe\xc4\xdf7\xf8\xfb-\xfe\xbe\xc2\xdf_\xb1\xd4g\xfc\xfd\x05S\xfe\x02\xc0ľQu\xff\xa8\xd7f_\xba\xa0\x95'\fe\x06%\x9dݓNI\xb9\xb3\xa7\xc9g-*\xf2\xf2\xe2\x9dؐ\xb8\x04\xa1X]J\x86p\x88\xc5$I\x9bʥ\xd0I\x9fʛ&\xa6\u05fc\xebEA\"\x9eJ\xbe%\x9d\xa7%۷\xab5\x12\x88j\xb0\x7f\xca\x06\xabƦ3J\xad\x85T\x82\xaf\xa5\xc8Rz>\xa1\xec\aU\xc3wY\r~bp\x1f%\xc7\xde3\xb9\xea\x8a#r\x88_\x1d\x85E^\xae\xee\xbb\xe2\xcd\xea\xc1\xa6d\xee\xdf\x04\xb7\x96I\xb2vNNnnnj7\xadZ\x18-N\x1a\x83A\xff䭛,\xf1\xe7\xed\x1b\x8b\xfd\xb54\x1f\x10r'8q\x7f>T\xcf\xe0\xe4V*r\xfc$\xf8\x9f\x05\x13\t\x80K\x92\xf0q\xde\xe7 \xe4\xa9J\x10A\x7f\x8aXf¢\x84\x1b\xefL\xc5\x12ca\xb2\x8f\xfe\xf1\x8aM\x99[\xee\xf0k\xdf\x18\xb1`N\xa95\x93w\xcc3jH\xe8=`\xb7 \x91\xa6M$1\xad\xab\x14\x01\xa9\xbd\xb9\xf0\x8f$\xa1l&\xf8Lp\xf4\xb4\x91$\x99\xab\x8dڇӏ\xe7g\x1f/ޞ\xbd|}z\xf1\xe9\xaf\x1f\xce\xe8(J\x9c\x92t\xb6\x12\xfc\xc0\xdcp\xcegbTJ\x9a9\xe7\x80N\xad\xd37o\xde\xffx\xf6\xf2\xe2\xd3\xe9w\xe7ҵ\vBVR3?\xb0\x95\xa0ι`\xa7F\x81\xd3O\x9f>\x96\x16\x80\x0fX\xe0J\x00\xc0Z\xa7/_^|\xfe\xf8\xfa\xe2\xfc\xf4\xd5Y\xae\xd4G\xf2\x1d\xfa\xc7\xd8ˀ\xa5\xbf\x13\xec{U\xfa\xe5\xe9\xa7S\xccav\xf2#\xf9SZ:\x97\x01K\xffI\x00n\xb1^\xbd\xff\xf8\xed\xeb\x97\x17/\u07bf\xfbt\xf6\xeeS~\x80\x85oX\xecG\xc1\xdeg\xc5\xf6\xe6\xc4H\xc7\xec\xf7;\xf6s\x96}oF\x8ct\x9d\xfd/\x82[\x9f\xcf\xcf.>||\xff\xea\xf5\x9b3Y=\x18\x99\x99\x89\x88j\xa5\x11\x1aN\xea\xc5\xe9\xc7ק\xb2\x9aw\x85/8r\xfc\xf2I\xa4\x89\x9f\xdf\xfd\xf0\xee\xfd\x8f\uf83eO\xef_\xbc\x7fs\xbe\xddJl\x9d\xd4p\x8e_\xbd\xffx\xf1\xe9\xec\xed\x877\xa7\x9f\xce䷏\xf0\xed\xc7\xef߿9\xbbx\xf9\xfe\xc5\xe7\xb7g\xef>a\xfa\x1bH\xffx\xf6\xe9\xf3\xc7w\x17/߿Ŵo\xf3i\x17\xaf>\x9e~\x97\x16xe|\xfc\xf4\xf1\xf3\xf9'\x80\xa0\xbf~8Ï/\xe1\xe3\xab\xf7\x1f_\x9c]|\xfb\xfe\xe5_\xb7[u2\xc8\x01\x9d\x9f\xbe{\xfd\xe9\xf5ߠ\ao\xd9\xe74\xf9\x87\xb3\xb3\x0fz\x91\xe0\xecHj\xaf\xdf]|xs\xfaB\xd6y\x9b\x0e\xfbLB\xd1ǳ\xef\xce~\xfa\xb0\xdd\xde\n@ I\xed\xdd\xe9۳\xf3\x0f\x98\xfdς%\xb5\x17\x9f\xcf?\xbd\x7f{q\xf6\xe6\f:}\xf1\xfd黗o^\xbf\xfbζ݄\x1c\xfcZ3O|\xb8}\xbd\x10\xb9$\xfeĒ\xbf\xb9\xfd}jA\xf5b\xff\x03\xff\xaaZ\x1e\xea\x91u)\rC2\xda\ue06a\x1f$BT_\x1f\xcc\xc3\x7fk\xe5\x94}\x00\x17\xe9\xb85(\xfb\x16\x9e\x81\x02\xa9S\xf6\x17x\xbeP\x87%\x92\x83\x80\xc2\xe0\xba\x06\xb7\x9c\xbf\x88\x1a J\xdb&\x1fȅ`/!\x18\xfd\xa9`\x7f\xa14\xfd\x1e_/\xf4\xe77\xea\xf37\xea\xef\xf7\xf9l\xf2V\x15\xeb\xdc\xdf>\x90\x1b蒷i\xb3\xbf\xaa\x1c?f95Z\x03<#\a\xc0\xf9\xb9\x1a\xcaGr!(d\xbd\x10F.@/\xba\x14`\x03\xf0\xa4\f\xa5\xae\x84|\xfaHNe\xa9Sa\xe42K\xe5\x10\xb0m\x7f ?\x88C\xa8y\x1fw\xda6\xf9\x06\x9a\xfbQȧ\x8f\xe4\x1b\xd9\xdc7\xa2\x1c\xd1R\xf6Y\x8e'\xd5\x10\xc1\xf5\xfa(\xa0\xe5\v\xc1\xc6Z\x97SQT\xa8\x828\xa1\xec\x02\xb6ݥ/\xf4䍕v\xe2\x04\x8eQ\xf4\xed\xf9^\xd40\x89\xb2\x95m\xafP5;\xe1\xe0'3N4\xcd$\t\xc20\xa5\n3\x82pB\x99\x9ff+\x92\x97\x8a\xea\xd4\x04R\x81P\x9dP\xb6I\x8b\xea<\xa6\xecQ\xa93**rB\xd9Te\x7fC\x87\x1f\xc84\x91 3M\xd8+ɸY\xabϿ\xc2\xe7u\xc2>\xcb\xe4yR\x12\xbd\xe7\x1dzE\x80cDa\x1bIc\x00\xed\x87|\xc6\xcf\x1f_;\x80\xfc\xe4G'\xa3Yw\xda\x16蜤\b\x8d\xb2\x80\x9f\x93\xb4*\xc3ZѬ\x8fs\xfeW1J\x8ai\x7f\x06{\xbc\xeb\x05Z\xf18{_\xfff|\xb5mR\x9cCi\xec\x1f\xa3\xff\\G\x19\xa5\x91)\xb8N\xa0\x8e(\xa9\xab\xb4u\xa4\xfa˛\xff\xab\xf9ٶ}h(mg}\xa0\x9d?\v\xb0\xf5-\xa9˶+X\x03-\xfd\xfe7\xf8\x1e\xeb\xefX9D\x83\x87\xbf\xdbmE\x0ejǖ\xf9ռ )S\x89\xdd+\x85jG\xec$\xabN\xd42f\x87\xca\xf5\x028\"\xc0\xa2\x92\x17ueX-j\xe1&\x11\x11\\\xb7\xb9+\xb2o\xba\x14\xa1\xbbݎ\xcd\xf6\xbc\xcbFw\xf9\x0e\xa4\xa7\x85\x93\xe7-B\a\x88\xa0\f\x8c\xb7\x9c\xc4d\xf1\x1d(\x8e\x14x\x9a\xdb\x03\xe2V\xe6J\xab\x84\xea,\x0f\x8d\xbf`\xdeN\xc5XL\xa87'o\xc4v\xfb\xad\x8cQ\xbfLHb4\xa5\x9cyFw\xf7h\xb2d\xd4\xc4,\xcb̷c\xab\x92-#\x1dH\xbf\x14Tp\xeb\x99\xec\xcc\xf3g'\xea\xc1\xaa\x8a!T\x7f/Yr\xa7D\xb0\x93\xbf\x8f\xbfD_\x02\xe9I\x8c\x0e#p8\x13\x8c\xeb\x93\xddC\x847\xc6\xed\xf8\xc33H<\xc2\xfb\xd3#7\x9e\xe7\xcf\x00\xdd=\x7fv\"\xff\x00\x1a{\xfe\x87\xaa\xa8Z\xcfN\xf0\xf9\x19^j\x9e\xa7\x0e\xc0\xf6XL\x02|\x0exs\xf2\x93@\xc0\xc5yK8z՚Q\xe9\x84\xf9U\x14\xae\x94\x13\x9d\x10C\xe2g3\x85\x96\xe496\xa6:a\xe9}\x92qq4\a\x87\xfc$\xcc˯4\x11\x91\xebQ(^\xf3\x82@\x81\xa3H0B\xba\xb9<҄;AO\xad\xdb\xed^\xe1af0\x1c\x81\xb7*/\x88E\x94|+\x00;\x13}\xa5\x03/g\b\x95\x11e^-\xe3\x13\x8e\xebʢ\x9225%\xa3i\xea\xdb\xf8\xa3\x18\xc9SƑ\xc7\v\x05^\xf0G1\xda\xeb\x82\xe3\xedآ\xf4\x92\x98\xda\xf1\xe7فۭ`\x82Mk\xe7߿\xffQ\x133[\xf5\xfa\xe2\xfd[\xf3\xf5\xd3\xd9O\x9fp\xeeX\xa5\x01\xae+\x1f\xbf\x8b.\x01q\ue148A\x06\xb3\xc4\xfc%\x1f\xe1p{!\x19\x97\xa5\xe2\x8b\x1c>\x01\x17J\x06]\x19\x9b\x8d\xcf\xe9\x83\xe5\xd3]x \x97\xb9QK;j\"\xd1\x03u\x98\x00@w\xec.\x7f\xf7V\xe2\x1e\xf3\xc6\xed\xd3Qb\x8e\xc1w\xf6\xef\xe4\xb6\r\xd2\xcbK\x11\x99\xa4\xae\xe6\xd9۶\xeei\xe1#\xcc\xf7\x8e]\xe6\x11)X7/\x01\x7f\xd9\xf6\x19\xc1\aF\xf2\xe6\xf9\b2\x11\xe4d\x81\xf4\x94\xc6nJP\x14l\xe4˄X\x978\xd4s7\xf0\x12\xefWͽ\x88-\xa6\xec\xc4\xd8ub\x98\"/\xe1\x85U\xeaP\xf8g%Y\xeb\xd7S\x01 \xcb\x00e\xbf\x88\xc4v+A\x8cL\xba\x13\x9bu\x18\x14\xba\x00=МP'`ʡ\xef'w\x11;\x17\x02\x9d_-\xdd\xf8E\xba\x17\t\x9c\x83w\t\x115\xf4\x90\xa0*\xc1\xefp\x95\x91\x9f\x14w\x9dn\xb7\xb9\xf7\x92\"Զ\x7f&'\xcf\xc6'_n&'\v&2$\xb3\xf7ŀ\xfe\xd2y\xd2\xc64\x1c݈AY\x8d\xd6N\xbc\\\xc5e\x85+\x17b\x1cL\xb6\xdb\xf7\xf0G\xfa\x88\xc0G۾MH@1\xa5pY\xdc\xe7,A\xab\x85L,\xa09\x0f\x00\x0fԡ\x19Q\xb6]\xc8D\x8c:\x00\xbb\x03\xcd]\xf9FvT\x9e \xef\xd0\xd8ݤ,\x98\xcboTb\x86GU\x88\xf4\x90j\x9bD\x8f\xbbJ\xc6v\xdc\x18z\xcfy}x|\xec\xd10\x8f\x9d\xef\x88;\xf6&\xe8\x90\xf1\x12\xbd\xc0\x14\xa6oW\x86\xde6\xe0u\b2\x8c\x88\xceG\x9d\x8c%[\xc15J\xf9\xb6\x15\xa4\x18+\xb0f_N\x82\x90\xc8\\[\xfcH\v\xab7\"pMl\xc1\x8e7\xb66\xda\x7f\x1b\x10\xc2\x12~E\x12\xb6\x10\xd2ѳ|\xbbVo\xb9\x9c\xc0\x9d\x80;L)\xcdVK\x85Z\x183<W\x90'\x942\xd8T\xee<\x11\xd1\xe1\x8d]iP'\x9b\x84\x1d;K\xf6̙\xbd9\x81@\xe1\x04x\xbe\xca\xc1\x122|\xe1\x19v\x15\xba\xfcw\xb7[\xfc\x1b&y\x90z\a\xe0\xf03\x18n\x02\xfcݡ3\x1a\xa4{\x8e\xbc9y- \xf12\x9f\b\x84Y2\xd9n\xb1\x90\x04vr+]\xee\xfc6 \a@\xfbM\x90-\xe8A\xfe\xc7\xc1\x96\xf7\xb3\xb2D\xb6\xffp%\xb9^\xecg%\t\x85SQR\xaf\x89\xcc\xf4\x04f\xc8\xd7OV\xf4['+\xa2\xd4@\x04z1\x7f\x90&\xbb\xfa\xf5gr+\xd8\x15\x89\xd8\x19R\xd0ƪ\xa3\x1e\xb8\x84vS\xbdE\xa5\xa4\xcfp\xa0\xcbM\n[l\xbb\x05\xd9\xdf\v\x12ISS\a\x82%U\xbe\xd7t\xfd'\x04=rs\xa0\xc5(\x83S\xf5P߱\xdbr\xda(\xe5\x98[\xc7\x16}^߱\x8b<E\x80\xa7\x1a\v\x99˼\xe1\xfeq\x9a\x92#\xb1\xc5\x12\xb9\uf51f\xe3\xc4 \x81`\xb7(1\xb2\xcf\xf1b#\xef\xd1\x16J\xf1\xfe\x82R3\xcbbWB\xac\xa1B\x10\xa5\xa9\xe30\xab\xdf9\x15;4\xa1\xf6\xb8\xd6O\x18z\xa9=\xf7\x86\a<\x06l9\xe5\x1b\xa4\x82ؚor\xe4\x10\xf4!\xe4J.\xc39\aό\xf8\xe2\xbc'\xea\x892\x17\x8e\xef)e~Mw\x92\xbb\xea\x05{\xc9C\xe6\xd7t7A\x18\x87\xeeѦ\xe2\a\x9d$\xa5\xb6\xacx\xe4\xa7ÀY\xf2)\v\xb9Q)\xab\x14j\xb1m2KȔ%\xd4h\x8dRI\x92|9y~ⱐR\x95E\u07b4\x90\x83\x17\xf2+\x12\xa6\xa8W\xbe)ԫ\x98\x1f0\xbc$O\x9d\x9c%d\xce\\\xa8\x10\xf0Q(\n\xb4݂\x96z\x1aZ\xe4n\xb4\xa8\x99\xe1\xcd\xc9Z\x81\xa04\x97$\xfb\x99\xa0)J\xef\xa7n,\xb4\xea\n\x9c-\x96\x13\xf2\xfcM,\xa4\xc3\xcbH\xb8WC3k\xaa,\x93˟\xa6B\x84\x03\xb8@\x81\xf6\x85I)\xbf;'k6e!u\xf2\xe9\x04\xd2\xd8mv\xfe䮽\xbb\xdd\xde\x11S\x02\xec\xbb\x1d;Ox\xde!\x94\n&\xc2\x17p\xe3F\x90\xdd\xdf7\xe7hr\xf8\xf2\xfd\xdbl\xdbD<\xa8\x05\xfa\"F\x87\xb4\bC\xb2\x884\xa8\x8cd\x19v\x93\x10\xc0j$Ҕ\x9e\x89\xd0<\xdb\x16\xd9\x17\xca. 3\x1d\xee\rk\xbf/\x86\x83\xb8Xe\xcapB\xa8\xfd\xa5\xc7læl\xcd\xe6\x00F\x04䌨X\x15r\xeb\xcbmkZ9>\xfer\xdb\x12\x16e{\xf7\x94PR\xb2\n\xe4Jn)a\xeaJG\xf9\a{M,\x9dr\xe4\xc52\x00F\xeahBi\xae\x14Z!!\x0f\x8d(}4\xabi\xe6E\xc9]V\x8d,ǎ\xd0W3Ԁ,\x96JNO#\xa7\xc8&\xef<X\xb7\x9bxS$\x8e\xb6ےMR\xc8s_\xeem\"U!33\xc3\x06\xf0\xe6D\xceҁ\x1c\x19\x9b*%\rC\xe8\xfa[\xb1\xddz\tqi^\xc5j\xbfi\xdb&\xbfH\xa6\xff/\xd2\xf1Q\x98\xa1\x06\\\xe1%\xe0\x8b0\x8f/\x80`_J\x82}9\xc9&5\n\xc3\xe4\b2\xc2\xc4\xce\xc3\xe8\x12m\u070e\xdc`v4E6%\xa8\xa1i`\x9a\x1dy\xc11\n\xd2-\xbaK\x0f\xd40wˤ \xd0%\x1bNb\xbeJ\x88\x06)\rT4\xcf10\xb4tH\b\xd4\x1e5o\x9f ,\x83Uۘ\xb7|\xc4;\xb9\xc4Q\xcc7\x8e\x8eX*Y\x83\x1b\x85^a\xdco\xe0\xcc\x05<[\x016;ʛ\xc3\xec\xf0|f\xa5\xeb\x048\xf4U\x91\xb5\x14R'\xc4\xe9\x93\xe3\tS\x8a\xe2荐:\x94\xaf\xc4ȅcp\x17\xdb\xf6Ka\xdb˄\xc4\xf2\xe2&ol\xa9C\x93\x19`\x96_\xc4(tb:\x9c\xf2Y\x0ek\x00}>5\x06?\x05u\xb4\xed\xf6&!S@\x14\xd3\x03\x88\xe2<!\xd3\x1c\xa6\x98R\xb6\xe6S\\\xf1\xb5T\x01\xf8%U\xbeÁ\xbc\x91 \xf3\xad\xc0K͜o\xd4\xc5<ί\f\x1d\x9a\x83\x18\xd2yn~\xf3\x03D@\x98\xf34\x88\xfe\xa9\xa8I\xd3l\x00.\xf0\xc1\xc2ת\x91\x80\xcdq\x95\xd9\x1c\xf9_+\xfeQ\x8c\xe2l?8qvoѕ\xc1\xa2\x81\xbc\xa42\v\xa5\xd9\xe6Ķ\v}\xddK\xa8\xa9\xbc\a? \x85\x01\xb4\xa6\x10\xec\xa1,\x80\x17W\xdczVy\xf9\xfe\x05\x88s\x8f\xac\xeaC٫\xd6\xf3/\x81U])\xc9܊_\x91Uz\xae\xcb7}\xae\xb3Rh[Qg\xb5c\x11\x1cw/Pc)\xef\xff\x1c\xefD\xa0qT\x87Lh\x93[\xcc\x06j\x18r\xdd!_\x03\xf2y\xf1_\xc0\xb43=\x05\xf7nS\xc0\x1d\xf3\x12p\ao\xf0B\x80\x10\x01\xaa#\x95\x83\x9c%\x04\xe8ɈB\x9d\xeel\xf6}\x18^\x15X\xe9e\xb8Զ%7\x88\xe3\xefv;\x9e\xb0\v\x99\x82N@S\\W\xa8\x0e\x81\x14\xb3i\xe8\xbdU\xaf\xf9\"y\xf7\xf2\x8a\x01\xa5Z\x1c\x9b\x99O}\xbf\x90\x1f\xb2s`\x94G\xbbL\xef\x95\xee\bݱ\xee\xa0ݩ\xef\xfb\x17F}\xa3\xa3\xe4\xde\xf0\xf5f\x84X\xf1\xc3\x1b\x1d^\x05\f\x84\xb9\n#'i^ި&\xc7b\x17^\x8b\xc8wױ\xe1V\x90\xa4%\x9e\t\xa8Cţ\xf2Û\xe7\x02\x93\xe9.\t7ӥ(/Tm\x14\x8b\x1d7҂\xee\xac\x18H9!\xa0\xa8T[y\x01\xd1\xf9\x19\x96\xa7L~po\xb3\xba\x99\xaa\x87\xee\xe2\xcde\x12\xb9\xd3Ĩ\rK=\xe3\xba\x16ۖ\x99\x9f\xf3\xb488\x05\xc6\\ϋ\x99\x9e\x19yd\xaf\xf2\x9d9nP&Ӆ\x1ad6\xaft\xe2\x14\x9a\x1e\x8d\x1f\xc8{\xa8\xfe\xc9.#2rQ\na\x19\x8d1\xe843r\xb0N\xabZ\xc7V5ͺ\xdbI\xf8\x88\x0e\xc0G\xe4\x06\v\x11Ñn\xc2E\x9d)ׇ*\x1a\x15.\x19x?\xbdجgn\".d>\xa2\x81L\x962*L\x9d\xa9b1\xfe\\T\xb3\xf0\x9du\x05\x02\x99\xebI\x80\xe9̽W}\x98<3\xab\x92\xe5l\xbb\"j\x1a\xe6\x8c\xcfxo\xa6I\xb5j8\f4K\xab\xe0\xd6p\xb9)\xaf\xf6\x81Z\x05\x178\xf4\xc2\a\x06\xadE2\ue80eC\xa9\xe61J\x9dH\xefu!\xd1\xf1\xe5\x8as\x98\xc5,0\xcf\xd1h$ty\xed\x109\xa4\x8er\x9c\x18\xa0\xcd\x01\xa0C\xa2\xa0\fX\x94\xb2~c[\xfc\x86\x19Nq\xc1\xbfv\x8a\x0fW\x1b\x95\xce\xd98\x99Ԍ\xed-\xa7\xfc\xff\xdf&\x1a\x8d\xc1c\x91\x9f\xe9\U00044e79\xe9\x8ex\x1d\x1cV~\xc5tG8/j\xba\x0f\x94}\xa8\xa8\x12\x95\xe6Qg\x9a\xc1Į.\xcf#\xde,\x93\x89i\x95OX5\x03\x01ܺYT\xad\xee\x9e<\x9f\xee\xfe|\xba\xfb\xf3\xa9\x976,_==\xe73q\x9b\x0f\x1b|\b\x9a\v\xe0$\x93\x9f\x81\xc7;q\xccK?ʍm\xa0]#CxS\x15\xfb\xd8\xd9\x1a\x1fYU#\xab\x8a<Ŏ,\x88\x892\xb1v\xc8z·\xb0\x8b\x88\f`#\x85\\q\x01ӗ\xe3O\x03\x9a\x12\xe8\xcb0z\xc6\x13\\\x9d!MC\xb7\xb2(\xeb>\b}\x80Ԉ7\x97\xb2\xc6\xf2f\xd0ѽ\xe0\xcfɽ\x1f\xde\xc8#\x8cA\xad\x8e\\z&\xe7\xc5iT\xe5\xfb1\xe6\x00_Ļ]\xe6\xda:ڱF\xaf\xd1\xef\x1d\x88}\xc0\"\xbe\x17\xd3@9\f\xd5Ή\xa5VB\x00\x8a \xa5\xde\x05\xd1A\xe8H\xfdu\x0e\xf8\x11M\xbd\x13\xe7\"\x1a\xfb\x99\x17x \x13\x87\xc9\xc1F\u009b\xe0\aq\x17\x8f\xd2'\xe7A7\xfdOu\xeb\x0f\xb7A3\x1c\xc0#\xee\xfdi\xa9\x8b\xee\ak\xde)\x15\x84w\bP5/~\xe7\xbe+\x0f\xe4P\xe1°\xd6r!\xccW\xcd\v\xbcDN\x91\x04\xcbl]\xdd,^R-\f\xa6\xe2\x80k\xd5\xd2@x\xe8Xz\x9e\xf9w\x8e2\x95\x974\xa2U\xc2\\\xca\x02\x12\xe5\xbc;\x97\x12\xecŢ\xb6]L!:\xc0bHYDƓ\xd2\xd0'\x94\xeeV\b\b.\xbb\x87\x01a\xc8)\xa6JJ\x16z\x11\xb6J;\x03|}\xa8H\xb7\x89\x90\x85η\xb3jQ\x94\xec\xd60\x86\xd7\xd9\xcaK\x12\x11q\x97\xb9\x06p^\b\xf8\xa6\x03\x85\x96}z\x11n\x82\x84\x17>\xad\xdc[=h]V\xb9\xdeoԇ\xa6\x1b\xeaC\f1Q\x1a\x9c\xea\x0f\x9f\x96\xe2\xc8\xf2U\xcdV\xe64\x1f]\x1a_\x8a#\xb87ݭE\xb6͎>\x8a\xa9\xf0\xae\xc5L\xa6\xff\xa1\x9a֟\xf3\xf7\x9c\x02Jj\xd1V\x18\x04\xd8:I\x0fKo\x8dT\xa7\x90\xcb\xf4\xec\xac\x02ܫ#\x17\xa4\tl\x83b\x01@\x82i3\xc4\xe5\xe9Lґ\xf9\xc6\xf7\xed\x88(K\xbf\xaaY\xa7\x0e\xc9\xec\x15k\x81\xb8ɀ\x8f\x88\x9aXy\t\xb1\x8cT\x84\x82\x9a\x9e\xbfQ\xf6\bA\x80͞0\x8f\xbbHE\xa6\x1d\xf5\xa8L\xe2\x11\xabV\xf3\xdd\xc8\xe4>\xfbp\xe8\x8dT\xb1`4\x8e\x987q\xc6\x1e\x8b&N0\xf2j\x9b@\x86G\x8c\xa8㥧\x03\t9,\a\x05KLO[e\x02\xfb\xd4C\xfbOdL\xaa'\xae4\x10\xa6\xdc\b\xa6\xf0!\x8cc\x0f\\c\x9b0}\xb4\x12\xab0\xba;\xf2\x85{u4\x13\t\xba7\xaa\x1dYU\xdd\x02\x84\x06\xab\xaaS3\x81CQ\xcfK|\x84.\xddjG\x9fcq$dm\xb5X䀀У\x048y\xb0P\xb18\x92N \xe8p*C\x85ZfV\bR(fb\xf6\xa3\xab|\xb4Lk\xaaR.\xd8T\x06aM\x18\xf0\xa5`uu\xef؆O\x99\xb2\x82\xb5m\xd3\x1c6\xffF6\xb4\xc43\uf528\x90\x1f\x18\x9f܋\xb2`\x9e\x98\"\xbdH\xeca=\x1d7X\x929\xe0z\xe1U@YV\a\x88\x82\x00,\x8a\x16\xb4\xea֧\xea\xc9P\xb6j\x87:\xf9\xefʩv\x96\xa1\xd4\xf3\xfe:\x178\x8f\xdfc\x0f\xc0\x18M\xf6Kypg\xb2\x06G0踓\xb0\f\xb8w,\xe4S\f\b@\x82\x94-\x13\xa6\xdd\xe0\x11\v\xd4\x18yh\x06*\x99\xe7\x1bN\xc1\x1e\xf6q\xba3\x025\x9f\xe3\x89:\xe2\x82q\x92zpNs\x85p\xa7/\xd9 \xe1(\x1a\x8d\xb3\xael\xb7\xe1\xc4\x19\x87\x13'\x1f!##$3'ҩ\x17z\xa6\xa8w\xf5>\xacV#\x9ah\xaf\xf3Fͦ\x13\xfad\a\x17\x88\x19\tY\xa8\xabɆ\xbd\xcc\x14\x85%\x95[\x1c5\x1cC2Gē\xb1\x98\x1c\xf2\xb8\xac!\xad\x91+\x9a&\xebh\x81\x1aj\xebY\x17f\x99\x17h\xd9L\xa9\xf3\xecj5\x90γ\x85\xe14\xdb\xf0%\xbdʰpy\x0fᘤ\x01\x12\x0e#\x81\x7fЊ߁g|J\xb9ᥪe\xc5Ț\x0f\x1cZj\x9f?pf\x99\x18\xeb\x81sk\xb8\xdf,I2\x8d\xb1\xa3\x10\"\x11\xc9!e\x14H1;\x12 .\x9e\xff\xbb\xf2\xf0\x97n\xeaR\xd0D`\x16\xbb\xcf\x1b\x80\u0086\xdb\x0f\x82\xed\xedXl~PǼҘ3\x03\xa7\x89g\xf5\xed6$\xe2@\x9ch\x9c;\xe9k;\x9c\x1f\x95\xf6\b\xc4/\xe1&\x81\xefx_\xa8\x1d\xbd\xce\xe6\xd6\xc5\xf0\a\x81X\xb8\x89w-\x8ed\a\x8c\xc9EeY\bh\xe9q\xf0=\xc0$\xa5\x99\x8b?\x9c\x02\xbc\xb1\x13l\xdb|\xab\xf0\xf2\x00\a\x92Vչt`\xff\x87\x8ew3\x83>\xe1Ub\x8e\xa2\xdaO\xdaneGw9:\xacpR\xf1\x7f\xf9\x82\x04\xff\xa2\xe97O\xa4\xfcP\xe5\t\x94\x1f\xd6\xe2а\x8cHH*\xfe\xaaY\f\xb6 /Ǫ\xe3\t\x8bxc\x18=+\x9eg\x18\xc2#\x91\xe4I\xfa\r9\x18\xff_\xee\xfe\xbc\xbfmck\x10\x84\xbf\n\x89\xc7fPf\x91\"\xb5\vT\x89W\xb6\x95\xc4ql\xf9ZvroHZ\x81\xc8\"\x85\x18\x02\x18\xa0\xa8\xc5\x02\xdf\xdf\xcc\xf4\xf4\xbeMO/\xd3\xfb\xbewOO\xef\xd3{\xffq\x93/\xf2~\x92\xf7wNU\x01\x05\x10\xa4d\xdf\xfb<=\xef\xfc\x12\vD\xa1\xf6\xe5\xd4ُ\n\xa9,\xb1k@\x18\xa9\xbb\x1cZ\xba$\x04\xa7\xfa\xe9m\xe0ʨ홞T\x98S\xb4R\x1e\xf9\xbd\x0e\xaa\xbeg\xbe)l\x8f\t\b\xc7H=\x93\x99\x81+\xa3\x96\xcb3\x82n\xa7\xb1.\xcf\xdd`\xe4\xf3Q\x05\xdblZu\xdb\xebZ\x15\x1b\xd0\x1f\x15ܼn\x11\v<|\xe8H\x99\xb1\x94\x8c]\v\xe6\xd1X\x05\x93t\x15\x9cO\xc7\xe0\xdf\x15p\xc8'\x81\xed\xe3\xf2\xa5Z\x1b*\"\x95Fl\x86ld\xfbt&%}\xf2\x16\x9b\xe1\xf5\x15\xd8\xc3^4\xd0e\xe7\x99N\x8f\xb9\x9e\xeeh\xa4\xf7@9\xd57\xc3]@\xe1\x0e@ei\xb3p\x18\xb0%u\xe5\xb2M#\x0e»\xfb\xb7\xd3Zhg\x19M\x1a\xc3M\x86;\x06?L\xd3J4뮬#\xc7\xc1\x90\xaf\xeeLVm\xa1\xefw\xb7\x91G\x02\v\xd5+%\x0f\xa5\x18\x85\x84\x8c0\t\x99 \xb7\xfb\x89\x89d涍\x1d\xb1\x00\xa4Q\xc5\f\x91TG\xcch\x12x'-\xc6\x1a\x8d\x05\xb0ؽ\v\x92:\xb6\xb2\ue0a6h\xb0@\x99cyI\x1e\xe5?Y\x94\x1b\x14R\x92\x98ʌ%(@$\x81\x88\x8av\x1ce*\xae.\xa8\xb8\xba\x8d\x06(\tE\x10\fO\x0e\xae\xe7\x0e\xf2\xe3\xbb\xf5X.\x91\x86̕\x8a@\xa0\xd8\x10\xee\xb7r\xd3$\x11\xc94\xae\xbc\x93_!\xe4\xc4\n)֒\xc0K\xd4넫`\x1b\xf5\xf6\xa0\x83!b@R\x17\x01\x06\x00\x02\xfd(\xe5D\xda0U,B\xf0\x92\u00ad\x8f\x9c9/I\xd2\xd3ZY\xdc_\xe1x̖\ufdd2\xadx\xe8\xfb嗗\xe2\xd9Ѡ\xb8\xb5\xee\xb7\a\xa3B\xd3:c)E\xf3i\u05f6\x93\xa1\xbbR\xb2\xfa\xa9;Ymd\xa8\x85P=\x92\xb2\x8e\xa6\x9c\x06f\x86\xf8\x8c$p\x95\x18\xb3\x9b\x91\b\x01)\xae_\x951;d\xae4@\xc3n-.\x02h\xe9\xe48\xb4\x8b9\x8a\xd5\xe6\xa7\xe7\xbe\xf3\xa7\x18\xe9e\xf7\x8a-pJ\t1z`\xc08\x91\x1dX\x83X\x91s \xb2\x03\x1a\xc0\x01\r\x1a\x8de\xb5\xc04t\x96\xeed\xbftW\xaa\xecc\re\x17.\x83Ƚ\xfa\xfa~%\xe5u\xa5\x9b\x91\x93R\x06\xeeKi\x9a\\\xb1n\xe1\xdd\x16\xc49Ϣ\x89\x96\x8fK6x\x9e\xfb\x86\xeb\x83\xec\xdc\x12\x84ka\t\x0fZ]\x91;9\x18\xdbuN\xd7\xdb\xed\xd6\xfa\x9d\xb1̷\xd7\xf7\xda\x18s\xc8.\x06\x7f2Cي\xa6r\x8d\b\xbdb\xdc|\x03s\x04Ԋ\x15YQa\x04\xaf\x14\xa8ȿ$\xe6\x9f\x14\xa1cLH\x83\xd3\f\xa42\xbft}\xe8ԥ\xebˎ\xd1PJ)\x98kgX2\xa6\xf21\x8f8\\\xfe\xae\xfdZ\xffN\xbf\xc67\x81p\xaf\x99k\x9f\xe0\x8f4\x1d9@\xae\x11\xf5\x8a\x86\xcdY\xe41\xd7~\xfb\xfaY\x9a\"\x0f\x0fs\xe7\x14'\nE\x1aU\x93fAk #⤉\xebRu\x89\xb36\xf5\xd82\x1e4\x8dY\x8b\xfai\xa0;:c\x96E\x87\xe0We\n\x7f\xc6%;\xc0\xeb\xb9\xf5\xfa`N\xcfYI<\xac\x88YVg\xad?R\xc1\xf7x/\x96\xe2C\xc6{q\xbd>\xa0\x02~d\x14|\x8a\xf2v\xd1\x04\xf1Y\x80\\Bt^ԉ\xf7\xfdN\xbd\x1e\xc3\xc5*K\xd1!Q\xea\xb7\xd8A\xab\tG\xa1kcG!G\xbd\x1e\x0f\x88c\xb5,\xa9~\x0e\x9f\xa1\xd5\xf6\x00\xf3\xb4d\xdbu\xb6> \x0e\xbe\x87\f\x05yJi\xf7\xccrfu\x96vcl\x13\xdan\x91L\xa7a=\xa7\xb7;\xc4\xdcE\xc5C;bc\x9b\x10iU\x91\xa2\xed\xb2\x82n\xe4\xc8\x1f\x18\xda\xf0\xc99(&\x8d\xb8\x9d\x8d\x1b\x9a˵1*푙cl9\x01S\xfd\xc3|\x9f\xfb\xa1\x8b9\xa1\xe3\x9f{\xd7|d\x87I\xb2M\b\x85\x9a\xba\x81\x13\xe8Hn\xf6ڻ\xd6\x1a(כ\xf5\xfd\x80-~ur\xfc\xb2)G\xe6\x8do\xb063S(\x87\u07b2\xea\xcb'k7W\"\xc6\x12c;\x97x\xad\xaa\xb9^UO{;W\xe6W\xf7,\xd3\x14\xe1\xdb\xe9T\x06\xbbK[U<\x05\xa8A\xa0f\xa6\xf5P\xc6\xd3\x1f\xb2j\vS\xf5\xb6\x9c\xcd\xd1\xf6'\x95(b\x14\xe8\vW\xb0\x88\x8a\xe6e<\x8d\xbc@\x8cˑ\xf2\xc8\x044=>\xc8\xc2x\x939-\x8bZ\x98\xb2{K\x00\xbc\xfa\xd6\xf4C\xf05!\x9aņɭ\x91Î\x96\xc0\xb8\xb9T\xd8j\xeflo\xee.\v\bo\x95\x88+\x81\x8bZ\x01p\xc1G\x950\x00\xbew\b\x11\x88\x90\xe9nѨ<\xbc?\r\x96\a\x0f\xa5!K\x83\xbc\xeb\xf6\x06V\xa7$*1ׁ\xea4\x1aT\x82\x91\xbbI\x12(hF \x92])KN\xd4\xddL\xbbԣ\xa8\xa9\x91\x03\x80\xb4M\xa8oΨ'\xaf\xe6\x9c\x12i\xeaE\xd2`c\xd3X/m\xb4 \xd7K\xf1\t\x1d\xa1\x9b\xe0>\x13\xc8\x13\xd7X\xb3\x9b\x86\xac]UӜ\xce2\xd5\t\x90\xbd)\xbc&\xc5\x05\xe9\x10x\x1aS\xd6\xeaL\xf7g\x9di\xbdN\x86\x92\x89a=\xb0\xeaS\xd4r\xf5\x98\x9emۂE\x95\xee\xbd\xf2!\r\x81K0\xd4\xfa\x02\xa0.@n+*\x8b,b\x0e=\xeb`\xa72\xb7\x88\xed\x13\x13\x7f\x90\xb3\x95ۦ\xf3\xce8\xfbnR\x06\xd43\xd2a\xe9\xc6\xd4\xcci\x86\xdb\xf3\xe6s\xba\xb5\xbbݾ\x13\xa7\xc0\x8dN\x8cm\xb5do'I0\xa7\x9b\xad\xf5vke\x8d4d\xc6\xddMݴ:\xea\xb1t\xa3\xd1\xfc\xe65\xfc\xa7\xba\xf6g\xb9:\xf5\xac\xda\xc8\x12#fP\u070eE\xec\xbc\x7f\x02\xff\xae\xc0\xa9\xb0\xbc>\x1a\xf3\xfb\xe0\x11$\xe7\xdf\xc0\x97\xd3'91\xc6bd\xe7ě\xd3!\xf3\xbb淬㳬&#\xd5<:\x16\x02\an\x11\xe8ނ7\xd7\xd9|>\xb7\x893\xa3S\xc0\xf3ڛ\xad-b\x13:.砖+\bd\x11\xb6\x01۸\x9d\xd3\x11+s\xd7\xfb\xd6\v\xc4.B\xa3n\xe0\x8c\xed\xec\x154\x8ao\xad\x87\x87\x93I\x04\x9cI\xb9T\x0f-\xa7\xac\x92|\xa6n\xe0\xe4\x13\xa8\xf5\x10\xab|h9\xf8\xd4\xef\x8fg\xe31_Zg\x96\x03*\xcc\xdeti\xed\xc73\x9d\x89\x87\x963\xed\x8e\xedޠ$\\/q\x02(\x17\xdf\x04Ct\xd5p\x13\f\xcb\xcag\x99Ԍ>\xb4\x9cs\x9d\xf6\x05\x0fd\x89\xd2Ĳ\x12eM\xe0G\x11^x\xc3x\xd9\xc8\xe5W\x18\xb5\xfcE\xad\x87\x8f\xbdɳ@,) ?v\x03G\xfe\x80\xec\xd2\xfb\xcaCK\xfba\xa1\xd6ç\xaep\xbf\xf1\xf8ՒJ\xf4\xe7n\xe0蟲\x10t\x1a\x1e\xd4z\x88\x91\x94\xc0j\xee\xa1夿\xcd\xf44F\x9d\x99!M\xa4\xd6C\x1e\xa8ć\x96\x93\xfe6\xd3\xcd\x1a\x16\x13\xa9\xf5P\xefC\xbd\xb3\x80\u0600\xbc\x97\xae\x0f_5\xcd\x019\xf4oj=D\xdcnc]oò\t0\xb3t\x03\xc7|\xd5\x15lo\xdeY\xc1\xf6f\xae\x82\xedʹ\x02/\x80\xe8[\xe8\xd1\xe45\x9fx\xb1\x88\x96VT\x92\x15*,I\x86\x8a\xb3\x8d\xe7R\xeb\xe1\xb2\r\xf9L\x1f\xec%\x8d>3\xe0@\xfa[\x96ko\xdfQ\xb0\xbdm\x94lo\x1bEWOy\x96A\x16ͦۋ?\xf7\x02\x0f\xf7\x9e\xfe\x89\xa9/ݗ\x98\xf4\xd2}\t\r,\x01\x01ˀ\x80\x84\x02\x80\xa2C\x87\x8a\x9al\x90ޅ?\x98\xeb\x85;]\xd2\xeb\x17.\x10\x03\xe0\xdb\x19s\x95u\xa2\fY}\xe1B\x1c\xe2\xee\xd8F}\xc9\x17\xee\x94,\x85S\x80\xb4<\xb4\x1cxP\xeb\xa1T\x06{h9\xf2\a\xb5\x1e\xcakࡥ\xd4ڨ\xf50#``\n\xd2\x17\xfdE\xc2\x0e\xfd\x93Z\x0f\x95\x9aג\x11\xaa\xaf\xdd\xc0Q\xbfd\x89\xeb\x9b\xe5\xf9\xafod\xeekX\xbd\x8c\xc8\x7fh9\xd9\v|\xc9\x11\xf9\xf05\x97 s\xf8rpe-\xa9\xaf\xdd\xc0Q\xbf\xb0\x04\x98vc]\xf0\x83Z\x0fO\xf8\xb2\xf2'\x1cʞp!s\xddw\xedN\xb80\xd6\ue10b\xe5kwr\xeeF|t\xf7\xfd\xb6\x90\x0f\xfaUL\x83\xfa\x10\xfd\x7fh)\x028MY\xb6\xf7-ky\xd70\x1d\xf3ɟ*5E\xd0\x1eZNH\xad\x87o\x00\xc9Iѳ\x87\x963\x84ě\xa9\xea\x1a\xc0v\x95\xa03x\xd4z\x98\xa1\x0eKF\x9cC5\xb2\x17]\xf4\x89\xef^\x18M,\xad\xc1̧+2\xd3T}\xab\x81\x96\x91CՑ\x81\xad\xb7^\n\x89V\x94\xce\x00\x97\xf1\x06\xa5\x15\v\xea\xa1\xe5\xe8\x9f\xd4z\xf8-w\xdf/\x87(\xeak7p\xd4/U\xe25\x1f\xaf(\xf1\x9a\x8fU\x89\xd7|\xacJ,\xdf\xf9\xea\xab*q\xc2ŜN\xca,\x94\x91b,\"A(\x85\x89Xl[.|0(\x1fR\xb9\x9d[\x86\xf4\xa7\xec\xfe\xc9J\xebr\x8fJ\n.C\xa7\x96\xb5}w\x1d\xaa\xac\xd6KZ\xd1\x06\xe9\x80:{\xc4\f2\x87\xcc\vՖ\x1c\xb8\xac\xfa\xb0\xa4z\xa86\xc4j\xc1\x846\xab8\x95\xfd\\HM\xc1hN/\x11\xd5\xceνy\xa8{\x96\xf1A\x06\xb6\x96߬\x81F\x85K\xb2\xaf\xc8x\xca\x03\x11y<^\x92\x99Z\xea{\xb1\x98\xb2\x01XZL}/\x16\x03\x01\xcb\xd22\xf0\xb1X@\x06LZZD~\x96\x85\xcc]Z\x98\x04\xf3\xd3\xe2d\x14\xf1\xf9\x9e\x95O\xbagɒ6WWQ\xacN\xa1\xe7\xf9zT\xe2Bf\x8d\x97\xe7s\xebԲ\xec|!\xebb\x17\x10D\xe5\xf3\x1d\xa9п\x85\x8c\x1a\x9f.d\xd6\xc9\v\x05L\x14:_\xc6\xfcR^l{sY\xb1\xed\xcd%\xc5J\xf7\xc1̯҅\xfe\xdd\v\xbfd\xcd?n\xb9S\xc4:_I\x9a\\V\xa0\xbd]^\xa2\xbd\xbd\xb4H\xe9\x9cg\xe9\vE\x00\xe9\xc5\xd8\"\x98\x11\xde,\x8c\x01\x12g\x9fO4K\xdb̒\xf2\xb91\xdb\vw\x9aoQ\x86\x16\xcf7%q\xd8|>\x99\xb6\x90U\"\xb7\xf9\xac\xc7\xdam\xecBV\t;4\xbbtYf\x9a:\x8cȗC\xa0r<^^Le\xc0R\n)\xce\xf7L%.t\xcd\xcc|*\xcey\xb047F:\b\xccB\xa7\xae\xef\x17\xb2\xbb\xbe\x9f\xcb\x11qE\n\x98\x99db!_\f\x81\xe1\x172b*\xe6\xcc\xf0\xf4\xfc\xc0\xb2\xf4\x85\xb1\xe5\x91\xf7B\xb1ܷ\x92\xa2\x80\xab\x17\x8b@\xdaB\xd6\x13^\xd8\x04'|q\a,\xa0΅\"\xc5ϋ\x15\xe0\xae(\x94\x12*\xd6_!+\"υ\xac\x98V\x925E\xae\x8b\xf9\xd3\x0f\v\x852T;_&K/-R\xd2J\x9a\xbcP ÿ\xf3%\xb2\xf4\xf2\"&\xa6]R\xd2\xfc\\ZA)43>\x94\x16*\x85gƇ\xc5B\n\xf3.\x94P\xa9\v\xd9\x15֝ϭ\x12K3/lI\x95\x98\xcf<\xa77,\xb2\x91\xef\x0e!\x98$w}\x1d\x02/)K\xb6\x94\xb9\x0eo\xb4(\x13\x92\x82\rB\x8f\x16\xb2\xa38a!?\x84\xae\x1drB\xaf˫W\x02\xd4,\xbf\x12f\x12zz\xcf\x02\xb1\xac\xff\x84\xad\xf5\xde=l\xf6\xfa\x83A=\xe9\xf7 \xb8]\xa3\xdb\x1fA\x9c\xba~\xb3?\xaa\x93.I\xec\x9e\xf5ـ\xd8\xf0\xad[\xed\xaf\x93\u07bb~\x7f\x90\xf4\xfbM\xf2\xa8K\xfa\xeb\xa4?H\xec.\xc3\x12I\xbf\xd7\x1f\x90\xecg\xf2\x10\"\xdcM\xe8![\xeb\xf7\xed~\x9ft\xd7&\xf4}\x89{\xeeS\x9b\xd3\x16Ƞ\"\xfc\xd9hK\x87?\x0f\x95\xc38롅j\xf4\x19\xcb>\xb4-/\xc08\xe4\x15/\x80\xc1\xc5ް\"\x95\fh\x85_O\xd1\xe2\xa52\xf4\xc3\x18\x1c\n}\xff\xf0{˨1\xd25\x8a\x8f\xae1\x9c\xf2 \xabQR#\xbdT\x8e\x7fmszb:3Ee\xfc\x90\xdc\x06\xbd@\t\xaa\x06,\xea^\xdb!=\xa4փ\xb6E\x1cp\x85\v\xb1\x84\x829}R\xae\x80\xc8\xd0a\xf1\x99}I\x03\x19\xd5\xcezh\xd5\xed\x88]\x82\xc6N\xaf5\xa8[\x0f!,\x98}A\x03\"\v\xb9\xec\x02\xcc\x03\xc0%%c\xec\x1c\xe3rM\xec\x80\x18ڋn\xadV5G\xef\xd9V6j\vD2\x15~\xed\xc5\"\xa6\x95\xb3\x99H])]\xba\x9e\x0f\x9a\xf0\xcd\xca+\x1f\x8d\x7f \xb2v\xc5\r*^\x1c\xcfx5Ul\xbeu}ύ\x9d\x88\x06\xd2\xf5\xa9t\xc3\xe6\xce\xe7\x85\xf9η8\n\xb9l\a\x9b\xaeZd^*\x14\x85\xb9)q\a\x05\xca\xdcҐM\x89\x04\x97\x8c\x0e\xbaTP\xd8\xe6\x17Sq\xa3\xdcDɍ\xb2\x10(\xafm\x04{H[\xcc\xcf\xe0g\x16\xba\xb4{\xe1Ű\xe9J\f\x1f܊\xaa\xe13\xedT\x1e\xdc4Ҁ\x19\xfa\x1f\xa0\x8f\x88n\xf3\xd8\x13ح\xf5\x00\x96\x97\nPPq\xa5\x82ό\xb9ҙ\x9d\xd6Oq\x9b8\u06dd)\xee\x8ei\xaf5\xa0GvD\xaf\xec^\x8b\xb6\atJH&\xfb\x1d\xb36=\aC\xb2\xf1\xben\xb43\xae\xb3\xb6\xdc8#\x16\xf5\xc6\x03:a\xa7\xf6H\x9e\xc7K\xfc\xa9Σ\xfd\x99\xf5\x19cl\x92$\xd6g\x96\xfa\xf1\xbd\xfa!?]\xeaO\x97\xfa\xd3%\xa9\xd5&Ux\xe6\x96~\xaaDx\xb8\x1aq\xe5\xca\x13\xe7\x15\fj\x1a\xcb\xd9:w/y\xe5\x02Dip\xda\xe4\x17u\x84\rI!\x9c\xe1Q\xadv\x0en\x8f\x98\xf4\xa2zAcy@\x82:\xb3\x9aV}D\xf0|\x90\x19\xbb\x00\x85\x1b\xcd\b\x90\x9e4f\xcaC'\x1c\xf9\xcaL:++\x1e\v\bno@\x84q\x18\x95\x9c\x0fq\xce+\xe9\x90\x16\x0eKz,@\xbfϯ\xd5\xc6\xf5\xf6A\xba\xe6r\xe6o\x98o\xcf\xe8\x88tf\xcc>g\xd5\xea\r8\xe5\x9bpayA\xe5\x06\xdc\xe7\xab8ۮ\x8f\x0e\x051\x19d\x87\xa4\x8b\x0fg\xd6\x1b\r$\x97㜝aMt\xc6 \xb1\x03.\r\x86\xe0b\xa8\x17\x0f،\xcc\xe7\x99\\\x92\xa28r\xb5\x1c\xb9\x94\x85\x8a\bR\xad&\x9f4\x84\x9bqk\xb3\xbdGʎ\xeb\n\xc5=`Ԕ$\xeb\xeam+\x96\x98XV\xc0\xb6\xc6ah\x91\xb2o\xb2\x14\xacW\x04\x19B\x90\x11\x80\xf3>\xec٢\xbeGiO\xcb5,dͥζW\x99\x8d\xe7\xad\x05ʻ\x9b2vufiቑ\xdf\x04\xd3C\x12<\x16\x16܍\xa9\x1eE\xa7\xccќȷ\xa8ULd-\x03+\xb3\xdaYTIQv\x8b䷭!2j\x00\x90#\xe0`\xa1B\xf6\xe6:\xe5w\xd9O\x18j\xbc\xb5Z+k\r\x12lN\xf4\x81\xb9_-%V\xf9\xb9J\x97\xf8\x03(\xb6!O\xc1\x1d\xee\x01\xa0'm\xd4\x1e\x97œ\x04\"\x1f \x82av\xb6\xba0{\x1af<\x8b\x8fR+\xb3LU\xf5\x93F\x9a)Ehv\xe6\x1dٰ)has\x1d\xf4\x87\xe4ݒ$\xd5\x16\xbee\xd6oio2\xeb\x14\xa9Mu\xa7&\x8aD\x9b\x8d#\x17\x94\xe1\xa8\v\x93s\xee\xc6F\x87ɜn\xee\xec\xb6\xd6\xf1(\x1b*\xaeK\x82\x00\x00\x0f\x9eK\xc7g\xa0(\x8b:\xe5\f\r\xc4\xcau@\x94\xedЅ;\x05\xf8\x1dqw\xd4\b\x03\xff\xc6\"s'W/\x8a\x8fl\xb4\x9fa\v\xf5\xaf\xaa9\xe6\xa2X3\xd1c\x1eG\x9c\x7f@Oj\xab7\xa7vݒa\x98\x99\xa9.غf\x81\x985\xc0L\x12U\xa3\x17\x7f\x1e\x85\x1fx`\a$I\x84\x1d\x80)$\xe5se\xd2J\x03\xd0\xfa\xd3\x16\xff,P\xfe\xcb¼\x7f*m\x1c\x88H\xd6\xc8\x15.\xce\x04\xfc\x90\xd1\xfa@\xf9\x19\xdfd\xa2L\xf0\xe2\x17p\x8d?\x9b\x04!\x9aQ\xb7\xe7\x1e\xfe\xc4T\xed\xab\xaa\x98\xa95\x9f\x97*@\xf3Lm\xb3\xb66\xa1Vͽ\x98v,\x92\xa5\xeec\xaa/r\x89\a\x988\xc9'Z\x98\b\x98E.\xf93L\xfe\xbd\xeb\xf5\x9d\x8eeX\b{6\xa7\xcdfSHUCQ\x89\xca\xf4\xfa\x11\xe4\xc9\xef\x12\xf0\x91H٢df\xc8%k\xa8\x94\x95\xcb\v\xc22Es\xf9-\x06\x17F\xd5*o\xbe\xf7\x82\x91Z\"\x7f\x89\v\xb13d\x8d\x80\x163\xbeb\xe6W\x11\x1f{\xd7L\x98o\x14L\xe9\xfd\xf7\xca|\xd0\x1d\x8d R\btɨ\xa5\xce`\r\xe6@\xfb\xa8\xc86\x88(\xc5YT\x85\x8e\xcfEE0\xd53\x0e^\xa2|7\x98\xcc܉\f\xb4\xf4\xfd\x83\xdbb7\xe6\x0fn\xc5\xfc{\xb5k\xe2\xa9\x1b`\xf0\x1a?\x8cu\xf4\x9c\xdbX\xbaM\xcfu\xc4\xda_\x83\xbc\a\x16\x04\x14\xf6g\xbc\xa0\x90/\xb3ͱ\xba\xe2\x18\xbe߇\xe4\nv\x81Y\x0fn\xf9\xdc:\xf8^{b\x9b\xe5\xa6Q\x95\x8c\xc2\x10\xbdn\xb2[t\xf4\x1f\xf1\x00T\xfaU\x8f\x85;|\xcfz\xb9|\x83\xf9\x04\xa6!\x9c\x16z\x85y{\xd9\xcf\xd4(C\x16\x80\xe2\x85\x12\xbaF\xed\x96\x0f\x13E8m\xea\x8eh?g\xb9EQ;\x88\xdd\xc2*8\x9c\x9a\xbd\xee\xa4.㴵\x9c\xec\n֓\x9b\xf9T\xf73\xd7ك6Y\x18\x922\xae¢\x87\xbe\xaf\x82X(\xb3,\xb9\xdci\xa5\x1dҙ\x8b\x10\x98\xcd\xd9P\v\nֹ\x91ː/\x9bd\x8e\xbb\x93\xe7\xa7\xc7X\xab\xe6\xa9\xcc@s\xa5\xc9<F7\xba\x95\xf4\xab\xae`\x11k\xea\xf2\xa6\xde\xf6\x02\x1cH\xebYC\xe0\x96N/\xccZ6\xfb\xe9!\x16Lz,\xcc\xda\x01\xb0j\f\\ \x98ս\x19\x86>x\a\xc3\xd5Z\xa4_\xc1|=\xd7z\xda\x1e\xbf\xe4\xd1\r\xfa\x84Z\xe8?'\xa4\x9b\xe5d=\xa3\x94Tc\xb5\xd0\r\xe2b\xd7\xe1\x1e\x9d5\xcd.I\x17R\xf2@\f+\xfcZ\xf0`T<\x1ap.gS\x1e)\xd7_\xcdp\n0,f\xb8U\x9f\xf3\x9b\xab0R\xae\f,\xc0\x17\xb9>\xc0\xb9\x89T[Q\x81\x1aZ\xdc+98\xa4\xabI\xf7/\xc7\xcf'\x19\x84\x91\xcdi\xb8\xccq\x13t\"\x04EL\xd0\xc8\x04Fh\xfe\xa0+\x8a\xc8\\\x84\xe8L5\xe76I\x9aR\xe7FG\x9a\n\xd8\xcc\xc7Rq+\x83;\xb9\xabjj^U\xddť\xear\x877e\xf0bi\xdc!;=fk\xc8{\x03\xceZ\x7f\xa0xk\xc0c\xb3\xfb\xddn\xd2\xef۽vco\xd0k5\xf6\x06\x8f\b|]\xebȂ\xe7\xcc깍\x0f\x87\x8d\xef\x06\xfd\xfe\xd5#\x8b\x8e҄S\x95r\xc1\xac~\xff\xac\x0f<\xbd~\xbf\xd9G\x9e\x9eE'̲\x1b]b\xf7\xfbg\xad\xde\xf5\xaf\x06=\xb71>l|\x0e-\xd4\x13;_\xe0\x11\xe9&\xf2W\x9d\xd8=~4\xe85ꃮ\xac\x89X\xf4\x12\x1b\xb0[g\xbdV{P'\x16\xbda\xb7g|\xe2\x05\x8e\xd5\xef\xf7\xfb\xbd~?\xee\xf7O\x06\x16\x8d\xb8\xcf/\x01\x8brZsz\xc6nq\x9f\xc9(\x01j\xa2\xa8*\xf7\x99Ey0§\xe7\xfb|\xe2\xfaPW`\xd1a\x18\b\xd7\vb\xa7w3\x98ӫ\x15u|f}\x86u\xc0sU\x1dG\xba\xb3k\xfd3\xdbM\xdc \x11\xe7<q#\x9e<\xfb\xec\"\xf1\xe2\xe03\x91\x8cB\xf9\x97\xe3\xdb\x15\xbe\x9d\xcdD\xf2\xc3,\x16I|\x1e\xce\xfcQ2\x8d\xb8\x107I\f\x01\xebo\x12\x1e\x84\xb3\xc9y2\t\x83\xc0M&\xa1\x17L\x92+1N\xe20\x89g\xc3\xf3\xe4\xca\xf3\xfd\xe4&\x9c\xc1\xbf\b\x1a\xbcI|\xef=O.\u0088\x93\xfe\xd9ڜ^\x17<\xef\x02~\xa5vx\xc0<\xdb\x1c\xf70\xbc\x90\xb1\x86\xe488\x0e[\x18\xc3\x1c\xcc\xc1\xad\x87\xde\xe0M\xfdAB\xfe#B\x8bIf\xe5\xa3p(\xdcla\xec\xae\xf3\xe6\xf8\xe9q\xf2\xf9\xb3_\xbd8J^\x1e\xbf9J\x1e\xbf\xfd\"9~\xf5\xe6ًg\xdf\x1d%_\x1e>y\x9e\xfc\xeaW\xbf\"N~\xb9\x91\xbdyʮmkm͢\xd6\x03\v8\xcf\xf0\xd6\xef?\xb2\xa8\xd5\xef?Z\xb3\b=\x84\xa4\xdfS\xdf\xdf\xe7\x16W\xf9mP\xfd\xb8\xc8\xef\xa5'+\xb2N\xf2Y\x8fWd\xbd\xccg\xfdaU\a\xea\x96\xfd0\xe1\x17\t\xbfN\x86\xe7I\xc4/\x92˫\xe4\xf2<\xb9\xbc\xf0\x82\xe4\xf2½N\x86\x17\xc9\xc5E\xe2\x05\xc9T$\xd3a2\xbdNF|\x92L\"w\x94\xc0?X\x8c$N.\xe2\xe4\xcb\x0f\xc9\xfb/?$\xa3\xa9\x97\x8c\xa6Ëd4\x9d^\xc3\x11\xcdu\xe6Y\xbaM\xed.\xeb\xaf\xf5ޭ\xf5\x83\xc1\xa3\xfe\x1aY3\x16\xda\xeco\xc4'\x1c\xe4ozs\xaf\xad\xe1\xbeX\xeb\xaf\xf5&\xde\xc5\xecf\xf0h-=\x17k\xfd\xc0\xac憦G\xa2\xa7K\r\xd6\xcc\xfe\xe4\xcf\x10\xfcG_\xe6fKE\xf7\x94՜\xe7\x87\xf2fy\xceQ>\xe7+\x03\x904\xfb\xfd\xf8Q\x01\xb4\x99\x99\x91\xd6|\xcd\xf2\xf4\xd4m\xaa7/]\x19\xbe8|\xf3\xe4\xcbӗ\xc7o\xbe|\xf6\xf2\x8b\xd3\xd7Gp\xe8\xfb\x8f\xd7賧\x10K\xf7\xf5\x91sN߾|z\xf4\xfa\xe4\xc9\xf1\xeb\xa3\xd34uD_\xbe}\xf1\xf8\xe85\xfc\xbe\xa0ON\xb3\xb7\t}\xfc\xec\xe5\xe1\xeb_\x1bI\x97\xf4\xf5\xd1\xe9ɛ\xc3\xd7o\x8e^\x9f@\x82UM\xaa,\xa92\x96<L\x1e\xb2\xa4\x96\xd4jI\x8d%\xfd\xfe#\xf8\a?\xea\xf0\x8f%4i$\r\x96\xac\xb1d-q\x92N\xb2\xbf\x9f\xec\xef\xb3\x04\xfeO\x18c\t\xfc\x9f\x1c\x1c\x1c\xc0\x1f\x96\xe0\xe3 \x81\xff\x93~\x1f t/\xe9\xf7o\xe1\xb6H\xfa\xfdw\xf0\x0f\xeaN\xe0\x1f\xfe\x80\xdf\xff\x1f\x8b\x9e|y\xf4\xf8\xf0\xe5\x17\x8e\r<&\xa0\xd85Ҹ\xf6\xee\xf7\xaa\xbd\nl\xaa\xb5,\x9e\xe9\x99\x17\xb8\xd1\r\xe2\"\xb8\x14\x19Xj6\x9b&Y\xa6]V\xc2\rHH\x8ay\xccmAך\x8f\xfagkTWF\xd7\xfag\xcdGk\x84\xd0<\x1c\xbb\xe0\xc2\xd5\x1bA\xc8m\xf7 \xbf\xeb\xac0p\xf0\xbb\xe5h\a\x9c\xc0\xa3P\xf1m \xb6k\x8e\xb2\x9c\xcf)'s\xfa\xf8\xf0\xc9\xf3\x93\xaf\x0fO\xbe<=:yr\xf8\xeaȹ\xa1\x87\xaf\x8eONO\u07bc\x86]\xf0\xe2\xf8\xe9\x91sF\x7f\xf9\xf6\xf8\xcdQ.튾\xfa\xf2\xf5\xe1\xc9\xe1ק\xdf\x1e\xbf~z\"\x13\x8f\xa8\x8aN\xe8\\\xd3'\xa7_?{y\xa4\xc3\x15\xca\xef\xa7\xf4\xc9\xe9㯏\x9f<\xcf'\x9f\xd0/\xa1\x03\xb9\xb4C\xbd\xab\xf0\xed}\xb6\xaf\xf0\xfdIaga\xe21}rr\x92K\xf9\x81\xca`\xde\xf2\xed\x19}\xf3\xec\xcd\xd7G\xf2她\x97\x8d\xf47\xf4\xc5ћ/\x8f\x9f\x9e~\xf1\xf6\xf0\xf5S\xe7\x15=z\xf9\xf4\xf4\xe4\xf0\xc5\xd1\xe9\xe1\xc9\xe9\xe3\xa3/\x9e\xbd\\\xe1\xeaҍco\x02w\xd2m\xc9b\b\xa4\xfa\x9b\xa7\x98\x8c\x8b\xc0x\xaf=\x98\xe3\xc2\xf1`\xb4*'\xacc\xaf=(YD\xf8?3\xed}\xa1p\xcb&z\xa0iz\xc1t&zj\a4\xcaʧ%\x9fʒ\x02\x90llW!\xab\xb1\xb1\xbb\x11\x93\xb1\xea\x85\f(\x8d\x15\xb6U\xb1\xf4\xc6N\xd02\fġ\xfd&\x01\x88\xdc?K\xfa\xfd\x98X\xe0\xaf\xf0TƁy\x8c5\xbe\xa0\xbc\xf9^\xd5ò\x9fIRhC\ag.&\x1b\f\x97\xf4(`\x87\xd37\xd6\"\xc6(\xbf\x96\xa3\x94\xf2d/֎\xd3\x14\xa8'\xb5Z\xf6R~\x96-\x1c\x7f\xe9q\x96\xa3\xb6\xe6\x98?\xad\xd2h\xfcq*\x9b\x83\x1a\xc4\xf0\\\xfd\xc6!\xc1\x90y0\"\v\x8c1\xfcZ\xa9Ux0\xaa\xb8\x11G\xd9I\xacòH\xc9\x10V\x06ڡj\x9dT\xf5٤᫁\x88\x7f\xae$\xa8w\xcf^\x9b($\xfc\x03\xebY\xe1\x18\xc3V\x8f\xa4\x06\xa4E-/\xb0\xa8\x15\x84¢\x96|\x1fk\xd5\x1eT\xaaB\xbc\v\xddsj\xb5\"k\x90\xedշ\nw\xb3Ԫ[\x19\nw\xab\xbd4\x97\xd0\b\xa1\x1dQn\xec9\xe2\x14V\x93\xc8,\xc4)\xb0\xc7K\xb9\x83\xf9\x83\x1bз6GGC4\"\xc8\x02\f:\x86#5\x0e\xae\xff\x04\xea\xb9F\xe9\x1e\xe0M\x11~\x1d^i+b p\xa3\x92\xb6\f\x12L\xe8\xde'\xa0\x8d\xdb\x03\x91\xe6\x80\xf58\xfdц\x9f4\xea\xb5\ad\x80\xae\xd8Ҷ\x7f\xccY\x0f\x8b\xae\xd4#\x03\x82\xbc\x04\x18}hz\xc1П\x01\xb7\xa1ع9LO\xcbig5\x7f\x03\xd0j\xea\xcf&\x80\xaf\x88\xb9\xe1\xff6\xb2MG\xc5\xe8\xf7\v\xb5\x96\xec)\x10\xa9օU\a\x12܍\xf9\xa9\x17\xc4<\x88=\xe1]\xf2\xae\xe5\xa1稺\x1du\xad\t\xfe$\x8ad\x0e\xcaxH\xb83\x9f\x01x\xe21Ju\xce\xd1\xfbƄ_\x1b\x0e\xfe1ӡ`*\x1e\xc14\x84\xb6\u0080\xb5\x80\xd2}=\xf3\x15\x89+\xb2/\xb9|\xf5:]h\xabgV<`\"װD\xf4{\x82j\x17+:c\xbd\xccI\x8713܈mP\x87\xe5m\xf2k>\x84\x9b>\xe5i\xc1\n\xd4\xdbs0\x92\xf6|\xa0\x93\xe1\xfc\xe5\x1aO\x1d\xf0`*T\xc0l\xc2\x0e$\vU\xee!\x9e/\x91nE\xd89\xa4\x93\xf5\x98G\xaf9\x8br\n\x17\f\xbau\xebs!}\xbc\x17\x11\x94ۨ\xce\xda\x1d\x8d\xf4Dȸ\f\xd8TF1\xb1,\xe9\xdd=H\xe5\xf1\x1d\xbd\xab9\x1b˱\x062F\x12'\xb7a\x9d\x05\xcau\x11\xfc\x04>\x83<\xcdv\x8b\xaa+\t\x84\xfb\xe6\a\x95\\\xe7\xbd\xd6 \xb5\\\xb6\xfa}\xbc\xcdz\xadA\xaf5\xa8\xd5`\x94ݰ\x0e\xb7Q\xea\xa4U\x9d\a\x9c\x80\xba \x8e\x1dֱ\x00\xb5l]\xb6V\x03\xd7mY\x9c(B\xd2A\x7fo\x03\xbb\x93|\xafA\xb9\xc0s\x82qGq.}7\x16\xb8oXk\x8e\x83\xe4\xe46?\xc9F\x96|\x89t*\v\xf9U5\x1d)Q\xd7;i\xe6\xfb\x9d\fJ\x8c\xbd`\x84\x95\xa4\x1e\xd5\x058}K\xbd\xd8p\x80Olqk\x1b^=\x95\xa6\x94ݢ\x11\xa1yX'h\x90r\xb3\xc2R\xf6\xee\xcc7\xcf\xe0\xcc\x17\xde\xeb\xc2\xc1\x1c\x1a\xcey\xccY2N\x93\x9e7\xf4ч\xa3W\xfc\xf1\x85Z\x8d\b5e\xdfҙ\x84\xf3\x16\xe4\xbd\x0eAOU \x05\x13\xd0\xdb=N\xa3\x01\xcc[3\x03\x14\x11@h\xd1L\x0f -k\f\x00\xc2<\xe2\xf1\xec\xc2\v&'C78\x14'\xee\x05\x7f\xa5 J\xc6\x11K=Af\x83\xc5\v\xd3\x1b\xf1\xe8\xd0\xf7ӹ4\xa7\"\x0f\xb4\xb2\x01H\xa8\xc3)\xf8Y\x96\xd7>\xec]є\x01\x98\xb2\t\xaf\xd7\xd3]\x98\xdb]\xc6\x14\x17\x1a%\x1d\xb1|\x87JX \xcc-\xa9\x8a/\x1f>\xba1\xab\xd5\"y^\x19+ԩ\xbc\xfb-\xeb]kU\x7f\xeamj\xf4F\x9fרV+\x0e\xaa\u03a2\fķ\x17\xb6\x1ccٌ\xa5\xb3g,\fȍ\xe6\x88\x7f\xa9\xad\x10\x1d\x01G7\x06\x86f\x92\x94&C\x1c$\xe0^+\xf2\xbeV\xcb~gW\xae\x15s\x7fl\x91ED\xee\xe8\xf5k\xa7\xa2\xf3W\xbe\x87l\xdfke\x98\f\xa1s\xa5\xb6\x8c\b\xa7\r\x9f_r\x1f\x1ci\xba\x15͠mV*'\x9cWt|y4\x905\xfce\xf2fJ1\x1e\x82\xce\x13\x8f\x99g/&&\t\xc8#\r!q\x00\x11\r\xb5\x10\r}\xa8\x05M/~\"' \xf5\x0f\x1dwz\x8f\a9\x069ǒ\x92\xa5\xbf0[\xe59\x83\x02\x05 =\xa0<\xa5_\xd3\xcf\a\xcbJd]\x01\xd5,د\xd2iA'\x17\x93P\xcbtS*\xa2V\xb3}\x96\xbd6\x1fL]!x\x14h\xbc\xb8\xe4\x13\xa1Y\"\xa8\x8b\xa5/\xec\xad\xf1B\x17\xf1\x1e\xec\xa7ϯ9\xeaQ\xf8KV\xff\x15z\x81\xaa|\xbf\xd0\xee\xf7\x15\x11V\xbe\xbf\bG\\W\xf2=\xad<>~\xf3e\x8a\xf2\xabp\xb0͊\x1ds^\x81\x9c\x95ԥ\x14\xa9d[\xc0g>x<Q\xb5$\xc9Z\xff\xaa\xbeFc\xdd\xf7W\xb2=D\v|\xbc\xe1\\\x1c\xa7\xa2A\xf4/\xb6\xd6\x7f\x9c\xf4\xcf\xd6\b\x8de\x02\x16P\x1fa\xa8<\x18\x01D8\x8cq\x15\xb1\n\x1e\x8cX>\at\x04d$\xdfz\xe2\xfc\x15\x92\x03I\xa22\xa6\xf5\xe3k\xadf\xc7\xf0T\xad\x00\x1d\x04-\v\x1e]x\x81+\xc2\xe8(\x18\xb1\xa9\xfa\x92$\x96E\x8b\x15\xd7jn>;V\x99K\xa93YA\xd7J$\x8aZ(!\xb7\x9a$۰\xb4\xfa\xad:\x95\x12t\x06G\x18\x87\xa3_\x10:\xe4^\xb5Öf\xb3\x99\xa5K\xac\xa3\xccٛ\x91ě\x97n\xe4\xb9\xe8Z\xb8\n\xbbmx\xceGߤIv1\x89e\x05\n\xf5gT\x03\xc8\xe8ou.)_\xa1\x02\x85Z\x1do\xbcP#\xc9@J.\x1d\xf2>\xc8$۲\xd6X\xb8\x91\x88Ax\x83?\xba\x9e\xad\x7f*/]\xd8DQ\xd5\"WI\x06\xc3\xe6\n\x84\x02\xde\xd6\r\x1c)w3\xd9\xf0\xa5*\x02\xc2\xe64\x96$\x9bj\xbaV\x13\xb6\xfeM]B\x15\x96\x94sd\x9aݠ\xa8xi\xc0х\xc6x\x0e\x8d\x90\xfb\x9c\xde\xc2ͭ=̫;{.\x01ba7\x9aes\x9f\xe8\xad,\f\x1c \f\xf4\x9enA\xb3\x88Jԙ\xd5+\x14\x10s;&4\x06\x8c5\xa3\xea\x1e\x18\x11\uea92\xa6/\x1e\xc4\a\xd9\x1a\x19\x05\xbf\xcd\t\xcaA\xff*vz\x96\xbe~,j\r1\xfc\xae\xe5\xceD(#'X\x03\x8a\xf1\xb9\x174\x1aoud\x85\xafUi\xd0\u06dd\x05\xef\x83\xf0*H\x93\xaa\xed\xf9\x9c\xc2\xdd1\x13|\xe4d\fς\xc0\xbfP\xackY\x8eu\xee\xff\x10\xeb@FŦ\xe6\x18\x10\xc8\xf7&炏̀\a\xd0律\x19\x0fք\v]D\x05\xb6So\xe9\xd6\xcc\x05T\xf8\x1e\xbc]\xeb,\x15Kim脹U\xb9\tg\x95xʇ\xde\xd8\x03\xfdz\x90}UT\xe8\xd7q8\vFM\xad\xd1Q\x18\x10\b^][!)#N\x94\xc2H\xca\a\xa9\x14:\xdfE\xcfc\xe9\x18\x0fg\"4J\xd3\xd29a\"\x1b\x9d\x93/\x9f\x1f;Mk\x92\xbf$\xc7\xf0\x99\xdcr\xf1\xd2\xdas\xd3\a\x1eЀ\xe13\xa7Y\xa73\xa1p./ [,\x1d\x9f\xac\x97*\xc3P\x1b\x02\xd5\"$P\x01\xbc\xf9\x9c\xe6\xbb\xe3\x00m\x8ca<y0\x92ą>ĠF\xcd-z;\xa7=n\xab\x8d+w\x98\x93i\xdb\xc0f\xa3\xa3\xf0\xe2\x15n\xf5\xdb4j\xaa\x93F#T\xbbh>'\x03\x92\x86\x0f\xbbM\x1d\xb58\x82~3㯐\x83\x02\xe5c\x81\xfa\x89\x00Ç:\x8fm\xa5\x15\xfd\x10[\x00u\xe7s\xc5W\xfb\x92\xdd\xca`\xd4N\x9aE\x85\xf9\xb7\x1c\xfb\x96\xfb\x0e\xa7\x80\x88\xfbЎ\xe0\xd7\u0089\f\xb1D\xc0\x9ek\"2ȫf*j)d\x1aUT\x9aX\xaan\x88\x01}\tF\xdbY\x98X\xa6VL\xaf\xdcBpS\xc9\nhI>\x80\xac\x1e\x1cF\x9a\xe1{̐\x96:\xa0\x99\xfa\xd5E\x9a>\x1c\x8fc.\x80f\xca\xde\xcc/\xfb\xb9tG\x80\xdcڍ0\xe24~Aס\xf8\x81\xa7\xf5:\xa2\x10\xba\xa7L\xfb\x11\x02\xba\xf04\xd0q\xfd3f}Vwm.\x87J\xea\x9fY\x9f\x01\x93\xc2ڷ\xea_\x01\x83\xa67\x80kT\xa9\x9a\x1aq\xfc\xa9Ȅ7u\xeb\xc0\xca\x05\xf8A\x96\x87\xb5\xbf\xa6\xea\xc8}Fj\xcd\xceF\xa3ܧv}gFl\xd912G\xe6\nO\x95d\xd3\x00\x1er\xee\x05\x8bm\\\xeb\x10\x14\xce\"\x83m\x12Pc\xda$S {\xa7\x02\x8fϭ\u05cc@M\x06\u0600\xe9\xa56#\x9dQx;\xb4\r>A\x9b\xa0\xc7gll~u\x0e$2V\x90\xadd\xadfԎ\xf1N:eu\xfbҭ@\xe9\x02\xaa\xf8>\x98\x82Cw<\xa9,EK\xfa\x92\xb2l\xea٨\xed\x00x\x99\x01}n\x87\x04\xe2X\x19\x01¾\xca\xe9C\xea\x15\xcf\xf3A\xb3uyn\xdew\x99\xa5R\xfa\x9d\xab\xa0`ad\xc3\x1a\x84,2\x839\x87\x1d\x88\xb1\x1e\xf0kq\xe2\x9d\xf9^0\xc1\xe8\xd3a\x1a}\xba\x1b\xd4\xd5\x1b\x9a%4ud\xba\\&\xe9\xb3\x11U\vpv\xf4\x9e\xa7r\x86\x9d\x80BN'\x045\x01\xc6\xed\x90\x06\x84~e\x87D\xa23\xf6\xdaY\x94\x9cG\x89w1IPܳ\x06\n\xac\xc5\xfa\xc2iIu\x99\xd6\xc3\x1c\xec\xcb\x00\x95\x903\xf1\x05pb\xbfÀ\x90\xfa\xfa\xc3P\x01\x80b\xd0_3\xad\xeb\xc9\x0er\x8e%\xbf\xff\xf6\xf0\xf5K\xa7\x02\xac\xb4\xefe\x869\xfd%\xd32\xad/z\xdf×5Tk\x1c\x80E\x8aY\xf4)\x9fF|\xe8\"\x01\x1c\x03\xc9\vy\x9b\x15\xa5\x03\x99/\xcbЍ\xf2\xaf\x98K9g\x1e\x15<5\x04\bB-\tA\xbd]^\x8a\xe8\x959\x9c\x0eJS]\xd8\x10\xb0\xea\x1e\x90\x9d\x9a<^\xb3\xdf\xd9\xfb\xbdw\a\x83\xfaA\xd2\x17\t\xa9'\xfd\x80\xacM.\xa8Ϭ'\xe9\x85\x0f\xbc;$\xe4Sl\xe1\xb3\xdb\xf9g\xb42\xf2F\x88$\x8c\xc3H*CV\xfc\xd0\x1d\xad)\xee\x81A\xe9\x03\x897\xf3yע3v;\xf2bP1?L\xefG\b\xb5\x82\xb6c\xd6+\xdf\xf5\x82\n\xdc\fVN\xa9\x05;>e\xb7A\xf8\xa5\xbeW^sg\xed\x9d\x1d\x84\x8dnzՐ\ak\x1e\xd5M\xca[\x1ar\xf5Q#\xcc\xee:\x90L\xba\r\xbb\u05ffj\f\xea\xa0|\xe3QCIU\"a\r\x8b\n\xf7\xec\xb5T\x11\x96\xaa\x04\xb3\x98?~\r\x11\x99tݒࠧ\xa7*\xee\x8d34\x8e\xec\xd88\xb2\xd3f\xae\xc7\xca?p>\x18\x91\x8e\xe1#O$Z\x83YVg\x81\x13 \xbav\xc88r\x93\xf28\x03u\r\\\x88\x06* \x1dq\xec_\xdaV\xbb\xd5\xdci\xb6,\x9a\xdd\xd86\xe4\xa4\x15ć*\xcdfӍ&1\xa9\x9c\xbbq\xe5\x8c\xf3\xa02J\xb7n\xd3\"ԬA\xd9\x00\x82\xca\x7fV\x99\xacF+Ρ\xea<wG\xcd~p.\xc44v\xd6\xd6&\x9e8\x9f\x9d\x01\xee\xb0f \r\xd9\xef\xe6\x0f\xf1\x1a\x9a\x13\xc6k\xeb\xeb;;\x16\xecSNC&Hz)\xdf\x0e\U000449d3\xef\xb8\xf3\xce{ےl\x97\fϰ\xa8G\xd2m\xed5%\x9a\xd1\xd5?\x9c\x91\xede\x93\xe4I|\x10\xa6]C\r\x89\"2\xf5\xe5\xbd]Dc,\x8atI.\x84SD\x03\x1a\x93\xdb|X\xbcL2v\xb4(O\xc2;\"\a\xbc\x1dHʻN]j\x15\xa1o\xf0\x94s\x13\x91Z-{\xedE\x83|`4i\xf7v\b\xb7LJk\x14\f\xa0\xe0*;6\xf5\xbayƋ*J+s\x15)*\xa4\x97KLY\u07b8\a\x9f\xa4j\x9fǤ\xc3\xd9\xc8\xce\xe5\xa5Ǵڢ\xef\x8b\x15,\xa40\xb8\xed\xa6\xd2܍\xb3\v\xfb\x98\xe6\xbek\xc4)\x97\xe8H\xf1\xd2a&\xfa\xc5\xf82?\xd4M\xd90\xa1O\x9aE\xddR\x1d\xb0\x8e\xf2\f\xf9\x9f\x9b\xa1(\xe4\xb0\x0f\xd39_>`9\x99\xad\xce\xe1\x02\xb3ʔ-(Ҩ$\x13\xb2\x8c\x8f\xc1\x10L\v\xaaD\x87\x80@\xeb\xd8\x141Q\xa1dO\x1d\x8dC\xcf\xecCe\xe2\x13\xa8\xad\xd8\xe34\x1cHFh\xd6\xc3H\xd6L\x7f\xa8\xb3\x90j\xda\x19(j\xdb:\xb5\b8/Ǎip\xbeqC\x17x\xaf\x18\xba\x9fw\xb0Z\xad\x0f,P\xecK\xe6r\xc9Ҋت\x89\xa0\xab\xa6`n\f\xda\xd6˦\x061\xb7\t=f\x965/\x05\xbf\x06\xb3\xb8V{\x92\xa9%\x97\r$K\x82!eo\xa0\xaa\x98\xbfOAČl\a\xe7V\x9a:\x1fBp\xad\xc3\x05\xa0\xae\x89\x8c\xbc1\xb3\x9eLT\x00\xc7\x11\x8a\x14\bE\xb5\x9a\f\xe0\x81k:\x97|\x8e\xd7 \xe5Q\xeb\t\xac\xad\x9eְ\x19d\x95IC[N:\xc6W\x14xӨ`n\x83f\xc3\x10\x80AV\xa8\x90t`\xa7h\xd6#o\xca\xd1\xc9\xe0\xeb\xeaw\xcaΒB\x86<\xfbE\x1f\x82s[\xe7\xc7\xe1g\x1321\xd6\x04\x06x\xa8\x99W\x86\x88\xa3k\x1f+)g\x9b8\xf6\x1b\f\xc1hTqib=\x98\r\xb5\xbfg>\\yr\xf8\x18\xe5\xb3\x17\xe5Y\xf24\xeae\xdaK\x83\x81a\xb5\x13\xa10B\xc9~\xec\b\xe3`Ӡ0])\xe7d\x92_\xa6h\x91g\x1c)\x9e\xaf!A\x17\x99\xa9Q\xaf\xb1\xd6\xef\xbf{\xf0\xa8\xdem\xda$\xe9\xf5\a\xb7\xf3\x01X\x1f\xf5\xfb\x0fj\x16* \xa0\xaaE\xfcޛv\x8f\xebL8P\xdf5\xe2Q\xba\x01H\x06\x9a\x02\xf2ɞ<\x96|\xee|\xce$\xb1\x8f\x19\x10Nc;*d\xed\xb6\x1cM\xf7d3{\xb30\xb3`\xa3\xae\x8f\x9b\x16mC܀C\ns\xd41#zU\x04W\x90\xc7e\x87\x1d\xd7쿫Z>\x02ֹ\xab\xbb\x88o\xc6P\xcc\x0f\xb5\x9a\xec9\x90q\x87\xf9\xa3k\x18\f\x00\xfc\x7f\xefM\x93$\a\xf2\x93\x04\xc0\xfb\xa1\t\xde\x0f١ڎ\x8a\xf4;D#G\x99d\x04\xca\xd4\fV;\\\\T\xfdU\xadm(\x9f0\xb7a\xcao\xa4\xc6H\xcd\x19\x86\xd3\x7f\x06\\\xaft\xaa\xaf08᭞\xb0\xb0V\v\x016\x027\xb9\xce\x04\x95\x01\xdaݔYg\x13ڂ\x8f\x99\xf8\xf4L\x89O\x91\xbf\x8at\x97|?KE\x97\xa1V\x90ī\xddE\x90q\f\xc2E)PV\x9f\xa9z\xd6ۄV\xbd<\xdfX\x89xZ\x95+o\xa4ձ*xN-\x1dA-\xc37el\x12*\x9ag.2wٙ<\x93Bӹm\x00\x18g,4d\xc0\xb2\xd3\xe9\xcd\tQw`\x90\x9a\vl\f\xab\x1ad\x1a\x19Y\xd7>S\x98oE\x8a\x85*\xc0\xfc\xa8\x7ff\xa1\xb5>\x8a\x92\xac\xcf궱\x7f\x92\xc4ڟ\x05@`\x8c\x0e,\xe4\x8e\xe8q\xf0&\xe4g\x87\x94C/\U000d36b5|\xa3zȁӓ\xf6\x9bϗtZM\xbc\x19\x1c\xf4\xe5A\x9bo\xd5j/\x0f6\x1e\xa9\x89/\xb1\x19\x9d\x86\x82\a\xc2s\xfd\x8a\x17\x8c\xd1sn\xc5\x0f\xc3)\xad\\\xb97\x15\xb0\x16\xa8H\xfbq@\xb5+\xe2\xdc\r\xe4\xd2\xf0\xd8J\x99\n\xc7u\fp\xad\xf6\x9f\xec\xff\x118Y\x91\x87\xf6H\t\xf2\xbe\xb3\xfd\x140Y\xb7s\x8br\x15\x14F\xcd\xef[\xc9\xceM\xa97\af\x94ˉ\x93\x95^\xb3o\xec\xa3L\xd3ʝK|\xe7\x040\x8aC\x16'\x89V\x15y\x0f\xc4\xf7\x13\\\xbci3\xa5\x97\xec)\xe9T\v\x11\x1d`\xa6{\x12>+\x9c\xa8\x03\xac\xb5\xa3\x8e`B\x9fYa\x02\x05\x9e\xc6X6\x92Am\xd0\x14r\x18\x97>\a\x83'[v\xf4\x18Q\x1f֢\xcfX\x8b\xbed-\xfa\x86U\xdb\x1dp\xf9\x0e\xedg\xd7SN\xc6\xde\xe9\x90ۗ\xf5:}Ӆ\xdcΒ\\4K\xcf\xf0\xbcg\xa9\xaaS\xf6\x15\xef\xfeH+\x18\xa9\x80\x1f\xea\x1c^\xe58b\xcf2\r#N:ϴ\x12t==dYv\xfb\x19!T\x81\xcb\xccJ\x8f>if\xb6L\xf4\x84=ij[(z\x9b)]cȅ\xb1\x1f\x86\x91\xfd\x03Q^\\N2\xb2\x8b\xa76\x04\xd56Մ\xef\x13*\xc2) @\xd2\x13\xbeT\b\x15:\xf2b\xad\x96\xfe4T\n\xd4\xf1\xb5\xf4\xddz\x9b\xd6\xdb\xd2M<\xbeqn/≓\x16\xa7*d\xa3\xa3\x01ٳF\xbbբ\xcf\xea\xedV\x8bP8Ő\x17\x8c(i\x1c\x8e\xdd\xc89\xc9i\x93\xcb\xc1\xfc\n.Ĵ\xe7s\x98yo\xa1\x0f\xed;\v\x9aS\x82\xa3\xa7\xc8Oz\xedz1\x1f9b\xae\x81\xe4<\x1f\xaa\x17\xb4\x0fY\x94$\xd3\x14x\xc6Ibju\x8a\f\x89/c\xf5ܚ\xfdҝY<Xi\x9791\x17\f::\xcb\x040\x9a\xd0\xc9Y桞\x98d\n\xfaP\xd9)ѿ\x0e\x95z\x99`\a#[\xc8\x10^\x04\x18\xee\xfa\b\x06$E\x03\xc2f\x1cF\"\xd5\xf2\x92Z\xc1i\xdf\x01\x86fo)\x16\x91%5\x8c\xccR\x94\xab\xa7\v\x99\xb6&\xf9yj|$M\xb4T\x8c\xb98\x1e\xa3\x9eQ\xfa\xc1\x04ħ\xb6XV\x82\x17K4\xdaY\xe4fBhϣ\xf1\x80\xb9\xd4g\x9e\x9eE\xbf\x19\xf3a\x18\x8cN\xcfx,XL\xb5\x89߄\xdd.\xb0'\nb\x10\x14{L\x9b\xc8Y\x92zک\xe4\xc2\xf8\x9d\xa1\x8f\xfd\x00\x90Ŝ\xf1\xfbYԫ\xac\r\x1e\xa1\xbd{?\xb00\xd6\xcerًb\x83,6,\xc5#\xeaYlp\xff,:\xb0г\xca%[39\x85\xa4\x0eL\xc2\x1bv{\xff&3\xaeڊv/\xa9\xd4B\xce\xfa!\xd6&\xd4,,=\xbdd8\xd5\x19\x9c\x11yc\xe44\x0e\xcd#$?\x1bD\x1d\x84\xc4\xef\x88zJ\xdf\x00\x9c\xec\x9a/M\xc3X\xc5J\xeb\x9c6\x8b\xdcŔ\x82\x032\";\xab\xa76\xea=\xa7\xc7-I\xec_\x17/]\xccA\xe8\xafm\xebs\xd7\av{\xe5\xcc\x1d\xbe\a&j\x106\xd2\xf9\x94\x18\r\xa06\x18\xa1\xe7\xcc\x0f\x87\xef\x9b\xf2\xc6\x16]\xa8±\xcc\xec\xd6<\xa7,)\xec\xb5~\\_#\xa8}\x89\x17\"\x90\xc7Ir\xaanC\x89\x18\x8c3W.e\x9c5\xbd\xa4T\n\xf0R\xe8\x17\x81\xbc\x9e\xf1T8'\x9a\x00\xa1\x9f\x00\xa0\x0e\x04\x04\f\xed\x9e\xdb!\xbd\xcd\xf2\x17\x85\x9d\xd5֜8\x17\x80]-\xf2\xdb\n\x8d\xaa\xbd\xe4J\xa9a(U\v\xd2#\xa3}d\x15\x85|iϺ\x10\xb5މ:j]!L!Z\x05#\xc7\xd7\"4\xacՊ\x9fB\x98\x1e\x1a\x01:\x95\x8a\x81\xb9\xe2&\xb2lP\xd9g\x1a\xc1[\n\xbb\xe0\x1aq\xf1\x97\x99\n\x01\t\r\xa0\x81'\xc1x\xcfUl\xa4\x17\x1a1\xbf\x947X\x9ac\xae\xcd\x1f\xaeP\a\x1b@\xe8US\x06\xbb\xd2˯߁\xfc\x96\xdc\xdfm\xe4\xfe\x02>\x9a2\xb2Q#\x1c\x94\xfd\fnq\xa5\xf2\xd6\xe4\f#*\x94\xf2\x83-\xd2I%\xb7?\xcexts\xc2}>\x14!bL \xd7F~\xb4\x95\x89\xdbΈd\xf9\x1f\x01Z\x96\x1e\xf5kŴ\x04!\x83\xe4Lf\x02ሻ\xa3\x9b\x13\xe1\nn\xb2\xe4\xec#\x90\xaf||\xe3i\x93\xa7&7\x89I\x01>\xc9sp\xa9@.\x98\xe8A\x88Y\x83\x03{\x02\x8c\"\x93NB\x83\x84E\x1b\x10\xd8\x03\f\xe3\x9f\xe6Q\xd7۠W\x10\xf4\r\x98\x98\x9b\xea-\x87&zpjh\x1d\x81:\x88h.\xc8Y\xb2\x92\xef\v\xbc\xa8\x8e[\xae\x87\x04\xe6#\xa0\xa3\x1e\rlT\xae\x9a\x97y\b\xbb\xf2\x82QxU\xab\xc9\xe7B\xfc\xfe\xa5\x1fl\xeb\xe9\xf1\v\x05.\xbe\x0e\xdd\x11\x1fY\x86;Er{T\xab]\xdb\xe08\xa7\xda.\xaa{sz\x9bn5\xe7\x9c\xe6TG\x9c\vjnC皎\xbd\xeb\x17n\xf4~6-\xb3/\x91\xbb|\x1dwy\x9a\xb1\x02V۠\xe7\"C\xa7\x8e*<\x10^\xc4\xfd\x9b\x8a\x17T.\xdb\xedf+\x95\x8e\xac\x9bґ\x98\xf3ʧ\x88>\xb666-\x04\xa6\xb9\xab.I\xd4E\xdd\xcd\x18I1^\x90p\xe1\xa3F\x99\xce oj\x87;f\xf9\xee\xca{\xd4\xe1\x848B\xc6\u009b\xd3\"\xe0uβ\xa4\xc7p\xe9,\x9f\xbb\x82\x84\ts\xdf1\x81\xeb\xc6\x04.\x17/\xa9\xaeT\x82\xf0\xaa\x89^/Ab;\f\x83\xb17\x99E\xdcɫ\x18j\x8cFV\xba\x81\x95~\x86\x89\x9f}\xc4jn\xfcNVsk\x0f\xb8ySƹ=E\xbb\xd4\"\xf8t\xae\x16\x92\x8e\x038\x03\xa6\xa4a\x15\xfc\x95\xb9?\x16\n\xd3#&\x95\x8b&p\x04\xa3T\\\x926j\b\"\x11\x99\x02\x8a8d0\xc3Y\xa83ol\x7fg[\xbal\x05\xc1\x81'Y\xefa\x84\x82Ἢ\x98n\rD\x8a\x05\f\x88 \xf7I\xf1b:\xdfI\xf2c6\x0f\xd1\xf7e\x92\xd8\xf2\a\x8b\x00\xc6F\x03\x8cQ\x1b\xb9WO\xd3\x16AC\x17\x10\x1b\xe8+\x85\xc2\xd29&(-\x9f\xd8\xe9K\x01\nGs\b0\x18,\x9f\x04Nn\x95N5@\xf6\x9c\x9f\xa7\xbc\xdf:; \x04\x10\v\xa9Q\x92\xc6d\x17\x839\xf5\xbd8\xd5ŋ\x17\xb5\t+y\xbaoN\r\xd5=\xe74] %\x9c@b\xf6Ǚ\x17\xf1\xd2\xce\xca}\xb2\x89\xfb\xa4\x90\xefΝ\x9fn\xfc\xcd\xdff\xe3Og\xbe\xbf\xb6\xbe\xbb\xb9i\x91N\xee>\x02f@J\xe3u\x16X^\xa0\x80\x88\x1bF/\x90t\x9a\x86C\x18Ig\x9b\xb0\x89|\xbc\x1c\x8a\xbb\x87\x03œ\xe9\xe3ya\xe0\x1cR/8\xe7\x91'\x1cΩ;\x1a)=6s\xb2\xaa\xf9\xebm\x01\xd9E\xe8e\r@\x9f\xb2\xb7\x14\x13\x86\x88\xe7\xab>3\xc1\x0eVTn\xe7/\xb3[D\xea\x1d\xd1\xe4\xa8\xc3\f7\x1e\xef\x15\xf1\xe1\\\xbf\x96\xe0ʪ[˾\xea^\x95\xd7|g\xa7\x90;া\xa8\xe8e\xaa'\bj\xb6\xcdTm\x10q\xf3\x11?\x9bM^\x00s\xd5\x14\x9d\x82C8\x106\xbac^\xf2\xad\x05\xdf@\xf1\xca\v\x03i\t\xc7\xe4\xfd\xb0a\x19\x87\x90\xc3\xd6}M\x16\xf4#^c \xfaȆg!\x16i\x865\xbc\x86\xbe\xa5\x1bÞ\xe4_\xbf̿\xde\x10\xca\xe7\xf6\xed\xdctf\x18\xf19\xddno)'\xa3\x86n\xde\x12\x17\t*\xc5\x16\x8c\x93n\x89;*\xe1\b\xd3G\x90\xba\x8dM\xa7\n\xe5\x91b5\xe6v;\xa7A\xe6\xd5\xe6A\xffV;\xf1\x98\x9b^>\xa4\nz\xea\xea\xc3i\x98\x1f#\xf0\xe9\xd1\xc9\xcfTDMo\r\xa8f\x7f\xe6s\x8b\xa6\n\xf7=U\x17\x10\x98\x0fz\xfd\xab\xfe\xe8\xf7~1\xc0\xe7)x\x1b\xb1\xecn\xb5\xd7\xef_\xf5\xfb#p\x11^\xed=\x18\x10\x8b\xcci0\x98\x93\x94^4\x9b@\xeee\xe6\xc1\xe4A\xdf\xd6\x03\xc9y=\xe1͢\x87\x87\xc1\x9c\xba\xe9\x04\xec\xef7\xba\xfd\xf8\x11\x18\xe9_\xd5\xc9\x1aU\x9a\xfc\xb7f\x05\v\x1e\x10l]ږ\x85\xb0Y\xf5{\xd1\xe5М@\x9cwo\x857\xa25K\xd5a\xad\xee9\x8dh8\x98w\u0082G\x1eC\x8b\xc5XWۘ\x90\xfc\x94\xa4\x99F\xf5\xdf\x03\xafQnc<\xa8\xe7z\xae\xdc\xd9\xc0\xe92\xdcI\xd0\b\xae)ƛ\xcaI\x88}+]v8`?ڳ\xc6^|nQp\x88\f\x8f\x0f\xf8\x17\xff\f\xf1\xef{\xfc+\xe4\xcbHf\x8a\xe1m\x90\xf9\x0f\x98\x93\xef\r^j\x1b<\x03\xcdr\xf36NC\xaf\xe81\\\xe9-ԏ\x1f\xf5m\xf8C\xe0\xcf\xed\x1a5\xa4\x98\xc0'6'V\xc1|\x9b73\xcf\x17\xf4v\xa1ʵ9\x19\xe4\xdd\xc8\xc8:o\xa5\xea\xd9c\x1c\x84B\x19\xe0\xc8\xe8q\x0f\xa8V\xf6pn\xb5\x85\x13(\x95\x81\x83\x9a\xe6icP\a\x87'*\x8bcy\xe3\x8a8\xe7AE\xea\xab\xf8\u07b82\xf6\x101B\x11$\xc0\xaeQX\x19\x85\x01\xaf\f\xe1\xa6屛EƲ\xa8\x8f\"\x1e߱D4㕱\xeb\xc7ܢg3\xcf\x17\xa7^\xe0X($\xa8\fGhx\xe7\x053^\x81\xf0\x99\x15\xe0A\x81\x87jQ\x91\xa0\xa22\xe1\"\x9c\x8a\x18Ժ\xce+ӫ\x11:\"\x05?\xa4:\x10/2m+\x82\xc3M\xed]\xf0\xb8\"\"wZ\x99]\xb8\xf1\xfb\xca,\x88\xb9\xa8\xe0D`\x18\xe4\n\xb6\xef\x052,vT\x01\x1fTn0\xaa\x8c\xf8\xd0w#^\xe1\xc3\xf3\xb0\xc2\x03\x00\x10\x95s\xeeO+h\xd2\x16\x0eA@\x17N\u0099\xa8\\\xb8St\x1d/Cycg\xf0\x8f\v.\x0e*\x12\xfcU\x00$\xe2\x1fh}\xe6{\x17\x9e\xa8\xcc\x02\xd9\rH\x8a\xcfé\xa8\xc0}\x0f\xe8@\xe5l\x82\x9d{\xcfo*g70\x99\xd3\xca\xf0|\xe4E\x95\xa1\x8f\x93\va\xbb\xb5[w|\x83\xde\xcb\x1fB>G\xe8\x14\xf7L\xe6\x85\xfe\xc9|\x93(\x9cM\xe5O\xf4D\x8e\xbf\x84;\x91I\"\xba\xc1\xa7\ffU\x19yQ\\Qt6<Aj\x06\xf3!\x86\xf2\xe1U\xf8\xc5\xccw\x05\xaf\x8c\x87\x95\xf1\xa42\xf6CW\xa4\xeb\x1d\xc3BA\xcf'\\\xf8A\xe5܋E\x18\x01j&\xf8\x84G\x95\x1f³\xb8\xf2\x1e\x1089\x1b~8\xa9\x04\xe1\xc4\x0f\xcf*\xd3p:\x92\xd3Y\x01\xa01¿~P\x898.y\f6N\x95XV\x1e\xe3f\xa8\xc4\xc2\x05\xcb\xccxʃQE\x88\x1b\x98\x86Y\x90\xe9\xae\x05Xr\x16\xe8\x99W\xc5.\xdd\b\x1cs\xb8\x9e\xa8\\\x9d\xf3`\xc8\xe1\x11\xc1_ox^\xf9\xa0\f%+\x1fd\xb0\xf8ʇ\xb1\x98V>@\xc2E8\u0085\xfa\x80\xf1\x88p;~\x98F\xe1\xb8\xf2\x01\xdc\xef\x7f\x90>\xab\xe0S\xe5C\x1c\x0e\xdfsQ\xf9\x10\x8b\x1b()\x86Skn\x9cp\x9ff@\x8a\xd0\x19\x8d)o.\xb8ء.\xf5r\x97V\x06T\xfa\xd6ڜ\xae\x80ן)\xc8\xfa\xd9\xda\x1c\x80\xe2|N7Z;\xbb\xdb\xff\x0f\xb9\xe1\xad/\u07fcy\xb5f\xaf'\xed~\xbf\tn\xf7\x88\x05W\xbe1\x9cT\xa1_\x8fH\xd8\xd6;\x8b\xae\xf5\x0e\x1b߹\x8d\x0f\x03\xf5l5\xf6\x1a\xeanf\xfd\xbe\x83.lH\xc9\x15iV=\x85\x0e\xcd\xdc\x1c\x90v*yOM\xba\x06\x98C\xebA\xdeS\xd7\x1c.ː\xf5\x02\x9ay\xf7\n\xd03\x9f.ej\xed\xf5\x064\xafX\x04\x1c\xe2\xf9 \x0f\xa7a:L8\x8dt\x8a50\x1c\x9c\x9d\x94\\\x8f\xd6;\xbbˬzT\xb7*\xfd\xfe\xe8vcN\xac\xd4\xedT\xf9\xd0M\x17UQ~\xff\xe4\xfd\xc3)\x97\x8b\xb7\x1b\xf3~\xff̚\x0fr\x13\xa2\x9c\x8e\x95w.\x9c\xcf\xd3y\xb1\xbb\xec\x1d,Ԡ^\xb1\x9b\x8f\xba\xa4\x82\x9d}pg7\xf3{٪\xc8\xfc\xf84t\x82\xe0\xca\xcc\xf4l`Z\xe9=Ǫ\xdd\xd9\xe8\x06d\x1f?z\x98\xd9-\x1d\x98\"\xe7\x16\xa2Ss\xba\xb7\xbd\xb1\xb9\xe9p\xc3C\x99\xa5v\xed\x83S\U0010567e\x80+8ֳ\xdcX;\xec\t\xc7\xdaU\x8f\xf4\xe2\x83\xf7,\xbc\x81\xc0ۿA_=\x90\x1e`\xa4\xbd쪵F!\xd2\xcbhOC-\xe0\x16[\xd4B#\x0f*/Z@vP\x03\x9fZ\x99Oph\xc1\x13\xe7\xe8\x19(\n\xaf0\x0f\x16Q\xee\xb4\xe1C\x04\xad\xc6W\x9e,\xab\xefj\xf8\x82@\x013\xfb\x1cΫ\xe5c\xec\xa1\x1b\x8f\xfb#\x99\x15\xbd\v\xe1\xfcc\xb6\xb3\xd9d\x02[M\xc6Є'\x80b\xa8\x1e\x9d\xccB\xd7.\x00v\xc0Т\xf0\x02Fp\xadޕKW\v\xf4\xb9z\x88P@&\x89RX\x00\x8a,jps\xa9\xf5҅\xd0pϤ\xee\tD\x86\v\r\xa3_ !ĳ@\xf0\xe8҅\x821\x17o\xbc\v\x1e\xcedw\xb9\x1b\x19\x1f\xf1=\xfb\xac\b\xfa\xb4o02.s\xea\x18\xd1\xf8Sv \v\x82\xac_\x9e\xa1\xe3\xa54,\xb9\xf9;\xb5[\x83*\x83,\xc3b\x04rH\x8c\x87.\x86k\x9b\x05\xea\xe7\x80\xf6\xac\x14K\xc0%\xf5\xe0\x81ra\xb5\x1c!n&\xc9\xc1\xc6M#%\t\xb0t\x80✈0\x92\xa6\xa4\xd2\xc2\xc1\xa2\x16\\Ю\x8fu?\v\x04\x8c҈\xf4\x98\x86\xcc\x03e\v\xf9\tʤ\x01\xcb\xd2Pji0;#>a\x16_R\x87\xc3J\xa3\x97\xc9\x18V2\xecU\x16\xd3*\v\x85\x85\x11\x9e\xb1~\x8c\xbelQ\x1d\n0\x8b)W\bҘ\x8bT\x98\x8bAhF=\xccG\x00ˇ\xf6*\x04\x8b43\xe5\xca\x17\x83\x8f壽\xc9\xd8\xf8Y\xbf\x1e{\x93\xb7^!\x01vȀ\xe6CZ\xe2~\f\xb2\xf7\\8\xbc\x85 w\xf9\xb0nf\xf8\xb54\xf8\xd8\xc0\xf0Lg\xba\xa9\xf7l\x00\xde\x16\xe5\xd4\"y/\xf2\xffC0\x86\x98\t0\xde\xd9?\x00[\x1bk\x7f\xed\xc0\xa2Ì@\xcep\x81~\xbfy\xea4\x80TԾB\x17>\x1d$\xfd\xb5\x835\xea\xc5o\xa2\x99\x7fs,\xa3a\xbdq'\xa9k\xbfT~\x94y8\xaa+\xe5$\x1a\xa4\x1e\xfb V\xc1>8v\x0e\xba\xd6\x01\x88-@\xb1\xd2\x06\x19\x8e\xe4D\x89\xb9Y\x17\x9a\x1cb\x85Rѧ\xad\x999\x8dv5\xf5\x02(\xdb8\x1e\xdb\x11\x1a\xfdguEs4\x18˹\x05$\u03a2\xb3\xc8)\xcbh9\x91RoQJ\x80\x05\x19\xd5\x15\xce\xe9\x18\xdc35mt\xcal\x9fv\xf1I\x1e\x11\x8b\x9e3\xab\x95\xf4ڹ\xe4\xa4\xd5k5v\x06\x8fz\xbbڍ3\xb8j.\xc3\x1f\x16\xb8)߃7f\xfb\xc1\xed\xf9\x9c\xd8\xf6\x83\xdb\xf1\x1c\x1d@\x93n\"_\b:d\xae7\x06݅\xae\xf4\xfbgߧ\xc8\xc4\xf7i-\xf0C\x96\x95^\v\xb3\xbar\x05\xa4S\xe7\xe2@H\x80\b\x8d\x99I\xfa\x8f\x96\\\x86\xc3\xc6\xe7:\xb3z#\x8f\x82nI\x91\xb3\xc7P\xa4\xad2\xb7\x97e\v\x8f\a8q2\xdbβl𩮾\xe4\xe9yz\xb1\x9c\xa5\x04z\xd6\xfd\xfe\xad\u008e\xfa\xfd\xb9\x95Q\xf5Ӽ\xff\xe4\x89>-ֹ\xb8\xf0\xbfWE\xac<\x9e\xfb\xbdES\x9d_P\xafZ\xc9\xe8\xb9\x18P\x13͵\xae/|\vUjtC\xc38\xfe\xfdhg\x18\xc7\xd0\xce\xcd*\xf7ۺ\xddﭻ\xea.\xfa\xf1N}Qgۘ7\x15If\xaf\xf5\xd7\xfa\x8f\xfa\x8f\xc0k&\xf8-\x96^\x9fs\x98\xdf\x12|\xb6\xe0\x84\xfa\x17\x9a\x88\xa9[K\n\xc8\x10\xac\xe9*\xe7\x968\xb7;\xca9\x9a\xb2`\\\x97TQ\xfc\xc8n\x90Da\xddqJ\x84\x14*ʼ3\xf7\xde\xf5\x83\x01\xe9\xc79\x8a\b\x9d$\x03+\xb9\xcc=,&/\xf8\x92E\a\xe7=\xde,:\xab\xa5\xbc\xb9ଖN\xe8%\xbd\xa1#ʛ\x86?\xd8A\xe7\"s\xd6r\xa5Ѷ\x94\vf\xf2\x85K7\xbed\x11\xa7\xf8\xde\x15I\x19\xb5G\x06\x1axF\xb3V `\xe5\x91\xfe\x90\xb1!M\xc6\xed}Z:\"\xf3\x01\x84\xb2̑\x9dn\xe4^\xc4\x16-\xabs%YS\xda\xe2u\x81\xd3\xf7\x95{\xe9\xcaxH&\x1d\t\xae\f\xac\x1f\xe2k@\xe5\xf0\xf7\xf0\x87\xd8\xe4\xfdM\xa9\xbas\x9d\xdbW\x87\xaf\x0f_\x9c\x9c>9~\xf9\xe6\xf0\xd9\xcb\x13\xe7z\x9eQ>\xbf\x87\xdc\xee\xd3\xc3ƇA\x81s\x9d\xf2Y}\xf7\x8c\xfb\x8e\x15\x9f\xf33\x17O\xa2\xe4\xbbZ\x01\xfat\xc86\xd2֜P\x9dy\x16\xf3S\x15i\x89.\x10n&\x93U\xcf\xd9;p\xa1\xfd\x995\x00\x81\xba-K&n|A mmN?j\xab\x9dё\xde\xf4\x9e\xbdֻ\xa5\xfd`Џ\x1f\xadQ\xd7\xf6\xec5۶\xfbk\xfd\xb5\xe6\xa3\a$\x81_\x8f\xec\xfe\xa3\u07bb\xb5A\xd2{\xf7h@\x1e\xf5\xc1s9pqɣ5\x1a\xd7-8d\x8e\x85\x8eE\xef\x04\x06\xae\x10QvD][\x17.\x9c\xb6\x8c\x88\x06\xb7\by\x97\xdcu+\x81\xcb\r\x88\xb4D\xee\x82\x04\t7\xb8\x00\tTg\\\x04V\xea\x9e\x04䔒\xae\xcb:v\x96?pt\x15'۲!\x90\xc3;\x9b\f\x1e\x95\xfd\xea\xf7\x89\xfcA\n?\x13\x18@\x89[\xf2\xba\x85}e\aV\x91\x11\xae\x80\x9d\xfc\xb6\x84\x89\xa3N\xd3\x02\xbaQ\xda\xd4R\xa0\x89B\xf6\xf4D\"o~\x8d\x82%\x8ed'\xfc\xae\xce\xea\xc0X\xd05\xba\xb6\x1c\x86g\xe0!\xd6MƏ\xcc\x1e-\x8c\xd7\xc7l\xb3\xb4\xfa\xa1\xf2{d\xb8\xb7\x1e6\x17\xb0],4l\xf2`4/\xb9\xc7\x17YM\xbaִ\x98\xee\xd2\"\f\x84\xc1.\x1f`aS=O\xb7i\xf6\x01\x87ݻ\xed\f\xd6\xee1\xb7\xaaU\xbaZb\x12\xcf\t=5\xd8i\x0f\xd7\xf4|e\x1dP\xb2\x8cqE29*\xc8&\x01)\x87\xb5r\x04Β\xbd\xfdig\x056=\xde\xf7KEC\xa7w\x0fuP\xb6O\x00ٷ걉x>\x80\xf7\x15k\xa586\x85yR\xa9z\x95\xd8\xc22\xa5\xb3\xdcs,\x88˼ȹ\xcc*\xd3\xfc\x9c9\xcdMc6,\xe3\xdc\xf4\xcf\xec.3\xbc\xa0\x92\xb5\xe5[徢4\xd8\x18j\a\x9d\x9a0w\xc2E\x12s\x01\xebQG&+\x02y[s.\x01\xf5\xc8 섋J̅\xf5Q\xadf\xb0\xa5O\xd6\xe6f\xeb |\xb6\x9b\x83\xb59r\x11w\xd7[\xeb\x92m\xbf\x8a@\x06%\xdbE1\x1b\xba\xab\xb5\xe6\xc0\\,\xc5Ж\xa0s\xc8\\+\xbb9!\xbb!m\x05n\x9a\xc4詵\xc8\xe4^\xb6\"A6s\u0094s/\x11\xf3/\xbb:\xef+\x91\x1e\xe4\xa3\xe7\x98\\\xdb0\x9dq\a\xe4\xa8\x1ay\x8b\x88Y\xe4\xc4B\xc9xzhz).>(_\xf0\x90\f\n\xe5\xb3\xd05(\fw\xa9W\ue01c\x93\xdb,,\x1a!T\xa3v\xc8\xd4*\x9d\xbfBC\xf39\xdd\xde\xdeظ\xd7vɤ\xbe\x8dn\xef\xb0\xf1\xa1\xdf었}Q\xe2\v\xfa>.\xdaK\xe20F\xa1\x92\xfb\xce\x02\xe1\xf9\x98\xc5\x1bWp\x82\xa4N?\x88]G\xaep+\xa3\x9b\xc0\xbd\xf0\x86xW\xa3w|)땨\x88LM\x85\xbe\x8a\xb5\r\xc2d\x05\x80Q\xf8+mu* \xa2\x9cF\xe1\x90Ǳ\x02\xcc\xe7\xdehă\x8a\x8aK\x86\x95q\x81\x02\x8cT\xb2\xec\x0e+n\x1cL\xa1\x00ȗ\x9f|~\x92\x8aS\x87\x15\xe4\xe7V\x86\xfe\xf9Me\xe8{\x95\xa1?\xad\f\xfd\xb82\xf4/+\xc3 \x0e\x94\xb0\x15$\xa5ӛ\xcapZ\x19N\xbd\xcap:\xad\fg\x91_\x19^N\xdd\xca\xe8lZ\x19q\xbf2\xf2\xc6c\x10\x9aVFP\x8e\x9fMQL\x9a\xb4*|\nr\xec\xe90\xbe\xac\xf0)|\x8aP:.\xe0\xe7u\x1c\xa0\xf8\xf4\xfc\xba2\xf6+cQ\x19_U\xc0\xfcsr6\xadL\x86\x95\xc9\xf0\xac2\x19z\x95\xc9\xf0\xa22\x19ƕ\xc9(\xaaLx\x14U&\xe77\x95\x89W\x81\xb9\x9e\xfcpV\x99\xf8\x95\xc9Eer\x11V&\xd3\xcad\x1aW&\xd3\xcb\nJ{+\x938\x80\x7f\xf0\xe3\xb22\x11\x1f*\x93YerY\x99\\]x\x95\xf3L.;\xbc\xa8x\xfc\xba\xe2\x9d\xdfT<\xaf\xe2A\x97=\xec\xb27\xbd\b+\x1e\xf4ۋ.*^\xcc+\x1e\x14\xf5\xae\"%\xbf\x9dV\xfc\xb8r\x01&\x9b\xa3\xca\x05w\xe3Y\xc4+\x17^\xe5\"\x9c\x05`\xe1\x01ᯧ\x95\x8b\xcbJ\xe0\xfa\x95`\x14U\x02\xaf\x12\\\x84\x95`\x1a\xc7\xc3\nLUpY\t'\x97\x95\xf0\\\x89~c-\xf7\x05\xe1~%:\x9bV\xa2\xe1\x0fg\x95h\x18\a\x95hT\x89FQ%\xe2A%\xf2*\x11$_T\xa2\v\x98\xf6\xe8\"\xacD\x81W\x89\x82i%\x9aV\"\xc8\r\xe3\x8ef\x90\xeb\xb2\x12\xc1bE\xd0\xf7\xd8\xfd\xe1\xac\x12\xbb~%v\xa7q%v\xe3\xcbJ|6\xad\xc4\xc3J<<\xabĨB\xaf\xe4\xf2ËJ\xecUb\xbf\x12\xfb\x9cO+\xb1\x1fW\xe20\x12\x95xZ\x89\xa7P\xcb\x14j\x98B\r\u0085t\xf1\xa1\x12C\x83\x90\x02m\t\xce+\"\x1a^H\x1d\x80+\xb8#\x94\x90\xf9\x87\xb3\xcaU\xe4\t\x8e\x80Y\x03\x96\uf5c4\x19\xcbKEW(-\x81B\xcd㵕r\xae\xb5\xfe\x03\x10\x06\xac\xe5n\x1aP$QJN\x0e\xe8\x93\f@\xb6Y\xc6[Yl1\x83\xc0Y\x8d\xbfЉ\xef\xac_\xac\xcd\a\xa6J\x16\rV\xb2+\xa03@a\xea[\xfd\x1d\xbe̥.Խ\xfacʼ\xd3\xfe\xe8\xc4w\x9f\xfd\x02+\xf3\x98y7k\xd6\x0e\xd2\x01\x01\xfa\x94ZD\x9d\xd6~oM\v,\xb3\x8a\xf7u\xe2\xef\x1d\xe4\xc7Y\xc6\xf8)Y\xad\xa6\x1d\xdf\x04\xe14\xf6\xe2d\xa4\"\x19{a\x90\xf0k\xf7b\xeas\xe9(,N\u0099\xc0g\x10\n\x1e'\xbe\x17\xbcOR\x97\x85I\x14\xfa<\xd1\xc0\xdd\xf5=qC̵m\xda)TL\xc6at\xe5F#Pe\x11n4\xe1\x02\x95\x91\x8dԡ+\xf8$\x8cn \x14X(8$E\xb3 \x9e\x82\xc6>\xbf\x96R\aH$\xfd\xb8\xde?\xa9\xaf)~P\x9c[\x1a\rzK\x06lٖ\xbe_\xad\xc3\xd1(y\x02\xe07y\xe2\x871O\x9e\x84ӛ\xe4(\x80~\x1e]{\"\xf9\xdc\vF\xc9\xe7\xa8{\x91|\xc1E\xf2\xa57\xe2\xc9W\xa1\x17$_\x87\xc3\xf7ɋ\xf0\x92'/\xf9U\x02\xe4Lr<\x15ޅ\xf7\x81'\xaf\xc2i\xf2j\x16\x9f'\xaf\xf9(L^\xa3np\xf2\x9a\xe3@_\xf3\x98\v\xf8\v\x19O\xb8\x1b\r\xcf\x13i1\x93\x9cp\x91\x9c\x9c\x87W\xc9\t\xf8_8\x01\xfb\xb2\xe4D\xf0ir\x82\xb7P\xf26\x18\x85\xc9\xdb\x00\x14G\x93o\xe1\xe6I\x1e\xbb\xc3\xf7\xb3i\xf2\xe4\x9c\x0f\xdfOC/\x10\xc9\x13yW\xe03\xe2q\x9c<\t\x83K\x1e\t\xfd\xfc<\n/\xf4\xef7a\xf2ԋ\x11H&G#O$G\xd7S7\x18\xc1#\x8cD\xf2\x05\xc0\xee\xe4\x19\xca?\x93g\x81'<\xb4pN\xbe\x06\r\x96\xe4\x05\x8f&<y\x81\x85\x8fg\"y5;\xf3=\x1c2@s\x9e\x9c\xb8\x97<9\xb9\t\x86\xc9\xdb`\xaa\xbe\xbd\x9d\x8e\\\xc1\x93\xc3\xe94\x82\x199\x8cc\xe8\xd9\xe3\x99珰\xc3>\x17\xd0\xf3`\xecE\x17\xc9S\x1e\xdc$O\xf9\xd4\x0fo\xa0\x9bp>\x93#T\x83J\x9eI\xbf\x99ɳ\xe02|\x0fS*չ\x93\xd7\xfc\xc7\x19\x8fqv\x01\x12\xc2sv\xc1\x93\x13|9\x11\xe149\x99\x9dA\xe7O\xa4\x9eN\xf26P.8\x93\xb7\xa9\xc2z\xf2\xad\xeb\x89\xe4)\x88\x86\x93\x17\xf2.I^A\xd4\xc1\xd7|\xeaz\xd0H\x1c\xfa\x97<y\x03-\xbd\x89`W>\t\x83\x00\x16\xf0\xa9\x17\x0f\xd5\xcf\xd7\xdc\x1d%\xaf\xf9\x90{0\r\xd0ַ\x00h\x13\xd4\x06N\xbe\x88\xdc@$\xaf\xa2Pȼ8\x8c\xb7\x01\xea\x04\xc3|\xa9\x0foc\x9e|.q\xb3\xe4\x04V\xe1\r\xe7ɷ\x00\xba-\nQS\x1aD+\x81\xd6-2G}\xc3{\x12M\x15\x1e\xcc.\xac\x94\xac\xef\xdd.\x90-\xe5\xdc#\x93\x84\x18\xcc\xef\xd27\xd4\t`#\x99\xb6տM\x1e,6\x96#1\xcb\xdb\xd6\xc77k&\x97o\xf1\xae\x99ӥ\xd1\xf1\xb4\xde\xe2\xe0\x91\x8d\xb3\b?\xeb\xc0A+\xe5D\xe7\xb5e\x179?\xe5\xfd\r\x90Ւ\t\ag\xb1\x17LRfʃ\x15:\x97!uW^\x9e6V\x95\xb8q\xcc/\xce\xfc\x9bD\xa9\f&RF\x9e\x00\x9c\x91\xe0\x12\x1dm\xe0]3e\xe6eb\xd4\x1dNy\xe4\x8a0S\xb71\xa0c\x03*m\xb8q\xd28\xc3_gA(\x92\xc6Y\x18%\x8d\xb3k\xf8\v<\xbc\xd4\xfbZ\xd2\x18\xea!$\x8d!\xff1i\f'\x908\x11Ic\xe8\xc3/\f\xcc\xd9\x18\xfa\x90\x80n\x1a\x92\xc60\x80\x94 \x14F\xd1 \x14*g\x10\n\x9d/\xfb\xac\xac\x14\x92\xc6\x10Mp\x93\x064ůݡH\x1a\xe3\xa4\x01\xaa\x86I\x03Z\x86\x86\xbd\xac\xa0\a\xf9<\xf8\xe0\xe1\x17\xc8\xe6Ɇ<蒧\x9a\xf2\x02\xf8\a\xa9\xb9nyi\xb7\xbc\xac[^\xda\x19\x0f\xb2\xc48A\x9e\xea\x17ȓ\x93\x064#\xcbA#\xaa\x1cT\x8fysM\x04\xa1\x80\x12iCY;0٨җ4\xd2\x16\xe3s\x1f\xfeDIC\xb5w\xe5\xf9\xa3\xa1\x1b\x8d\x92\xc65\x8aց\a\vZ\xe1\xe6r+\xd2<\xdbJ\xe9\xee/\xcaX\xe8\xf8\x0ee\xe2^\xf3Q\x1f\xf8Խ\xfeՠޫ\f\xbaݾ\xbdts\xdf͌.jBe\xfbPh\xd7eF\xc0$\xc3\xf2.\x06K\xfa\xc4\"z\xc0\xabdK˘!\xb9\"ȶ:g\xbd1\xf5hT\xd0ꆣ\x19\xd3`\xe5\x9c\xf6\x1f \x12\x97\x00\xf7#A\xee\x87\fDk\x96\x89\x95\x91lÐƭ\xfd\x02\x94\xbcr\xab\x00\x92\xed\xb2\x00\xa2\xab9\xbf哝r\x15\x14\x97\xe9\xdc\xe4\xeb\xf7Rd\xd6\x1a\x9e\xa3B\xd7ٍ\xd4\x1bR\xca8\xf8\xe9,Dm\x98\x11\x1fz\x17RO\xc9\v&\xa8\x953\ng\x88@\xa3\xc6\r\xe8&YT\xf2o-W鑀\xfe\xab\x90X\xb6\xd4\x06\x1b\xe4C\xe1\xd1R\xecm)GДK\xae\xf5\xfaͲMLR~\xc78\xb3\x02\xd0.5F)W\xe3\x15\x98\x00\x9f\x9cs\xdf7\x05VS\x10RM\xe3\xb65\xa0E?\x939\x0e\xb0\x11\x1e\xf8\\ϰOgtH\xa7t\x04\xbe\xbb\xe9\xe6z{k\xa7\xa8\xee\xfa\x91\xc1\xad\xc7Y\xa0\xb5\xacd\xb0D5fiDQQ\x88(:\xce\xc2\xd5-\x8f[(\xca\xe2\x16\xaef\xe9\x046\xaa\xbb\x9c\x0e\xd6(\xf4Q)˶\x1a{\xa7\xcd\xc6\xe0\x91\xb3F-ҵH>u\x8d\x14\xa9+\xa5|\xa5ָ\xd6C9u'\xa9\xa1\x1d\x86\xfcu\r\xc1\xb6\xe5ۚ\xc1\x1cC\x99\xc5r\xbd\xd3F\xf1B\xfd\xbd.T~\n\xa1\xbb?\xb4\x1b{\xa7\xa8\xb7c\x86\xf4\x05x\x18\x1b\xe4\x9aW\x8a\x1b\xcc\t\xf5\x8dL%B\xc0\x85\x9e\xa4f/t\x96+\xb9\xc8\xf1\\Qt\xc8n\x17y\x9ei\xf7\xf7\xd7>R\x14h\xa8*i\x1d\xa6R\xb4\x88\xa1@\xe8\xee\xba5d\xc9\xc3\xe5UD\xbcQ\x91kp\xa33\xaazI\x86\u07bb~l}\xc6\xf6\x0f\xbe\x1f(\xbap0/\xaa7\xbf\xf8\x9aV~\xf5\xe2뼎3B\xaak\xf5\x8cP9\xd4\x15\xa8\xefy\xfd\xc3\x19\xfc\x8dG\xf8\x17>OU\x8cʫ\x18TM\xe3\xcb\xc9\x12\bq\xb7\xde\xf3\xda~\x157\xb5\x1a\xd6\xc1Z^ԜU\xe0\xd1\x19\xf5i\\\x1eL\xfaS\xda1\xab\x8e\xa1r9W\xd4\xd00ٯ6\x1akt\xad\xd18X\xa3\xb7\x05;\xa3\x94\xf3P\xed\xf7\x9e<=|sht\xa8?(\x8cc^\xc0f\xf3=\xebw\xaf/|]\xb8\xbbP\xd4,h^\x95\xfbh\xcc\x00J%qr@\xb2\x81e\xb6D\x81\xda{7>ϙ;\f\v\xaa\xd5\xfb\xfd5\xcct\xb0f\xaa\x02\xb5r\xa2\xc8\x1e\xea\xfa\xc8\x1bm0_\xd5+\xe4\x9eܣ[\x98\xef\xce~a\xae\xd5\x1d\xfb\xc1ж\xb0\xce\xdd`\xe4\xf337\xbaWg\x0f\x92}P@\\\x9a#\xb0\x11v\u0601-h\b*G\ak\x14\xff\xf5\xe35\f]\xaa4\x1b\xbb\a\xcb6!\f6\x8bC]b\xd50Di\xf4\xf2\xe6\xfbk\xba\x03k\a\xd0\xe6Ƕ\x93\x81\x86\x83<\xa4\xca#|\x03)\xe0\xda\xda\xdc\xda\xddY!\xb1@MQfJ\xb5nx\\\tB)\xdc\x02\x87\xbf@\x8f\xff^g\xad\xeb\xfc\xa2\xc6\xea\x0fh\xf3\xff\xf3\xe83\x1b\x88\xf4\x01(X\x05\xa5\xccü;\xb4\xfb0\x12˘\x9d\x92\x11\xb6Z\xc7,7\xcf\xfcb껂7V\xf2qo\rIX\x7fn4\xf7\xd0\x14\x91I\xd0\x11\xb2\x9c\x11§\x0f\x05\x808\xbd\x9d\x83\xbc\x16\x81x\x11/X4\x0fA|\xe0vsn7\xf0\x17\xfe!\xb7-\xba>\xb7{oD\xa5\xdf\x17\x83\xecC\xd7v\xcc\\\xebsҵ\xd1\xf4\aTII\xd7\xeea~\xf2\xc8\xfe.\xe95\xeaK\vv\x89Ҟ\xf4\xee/x4p\xc6\xdc\x1e\x8d\xef\x92<z\x05\xd1a\xbe\xb8\x7f\xb7Lpu\x053V\x82\v\x94\x89\xed\xaf`\x7fW\x9c~\x7f\r\xf18\xd0Ы\xf4\xc5\x004\xfa\xd2\xf5\xfbl!\x9fef\xfc,\xcdh}V\xcc\xf8Y\xbe\xc6\xc1r+\x1a\xeb]\xa3\xd1\x00\r\x85\a֊\v\xa3\xa0\x8b\xd9\xeb\xf7\x93\x83\x81\x8dj\xb8]\xd0\xf3%]\f\xd2\xdf\x0f\xecJ\x9d\xf4\xdeU\x06\xbdw\xfd~ S\xfa\xfdu\xf9V\xef\xf7\x83.y\x94\r\xd0\xda\x7f\xd8{\xc8\x1a\x83\xae\x9a\xea\xde\xc3Ơ\xfb\xf0\xc0\xca+\xafD\xb3\xb3\x1b\xeb\xfet\xdar\xe2ƪ\xf6\xfbW\xf5\xaaU\x8fV\xe5\xd9G{\xa6\x03kU\x9e;\xaaX\xfc\x9e\x9b\xf1\xdaRݩ\a֊b\xfd\xfe\xa3{\x17<\x9b\xf9h\xb0\xa3\x8a6p7\xe0^(R\xf1\x8b\xf6\x89\x05u\x0ea\\\xb9Z\x1dA̋\xa8H\x1e\x94\x18:\x05Rm\xe6\xac\xd0nL}\x1a\f\xe8\x90\xf5\x9a\xcd\xe6,\r+0\xd4\xe1h\xa4\xc4<$\x18\x00A\xe9\x8a\x0e5\x95\xf9\xebC@:\xcb\x10\xc5\f\x11\xbd\x81+;;\xb63Ժ\xd8\xde\xd9s\x94\xc75vp\v\xaa\x8a\x15\xa5\xaa\x88\xa6\v\x01\x8b\xec\x9d\xf5\xbd\x9d\r\x82\xd22\b5\xf3D:\xf3\x043\x8bXc\xa2\xea\xbd\xf0\x8a\x9f\x95i\x95\fj\x05\xef\xc0\xc0rop\x8a\xaa-:\xe1\xe2i!\a&E\xde%\x1f\xa1K0\x10*\xa0\x11ǒoi\xb9\v\xef\x1a\xc6UmQ\b\x16\x97v@Ȏ\xa1x-P\xad\xaa\xe872\xab\f׀}G#\xe9\xf4\x97\x9c?mb$_<q\x83\x95y\xec\xf6\xc1\x03IP\xcbQ\xa3d\xe4\x1e\x03.\xef[\x9cs5\xed\x9bd885\xe7\x17\xa1\xcdI\xd7s\xe2\x1eo\xeav\aI\x12\xce\xe3^\xd0\xfc\\\x8a\xb7^\xf3\xf1 \xdf+\x19\x88\xec#;5\xa7P'49`\x1en\x82\x99\xf6\xa1/\xed\xcdtD\v:\xd4\xe9\x13.\x8cH\x17PiL\xa7\xe5\x1f\xa5\xd1SL\xc7域*\x11a\x18\xd1s#\xc7+\xbdJ\xc7c:b\xc5H\x1b\x9dE\x84\xaa\xc2m\x15\x99\xc5\b\x86\x91:=\x8b0y$q.p\x8e\x1e\x91\x0e\xf8\xf3\xae26\xaaՠhH\x032\x87\xaf\x1eC?\xbf\xd3Z\xcd\xf6\x98\xa7Y0S;\x02\x17\xaa\xe30\xb2!S\xcc|[\x10z\xc1|;\"t\xc2Z\x9dɾ\xa7Cf\xd5\xeb\x13\xd9\xd0%\xf3z\x13t\x18^\xb5\xdd\xde\xe5 I\x82Z-\xc0\x1f\x17\xb5\xda\x05\xfe\x88k\xb5\xb8w9 \xb2\xc4\r\x03#\x99K\x82\xae\x9cf\xb6\x00\x85^Ù\x13\x84nS\xbb\x04\xa0\xcfnk{s+5\xef\x91^\xf5\n\xa1\xd3h@CY5X\\\xc7l\xf7Q\xd8\b\x1am\xea3\xbb\xbd\xbf\x1f\x93F\x9bΘ\x7fpЦC\xd6ءS\x16u\xc3F\xdbi\xd11\x8b\xba\x8d\xb6Ӧ\xe7\x8c\xf7D}*=+M\xeblL]v^\x83ҍ!\x14??8`\x8d!\x1d\xd6Y\xdc\x19\x1e\xb4:.[\xdf\xda~\xe4\xd6e)\x8a%\x86\r\xb6+'\xcfc\xaeQ\xd6M\xcb\x06X\xd6ò^IYol\xb7\xd0Q\xb7\xcbڍ\x99\ft\xe1\x8dm\x971槑<\xbb/ݗN{\xad\xf5\xc8>Ǿ\x93\x8eWg\xe8'y\x1a^\xd9\xeb4 \xd4m\xb0\x99\x9aB\x9d\xe9\x91\xf7\xc8\xc8\xe36\x022\xa7\xa2\x89\x8a\f%\x93I]9\x9d\x1e\x82\xef\x19\xdb}\xe46\xc2\x06L\x1f\fk\x06\xa3\x9a\xb2!L蘭cD\xae\xaeQ{c}\x934\xcc\xf7\x9d\x1d\xe2\xb4\xe89\x03g\xffn\xa3MG,趝F\x9b^0\xb1\xdfJ\x12\x18\xb4\xa8\xd5\xdakb\xbf\xd5m;-\x9cD!\a\xe5\x9eŰ\t\xd1\x14\xd3\x16`I\xc5\x18k\xaf\xb5\xbav\xcct\"\x94\xa1\x1e\x1b\x12\xc7\xf6\x98\xe12\x1a\x7fB\xf8+A\xd6\xf0\xf7\xd7/\xd7\t\x15\x8flߜ\xb0\x86G\xc8~\x1bNB\xa3A\xfdGl\x9dP[ԙW\x9f\x1e\xb0vw\xbc\xe6;cs\xeeڍ)!\x8f\xfc\x03\xb6\x0eE\xeau\xea\xafA\x11\xcc>\x84^\xa9\xae\xa8\xf2v\xccl\xf1\xc8o\xb4\x89Y\t\xdcwu6%\x8e\x1d3a~\x98\x96dd-\xf0k|\xc0v;\xbc\x17\xd5\xcf\al}k\xab\x16\xd3\xf3:\x1b\xd1x\rv\x13\r\x8d\xbd\xe7\xed\xef\x87ILgu\x16vf\a-\xb3\x90'\vy\xb2\xd0\f\v\xe1\xe7\xc6h\x90\xb0\xf6\xfa\xee\xa3\v\xe0\xbdnl\xecm\x14\\\xd9-\x90vd\xf1V\xe5\f\xed\x1f3\b&\xcd\xe5:&\a\x17#\xb2\xa3\x8b\xd14W!\x94\x89\x01\x011\xcckV\x9b\xa1<\xcax9w׃\xfb\x84;_\xe5\x02Z\x99\xee\x01c\x99\xe1y.Chd\xf0e\x86/r\x19\xdcB\x13\xb5Zu&\xf3}\x97\xcb\xe7e\xf9\xaav\x95'I\x95\xf7\xa6\x03#C\\\x92alf\xf0K2\x9c\x9b\x19f\x85\xc1$\x89\x9f\xeb°\xa4\x82р́\xc0\x8c\b\x15v(\x1f\xae\x8e\xc4\xf2L\xf0\b\x88Y\xe6\xe1\xebs~\xc3G,\x96\x9f\xc0\xa2\x91\x8f\x98\x8fo\x87q\x1c\x0e=\x17006Ô\xe3h\xc4#>bC\x1a5e\xb9\x80FM]*\xa4Q\xf3\x84\v\xe6\xe2\xe6\x982\xeb\x17\xbf8=}\xf6\xe2\xc5\xdb7\x87\x8f\xbf>:}\xf6\xe6\xe85\xfe8\xfd\xc5/,:.~~~\xf4룧\xf2\xdb\xf9BїO\x8f~\xa5\xbf\x8e\x8a_\x8f_?=z\xad\xbf^\xb0\u0530}¶\xe8%k\xef\xefO\xe8\r\xbbl\xb4\xc1v\fl\x9cT\xc4\x1c\xf0\x03vd\xbc\x98>^M\xb9\x00f`\xe0o\xbe\xe0\x92\xd5\xf4!]5\xe3Ĝ\xd8\xe46\xe7!\x15\x0f\x01\x13I\xd2J\xef\xdaHB\xa4\v\xf7\xdanQ\x1dA\xaa!\x88\x8a$\x83\a\vnᐵ:\xe1~\xd4\t\xebu\x12\xf4\xc2\x01㽰.\x06Y\xa0Aӟj\xdak\x19\x7f\r=\x8a\x83\x0e\rv\x14~0\xde<=\x95!\x1c\xb8}\x8c\xc7\r\x92\xb3J\x9e\xc8\xce\x02\xb6\xa1\xf0\xfe\x14\xdb\x10\U0008a218888\x901A\xacz\x04\xbeԓds}oso{g}o\x8b1\x16\xe9\xab\xeb\xa5\xfb\xb2#X\x94^\xee\xfb\xad.t\xb2.\xcc\x00\xa3\xc7Y\x10\xdbV\x96\xfa\x83v\x9f,?\xe15ɓD\x8e\xab\xcaXT\xab\xf1}ր\xe0cv:X\x91\xcf \x0e\x98\x19\x00\xe8\x99\x1c\x9a\xea\xcc\x1bl\xc0\\\xb5\x97%߅\xf1\xfdM\xbeK\xc6\x1cw#\x87ﷺƂ\x8a:'N֯\xae\nlp\xe1\x05\xb6\xa0\\\xa2d\xafX\x8b\xbefm\xfa\x82\xadӧ,\x93\x1d\xa7\x02.\x89c\xd6j\xf2ٔ\xcb\x16F\xf4k8\x00\xfa͢\x8f\xd9\xd3$\xf9:۽\x9f\xc3>\xc0X\xbb\x01\xbf\x16&\xe0\xfc\x90\x05\xfa\x93\x18\xa3\xec\xbdp\xdaj\x14=A\xa3lku\x03\xb5\xb9C'\xd0\xe7$\xa4\xa30\x90g\xc7\xd8zo\xd35T\xd9\xe4\xd0Uޖ\x11\x84\xe0G3\x8c\xf6\xb79@\xf6\x8dy\xeaj\xb5\x92\t\xe18\xa2BLn\xc9o\xfc6\xefyX\xa80u\xc58\xdc23\x9c\x87\xa7\xe0R\xf8)\x84\xd9\xea}=\x90\xc1_\x16\x1b\xcc\xfcFf\xf5|Y\xe8\xa6:&F'\x8b\xc1\x8d\xcc\xf8\xa92\xbc\x0e\xefz\xdc&\x8e\xbc\xbc@`\xcf\x7f\xb4\x893\xe3\xb9\x0e?_R\xac)B\x84\xbd\xb2\x10\xd6\x11\xe7+\xe2M\xf0\xc3q\x14\x88\xe8F&\xc4\xf9\x9a\xbfX\xd9!U\x19ϊC\xcd\n\xc8\xcb\x04?_\xdfwY}\xf6\xbd\xea\x93\x15\x10\xec\xb0\xc0\xb4\xf9\xe7ƽ\xaf5\x18\u0602\xcfR\xab\xf7L\xed\xfb\x815\xa7\x9f7\x9f\x1f\xfd\xfa\x84\xbd\xa2\x9f7\xbf9\xfc\xfa\xed\xd1\t{M?o\x1e\xbd|\xf3\xfa\xd9\xd1\t{A\xcd:\xbd \x9e\xf2\xa1`\x85vPz\xbc؎\x8c\x0e\x9eiR\xccͺz\x8f\aKJ̩\xb0\xbf\x82{\xf6\xabf8.\xc9\U000d5752\xe1dN\xbf\xcaw\x85\xff\xb8\xb4֯\xee59\xb2ӧ\xa7i\xb7\xad\x13\xfec\xe5֢\xd6\xdc*47t\x87\xe7\xfc\xb5\xf4#\xbfP\x8f\f&~\x8ayj5U\xa9\xba4\xde\x06\x98\fa\xb2\x8c\\2\xd8x\xb6\xc2M\x11\xca\vL\x856ǫ\xc7ȯ\x8e\x88\xfc\x9a\xefY\xdaR!d\x9d\x1a\xe2TF\x95\xa7\x00\xc80jmY\xd90*/<.\x16\x16\xf6s\xfa\x15\xa1\xcfsӫ\x0f\u05ca5\xfe\x02J}Q\xbe\xc6_\xe4\xd6\xf8\x8b\\\xd5\xd9!ZZ\xf9\x17\xbf\xc5R\xf7,j\r\xacB\xab\x1f3\xa1\xed%e\xef5\xa1m\x9c\xd0\xef`j\xbe+\x9f\x9a\xefrS\xf3]a\xfb\x8b\xd5'\xc0\x8b\xe1\xbb\xcb\xe9W\n\xf5|N\xbfBt\xf3;\xfaU\x8a\x82~\x81\xa8\xe7\xaf\xe9/\xe9\xaf(\xe7ED\xf1\xe4藈$\x1a\x04\nO/\xcbS\xd4\xc1a\xdcر\x8b\xa0<\xe2\xd9-b\xbaO\xe6\xa4#+\x91\x1eau-\xa7\xf0\x91\t\xf3\x10,T\x19\x18]\xf04j^\xd6\v\x88\b\x99G\xd6\xc2bQX(N\xf3\tO\xf0\x80\xf6\x8c\xa0\x00./!\x1987\x89\x0e\x80\xdez\x01~\r\x81C\x10)\x15\xdc\xee\rL\xe7\xff\xb11\x1d\x92\x14\xf4b|\x02\xc8W%8)\xdeF\xdf\xe8\xafa\xd9\xd7\x1f\xf5נ\xec\xeb\x82\xcb]\x8eyq]\x14ց\x9c)A2Gϩ'\x1d\xdb:\xba\x86K\x80\x8f$~]\t\xa3\x8a\x9e\xf2\x8a\xac\x18\xbck\xf7\xde\xd3\xca\xe5\xa0\xc2\x03\x11y<\xa6\x90\xeb=\xec8\x95Ł\xc0\xf7\x19\xb2a\x90r\xc6l\f\xb9\x0ex\xf6\xdb\xf4Dzx\\\xda\xe0\xac\xd8`\x92,\xceO\xad\x96N\xd0\xef\xa8?\xf7\x9f\x91\xa1\xb1\xd3\x10q\xca6Ea\x17\x14\x96]-eVӔ\x17qW\xae\xee\x12\x18T(\x83\x88\xc2\a\x97\x85\x9a\x98j\x03'\xa5\xe3\xed3\xb7\xe3\xd5\xeb\xb2\\\xcc\xc2^\xd4u\x1b\x9e\xe3I\x16&\xa0\xbe\u008e{\xed\x01\r\xbaq\xaf5p<\xcaӈ\x9b^=\r\xc0\xe4\xe9\x1f|\xf16\xb4\xf3\xa1F\xc7wu\xb6\xb4\xa3\xaaz\x98\x83\xcfm3\x06\x85d\xb5\x18\xfdN;W?p\xbbom\xe2|\xb0\x05\r\xba\x1c{\xdfhS\x0eQ} R\xc6B\x8f\xc3hI\x97\xcfy\x0e\xb0\x8b\xee\x88c\x8c-ˢ\xb7\x96\xe5p\b\x92\x93G\xfaF\xc6 U\xa9<\b\x10\x80\xf5!\x1a\x1eЈ~a\v\x15\xbf+\xef\xe8_\x15\xc5\xda\"\x1a\xa0+%B\x9c\t/\x94\x7f\xfeq\xe5\x8dMxan\xc2\x05(\x05\xb80\xd6{\xc11\xa6\x8a\x17\v\x1b\x9b\xe7\xa4\xfb<\xff\xed\x85;\x05\xe45\xaby\xc2\U000f401dgY1uEh\xaa\x14\xc9q#\x831\x99\x97<\xa5\xbb\xb9$dy\x15\xc8\x14Qe\x9a\x06\xa9J\xc0\x06\xd0:Mj/\xa1[\x14W\xe2x\\JF\t\xfd\x15۳\xd3\x00X\xc7c\x9b\x10Ƙ-\x980R\xee\xdb\x17\xb5۪F\x87\xaaY\x87\xf8\x8f3\u05cf\x93\xa4\xe4\xa3H?Vu>[\x98\xd7\xccMav\xf2\xbd\xf0\x90K\x9c\x12\xfe\x9a\xe3\x91&\b\x95 ?\xa4\t\xb9\"\xa7\xa7\xa0y\x9b+\xa4\x93\xf4G#1I\xe0Ư2\x16cӾ|\xf1\xf1e(_\x860\x02s\x95LfL\x8b\xe9Nd#\x91\xac\x15\xe42Bv\xa8F\x9e\xfc\x80I\xda\xc9\xe3\xb1M\x8c\xd8y\x97<\xba1\x0e\x03N\x90\x046\x01\x92\xc96\x91k\xa8\x8b\x84\xb5\xda%\xb7C\x00t\xc0ϴ\xa3$\xc1\xf7\xd6@\xba\xd6'\xb5ZZ\x10\xe8\xf6\xb9\xacL\xf6\xbe\xc0O\"f\x92\x1aI\xe964\xe8\x8cZ-\xf7j\x13)\xee\b\x99\x1a\xbc\xcbx\x87\x03\xae\xc4ܹ\xe4\x1fVA^#\f\x86U6Z\xa1DbQ\xb7\x8a\x01\xeamA\x9c\xb0[\xbdD\xa0\x05\x926;\xa0g\x848\x90\x92\xbd\xd3tM\xa0\xf66\xad\xb6\xe7$\x9dҩ\xde!\x8c\xb1q\xb6\xf7β\xbdWEd\xb7\x92\xf9ᬜe7\x05\x80m\x95\x17&L\xe2`*\xbe\x9c\x81\xcc\x19<\xa1\xf6Z\xcb1YF\x84b\xbaΊM\xfeRW\xff\xcb\xce/\xf1\x93\xc1J\xb92z\xc6I1\xae\x85ɹ:\xe2\x9auU:\x8a\xa3\xfc(\xd2\xdc0\x8e+n#7\x8dZO\xdc \bE%\x16|Zq+\xe8ɰrvS\x81\xb05\x1cXs-\x9a\x8d\r\xa8C\x90\xd9\x10\x1ae#\x8e\xbam'\x95\xf0D\x84\x8a}\x00\x99\x110\xf1\x14ʊ\xfan)\x02˃\x91F\x9dO\xa1U\x16\x19\xf3hL\x1c\xfe\x1crϷm\xd1\xe0d-j\xb4\t\xc4\x14^\x9c\xcd_\xe9a\xfe\xaa\xf3\xab\xe2l^\x03\xc6+'\xd1\xc0\x8a\x0e!\x9e\xa9;\x14\xa6\x1a\xf8)ϱwO\xf2\xaf\x87\xf8jP\xa5\x80Y\xc3V\x16\xb6\xe0\xf4\vB\x85)\xe1\x98pQNYa\xc7acs\xd25ȓ\xde\x13Ep\x91\x01xc\xc8յ\x8c\xd0\xcb8\xceFE\x18_;E?$\x93\x99\x05\xc8e\xd6X\x11\xb7\xa3\x9e\xe8\x06\x8d\xd0\t\a4ĹO\xf7IXo\xa7peI7\x16h\xc6{\xf4a)\n\xa4\xdb:\b\x14\xbe\xc3iHU\xf7\xeau'\xac\xd7%\xc2C\x85\x1dq\xfa\x9c\xd0\xe8^\xb3lr\x8e\x17g\\\"\xb7=\x8eS\x9d\xab\xf0\xdc\xcd\xeb<\xe6\x88rY\f\xf2\x18\n\x01\x18\xad$\xfa\x94\xe5\x92\xd5рe\x14%\x05\x18\x9fN\x9c\xcbZ\x1dw\x9f\x85\x1dW#\xb9\x1e\vz\xa2\x1b6\\\xc7\x1dt\xcc\xe5\x04\r\xb7\xfcB\xba\x19\x82\xeb.\xe9\xe0ʅ\xbcW\xefVb\xb6\xb9Φ\xbd\xaa\x1f\x84\xe9J{\x14z.\xd7\xd7\xecao\xa4\x0eV\x80\a+(\x9d]\x8d\xef\x16Ɛ\v\n\x04]\xcf]MYq\t\xd0\xe5\x88\x1f\xd8y*\x1dd5\x88\x82|cG\x84\xe8u\v;U;d\x91\xbaJ\xe5]Z\xabU\xd1q\xa8\x1d\xaa \x91A\xbd.סC2IN\xf9\b\xc2\xe8\xb7\x1bB\x18\xad\x1c\x03\xee\x8fo\xb2\xe0\x9bj\x95\xde\x12\xa57u\xc7\xea\x89t\xa4\x19j\x02#\xee\n\\:\x1c\xa7\x1c\xb3>\x9f!.V\xf8\xfb\xb7X\xe9\x01Jwe*9\tY\toD\x1d M\x8du\f\x00\x18\xf6\xdc\x01u\xf5Z\xa5g\x06\x9b\xe8T\xed\x88\x05\xb9e\xee\xe8\x1d\x1d)\xd4\v\xa8\xbd\x9e;`\x1eU\x15zFm\xe8\\\xc58|\xe1\xef\xff\xea\x17\xa6#(\x9d\x8e\x95\x80\x18Ft\x90\x9e\xef\x85=\x00\xbd©H\xbb\xd6A\xa1\xa5\xda\x03z\xb4\x12~\a=\x13n\x9f\xe1\xbe8\xe3\xf7\xe3\x7f\xe6.\xf7\xae\xf5\x9aO\xb9+*\xbd\x81夿+V\xdd\xc0\xc2\xea\x96~\x87\x12uK\xc5\x1c\x011\xc2\xd9'\xde\xc8X\xaf#\n\x15\xe8\x18\xe3e\x17\xc4%7\x11C\x8c\xcawƋ\xea\x13ˡ-\xf4\\/\x8d\x16\x90b_\x1c\x85}\x9a\x95\xbfD\n\xbf\xf1\f\xa3~\x17\x1b\x8a\xf8%\x8fb\xbe\x94\xddZ\x18\x11\xfa9\xbe׀\xba-\xa7\xd1.\x94OC\xd0߷\x8et\xb0\x8bu\xdd}k\xb6:Ѿ1[9\x84\xc6l'\xca\x1f\xea(\xc3h\xa2%m\xae\xb8\bip\x0f\xec%؏\xe4vՐ1R}!\xce[[\x1e\x83\\\xbb\x92\x14.\x9b2\x9e'B\xba\xc5)L\xeb\xbdA\x9e\r\x15\xf6\x11\x9e\xae\xa3O<]\x88\xec\xcb\xc3%\x7f\xa6g\vQ\xf6\xba\xd5l6u\n\x0fFu\x1bn\xbb\fs\xefZ@)dE\xf8Ա\xc0D\x12O\xdf\xd1'\x9e>\xd9r\x8a\x0f?2*\x17\x85ZK\x8f\xa4\x84\\6o\x18Ց\xb5\xac\x96\xf4>;`\xadZMd{\xaaV\x13\x8c\x99Zg\x82\x14\xda+;ƹc\x9b֥\x8e\xaf\xcd\x19\x9c\xd3,\x99ڂ\xc1\x01\xceR\xc8>\x93\xac\xee#n\xb7h\x8b8\xea7\xe6\x00\x02\xd7 \x9b\bMS\xc5B*\x0e\x8e\x90\x85)Z<\x9fr\x86r\x13\x84\x00\xfeaV\x0fc\xad\xf4\x18\x98s\a\xa49Λq\x16ӓ6Oc\xdbӣ\x8f\x80\x13X\x91\xea'\xee\xea\xa3O@\xa7\xa1#\x8dvz\xf3Ag\x01+\xc8\xed\xa9\xe8Q\xe0\x18\xef\x1a\xc1\x8e$\x82m`\a\xd4]@\xa6;a\x9d\x89n#p\x02\xe3f?\xfaX\xb4\xfaS{y\a\xa2\x1dvR\xf2Mu\x92\xba\aQ\x8ag\x03j\xe2I8t\xf4\tp舛\xfdC^\x91\xfcY\xabe\x04=\xa6\xf2`T\xabeC\xd3Y\xf9\x14\xe0\x95:\xcf\x00\xb4\xae\xb9T];\xe5\xf4\x9aÏ\x13\xfd\xe3P\xfe\xb8\xe6J\x16\x889R) fC\xd9\xe0!G\xcc\xe7=/ӯ\xc1#\xec]\xcc\xfcZ\xad\xb1\xae\x8f4\xbcۙ:\x13]'\xdd4\xdd)[\xb0\xed\xad\xad\x8d\xad\x9a\xcd\x13\xd6\x02\x82@\xbd\nxM/\x94GAݶ\xf9\xc1\xc1A{\x9b<\n\xea\xd1#[ȗ\xfd\xfd\xf66hS\x91\xa4e\xe8\x9f=\xc9\xf1\x95!g\xad\xdd\xda\xd9\xd8\xd9l\xef\xaeo&\x1b\xeb\xeb\xed\xf5\xf5\xad͝v\xcd\xe0E\x1fs\x9b\x1b\x9b3I\x94\"\x86ޛ\xad\xbbYŲh\x81\x1f\x9cU\x94\xab\xa9\x8a\xcc@\x9d\xd4\xeeHX\xa1\xeb\xec\x18\xfacȰ\x85\x8e\xf1*t\x8bKmެ2uk'\x1c\xb1j\xd0'C\xb6\xd0;\x96-\xc2#N:\xfc {\xef\x90\xe8\x1d\xe3kF\x0e=\xd3O\xb8\x1d\x91\xb9\xa1+o\xb0\x8bS͜\x83\xcfy\xf7\a\x942=\xd3R\xb1҉\x01V\xef\x93pĳ\xf2:Eb\xba\x99\xac-k\xe3\xe5\xca\x1a\xf5\x95\xabsC\xfb\xcdL˄,F\xa7\xfd\x06\x16B\xfa\xa4\xb3\xea\xa2nU\x86\x92\rw\xc61\xb0\x1eD\xa45XS?\x18\x02\xc1\x1f9\x04\x0e^`t k\x0e\xc7M\xdf\xc2Z\xa0r\xe0[\xceZ\xf4G\xcen\xe7\x90Z\xafS,\xcc\x04\xa1\x86\x1c\x05\v\xa5\xf0T\xb0\x16\x95Ȗ\x9eVĵ\x04\xdbh?\x12u\xde\x04\a\x190U\x87\u008eH\xd22\x16\xc8\xe4L\xbe\xcc:\f\x93\xf6\xd4\xe4ۃB\xb8\xe4\xddrB2z\"e=\xcb,\xbc\xf7\x98\x0f\U0009faef\xe4I\xc8ekN\x15'\xe6Y|\x14\xcc.$\xf5[\xab\x95\xa7/\xd6i\xd6\xf5\x9a\xe7z\x04\xdbM\xb0z\xfdkN\xb3SZ\xfb\x1a\xe6\xf5k\x0e@\xe1)'/x3\x96w4I\xb5\xfc\xd3*\xdf \x7f\x80\xc1\x0f\xa8xa\x0f\xbc\f\x83\x06:ލ\xbdL\x1c\x1bW܈cdb\xd7\xf7\xc3+>\xaa\xb81\be㦅\xfb\xef\x15'\xa5\xd6.6\xa7\x8f9\xbd\xe5\xe9XUD\a\x8cg\xae\xdf\xc1L@\xff\xd6T\x8e\xecx\xc5\xec\xf8\xc7MjQ\xfce\xdc0e\xd9ɒZ\x96\xe8\xc2ܿ\xe2\xa6;\x9d\xfa7\xf2\x9e1\xf5Q\x96o\x05&\n\x8b\x86C\t\xc2\x11\aV\xf1₽\x95\x81(E\x88\xce)\xddJ\x00\v\x98\xd6X\xd1\xcdT\xc2@-&\xac\x99j\xc90\x85\x81c\xf1&Ֆ\xf7\xe2\xa3t\x0f\xd0W\xb9y\x00\xb3\x9a|\xb0\xe1\u009a\xdfΩ\xf5\v\x8b\xc2\xf1\xae\xb62ÛTr7\xb7\x8d\xb8B\xaf\xf5M\x82+\xa9Gy\xd0\"\xd2ϫm\x8c\xfc\x16ㇶ\x9d\x14@\xce\x02\xef\xc7\x19\x7f\xf6\xb4\x83\x1f\xf6\xb2\x0f:f\x95\n\x04]\xab-$\xa5e\xe78\xf0\x17\x9c>-\xbd\xb5U<\xa9\x0e\xc0\n\xfb\x05G\xc5\x14\x95&\xd9\x1cp\xe8\xe8cά\xd3S\xef\xe2b\x86\x1b\x19`\xe5\xe9\xa9\xd5Y\xa1ek?\xe6L\xfe\xb6\x1fs\"\xab\xfa\x9c\xb3\xf66\xfd\xc0\xc1\x96\x82f@2\x9b\xabop\xae@\x12S\xc5k-\x15\x93Ly\x04\xd1(qoV\\%\xb0\xf1\xc4y\xc5\r*\x9e\fo\xc6+\x80\xe6\xe5\xe0\xf7\x03^\xa2\x99\x19\x82@\xfa[.\xad\x10\x86\xd2\n\x01ҚPߋ\x99\xc0Ȉ\xb1)\x1cӨI\x00w\xd17\u070e\x14QQ\xea\xc4\xd8\xd0h\x16\b\xa4\"*yu\xa6\x04\xf6\xdb2\x95\xa1\xaf8\x9a\x19<\xe0\xf4\x94\x13\xfa\x80\x17\x94\xbe\x14\xf9 E\xf8\xe9A\xa3\x19Z\xb4r\x10|\x81\xa6Ϯ\x19\xb6.\x85\x7f\xf5\xf6A\xaaP\xb5x\n_x1x^\x92J+\xe8\xe9\xf8=\xbf\x01U\x15\x88\x06E:\x12\"\xc3o*zQ\x1d4&\x10\xf5}\xc0?Q\x03\xef\x85;͔-\x1fܟ\xaa=\x8d\xc2Pt\xb3\x9f\x90\xdd\xd6\xd24\n\xd9\x1dQ\xa80^V\xa1+R\x85<\xb2X\xe6Y\xb0\xa2\x1b3\xf4;\xf9\f\xbe\x9c\xd1\x12\xee\x85X\x9c\x1d\x19п\x8c0\xc8\xfaqV,$\xad3\x9e\x05eŊ\x1d)\xe9\xc6\xd9b7d\x81\x82U[Z\x00.\xd6t\xf7\xa9\xcdҕd\x06q\xf2\r\xf6\xf8\x00\x8b\x96\xd6\xff,Xl!I\xec\bE\xd6r\xb14\xcf|\xa2F\x7f\x14\x01\x9a\x05YSʌ1v֕\xb9\x9d\xa0\xd0\x0e\xba\xb8\xbe\x93\x1b\x03\xbf\x14\xe1w\x1a^\x05<z\xf6\xb4k\xa7\x9fY\x8bf;\x89\xa1\x9b\\\x95\x17@\xa0\xea\xa7Nr}\x81\xe6<U\x99B\x10\xac\x14:u\xc1\xa3I٥;VcT5\x9a\x17\xe9by\xf0\xf7\xc0L\xf8T\xa8D\xd0\x050\xd1&\xa55\x99\xab\x90¹\x92\u009d\xd2\x1d%(\x8c\x90ڋ[\xaf\x14]\xc7\x16\xbb\xea\xa9\xd0\x06\x80\x97N\xd4\xcb\x04\x97\x83yyO\x9fr>]1o\xe7\xe2\x8e9\x83\xf2\v\xf3v\xe7xu\xf5#a\vB\xa3\xa55\xff\xc1\xcf#\xb4\xda5~\x7f\xc4|\xc6a$\xca\xc0ŏ\xc2\x1eF\x9aaPV\xe8\xf1M9\xc03\n\x8a\x92\xa2\xb9;\xa9\x84%\x86\x93\xe1\xc6/$n\x91\x89\xbe8̹h^\xb9\xf1\xa1<Y6\xe9\x82\xfe\n\x0f\xe2Yď\xe1\xb0\xda\xf9\x93+!P\xa1\xf9\xb4\xe6巎>\xf8&00[\x81k\xf0\x84,\xd4\xfb\xec\xe2⮚\xcdZ\x16\xe6%\x1d\xd8\xf2\xf2\n\xa6\x14JޥH\x0e\xfd\xe5\xcb\xef\xaee̽r\x06|\x06\x00k\xb5\xecw\xb3D\x8b(k\xbf^\xa7\xdc\x16\xa0\x1c%@#*\"sB\x05\xa1\xc1BG\x8c\xf9)ے\\\xc3\xea\xc2\x1a\xf1n 2(m\xc0h\xcaM\x00M\x9c\xc2\x06I\xb5a2`\xdd\xc6\x149E^\xfc\u009d\xb2o%\x97\xebKN\xbfZ\xd0y\x7fq\xf8J\x1aF>\xe7\xcc\x1cJ\x86\xc3~\xa1\U00108c1dB\xb3J\xf1\x8c\x19l\x80\xefRe\xa2\xb2\x02g\x9e\xb8p\xa7Zo\a\b\x85\x98EY\xe1_\xaf,<\fg\x81XZ\xf6\x97+˾\xe77_\xc2\r'\xf2\x1d7\xca\xff\xeac\xcbߘ\xa5\xb9ȕ>\x859ԙO\xb58-\xca\x14\x99\x86\xef\x19\x97+\\\xabE\xc2V\xbf\r\x94Z\x88\xdc\t@\xdb<\xd8z\x02\x95w\xd3lQ.\xdb-̊\xc3)\xb2\xc1\x9d\x16==\x9dF\xfc\x12\x9c\xb6\xa4\x05\x02QT>Λ[?\xcf4\xb5Ce`@C\x85/\b\x1a\x1a[/\xa2\xa1ږ,\xa0a~\v\x86Y\x83p\x15\xa4:\xde<I\xec/9\v\x84\xdd2\xe9\a7\x9d=\xe8R@C`Q\xe89Q\xea\xd0\xd7\xf6\x15ؾ_\xdbG\xc8\xc0\b\x98\x97N\x1b\xe5Y\xb7h\x8a\x1a\xc3 18K\xd5S*\x06\xfa\x10vB\xa5\xa8X\xb7]\xf9\xa9\x1b!\xe6\x05\xae\x11\x9c\x16\x99kr\x1eS\xcdbme\x7f\v\x87\xc2h\xb3\x87\x96\x91\x83\x9cFu\x8a|ɦXH\xb9F\xbb(/ \\<\x8fmq\xe2\x04\x00\x10B\x1a(\xa4+\x9d)O\x98^\x19\xa8Gc\xc3\a\xaa\xc6E\xed|\x06\xc7\xc5\xc1q\xc7>\xb5cBOm\x8fP\x18į c@\xc1Uv\xdel#\xe7Y\xb5\xa0\xb3\xfc+`\xd2\x16\xd2~i0\x9a}a\xfa\xe0\xc0e\xd4g\x87\xb1\xc0\xd4\"\xf9\xa5j^\xd9\xfe\xd1p \x11d\x97z̖\x1a\x81iY'\xfdupp\x10\x91\xda\r\x8du\x9e\xc0\tT\x9a)\xdf\xf8\x0e*o\xef\xef{\tx\xfc\xa0\x1ec,\xee\xf6t\xe7\xea\x13\xec\xde\xc0\xb1]f\xccDH\xa8\xb7\x1fw{\x9c\xba\x03\xa7\xe7R\x9e\x9b\x96\x99qr`\x1fK\xde\xc2I\xa6Q\x12\xea\xba8=\xe6vDh/\xa2\xc1\x80(\x01QJ\xa0\x1azX\xa2\xe7\x0e:!\v\xf5\xba\xf1l\xf7zp\xd8=<\xec\xa9&]ڕ\xa1ѕ\xac\xf5\x16\xb4D\xbd\xbc\x818\xf8\x9f\xf0\x198<I;\x10\xef\xcf:18\xaa\xd8\xdfgmٕ!\x13\xbdx\xd0IYw\xc3Z-\xae\xca(\xc8a\xc2|\xea\xf5\xdcz}\xc0\x86ig\xd4\x1cs\x1a\x82L(\xed\xd84\xb7\xfa\x99\xd1E\xbe[\x97ح\x0e4\x14aO\xa2\x83\x83\x03\xd6&^/\x1e\xb0v-\xea\nlN\x1b\xec\xa8&\xbd^0`!\xee\\\xbc'\xdcz;\xd7\xf68\x05\"ٔ\xf4\x06j\xf6\xa3\x85ُY\x04\xea;>\v\xec\x98t<;&Ib\xfb\xcc/X\x11d'\xe1\x9c\xcb@N\x84\x86\xd2I\x95\x9f\xceƅl:4zs.\n\x84&\xb2\xcbR\xf4\xb6V\xf3\xa4\x05C\x9a\x02:\xcaJϿ\xcbM\x03\x85\x91y\x1c\r\x15g\xed\xf7\a\xed\x99s4\x01T\x9eil\x15>\"Jݑӣ\xdd\au\f]\v\x1a\x92\xaepB\xe3ʸ(\x0e\x05\x0e\x9e\x1d\xb1\xa8)\xc39\x95\xceV\xa6k\x0fS\x96\x12\xd6N\x96\x9e$\x06\x98L\x12\xd0\t\x88\xd2|\xf7a\xfc\x04Lt\xf3\xd6\x1e)\x00\frL\x8a<\xfeu֍\x1c!\xcdA\x90\x8b\xe5\x98sJn%\xe3\a\x83vk\xef\nz\xef\x80\xfak\xd4\v\a)\x9f\f+pr\xe0Ўz-\xf3\x82\x9e,ܷ\xd8\a\xea2ahE\xb9R+J\vb\xbb\x91\xc3i\xcc\x02\xdbK\x97&f\x8cy]\xee\xc4\xf3+n\x87I\"-\x16\xb8\x00\xef◮\uf340}\xf5\n\"\xf6\xcb\xc5\xf5\x99\xba\xda茅\xdd3G\x8aJ|Ps\x1f\x02\abF\xf3K?d\x8cͺ\xdc\x19ʻB1ol\x9f8v(y\x8c\x9c\xe0\xcc\xf8th\f\xef2wW0\x9b3{wkwoocsk\xaff\xf3\x06\xe3(\x9e\xdc\xdchoom\xedlo\x11R\aI\xe7z-ͥR6Im}k}{s\xab\xbd\xb1Ey\x1d\xca\xed\xd2\xf6\xfaN͖/\xedm\xa3՛\x85I\r\xba\xdc94\x8c\xfcÞ\x18\x00\x86\x92\x959[(\xa3%Su\xb4a\bj5Q\anP\x98^\xf8\xb2\x0e\xde\xc9\xe0X\x06ä#\x1e\x84c\xf1~\bP\x8cĨ\xb7o\xbb\x00\xc5\"\xea\xb1F\x9b8\xf8\xc2{q=\xb3\xcbr\r\x1d\xfd<ޓ\xf6\xa8\x81=\x8a\xa4*Izir\xe5#\x8f\x17n\x1c١\x80(\xf0\x0f6m\x01\x9a\xb4yJ\xa2\xe7\xb26\xa1aσ\x9ex\xf5L\x8f6\x9c?G6-\xe0\x1c\xcfy\xefb\xc0\x9e녧\xe9\xafg\x01{\x9e\xb1\xe5\xe8\x17\xab8\x97\xc5\x1b\xc9ĵU\xefR=Jw\xdfC`\fR\x13nG\x144!\xe1\xe8\x90t\x05\xddA\xafmx2\xc97]\xca\xd5\xd3HOև\x98\x85x\xdc\xfc|_f\xacE\x87\xcc\xd7}\x99\xed\x0fk50\x06\t\xa8ߛa7:\xb3z\x9d(O5\xb3\xfd!\xacǴ\x8b\x1f\xdb\x03\xd8$Nl\xaaW\xc2gīl0S\x9a\x92Z\xed\xd4v\t\xad\xc6\x12\xae\xf9\xa9B$\xc8\x1f\xa7\xb5Z5\xae\xd5t\xe2\x01;J\xe5Èc\xf8x{b\xdbc\x90v\xa7D\xa3F7\xcfٸ\xeb;\x87\xb6\x9fY\xa7t\xe3\xee\x8c16l\xb4\xbb\xe7r\x9b8\xe7\xbdـ\xe5^z\x01\r\aι\xbc\xbd\xf0\x85\xd0q\xd76g\x86\x9d+^\x9f\xc6r\xe99\x99\xcf\xe9ww\xafzA\x86\x8c\x18\x90\xbeg\xda\xfb\xfb\xb6\xad\xbd\x89\x80n\x03'\xb5\x1bد\x06Q\xd8\xc9.\x17ۭ\x85\xa4\xab\xf4W\x90\xd0\xeb]\nHl\xb4\xc9@\x8a|\xeb\x13\xd5n\xa1owl\x8b\xb4\x93\x11ږ\x1cs;P\x9dT\xf8\xa4\xe8F\x0e`#\x02pL\x9f!\xf283\xbbI\x87\xacUe\xf6\xac\xe6K\xdd\xe8a\xad\x16\x9aT\x02\xee\x06\xb9m.\x05dk\xb4\t\x1d\xcb\x1ap(\xf4\x9c\r\xbb\xe3\xdet\x90zA\x01J\xe6\x9cr*\xea\x13\xa3\xafP;(Ĝ\x17\xf7\x1949\xaa\xd5\xc6\xe9\xf6\xb9N\xb7\x0f\xa2_c:\xa31\x1da\x05\xb0\xadG\xb5\x1a\xa8\xaf\xe8\xfc\xb5Z,\xecq\xaf\xfdn\x9a\x9d5\xf9\xaa\n\x8cj\xb5v1\xff(\xcd:\xc2\xc1]\x94\xee\xcbK6쎺3g\xf6\xcewf\x89O\xaf\xf0\xfdF\xd8c\x88\xe3@/\x88s%\x7f_\x10\xe7,K\xd5+\x7fѵ\x8d\x89f\x97&\xa1\x7felK\xc4;/\xe9\x15\x99\xd3_\xffv\xfbraO\xea-)7]\x98\xc1\xec\xae[\xd8wNPh\xfdw\xbc\xf3$ؚ\x99\x1bg\xc8f\x80\xa4{c\xdb\aQ_Ɏ\xf3\x84=,\xddH\xa0D\xb5X`\xcc2\xbe\n.~\n\x9f\x1a\x8d\xf1\xfei\xba\xad\x90ܘ\xd11\x8d%i\\\x19\xd7\xebX\xc1y\xe96\x18\xb1\x1b\xc0/b:\xa5\xe7\xe9\xe2\x9ew\xed\xac166\xd7vd\xac-b\xf6c\b\xfaA\x7f\xf9?\xee\xa6\xf9\xe5\xefhaq\x11\xf1*\xd7\n\xaf\x8a\x8a\xd5M\xc7J\xc1\x13\xef\x0e\xbc2\xfc\x8c\xcdI#*\x81tFb\xfe\x8f\xb9\xc6b\tA\x86&\xf5.\xd9eƐ\xa8\xdfk\xbf\x9b\r\xfe\x9fpo\xfdr\xa1o\xe7dN\x7fu\xf7~\xcaȠ\xc8\xe0\xf3\xc1<v\x8d\xd7\xf6\xc0\t\n\xd5ݵGr\x98\b.P\xbez5\x1f\xb6\xdf\r\xf5\xa4\xa5m\xc5\xc4\xdc$\xb1\x14\x0fº8~\xb7d\x96\xbbv\xae8\v\x8d\x89Y\\49\x9b\x8e\xbd\xb0\xf7p\x1f\xabτ\x14\x90/\xcdn\xffeY\xe2J\x05[\xbds\x03֢\xa1a\xdf\xd7\t\xc0D-X\xb0/\fA\ru\x90\xd9J\x17\xae{\xdd\xe6\xaf?\xba#\x12\xa4.\xef\x86\xe2:f}@2Mib\xb9MӤ(\xeb\\aW\xac\xf4\xacÍuBuV.\xe8\xe7\x84r\xc3ߥ\xf4\xd0f\xc8R\xf4\x18\xb4\xb7$\xc8D\x053\xb8\xca\x1d\xa1\xac\x8c\xd0|\aGIC&\xa4bt\xbd\x0e\x83\bT\x9b\xb7\xca\xec<\xa5w\x90嬿ε:W\xa0\xd7\f\v\x84\xfb\f\xed\x9aTZ:q\xa4\xa4\x12\x8fǽ\x1c\x03\xbc\x1b\xa1\xb5hV\xb7\xae\r\x17èKM\xbe\xfaPZ\t.\av\xc9U=6;\xa0\xd3:\xb9\xc9a\x91\xb0] \xecu\x98\xeay\xfe\xb3\xf1\xbb)Y\xe7\x9a\xc1\x03\x06\x18\b؎\x04\xbb\\ۤ\xd7\xf0X\xa7\xa7\xf8\x96\xc9JND&\x05|\xadH\xfb\x82\xa2-^\xb4\x87\x90/\xe3+\xcbm\x19\xdah2\x18\xe5\xecx`\x81\x82\xaep\xeco\xf0<\x06\a\xadZ-ؿ\xec\xbe\x02\x1d\x90\x80N\xa8\fu˯*\xc7\u008e2\xc7Z\x848b\x15\x0f\x05y\a'\xde\a\xac\xb5L\xf1'Ǻ\xcaX\"\xa8\xf8cP⇢D\xf3\xe7\xbd@͟\x13AO8\xa1'\xa2\xdc\xdd\x13\xccv\xce\xe3Ӊ\xf8D\xd5\x1a\xf0ʑy\xb7:\x11+\xe0\xbc\xf6i\x91\x1a\x86\x10\xb4C\xe0\x86\x1d\x82\x12\x1b>\xd6\xc0\xb0\xaemY#o\xe2e\f\x93\xa8V\x8b\x9a\xd2\xea\x9a\xd7n\x06\x99^^\xbe\x03Kuq^\xe4䙹2\xcb\x15gL;\x17\x85\xbbb\xbf1\x1a\x1bq\xb8\xa9\n\xd2hˏ\xf2\"\x95\xc9S0=\xb19mk\xc9r\xae]/\x88y\xb4J\x17)-\xdfZ\xec\xf4\xefD=Ŝi\xf52t\xa7\xee\xd0\x137\xa9\xea\x8a\xcf/\xb9\xcf&\xa6\"\x8b\xfc)\\\xcf\xffh\x9d\x96\xd7\xc2.\x8e\x04\xd0\f\xb6\xe0`'S{\x10\x8b\xe6v\x98\xb0\xec\xb8E\xe4\xf6s8>\xe8\xf7Ss\x02:\x19\x17\xb3\xd5\t2-i\xb8{\"<o\xa2\x1eP\xde\v\x94\x05d\xbe\x87a\x99\xee\xc8\xe7jG\xb5h\xa3],\xa1\"\xf6\xad\x18ֽ\x86\"p(\x826\x16\xc7Q\xa6\xed\xad\xe0\x06\xefEe\xa3X\xe8Qa\x1c\v\x83X\xa6l\xf4a\xb9\xb2\xd1b\xf9e\xcaF\x1f\xeeP6Z\xaci\x89\nχr\x15\x9e\xf2\xf2\x1f\xaf\xc2\xf3aA\x85\xa7\bo\x00\xac\x97\x01\x8fl\x7f\xf0\x85R\x9fjY\xaa\xeb\x94v\xa4ʪ\xb4X\xfb\x1d6M-\x1a\xb07z\xf6\xc9]\x06\xe5\x81aK\xce\x18{)R\x03\xa5\bl\xa7\xc9|i\xf3K1B\x85\x06\x1a}\xb0\xe1:&\xe0\xa9\xe7\xa5H\xad\xf3\xa3%v\xf9'\xe2w\xa3\x02\xf2J\xd8&\xfc\xa3y\xf8gB?SA$\x83}\xf7\xd3\x15\x91\xb3\xe3\xc5pi\xb2C!\r\xa0DQ/\xe4\xebg'o\xa4b\xc8\x13\xc1\xcc\x01f\xc8α0\x14Cr\xde\x11u\x83b\xfeD\x002\x00\xd0\xf6\x89\x00\xfe\xf2\x13\xa1\xf9\xcbO\x84R9}\xaetO!%U\xfeL\xf3\xc9\f\xfa7\xe4Q\xa4\xd6sMtei2\xaf\xfe\r\xe9Z'\xf0y\xaa\x1e\x98\xa6*-\xb7\xe79\xa57\xf8\x9a\xd7\xeez^\x90DA\x8eL\x01빡\x8d%\xbfd*T\xcfs\x1aUXs\xa6\x1f\xf5\xdcԖ\xa2ǋ\x97\xffc>\x0e\xa3E\xa5U\xa5\x93 \xba\xed\xfd}\xe1\xa4W+ξ\x86\xc8E.O\xc0\x90\xadT\xbbAl\xf4`y\t\x85E\xf6\x06\x94+\x06\x19pR\x00\x01\x85\x92⠕\x8a\xad\xd3\x1az\x92.\xb2C\xe6\xd5j^\xae\xef\xd0\xe5\xc6\x04\xa0\x01H\xaej5\xd7\xec\xd8\\\x11S\xe1Boc\xf6\xb5F\x8c$\x83\xd5%\x19\xf3\xa3\xd5\xf1\xf7\x83\x8e\x0f\xb2\x16վ?`y)qX\xab\xd9Y\xe7XHh</\x9b\xe0ñ\xe0Ѳ\xf9\xb5\xf5\x04\x13\x15\x8c\xe2\xeeI\x062\xb2\xd1\xce&:<\xb8\xa3\x949\xa5\xae9\xa5\x92\xb6\xb1\x03\xe6\xd6j\xae\xd9\xdb܌\xba\x92\xef\xbc\xd0F\xa3\x9d\x9bg\xb9`Ɣ\xaa\x8f\x9e*\xa40\xba\x10<\"\x81\xb4\xdfK;\xc1\x02B=I\xf4\xfc \xe83A_\x8a\x9cm\xc3\x1ba\xc2p\x9eB\xad\x80q\x03h\x85쭐\xa2).\x81T'\v\x9b\x90)\xefH\xa0\xd62\xacL\xbc\x12\x91\xb3\xe8\xc6R\x1f\xd4W\x1fsq\x142>\x8b\x87\x01Hp\xf6p0\x0e\xcaJ\xf1'\xf5\x99w\x10u[N\xd4\xf0\xe8\x8c\x05\rO\xf7gvpY\xab\xd93vIhޟ\x85\x0f\x82\xd1\xd4~O\xa8\xc8\x16\xa2\xdbh\xcc\x1c\xbf^\xd7\xe51\xa4\r\x1f\xccs\xa1\x1b\xb2\xc8)1\xf5\x99э\x19sU7܃\x83\x90\x0eY\xbbn\a\xf8;\x13\xc8b\x87\x86\x85\x0e\xa1k\x91\x0ev,\xd6(\\,\tM\x8e\xb7UFUƈ\v\xc3I\x9b\x99\xcc;5\x84\b\x870tf\xf5z'f\x1e\xb0\x94}0v\b\x1b\x13\xea\xd6\xedh\x7f?$\x10y9\x1b\xcf+Q\xce\xd6\xca\xeb\x8f=\xc9np\x8d\xd378͐z\xfc\x9d\xe2\xf4\x82\xa6\b}DS\xb5t\xaa\x91\xf9\x90\x9a\xf7\x97KS\xa4ޣ\x05\x9d\xc78\xeb\xe7kC\xe1\xec\a\x91$\xf6\x0f\x82!\xb9ܢ\x13\x93t}!\x8cSo\v&c\x1b\x90\xaaa\x11*\x0fi\xaa\xaf \xf6S\xc3ԕ\n\n\x10\xc9\xe0sy<\x10\x03n\xc1\xa6\xc5\x04 \x01\xda2\x1170\xd8tֳ\xc3\xd3\xd1b`y\x9f\x87ZG\x90\xbaR\xef-\xf39\xf0\x16\x95\xdf\xf4<\x92n\xc0\x9e\n;((\xc2\xc1b\xb9\xc4\t\xe1[\x98\xfb\x96\x9e9\x99\x85j5\xb8\x82\xe2\x9a\\\x8f\x90\xaa\x0e\xddKs핰\xd3\xf1P\x13\x14\xa4m\xa2Z\x9b\xa12\xf6T\x94F\x1ab\xa8ׅ\xc2\x10^\xab\xc5\xfb<\a\xe7\xf0n\xf0\xb5=hNl/\xdd\v(\xd0:3\x8e]/\x86\xc0sO\x95\xf2Cc\xa2\x1a,\xaa@\xd86BL\xdc\riA6\x04e#\x95\xd37\xabDh\xc3\x15\v5-\x99\xb9\xbb\vk5T\xde(\x80\xe9\xaeN\x90\xf4\xb9\x97\u0557W\xa9\xfa:\xaf\b\n\xb6qR/\x80\xa7\x1c_\uea2b\x1b\x16P\xc1u\x04\xeb\xc4\xe9\rrA*\x1e\x8b\xcc=\xd0\xc2&\xca\xf6\xb6\x84Ԑi\xbf\xbd\xbf\xaf\x17\xae>\x91\xf0'\x85\xf8\xb85\x03\xa6\xbfw\xa2Z-8huH\xc44g\x04\xe4jA\xedf@\x83\x06\x9bd\x0eT\xb2\x0e}\x9ei@\xa4\xbe5k\xca>\x9e\x9a\xd1:\xec\b\x92\xb2\x03\x92)\x0e\xa1\x16\x9e<+j\u05f9\xb9+\xc8ca]\xd0\xd8\xf44\xe8:\xd1~\xab\xeb\xd6#'\xacG0N/['7\xb7\x8f\xbc\x03\x16g\xf3\x82<\x0e\xdb\x14Ǥ\x9bz\x96N\xc8\x1040\xea\xc3\xfdV\x87̘Z\x98Y\xad6˭\x7f\xb7\xa7\x0e\xd0l\x00k\x14\x10\x88\a\x06br\xbf\xce&\xa43\xc4XRlHC\xf8\x13\xc3\x1f\xb7ΆY\xcbSX<\x17$\xcbo\x05词\x0f\xa0\xb4_\x9f\xacn4m\r\x9a\xd1\"<\x05mFl\xbc?\xed\xe2\x0e\x89Aue|0\xedf(a@\x1c<s\xe7\xb5\xda\xf8\x00\xd4\xd7\xf6\xddZ\xed<W}\xb6;.\xd8\f\xce\xc1\fZ\xbad~\xe7\xf2`ҹl0\x15!\xee\x8cM\x0f\x0e\x0e.k7\x9d\vv\xa16\xca\xd9\x00\ndo \xd9\xd7o\x90{R\xbb\x19\xb0s\xb8\xccbh\xd8\x1eA\b\xbbQ\x0e;\nh\x8bƄP\uf00d\x89\xd7`c\x1a\xc3\x1f`\x15\xcd$[(WHa\xa9P\xcaˌ\x97\xbd\x830I\xc6\xfbS9\x16Xʙb\xa8_1\xef\xe0\xe0\xc0\x97\xd8\xddU\x95\xb1\xb1|\x95\x8e\xba:WpY\xd71\x1e\x9aO\x1e]\x11\xea7\xb0a\xb5\x02\xbd\xab\xc1|V\xaby\a!b\x19\xb3b'|\xea5\x86\x84\xd0Y\xad6ޟ\xe6\xf2\xe8\xd1\xf9t\x8cYpo4`[4\f\x85\xcc2\x95\xe3\xb8\xe1\xd1\xf4T0τ\xc6,N\xe11\xf3S\xcd\xe4Y\n\xe9G\xf7\x83\xf4* \x1c\xc8k\xb302\vz\x98\x81\xd2\xc3T\xdaR\xa9>]\xac\xe5,>\x8b\x006\xcfX\b\xb2\xc1\x19\xf6\xfe\xc0E\xcd%\xf9B\xa8g\xfb\xa0\xa0\t\xd3r\xb7\x82f E\x85\xb3tv܃,\xd2\x11\xcbx\xdf.!Ti7\x06\xc6\b\xde\xe6\x94\xda\xf6/\xbb-\x87#n?\xd9ߟd\xd9~\x14%\xe6\xb8\xdf\x02\x1f\xf6\x1b!\xedp\xbf\xfd\x03\xb6\xc3\xfd\xc6쑲\t\x1e\xe6\xdcr?\xb8C\xfd\xffG3\xdcZ\xd1\x10\xa0+'\xd1i\x81\xba?\xa8h\xa0i\x80\xefūM\x03\f;a\x035{\x06\xa8\xd93\xc1\x1e\b\x1b\xad\xc5\x00m3\xc7\xf2e\xd1&@\x82uP\xc1\xf1\x18\x97\xcdҘI\xbd\fA\xa8\xaf6*\xf8]\ue93a\xfb\xa8\xd0\xe0\x1b ]\xee-vY\xab\xe9\x9f\xeb\x8f\\\xfcյ\x03\x06\x84l\x89V\xeb\x82OP\xe9\x86\x04T\xa4\x05\xaa\xb7\x9a1w\x96\xeeOp\xc0>'\xa49\xf6\xbd\xa9\x9dz\f7\xf1\xb2Z\xcd\x0e\xb27fN\xa9\x91\x8b\x10\a\xa8C\xad\xa2)\b\r\x99\xc40\x14\xdb\xdfKq\n\xd8(\x9a/J2\xb9\x9b\x9fR\xba\x1eN_L@\xd1<\x9d\xa4\x80\xb94d\xba4\xda6\xa8\xb2ЬD^=\xb5Yu>\xf9\xae2wV\x00\xa5@f\x94K)1J\xdcA\xe1\"\xc4!\xce\x03@jM\xb5\xea\xafL\xa6\x13\xf2\xf5R\x13\xa8Y̟\x17\xe3i\x14Cb<\x176/+\\\x9a\xf9\x8b\x8f\xc9\xfc\xdd\xc7d\xfe\xb5!'\xbc\x89\xcc\xd8TYa\xb3$\x15\xb8iJ\x98\xcd|NE\x99\xbb>m]\xaf>\x19N-\x8c\xb6\x96թK\x81dD@\x039\x87\xb6\xc2Ȩ]\xa7\xa1\x933Q\xe2I\xcd\xcc|\xee\xea|f|\xa1\xb3\x88\x8aU.>\r\xf5X\xe4\x9b,\x86Y(\a\x93ȴE0\x89z\xde\xc8)\x17+\x1dgjZ\x10\xfd\xb7e*\xb9Y\x11;g\xab\xbd$\x10C`\xa8uW\xb9\xa1\xd7-\xb4\xe3\xa4^k\xd0\xc9~\xa6\xa9\xe0y=\xfd\xc9Dz\xb1/\x89Ԁ\xdd|\xdd}弖C3,\xe1\nZŹ\x1d\x16\xe4\xf6U\xb0|i\xf5j\x05y\x19j\x16Z\x97e\x9e\xd3u\xe5\xd2\xd0(tT|\xb5\x88\xba4@aC\xb0j\x85\x8d*\xef\xb3\xc2@\x1e\x99k\x1c\xd8is\xf2#\x12usB\xc3\\\xc3a\xb4\xb2\xe5\xdcԾ\xa0\xe1\x1d\xeb\x1c2\xd7X\xe70\xe7\xd44\xec(5~\xb9\x9a4f`̓\xcao\xec\x80\xc64\xed2\x98\xf8Иr\xec.!\xb9\xb8y\xbfʱ\xd8r\xcb\x18\xa5\x80\"\xca-h\xb4\xc2w'\x9fS\x8eg\x1e\xa8\xa9\x85\xc3/\xb7诅шXU\x99\xba\xc6`\xe7\x01ʒ\xdb$\xa62\x95\xdc%\xa0\xdd\xd8h7\xa4\xc6n\x94\xdfuQqש\xbc\x98s5H\xc9\xc1\x9f\xa8\bW\xa22\xd1ϧ\u0093Jf3B\xab\xb2oer\xad\xbc\xdeD\x0ev\xc8R\xd9\xf2J\x8e\xae\x89\x8b\xe5O*\x18_\xe5g*\xc8L\x17\x8aG\x0f\xa2z\x9f\xd5j\xd5j\xba\xb3Bu\xf6\u008f9\xc0\xb2\x96\xc2\xf9\xed\xbaN\b\xe7i\xd5!\xce878\xad\x88\xea\xdf1\xb5.\x95x\x88q|!)Ս\xac\xd7ihs\x1at]'n\xa0\xad\x17\x01^U<\xa7\xe1\xcaSmt\xa5p\xaa]b\xf6k\xf1dk\uea9c\x1eo\xd1@'u\xf1\\4\xb1\xf1\xc1\\o\xc8|\xd0#5\x874\xa43#^\xd0\a;\xa4Aw\xe6\xc0І\x00\xa4\xf0\xc4\x1b\b\xb2\x88\xf2\xa0\xfb\x01\xb7I\xa9+\x81\xd2)Ł\a\xa9\x11h\xb6\x11\\\x80/\xadr\x8b\xa8z[R\x1140\xe5U\xa6\xcdiT\xe8Tl#އAH\xba?\x02\xbd\x03\xdd\xcc\xf7\xb3\xbc\x83\xc85\x0e\x17:\bf\xaa\xbc\xd4_\x84\x8d\xb1#z\x03\"\t\xba\xa0\xdb\xf3\xc06\xd3%\x94\xcb^\xab\xd8 \x97\xe6\xc1\t\v\x18x\x060F0\x12\xd7\x16\x05\xb2)\x88\x16M\x83P\xe4mz3[ƖR\xe1\xc7#\x16:Ȣ\xa2?\xa0Q]X\xd0\xefr\xd93\xb4\xad\xa3\x1e{\tk\"wU\x95\xb9I\xe2U\x99\xa73CWt\xa0μ\xcfnm\xaf\xa5\xa5\t^\xc3\xed\xf8\x8c\x81k\xaa\x98\xf9\xfb\xadn\xcb\xf1\xe5\xd7Y\x1e\x8e̔\xe7\x174\x81\x8d\x1dM\b\xc7:\xc2\r\xad\x06\xb5\x9a+\x89\xc5\x18\xb5\xa1\xecY\x1ed\x180\r\xf9\xe4JJN\x94\xeb۸\xab`|\xddEo\x1dsBg\xf7\xc2\xe8\xa4ڶ\x0e\x7f\x13\xe7<HF\xf7\xf2\xe8.\xb4-\xa3\x87f\xae\xd5\x16\xa8D\xdf\x03\xa4#U\x98$U\xdbg^\xbd\xbe曆5\xabש\xc2\x1c\x01\xf2D\xce\fbO\x90Zm\x06t%\x1c\x93ٜ\xceV\x82\x1f\x8dF\"%Z\xabE\xf7s\xc3.2\xabLUp\x01\xf3\xa4r\x90w\xc1/\x1cP\x87\x84\x06\xf4\xaa\xd7\xfd\x83tz\xdf\xdaD\t\xb1\u0082o\xfe@F\xd3\x7f\xdd\xe5\x182\xcco\xb4)\xbc\xbf\xd2\x1e\x89LLU\"+\xb3\xec\x10\x85\xd1J\xac\xf3#\xf0?\f\x82v\x9f\xd5Oͤ\xbc\xbb\x17=4,\xe5s\xf8bLj\xb5zݫ\xd5\x02%\xaf\x83E\xf6>\x02s\xfc\x98>\x87\x91\xd9\xe9\x05\x9c\x93\xc6\x10\xeeh\x95w\xfdj\xc92\x9a\xf7\x14\xcf\xddS\xbc\xa3\xc1\x99\xbc\xa7|\x86\x01\x9df,4\xec\x1d\xd2٘Q\x9f\xba Jb\xec\x05n\x81\x00y{\x9c8v\f2\xb6\xb76Y@Q\xdd\xd58\xccǠ\fR\xa3\xf7>\xeb\x0e\x05\x95\xb2@5w\"\x96c\x1a35{p\xe6c\x96\xc38f\x99\xb3N\xdf@9|\x85r\x10\xea\x7f\x04\xc2\xf11\x03\t#s$\x8b\xa8J\x11\x9e-\xa18\xa9K\x87\x9dQ\xa8\x14[\xe3\\\xc8\t\x92\x9d\xec0=\xd9!\x05(\x17.=\xd9\xca\\D\xa5u\\6\x95\xc8\xcd\x14>\xc3}\xe33\x03\xbf\x81;\x9d̯\xce=\x9f\x1b\x86\x1da\xba\x89\x00\xfb\x18jxa\xa0:^d\x127\n\xa7\xe8\xf1\x01\x18S\x0f]\xb1\x18\xcf/\xc3Y0nt\x84\xbc\\H\x06\xbdר\x8b\xc1F!r\xf4B\f?\xee\xf4\xf8@b\f\xf7\xb6XחRXP\xdd@\x9c\xa0m~\xd0\xd1\x1a\x81ă\x85\x97\ue563Z-\xb6]\x15\xfd\xadV\xf3\xed슑\xf1\xcbf:djFiF]\xe0n\xe7\xc2w\xfb6\xd7\\\xef,\x186\xa1\x980\xf6]!x`W[\x84(\x17#͈\x8ffC^d]朲\xea\x19\x17\x8b(NzU\xf1z4\x9f\x13\xda\"&\x80\x8f\x7f\x17\x00\x1ed\x00\xd5v'\xa7$1K}P\xc0\xe1Y\n\xc0S\x14\xa4*\x92d\xb6/\b\xb89ऋU\xd4\xdb\xc4A\xd3\f\xc8\x1auC\a\xbc\x95\x0f\t\"G\xd5\x16X\xe2J\x86\x80>\xe8 \x8b'\xd4\xfdT\x06A \x81uop\x8f\xfb\xd8\xed\xa4\xba\xb6&k\xa8\x8d\"܌=4K\x0f\x1cz\xb7a셔\x02\x01g\x96\x82\x12\xa2\xad-$\xf6!\x90`ճg閊4\xb8\xae\xd7\x11`wb\x89/\x83d\x9f͊\x1d\x97\xac\\\x97)\xd5p\xd3ơ\x00\xdd\xfd\u0092\xe7\xd0\xec\fO͟S\x84\x86zJ\x8aT\b\x91\f\xf0t\xe7fm͢\x15\xcc\x0e\x83\xc5Q\xab\xad?\xe2\xda\x05~\xb4j\xdb\x19\x97\x92\xf4\xe6{\xf7E\x11d\x9b\xccM\x12D\x04#[\xa0\xeb\xfb\x10B \xaa\x14\xaeR\xe6\x84\x06\xb8\x8b\xa2\x95\xbb(\xeb\b-\xec\xa3\xd7P\u07bb;8\x88]\r\x93\xc4{\xb8\x0e\x1b:c=I\x18\xdf\r\x1d\xef\xe1z\xf7\x83\x1dQ\x0f5`\x1d\xfdS3\xa0\x14g\xc9`=\fӅ\x05)\x8c`W\x91\x16\xad+@\xdc\u009e\x96/\xb0Ȧ\xa9\x17PAC\xf0\xe0҅y\n\x00Y\x10(\xe4H-Q\xf4\xd0\\t\xb9\xb7\x9c\xb7\xd2\xdb\x18Ш\xb71\x80\x80\xa2\xbd\xf5A#\xea\xadcE\xa9\xaf\x8dn\x1e\xa8\xb9=1P\a\x82\xadϝŏ\f\xae3X\xa1\xees4\x96\x93\xe1\\]\xe2|g\xbb\xa6˚\xc8P\x03Jg\xc3\xf4\xc9p\xf7,\b\xaaG\x8f].\x81\xc2\xd9Pǐ\x15o\xda\b\x8e6\xb8\xfa0\xc2L\x06\xb5Z\x00b\"\x83\x15\x7fg]4ҵd\xa3\x1a\x17N.Z4\x92\x9cUQ\xad\x16)\xb2V\xca.\xa3$\x89\xaa,\x02\x97\xfe\aFh\xe5\xf3H\xc9G\xcb\xf1;)ӑWY\xb0B\xf6\xa5/\xd6\xe6\x85\x17\xd89^\xd2\n\xadl\xa5\xc0l\x1c\x17\x14v\xb5J\x82keZ\xdaz\xd3/\xeaj\x87w!r<\x03\xf7\xc1\xf2\xa1\x00\x00 \xf4\x81\x1dv\rI\x89\xc3%\xe1\xa0o\xb8\x958[\xa4?\xc7\xe8\"\xd6]1mr\x8cPu̢f\x1c^\xf0%\x19a\x12P\xf8\x1dg\x91\xf1`\n\x94\xe8\a֘F+\x1a\xc2Y\x83\n\x160\xb5Q\x94w\"\x8c\xc1\x8fE\xc1퍩et\x11i\x17\xe1U\x1d\xe48ﹾ,\xb0x\xef9\xad|3\xa8\x88\xd9\xd4\xe72bxV\xe1$2\xba\nN\xb5\x95\xf0\xf1}N\xb6}i\xe6B\x13\xa9@\x9e\xfa\xd01|\xaeܘ\xb9\xf2\xc2o\x1b\v=W\xa0\xc2\xf9\x8e\x18\xb2𬂳\xa8h\x8a\x06\x1b\xca\xc4\xf1\xbbvy\xbaMh\xd1\xd4\t\xb3\xa4>\x1f\x89\xf3\x95iQ\x95\x95\x94\x97(f\xc9:r\x95_\x17~ \xbam\x87\xef\vpcg\x1c`t3\xac\xd8\xfb\x0f\xb20\xefH\x0f}y\xf7\xbadqޣ\n\xaa\xb24|\xef\xbdZ\xa1\x0e\xd4\bՓ\xf9bt\xf7k\xf3B\xa7\x86CYe\xb6i\x06\x87\t2\xceji\f\xda \x17\x7f\x10\xaa\xc0|\x00\tu\x80`Ok2@\x94\x03\x18\xe6\xfbȖ\xd2 9ߞ\xba,\x00\x04\x04\xee\x05\x97*\v\x90\x99y\xf0k\xc4\xc7\xee\xcc\x17\x18Q#f|.\xd7\ad\xd4\x0f8\\\x18\x18\xb72]\x9c\x82\xda\xc4id\x80D\xe3X\xb0\x80\x06sa\xff(\xe8\x03N\xe8\x8f\xf7\xb5\x84\xfc\xf1S-!\x8f\xa3\x11\x8f\xf8(\xe7k\xfc\xc7\xd5\xf6\x90f\xe8\xbf\vw\xaa\"jt\x8a\xea\x0e\x91rA\x0e\xb2z\xcc\x13\x81\u0380#\n\xf5\xffN\x9dTCw\x94\xca\x1d5Z7\x93\b\xea\xd8\x14:\xb1\xd4\xe6\xf2˜\xcd\xe5\x8f\xf7\xb3\xb9\xfc2\xe7\xac<W\xe8\x1e\x1eoa\fY6\x9b$\x891\x12\xf3C\xa1\xea\xbb}\xda\xe6\xfd\xd9b}eHm^utщ\xed\x92v\x97\xb9\xe25Z\x1bG\xe1\xc5Q \xa2\x1b\x89\x1e\x15bJ.Ի\xcc.J\x87m\xcfm\x87\x05\x03\val\xd1\\]p\x17G\xcc\xe8V\xf1k\x8a\xf3w\x1f\bd\xcd\xdfӥ\xae\xe1\xa8\x16+V\xee[\xe5\xc0\xbc8;j\xec\x1b\x91\x1b\xab\n<\x9bK\xba\x18\xb0\x92\xedF\x85\xfd\x95\x80x\xc4_\x89\xfb\xc7\x03\xc0+C\x85t#\xf3|ѕ\x91\x87\xb1\xa04\x1e.\x14û\xff\x84\xff\xb8t\x17cQ\x9d\xcb.\x16_\xa2\x7f\"\xef8*د\xd4\t\xaa\xb6\xf2\x0eõ\xca\x0e ݫ\xfa\xc0U\a4\"\x9e\xa9\xa7\x10*\n}\x81U[\xe1\x05\xfa\x97\x06\x04X֗`U_\xa2B_\x00\xa1\u0085@o\xd0_\xdd\xfb\bӠ\xe4\x10c\xcdi1;ױn\xb9\x88Yyp\x9c;6XtL\"\x15\xb9\xa0\x95\x19\x8d\x84F搂\xd5G䀩d\xa0\xcf\xffW\xf7:\xff\xde8\xdf\x1f\xb2\xbc\xe7ˢ\xca.\xa0\xf2A\xae\xcbwi\\\x14C\t\x87\x9a\xfc\x05\\\x17\xc6\x158\xc1\"\xd5\xfb\x95(\t\t\xfd\\@\xbc\xcb\xe7➁Y\x8d!\xe8lx\x88\x9e\x8b\xdf\xd2\vya\xc1ˠ6\xac0\f+\x05\xd7\xcf\xc5\xc7F\xe1^2\xf7\xbf\xb3\xd8\xcd\xda\xeaV\xd8_\b\xfa\x1d\xa1_|\x1cH\xcaM\xe9\x17\xbf\xcd%x\xcf\xe9\x14\xd9d~\xf1;\x99\xcc\xdfj\"\xd3I,N\xe6wx5|g\xf6\x90\xab\xebv5\xa0V\xb0i\x9e/\xfb\xbb\x9cL\x00\x06\xe4\xf6\"\xb2\x85\xe6\x1byv6\r\xdc\x0e\xba\x12I\x04?\x13\x80p\xe8\xf7\x16q4\xee\xa1\x16\xe0\xbb\xdf\xe7\x050U:VG\xa2\x96\xe3\x10\x06\x13\x16G\x18h\xb1\xacgg\xce?`\xdd\xc2n\xa0\xc7\x04,\x9b\xf4\xbd\r\xefm\xb4\xbb\x91\xfa\x1d\xcfE9QǾZ\xf6\xe1\x8be\x1f\xbe[\xf6\x01\xb4(\xed\xeb\bC-]G\x9fF=\x1c*H\\\xb72\xe2\xe1:\xba\xefQ\xce\xd1O\x90\xf5\xf8*Ȣ\xca\x15\xab*\xf5\xcbR5ܛ\xe4\xd7%b%\x8d\x18\x01\fS\x8c\xad[ d\xa4BB\xbe\xed\x05\x1a\xc5\x1b\x17p@R\xac\xb6V\xcb\xea5ɏ\x8e\xe1\x8d\xc9 \xfb\f63\xbf\x98\x8a\x1b\xf0\x16\xae~\xb2\x139\xcb\x18\"\x85\x14\xa7%\xbesZ\x8aq\xac>S\x11\xc5b.*\xb3\xe0}\x10^\x05\xe0\t\xb8b}V\xe7\xf5ϬJ\x18T>\xab땕\xfb>\x1bT5\x1b\x95\xac^Z\x96\x95\xcf\xf5\x02J\x1e\xb1\xd2\xf9I#\x19\x96\xc6$I\x92(mA\xaf\x97\xa3\xe7$*\xceG\tU\xb6l\x9f\x94\x10\nf\xafdM\xa6rc\xa1[by\xb7D\xb1[\x9f@\xf7\x15j\xb8\a\xa4\xebd2\x94\xc5\xf5X\xe0\xb4\x1adB\xa4L\"\x80ۺ@\x94\x95\xf6\x82\xff\xc1t\x82\x97\xf7\xe1wF\x18\x9a뽜\n<1B\xfb܃\xf8S\x8e\xbfN#fv\xdb\xf0\xf9U\xe0\xb4\xe7\x19C\xeam\xc2\xc5+]\x14\xc3S\x9b\x82S\xd9V\x90\xb3\x9f1\x84p\x87Q\x8eO\x8b̫B\f\x86\xa6L\xb3^\xf3a\x18\x8d\xac\xac\xec{Ń\x13\xd1ͭHE)O\xa2\xe6\x99\x17\x8c\xec\xd4\u0382dA\x1f\r\xdb\xcc'\xaa\xf0\xb2@\xa1\x82\xdeN\xb8p\x96\x9c\x00\xb5\xfc46\xb3\xc8h\x88\xf99\xa7\x96\x01\xc2\xc2\x00C j5\xc4J\x84#jZ\x9as\n\x95R\x0e\xd8C\xd6\xcf\xe3\xa8\xc4\x0e\xebEd\x13\xe7\x87(\x17\x16\xf1Et\x1fs,\xf7>\xe6X\xd9X\x9b\xeehd/\x9ab\xfd\x10\x958F{\t\x864\xa7\x11p\x03N#\xcd\x038\x8d2\xbf'ij\xc1\xef\xc9i\xa4\x9c\x1fi\x7f%i\n:\x10zn\xf86J\xbf\xe4\xfd\x9e\x9cF\x99\xa3\x93\x9cדܗ\\m:!\x97c\xd1g\xcaiT\xf4\xe4r\x1a-ziI\xd3\n^ZN\xa3\xbb<\xae\x9cF\xcb<\xae\x9cF\xa6\xcaj\xd1㊰\x8f#z\xc8\t=\x8e\xee\xc9v=\x8e\x90\xa7\x85VDK\xf0\x1c\x1b\x12\x80\xc5,51\xb0̧\xb1jO\xb8\xc8Ь\xe3{\xa3Y\xd9]](\xe5\x8eFe\xa5^ihW\xb8\x9fAk\xa4P\xc3r\x16\xe8b%\xe9uZ\xacd\x19\x03x\xb1\n\x85G\x15+\x98\x05^\x18|T\xa0P\fG!\x98\xf8\xf8p\x14x˷\xaa\x06k:I\xf2\xc0I\xfao\x17f\x81O\x8aIZ'.\x06\x13%%\xc0\xc44h\x00`\xa2\xdd,:E\xb4\xd2\x16\x18[\"?_^ x\x14\xf3\xa1\xb8ߜ)\xbd&Q\xe6\x92\x06\xe6p\x99\x80Е0\xae\xb3\x84Z\\1'e\xf03\"\xb7\xa2\t\\\xbc\x9b%\xd2Ȕ'\x80N2\xe0\xbaS;.Ґ6?\v\xf1\xecLD\xee\xff\x1fN\xc2\n\x89n~\x0ej\xb5\xbb\xe6`\x99{<\xec\x1b\x1e\xabe\xb1\xad\x17\xab\xf9x\xbft\xe5\xadD\v\v\xb5$X\xe3\xd3(\x1f\xac\xf18\xbag\xb0F\xa3\xa0()\xfaI\xa8\xfaq\xf4[\xf0M$\xf6Y\xc261\x8d\xa2\xec\x80\x06\x19\x17\xea8\xfah\t\f\xb4\xb2\x1c\xfb\x16\xa5\xb8\xffq\xf4\a$\x90ɐmUɅ\xfb\x9e\xdb\xf7F\xb8\xa1\xa3^|\xc2\x05\xfb!¶\x9eE\xf4eTtQwr\xa4<Խ\x89\xd8q)j\xfe\xaa  /\xb1F\x169kd\xec\xa1\xf4\xad\x82T \x97.֤\xd0\x12\xa7\fHx\x9b8<\x1d\x93\x81\xf2\xbd\xcei\xb0\xe5\xe9\x807т6[fM\x1fik\xfaȘ\x18a\xeak\xbd0t\x0e\x9eA\\\xe1g\x11{\x1d\xd9a\xc1f\xfei\x19&\xfc\x160\xe1\xaf#\x89\x02\xbf\xfd\x03D\x81\xbf6{\xa3Pq\xf4F\xf0&\x02L\x188\xf1o\x10\x17~\x93\xe2\xc2oL,U\xbf\xe4R\x110\xbd1\x90_\xf8z\a\x06\xf9f)\x06\xf9f%\x06\xf9&\xd2K\xce^D\xf2\r\x16\x9d\xbd\x8e\xa8\xb0\x9fF\xf48\"\xf4\xe9}\x91˧\x9f\x80\\>\x8d~;=\x80\x1c\x8e\xf94ʤ\x96p\xb6\xbe\x96g\xebqD?\x8f\xd8\xd3\xd2\x03\xf4aŎ\xfe\xfc\xb7\xdb\xd1o\x8d\x1d\xfd\x18v\xf4\xe3\x88}\x88\xeco\v;\xfaǲ\x1d\xfd\x1cv\xf47jGËvo{\xe8\xfb9\x8d\xa0oʈ\xb0o\x81\b\xfb<R\xb2\xa0ϳ5~\x1b\xd1\xcf\xd35\xfe\x00k\xfcc\x84\x1e\xac\x7f\xbc\xef\x1a\xff\xf8\xc9\xc4\x00\xf8\x1c\xcf\\X\xff\x18\xdd[e㜻#\xf4\nd\xf8\xb4\x06GL\xbcѐ\x8e\x98\x80\xe1\x9di\x98+)\xae#\n\x8dL9\x7f\xbf\xb4\xa3\xd0F\xad\x96\xfd\x96u\x14k(xMV8V1\xb6z\xee\x1e)\xf8\xb3ǐ\x9c\xc5\x02\xa9\x7f{h\x99F\v\x156ڝ\xe8\x00\xd0\xedF\x83\bv+\x87\x97f\x02\x97v0\x03\x8e\x98\x973\xfeL\xbd\x13N\xb3\x96\x980\xb5\x04\xee\xf2\"\xfdU\xaa\xf3P\x9c\x92C\xdf/^\xaeH\xafp\xf4\xf7.\x15\xf7ss\x92\xa9\xb7\x99\xd7l\x8c\xc6\xdd\xe6\x9a/:k(\a\xd2\x02d\x87z^\xb8\x9c\f\xd0\xee't\xf9D\bs\"\xa2\x8f\x9c\b\x19\"\xff\xc7\xe8N\x7fղ=tT\xd6.\x96(\xf1Wm\x96\x82\x99]\x86̖\xd5S\\\x85b]\np\xe4ˮ\xeeA8\xbdW\a~\xa7\nQ\xb8\x1e\xf9\x15\xb8Ϣ<\x8f\xecb\xb7\xca\x1c>{c[:wN\x1b&%<\xffg\xb6\xe1\xd9\x04I)p\xfa\x9c\xa5\x98Դ.~\u008bMg\n\x8dR\x15È\xe9\xa8\v7\"\x1a\xe66<@\xb4P\x19-\xde}\x98\x03s\xce\u008f\xdc\xc3h&\x91\x9f\xb1\xdf±\xf3W\xd1Blo\x04f\x9f\x1e\xdb\xfb\xc7\xfb\x10((\xa45\xe7)\x83\x14\xa7\x19G>\xe7\xc1=չ\xc6\x19\x0fR\xa5j\xa5\x12#]lK\xa5\xea\x80\x05\xb9\x95\x88\x96\xf4*\x8c>\xbe[!\x12\x11\xa5}Ze\x1c\x19\xa4>\u0095\x10WgV]\xa5\x86\x97\xf0\x82\xd1ˏHq`\x14\x90o$^\xf4 \xa2\xdf.\xd2\x1co\x0e\x9f<\x97TǗ\x11\xfb\xb1\x14i\xfa*Z\xedb\xeb˨4¶\xbat>!\xc2\xf6s\x03\x95z\x00\xa8ԃ\x88}\x15\xe5#l\x7f\x91\xc3\xe4L\x16\x84q4{р\x01\x93*\xbd*M\x8dZ\x91\xdd/\x11\xa1\x99\\\xc3\x10\xf3\x9e\xdc\\\x9c\x85~\\\xab\xad\xfaZ\xa8\x88Ͽ\x8c\x00%\x83\xe3\xf7坘\xfc\x97K1\xf9/Wb\xf2_F\x06\x85_\xf0\xbe\x1d5\x9f\xe9\x8d\xfa9\xfd\"\xb2#z\xab\x8cWL\xd1\xc67\xdc6\xa0\x9er\xf5\x93\x06\xdf4\xf8\x87\x05\x1d\xb7Lin\tS\x00\x96\x00'\x1e\xaed>\xa7\"|\x06\xb1z\xd0\x02\xb0D\xb6\x02m>\x17J\x15\x9c\x8a\xf0\xab\x93e\x02\x98r\xb3\x15n\x06$\xb6t\xb2\xc5\x18l\x80p\x8cV]_\x9dt\xe5\x03(]\xc9I\x90o\xb2\xc1㗿\x1fM\x1e\xbf\xec\xea\x1fe\xcdj\xa3\xc8%S\xf2U\xa6b\b\xb9_\xb8Ӓ\x8c\x0f\xd4\x1af\xb5!\x95%B\xb9_\xefZ\xee\xdb\"\ny\xbf\xe5\xcc\x14EK\xba\xf4\xa3X֥\x94P+)\xf54\xb2c,G\xba\x85M\xe6\xa4\x1b\xa3\xbc\xe4\xf1\xfdJ.\x9f\xe8/\x8c\xbdW\x9e\xcb7\xeb7w\xb3M\x9c8\xff-\x1b\xb3\x93\xee\x1f\xa1t\x97\x808r\x87\xef\xcb\xe6\xec\x1ec\x80\xe8\x06%EO\xc4=\x86\x8f4\xd9ba\xab\xf7L\x99B\f\xac9==]\xcci2\x9b\xf2\xf8\x1d\xaf\v\x87\u05ed\x8aU_8'\x05Z\xf0\x85;\x9d\xf2\x884\x7f\b\xbd\xc0\xb6h\xc5\"\xb2\u061cJ\xbb\xe8\x92A\x8d\x14\xfb\xd3S\xcf\x12\x8e7\xec)\xcdNv\x96!\xc2\x05V\xb40\xe3\xe1\tm\xc1\xad\x02{9K\x89\xdă\x1dr\x03\x8b\xbf0A\x8bG+2\xbc\x1e,=Z\xca9\xb4\xf4\xaa\x86\x03\x14\xca\x7fsj\x05\v\xf7b\x15\xdc\xd9\xd0hN\xa50\xa8|q\xf4\x8c\xf1(\vx'\x05bc/\x189Ũ\x00\x06b\n\xdfQ\xe7=\xa7\xe2\x12tA\xe3\v\x14\x8d\xd4\xcdV\xdeln\xe4\xb40R\xd1\xe5R*/Ж\x8c\xc2\x06\xc8-U\xbe4\xcf\xdc]\xf2\xaeeչcQKQ\x8c\x96E\xef7\xa5\xe46\xea¬9\xa2\xce8\x15ut\x93[eA7H\xb9\x176q,\v\xa6T\xcc)\xe0\x01\xf7X\xf8WdN/\xdci\xf9\x1c\xe8\xa97\x15\xb1ɜJ3\xc7\xf2\x99\xa7a\xa7t\x02\x8b\x9c\x80\xfd\xf5nȪ-'\xc8h\xf72\b-\x9d\x10u\xed\x10\xb6K\xc0\x04q\x02-\u05c8h@e\x06\x18q\xa0\xbb\xf5ڛ\x9c\x8bU\xbb\"\xe7\xfe3Eg3\x15\x0fY\x8d\xb6\xc4\xcb\x11\x8b*\xf7\x8acmhσ:\x05PO\xab\xa76X\xd8\xd5p\xb2K\xcbHM*)\x88\xe3\xb0ː\x89\x11\x87\x91(\x83\x12\xba\x81\x9c\xa0\x06A\xe8}\xb6\xc5k2\xa7g3\xf1\xb5\x1b/\xd5\x19\xc1\xc1\xd9*~\x95\x17\x1f\x01[\xae$o\xba\xf33\x00\x9b\x03\xb7N\xb5\f\x9e\xa5cn! \xc3@\xb4\xe5\x13\xf9\xde\xe6\xdd\x1c\x9cV\x82eȣ\xef\t,\xfe\xf8\xa6\xbc\x02\x11\x99vF\xfcǙ뗂\xdd\x1b\xae'R\x02֛\u008d\x9a\xf1Ƥo\x97ST\xf94M\xe1\x04ϒ;Z\xe0i^/\xbf\x8aH\xe1\x126\xfc\x7f\x1a\x06<\xa5f\x17\xa9\"\xb1\xd0\xf0\xf4e\xb8d\xce\x14t\x94\xf3\x94\xee\xa4\x14\\.9=ѝ0*J\xc1~\n\xf5\xa3\x1c\xd4\x0fX/\xa4\xd1@\x01\xfe@\xb6\xf9\x9c\x17W\xc6\xe0\x99\x96\xc3p\x88\xe7\a\x96\xd7X>\xbfI\xf3\xa1HV\x9cx\xacZe\xcf**\x9f\x80{֖v4W\xe5\xe2\xf8\xeeY\xdds~\xa38\x95c/Z~\x10q\x1cǐ\xcbwŋ\xbb`\xb9\x1f\xe5`\xb9r\xef\xb0\n\x82\xc4i\tu\xed\x1a;q\t\xe2\xf9]\x8ax\xe6\x94\xceJ\xf6`0Z\x12\xc7\x12\xe3\xfa\x02\x1e\xa3#\xc5\tY۳\xc0Ym\x83NC\x86V\xb4hw\x1e\xe6\xec\xceSo\x81Q\xa68n\a`]\x8f\xda\xe0J'ܥg\xc49#\xb9@\xf1\xf3,\x82\xd8$\ng\xd3e\xc0$\xca\x01\x93sw9\x02'5\x9eπ\x05w\x869\x9f\x05\xab\xf2>\v\x8c\xdc^|2;+\xea\ua95c\xadR\xaaM#\x94]\xeeD\xb6Fh\x8aJ\x1d˼u\"F\x1a\x9f̦<*o\xd5^֪\xea\xa8j\x95\xa4\tz{\xbc\xe77\xc7\xe3\xa5\xe3և\xe0.<WJ\xe1\xeeC\xe8\xfer\x01\xc2Ω\xbf\xe2\x92[\xb0bk\xe2Q\xd4Ş\xaf\xec\xfe\x92\xa3\x8dcF\x0e\xf6\x85{]Vx\x1ae7ͅ{\xbdl\xafM\rM\x8a9\xbd\xf0\x82\x95uu\x05$;a \xf3\xdeY)\xe4\x17\x90\x1fk\x8f\xf8]\x88\x00`\x01\xf1{o\xba\x9cb\xc1l/\\q\u07bcp\xafm\xa9ʊE\n\x00|\x01\x00-Y\t(js#EU\xf7-\xb8\xb7Z\r\x04\xddE\xac\xeb\xbd7}\x1b\b\xcf_\x01\xad\xd2\xca\v\xe8\xd7\xe3\x9bխ\x15T^\x84\xfb\x9e\xdf1K-Z\x9c'(\xf4\x89\xf3\x04E\x8b\xf3\x04i\xf7\x98\xa70\x7fY@\xa9\xbb&)\xad٘$M\xb9/?e\xf9\x13\t\\\xd4'\xe1\x88/GT!\aX\xa2fo̕j\xef\x04\xf4\x8d\x11\xbb\xfa.b&\xcf\xf7\xbb\xa87E\xce\xe5wQ\xef\xf1\x80}\xa7\ue018~\x17)\xe6\x15\xa4)n\xa2N4\xe9|\x16\x05\x90\xec\x05\xf1\x94\x0f\x85\xcc|\x12\u03a2\xe1R=\xae\x8c.\x9bC\xc9\xe1\xb9\xeb\x05PN]Ԙ\x16\x06\xc2\xf5\x82\x98}\x97\xd9\xd6\x01c3\xa0\xb7\xe0\xb3y\x05\xa9\xf1k\xa1\xc7\v\x04\xdcQ\x91\xd4_a\xceX\xba_\x96\xfb\x85Jq\xb9\x9eK\xc3Aj\xdfH\n6\xe5\xb2\x1f\xcf\xf9\xcd\xf2N\xac쀊\xb4\x90\xefG@\xc3\xc5~\x00'A\xf5\x00ˤ+\xfe\xeb\x88\x05e\\\xfe_F\x05\x85\xab\xcckw\xeeKOP>\xc8>r\xf3\x90-\x92&|\x89L1\xab@\xac\xac\xa0qw\x05\x91Q\x81\x15\xe3^2\xae\xd7.\xf0_\x9b2\xd9\x1b\xdf\x00xW\xfb\xcdԫ\b\x8c\xbdshj@\xa49\n\xc7\x18\xbc\x92\xb4\x1d~P\xf4M\xe2\xa6\ngR\x12\x82\xbet3?\xb0\x92\xa0\x19J\x13\x7f\xf4L\x05\x16\xc3\xed\xccT8\x0elӕW\xd4\x15\x05/Q\x01\xdbh?\n\xea~`\x1fs(\x7f\xccmAH\xd2*:\x8c\nXi&\xb3\xba\xb42̔\xafBV\xa0?\x90\\4\x99\xb8\x00\xd1\x18\xaa\xc2mln\xac\xef\xb5w7\xb66\b\x95I\xfb\xfb\xed\xad\x04\x02f5\xda[ts\xbb\xbd\xbb\xb9\xb5\xd7\xda1\xben\xa8\xaf\x1btK\xa5ڂ٢\xbe\xb1\xbb\xbd\xb9\xbe\xb7\xde\xde\xdbNZ\xe4\x1d'\xef [{\x9b\xae\xafonﮯo\xc9J\x9e`v\xa8J~ߠ\x1b\xeb\xdbۛ\xbb{{\xad=\xa2\xcb\x18\xdd\xf6\v+\xf8N\xd4\u05f7\xb7677\xb6v\xb6\xf7\xea6\xdf\xdf\xdf&u\x9b\x1f\x1c\xacÐU\xae_G\xbd1\x02\xc4_k\x80\xa8\xf8\x85\xf4\xd7y\x88(9\xee:5\a\x12K\xef\x81¶\x14\xa4n\x81\v\x1a\xdc\xccs\x00n!\xbd\xcdp\xa3\xbb\x84\x04m\xf2\t\xfc\xc1\xb6\xe6\x0f\xe2\xc5\xf2\xd1\xe4e\x17\xa8K\xa7\xd1\x06F\xec\x88_\x17p<C\xd5C\xe3ry\x17,\x18\xff\xb2\xd1v\x84\xc4\x12\x9f\xad\xae#E$W\xd5\xf3\x11\xac\xa7\xf6\xa7\xb0\x9ed\xa1iI)9[E\xfe\x1d\x1a'\xb2\fKI\x04\xf8h\x84\x1eGI\xb2\xce\xd0\xdfv5':\xeepTG\xe0\xfb\xad\xaeҕ\x9f\x05\"\rv\x9f\xf2\x97\xb5R\x81\u0083\xb2)\xd1\xdd\x06_\x8cQ7p\x02\xed|\xd4\x00jt\x9dP\xa38\xaf\vB\fJ\xfc\x1e\x9b!\xe5\x01,\xdb\x10+Iqib\xfc\xb1Tu\x9b\xac \x94M]1\xb2\xdfJ\x92t\xbe$\x00N\x92E\x0e[\xad\xc6\x0fD\xa6\xaf\xe2\xdcEkG\x8c1\x9e\xa7\xb4\xcbI\xd7\\g\xa4\xfb\xf1\x12\xfe\xdeB\x0f\xf9~\x9a\xe44\xda:\xaf:Z\xd2.\x05-#\xa6a\xccW\xcd\xd9\xcc\xe4eb\t\x9f\xbb\x97|\x91\v׃l\x83\x92\rB\x00\xba\x9eG\xb6\x89y\xd0/\x9a\xe1\x98\xe2\xb5%Lw\x97\x19\xefMΩҰU*ƏҨ\xfatdX\xc4.%E\x8f`7g{}5\xd9\t\xfb\xa8\xd1\xfeDz\x06\xb7Ӈ\x95\xb8\xa3\x9a\x01\x1a\x04t\xc5T\xa9z@q\xb7\x04v\x99Yө\x82\x189Xs\xb1%IA``\x8aL\x85\xe1\x1c\xaf\x9fp\xc1\xe7\xc7\x17\x91\xed\x16\xec\x15\x17\xe8\fiW\xd5E\xed\xc8;\xa5e\xda\bk5\xa3\xc0\xf0\x993'\xd4-Xy\x99\b\xba\xf9-\xc5\xe0\xdd\x12\a%0\x94\xe7\xd4@J\t\xa4|A\xc3B\xcaw\xd4-\xa4\x9c\xf2\x85b'|\xa1\xdc!\xcf\x17\xbc\xd5bO\a\xb4a\x7ft\xbe\xa2OB\xdf\xe78Z\xe7\x9aS`\x0f>\xe0\xd4\x10s\xff((\xca_O\x04\x952\\ԣ\x15\xceqD\r\xb1\xf6ӈJ{U\xe7:\xa2\xaf\xdd`\u009d#N_\xf3)w\x85sƩ\x17;\x97\x1c9\x83_\x9d8\xe7|>\a\x92gck\xa7\xbd\xe3pvp[\xc2\x1c\xca\xe9\xd9ty\x93_O\xc3H\xc4\x05\x8c\x02<^\xf2f\f\x9c\xa7S&(_\xea\xd4\xcd\xf0\xb5@o\r3,'U\xa6\xe4\xc1\xecB\xcdM\xb5M\xaf\"O\xc8\xdf-\x10֎\xbd\xc9L}k\xa1#\bgY\x8f\xa47\x8d\xacO\x9d\x82\x96\x0e\x01\xcd\x03\xa3\x9bf\xc7\xcc\xee\x03\x8e\x13Q\x9e\xdfH\xba\u05cc\xcf\xe70\x7f\xbb\xeb\x1b8\x7f\xe5\x9d1Ֆ\xa0\xba\xc7~x\x96\xc1\xe3\xa0\xdb\v(\x1f\x80?mz\v\r8Q\x92X@qxC\xd4\xd0Y\v\x87\x82\x8bF,\"\xee^Xs\x92s/}\xe5\x05\xa3\xf0\xaa\x19\xb8\x97\xde\xc4\x15aԼ\x88O\xdcK\x0eM\x90\x15\xdf\xec\x10nM\xee\xc7\\qZU\u07b7\xaf\xbf\xaeղ\xdfj\xd5\xe4\x12\xbe}\xfduw\xf9';$\x8e\xfaz\xc5\xcf\xde{\xa2<\x0f\xf5\xd8(\x1c\"8R_\x8f|\x0eo\xb6\xe5Z\x04B\t\x8a\x1b\x9f7G^<\xf5\xdd\x1bf\x05a\xc0-\xea5\xcf#>f.Ÿx\x87BD\xde\xd9Lp\xdb\x1a\x85W\x81\x1f\xba#+\x17\x1d\xd8k\xea\xf4Z\xadXB\xb8ф\v\x8bZ\xa7g\xbe\x1b\xbc\xb7\bM\xfbs\x16\x8en\x80\xd4\xe3\xc1\xe8ɹ\xe7\x8fl\x8fP\xaf9\xf4\xbd\xe1{\x9bИ\x8b7\xde\x05\x0fg\"'\xffʗ\x96&\x17iic\xba\"~\x19\xbe7\xa6\x02\xa3i\xad\xb7Z\xe0Є\xee\xb5\xd7\xf7\xb6\x1d%\x91`\aJ\x90\xb3\xf6\xae\x1fד~\\\x7f\xb06\xa1![{\xd7k\xd4\a\xad\xeb^\xab\xb1\xe76ƃ\xfa\x835\x8f\xbal\xed]\xeb\xac\xd7j\xcbW\x0f^\xc3^\xab\xb1#\xdfc6u\xa3\x98?\v\x04\xf5\x99\x15b\xeb\xd9َ\x9a\x93ZM\xffiʾ1\xed]\x14\x13\xe9l\xb1T\xcc\xfdq\xad\x96\xfd],\b\xa9\x10\xb8'IfI\xf2\xb9\x9e+ˀ\xe1\x16\xb1\t\x9d\xaa\x02%\xfa\xfdt\x9c\xe2\xac\xf4\\\xfd\xf4\x02Zfo6l>u\x05o\x06\xe1\x95M\x8cP\xfa\x17\x06\xf6.\xfb\xadU\x17\xabU@\x12\x8ca%I\x0e\xee\x99>R\x15%m\x05\xb3\x8b3\x1ees\xc0s\xbe\xeb\x17\xaf3+F\xc5<\xa3@\x92,\xe6\u009e,\xcc/\x9f\xa3U\x8fՓ\x1f*R\xc7o`16\x95\x9c\x15N憓\x8e\x97\xeeK\xe8\x03\x8cW\x0f\xb8\x94Ǐ\xf0\xf5x\xdcM\x7f\xd9\xc4\xe1\x1d\xce.lA\xba\xa2nY\x8e\x80P\xc0\x9a}Q]\x18*\x9c,\xde\xe5N\x1dJ\xf1fħ\xbe;\xe4v@-K\xab\x9c\xb8M\xc1c\xd3\xd5e\x94$\x9eN\xebƶRT\xb6\xd7\t\x8d\xba\xeb\xce.q\xc2\xf4\xebK\xf7\xa5S\xe7\xf3e \xd4\bYJU\x84\\֢C\xd07\x98\u009fKP\u0380\xfe\xa7c7F\xb0\xda\x1b\xab\x9b\xf2|,\x92m\xa0\x1b#\x98,uY\x98)\xbe\x86Z\xd9y\xc6\x04\xc6M\x95\xec!\x17\x10˴\xf8\x99\x81\xdb\xcc\x18\xa713\x00\xc8\x11\x80\xaba\x17YA^V\xe6\xcaN#\t\xf0\x86\xbf@c\xfaI\x12\x1d\xc0v\x8d\x80\xbe\x98\x82=\xca\xec\x80\x19nx\x8fR\xc4z$\xdd1]\x19\xfb\xe4\x1a\x96\xa5Ћ\"\xb6\x181Ѱy#\v91\xed\x9e\xdb\x11u!qF\xc0\xeb\x0fR\x01\x99;Zc\x90:\x188\xbd\xac\xd5\x0294ۘ+3\xfc\xfa\xa9\xd9O\x1a\xb1+\xe5A7\xc8\xc8W\xa5\xa6N}\xa6c\v\xa5Ӑz\xc9;\x83\xd8\x18\xde؞\x92\xb4\v\xc59\xbe\xb1\xfdT-9\xab\x00#\x18\x14\xb2\x12\xea\xcdSn\xd2Ćp\x00-zaG\xe0\x1d~Ȫը\xe9sw\x04\xa0\xc9e\xf6\x94Y\x17\xee\xf5\xb7\xae',/\xa8D\xa4;\xb6'v\xd4TiXT\x10ǥ\x97\xcc\x12\x91\xeb\xf9p\xa0 c\x17\xea\xd1)\xce%\xa1\xa7͡\x1b\f\xb9\x9f3\xb1NC\xee\xd6jh頻\x19\x13\xdc\xf3\x01\xf3Y\xc8\xf4t\xcf\xe9is\xec\x17\xec\x84\x16F\xdc\xf5\x9ck{\x84\xcc\xdf\xd3\xf9\x9c\xb6w\xb7\xb6\xd6\x17\xee\x9b\xc8n\xb7v\xb7։\x1d\xd9[[\xdb\x1b{\x84ZO]\xe1~\xe3\xf1+\x8bt\xb2\x83\x19\xcci{ow\xaf\xa4\xfcV{gw\x8fАE\xf6nk\xb3\xd5&ԅԝ\xed\xed\x1d\xb8\xf9#{\xbd\xbd\xb1\xbe\x83n\xb7\xed\xdd\xf6\xee\xf66\xc9E\xad\xd0\xf0\v\x82\x100m\x98\xd6r4\x05\x87J\xf5\x92'\xa1b\xc6\xd7\xebb?\xea\xa4\xfe\xd8{b\xd0I]\x9a\xa0\xff0P!#\xf3\xb9\xbf`<\x12P3M\xfa\na!\xf5\v6b.\xf5\v\xe4\x85G\xfd\x82o\xa9\x98fS\xe3\xcf\xe9\xc6\xeefk\xa7dn\xd6wZ\x9b-97\xed\xcd\xf6\xfa\x96\x9c\x9b\xdd\xf5v[\xcd\xcd\xf6\xceV{W\xce\xcd\xd6\xe6Nk\xeb\xff\x85s\xb3\xb5\xd3\xdai\xdfg߽p\xa7\xc5-\xb7\xbb\xb1\xb1]\xb6\xe7\xd67wv\xb7Լ\xb6\xd7wռ\xeem\xf3\r9\xad\x9b{{\xedm9\xad{[\xeb\xdb\xff\xaf\x9c֍\xdd\xf6\xee}\xa6\xf5U\x14^x1/N\xed\xd6\xee\xd6\xfa\xd6}ʟp\xb1\xb0,\xbb\xdb\xdbem\xe3r\xc9e\xd9km\xb7\xf7\xe4\xb2\xec\xaco\xec\x9a+\xe0}\xc4\n\x9c\x9e\x8e\\ឞ\"\r\x15\xe8\x85\xc0Oh+\xdd\x13\x032\xf7\n\xde[\xbc\xa25eH\xbd\xc2\xf4\xba\xc6dzs\xba\xb9\xbd\xb1\xbbY2 <\xd7r@\x1b;\x9b\xdbj\x9fmo\xec\xec\xec\xa5\xe7woOn\xb4\xcd͝\xad]B}Ȼ\xb9\xd1\xda3F<+p\x95\U000e308b\xd00\x19\xc4\xc7|\xb6\xb0\x95B:[\xdcJ.\x9d\x15\xb6\x92Gg\x85\xb1\xc6tV\xd8J\xbe1\xfaٜn\xaf\xef\xb4ʶ\x82\xdc\x01M\x89\x8b\x16\xee\x82v{soy\x91\xb7^ vQX\x9b/\xb6\xd3\xda\xdaٹϦ\xfb\x96\xbb\xefK\xe0\xc1\xde\xf6\xee\xce\xe6J\xaa\x9b\xdc\xc6W\x9e\x00\x93\x984\xae\xd4Ѝy\xa5\xe5\x14$\x95\xa4\x83\xe9\xedb:\x05n\xb2\xfa\xb8^\xfaQ\xc6\x1b\x9196\x96\xe7\xa0\x10xň8\xad\xe4\x89貓\xee\xecl\xb6\xd7W\f\xc4\xf4\x80\xd3\x00\xedڒCR\xafG\xfbڲL\xd8`,A#\xe0\xc9g^#\x80O\xb1\xb9\xb7\xbd\xf1\xdb5\xa4\xa2\xd8\xf4\x06\xb2\xc5N\x1a\x13\xb6\x17\r:\xc2\xf6\xb0\xd5Z\xcdv{a\xbd>`^:f\x17\xb0\x8e\xcd\xed\x8d\xed2\b\xbe\xbe\xb5\xa9 \xf8\xc6\xd6\xf6ަ<Y\xed\xcdmu\xb067ۛ\x9b\xf2`mo\xed\xecl\xab\x83\xb5\xbd\x03Pe\xb6HD\xe6\xfddv\x96\r6s\xcb\x00dDT\xab\x85\xf0s\x8a?\xabC\x19\b\x8b\x8e\xd3\xd7\xea\x14B\x8eqB\xcfAt2L\x92i\x92\x8c鈝w\x03;\x9d\x1eI\xbd\x12\xa77\xa0\x17ld\x820hmR\xf1\x82\n'U\x88<5S\xb4\x1c\x9d\x90$9\a\x82Tf\xb6\x18\x9b ro[\xe1x\x1cs!\x13\xac\xa9\x1b\xf1\x00_H\x92\x8c\xe1\xf3\xd9l<\xe6\x91\xfa|v#\xf8\xd7F\x05\x98p\x9cV@\x92$\xb6'\xf4\x02\xdc\xfe\x8c\x10\x10ړLb3\x9f\xd3\xf5\xbd\xbd\x8d\xf5\xdfzoHí\x80\xe8\xdd\x11\xa2Վ\xb1\x1fӠ1\x00i6ww?\xa6E\x91\xb5\x93\xdf\xf7\x1d\xc2{a]\xd9ٙ\x1b~{}{\xfb\xbe\x8c\xb9F\x9b\xbaˮ\x1f\x88\xe5\x8aaiy\xaf^\x0f\a0\xbc\x10\u0081F2\x16~/\x1c\xd0\xd0\x18]4\x9f\xd3\xdd\xf5\xbd\xd6\xee\xef\xe2Pw\bp2\xb3)$Z\xe1Zs3\xda\xf39\xdd\xdc\\\xdf\xdd^ښ\x190h\xea{¶,\x80;\x9b{\xad\xf5=,$\xaf\xa3\xb5\u07bb\xfeu\xab\xd5\xe8_\xaf\x8f\xfb\xd7\x1bn\xa3\x7f\xbd\xd9\xea_o\x9d5\xfa\xd7ۭ\xfe\xf5\x0e\xfc\xd8\x19\x0f\xeak\x93\xce\x1d\xcd\\\xa0\xe7C \x84z\x03\x98\x8c\xed\xad\xad\xb2\x83\xbf\xbb\xb7\xb9\xad\x0e\xfe\xce\xcen{\x83t\x96\x83rی\x10\\\r\xf1\xba\xa7\x10X)%r \xdd\x16\xf2\x88\x91ZM\x97D\xc0\xb7\xbb\xbdu\x9f\xf6\xa9\xfb\x89ЄF\x19$\x14\x83\x8e\xab\x0f7\x04\xd4\v\x01,\x12S\x04\x17%\x89\xeag\x92\x18\xddl\xefn\xee\xb4J\xba\xb9rn̍\x94\xee\x1c4\xeb\x06\x82\x1a\xf7\r\xdcB\"\xb3\xe8Q\x1b\xa7!7Nk\xa3\xec\x12\xde\xdb\xdd\xd8\xdeP y{gsy\xe3\x99\x11b`\v\x1aڂ\xa0'I\xba\xbd\xb1\xbb\xbb\xbd\xba\xe2\xdd\xf6N\xeb\x13j\xc6E+\xc5\xcfvvvV\xed \xeb\xf4\x14W\xf5\xf4\xd4\xc2\x10]AW~\xba-\xc8\x15r\x12\x88\x96T\x8as\"S\x121'\x0e,3Ã\xbe\xb5\xb7W\xb6h\x88F꽵\xd9^\x97\x97\x1a\xeeD}\xab\xb56\x14)\x8c\x93%o\xb5\xed\xcd\xed\xf5m\xb8Հ`܅\x9b)\xb2ۻ\xbb\xad-\xb8\x99\"\xbb\xbd\xd7nå\x14\xd9 s\xd8\xc4\xcb\xc8\xde\xdc\xdekm\x12:\xc2\xe9\xdd\xdd]'\xf4\x02R7v\xd77\t\x9d@M{\xed\xcd]B/q\x96\xb6\x80\u07bcI/س삽\x82j\xb7\xb7ww\t=\x82\xef\x1b\xeb@\x8f^#ھ\xb7\xbeK\xe8\xa9\xde\x0e\xf4$]?z\xc8RN\xe5\xa1\xe6\xde\f,\xfa>K\xd6\x1c\xe0\x81E\x9fd\xa9\xf2\xa0\r,z\fF\xa0ǽ\xc3\x01;\xee\x19UE\xee\xcd\xc0ZL{\x8c\xb7^\xe1\x8bfS\x14\x92\x1f\x87\xa1\xcf\xdd`13/$}\ue1ee\xd8X/k\x14?mo\x96}z\xa6\x11\xdb\xc5\xf4\xf6\xf6\x92\x0f卼p\xa7\x85\x94\x97\xc8p\x96\x89Or_^\xf3\xc9\xd1u1\xfb\t\x17\xc5\x14\xc4D\x8a\x89\x8a\x95\x9cK\xcc\x10\xf4\xb2\x0fO|\xf7b\xcaG˾\xb7\xb7\x97}1FZmQ\xe3#\xb2_e\x81\xf7\xb9R\n\xd1\xc7\x12m\xbax\x90U`\x8e\x1f\xe83\xfa\x92\xbe\x91\x00\xf7\x15}\xcdڵ\x88\xbe`뵈>e\x9b\xb5\bX\x82?\xd4j\xf6+\xf6\xb2\vq\xe3en\xe7\a[\x10#\xce\xfc+\r\x0f_A\xfe\xea\x91-\n\xeeѿf\xc0\x01\x86\x8f_#\xff\xf1\x15r\xcai\xf5\xb5\xce6\xb3\x05}%\x03\x7fbW\x1e3\xb03\xa4\x9f\xb3ǌ\xbdO\x92tX_\xf0@Zke瀱\xc7P\xf1\x99\xd1(\xd0\v\xaf\xb1\xb9ǌ=I\x92ǌ\x1d&\xc9\xe7\xb5Z\xf5\xa5j\xfeE\x92|\u07bd\x9d;\x97\xf9n\xbc\xe8NmAc\xfb\x15\xc0xgh\v\xea\xc9߲g0\xb8\xe3\xde\xe3\xd4\xd3\xf8ˮpn\xe7\x9dW\xc0㤏\xe9k2\x9f\xbfI\x12\xfb\x8d\xa4?%#\xff\x03{\xa3\\\rC\x7f>\xe8\xa2\x1f:o\x94\xb3\xe0W\x84^\xa3ؠ\xc4=N@n_I\x82\xdc\x0ep\xb5\x02*\xe8\x1b\xe9|\xf3ʆ\v\xb1\xb4\x14(\x90\xbe\xc2\xeaC\xaaK\x86iI\xec\xd6[\xf6\xb5\x8e\xadl?\xed\xbe\xe8\x9e;c\xe7E\xf7\xc49%F\x9c\x84\xd0~\x9b$\x82\x16j~\v\x11\x19\x99\xe8\x85,\x18\x10\xeaگhY+\xf4\x15 \v\xedr^\x8a\x84\x86\x05\xc7\x13\xd45ٮ\xc6F%\xb7\xf3\xa2>\xa9r<\x1fd\x8b~;\xcf\xc58\x87;\xaecʞ\xb5\xd0\x1a\x96\x86gΉ\xb2\fr6h\x04r|\xe3\xe6s\xe1\x96\xdc\xdd-\xe3\xb4m\xee춷嵴\xb7\xb7\xbe\xd7&v`\x96\f\xe7t\xb3\xbd\xbb\xb9{'Ҭ\x91\x8e\f\x17\x87\xfb\xadn\aݶ\xd3h\x93N\xd0u\x1b\r\xa7^w\xf7\xc3\x14\x83u\a2\\m\x1a\x96\xce@D\xd6ۭ\x9d\xb2iG*As]\x80\xbb\xd7Y\n\x17P.$\xa1\x82\x0f8\xf5\x8c\t\x13\x89w\x93\xc4vYH(\x84#\x820À\xc6\xfb\xfb\xb3N\x1a)\xb9\xe7\x0f:\xd1A\xabVs\xed!\xe9F\a\xed.\xb7\x874j\xb4U\xd5N`\xc7tH\x1c\x0f*\xe8i\r\xbb\x01\x1b\xa6Tn\f#\xd9\xdd\xdc\xdd(\xa3r\xb7Z\xdb\x1b\xc4.p1p=\xcarC-\x1f\x8b\x80A-!\xe0H{;;\xa5\xd8\xd7N{\xb7ݖ\xb5n\xb6\x80\x13\x7f\x0f\xa4\x12\xe8}[\xb0\x00\xcdx\xb4\x97ߎ4j\xe6\xb5Z\x04\x84\x10g\xbc\x17ڢ\x17\xd5\xeb\x0320\xad\xff\x18s\xbb\xdcQ\xa2\x8a9\xdd\x06v\xe1\xeaeF\xb4\xe4\x0e4\xdbe\xa6\x90\x11\xe8\xf6\xae\xeb\x04\xb6Ke\xdcD$\x886\xf6J\xdb\x01ƺD?\xf7\xb6[;\x12+[\xdf\xd8@f\xb1i\xa3\xfdƝ\xa8n\xaf&v4ݖ\x92!\xbc\x9b]\x83\x81\xf4\xef>\x1aX\x8eq\xb3\xfb\xfe\xc0r\xbcZ\xcd\x03\x1a \r\xe5مa8\xe8\rwN۫\xf94F۸\b\"W\x13l\x80ͭ2\x1e&N\x8b>M\xad\xd6\x16Y=\xb60/\x886\xd0;\x8c\t\x8e\r\xb5\xf66ʹ\xf2{\xebw4\x94?\xb6\xa9\xec\r\xc9#\x15>W$I\x1aG\xb7\x1a\xe2\xe5Q\r\xed\x88tE\x150\xf8\xa8\xca\"'Hk\xe1\x14\xadJ(\xb4\xbd\x1a#\xdf\xdei\xb75\x9biwc\xab-1\xf2\xf6vkOK\n$\x1e\xed\xa7h\xf2,C\x93\x87\x19\xf3iZ\x8e\xfb\x8eY\x11\x8f\xa5\xe7%\x88\xef蓉MzA'\xf4R\x9e\x85\x1b\x86\\\xa93\x06\x8cLz\xc5n\xbac\a\xd5\xfa\x8f\xd8\x19\xfe\x14\x80\xc3\xdbW슱i\xf7ܹ\"\x8c\x9d\xd3Sf\x1f\xb1#\x99r\x84)'\x90\xe1\b.\xa4\x93Zm\x86\xda\x04p]Ͳ\xeb\xaa\xda\xee\xdc\x00.wͪ\xed\xb9\xccW\xbd&Z֘$\xf6\xa5B\"\xe8M\x92\fՖ6\xba\v\x9b\x9b\nz\x95&ȸ\xa4\xedZ\xa44\x17\x0e\xd9u\xad6Ҵ\xb3uzz\x15\x81:\xce\xe8\xf4\xd4\"\xf4=;M?\x8a\xc2G\xa8\xe80I\xde\xcbj\x9e\xb0C\xad\xe5\x00:\x0e\xf4\x98\xbd\xef\x8a\xf4]t\xcaz<\xb1\x9f\xd0c\xec\xd8%\x99ϵz\xc6\tP\xedf6/7 \xdcl[[\xbb\xbb\xa5\x84.\xee\xa0O<j\x88\x06\xa7\x87l}okw\xf5\x86\xc6c\xb8\x1cj\x82\x95\x90dOhV8E\xb1\x12\xabblX\x05\xc3\xf42\xc7\xca\xf7e\nP:\x1e\xf0\x13TD\xfd\xa8\xe7\r\xa0\x90_\xab\xcdz\xeb\x83.\x04ӯ2\xc6{\xb3^k0p\xaa6<%\x13&\xe5PA}\x9dz\xddۏ\xd3\xdb֖5\x11`NL\x19\xef\r\at\x8c\x81\xf9\x8d\xba\xf3r\x7f\x88\xadc\x0f+\x85\xaaS\xf4\xfb\\Ʌ \f.\x81\x84\x11s\xed)\x1d\xd3!\xaa۞\xabݖV7\xea\x86\xf6\x98N\xe9\x06u\xe99qFF\x9d\xf3ԩ\x01^祓\xbf\xbe\xb1\xb5\xadŹ[\x1b\x9b\xdb\n\x9aH,\xd1C\x018\xa6Ơƥ\x97\xb5Y\xef>ɴ\a\xfb\x83\ak\xd4g\x9a,\xc8\xc0@\tS\x1bԩ2\xed\xa8)\x9b\x15@\x05\x1d3I\x18\xda\xd6;\xab>\x94\xc7dJR-\x9d\xb5^\xbf\xff\xeeA\xf3Q\xbdk\x93^\x7fp;O\x06k\x13j\xf5\xfb\x0fj\x96\x91+_i\x92bѤ\xf9\xa8kwY\xbf߷IR\x19\x87\x11\fE&\f\bT\xf4\xa0\xdd|\xd4\x05\xbf:\x0f\xac\xd5;\xbdjW\xe1\x8eK\x12\xd8\xf1\xc0\x15\x83o\x00\xa7\x88\xd4\x04\xf2\xd4\r\xbe\xbe\xb7\xbe\xde\xfe\x9d\x9f+\xa4\x93\xd3s\xb5\xb1\xbb\xb3\xb9\xb7\xfa\x9a\xdcl\xef\xec\xb6\x14\xef\x06\x9b\xa3\x1e\xb0+\xbc\xe5\xbc\x03o9\xef\xc0[\xc2;\xf0\x96\xf1\x0e\xbce\xbc\x03o\x19\xf9\xee\xddA\xbe{K\xc9wo\x15\xf9\xee\xf5\xca.\xba\\\xa1\xb2\x8a\n\x1c\x1b\xaf\x945\xe3\x95\xf3q\xbcE\x8e\x8dW\xc2D0\xa7;\xa5\xafsɊ\xa5\xe0\x951W\x8cD}-\xe7\x123f\x8b\xb7\xc0l\xf1ʘ-\xde\xfd\xd9\x19\x85\x18\b(\x7f҂\xcaZ\xadZ\xf5z\x90\x05\xf8\xe7\xdb;\xeb\xadRfj{kG\x11\t\xed\xed\xcd\r\xc5c\xdc\xde\xdaR\x1a%\x12u\x01|fco\xbb\xd5^}Tʔ\x06\xbb\xdc\xd1\xf8\xad\xeb,**v=u\xc9\x03\x10\xe7 \tu\xa0BD?\x10t\xb6J頝uE\x81\xeen\xa3jƧ1\xdd55\x9d\x11\x94\xa1\x9d\xfak\xe9\r2\x0f\x9c9ܘ\xa4\xacy`\xc9[\x86.\xb7U\x05\t\x82\x90±̝\xa4\x00l\xbc\xb5\xd1\xdeX\xc5\x13HG\x85pbcs\x0f\xef\x80\xdfͨ\xdclT\xa14\xda4\x06\x17\xcb\xeb\xd0.\f$N\x12\x102zz\xac1@\xdaH\x0e-\xceɩp\v\x95\xad\xd2\xdeVJ\x8f\xed)\xe5\x8d\xcd\xf5\xed\x8d\xcde\x9b(롮\xbe\x9d\x05\x03\xa9\xd5\xc0\xe2\x04\xb0\x05\x17㭠D\x02\x9e\xb0eLǙ\xa6\xa1\x13\xc8F\"\xe9\xa1\x03\xd6\x006x\xd9\x19@\xccG\xae\xc1\xceƶR9\xdb\xd9k\xed)>{{k\xb3\xb5\xa1T\xce\xf6\xda\xdb\n\xab\x97c\xa1\xb3\xbb)a\xd5%\x0f\x8f(`\xd3]ߞI\x8b\xf9\\\xd7%~\x15ڦ\x9c3\xd3H\xaf\xd5<\xb4\x12t\xf1;\x12-\x1e\xdd\xc0\x91m\xae\xef\xed\xde!A@\x01\x9a\xa2U%c\x00F\xb6\xb5\xb7\xb3\xabF\xa6v\xa2\x9f\xb1\xe5gH\xa5l\xec\xb5V\x90^C:\xa5cr+\xa4P\r\xba\x96\xb1\xcd\\\xea\xe3^\x1c'\x89=\xd6\xe8ol\xbb\x84xX\xd6G\xbc\n\xcaw\f\x14lڝ\xda3[P\x9fP\x97\xfau˂A\xd11Ѥt:! \xf1>g.\xa1!\xe4\xa6\xe7h\xca\xe3\x03\xd4\xc0Q\x95\t\xeb\xe4\x1c\x84\x99\x90\x04\x17z\xa7\xbd\xa1\xa6\x03\xe5%q&\xe3\xf03}\x82Y\n\f\x87\xb8\xb7\xd77\xb7\xa5<E\x91t\xe3\f\xa1;\xcff\x13\x04*ۻ\xdb\x1b-)PQ4\xdf$\x9dX\x14\xa8l\x01\xa3m\x05\xe6}C\xcf\xe8\x15=\x92\xfb\xe3\x9aM\x10\xf2\xd0Sd\xbfF H9BV\xeb)\xa2\xa7'\x04\x8aE\xf4Ę\xd4Cvս\xb2\xaf\xe9)\x8d`>\xa1\xd6#=\x9f\xf4=Kg\xf4\x10*H)\xa0\x99}J\xe81\xab>\xa9զ\xf0\xf3\a\xfcY=\xae\xd5.\xa0\xadCvJ\x9f$\xc9q\x92\xfcН\xd9פ{Ȯ\x9d\xa1\xfc\xe1\xd9\xd7\xc49\xee\xda\xef\xe1\xda:d\xa1}\x8a\xbe7\x9c\x1f\xd2$W'\x1d\xb2\xde\xc0\x19٧$I|\xfb\x94t\xedCvM}Y\xcd%Tsn_\xc3]6\xb6\xafI\x92؇,\xb6O\t!\x0e\xd43\x7f_\xab\xd9G\xc8\xf1=\xa5\x87\x84\x9eه\xf4\x94\xde\xe0d\xd1#\xa5\xd4\x04٩\x9c\x93CyXZ\x1b;\xed\xbb%\xdd%\x8eh\x14GAs\x90E\x8f\x0f\xa0\u009d\xbdv\xa9*,\xb2\xd0H\xe7\xa3[Q\xa1\xf4@\x9a\xbb\xbdB?\xe9\xee\x9ej\x96\x12\x8a\x1a\xb1\xc2V\xbb\xb5s\ac\xd6p\xc3\x00\xb4\xb7\x19\xc8F\xba\x8a`A\xd7\x0e\x10\x1f!\x0e*/`\xbat\xef\x88g\xaf\x94;\x87\x18\x05\xa2\xc3[\x1b[\x8ai\xb6\xd1jm\xb7\xef\x04\x9b\xae-y\x00\x01\xa1\xbc.\xf5\x0eڭ\xedv\x19f\xafd\xa3aƧt3\x8d\x1f/;\x96\xf1]0\x9bF\nvU=\xe3\x1e\xe5\xe9\xa59\x03\xee\xf0\x90\xd9p]\x99LM:e\xc3F\x9b\x8e\x19W\xec\xcdq\xadV\xaf\xcf\xf6\x87\x8ah=g\xb1-z\xb3\x01B\x06\xb450%\xca\xec<IrװJJ\xaf\x7fL\xc8ٍ̪l*\xab\xbe`\xe3\xde\xf9 \x03\x8f\xf6\x88\xf9]߾\xa0\xe7\x19\xf0\x04bi\xc4<\xfb\x82t/\x1c\xb8Gg\xf5\xf6\x80t{\x03\xe7vN\xe6\x81=\xa6\xe7t\x04\xb0\f\xaa\x9a\x1bj0[\xdb[ۥz\x05[;-\xcdcF\xe1y\x0e}\f\xbb\xa5\v\n\xcbiiB\xd4Z-<o+\xe1y\x80\x9c)S~>w\xdcNN벽\xb9\xbe\xb5w\x876\x9f\xecw\xa3m*\x00\x89}0n\x16\xac!\x0e\xc2n\xcb\t\xeb\x82P;b\xd1A\xd8\r\x9d\x88\xe0ר\xceB\x18\xa68\x88\xba-'j\x80[\x86\x16\x85\xbf\xac\x95n\nW)/\x85 \x16\b@j\xe1\xf6\x82\x01㽠.\x06\x1dC\x8bm\xab\xb5S\xae˲\xe2\x1e\x90}\x8f\xb2\xc0\xaf\xf9\xa3i\x1c۪\x1d1\xa1\x92\xf0XV\xabp0QE\xeecԉ\xe4`\xb8\xd4\xc4\xe2\x1d\x12HM\xac\f\xaf\r@w\xa1\xb5\xb5\xd1^\xcd%G\xbd\xb0\xa2B\xde\xc6\xc6\xe6&\x1eD`\x98\xa7\xfb[\xdfI>\x8b\xbb\x99O\x9d\xe5Lt@Bȭar\x94\x12\x16\x99?\x02\xe4\xe6\x18RRy`\xeb\x96\x05\x1f<\xe3\x83\xdf\xf5\xb52\xa7cY:\x98\x1cd\x94\x19\xac\x96\x85HN{M0\xd6x\x16\x8c\xbd\xc0\x137]\xabѲ\x1c\x9c\xe0\x9d\xad\xedҩ\xd8\xd9\xdbC6\x0f\x1a\xe3\xadݡ\xf8\xd4\xe5\xa9C\x04H\xaf\xb73\xd6JH-\v\\p\xcf)\xa8\xf9\x7fҽ\x00\xf3\x05\x17\xc1\xd6\xcef\xeb\x0e\xe9N\xbb\x85\n\x1b\x88\xb5\xb7\xd6\xf7\xd6\xe5\xba\xdd\x0f\xd3ղ\x1e*\xef!\x9b3\xe4ۢ\xea ^\xca\x15\xde\xf3l\xe0\xc6#q\xda\xde\xd9ڹ\xe3\xe0f\x81(r\x87\x97\xba\x99N\x1frU\xd4\xc1\xc3\x01\xc5,\xd8w\xbb\xa2\x17\f\xf4\x0e\x8al\x8f\xf2^0\xa0q*o\xf3`:7Ww \x9b>4/\x17\xa8r\v3UF\xd0mj\x9duE5\xa0\xf9\xca\xd6V{SN\xe0\xce\xde\xee\xc6Ɲ\x13\x18H\x83w\xbc\xf8H\xb7\xc7\a\x8e\x9b\xb2\xb56[\xedRd\x1f\xe1\xdf]b/\x03\ue944R\xa6\xfe\x06\x00\x8f\x02\xd9\x17\x1d\xb0\x10(\xf7L\xb3lgs\xa3\\\xa8\r\xaa۫):\x94=\xe7\x02f\xf2f\xa6lJ\xcc\xd8\x11\xb0W\xa5\x1d\x04\xbeptp<\xa7\x88\xad\x1b\x8ds\x165\x83\x8b\x91\x9d:\x12IU\xbdC\x10\xeaTE3\bG\x1c\f\xf5j5A]\x16\xd6j\xbcV\xabr#\x99S\x8f\xb9\xb5\x9a\x9b\xf6\x9b\xb1\xb0\x1b4%\xa7Iá\x98y]\xaf\xe9\xfa~8|\x1b\xc4\ue62f\x92\xe7\x15\x83h胬-\x1b\xd3=\x1b\x00t\xb3#\xe2,NL\x06\\!}\n\x8a\xb04\xc0\xf3\xda\xde*S\xb2\xc3U\xb9\xe3\xca`\x02Ջ\xa5\xae/q\xf4/s\xda\v\x9d\xa0\xbc\x99\xe9\xfe\xd2\xdcb\x01\xbd\xbf\xd1\xde\xdc1\x95>\xfbW\x8f\x1e\xac\xad\xd8\x01Q\xe9\x0e\x88џ\x1a\x15M~͇f\xe8\xed\xa8\x99z\xd2a<\xfb\x8d\xd8\xe5fk\xb3\xbdR([z\x9d\xb8,\xec\x86\xdaf\xf5^\"Y\xb7\xab\xd8=\x9a\xd7C\x88s\x8b\xb8>\x10\x8b\xff\xc3\x16\xc2O\x17a}g\xb5j\xb0q\x8f\xe7\xcd`\xc0\x95^\xaad\x9djY\x83ڳ\xd4\xc07xV\xa8h\xb9\x1a˖\xea\xaf\xf7\x10\x1aU\xa3\x0e\xc8d#v;\xcf\"\xdb\xc4\xd0A?ӱ\xa8\xd7\xe3}?\x95\x12\x89^<\xa0C\xe6\x02ã7\x03\xee\xe0l@g\xa8\xc1\xbc\xc0\v\x18\xa2\x89%G\xcc\xda\xeb\x86vDg\xa0f\x11\xa8\x1fs\x83]\x85\xaa\x90\xab\xd5J\xf7\xf6\xb6\xb6\xda\xf7\x81\xd14T~\x0f\xc1\x9c\xb1\xdd^]\xebV{ss\xfdck\xdd\xdc\\_n\x18ӳNO\x87a\xc4\x1b?ħ\xf1\xb9\x1b\xa1$s\x90\xd7\x0eY\a\x93\x89\xb2\x1a\xf6v\xb65\xdfw\xbb\xbd~\a\x99\x1a\x14\xdd\t\xa5w\xb1\x9b\xc9\x03=\xe6\x1e\xb4\xbbQ\xcfm\xb4\a\x19\x04u\x0fֻ`\xbb\xa2\xd7\f\x16\xdfK7\xe5\xc1Fi\xec\x11\xafk\xbb\x8d\x06\xf5HZ\x0f\xf0\xb63c\x98\x18(\x19\x8f\xb9\xfb\x1b\x9a\xc4\xf5\xa8\xcbڄ\n-r\x14\x12\tw;Z\x9d'\xea\x05\x83\x8e_\xabq\xe4\x16\x0543,\x11s\xbcWQ\x99\xa9t\t\xb7\xdbw\xaf\x9b\xe9\x19[F=\x92Z\a\x99δ\xe4\xcaF\x195\x89Y\r\xea\xc1\x98H\xd1u\x9dF\x9b\xc6z4\x11\xe9آ\xeb\xa1>\x94\xb7\xef\x12e\xa7\x13\xdaq\xcf\x1b\xa0\xf6C\xc7\xe4ɂL\xb9\xb5\xc2na\xb1\xd7\v:Y\xb8\xb4\xe9\\R\x8f!\x05\x163O\x1f\xd68\x15\xe8\xfa\xcc\xeb\xf1n\xec\x80a\x03\x8e\x12\xfdv\xd9n\xcf\x1fP\x9f\xba\x84\x9cE\xdc}\x9f\xcd6\x82\x96\xf2C\x88\xb8\x8d\xe2Эo\xef*\xae\xf1\xeeF{\xb3u'\xfaT\x8a\xf7\n\x06(\xbe\xba\x81\x01\xe1\x04\xf6q\xb6\xab\x98r\xf7%\xc0cgt(\xec\x162\xfd\xba\xb0\x8cm\x1d\xed\xc3\"\x8eH]\xdf\xeaY\x8e{|`\x93\xba/\xbd\xb7l\xecm\x94\xdeJ\xdb\xe9\xe1\xdf\xd8m+~\xe3\xd6\xee\xce&2\xf7\xb5\xb4\xb5\xf7\xd9\xff\xf7\x7f\xfa+\x03\x8bZ\x13\xebS\xb8E.\"\xd2)\x95\xe0\x01\x95@(\xa7\xc8*\x01\xc9\xcf\xcef\xab\x94,Yoi5>\xdc\xe1\xd4]\xa9)V\xbeg<\x8djg\xc7\x0ev\x00\xa2\xf6js\xc0\\n\x90\x8e`0\xf3\xb44F\x9bo\xc3t\xa2\xf6\xcd|.\xa1?W\xd5\xeb\xf9\x9e\x1d4\xdaݸ\xe7w\x81}\xe2\xcc\x06\xa9B\u061cn\xb7\xb6\xf7JyN\xc8x\xbd\v\xb0q\x92rɠ\xae\xbd\x8dR\xb3td\xc5\x11\xfb\xf67\xff\x93c\x1dZ\xf47\xff\xb3|\xfc/\xf2\xf1\x87\xe4\xe3\x7f\x95\x8f?,\x1f\x7f۱\\\x8b\xfe\xe6\xef\xc8\xc7ߕ\x8f\xbf'\x1f\x7f_>\xfe\x81|\xfcQ\xc7zb\xd1\xdf\xfc#\xc7\x1aZ\xf47\xff\x9bc=\xb5\xe8o\xfe\xa5c\x8d,\xfa\x9b?\xe6XG\x16\xfd\xcd\x1f\x97\x8f?!\x1f\x7fR>\xfe\xb1cq\x8b\xfe\xe6\x9f\xc8\xc7?\x95\x8f\x7f&\x1f\x7fʱ\x9eY\xf47\x7fZ>\xfe\x8c|\xfcY\xf9\xf8\xe7\x8e\xe5Y\xf47\xff\xa7|\xfc\v\xf9\xf8\xbf\xe4\xe3\xcf9\xd6K\x8b\xfe\xe6_9V`\xd1\xdf\xfc\xef\x8eul\xd1\xdf\xfcy\xf9\xf8\v\xf2\xf1\x17\xe5\xe3/\xc9\xc7_\x96\x8f\x7f\xedX\xa1E\x7f\xf3o\xe4\xe3\xdf\xcaǿ\x93\x8f\x7f/\x1f\xffA>\xfe\x8ac\xbd\xb5\xe8o\xfe\xaa|\xfc5\xf9\xf8\xeb\xf2\xf1\x1f\x1dkf\xd1\xdf\xfc'\xf9\xf8\xcf\xf2\xf1_\xe4\xe3o8֯-\xfa\x9b\xff\xeaX7\x16\xfd\xcd\x7f\x97\x8f?\xe2X\x870\xdc\x7f\xe8X.<\xff\xa6c\xbd9\xb7\xe8o\xfe\x9bc\tx\xfe-Ǌc\x8b\xfe$\x97\xed'\xb9^?Ʌ\xfa\xe9\x7f\xc6\xe9\xff\xe9\x0f\xc9\xc7\x1f\x96\x8f?\x82\x8b\xf1\xd3\x1f\x93\x8f?!\x1f\x7fJ>\xfe(\xae\xd0O\x7f\\>\xfe\xa4|\xfci\xf9\xf83\xb8l?\xc9\xd5\xfb\xe9\xcf\xe2\xea\xfd\xf4\xe7\xe4\xe3\x7f\xc7\xf5\xfa\xe9/\xc8\xc7_\x92\x8f\xbf,\x1f\x7fU>\xfe<.\xdbO\x7fQ>\xfe\x0f\xf9\xf8+\xf2\xf1\xd7\xe4\xe3\xaf;\xd6\x17\x16\xfd\xe9o\xca\xc7ߖ\x8f\xbf+\x1f\x7fñ&\x16\xfd\xe9o\xc9\xc7ߑ\x8f\xbf'\x1f\x7f߱\xbe\xb4\xe8O\xffP>\xfe\x81c\x9d[\xf4\xa7\x7f$\x1f\xff\x18w\xc4O\xffT>\xfe\xb9|\xfc\v\xf9\xf8\x97\xf2\xf1Opc\xfc\xf4\xcf\xe4Cn\x9a\x9f\xe4n\xf9\xe9_\xc9ǿu\xac\xaf,\xfaӿs\xac\x1f,\xfaӿw\xac\xe7\x16\xfd\xe9\xffv\xac\xf7\x16\xfd\xe9?\xc8\xc7\x7ft\xac\xaf-\xfa\xd3\x7f\x96\x8f\xff*\x1f\xff\x1d\x1f?\xff\xcf\xf2\xed?9\x96oџ\xfe\x8b|\xfc7|\xfc\xfc?\xc9\xc7\xff\"\x1f\x7f\bw\xe7\xcf\x7fX>\xfe\xa8|\xfc\t\xf9\xf8_q\xcb\xfe\xfcG\xe4\xe3\x8f\xc9ǟ\x94\x8f?\x85\x9b\xf4\xe7?#\x1f\xff\x9b|\xfci\u070f?\xffY\xf9\xf8s\xf2\xf1\x17\x1c\xeb\xb5E\x7f\xfeK\xf2\xf1\x97\xe5\xe3/:Vdџ\xff\x0f\xf9\xf8+\xf2\xf1W\x1d\xebĢ?\xffu\xf9\xf8\x9b\xf2\xf1\xb7\xe5\xe3\xaf9Vlџ\xff\x86|\xfc-\xf9\xf8;\xf2\xf1w\x1d\xeb\x8dE\x7f\xfe\xfb\xf2\xf1\x0f\xe5\xe3\xef9\x96\xb0\xe8\xcf\xff@>\xfe\x91|\xfcc<\x14?\xffS\xf9\xf8\xe7\xf2\xf1/\xe4\xe3_\xcaǿ\x96\x8f\x7f\x82G\xe4\xe7\x7f&\x1f\xff\xa7|\xfc_\xf2\xf1\xaf\xe4\xe3\xdf\xc8ǿu\xaco-\xfa\xf3\xbfs\xac+\x8b\xfe\xfc\xef\xf1L\xfd\xfc\x7f\xe3a\xfa\xf9?ȷ\xff\xe8X\xdfY\xf4\xe7\xff,\x1f\xffU>\xfe\x93c}\xb0\xe8\xcf\xffE>\xfe\x1b>~\xfa\u05ce\xf5\f\x16\xfe\xdf8\x96\xf7\x83E\x7f\x06p\xc1-\xfa\xf3\x9fw\xac\x10\x9e\x7fܱ>\x83\xe9\xff\xef0\xf2yAo\x19\x19\xc6K\xad\xb7ih\xaaŋ\xe8\xe6V\x05\xf7\xb3\xe5MC-\xa9\x18\xabE\xe1VF9۷sjY\xf4vN(\x9f\x0fю\x90\x93ۂ\x82{\b7c\xbb]\xa6\xe2\x8a\xfe\b\x14}\x036\x98JL\bL\xa2\x15\xa4\x0ez\x14Ҵ\vؓ\f3\x8a\x7f\x9a\xd18\xde\xd8\x1eV\xa5\"ڬV\x9b\x1e\f\r\xd5H(;f\xbe\f\x18\x01rD߰\xa1\x18\xd7j)\xeb\x7f\x8c\xd6g\xe7\x8c\xf1\x8eTSk\xb4\xe9\x05(\xd9L\xc0\x86\xa5\x8b\xcc\x13\x13\xdf\xf6\x91\xa7© T\xfe\x14\x14\xf9\xba\xa3T0q\xc9\xc0##\xbda\xa27B$\xceC\x05\xb836\xebz\xf6\r\xbd\xa4#*(\xa7>q<\xfb\x92\xdeP\x8c\x92J\xfd\xbc'\xb93D|\xcf\xc80\f\x84\x17\xccx\xe7\x82U\xdb\x1d\x89\xfdyc{Bn\x15n@\xed\x05\xb6Iյ'h\xebh_J\xc9}l_R\x8e\xf3\xea\x93\x14g\x9eH\x05\x04\fTAn\x8d\xeaQ\x89\xaf\x02\x9d\xa92vS\xabUc\xec\xa6*\x9e˩q\x0e-\x1f䄦\xbf\x05\xa1\x17H#nl\xdd\xc1Ֆ|/\xea\xa6ƕ\xd4˴\x82\xd1\"o7\xb53_o\xef\xb6Q\x84\\ʝ\x18\xb2Ywv\x1f\ue112\xcfͤ\xc8];&\x90\x1e\tJ\xf4\x9f\x1c\xf4ߝ\xf1n\xaaL\x18oIb\xf2\x16\xf47\xf9\x96\xedH\xce4\x97\x82\n&\xd4\xcfN\xae\xc1\x9c\x82\x96\xf2_P]\xd9pu\x8a\xec=\xa4r\xe5\x0fA\b\xc9ך*w9\xc5\xd1\xf1b\x9aV\xc5rRya\x9dӺ(T\xa8\xb4\xbd2\a\v\x81{\xc1\x19\x13\xf8\xac\xd5x\xf3\x82Ǳ;\xc1$\xf53_\x81\xd6\xe3\xca7\xaeն\xd2z\x99\x14\x19\xe42\xa1\n\x97\xa3\xe4\x7f\xf9O\xa8\b\xe6\xc8Cܮ\xa1\xea)D;8g>\xa1\xd2\xf9z\x95i\xaf\xb4\xd5Q\x1eT\\\xb0\xb1\x02\x15P\xecB}\xac\\0&:A\xc2\xd6\xe98=\xf6\x98\x7f\xc2<\xfb\x1c\x01\v\xecs\xbd\x914\xd8\x1c\x1b\xe7aR褲\xf9\x83\x1d5$\xa9\xd3<\xc5\x19cL\xfdL\xc3\xf7\xa2A9\xaa\u00971\x1e\xa4\xd5i\xf8\xe9\x9a\xeb9+\x1d\x80\xb43\xd4Ƅ\x83\x94\x87\xb2\xc8M\xd6\xeaCU?\x9b=M\xe6Nٰ3M\xe9XP\x1e\x9eJ\n\xd6\xf6\xbbcЋ\x12N\xa8\xc6F\xc7\xc4P\xefUk\xa9!\xf5\x88\xc5\x06\xa4>\xaf\xd5\xf4JU\xce\x11R\x8f4\xa4\x06 ݉3h\x1cg\xd08\xf5\xf0\xc0\xfcN\xbd>\xcdAf\xd93\t\x9eǃL?\xf9\x8c\xf9]\x17\xc1\xf3\x18\xc1s\f\n\xf2\x00\xf7\xc6\b\x9e\xe3\xa2\xc2\xf2Y\x17\x80\xebM\x92x\n:\xbaP\xe4,\x0f 'IbOXA\x0e=&\x00\xc1/j\xb5\xeaDv\xeb\x8a\xe5x\x95\xf4\x88\t\xf3\xbd\x03\x96\x00IRͫ\x95)\x83\xf5\xc5T\xb0\xf3/a\x03]\xd5jW\x15/\x88\x85\x1b\f\xd5{I\xae\xa3Z\xed\xc8\xccu\x94$6\x8c'\xb3\xa92\xb6v\\\x00\xf5{{\xadR%\xe1ݭ\xad\xed͏\xd1X(\xe8+(\xe0n(-l\xb4\xf7Jx\xf8\x9f\xe0Q\xb3\xe8yi}c\xb3\x94\xfa\x05\xe7h\x06?\xf3\xbe\xf4=\x1c3W\x1a\x81\xa1Y\xf8\xea\xba%W\x93\xba\xa9\xda\xd8\xfd+\xdfjm\x951&6vZ\xed\xbd\xbbX\xe9<\xf5z\x94I\xc4\x05\xe9F\xbdEIpW'9\x16D\x8f\xb1\x06\x0e\xb8ٛ\"wuo\xafT\x0e\x8f:~\xe1\x1dӥ\x0fl\xaaT)r\xbe\x13\xb4\x95\x19x\xdd\x00\x9f\x0e\xee\xa0\x03\xbfY\x0f\xc0W`{d0\xcf\xe9\x87\xee\x96\xea\x10\xa1\xed\x80چ;\xbb\xad\xbb\xd4\x03X\x98\x0f\x80\n\x86NQf;\xb7\xbb\xb5\xb7\xbeY\xceN\xde#v\x16\x1e\xfc\x95\x86\xc9\xc7c\x15R\xbc\xe8\xb2\v,\xdfV!H\v\xc6\a.\v\x8b\xf6\x06\x1e\v3{\x84\xf8\xe3L\xe7\xe4Ļ\x99v*\x8d\x18\xefŃ\x0eP\n\xf0CI5\x95|\xb0\xda2\b\x01\xc9\xe0Ԛ\xad\xd9d\x81\x16H\x17\xcbFN*\xa6\x8e\a\x84\x86\b'\xb6\xb6\xcaU\x8e\xf64\x8fo\xa7\xb5\xb9\xb3W\xaa\x8b<UC~\x16\x1f\xa5\xba-\x99voi<v\x94=\xae0\x16\xec\r\x1cӾ\x06v\x14<\xca\xe2\xa7\x19\xaeD\x00m\x9e;\xa11\xa9\xf1\x9c\xe2\x11^mQ\x89\x1bG\xb9œ\xc0\xc4\xcb\x06\x1c\xaf\x1cH\xb7\xfc\xcc\xf4\x06\x1d\xd0)\xb1\x05uQ\xcc\xcbs\x8a\xbfb\xeex\xf9N\xa2\xd1F)cnkK\x1dVt\r\xa8x\xac\xe0\xceN\xa9\xb8\x82g:\x89\x92\xa3\xbf0\xa5\xbc+\xed3f\x99\x95͐\xe5\xf15\xd32O9\xbd\xcb\xdb\xe5!\xeafZ\xe5\xa5\xda\xfat\xc4J\xf0qz\xc1f ϝ\xb0\x99\x1d\x12z\xc9f\xb6K\xe8\r\x9b\xd9\x1e\xd8\xde\xcd\xec\x18l\xef\xfc\x0e\xf8\n\xbaR\x02\xf04\x94\xbdD\xb0\xed6!\xa4\xcaFI\x12\xea<!\xa9\xb2a\x92\xb8\xf0\xee6#\x1e\x87\xfe%\xb7!\xd74I<\x9d\xcb#U6N\x92X\xbfǤ\xca\xc0D\xc0\xbe*9U\xbe\x04g\vf\r\x8cA0d\xd3㺾\xe4Xԝ\x81@[*\xd5\x04D\xd1&\x81\xf2\x96v\xa1\xb1\xe3\x91ts6\xd1\xefC\xf9~\xa9ߧ\xf2\xfdF\xbf\x8f\xe5\xfb\x99~?7D6\x869\xc4\x15\x1aA\xb7\xda\xf75x]Ќ\xa4\xeb\xeb뫵aJ\xbd\x8a)\xc5\xc283\xf2\xf1\xefV,$\xb7\x8bJ\x84AQ\x89\xb0\xda\xee\x98j\x83c\xb0\xcc\x04\xe1\xa6\xc4\xe7\xa6,\xb3\x9d\xb69b\xa6\x88\xba\x01\xb9\xd6\x1b\xa7\xf7\xca4I\xea\xf5Y\x95\r\xbbS\xa7Z\xb5\x87%\x0e\xa2P\x1f}H\xc0S٘\xc2\xd36,\xab\xa4\xfb\xab]\xd3\xc1S*\xb2\xe8\xf7g\xeb\xad֨ߟ\x8dv\xc1\xe1S\x7f6\x1a\x8f\xc7\xfd\xfe\xac\xb5!_[\x1b\xdb\xf0:\xe6\xeb\xf8:\xe6\xebc,3\xc2\xd7\xf5\xd6X~mq\xf9\x18\x0f\xee\x92}\b\xed\xe6Y\xaa l\xe5T\x10zn\xe3àw\xd8\xf8n\x90\xe0\xdf\xdb\xf59&%\xe0\xe9|\x00?\xe57\xf5\x03S\x93\xde;\xf9\xdaj\xecU\x06k\xf7o\x1d]Ԗ\t\n7\xb76\xca\xf5}\xc9m\xc1Qc7@\xf9 (\x15d\x91B\x18^\xd1\xe0\xf4v\x85\xe0\xce\xf0\xfb(C]\xd4j\xea\xae\xca5\xd1\xe3\x83\\\f_\xa8\xbf!\x83t\xa1F\r:\xd4]:\x86O\xa4\xceJ\xbdRJ\x88\xa05 \xb2~Y\xa7\xa7~8r\xe3s\x8c\xe9w:ӆ\xeeR\x136\xd2\a4һ9%\xc48\xe9B5\x19V\x83\x1e\x81\xff`ƒ\x06\x96\xcfb\xe1@W\x8c\xbe\xc1\x12\xb6\xcb=\x14,\xdf\x1f\xc5\xf0D\xc5\xf6\xd2%\xac\x9b+\xdfm9m\x1a\xf5\xf8\x00\xc2\xda\x1aa\xa4V̬#C\xb0\x03\xf6\r\xbe\x9d\x8c#\xf4\xe9s\x94\xd3lZ\xa5\xd2\x14\xd5j\x8b\x91\xedz\xad\x01\x98Li\x83m\x8c\x14d\xa1Q\xa7\x8c\x1aĸ|Rx\x9f\xce\x04\xbeOgB\xeaE\xa1[\xaa\xa5\xea8\n+hk\xea\r5\x97\xb4\x1e\xe3f[\x19\xb6I\xfb\x8f;\xf4\xe6\xfc<\xb9\xdbQ7\x9c(p\xdfʘa\xd2%\xebGp\xb7\f} ߮\x17\xcb\x1a\f>C\x9f:*\xe4ʛ\x94:\x8b߶7K\xbf\x19F\xa5\v\x1f\xda\xdb˾,iȴ+-\xf9\x927,]\xcc\xd0\xde^\xfa)kP\x93\xf8%s \xd9n%\xec6s\x82;\xe5\xbcĕL>\xb92ŅI\x19\x84\xa6\xd5]9\x1f\xcd0\bC\xfd\x01\xb06*\xa3-\xda\xedE\xfc[\x9a\t\xde\xd3\x0e3\v``\xee\xde$\x81\xbe\x81#\xa8\xc0\xd67=\xba\xc6Y\xcd\xf2.\xc1\x81\x80f\xf3\xe2'\x18Q\xead\x1aqw\x84\xfa\xf9\xf7R\xb1K\x11\x8d$\xa9Vm\x0fU49\xd8\xf3\x03\xde\x01\x98\x95y\xc1\xbf\xb3\xbbN+\xe9\xb5\x1b{\x83\xfe\xe8\x11Y\xa2o\x98i&-\x04찵g註\xd7j\xed\xb4\xf7\xf6ַ6w6[{{m\a]+f\x01:\x82$\xd1\xc17\xaa\x00Zӻ\x9f@\xb4\xb5F\xbbV\xe3\x0f\xdb\fB\xa2\xf1}\xd4(\x03\x05\xaa\xa5N\x17\x8b\x1a\x16\v\xd6(+ \x8f4@\x89\n\"\xa2X\x8fNd\xa3\xcbz\x1f\x83\xfa\x1b\x01[<AS\xaf\xc5N\x06xc\xe5\xeb%B\a\x97\x11\xf8\xc0\x94\xfeb@]y\xa5B\xb3\xd2\xd9w\xd9Z\xbf\x99\xf4{v\xd7\xe9\xbd\xeb\xf5\a\x83G\x89ݳ>\x1b\x10\xbb\xeb\xd8\xddj\xbfMz\xef\xfa\xfdA\xd2\xef7ɣn\xbfM\xfa\x835\x8c*\xb3\\OTɀ\f\x9bU5\xd4ha!ӑ\xa2\xadm\xb6N\xf0r&\xc1\xaa|KQ\xe4*n\xf1$\xb1\xd30\"IRu\xb3\xdf2\xa3\xa8ոa\xe6+ԡh\xb5\xf7\xeeF\xc6\xf2]4\xee\xb8$\xc9\xd6Ed\xbbJ\xbe\xe8ު+[\x9b\x01U\x19\xd3&ӌ\xe3\xball.`\x13ʬvs\x1d\xf9\rv\x00\x9eW\x9b\x10\xafGjӆ\xb5Z\b\x01+c\xfdl>;:}\xf5\xfa\xf8\xcdq\x92\x80vPג\xa0Ȏ\xa3!9m7\xadz\x00\xb4\xdbJ\xa7\vUЖ\x86\x19\xe2\xa8^\xb6\xb3\xbe\xbd\x02{X\x8d.\xd4j9p\xd4\xc9d'\xcc.\xe1\xefFhx\xac\xabN\x12!}x\xb6\xb7ח\x1aT\xdfad\xc1p_h\xd7(\x10Ua\xd9\"\x171\xf7ޠ\x80\xafc \x86R\xbe\xc4\xe6\x0ej\xb2\xe1EezL\xc7ț\xf7\xc79i\xa4\xc8\xc3\xf4\x04D\xfbh\xc8\x15e\xc6эvW4\xa7\xe1\xd4&\x19*\x1a\xd16\xa1\x8dF\xda[0\xb7D\xaf\xc2\xed\xf6\xce\xd2\xfe~z\xb7*\xd1~+3\x8c\x8c\xc0.\x1b\x95϶ڻ\x1f\xdbZ\xc6\xd0\xcd5\xc8\xc9\x01\xfay\xc3\xc8\x16\x1fW\xe7\x12\xf4\x9a\x86\xa8\x9c\x96\x8d!\xdcou\xedz=\x9b3e\xf0\xde\xe3T\f\bq\xa2^\b\x03c)\x16\x8d\x91#\xca\xfa\xb2\xa7\x83\x98(\x9f\xffnʟZA\x1f\xcaME\xf3;\xee\x16\x90x4\x15\b \xea6\xfc\x02Gt!\xa1\x12\xce\xc8Oh\xdd\xd9.\xbf\u0091Ѿzi\x03\x1dm3\x93\x94\xac$\x1f!6\xc6Ƕ\x94[VhJ\n\xcfЍ\xf4^{\xfb\xb7\xaeNE~\x9cS\x8c\xd1\xf1q\xd5e\x1b$\xad\x0fV\x0fǝ\xd9%\xa4b;\x83\x1e\x93y\xc0x\x04\xa81\xb5-P\xf6\x7f罁\xe1*\x94\x95\x9d\n\x8b\x9bJ\xa7\x17\xdd[r\xd0ύz\xf5\xba\x180\f\xf7\xa7-n\xd1\x0f\xc1}X_%^\x12\xe4\xdd\x17\x014\x01:\x12\x85\x95F\x9c\xd7$1\xef\xc4\b\x98Ǹ\xe9\xb7\xd6K\xcd\xebw7Z\xdbw\xed\xb3\x9c\xd9bڏ\xad\x16\xda\x1f)Aw\xa4C\xa5P\x8e\x83\x04Ѣ;<\xe79\xe3\x04 \xa6\x97\xc7~\xd0JB\xd2\xc7\xe6Bđ\xed\xf2\r\x97\x93\x83\xc0\xbdY.\xfd@\xf7\x1cw.oo\xa0\x1dcU\x19'K|\x89\x94:\v\xd9h\xb7\xb7wV\x9b;\xa10\xf1\xb74w\xaa\xd5P\x1be\xc8\xe3\x98\x1a\x030\xb5\xad\xb0H\xc4\x7f\x9cy\x117~\xda\xd6Lx\xbeE\x9ap\xa3\xa5\xb1\xfe92\x9c\xbd\xe6\x99\x17@\x94+\xe3\xa7οT\x1b+\x9eS𠸊\x1f\xa1ECw\xf1\xe9t|\xbb9\x85\xd5\xfc\xc4s\xc1m\xdc\xee\xe8.\x00\xa4\xbfe\xdc\x1c\b\x1e\x02+\xa0\xa3\f\xae\xc0\xe3u\xe7Xh\x1b|\x1a\x9e\xde\xde\x0e\x86\xd46V 3\n\xc8\u0099y\f-\x02B\xdb\xd5尔\xaf@HL\xb4{4\xbf\xe7\r\x98\xdb\x13u\xf0\xb2\xc6\x1am\xc3j]f\x15\xf5\xb6\xcc,:d&3{)\xbbp\x86n\xc9m_:M\x90\xc1\x98\xe5D\x80\x15\xe8R7\a4\xbc\xcb\x04\xd1X#\xd9\xff\xfdueb\x18ڂ\xb6h\xa3MԪ\x95\xba\x83Lw\xfc\xa7F\x93t\x81\xb0\vWD\x93̻\xbfE\a\x1d+\xb7\x8f7\xb6\x8b^s\xf2\x91 3\xea\x1b\x02\v\xd5j9,?\xb3\x13\x94\"\b\fqt\xb7\xc5F\x0e9P7\xd2\n\x86\x1f\xd1W\x12\x86M\xfa\xd8\xea\xd3+\x155\xd8~\xd7\x17Zz\x9dq}\x95\xa1zE\x19\\\xdeN=孷\xd7w\xb6\x16]\x0ec\xb2\x01>t@ϕ\x84\bH\xaeZ\x9d\"\x14\x90_C&l@\xdb\xda\xdb\r;l\x04*\xb8`H݃\x16\xae|\xbd\x1e\x1d\xb0\xddVK\xafbzN{\xad\x81TF\x8c\xb2\xbau\xb8\x1em\x8f\xa8\xf3J\xee\xcfβh\x04\x809\xdeK\x9c\x80\xc8a\x9e.\xc1\xa8R\xf7\x93#\x18(\xbdX\x81\xff)=4\\(\fT\xf5\xb1\xbb)\xc3\xf7 \xb6\xd5'\xefE\f\x87\xb5:\xba\x96!\t\x96A\xc4>\x8a\xe9\xee\x8d\xed\xc8\xd4'\n2ϗf\x9ej\b\x91I\x15(k\xef\xed\xe9\x9d\xe0\x994\x83\xb1*\xf5\xba\xc4o0\xa9\x13\x95\xc4\xeer\xed\xcc(\xad\f\xdbdF\x05@ʁYT\xb9\xefA\r\x90\r#\xaa\xed\x9d\xed{8:\xec\x02[\xce\t\xf4\x05\xbaU\xaa \x8d\b z4\xe8\xbdk\x02\a\xa8.\xd9Av\xa3\xdb\x1f\xd5\xed\xae\xd3o\xf6Gu\xd2%\x9a1\xa48C\xeb9\xce\x10鯓\xfe \xb1\xbb\fK$\xfd^\x7f@\xb2\x9f\xc9\x03\x02n!\x81\xdbԷ\xfb}\xd2]\x9b\xa0\x15\x9a]\x8ai\xa9!ln3\x06\x9c\xfas7z\x12\x8e8\x1at\xa5\x1e\xd9,\v\x84ƙ7\x05\xdbd\x1cJ\x0f\x1a*g\xd0\r\xd3|.x\xa6\xb4\b\x84\xd4\xe6\xe8N\x03\xcc\x05\xf3\x9eGP\xe2[\xb6#\x91a\xb6\xdcIۢ4$I\f\x1eX\x85+\xa7m\xbc\xe0\x84B\x80\x13\n\xbe\xe0\x84B\xa0\xf8\x10\xb8E\x19\x1c\\\xf4\fz\x17\"eଈ\b. V\x19\x0eg|\x86.\x1a_\xd4.\xb6,\x84\x15{{-\xa3Kk\xfdx\xed\x0e\x95\xaa|\x1c\x9a\x8c\x03\xab\xad\xf4\"\x92\xb7w\xa4\xdb9n\xb1\xd5+ȥ\a\x16\xea3|\x94xz`рYX\xd1ư'+:3*\f\x99\xd5{\xb7؎\xcb,\xd8\xc3F\xb1\x11\xdf\xc6\xef\xa3\xf1x@n\xd7\xe7\x16\xf5\n=<\x1b\x8f\a\x980\xccU\x15cUV=\xaa[\x89U\x0f\xea\x16\xb1\xeaVע\xbe,n\nϻ\x16\x9d1\xbf\x1e\xd7m\xd5:\b\xe7\xb1p\x0f\xa3\x1d\x0f\x94)c\x02\xfeU\x89U\x87\xac\x16yd\xa1ڋ\xca\a\xedt-\xa5_+\n%\xe8T\xcb\xfe\x83\xbaew\x99\xecObՇ\xf5\xd9=\f\x17u<\xa7\xa9\x8e紾\x93\x13\xe0\xc3<\xaf\xef\xc8ѯ\uf70dq\xbd\xdcƇ~\xffz4n\xf4\xfb\xd7\xe3m\xf8\xb3\x8b?Ǹ2\x87\x8d\xef\xfa\xfd\xeb!\x14\xb9\x1e\xc1\xd7\x11~\x1dq\\\x9a~\xff\xda\x1d\xf6\xfb\xd7gmHہb\xf0\a[\x80\xe0T2:\x15\x86\xa7\x92\xf1\xa90@\x95\x8cPտ>\x93\x9bA\xf5\xa7\xb5=\xae\xf4\xfb\x02\x8a\x9f\xf5\xfbP\xd6m\xe1ă\x0eC\xd0\xefG\x98i}W>\xf6\xfa\xfdY{{\x17r\xb4w[\\U$\x1fm\xf9X\x97\x8f\r\xf9ؔ\x8f-\xf9ؖ\x8f\x1d\xf9\x90u\xb6\xf6\xe4Õ-\xc8\xcem\xc1c\xa3\xd5j\xe1\x9e\xebY\xf5\xb0n\rpo\xf5\xfb\xa3:\xee\x9e^aV\ar\xef\xe0\x8e\x1a\xc0\x8eY\xdc\xc1P\x8dW\x17\xf5\b\xd6w`\xa9\xed\xb1z;O\ufddd\xc7ش\xac\xf6\\\xed:\x1f\xb7\xf6\fw\xd8H\xa5\x8d\x8d\xb4\vL\x93\x06\xb2v\xd7\x19%\xbe\x9f\\$\x11O\xe2D$\x97\x1c8\xd9t\x92\xcf\xf34\xf9\xfa\xeb\xe4E\xf2\xfa(9I\xde$\xdf\x1ca\x9eK\x99\xe7\xe3\x00@\xb2\xec\xf0C\x8d7\xa5\x87\xf0\x8c\xdd\xd4/K\x0ea)\xa8\x18\xd2i\xf1\\Biy.\xaf\xf4\xb9\x8c\xcb\xf2\x9d\xd1#}\x1e{c8\xb60\x93u\xab~\xa1\x0egϥcj=\xb0\n\xe5\xe8\bsM\x8c\\\xf5\xf3\xb2|\xb2\xcesY'\x1d\xcbR\xe0\xeby\xf4\xc8\xee:\xed\x937\xc9\xfa˧\xc9\xc6막ݭ\xf6\xda\xeb\x1b\x03\xd2\xef\x8f\xde|IС\xf3\x19*ڜ\x0e\x88\x95\x15\x89E\xb2\x1e\x8c\x92\x8dh\x94+\"\xce\xd3\"\x87\x8dﰈG\xaf\x8c\xee|\x04p9\xd2\xc0e{w\xaf\xd4k\xc2\xe6\xeef\xea\xad\fL\xb6I\x8e\xbd\x96\xa7\xd4ES\x84_\x87W<z\xe2\xc6\xdc\x06-n\x1b\xcc\xc2\x05qP\x8b\xb2\x10\xf0d\xb7\\v&\xadՕ\xbd\xd0N\xabu'\x02\x06o\xf9\x86\xd1\xf7\x0exY\xfb\bc\xfe4\x85#\xbbnc}go\xb5\xebٝ\x1d\x8d.\xb77w7Q\xbbSs5h\xac~z\xc1]*\x12t&݁\x82\x139\xd6\x02\x83\xb46\x9d\xfc~\a\xd6\xf7i\xc0f\x1a\x15\xf0\xd9L\a\xb4\x191A\xa7i`\xfd`i`\xfd\x11\xe3t\xbc\x10\xf4\xfdBF\x9f\x9f.\t\xac\x7f\xbe\xe0\x9d\xf5<\x17X\x7f\x02\x81\xf5G\alX\x16X?\\\x12X\x7f|\xaf\xc0\xfa\x99\xbf\xa4\t8\x11\xa2CH\x1c\xdd\x11X\x7fl\x04\xd6\xf7\xe5\xd0lc\xae\xa6\xa5\x81\xf5\xc3\\`}\xdf\xe0D\xcdd`\xfd\xf3\xc5\xc0\xfa\xe3,\xb0\xbe\xf4\x96?\xd1\t\xb90\xf4cR2\xe77\xf6\xf9b\xa0}\bS\xba\x90\x95\xd0\xe9<=\xa8\xae\n\xb4\x1f\xc8@\xfb\x17\xb9@\xfbC\x06\xa6,\xf9@\xfb\x9e\xed.\x06\xda\x1f\xfe\x96\x81\xf6ǅ@\xfbc\x82g\xc0g\xe7l\xc6\xc6\x1f\x13h\x7fܝ:\xd7v\x98\x06\xdaG\xcf\x11e\x8a\x19\xe0\xa9@\x1d_\tf\\\xb6֓\x18\x11 D\x88\x0f\x01ބh\xd3\xf5xܟ\xb5\xdap\xff\xccZ\xed\x9d\xf1\x00\xa9)S\x9d\xf3\xa30\xe3;\x81\xb2-\xf5\xb9Ac\u00a0\xa4\x82\x82\x9b\nt\xa8\xb4{\xbfH5\x9cI\x81\x84\x8cVSeh5\xb1\xb1\xd1.w\xb9\xb7\xb3\xd9\"6\xd8\xeb\xec\xed\xed\x92\"\xfb\x1e\x12ˮ\a\b[\xa5\xa3\xbb\xa0s\f\xe9\xfcnkkӄ\x88w\x80\xc1\xb8,\x0e+\xb0\vb\xa2\x83Uu\x94c\f\xa5\x8f\xd2r\\C\x00\xe0\xa3\xc3I\x9fyv\\\xf7iK9\xaf\rmA7\x94{c4M*#\xcb!\x00\xd6\x1d˲\xd87\x821,\xdb\x10p\x18\xf1\xf2\x8d\xed\xf6R&\xef\x9d\xce\xe5\n\xda\xd5A\xce\x1a%\xdd\xe2a7r\xc2\xff\x1f{\x7f\xc2\u07b6\xad\xed\v\xe3_E\xe6ۭC\x1cêd;NL\x05՛fh\xd3fj\x86\x0e\xdb\xf5ɦ%\xc8fC\x81*\b\xd9q,\xed\xcf\xfe\x7f\xd6\xc2LRN\xbaϾ\xe7\xde\xff\xfb\xdc<y,\x12\x001\x0f\vk\xf8-\x04\xce\x1d\x1ewJO͙\xb9\xbf\xff\x05xIV\xcdÂĢ\xf6\xd0-p~\xe1\xe1HQ\xa5\xa9[U\xf30\xf4\x10\xd1a\xd4\x01\x02\x95\xa6EK\xcd\xf2n[\x0f@Ai\xafy\xb7\xa3nRB&\"\xdb\xea|¡\xb0'\xf0\xcb9(H\xeeԭ\xc0\xcd8\xf2\xe9\x0f\x9a;\xc1\x1dK+\"\x14uӑ\xba\x02\xe3\x8an\r\xa6\xc0C\x89Vp\xff\xbc#+;\x16\x81\x1b\x02\xe7\x82f\xff\xf0h+\xbe\xd2_t\xc7!,'qtx\xf8\x85\xc0\x802=\xbe3<B-\xac\xb6\xd0\f\xa5cm\xa1Y\x8d\xb0\xeb\x81\xd0,oa\x04\x96,\x05\xb0Ң\x8e\x82A\xb5-\x1e\x8dC0\x88\xed\xb4\xf0\x1aZ\x1d1tK\x92{M\xbb\xd8\x01\x83\xe9\xa7\xd2c\x8e\xaf\xbc׀ȣԿ\xec5\xa0\xe1\xc5\a\x896\xadc\x8e\xd6E\xa0\xc1\xd4\xe6Xuy}0Z.\x80\xe9\r\x9fM\xf1/\x1a\xfaؼ\xdd\xd6\x18\xa0\xfe\x03\x89\xd8p_\xa4\xd6\xebXy\x939\xc1Ɏ\x961\xc0W\xab@q\fI\xe8\x86\xd7t\xa9}\x1c\x14\xf3t\xe9}7xM\xb3\xd0W\xcfA7\xd0q\xe0\xcf\xe5V\r#\rp\xd0TcC\xc1\xb7\xdd\a;\x9c\x8d\xc4\xed\xec\xf4\xf6\x19&xP_\x8b\xe9\xb6\xc8W\xb2\xfax\x8d\x81\xb0>`\xd5~v+\f\x94\xd4\xcc\xf8\xb5\x15\x1dYSk\x12Q\xa1\x8f:\x1dW\xa1C+C\x9a\xdc\x19\x19\xfc\f\x14j\x13+\x86.\xea\xe7\xf9\x12mܪ\xb4 \x99\x88e\xc1\xd8\xc5\x7fU\xef\xceo?i \"l\xccO\xa3\xd57\xfc\x02Q\x98ˮ\xed\xb5\x04\xef\x98G\a\x9f\x99(\x81Үs\xfc\xd3ᩩn\x1f-%+\xbce\xe4\x8a\xd5\xcds\x06<9\xe1<n\xab'4'\xa3V\xb3m\xd9r\xed0\xd11K\xed*4\xea\x87\xcao\x04\xfa\x06\xb2r\xbe\xd3B\xb1'r\xd8\xdb\xfa|[4\xf9BɊ\xec\xf7MC$al\x8ab\xca\xe3\xfd\xce)\x05\xbe\x9c>7\xa5\xdep\xb5uJ\x1d\xde\xedvS\x1e.l\xdcj\xf3/;\x87\xbav\xc2\x1d}6\xe5\r\x87QF{\xdc\xfb\x8c\x02\xb1\xc0\x7f۵b\xa0L\xea*\xd0tUe\xf4\xcd}\xc9pBt;>?<\xfe\\ﾽ\xb6\xca\xfa[;\x19̥\xbbU\x88\x0f\x8c@jߺº\x05U1R\x11\a\xea\x94dUj,k\xee\x0e?S\x00:\xda\xf9\xebE\x80v\xa4-\x05\x91\xaf?\xbb\xfbtP\xf9&O0\"V\x1e\x03\x13\xea\r\x9aQ]\x1aSZ8i3\xefUN\xb1\xa0\x8bU\x12\xa8*wD\xab\xbf\xc2I\xd1˹!\xf2\x16\xc1\x15\xbfbj\xa2\f\xf3\x04/\xfb\x82d\x02\xe07s&\x8d2\x16\xa0P\xa0T\xb6\xf2\x8e\x86P\xc6[\xe9\xfc\v\xc6\xe3\f\xbc\"\x1df\xc0r㫹\x00ڀ\x16\x9bf<h:V\x83\x87\xf0\xbc^\v\xd0\x100oLЈ\xfdvo\x9b\xbbNp\x8bc\xd5\x06\x0e\x8f\xba\xf8}\xf6\xa1\xc5\xd2\x03\x96\xd8V\xe0\xd5n\xa1\xbc=7\x06V\xf9 E!\xea\xdd;\x9d\xfaC\x06\x16\xdf\x1c\x14\xc7C\xab#z84\xd6\x01\xc6\xfa\xb4\xf6(\xb2\xe8\xdd\x1f\xc0\x0e5\xe9\x87\xd0\x15\x9a\xf43\xfe\xfb\x97l\x95\xa6]\x02n\xed&:&\xefzr\xac\x95\x82vFcm\x82\xdae<\x8e\x00\x9a\x94\x13\xbaZ\xafS\xef\x0f\xf9\x9b\x91\x16\x84\xd2:\xe5t\x8a\x06Ä\xaeP+\xb9J%\xbdKKB\x02\x98\x15\xfb\x99F[\xc9SI\xd5\xc9\xf24\x10\xe1Eݿ\xdcP\xf46\xd6\t\x10zpמ\x03\xe0v\xc5p-5\xd4\xfag\x00ꛋ\x1e\x01\xd5\xed\xa2\xbfsحz\x12`xj\xff\f4\xf7\xfc\x01$\xd0\xc1\xa7\x8aq\xa1\xf4\x05~\x87K\x86\xa6{\"+\xe8ʯ7\xabFp\xe0\xb8\x00)\xa7y\xaa\xe8!\xa1\x92\xae4`\xc6\xc1\xd1\xf1Q\xa725xC!\x9fW?\xb3;V\x84\xeb~\xe7\xb8{K5\xc8g\rf\bx\xaeh\xdeKn\xc1\x0f\x0e\x9b]\xe8f\xe7\x815`mR\xa0\xfb\rso\xa2\xa5\xe3v {\b \x06\xb6+\xe7뼐s\x81\x97\xbbϥ\xd4\xc8D\xf7\xba\xe7\x96\xe1}Wl\xf4\xf5\xf0s^\x1aR\xae\t(\xe0f\xac\xd7\xc0\x9b\xda\x1b}=\x9c\x8c\xee\x1e\xdf=:>\x18\x01D\xf6>\x18)\xf3\xfd\xe3\xfd\xffL\xf9\xfd\xe1do\x94\x8dH\xc6\xf5\x00\f3\xed\xe7\x99gCԬ\xbb\xd3\xe9k\x19+\xfaY\x85ZT\x90\xfd\xdbȝ>\xa0Z('jOf\ns\xbf{Љ\x0f\xf3%H\xbamq\x04Z<\xdc;\xec\xea>\xf4\x7f\x11^\x91\xac+;\x8dv\xfb\xf5\x7f\x9d\xec\xed\x9e\x0e?\x82\xedu\xbe7\ac\x95\x02]\x9b\x0e\xcfN\x86#\xfdZ\xc2ku2ܻ\xab\xdfWl\x99˚?\x15\xea\x16%\x8a\xd6\xe5%r\x90\x93\al\xf6\x17\xf9\v\b\xc2\xfb\x99\x15<w]^\r\x16\xdb\xc4=\xa5$\xe3c\xae\xf1\x83\x01\xde+S\x9b@}c\xa7U\xb2\x1d\xdc]\xf8JXn\x84d\xb5\xb5:r\x8b`\xbd.m\xd8d\x95Z\x97\x01\xfb\x84\xca\xc9~v\x8fd\xcefi\xf2\"\x7f\x91\xed\xa2/\x9e-~\xf9\x03\xa8\xf1/E\xe2\xb1\xc6\x7f8\x17\xba6\x01\xf0\xeeB\xbe\xcc{y\x92X\xb5!\x14uu\xfb]\x1d\xde!i\xa2\xaaw˥\x9ePM\xc5l\xc4F\xee\xda\xf6\x8f\x87\xfb\x96\x89s\xe0\xecy\r\xdf[{n\xbbs\xf7\v\xb6@tDB\xa8w\x92\xa4\xbc\xa5\xb9\xd2\xfe\xd3\xd1#%6%\xb3\x82Ee\x05\x8bw\xf7\xefݽ\x1d\x10\x7ftK=B\x16\x16dH\x15\xfeE\x96\xe5\xf1\xd1\xe1ݰϒU\xcd{0\xbf\xa6*q\xac,\x00\xb9ط\xf4\xcch\xb8O\xc6jpQ\x9c_\x94\xc5\xf9\x85b\x05\r\xde\x1e\xacTե\xeaFk\x8ar:\xb8\x18\xdfl\xc0]ՠ^\x9d\xd5\x1cܤ\x0fʢV\xcfrq\xbe\xca\xcfy\x9d\x82\x8cf:XJ>/>\xd2\v\xb6\xb4Fހ69֣\xae\xa53\xb9\xe6ܴW\x83&H\xab\x80\b\xfd\x87N\xf4\x0f\xf4\xff\x8b\x8b\x8b\xf6\xce+\xd5\xfb\xc7\xdf\xea\x7f$`\x88S\xb3\x1b\xc9K~\t\x17\xc4lHKS\x1b4\x883\xae\xa0NN7T~Q\xb2\xb1q\x1a=\xbb\x7f1&+\xb6\x04\x84K\x01$\xaamd\xba\x82\xb3\a\x99\xed+t\x13I\x066+\xb6\xa2\xe5\xc0\x95\xf1M\xed\x9f\xfb\xfd\xb4f%\x89\xa2e#ZRɀ\x02\xaa]~@\x1a\rj>\xad\xc4\xec[^+\x16\xfaФj \xf9yQ+.m\xcd\x1ac'Z\t0\x1c\xbe\x8c\x06\xad\x93\x1cm\fkX܃\xb2\xc8;U\"i\xc5\xf8\x18\x8cA\xd2\n\\6\x80q\x88\xd2\x04\x1d\xf2\xd2*\"\xe2<x\x9dV\x80tuc\xdb\xfb\"_\xf0LnȆ\xd6\x01#2\x9f\xcd\xde\xf2\x8f\xaa}\x90QI\x85ֈ\xacU>\xfd\x80\x13*a\x9eX\x05\n\xf5D8\x85\xf9S\x9a\xa2U\xc8EQ\xce$\x17'\xfe\xd1'\x81\x9b\xb0\xe2\x1f\x81o\xc3$Z*L\xa4\xde\xd0w\x19ς/P\xe5\xef\x06\x12d\xfa\x033\x83xG\xed\x7f\xe4\xd7W\x95\x9c5\xba\f+^-\xb9xQ\xcd\x10Y\x0e\x03LcSnާeUsL\xd1\xce\xf7\xcd\xea\xac\xec\x1e\xfa@C\x15\xbb\x86\n&Od\xd0\x13\x15\xe3\x03YU\nrv\x8d\x02&\xf7Ĵ\x89\x97\x1c\x88̄\xaa\xfc\x1c\x87%\xa9\x97\xb9H\xa8\x91T\x14\xbc\xcen\xa6e^\xd7\x18\t\xda\xe8\xd4f\x93U\x80\x16%\\\xb6\xcc?\x02\xc7g\x9a\xab4o4\xc6v\xc36mc\xdd\bӢj\t)\xa0k\xf2\xba~\x85[\xcd.\x87\x99p\xa2\xa2\x16\xfek-\x91aK`?\x10\x8dA\xaf\bU\xf6)n\x85\x1b\xab\xb6\x91\x1e\xd4_\x1b[v|\xf2\xa0,᫚\x95Qܼ\x10y\t\x1a\xbcq\xb0\xaa\xbe\x7f\xfb\xfcY{\xd1&\xc9f\xac\xcd?\x92\x8b\xf2\x8fz/\xf1,\x82\"\xa4\xaa\x81b\x12\x03\xeb?\x0f\x80\x8f\xe1f\x98J\xd8ۉٺ\xff\xb5\xbdY\xe4\x8b\xe6\u058c\xfe+\xa2ݓ\x13\x9f\xcd;\xf1ATW\xa2\xe7\xb6b\xfc\xb0W\xd4=Q\xa9\x9e\xdd+\xf8\xccfժ\x92\xfa\xcbǅ\nx\x95p\xf5\\\xb1\x9cШ;\u07bf\xe7\x8bB).\xb3\x9a\x06S,[m\x10\xbc\xc0\x1f\x97\xa9\xf2;W\xc6iq.*ɟ\x96%?\xcf\xcb\x1a\xdd\rF\x19\x97\xd8\xc1\xb4\x18p)+\xf9:/j>3Տ\xc2\xcc\x16\x1f\x9cU\x85?(\xfc\xa9U\xb8S\xc2\xec<\xc5\xc0Ի\xbd\xb07^U\x03.\x927\xe12bZ5\xdc}\xc4n\xc2\xd9O\xfd\xf4e'Q\xbaS\x9fg\x99\x82\xf6.ݿ{h\x18\xf0-BD\xdd\x0e['?'}\xa2\xe2\xcb\xf0\xf1\"\xaeX$\x9d\xea\xe4s\x99<\xf3\xba.\xceEo\x9a\v\x98vg\xbc\x87\xb2\xd1Y\xef\xaaP\x17H\xaa\xf6*\xd9s\x062\x1e4\xdcY\xe4m\xba\xed-\xe45rӣRb\xee9\xf2\xab\f\xb37M\xf2\xb3i\x82ӓ\x9f\xdc9e\th\xa5&w\x12ƺ;\x0f\xb6+\x18͓\xe1i\x1b\xa4V\xb1\x1b x\x86cy\x7f4\x1c\xcb\xdd]\xa2N\x92\xf7ɮ.j0\x97\xd5\xe2\xa1\xd1wO%95~E\x87\xa3\xfd\x83\xc3;Gw\xef\x1d';\xb7\x96\xaa\b\xa0a\xa6]V\x99\xe8\xe0\x96x7(q\x83\x05p\x95t\b4w\xc6\xe7\xe7\x17\xc5\x1f\x1fʅ\xa8\x96\x7f\xcaZ%(\x04T\xf0a\xb7\xf5\x8f8\xe1\xc6\xf6\xa7\xfb{\xdf[`\x8a\x99F}\x0f\x18\xef\"\xa8\x9a\xd7=\xf7\x17\xfd\x94L\xa2o\x02\xf9:8\xa6\xb2\xfd\x8b\xc0\xed\x1a\xc3s\xc5F\xe3\xd5\xfd&Wf\xbc\xda\xdd\xf5ɧ@\xffX\xec\xc6ԥ\x05t6\"\xb50\xa3\xd0Pj\xe5\xc9\x14\xbc\xf9O5l\x9b\"75Si\x11\xb2ņ\xe3\xe5}W\xcarw\x97\b\x9bA\r\xec1\xcc\x03\x9e \x1b\xfc%\x1e\xbe\x1c\x99#G\x1d\xce7;\xae\xb4\xcf\xf3e\xbf\xff<_\x06\"\xa6\xaa{N<\xe2\xf5T\x16KU\xc9~_L>\x93$\x8d\xb3L@\x1c\x9b\x10M\x84瀜Ru\xe2\xf5V\x90\xe1\x04\xff\xea\xb4\x05\xa4\x8d\xf2\xb2\x13\x06\xb4\x98\xdb\x19\xbc\xe1\xaa\xdf\x7f\xc3c\x89\xd9g\x9bS\x7f\xb69q\x96QsV\x80\x9bRv6\aA\xfa'\xa5o\xce\x14\xd2Fy\xb9\xe6,\xbb\x9acp#\xfb}\xf3\u0fdb\xb4B`3ե̷e\x85\xbdc\x1e\x1aYŕrY]l\xcb\xea5\x9f\xeb\xac^\xf3y#\xab(d0\xe3\x92\xcfuf3fЭ\x82h\xc3F\xa1\x8b\xedf\xbd\xf4\xfc6[\x15z\xc9\xf4C\x10\x87wszݎ@\x1e\n=kG\x18\xdd3zՎ\nx\x12\xf4qW\xb4\xe3\x81яFm.\x8c浢\xef[P\x1f\x9aB\xa6oZ\x11\xb0k\xd1\a\xad`]\xf1\x0fZ\xd3l^V\x95\xa4\x0f\xbb\x86\xe6\xdb\xe2\xfc\xa9P\x13\xfd\xd3\xeef=\x0e/o?\xa7\xff\xe8\\X\x18\xe9au\x1a\x11\x83Biń\x89yo\x0f\x94.\xfb魙7\xc5\xe9\x8d\xcc\xe9\x8b۾6\x89\x03\xa0`\xe0\x1eG\x19\x05q\x8c\xb1\xa7\x1ew\x87L\xda)t\x85\xdf~!f\xef\xabN\x80\x9a\xd7|^\U000a9698\xdf\x06\x82r։\xab\f\x80D'\xa7\x03g\x11\xccXs:L:U\xdd\xdd\a\x1b\xacy <|턇\xc0\x8e\x1e}=\xf4|i\xad6\xb9^\x1b\xbd\x0e~\x00\x1a\x1d#~\xb0^\x7f\xd4\a\xce\xd7\xfck\xea=!+î\xfc\x1aq2\xb5\x81\x1e>\xde\x1cl\xc8.\xa8\xef\xc3\v\x98獻\x19\xb0\xe6$B\xb6\xf7\x87t\x8f\x93\xec\x83\xd5,\x00x#\xab-h\x88%\x01ܼk\xabZP\x99\xf3p\xd7\xfbZ;\xd3q\x15\x954\xf9\xaa\xff\x1el\x14\x06ɮ\t5?9\xfd:uu\xfc\xfa\xdc$\xa4_\xbf\xff\xeak\xadi\x1a\xe7\xa5\\^\b\a\xfd\x1c\xad\x1a\x8f@\xe9\xef\x11{>\x98\xaejU-\xe83\xf6g\xfa\x88L\x1eaO\xfb\x8e\xfe6\xf6&\x9e̪\xd5Y\x89\xbe\xd9S9\xf8sU)\xfeF]\x97\bT4\xf9\x8f\xe4?\xb2\xe4?\xac\xf5^O\xec\xf2]\xe1\xa9\xed'\xc1К\x8a\x99>\xe1\x84~\x9d@3\xfa\x90\xe18\t4\xb4?\xf9\x8fv\xd2\x18y\x10\xf4:\xd8/\xa8\xf1\xf1\xa2c\xa1\xf1~\xff\x85ք\xf2\xb9\xbd\xeb\xcc\xcdb\xd9\xfd\xe5\xec\xfe4\xb4\xfaSǄ\xef\xfe\x90\x87j zU\xea[aS\xa9!\xd2E\xdb\x01u3\x93[(\x17\xdf\xf9Ó\xa6\x81Q\xe2\x1f\xd6f\x91F\xf8ߎB\xecv(nmA\xb52/ޥ\xa1\xec\xafҚ&~tA\xeb%\xa9\vq^r\xe8\xa5:\x18\xf8~\xdfN\x89FD\xe7\xcd\xe5?\xf4\xe5\xad\x17\xe6\xdd[\xacj\xbc\xbd\xd8\x12\xe0\xd6b3\xfd\x0f⪳\xc8?\xea\xf9\xa2]\xab$1\x8e\x9d\xe9\x9fz\xd0H6i\x85\x80\xcaq+p\a7\x91L\xeb\x1c\xb0V4\xb9\xbd5ͪ\xd1^1\xef-euY\xcc\xf8\x8c\xba\x06\xe6\xbdeU\x17\xaa\xb8\x84\t\xa1\xf89\x97\xb4g\xadZ)\xb4\xfa\x1fP\xfe?\xfe\x83\x18\x9d\xe9\x1dl\xb7^\x9fOE\r|\x82\x84\xac\xd7\xf5 \n©\xe4\xd1\xe0,\xa1\x16\xe2ű\xb2\xfb\x1ei\xea\xff{\\\xc6\xef\xdb\xea\xff\x0f%W\xfc\x1f\xb4\xf7\x8fy^\xd6\xfc\x1f\xba\xca\xff\xa1K\xf9\x8f\x7f$~\xa8\n1\xe3B\xc1\b\xb9\x0e\xd5A\xfd~\xf2\xbbJ\xa2\x80\x9dԊ\xbdR\x1bHGC\x905\xfa4\xf6\xe9\x9b\xe1g\x06\xc2\x14\xec\xa7\xd4￫\x84\xf6ra;\xbc\xf7Mo\x18w\xb5\xad3\x9e{\xc5\xf4\r_\xe6x2\xe3\x9couk=h\xa6\xbb\xbdB\xad\\o\xedZ\xac\x98\xee\\3\t\x16\xac]\xe284B\xb1jk\x89\xbf\xe7w(\xb5%\xf0\x9eD3\x85y^\x94S\xe5I\xa0\x16I\x96`\x15\x92q\x97նK\xfdCj}\xae\xb4\x16\xa1>\x98\xc3\xda\xf5F_\x0f\xbfV\xdf\f'\xc90\xc9\xc0~{\xacݪ\x98\x03\xc0\xab\xcd/&\xafSE\xafHv\x85Bǳ\xe2\xbc\x10*\xca\x19>\xfc\xe8?\xdcMD\x12\x7f\xfc\x91d\x1f\xf1\x9c\xfb\xe0\x9d\xbd׃\x19_\xaa\x8bɝ\xcc<E}\b\xce\x10\x04\x1b\x12*\xbea\x1f\xfa\xfd\x0f\xdf\f;\xf6qהO \x18MN,&krb\xd5\f\xb1M/\xbb8\xda\xd8K\xbf+\xe4\xf1\x9b\xa9L$\x83\x901/k\x8eܖv7r7\xffy0\xff\x03I\xe4X\xb27z\u05f78$:\xd9\xee\x88Ф\x97X\x03\xa3\x9b\xb3\xbc晤K\xc9/\xb3\xe8\x03L\t\x9a\x11)\xaa,\x85}R\x91\n\xdd\x16\x18Ocߧ\x15U\xe4\x1bf!@\x92\x93\x87\x85\x9c\xae\xca\\\x9e\x06\xfc\xdaG\xa9\xf1\x83]\xccS\xc0٪\xd8\x03]\\E\x88\xc5~\xf2n\xb2op$\xec\x888\x8d\xa8\x8e\xb3'-\x82\x83%>e(\x1cb\x05\x15\xbb#\xd0\x1b\xf1\x88F\xb4\xb6a\x91\x82\x99\x1f\xce~\x7f\xe7\x9ds\xdc\xfasSЮ]f\x91ȁ\x96\xe1\b^\xea&\x9d\xbb\xf3\xf6\xeb\xff\xb2\x1f\xff^\xffgz\xf2\xfb\xd5W\xa7\xbb\xe4k\xc3\aqܥ\xd1i\xa8\xba\xbb\x01\xc1ɏ\xec\xd7T\xd1G^W\xda^\n\x93\xdd\xf4\xe7I\x92\xf5\x92ݟ\xb3\xa4\x97\xe6\xa2\x12\u05cbjU\x13\xa0\x06O\x93\xdd\xf4G\xab\x994\x9c$\xbd\x9b^\xb2k\x86\xf5G\x9a\xd0\x1e$\xeam\x92\f(\xc1b\x9e\xfe\xe9Z\xc99{:\x89I/\x05\xd5O5M\xf2{:\xf8\xcf\xdf\ty\x7f\xf2_\xe4\xf4?\xbf\xfa\xda@A\x18\xba\xc2-\xd1\x16I\xa2\xd6\xeb\xa7\x13γ\xefRα\xc0\xa6Vn'\x1d\xe3i\x18\x18\x1f\xbfy\xb9\x14 ,x\xacE \r\n*\x88\xf1\x04\xd36EU\xb4px\x81n\xcf:\xd5\x1fιz\xa0\x94,\xceV\x8ao\xb0\xa7\x1c+\x92\xb3\xe4~\xb2\xfb8\xee/\x97\x1f!Tr\xa6\x06\xb9\xfd\xba\xd6\x02nΆc\xc1\xefK\xa7()8p1\xf9.Kzɮ\xe4'\x82\x9f\xe2t\xdaMX\xb2\xfbm\xfa$5ax\xa1%\xd4R>\xd4\vM\xf1\xe3o\x12j\xc4x(s\xe9\xf7\xc37\xe7\xf8+Ŵ\x83\xc1 !\x14\x1f\xef\x7f}[\x13v\x93o\x12\x18\xb2O\xd8r\xbbi\x9b\xdc\xdcR7[[\xc5\xe3\xf9\xda{\xd9\xef\xeft;<\x19\x8e\xd5}\xd7\x01jw\x97\xe0\x16\x02\xe0O4\xf9]$\xc1F\x12Z$\xa4\x15\x87\x9d5\xd9\xfd)\xad8}\x89s=KN\xfc\xf4\xae\xb8\x9bߧIs\xaa\xb5\xe8z\xe3\x05\xefK\xc9z\xb7L\xf2F;\x93i\xbe\xaayR\b\x9dc\x88κ\x93\xfaHP\x9cx\xebu\xc61\x98L\xa0Cs\xdb\x15ظ\xe0\xe8\x82\xe6\xdd\xf4\x1aa\xbe\xb99\x8f\x96\xf3-)\xdf\xeb\x9f\xe4\x04\x8b=\x85\x9d\xe3Q\xaa\x06\xf8Fh\xceI\x90\x11n\x88\xadӭ\xdf/q\x02<\xeb\\'\xea\xe4\xd9i\xbf\xff\xdc\xeef\xcfA\x84\xa4w\xef\x0f{bC\xc2\xdb\fP\x9b\xddy\f\nM_\xda\\\\@ڹm\xe4\xeb\xf5\xe7\xb6\x0e\xb8\xfe\xe4ε\x11\xbc\xad\xda\xc8-nz\x99b\xa3\xbd\xe4y\xbe\xec\xba,\xb9\xb9P\xf0\x00p\xa7\xb0\xa3\x9b\xc6(\xdc\x05ׇۣTR\x05\xcaջI\x8f}\x83c\x80z\x16\xc0\xf5\xff-M\x9e\xe7˄\xe6v;\xa5\x05L\U0006e1af\xbe\xac᫨\xe1\xf9_l\xf8\x1b\xaenmx\x1d6|\xda\xd1prS\xbbf\x87\xad|\xc3UBW\xae\x95\xf5\xb6V.\xbf\xac\x95Άi\xa9\xdb9\xb7\xef\xf3/m\xa9a+ok\xad\xf9\xe4\xefib\x12\xea{L\xb3\xba\xf3/\xabnP\xbdf\xf5\xffBuo\x19\x9c\xb8\xba\xd0ٝս\xf8\xb2\xea\x9a\xdc.>\xc3;h\x17\xfc\x9a\xcf\xdb\x05\xb7v`\xeba\xe0\xafl\xc1\xa6\x9c\xef\xd2G\xa9\xfe\\;L\xfdR\xbab\xbd\xdey\xd8\xd9Ƈ_\xdeF(\xfb\xa1\x9d\xc1\xe4\xf3\xcdt\x1e.\xfe\xa5v\xce\\I\x9f-\xc8Z\xd7\xfc\x8b\xfd\xe9\xce\x0e]\xd4έei\xff\x1c\x7f\xa1\xa4\x88\xa0.\xed\x19JW\x9c\xbd\x9a\xbcJ\x15\xf1\x12N\xef\x15X\x853_Ǯב}\x95\xfb\x8aN9\xebH\x0e\xaa\x98x\xbb\xed\xb9\\\x13\xba\xe4lg\x05\xb5\xeb\xf7\x1dĽ\xc6\xfb\xc5\xfa\xaa\x89a\xc0\xfe\x02;\xd4=\x00\xe3̦|b\xc4\xea@8\xd39g\xe9\x8aw\xe2[FՃ\xe2\xa3\x00$\xed&\xed\xa0ݤ\a\x19\x93\xdd\x14\xe8\x06(\xed\xa4yz\x9f\x9cҥ֕\x9c\xe2\x0f\xa1p\x05\xd0\a=|:\x0e\x14nKGR\xcc\xf9nr\xb3I\xb2\x97\xfa\t\x88\xa7R\x13O\x9b$\xc3 O&\x94!Aaw\x1f7+\xb4\x1a\xceϟ\xd3iX\xaf\xbbx\xf5د\x00\xd5\xe7o\x83_Ez\xa0?\a\x8e\xf9\\\x92_\x82,\x16\xee\x04s\xd1\xdf{.\xbfv\x8c\xf3r\xee\xafd& U^ȬQ.\xbd\x89\xd2}\x81*\x04\xf0=x\xa3\f\xf8\x11\xd6noo\xe4K\xfb!(\xcd\\\xafT\x8b\x11\xd8\xf0\x02\xb4\xd7JA\x05\xd2\xdf=\x04\x9e\xea-*\xc9{\x00\xe4\x96O\x15\x97\xc9n*\xbf\x19M\x92:\x1a\xcf\x1f\xd2k\xdb7C\xda.\x91*\xb2+\xec`}\x9bƒ\x00\x0e\x92\x80\xff\xf8\xfd\xf7S\x14\x03\xfc\xfe;\\\xd9( c\x00\xbe\xc5\xc7\x11B_\xfcH\xa8e\xadF\x9d\xff\xa3WVk\xa0\b\x82\xca\xe9\xbd,9K\xe81:\xe7\x1f\r\xb3D$t\xb4\x9f%\xf3\x84\x8e\x0e\xb2D&\x9b\x13\xe5\xe8\x039I~\xff=ٕ\x19@\x91%\xbb\xa9\xba?:Ҭ\x9e\x84\xec^\x99\xfd\xcd\t\xa2\xd2\xd1Q\x88\xdd\xf2\x9d\x9f\x03f\x05\xa6\xc9.\ad&\x9f\xe6\xef\xe1Tۅ+路\t\xe2\x7f\xb3\x0eãTi\xb2\xabv\x13һIvS1\xf9)\x85x\xcb\x00\x91z%\xe02\xf1\xf9\xfc\xe4\xa6\xc0\x9017\xc8\xf6\"\x94\x18Q\x11\\ava\x81\xf0\xcb]5\x00\xee\x8a\xeb\b\xbb\xd2`\xa1%\xbb\x92\xec\x06i}1\xbf\x86ڎ \xe2\xa0\xc2 h\x83\x95\x96)\xd5\xcfc;\xbd+6\x1cW\xfe^U\x81\x0e\xc5Iu\xca`\xa1Ud\xa2R~R\x81\x83\x93,I\x90\x01\x96ӢK\xba\xf8r\xf2\x12\x14\xbeu\x81O\xc9M\x0e\xba-\xb6\x8c\x9a\r\xc7\xf5}\v\xf59\xaewwI~\x92|\x95\xec\x16'\xb5V\xcd8\xddش\xa5\xde\xfc\xa1\xf8\x12\x9d4\xf4\xfbf\x84\xcd\xc9]\xa2\xcdH\xd9\uf5ee\xd6\xeb\xf5\xd3~_\xe7X\x9e\xb6\xc4\"\xebuj\x85t'\xff\x05\x8c\x94\xafiI&F\x81Q\xa5%\xe5d\x17\xf9\"\xd0\xd6\x12\xdaJ2\x13[6#\xf4!\xd7\xd5~\xe21\xa2\x87\xe3\xd5\xfd\"T}ykǯ\x00%\x97~\xdf\xe4\x0e\xbb\xb5J1l79\xb5\x05\xc1;\x96\xe5X;\b\xd9:\xba\x13\xa0\U000e2caf\x17\xbe\xdclB]/r\xe3\xb9ǆ'\xef\xe1\x7fz\x17\xb9V(<\xe3\\\xf4\x9c\x16\x97\x9fFy\xd7\xf7!2\xcfgs(\f-\xa5\x80G\xea\n\xb6\x9b\xa4\x0f\x81\xad\t\xbb\x13SV\xeb\xf5\x0e\x9c\xf7\xed/T\x90\vm~\x1e\x02lb\x88!\xc3d\x17\xf6&J\xaa\xe3Dq\x02\xed\xb9\x00\x12l6;\r\xed\xb5NS\x17_\x9d\x89\x7f\xcc*O\v*Vi\x94Ϯ\xaf\xc3n\x9d\x84/Y\xees\x90,G\xacw\\H\xb4\x04\a*`qH\xa7\bLn;}\x99\x92\x9bU\xbf_\xa3\x16\xe7ΈZ\xeeͤd\xb5U4.I\x06\x1f\xd1\xd2qv\xe6i\xb8i\xceSsW\xb4\x00VE\xba$\xe3\x15`\x8fy.L\xe980c͜\x01M]\xa8\xd5xww\nx\xe8u\xbf_\x9fLO\ar%R2\xc6\x02\xfdW\x1b\r\xee\xa3[Р\xbd\x81,\v;\xa1\x13\xf8J\v\xc11-\xdc\xe5\xc1\xf4\xad\xeb\x1b\x19\xe5D\x9bY\xe0\x88\xf8{\xad\x8a&\x8b\f'K\x90$\x8e\xd6S\x05&\n\xe4\xe3;\xf1\"Ph\x9f\xaf\x84\xd5(́7ΔO7\x03=Q1\x10\xfc\xa3z[L?tY\xa6Y\u05f5iS\x9fno\x84\xbd\xd0\f\xfefD<\xd12\x1a˶\x1e\x9e\xd6}\x94{\xa3So9y\"Oǥސ\xa0D]\x7fBG\xc0sq\xfb\xeb\n<\xde\xcfɆ^\x84\xda@+\xd1R\ue7af\x84E\x8c\x83\xfe\xf3m'\x1b*\x06\xaaP%gə\xac\xaej.\x13*\x06\xe6\x91\xed\f\xa9\x18pq\t\xaa\x9ab\x90\xcb\xf3K\x98\xe8bp\xc9e]T\x82%\x89\x7f\xa9u\xa2J\xb0\x19\xa4\x9d͞\x15\xb5\xe2\x82K|\xafĔ\xeb\x87\xf9\x1c\x7f%_T\x97<J\xa3\x83\x1e\x94\xa5\r\xad1\x18t\x86\xf1a)\xf9\x92\x8b8_\x13\xf6RL\xe3\xbcJ\x97E\x9b\x84\x05\xa5aa}8D\xf1\xad-\xd6\xf8\x91\xb0\x89\xad\xdew\xbdZ\xc2\x1e\x8f;,\x15\x83\xe9լC\xd9\xfd\xeb\x04\xe3.f\x85\xfc\xb2B0\xe9\x96\"V\x8b\xbc\xfe\xd0.\x04\xcd\xf0\x8f\xf7\xefF0\x8d\x9dFVw\x86\x87\xa3C\x12\x9fH\xf1\xf9\xb2A?\xcb\\\xfd\x92KQ\x88sm\x92^\xd1N\xc5\xe1\xb9W,\xc0b)\xc2\xf1\xe2\x86Q\xec0&\x1cf\x98o\xe5ü\x04\xc89\x00rY\x82\xf8\xb4\x06\xcd\xf7b\x96\xabJֽY!\xf9T\x95\u05ed\xd6\xf7ή{\xea\x82\xf7\xfe\x01zK{0\xc1\xeb\x7f\xf4\x96\xf9\xf4C~\xce\a\xbdw5\xf7\xf9\r\xa6\x17|\xfa\xc1\xbd\xa6\xa4\xa7*ԓ\x86\f\x16\x83\xdek\x9e\xcf4\x95\x9e\xabޅR\xcb\xec\xeb\xaf\xe7g\x83\x05\xffzU\xf3=\xfcxϗ\x92\x90\xb1\x1e\xa8\x1a/u,y*.sY\xe4B\xf5~.\xaa2\xc73\x83\xd6\xc1\x1e\xa3B\x80J>(\xea\xd7ګȌqCM\xde\xe0\x9a\xcb8\xd5\xc2Nx\xa8\xaa2\xe3\xb8\xe7f\x9cj\xb1`Ʃ\xbe~g\xdc\xfaB\xe2T\xb3\\3Ns\x01\x19`F/癢\xc6v$\xe3\xf6\t\x1a\x0f\xba\xfe\x86\xe0\xc2D \x06p\xd9bH%\xb8\xff\xc5O\x14\xad/r\xfc\xe5\x1f\xf3\xa9\xca\x14\x8d\xfb3\xcbikzdU\x80n\xe0R2\xa9\x1d\xf8\xdc9:\x8eܽ\xb8\x89$S\x9c\xb2\x04LMp^\xb6U\xf2}\xe2\xe4\xcd㇯\x1f\xbf}\xff\xe8\xe5\xfb\x17/߾\x7f\xf5\xe0͛\xf7o\xbf\x7f\xfa\xe6\xfd\xcb\xd7\xef\x7f{\xf9\xee\xfd/O\x9f={\xff\xed\xe3\xf7O\x9e\xbe~\xfc(\x01t\xf7\xbb\xc7[u\xfc\xb7\xeaNJ\xf6\xf5\xdf\xf6\x87_\x9fÝ\xee\xf5\x93\x87\xa3\xbb\a\xf7\x10p\xf9\xf5\x93\x87\a\xc7\xf7\x8e\xc2\n\xdd\xcc\xf8<_\x95*\xab輒\x8b\\).\xeb\xec\xc6|\x95\xdd⽅J\x9a\xec\xc2z6\xb9v\xa5u\x8aR\x9b\r\xb5Y\n\xf7A\x85X\xe8\xa3\b\x06\xb6{\xb9\xdf۷V\xcbw\xee\xec\x1f\xdc1v\xee\xd07\xa1\x1d\xe7\x8dn\x01\f.\xeafd\x95\x99r\xc5\xfc:\x13\x88\x95\xbf\x7fp\xe7s\x85\x8d\xf6\xefjg\x8a\x9f\xb3\x91\xc8c\xb46Z\xb0\x9b\xbc,\xab\xabG\x95\xaa\xb3\x9d\x11\xc5\x17\xa7M\xe8\x83\xde\xe8\xca\xc1+|\xf7\xacX\x14*\xdb\x1fR\xb8\xca\xd6\\e\xc9J\xcd\xf7\xee%\xf6\xfd\r\x17\xaa\x10\xbc\x84\x0f\xa6\xd5b\x91\xc3ÌO\xab\x19\x97\x99\x18\xe8':\xe3%\xe4\xc3e\x96\xf4\x13\xaae\x1cw\x8c\x8d\xccO+.\xaf\x8dU\xcdΈ\x16Bq\xb9\x94\\\xbd\xd0\n\x1a\x8f\x85*\xd0,kg\x04\xfd\x96/8\x980b\xadF\xfc@w%\xb6\x10\x8cl\xe8\xb2\xcc\v\xa1\xbb\x06\xbf\xd0\xdd\xf7bU\x96\xdf\xe7b\x868\x9c;\xa3\r\xdd\x02\x02lfh\xfau\xff\xffI\xc1\xfd\xc0\xf8\xebs\x9av\xda\xdfvYN8\x8d\x1bT\xb5\x01\xce\xfd\x86\x96[\x90(\xbb\xfc\x18k\f\xa4\xc5\"\xef\xf7=\x1f&\xa1\t8\xad\x9bpk\nA\x13\x92\xf1\r]5쓩\xd0<\x16#lcr\xe0F{\x124\xec\xf7A\n\x0e\x17@\x94~N\x93\x93\xafF\xa7\x90\x1d\xd8֧\xbf\x9f\x18_\x9c\xc8\xf5\xa8\x99\xd4\x1a\x04\xa0\xb6\x11Gj\a\x919\xd8:ԓ\xdc؛\x0f\xa9V'\xfaH\xb2\x9cNͽ{\xa5\xc9i9\b\x87\x05\x1cJ\x06\xc8S~\x06S0\xa5ݑ\x83\xc6̴\xe6\x9bSM\xa2\xad\xc8&\xb4~\bji\xb4\xa2Қ\x15\xb6\x8a\xa4\xdf_\xde7I\xc6X\x99\xe5.\x1b\xd1/\xaeQ}2:5\r\x1c\xa1w\xa1/\xa8 |\xe3t&\xea~\x7f\xeaﺶ\xb3lW\x81\xac\x92\xd0\xf6@zք\x98\xa8\fUMaS\xf1ޞ\xc6\xf97l8\xde۳Z\x1f\xb4f\xfc$?\xd5\xf0w\x80\xd6\x04\xf6\x03r\x10,\x0eR\xb0\x93S{\x0f\xaa\x88ѐaqGX\xf3\x06\xed\xf2\xcc\xf9\x977 /\xc9\tfl=(\x80[\x8c\xe44\n\n\xc8\xf3I\x1d\xf6\x1b\x18\xcf9 \x86t\x05\xebc\x1cU\x0f\x9c\x8a\xee0\xb6\x9a\xec\x14\xf5\x8b\xfcE\n\xd6/\xf5\x0e\x1a\xe5\x99]z\nL\x8fU\xbf?\xfd\x06\xd0䢏\xfb\xfd\xe9}\x98\xf0n\xbf\x9a\xa4\xd0Z\x02\xc63U\x16;F\x05+?`0\xb0\x8ad\x05\xbb\x19\xc26_\xb1\u008eW\xb5I\xa7f\x146\x9bϸ|iIN\x9c\xfb\x16﵍q\xb3\x05J\xeb_=\x0e\xeb\xf4\xb3lb\xbb\xb5\v\x1f\xe9HdB\xa8J+@\xdaL\x06I\xa0n\xb4cݘ\xd4`\xcda\xb6\xedF`QW{\xf7\xee\xdd9\xde\x1bE1\xdd%\xbf\xbd\xd0Lך\xab\x9e\xd1ĳ\x8av\xbcP\x17\\\xf6\xb0\f\xda\xf3\xb9Ҧ\xad\x9cQ\xf6\xb1\xfaP\xae\xc4Ia\x9f2\x17fM\x1f\xfd\xb1\x15|\xe7w\xb7b\x10\x9ck;AL\xeb\x88kk\xe9\xf1\xe6Z\x9e\xb4B\xb2\xa2\x19\x12\x9d\x93[\xf3\xd4\xf1\x93\xe8-+·\xf0\x80\xedPS\v\xa6s\xf8\x92\x15\xc1\x8b;\x95U\xeb<\xee\xaaX#ͤ\x15\x92\x15\xcd\x10s\xa8w\xe6\x061\x13\xf3\x9b\x15\xfaם\xfd\x9dzA&r➲\xc2>\x854B[\xdf\xc8E\x02\xc8DQk\xe5\xf24\b'\x93\xe0%+\xfc\xb3\xa16::\x18#\xd6\xeb\x9d\x11\xd3K\x12T\x19w\xcd\x03\xe6\xb0T\x17]4\x8a\x9e\x80\xad\x88\xed\x94KW\xe7mK<\xd9\x1e\x95\x15[\xa3\x9a\x94QGk\xe3\x14\x93f@V4\x02b\xcaj\x84\xdbC\x10\x14SZ]-\x8c\x0e\x96\xf85+\xa2\xd7.*\xad+\xc7v\xb2IW`Vt\x04n@\x98\xea1!4\x10^\x88d\xf6E\a\xa1\xb3\xe0d\xed9:\xe9\xc2\xc0X\x01\xa7f\xcaT{\xba\x84T\xd9\x7f\xfd>A\x13\x93\x8c\xd3%S\x8d\x81Ж8\x0eb\xa69L\x80\xb0\xa2IC\x15L\xfa%\xa1\x17\xc0x\x9c!ą\xdeN\x81#\xdd\\\xde\xc8/Ӗ\xc1s\xcb#\xdbݕ\x04a\xe4O䩧CWj~\x8fi\xf3\x00|\xfc\xdb\xe3\xfd\xbf\x1d?\xfc\xdb\xf1Ab\x92Nf\xcc\x1c2\x99I\xb1\x7f\xf4\xb7\xfd\x83\xd1p8<\xf8\xdb\xc1\xb76Y\xbf\x9f\xceXx\xf0@M%\x95̖O\xc6[\xea\x04\x1c\xd2\x1dƌ\xb4pA\xc1\xa4\x0fr\xa4\xd7\xec\xd2\xd7\xf3\x94%\x84\x9e\xb1=X\xd5ד \x82%$\xbb\xde\x1d\x8d1\xe6l\x92.\x98\xb2{OzI\x83}\x88&\x1f\xf8uB\xe89S]S\x0e&\x03\x88\xe0\xb28\aG\a\x9f\x91\xee\xcc\xc4`\x91_\x9f\xf1\xe7\xf92-]\xf23\xd0\x15V\xa4ӣ\xadϜ\xc79\xa2Nc\x02\x17\fB\xcf\xe1ְm[\x88Ox\xc6ج\xdfO\xcfY\x9d\x9e\x13B\x83\xae9\x81NC\xb4\xde\xf4\x9c\xe5\xe99\x99\x9c\x9c\x9ff\xe7\x84\x1a\xbaxE\x17d\xb2:Y\x9c\"T\xc4\xe2\xac\x10<\x85WzN2\f>\xb7\xf4\xd3j\x83\xca[8\x91\xbfhI\xd1yd\xa1=\x85\xd90\x1c_\x84#o\x06|\xc6\xe6'\x17\xa7t\xc1V\xe9\x8cN\x01\x88G\xd2\xf6*$\xe3%\xf44\x97\xe7<]\xd2\x05\xe8a[\xc5 \x04q\t\xcf\xe6e\x86\xcdY\xe6S\x95.\x11\xc9\x0en\U0009fec1\x1f\xdc=\xbck`\xed\xcce\xdc_\xf7i\xf1\xd9{y\xcdn\xced>\xfd\xc0U\xddœ\xe0\xbb@\xc0o챋?\t\x05\x96\xe8\x94\xd7Y\xbc\xc5\x04\x9f\xa0L\x16\xbe\x93|\xc9s\x95m\x01\xab/\x1bL\x81U\x87\xe9+\xec%tڲ,\x85K\f]6\b\xe1\xa9ausZ\"\xb0\x19@\xbf\x10\x18Sį\xf4\x1f\xab\xea雗\xba(z\xc1\xf2\x81a\xeb\xd0\x19\xbb\xc9g\xb3\x06\a bS|\x01\xdb!f.p\x01\xeb\x04x\x01\xfaIf\xd5@?\x99\x80\x9fa\xf5\xd4/Ey\r\x1fk\xa6Lv\xe1\xf9KY>p\xcf5\xcc8\xdb\xf9\xc0N\xe0\xb2@\x14\x16h_W'ϝ\xba\x05\xad?\x14K\xd88n\xe3C,\xe0x8oڎ崠5\x9d\xa2\x1b\x9cszI\xaf\xe9\x19\xbd\xa2\x8f\xe9G\xfa\xde\xdf\r\xdf\xd0\aL\xd1\x0f\xec=}Ȇ\xf4%@p:\xba?\xfd\xc0> \x8e\xea\x02o\xac/\xc7z\x05\xfdaB\xf5A\xf8\x10n\xc2\xee\x93?\xf0\x0e\xf3\ac\xeca@\xfd\xbf\xceŹ%\xff\x1f^O\xcbb\xda3\x8aLf\x0f\x1a\xbfd;Í\xa3\xcbm\xa9\xfd~\xfa\x90\r\xb7Y\t\\L\x1e\xb0\x8bT\xd2\a${\x10\xea\x1eA\xa7N\x1e\xb0\xeb\xf4\x01\xb13\x1f\xa4\\\xfd~\x99>\x80,\x1f\xb0\xca\xef\xa1\x0fh\xbaEc&\xce\x0f]\xd5p\xdc+\x8d\xf9\xce\x03l\xaa\xbd\xb6\xf7\xe6\xfd\xfe\xce\xe3\xc9<\x95tf&\x8a\xa4\x1f\xf5\xb6M\xcfH&\xc7\x0fX\x92l:\xacv\xd27\xec\x01\x00c7i\xad7\xebu\x9bzy\xe3m\x88\xe3\x84\r[\x9c7\xebu\xe5<\x01\xa4\x0f\xb4\xfe\xf8\\\x8f\xdfS\xf6x\"\xb3m5E\xe2&\xec\xb5\xc7~\xb2\xbc\xb0\xb8\xdb\xe62\xfd\x00T\x97\x13B߂X\xe9\x15\x1b\x8e_\xdd\x7f\xe17\xdcW\xe4\xed.C]\x8dW\xa8\bFA\xdf$\x9d\xa7/N^\x9d\xc6E\xebY@Ϝ\xac\xfe\xe4*}Jv\xd3\u008e\x19\x9c\xb5/\xbc\x9e\xf6\xa9V^\x01\x1d\xfd\xb7\xa7\x9b\xf0\x13\b\x822\x1el+ H.mr\xd7\x1ar\x8a\xaa\x19\xaf\xe9s\xc3yr3\xf2\x81Ӯn\xf5\x0fԐ\xbcf'7XH\xf6\xc0[\x7f<0\xa0 \x14\f\x03\xf1\xbc\xd7\xf9m\xbc\xe9N\x99^\xc0\xc7\x17\x18\x80\xa3\xf3(:\xc8\x1e\x90\xf1kv>y4\xa8+\xa9\xd2s\x92=rl\xabg,\xea\x1e[\xeeD\xe2֟I\xfa-\x1b\x8e\xbf\xbd\xff\xda\x0fȷz\xfc\x9f\xb0\xd7'ߞ\xd2Om\xff\xd5O\x02\xee\xc2\x13m\xf301\xbfك\x93'\xd8%;S\x8b\xfe\xcc>\xe9\xfc\xde1\xa8Ťc\x85\xe6\x93<}F\x9f\x90\xecY\xf6l7\xbd\x9c\x80\xb1\xf4\x93\f\x8e\x99'ȳ\x1ak\xbfъ>\xd4W\xfa?\x19\x88\xba\xff\xc4\xc0\x05}O\xe82}Ny\xfa\x89\xbe\xbbe3\xfb\x93x\x9b\xea\xe7\x9fa\xb6Њ\xf1\x88\xe7\xd9`\xb9\xcc\"\x96\x8b\x99@\x11\xcbŅu\xb2\\L\xec\x16\x00lq;\xcb%\xd4\xf6B\x1c\xc4YHs\xff\x1f\u038d\x91\xfe8n\xd4V\x9f\x82\xba\xa3\x8d\x92~x4R\x97\xa2\xbb\x82\x16\xceK'\xb2u\xb4F\x9a\xd0o\xd2\x15\xb21\xe0\xe1\xe1\xc9+\x01\xb5m6\x98\x17\xa5\xe2V\xb10\xed\xe4*\xe8$\xe8)\xc4<ñ\x97V̽\xd2&\x99\xd1ɶ\x89\x92L\x9a\x01٬\x11@?Ø\x9ameL\xfd\xbb\xb96\xb3fH@\x13\x05Us\x81\x93\x99\x7f\u0382pK<u\xd5BGM\xecC6\x8b\xa9\xaa-\f\x1f\x13\xe9>\x93\x99\xdf\xde[\xe4\xd8\xf6b}\xa2I;(\x9b\xb5\x82\xa8\x1ew'6\xccd@߉\x06\x19\xd7Y\xf1(ɤ\xf1\x9e\xcd\xe2\xf7\x80\xd6\xebj\x83\x8b\x9d\x04\xcf\xd9\xcc?S8$\xb6ԣ\x92j\xa2\x7f4\xceȿ\x99W2\xdb\xca+\xe9\xa8\xceʬ\xa7I\xc5\xd2!\xb5o$M\x12Z\x91\xacL]\b\xa2\xb8\xbb7\xdcg\xa6tn\xce\xe6\x96yAe\xb91\xac\xf2J\xa1SP,W\x9a\xd7\xf9Do!\x85\xe8Փ((\xc3mS\xd3\xe7h-6Q\x03\xf3:q\xe1Y\xa2/EI悰F\x17\fԴ\xa0F\x90\vR\x06\xaf\xab\x95\x98\xbd\x95\xc5\x123\xeb2UW\x838a\xf7\xde\xf7\x8f8\xd1?\x02\xb0\x02\x93#\xee\xc5\xf9Y\x8d\x16\xfd\xc6(\xddS'\x17\xfd\xber\xe2@\x97\xcdX\xaeש\x8cȌ\x8a\x10\xba\xc2\xd9\x012\x11\xf8M\xf5\xabW߾\x84\xb3\x99^\xb3\xe1\xf8\xfa\xbe\xf44ŵ>YϘ<\xb9>\x1d\xaf\xfcd\xec\xf7\xed`\x9c\x9c\x9d\xae\xd7\xcbtN\xcfSx\xa6g\xf4\x82.\xe8\xaac\xc6\xd0\xe0{\xba\xb2{\xc5\xca-z\xad\xf2f惩0]\x05;⪱\xa0V\xe6$p\x0f\xfa\xbb\xd6:_ٝ\x8f^\x12\x8d\xc3r\xc5\xe6\x9az[\x05\xcca\xfa\x98\xe1\xfd\x7f\xd5\xdc\xe4\x93\tP\xa4V\xb1b\xd5\xdcG\x81\xdb\x15sP\\\x92\xc9\xe3]\xd6\xc5\xe7\xea'\x99\x8f\xb1<\xb2>\xb8\xe3\xf5\xf4\xe5\xe3ݫ\f\x1d\x9b#\x03\xe1\xb3\xfa\x05\x9a\xb5\xf0\xaf\x88\xfcC5\"3\x1d\xc0\x02\x8dj[\xd2\xfd;G\xe3\xdd]E\x8c\xc5Y\xf2\xb7d7\x8d\xb5\xcc\x1b\xea\xe5!\xc6U\xea\xf5s\xf9&%\xb4I\xb6yE<\x9c\xc9_\xc2\n\x12`\xe4\xeb5\xb1ww\x05\xf1\xe4ȉ\x00֡<\x11\xa7\xf8\x1c`\x1f\x87:\x16\xb87\xbc\xadt\x01YM۰}\x9eo\x12\xae#E\x06\x92\xcfVS\x9e6\xac\x10m\x13\xc1\xd2A\x01M\xc27\x04\xb4\x1e\xa9\xe1\x83uf\xed%\xad\x1ab\xd90\x99\xb2n\xb3ޓ\x9b\xea\xec\x8f\xec\xa6\x02\xb1>(EeI\x95lN\xa9\x84\x81\xd2]\xa2\xc2.\xf1\xc2au\"Ni\xc1\xaaAu\xf6\xc7I\x85pS\xa7\xde\x17\x14\xb6\xab \xb4d\xc3q\xe91\x02wwK\xbd\xf2W\xac\x06\x9d\xee)\x03q\xe8\xb8u\xa5\x98:\x81\xfa\xb4\xdfG\x9e\xa9t\fC\xc4&4\xb0\xb7X\xf9BW\x1c@Q\xa5\x0e\x9e\x12'\x03o\xb6z\xec\fAFco*\x81p\xb8T2\x85\xadQ\xba5\x1a~_\x06\xf6\xe3\xa0\xd2O\xb5\xca~\xb0\x99U~\xa2ȓ\xea\xd4)\x98\xc3\v\x19GY2\xb1\xc1\xf3\x8d\xf2\x8d\x91Ue]\xde&\x04\v\xb5&vA[\xa2\xa7\xa5\xab\x8d\rA:\xec\b\x9f\xfeo\xd6C\xc1\xcd\xfe\xe6\xeb\xf3\x82\xae\x04\xaf\xa7\xf9\x92G\xdaں\xf0w\xaf\x9f>\xac\x16\xcbJp\xa1R\xd12gD\xddw.:\xaa\tj\x80\xdbl*zZ\v\xaef\xbc\x1b\x11iR\xb3\xad\xf0k\x96\x81\x95\xb5\x11\x7f\x11\x97\xdc)M\x11\xba\xad'tcӚ\x04=\xb2r]r\x88]\xd2\xc1\xbcI\xf4F\x9a\xec:E\x81\xc0\x99\xc1\xe8\x88\xec& F\xd8\x04^aJ`_hs\x83`~\x1b\xed\xed\xa9\xd1K0\xf67+2>\xbc\x03\n\xd6\xeb\xf5\xe1\x91\xfe=6\xef\xa3}\x130\xfd\x86\x1d\xdeCm\x82;w\xf1\xed\xe8\x0e\xbe\x1d\x0f\xf1\xed\xf8.\xbe\x8d\xf6\xf7\xd7\xeb\x9c1&\x06Fk\xac\xdfO\x0f\x87&oX)S2)w\xbdZĊd\xd3\xfb\xa3\xfd{\x10\b`\x9e\xd9\xf4\xfe\xfe\xf0м\x8e\x8e\xf7\xd7\xd3o\xbe9:\xdd-NF\xfb\xf7\xd6G\a}Lq\xe7\xce\xfe\xf1\x11\x16{\xe7\xee\xc1\xe1\xa1N\xbc\xbf\x7f\b\x89G\xfb65|\xd9?:\x88?NW\xc0ڛ\xb2\xa3;w\x0e\x8ev\xd3t4\xdc?\xe8O\xc9\xfd\xfb\xa3\xe1\x1a\x9f\x1b\x1dC\xa8\xce\xfcp\x88\x99\xdf\v2\x1f\xed\a\xb9w\x15F<\x9a(u\x0e,\xbbL \xb7\x18\xce\xf6\xfb;;)\x0f\r\xfb\xfa\xfd\xe8\xd51ö\x85\xa3\x03\nje\xc2\x1de\xb7 \xc9:l&[\xd3\x7fC-\xa7\xb1\xb1\xc3[\x87 \xc1\x01\xe7\xf6iwt\x89]6\"\xd2\x1a\xd8\xe0y\x15\x1cX\xde\\\x03\x8a\x01QE\xd6D\x0f3\x8aS\x1eN\xaf\x8bn\x96\xa66p\x03\xb7@0\x1e\xfaFu\xf4\xb75\x0f8QT\x9e\x8eS\x84艎f\x10\xaf7՛\xc0+\xdd\x16\xfd(}цs\x91\xed\f\xddTP\x9bϗ\xefNHi]}Y}\x8f^\xae\xcd^s퍻`u\xaa\xa8 \x84\xeap\b\x9e\xa4\xb2\x03\x0f\xd8b\xe4T֮>\xb7p\x03L\xa1rT\x87\xb1m\xd1\xefˎ`9\x81/\x18O\v\x1c\x8c\xccu\xef\x06\xf9\x7f\x18\t\x1e\xa7\xa8\"Yx\xdc\xca\x0e2Byl\x1e\t\xd50\xad\fji\v\x83\x1fZ`y\x10P\x80{,Z\x80\x85\x05=ڿs\xefn[\xd36P\x88\xee\xa0l\xb6\x11\x8a\x81\xcdh'\xd3͠\xd7I@\xae\x03\x91\x8a`b\xbdNXb\x00\xe55\x98]\xfbxX\xaf\xbb\x8e\xa2\xdc\f/\x1e\xa2cά\"\xa3\x19\xf7\x9a\x8d\xf8\xc1\x18P~\x9blt\xe0\xf5\x7f\xfc\x91\x83zWZ3\xf7fa\xdc\xdcZ\xabA\xf7\xaf\xfc\xa6F\x97\u05f5?\x1c\xf4\xb9P\x06\a\x02]\"\x17r\xc6\xf8\xc9\xea4t#\xfe\xb7\xfdaB\xe8\x82\xcd\x1c\x85#\xc8x\xf1\r\x1bN\xd2)\x9b\xa1{\x13%\xd3!]\x80\x7f4\xf7\xbe\xd8\x1d\x11\x92A\n\x00\xe5\x05E>\xd6q\xa6\xa3D\xb3#|I\xa8Js:'\x93\x88hO\xf3\x93\xf9)\x99\xc0_=\xe7.H\x06/\xec\x04\xfeҋS\xfdva\xd7Z\x8e\xb7\b\xf0\xb8\xbdE\r;\u0096\xb8*ДǁlN\xf3\x9aہ\xcc\x1c퀡\xf6\xe6낛8f\x98\xca\f\x9aMT\xd4O\nQ(\x0eNp8\\\xac\xac\xfe\xb6sJ\xb0\x19\xdf:\xe5\xac\xf1Rc\xe6Y\xd1\r\x90 \xdc\xf9\xf9j-[>\t\x17#o\u0092WV9\x96\x8b\xd6p(\xb8J\xef\n\xbb:\xe31\x01\vP2\x81\xbf[\x81\xce\xf3\xdd\xceL9\xea\x01\xeb\v\xa9$ٶT\x90\x7f\x94\xb0\x9al\xaf\xe3̝֒\xf2\xee\xd1ݭ&*V\xa7@k\x99\xd4\x1c\xdd\xd3ݹw\x97Pe.\xd7F\xe9\x01\xd5\xd2Q\xc4}p4\x02ہ\xbbV\x13\xbe\xf3\x9e\xfaY\xcc~\xbfUa\xaf\xddN\aw\x91\xde\x1d\xa41 ~mb\x8c\xff ߎ^\xe2\xdb2\t\xdb\x1c\x1f\xf7\n\xfd\xff\xeaF\n\x10f\x00\x13\v\xb6\x98\xbcW\x88\xd6\x1e\xa8\xd0\x0f]2I\xe0\x8e\b\xfa*v\xab͵ /\x15\xa8\xa7K\xac$F\xf4\xfbF\xe1U\x00\x9a\xaf\xc0m$g\x15hW\vV\xa5\xc2\t-s\xc7#\x13dZ\tU\x88\x15\x1f\x17z\x8b\xc8Q\x1c&\xdc\xf9kMo'j\xb70\x92\xac~\x82\xb3\x83\xdaa）\x82eFz\xf2_l\xf2\xff\xf4Ow\t\x9b\xa4'\xff\xd5?\xfdO\xd0\x06\a\x0f>cŤ֫\xe6dlW\x92H\x01\x83\r]a\xa4\xead\xff\x94\x8c[\xf5-\xd6\xeb\x1c\x1d\xfe\xac\xd7i\x85\xe7\x1a\xf1ڶ\xe0\v\xee0r(\x8b$\x9e\xf6\x9b8<:\xbek\xfc\a\x1e\x1f\xdeA\xf7\x96\x02\xbd\xf0\xd4\xc1&\x82N9z\xfc& \r\x1d\xa1\x86\xc6w\xefk\xae\x1e\xe9m\b\xf6\x04\x1a\x89\x885IH0\xa1V\xd1\x02\xde\x06\xe3\xc1\x8b6\xe1[\xacJU\x94\x85\x808\xf7L᭮Vr\xca\x1dݵ\xd5QJ`\x9f\x15xT\x95\xfc\x9c\x7f\\\x023\xd0|G\xc6\xcd\xca(\xb8\x7f\xef0\xa6\xbc\xc2N\x91\x90f\xb5:R-\x12\xb2\xc1T\xaa\xfa\xc0E\xad]\xf8F\xdda\xed4\x17\xf9G\xa6g$\"%L\xf0\xaf\xc1m\xe5\x11`\xfa\xc7I\xe3=\x1b\r\x87\xba.f\xafG\x9d\x01\xa6\xa2\xd7I\xfc\x9a\xb5\xd2\x0f\xa6e%x\nې\xcc\xc5\xec\xa9\xc0\x95\x04\xa9\xcc+s\x11ds\xce\x03\xab<=\xc6\x10\x144\x95\x9e\x9c\x92\r\x06\x06\"DA+\x90E\x8e\xcd)\xc8q*\xe93\xb0\x97\x0f^\xbf|\xf963\xcf߽~\xf9\xeeU\x86\x90\x15\xf3\n\xa8a>\xfb\xf6z\xbd\xe6\x03Q\xa9'.\xc0\xf3\xaaa\x05\xc1\x9e\xb5\xe0\x8b3/vDb\xe8\\V\xab\xa56܇\xb3+|g\x86\xaaD\xfe\xd7\xde\bV|\x92Ђ\ri\xcdRɸ\xf5\xc92\xd1M\x84\xe6\xbf\xe1%\xfa\x18\xb1Q$\xe3\xda\x1d\x8b\xf3W_ܯ\xc7\x05@\x18\xec2\xdf3\xf2\xa48\xa5\x1e\x0e4\xacj\xaaN\xa2J\x9d2\xd8sƦ#^\xbd|\xf3\xf4\xedӗ/\xdc\xf9m#\xde<~\x9bi:L\x97\xc2?.s1\v\xdc\x12Z\xcb\xd9I\x97\xa5L\xabA%\x1e^6\xf3\u05cf_=~\xab˅\x9e\xadX8\x11R>X\x14\x82\xe2\xdc3\xaa\x91\x18\xb2k'2\xfa\xdc\xfb\xe8{s\\ܯ\xda]b\xa0\xee\x83^\x11\xbe\xf8'\x8f_?~\xf1\xf0q杘\xe8\xd4{\xa3S<\fL\u0087\xdf?x\x9diR\xb3\xb1f\xfb}\xdfD\xc01J\x89\x19DU\xbd\x04\xd9,\xa4\xb1U \x99y\x18\xdfb[\xb4\"\x9bM\xfcq\xa0\xfe\x95\x1e߽\x0f\xa4\x11G\xde\xc4d\xef`?;\xbacC\x8e\x87\x93\x83\xfdlH6Ae\xec\xa5<\xea\xd7!\x1d\x91M8([\xb4j\xaa\x89\xb1NJ\x1b\x9f{\xf3\x14B2~\xb2=\xf6t\xe3\xe7\x8b\x06\x86\x81\x95\x88\f\x15\x85֥б\x8e\xad\xc6\xc1\xa1\x93\xed\xabqW\xf2\xd7\x0f^|\xf7\xb8\x99\x1e\xba\x8f½\x9e\x8coJ\xae\x8c9y\x85k\x15\u07b5\x82)\x1f\xd4\\\x85\xc6\xe1\x98V4f5$:\x91\xc6\t\v\x88\x10\xe0`n\f9\xb1\x19\x03T%\xbfo\xf1F\xc6\xdcf*\x990\xfd\xc6\xe1Pk\xcf\aIƠ\xdfZ\xa1\xd8k6\x03\x87b\x1b\xb7bE\xa5&[wM\xb8\x91(\x99#\"\xd3\xf6\xbdU\xab\x89\xd6\x1a\xb8i\xb3q\xeb)R%\xf4\x8e#R|\x84T\xd5\"%\xff\x99\x8ev\xd5\x1e\x90\xb3\xe7\\\xf5\xc2\xec\x9b[\xb1\xd4\xfb\xbf\x7f^\xaf\xf5\x98\x1c\xec\xd3\xd1\xfe\x11\xd9\xd4\xcd\f\xac\x0f*\xf3)\xdf\xd4*WŴ\ae\xf3\x8f\xcb\xd43e\xb7\x01\x92j\xba\v5ִe\x00|\x128\xe9Tz)\xf2\x8f\xcbI*0\x1d\xf2Y\b\xf5\x11L\x90,\x15AJ2h\x1c\x95\xe8\xf8Q\xa4\xc4V\xaf^\x9d\xe72%7-_\x1e\xe7\\t\x98\x8f\xf3\x81k\xcfEQí\x1e\xcc\xc9\xf7\xef\xed\x7fV\xe1\x14\xa0O`\xca ?\x8f\xe6\xecp\xff\xf8\xf0\xf8\xe8\xee\xfe\xf1\x1ds\xb7F\x9f\xd5\xc7Gd\xa09bh\xbcw>\x98\xca륪\xd6kx^\xd4\x0f\xf1m\f\xe0\x14\xa0\xa4\xf7\x1a\xc7UK\xd2&\xdbTo`\xb1}\x93o\xd1\a\x94\xfc\xcf\x15\xaf\x81\x8aQU\xd5[\xe4⺧'K\xef\xecZ\xa1Q\xb7\xbe\x18h\x9b\x97\xe9;Q\xe7sn\xe0+\xf87Cď\xfa\xa6\"\x9e\x97\vb\n>.wYEZUL\xa5a\x05\x97\xb4ܭ\x88fs\xf5:\x92m\x83\xa7Q\x9eMo\x11'\x82{\x1c\xb9Q\x1a\xaeA\xbbqw\x9c\xba\xacەW\x93\x9e{ç+\xc9m\xf3\xf5\x9d\xb8w\xce\x05\x97\xb9\x06\x84鴴/\xea\x9e\x01\x7f\x18\xfc.\xc0\xbc\xfeᅬ\xc0gޓB\xf2y\xf5\x11\x88§\xb0d\x05W\xbd\xc7\x1f\x97e%\xb9\xec\x8dF\t\xfa\xdb=<\x18n\xf5\xf6\x1aߵl\xc3\xff[\xeeZ\xba\xf4\xd3uJ\xbe\xe9\xd46\xeeD+\xb5\xc5\xf1\x06\x18\x9d\v\xdfa-\xc9\xc4\xc4\xd6-s\x05R\xa4d͍S\xab3\xd9{f\xaah\xf2\xfe=\xaf\x9fW\xb3U\xc9\x13j\x94\xfb\xd0\xff\x9e\x1a<\xac\x96\xd7o\xab\x87e\xb1<\xabr93\x9c\x84\xb1\x16\xa7\xd5):*?>\x04^#\xbe\xed\x0f\x8f\x0e\x87\x04n7'ƭgR\t\xc8\x03\x1e4\x05\x96\xd0\xc4z\xc8KNǱc=\xdf\x15|\xe0\xeb4\xe1\x993k\xe7\x9b\xd0o^`[\x18\xf31`N\xdf\xe6\x8aǊ\xacnK\x03\xd9(\x84d\x17F\x0e\x9fv9\xeb\xff\x9c\x83+\xa8\xe3\x80;w6\xa8W\xab\x19\xddF\x15\x1cM'\xdd\x02r\xad[\xc52\xcf\xd1X\xb5\xc1Y\x00\xd2ش__=\\\x8a\x13u:\t_\xc0\x16G\xfdm\x7fbYҩ\x04\x00\xca.'q\x8a\xdc\\\xebU\x82\xaa\xe9\x84d\x9fia=\xe9\x9aW\x05\xafSN?\xf7)H*\xb3\xa0N[*\xd4=q\xa1\x92\x9f\xeb}i a\x1d]\xe0;x\xea\xb6\xecزɘ\xef\xe2\xf5\xa7\xbd\xbdߒ\xf6fC\xf3\xe6<\x84\x01Ԣ\x8e܋:v\x89d9\x88\xa2\xfd\xd5S\x02\x165\xde\xf7%\x88\xeb\xa5\x17\xd7W\x1b,\xfb\xcbft\xfe\xd9\x19\xfd\x17+\xf4E.\x9a\x1c\x82\x04*\x11\xba\x16x\xa6E\x809e\xfbq'\x8dh\xe4m:\x91\x0f\xb5SI(\xa0\x97\xf74\xeb\"\xaf\x03u\xd2$B\xa3j(R\x00\xc1\x1a\x13\xabz\xd9\x03m:\x16\xc1\xaad\xe1\vX8\x06\xaeG1~ghU\xab\v\xd1\xd3R\xa0+Y(\x13G\xe8\xb6\x19*`&\xa0mt\x13\xee\xc9b\xde\xda\x11\xab#WU!\xbae\x90:\xf0E\xc5\x14\b\xe4/\x1aH\x963\x0f\x06\x15\x9c\xbf1\xb6}Ӈ\xd6z\xbdc\x9e\xfc!\x13\xe1\xe2\xb7b\a\xf5E\xbe\x88\x92t\x1c]\xafd\xf5\xf1\xda#\xe3\a\x8cƭ\xce\xea\xf4Dj\x15\x97\x9a\x0f\xe8\xc9)\rI\x10\xdcKC\x18\xdd\xd0\x0f帩L\xe1\xb8\x1a\xecҜ\x10\xe6\xf0\xa8إ\xa60\xc33v,Y\xbb\x1e\x82\xba=\x95VF\xb4\x05\xb7\x14\xbd\x91C\x1e>\x81\xab\xc0BG\xc8p\x0e,<\xc7\r4\xa6\x1c[\x9e\tt|\xdfI\x8eE\xa2M\xa7\xbd\xa1\xb6\x19\xbd\xcb\xe2\x92\xcfzA\x8b\xea\xde\"\xbf\xeeU\xa2\xbc\xeeم\x89\xc5n\xf1\xcez\x1eA\xa0\x9e\x9b\x9b\xa7gԄD.\x9fs\xc9\xc5\xd4\x16\x8e\x94\xdaE^\x8b\xff0\x90\x7f\x85(\x14\xe8\xaa\xd5|\xd6\xdb\x03\xaa\x8e˔D)\xb4\xcb\xd8$АrE_\x06\x94\xc1e\xf7j\x99t\xba{\xcbnw\xe6\xb6^w~\x05ͦ\x97Q㯭\x9e\x8b\x1d\x01\x04\x9c\x9cl?\x95\f\r%\xa9\xdfT\xc0\xbe*\xdcQ\xe0\xdd\xee Hl\x01\x03\xe0\x94I\xca7Z\xcb0\xac\xfcN\xeb\x18\xea\x02\x04v\xfaG[\xe6\xc4\x1b\xe8\xf7\x1e\xff\xb8\x94\xbc\xae\x9d\xfa\xbb\xd1}?\xe3\xceUo\xb8\xb9\x8e\x03\xe6%\x8b\xb5д\x96\x9a\x8d\xa4!?93\x1d\xc0\xc3&6\x9b\xbf\xd9l\xdf6\x93\x00E\xf9\xc6\xe71\x02\x9a\xb4\xdf7\x9b\x1e\xe2p\x8e\x1d\xba%\xad\xd9,\r\x9d\xf3\x95f\xc9\xf3\xb1\xbeAҒ\x04\xa8\x84Mb\x8a\xca\x00>\x0f\xee\xaeZ\x91L\x9f\x8e\xa0D\xe7\xc9)\xe1d\xd2\xd7\xe9y\xcaAo%/K\xb3\v\xd4\x14\x199\x81\xb8\x1e\x14\x7f*\xf1\xb0,\xa6\x1f\x12\x1a\xd15\x16\xc4\x17\x0e՚\n&\a@4ӂɁ&\x9b\xf1b\xea\xfc˗\x10\xae\xa9h\xbab\x95\xe5Y\f\x1e\x9a\x04\x03X\xdciM\xe8\x14\xb4\x8d\x9d\x95\x02I\x054\xbd\xe8\xf7\x8bT\xd0)\xa1\xab~\x7f\xa5\xcb\xec\xbcs\x98\xb8\x81\xa9s\xbf\xdf\b\x00f\b\x01u0\xbb\x1cXIS\xc9Nn>\xf0kP\x1d\x163.\xad?\xff\xc6\xeek\xd8\x1c\xba\xbd\x8a\xa5\\7\x98\xdb\xe6:>-\xf5\u07b7\t\x95\fh\xb5\x02\xc6d[\xa3=Wҧ@.\x8e\xf1В\n\xbaJW\xe0\xc1\x18l\x1b6\xf4ƴE3\x7f\xcc\vЉ\x1b@[\x05\xa6U\xa8\xb3A\x05\x86Q\xb1mƪ\xdbfl\xb9I}\xa5^\xad$w\xf25дkް\xce\xe8uzF\x13\x93\x1eJ\xa8\x13\xacn\xb5\xbc6\xb6\t\xd4t\x92y\xdd\x10\xb8\xdd\u07bb\xf3Y(,\xbc\x02\x93f\x89cѪ\x83\b\x80\xf5Ć\xde98<\x1c\xfd߫\xf3_\xbc:?\xe2g\xd5JL\xf9S\xb1\\\xa9\xcf]\x9c\x8fG\xfb\xc7G\xe6\xe2l\xb0\xf2\xf4\xdd\xf9\x02\x18H\x89\xb3\xebK\x16\x85\xb0\xde\xfd\x92\x99)\xc1\xc0\x94&4\x99Wr\xca_T\xaa\x98_\x7f{\xfdX(.\xe3\xc0\x97\xe2\xdbr%1\xe7\x1f\xf9\xf5\xa3\xeaJ\xe0\xb3\t,\xa0\xa6\xe0R\xe1\xdfr/\xff\xbf\x17\xab\xff\xc1\x8b\xd5\xea\xffӼ\x90\xe9\xff\x12^\xc8\xeaKx!\x97\xff\xb3\xbc\x90\xd5\xff\x1e^\xc8\xf2\xff/n\xce\xf3\xe8.<\xff\xefݜ獛\xf3\xc5\xff\xbd9\x7f\xfe\xe6|\u07bc9\x9f\xff\xaf\xbc9\xcf:nγ\xff\xf3o\u038b\xe8\xf2\xb8\xf8\xdfws>\x0f\xce\xee\xf3\xff\xa1\x9bs\xcc6\xb8\xfc\x9f\xbf9_\xffߛ\xf3\xf6\x9b\xb3\xd9\xf4\xd2U\xf3\xe6\f0\xa1\xe3X\xf6\x80\xd1\xe3v\a\xfe\x1b9\xb7z}\xaf\b\xbdL\x17\xa92\xf7h\x1d\xc8\t\t\xe9\xdfH\x83\x94\x0f\x96\\\xd6E\xadR+2U\x83Z\xe5ʪ\xa8\b\xa6̍\xd5Q\xcbc\x9c\xf8o Qj\xbbx\xa0ryΕ\xfeh\x13\xed\x8f\xc6\\,\xccul\x9d\xe4\x7f\x03\x18\xa3\x03\x81\xf44\x98\xfdX\xab\xaao*\xe7$\xc1EO\xd3)\xdc9\xb9\xbes\xea\xf22\x1bh\xca\xd7q\xbaNI\xb2\xc1\x13\x1a\xff\xeb^!\x11\xb1\x1e\xf5C\xa2)|T\x9b\xfa\xc0\xaf\xa1܀\xd6O\xb9\xef\x1d{\x7f7\xf9\x8c\xb5j\x95\xebE*S\xde,R\xdf\t\xa2\xf2>\x9b=|\xf3\xf9\xbc\xf5*\xc0\\\n\xde,\x03\x84\xdc\xf7\x87\xc4\xf6`\x87Z\x00*\xa0:X\x11\xb3\xa3\xda\xe4j0\xabt\xfd<ΈlpC\x1am*jsS\x03\xc0\xfd\x9d\x11\xf5Y\xc0VF`\xa5\xb4k\xd3\xf1\xe5\x10[\n\x1a\xa3\xf3rU_t\xd4\\\xea\x98\x14\x13Ma\xfd\x94a\xaavU\xa4I\x95\x82\xf2CЃ\xb6\x82Ik\xd2\xf2`4\xf4\xda\x19ss\xc8\x1aށ?f\xc3\x1c\x83\x81m\fH<ƍ+\xa7\xd6\xef\t\xab\r\xceH%8\x88\xbe\xb1M\xec\xf7\xed\x93Y\xae\xa2\xb1\\\xab\x8e\xe5*\xdcb\xab&рd\xc1\xdb_]]B/.hwװc\x9d\x98I\x1bh$b\x80\xd3\xce5\xef\x80\xf3c\x1e7\xe3\xdb\xfb\xc8\x03\xadG\x13\x1f\xae\x1d\xca\xf3\xd0V\x01\x0fmj\x19D\x8f\x8aٻ\xe5,W\xbc\xc5O3x.Z\xa7*h\x8a\xa5a\x03>\x1b\xd4\xcco\x8c\x8d\xdaъ\xf1VXn[I\v\x9dQ\xb8\x0fz\xc3\xd1~?\xd7?\x85\xfe\xd1I\x1b۬\xdc\x10*\x8c\x96\x16\xc47:A\xa0\xb5N\xa3ٿ\x14e\xf9N,\xaa\x95P\x1d\x8cD\xcc\x06WR\xbf\xef\x9fS\xe2\xf2\xb9\x9d\x05\t\x04I\xd8=\x82Ɂa\xb9М\xa5ҭ\x1c*M'H?1\xa9lu\x96\x1c\xb4\xb9.\x04\x19\xb7-\xc6\v]1\xe9waD\xfcӛ&\x9d\xa3\xb1\xb0f\xbf\xd0\vV\xa6\x12\xf8\x9d\xb3v\xefs\x96On\\\x16\x96}i^7\xd9*\x8c\\m\xc0H\\\xb1zr\xa3\x8b\xb1\xc9\xe1y\x93-]\xf0rcA\xab\x17l>\xb9\x91|\x9e\xcd1\xa8\xcdS\xc5\xd1\xf3L\xd5i:\xb5\xcb\xf0\xc21V/\xbcʲ}3c1\xc3ժ\b]XV벃պ\xfc\x97Y\xad\xab[Y\xad1G\xee\x9a^\xa6\xd7-F\xabuY\xa1\x99a\t\x85b2\xa3\xcb\xe2{\xd6\xf2au\xf7\x99\xb7p\xe3\xa0n\xc6dCژ1\xa8\x00ޞ3\xd9N\x14\xaag\f\x04\xday\x11\xb2{\xef~\x96ًL[\x12\xb7y,\x1a}\xd0`\xf4\x1e\x1d\x1e\x1e\xde\xfb\\ƚq\x89V\x06\xfbw\x0fG\xf74\x9c\xe3\xd1\xc1\xbd\xc3!\x19\xc7.\xbd<\xcb&\x01\x9f&u\xf6\xf5ג\xe7S\xf5G=\xa8\xe4\xf9׳jZ\x7fͥ\xac\xe4\x9e\x01\xeb\x1c\\\xa8E9)\xac7\x13\x96\xecrz\x9bc\xa2]\x96\xf4sy^\x03\x1eg\x97\x95O\xe8\xb2\xc8\xf9\xd1~^\b\xd8{f\xe0te\xaazX\x81\xde\xff\x83\xce\xfeƽˢ.T\x0f\xd1!{\xf3J\xa2\x7f\x979\x90\xf7\v^\xd7\xf99\xc7;_\xcd1\\Tboa3\x9b\xf1\xcb\x1e\x17\x97\x85\xac\x04\x94\x88\x1f㇘\x7f\xdd\xcbŬ\x97\xcff\x05\xf4N^\xf6.x\xb9\x9c\xaf\xcaޕ\xf6XR\x0f\x101oG\x18bZ\x13\xd1E\xba\xbf\x7f\x97X\xbb? \xb0\xdfpE\xcb\xc8{\x9b\xe1\xfcim\x16:M\xf9n\xf20_\xaa\x95l\xb8Y\x9cz\x16Oy\xc2O\x99\xa2Z\xefV\x85z\xb75\xeaѪ\x13~\xaa\xb1A\x96l\xa7\x93\xffqU\x88Yu\xb5^\xbbSR\a\ff\xd5\x14\xfb{{L\xbc\x83\x80\t\xe0\xd7\xffu\x92=\xd8\xfb\xfb\xfb|\xef\xd3\xef\xab\xe1\xf0\xe1p\x0f~\x1e\x1d\xe1\xdf{\xf8\xf2\x04_\x9e\xe0\xcb\xfe\x93'\xbf\xaf\x86\aw1\xd9\xc1\xddG\xf8\xf7\xc9\xde\xef\xab\xd1\x13\x88\xd9\x1f\x0e\x1f\xee\xe1\xcf#\xf8\x8b\xc9\xf6G\xf7 \xe6\xe1\x10_\x9e<~\xf2\xfb\xea`8\x1c\xed\xfd\xbezt\x17\xbeyr\x8c1O\x1e=\x84\x97GO\xf0\xe5ɓG\xa7\xff\xa7V\xec\xf7\xbd\xc1p\xef\x18\x8a\xfe\xf6.\x143\xd4e\x1ea1\aO\xb0\x98\xc3\xe1\xe9\x7f~\xf55\xbd\xf8,\bʌ\xddh\\\xcdq\xc88И\xfe\xd6u\x92v\xca5\x9d\xf2\xa5\xaa\rK\xa9f\xfb\x8c\x81\xadׁ\xfe9\x84\x1f\xe3\xbdK)Y\x9c\xad\x14z\xf7g\xa2#\xb0^\xe6S\xce*k\x92S\xabw\xb5\xdbᙤ\xeet\x86w\xcc\xc4\x18\x15\xe1eڔR\xe7\xa2P\xc5'\xfe\xee\xf53\x96\xeb \xed\xa4\xeb\xf1b\xa9\xae\xb5M\x00+6\x1a\xd1\xe7f3vj\x87\xbd\x19\x9cH\xb2Z\xd5\xe5\xf5\x1b\xae\x9e\n\xc1\xe5\xf7o\x9f?\xb3:֨\x19k_\x1e\x82\x9f!>\xeb\x15.\x15(\xa5J^\xd7\x0f+\xa1\xb8P\x8fg\xfa\x002\xae\x87\\\xf4\xf7\xd73\xad\xcc\xea\"\xd4u\xc9\x13땤\x97t\xf1w\x81ކ\xc5\tK\xfd\x1c\x1d\xb1\xee\x8c(G\x936x\xda\x19!\xd1~r\x92\xe8\xb1x\xa8\x91u\x12j\xde\xf7\f\xd4NrJO\x12\xbcrC\xe7%T?c(l\xb0O*#\x162!j\xf9\xf8\xcfUq\x99P|\xde\xe3\xf8rz\xba\xa5~\xb8\x9f\xf3\x93\xe1\xe9\xf8\xf2D٪*:ª\x9e\x8cN[\xb5M\xa6qO\x81\xe4J\xe6\xe7\xe7\xe6\xb9^\xf2\xb2\xc4nvb\xae\xd3/\xe8\x9a},o\xa0\xaagՕ\x05\xd8i\x17\x9d\xafT\xf5\x9a_rYCQ\xfc\xa3\xe2R\xe4\xe5k\xaemМ\x17,\xec\x8e\xe9\xaa6U\x82\x01\xe4\x12ܽ-/\xf2\xbfP\x9bf\xf9\t\"\x03<Y\x95囩\xe4\\\xf4\xf2\xfaZL{P\xa9'P\x1c>\xbd*\xf3\xeb\x1et\x91\xac\xca\xdaN;\xf8\xe5\xb27+\xb0J3\xfb\xf0\xaa\x98\xc2\xce\xfeT\x98\a\x1b\xfe\x9a/*\xc5!\xa7\xb3|\xfa\x01\x11\x04_T?k\x17f\xbcwQ\xccf\\\xf4ʪZ\xf6D\xa5\x85w=\xe1\xe3\xab%\x17\xbde\x99_\xd7OEY\bޓ<\x9f\xbd\xd4\xecN\xdd?=\xa9\xfbp֫\xa7\xd5\x12~x\xbe(y]\xf7\n\xc5\x17o \xec/\xce\xec\x83/\x1a\xbe\xa9^} \xf7\\\x95\xaaX\xe2\xe8,V\n\x83j\xb4\xba\xe1\xb3/\x1a\xa0\x03\n\x00\xc7\x1d%س2\x99UW\xa2\xac\xf2/\xcb\xee\xb0{a&Ӫ\x04\rg\xd0R\x87\x1a\x16\x9f\xf4\f\xcf\xc5\x17\xe5z\xb4%W\x89\b\xdc \xab\xadU.\xd5\x17\xe5u\xe7\xf3\x1d<\xd6\xfcүO~\xdf\xcbNӓ|\xef\x13x2\xf2\xc7\xc0Y\xc8\xfe\x05\xc7>\x11\x9a\x95'-\xae\xbc#\x1e\xa3O\xd78c\x00\xea\x1a6\v4\x90\x1c[\xd8\xd1j\x02\xf4A\x85\x9bz\xb6\x03\xa2\xa1}G\x8d o\x1f8[\xead\b&d/\xed3\xe2\xe9\v\xfd\x86\xc6e/\xec3!`\x11\xdc\xe9\xe4I_\xdd\xd5z\xbd5V_`5\xe29\xd4ǋhb\b\x00e \x00lF\tZ@:\xad\x01'\xb3\xe9B\x04\xc0&\xda\xc2&;\xb2y\xa0f\xc9,W\xf9\x1e@\x99\xa6\x9c5\x86Ρ\xe5\xdf\x01\x04\x91\x04\xe8cL\xc9I\x032\x00e8\xb6y^\x88\x04\xed\x8c\xe4N\xb6\"\xc44P\x86֝\a63\x85-\xe9\x1d\xba̡\xb3u\xd8\x1d\x8fa\x00\xf6؊\xe8\xe0\xa3f\xf0z=\xfa\xc6\xf29vF\x9bTC1\t\ry\b\xb5 Tx<\xc3\x0e叝\x9d\v\xcd\x18^P\x0e\xd8*\xe6mF\x01\f'\x9d\x0f\x14\xaf\x15\xe0',`\xfa\xef\f\xb3t\xa6\x1f`\x96\x03\x8f\x99\x98n\x87\xc1\x9dpC$<\xb0\xe4\bX\x80\xa1\xbdZ\x10B\x13p\x9aM\xb2\xaaI\x9b\x00\xa4AD\x98\x9c2\x97\xf3\xc1\x8e\x9d\xcc\xfd~\x92d2K\x15\xabb\xb2\x87\x8af\b\x12B\xf4\xf6ڥ\x92\x01\x8d\x95V&{\xa2\x89\xad\xaa\xdf\xd7\x10\xfd\b9\x9d\xecJ*&qC^\xbcI\x05^\xe5\xda\r\x94\x84\x10\xb2A\xb2A\xa8\xbd\v^\x9c_\xa8^^\x16\xe7xy\xd9\x03g\xe2x\x12\xe42?+\xa6{p\xa0\xf4l\xe0^}Q́\xb3\xbf\xb4\x1fN\xcbb\xb9\xb7\xccՅ~\x92p\xbeL\xab\xb2\x92{\xdaтqI\xd9\x15\xb6\xa7\xd5\tj\x13\xb7\x94ռp_kF\x0e\x10O\xb3jQ\x88<\xac\x19\x17p\xf0\xed\xc1y\aָb֛\x17e\xb9W-\xf3i\xa1\xae\xf5\vVd^V\xd5l\x0f34\xcf.M%\xd4\xde<_\x14\xa5y\x86\xfd\xda?\xed\xe5\xb3?@Σ\x03\x94\xe4jza_\xaeK\x93\xd0\xfa\xddė+\xdd\x1d\xe7\xe5\xf5\xf2bO\xe4\vn\x1e+Yp\xa1t{/*Y|\xaa\x84\xcaˎ\xc8K.U1\x85\xeb!\xa4\xda\xcbg\x97{\x1f\xcds%\x01\xf8|\xefc\xafX\xe4\xe7<蚒+\xc5\xe5\x1e\xcc$|\x85*\x14\xe2ܴx\x91\xcb\x0f\\\xeeq1\xb3\x8f\x8b\xc2=\xe2iҫ.\xb9\xc4q]V5^O}\x88\xba(\xa6\x1f\x04\x9c\xf3˼\x10j\xaf\x923.{\xcb\\T5\xdf\x1b\xf5\x96\x15\x8e\xe5\x1e\xbf\xe4B\xd5=W'\x1cb\xa1z\xe8\xb43\xa8j\xad\xaa\xa5\xa9\x17>ځ\xa8\x95,>p\xb8\xf9\xae\xce/|5\xe2`_\x97Z\xc9\xea\x03ߛ\xe5\xf5\x05\xc2\x17\x86\x01\xd5|^seC\xa0\x11\xd3|\x19\xbe\x02\xbc\x83}G\xacK\x84\xbc\xb4!A\x8d\xe0\xf5\xaa\x98\xa9\x8b\x1ep\x7f\xf6r1\xbd\xa8\xa4~\x06n\x85\xb1T\xc3w\xdfB\xb8+7:\xd3\a\xf9\x16\xacD\x01\xbc\x8a\xbd\xb3bV\xb8\x174\xe6\x847U\xef-\xa1W\x17\xbd˽\x1cH\xd03\xae\x8ai\xefr\x0fXiP\xca\xe5^1\xe3չ̗\x17\x18\xbe\xc8\xc1\x8bl\xae\xa7\xce%\x9f\xaaJ\xee\xf1\xf9\x1c\xdd\x0ep\xa9p\x1e]\xebG7\x8d·\xeb\xdeU%gn\n\x01O\rFqQ\xcdx\xef\xe3\xa2\x14u\xf6\xb1,ć\xdeG\xb3࿀\xd63 \x85\x16\x96\xe4\x1a\xf0\xf6;\xee\v-\x8a\x19\v\xca\xf2\xa9Z\x01ej\xde\xe4TV\xa5}\v\x1e\xeb\x8b\xea\xca<\xa2\x9fh\xfb|\xbd\xe4\xff\xc6*&\xc6\x1f\xef\xd5\xd5\xd5\xe0\xea\x009W\xa3\xe3\xe3㯱\xb0$\"\xd4>.\xca\fv\xa8\x84\xe2c\x99\x8bs\xf3\x88\x1b}r\xfa\xef\xafʯϟAu\xee}-\xecq\x12WI\xe5gOA\xf5\fn\x81\xb2\xaa\xeb\x978\xe4_DB\x8e\xbe\x80F\xbf\x1c`7|/\xf9\xdc|\x97\xb8\x80D\xe7`\xc6\xf4\x02C>יxjӓ\xa4\x96SH\xac\xbf\xc95\xad\x85w\xd6\xc5\x03\xfd\xf2\xdfl\xc0\x10T\xbe,\r\xfc\x98\x89\xc1\xfb\xf7Ɨ\xef\xd3\x17o\x1f\xbf~\xf1\xe0\xd9\x1b\xeb\xd5\xf7ݛǝ\xfe|\xe9Gv4\x1c\r\x0f\xe8{\xfc=\xa2o\xf0\xf7.}\x80\xbf\xf7\xe8\a\xf8\x1d\x1d҇\xf8~L_\xe2\xfb\x90\xfe\x81\xbf\xfb\xf4)\xfe\x1e\xd0\x17\xf0\xbb?\xa4o\xf1\xfd\x0e}\x85\xbfG\xf45\x86\x8f\xe8s\xfc\xbdG\x1f\xe1\xef1}\x06\xbf\aC\xfa-\xfen\xd3\"\xb2z\xae\xfa\x17z\xcb:E\xf0!\xe3\x8f\xecI\x9a O\xd6\xca@\x12B\xdf\xfb\xc0e%U^&\x84\xbe\xf1as\x99\x9f\x9b\x94\x0f|\xa8f\x13\xbf\x87=#!\xf4C\x90\x85>\xd2eB\xe8\xc3(\x14`\xed!\xf4\xa5\x0fEN\xc1G\xc8\xf9\x8f\xa0\xbcJ^\xe5r\xf6\x1e\xe6\x02\xa1O\x83\"W\xf5\x92\x8b\x1a\xca{\xd1\x0e}_\x16\xb5B\x97\x1d.j\xc1\x17UB\xe8+\x1fR\xe6\x9f\xc0\xd9\xd4k\x1frVV\xd3\x0f\t\xa1>?\xbc\xdd\x12\xfaܧ\xa9\x96\xf9\x9f+>(f\t\xa1\x8f|\xf0\x8c\x9f\xad\xce\xdf+\x99O\xb9\xed\x87g\xc1G\xf3y\x8d\\\x80\x84\xd0o\x83\x1a\xf0\xf3|z\xfd^\xdf\xd1\x13\xcdv\xfdD߱Ϗ\xa8\u0557\xf6\x17\xb6?\x1b8M\xdaq[\x1bDҸ\xe4j\x95\x90r\xf6\xae\xdf\xe7'\xefN\xd7k~\x92\xfc\xbf\xff\xaf-\"\x014/\xbc\xbd\xf9[\xdf\xcfMŦO\x044\xd5B\xfeu\x80\x1ee\xb78\x04_\x19(Y,R@\x1b\x83د\x7f\x17i\xef?\xd3\\\xf5Ȅ|MƟ\x10\x00Y\xdf\xee\x12sqH~\x17\xc9\xee\xa7]\xad\xe2\xf3\x15\xf8\xeeq\xf5\xf8\xc5k\xa6\xf0\xf5\xfa+\x0f2\xf3\x15\xdb\x19\x1a\xd1,V\a\xfd\xf1璿\x81*\xbc\x85Q\x1ao\t\xb7\xda\xda\xd0\x1e\xd4v\x83?m\x83v\xdb\xc8m\x92\xaa@\xb0\x95,\x8d|\xa9\xe6*ۚѦ\x03\xa2\xcd\xe8\xd2\xf5\xfbmMC\x04\xefj\x05\xa7\n\xc1|\xa2\x9e\x17\x8co\xda\t9\xa8\x05*\xad\x8d\x87y\x19_\xe0\xc1\xc7\xf0\xa1QC\x0e\x9a\x13~\xd2=\xdc\xf8\x1d\xb4ȅ\x80bG\xbf/\xba\xfc8\xebI\x11z\xf15A\xf6\f\xff]$\x1a˪\x1dZ\xb0\xca\xe1\xa4К\xe5\xeee<\xba\x0fnc\x86\xf7\xc1\xb3ouR\x9c\xee0\x96\x9fԧcR\xef\xed\xa1\xb6v\x90b\\\xec\xed\xd1zo\x0f\x06:H\x8b\xb5\x06\x8c\xa8b\xbd\x86\x9f\x9a\xcc*\b\x82\xd4\xc3o\xf6\xf6\xea\xf5:L\x1dLU\bv\xc7y\xd2\xcb\x15\xaaG%\x14\x1f\x13\xb2\xb9\xba(J\x9e\xfa\n\x90\xf1\x99\xe4\xf9\x87\xcdf3/D^\x96\xd770\xc9\xe9\xb6\xf9i!QS\xce\xc0\xd7¬\xa8\x81}\a\x17J\x04]\xca\x17\xa0JD&?#,q\xe2\xd7\xec\xf7\x01\xa8!\x1f\xa8\xfc\x9c\xdc\xc4w\xf9\x9f-ʓ\xbȅ\x8e|D\xf2\f\xf7J\x13q\x10D\xbcq\xfb\xb0\x89<\xee\x88|\x86\xbb\xb1N0ԸQ\xfb\xfag\xe4J\xe7\xec\x17S>P\x01&\xb3Q;v\xa0\tn\x9fh\x7f\xbf#\xd1\xfbf\xaaQW9Cҁ\xb9\xe8:\xec\a3u\x1b\xce/\xe1u˱\xeb\xd2t\x0e\xcbz\xed>m-\x03\xf7\xa1\x03\xdc2\xa3\xf3\xc6\xd6\xec\x89=yu{\xde\xdb\xf0W\xfa\x94֡\x1f\\\xa8=xu\xf8\x03\x1b\xfe\x06O\xea\xe7p@阧.\xc6\x0e\xa4\x0e\x7f\xd1\f\xc71\xdc\x04ȺA\xe5ݬ\xfa\xea+\x1df*\xff2\xb3S5\xee\x8f\xe4\xa1=\xebw\x93\xc1\xc3JԠ\xefi\n~\xe8\xbfyoH\x82[>~eI\t\xfd\xf1\x1f\x99'\xa7a\xfc\x9d\xfe\rkd\xa2̈$\tm\xd4-E\xdf\xdaj\x92<Ѥ\xc7k>OQ$L\x92,\b\xb3\xd3\xf9\xad\x9dW?ī\xe7u\x18nf\xa3\x89z\x95A\x05\xdf/\xf3k`9#,\xde\xfbB\x14*T\xf6\xfe!\xe5\xa9\na\x1c\x1d\xbaQ|\x10\xff\xb8\x1d\xa9\xd4q #\xecQ|1#\x98E\x88\xa6\xf8\xe2\xe5\xbd\x1e\xe1\xf4\x96%\xf2]x\xbcC\xf9c\xbf7\rD5C>\x17\xe9\xf7\x8dF\x05\xaaPE\x149pt\x91\xd1\x7fV}L\xb401\x91\xf9\xac\xd0\xfc߀\xcf\xfcw\xad\xfc\xf9\x1e\x85Do\xd1'$8\xf8h\x84\xb0\xf6\xcd\n\xaa8q\u0084\xcc\x1aSI\xf6Y\x83\x95\b@\xdb\x1f\xe7J\xa3\xa5\xed\x82\xe61\xba\n\xe3m\x8ew\xe0LKv\x1a\xa8I(wKL͝\x8a=&\xa39\xc3\xc0qliӥA\xddԕ>\x8f)\x8e\x06\x9a2\"+ш*\xc1\xe3\x1b\x1aG\xf3H!\xf76\xb5cEo\x02\x8dm\x19\x19\xf9Лs\xae\x85\xaa\x1d\xf5\x10X\xf8\xcf-\xe56]\x83\r\x05>\x11\x0e,\xf8\xde\t>o\x0e:\xde\xeaf\xbc\xe4\x8a\xf7`T\x00-\n\x15O\xdd\xec\xf9\xad\xe9\x03\r\x1c@\x9ai\x1b\xe5\x85\x03\xaa<\xeb\xdcj\xf7\xd9V\xa4z\xf0\xc7\xde*.\x15z\x8a\xf1\x81\x99c\r\xe8_\xab)Hhʙ zF\xa4\xa8\x92\xacs\xe4h\x81\xe4\xeb\xfaS\x93\xb6\x86\xc5\x04\xfb\x92_\x9b\x8e\x9a\xb7\x9a\x15\x13\xfb`\xf4\x84\b\x89N\xab`c\xe1\x03\xb8P_Z%\f8\x9bΪٵ\xd9hB\x9b\x19\f\xf6\xd5\xfa5\xb42S\xb6\xb1\xb6#*\xd0\x02S\xf4&\x96\x9c\x9b\xda\xd0P\xb8ީ1er3 \x9ar\"3>x\x7f%s\x908\xa1F\xe1\xc0\x18C\x98\x8c7A\x7fq\x1e\xd6̈{\x06a\x91\xc0$\x8fC\xa8p\b\x9ev\xd4ܓO\xfaж\x91\xfd\x98\xda\xe4Z\x01\xd4\xfcf\x92\xd0FE\xd9M\\\xd3LP\x13\xa0[/\xa9\x11\xf7\x96|\x96\xc5[\x1fn\xa0\xd1\xfe\x87!\x93FM\xb3\xa8*\xc1\b)\xd3\x11:>U\xfe\x13\xd2\uf0dc\u038bT\x15\xb2pܗ\xd2|ir0\xb3\xfe\xc7T٩+L]\xbc\xf0H\x12\x8fA\xce\xc4\x04u\xb1a\xabKB\xb5Y\xf3\x00\xa9Q1\x1c\xdf\x18\nX2\x17\x87\xef\xcdh\xa7\xe3\x9dԫ\xb3E\xa1\xad|\xa0ox\xcd\xf5\x8b\x9d\xe08\x83ڲ\x13\xeb\xf7y\xac\x9a\xbb\xb3\x8d\x99T\xd8ځQ>\xcc\xda\t\xc3\x19\x03μ\xc3\x0f~L\xe3\x19\xe5<\xa6\xbaN\xb7\x86%\xcd\xe9\x84M\x8d\x83\xd8\xceN3U0:\x82[K\x1aԸ\xdeҜ\xf5\xba\x1d\x157\xc0\xd9\xf1\xb9\xa1\xdcqݻ\xc3\x00^\xd9t\xef\x0e\xf6\xb57\x96\xd2\x03\xe3\re\xf4\xbb\xddb\xc6\n\xb7\xeb\xee\xf5\xaaW\x9b\\\xafU8/\xdcX+X?a-\x99\xda \xfd\x85\x90\xaeB\x13\x0f\xa9~B\xb8玞\xbb}\xa7\xa0\x98\x9d\xf4\xb9Ƞg+׳\xc1dV\xfd\xfeO)\x1fTW\x82\xcbGf[%\x9a\x9bb\xae\x02r\xe2\xaa\U00073772\xb7\xb5?\x8b\x93\x87S\xbe\x99\x8d\fO\xad\x9c\xc7f\x8c\xacJo\xac\"\x93U\bE\x7f誃\xee\xf1\x87\x94\xf0x\t\x96m\xcac=\x7f=\xb0p\x98\xa9]Ƒ\xb7\xab6\xe8\xff^\x7fG\xf4(\xd8W\x1c6_͂G\"u\xee\xc1x5&8h\x97\xb9\xdb}䋨\x02=Γ\xe4\xabd\x17|\x0f\x81\xdc6pg\xcfCmϊ\xb5g\xf7W@\x83\xc9S\xbbG\xe1\xb3\xd5\v\xd1J\xe7i\x14\xc6*B+dC\xe8p\xd3\xf7ol4\x9c\xc2\xc8\xdb\xd0uH\x92\xdd\x1fQO_\xd3\x17\xba\xea<\xac:\xb6\xf6\xa425\x88\x9c\xfa\x9cTA\xb9;C\x04\xd7Lu\xc9Ug\xc9dlW\xd7z\xad\xd3\x18\xf5\x9f\xf5:\x05\x95\xab\xea\x94ll\nC?\x04\xd5\xf6\x83Q\xf3Ȫ\x1e\xb7\x9fn\x95\xb7\x86\xb6\xe9\xf1\x88\x90\xe6i\x1e\x9d\xd1]\x87\xb8\x9b\x8d\x9fY\x01\xe1y]\xf2\x98\x92\xc0B\xc6\ue7ad\xb79Mb\xe8̩b\xcd\xd3\xdb\x1cAA+\x9b\xad\xd9'h\xac\x1a\xbb&\x90\xc4X\xb1\x8d\xbeav\x12\x92\xe6\x97\a\xd0\x0fL\x9e\fO7\x8aɍ\xd9\xd55V}\x82N\xb76\xdb\xce|\xdd70k\x82\x11YE\xed\x8d\x0e\xd5\xd6!b&\x01\xec\rz\x06J\xb2\xe3\xf6\xcd\xe0\x8c\x94\xfe\xb4\t\xbf\a\x8c\x86\xc6Nӱ\xcdHsV!\xa2~\xc7\x1e$\x82\xc1\x9a\xf2\xe8\x86\xc7?*\xa3\xfa8\xd6\x1b\xfa\xf6!\aZ@O\xd6`\xda\xfa}_\xab\x18s\xb6U&\x04ʊ\t\x9dw\xa6\xd8\x1f\x0e\x87_ח\xe7\x01\x02\xc9\x05\x0f\xd9[\xd6Yǥ\xf3\xd4qk.\x98\x18\x04\xa9\xb7\xa4F9\x1b\xa0\xfc\xe2\x9f\xe7\xcfZ\xee:nmHh\xc5\x1c\xef\xeaz\x1c\x81\xee\xbb5\x03\xe8\xed\xc9\x05\xea\x84\xdc\xd6\x14\xed\xf6\x03de\xbc87~\x8a PMn\xcf>\xd3\xdc\xf5\x05\xa7`\xaf\xc1Yz\xce;\xe0m\aN\xce\xf8\xee\xf5\xd3\x1d\xc6\xe6Pm\xa7\x1d\x9b\x14\xa2\xc7\t\x1f\xb8\x00\xa6\xc6n?M\x17\x9c\x01{\xa5[\x17<Mf\xc5eBH\xf0mr\xbf\xbe<\xff&\xd9U\xd6\xcc=%\u07bf\"\xd9M\xee\x7f\x8d\xf1T\xb1\x05\x1f\xcc\vY+<\xe5\xc6\xd1\v\xb1\x84!\xbe\xa6a\x9cF\xed\x18\xabFr\x98\xcdb\xa6\x93\x87q`a\xd4u\x03{\xfe\xe6\xc1r\xd9\xef\xe3\x0f:|\xd0нϪi^>1\x1d8i\xab\x9eݚ>\xc2ڵ6\xdczր\r[v\xce\x03c\x8bk\xbf\xe1\a\xa8RA\xa3`7\xed\xf7%.\xd727\xa1\xfd\xfe\x01껉j\xc6\xdfz\x9d7\xa4\xa6S\x1d\xfc\xb3]\xa9Ѻg\xda\xfd\xf4\x19g7\xb9(\x16\xa8\b\xf1T\x19\xecއ\xd5J(\xe0;\x9c\xa1\xb2\xc8S\xd0Vy\xb9R5o\x06\xbe)\x8b)o\x84\xfd\x02\xfa\x16:\xec㓒\x7f\f\x1e\xbf\x93\xd5ji\xde_\xca\x19p\xc9]д*W\v_\xb2~\xad\xe1qn2\x99\xeb\x1c\xae\xec\xf3+\xd4θ\xe4\xf6\xfdͅ\x04\x19\xb5y{\xc1\xcf\xf30\xf6%T\x10^\xcee1{ yn\x9f_WW\xc1\xe3c1\v\xde@c3|U\xb9T\xf6\xfd!\xd60~\v\xbe\xd6\x01a\x06&\xc4\xe61\xaf\x84\xfa\x05U1\xe0\xad,\x04\x7fX拥}\xf9\xdeE\x19}\x16|\xb4\x8d\xa8\xe4\xf2\"\xd7ݣ\xf2\xb37\xc5'mR_̪+\f\xfc\x84\xfa\x02\xf8TU\v,\xae(˗>'Ԣ\nށG\x13\xbd\x82\xee\xcc#\xab\x9d\x13\ai\xfd\x1c\x1f\xf6ܩ\xe0\xf8\xb0V^vZl\xe8\x15g'\xc9/\xfc\xecC\xa1@\x13\x18\x94l\x9fW\x9f\x12\x9a\xbc\fA\x8b\x1e\xf3\x06\xee\x80S\x00m{~\x86@\xb3;&\x19xQ2\xb7\x80\x9d \xc1Ps%\xcfZ\\>\xb8\x9a\x9c\xf1\x13~:I\x93dW\x11#\xd8\xcc\xd4n\xb2\xfc\x18\xc8]>\xf2\x06\xc0\n\xe2 \xa0\x98뺄\x98\xae[\x9dt\xb7\xb5a\xe4L4\xd9\xdbK\b\xad\xd8c\x9eJ\x8a.V\x058\x9f.\xab\x1c\xb7y\x89\x8a\x95ɴ\xae\x9f`\x10\xb1z\x81>cp>\x8d\x0eZ\xab\xcd&\x04\v:\xe3[te\xaex7\x18\x8dbj\x97[\xaf\x8dÆ\xbb\xdb]n\x1c\x7f\xa17\\B\xcf\x10\xa7\x01\xbbK\x1b\xb8#\x8f\xe1=^i\x16\\\xac@\x95\x1c\a\xf9&7K\fTi\xf0W\x9ae\r?\xe0\x87\x03W\xca\x05\x86\x16\x8bsg$\a\x0f\x1f\xf8\xf59\x17f%\xe0\x8a^p\x85\xb9-s\x99\xe3t\xd6\xca\xff\xf0\xa4\x80ㇳ\x1f\x8b\xd8\x04{\xea\x9bhO-\xe6\xe9{\xa8\xb8\xd3\xe0\xf5\xa4\xe9z\xfd9\"\xbbA]\x8e\x0e\xeeR\xaeI\xd3\xcf}\x19\x92\xf0\xb6\xbcFnGC\x9dU\xdbu\xe0\xb6\\\xc1D;y\xff\x1e\xcf\xfcB\xf4\xbe\xb8\xdeG\xa0N\x1bT\b\xe7n\xbf\xdfQ0\xc64\xbf\xde'!E\xfc\xc0w/\xfa\xca\xe5\xc1\xf4N,[\xa1\xed\xb6`P\xd4\r\xe9X\x828\x13Z}\xf2#P1\x18\x18i\x93\x9a0\xa3\xee9m\xbd\xef\xd5r\xda\n[ɢ\x15\xa6=k\xb7\x82\x81\"2\x81\x8b\xa2\xaeAu\x0eU;\x9d\xe2\xf7\xa8\xa9\xa3=\fz\xe2C\xe0\x9bD\xeb}\xa3\xe1:p\xcej9u<ZmGG\x06\xd3JJ^/+1+\xc4\xf9\xbb\xdaRO\xe8\xe7\x8do\x8b%\xf4\x00\xfb\xd8\x1e\xf5\xe0\x93(\x97\\\xa8\x17\xe0'W\xd3}\x0f\xb9\xbe\xe4\xbe4\xbf\x7f\xe8_\xbf\"\x9er+\xc7g\xa2B\x8f\x9e\xddp*\x0f\x9bC\xbf\x7foh\x16\xbb\xd1\x00\x01\xfb\xef\x19\x1f\xeb\vU^\xa5\x8aЇ<\r\xa2\xa8\x91Ϊhμ\xc0\n\xbc\xe4\x93?\xe0\xbf\xf6\x05\xc4I\xf6\ag'\xfc4{\xc9Y\xc0\x90x\xcb5z\xd3Kn\x81\x11^r\xaa\xd8\x1fx\xcb\xfc\x03\xdet+\xb1QT\xa1W\x85\x0e\x83ȧ\xdcXC\xfa\x9c_582i(\xa2zͽ\xe9\\\x98Ą\xf8\x84ϡ~\xd8\xef\x8f8{\xc5\xe93\x0e\xf2\xfeoy\xa4\xda\xf2\x04\x12\x19E\x9e\x97\xdc\xf9\x99\xff\x03\xd8P\x90\x01\x85V\x06\x99~\x8a\xae\x99aW\xbb\xabu\xe0~\x19\x06W\x9f/ye\xfc@\x984\"J#\x19\xb8\x1f\x1d\xf3\xcc\xca\x18\xcd³\x98\xa6Y\xf8fmOm\xe0\xa3juV\xf28a\x10\xd6L\xfe\xbcZ\xd5\x1c\xa1NZ!\x9dI\x9fW\x97\x1d!\x9dI\xdf-\x9b\xef\x9d\xc94\xa6\nx6\xd9\x11\x8e\x01\xa3}\xcd\xed\xa4\xc9\xd9J)\x94\xd4\x19\x13\r\xad\x8e\x1f\x886\xd7kc\x97d߀n\x86\xf3\f\xdf\t\xa1\x9c\xed\b\xad\xec\x11\x88Ua\xd8akm)\x18D\x92\xc3\xc0\x8fmc\x81\x1d\x8c\xa8\xa2.2\xf0\xc7\x01\xe3\xfb\x0e'U1O\x97\xa8\xa0\x05A\x7fr\xe0\xc8u\v\xf3\xfe\x04m\xa5\xbc\xae\x8bK\xb0\xafoH\x0f!/\xc4\x1f2f\xbd\xf9l\xf6\xf8\x92\v\x05\x9a\x01\\p\x99&\x8a\xd7*\xa1\x7fr\xfa'w\xa9\x8c]\xe6\xf6\x84F\xf4t\xceu\t\xa3@ˌǶ\xa8\xb4\xa6\xce\x1d\xbbf\xecx\xc3V4\x91\xd1\"K\x8f6v\xa0}\x88+\x870\xb8\n$\xea\x06\xa3@\xf7#H8Q\xbd\f\x97\xe2/f\x83\xf8\x1e\xdf~0o?rvc\xd2G\xe2J\xf8f\b\xdf\xf0M`G\xfb]g\xe5\xe3\xfc\x7f\xb6\xf8,?\xf2\x10\x9b\xc5\v\xbdC\xee\v\x95\xdaC9\x1f\xe4%\x9a2*\xedFi\xac\x06\x86\x11O\x14s\xcfx\xf1\xe6L\x8dg\xd5\xcdp\x87\x81[\xeb#\xd8q\x81\xca+\xf3\xf3Z\x9b\xe0\xd8\xe405\xed\xb3\xd1O\xe2\x0e+\xf0@\x8b\xa2\xf2\xf3\x89l\xa8\x01\xfefO\x85\x91>bP\xa9\xc8\xee\xf5\xa0pY|\xe23d\r\x05\x1b\x8c\xf2\x96P\xb8\x8e|k\xf0Hh|\xe8xU\xcc9\xa5\x81Y{\x81\x16\xb6|֭\x15\xf1\x93\xad\x17v\xe0\x8e\xc7]sdؽ{\xe1\xde\xf9\xab?ݺT\xb3]\r\x8dt\xd8+\x05\x81\x94\r\xcb ]\x05إ\xa8\xa0\x06Z\xef\x92o<\xce\"b\xb2\x8c\xc7^\xd6o\x86\xce\xe7^\x11\xdc,\x8c+\xe1*\xae\x88I\x93\x87vl\xa9`\x95\x1dRr#\x99\x18[\xf7\x97\x1b\xcci\xa3=>\x035\t\x9f\xea'}5\x81\xfc\xf1u\x9c\x8f1\xcb<<0~\xe2iE(\x1f\x9bp\x11\x87\xabq\xce\xf2A]\x9c\x95\x858\xdftu5\xba\xf7\xd4߀t\xc9\xd6Q\xb2\x8a\n\x96{6\x91F;\xd8\x19\xd1\xd2U\xa7\xd4\xd5\xd1\xe7\xd7M\rk\xcd~\xe6ڤ\x0f.\x1d)XE\xa5\x8b,Y\xe9*\x06\xa3W\xeb֖,\xbf-\xfb\x1crٖ}N\xa5\x8blg\xdfl\xfd1\x901\xd8|7z\xd0\x03\xcdt\xc7C\xddK`I&q!\xdd2\xa1\xa4?\xdd\aӕ\x94\\(mB\x96\xa9\r\xea\x13\xc4g\x89\x87\xe2\xe0c\xdd\xda;vI\xaf\xd7G\xf61BJ4W\x0eb~\xcdx1E\x95\xbd\x8d8\x1f\x9e\n\x0f7=Qq?\xdaQ\xb6K\xc6\x06\xa8\xc8|\xbe^\xdb'\xd6ԧ\xf3;\xd7\xc6}\xed\n5\x0fX\xb6\xed\xec\xee\x85\xcfU\x13\xcc4\\\xbdv#\x19\x13Wo-\x98\x94\x01য\x883[ľST**\x14\xad\x14\xcd\x15\xcc\xd0B\x81/\xfbڈ\x87J\xf3\xbb2\xbfS\xeda\xedy\xbe\xa4K\xff8\xc7o.\x14K\x16@o\x80\xd9q\x0f\x9fV˞\xaaV\xd3\v\r\x96\xa5\x9f\xb9\x98\xe9\am\xaa\x95\xaf>N\x81l\xea\xcd\xceJ\xfd`L\xb0\xcc7\xe6\r\xf34ϫe\x0f\xac\xed!#\xf8\xd5\xf9\xccd\xb5\xec!ܒ6R\x82\xd8\xe0U'\xfa\xc0\xaf1\xa3\x0f\xfc\x1a\x11\r\xe0a\xb5\xec!\xad\x83vO\x88!ӛV\xcb\xeb\xdet\xa5z˼V\xbc\xa7\xab5Eԟ\x9eQރ\xdb}\x0f\xe5\xca=#k\x0e\xacs\xfcq9Sm\xe2\xf9\x06\xf5\xf1\xf9\xec\xa5\xc88\x9dU\v$\"@\xa3,S\x14\xcd\xce\xde\\\u05ca/\x9e\xc0y\x96\x8d\x8e֒\nd\xdda\xba\xac\xa2\xfa2\x05\x8c˼\x10\\\xd6ى8\r\x1dq\x98\xc9ҸS\"\x18@!\xdcMo\xba\xaa\xab\x95J23\xd2z\xe1k\x91\x81\xee\\\xe5\xf4\xea\xe0\xbd\xe49\x90\xa5e;5\x0etu\xe9R\xebw\xc8z\xd5NlF0HnC\xe0\x03\xa4\xdcJ\xae8hf\xeb\xf0\xa73\x12~\x7f^)7=BJ\xb7\xac\xeaVĲ3\xb7\xa0\xa7\xceUH\xcbt\x98\x19\xf0A\xd0\xf3\xa0\x12=I9\x9b\xa9\xd4\x7fC\x03)\x90yL\x15\xdc%\x15\b\x97\xa5\x82_\xcaI\x96\xf2Ash\xd7\f\x96>\x1f4\x87\xd3fY\x813_\x16:\xf3\xad\b\xa2l\xc2\x1d\x11N\xad\x10\x94T\xf9\x83]V\xa9\xcd4`\xc80w\x87\xfa;O\xa3\bI,\xa5\x93*\xb3GGg\xafb\xbfqt\xf9\xe0U\xa8\xec\xfceJ\xbbW\xacT\nLx\xa0\xb8\x8bJ\x16\xea:\x02\xf1\xcb\a+X~g%\x7f/W\xe2\x97B]\xd8d)\x1f,\xbb\xbe\x10*\x95\x164\xd2j\xcc\x1ch\x1a+<#\f\xb5\x141\xf9\xc3\xdaiY\x00Rx\xc1WS\xdb\xd3OżBʏl¯\xe2\xad\xf7Z\x05\xea\xd2(\xb4t)\xbd\xce]p\x12\xb5\x86s<\xf4\xd7o;\x04\xbfB\x7f\x85+\x9f\xb6\xa7\a\x05h\x00\x1a\xcd\xc0xԂ\xd9\xeaf\x9dt\xb3\x8e\x86M\x02\x85q\x80\v\x05#\xe7\xd4\x12\xc0;C\xdf\xca3e\xb9\xcb\xd8^\xe8f\xbbtBP\x04\x95\x1a\xa2\nN\x8a\xf1\xf0~\xd1h\x19g\x05\xd4z\xdc\xdd]7\x9e<\x16U8PPi\x05\xe5\x1a\xea\xe3\x7fKw\xdeD\x1dfj\xe2\xfb\xccA%\xbaD\xfd~\x11G\x835\x85\xea\xf7\xafUZ\xa3\x81\xbe\xd9Y\xdd\x1eQ\xea\xc8\x12#\xcbF\xe4JG\xae0re#\xa7\xca1\xa9\xcf\x14\xa1\xcb\xe8\xd5\x0f\xccc\xb3\xe5\x87M\xb0\x92\xe9xb\xd3\\\xad\xd78~C\x1a\xac\xcbzz\xc1g\xab\x92?\xcc\xcb\x12\xcc\xcf\xd3 \xee\x05p\bK\xb7\xb2\xaf\x14\t\xef\x18\x1fq}\xb8W\x15\xe8Z>\x86\x8d\x92#\xf9\x17L\x15r\xf3X\xa5\x85\x1e\r\xef\xbcF#\xa2\x15]\xf8\xec\x85\x01h\x0f\x9b\x86\n\xaa\x8d\xa6\xc1\xce^\xc94\x18\x89\xc70\x12\x94G\x03\xf0\x18\x06\x80\xf2\xa8\xdf\x1fC\xbfS\x1euw\xdcۊP\xad\xbd3\x8fj\x98\n6\x87ڑ\xcfWNӐC\x9f\x81c}\xa5\x122\x19\x86\x99\x8c\xc9%B\xf8\x9b\x142\x9cts?\xe9\\\xaf\xbfW!\x93̃\x1cʓ\x86^\xfa)Sq\x00\x95N\xf6\xb4\xcbOYre\x9e\xc1?\x00\x8a\xa10t\x01\x0f\x8aj\xde\xcb\x1b\x15\xc8H\xb9\x98e\xefU\x9a<\xb0\x01\t\xf5Ϗ\x05X\x13\xba\xb4\x85\x95\xa7n\xff\u0089\\\xc3\xef\x90t\xdb\xfe\rJ\x10\x13B\x95̅#\xfd0\xf9[\x17\x92\xd0\xe0\x05\xab\xb5\xa1\x0f@\x85\x8b~P\x11L\xd8C\xbb\xdd?@\x1e\xa9\xe9G|\xc1[\xf2\x9b0\x98[\xbci\x86\xc18\xc4\b\a\x8eG\xaa\xecҟ\xc7\xe8\x0f*ʘ\x81+\x06\x8fu\xbe\xec\xf7\xd3\x0f\x8aݪQ`\x84lAWÆ\x06\xa2\x0f\vc\x97\x1a\xc5\xf17j\x10\x0e\x96\x7f\xa1\x1d\t\xdc\bݞ\f\aĿ\x92\xa8s\x9b\x15\xf1\xdfG\x03\x14\xbci>\xfaK\xc5\x1e\xaa4\t+\v6\xb3\x8d\xd0\"\x98 O\x1bq\xb5\x99\b/txT\x1c\x18\xcd\xfa\xfb\xca+\xff\xf8Z\xb1\x93$?\xab$B\x8e\xe9ߗ\x8a\xfa\\a\xb6\xd0?\xc2\x10?I\xe9\xd30\\OD\x9aLs\x01v7\xfa\xe9\x95{\x820\x03\xfc\xe0\xa3\u07ba\x80\xd9Jg:\xb5\xeeql\x80\xf3\x97\xc3\x17KU\xf0Y\xfc$\xd0\xc3s\xc7\xf3,\xfe\x95\xb2\x92\xc1o\x9b\x8eưW:̡-&\x88\x1e\x15\xfd\xf0\xd9,W\xb9{y\x14\xbe\x80X2\x8a}\x1e\aԦ\x87\xe0\xf9\x8d{\xae\xdbU\x81\xc0V]\xa0\x03\vq\x1e?\xc9\xea\x1c.r\xf1c\xcd\xf9\a\x1d\x1f<)\xedU zB#\xb0\xf8I\x15\v\xbe2\x00\xc5\xf8bъ_(\x1a\xcc)\x9c\x16\xc9U\x8e\xb0\x12\xc1S \xb2\x7f\xae\xba\\\x90\x04\xfa\x9flߙ\xb2\x82Ȼ\x82\x9f\xdd\xd1\xe9\xb8bI%\x92ݴ:\x196\x00\xaav+\x03\x9a4\"\x84\xbeBk\x8bTP\x05\x93\xdb<W\x84\xaeҊ\x9e\b\x10\xf0\xa4\xd1!/\xaa+b\xf0\xaa\x1f)v\xcfW\xf4\x99\xdd\xf4\x90\xa3\xdaw*Аlt\x87\x8e\xc6&j\xbf\x11uH\xf7m\xd4a#\xea\x80\x1e\x1a\xe9\xd8\xfeaߚOi\xb5\xebI\n\t\xf6\xa9\"\x19|y\x00\xb9\xea\xb0\x11=\xd8\xc7@\xa0fG\xc7>bh\x13\xef\xdf9\xb2\x81\xc7t\xffΑK}p\xe7ޡ\x8d\xb9gS\x1f\x0e\x8f]\xf2\xbb\x14\xde\\\xfa\xc3ѽ\xa3\xd1ȕpD\x95\x8b:\xda?\x1e\x1d\xde9\x1aڸ;\x10wtw4\xbcw\xef\xe8\xb0\xcf1\xec\x90\xda\x00]\xd2\xe8\xe0p\x7ft\xf7\xee\xfe=\xfb\xd1\x01uA.\xe3{\xc3;\aã\x83#\x97\xc8\xf5\xc1hx\xf7\x00pg\xf7]\x1bFԇ\x91L\xb7*\xbc\xf0}\xabb\xb1\x18L\xdeB\x9c?\xcb\x05\xaf\xc7\x06C^\x06\x0324b\xb1!(\xfc\"\x186\xff\xb8,$\x9f\xe1\x17\xb4\x00ɚ^\x026\xa8\x86\\\vq\xceg>S\xb8\x02\x13\xe0\x14V\f\xa7\x86\x87\xac\x87\x16\xe6̶\xf9n\xdf*z\x94,\xef\xff\xb3\x18C|\tn\xf7\x9f\xa9\xb4$\xf8\xb9閺\xcfrD\xb3\x82\xa8\xdaD\xe9K\x9f\xc9T\xf6\xffY\x10\xf3m\x1e~[7?\xb3\rwl\\\x8d\x11\xc6d?M\x87ߤ\x82\x1d\x8c\xf6\xbeR\xa9 d2\xccF\xf7\xef\vr\xff\xfe\x88\xec\x8d\xe8P\xdf\xe0\x956\x17\x18\xc2h\xf5\v}\v~\x86\x97\xa9\xea>\xe4\xef8\x89\x8f\x14\xab6\xb6\xd9\n:S\xa8\\\x9c\x97\xa6\xaf\x88\x11\xbd\xfap-\xb7Q}&\xe0\xfe2&\x15\x1bݿ\x9fJS!\xe0\x11\x88\xb5\xde\x04T\x9f\xfd\xb3\x1a;K2/7U\x01\x10\xc2Pߢ\xf6\xdc\x1c\xb9ӏ\xa7\x00@\x1b\x84\x93j\xe2_\xb2\xe0\xda\xf7\xa9\x93U\x14X/\x8f\x8c\x95\xb1\x05K\xeb\xed\x9b\x00g\x9cl,\xb9ީt\xff\xb0\xffOE\xc8\xe4\x93J\xf5\x82\xe5&\xed\xb0\x9d\x16\x16\xb7M|/H{\xaf\x9d\x14W\xf6?\x91\x9d\x92\xfaP\xbb~M\x04gwF\xfb\xc0i1\xb6\xd3Q6\n>\xf0\x8b\xcf|\xa2\xd8\xfeѽÃ;\x87w\x8e\xc0, fK\x1f܁\xe5\x16\xac\xb7w*r\x1a\xb7\x17\x88\xe5\xffT1.\xf4\xc9)\xde\x15\x0eF\xdfH\x8d\xe8l\xa5\xfavTU \nt\xf7\xeex\xfc\xd6L\x99\x05\xab\xf6F\xe3\xe6\xda\xec#\xc8u\xb08!@\xcf7\xa0\xbe\x00\x93\xbb&'\xcaͮS\xa6)\xf7\xaf\x14\x03%\xdf\xc1\xb4\xfct\xb0?\xf1\x8f]\xbeq\x86\xa8\x99{\xb0\x9f\x1d\x8c\xf6\xd2_\xa0\x89_\x7f\xaf\xd6C\xb2\x1en\xe8/&\x9f\xb2:\xa7ߛ\xe7g/\xf6\xb1\xc6?(\x16\x1c9\xefj.\xbf\x85\xbbK!\xce\xdd\r\xf2GŶ\xb3\x84\xe8w\n\xed%\x9c\xacPy\xed\xd2g|\xbd\x06\x1d\x81\xb1\x96,\xfd\xa4hΞ\xf11\xe8\x19h`\x8b\xd7<\xad\xa8M\xee`\a\xd2g\x9c\xe5d\xbd~\x82\xe8\r^\xca\x17\xe4\xfc\xa3J\x7fP\xf4'58+\xc4\f\xaf\x90.\x9bЄQ5P!a{\xf9\x0e5\xddҊ\xc1\xb6q\xd8\xc7\xc9\x15\\w\x81\x89w\xff\xc2\xf3\xf08!\xc8B\x8cʠ\x85\x9f$\xce\xc7G\x8e|\r\x93\"\x92\x8cU\xfd>2y\x05qҊʨ\x1d5\x8a\xb2\x13\x16J\xcc}qȴ\v\xca,\xe6-\x90IZ\xb9mA59\xc8&\xd7Z\xb1s}\xc3v\x9fP\x8b\x13\x19\xb0\x90M\xea\x12S\x97\x9d\xa9\x03\x16\xb2I\xbd\xc2ԫ\xce\xd4\x11\x0f\xd9\xca\x10\x1d\x83\u05ee2\xed\x17*\xcd\xe9\xb9J\xb5=t\n\x93 \xecwZ\x11\x9fk\ag\xd9\xe4\x14eO\x97A\xbe\xcb[\xf3\r\x00\"}\xdf[\xeb23\x80\x9b\xe7\xbaǅ\xc6p\x02\v\x8b@~ۜo\xec\x03OEȶJ+&\xab\xb4\"\xe6\xb4́\xb9[5&\x8b\xe6\xc1\xbbiU\xb0\x1cX\xa2c\xcb\xf4-\"fo\x05\xcc\xde\xdc3{\xab\xb1\xfe>b\xc3\xeaO\xf2\xed\x9cXH\x84\xa5L\xf2[\x19\xafQ\xe6\xb91n\xaa,7\xc7d\xe6z\xa8\xa2\x86%\x82\x9b\x995\x90V\xe6Wʆn\x97\x90Z]J::H\xcaq\xe0.C\xa2\x9b\f\xbdJiżG?.'\xdc\xf8\xc9ȸ\f\xb5\xc4i\xee\xa0Y\xc6^\xbbJ\xf4\xfb\x12\xae\xf1\x8cU\xc0\x05\x00\x1d\xab\xb1\xeei\xb1\xc71\x9d\xf6\xef\b\xc0(\xf2D\xec)\x9d4\xdfS\xa7\xe8\xe2\xd1qk$\xb3\xe4=\xa7\xa3\xfbj2\xdas\x86\xcf~R@\xb1^\x86\xff\x81_?\x04e(\x9dE\x02j\xab\xf0\x8e͘X+\xeb\x81\r&\xfd\xfe\xe8\xc0r\x06\xd9\xe8\x80d\x9c):\x1a\x1a\xa6\x15\x06у\xfd\xfb \xfb\xc0\x84\xe0\x105\xa0\x17r\xe94\xf7C\xeeq\x11\x04\az.\xb5l\xb2\x06\xbd\xa8\xc5\x1e\x97\x05\xf0CPe\xe5=bG!2\xbaAC\x7f\xafy\xbfOE\xad,\xe2:\x82\xa5\x1b0\xf5\x80\x93ka\xd7\xf5\a\x16:݈\x90\xdf\xea@\xbd@IK߹0\xfa\x19'\xc5)~vR\x9c25QiE2@\xbc\xf1\xa7\xb6uKc|k\xe0Q\xcbg\xcc,\x1d\xe7\xa3\xc3\xc5L\xdaA\x19\x82\xcdZU\x06m\x1b5\xc9eV\x98&\x155T+?7\xec\x84j\xb9\xe43f\"7Έ-r\xb4\xb6\xd4y\x9bj\xb5|\xca4k`\xf1\xa38k\xf6\xe1X\x1bb\xc5\xf9M\x9a\x01)ɒ\x95\xf8 \xaa\xab@w\x8b\x87\r\xc2\\\x82w\x06\xe0w\xdb:/\x97D\xe3!\x04\xed\xde\xe2`\xbb]\xd5\xc6w\x93VȖ\xcaj\xc1\U000b7af3\xb3Rg\x14\x06\xa0\x03\xcf탁\x156\x8e\xc0\u008anhQ\xbf\xd2\xc1\\\xa8,\a\x17Aڤ\xa4\x94t%\xe9Tҥd7\xd8\xeeW\x17\xa0\x03>\xa4gX`\x9d\r\xa9\xae\x00\xe2L\f)\xf0\x1c\xde(0}\xe8tY\xe8\xa2\xd7\xebG\xb9\xe2\x03Q]\x01^Wk\xaa\riQ\xbf\x95\xab\x1a\x9f7t.Y-ӥ$\xf4B24\x84\\JzsY\xf0+\xf4\xe6\xa2\xf2\xa2\xcc\xc0\xc9\xf3\f\xd3]HB\x17&݅\xa47\x1a\xe5\xed\xd7lH\xf5\xd3oP\xe9\xb2\xe0B\xfd\xea\x9e l\x99\x9f\xf3_\xcd/\xa6Q\xb2\xfc\x91_\xc3w\xc0\xb3֏yi\x1e\x80]\xa4\x9f\xce9\x80\xf7\xa0\xfb$T\x8f\xca\x1eJ\xaa\xf5\x12\xb3\xa1y\x80~\x92\xbc\xcc\x15\x9f\xe9\x15\xdd\xd5=\x81\x8b\xab(\xed\x84\x0f\xe6\xb2Z\x18&*\xc6{E\xe4\t\x1f\xa8\xca<gQ¬\x91͆\x82\xd2\xdf\x02\x9b\xdd.=q\x91z\xfb\xe5\x03\x9f:\xe5;\x8cM\xc1\x8e\x01\xfeh\x82\ab\rv\f\xe8/\xa7%\xea\xb7\xea\x9eޛJ\xfbHW>\xfc7\x1f\xfe\x1b\xc9V\x92\x95\x92\r\xe9T2Nh\tSӖ\xf8\xdbm\xf5\xfb\xadY\xbf߲\x95\x04\r\xc8s\x1c\xfc\x85$\xf4\x12\x9fp\xfc\x17\x92\xde\x00\x0f\x0fy\xbcs.a\x9a\x10z\xed\x13\xc0\x04\x89G\x06S\x9c\xf9\x140\xd5\x1c\x9b\x145\x1d\x86\x94\x97\xf9\xb2\xe63\xb8\xac\xc0\x84\xa9\xf9j\xe6\x06\x01\xbf\xbf\n\xe6\xe9\xd4\xfaq\avcWӢ\x04\xb6y\xf1WFq3\n\x84F?\xc6z^IB?\xc65\x86F몼\x97\xec\xe6q=͒\xc7\xf54\a\x1fMo\xc0X\xf0,\x97Y\xd2K\xe83>WY\xf2@\xca\xea\n\x1e\x13\xfani^\xdf-\x13\xfa\x1a\xed\x96\xf4;>'\x14\xfd-\xe9\x90G\xe8\x02\xf1\x11/\xb3\xe4\x112\xca\x13\xfaK!\xb2\xe4囄>\xe7b\x95YX(xI\xe8\x83\xe5\xb2n\x04\xbd\x99\xca\n\x90\x0f\xf5\xef3@z\xa4ϫO\xafd!\xf0\x82\x05\v,y'\x8a\x19\x17\xe8\x9fl\x96l\xe8\x1b\xc9n\xeeeɷ\xf9\xf4\x83\x81W=Β\xb7\xf9YBG\xfbY\xf2\xb0\xe4\xb9L\xe8\xe8 3\xde\x18\xe9\xe8(K\xde\xc0\x02N\xe8\xe8\xae._VeBG\xf7\xb2\xe4A\t\xa1\xc7Y\xf2*_\xd5<\xa1\xfb\xc3\f\xbc\xf5Ժ&\xfbw}\xa7\x1d\xeccw\x1d\x1c@\xdasP^\xa6\a\x87\xfaYw\xc3\xc1\x1d(q\x96Ѓ\xa3,\xf9\xbe\x027\x1f\aw\xa3\x9e=\xb8\x17\xf4\xec\xc1qܭ\x87èS\x0f\xefd\xc9SQs\xe02\x1f\x1e\xf9\xfe\x1dA\x1b\x9f\x8c\xe0\xe1 K\x9e\xec\xc3\xc3a\x96<9\x80\x87;Y\xf2\xe4\x10\x1e\x8e\xb2\xe4\xc9\x1dx\xb8\x9b%O\x8e\xe0\xe1^\x96<\xb9\v\x0f\xc7Y\xf2\xe4\x1et\xd50K\x9e\x1c\xc3\xc3\b2\x1c\xc2\x13f\ry\xefC\xde#\xc8\xfc\xf00K^\xac\x16\xba?F\x87w\xe2\xa1\xda\xdf?\xcc\x12`\x93'\x1b\xfa@\xb2\x9b\a\xa5\xca\x12\xbdC&\xd4tt\x96\x98}\x14\xe6\x84ʳ\xc4l\x9c\t\xc5A\xc9\x12\xbb\xb9&\x81$\xebC@'\xb6\x0eP\xa7r\xda\xdcx'\xed \xb0G\xd8\xd9I9{\x00T.\xe9\xf7wv@j\xe5鼇\x9e\xfc\xeb}М\x86\x97\xc1\xd9\x01\xfe\xeb\x9a\xee0\x81n\xb5\x95{\x0fR\xca\x0f\xfc\x1a\x00:\xe1\x17-\x7f\xa2\t\x1bi\xc9Z\xf4L\xab\xc2\x15\xec\xa0Z\x89\x853$\x91\xc9\xc4\xcc\xdeL\x1b\xd7\xe2\xbe\xfe\xd0\x10\xc2Ц\xc4(\x83\xf9\f\xd6\xeb\x04\xf5\xc1\x82,ߘ\xca\xc1G\x00\xde\x19\xd5\vp\b\xe9\x14\xccM\x86\xb4\xac\xa6\x9az\xf9\xf2CO\xf2%ϕ\xf9\x16\x8f\xfe\xaec\xd0\xd2\xee]\xbb^G\x1f`\xdb\xe1\xc07\x95\xde\xf2\xd9g\x1b\xeeZ\ry]]\x14Ӌ\xbfT\x81\xbf\\Ɔ\xd0?p\xfb})\t}\x1a\x9f>\xee\xf2\x9d\xa1M':\xa0Ө\xddpr@\xf9+\x89\x94\x13\bʄ*@[\xc1\a\x16\xa5\xfa\xd5\xfc\x02I\xa2\xae\x80t\x1bR\x93)\x98\x0e!\xad\xf4J\x16\x8b\\^\xeb\r\xffE|\xbai\xbd\xc5\x1a\x8b\x80\x03\xee\xad{\xd7Һ\x99\x0f\xe8\x18\xe7\xce\xf9\xd01\xd4P\xf0\xdb\xf8\b\n\xfd2|ə\xf9J2\xd7k3^\xaa\xbc\x93N\xd11\xf6\x944钫\v\xce\xcbGA\xd4\x1e\x1f\x04a0\x0f0\xe9o[\xb3\xfc-\xca\xf2\xb70\xcb\xdf:\xb2\x8c\x12tĻ\x12\xff\x8e$j\xa9\xf2\xe7z\xae\x10\xfa\x1a\xbb\xe9\x95$\xf4\xb9d'\xc7tt@\xf7\xef҃\xfdS\xfaH\xb2e\xbf\x9f<\xf4\x9a\x9f\r\x894}f\x18\x05\x90\xcc\nٟ\x9b;\xb3}\xef\xf7\xd3gҋ\xe0\xc3d\xfan\xff\xad.\xe6-\xff\xa8\x1a\xf9\xf7\xfb;\xcf$}\x82\xf1\xe9\xce#\xb9^?\x93\xfd\xfe\xbd\xfb\xf0w4\xfa\x86=\x93\x84~\x92\xackS:\xd8'\xf4\x9d\x8c̥\xfe\x94\x9dʜz%\x19\x06\xd5\x1e\xe0\xb8>\xf7ƴ\xca.+2\xb6\x89q):\x1e\xfe\xfe1\xc2\x1d\x99T.\x91^Ɂ\x12\xe7\xcc\x1b-y}Q\xa7R\xdc\xf6\xd1\xe2\xd9\xda2\x98\x1a\r\\MdH\xe8\v\a (\xce\x02z\r\x9e3\xc7\xe0\xf9\n{\x02\x9e~\x91\xec\x06\xad\x1f\xc1\xac\x15\x84\xb1\xf6\x17\xeeB\xf0\x9cؗ=\xdcJ\x13mS\vW\x1a\xb0\x95\xad\x846\xc0\xd7V\xd0\xdal\xb6\xae\xc1=\x00<\xa3\x8b\x02x\xa8y.\xa7\x98P\xf1R\xff|D\xf3[[\xcaJb\xf0\x15\xe7`_\x1b\x1c\xb5߇,\x99~_\xdb$\u0092\r\x9fc\xbd\x1b˰qVVj\xb2\xb3\xf3\v\xea\xeb\\/\xf9i\x16\xd9X\x05\x02\x83\x1f\xa4g\t\xbe\xe0\xa9 tx?U\xec\x99HU\xe0[\x9c\x10\xefsH\xa2\xaa\xc3\\\xa6I\xa0J`\x95\f4\xe7\fX\xc2\xc6\xf6P\xdfP3IKc\xd4Tg\n\xb40\xa1q?\x1aN\xdbwMN\xdb߱\xfd\x7f\b\xf0\xee\x16\xa2\x13Js\xdc\xff\x96Vh\\I\xda\xfe\xfc\x7f\x92ދ\xbd\xa9T\xa8D\xaf\xafпJg텽\xccE\xf0\xa2\x04K*\xa1\xfb1X\xc1ڦ\xc50N\xa5\xb8]\x9df,E\xecM\xc6\xe5H\x13ï\x05\x05#х`.\xc5\xc0$\xdep\xc1\x94\xd0\xccL.\xa0ʿJ\xc6\x01\x8cf\xa7s'Y\xaf\x8f\xefwF\x04}(\x80\xb9\xf0#\xdc\x1b\x7f\x94\xb8j\xa6\x17\xb8\xdf@\r\xed\xe1`\a\xb3\x12\x04\xc6\xc6\fS\x90\x89\xa3\xbb\f\x8f\x13O\xe0\xf0h\xe9\xf7\x7f\x93\xe9wVf\v\",\xe8\xbe\x1fd\xaa\xe8w\x92r\x8a\xf6\xbc\x84r\xf6wI\x9fq\x82\n\xc4\xc8P\xf6\xa2\x17cB\xea\x84.\xda\xf8S\x8b\\\x82\x9d!\x17\x0e\x9å\x13\x90͘BC\xa1\xf6\x92\xa6?J\xa6\xc8 W\x9fk,\xc9\xfc\xb6\xa49\x98\x90\x89/\xabv\xcd\xd6\xe6\x8b^\xb9\xc6\xd81zzD\xbf8\x82\xc5N?\xdd+>\xc7R\x04\xb3\x15\xed?\x1b\xa9C\xa5\xccU\x9082\xa6\xec\x98\xe7\xfaS\xe8\xfci\xe7$3f\x8dE=qOY\x8cc\xe3$=\x9a\xbb\v\x12b`\xe2~\xcd\x19\x1b}\r\x1e\xab8\"\xa1\xa9\x1d\xa66t)>\xe7B\xd3/\xee\xb9o\xc6T?F\x1e\xb8\xdaH\xfc\xeb\xf5-8\xfd\xcaŪ\x18\xb2T\xb2\x10\xb5\x01a\xa4\xc2\x00-ܷ\xecz\xb4\xb5ҏ\xb1\x12\xb6`ñ\xf0@lbw\x17\x84s;KaP_\xa9\x04Ř\xf5z\a\x9ar\x02/\xa7T\xe9_\xe2sjs\xb8/\x84\x95\xf1\x8eqS\x8f\xc0ub\\\x9a\xf6\x1e7\x13\x81\xae\x06\x15\f3s\xf2\x03\xa1\xcdv\xe0\xea\"<n\r\x04I\xc6wE(~0͢\xfc>\x8c\xb1\xfc\xc6\xf5\xe1\r|\x97\tj\x90H\xd4\x1eߌ9\x93\x1b\x9e\xe9:\x9b2\xc4@\xf0\x8f\xea\x8d65\"7\x82E\x01ւw\x03\xe1ޔ~#\x8c\xeb\x80\r\xd6\\\x84\"\xd4E4\xf9v\xd0[\xc1\x8e\xd2\x02y\x84\x17\xc1\x90\x83\x9d\xd0N\x1fb\x95\xc6\xe9Q.p\xa2s\n\x8a%Yb\xe4E\xb5c\xe7\x98\xf7T\xc1\x85\x14\xde\x17\x90ނ\x19\xbe2\xf4\x1e\\P\xd3\xd1Q\x7fk\x82T\x91H\x85\xf9\\\xa4^ \xc1\x8dw_\xaa\xd8O)\x19\xab^!j\x95\x8b\xa9f\x1d\x03\x86\xc4\xd3'2_\xd8\x03d\xac=\x16\xe8\x19܁\xed`\xfc\x84\xfe\x82y\x0e\xec%q\x00N`\xbcɮ4\xa6\xd2;\xd2؛A\xd9)g\x8dω;$\x9c\rk@\x19\\\x8a\x7f\x95\b\xe9\xc1\x86\x11P\"\xf0\x86H\xe4\xe1\xddMSGQ\x90\xe2e\xf4\xbe\x92\U0007b972| i\x18\x8f\x03P\r\xa2\nc\x8a\x86OU\xbd\x1f^\vv;\xa1\x0e4u\xe7)Jό\xca\xfb\x95\xf9}l~?\x8a\x88\xc4~\xef\x8e$\xe3\x0e|\xa0g\x00\x9a\x1eJ\x97ev\x1c!KMd&c0\xcd\xf1G\xe7\xf3\xefL\xac\xd7gb\x87\xb1\x9fR\x01v\xf6\xfe\x00\xd2Z\x8f\x85H\x05;\x03\x7f\x81\x97\xb0\xa2&\x82\xddh%g1\x88SRPe\x0e\x02\x1f\x8b\xd9&\x13\xa0\x7f=\xbd\xa8$\"]\xa4\x82\xa5\"\xaeI\xbf\xdf\bpXx\x05\xbf\xf2\xb0\x1b\xe7\xdc@5\xa2\b\x83\f|\xa6T?\xbe\xd4ۉ\x18\x84\xaf\x14\xcf\\,Z\fܳ\x0eu\x1f\x04o\x1b\xfa\x18\xb4\xd6E\xfaXP\xec\f\x18\x06\xa0Z\x05P\xadW\x02\xc8V]\x8d\x88lU\x01\xd9j\xa2\xad{TC\xb6\u00905\xc8V\x15\x90\xad\x02$0V\"x&`\xc5?Wib\xac\n폶\xec3\x7f\xab\x9aۿ\x81\x91\xdf\xd4s[\x8da\xa0\xb5\x0e\x9c\xae\x02\xf3\xc5|\xf5\xf1al\xc78\xf3\x98\x10\x91\xbd\xe2\xe3\x86\xddb~\xfe\xc6[0\xe2\x1fC\x1a\xe9ߞ%rzg\xe5J\x1a[E\xfb\xf7\x12\x1c\u07ba\xdf\xc0\xbc\xf1Q\xd3\xcc\xf1U`\xef\xf8\x81_\xbf[\xf6\x1a\xb6\x9a\x8fB\xabͅ\x86\x930\x06\x90\xf6\xef\xaav\x7f\xc1ï\xfe\xd3e\xad\xf9p\xab\xed\xe6\xa3؎\xd3<\xbd[\xf6d\xae\xb81\xb1\x84Ǉ\xe6\x11-,\xf5_\xd0\xe5\xe53\xf7\x83V\x97\xf6\xa7ee\xfa\xb0\xcb\xe2\xf4qlz\x8a\x8f\xba\xef/\x01\x8c̖\xaf_\xcce)0\xeb\xa4CBa\x0e\xc1\x90\xf5\xdc\x1fTs1\x03k\x9f\xf8\xc7B\x8f\xecc\xfb\x80\xf6\x93\xf8\xf4\xcc=U\x97&\xf9Kxp2!\xfd\xf4\xdc=\xc1\xd0\xe3\xc3K\xfbP\xb9\xf4\xf8\xa5\xe9D\xfc\xd6<?\x0f\x9e\xe1{\xf3\xf8\xd2?V\xc1\x97\x98K\x8d\\i\xfb\xa3\xaa\xf3\xf3\x92\xfb\x9f\xd5\xf4\x02\xf3\xc7'\xcc\x1d\x195\xfao\xd4I#\xec\xa4\u05ca\xee{\xf3\x9e7\x82\x19\xb2\xb7נ\xc5#\xabۆ\xb5\xeevk^\xa3\xa0\x1d\x16\xfb\x00h\xbf\a\xe2\xfe\x1bK\x19\x8e\x1f\x00\xf9g\x94\xa4߈\x93\a\xe2\x94\x0e\xc9x\x9aư(\xf4\xc4[\xac\xd2@\x13\xe9\x94P\x9f\x14\xc7\xec\xb3I\x8dƺ\xcb\xd7\xf7\x7fB\xddK\xc7\a.\xf7\xdb>Xu\\\xe0\xcd\xce\x15m\x17\x95\xdb\x1d\x82\xdd`\xb5l\xf6{\xd8w&s\xb7ͺ|\xc2m\xd0\xee`\xb6\xb08\xf7\xb6\xdd\xf7\xe7\xcb\xfb\x96\xcf+p\xfc\x8d\xf7\xec\x93$\x18^\xad\x89\xefXR4q\x93$A \x17\xc5}\x8f\xf8\xaf\xb4J~cҸ\xb6l1\xfd\xf6\x8c\xaev\x05\x83\xbc\x9dmGs\x8e\xfe\x9b\xf2\xb7F\x06Ik\x92\xff\x8b%\xe0\xa5\xea\x83`ڦ\xa5g\xecOz\xb1\x1dJ/6:\xe9\x19\xbb\x92\x9e\xb3$\xe9\xa1\xd2i\x0f\xadFz\xde\xfa\xa3\x17\x9b{\xf4\x9cmG\xf3t\x00כ\xd6.#\xdcჭ\xbc@'\xaah\x91\xd13j\xae=o\x83\x11o\xcc\xd6\xc8\"\\\xf7\x0f\x05R\noxp\xb8\xe3\x19n\x0fE\xa8\\\xbc\xab\x85\xdf\x03\xd59\xcdU\xfaA\x90\xc0l\xffe\x83&\xf44\xaeV'\xd1\xeea\x931o(\x19I\xda֣\xa49\xadiIWx\x05\xfb\xceB\xe3\xa0\x03\x12\x0f\x8eC\xbf2\x9e<\xbeja\xba\x1c\xdf3\xa39e\xbf\xf0q\x13\xc9g\xbdN\xbfG\xa0\x9e\x1f8\x9b\x82ɇ\xa0\xc6({H9\xa1\xcd\n\xc6\xc6\xcd\x7f\x98\xfb\x9bbچC\x91\xf1Vc\x95݆\xad\x8a\xd0\xca\xc6c\x01WqKu\x8dyf\x14\x11u\x15\x10s\x83\xd8<\vwk\xdf\x03\xebaV\x80\x8f2\x9dm\xcd\x04(`\x95\xac\x1e\xd8\v\x17]\xb1\x86\x0e\x17\xe4V\xb3\xda\x15GAe1\xef\xf7\xabN]\x9d\x94\x10\x8bI\xf5R\xa4\x15\xad\xe9\x8aМ\x19mC\xa8S\xc1\x86\xe3\xe2\xbe;/\n\x83}^\xb2TW\x87\xdcR\x17\xfao\xa8\b\x02\xa8|o\a\x9c\xb3\x1fx\x03\x9a)`\"<\r\x99\b\xac@\x809\xc1\xf8n\xf2\xfe\xbd\xd6#J\xc6hp\xa8/\x1c\xafE\xaa(\xa7\xfb\xe8yT\x02\xa4U*\f\x17\xf7\x85`\x89ֵӸU\xb0\x9evQ\x85[\xe6bV-B<\xe2\x83#\xeb\xa4}?X\x1co\xf1\xa6\xc9O^\x88S\xf0y\x00\xbf0\x01\xebn<͇\x02\xab\x05,\xa8WP+\xe7\"\x97P\xfd>\xb4\xef\x9b\xe8V\xfeJ4\x95_\x0f\xef\xbb\xf5\xe2\xee\tΛ\x83\x8b:9<\x9d\x84/ \x93cr\xbc\x8d\r\xd8\xef\x1f\xef\x84\xf7\xba~?\xcdY\xe3f猅\x81\xb7\xa0\xfa}\xd3$\v\x17\xa8\xf7\x16\xed\xb9\xdep\x8b\xab5ۧ9\x13\x1b=\xed\x8b\n,Wj=\\\xc9n\xaa&\x89S2N\xcc\xf0\x91q\x81\xb9\xd60~p\a\xaf\xd6\xec\x90\xd0\xd7\x02\x95\x87+\xaa\b-p 밗^\xb7z\xe9\x95\xd6IVĂJ:\x9d\xa8j\xb2\x9fU\xc6\xc4c\x98U\xec\xef*@\xd6荲\x8a\xfdfC\xacl\a\x94\xec7\x92U\x81^<\x14\xc6\t\xad\xcc\n\xa7;\xef\x90\x17\xe0\xa8j\x83\x8e\x9e8\x92\xd1\x06hJQ\xbb\x02H+Գ\x13\x137~Մ\xb7\x91נ\xac\x1b\xd3SVn\x03\x18\xc6Նd[\x92\xef\fI\xf6\x05\x99~IN\xa1+\x99\xe7\x91b\xbc\xdeℱ7JG}\xe0y\xc1\xd3>>\xd9\xd9Bx\x86\xec\xb7q\b\xade\x8d\x93\xac\x8a\xb0U\xc6F\xc5j\xed\x06\xbfp{\xe26\xedi\xbd\x132V\xad\xd7\xf7\x18cu0}뀉\x16`m\x15\xf3Tg\xad7>\vUe\xc1\x83\x8a\xb1\xb5\xd7*l\x85\xb0F\xa5\xae\x11\x88\xea\xd2\x14\"\xb7T\x88\xf8ʔAe\xcafel\xe3\vV\x98\x1a\xa0Ѿ\xadF\x1duUZ0Y\xc1|'\x1eB\f \x9eRSK\xa2a\x9eJ`e\xe6\xacp\xa0`=\xbe\x81\xdd\xd9\x17\xbdA\x9e\xa6)o'>\xa2\xb1\xc0o\xbd\xa7B\x18{2\xfe\xd6\t6\x1eY\xe0e'\xda\xf86\x10mD\x98\xe6\x06y\x92\xa2\x9e\xbe$\xb4\x00A\x8a9\x11k\xf6V/Lmn\xe1\xa6hm\xfb}.\xe9\x8a5\xfd$\x06bY=\xd7X%S\xe9ϓX\xbe\x1b\x8a\x86K\xf6\x87\f\x81s\x9c\xf1Ɗ\xe9焖캝D\x03\xf7\xb0\x04x\r\xed$gH\xb2c\x9c.-\x9f+.\xf5{3\xad\x16\x90@\xbd\xf75\x86\x01\xaaq\xc6U\xb7\xdc\x13\x93\x9be\x9dl\x11B{\xad\xc9\xe0}\xb5l\xe1\x0eu\xc2\x12\x05w\x19\xa8\xeb\xb9l\"\x1e\x05`Gp\xffȶB!\xc1徍\x8c\xe4ރ2\x1d\x97ǽW84\x97Q\xe1\x01\xef\"\xc9|\x88\xaf\x84\xdfJ\x83w\x93o\xc9^\x84\x99\xf5^*L\xd3\xfb\xc3\xfc>UY\xc9\u03a2$/ \xe8mT\x05s\x84e%\x9bE\xe1z\xcf\xceJ\xf6:\x1e\xdajym\xbb\xd5u\xb8\xbe\x97e%{,\xffUĦм'ꐀ\x91\x14\x87\x04\xbd\x12\x01I݊5\xa5\xd7\xc7S\xad\xaa6u\xb4/]\xb2\x9di\xbfo;\x831\xc6\xe9\x9cM'vo\x9aԻ\x89\xc3W\x85\xc0\xac\x1eOa\x89[\xfa\xf6\x82Θ\xdbQgfG]\xb0\xf4\x82\xcdH\f\\\v\xbb\xd8\x05la\xee\xb0X\xf4\xfb\xe9\x05[XJc\xee\xf0\xa4\xd2\x05\xfb\xc4\xd3\x19\x9d\x83a\xd9T\xb3;\x1f\x89tF\x17\xf4\x02\xe4\x18tiv\xf8\x19\xb3xv\x9b\xe1\xfd\xa9\xe7\xa4\xd6x?*Ӛ\xae\xac\xbc\xbf\x02Z\"d\x9c\xd6\x01\xe3t\xba1\xd0~p\xa0\xdd\x05\x836M\x14\xfb\x05fd{A\x87c_\xa5;i͂u\xd7Hf\x83\xc8z\x8d6\xcfG}\xa0\tw\xd2\x15BD\x06\xea\xc3\xeb\xb5\f\x95\xb0!\x91\xac\xd2\x15h\x1e\xaeNxu\x8a\xf09\xeb5b}֬\xf2\xec\xfajRe\x18\x12So\x93:\xe6\x7fۓAKU\x8c^0-'iɄ\xed\xfftź+\xe64\xc5\xc9\x04+\x85\x13\x01j\xb2\x82\xaf\x96`\x80\xb5\"d\xbd\xbe\xb3\xc3\xd8J\x8f\xf0\x91}\xc4dZ8O2(\rFc\xc5\x04\xc1\xfb\xc3Jw\xf4\x94\x9dK\xba`\r\xceӜ5\xb8V3\xd3\xd3\x01\xa3h\x85\xb4\x17\xc0\x97\x87]\xae\x05\xc1\xe9\x94=5\xd9\xc6L\xa79k1\xaef\xccf\x90\xc0\x9aЇq9\xa9\xb3\xaa\x02\xe3\xed\v\x13\xb2\xd2!+B\xcd\x14\x9b\xa6\v:\xdbM\xf4~H\xf5<#\x96\x03\xbf\xa4uܙ\xec\x82.t\x0f\xa0\x05\x1bC\xea:M\xa7&\xab9d\xa5w^\xba\x8a\xb3\xba\xa0\xd3FVK\xba`S\xa8낖\xfd\xfe\x8a\x18\xb9眭\xe8\x8c\r\xe9\x05\x9b\xb2r|1\xbe`ߊ\xf4\x82\x90\xd9\xee.\xae\xd9\v6\xa4\v6\x1f/\xc6\v\x88Y\x10rab\xc6\xc3\xfb\xb3\xbd\x8b1\x99B\xf8\x94Й\xf5\xaf=\xbc\x7f\xb17\x1b\x939\x84\xcf\t\xbd\xb0\xe1\x90\xc0\f\x1fc\xf3\xf5\xda/c\f\b\xd0`\xdd\xf9g\xb26Ym\xa6\x81)\x9c~\xb6;I\xd9\xef?\x11)\"\xed\xd2)\xde\xe8L\xc4\xca\xed\x1eK\x93dIW\x90dH6\xfe\xbe\x93h\x8a\t\x06iRU\xa9 \x99\x15\x00y\ta\xbdEB\x18\x01A\x97\x00\xdc\\\x94xoҦX\x046\xb8s\xf6\x93t@\x00\xdfK\xa0\u05cay\xfa\xab$\xe7le\x00{\xcfY\xad\xe9\xddK\x96k\x05\x99\xb4du\xb7'\xdd\xf2vO\xbau˝\xa4\xa9J\xbf\x9f\x9e\xb3R\xb8k\xcf9\x06\x9c\xa3\x95%\x99\xfc ӂ\x9e\xe3<\xca\xd2\xcb~\xff2崦\x82Ц\nIz\xc9\xea\xd8\xfd\x13H\xe9\x06\u07bbe\xbf\x1f8\xd5\xd3e\xa3\vŚ\xdapZ[O\x82\xf4\xb2\xd1紅\xc6\b=vI\x029\xe8eK\x0e\xda\xef\xa7g\x82]\xa2\x1c\xd3\n1I7\xe1\xf6X\xb0+\x90,v\x834\xea\xe3\x13ğ\xc3\xf84\x0f(\xa3\x0e\xc2\xcaQD(8\xa5\xefa\xa2AG\x86y4\xaf\xd6@\xf6]\v\x12\xa4\xe8\xa4R]^Z\xd6\vG\xe3#\t˷A\x067ٮ\xda\xee\xf7\x8cu1i\xc7\x11\x85\xd9\xe0*gg\xac\xcd4\xde\xfa\x85\x113\xb4>2\xdcZ\xfb\xdd\xe6̪H\xe02\xf8JNPm\x13\xbd\x83v\x95\xd7P\x8f\xee\xf7\xf7\xf7\xb5p\xd9hdv|\xa6\x9bF\xc6g\xfd~\xfa\x04,\x88>T\t\xf2-\xb4\xeex\xbf\x9f~\x05\x8e^:\xbe\xd9a\xeclҮ\x03c\xec\xac\xdf\xff\n4ˮ\x99\x90)\x9cHJz+ؔKV\x91\xad\x86\xb0\xa0\x9c9$\xa8}x\tr\\A\xcfB\xe9\xed\x19\xee\xe2\x1fezf\x98;]D\xc7Y@t\\n\b\xbd\x9e\x9c\xa1\n(\xbb\xce\xec)|\xcd~\x96\x1a\xd40\xb5q@\xf2\xa4\xd7\xec[9\x89\x15\xa0\xb6\xcf\x18\x1cw\xeb\xbd_\x02g\xa4q\xb52q\a\xfb\xa8\x16\x8b\xca\xee\x1a\xd6:\x05m\xdc!\xfdd\\\x9c\ab\x88\xcc9\xb7PX/8\xbf>\xc9~\xff\x9d\xd4_6\u074bk@\xdf\rΊ\xac\xe5\x81\xec+k\xa2ܬ\xb6&\xa1v\x1e\xc9~\xdfh\x02\x03\x02)\f\x17\x95\x92)ɬ\x154*\xcbR\xaeɑ\xe6%\xd2P\xe6\x1d5\x1a\xb7/\x99;\xa9\x1a\x18\x8du\x006\xd6\xfa\xec\xf0d\x14\xda\xd1#\xabI\xd0\xef\xdb\x04Ɖ+\x18+\xf4\xfb\xa3\xfb\xfa)V\xd1\xea\xe9@\rÌ\x9dl#\xbat\xa1m\x92M\xab\xb6[F6X\x15ʬ\n=\x14z\x80L\xdf#\x8a\x88\xd1=\x10\xb4!\x84\ngpegp#\r5w`\xa3\x1e\xbamnW\rM\x84\xca\xcd\xde\xcd\x1f\xb0\xe7i\x97g\x8e\xbd\xf4H\xc4ޝn,\xe39\xe3.\xa3Lш\x03\x9d\xc9@\x13\xeb\x99hbM)\x7fY\xa1\x02n)fMq\x87\xcc\xceќ\xdd\xdfK\xee\xa0mrx)ɱ#rCo\xa49\xfb\xc4m/\x8a\xc1Jh\x1cB\xac{\x8e\x98\aq:\xa5\xd3\xd9\x1b\x8bID(g<Ƣ\x0eqn\xbe\x15\x01\xdej\vO{V\xdd\x04_\x1bD\xff~\x1f\x88m\r\xd3\xef\xd4\xef4\t\x16\xe0\xe7D\f<\xdbM9S\x81\xe1\xb9fؘ\xb6\xcb~_\x02\x13ol\x99q\x12\xa5\x13\x8e\x90C\x91@\xcb\x1b\x89&\xd74\x9fO\x1f~w4\x99\x10\xf4\xea\n\xef-+Z\x99\x9beZB\x7fI\x9aC\x7f\x15a\xbfJZҚ\x90\xacZ\xaf;\x93ڮ5\xe9\b\x95\x0en\x7f\x03̥\xc2\xcd\xe7\xedz2E\xe8\xc4\xf4\x93\xb0.\\\xde\x19e\xa9?EC\xd9\xfb\xe7\xee\xfd\xd6x\x11ѧ\xbb^\x1cY@\x19$\x99\xdb@Q\xf9\xcbZ\r\xec\xa8A\xbeR\xd5\x13\xa0`6m\b\x81\xaf\"\x05\xc3\xd8\xf1\b\x1cwK\xa3&\x8bo\xa2\xaa\xa7\xb2Xz\xa7%mm<\xe7\xdf\xca\xd3p]\x91\r\x93\x85\xed\xfe\xa4\x02\xe7џI\xb15\xc1@\xbb\xad\xc2N\xff\xa5S\xf9\xb7\xe6\b\xe9S\xad\xd4\xc4?ZW\xb5\xdfw~2-y.\xedG\xe1\x8b\xf9\xcc\x0f\xe7\x0f\xb8\xdeFMWJ\xc1Yϒ$;\x8e\xe2=o\x823\xedP_;R\x8e?\n\xf7\xb7\x1f\xbd\xfe\xac\xfe\x92\x8f\xe1\xd3H\x1d\xd5BZ\xd8R\x10\xa1D+\t\xa2\xb2\xa8^O\x9b\xb6~\xedw\x989g\x1a\xab\xa0\xa8V\xb5\xc93\xc0e\x1e\x8e\xb9\xbe\x9f\u074bZ\xe2\x01\xd4`{\x86\x12\x93\xaf`\xfa\x00-\xf5Վ{\x9a$\xccz\xf0\x1d\x06\xaa\xcb=>V{{H\xf4%_\x7fe\x1cթ\xdd\xddMG]\"`\x7f(\xf4\xef\u0080\xb2\xfd&\xd8\x17\x88\xdf\xe8O \xb7\xd3{Փ\xe2\x8c˯\x92\xdd\xdf\x04\xfdՇ\x82\xe0\xb1֡\xbcr\xa1\x0e9Y\xc7(\x1f\x83\x12\x0f\xfd\x81\x9f\x0e\xb2\n\xb4HO~\x12\xa7Z\x82\xeb\x90\xcfB\x0f\x04\x9e\xbf>\x96\xd6\xf7\x80\x04\x06\xcdz-\xe1S\xe7\xf1\xd8\xef\x99n\xb5\xe0bs\xd7d\xe9֑\xd41\x06@\rG6<\xb80C\xac\x97\xad\x92\x1c\x9bd^%V¼\x94$\xe4\xffwzU@\x8f_Nw\x99\xeb|\xc1M5\xf0\x98\xd6kw\xa8h\x0e\x8ey\x1c\x1d\xf8g\xf7蜠ج\xd1\xde\xc5z\xa4\xe0\xde#\x05\x0f=RD~\xc3b\xf8\xb1\x83p\xf1\xe4U\b\x00q\xf2+T2nI\x11\x8d\x9a\xaa\x1c\x9a\xac\x93\xfd)\x03r\xa2\xaaS\xab.a\x01*\xea\n \xcbʊ\xed\x05J\xb1\xab\xa0\xcc\x1bCvd< 8\xa6\x98`\xf8MY\xa1\xdf{\x93\x84\xd5\xd5IY\x9dR\xfdc\\HT{{Ak\x96\x95\xde\xd2\xcbjwצs\xdf{}\x05\xe3\x90u^\x01H\xefE\xc5VU:\xaf\b]\xe0\x13\xf0@\xce+6\xaf|\x85/\xab\x18\x10\x11m\x1a\xcc\xcd\x16V:\x02\x18\xee8(\xa0y5\xb6\x9a\x1d\xf1\x19\x0e\x14\x8bY O\x05N\xdb\xf2\xb9q\xdc\xf3N,\xf2\xfa\x03\xd7^t\x8d\r~\xb8\x1bl\xfd\xf0y\xeb3,\xbc\xa2\xb9u\"_i\xd4\xe0\xfc\xa4:e\xeaď\x1f\xb2\xc4xXI\xf2\x97j\a\x1d\xfaŕb9\xa1\xb9\x1f\xa8\xebp\xda\x05\xfb\xfd4\xf8\x04{6\x18ܳ*%7\xd3*]T\x84N\xab\xf4\"t\x0fwU\x05\x02\xb7\x8b\xca\x0e4pɪ\xa6\xce\xcb\x11\xe8\xbc,!\x03\x90{/!C\x1a\x1a\xe2<\xae\x9a\xfa9\xd1(r\xa6\xdaդ\x1d^\xc7\x04\x88\xe4\xc2>\xf0ۊ#\x11ah\x04k\xa5L\x91͵\x93b<o9J\x1aޣ?\xa0\xd2C\xf2\xce \xd0\x00\xd1\x16y\xa2\xd7 u\x0eH=Z\xe5\xac1\xea\xfd\xfe\xf6\x81\xe4\xf2<\x1e\xc8\xf5z^\xc1\xfa\xf0\x9dLu_rۗ\v\x17\x83@e^\x17\xfe\xf6~\xddi\xf9\xf79:\x86F\xc1\xa5\xd4\f\xc9yE\xa8\xf8\xf2\xaa2N\xa3\xe9\xe2*J2\x13\xee\x06\x1f\xf5H58\x18}`~?T\xb7A\t>\xac\xd8-\x18\xf7\xf4e\x14\xad\xe5M.\xf2\x8f\xf8ۋjU\xce~+x9\xa3O\xe32\xf9\x9f+^\xabWy!\x14}\x11E\x89ꊾ\x8dB`\n\xe9n\xb7u|\xc6/yI_E\xa9\x9e.\x16|V\xe4\xca{\xd3x]}\x16T\xf1yŶc\xf6?\x8a\"\x9fUW.\xe6Y\\\xf2\xac\xf4\x85~\x8b[\xef\x13\xab\xe8\xb1\xc3\xd8\xd3j\xf2\xb4\x8aQ\x8f>\x99\x81xg~\xff\xac\x80\x0f\xf1s\xc5^T)\xa1_Ul\xc4\x0f\xbf\xf9\xb9\x9a\xbc\x88\xbe3\xb3\x1c\xd2\xec\xfd\\\x05\x96\xb4\xbfT\xa9\xbbY\xbc\xadRb\xb4U^U\xf6\x96\x7f|\xace\x98\xaf}\xc8=\x1d\xf2܇\xdc\xd5!\x8f|ȑ\x0ey\xe6C\xee8\x06M\xf3荝\xba~_\xb5\x1d\xf0\xf7\x8e\x8fm>\xaf*\x9d\xf3\xb1C.}mC\xeeڐ\xe76\xe4Ȇ<\xb2!\x0eh\xf5Y\xf5\x85\xf5\xf9\xa1j\xd8\xfba\x05\xe9\x87ʘ_:\x8a\xbbj\xf8\x8b\xb6)\x1fVN\x9f\xc1\x11\xd0U\x1a\x82\x00\xbe\xab,\xf0ֻjl\a\xf6%|\xbc\xf9{\x15\x1aX\xfe\xdd|\xb7\xf3g\xe5\b\xb8O\x15\xb9\x81I`aƴ\x12\xc5%\x92&\x9f\xaa\xf1\x0fUz|\x1c\xf9z\xd1Fu\xb1\x8bRs\x8a#\xa8\xfe\xac\xba\x91L\xa6 \xd3\xd0\xf7}\xe7\xb1c\x03\x88\af\x02\x1ac*\xd0e\xc4\xees\xb5\xe9\xf7\xd3O\x15\xfb\xe4\x80\xf5v\x019\xfba\x95\xbe\xaa\xe8w\x15\x90@V\xab\x03g\xeeF\xbbK\xfc\xadb\x8f\a\xaf\x91|\xd6\xcb\xf5[\xc8\xfda%\xe6Ź\x9f\xac?U\x8e\x81\x87FV\x0e\x82\xabZ\xd6\r\xbf\xd8J\xc32(\xcd\xfc\x88\x12z\x12\xedD\x82/f\xf8A\x1d\xcb\x00/6\xb6\x86F\x12Hk\xd0\xf1\\\x8f\x8e2\xbf2o\x82 \xe6\xb0\xdcr\xa6rf\xd2\xfa\xe1\xabrO5\xfe\xea\x0e\x84\xf1\xb4J\x7f\xad\x88qW;xoh\xa8\xc1{\x13o\xfc\xe7\xfbl\xf2ܳ\x9e\x9aL&\xd9t3\x98\x1a\xfaA\xc3\xd6*\x82\xf4S\xc0\xee\x91\xebu*;\x92h^J\x18\xb3fʘZǁ\xd4\xdf)\xd2Fz2\x0e\x98G\xbe\x01\x85i\x00\xcf\x19\xa7\xba\xaf\xb03Co\x8e3\x8e\xf8\xbbbZ\xf0\xdakxY\xc3O\xddE\xda\xec\xd6xB\xaaQ\x13,}V\xa0\xa2[\x9c\xb0i\x9f]\xe7n\"\xc9\\\x8bpwFZk\x0e\xb6]\x04\x98m3,\xfa}\a\xea|`\xb4\xea$\xb4@1\x1fN\xa8b7f\x043N\xab\xb3\x9a\xcbK>\xfb\xb6Pu\xa6(\\\xbf5ނ\xf54\xa2\xf2p0x\xde8\xee\x0f\x86@\x97\xa9\x9c)\xca\xf3\xa8O\xd8\r6:\x1bҰ\xa1\x99\xa2\xc6u3\xf2A\x91\x05\x8ec\x06Y\xe4x\xfdg\x0e\x8c\x88\xc7S\f\xe7z\x99G\xa6z+\x9c\xb1|\xa0\xe52?\xad\xf8\x8a\xb3\x1b\xf0\xa8\xae!T\x1a>6uU\xbe\xcdk\x03\xe1\x9f\xe9\xebH\xde\x0e\x03\xb7\xfe|\x96\xdd\x18\bf\xd3'|>\xe7Se\xeb\xedo>v\xba\xb0\xa8\"T\x85oF\x98\xa8\xb6W\xd5=\xb7\xaa\xc9\a\x8d\x90f\x9d\xf9 \x0e\xb0\xf5\xe7\x03\xfd\xe0j\xce\a\xe6)\xe4\xf1-\xf3\xf0\x04\xb9q\x90\xd1\xc0j\xce\x05\xcf\x14U\xf99b\xf8]\x83\xea\xbc\ue869\xa1\x8b\xf4\x9b\x9f8>۹\x9f\xc4\xe1\xc2\t\xdaO잀\x11\xba\xaa\xc4\xc2^\x8f\xed\x060Qf^d\xa9y\x92\xf8C\xa5\tG\xa3?\xfd\x11SA\x05.\xf2\xf8\x06\x18\x8e\x8dh\xeeC^\xa1W2\xf4/*:jj\x8e>\xb3\xa7\x06\r\x93L6ǈ\x90\x9bYu\xa3u:\x83>\x95\x1e\x92[\xf7\xae\xc4\xdd\x01\xbb\x18]\x93\xb9n\x96\x03\xf3\xe4\xfbZ\x0e\xecc\xd0㶣\xf2I\xc5rVd93\v\xa9@\xee/<6\x0f\xca\xf8\x13\xe5?1;(\x86\xdae(\xc3i*n\x99\xa6UsZ\xe6v\x1e\x8a\xe6<\x14n\x1eR\xe3\xa4-\\\x16\xd2y\xd7J9\x93\x8d\xb9M&\xad\xaef*\xe3\xa6\xfe\xb4\x99<<\x98fy\xac\x1b\x9dǓb\xac7\x17\x8b\x89\xdc\\s5˛ˬd\xb9i\x99\x9b\xb5\x01۟\xdc4c\x99\xf3G\xbeb%\x9d\xb2\x15\xd6z\xac\x7f\xfc\x19\xc3@\xb7\xac`Ӭ\xd6\x11SZ\xb3\x15~\xb6\xdc2m\r\xf4ɜ\xa5K\xb6\x8c&n\xa3\xca\xe3\xb9\xf5\x93\xa0˙O\x96\xadޜfs[\xee\xb2ٛ+\xad\tf\x8a-\x88Q\xabɃiQ\xb3!]\xb2)ӊM\xa0\xeb\\\x82\xdc!\x17\xda;\xd2\x05+\xfc\x12@\n@\xf4Kb\xb4v\x9d\xf2\n\xb6\x03+\x11\xac\x9d\v\xbdb\x86\xb8X\x8ah\xb1\x14\xed\xc5Rt-\x16b\xd5og\x8c\xd3\x05+\xacd\xb4d\x8a^0I\x17ڷ\xb3Q\x80\x8f|\xff;\xbc\xa2\x19[\xd8\xd2\b\xb9\x99\xb3\x19\x16\x94^\xd09\xb5j\x18\xc0\x8df\xb3H\x8d\xa0w\x90ʹKj\xb6w8<\xbe\xdb7o\xeb\xa3ñ\xd1\xc0w\a-(\xd7\xdd^\xee$*4\x9by\r\u0e66,úX\x1f\b0\xbf\x87\x1b\xeb\x9a\xce\xf7\x0f\xf2\xeaue\xd8\xc1\xbe\x9d\x82\xe8;\xd8,S2q\x8f\xec\xa48\xcdJ-=*\xac;Ƌ\x8ea*\xff\x1b\xc3d발\xa4S\xb6d\x17t\xc5\xe6$ss\xe2\x82\xd6kV\x8e#\xc5\xf0\x02\xa3H\xa4.\xde^\x9f\xc4j\xbf\xb3\x12\xd3\xd32X{\xcd\xf5\xcdJ\xda\xfc^S\xccv{Z\xa2\xfeޜ\xd0`\xfe\xb3\x15\xcd\xdbK\xaa\x9d\xf7\x92\xbe\xab\u05ec\xa6\x86:ħ\x88Za\xf3\x10X#\x0fxe\x9c);\x1a\xd4=E$\xaa\xf6\x98\x0eR\x0e\xe5M\xb6Th\xb2\xa5\xb4ɖ\xed\xfb`/ў\x03|\x94ɘ\xc9.~Y\x8bQw<\x02\x99\xee\xb8\xd2\xd3\x13\xc0A\x90n;\xcfY\nlf1@\xb5\x13\xc1\x85\"\x03\xc9\xe7u\xc0\xb0\rvgiT\n\xe1`M\xb5WԆ\xb7\xf4\x89\xca\xf4\rJ\x1b\xfdG\xb1LR\r\xf9\xab\xe9\xee~|\xbc\x04Ce\x98H\xd79\xbb)\xea\xe7\xd5\nq\x92\xdb`v\bv\xc9\a1\v\v\xa8~t\x89\x0e%m(\x17\x7fB\xe6o\xb8\xd2\aeӦ\xa0#\x03\xc3p\x9e\x97)\xa1\x15\xbb(\xe12\x9e\xb3e\x8eN\x9bƹ]*\xee\x06\x03\x17\x98\xdc\x0f\x8b$\x14I\xac\x9c\xd0Y\x99rZ\x01\xf7\xd0\xd6\xe35_\x96\xf9\x94\xff\xdb\xea\xa2\xf2s6\xa2\xff\xbd:=\xa9\xe4Ԓ\x06\xb1\xc6˶\nI]!a*TA\x85\xd0\xf9\x05j%\xb0}S\t4\x8e\xf2\x95P\xa6\x12\x95\xa9\x84@/\n~\xa2\x9d\xe5\x91-f\xe1\xa4\xc9\xed-\xb7\xc1i\xd7\\?7\x83u[&\xbc;<\x15\x98y\xb6\x13\x80*\xad\xd7\xe1\x1b\x18\a\xae$\xd7|\x05\xfb1`\xe4\xcc\x056s\xbd\x86\xa7\n\xf8\xc4\x01\xd7<\x8f9\xb2;#Z\xb1yEs\x8b\x0e\xa3\xb9\xdb\xe3n\xb0\xbd^\xeeu9&9\xab\xf34'YZ\xb1\xeb*Udr^e\x01\x8b8G2\x18\x13\xa7\"ν&d\x82\x02\x96\x8ad \x85\xd1\xe0\x1c\n\x15\x11Z\xab\xd1\xc9\xf8\xb0'\x03\x13=\x1321\xbf\xfa*aoH\x92]\xe74\xe8}\xa6\xa8jN\x12\xc6\xe9\x7fW R\xfdU\x81H@O>\x0ev,n[C;\xa4\xefj0\xb5\xc3\xfbKQ\x96\xaf\xf9\x94\x17\x97\x1c\xd9=\xfd\xfe-\x918\v\xba3|\xf7\xe2̓'\x8f\xdfߚ\xef\xe7\xd2\xe8\xecM\xbd5\x9b\xe1:\x1ft\xec \xa9\xb2\xa9h\x83S\xf11o\x9a\x1b\x86\x12\x82\nA\xe5j&\xa9Q+j\xee\xe3\xb4\xc2C\x80\x9d\xe7\x14\xaf\xf2c\xab\x83\x13\xce\xe4[\xe7pe\x93ڹ\x9cw\xcee\x9f\f\xa7mN\b\xc5ۀ\xc4-jk\xf5:\xb6\x04\xa8\xdd9W\x8f\xb8,.M\xb2'\xb2Zh\xde\x1d\xa8\v\xeb\x1eɩܚ\xed\x96!ݖ\xebzݑ\xbc\x82\xe4oD\xbe\xac/*\xa5\xf5\xd1\xf4\xb6\xb3^w\x1dӝs\x01O\xbc~\xbf3};!X\xa1Z\xf50\xdaY\xa3\xae̻BS\xd2\xfd\xfd-u\xbc%2%\x14d\x86\x95\xdd^\xb6L\xe1\x8aV\xe1\x14\xfe\x92\xe1'\x9fi\xe6\xa3bf\xaa\xe7\xe9\xe7CMR\xbc\xcf\xd9\x03)\xf3\xebAQ\xe3\xaf?}ބ\x94\x9cg\x8cHX\b\xa4s0\xc0\x80\xa3\x05\xaa\x87\x9f\xcb\xc1{\xb4l\xd1/,z\x1d\xed\x18_\xe7-\x96ݱ\x81\x0f\x10,\xf0\x82\xbe\xe9\x94\xe6\x1dޥܤ\xaeX\x92\xec:Ǎ\xde˽}\x82\xfa\xf7\xfb\x1d\x1dfb\xf0g\xf0^\xeb`\xbd\xe6s4\xcf\xc1\xc0,U,$\xba4\x03Zh\xf2P1\xc6\xces\x8d\xff\x84!\xecf\xe3\xfc \xf3\x89q&\v\"\xf2\f\xfe\x00)\xa6\xc2R*\x10\x82\xa0\xed\x03\x06\x85=\x18\xb7u\xff\xde!!Z'\xc0vc#\xc1\xf1\x10]\xc0\xb5\xf5\x8d\x1exN\x97\xd7H\xdbq\x18g\x8d\xfe\x1f\xd1\xe4D\x0f\xa7Aq<M\x18k\xc3/Z\xa5\x1f\x03TH&f\x0e\xf4\xae\nu\x01H\x1fu\xef&ٍ!\x11\a\x7fT\x85H\x13\xdaK\xc8n\xb2I2\x15\x12\x0e\x1f\xf2\xb6\xf7\x1d\xac3\xb7\xa4\x84\xc2;\xcac\xbcOXƽ\x98\x18\x94@\x1d\xcc$\rS1I2\xa5o;& \x8e5<:\xf3\xaa%\x12\xe6\x12|/\xb8\xddH<\x89Pr\x14\xabw\x86\"\x041&\n\xd3Q\xc1Ġ6\x1a]݊<^\x00\xc1\xadc_\x9b\x8d\x1a\x13\xfb\x04\xdd\x06\xd4\x1bW\x1a\"\x19\xfd\x0e\x9aW\xc4N\x06\xf9\f4\xa9QX0\xf4\x91\xf0-\xe5\xec\xfb\x12\x03\x88\xfe\x9e\r)\xb7\x1f\xb7\x00\x1c\xf2\xd4^v\xac6\xb4\xfeFPn-\f\x91\xec\xf2\x96B\x13dKb*r_NRe\xbar\x1ft\xc8E\x16\xbfK_R\x1d\xf8`\xe7\xce\xcdx\x90s\xbfￍ(\x9c2\xb8\x92\xf9\x8e\xd6:xG\u0605\xa0씦\x8a\xfd\xbdL%P~\xd5\f\xedk\x8cP\x85Agd\x10_\xe1t\x8b\xc2}1\xab\xceb\xf4\xfe\xa2\x06\\\xdb\xd7\x01-\x80F\x11\xda9J*t\x9e\x9a\xc4\xc0\x9c\xe7\xccm\xaeT\xf8\x92\x04\xd4@\xb0\x1f\xcaT\x7fKѮ\xc2~\xa95\xb6\xa3\x9ao\xcd\xc7\xd7xzK\xc7\x1cڎ\x01\xc5\xf8-\xe6\xf1Z\xb3-\f\x89\x13\x17\x8b\xa5n5\xe2\x86`\xea8\b;\xfd\xb7/\xe9\xf4@\x8f\xf5\xe4t\xdb\b,ݕ(\xefh\xd1\xddh\xa8\x7f\fK\xa5y\x9c\xe5\xe7\a{\x1e\x9c\x7fm\x95\xdc.M\\\xb3%\xe8Y\x96\x80\xe3ySz\\F\x88>\x1bJ\xc5\xec\\\xf2\xfe\v\a_}\xa5\xa3\r\xa7\uf8f5ސ0K\x94\x9e%fK\xe8\x98%2\x9c%\x18\xa1\b\x95\xbe&R3\xdc\xdeg\xae\u07bf\x95\xe9\x96J\xc3\xd9\xf4>GŠ?S\a\xa5\xab{\xd9\x7fB\xb5\x8dh\xd8Xs\xe8tk2^4\xa9r\xdb\a\x13lUf\xe5\x17\xad\xfe\x97\x1d\xfd/I\xbc(\xb5\x9az\xa6\xf7\x86$ٵ\xee([}\x1fhq\xba\xbe\x97\xdb\xfa\xbe\x87\xab\x12\xa9\x02\xbdL\x19co&f^\xeaAp3\x19u\xf63\xbfkd\xce\x18\xa4\xf7\xbe\x9d\xdd4Ngz\\b\x8fK\xd2\xdd8\xbf\x1e\xb4q\x1d\xf6\xb6\xdc\xd2۳В\xa0\xabW\xbb\xf4˝Ob\x1cg\xc6\x11\vBZǑI\xb2\v\xb9uv\xab\b\x00El\xb7\x8a\xad\xddj\xb36\xabY\xe0\xa1'3\xfc\xb5\xa5\x89\xb0\xc3\x15\xe5T4;\xbc\xa2\xfa\x83l\xa5\xe3\xa1nq\x7fO\x83f\xdcZ\x16\x18\xf7\xe9!\x108\x04\xce\x05fo\xd9\xd5\x13X\xb6\x1d\x03\x05\x9bp\xe7\x18,Ҋ\xa2E\xacW\xb4\xd0\x02\b\xaamg\xe9\x92\x15\xc0\xf0gCz\x1e\x19\xd3.\xfb\xfd\xc5}\x8b\x1f4^\x00\x8ft\xa9\xcf\xd8o\x16\x93\xf4\x9c-\x8d\xc53\xc9\xce\xd9\xd2Q\x02\xdav\xf5\"\xad\xe8\x92\xd6'\x8bS\xe0\xb6{\xc6\xf3%\xb9\t8\xc4KvnX\xf1\x1b\xde\xef/\xdd\x01|\x19\x1e\xc0\n\xb2\"\xb4`yzI\v\xbap4\xeet\xb2b\x97\xd9Ԗ\xcc.\xe9\x94]\xd2%;\x87N\\\xa0-IlU%uN\xab\xa0>K\xa3\x8c\xd1h\xa8\xa52\x96l\x9eV\xb6\x19p\x87\x85Z,[\xb5X\x06\xb5X\xd2)[:\x85\x94\x15b\xb6,\x99\xc0\xa2\xb7\x15s\xcef\xe9\x92Vt\x11\x16\xc5\xddd>\x0f\xbbc9Д\xbdm\xc19N\xa5E\x86\xbf\xba\x9b\xce[\x15<\x0f*xN\xa7,\xb0\xfe\x81\x1c\xdbhT^\xbf\x12\xba\x9f\x83\t\x16]\x058\xd0ie\x91\xda40ğ\xa9\x1e\xe6\x8eKڴyu\xba3$\xc1\x94HK6Ք|IZ\xaa\xa1wF\xc4㬁\xe8\f'\xec\x82\xd5\xf4\x9c\x814\xedR\a\\\x1b\x99E\xea4\xe0\x17\xfd\xfe\xce\xf5`V\t>>\xdf\xdd\r\x12\x90\x9b\x85\x99\xc3\xe7\x93\xf4\x92-\x8ce=\xc9.\xd9\"\x9a\xc3g8\x87\x17\xf4Z[u\xd2U8\x8d\xcf\xdc4\x060\x8a\x05\xbb\f\xa6\xf1\xc2M\xe3\xb3\xe64^\x10\x90\x95\xa6g\xb4\xa6\xe7\xc4Kr\xa6\xec,s\v\x88\x9d\xd1%;\xa3\vv\t\xd3X\xb7!\x9c\xc0\vB\xa7AM\x16f\x02oi\xad\xb7\x0e\x85\x89\xecۢ\x01!\xf2\xf4\xbaU\x95\xeb\xa0*\xd7tɮ\xddT\x99\xe2\\^\xe0\\^\x90ϗ8K\x17\xb4\xa2\xe7\x8dR\xfd\xb4\xbe\x0e\xbbgј\xd6\xd78\xadϳk=\xad\xffj]1\xc7/\x9b\xd6S\xbbm\xfaT@\xbc\xc1~\xa9\xf7\xca۸l\xfd~\xeeN\b7\xee9\xd4y\xbcB|\xb4\xbcq^X|\xc2\xdb2\x85\xe1\x9d\x12s~\xe5\xed\xf3\xcb`6LuAt\xe5\x91TV\xda\xf0ceN\xf8)\xbe\xdde\x16T\xe3\x06}\x11\xdb\xfa\x92\x1b\x99r\xba\xb2=H(^\x1cV\xb4U债\xe2L89\xaest\xbcj\\Dt\x11\x9f\xc9>\xa4\x14W4\x8f\xee\x13a)\x98\x89]^\n_芹l7\xbe=x\xf3\xf9\xb1L\x9b\xf5\xb7\xb4\"\xc8,a2\xc5\xcd\x01\xba\xbc\x04\xe2Vg\xa4\xd3\xd8JF\xc4m\x19V\x19\xc8{Z\x869\x95\xee\xfc\xadS\xeeh\x003V+3)\xfc\x8d]\x8b\x12\xf58i\xc0\xcbC$\f\xb4\xf9\xe3\xb6\xdb\x11\xf6n\x14\x12'\x8e\xafB\x98:\x0eң\"\x1a\xa3\"h\xbe\xf52\xd4\x1c\f\x11\x0eF\xccsؤ\x02\b\xfa<\xee1\x9fO\xd8A\x9b.r0\xef \as\xbb\xfb\xe5\xc0g\xcb\x03\x80\xc1#\xdba\x93tK\xa3\xdac\xad[\x00\t\xfe~KE\t\xad\r\xfa\xd8\xfb<\xcd\x1d\x19\xb6p[\x03D\xfd\x19ĜG1\xd3~\xff\x81\x11\xf69\x9d\xd5\x1c0y\xec\xa2\xe6\x912\x05\xfe\xec\xefgF\xd9A\x87\x9a\xe0ѝ\xacy&\xee\xd3\x1fR\xef$\xc0\x89\xc7\x12ρӍ\xd4\xf2\xe5\x879\xfb\x90\x83N0}\xa9\x9fF\x84\xfe\x01\xd64\xf4i\xceVU\xfaGN\xe8\v\xf7\xf4\xd6>y\xb6\xec\xabܺ\"d\x8c\xfd\xd1\xd4s\x1c\xdd=\xf4\xb6\x1a\x01\x0f\xe7u\x1eپ.\xab\xf4mn\fT^\xe4ƺ\xe2iN\xa1L\x1ex\xb7\xb0Z\xe3\xae\x13\x14K\x15\xf3\xde\n\x1c\xa2\x90\x1a\x88|\xc1\xd1%\xe7\xbb\xd7O\xb3\x99>8h\x92\x90\x06.\xa3b3\x9e*\x96r\x86\xf6\x8c\x93\xd0sF\xa6H\x94\x8d\xf5M\xaf\x8d\xcf\x10oe3\x85z\xdaꆷ\xf5\xe7\xb96\xe0\x81\xd8)\xb4\n\x7f\xde桭:vݫ<}\x9b;#\x92\xb1f\xe2\xbe\xcaӧ>\x90J\xac\xa5\xd1$&ce\xb4r\x1b\xbd%C\xae\xe53\xcc\xfc\x85\xcb\xc4\xe8QF5\xd2,\xf7oqP\x87\xc1\x98>ɭ\x91\xa9\xae\r\x0fx\x807\xd6ݽғ\xd4(\x98\xc7\x02\x80@+Bz\x9d)\xe4\xb4ϸqq?#\xde \x14\x8dF\xbd\xa5(\xbe\x12\xef\xbaʞ$\xa3c[n$\f\xb5E\xa3\xd0g \xf9%\xcf˗rf\xf8\xf9\xa0I|t\xd87|\xba\x8e\\cSJrc\x1e\xec\x8aW\xc8\xc9\xc4 \x87\x8b\xb8A\x13M\xc6\f\b\x91g\xb7b\xfd,\x9d\x18\xaa\xd4(\x93\xddzm\x9fZ&\xf9\xca%ڸ<\\%\xccC\xc8Um\x19\xc2~2\xea\xd6\xef\xcc\uf7f1\xce\xefϑZ\xe7Wez\x87:\xd5\x17\xc0s\x97\xd19\x9d<z\xfc\xec\xf1\xdbǏ\x12j\xb8\na@(\\\x0e\xf97\x96E\xed\xd4i\x02\xa6\xf6$\r\xdfb\xd68o\xb0\xc6y\xc4\x1a\x8fc\x03\x9b\xf6x\x13\tw\xcd;Yh\xbe\xd8\x10\xbe\xa4\x8a\xa1R\xb8\xdbV4\x1cY\x80\x95\xe4b\xdb@N\x06\n\x83\x18\xc7\xe9\xbe\x1fv\x86\xe6T?\xcaZ\xc5%\xc6S\x98օ2\xc2Ƀ\xa8\x0e\xb7\xe6{\x8b\x87\xc0_\xec\xfe\xfbgn%@\xefrmkl\xd7\xe6\x18\xe1\xb0uga\xd2\x1d`\x93\x89TFf\xe3\xa05a\x139Er\xa3w7\x1a\xee\xdf雷\xf5\xbe\x9eXZ\x01\xf5S\xce8\x19\xff\x9c\xa7\x9f`\xff\xd9\xc0+̿\x1fE\xaa\x02_N\xc4\x1a\x18ܖ\x1f|\x1b\xda\v\xb9M\xc8\xdb\x1a8\xab\x88\x00\xa9\"\xb0\x1e\x0e\x8d\x8a\xc7$\xf8\f\xb3\xf6\x96?\xee\xc8\x02Öܻ\xabB\v\x1c\xfb\xae+\x80u\x1bR\xa3ު\xec\x84\x02\x83\xe4\x1dg\x90\x9c\\\xf0|f\xd1y\xc1\x8a\xdf<\xef|%4/2ܠ\x88Q1{\x97\x8f\u0558\x98EI\x95\xee\xb2p@\xa0\x10]\a\xdco\xb9\xa3\xd1wR\xa7\x1b\x92\xb6e\xaf\x13\x1e\xec\xb1\x1aT\xaf%I\xbbKP\x9f\xd3vnP*\xfd+\x16\xfe\xc6T?0\xe7\xbf\xd1c\xcf\xe3\x86X\xfa\xd0\xd9\xf8\x7f\x95\xe8\x93\x016|\xfb4\xc1\xa7\xf5ښ\xfd\a9l̎f\xee\x14\xefr\xf6)\x9f\xfc(\u0085\x12\x15\xa8ٚm\xe7c?\u0099\x8c_\a\x1b$n\x9d\xdf\xe5\b?\xe9\xec\xb1\xf2ȑ\xd5p\xcc\xef\x7f\x97\x87vU\xdf\xe5'\xfct\xf0\xfe\xaa\x92\x1f\x9e\x8aW\x06\x97\xffg.\xeb\xa2\x12\xc6\xff-\x161v\x9f\xb1\xa16\x88\xca\x1b\x06Q\x8f\x8az\t6Q\\ҟ\xf2\xed\xc6R\xf4\xd7\x1c\x84b\x85\xae\xb82\xbf\xd2\xfc\x8a\x02ՙ\x8ah\xbfϋ\x94\xdc4F~\x7f\x14\xd2\bE\x11Y\x1aľ\xe4b\xfcz\xe5\x00P\x1aX\xf60\x1f\xb5\x0f8t\x00w\xbb\xf7\xb7\xba\b5Ȱ\xe0_s\x96C\xbb\x14U\x1d\xeaO\r[\x10\x13\xa4\x15?\x87\xf47O\xddxOy\xf6)\xcem\xf2\xaa\xc8^\x17\x94\xa3ZdEhU\x90\x9b\x9c\r\xc1(\xae\x98\xa7\xd8st'ݿ\xf3M\xdeZ-C\xe02\xe5\xbbl\x04ݭ\x8a\xad\xf5\nj\xf3ܗdL\b\xaa\x02\xaf4A\x9a\xb7\x05UN\xbcPxi\x8b\xd6\xc7\xd5\xe3\xad\v\xe4\xd1(\xabV\xfd\x86\x9d$vY\xa4\xd6\xfa\xf0&\xea\n\xad.\x16\x98&\xd8Wl\x8b~\xfd\xd3?\x06\x06\x13\xb1|K\x16\x13^4\xc6L\x16\x8cg\xb2`R\xb7\x82q*\v_\xa5U\x91F\x93\xad\xb0\x15\xe4E\xa0\x9d\xef67\xd0\x10l\xd7\xdc\x1c%\xb6\xa3̾\xbc\xbdN\x99\xa9K@\x93*\"q\xc2\x15\x8ckX\xc5\x10%\xa9\xb9WBﶺ0\x85oI\\PХ\xaa\b\xf4\xfa}ך`|1]\xac\x8a\x01>t\xe8p\x7fQ\xff\xba;]\xd0\xcd\xd3\"\xc2\xfa\xe9\xd0,\x99\xa8\x94\x93,\x90W/\v\xaf@\x02\xa3D%S\xbab\x013Q\xb6\xfa\x06V\x866\x1cy\xcdŌK>{\xcdg\xab)\x97\x8c\x1bM\x19U\xa0\xba\xb4ov\xced\x87\xcdG\x1ej\xf5T\x16(\xaa\xd2\x03\xa7\x7f\x8c\xa9\r\xd5?\xac\xde\x04\xb9\xb2\x8a\xe5Tƺ\xe6Q~\x95\xc9\v\xd9\x11nh\xc6\x1a0\xbcf\xb9E\x90\xad\xc6\xc6\xf6h\xcaV\xda\xf4\x02\f-~\xcd\xfbS\x82\f3\x8fL\x95\x96\x86\xa9\xa9\r\xf6\xc09\x02ve\xb6\x1a\xe8\a\xca\xf3s.M\x7fd\xabA\xf8\xaa\xe3\xf4\\Y\r\xfcKhpA\x05\x8b?\xc2\xfbi\x98:㩠\xb68\xe3GVs\xc2u\x95\xa6\xff\xe6*\xd9\x1bN9IkV\xb2%́g\xe2\xfaaI\xb96W\xa9\xd7l\x8aJ\xfa\xd3\xcdʘ\xeaĆT\xab~\x7f\x85\xe3\xe2s̙\xc8L>5\x9d\x8aT4\x8f\x03p\xe6`l@[\a\x05U~LYNU01J\x1a\xcfO\xf3\x85Y6'\x8d\xac\xa8\x1c\xcc\xccy|\x1a\b\xde\xffW-\x0e_\x1a\xad\xfc\xb2@\x8d\xe4m\xd7\xe9\x8a\xdcȶUT\xcd\xec\x04\x87\xf9\x9b3\x9e洶\x13\x03\x1d\xac\x04\x83P뮟\x8a4\xff\v\x9d\x9c;\xcbV\u07fb\xa8\xfe\x12\xf4<\xe9\xec\xec\xdcvvNEЫ\x17E\xac\x8f\xad\x06\x80\xea`h\xa8\xb1`@\x0e\xbf\xaf\xab\x95\x9cr\xabH\xa7n\xa7\xb8\xc2n\x9a\xc0\x9e\xc0\x98\xc84\x85\xbcBX\x86\xd7<\xd7&\xcc4\xe5\fV\xb56B@G\xaa\x9f#\xe6\xe8w\xb9\xb6\xddQ\x88\xbc\xe7e.A-\xf5\f\xf0\t\xa9\x9b\f \xd4\xf2M\x9f\x15-_#u0\x95\x9a\xb6!\a\x87V\xf30\x8f{\t\xe5\x1e\xbe|Z2O_\xd0\x15+\a+34\x91s\x05S\xf3\x8b\"\xad4|\x01H9\xd8\xeadtJ\x97lu2<\x1d\xaf\x98,\xc6\xda.\xae\xa9\xd8{\xc1\xe6\xa8MHg\xec\"Ԥ\x05\xf8灮\xc7x\x0e\x8f\xab\xb3z*\x8b3=\xd5\xcf\x19/\xbcur<\xb1n \xbb\xec\x82\xea\x8f3Eݧ\x99\xd8Pl\x83\xbe\xfaG\x8d\x88\ng\x92^\f\xea\xe0}:\xd6tE\xd8;cC\xa9\xd6\x14LL9\vG\x0ev\x9c%\xc5\xe9?\x85\v\x17\aÉs\xd0omΜ5\xe3\xfd\xca.@\f\x81\xd4\xedt\xb4\x1ap\xa1rq^r\xf7\xa1#\xa9\x05\xf3\xb1\xe8]\x87\x02%2\xbc\xef}\x87\x1c\x8c\xf6\xbeRi\x01\xb2\x8c\xd1\xfd\xfb\xe5X\x9c\x94\xa7k\xc6i\xd1g\xff\\m6\x1bBO$UT\x9c\x92\xad=d\x895\xdfL\xdat\xb1\xc1\x1bc(Yԍ\b8!S\x1et\x94U\x80\xc5\xee\x19wu\x8fhtO\xe0\x9c7\xaa\x9e\x9e\xe2\x1c!\xf2\t\x81\x06\xe9\xe6LE:C\xc0ߩH\x17T釹vx\x9ar\x16Y\x96S\xbb}z\x83\xf4\xc6V\x9bM\v\xdaڑ\xb2冸\x9d\x97Mً\"\xf0\xca\xc3\v`\xa8\xae\xf4\xfe\xce@B\xe5\x0f\x13\xa3\x0f\xe1V\x0e]5\xe6\xf2*\xd8\r\x97\x84.\x035\x8b\xa2\x81\"2+R<K\x9a\x10\"\xe7\xc1a\x03$\xfcx+\x15\xc7\xd1;4OI{\xb3\x0eweN\x11\x03ɜX\xff\x9e\x0e\xe4a\avt_\xeb\\\xe5\xc1\xc6\x7fY\xb4t\x009\xbb\x01\x9bGN\xa7\x92C\xfe\x8a\xcex\xaddu\x9dI:\xe3\xcb:\x13\x1d\xf4q\xaa\xe0\xde\x10\xda\xecNR\xa4\xc6,\xaf\xd0$\x8f\x13\xe1Eӧ1\x8c\x06f\x00\x7f5\x97:L@&\xe1\x9bK\x9e\xa5\xa2aNϩ\x89\x13\x8d\x02H\xa8\xbcz]D\xe0T\x01\"\x1c\x85\xd1n\x8cc\xf0\xe1Y\xe1\xd7\xf4\xaa\x95ҧ\xbbj\x9d-8\x8bxa\x15\xed9\xad\xe2o\xd9e\x91\x8e\xd6\xf0\x89A\xc2tB)\xa1\x99\x92\xa1\xfe\xe6\xe3V\xf6P\x99\xb1`\xad\x8f\xc6M\xf7u\xee\x82K\x8cu\xbf*\xdadNΊ\x81\x19\xfb@\x94W\x14\xa9\xa0\x10\xb1\xf4\f|\xcc\xf8\xb2@e\xe0\x1c\xe4Y_\xd4\xc4<\x86\n\x8bnJ\xd0wwFG\xf4\x90Ɗ\x97\xef\xe3T\x8f\xbbS\xbdi\xa5:\xa4\xfb\x8d4\x0f>\x7f3\xd3K\x9a\xc2\x05\x8d\x86\xdbej<\xbb\x19Do\x9f\xd01\x14x\x94>\xe2\x8cl\xac+\xaf@\xab\xbd\xb9!Is\xed\x06W\xddƕ\xe3\t?\xd5\xe5QӚ\aE俌\x93h\xebz\bSԿ\xbe,By\x03N\x13姉2\foG\n\xc7S!f\xa1{\xcd=\x85\x93AQq2:%\x13q2<\xcd\xd2Ʒ\xec\x84Su\n\xea\x1b\xde=\xe3\xffTU\xf4\x98|\xbeBO\xa3\n\x01X\x17\x02:\xdd\xfbFN\x00\x00+:\xa99B6\x11B!\xc5\xdd\xfbrr|\xb7\x91B\xe7\xf2S>P2\x17\x1a\xd0{\x1c\xbd\xb1\x11\x9e\xe5\x1cŻ*\xf5\x1e\xb8\xe2Tr\x13ai\xbfhP\xe8\r#W}ɬ\xec%SƷK}\x1a\xf8;e\x83\x97D\v\xa6\x9a|\x00\xc6X11\xd7\xfb<K͓\xe1\x87\x15&\x9cP\xf7\x1dˁn\n@Q9c\x8c\x17\x0e\f\xb5\xe8\xf7\v\f!U\xc1\x80y6tܞ\xd8\xcc\xd9\x16\xbe^C\xb8\xb9\xc4zp\xa2\xb40'B\xe3l$\xc4\"rՍ\x04\xfa\xd0+Y\x91\xd6T\"\xe5\x99\xc7W\xf9\x82\xe6\xc1\x1d\x9b\x95@\xf6 \xf2\xb4\x11\x00:\xa2i\xe3́\x91\x7f\xfc\xb6\x00\x829\xb7\x86\x95Y\x9d\xd3U\xed\x90\xf8\xb2\xbc\xc0W\x13\xa9\xdf\xcca\xa8_\x9e.\x96\\檸\xe4\xdf\xe7bVr\x13\xfc,\xbf\xaeV*J\t֜\xe6ю\xa9}\x9b\x9b'=\xb2\xfa\xf9\x11?[\x9d#ސ\v\x98s)\xf9,\f{\xeb\xe6\x9a-Dӏo4\xed\xaf\xc3^.\xf3?W\xfc\xe9\x8c\vU\xcc\vS\xac\x05\xd6+\xea\x17\xfc\xea5\x87\r\xaa(\xb9\xccvF\x1b\xfa\xea\xf6>\x89M\xa6\xad\xf2p\xfb\xb0=ᴵ%\x9cR\xbe\t{Tgm\xfa\xe9cw\x8f6\xadƿd\x7f\xbd\xbae\x7fm\x8dOg\x83\xae\x82\xf3\xc6\r_\x9cRo\x13\x01Q\xd9\xeb\xd8\x04\xe9\xad[\xd8&\x9c\x0e͆\xea]\xa2+\xff\x1d\x06\xcdN\x15\xc9\x14\x15\x8d\x9c\x03~\x1cS\x9aV\x15\xff\"\xad\xca;HU\xf59RU\xb4IU;˯\x83Y~ޜ\xe5\x0f;fy\xdb\x1a\x0f\xc9y\n\b|\xc3S*\x98:\x199HڏE\xcb\xe7\xa1\xfa\x82M\x1c2߲\x7f+\xbc\x18\xf2SB妱\xdcZ7\xbf\xf3B\x1f\x06\x8c\x9f\f]\x95\x80:eO\xa3N\x82\xc3\r2\xa5\xeat\xd3^\xb1\x9f\x9f\x02\xa2\xf3\xba\x7f\x13\\;\xe1\xca\x1f\xbc\xe9\xe3\xc1p\x03x\xc0\r\x90\x1b:\x03:\xd0ܘ:\xb7\x8a\xa0\x99\x81H\x1c\xddLƶ\x92\xbav7Vy2{N\xad\xdd`\xc6)*\xa4\xbe\x9c\x03Lsם\x15p\x9aA(,\xd3Df\xc9n\xfawp\x8f\x1f\x81\x8d\x93\x90\xf5s\x87\xe0\xe9-\xa1\xcb\x15\t&\x80\xf6s\n<~\rL\x9bz\n\x16\b\xcc\xcb\"\xbd\x13\x1d\U000f7537\xb1\xaad\xc6\xe0\x85:\xa8C(\x94m\xfd\x8e\xaa\xcd\xed{\xeb\xeb\xdb\xf7֗\xc5\xd6\xdd\xf1}\xf7\xee\xf8\xa1}\u07bc\xf1\xe7\xcd\x1f\xd1y\xb3t\xe7\xcdY\xb0\x12\xdb<\x8ee\x91N\v\xb2\xf9\x97\x16\xa8\xfe\xb6{\x85\xbe\xffߺBu\xcd\xfc\xea<\x81+\xa0\xc7\xf7\xeeZ\x8e\x8b\xe2s\xab\"걓\xe1\xe9g\x86\xff\xf9\xff\xce\xe1\x9f\x7f\xe1\xf0\xcf\xff\x1b\xc3?\xff?v\xf8\xe7\xff\v\x87\x7f\xfe\x85\xc3\xff\xa8hh$\xbc\x04\xfbn\xfa,V4\xf86\xe0\t\x18M9'\x8f\x9f\xbcD\xe3#\xed\xdcG\x90\xeca\x8e\x1a1\x98\xa8\x81\xdb\xfd\xa4\b\xcd\xc1\xb4\x03\x168\xc9\x1d\xe7[\xf2\xb9\x1d\x9a\x02\xb2\xa9\b\x15,P)\xc81\xc4)\x02<+\x9c\xa5\uf68d\xa8\xa9%dNM-I\xd6\xc0\x95l\xe2P\xe2\xc7}\xb6wgt\xd7\xe2M\xf5\xd9?+\x9aטW\x15ޖ>\xb5u\x1bl],\xc3C\x86\xdah\x1d\xb60\xc5z\xfdK\x99\x16d\xbdvdS\x11a\xdcz?\x0f\b\xa6\x90K\x1e$\x95Q\xd2I\x9a\xf2\xc0tט\x84\xa1\x96\x05\xa84\xe7F[\x1d{\x95\xf2@\xd9\xd1\f \xc7\xceA\xf8\xa2;T[v\xb2\x82\xbeӍ,L#\x9dJqa\xe1\xec\x01@*\xad\xfa9A\x87GE\xac\xfaDS\xc9B\xe4G\xd3\x062\x91\xd9\\\x90\x14n9\x00\xff+\x11\xe4\x00kF&\xa6\xabs\x92\x85\x83\xa9-\xc6\vgw|K3\xfc\b\xbd\xeb\x1e!\xadO6\x17iCQ\xab\xa36\xf0\tN~lfޯ\xbc:\xa9\x9e\x1d\xf6RI]\xb5\xc7\xdaE\xeb\xc1\xbdC\xab\xf0\xe6\xd1lm\xef}\x15\xccᠾ\x7f\xb6$d\xa1\x02\xa1\x86!\xb3F\f\xf9\xedJ\x13\xa8\xaeuQ\xccf\x1c\x9d\xfc\b\x9c\x04\xebubW\xff\xde\f\xf6\xc9=\x80H\xa8Vj\uf898i\xc5{\x93\x92\x18'\xb6\xa0F\x8b\xef\xa4\xc9qF\xa8\xcbg\x1a\xb9vC?\x96\xda\xf9wp\xddN\x1d\x96\xeea\xdf\x1b\x8f:]\x8f|\x92\x0f\\\x16k\x99I\xa7\xe6\xa3\x02\xe8a\x0f\xc8{Ho\xa9\x0175\xe0z;\x18\x7fAe\xbb\xaa\x91I\xa3\xb7\xe8\"S\xc1\xa2jv\xea,\x91L0irud\xb0\xd9}*\x90\x1a\x98\x16\xf9\x81\xfe9\xe2\x06\xe9\x8d\xcem\x1e\xde\x16ح~n\xe6%\x04\x12\x8fA\xb0f\xa3\xfd{\xc1\xfc\xf9\xaah\xbbڿ\xaeR\xd9\xc0\xf8\x19;\x03\x8a\xcb*\x85)K\xed\xee*\xff\xf2\xee*\xffW\xed\xae\xbf\x14\r[al\x88m\xd5\xcep\xfc\xb1J\x95\x19.T{.\xe6\xa9;$\x9cb\xb6\x83\xba\xf2\x1d\x99\x06\f$\xab\xda\xd5\n0\xcd\xdc'\xf4ʢOЏ\xe6\tK\xb0\xbc\xa5^Ǯ\x1f\x94K릺\xfa\xb80PO\xb5QF),\xe0\x12]1y;\x9c\x93\xf7Z\xbb\x9a\xacX\x9d\x83\x13\xe5\x95\x1e\xc4U\xd7@[\xe32\xb9\x151\x89.;}`u\x02)\x15ۀ\x94\xc6\xcbN(\xa5\xe2\v\xa0\xb7:?ۚ~\xbdF]\x06\xb1^c\x1f\x90~\xffq\x9e\xea\xc3iE\xa8\a\xa5\x9d\xb7\xf5)\xf4\x90\xb09\x9d\xc1\x17\x82\x160\x8ae3\x1d5\xd9\xc3B+\xd7k\xef\xebc\xbd.\xf3I\xda\xd5U\x1a\xc8\n&Ɣ\x8a\x8e,\xd1\xc7s\x99\xaf\xd7g:UM\x05@\x8e\x82\xf5\xe3$\xfd+\x1d\xb7\x1d\x83\xaa\xe8Ơ\xea\x1c®,\x8b/F\x9e\xba\xb5f\xb7D\xa6dKv]\bQ\xca#D\x91,\xfdW>\xa3\x8d5\xc7Z\xcaE\xacD\xb7\x93&\xd2Ύ\x92\xba\xc5\xc8V@j\xfe\xab\xe5\x03ؠޛn\xe2\xed\xc0 \xa0\xb7\xb7\x05\xbab\xcaZ.F@.\x93:\xfb\xa9\xb2H\x1b\xb5\xaf\xf5\x8a.\x9b\xf4\xc1<\xd8K\x9a{GZƛ\x8bgv\x97\x93\x12\xb6\x92\x12\x14\xbbp+)\xb7o%\x17\xb7l%\xe3tڵ\x97\\\xfc\xb5\xbd\x84\xfc\xcfn%K\xb3\xd6í\xa44[\tmm#\xb4s\x1b\x19k\x94\xe4\xe6\x96\x13d?\xfb\xa2\xad\xe4\xc2o%\x17\xb0\x95\xcc:\xb6\x92U\xb8\x95\xacp+\x99\x81\xb5\xe2$\x9d\xfe\x85\x8e\xd3]\xfd%]f\x91\xf2\xbe`3\xb1\x99v\x06\xa7\x02\xeb\xf9\xe5{\x8a\xcfm{\xac\xc9\xf4\v\xb6\x16\x9b[\xb4H\xffʴ\f?ݿs\x14oL;\xb7\x15\xba^בr\xba\x99\xad\xf3\xb6\xc6\xfaz\xedˈ\xaa\xb7\xf3\xd9\xea\xfdk\x85@C\xbe`\xab\x9cum\x95\xb3`\xab,\xa9`\xab\xff\xef\xf4\x88\u07bc\xadMPL\x0f\a\xd6J\x8d\xbb\xa5!\xe9\r(~\xc3\x06Q{\x00\xeb\xf7w\n{\x0f\xaa\xfa\xfd\xf7\x15\xaeb`\xca\xfbˣ\x88\x8e\x8bG\x85w\xeag\xf4>\x8b\xcee۹-#G\xda(g\x18\xd6J \xa9\U00064f27\x8d\x91\xc4\xd7\xf7\xe8\x88o#\x10\xf4\x9b\xd0(\x12\x03k`\nd\xe62P\xeb4Mq\x0f\xb6\x87\x06-\x1ev\\\x89~\bԠ\"\xf7\x8e\xf6\x8c3L\xc7\xc9U\x95\x0ei3\xb4\x15\xa0\xed;\xf53\xc9\xdcc\xbfo>7\xef\xd8\xfb\xda696\xa3ז\xb2?\x16\xf4\xbb\x82\xfe\xbd\xa0\xbf\x15\xec\xa6adE%W\xf2\xfa\x19\xaa\x82\a\x98\xc9?\xc5wxZ5O\xe9\x9c}\xebu6\x91\xaf\xa6G$\x15\xcdY\x03\x1ap\x82\xa5\xb1\xa5\xcbNk\x02\x13\xf4u\x93\xee\xf7sB\xa8\x98\xa4\xc0i\b.[GwH\xe6G\xb8\xdbX\xc62\x95Ѝ\xf5\xdc0{\xd7\xeb\x1d\x1d\xe08\x869\xa4z{Q\xd4O\\\x924_\xb3\x11\xda#\x7f\x9b\xd3Q?\x0f\x10'SǨ\xf2y\xf6\xfb\xbf\xe4\xa9\xd2\x1a\x94\x01+\xc3'\xa0\x05\xe8\xb9\xfcZ\xc0\xe4\xd3а\xd6:w\xeb\x8d^nZs\xee7\x90\xf4e-耠)\xfc\xe3\x92O\x15\x9f=\xab\xf2\x19`\xf1\xff\x9bJ\xb5<\x8c\x83\x83;w\x0e\x0f\x0f\xf6\xa1\x1ai*\xd9wez\x03|\x94,\xb9,\xc0\x9a\x97'\xd46?C \xceN\xf42\xcfՒp\xe2\xf0&52I+\xa6\xcc\xdd\xd9wgЙ\x92\xa0\xda\x05F\xd1ܲ\xec\x9a\xd9t\xb04\xd0\xe5I\xd4\xda,x\x8bX\"\x1bZ\xe8l\x9f\x19~\x98\x7f\xe9\xffSvuRE\xb2T2ު\xb8$\xdd&a\x91\x99\xfb\xafE\x1bG\x19;\xcf5\xcfˢM\x97\x1b6\x98\xefq\xb5\xa1Z\x14W\x91\x00 9\xcd\xc3v\fi\x1e\xad[\xa6H\x96\xc38\x02O\xc7Hݨ\xd4\x10\x7f\x95E?\xa3yh\x1b\xed\x1es\x87J#\xed\x96\xcar\x1a\x186\xf3\xbaݨ\xa8-\xb0^L\x1eT\x02'\xb4\xa2[\xe7\x93\xdc\x10\xd3<\xe5\x04\x8d\xd2\xccK\x11\xc0\xef)*c\xc8͈G\xd2D#\xe5\xce\xd4\xfb\x16\x18S\ue3c8\xa0u\xaan\xb3\xa5̌wl\xe41gE\x84\xb7To\x1d=\xb9\x89\xe5\xa9\xc0z6\xa5\x02\xff|\x92\xa2B'\xbe\x93xDe<\xa25\xf5\xfa>2\xd2\x00M\xe36\xca\xf0-V\xf6,\x8c\x9eR\xd0U\xb7\x02\xbd\xea\x04R3\xb3k\xe2\xfa|\"\x18\"\xa2\n\x92i\xd4\x1a\xa47\xec~`\xc9\x13*\xc2\xc1\xeb\x1aG\xdf\xffT\xf8\x11\x90\xb5q\x1f`\xcduԸ\xedc.\xf2\xfef\xd3\x11\x9a\xe7)w`\x04\xc1B\x14uL\x00i§\xb1G\x8d\x9d\x9aW\xcb\n\xa0\xa8\xbfͧ\x1f\xaer9\xab\xd1\xd9\x19\x90'N\xf5ý\xbeQ\xb9\xd4\xceR\x86\xa8\xeb\x91\t\xaa\xf2\xa2\x04vq^\x94ϫ\x99\xf5\xa6dU\x996YZ\f\x82\xbc\x99\xa2\xc5\xc0e\xa7\xe7r1h\xe7φ\xb4\xc0\x81B\"\x172g\xd2<@)\xac2\xd1f\x1cC\xb6}U\x7f\x96m\x1f`T\xd0\x1cAj\nd\xd1[\x19U\xb4\t\xea\xd3<\x15\x01\xa1@\b\x11l\xd4\x17\xeb}Ϝ<:\x8cl\x19\xf5\xca5$\x84\x95<\x10\x9ei\x9bo\vh\x11{\x04\x0f\xec̻i\x8b~\x1f\xa7\x8e$\x8e\xd99:v\x9f4\xa3\\\x16\x06W\x83Ǹ\x1a\x9c:\x1f\xc8\x11\xae\x06\xf7n\n{<B\xd6\xe0\x9d\xc8\x1a\xdc!kا(\x83\xc0Q!o\xa2j\xd8\x18\xac\x89\x89ۈ>\x1bA=4\r#\x1a\x9b'\xe9:\x92t\x93\r\fE\xa5!(\x92y%q\xc6%\xd8\xe5n\x0f\xa2U\x84\xa2(\xc7$\xc42wˏ8\xda\f\xc1XP\x9a&\t:&\xb3\x9d\xe0\xf5\xd5+\x82G\xbf) \x14\xc1\xa2\x8f\n\xe9ϊh\x87'T\xd4)м \x96\xa0y\xb4\x93Y\x9c\x1clʙ]=\xb6-\xb8l\xbaK\xb4\r\xab\xc6\r\xa0\xf6jk\xe3\xbcԸ\xb28y\xc1\xf9V\x05\xa7\xa5d\x15\xad\x18\xdf\xe8z\x0f\r\x85t[\xd5Uu\xce\xd5\x05\x97If\xdb\xea1O\x8c\xc6L\u05f7\x0e\x1e\xa8c\xb4\x9d\x13\xd1\xe6\x9d%\xaf\xdb\b\xf5\x9aU\x109xl\xf8\xc0DKIC(\xe2Z\x97\xfdP\xe4E\x1a\xb9\xf9\xa3\xcd.\xac&\x00ԁ\xc7O\fam\xf4\xc8\xc1\xa9\xe2\xc6-F$\t\x0e\xeb\xe04q[\x84_|\xc1j\xa1i0#\xf5\x91\xd5\xc8\xd4S\xaf\xe3x\xf65\xbb\xb1\x13\x9d\xb4\xa8\x1dB\x01 s\x04H/z\x1f6k\xcd\x10\x05\x19\xe2s\xc0^\xea\xc1\v\xc29\x19B\x98\x87\x18ޒ5\xf0ʝ\vE\x9d\x1df\x92\xc5\xd5\x0f'ٴ*\xcb|Y\xf3Y\x92\xc9f\rĖ\xe5.\xa3\x1a\x88-\x8b[LT\x80h\x00\x19GU\xd2\xcfQ\xbd2\x11\xf7\xb2\xef\xcb\xfa\xd6si\xec0\x9e=\x8a\x8e\xc1\x1c\x1b\x1d9\xb0\xb1.\f\xb2\xbb\xfa\xe7\x9e\t\xdd\xcf\"\x8c\xae\xc3\x10\rg\x1cB\x9a\x8d\x9c_\xe7k\xcb\xd1&\xfd>8\xbe\xa7>\xed\x81M\x03xZ\r\x0f\xe7\x80\xd2AӈEB\x1a\xf7~\xe8Zǘ\x12\x8dH\xda\f\xb0\xbbbt=\xf6+m\xbdN\x7f\x80\xeb\xea\xc4s\xa1210\\\x80&\xdf(lĝ\xec\x19|g\x14Y\x1a\x80_\xe8\x81By-\x8d\xb0\xe4\xb0e\xdf\xf9\x9b\x0e\xb5\xd2_\xe3\"\"\x16\x00;2`G\xc4\bT>\xaf\xa6\xef\xf9#\x8f am\xe7y\x13\x83\f\x9bNnb\x8e\x94\xad\xf9\xd8j\xc1\xc7\xc2M3\xa7\xc4\xc9O━\xa5į\xe2\x94\xd50\x05q\xdd̊\xbc\xacΓ\xec\xa9H\x13\xed5>\x81\xd6\xe1kY\xd5<qh\x82z\x9d\x15s\x99/x\x82\xd3\xc7J4\xf4\v_\x9c\xc1\xea\x83/\xcb*\x9f5?\xbc,f\xbc2I\xf3լ\xa8\x12C\a\x01\xb2\xcb\a\x11\"\xbb<\x15\xe9\aq\xc2O\x1b9h}T]\x02\x87~k\xd5mqn\n(\x16\xf9\xb9\xaddY\x88\x0f\x8d\x8f\xe8\xb6J\xce8,\xe6Z'W\xd5\xf9y\xd9\xee\x00\xb1\\\xa9$\xe3<\x15p[\x80\x84\x85\xb8\xccˢ\x95Y\xcdK\xec\x1d1x\x7f%\xf3\xe5\xd2Z\x1a\xdc\\\xe5\xf5\xf3U\xa9\x8aeɳ\x9d\x9dz\xb00/\x9b\xdbrs\xce9\xb2\xb2\xbb\xe8\x8dÎ\x06'\xddox*)\x1a\xc6j\xa6 \xa9\a\x17y\xfd\xf2\n\x8c\xab\x97\\\xaa\xeb\x14\x84\xb5i\xce\xea\x93\xd5)M,\xb1\x9b0\x90d\xb7Q\x1f'b\x00\x15\xc0E*\x94\xc6UM9;\xf1\x1f\xd2\xfc\xb4\x83\xbf\x93\xf7\xfb\xcd/\x01\"\xb2\xf51\x04\x9e\x92\xac쪤^\x879x\x92\x11o\xa6\xb2*K\xace\xbf\x0f\x1dP\xeb\x00*\b\xb1S\xddNm3R\x7fO\x05\xa1\x02\xfb\f\x81\xbc:\xfb\x14\x13My\xda=\x82\xf8R-\x91\xc1\x9b\xc5\xd4I\x87Ȣ\x1eT\xe2aY\xa0oJ1\xa8\xc4\x14\x9e\xd9'A6\x88~\x1f\xa3\xd6\x04\x16v!g]K*M\x83V\xec\x18qB\x1dNY\x9e\xe5\x03t\xf3\xf2Ȁ.\xa2\xd9\xcdR[\xa3^\xf0T\x12bB&\xd0A\xc5R%x\x94\xa6)g\xab\x816\xf048\x8di2+.\x13t\xfa!\xb8\xfc\xfe\xed\xf3g,\xb9\xaf\xbf\xf9\xe6\xfe\xef_\x9b\xa7\x84j\x1a~Q]rD\x13Ky\b-F\xb2\u058c\x11\x83\xa2\x9e\xb4K\x93\xf4\xa6\xa83\x88\xdc\x00\xfb\xac\x1dO\xa8\xedu\xa6/\xbe+ƩpKd\xb2r\x8flg\x88\a\xed'h\xf6\n\x1f\x98~'\x84d\xad\xac_\xbc\xc1\xab\x11\xe5f#\xe4\xb8\x11\n\xfacaп\xfc\x96\xca8]\xb1\a\\;l\xb9m\xa3\xe4\xd1F\x89\x16Y\xe2_\xdc,\xdb\x1fo\xd90\x011)\x0f6\xcc\xdcm\x989ط\x8d\x1b\xb9tl\x9a\x1d\xf5\xfc\xb2\x8d\x93\x87\x1bg;\x97\xceͳ\xa30\xb7\x81\"\xcek\xce~\xd5\x0f\xd1vƣuh\x97^\xcer\x1e\xe0\xdbƋ\x94߾͊`\x9b͵\x9fYAo\xd0\xcc\xc0\x1af\xdeZ\x87h\xff55\xaf\xf9mU\xb7[D\xce\xc4\x06\xb7\xe3\xdcj\xff\xe4H\x9aְOOA\xafo\xda\xdc\xf6j\xa3V\xb5dӓ\xfat\x9c\xd4\xea\xba\xe4\t\xfaV\xfe\be.I\x96\xccrq\xcee\xb5\xaa\xcb\xeb7\\=\xb5\xcbW\xa72\xae\x0f\x97l9Y\x0e\u07bf\xbfP\x8bҴ\x92\xf4\xfb\x976\x8bpׯۻ\xfer\x12\xfb\x84\x02\a\x1b\tzl\xee\xf7\xafm\x1e\xcd\x1d\x7fi\xe2\x92d\x17\xa2\xeb\xd5r)y]\x9b\xfd\xff\xf1\xac@n\xff/\xb9\x14ڥ\x15xtv\xa9\xbeGB\xae\xa8D#>_\xa9\xeaI5]\xd5& -\xdb=fڼl\x9c\x13u|Np+zY\xf6\xfbW)\xa75]\x82\xe2\xcff\xdb\xd9\xc1\xf1\xec\xe0T\x80\\j\xdb\xd9\xc1\xf1\xec螳\xba4\xa1\x11\xd6Ai\xb0\xe6\xea\x81R\xb28[)\x9e&\x18\x8c\xe7ߏ\xa9ID\xb6Lo\xbf\xf1\x05\x93ٜ\x1fim\x8b \x93\x02\xea\x1b\xa5\xa9\xa1\xf2\xae&f^\xfe\xac+\xd4N\x1d\xa7\b\xce\xcc[μ<8\xf3xt\xe6\xfd\xac\xfd\x816\x8e\xb7\xd8\xf3ZC{\xb2u[9\x02\xf7ҝ\x14\xf9\xdf\v\x90\x1dҶڰ\xa3\xc0[\xce\xd3D\xe0E\xea3\xe4x\xe3\x9e@\xbb\xc8\xf1I\xda&\xc7\x1b\x95\xb1\xa47\x1e\xe0ة\x86\xa9\x1b\xebU\xa5\x82\xa5ǈ\xbd\xeb\x0ez\x99\xc9\xf8\xa0'\xe6P{\xcb?\">r*\b1ه\a\x98\xe8\xe8đ\xbb\xc7M\x81\xadF\xa8h)\xd34\xa4\x9d\x93\xd4\xf0D\x98D\xefH֣*\x13T2\xc3\xc6A\xb9\xe2V `/dľʜ\xeeySp\x06\x92xi\xa5\xa6^H\xe2\x15pw\xba\xb2\xbfM\f\x8a:\xdf\xfd\x80_;\x01\xd1鷰y|[\xb3\x03\x92\x01*1\xbe\x1f\xe0\xefz\r\xe1\x87NZ\xfa\xaaFS\xe4ttp\xb8?\xba{w\xffn\xff\x1d\xa8\x8d\xc7A\x7f\xd6d\xbd\xbe.\xd3W5}^\x83\xc9Y*\xd6kI\x9a\xbag\xe8\x02\x06\a\xe10\xbaK\xfb\xe6\xbd\x15\xe9V\x7f[\xe15v4\xb4\x19T(\xb5\rb\x8ea\x91ء\xd5\x19\xa7\xad!\x8e\\\x06\xc1\xbd\xb7n\x8a\xb8ݷ+&<S\x9e\xc01U\x93\xa2N\xf5F\xe8\xb4\xcaM\xdfmgw\xdf\xc6\xec6o\xe9\xca0!5\x87,`\xa7S[\xa0\x93\v\xd5l\x15\xe1\x8d`W\a\x01\xac\xa6ͮG\x15z\xcf_ԃ\x13H\x834\xc7!\x96\t\x05/\xe8>\\6[ \x81\a'hZ3I\xac`\x7f\x9f\xd6-!]\xdd*\x8a\xd6M\xe9S\xd0\xe5u\xe42\xaf\x8e\x85e\xb5Y\x8d\x9c\xd6\x01\xb7\x97\xd6M}\xa180\x10\xdb\xd6m\xc4\xcc:f\x8c\x9a\xb0`'\xd1\x1c\xec\xa8&\xab\xe0\xc5UJc\xf5ծj&Q\xabv\xab\xf8\xbdU\xd1US\xff7\xaas4\xf8\xb4\xd6\xfa\x99+\xfc\xa1\xf0\x106\xa6\xabm\xb8]\xc1C\x86\xd0\tuf\x8dE\xf4m\xc6X\xd1\xf1A\xf8\xba\x89ق\xd6|\xcf\xe8W\xf8\x1df\xbd\xef\x95h\"i\x86\xd94\x91Q\xd8\xef\x7fU\xa5\xe4\x9b\xef\xebp\x8f8:\xa45\xa8\x88\xb8\xd9\xdeTZ\xb0\x9a\xab\xf3t\xa7&\x11K\xffI\x9e\xae\x88fP\xb7\xb2\xb3\xa9$㷯\x19\x19\xad\x19]\x8ba\xb0xt\xc5#\xe3\x15\xcb\xfd\x05\x9f\t!\x1b5\x00o\xb6\xc57\xe5\xad\xc1\x8b\xaeJSZK=\xc6\xe8\xfe\x7fBw\xed\x89\x0e\xf1 \xf6\xa17Fi\x1e\xa9\x9f\xefӱ\b\xa5\x92\x93\xd4y,i\tU@\x95\xcew\xa6n\x00\x99xf\xf4*s)\xa9\x8ee\xab\xe8\x04v\xc3?I\xa5y\xa2A\x9b\x98\xa4:0\x90\x13\x85\xdd\x14u \xed\xea\f\x06\xdd\xd4\xd4\x1eP\xa1^\x93\x9e\xaf\xf5d\xd4W\xeb\xfdl\xd4W\x04=o\xba\xf3c\xff\xc0x\xb9pG\xd4\xfb2\xedd\xc4F\xebs\x87y)GS\a\xea3\xa6M;ִ\xa9\xe3\xb8\xdc4%*GTs\xc5\x03!oY\x83~Z\xa7\xbf\x8e\xeb\xca\xfa\xdf\xd0\xdcl\x87\x1c\x8e\xa5\xd8%|8<>\xea#(\xbe\xc1D?\x1c\x1e\xdf\xed\xab\xf5\xd1!\xe5a\xd7\x1c\xc0ɺ\x8d\xf9mλ\xd4\xe5\xde\xf2\x91\xb5\x7f\xefN\x80\x0e\xdcQ\x96\xe5P\x9b$\xe86\x82\xdeB\xb8a\xbd\x83\x02?߂\xd1q#\v\x1fզH:(\x8d\xa8>\xb7̔\x06J~\xaf!\xfeX\x19Q\x92\xc5l\x91,I\xa8`\x88\x98)w\xd9\xf7\xa9qV\xa3\xbf\xb6\x80\xa5\x16zRzH\x96\x8a%\xbf\v\xec\xdf\xde9\x17h\xde,\xce{\xb5\x02\x83\xe8^\xb2\vs\xb4\xae\xf3s\xbe\x9b\xfc.\xe0\x15ĉ4w~\x1e\xa0\x1d\xe2wUP\xd1iP\xd1i%\xea\xaa\xe4\x03d\x82\xa4\xcaܲ|]j\x8e+\xb0Z\xa9\xad\xe0z?\x16,\x86\x01\xf1\xb2\xb16Y\x01[\xf9\x1df\x1c`\xaf\xd7G\xf6\x91\xf0\x01p7\x84\xf6\xea\x9f\x06\x1e \xbc\xbc\xff\xd0:\xcev\xcbUZ٣\x8cE\xff\x12ϴ\xb6\xe8_z\xc9}$\xf8\x97\x9d\x82\x7f\xe9\x04\xff2\x14\xfc\xebǱt\xf1\x1bٔ\xfbۘ\xf0h\xddl\xe8wqOy\xfd\xab\xbc\xa99\x8c\x88>ڏ$\x8f.`\xf1%Mk\xffR}\xfao\xe1\x10k\x16\x14ވ\f/\xaa\x06(\xfa\xed\f(L\x9a\xf3\xee\xb4\xf6\xc2n\x98Ly\x8b\xc9$\xb6q\x9f\x9a9y\x16\x83a5a\xb9u\xbb\xdc\xf6\xc5|\xa7\xe3b\xdeqm\x17[\xaf\xed0\xf6K'H\x10@\x02\x19\x95c\x94j5\xd9/\xc0\x12ʻ\x02\r\xf3\xfedyJ\xf4e\xdc0\xb2\x96\xd6w\x1bD\xe1L+\xa0\xb4\x15Y5s)P[n\xbdN%\xbaO\x97'\xc5)K\x12M\x11me\x82i瘞\xc1e\xde?ύZ~\x86\x1b\xb5lr\xa3\x96]ܨ%\x99\xa0=\v;9\x05\xe2\x99\xd5\xe8\xb2Kc\xd2.\x8d\n\xd9\xd8u\xb1\xb0>\x1a\x05\xf4\x05\xb8k3\xd7\xe4|\x02\xbd\x93YX\x91\xae\xfe\x9dj,gs\bO\xedMlE\x9a\xdd\rْ\x9b\xa0\xa3w:zz\xbd\x9e\xf6\xfbӮ\xf0\x8e\x11\xf0\xa36%ӮQ[\x9d\x14\xa7;\x8cMO\x8a\xd3\xf6\x10B\xa81\x16\x85\x18\xd7]\xb4\xb6\xdd$\x11\xb1e:\xbeu\xa0\xd1\xcf`:e\xd3\xc94\xe6s\xd2\x15\x98d6\xc2L/i\xec\xebi\xbf\xdf\x1a\x9a)i\xb0E\x97\x93\x16\x03i\xda\xef[\xae\xe7N`\x9f\xd9\xca+Iv\xa7_\xc4\x01\xfd\x829\xd79\xc5Rל\x88\xe3\xb9lr<i\xbd^\xaf\x18cS\xdb\xc7$k\x19\xb2N݉\x813\xc0\x82\xf20ƞO\xa6\x1e\xb0\xa6c6O\x01\x82\xa0Օf\xea\x812\x9afd\xd7\xe3ƥc\xd9\xe4\tn\xe8\u07fbv\x7f\xd9!\x1d\xd3y\xd6]\xd6m\xbf\xf0\xfc\xc3\xf3|91\xbf\x19\xb8\xcf\xf70\xe2N\xff\"\x95l\x99\xa7{#\x98ep`\xb2\x03\xd0EͯA\xb8\xc1n\x8c\xb5\x9f\xc1\\\xb7\xba\x1a\xb8]\x8f\x9d\x8f\xe6\xa9\xe15\xb1\xe0\xd4\xff;\xcc\xe3\xbf\xe3\xc5\xe37\xd4\xed\x9d\xd6\xc0\xa0$\x9bP\xb7\xf8\xe2\x96Z\x98\xc24\xf5\xbaՄ$v\xe4\xea\xb7s\xab\xab\xec\xea\xea\x9a\xd4\x06 15\xa3\"\xad\f\xb0]\x1e\x99y\xc4ח\xbc\xf3\xfc\xc8#c\xa2\x87@\x12\xa1\xaejW\xd7t\x1cN³\xf8~\xaa'?\xd5L\xf0\xab\xde\x1b\xae\xd2\x13uQԧ$\xfb\xa9\x1e\xe4\xb3Y\no\xae+\x89\x01\x96V\x9a\xaa\x1bCd\xbb\x1a\x96P\xa37.\xea\r$\xcf<FC\x96$\x1b@m\x92\xd8\xf8\xd9\xd6\xd9\U00106ac9\xf9\xcd\xdep\xe5gӢ\x0e\rd$\x9fG\xfe-\xba\x87H!`\xa1\x85\x12\xd5D\xa4\"7\x9fJ\xed\x11\x1d\xf7\xc3\x06p\xa8+．\x9cm\x85jBm\x9f\x84\x91\xcbB\xf3j\x94\x88\x0e\x1b\xca?V%\xa8\x98\xa7\xfbw\x8e,'\xd0\xdf\xf8\xbcۡ\x06c\xbb\xa5[\xac4\xccq\xa0\v\xb4\xc5\x18,U\r\x8f\xa4Z\x87e\"\x03\xd3ZI\xb4\x9e\xcd\xfb\xf7\x92\xe7S\xf5T \x93\xa1\xecʎ\xa9M\xd8\x14waB\xffXQ\x9b~\xb8\x8d\xd1J\x9a\xd7Σ\x83\xf0\xc2y\xe9֭s\b\xff%#\x10\xf0k\x9c\xb7\x99T1\x19\xe3&\x87W|\xe32\n\b[\xa6\x9cO\x81b\x9e\x1e0\x96\x1e\xf4\xf5]\xd7\xea\x90q#\x12\x18s\x8b\xda\xcbDJ\x9c\xff&s\x8b\xe28'7\xff\xae\xba\x18\x93\x88\xb1\xb0N=\xe0\x1a|\b\x1a\xb2\x95\xae\x9cf\x04\x8fО#}V\xa6\x12ΡG\xfa\x17\xb5R\xe2z\xc5\x13љX\x04\x97\x1czؗv\x10\x9d>Մ\xb7\r\xbfS\xa2\x81\xa1\xe3\xf9\xa51x&\r\t\x02\xcc6\x1dӴ\xba$4\xce\xdaٷ\xb6`\xb5\xbfd~\x82h\x00\xe7\xe2\xb6.\xef\xf7\x17y*\x11o\x92\x04L\x87mɍ\xb7\xcf\xd0F\xc4^\xf4\xdc\xd4\xc4\xd7\xc8\x1f\x9f\xe9]n\xd3\xfa\xceݸң\x91\xb8\xd3=\x12\xae%\f\x11x\x83\x81\xf9Y\xd8\ue50d\xee\x04\xc9\xe7\x1ch\xe6\x948\x1f}\xd1fd\x15\x1d\x8f\xdd\xe6\xa4\u05cfYW\xfb\xa3\xac\x9b\xf9\xd0䑄\x95\x93M\xd5yT\x95\xf5`\xb5\xa1}E3q3\xd2\xdb\x1a\x061\x1fU*\t!\x9f\xdb8\xae\xeb浟\x8f\x1b\x17}\xbb\x9a\x83~\xd6\x1e\x04\xdbgH\x8a\xfe|\x81\xbc\"\x83\x9a+K\x11ND\xf8\x06z=\xf5\xb2̯\x13\x9a\x88J\x80\x88\xb9X,+\xa9r\xa1\x12\x92\x89\x81\x89f:V\vt\xe2\xe2\rץ!sÂǕ\xd9<\xaa~\xbfjR\xa6\xaed2\xa9l1\x06{X\x7f\xed\xca~̃j\x02\x15b\xf9\x18\x9e\xe9\x11TȋO\x99\x9a$I֨\x99c\x82\xa4\xfb\a\x9e\r\xb2\xefX\"N\x89W6--\xa5\xf1@\xf2\xdf`\x99\xf0\xff\x1e˄\xff\xeb,\x13\x8fN\xef\xf5\xb4\x1fT\x9d\xd4ڃ\n\xae\xfb\xd5bQ\xa8'\xc5\x19\x97\xef\xc4\x026L\xa4H\xb6ĥo*\xaa,\x9d\x02X\xc8_@{\x1c\xdev\x02r\xa6\x1a\x1b\x9f\x8f\t\x9d\x8c\x12Gt0\x1e\x9d:0\x0e\x15\x13\xf6\xacC\x92K\xfb\x98\xa6\u07ba\x95\x18\xa7\xb3\x87}Aȳ\x10\tK0\xedS\xa3J\x83V}*S\x01{\xdfF\x1a\x87\x02\xe6p\x92H\xfdl<KF\x13I\x8b\x1aD\xaf\x1d\v\xb3A\xfb\xc4\x00\tAgsc\xbc\xdf\xd4\x140\xe47k\x9f1]Y5Z\xa0h\\\xd5;\x19\xd63`(\xf5\x0e\xb3\az\x92\x04\xd3\xe6\n\x89\xd8\x16\x02\x13\x0f%\x8c\xbcCH\xc8ۂM\xde\x12l\xf2.\xd1$\xef\x12M\xc6&\v6\xcc\xcc|\xf3\xd6\x14^\xfaF<\xae\x03\x97\x0ew\x98\xf3\nz\xe0\x1f\x0f\xed\xa3\xff\xea\xa3nz\x16\xbaYnx95ޖ\x1fCO:{\xa6\xd0Kpc\xdb\x1f\x1a\x87Β)\xa7\xcd\x1f\x9d\x9d\xb2\xe5!\x17\xc0\r\xc2A:\xb0\xe7\xa2b\r\vx\x8dqհ\x8eiT\x00\x9cK\x8e\x8e\x02R隧\n\x9cn[\xcf\xc0}\xb67\xba\v\xaeG\x951$£\xe8\xaf\xec[\x8f\xebԾ\x10r#\x03S\f\xf0?\xef70\xcc\xfeK6\xb1\xf1\x1d\xbf_\x1f\xf9\xc7\xd1=\xfb\xac+\xb2o[E\xec\xee\xdbS\xa1\xf7/k!p\xe8\x0e\x8f \xdd\xf6\xbd|\x83\xee\\}\xe6\x1a\xf7\xd2\x1f\x83\xb6e\x1b1y\x0f\xab\aua\xdeا\xc0EEö\x03'\x1b\xad\x18LG\xa1\xf9\xf8\x02\xaa[\x11\x0e\xbe\xb1|\tY\xf0<(D\xadr1\xe5TM\xee5t\x81\x02\xb7萌KCb\xe2\x8a\xcedGX\xda\xc8\x02\xe7\xa2υ4?\x91\x80\x17\xc7$\x89\x04\r\xdc\n\x01\x91\fҴ\xee\xeb\xaaR\x0f\xed\xd4$N\xfdCY\x162Zb\x04\xec\xe4XB!\xe2M_\x9f\xb6\xa8(\xe2\xfa04\a\f\x94F:\xe3\x03\xff\x1f\xff\xee!\x90\x9d\x1d\x1d\xf7\xce\x176\xed\xcdg\x9a\xf6\xe6\xf6\xa6=h\x92\x90\x14\x81,(\"\xac\xe9\xf5\xb1\x93\x83?\xd5\xca\xee`\xda\xcet\x1c-\xe2\x9ctlXN*\xc2\xf2`\xa3ʣ\x8d\xcaoR=\xdeئ\xa4\x81\xb1\xeaڦz|\x933k\x81\xbf\x01\x90\xc0\x8d!z+/ݪtI<\xb3m\xab\x19\xa7%\xab芕\xe31\x1c\xe5guZӕ\x13F\x1bՒ~\xff\x10_\xe0\xebU\xbc\xbcW\xc0\xfd\xb5f\xecFsb\xc5\x18+;\x8dZW\x9d\x1b\xde\xcamx+O\xa8\xf9\fV.\xc1f\xd5\xdc\xe4l\f\xd6\xc2\x0e\xa5\x00\x97\x8d\x12\x1a\x16\xf42,\xd0\xda/\xd0:\\㡺}I\xb2\xba\x11\x0031\f\t\xb2%\x8e\x90>\xf4\xfd\x1bx\xe6s\x04nX\x97\x8eA\xb4P\x10\xb6]\x15\xadXդ\x80]YH\x80Vn\x94\\)_\x92\t,\xca-\x92Ǫst*7:U\x87\xe4\x11\x9a\x8d\xbc\b\xfdN\xf4\x81\x92\xe6\x88#T5\x87\xcb&Ê\xb5\t\xeb\x0f_\xcevk\x93\xbeV\xca\x1b\xd0.\x01\x97Ѓ\xf1\x82K\x8a6\xd3\xc5\x10\xbcL:\x12Xs\x80\x90\xdeE\xb8zO\tS\xf7\xc4\"'R\x06\xac4%Z\xb6\x1e\xba\x02\x85r\x9b\xbc\x97\xf86\x1e\xb3\b\xefxJ\x1e\r\xe6\xfc\x84\xd3Fi\xf1}L\xdf\x1f;0q1:\x13c\x1eZ\xae\xe5\xedN\xeap\xf0\xec}\xd5\"ual8\x8c W\xabR&2\ac\t\xad\xa5t\xbdt\x9a+b \xf2\x05X\xef\x1a\x81\xe6\x03\xae\xa7\xabb\x0f\x8cD\xb5b\xc3qu\xdf9\x19\xafvپu\x83\x9b\x9fT\xa7\xb4\x84\x9f\xddQK\x1f_\xd2\xf2s\xfa\xf8\x976U\xacr\x7fm\x82\xafRIkZ\x02=af\x19\x8fE\xd4RW\xba[:\xbcjG:\xadq&c\xb3\x88A`\x15Ao\x89\xebT2\xcf#%s\x19\xab\x8d\xe7\xa8d\x0e\xfc\xbc0؉&c\xad\xf2\x8e\xef\xdbj\xe7YW\"\xfb899͒\x04\xca$\x9bM4\x87\x8f\xb2/\xb3\xb8\xdc\xf7Z9\xc84Rݬ\x86\x06#\xab\x8b\xc3\xdcX\f\xd6\x1e\x15E!\xe6\x19\xf4\xa5\x81[\xd4\xe48\x93&\xff\xaa[\x9b\xaa\xdfO\x7f\xa9\xb5\x92\xd7um\x11\xc1\xa0\x8f\x8cӉ\x87x\xd5k\xe8\xfa4cڊ;\x98\x022\xa4\xdd\xc5~\x86\xaf\xf50\x12\x80toq\x88q\xd2\\\xc7\x0e\xebēٖ\x8a\x87N\v#P&4C\xcb\xf8y%\x1f\xe7Ӌ@\xcdF\xd9-\xf2\xcf2t\x98B\x15\x19K`K\xa5\nE\xd7Z\x88\x04Y\xa8\v.RA\x05\xfa\x03\t\xf6\xf8\x97u\xe4\xc9'@\x0e0\xf5\xd2>l\x1b\x8ezm\xb2\x809H\xfa\xfd\x80k\xdb։s\xf32\xf8\x04o\x8c\x7f\xd4\xecy\xae.\x06S^\x94\xf4i\xdd\x00\xfd\x7fd\xdc\xe7pI_ԝ\x0e\x01ނ\x86\xf0+ss~m~\x9fC\xe0#\xf8\xf3\xacf\xab*\x1d\x12\xfa-\xbc=1\xf1\x9f\xe0\xe5\x1d\xfc\xf9\x13\xfe\xfc\f\x7f\xbe2q\xbf\xc0\xcb\xf75\x1b}=\xf4\"\xaf\x1f\xea\x94\xdc|\xafg\xe2\xee\x9d\xe1Pc\xb2\xd5\xf4;\xf3\x11\x88>G\xf47\xf3\xf6\x93\xf9\xfd\x15C\xb9\xb6\x98\xa7\xaad\xc7C*KvrJ\x05\xfe\xadLL^\x02\xe6\x8by\xa9K\xb67\xa2%\x04\xad\xe0\xcfԄ/\xcb\xc8\xf3\xc1\xbc\xf4\"Md\xf9\xdc뿭\xc9\x04*\x98\xed\x8d\xc0\\\xa8\x9c\xd4eV\x97X\xe7@\b[\xc2\xdc5\xc0\xe8\xfb}=\xbex\x94Y\xfd\xd5\xd1\xd8\xc4\x1e\xf6\xbd\xde\xfc1Xh\x80\xb3\xb8\xc9(\xdb7\tX\x89\xce\xc8K\xf6\xa9F}@\xf6[\x15x\x9c 7\x10\xb4\x82$\xab\xd2\x1e\x87_Փ\xaf\xea\xc8Ik6\x04\xca\xdf,\r\xc5\x0eG\xf7\x8eF\xa3\xfd\xfe?We\x80\xad\xc4R\xd5g{\xa86\xab\xdf`^\xba\xa4\x9c\xf4\xf7\xb4v/\xbb7:\xde\x0f]\xd0p\xac\xb3\x91Ҽ\x05v\xfc\xf1=ԅ\xe6\xec\x93JG\xfb\x14\xb4\xbc\xf1\x99G>z\xe2Өw\xec\xf6\x96\xd1\x1d\xbd\xa7\x1c\xdfs!C\x13b8\xf7\xc7G6枉p\xe2\x84\xfd\xa6\x9e\xe0p\xb3\x81{fYF\x8e:\xd1\xef\x9a\x05\xf3\xb83\xbc\x9f\x97f\x0f\x8f\xe6\x89ۛP\xcd\xd2o\xfd)g\v̀\xc4F\x0f?+\x7f\xd1\x023\x8f~?\xfd\xb3^3E\x0f\x8d}\xc85|\x05\xe6\x1cF\x16\x0f=7\x1e\xa1\xb8\t\xfa\xef\x9e\xee?\x9c\x18z\xae\x9d\xc1T\xca\xd2\xf3R[\x98\xc2ȼ\x85la\xb1\xd0\xef\xaa\x14\xecQ\xcdDz\v\x06#\xc7\xf7\xf4M\xf1\xf8Xc[;\x9a\xb6\x9cT\xa5\x97\x81\x83g\xb2\xaaĽ\vdf&{\x02\v4\xe8\xa4E\xf9\x05@R@,m\x01\x93\x92\x06\x05(\xe6{\xf11\ta\xe3\xa0{Bm\xf2\x10F&\x95q\xc2V\x96\xfa\xa7w`\xd92\x93\x80\xbb\x925$\xdceKP\xe2t\t 9\x8a\x9c\xebU\x8d\xccH\xeb\xe5\x99\xf1\xc1\xb2\x10\xe7\xf6=g|\xc0?.\v\xadF\x03\xaa\x995\xad\x19\x8f\x16\xdbxx\xbfn8z\xae\xad\xa3g:e\xf9I\x89zX{0\xeaS\xb7K\xac\xfa\xe0\x02\x19\xe6\xc0\n\xdcN\xdcL\x99\xa2\xcfT\xba\xb2*.\x8f\xd4\x18\xbed\xa3\xe1}\xb6\x9cLw\xf7\xef\f\xb3#\xfdx\x87\x1fd{#sW\x9a\xde\a\xb1Yj\xaa\xe9\x9cS\xafȸF\xdf\xd2\xc8\xd2\xfe\x16\xa6\xa9\x9e\xa1\x93\xe7\xb8;(\xf6H\xe1\xe4\x12$\x1cK\xb0\xc0\xa9\xfa\xfd\x97U*\t\x8d\xbb˱rM\xd8+YT\xb2P\xd7lH\x9a@Uz\x91u$\r\xf59â6\xa3;\xb8$R\xc9\u03a2\xc3\xd7\xd9\r|\xaa&駊\x9d\xc8S\xfa\xaeb\x0f\xab\xf4UE\xff^\x11\x92}\xaa\xb4\x8e\x10\xe2\xad|[\x91lt\x88YI\xf6c\x95\x1e\x1f\xd3F~\x88Mx˦4\xba\xd3\xc499>vTU|\x9d\x19e\r\xdd\xe6c\xbb7E\x00*w\xbb\xd4%\xdc\xeeu|7\xe2\x80ؼ]\xb4\xd9\x19}\x19\xc3-\xdcڃ;\xf7\xa0y\x9bM\x8a\x8b\xe6\xc7*\x95\xf4\xb2\xd1v\xd25|\xaa9\xce2 e.\xed\xc1\xa6\x0f\xd0U\xc9\xf0\f\xf5gcӵ\xfd\xfe]\xe2\x15\xe4\xc3la\t</S\x94݆\xe1;~N\xf4\x02j\xae5c\xed\xf1([\x89\xb5\x10\xe7m=~\v6\x9fG\xe6\x02\xf8\xa1L\xb5R\xe1\xabZ_\xe5\x9eךq\xa7w\xd27\x1a\x8d\x8f\x8c\xc7(D\xf9\xa3L͕ǫ\xd1<0j40\xafA\x9d\xfdi\xedTir\xa0\x90*\xbb\x8b\xbd\xae'\x82\r\xb3\xf4UH(\t\xf6\xad>\xc0\xd3O5\x98\xf6\x11,q\xe89|C\xado\f\xbch\xa6\x95\xd2\xdejK\x13\x1e\x90\xfc<$\xf9\x7f\x10iKɄ\x0e\xf56\xfaD\xc1\xbe\x8e\xc8C\x0f\xcd\xdeN\xe8\b\u05f7\x1e!Ş\xd4\xd4ԁ^\xeb$\xfa\x18\x00R\x86P'b\x00Y\x8c(\xea\v>\xfb\xa5\x92\x1f\x18\xb7\x8d\x0e\xfd\xb2\xba$όE\xa7h\xf00\x9a\x93\xf3\xf0\x8e\xbd\x9c8%\xa2\xb7el䬕\x14l\xcdң\xfd\xe3\xd1ᝣa_\x12Mɏ\x86\xf7S\xc1~\xa9\x81R\xdc\xc3:\x13g4\xa8\xb0Y\x86\xdf\x03R۴j\xed\xed\xa4/\x89ޛ\xd0\xefm\xb4ϯ[\xa9\xfb\x0e\xbal\xa0\xb4B\xbevx\xc6~\x11\xe9\xdb\xe6\x16e\xa7N\xabI\x87Q\x93,m\xa5[\x14p\xa7\xe0 ◠_\x82\aL\xc5\xf6F\xe3\xe1}9\xb6\f\x03}\xa8H2\xce\xe1P\xa9iZ3qR\x9f\x92o*đ\xab\t\x95}\xf6\xcf\\ˏ+\n=%Y:\xda\x1f~\x93J\xa4T\xf7$\x99\x8c\xf6\x87\xd9\xe1\xbd\xe17rrxo\x98\x8d\x86\xf8\b?\xd9\xe8x\x1f\x9f\x8f\xf7\x87\xd9\x01?\xf8FN\x0e\xf8Avx\x80\xa1𓍎\x8f\x86\xff\xf9G\x9dʯ\xe1\x89@~\xe4\xe6\vzFv\xf5̖\xfdk\xff\x18v/\xeb\xe5\xd0O\xcc\xc6\xee\x04{{c_k\x9c\xfb\xd7\xc1\xb9\xaf\xfa\xec\x9f?\xd7\x14~\xfe\xacis\x90\xd7L\xc5\xf3\xa0\xcf\xfe\x89\x0eS\x9bg\xfexx_\x8d\xad\x10Y\x8f\x86\"T\xc0h\xc81?\x91\xa7\xb09B!\"\x94\xa1\xbbK\xc1\xed\xbb\xa5\xd9\x18\x1d\x05\t\xc9\xe3\xe3\xbc\xff\xdcb8(\xf6\xbc\xa6R/qE\xc6~{\xc1\x9b\xae\x0e\xd6\xcb\x01(U\xa3\x11\x1d\x85\x0fu\x89\xc8\xc93Z\r\xe6\xa6\xfc\xafo?\xcao?\xaenf\xfb\x91\xa6ɲ\xb5\xfd\xa8h\xfb\x91\x81M\xd3_\xdd~\x14\xc5\xe9\x15f\x17ψ\xab2\xf4\xc7dO\n\x14\xdd\xdbRS\xe5}\xfc\xe1m\xe8m\xad}1y\xba;\x18\xda\xc7\xed\f\xfblo\x9fB\xbe\xf7\xfe\x1b\xf9~4\xf9.\xab\xf4YM\x1fՄ>\xc2I\xfa\t\xfe\xfad`\"u\xf3\xa8f\xcf܉\x04Vd\xcf\xea\xd0{\xbd\xa3\xe4\xa3\xeet\xd2\xf6\xb0\xff\x86\x8eȏ\x16\xb4&Z\re؈\x83\xf9\xfe\xbd@l w\b\x12-\x02~]7\xc8\x7f\xbb\x8f\t&\xed\x19#\"\x03;\x9dNk^\x80\xa22\xde\x00\x1ezg&\xb55\xbc\x8b\x0e\x8b-6t\xe3Hk\xe1Y\x1e\xa30\xf5\x0e\xf1\xbb0\xc4\xd1uǙ\xb6g\x8b\"\x87Y\xd5\xcc\"\xe0|\xc1@\x84R\xeaW5\xe3\xc0/\x01\xe0D74\xda\f\xf5y\xcd\x1e\xd5\xec\x13\xccֈk\xf2s\xcd\xfe\xac\x19\xb0M\x02\xf9\\\xb0\x83\x8dݾ\xf3\xbaƙeɑߜ]\x12{[PQ\xf8\x8b\x8e`\xbc\xe8\xc0\xe9\x05zcl\x95\xba\x8dKf\x1b\x81\a\x89\xf3îk\xec\x18\xfc\x02`\xec\xe1p\xf95gC*\v\xa6\n\xc6\v]\xfd\n\xe2\xe8\x8b:\xd22\xb6D\xbb\fԤ\x8c\xf0\xe4\xe6ۚ\x8d\xa0\xf1\xca\xf2\x95\xec9\x9bY\xd3,Z\xb8\xe4T\v\xb9P2\x8f[_\xed \xf9\x86\x87\xf7\x1a\xf6\xf0ݦ\xf0;\x8c\x81\xb9q\xd3D\xa1\xecTj*\x91\xa7g\xad\x89ʱ\xe3\xe8Ԇ\x9d\x831\xd3в~<\x05\xd3\xfa\x90'9m\x98\x95G#\xc1\xa6-\x93tmI<տh\x1e\xdfaX\xdf\xe1\x96n\xa3/\x8a-l\n:g\x85Up\xba\x80&\\0\x04\x02\x9e{\xd5\xc0\x19\x9b7&\x88\xbf\xbc\xcdȅe,\xcd\x02Ƣ\xbe\xe3\xc1\xc7\v6\x8f\xd9\xd8\xe3\v\xef\x16|\x11\xc0t\xa4;:\xe46d\x8d\x9d%\xd9\xc0Ժ\xd0\x15;g\xf3.\xd6/c\xcc\xd4\xfcҲ4Ɨ\xc8\xccX\x11\x1a}\xc1.\xf5\xa1wnb\xfd\x10\xce\xed\x10\x16\xf3t\x1e\x1av\xdbgt\xeah_a;?\xbe7\xc23\xacƎ\xf3U\tF\x9f\xd4ڥ\xe6]\xdf=\xd7\xc6\ncD\xc6\xd7\x18\xb9O\xe7yZ\xd3k\xb2\xa9-\xabĉ\xbf7\xa5\x95\xafՆ\xd7r\xc6r\xa4H\x1e\xe6Ӌ\xb0\xfdg\x934\x8a\xc2~X\xd6\xd4\xf1x\xe8٠\xe6*]Q\x90\xe6:\x7f\x0fi\xc9\xce@o\x1e\f\xfb\x81\xafؕ\x9a\xee\xa0A\x10\x02Ei\x16Q\xad\xefpW\xec]Hi\xe5tEk2^i\xc6\xf7\x15\xbd\"\x1bב`FL\xe7f.+\u05fc9\x9b7\fp\xf5̚\x93q\xc94\x19\x94\xfe\x90j\xe8\x05\xf0\r\xf5\xa0\x87\xcc\xe8\x9eSQK\xc8n\xd2sT[\x0f3\xe99\x93u\xda;[\xa9\x9e\xa8zv\xd2\xf5\xde=\xed]\xe5u\xaf^\xf2i1/\xf8l\xf0\xbb\xf8]<\x98\xcdzy\xef\xfe\x1b̦\xe6.5\x1b\f\x06\xdf\xf8\xb2z\x17\xc5\xf9\x05\x97`\x84\xa6.xOI\xce{\xaa\xea-e\x05\xf0i\xbd\xbc\a\xc6/`\x1b\\\x88Y1\xcdU%{\x95\xec-\xcb|\xca/\xaar\xc6%\xa46*\xa8\x83\x84l\xee\xecx\x8c\x98}BK\xb6\xaaӒ\xd6n\x81\x9a\xc3p\x1e\x1c\x86\aY\xceJ\x1a\xf7*rim\xdf\x025p\x91\xa7s:\a\x03\x96\x1cMX\"U\x8a\x11䀣\xf7\x98͵\xfa\xf4G6\x8f\x15\x81aA\x1c\x1d\xf6\xe7\xde\x7fi\xc7^\xf8x\xab\xc1\x90\x93U|\xec\xdcD?v\xda\xf18Ӝ\xf5z\xe7'ďL?\x12Di\xf9\x82\xc6^\xd4\xe9<n\xec斩\xb5yQ\x02O\xc9\xe93*}(#!\x130\xbb^\xd7\xcc\x1f\xddī\x0f\xe8\xf3(\x10ٗ·\xb3g\x01Xr\xf5i\x1d\x9d\xc2\x16\x15\xe4m\x91\x05lՇ\x9d\xf4\xe7\x91\xe1^ts*\xd4zmh8âx\xf9\x05,\n .C>\x85\b\xa8\xb3\x06\x8e\x00(\xf7\xd9FD\x8c\x8bo\xeb@\x92\x05Mw\xfa\x13\x98Ϙ<-\xd3\xd7!\xa9\xf9G;Q\xbf\xbf\xf3\a\x10l\xad\xb4OK/\xe4\xfb\xb1\x0e}uR\x0e\xf4\uee29\xe4\x19\xabrRg\xab\xf1\x02\xd9䯁\x8eh\x10\x1f\xbe\xb0\x17Aaܞ\x882Ċ\x1e\x17Z\xcf\xd6P\x1bxP\f\x0f\xefy\x17=7\x91zE]\xa3aã\xdaK\x01Pd\v3\tO\x1aT\x10G\xf8is\xb5:\xf8\xbc\xc28\x9e\xdfޗ\xee#`\xeak\xfe\xbe\xb4'\x96'솴rj\xe3\x1e\x98]\xacYeVL\x15\xc2\xe9\x04*(㐱\xce\xc4&\xc0X\xb2\x8d\x0e\xdc\x19\xdby\x1c\xd2Wx\t\x88\x1d_\x04o\xc4\v}#t$[N\x1c\x1a\xbe\x86\x88-\x8d,\xf96\xd8\x12BG\xf7Ud\xc0\xd3(c\xb2\xb5\x84\xacшF!ģ\xe3\xf8q/Qc\x978cM\v\xd14<\xbcK\x83\t\x109X\t\v\xe9\xd2e\x0e\xe8؆%\x95\x83-oM2E\xc6\xf0\x97\xf1x\xc7\x03F@\x00Gv'Xoo\x83%\x80\xa2'\x93\xe3\x0f\xc8\x1b\x7f\xd5\x14t7/ί\xcc\xc65\xabn\x80E\x11\x17\xcbK2\xfe<w\xc3^-\xc3kh\xa8h\xdb\xc4\x10\xfb\xa2\v+E3\tG\xf1\xc6\xe5\x8e\xeeB\xb9\x1d\xc2\v\xcb-6KE\xc6KE\xd0\xdc\xef6ƿQ5\x8eCX\xd5\xe2!\xb1a\xccB\xc2\xf7\x88y\x83\x1f-\xb4c\xff\xd7<\x0fC\xb9P\xb98/\x83\x94\x15\xf3\xa1\v.T=\x0e\x14\x19C&a\xd9ɥ\xca\xc7\xf6\xf2\xa2\xb9T9\xa1S\xe0R\xad\xc6\xd5\xc9\xea\x14`\xbf\xe0\aD\xe1\xe67\xef\xb3\x7fN\x83\xf9W\x95fG\x00k\x87~\xbfҔ!\u05cf3^r\xc5S\xeeyU0+\xc3\xd3\x03\x16\xa6Y\x1f\x13g\x01\x13,\xcbTnY\x97\x92\x8a\xd8\x0f\x0fz\xdd\xce\x1a\x81v\x93\xd1|s`\xfa#\xcb\xe5`\xbfu\x03}'\xd8w\x8a^\x8a\xb4f\xe7\xc2\xf2\x8a\x8d&SQ\t\xc4:J\nѫI\xc9njx\xcb\xeaA\x1cM\xb9\x98\x85\x81\x8f\xc5l\xa3\xb9\xf7\x1cغ%KKV7\x10\x15\xfb\xfd\xd2i\x1e\x15\xfcj\xbd\xbe*Ĭ\xba\xa2锕h\xbej3\xeb\xf7\xe3\xf7\xd4X9\xb2\xe9@\x82\xee\xd7C\xb4\xef\xb8)\xd9t\x90\x8b\xe9E%\xb5\x1e\xad{}9\x9f\xd7\\\xd1\x15\x9bjK8\x8c\x9e\xda7\x1d\x8b\xec\x82\xd2)\x84ҕ{\xf4\xb4Q\x19kܛ+%\x9d\xc3ܸ\x80?36\xa4\v6\xa4符\x97:\xb1r\xca\xc0vn^\x8fϵK塶\xee\x86c\xefܕ\xb6^\xa7s\xb6\xdc\xcd\t\x85T+\x9djڑ\xea\x82-w\xa7\x84\x1e\xb00\xbc\xdfO\x97\xbb\xec\xdckQ\x19\x95:\x0f\xe3w\xcd\xceC\x00\xe91\xb9d\xe7\xf4\x9c]\x8f\x03\x9d\xe5s\xc6Xm\xf4m\x91\x9dpɐ1\xb0\xbb;cXe\xa8\"\xa1\x97\x1a\f|ww\xc1\xb0\x8aP\xa5\xb8 \x98\xb3o\xec\xfe\x8c\xf9\x8d/Yz\xce.I\xa0n\xbb9g\xd7\x1b\x90\x981\xc6\xe6\xeb5\xfe^\x18\xa88=\xd9\xe68\xbb.\x8c\x10\u05ccB\t]h\x12\f1\xc10N\xf0\xa7`78\xc0|\x06H\xd4YM\xdd\xec|\r\xb3&+7\xf4;\x05\x1c\x99H\xc1\x055j\x80\x00\x82\t\xf1\xba\fm}\xfcN\xfc]\x93D<8\x00^\xf1\xa72\xfd\xae\x06^\xfew5\xfb.\x84$\xdcħ\xc1w\xa0\xcdf\n\r\x8b\x831\xa8\x99c4}gE\xe5g\x90\x9bF\xd0\x02\xb7CG\xfd3\xc4\xfa\xfd.\xc0\vDK\x93\xd1\xfe\xbd\xfe\x99\xfe\xe4\n>\x89h7\x93镎\x7f̮\xd0pބ>\xder\xaf\x99<N5\x10\xe1\xe3h\xcb \x1b\xab.9\x1a\x1e`\x99F^\xf5\xb1N\xbf\xab\t\xb5\xd5\xed\xb3\xbd\x83\x90\x1dxԕ\x80~\xa8Ӱ\xb2\x14\xba'\xe2+\xee\x1ff\xc1\a\xa3\xe1\xfe\x9dF\xfc\xbdf\xfc\xe7\xf2<\xcc>\x93\xe0\x1eXl\xc1\xc9\xf1\x9d\xb9\xdf\x7fdeПWu\xea\x9de|\xec\xf7\xaf\xe0\x86\xb6i\x0f\xfb\xff\x9a\xb9\x03\x86Q\xecOA\xafp\xbf\xa6g\xec\xf1 \x98\xe9\x14\xf4\xd4\xe2\xb9N\xafv\x18;\xebü9\x8bw\xe1~\x7f!\xd2F\xd8`f\x1e\f\x82;=\x035c]|\xdd\xef_\x8a\xf4\f\b\xdf+\x86\xf3O*\xea9(\x8fY=\xe0\x02\x95\xf1\x1e\xb3+\x87)\x1f\x9e\"\xc0\x9ci\x9c\x1e슞Eg\x87\xd6\xc8[\x14\"}L\xcf\x06\x97\xe1NF\xb2\xf41K\xafX\xa3\xce\xeb\xf5̟+W]\xe7\ni\x9c)\xe9c\xf6\xb8q\xacP`\x00\x05N\x13L\x99\xf4\xa3\xaf\x8fmrIh\xcd\\\xbb\xb1ѓ\x8fY\x90\x8c\v\xf4\xf2\xbc\xf3x\xc0?*.f\xfd\xfe\xc7o\x10@\x9bմf\x1f\xe9GVBy3\x91\x9eя\x84\xe6\xfa\xa9&\xb4\xec\xf7a\x8b\x05a\xc2\xe3\xe0|[\xaf\x1f\a\xa7\x1b\x9c \xb8\xcb\xfb`}\x8eaD\x85\x8f뵙\x16\xe6\x83\xdc}\x10\x9c{\x18\xae\xd3à\xa5W\xec\xca\xc0\x1a\xe0\xc4I\t\x9a:\xe3(\xa5\xbaDj\xf3'\xf4\xb1\xb1\x83xP\x96\x98\x1a\xc44\x1f\xbf\xa9'\xe9c\xe0\x8c\xe9\f\xae\b\xb5]\x90\xea\x1aPW \xc9\xd2+\xc8\xfeqG\x1c\x8d\xf2\x80\x7f\xf4\n\x80\xb7`\x8f|\xcc\xce\xc60z\xfe\x10\x19\x1386\x1e\a\a\xe1\x95Vuq\x882\xff?\xf6\xfe-\xc9q$K\x18ƶ\xc2\xc0T3\xe1E'\x13\xe0\x9d`xp\xb2\xb2\xa2\xbas:o\x93\x99\xd5\xd5=\fV4\x82t\x06Q\t\x02,\x00\x8cȨ \xc7\xe6A\xa6\rH\x0f\x92\x99\xf4\xa2\x87_\xf6\xbf\xc8L\v\x90\xc9\xec[\xcal@[\x90\x9d\xe3\x178@022\xbbg\xfe\xf9\xc6\xfe\xe9\x9a\f\xc2\xef\x97\xe3Ǐ\x9f\xeb9\r\xf92\xf3\xce[\xc2=\xcfK\xbe\xcch\x16ot\u0087x\xb3\x17\xac\x85\n<x%\x96\xac^\x97?\xf0\xd09\xe3\xab\xd3[\xa5\xb3~\xd5h\x10\x00\xf9\xe9Ռ(\x0f\bFW\xec\xbc\x05\xdd\xd3\xf3Rއx\xc3\xce[Y\xbc\xd9\xc3Ut\xf2cD\x7f\x8d؏\xda\xf0R\xe1\xdc\xe4\xe0\x9a\xb8\xaa\xb8&.\v\xd7D\xa7_\xbf\xac\xd7oR\xfb\x8a\x96\x11\x1d^\x14\x97\xe4\xfeVB0\"\xb8\xf7P\xbb\xe8K\xe5\xbdh\xf7\x193o\x9a\xf1\uf44fKoٳ\xaa\xb8\xe1\xef'\xef\xed[\xe2\xbd\xd7c\xbf\xdd\xff\xa7\xe1E\xa5\f\xfbC,\xf8;\xb1 \b\x8cu\x84\xc9\xfd9%Z/\x96\xd3,d\x99\xa0TaYq\x99\x8du͊}\xd2\xdf\x1f:\x1a\x1e\xd6\xf5\xddS\xb7m\xd8\x03R\xf4\x06zY\xf6\xea\vSɔ\x8e\x0e*4\x98\xef&8\x86R\x7fWj\xa5L8c,\b'~\xd8hx\xb6\xd2\xc1\xe4\xc4ß\x05\xa3I\xfaH\vt\xb0#\xac4?\x87\f\xb0=OT\x84\xb8~W0@\x13-\\\x96\xec\x1e\xc36\x1dh\xc6\\\x9e\xfc/j#\x85N2g\x7fI\xb5b\xb2\xf6n\xa4\x15:w\xbb\xdf\xc7v\xf9-\xfd\xae\xcc&\xd30\u038bt\xcd&\xd4̢y\xb8\xdb٢Y\xb5!d\xc23\x80\x9ey\b\x8b\n\xe4\x9dC<\x94\xef\b\x18\xae\xd7Q\xfd\xfc\xf7\xb0\xe6\x87%\x95n\x96>T\xd08\xfa\xb4!\xf5\xfa\xb5\xac\x88|\xb0\x9eۮ\x83\xc2\xfb\x9f\xc1\t՟\xd1\t\x15(\xd4\r\xa8}\xe8\xfd\xe9\x95rٹ'\xa4\n\xa8\xf5\x1a@A8\x1e#t\xc0\x1e\xaa\xe9\x8f\x06\xa7Y8\x19\r\xbcL\xeb'\v\xbd\xee\x7f\x8amN\xbf\v\x95Yщ\x9b7\xf5\xbddM$\xa1@\x8a\x19\x9c\xa7/\x1el\xde\xdeK\xd9^\xf4wj\xef;9U\xb9\x93<$j\x0e\xd2\xe5\x14\x17\xec\x0eI2\x1f\xe7\xa2t\\\xbdk\x92s\xdci\x8fe,\xbcp\x8c:\xefFp:g\x1c\x9d&\n\x81G\xda\xe8(f\xc94\x9aQ\x1f\xfe4\xdc\x19MYl:=\x88\xcb\xd6^\x15\xc7-ų\x95V?\x1e\xfccx\xceGW\bB3 \t\x95\xa2~\xd50KC\x1c+\xbf\xae!\x8b\x95S\xa2|\x98\xe1W\x0f#4TJ\f\u0382:\x94\xe1\x98p\x16\x9a\xe81\xac\xc0\x8e\xa1F\x8e\xc5`}4<\xc0\x8b!\xd3\xf8\xe1C\xca2T\xf9\xa0'\x86\xb0\xff\a\xad\x98\xbe\xc4@\xf5\f%;\x19\b\x8b\x12\n\x9cNB \xd1🬔\xd1]\x14\xe9\xa1\n\xba\xab\xd5i2\x13\b\xa5'0\xe9\xedI\xc6u\xc5\x0e!9\xbf\"\x14\x93\xeePqC֔\x9e\x06~@\x9fKT9\x82\x00$\xe9~\xc6\xe9L\x05$%\x0f\xfb\xa3\xdb\xed*\xeaD\x8f\x15*E\x84(\x90_\xc1\nrXH<\xcc.>2\x96\xbe\x9d\x80\xa5^\\\\\xd2\x04\x964\x81%%\x7f\xca\xe0\a\x94\xb9\x0e\xb1\xacV\xd9\xfc[\xc7\x05 ]Q\x01\x87g\\=R\x00e\xe8\x95\xe4;\xfacX\xb6Jυ\xc5y\xf4\xafH\xb1\xea2\r;\x9f\xd3wL\xa8\x12?ٯR\xadti\v;\x06\xe1\xfc\x02\x19\xccZ/\xf3U\ne^\xa5\xf5z\xcfq\xceP\xcb\xf0\xa7t\"U\xbc\xbc?\xa5;\x96h\x90\xcc\xc7\xffkA\x14V\xb6\xd9\x12\x92\xb9\xc4\x18\xbd\xb4Lq\xa4\x95\n\x18\xd7dڸf\x921\xd7\x13\xb2\x12\x88\xe2\xc8\n\xf64ޡ5\x8dh\xeb\xc7,\x9fĿ\x86!\x11F.]w\xd4\xed8]\x82~B\xabN[\x96\x9f\xb6L\xeb\xce\x16b\xd7\xff)\xcc\x1dM\xa2#A\x90\xfes\x8a??\xf2;\x96\x88\x9f\na\xe0\x87p\x8c\x82?U\\U,\xa3\xb1\x88h\xe8n#\x7f\x99\x8eȄ\x01\x14\xa4\x06т\x7fb\x0e\x95\xed,\x8d\xacb\x90{\x91Vp\xc0\x82)E\xf5\x12L2\x95*\ne\f\xa7*\"\x1d\x91\x9d\xf8\x8dhQ\rĔ\xab`f\x90\x14\x13ʈ5_\x11\xa9\xd8'Z\x11\\u\xfc(:\x96ɗ\xfe\x1bc\xe9\x95@\x81ߚ;\x92\x97\xfd)\xcc\xfd\xbb\x9c\xd8'h\xac\xb5I\xe2,\x96J\b'\xbc\x15\xa4\xc2&N\x9dT\xa3\xf6\x1fJ\x00\x9c\xd3m\x86 C\x06\xc8K\x18\x8c\v_\x16\x19\xe5\x00\x03T\x81na#\xb9\xf9E\x05z\x94^;\xa9\x81N\xcd\xe3B\r\xd7c\x8cSc\x1c\f\x1co$\xe5}/7\xaa6*9\u0604\xe4\xd0\x17Or\x10\xe2\x9e\x16ĉ\xa6u\x0f\x95\xe2\x15\xa6\x82aȒ\xaaPٙہ#Ȓ\x80\xf4 \xbaO\xc1y]\xd1R\x94f\xa5`\xcc4\xa9\x8cڑ\x15\xa2vdUQ;\xb2b\xd4\x0e#\x1a\x027\xa2o\x8bs\xc7\xc5_\f\xb2\xbc\x14\xce;M\x0f\xad\xff\x14\x1eF\xd2OY[8\x9b\xe2U\xa4\x16'\b\xa3\xe8\a\xd75\xae\x9frL5NR\xd6S\"\x89\x92!\xcb{e1\xf2\xc7\xd0N\xf2\xf8\xf31\xaaQ\b~\xe0\xf7^ʆ4FU\x04\x83M\xf8L'\x9b\xa9\x1fe{6\a\xa0v\xdb4\xa1\x19\x1d\xee\xe2\x12(\x7f\xa4\x02\xc4\xf0\x87\x00\x04_\x05%xQl\xa2\x83M@\x03X\xe1\x05-\x1c\x03\xf6Ⰱ\xd7\xc5\x06F\xba\x01\xb3\xde\xeb\xc3z/\xd5Z\xfc\x1e/tc\t\xbe+\xb4\xd8\xeeV\xb6\xf8]\xa1E\xa5\xbe\x0e\x1bR\xd6*4\"[䱭\x95\xfbc\xb91Ͻ\x94\xb9NQi獗\xb2Q1\xe9\x17(Vr\x93\xf2\x01ҺŴ\xb7\x90֧QA\x8e#\xb2\xdey)k\xb7UZ\xd9\xfa\xba\xe3Hm\n\xf0^\xab\xc6\x0f,w\xf5ڲ3X\x93\xb4rI8\x15\xde\x16\x00\xef\xeb\xb514\x94\xffx\x80\x8f\xc5\n\x0f(\xa7\x11\\\xa5\xb2Rb\x9ah\xfe\xfeH\xa5vG\xd72\xc7\xf0\x92\xf2\xaaV\xfeESHf\x1b}*\x1c\x0f\x1c\xeb\xfb/\xe5Z8\xf9.-F\x8cNx4\xc9\x7fzә\xc4\xeaf\xa3f\xe8\xb4\xfb\x82\xba\xbcWv\x8b\xa2\xa2U\xab\xf6p\x80\xc1z#&\x89\x82]\x8f\xb7\x8a\t{s\x99\xffY\x8fZ\xd3\x1b\xf2\x8a/t\xa4\x88\x90\x82d\x1dSruBQK\xf2\x94L\xcaA\x8dθ\xa2\x0fT\xc1\v\xe5\x05\xcadz\x18*\x10\xb7(\xa5\f\n$1thaXH\u038d\fEz.\x00g\xbff`\x81.R\x8brp\xc8j\xba*\xaf bg\x85u0\x92\xca\xf2y\x967\\(gJ\xfa1\xa1\xa4\r`\xae\xc4K\x93r)\b\xf5\xcd\xc1˞\xdfc\x00\x91s\xff\x9a'\xdam\xfb\xf7~\xe6\x97\b\x9d?\x97\xe8\xffΩ\x9f\\\xa3\x8c \x95\xcf\xe9z]+\xe3\xea\xacig61?D4\x17\x19\xc2D\xe1'\xef\x92~\xe4¹&0\xe8\xe0\xafgY\x8d\x88jx\xe7\xb4\b\xcfY\x19XM\xd3A\xbe-\x06݈\x99vEM}Aa\xa7l\x15\xda\xf0\xbe\x02d\n@\xec\xc1{\xf3_\xb8\xe1\x1cL9\xc2M\x89\x88\x19\xea*\x85\xaa\x03\xa5\x0f\xc5\xdc\nYb(W\x86\x05\xe5ʐ\x85%\x1fE\xe0\tGI\x85s\xa7\x8cw1T\x04r\x90\xdc\x17\xeb\x94\xfc\U000fe4a4\xc9+\x9e\\s\xe1\xc5\xeby\xb1\xd5\xfd\x1e\x1a\xa8RT\fɸ<\a\xf0?r\xf8\xa6\xdeJ\x87\xc3c1\xb2-z\x95;\x8f\xed\x84nihhD&,T\x16?˸D\x8efj\xb6\x13\xfd\x8b%^V>\xb4\t\xb53\xd0_\xf6iJȡ\v{\xbeׯ\xa2(\x97\x14IX\x91q9\xd5\xd1e\x11\x01\xc5\xe7\x188\x13\x8bЎiJ}B\r\xe5\xc2l\x9b\xd3\xe1\xc2\xc1\x99T\xe7\x11\b\x16\x02\x11i\xb7\xc3ʅd\xbe\x13e\xab\xafd\xabY\x1d\xa6Ӵ*\x97\x19e/\x1b\xf7\x06\xfb#\xb9\x83S;6~3G\xbeJO\xb3I\xe2\x99\xdc\xccHv)\xbb\xa6ءi\xcd.3\xf2\x1a\xf1\xb6xz\xc5h\xb4Z\xaar\xf0\x12\xc4\xd1\x1b\f\x12\x93\xd6\xeb\x87iE\x8c!\xe3%\x02l$\xa8\xdc-\xef\x04\xdd\xf4\x89\x83\xd0$gK(^l\x1d\xaa\r$h\x1b)\xe2\x81'\xbc\x12t\xc0:<\xc9o\x82\xc2}\x96\xd0-F\x8a\xe4S\x1eϘ.E?D\xe8\x1d\x90\xe7\xceǸ!\xc8\xf2\xd0F2\x0f\xd0_\x88\xcf/q\x83\x9d1\x88\xd2OZ\x97\xd7<\xfb\x13O\xd2 \x8e\xc61\x8b\xed\xacu)b+)S\xf0\xe4s\x18s\xf2\xd9\x12l\x9a\xd1x\xe6}\xb6\x9c\xe2\x04\xc7\xe0\xba&H[\x97\x81<\xf6 Q`\x06\x89\xefo\vOJ.\xf0\x147Dw\xa3ҷ[.`\x0f\v\t\xbb\x9dUCL\xd3D\xf7\xac\xcdM\x1cDYSYR\xd4,]\xf8O\"\xcao>\x96`\x9b?7T\xb8\xa3JW\x8b\x002\xf29\x120\xbf8\xb9#\x9c\xbbX\xbd^\xe2ql\x86c\x90A\x14\xb6v@\xc6\xe2\xf2\xb6\x81\xdfʷvF\x03\xcaa\x01\x95V\xe5\x91\xc1\x94bZ\x05K;\x03\xbf\x8f\f\x1e\xe8\x19\xe3\x93Q\x19\xbcJ\xf2|ό!/<\x9e\x89MȌ%=ɀ\x15g\x04L^\xf8\x99\xdf\xc4\xc1$q\x9cY\xc0\xfc9Ɉ戎\x13\xa9\xba\x89\xad\x8eIэ^\xa2\xb5)\xe1\xd8\xe1\xd1vh6\xb9\x97'\xcd;q\xf6*L\xf6^x\t;Xfz|\x8dÇ\xd68\xcc\xd7\xf8<,D\n3\x97\\S\xf25\xac\x95\x83H\xba5y\x18\xedǑ\x10m\x93\x84hK\x12\x02=En\xed\xec L\x9d\xe3\xe4\xea\xe5\x7f\xce1\x12M\xc8\xfe\x8fi)0\x8b\u0086\xf2%a\bk9A\x8d\xd0\x02\x83\x00\xf7\xd4\xe4n\xecv\xebX\xdf\x1d/\x03\xf0\x17\xa9#\xca2f'u\xf0,+Ɂ\x97h\xae\x96\x15\x88\x82\x7f\n\x00\xa5\xfd\xf1\xc0v\xf0{\xbf\xe4\xf1\x18C\x01f*\x14\xe0\xa7\xf8\xc0!\xf2;\xdf.\xe0˒\xb5lɰ\xf0\xc0ɝ\x8c\xb3\xa2\x88%\xe8\xa7u\xa9\x88\x94Ml\xff9\xa6q\xebR\xceT\x9c\xfbr\x02\x8bJ\xa6\x8d\xf9Z\x96\xddG\x19Bˤ.\x9d\x80\x19|\x1c2\xf9\xe7@n\x8fg\x1f\xc6'\xcd٣\x19\xf3\x95\xb7Q\b\xf6 \x99#\xe2\b\x8e+*\x16\x06\x88q\x86#V\x18\x84螖c\xff\xe2ͮF\x1d\xab.\xc7F\x8cNS\x8d:\xaep\x97e\xc7F\x94La\xbd(\x02e\xca\xdf\aL.1\xfa¤\xa5\xca\xdfA@\xecCwh\x99\xe6\\\xfe\xaa\x16R\x1dG\xbd`\xfb\x97\x81\xb0\xdf\x03\xd33=UA\xc4!\xa8j\xbfϺ1\x13v\x853t\t*\xb4\xa0~^\xf2\xc0\x9d\x1d&(\x05tBy\xe9@ј\xdd\xc4vFW\xf9\xb1\xa2\x81\x8f~\xcfi\xcc\xd2\xc0\x16-\xd0\b\xd0\f$\xea\xc6\\z\xc0\x12\x895\xcdU-\xc5W{\xa2p\x0e\xba\xe3\xccY&\xe8\xb9\x11M\xec\xca\xc12\xd4<\x0e\x8c%\xefb\x10\xb6ț\xef\xc4\x19\xe3A\x15k\x8a\x0en\xabځ\xe1\x89sk\xe0>\x992\x91\x7f\xc5\xf3\x1c\t\xa0\xb1\xb8\x05\xa3*\x19\x960\x89\xac\x98)\xa8\x99\xc1*F\x14u?b9\xf2\x84\xdd\xf9\x05\"\vOy\xf1\xf1\xc32\xfaITEO\x12\x19\xfb\x83\xb1\t'`\a\x96\xe8p?\xb0X\x0e\xfdN\x17\x10;\xa4cC*\xe8\x94\a\xb0\xef\xc1I1\xd8*c\xee)d\xf9\xf7\x04(\xdbg1\xdc}AF\xec\xb8u)_\x16D\xb1\x91b\xea31t\xd37O5\x11\xa2Q\x172I'\xae\xe7\xe47\x86\xa8\x84\x84\xb8\x86!\xc6\xd8/\xaa\x8a\x8b~\xd88c\xec\x83N\xea\xaac\xd9\xde\xdb1\f\xfe\x9fc;\x86\x1d\xf2\xb5S\x93\x8c}c\xac'\xd7~-r\xa3\xbb\x8c\xfd\xf4p\t(\xf2\xc3\xc3E\xc0K\xfboF\x11\x18\x878\xda@\xfc\x1a\xe5K\xac\xbc\x8eӧ\xb1ɹ\xabe%\xafA\x1aG\xc4\xe5\x9d\xf9Fঈ\x96\xc0\x00\x9fu1\x84\xb4\x012\x12\xfa.:&:\xde\xe2O_\xd6\"^Q\xe2\n\x8e\x8a^[\xb55\x9dV\x87\x89\x0e\x82ᶥ\x97\xb6C\x80;~\x11L\xe2\x96\x19\x92\x8d\xce}\xf1|[\x88\x13&\xc9\x14z\x18\xef^U\x03\x88\x8a\t\x10\fԸ\xfc4\xd1a\xfb\xa2\xd7C\x1f\x9ep\a\xfd\xe8\xb3?>\x106\xcaTd\xa7\xbf\xf9\xe8\x94\xfbW@c\b\x8e\x1a\xcem\xce\xe2Ͻ^\x84\xabpᇖ\x17\xfc\xd0\xda>\xe3\xd3\x18\x1e[\xb7q\xf2\xf1\x05D[\xb9Nx\x9a\xcaw\xd7\xdb$X\xfb\xc9\x1d\x94\x01]\x91\xdf\xcbw\x90/T\t\x13\xf6Ɨ>>\x11(u`\xebd\x9c\x8c\x89\x92,5;\xca\a\xfe\x0et\x9d\vaF\x10Q}\xa7\xc0$\x91\xb4\x97FQ% ֮\xb2\x90\x1e\xd3@Q\xaf\xff\xe4+\xa0\xa9\x06D\xff\x01w\xc10\xf8\x94Ź\\\xe4\x9b\b\xc1r\"dD^\x1e\xa8\x0e3\xfcBxA\xb7O\xe8\x9f\x02\x014r\x1a\xa9\xb1\x10J-\xbcȀ\xc9\a\\\x11\x8bY\x93Z\xa50ʟ!(\xe9\x01\xdck\x03T\xb5)\xcf\v{噫^\x18\xae\xfb\x88\x93\xfd×\x9dl\xe5\xeeYmu\xa9\xb5\xf2\b\x86ڽ\xdaC\xd5\xf2\x1d;\x98\x81\xe3q\xef>*\x13·\xd38\f\x95\x02wSN{\x87\a\xb4w\xb0\xb4\x05\xf9\x1d\x96\xc9\xefR\x02\xf3\x15\x15\x86\x91\xf5C&iz\xa1z\xe0\xb3yd\x87\xd4'\x13\xc7sv\xd5\xda$\x97s?\x9coC?\xe3\xcfW\xa0'\xbc\xf8.\xc8\xd2ɑtl\xcc\xd3ƣ\x1di\xe6\x94/\x12c\x06\x8c\xd7\xeb'\xc6C\xe9\xdeD]\x9a\x85\xa85\x82\x14\xfa\fթ$\xa8\xec\xa4t\x14\x88\xa1/\xa5\xbce\x14D\xad\xc6#nK\xeeS\x16ʭRo\xeb9\xdb\x16$\xac\xaa\xbd\xb9\xd09\x9ak&%\xea\xb3\x00\x8d<o\xc5W)On\xc4\xd4\xeb>!\xf7\xc0\xb1\n\xa5;v{\xae\xa2_֛*\x00&z^\b\xe9\x9c\xc0FI\x8b\xf2D?\\\xe6\xa6\xf5\x03Lo\xae\xcb\x10\xea\xfbz\xb6\x00i[\x9d\xa5L\xb0\xd9\\8G\x11K\x06\"\xbd|0\x82w\x9c\a\"D\x94\xa2V _\x98\x94h\xa5\x8f0\xd7\xc5JY\xa8\x16#\x15\x8b\x81\xe6ఈ\x86\xbb\x94\xbc\x15\x842m1z\xaf\xf7(\xcd}\xa8\xa8J\xa9N܇,\xdd\xcb\x03\x16\x17\xcf\xd4\x114\xac\x9d\xb5\xc6\xfa\xbd\x01\x10]<[$oJ=\x18\"\x16\xd91K\x81\x11\xec\xe7\x9e@̭$\x85\a\xc4q\f\xa5\xb1\xa2\x8f\x14\x9a\x1aFi\x04\xf479+*\x8a\x89B\xbe$\xa0\x94\x8fG\xd9ҏ\n\xc5T\xb4dV\x18|\x1e9>\x8c\x13\xbf\x9a\x94V\x8f\x1f|\xd4Ll\x0e\xaa\xa8\xf8\xa0!\x1e:\xe5R\xcb|+\x88\x97\x98\xa8\x87\x02\x92\xfd\xa5g\x02\xcfg\x94ogZ\xb8z\x0e߱\xf9\xeb\xb5$\xad\xe8\xf5\xe5c\x94\xeci\xbc\xcdUh䃮@\xc9\va\xd0\x01\x0f5\xe7B\x97\xdb؊\xb0T\x15\f\xb0\xc3F2V\xba\x18\xc7|\xabl\x9a\xf1O!\x94>\xb2\xae\xa5\xbe\xee\x9efYa\x9c\xaeV\x93\xac\xd7mt\x19ܥ \xaa\x82\x1d\x84\x0f\xa8\x92|\xaeJ\x7f\xe0:\xc3a\xbfPS\xa5A\x03QV~\xe5\xe4m(cm\xa8J\x13\x86\x8e\xa5\xc7\xd8**\x83b[\t\x847\x8e\xb3\x12\xcbT\x1dW\x9b\xec\xe9s~\xc0bS\x8c\x84b(\x00\x90\x1a\x88`=4c\t\x065\xa0ỹ\xa4\x10\xf3@:\xa4J\x98\b\x05\x9c\x9b\xa9$\x85@@\x92HL\xc0\xc1Tr',\x91\xe2\xe4Y\x18ڢ\xd3)t¬\xc6?\xbd\x7f\xf3\xba%4Z\x82\xe5\x9dmY\x8d\x8c4\x9e̦\xd0%\x93c\x98=\x81q9\xe3,W\x16Δ\xcc b\xc94CϺ\x918YQk\x19'k\\H\xf8\xa1$\v>\x9c\x1c(v\x12\x97\x9e\x0f#\xe0Q\xfeŎ\bM8\x1e\xd3\xfd~\x7f,&B\xfe\\+\xc6D\x90\xe48,\x9e\bfP\xaf\aP\xfa\xe4$\xc9\x03\rd\x10Y`\xbf\xa7o9\xbb\r\xe9;~\x10\x98:\xe7\xd4K\x15\xef\xae\xe9D\x0e\x8c\xf5\x87\x94\x1b\xb6\xfa\xaaҡo9\xbf\xec[\x8e\xbe\xe2\xe6!Bmɑ\xf0:m\x1e\v\xd3\x02\\\x1d\xb58\x1c+g\xea\xbc\xc2_>\x1c\xeb\x92\xd7\xe160\xb1L\xe9\xb7\xe1\x93\x0f\x8e\x1b\x8cio\x13\n:\xf3dO\xbf\xe7%\x18.8Hi\x7f\xb9#=A\xbfm\xd9\xfd\xf9\r\x8f\xb2ԛF1\x8dc\xea\xc7\xf45\xa7\x1f8}\x15\xd2{I\x00y'\xee~\xb6\xa7\xdb-\xbb_\x06\xd1\x02\rE\xbe\xbb\xfbC\x9cf/d\xc8&/\x89\xe9\xd56Z\x84(=\xf0\x1cz#\x9eG\x9e\xe5\x0eZN\xabmQ\x81\xe8x\xf2֟\x7f\xf4\xaf\xf9k\x7f\xcd=KHm\x16\xf1\xda\xda\xd3\xf9\x96\xdd\x1bMl\xb7\xad\xfcK7\xb7ݶ\xe4\xcf\xca\x06\xb7\xdbVE\xb2.\xfa<\x8e\x96\xc1\xb5YJ\xa4\xd0\xf8\x86'I\xb0\xe0\x7f\x88\xe3\x8f\xefs\xfe\xd5A\xf2\xf7\xa8k\xfb\xd6\xcfVG\n\xbc\xe3pb\x0f\v\x18O\xa9Bұ\x061\xb3\xdc\x18\x18\xe2I\xb7LB\x9d$\x91\xe9\xf3\x15_lC\x19\xf2V\xa4\xcd\xcb\xe1\v\xde\xf1\xa5w4\xb4\x01쪹\x9d\xdf\xdd\xe1\x1e{&\xfc\x16\x9fh6g\x7f\xe66\xe8\x15C\x82\x19\xc6kO\x8f\xc1\xc8v\xdb:\x92\xb5\xdb\x1dڊ\xe0\xb5s0\xb2\xf4\x87\x18\xa6\x92\xf0tU\x9c|ub\x1cgz\xedd\x89\xc2\xd2]s\xb5\x1ab\xc2\xd8)\n\xf4\xb6т/\x83\x88/\xf2\xa8闗\xefΟ=\xffp\xf9\xfd\xf9\x9f>\xbcy\xf3\xf2\xfd\xe5\xef_\xbe\xf9\xee\xd9\xcb\xcb?\xbcy\xf3\xc7\xcbKq\"7[\xf6p1Į\x9bm+H\xbf\x0fR\xa0\xef\x16\xf5\xfaf\xdbJ\xb7\x9bM\x9cd)\x0e\x03\x95\xdf\xdf\xc7\f\x8aE\xc0\"\xb6\xe7[B\x9fA\x82T}\xbf\x06\xdd\xf7}ֺ\xbc|\x7f\xfe\xfc\xdd\xf9\x87\xcb\x17\xaf?\x9c\xbf{\xfd\xec\xe5\xfb\xcb\xef\xdf\\\xbe~\xf3\xe1\xf2\xc7\xf7\xe7\x97o\xde]\xfe\xe5͏\x97?\xbdx\xf9\xf2\xf2\xbb\xf3\xcb\x1f^\xbc;\xff\x9e\x85[\x9aI#\x91\xb7q\x92\xf9!K!\x05\x16\xfa\xfb7\xaf`\a\xcb7\xae\xd8r^\xf6>\xe2\x16Č*\x97k\x8f\xd6%\xbe,T\xd1l\xeb\xec(\xc3R\x1eͲ\xca\xcapH\xca* \xed\xfe\x90\xbeA\x0e:蕥\x00\x8c$\x0f\xbbP\x80Ӭ\x12N\x81vܦ\xab\xf7w\xd1\xfc\x18n\xcd}\xb6$\xda\xc3\f4gxE\x05\x1e))\xb8\x8a)\xdc>ƽ\x83\x1e\xa7\x10\xadC\xdfJ\xb9\xabL|<JL\x18h\xa2-\x03b5\x01\xea쐖\xfc\xda\x06]٠$,\xb5j\xf7\xb3\xac\n<NP\xa2_j\xbc\x9b\xb7}r«\xa4\xd8`\x81]\x94Ǫ1ȁ\x9c\xb8\x05b\xb4\xb2\x11y\xe3\x16\xe8T\x10L;r\xfc\xf2\tu\x85xN\xc6\x12O\x81\xb002\vg\xa1\x92N\x142`\xfa7\b\x7f\x8b\xc3\x11\x1b\xf5~{\x95%\x9c\xbf\x88\xb2\xb8Z\xb4/\xf4\xc1\xe4\n'\xd5ۗ\x1f\xcf\xddN\x9f\xae\x83\xb3W\xaa\xda\x19\x166^tu\xe2\x82\xe6?\xcd\xd4\x05\xcb\xd4ս\xa7\x83Ψ\xd3\xf3$<\xb1\xb3{k\v\xcf\xf8,\t\xe6\x995>ɵ\xd8\x04m\xf4娳R\xd8\xf5p\x95\xd6|\xc5\xe7\x1f\xbf\x7f~\x8e\xb8\xf2\x91em\xd3th\x1eGi\x1c\xf2\x16\xc7E\x01\xf5\x00\xb4\xfc\xe1\x9f\x10\x0f\xb3\xc4\xeew\xbb\xe0o\x8a\xb6;\xa3\x8esl\xf6H]Ә%v\xb7\xd3\x19u\b\xf5\x99uz\xeaGqt\xb7\x8e\xb7\xe9ٙE\x03\x93\xa6\f\xa2\x1b?\t\xfc(\xb3O\\j\xbdXK\xbe4\xdc\xf7辷\x06\xb3\xaf\xe1\x80\xc1{\xe2<^\xf0Z\x90bw\x9b\r_\x80\xbf\xc5M\x12/\xb6\xd8\x1e8N\x1c\ah\x81\xf1\xeb\x16HK\x16Hi\xdb\xe1u\x1a\xec\xf3\xd0=\x86\xa3+\x85v\x158<K\x12\xff\xae\x15\xa4\xf8\x17DF\x96\x0f\xbf,\x8f\xd7Tx\xcexY{ǯ\xcf?m&J\x80Y̌[/2\x9e\xc0\xac&\xf9\x04[V\x83\xb7\xb2X\xf0\xdfm\xd2J7a\x90\xd9V\xcd\"Sg\xe6\x19j\xba\xa8\x1d\xa4\xbf2[\x19\x03\x044\xcd}脬|\x12閉!\x87g\xfdI\xd8\xec\x83f֜\xf5\xc7\xf3\xd3p<o4\xc8v:o\xf6g\xc6)\x9d\xe3s(e\xa0\x13Fc\x16\xefv\x8a\x1f\t\xa6\x95\x1a\xd7C\xcc\xd1\xf0N^\\t\xaa\xc72\x83W\xf4\xdcG_\xa12\nI\xa0\xd60\x9bD\\\x1d6KmM\xcdjl\x1aV\xed\xafV#mX\x7fEǛQ\x9c\xe5\xce7ag\xffj5\xe2\x86\xf5ז\xa5\x9c\x92\xaa\x90\xc6ƅr\xe2\ua8db\x98;_(\x03ji\xf9\x92\xce\v\xc8,a\x96\xda\x1f\xd8\x14\x1a\xb1\n*/\xdf\xc3V\x90\xaa\x9fhf\x91\xc1Q\xa1[\xf3m#8H4P\nO\\\xbe5O\"[\xf9b߲\x10\x1c\xa5\x1a:@ry^D7~\x18\xc0\xea\xf8bu\x02X\x9dx)\xce\xc1_\xad\xc6\x16\xbe\x81:\na\x91\xb2X-\x12\xad\xf1O\x1b>\xcf\xf8\x02R\x12\xb1l{\x93v\x94\x1b\x93\xd0hoG\xec>\f\xd2\xec\xcd\xd2K\xe9\xda\xdf\xe0\xdf8\x81'\xc0\xe2\x95\xfcLyf&\xbf\x97\x9fi\xe6\xcf?\xe2\xaf@.\x03~$|\x1e'\vQb\xe5o\xb8\x97*}\xdfTt\xf1<\xff\xca;2\x12a8^\x00%\xbd\xc0(\xe1\x050\x90<\xe9=~\xe0 0\xebW/\x90}{\x81\x1e\x91\x17\xecI+\xd0\x1b\x06\x066|\xc1氺\xf8Ӣ\xc5\xfd\x14\xa9\x84Fy\xa5\x8f\xfcNT\xf9#\xbf;\xa8\x80i&r\x8c\xf6t8\xec\xf4F\x88\x15+qbª|Cܭ\xaf\xc0i\x9a\xf8\v\xcfd\x1a\xb1d\x92\x7f\xda\xe2E\xa8\x98\x83\x16\xf1\xfa\x8e\xebth\\Yj\x83W\xb6,ԧ~e\xa1e\xe2_\x1bm\rhPYL\x8c\xfd\x12\f\xced\xc9!M\xab{M\xe2e\x10\xf2D\x14s\xbb4<V\f<\xe1\xcabΈn+\x8bIֽl̡\xf3\xcaR~z\x17͍\xc1\xb9.\xdd\x1ckN\xbe\xfc\x8a\xa5\x97\xd5k\x13'\xb7~\xb2\xb8L\xf8R\x96l\xd3U\xf5\xf2\xc8w\xa7,֡\x8b\a\x8b]\x02t\x8b\xb2mp\x89VU\x16\xc4J\xb2\xb9\x1e\xbd\xae,\x12\xfa\xbf\xdd\xc9\"}zSY\xe4*\x8c\xe7\x1feO.\xbd\xab\x9e\xe66Z\xf8\xa8x/\x81\xc5\x1dЫʒ\tO7q\xa4w\xcd\x1d\xd2\xdb\xeay\xce\xe3\x8dZ\x8bQ~\xaf\x9e+E\x8c\x87l\x9dt\xd8F\xa5t1.\xb2\x1bk\x916O\x93&\x882}.\xb8\xcd\x1b\xf1\xc7\x17\x7fR\xf1'\x10\x7fV\x9e~\x85)ӫ\xbc\xadz=\xefS6\xb9\x15Ֆ\xe2ϵ\xf8\xb3\x16\x7f\xc2öd\x02\xfaoIy-6\x12\x8c\xb8\f\xc65\x02\v\xc2\x18\xdb\xec\xb3\xd63\x00\xe1W\xf0|\x98Ӭ\xf5\\\xc3)&mD\x92t\xbd\x94n\xd7<a\xdb<\xed\xad<K\f\xe8v\xa9Ċ\xd6T?\b\x00~Ǘl\t\x9f\xf2\xac3\x9ff\xad\x97\xfeow\xec\x9af-\xb0;`k\x9a\xb5$\x89\x1f\xc3Oy\x88YJ\xb3\xd6{<\xfb8\x90\x00>%\x10\xb3\x15̀\x12\xd2\x03\xaf\xb8'a\xb2\xbb\x9d\x9c\xe6|\x8f\x15Js\xfb\xa4\x12\v\xb3\xabhK\xb6\xb2ݛ\x15\xf4ԏW\bE\x05\xb5.\x87\x05\x1f\x02G\x13(@\x0e#\xda2\xd7\xf5h\xbfKYV-\xfa\xf1\x92\xbe(\x89;r\xbcԵ(\x85\xdbu\xbc\xd4Z\x94:x\xae\x95\xcbŲ\x9c\xda\xe8\xe3%SQҀ\x82\xe3e\x03YV\x81\xc8\xf1\x92+Q\xf2O@ݜ\x1b\xb2\xae\x8a\xed90U\xad\xf4\xea\xc0w;\x0ek)\xfelğT\xfc\tğ\x95\xf8\xb3\xd8\xed\x1e\xder\xbb\xb0\xe7\u05fb]\xe1{]\xfa\x0eK\xdf\xdb\xd2\xf7\xb2\xf4}W\xfa\xbe*}ߖ\xbeo\xf0\xd5\t\x9fo\x96\xec|O\a\xedѠs\xec\xbde>А\x04!P\xa1\xeb\f\x1f|\xa0\xb1\xc4n\x83\xd8\x1ft2\x05E\xe1\xe3\xdf\xfe\xd8@\x1a\x82<(\xa0\x03A\a\x18\xe8\x02o\xfc\xb10\x03\x10\xd7z\xca\xc4\xc5\x1d\xe2\xdf\xf6\xd8\xc0\x1fxS\x8e\x05\xf9+\xae\xb99\xfe\xed\x1f\xb1\x188$\x8f$O\x91\xe5)\xe3\x98m\x0e\xa8$\xea牊(2\xd1\xe1\xe6\x90\x18*\xcesSI\x06\x99\x13\xdf\x1c\x92?4(\xa4Jj\x87\xa6ls@\xdc\xd0\xd0\x18\x83It\x98\xe8vsHl\xd0-\xdb\x14\xe9\x05:g\x9b\"y\x80o\xa4\xe5#\xc8M\xa4u\xb38\xc9\xefk\xe4\x03\xa87eƬU\x96mR\xef\xe9Sl\xfe\x97\xb4\x15'\xd7O\x17\xf1<}\x8a<\x82\xe6\x82\xc3s<i\xad\xb2u8\xd1\x0fy\x06\x8f\xa8\x84\xb9\xe3\xe4\x80=4N\x1a\r\x925\x98U\xf7\x93\xebt:\x83\xa2\x11\xb4\xf1\xe3\xbb\x17\x9a\xa9f\xe7o\xd2d\xa6\x9eH֫ \x12\xcfB\x11\xe3\x01\aP\xfb\a\xab\xc1\x1bָv\x13\xa4AV\xb3\x1aY\xc3\x025\b\x8c\xc0\xb0܆am\xcd\xd3Կ\xe6\x10j\x01\xce\x00\xa4Gq\xd4\\\xab\xc6\x16\xfc\xa6ƣ\x9b \x89#\xe8\x11+cEl?\xad\xf9Ѣ\xe6/\x16\x18>\xd7\x0fk+\x1en\x96۰v\xeb'Q\x10]\xa7-k/\"\xa2\xdc\a\xe9\xabx\x1be|\xe1\x1dp\x1aN\xdc=\xe5\x11F\xcc\xf9!N\xe6J*a\x94\xd3\xf9\xef8\x06\x84x\x9f\x1d+\xf0\x9eg\x87\x99{\xbaf\xf7\x067\xe3\xba`\u05fb\x11\x8e\x12h\xc1\xa46\xd3\xde>R\xb6\x16\xbf\x95Bq\xb2\xdb-\x8c\x18\x8eЁ\xfe\xba\xfb\xdb[\xbe6\xe4\xf4eO\x19\xec~O\xcd\xfcT\xce\xf6оG\xa1\xf3\x13\x03\x9d\xe7\x00\x7fr\x80\xe4\x8b1\xd7W6F\xe85\a\xd7*-\xb0\r\x99\xc8\xfc\xb5\xd4(,R\x1c\xdd2\xdf\xcc\xc2%Vլ\xb1\xf1\xaae˨\x0fM\xdf\xe4M3\xa3\x1b\x19\xd7\xe5\xce\xc8\x05n\xc1\xcd\xf8\n\x96<͒\xed<\x8b\x13vG\xc1[\xa6Q\x8f\xd0+\xb8\xed\xb7\t/\xad\xf1\x89#óha\xa6\x10,\x9d3);\xc8g\xb8\xf2\xd37\xb7\xa0\xe0\xb9\xe1IvG?\xb1{\xb0\xf1\x05\xf6:_\u009f\xcb˔\x87\xea\x172\xb2\xc0n)\a\xc4˂\x89\x0e\xf5a\x7fe\xe8'\xa9\x8f\xa4%\xc7\xc2p*\x02\xa6\x8f\xe6 g\x00E\xe8\xc7\x02\x7f\x11j\xe4|\xe4w\xf5\xba\x1d0\x90\xfc\xc3\a\xa1\x199\x17\x86M\x19\x05cғO\xa5\xe1ېj\xfb\xd3h\xc62`h\x8d\xab\xf9fͶ\x12\xe5\x84\xc4\xcfu\xe1\x12\xedH\xc3=\rs\x1c\xa9\x99l\x80\x87\x1d\x93\xb9V`\xad5ڳ\xb1\xd1\x18\x06\xd6EJS>$\x84\xf6\x91^\x81\x90\x95r4#\x1b\x86\xaff\x11\xe2,\xcav\xd81\x85\x1f\x1eGs\xec\x00w*\xa5xR=\x9f^\xa2\x8bX\xefV)\xf2\x19\xaf\x94\xf7_E\x1e\xc7{\xe1\x0f\xf3\xe9\xc5\xd3\xc6\xd3\xeb|\xe7?\x9a\x1c\xb6\x87\xdb\x13\xbf\x90\xe121\x8f\x91\xb8\x82\xee-fy\x16s,jy\xf0\xa3m\xedՍ\xf0\rpP\x13\x811\xed\xa7S\xe6͞^S\xbb\x82\x00ͦ|\x06r\x10P\x13\xc1~\x88\x97\x01\xeb\x15\xa9L\xbb\xd37\x8c۞k\xa5\nͼ\xd3\f\xe1\x02\x1b\x1f\xfc\xfeZWq\x1cr?\xc2\xcf\xddΖ^\xdb$`\x9d\xb8F\xa4\x02NBeZV\x93\x0f\xd0T*\xd3Hb\x17ߘV\xb4]_\xf1\xc4\U000b0d211\xa2\xf8\xca\xc7\xfc\x82\xc4\xea\r\f\x151&\x96r\x19X\vX`\x87\f\xdd\xee[\x16j\x96Y-\xab\xf1\xd1\x0e\xc1\xd3VD\x8b\xfc\xed\x80@\x18e˒\x9c_\x8eA\x19\xf3U~F\xado\xeaO!\xac\xd2S\x8b\xd0\xe7v\x80\x8beY\x95\xcb\xce\xc1\xa7\xa1\xd4q\t\xeau\xfb\xbd\x1d\x10<\xb3U\"\xa5C\x00\x16\xaar\x00\xc5\x19B\xb1p\x8d# \x99\x8b\xbbG\xc13o\x89\x1f\xfb\xbd\x1dФa\x9f\x04\xb0˻]\bڔ\xe0<\x8b1L\x99X\x96\a@\x80\x1f\xa4zV\r\x8e*\x85\xa8\xb4\x1e@\x1cJ\xd8\xc5\x10c\xf2\xe6\xcb\xe7E\r˳hY6@r\xb4\xe0\x8c\xb7\xb9\xde\xfcV\xa9\x1d\xcdY\xd4\xf8h\x03Cx;\xa3[2\x0e\x1b칝\xe2\"\xcei \xadp@\x83\x95\x1dW4\xe0\xf9c&\xbf\xe7\xa4Å\x03\x92\xcf\xe6lY\xaf\xf3\xe9r\xb6\xdb\xf1\xa9\xf5\x8f\xff\xa8h>kF&2\xe6\xb9\xcdI\x95)\xe9\\\x1a]ϕ\xb9(\x85Y\x9d\xc0\xe8Qy\xd5&\xa4\xb5\x88#>&b\x16Z]\x19g#&Ja\xe640\xbc\x10iT\xc0R\x1d[\x18\x8e%U7sǥ\xd6T\x94\xaa\x89\vif\xa1m\xb9\xacZ\xbb\r\xb2U\r\xe4۵{\xabQ\x14w\xb7~\x89\x83ȶh\r\xf6royY.\xd53\x8c\xfd\xdf\x18r\xe0\x92$\x9f\xcb\xd7\xd1tF\xc1\xf4A\xa6\x02R\x88\x00ʏ\x00\xbap[\x80\xce\r\xe3F\x03\xe5\xadQ\xde\xdd/\x92\a\xd6\x14\xea\x01\x97i\xe6g\xdb4\xe7z]&<݆\x19\x980\xa0\xbcM\x16\xc0P 2\x0f\r\xe91nZ\xde}\x86\xeaXy\x83\xe8\x87.S׆юk\xb6C\xf6\x84>\xd8F^\xaf]\xaa\xa7\x9dK\xe4s\xd02!=\a\xdcQ\xfd\x8d\x97Ë\x12\xa1\x91_\x12\xaf\xf3\xd0X:2\x96\x892\x8b$[\xa7m\x04\x9d\x12\xc1&>\xb0\xfbj\xfd\x1d\xef\x0553\xbe\x83T\xa9\xebt\x9f%~\x94\"A\xef9\xfbB\xb17\xe2r\xa4/\xd2\xf7\U0005afd3\x1aQ\xcf\xe6\x19\x98\xa4\x9a\xaa_\xd4O\xd3\xe0:\xf2\xa2\xfd8ki\x177\xf7 \xbaxC\xa5\xae\x9bW\xd6:\x00\xb0+\xe8\x92JI\x1a\x92\x83\x9aV\x80\r\x02]\x839\xbc&\xbc\xc3\xfbP\x03\xe5As\x8d\x06\x00^\xb6\xa7Y\x8c\xf8\xa8JW\xa9X\xa9\x88\xaaw\xbb\xe9lO\xe3(,\xd6\x04\xb1\xd5\xfb\xb2:\xc3\xcav\xbb\x1ds7\xf6țT$&0\x19\x81\xf2\xccS\xee\xe8W\xa9\xe6|\xa0Yk\x1e\xc6\x11?\xe4\xe5\x1d\x1c\xe1\xe2\xf8\xda\xfd\x01\xe5R\xe0\xe5\xb3Ȇ'\x94\xb84\xe0\x99.\x1c/\xa5\xd2\xddZ\xc8\xd4\x05bң\xf7Z=\xe7\x90\x14\xa5!\xbb\xcd\xcd[\x1f$K\xb9T\x83\x15\x7fKd\x1d^\x15\x159\xa8\x06;\xc7X\x829U;\xaf\xa4j炪\x9d\xcfr\x97*\xd9t>3\x141\xb6\x13 G=H\x15\xbc\x81\xf9\x03D\xef\xfc\x18\xd1;'\xf7\x8a؝\x13m\x89\xb1a\xcexs:\x1fo\x90\xe8ݘD\xef\xe6\x80\xe8\xfd\xfcM\x7f\x84^\r\x11\xbe\x84Z\x8ar6S\xa9\x97\x92/\x01\xa2Ca\x88ms\x96\xf7\x99\xd2Jc\x18/\xa3\x05[\x1c\x8f\x17\xbfې\x90\xad\x12\xee/0\xf8\x80\xe7Pň\x16zs\x8a\x8f\xed\tIkK\xe5\x1a]\aT\xd9\x04\x81+\x1c\xdeRU\x18\xcfg\xa7\xe0\xfcR\xa7\xfc\xe0\xc3\xfb\xae\xc8&\x16\xa8\xe0\xb2\x10\xc7\\\xcbڥ\xafF\xc4\x04\xb2\x8d\x02\xdbZS\\\x05\xac\xbc\xa7\x9a\x11U\xcd\xe4\xce\xe7\x11J\xf5Q\x8f\xef\x0fY\xba\xec=\x15|\xa8\a\x9b\x98Se\xc4\xeb\xdd\xcb\xcb\xc4k\xbaT^\x1b\xb0<h\xed\xeb\xfd\x82=\xac\x8b\f\xf0*\xa2q\xab^=\xe0\x1f\xd7Ox\x1e\xc3T\xbak̰\xa5mʟ+\x0fC\x95\x00\xf4\xda&f!\xccS\x15\x1f\x02<UO\x941\xab}ϯ\xb6\xd7¾\xab\xc0\xd0\xc1L\xe90\xe0\xa1&E\x11\xb3\xc5\x17\xeb\rO\xfc,\xb8\x91J\xaf\aH\xb1\xd8@\xb9\xb8,%\x1b{\xe9\xdf\xc5\xdb\xec\x11\xe30\v\x9a\xa3yulsTE(`Vx\xc7\x17\xdby\x85V^\xb1\x96,U\x1c\xec\x11\U0004bbb2\xb4\xb9*Z\xe6\x1b\x95\vc\xbe͏\xe8w\xf5\a\xedQ\xf71\x1cw䴓=\x1du\xdbC\xd7\xe3U\xbc\xf6\xac\xc0\x9bK\xd0\x18#\"\xf7\xd1ngG\"\x8a-\x91^;\x0e\x8e8\x8d\xf2\x8a\xb14ѹ\xd7D\x97\xb8\x16\x80z(,etT\x8c\x92L\x12/\x91e\xf6\xb29\xb2\xdbA\x13ZO\x84qjg,&\x06\xbbI\xd2\xd8\x02\x95ؑ\xc9b2\x99E&7*\xc3\xeb\x1e\xf3./YD\xe3=\x98Q\xc4Fi\xb4\xe3\x88\xf0\x0f\x8d\v\xad,\x10yM\xf9\x8c\xc59\x11\x9ds\xfe\x0e\xde\\\xda\x01\x97b1\xcb\xf5a\xbc\xb5\xf67v\x15\xb9#_\xfe\x1cI\xf5\xe4\xac=\xb1∃\x8e\x8d\xa5\x94\x982\n\x9aXꋷ\xd20\x98sۡI\xd35_\x19Ԣ\xc0Z\x86W\xe34i\xba3\xaf\x8d\xce{?\xd7\xdcԙQKT\xcc\xd3\xdc\x19\xf1\xac\x87+)u\x9e\a\x8a驑}b[\xe7\xef\xde]\xbex\xfd\xa7g/_|\x7f\xf9\xe6\xed\x87\xcb?={\xf9\xe3y\xe1\x11\x93\x9f\xd7'\x1fV\xbc\x86/\xb8\x9a\xf5\xa4\x915\x9eX\xa0g\x17Hu$`\x8c\xc7\xe8B\fry\xe3\x89\xf5dO(\b\xf0\x04\b\xd3Ro\xcf\xde\xfd\xfe\xf2\xc3_ޖ;S\xcc@T\x8f\xa2\xa8N|\x00\xa6pw\xfb̊\xe2\xacfQ\x10~\\\xa5Yb\x9f\x04\xbb]p\xeaL\x1c\xaf\x11P_n6\xcaR\xc9Ď\x99\xb5ަ\x19*\x90]q\v\xed\x105g\xe8gH}\x8a.\x0e<U\x10\nU#\xa0\\%;\xd9\xed\x923\x05VDrB\xe4\x17\xe5r\\\xb0\xd8IS\aFB\xd7\xe7\xd9\xde\xe6Ԫ)R\xc8\"$e\x16\xac\xae\xde3^س\xb8\xf0\x15\xc1\x8e\xc2RX\x84\xe4\x81\xc1\xc3#\xf8Rq\x8c4+ \xc1\x81:\x84\x9e\xd8IC\x8d˜FS8\x0fC\xa5\xa87K4\x00\xc4\xf1\xb6,2\xb16\x92\xac\xb4<K\x0f\x7f\x9c2\x84\r\xebI>\xfc'V\xed\x89\x1eq\xf8\xc8\xd9($\x936\x98ժ\xbd\xe3s\x0e\xbeO\x84b[\x0e\xcer\x1a\xa4\x12\xbc\xde\x7fxw\xfe\xec\xd5\xe5\xdb\x1f\xdf\xff\xe1\xf2\xd9\x0f\x1f\xce\xdf]\x9e\xbf\xf9\xc1\xa2\x00C\xdc_\v\xae\r\xa9\xf9ˌ'5\xc8\xd1\x15_\x9d\x7f\xf8Û\xef\xf1\xb5\xf1\xe2\xd5ۗ\xe7\xaf\xce_\x7f8\xff\xbe\xf2=/\xb6\n\x04K\xb55\xcfV\xf1\x02\xce\x01\xc0\x90\xf6W\xc9\x17֞\xe8\xa6\u0558ޝ\xbfz\xf6\xe1\xc7w\xe7\x97\xcf_\xbey\x7fnQ\xebm\xc2\xd7~\xb6Mxm\x1e\xc6(\xbd+\xd6\xf8\xfe\xfc\xfd\x87wo\xferl\x18\xcf\xfd\bz\x054/\x87#\xe6\xe5\xd7\xc4lQeRƴ(\x8e\xe8Տ/?\xbcx\xfb\xf2\xfc\xf2\xf9\xb3\x97/\xbf{\xf6\xfc\x8f\x16\xb5\x14I\x83\xed\xf1EMٗղ`\xcdӃ\xb1=\x7f\xf6\x1a\xd6\xea\xed\v8\xc2j(\x9b`\xc3).\x05\x10\u00a0\vwP\xef\xa7w/>\x9c\xab\xady\xfd\xbdE\xad\xdb$ȸ\x1c:\x8f\x16\a5^\xff\xf8\xf2\xa5@L\xef-j\xbd\xf2\xef\xb0\x03Q\vH7\x81\x93R\xd0t\x14\xf3\xb6*\xe0\xe2\xc7\xd7\x7f|\xfd\xe6\xa7ח篟\xbf\xf9\xfe\xc5\xeb\xdfW\xaf\xe8\x8f\xd1\xc7(\xbe\x8dj(s\x84G=\xac\xebCp\xf6\xe3\xeb\xf7\x7fx\xf1Ç|>\x97\xe7\x7f:\x7f\xfd!\a\xb8m\x94\xae\x82e\xa6a\x8eG\x8b\x1a\xba\x99\xb5\f\xed\xc0֯,\xdb\xd3^\x7f\xd0\xeb|N(\xdf麽\x1e\b\xe5\r\xc6\xd6nw@\x18\x98a[0\xe4<'\x92e\x99\xfb\x95\xcb\xf6\x06\xa52\x97\x0f\xe1\xc4\x1e\x8c\xbaC\x17\xde\xc0\xa0\xcf\xddn\x8f\xc88\xb1;\xbd\x81; \xf6\x9c\xfaĈ\x18\x1bہyׇ\xcc\x19\x87\xa7Z\x92\x1b*\x9e斥\xd3p6\x9e\xe7e\xa7\xdb\xd9ng\x17\x13XP\xf8$\x05e\\\xc1e@Z\xc6ԛ\x9ek\v\x13\x90xA\xb9\xb1oP=\x9cР\xf8)\xbd\xfc\x87\xf1\xed\x1f\xfcp\xf9f\xc3#\xb0x\x06&\xf6\x89`\\)\xb0\x85\xd7\xe1*H\xf57;q\t\x95e\x00\xf2\xcc2\xea\xdb,S\xe8C\x15,u,\x1d\x1b\xc7ќ\xdb\x16\x00>\xdd\x14b\xfcll)*\xbcT=H?1т/v\xbb\b\x99\xac\x1f\x82\xf9G{\x89-\x19U\x97\xd2|2Z\xd8d/!E\x88&r\xb6@\xbe\xdc\xd4R\x1d\xfc!\xb8^\xfd\xe4g<y\xe5'\x1f-z\xcf#x\x7fB\x8ew\xe2\xd2k\x9e\x1d\n\xafkUC\\\x99\xed\xec\xf7\x84~\xc1\x18\xbe\xdb.\x97<\xf9\xfa\xce\xeb\xf5\xaa\x11]\xf3L4l\x93/\x1c\xcfK\x04\xe7\xbfa1\xc4yxd\xaf9\xba~\\\x879k\t{V\xf0\xfa\xbe\xe4l\xacr\x9d\xec\x8a:-=\x80\xeau\xd4\xd9dOSsD\x80y\xfe\xc3Ƣt\b\x8e\r\x86\x811\n\xd9\xd3a{\xd0\xee}\xfeY\x16kt:\xe8\xf6\x9d\x1e1\x1fQ\xc7pM\\\xc05Pn\x1c\x15\x90\xcb^\xa3ɘF\xa4\xf0f\xb9D\x962ڏ\x1f\x10i\x8a=\xb3\xdfSļ\x9f7\x9b\x11W\x801\x9dg\xf4Y띹p\xec\xfd8\x01\x7f\xcf\xc3\x01i\xa1\xad\xf2\xf9:\xc82\x9eH\x1c_\xf9\x18\xe7\xad0H3\x1e\xf1$\xb53\xa2\x80\x16\xaf\x81v\xbb\xe7t@M)\xb1\xbb\xc3A\xbfKZ\xe2(ѐ%\xad\xeb֏A\x94\r\xf1\xb5U0J\x15\xe6\xd2[:g\x89=\xea\xf6\xdd>\x19o!\xe8\xf1\xbc\xb5\x00\x8eG\x18_O\U0009fdbc/-\xe2\x1d4\xb1\xa1K\xba\x02\xf5i\xbb7\x80\xa8\xef\xa0\x1dm\xf7]w\xd4#\xa0\x05m\x0f\xdb\xdd\xde\x00c\x95\x16\xd0\x17\xa8?\xdb\xf8\xec&\xad_\xe9\x1d\xbbiU=?\xe8\x95̨$\x1c\xe9\xaḓ\xa6\x0e\xe9y\xb1\xf2\x11j \xbf@\x9f\x81\xa8K\xc4\xc6]\v3\xaa7\xc9\xf7\x02\x88\xe9%\x9bZ\x98dQK\x90\x83\x1a\x1bX\xd4\xda\xf8[LI8\xb0\x04\xad\xd9ؔ\xcbc\b\x85\xfb\x88E\xbb]b#\x19A(\a9\xe0\xfd\x9e\x1a2\xe8\xdcg\xa2\x1d\xb3\xcc\x04\xeeH^\x8dB\x82\x86\nn`\x83\x98\x7f\xd2X\x1dQ\xa3H\xe9{\xb7\x83:\xea\x04\xbf\xd1\xe9\xb2\xed\u009d\xc0\xae\xb5\x96\x8b\xaaP\xba{bY\xed\nA\rUZ\x16\"E\x80\xa6\xf2|\x0f$\xa7\x19/\b\xbf\x91\x05\xabJ,\xc3\xf8V\xfb\xe4\xc4\x14\xbcA\xf5\x05̣\x858\x1fy\x12\x8c\t\xaa\xa8oPh\x06J\x01?\"\xce\x17\xefr\xba@6\"Z8HW\xb3{\x89\x87\xcblS\xec\xe4{iw\x9d\xf7\x8d;\xbdнA\xc3\xcf\x01\x1c؉x\x97\xe9\x04\x91\xefo\xb3X\x82\x10\xee\x99\xf1-\n\xe4HR\xf5 \x05\t\xe7\x92\xc8e\xbc\x9c\xb2\xdbY\xdbl9\xb4d\x0f\xb7~\x90}\x9f\xf8AĜ\xc2\xf2\xbc\x8a\x13n4\x8a\xaa~\x85U\x96\xedc\x12\xd7\xdf\x10.r\xb7\xb37\x80\xcaڽ^\x87\xb4RRj\x83\xdf\xd66v^\x83\x94\x1a4rr\x9a癲\xbd.\x1c\x83CD\xfe\xac\x80ȡ\x96\xe4\u0095\vF\xe3\x8a;\t\xc7\xf6^\xba\x9b\xa1\x19\xa1%2QP\x93G̴\xfdEᢓ\xf4f\xa5p]_n\xba\x86\xfcf:\x87\x14\xc8[c%>\x9a\x8e?\xb6\xb6>a\xcf\x16\x8b\xe7\xabm\xf4Ѣ\xd2Ǩ\x8f\x92\xae\xe2\x04\r\x01kF\x02\xf3$\x1c\xe8\xd8mm+\x8e\xce\xe3\xa5h\x14\x98}x\xb0\xe4\xf2\x8eѹ\xaa\xdcRń\xd3\t\x826\x1dc<6e\x19lg\U000b4ad7\nU\xec\x89\x06\xcb\fD3q=U\x89\xec3u\x9a\xc1y-\x9c\xd3\xc9\v\x9b\x13\xcf\xce\x0e\xcfi\xf9\x90\x82\v\xf0Ã\xeb\xd0\xd7\xc20\xde\xe6\xa6bB\xbc\xdb\xd9~\x95\xd1\xfb8b\x19M[A*\xe9ˈ\xecv\x91\tG\xe1nw\xc8\xc22l\x8f3\xd0\xee6Ѩt\xb5\x7fg[s\xb1]SU\x9fZ\x8a:\xb6\xf2[ך\xd1L\n2#m\xe8\b\x8a.\x19!\xd4'\x9flN\xfd|\x1eA\xa1\xab\xac^\xd7, \x87T2\xdbv\xbbb\x15I\xc4^\xf3\xec\xad\"p\x80K\x84v\x019\xcd\x03k[\xc5]O[\xcb$^\x03gՆ\xe1E$0\xf0\xef\x04Ɗ\xa6\x8e\xc4\x03\x9d\x8a\x00m\xf5ͱ\v\x00SŮr\xb7\x98\x81A\x99\xeaP\xa8\x05\xf0\r\x14\xe8\xd5\xeb'\xc9\xc4ΘN\xc0\x17\x1c\xc6\x03,\xceTHl\xc5\xeaL\xf4x\\\xe2}\x80\xdf\xc4\x18\xa3+5s\x80g_\xe8T\x96Tv\xfdr\x02 \x01\x96\xed\x9e\x06ŋq\xb7sP\x19I\x81\xf7\xa1\xe2\x19(\x06\xc8+\xad^wX>\xc4z\xfdD\x9e\x00;+\"mqi\b\x87\xf4\x16M\b\x1e\x8fϜ,\x1aM\xf4qTL\x8c\x84x:-?\xa2\xe69\xab\xd7\xe1\xf4\x11\x9cwv\xec\xe9\xf9\xec?\xee\xc1\xf3\xe0+\xe2\xf0\xc5R\xd9\xc6\xe7\x9e\"\xf8\x804\xe6\xa0\xf2\xd8Z\xfd*\xe4^n\xa3\xbc\xc06\xaa,\xa2\n\x14ы0\x1c6\v¢W\xe1 \x1aU-\x876\x1e6w8a'\x8ewp\xcaa\xdav\x06\xa8(*\x13\x03\x10\x1a'2/o\xce\xd4!\xc6\xfb\x8fY\xc0\xb1C\x1f\xb2\x1fs\xa5h\xe9\x11\xe3Y\xc1\xe9\x1aBQ\x15V\xd0\x15\xa516\x9e\xa8B\xe5 }+\xe8\xa2\n\xedyV\t\f\xea\x90\x14\xdb\xc1\xd8\xf9\x92\x8c0\ar@\x91H\xa2@\x92\"d\\\r\x14\x82^\xc9hU\xae&W\x1e\xa8\xaa\v\x19A\x96\xab\x8a\xcbC\xb7\xe2\xfe\x82\xc6̲t4\xd61\x89\xe1\b\v\f\x16\xb5\xe0\x84\xa3WDd\x0e\x8d\v\\\x89\xca\x16\xe7!\xf7\x13\x9bP\xcb\x12>\xd4\x1f(\x8a'^\x91\xe5\xa5\"\x92&\x8f\xe5\x0f,#^oo\x98\xf6 \xda\x1d\x9b:o\xe6\xdb\xf3\x949\x02\xf1\xe5\xb8L\xde\xeb\x13\xc7+!(~\x82\x0eh5\n\xd4\x18:3W\tWB\xe6x\xaa\x88g\U000f3b08o\x91\xea(\xa4T\xc1'?co&\x9c\xbd\xf1l\xdelR\xbec\xfc\xec\xec\xccU?\xda\xeaGW\xfd\x18\xea2}\nah(\xdf#Z\xe4\xa7\xf9\x85\xc2=5\xc5|xe\xa2š\x8e\xc9\x11|a\xf3\x82Z\xa0yַ\xb6\x05H^յJ\x88\xf9\x90\xfa)\xa3\xeec$RE\xc3r\xed\t\xad$\x9f\xe2\x9c1\xf9\x9a\x16\x82Ǽ~\xfc\xf8/\xa1\x1f\x8dt5!\xa8iL3\x17C\xaf\x18w\xa0d\x90\xda\xea\xda3d\x11\x15\x03v\x0f\x17\u0080.\xd5\\\x0eg\xa7\xac\x040\xf4\xa5͍I~\x90\xc8\xdb|%!\xa5Yx5\x15\x96\xe9--E\xd6~+\xda\xc0x\xfe'\xba\xa69\x1a}\x81\x9ffe\x02\xe2\by@\xc69\x11.RƂ\x86_\xfbwW\x18\x9a\x0eF\x86\x82\x9b\x9a\x83\xd2\t\xf8\t\x81\xe4\x12\xb3\x15\xe1\xeb\xb54\x1b#r\xfe\xbb\a\xf68\xabx\x0e\xe7|'|\xb4\x9b\xbbu\xe6Ь\xfcL\xc65\x10O\xe4\x1c\t\xc0\x95v\xd0\x0e\x12;\xe4\xcc\x01\xcd:шm\xac\xf0+\x9b\x17\xdeF5،,\x98\x7f\xacX\x80\xbc\xd6\xf7b_\xb0\x1e\xb4hQ\xbd\x10$\xff\t\xf4\xbcZ\xbb\xacꝟ\x03&6B\x10\x82h\x11\xea\xf4\x9eW\f\xe3\xe5\x03k\f0\xb3\xb5-h\xca<\xaac\xa3u\x1d\xa5\f\x1b&c2Λ\xfe\xae\x80\x98M\xf8\x11jRvV$&4ҕb.\x0f\xc2S\U00073f16m<\xfar\x14-4$,\"\u0082\xa9\xd42\"G\x17ζAt*\x11\xb0\x96\xb2g\xa5{\x8cxƐ\xe6B\x7f\x0ef\xa4ߡT*\xd3\x18\xce^~\xf8\fV\x8a\x16&\xee\xcb_+\xa4\xf0%\x1e\x93\xea)j\x1c\xee\xdfhVĂ\xbf\x99\x0fh\xa3u\x10\x1br\xa3E\x9a+\x13\x9c\x98\xe9\xe2X\xf3\xfc\xd9\xcc\v,,\x87f\xa6\xd8J\xa2<\xec\t\x81\xda`\x10\x91\x1c#\x149\xebc\xfb\x04\x94\x1b̲\xf0PW\x91-\xc1\xa9\x8dB\xbf O\xc9\xe3\xc7\xe7xK4\fV\x11\xb9\xe2\xcdi\x84\xf6\x9d\xc1\xd2\xe6\xd3d\x86\x1c\x06\xf5^\x95\xa4J\xd3\xdd?+\xf8\xfa\xf5\x17\x85\xfbX\x9eX\x8b\xa2\xb9\xca\xc6OR\xf0\x06fs\xea:&\x1b\xa7\xb4\x8d4a\\:\xa6C\x8b\x95\xea[\xc0\x11~\xf4\xcb\x0f\x1a\xdb\x16\xef\xc0\x02\x96\xd5w\xf6Y9\xc73\xdeӻ\x9d\x04\t\xcdr\x92\xe3\xf7j\xe5+\xb5|\xbdU\x13C?\bV\x8f\xf7B\xfc\xa5\xcaD\xce\x11~\xfb~Q\x81䋜\x98Z\xb1\xb1\x1f\xcc\xcaJ\xacP\x9c\xf58\x1f.$\xe7\xd2|`\xcb\xdafs\xbb\x9d\xfa\xd5\xe4嫈\xd4\xeb[\xdb\x12\xb9\xb5\x90\xa7i-[\xf9Q\xed\x16\xf2ׂɋ\xcf\x06}ck\xac7\x91\xeb\x04\x0e\xbdb\x14\x9a\xa3\x87\x1d\xd8&\x0f8\xd1[\xdbZ\xc48*\xcb\xc0\xbb9\x0f\b~\x15'}HZ\x99\x84l\x99\x06$\xba\x1d\xd7D\xeb\xb8\xc4\t,\xb1\x8e\x14aG\x8c\x9f9\x13\x816\x85\xe3\xbaI\xb9\xaf\xe3\x94\x03g\x8e\xf1\x16ob\xe0c\xf3\xe5N\x0eg\xa1\x88\x9b\x8a\xf9$'\x12\x80%\x8d 7Z\xfb\a\x8f$yo2\x03\"0E)>F\x0f\x0e\xdd'l\a9.\xb7\xb6%\x16\x8c\x80\xbaN\xa1\x1e0\xde+\x9dB\x8aʕ\x0fT\x15\n\xc8\xe0\xda\xeb\xa882\x91\xf1b\x904\x95<\x95?(\x9f\xc9\x02\xca\xe7\x8a\xcc\x10\xef\x15N\xf6f\xeb\r\xe6ҭmAB\r-(\xd8\xef\x16\xa0\xb6\x96\xb2\xdf\xfdbQ\xb3\xa4\xe2\x91\x06\xcc>\xc9v\xbb\x13\x11\xed\x8fG\x80\xfc\xb8\fߴ\x88\xb7\x99\xf1œd\x12z\x86\x91c\x8a\xd1\x1d\xef\x91O\xba\x8d\xa0m\x00W\x86\x01?\xe3z\x1d_\xae1\xe8\xeb\xff\x88\x99\v\f\"\x96\x7f\x02\x14om\v\xae\xb6h\xbb\x91\x14\tD\b|))\x1d[\t\x8a\x16Uy\x02[[t]\x95\xb9\x00\x00\xb3\xe8\xbc*OJ\xa1VUyr\x1a4%49\xc8D%\x86\xf0h\xceuU\x8e\x00\xc3%\xa1\x1b\x98\xeeId\xc0?\xd0Qe9\xf1I9\t\x8f\x81,>\xb7\xcd{6\xb4\xe5ҫ\x9bO\xa8CD&\xc3Ѹ\xa7\x03\xe2%\xa62F\x00U\xe2Ȝ\xf2X\x18GT<\x10K\x8e\xe4\xab)\th\xe6M\xf4\xbdXy\xf3\xa0\x93\xc2W\xbdn~5\x9b\x12\a\x98\x05|\x9bS\xb1r*t\x8c\xa2\x83\x91\x94\x04ό\t\x19\x8b\xe1띖N\xf7Nܱ\xa1'\x92\xc95\x12\x8dI=c\xae9\xa20l\xb8\xe8E\x02\xa2\x7f\x01\xb5p/\xc2\x0f\xf3\xc8\xd4\xeb\xf2K\x86U2\xb2\xce\\\xa9W\xf8\xa3\xad\xcf-\\T'\x1b\x81͗>\xb0O\xb1\x93\x9ap)\x95rZ\x93BϨ\xb0T\xe6\x17<\xad\x13\xf1\x1c(l\xfdJ\xcfK\xc2rF\xe8\xb5\xfd0\xac\xc3*㺊$\x88\xc1X\x8a\xaf\xbb@\x9f\xaa\x0f\x1c\xb3k\xf3\x85\xb1V\xe0'\v<||\vU\xafEU\x8d2\x92\x96\xf8\t\xd8L{2ĽU\x87\xa7\xc2\xec\xa9R\xa8\xb4I0\x8a\x8a\x1aAn\xa0WʰE̩\xd6%ꪥ\xf5\xba\xfe9\xcdf\x93\x92\x8a\xb5\x91E&\xe6\x97\xc986\xd3\xd94\xa1\xe6\xf7\xccCP՚\xa6&\x02\x12'\xd2\xc4s\"\xa5\x80\xddč&\x8ei\x02 \"\x0f\x84`_@zM\xbf\xb5\x12\xfd\x1a\x04\xb6L\x89CY\xba\xc4\xd4i\xae\xa6)\xefsT\rF\x7f\x8a\x10\xcb\xcc\xdb\xcc\xe0\xc0)\v\xaa\xaa|.\xae\x11\x99\x05\x94\x06\xfc\xbf\xfa&T\xfeP\x11<\x8aB\xee\xfc\xf8\xbb }Tˡ\xf0\x16\u07bf\t!T\r\xe2\x84\xe71Q\xc5Y\x8c\v\x83\x1a?\xb67ʹ\xf4\x993\xf6O\xe3\xb1\xdfh\x90h\xeaϪFPZ-b2'\xf7\xe2\xa6\xfd\xd1\xce4r\xd0\x0f\x02\xe1\xacI\xe5\xa0\x1b\xd49\xb7\x03\xea\x92\xc2К̥\xe5\xd5E\xdc(\xa6\"\xff\x82\x8e<}p\x81\x8a\x10\x11G\x95$MP(b\xaa\x01Q\x114\xec(3^\x1cY\f\xa2eG\x15\xec\x10\xac\xf9\x10G\x04\t\x91\xc8`\x7f\xe6\xba\x04\xf0\xfc\xcd\v\x8b\u05cc\x1d\x15ި\x15]\x82\x99I\xc5@\xa2\x03Vdd\xc2YT\xa5\xf1\x80\xe8\xcex,DZ\x96\x94sIT\xdaD\xbea\xbc(\xa7\xaf\x8d\xdb\xf8\x15n\x06\x10\xdaIqG\xfc\x85FR\xac\xb8S\x85bEL\xfb\xd9M,\x16/m\xa8ں\xd2\xd2\x1a\xa3}'F[\x1e\xabh\xf5Y\x18\xaa\x86\xd3\n\xdc\x12<X\xa1\xda\x06\xf8`D\x82\xf2ײ1\xbe\xdbU\x8c.+\x8f\x0e\x80\xe6X\f\x9cJI\x12/\"V\x8dSy\x0e\x1a\xfc\x10\x96J\x9a\x03\a,1\xc9\x1d-\xb1\xc9\nL\x94\xef%\x87Tn\n\xa0n\xad(\xe3V\x9c[̫\xf0\xe9\f\xe44\xa8\xcbc~M\r\x1ah\xff\a$HD\x1c\xba\aJ\b\"F\x90+䡦\n\xfaB\xbaFu\x95\xa2\"Pq~\xb7\x89\xbf9rOѤ\xf2\xba\x8aL|\x1d\xa3J8\x92\x10H\xeb\x96b\xb5lm\v:\x00\xef\xd9R!ߔ\x9dKނ\x84\x96\xa4\xa4с\x17PηP\x8f0\xf9Kxv'\x8a\xb4\x16\xf4K\xde{L\xee\xcd\xce1\xbbл\x1d\x1b\x1d\n\"5\x06\x1ca0%\x05\x7f\x93\xb1\x98\x80&EA\xa0\x1fá\xcd\xcdu2%\xd2BK;P\xddѤ\xa4\x18c\xeeV\aVt\x1a\xcf*ݮs̰e\x11f:O8|\x1d\xc8\x14p\xf8,\x8f67m\xfb!F,)]\xab\x97\x8a\x7f\x06\xb7+\xae\xdb\xe5ԟ\xe5`$\xac|\xe1\x93B\x06!\x87b\xbf\u00a0\x8c\x05\xbe\x14\xcc4\xb8\xb3\xe0\xaa\x10\xbci}\x99\xec%\xe0=\xe0(Δ\xe5OE\xa2p\xbd\xfbB\xba\x11\x99\xb1c\"|\xc6\xc0\xf3\x88\xbd\x04\xad\xd2ް\xe7\x10B\x97R\x93阦tAq\xe0\x88\xde\xe0\x17\xa8i\x17\x8f\xdb#u\xd6+\xc7\xf0\x15:\xeb\x8fP\\\x10l\xec/\x1c\xca\x0f\x02\xd1\xfc\r\v\xa1\xe5\xe6_\xaf-\xa1\xb0\x9dҕ\xb8\x04}\x01\xb8\x0f\xd8w_2\x97\xafP\xbd\xaf\x12K\xc3\x18\x1e\x86a\x18\xdeg\x1c\x06\x00\xf7m\xc5\x12\xdb\xed\xb9\xfd\x01!te?\xc3;\t\xd4\xceQ\x8b\xfc\xf3j\xe7s\xadv\xaeՒc\x16=\xa4_\xec\xab첽\x16\rd·w\xcf^\xbf\xff\xe1ͻW\x97\xcf^\xbe;\x7f\xf6\xfd_\xf2\x94\x17\xaf\x7fOӃb?\xbd\xf8\xf0\x87˗\xe7\xaf\x7f\xff\xe1\x0f\x97\xe0\x83SiJ\x8e\xcdx\x02E条\xbf.\xa9\x81\x96N\x90\x0f\x0191\x81\x92\xe7W\x86\x12ad>\x81\x94(B\xbc\uf021蓱\xaa\x05\xban\xe2ɡ\xdba:\xba\xc9\txl\xc0&\x10g\x03\xc6R\x9a\x9bq%\xc5\x12\x9bjXv\\\xa0f\x81:\x92,\xd9\xf8\x80e\x9d7f\x973\xbf\xd2&)\xac0B*-)\xbbG\xf3\xb0\x0f*\xd1\xdb\xe6X\x9dP\x18{\x9e\x05$\x84\xb1\xfc\xf0-\x97Kx\x9a\xc8W\xd3\xf8\xd6\x16m\u0097_\xd5a)\xd3\xfbUe\x14S\xfc\xa8\x92\xab\x1e\x99\xc6\f:\x85\x19\xb9Gt^1\xba\x90\xae\x89_L\xa6*\"\tȆM\xc2\xd5\xf3\x7fS2\x98\xcaI\xd8q\x85\xbbG\xa3\xddݮj~Z\xa7`\"\xee\"#\x84\xa4gT6\xdd,\x01\xbbe\xa9\xdc\x10\x90\xa2\x11\x96f\xc6\xe4\x0f\xfd\xe2\x11\xc8H\xeeQ&\x01\xcaI\xa9\xd5\xf1j+\"\xe9\xc4\x06@\v\x9f\xf2\xbc\fH\x85\x93i\x94\x0er\x12\xde \xc4\f˾\x90\xd0\xf9Úf\x05<[\xea\xb5\x00\xa1\x00 a\xa9\xadғj_\xe8\xeca\xfb\x184\xb7\xb1\xf22R\xf6Ph\x00a\x9cU\x19\x92G\xd5(\fV]c\x99\x84F&\x0e\xe2\xea3\xd7\xe0\x02\xc6tae\xef\x8fc\x1e;*.\x87x\x8b\xfd\x1d\xb0Oi\xce\ar\x1a\x93WU\x9a\xae\xd2\xe86\xa6\t\xe26sJ\x93\xac\xbc\x8b\x8eg\x17\x8b\xe4X!\xdf\f\xb3I\x9a\x15\xd7\r\xd8\xda\x05\xb4v\xb0oՊ\x8faE\x91\x02\x00\x15lw3\xe1\xa7a\xbf\xa7h\xaf\xfah\x03*}P\xfd\xe2⍥\x95ǧ\xac`̐%wƷ\xc0?&q{R\r}\\T\x1ds\xa3\t$\xf0\xc7\xd1XA\x91\xe0 \x81\xf1\xf5X\xc7\xfd\x9d_5\x9b4\xb6\x93\\\x9bo\x9f\xb5\xe6q\xf2\x11\x00\xe9\xd7-O\xb3\xf4\x87\x84\vO{\x8c\x83\xb7\x0e \xb6\x8aFa?\x99\x18\x84\xbd\x97\xf2\xac\xfb\x05\xdf$|\xeeg\xdc\x03Jd\xd4\x1e\x80-_n\xe7\x15\x1e\xd8ym?o\xe75\xa7\x9b\xdc*k\xf9\x80U\xd6\xca$\x7f\x16lUm\x95\xb5f\xab\x87\xe8\xa2k\xb6:B\x17ݰ\x95i\x91eX\xa9ӻb\x966\xae\xa7W\xc5\f\xc3ޜ\xde\x16\xb3J\xc6\xeb\xf4\\f\x97\xad\xcb\xe9'\xb6)\xd9w\x99NoM\x8f\xc9\xc2|+x\xbc\xf9V\x80\xfeþ\xd0|+x\xac\xf9\x96\xbat>c\xbe\xb5\xd4\xe6[GL\x87\x03\xa2ω\x1f>G\x8f\x02\x9a\xf7\xa1%v\xa6\tV\x819R\xb0\xcfRZ':\xc1\xb4h\x92ѻ\xa4ٵ\xe0\f\b\x17'\xf2\x1c\x17\x92\xd8I\xfaU&PE\x933\x98\xb29\\q*Un\xc1VL<\xe1\xde&\U0005c9e9Y'\x8e\xcaWV\t\x83\xe4nk\x8a\x84\x00\x052\x1b\xfa\xa0~\x91ޮ w|\x83\x00\xb8\x862foܜ\a/R\xdc<\xd7H\x10\x19!\x8fh\xfe\x939{T\xfd''\a\xa1\x86}r\xdfl\x1aH\x8c&\x13\xdb\xe0\xe3\xf9\xc24\xd6T\x10\x05\xee\xc3\x01\xb5\x83g\xc7PiB\x8b\x0f\xb0[\xf0\xed\xe8\xd1\xc5\xc1\x90Gp\rѥ\x12͔\x1d̽@\x84\xbf\xd8\t\x01\x19\xb3\x86\xa7q\x00JOb;\xe1Wy\xf7v\xbb\x13\x95\xa8\xb1\xf0n\xf7FD\xb8\x8eLa\xf2s\n]\x06\xd4\x17F\"\xe2\xe7^b\xe9\x1c\x8a\xf4\x82\xe7)\xb8\xbc&\xf0螌\x92\xa1\x9ff\xdf\x1d\xcdՋ\xaf\x1a\xd2\xf4\xb2q\x88\x8aK\xe6\xfe\x9d\x8c\rKC.\xd8a\x1e^^\xa8]\xef\x97\xcdמ\x15\xafc\x03\xd1\x15m\xfbD\xd8\xed\xac^?\x91\x1eh\x9fI\x99Aټ\xaf\xc2t\xbcڌ/\xf7\xe4\xf0\x80\x19\x1f\x14\xca\xf9\x1e\xf8\xa5NɑW\r\xe6\xdd\x14\xabܨ:7_m\xfeW]\x0f\x11\xae\xae\x85_L\xa6\x12B\xd3\xcf\x19\f\x8a\xa8tY\x0e\x8b\x91\"\xea\xe6W,\x90\xbf\x0ft\xad\fe\xecI\xa6p\x9b-\x8d\xd8\xf0\x03|+%\x13E\xa9\xdf\xd81\xd5\xe5P6,j@\xf7y\xba\xee\xc0\xad4\x83J\f2\x04\x11fYgN\xdf3Z\xfdK\xdf:\xda\"\n\x12,\x81\"\x00\n\n\xd4Wd+\x14R\xf2\r\f\vt\x88ٝ\xb1\x8e=X<\x05\xf2\xa1\xa6\xc0\x00m\x1e\x01S\xe4Rتc\x03\xbc!\x80`\x94\xb1G\x84\x06\xac\x8a\xfe\x1b\a\x92\xaeL\f\xc7,\xc0\xd79q\xc6ɘ\xc4\xd3t\xc6\x12\x9a\b\xf3\xc4\xdd\xce\x0eQ\xd51ab\x044m0w\x1c\xb7\xfc0\x14\xe8$e!\x15Ѐ\xbb*\xc5w186\x0e\xe4E\\X\xa5F\x83fǱQ\x80\x9dL쪁3\x91)\xcb0\xf9\xc4~\x00I\xe4ʾe\xf4\x82\xb6wBY>\x19+\x9f3IK<I\xe6,7\xb2\x01\x1a5'\xb5\x83\xa5-\xa7\nJ~\x05\x1b\x93\xad\x9a\xf9\x96\xce\xe9\xc6X\xaf\xea!4\x9b\xf9\xc1\x90\n\xf2\xf2\xc1\x95\bM\xfd#\vD\xf6\a\xed\xb1\x84V\xc1\x96[r\x17\x9ds\x13\xa2\x85\xd6\xf4\xcf\xe5.\xb2w^n\x1d\xf5\xa7\xd4U\x80\x1fr\xd4\x05\x1b\x13\x00p.q\x87\xc1\xe9HȽ\xb1\xf3\xcd&M\x84\x8a\f\x1a\x01\x16\xae\x98\xdc\xe2P'[\xfa(\x95\fBrJ\xe7\x17[pC`\xcd\xca\x12C\xa3y4pȩ\xcaݮ\x8a\xf2Q\xc3\xdf\xedL\xd4d?f\x9c\xa8\x90Y\x04\xf0\x02\x11[\x90K\xbe\x10rI\x85?\xa4\xfa\x87\xaa\x8c\x9b_՝^\x93\xac\xa8\x8e\x9d?\x1a\xcb<\x85\x93\b\x84\xf7\x05}lS\xb2OD\xac\b\xa5\x91\xad\xad\x80\r\xcf\x13)\xa1\xef\x8dw\xb5v\x88c\xbea\x15\n\x91\x8f\x83\x12\xf4Pt+\xc5\xc7D\x8b\xf5(\x97\xbe\xdes\x9fR\xd4h\x0e\x02\rW\xb3\xfbߛ\xec\xfe+%=\x01\xc6~\xd0\xd2\xcf\xd3B\xa8k\x93\xfbd:\xf3!\xd4*\x11\x83\xa29\xf0ɦ[Z\xb4j?\xa6\xbcv\xcc'\x10\x92\x18\xdc_\xb4,j}\x7f\xfe\xd6q\x9c\x8eE\xf6F\xe4c\x8ct\xfc\xf9xI+?UQ\xf7+%\x85?ȔC\x99\x99Qs6\xb1\xe7\xecq%\x8f\xc9R\xe8aYz\x8f^\xd2*|~\x9f(\xf2I\xf2\xa7\x05c\x941\xf6\fL\vP\xf3\xab\xb0l\xe6\xc3\xf3=\xc8w\x88W\xa9\x12Y(X!\xa1/hZ\x15\x94\x8coHY\xd8}\x94\xb9G\xfd*wB4\x80;>e'~A.lG\x8c\xd3\xf0\x01K\xfd\xad\x16\x9d\xa6\xf5\xfa\x89Q\x92\x83\xb8\xb8\xd2[l\x98۳sR\t#\x19\xfa[\x84\xc3#\xbcM\xa7\x93\x8c)\x90\xf72\xb4\x93\xf7\x0flni\x05N\x13\x8e\x1b/\t\xf5%֟T\xbd\x17QK{,\U00072069\x84r\x9dTu\xf2\xec\xb4H<\t\x8a\nZ\xf0\xc7\xc5h\x12\xc9\xc4\xc76\xaf\x0e\x8d\x86\x13\xc0\xae\x05\xc7\x05\xa2\xe8\xe2\x01\xc7\x053T\xb4:\xf1w;[8&0\xc7\b\xcfG4\xfd\x15\xe3\xf4)L\x02\x96\xde/ \xe4\xe0 \xe6;ЭB\x1e#\x01#8\x00\x97\xa2\x8b\x85*~A\xee\xa6\xe1\xc4p~`gL\xee14c8ϓ\xfeq\xc7\xd1\t\xc3\xe8%\t^\rzki\xc4\x02\xe1M=-\x9b\xd8+\x15\xa8qn\x84\x9fʠwǬ\xfa\xc6\xdbݮH\xc3:D8ؐ\x17\xf8n\xa7h'\x15H\xa4\x92\xec\x18W\xa6\xb2{).\xa2ZL\x14S\x04~/\xa1\x8a^\xf2|\n\x1b%\x05H\xf3\xc9\\\x10n\x95\rz\x87tMe\xb9#\xf4T\x83\xb9\u009f\x82\xa6\xcfR\xb9\xc7j\xf9\xb7\x1aD\x84\xb99\x00UP\xc4\x1a\xb0\x1a&\x82\xa9r &V\xac\xd1(\xab\x81\x96뚊Q\x85\x16ƪ\r4\xc3\x12?\xc1B8\xdf\x16\xae\xf9\t\xbc\x8a\x9f\xc0\xab\xf8\tJ\xc9\xe9\xc0t\xfd\xfb\x12۪\x14\x87\xa1Ҭ\x9f3\xde\xca\xe2\x97\xf1-O\x9e\xfb\xa8\xdcBO쩵\xe2\x9f,*y\\\xf0\xa7\t\x7f\xfdt\x1e\x04\x16\xb5\xae\x82\xc8O\xc0\xedԕ\x9f\xf2~\x17J\xccӶ\xf8\xd3l\x8b\nn?䲪\xfc\x99\xf8\xb7\xd6L\xfb\x8d\xb5yòH\xa9볦K\f\xa6\x14D\xfe\x1c?\xe0:\xef\x80M'5R\x1e\xa1C\xf0_\xc0\x9d\xe0\xb3\xffT\x17\x8b\xcf>/\x0f\x13´\xb5-(&^aģ\x98\x13\xf8|33x\xb4xP\xbcV<\x15b\xdcU\xfc\t\xb0\a\xe5T\x04\xc2RW\xa2\xf7\x88[S\x87\x9b\xca9e\x82\xe4\x8e\xf2\x03\xa8~2\xc9\xcd\x12\xe7\x18\x00>\x927f\xf9҃\xa7\x85\xe2=;\xf2\x81B\x93\x02\xd9nr\xf5P\x8d\xbd\xa8~\x0eW\x82a\xf7\xc9Mo\xa0\x12GE\xf8B\xfaR\xb8\xfd\x0fw;\xf9\xf7\xf4\xc2\xf2\x88\x13\xf3Y/,\xd5\x0e!\x1ft\xf8X\xe9\x85e\xf39/,\x9b\xaf\xf0²\xa7\xa8\xa3\xf6U\xb2\xc5B\xf4\x82\f5/'\xd5[\x02E%\x9d\x9ePs\x1b\x1c:\xc7\xe0D[\xfd\xad\xd6\x05\xe2)\x12\x0f\xd4\tYB\xb9ԣO\xeca\xdfu\bMe\x18\\ۂ\xbb\xf7\x1dO\xe3\xf0\x86cl\xd9b:\x8c\x05CǪdi\x02C\xe7y\n\x9a`\x82\x95\x96Y\xf5m\x12\xaf\x03\xd4y]\xea\xf4\x15\x06\x91\xc8sV:G\xb9x\x1c\x1b\x165f\xb0\x8e\x1b\x19Xe\x11G\xdc3C\x0f\xae\r\v\xedi:\xcbU&X.&\x99\xaefʞ]\xe6\xc19\xe6\x10r&B\x91\xc64տB\xf9+\xb3\x17v\x02\x04'1\x8d\x98\xaf\xa17\xe3ԯ\x81g\x0f\xbdܰj\xdfW\xe63\x15\xf4F\xefT\xb9\xb4Tη#\x06\x8f\\駺x~\xa7\xab\xd9^PX\x95\x84\a\x15\xdc\xf0\xe9\xb68}ـ\\\xeeV\x82{)\x19)X~>;,\x83p`/\xa4'{p\xb1\x95\x97_\xcd\x0e<i\xe1U-k\x975_\x8cu2\x97\x01\\8O\x12\x1b\xfe\x10/+v\xb5\xc7\xffƦ\xe3\xa2\xe9F̊$\xcc\xec\xabR\xffD'Ⓟ\x97Þ\x91{\x88c4I\x8a}z\xd9t9\xc3\x1a{\x02\xff\xec\xedH\n\vr\x81\x90\xcf\xd4\x02H8\xca\xd7\xd9?\xbe\x86>\xc2ϸ8plh\xa9\xc31\xd4\xe4\x1cYB\x93\xfd\x9eV\xe9\xc9\xd2#\xbc\x0e\x80'ߎ\xa8%Ҋ\xaaچ\xb2\xd3\xe7w\x8a\x1b[\x8b\xf3*jQpخ#{\xb5GOm\xf4\xc6t:{\x18\x8b$)\x05\x02\xb9\xa3\xb6oc\xc8\xde\x15\xd5g\xbb\x80\xb7\xa8og4U\x99ZY\xad\x98\x1f~&\x7f\xfb\x99\xfc\xb9\xee\xfc\xd0A\x93v\xb5pXmy\xc81\xa1\x9ac\x9f\x98\xd8fb'\x1a\xcb$\x1a\xcb$\x1a\xcb\x00\x94D\x02\xcbx6\x16\xe0\"\x17Ԡ\x8a\x1d\x1b\xf1\b\xf36\x83rL6\x11\x1b\xf6\xa1\xd0\x02(\xbb\x83\xa7\xadB\x9bП\xf9\xb0?\x11\x11\xb0\x1e\x1ex\x86,\r\x80\x06;\x01\x0f\xed\x12\r\xc2\xf4\xd3\xd987*\xffL3Q\x11\xa4\bM \x18\x19\xccW\x99\x01\xe4\x16C\xd7F\xc8*x\xd3\xc1qA\xaf\xbf\xc7n\xder\x00\x18q\x1b\x14\xa3>\xc2A\xce1\xb7\x11\x1bM\x1c\xc4T\xed\xeaCe\xa0\x99\fYHQk\x19\x84\x19O\x8a\xa1\x16U\xfc\xbc\xaa&\xbe\xe7\xe9<\t6Y\x8c\xb1uH+\xbf\xd9\xf7\xb8\x1cBANX\x03 \x8a\xd2\xec[\xd3/\xf5\x7f&\x19Q$_\n\x0e=\xc6\xc9if\x06kW\xaf\x80i2\x1bG\xc6Ԙ\xf9\x01\f\x17\xa4\xd4\xf3ށZ\xb6p\x90V\x10\xd5\"$\xdf\r\xf9\xeb1\xaa\x95\xd3\b\xb6\x15q\xb8\"v\x8a\xcaJ)\xa84\xb7;}\x97\xb4\x82(\xdd\xf0yFC\bƛ\xb6\xe6\xdb4\x8b\u05fb\x9d%ӭ*tF\xee\xf5\xe4\xb9}\xa0\x93\x81*\xbfEΦ\xf9\x9e\xd5q\"\xecBp\x0e\xbf6\x0f\xfd4\xad\xf9i\xcd\xd7\xf7\x97\x95[3I\r\x1b\xd0\xe7˥\xf8\x99\x1f\x84ƧRA\xd9KdK\xb7\xfa\xe1\f\xf8\x84M1\xf4\xb6\x05\xa0d\xd1C^\xaf\f4\x05\x165\x1e7\x189c\xa3\xf13g\xa2{\x96\xcc\x1d/\x1fYf\f+\xa3\x8d\x86Qq\xbf\xa7\xa2wi\xef\xfb\xc8\x01\xe8\xb6\xf7ceccx\xba\xc8;3\xd7\xe7X\xcf\xd5\xfd\xe2\x86\xe9g\x8a\xa8R\xe0\xe6hWqj1\xdd\xe2@&FυM\xf1J\x19\xd8\x10̊6\x9bF}\xca\xf7z\x88袨b\x88Ǻ(\xed\xbbj\x06\\'U-\xb0\xb2>6f*i\x06K\x8b\x86\xb3|\xb04\x111(q\xf2\x19\xfa\x7f\xf9\x94\x8dI\xd2`\\\xa5j4\xa4g\x80\ue5fe\xa8\xf3Z\x80a/涓\x9b5I\x96-\x93Y?F\xa9\xbf\xe46\xb8\xcbs\b\xf5\x8d!\xa6`\xff4&\xc8 \xf73\x9f&,\xa6\x11KiP`\xf3m\x94n(4K@\xa4\xed\x9b\xde\xff\xa8\xcf\xfc\x82\x8c*6\xe7#\xbcx\x1d\xbb\xe9\x95[\xa2\x1a?-\x82\x8b\xe9ݪ\x98\xa1\x02\x81\x99\xa7\x1as\xaa\vrtY\xc5\x19+g\x9b\xf0\xa7|ke\xe2\xf3\xf2\x9ag:\x8c\x97\xa7\x93\xb4\xac\x82\xe6;\x86\x1e\xb4*`\xce\xe4\x1b\xe8>u\xad\xbc\x83\xe3'\xd9\x04$\x17\xc8x\x013\xb0Ǽ\xc9r\x16\xb7\x86,\xa51-\xb6\xd2g\xfcL\xa9AO\xd4\x0f\x0f\xf5\xb2}\xf4\x8a\"\xb3\xa2\x06\x8b=\xf8\xc7\\X\x871\xe8\xc4'\xe4\xbeP\xd8n4\x12*\xfa3\x0fn\xab\x88mJ\xc7\f\xe4\xbd&\x92\x13\x9b\xa5\xfa\xf3\t\x11\xeee\xf6\x8dFb\x92\xf3ZC.\xa1Qa\xe1\x14\xc3\xf1\xd8\u0095\xa0\x9eP\x03\x84hĄ\x91f\"\x80\x00\x80\xdb\xce\b\xe5M\x96\x98`1V\n\tzY\x93\xcf.k,[\xa3\xb9\xc3&\xeaP\xff\xc1ՌhR^\xcd\xe4\vV3\xa1\xc9\xf1Ռ*W3\xa2\x99ZͰ\xf2X\xcaJi9\xb0aN\xa4d\xcc\x1dg\xa7\xe5\b\xb6\xe3L\x11+\xc2G\xfd\x89\x11\x896\x9bM\xcc\x0f\xef~?\xce~מD\x92j\xb4\x13\x02\x94kK\x06n.\x12~\x922\x03\xdf\x17 \n\xfd\f\x01\x98V\x92l\x01OmN?W\xd5\x06\xc7\xcdƘ\x8e\f\xe88M\xf8\x99\x0e\x84g\xab}\x1e\x86\x8d\xef!*rFAa<[y\x0e\x15\x04\xd4\vA=\xa1\x13\a\xb2\xdfπa\xea\xdbF\xa4G\xe0wn1\x8dn\xd1\xf5)\xd9S\xd4\f\x7f\\p\xabq\x91\xea%\xf7\x01\xfe\xa1~\xc1ͦ/tX?\xef\x1fH\xab0\xa2;\xa1\x92\xf5\xe2\xc9\xe1\xa3\xd0,\xceQ[C\x06g\xcb\xfb\x0e\x94\x8eLɖ\xc7P\xbf\xbf\x97/m\xef\xa8G\xac\xf4+<X\xd3\xf0+\x18\xaeZ\xa6\xbdۅ\x13;\x9b\xc0\xb3\xce;\xc2a\x9dT\xb5d\xaa\x8a\x82\x18\xf23E\x84\xaf\x88ܯ\x12U\xe2,\xaf2\x95\n7\x10\xf6\xd7\xf8\xe2ν\xb8}\x19\xf78\xaf'\xd3\xc0\xdf\xca!O\x04\x94K\xf9$)\xafQ\xf2\xd0\xec'\x91\xa9\xed\f\xa2\xf6\xe4\v\x16+\xa6Iy\xa5D\x92\x97M\xecR\xcb\xe2}\xee\x95R\xf7jE\xf7t\x1b\x1d\x00\xe1\u05f9<W\xea\xc1\xa5\x02\xe5()\x87\xec\x95\xe3u\vAW\xber\x13]z4<\xda\x03\x99\x85\x11\x17s\xab\x8c\x15JE\xaaT\xa9\x0f[y0\xbf\xa4yM\xf6\xb4h)\xe2\x1d1\x03(\xfbs\xe0\aR7P,5T\xc4v\xbb\b\xb4Č\x94\x89^?;#\xde!\xfe\xda\xef)H\x0e>\x87\xa7\x95\xfdN\xeb8\v\xcaD\xe2\xe4~\x7f\xf8Юq$\xd8\xfdc\xbe\xaar\xdfTvF%?h\x9c`p\x8c{`\xd1UX\xbe\x9d\xb8\xe3C\x87\v\xa8#N\xee3P\x88\xcd\xd9\x18e\xea\x80F\x86\xb2mBh̜q|\x9a\x8ccth\x14\x9ba\xeb\xe3٘\x9b^Y\x80\x13\xb1\xb7\xfd\xdd.V\x0e\x03\x13\xed\x02E\xfa\rL\x8c\xb8\x82\xb9sV\xe0Th\x96\x87.\x99G\x17\xcc\xf4o\x1a\x16\x14\x19\x8cJs\xb8[\xb7\a\xfe[\xeb\xf5r\x8a\x86J:7\xdb\x02\xcb\x19\xba\x05$\x14\xecv*v\"\xd9\xd3\r4Y\xbe\x99\x1e\xe0\x9f\x16\xc2ǣ\xf6\x15\xba\xf3K\v\x8d\xae\n{\xa62\xd0\"bq\xa0m\xa1\xb62\x00'q\x13\xbb\xdcy\xf5p\xf0~\x12\x1a\xc3\x11\xa1F\x0f\xc4\x03\x8d\xae\xed\xc4.\xafK\xc5J=\u070e\xe0d\xee麸%\t\xff\x15Y\x99J.<'{9\x83\x93*ݸVʳ?p\x1f}\x9aT\xaa\xf1\xfbWq\x92\xed\xed\x8cLRt\a}\x88\x1bs\xef-!\xa1\xe2KzI\v\x85\x0e+\xa6\xc4\x10\x926\xe30 Y\b[FWj8\xea\xc9\x1a\x1d,\xc7ȆE\r\x14\xf0\xa9F\xa8\xd1\xfeR}哣\x12Z\x11u\xd4\xeb\";w\xdbV\x18\u0382\xd0\xd2R\x95\x9c\xe0\x15\xc7X\xce.\x8e\xb7\x98g\x8cX\xccF\x9c\xaf_\x8f:\xe9\xab\xec!_\xc4#\xfe\xf9*\xf3>\xdf\xe4\xb22\xc7X\xa4\xa3\xee\x00\x01\x11\xa3\xa3\t\x8c\x18_ɥ4\x94h\x04\xc3Q\x99\x17\xa3\xb2\x9a\x8aH\xec\xdf\xf8A\b\xa9\xc0/\xceV\xbcv\x95ķ)O,\x10i\xf7F\xa3n\xffA\\?\x96\x8f\xcb\xdcb\x13\xderhy\xf9\xe2\xfd\xfb\x17\xaf\x7f\x0f\x16\x9b\xefi\xc0b\xf3*Ж\x95\xa6'T)\xb7\x90\xccRn\xba\xe9\x84\x01\xa0\x02ߣ\x91\xba\xd0\xcbC}\v\x81\x89\xa5|!\xf7\xa4\x03\">\x9fh\xeb@\xe9\vS.\xb0]Ā(\x89Ў>\x84'\x1c)BG7\x13\xf4^!\x1a/\xcb9\xe6\xf1\xfeP*\xa3\xee,x\x9c\x8c\xa1e\xea\xdbZ\xd8\x19V\xcdE\xf2\x94Q\xdfT\xd5\x06\xe3\r\xfa\xb7`\rN&\xf2\xb7]\xa9\\\xa3\xa9\x00\x83\x1e\x90\x98ͷ!\x06\f8\f\x90\xfe\x14\v\xa2\xf1-\x8c\xa6\xe0G\x7f^\n\"\tu\xec\xac\xe0\x94\xc1\x9c\x80d-Ti\xecOUnӝMR\x8f\xb76\xf1\xc6&^\xba\xaff\xd2kM\xf5\x83\xab<3\xaerd\xaf\x80̂\xa3\xb0\x02\xa4\x14\xc6U\x9e̴\xe6\xc6F\xca̋\xae-3\xf0\x17\bȖ\xe1/\xcd:9m\x1bL\x7f_i6\xa4\x96\xa2\x00\xb2\xd6\xda\xdfئ\xfcZ\xaaK\xf9,\x17\x9f454\xc0\x11\xf0ir\xe6\x14!*B\xbfT\x9c\x80-Z\xa0\x1f\xfb[B}\f3e$\xc4v\xa4\xe5\xea\xb2M\xc0.\x8b\xed\x9c\xdbs8\xeah\x8d\xfdEd\x9d2\xc9~\xf3\xf6\x830\x866\x84%\xf7e\xabn\xaf\xa4\x90\x1b\xab\xd9VǤ\x97\"Ȓ\x12ۄ\x97=\xa9O\xc0M\xbc\x90S\u0600\"\x12C&/\xf5}\x83\xf4\x87 \n2`-\xedv\xaf\xfcl\xd5Z\x86q\x9c\xd8>\x84-\xf2w;\xff\xd41\xb6*\xb2\xe3I\xe2Y\xab\xa2\x0e^\xaeVZh@\xb3A\nڻ}\xcf\xedw\x86]\xc0\xd0h/o,j\xbe@\x95\xd1W\xf7\xb4=趇\xc7v\xa1\x9ao\x93Q\xeb\U000929ef\xe2\xc5\x16\xe4\xa3R\xac\a(\xcbP:\xeav:\xa3\x0e\xd0\xc4v\xc4b\x024\x7f^g\x12y\xf7\xda3\xb6\x88\xe7:\x18\xf5\x9d\x01\xa8\xad\xc9\xf4\n\xac[>T\xe0\xbfW\xeb|\xe5\a\xc85\x19g\xee\xccӊ\xec\xadW\xfe\x86\x1e\xc8a\xab\x9d%G\x87\xbd9\x95\xbd9fo\xce\xcc\xcbl \xd4\xcd\x01\xe4\x11\xafn\x83l\xf5j\x9b\xf9\xd0OZ\x12\xd5V\xf0ʹ6#0\x98\xa7Ɍ\xd8\xc8'ç\x00\x19\xdb\x0e\rZ7~\x18,\xfc\x8c\xbf\xe6\x9f2$\u0088\x1d!\xa8ST\xf4\x91\xca%\xf8\xdf>\x0f\xed\xce\xf4*\xef\xe9\xa0=\xe8\x1cuO\x91\xb5~db\xce\xc6\xc6\"\xc0<jc\xb1\xbe\x9f\xf75\xea\xf6$\xa8\xfd̀F\xab\x01%\xf7\\k\xfd\xe3?\x02\xae\xf9\xf4\xf4\xc5\xeb\x17\x1f\xd09f\v\x90\xfaĂ\x93\x19\xf8\xa10\x1bQ\xfbT\xdb\xf8i\xca\x17\x10\xae_(\x8a\xbc\xcf\xe2\x84[\x9e\xb5I\xf8M\x10o\xd3Z\x8a\xe5\x13>\xe7\xc1\r_Ԯ\xee\x90r\x11\xe8,\xb1\x8e,\xee\xa8\xd3\xef\xb9\x7f߳\xc5|[\x9d-\x044\x1bPd\xaf=$e\xdf!\xf9Rps\x83x\xbeA|\xbf\xafZ\xc5\\\x8f\xd6/\x9c\x15q\x17\x9d\xf8%A\x1e\xaeTm\x11sAܭ\xfc\x1b^\xf3k\b\x98jyZ\xb5W\xfeG^K\xb7\t\xc7U\xabZ\xf5x}\x15D\xfc\x9d\xa8\x90\x02\xa9\xe8G5\x81\xe3j\xb7\xab8\xe55\\\x89\xb4\xe6'z\xd9Ӗ\xa5\xdc\xe1\x83\x01\x9c\x9c\n\xb1%JV\t\xad }\xb1^o\x91B\x9a\x9cT&\x03\xf7\xd1\xc8A\x05( [\x83T\xfd\x04\xb6\x96\x9c\xf1\x87\x15\xafY\x8d\xa0\xf1\x04F\t\x16:\x11\xff\x04\xccg\x98\tH\xffj\xe7\xeaSO4\x8bkW\x1cf\xa4\x84\xf4PO\xf7\xdez\x1e\x87!\x97\x9c\x89\xc4H\x7f\xc7\xe7q\xb2\xa8\x01\xde\xc0\x85[ơpbW\xdbh&\xbdW\xb3\x9e4|\x11\xbc\xe6\x89Ek\xd6\x13\xd2xb\xb5\x9eH2\x93\xb7\xb2\xf8=\xff\xd5&\xb0\x89\xe2G\x16\vB\x82\x1c*\x8d\xe4\x06X\x19\x18j\x19,y\xe1\xcfF\xb3ms\xe9\xbc\xf5c>}\xab\x81ΤSMR\xc9a\xdeY\x9e\xfa\x19\xf0\xd4\"\x8d'0\xe8\xb4<\xe8\xda2\xdeF\vx\x15<\x81\xe55\x162\x8bk\xcb Z\xd4\xe2\bW\x0e\xd6\xe2c\x14\xdfF\n\x12\xd4z\xdc\xd5\"\x7f\xcdSe;wdmjƐ\xf3a\xd5n\x830\x84m\n\xae\xa38\xe1\x8b\xd6\x13iVR\x8d7\xe1\xbe\xfa{\x1dm\x9a\x1dbr\x86\xc8>\x1f\xe9\x8b\xe8&\x9e\xe3\xd5\xf1\xd6O\xfc5\aJ\x81\xa7\xa9\x7f-Kb\xa5\xd7\xfe\x9a\x1706\vr\x04Ac\xf1\x05H\t4\xf8\xf0k\xd8\xeb\xb5\a&\xf2\b\xbe\x00y\x14\xba\xd5Ǉ>~\xe4\xb1Q\xe7p\x05\x8c\xab\x03\x87\xf9\x1fxuh\xa7h\xfa\xc9\xc5I\xf95\xfbDb'\x00\xaa\f\xc0U\xac\x12_Զ\x91\xe8}Q\xbb]\xf1\xa8\x86\xda\xc6pJ\xad'\x8d\x04\xaf\x1d(\xedcW\xadڇXB\x18\xe0\x03\x91Hkw\xf1\xb6\xb6ަY\x8d\x7f\x02_\xe2A\x16\xdeմ(\x92\u05ca\xd7P\xeb\t9v\xe1\f\x06\x83\xa3\xb7y\xd2Z\xd8\x19\xbd_/<\x9b\xb0\xb35\xfd\xfe\x1c\x7f\xac\xe8\xaf\xcf\xf1ǂ\xbe\xfa\x80?6\xfa\xb2I\xec\xee\xa8\xdb>\x94~\xfd7\xd5h\xf3\xffCD\xb6\xf1cD\xb66\x88\\\xfe\x85\xfc\xe7\nn\xe3\xffT\xc1m\x15\x8a\xb1^\x05Q\xb0\f\xf8\xa2\x06\x87\xebS\r9Q\xb5\x7f\xb0\x1a\xbca\x8dk7A\x1ad\xb5U\x96mR\xef\xe9S\xa4\xe7Z\xbf\xa4\xad8\xb9~\x8aG2\x9d\x80>)\xc3ҵe\x9c\x88Kr\x1b\x86\xb5\xb5@0p\x9d\xc2\x11\x80\xf4(\x8e\x9ak\xd5ۂ\xdf\xd4xt\x13$q\x84\x174TƊ8\x80\xb4U\xb3\xa4\x99\xe4\xe7\r\xac㫔'7\x82\xf5m\xfd\xe3?\xe6\x9fV\x91En>\xe7\x12?Z\xc4k\xbc\x8b\xa5\xd2L\xa7OZ\xe9\xf6J\x18\xcb\xd9\x03\x821\x052\x1b\xac\xd5D\\\xba\x96\x85\\\xf5{\xa0g\xbd\"u\xdb\bmBߝ\xbf}\xf9\xec\xf9y\x9e%\x13D\xee\xdbwo\xbe;\xd7.\xc1\x9e=\xff\xf0\xe2\xcd\xebC\xdd\x1b]\xb7\xaa86\xb4ߏ\x0f\xfc\x8dZ\x82L3\xd8&Bn\x89HTǽΏ\x15Wj\xbaǢv\x83v\xd5Ѽ\x03\x14`\xe6s\xc2\x18\xcb\n\x8c\x1e\xc3,l\\-\xd5\xc9*\x19W\xc9nW\x95ZY6?\xf5\x9d\xd9\xc1\xb5\x11\xd8Ҕ\xa1\xbak}\xe1$ںL$)\xf6\x1f\xf0\xb4K\x02)\xbd\xd2IEo\xae\xa1\xafmo\x88ppQ]\x9dWTo\x13\x1dq\x87\xfa,\xa3!\x9b\xce蒅tU\x88\xd2\x03qg\x96\x8c\xb1\x10\xdde\x87R\x97\x86\x94b\xcd\x04K{U\xd1G'\x1f\xa2_\xb2qy\xfc@\xbbbU\xab\xda\xef\x11\x15x\xef\xc4Q\x1d-lB\x97\xdaGDQ\x18\x97\x1d\x1di\x1fL\xe9@t\xb4\xb0E\x93\t[jkRN\xc6K\x15\xfb#\x81\xd8\x1fB\xddho0'o\xe4\x9cN\xe0\xb0T\xb4?\x10\x93ȩ\x0e$\x17*\n\x0e\x8f\xcfv\x04cL\xee\xeeW\xc8\xdbe\xb1\xed\x83\xf8\n\xe5\xc6!\xa6\xba\xfb\xfc\xf0\x85lI\xab4\xa6m\x87f\xc8c\xa8B\xd8w_\xb63\xc0\xa3\x1e\x03\xfc\xdc\xd8\xf7P\xcc۶$2\xda\x1b\xf0q\xa5\xa4j4c\xd7r\x97l\xce\xee\x01\vΓ\xe0\xea@\x99\xf3A<S1\n\xd7$k\x13\x8c\x93\x04Z\t\xf5\xba\xf8k\xaf\xed\xfcvJlB\xef\xb7Q\xde7܉\xfb\xfd\x9e\x80\xed\xc0\x11+\x18\xcaU\xed|\x9e\x80\x8f\xf7\x84\x82M\xd5\"H7~6_y74o\xf6\x9a*\x9a\xd9[ӄoB\x7f\xae\x1e\xbdޝ\xe8\xed\x8aF%\x7f\xbd\x0f\xf8\xec\xcfl^\x1d\x8d\xc3䐯\xb4\x9ex\x15\xdf]q\xf8EW\xe3\xafX\xe8>Qg\xe3~\xaf\xf1|\x84\xd6\x00J\x15\x90O\xa3ٸ\xa2\xf7\x18-5\xa2\x19[\xda1\x12\f\x87D\xd9\xe2\xef\xc8Y\xaf\n![\xc5?\xda{\xaeY$CΞb^\x7f\xc6\x04,o\xc5\xce\x1e\x90\x04\x95Q\xe5\xdfy\x8a\x9f\x8f\a\x97\xb0\xa3\x82*\x1a=$\xd5\vl\xb7\a6\xda1\xbb\xd7\xc0\x9c\xe8\xb7 \xd5p\x7f\xb8\"ѱ\x0e\xf7\xe0\xec\xf5@@a\xacdlr\x1f\"\xb6(6\x94\x12PN\x95\xfd\x82R\x9e\x0fJ\x82\t\xa1\xf7{\x9a\x9f\xc3h\x0f'zO\x87\xa3Q\xfba\xb9CὣF!\x1c\xfd-\xe29\x8eY\x1a\x94\x9d\x87\x1c\xbel+\xe3\x9f2?\xe1\xbeEH+\x88\"\x9e\xfc\xe1ë\x97̪\vJ֢Q\v_\xa0{\xf9\b\xbbć\xd6s._Z\x9aӖ\xeb\x90\x17\xd9/㲞\x9f\xf2\x93\xc3\xebu\xa9\nP\xf4\t\x97\xe6|\xf3\xe9L\xdc\u03a2\x98^t\xea\x1ar\x99J\xe2_ߌ\a\b!\xab\xb4/\xc9\x1a\x16\xbea\xaf\xb8\xe4\xdcYd\\\xe4 Vs\xb91\xf0.@\xf2^\x1c\fi3\x1c\xb2\xa7\x17\x17\xf6\xf4\xe2\xe2\xc4\xfa\x87o~W\x7fb\x93o\x1b\xb4u\xf1\xd4\x1b\x9f\xb2\xb3\xc9?N/f?_\xfe\xf5~\xb7\xff\xd7\xe6\x8c<\xbd\x1e\x17\x05\x82ZN\xa2.k\xeb\xe2\xc2\"\xa7\xce\x04l\xee$\xe2\xb5Cj}\xe3Ze\x17\xfarim~\xc6z\xbd\xf6\xa8_\xaf\xf3S\xd6\x1bt\xba\x1d\x10\xbbaz\xbf;\x1a\x88\xf4~\xcfq\x06\x90\xde\xef\xf5:\xbd\x13&\xfe\xd6\xc19\x0f\xfc\xea\x16RDe\a+\x0e\xe1\xdbue\xf4_\xccp\xbb\x98\xd3quQ\xb7=\xc0$\xb77\x82\xc0\x846?s]\xb7\xeb\xc2Ն\xffW\x12i\x82\xb8\xf7\f\xbbS{\x8f\x13hؠK\r\xe9}rv\xe6b\xf4\xf4^\xbf\xd3v\x1a\xb6\xeb\xb4;\xf5\\\n\"\x1e#(\xad\x7f\xbe\xf2\x93\xe7\xf1\x82\v'=\x0f\xe4K\v\xbc%{Z\xb7\xa7~\xf3\xb7\x7f\x98\xc1\xbfNs4\xbbwi\a\x82\xaa=\xbd\x0e\xe8\x8a=\xfd\xf9\x1fl{\xe2}\x9a\xfaͥ\xcc\x1d\xeewS\xfd\x93\x90\xa7\xc1\x81\x15v\x1e\xb196\x88\xff\fl\xfc&\x91\xd7\xe9!\x96\x9eˡ<\xcbl\x87\xd4\xeb\xabV\xc6\xd3\xcc\xceH\xbd>\xb7\x13f}\x02\xf6\x7f6ugE\xbf\x1f\x13\x1d\xa99\x93\xd4k\x9bP\xb7O\xbc\x83t\x97@(g2٠\xb3\x85\x92\t\xf8!\xa0\xd5\x0f\xe0l\t\xea\x130\x95k\xf6tZ?=\xb3fO\xe9\x8d\xfeyM\xefؽU\xb7<\xab\xee\xaf7c\x8bZ\xa7\xf0;\xcc\xe0\xe7\x19\xfc\xbc\x86\x9fO\xac'\x9eU\xffu\x1bgc\xcbx\x85]\x19\x83\xb8\x9b\xf2Y>\xbc[#\xe7Z\xac\t\n\xe1ըn\xe8\x15L\a\xc6u\x8e7\xb7\xaa\xf8\xc9D1\x8dFv\xa6\xbd\xf85ۓ̳6~\xe2_'\xfefu\x19o8\\\xe6\fC\a\x01n\xa8\xd7\xc5\xcf\xe0z\x05Ϫ \n\x83\x88\xcb\x12\rW\x95q\xf2\x84y\x1ce\x80N\x95\x8d\x95ѸP\x8c\x90E\xdbF\xfb\x8d\xb6\xeca\x82#m\xb4\x89\x97\xed\xcf[Wa<\xff\b\xeb\xc3q\\\x87d\x9bu\x9a\x179\xbb\x88\xac=-T\xc2\xfe*\xe3\vX\xa7O\x8d\x9aV\xe3\xd2\x16\xf1\x02\xceў\xb5\xb2\x8a\x88\xa6\x84\xb5&\xd6\xe9&\xe1g\xa7P\xf6\xccj\xdcژ%'N\x1a\xd6\xe9S\xcc9}\xbaI\xf2\xc6=\xebs\xe5q\xf8K\x1e\xcdy\x85\xeb))\xc0\xa1)\rqs\xe8\x9cY\x16\xba\x9a\f\xfd\xe8\xfam\u0097\xc1' \xf5\xc2\x16\xac\xf7:Eܑ2\xdbg*E2 \x9e^\xa4\x8d\xa7\xd7Dq!j\x16\xa1\x81\x1d\xb7\x92m\xc8S\xd1\xfb\xa5\xd0\xc0\xa7>h#(b\xb2\xaa\xc4\x14J̌1\x8e\xe7쉰Bd֓Ʀqk\xaf\xed\xad\x9d\x12\x02\\\xfa'{\xb5\xf6z\xf1\xacƼa\x9dY\r;A\xa1|(\x80\xcc\xf8Pܽ<\x85NC\xb5r3\x15\xa9\xdf'd\xb7\xbb\xb5u\x069\xb6\az\x81\xe5\x04\xc0l\xfc\x1c\xadH\x82\xe8\xba\x04c\x05hYY\rܳ\xd5K~\xc3C\x18\xb3Y\xf1A8;\xa8*\xe1t\x95\x1c\xd1[\xb0\x93֧U\xb6\x0e\xdfl\xb3\x89u\xbaJjO\x01e\x9c\xae\x923\x8b\x18\xf3\xb8چ!\xcf.\xc3 ͎\x9e\x8em\xa8O\x85Q\xfa\xc1\xe1n\xc3\xc2ja\x85 \xe3\xeb\xa3}\x84\xc1\x99U,Yj\xdfh<\f\xd4x\xe2d\xc1\x13\xbe\xa8\x1a\xbe\xa9\x8f<\xcd\x14\xfdk\x9d\xc6!\x02\n\xd6<s'O\x80u\x9ed\fY\xf2\x98\xd8@\x84j\x91|\x8d\v\xbd<8\xed\xb88\xed\"B|\x00\x1f\b\xace\xc1\x06mάbժ\x0eżNl\x13\xa9\x1e\xe0զƫ'\xf2K\x01\xb6~U\x97\xfa~\xba\x01ఓ\x89\xc25\x96ܽ\xe8\xe3\xe1\f\x8c\xb0\x11\xa2\x99,\xe4\x93'5\xfc\v\xcb\t\xa76\xcf\x11g׳,\x1a\x03\xb2\t\xa2\x8f\x1f\xfc\xe4\x9agP\x03\x7f\x88\x1d\xc83dq9\xd4'\xa7~m\x95\xf0\xa5hX\x9c\x84\x84/\xb1\xd1FԈ\xd5Y¡\x1e\x87\x1c_\x94\n\xd6\xfe\xb5Q\xa00\x99'\xb54\x99\x1bݤ\xc9\x1c{\xa1\xf1\x97\xcdS\x03\\\xb0\xbe\xb6\x1aQ\xc3~R\xf3C\x9c\xa5(\xed\x87\xd9D 6\xf5\t>\x17\x00\ue78082n\x14\x0ep\xed\xa9%a\x12'\x80b\xe0\xa3g\ts\x15\xf0\x8a\xa2Ǘ\xa4X\x18p\xd1\xf1v!\xb7X\xf4\x81v\v\x85\xaf\xe2\xc5\xdd\xf1v!\xb7X\xf4\x81v\v\x85\x93\xe3\x8d&g\xaa\xc8\x03\x8d%\xf9t\x1e\x89?\xb2\x15\xe2\x0f?\f\xae#\xc4\x1ew\x00\x06\xf0\xcckb\x9a\a`\x8c\xbf\fD\xa2\xbaxh\xb9d\xa1\xc5cǱ\xf8\xeaq<\xb8m\vQ(͒\xf8\xe02\xcbˉ\xecB\xd1\xe3m\x9a\x85\x1f\xb8\x02\xf8Z\x179ޖ*\xb4\xe0\xe1ц\x16<\xcc\v\x1doJ\x17\v\xa2\xf4h[A\x94慎\xb7\xa5\x8b\xad\xfd\xe4\xe3\xd1\xc6 \xd3(v\xbc\xb9\xbc`\xba\xbd:r٤۫j*\x103D\xdd\xcdѺ\x9bcu\xe5\xfd\xb3\xf2\x93\x05Z\xbf\x1eS\x8b,\x90\x17W@^\\Dp\x87\\\xe9#\x95\xc6\xcb\xec3M`v:y\xb8-\xcfRg\x14\xdc<T^\xa2\xa5\x99\xe0\x04\xb2u\x88d\xf6\x03\u05ee,\xaf\x8ag\xfe\xf5\xe3\n\xfbWW\xc9\xf1+\xfd\xc9)\xe4?xA\x9c=\xd1\xcd<pY]]I\x1c\xb6\x8c\xe3,\x82\xa7\b܀U\x98\xe1\xf5v}\xc5\x13\xd1K\xb0h\xb8\x86\x00\x11\x98v\xd62J\xf8\xd2j\xe4.\x02\xa0`\xba\xbdz\xb1\x00\xf5E;j0˳\x1ay*\xa1O\x00F\x14\x11\xae\xfaoB+g\xfa*\xfe\x87e\xf4\xa4\x01\xf4R-X0\xbc\x87\x9fXgSH\xb2fp\xd7\x1a\xe0\xa4g\x80[RML\x1cҭO\x80n-\x8d!m\xa6|c!\x88<\xf1\x8e\x17\x80l\xd2xr\x9aJ\xfd\xa9r!(p\x1a\x87\x87\x95\x81\xc8\xc3\xda\x15\xc3>\xbeW1\x90ȧOeo\xea\x04\xe8\xea\x0f\xc0J\x18\xe0\xea\xc1R>\xb4\x8b\xb0\xca\a\xdb\x01t\xb2\x84%\xdd\xd5c\xc8f]؏櫸:⸂\x99\x87\x06u\x04\x9c\x92\np\xaa\xe5P#a\xa6<\x19\xf0ۋ\xf0\xf5\xef\xff\xc7\xff\x15\xa0\a\xa7\xb5x\x00\xcb\xebW\xc9\xe2\xf8\xd3e\x91ɛ\xe08U\xb3\x907\xde\xe2\xc1\xbb\xc2\xec\xec\x81b\x99.\xf6\xd0%\xbb\x10\x84\x11\xb2x/\xd99zh=@\x94\xfa@dL0^\xc8i\x1e4\xc1*\xbd\x93\n\xcc\x16$\xe6\xb1\x03#r\x97\xb4\xa6\xc5\xe77K\x81\x0f~.MYu\xef\xf9@\xcc\x007\xb2\xe2\xe5%V\xbd\xbc\x04\xb9\xafL\x99\xfb\xf3\x15\xbf\xbc\x14\xd2ͪ\b-\xb2.P\xd42*\x0f\x8fn\x98pg\u05ca7P<e\x89\xf8D\xf6Z\xa2]\xf7\xc4\x1fy\x94\xb2X\xe6ũ\x8e\x0f\x14\xa7\xaf\xfcOL\xb5\xabe!+t\xccp\xc3C]P\xda\xcfZV\xe1\xfb\xa5Y\x06'\xa0'\x14\xa4/\xa2\x97\xfe\x15\x0f\xb55,<'\n\x15 Ṹ\bt\xc3!T\xf91Z\x830\x81/\xde\xcf\xe3\rO\x99S\n\x03#\x8f\x95p\r͚.\r\x18\x97s\xa1\xa9\xf8\t,\x99|\f\"\x10\x8b\xfe\x94\f\x94\xa6+ҫ\xfa\xcc\xc3vV\xe56\x9b\xb4)\xb4-\xb03\x965\\j\xb4\x0f2℁\xed\xd1&NO\x8312~F \xed\xb2c\xc6q\xa5\rv*\x96\"\x84$\x8d\x06\xfa$\xacA\xe1\x0ec,\x16L\xbcf3!\xf7\xe0\xf8[z\xd0\xe0rw[\xe9\xc7`\xf3\x01\xb6\x16\xd8\xc3r\xbc\xd1\xc4\xf6\xe5\n\xf0#\x8bI\xbc#9Iӥb>ia6!\xf5Kqpr\xaf\xf1\x187\b8`t\v\x8b\xd9m\v\x0ft&\xb3\xb8\xb0\xda#\xb7\\\xc0-\x16h\n\a\xed\x9a\xd1;\xf3\xacb\x01\xdbg\xcfm\x11\"'?\x1c\xd3\x19\xa1.!\xa7\xcen\xd7\x1b\x96{\xf0\x1bf\x1f\xb0k)\x8b\x15\xbc\x04\xcco\xb4\xc7\xc1iZ\xaf\xbb\xa0\x06\x12\x97\xb7' \xe3\xa0\xd1\xd0\xe8\x19\xe0Jr\xb2\xa5\x0f\x15fou\x9a\xdfhӀ\xb4\xb2$\x00\xc1\xb7\x12m6]ώ\x90B\xe17\x81\xb0L\xd8\xed\xca)hݜ\x1b\xa3\x153\xa7p\a\x843t\x96V\x95\xc1\xb6\x84\x06\xa4\x14+FH'֨\xef\x97\xdc\xddgLx\xbd\xff\xf1\xdd\v;3c\\(P\x8f\x8c\xdcrX\x18\xf3\xc0e\xc6y\x83\x1d\xe9\v\xa5\x8aҺeD\xc8[\xb3Fc\x9c\xa9C\xe0\xe2z%\x95\xa5s5&h\xb3\xcd\x18Ӧ\xe01\xfb\x05\xde\xf6XI-\xb4\x8b\xf1W\xe8ɉ>\x0fJ\xb9\xf5e\x10}\xb4c\x8c\xdc`\x9cN\x13\xdbĠ#8\x1e\xb5\x85FP\xd6pO\x83I\xd6`m/k4\xf6j\x18\xa8\xd0\x111\aF_\xafw\xda'GG\x0eR\xa3\xe4\xb4\xd3\xde\xed\xdc\xf6\x00\xc7=&\x00\xebf\xfb\x04\xda\x17>G\xe1\x9cHe\xa4F#:sE\xe4 <>\xaeHn6\xa3SG&\xe7C\xaa\xf9\xd2{c\xccJ\x8bA\xb3ϯDq\xfere\x84\xaf\xcfʰ<4b\x19\xf0i\xd4A\xf1\xab\xa6\x0e\x83\x06\xf9\x1b\xf3\xeb\xf5\xceH\xfc\xed:\x86\xe3T\xa9\x94\x965\x1a\x14\xe7\xecCP\a\xd6u\xc98;\x8d\x05L\x1cYT\x967R;\xb6\x8f\xc5e\x88\x04L\xd0\x13\xa7\xb0\xb5q\xd5֪\x19\x7f(\x88\x97Ĺ\xd5\xc2\x1b\xc1\x97\xa7\xc0\x90oe\U0004f6cd\x12k\xe5\xf5\xdf\x1eE\x87\x10@\x8a.\xabq\x9e\xf3\xf7\xc2y\xceߊ\xf3\xec\x0e\xac\x94\x1dV\xe1=\xb2\xdb\xe1y\r%\x06\x04\x15\xab\x17vL\x83R\xcbs\x16\x9b\xbbB\xb7,\x10}\xd1\x00\xa2\x8c}q?\xd0&Vڊ\b\x1b\xaf\xb1ω\xbd)\xf5#{!\x9e\xbd\x01\xfa!`[\":þ\xaa\x109\t\x1a\r\xed_\xe0!\x94\x8f({\xc9>\xd8\n\xb1\xbb\xd4/\xd8\n\xb7\x12\xbe\xe4\t\x8f\xe6\x1c\xfc\xfd\"^.\xa4\xb0{|\xa0z\x1b\n\x84\xba7\xdf#~6\xe30%<Z\xf0\xe4\x052\x99\x0f\x9eoZ\xc1\x87\xe5\x84&\x1eF\xed\xdaΡ\x01\xb3\xacq\xdcl¬X4\xe5S_P\xac \xfd\xf1!|\x15\x15Ġ\xbe\xba\x82==\x1c\xc0Wt\x8d\x04\x97e\x8d\x1b\r\x1f\xcep\x81Q.\xc70\t\x1a\xb2\xba1K\x1b\xb3\xe7\xab \\$<\xc2\u07bc\x8a\xa1W\x0e\xbc\xe0\xaf\x1dB|-./Y\xb5\x13\xb0\"\x85\xad\x86\x9d\xb0\xa6;\xcep\xb5\x96v\xb1ȴ\xd1Hf-0j1\xb4i\xb5\v\xc0\xa6[\xee\x1d\xfc\x16\x04!7\aPr\xd8=\xb5,p\x16\x92\x0f\xe2P%\x03\x9dX\xf1\b\x18\xc6\vЖ\xf3ì\xbaTf\xa8B\x9e:\xe0\xe7@\xeaWJ\xff\xcc\xdcx;\x80\u05eej5\x13\xa3\x14\xf8z\x9d\xce\xe8\xc3\xc3\x03\x16B><\x1bE\x80~\x98\x8f%C\xbcSlU\f,i-\xb5Mrq\xe5\xfc\xec\xc1H\x12rWm\x0eF4\t\x04Aָ1:Ј\xb3\xde\xe2MW\x83\x19\xa0M\x1c\x9a5y5\xab\xa1\xe3~\xea\xed\x8df\xade\xa4\xdeB\x85d\x10\"\xc4\xf0\xefnw\xe4!V\x98\xc0\x15_\xc6ɡ$\xb8\x18\x13ޘ\x86Ϣ\xc24\xe2\xbfe\x1aJ\t6\xa6\x0e\xbd\a`\xf52*7\b\x1c\xfa.#/\xa1~\x98y\xbe\x9aϞ|~F\x18\xa5\xfd\x7f\xeb\t5ܿ\xe7\x94\x00\b\x8f\xc0\x99\x00\xaa\xd2(\x10hE\xef\xbc\xd4{\x86\xbdG_һh\xa0\xc4{\xe0\xac\xe8]\x004D\xbc)\x9fѬ^/\x8d\xe6aD\xc1\xd0\xf5\x1e\xe5\xd5\xc5\n\xe8Oo\x1alVv\xea\x1c\xee\xd4;貶\xf6#\xff\x9a'^-\x88\xa4=)\ue73f\xe6\x95g)\x9b\xe5cq\xe4\x82<bY\x16AZZ\x17ro\x1f[\x16\xf2_cz\xee\xe3\xa7w\xcd3\xec\xae\xcaNZ*\xf5\x16\xdb\xd0\xfb\xae\xef\x12\xbb\xdc͔\xcf\x10\xe8\xe8\xc7\x12p\xbf\x95\xbc\x98\xb2o7\xc1\xe5\x91\xf0\f\xa5=\x94`YT2\xdb=\x93sC\x91\xbd\xe3\x1d0s\x14\x8c\xe7\f\x9f\xc3\x01\xb0\x83\x18.\x1b\x15\x8dT|\xe5\x83T\xb32\xc7\xc6I\x05\x0f)g9\x15\xfb\xc3\xc5x\xcfˢ\x8aܡW\xcezҪ\xf6\xa7L\xea\xed\xe6YرC\xc6yҔ\xcfXV\xd1\xd7\xefy\xb5\xb5\xfb\xe9AG\x93Bc\x9e#X\x90\xef\x98U\xbb\x88l2\x9d=\xb9\xb0Z\xf4d\xd24|տ2[̟\x19\xf6\xb4i\x93\x8b\xe9\xc5\xec~ߘ|\xdb\xfa\xe6\xe2\xe7\x1d\xf5\xfe\xe1\xf4\xe4\xe2\x02\x94.\xa9uq\x81\x1a\x94\xd0\xfc\xf7\xec\xe9E\xa3\xb9\xbbh]\xb4v\x17\x13\xf1\xbf\xdd\xc9\xc9\xc9Ɏ\xd2]\xb3\xf9\x94\xbedO/l{\xbe\xcbֻd\xb7!\x17\x04\x14\x03\xbfc\xf7s\xcf\xfa\x1f\xff\xabE\x13\xcf\xfa\x1f\xff/\x8bn<\xeb\x7f\xfc?-\x9a\xad=\xeb\xdf\xff\x0f\xff\x0f\xc9=\xfd\x81=\x9d>\x01\xb5\xb9\xdf\xe4\x8fk\xfa#{:m^\xa4bt\xb3\xa7\xf9L~-h\xc8\xc2\t\xdc\xed\f\x1d6x\x12\xff(\xd5\xe1\xd0\xd0NW\xfcSInťE\x16\xd8F\x90F\xd2\xd0\xdfY\xc3\x15S\xfe\x86M\xa7\x16\xca\f,ZĊ\x82\xf0D\xf7\x1c\xbc\n\xfcE~~\x00\xc4c1\x7f\xddE\xfaq\x87\xef>y&\x1c\n\xb5Ro\xeaPwF\x15\xb9\xea\x01\xfe\xf7\xb8\xd06\x13\xefl\xf1\xf8\xa4\\1_)\xe0\xe9\x1b\xaa\x86B\xf63:\xb5\x80[S\x1c\xb7\xe1\t\x1b\x1fբ4\xbe\xae\xcc)\x11|73\x97&\xcc\xcf\x1d\xc7d\xa7\tZG\x82\xc2𡊠o*\xaa\x18t\xb9\x9f\xab\x0eV\xe9\xfe\xf9\xb9ޠ8Z\x91\xa8!\xd7m\x1ciV\xf9\x89m\xc7\xec\x8d\x1dQ5\xd2\xf2\xec\xe1=J\xc6$b\x91|8\xc5ju\xc7f\x93,\xa2\xaaQ\x88\x1e)\x87\r\xca3h_#\xc7#\xbf\xd1\xd7\xec\xd4\xca\xdfW\x8f]O\x1c\x91\xf1.c\xe5\x04\xa0\a\xe8\x17\xae\xfa\xe7V\xb5z/\xfe\xf6U\x7f\xfb\xbfͪ\xab3td\xc5\xf3\xf5\x86\x19H-\xe2\x1c\x13G\x88\x88\x8d5\xb33\x16\x83\t\x94\\\x175!y\xa025҃C\x95\xe9w\xa38VZ\xea\x96\xf9A\xf8\x00<\xe4\xdc\x18\xe6\x80kB\x97.\x99 `\x05,\xa8vR䑉\xa9\xe89=\xe0\xbb\xc12\xc5\xc8\x02\x9a\xf4\xceK/(6zBDӾ-\x93,w\x88\x06\xe3U\xd5\xcd\x05Oye\x97.\x91\xbd\xbb\x9d1Q\xd1\xde\xd4롾DO6\xf2\xfdW\x98\a*\xfdI\xd6k\xc0\xaa2i%\xae\xac\x10*[\x12!\xce\x1b\r \v\x18\b5\x02\xb5\xb7\xeaH\xdc\v)\xc8C-\x8a\xb6\x82\x85\x97\x15\x1a\f\xf0\xf8`\xbd\x89m\xa7l:#\x85\xfa\xa5#E\x110!2\x9d\xd9\xc8\xc3\b\xdf\xd2S\xc8\xf1\xb8\xd1\xedA\xfd\xf2)-\xf7\xd9l\xce\xc1d\x1c\x9b\xc0\xfd\xac\xd7픉]\xca\x13g\x84\x1e\x82\x92ԋM\xe1\x11U\xa9\x7f-\vNu\r\xed\x14M@D\xbe\xc6\xe8\x1e\r=\xa3\xc6,\x10\xc7{\x1beg\xce$\xff\xf0\xc03\xbf3\x8eN\xe3q\xd4h\x90\x87\xf7G\b\xae\xe5\x0e\xa1\x8cًԺ\xed\xc9د\u05cb\xf5}\xf2\x19\x10\x92\x8bg\xac\xd9\xfe10WQM_\xa1\xedG\x9dq\xe0\xb8\xd2U\xc5\x1dP\x90\x99\x10\x01\xb2*\xfd\x1d\xbf>\xff\xb4\xd9\xed\xec%\xb3\xec\x9fwS\xab\xf1\xce0\f\as\xa9WJ3\x1b\xf4\xbefĶ\x1a\x05\xaf\f\x15=\x1c\xb7\xb2R\xe4\x8d\v\x87\xb7\x95\xc6Iv\xc4\xd6-\xf7\xd6/\x7f\xec\x89hV\x8ff\a\xc3!\xf67\x8f\x18\xb2E\xcb\xf3Ef\xb2\xf8i/\xa9um\x11B\xe7\xac\\\x8a\n\x8c\xbe*c\xf4\xe2E\xb8\x9a&\xf2\x1e\x13\xb7\xa7\x1d\x8b$\x8d\xb2\x8d\x9b\xf4\f\x04*\xcd&\xb6\x80\xcf\x13\xb8\x14|\x16\x03\x95h܅!28}}\x1f\xcc1\x8a\xf0\v\xe0>1\x87n\xf1r\xbe\xe1!\x05\xb41ްy\x8b\x7f\xe2sd\xf1\x1a%\xcf\xc2z\xbd\bq\xc5\aQ oːn\x04c\xab\xb1\x01\v\x16I\xc2J@\xdc\x1e`\t\xad\xb1\x04\xf8\x01\x98\xbc\x15\x10\x80(a3m\xcff\xaa\x99\ntU\x1c\r\x94~\xb8\xcf\xd2\x01\x81R!3\xe6\xdb\xdcL;j\xf8c#\xa4Nx\x1a\xe8\x8fG\xad\x879\xf7\xc2N\xb2\x18,\xdd$.\xcbCsd\x84\xa6T}\x02\xe1N4͆\xb46\xda\xc2=x\x82\xc5Q\x95w>\xc0A\x8c\xf8\x91'\bR9Y\x97C\x92\x0f\x90\xe47\x9b%X\xd4x\xd4/\x83\xa4\x99\xf3\x10d\xe6`\x99\x18`)\x9c\x83jx\x8c\x98\x1d\xb0\x88\xe42\x1b\x1b\r\x92\x02/\xd0\uf2d7\xb4\xfa`\x7f7͊FR`\x92G\xbf\x17\xaf\xa5\x88H\xcf,\xf9+\xa5фg\xca\xff\xf8\x7f[\xa6`\xaauߦ{H\xff\xf7\x7f\xfb_\xcc\f{:9\x99\x91\x7f\xff\xb7\xff\x05\xf2\xbeq[\xad\xc3\xcc\xfb\xae\xa8\xf9\x8d\v\xff3\xf3\xa9j\x94\x16j\xfd\xbc\x9b\xfeܜ\x91f\xb3iÏ\xdd7\xe4\xe9\xf5\x1a\xea\xff\xfb\xbf\xfd\x9f\xbfi\x97\x8a^\xa4\xa4ٴ/R\xb3\xd4\xff\xe9\xa0\xd4\xf4\xe7\xe6E:#\xa2ŋtvP\x9a\xd0$\xa7Z\x05ɗ\xae\xfd$C\xeb\xa0\xf4\v.\x03\xba\xa0kzMo\x1e\x06\xb0\x1b\xa0\xd2\xd6\x15P\xb6\x06\xa8X\x1f\x87\xb2\xb5\x01eׅd-\xe7\xb8ѡ\x9c\x80\x86\x1ag\xa7צ\x9b\x9b\x02\x1eLص\x01p'?\b\x98HPYS\xd2t)\x16\x91\xd8o\xc5n\xf2\xa1\xae\xd0\xea\xf1ľ\x99\xaed\xfe)K\xc9x\xd5l\x92\xb1\x1eê\xe1J\xe1\x91\t\xce\xea\x18\x8c\xb9\a}\x8c}\x90xۿ\x198ק1\xfbM و\b\xf1v\xc8N~\xb5#\x1a\x8b3\xd0t\t\xfa\bƏ\x86KA\x89\x11\xe6\x14O\x9d\x19\xb5\xb7\xa2,Z\x05\x85(\xf5ݰ\x93-]\xb2\x93\x10\x97\xaeb\"\xf6\x9c\xc1LhaB)\x11\x13\n\x96\xf6\xbc\x05\x91\xd4C\x90\xd9,\xea\xf5\xbc\fc,%\xf7\xb2\xf2bb_O\xe7bW\xf2gП*\x12\xe9\\*\xcd(\x10\x11\x906m\xcfLP\xfc\x93\xad\x7f\xab\x89\x1fV\xe9\xcc\xc0\x0f\xf7\xdfد\xf3\xe5\xfd\xba3\x10Q\xea\x9d\x1eC\x85 \xda\xf2\x1a\xdfo&7\n\xefC\xef^F7q꩖\xc4Bz\v\x89\xf5\xd3=\xf1\x96\xf5:\xc8}\x1e\x1e\x81\xf5\xef\xff\xf6\x7f\x05?\xc1\xa8\xb5\xf4\xf8\xe2\xfb\xd9,g$\xfd\xa4ؗr2(\xc3R\x82G\x11\\\xf5\xd98\xb7\xdbw\xc6\xfc\xf4\x1b\r\xad\x8aɇe\xc5\x04\xbf\x99\xf2\x19\x80\x1c\xfeuM\xce\xd3\x1f\x0e-\xf7\f\\\x81}\x94\x15\xec\x8ajt%\x1d;C\xfb\xae\xa8`w\x05\xdeeS\xad\tǋ\x9f\xd9\xfbU\xb0\xcc\xf4\xe7U\xf8\x11NX\x94\x19Zq\xdc\xfc\r\nz\xf2S>\xcd]=8\x1ee`\x19ά$\x8e3\xa9A\xb7X\xc8\xf6\x9an\x85\"_\xc2\xd3m\x88\xeav\xfaA\x9c\xb2\x90͑\xa8\xb2\x03\xad\n\xa81Bx\xba\x1d\x87\xf0\xce\xc3\x00]\x81)&\x0f\xf1\r\n\x19(p\xf7\xc9\xfd\xbc\xd1\xd0P\xb7\x87\xe7\xef\x1e\x05\xec~\xbd\x0e\xae\x88\xb6Mw\xb7\xb3u\n\x88\xa8\xf3\xe5\x12\xbb\x97\x12s\xcdDZH̅\x13is\"\xc6\x0e\xe3NY\b\xac\u0083\xb6\x02M\xc8\x1d4Y\xca2[vHq\xe9\xcdf\x15\x86\xcaa\xea\x9fJ\xdaQc\xedƀ\xcbJ\xd3l\xd6\xe0\xb2\a\xc0\xedg\x82\x14\xe1*\x93\xecv\xddc\xaaE\xf0t#\xf5z\xb7'\x82Zw;\xf0w\xb7\x8bNc\xa9\x8ftX\x035\x17\f\xdf&\x7f,\f\xf0بhaHpUF\r\xf7\x8c\xc5E%\x94c#<\xed\x0ew\xbb\xe4\xac7(\xaa\x85\x8c\x85zOTn\xe7\xe4xKg\xac;\xac\xd7\x13\xf0\x04@ȽV\x88\xda\xed\xba}\xf8+5\xa2Tc{\xad\xf4\xf8\xf9\x05\xf9ɔa$\U0005c9d5\x91\x8ci$\xf0\x00\xf2:lC\x05\"\xd12\x1exR\x912\x17$\x9af3\x10\xc9\xd3?\x18\xfd\x04\xe9\xf9z\x93\xddU\x89\x13\f\xa8\x9a\xf2YÀ\xc2)\x9f\x9d1\x03`\xc1ڼ\xd0*h}b\xbb/\x83\xa8$q*\xe9@H\x10\x1e\xf3\xd3\f\xe8\x82\a\xfb<-vI\x10\xb7j\x95\xf4\xc3\x11\xbc\xdf\xf8\xf3\x87{\xcf\xf5\x89\xc5\x00\x10G\xe8\x1cc\x8b\xf8g;\x037\b\xe9\xc3\x12\xa0BoI\xbd\xae\x13\v\x1d\xc1\b\x1e\xd5\xd9w\xfe\xfcc\x95\xe7J~\x9a+%r\x01\xe5\xfc,\x11:%'\xd5\xd3k6\xb5W\xdb\x1ao\xb8Gz\xbe\xe6Yy?\x8f\xe8\x951\f\xea\xc7\xcft\fx˂\x84m\x03\x8e\x8aJ\x931\xcd\xd5vog\rt\x89\xb7\x0e\"\xdb\xdc\xf7\xed\x8c&\x84\x06,\x9a\x98ۿ\x9d5\\\xaf\x98@\xf5̔\xc6a@\xa4VY\xee6'kr\x11@g{\x9a\x8d\xb7\x8d\x06\x85 :vȊ=\x923p\xc2\x16\xb2\x84\xd0\x10\xf4Y\xe0\xa9O\xe8\xc1xC\xd0\xe7j\xb8\xa7\x10\x95ೣ\x83\xe0<\xacj\x88ڕ\xaeb\x86\bI\xd7\xef\x81\a<\xb5\xfc$\v\xe6!\xb7\xa8\xe5\xa7\xc1\x02\xfe^m\xb3,\x8e\xe0\x87vE\x00\x1f\xf1\xe2\u03a2\xd6\u070fn\xfc\x14\x7f %\x00\xbf\xe2P\xfc{\x9d\xc4ۍE\xad\xc5\x02\xfe\tn\xe0_\xc8Zd\x16\xb5\xf8\xfa\x8aC\xfa2\xe0\xe1\"\xe5\x19\xfe\xbc\xce[\xc1\xd8\xc8\xd0\x110\xc4x\x82?\x92\xb5E\xad\x95\v\xff\xb4\xe1\x9f\x0e\xfcӅ\x7fz\xf0O\x1f\xfe\xc1\x88\x10\xf0C\xf5\xbe\x82\xaf`\x99\xf8\x10\xdb\xd4\n\x03\x8bZk\x1f2\xa4\xab\x18j\xe1x\xe3m\xb6\xd9\xc2\x17dm\xb0\xe7M\x12_'<\x85\xd9\t\xef\x92\xf0C\x18\xe0\xc0/0\x88\xb4\xa8\x95\t\x97\x8bV&W$\x83Yi\xaf<\xd4ʖH\x87X\xd9\n\xfeI\xf0\x17\xf7\xa1\xd0\x16\xfa\xbd\t\x16<\xb6f\xd5\xe2\xfd߃D\x16Cr\xe0\x16\xfd\v{\xfa\xf3)\xfa:y\xd6\xfc\x17p^\xe2\xf6\xf6dz\x91^<=\x9b=\xa5\x7f\x81܋\xa7\x15\xf9g\xa6\x94\U0009f2d1\xb4̻O\xd3]x+\xe6w_ΧK\xe6\x8aW\x97Ш\x99\x18\x94\xe4\x9fK\xb7\xfe\xd1kկj:>\x83\xf8\x04n\xbb\x0f\xb7~txe\xc5\xe2\xd6G\x9d\xceh\xb7\x8b\xb5ƴF\xbbv\f\xef\xa8\xe4\x8c\xf9p\xb9%(%\xe5\x1cĤ\xe8\xc1\x81Vj\xbf\xd0X<\x81\xf5\xe8\x9a\xc6\"\x9cv\x8b\xfa\xba1\x8b@\xddv\x1c\x9d\n\xe4\xa6/3x\xfdE\xd2PA\x06\xc5V-F\x85\x16\xcfX\x97\xc8\xfb:fF\xd0O.\bܨ\x9a\x85,Ɵ\xcbk\x15Z\xc4(\x10\xdd¶A(?<\xa5\xa0\xac#%\xb6\x99\xd0\x0f抏ƥJ\x01\xe8\x04\v\xb1с\xfc\xf0\x18\x96E\xe2\xf2ؾn\xca\xe4Ҽ\xd19\xdb\x14\x14\xd8\xe5\xfeV\xd8{\xccawG\x90\x1b\x17j\x84lNm\x9f\xd9sf\\D\xf6\x9cƄ4Cr\xda)\x14\xb6\x03ٰ@vs\xbaɭ\x0e4O\xea\xaf\x169cN\xa1^\xa4\xbe\x1c\x89\xbe\xb3\xf1\x89\xddh\xa4g,A5v{\xce\xc2|ک1\xedtFNmc\xe2)\x04H1rO\x8d\xcd!\x12h\x0ef.\xacZ\f\xa0I\xcb@\xb3\xdbU/\xc0\xa9o\xe4\xc8c0'\xe4tC\xc8\xfd67\x8e\xd1ן\xb9]\x12\xe6҆\xbd\x9d\xb8\x9esL|\x01\xb0aQ\xe1\x99\xc5\v*\xa1\xb0\xe1Ҕ\xfa\b{\x8f\x019:\xcdE=\xe5K\x05m#g\x00\x94f\xf2g\x01\xd3\xe4k\x1d\x85\xcf\xeb2|\xaeϮK\xe6\x15U\xc4\xf2\x1a0\x8fYLN\x06\xf4:\x14\xafa\xed\x7fz\xcd\xd3,\x88\xae\x1f\x82+$\xf6\x0e\xdb'\xf5\xfa\xba\xd1@۬\xfc \xf3\xc2\x1b8eScV3j|\xb05\xf5\x99\xbdf\xeb\xd3\xebI\x01\x0e\xd6\xc4[\x933vM\x0365\x96aF\x8d\x0f\xb6n\x1aM\xe1\t\x96\xc6\x12e\xf2\xde\xd8\x0e g\x00\r\xc6@R\x9eض\xb1ⱱ\xe21>\xeb\x8cE\x8fg\xe0\x9cSp\xf7\x1eXj|SK$\t\xab\xb6\x10\xf2i\x87\xae\xd8FQ\xb3\xcb\xd3\xd5x)Z\xdaL\x97\xa0 \x1d\xd3\x04\xcd6\xee\x179\xd8\aK{!\x1b\x92@m\f\x94\xd0@\xa5\xe5\x03\xa6\xc6\ak\xba\x9d\xce@\xb0q\x1e\u07b9\xaaƍ\x8fG\xec\xd0gFb\xecR<\xc3E\xd92n\xb0:(/\xf0=̣Sy\xa8K\xfe\xaa,yl\xe7l\x9aQ\xa7tfQB\xa3\xc1\x02\x1b\v~\xe3x\x12c\xf2\xd9\xe6K\u0099\x1c\x0f\x14F\xbc\xa5\xf3\xa9;c\x02g\xc0^\x8f\x97Z8\x83\xfb\xac\xa7\xbfld3\x06\xa6\x04\xf9\xfa`R0]\x1aTJ~t\xc2\xc7#\x9cU\xf2\x19Dc\"\x16\x1a\xe6p-\x90\x89\x9d6X\x81\xa5\x11\x16PA\xb7}\xe4\xe2K\rvF\\\xaf\x8fz\xc5+P\bz\xdcqz\x1aJà\xe0H\x1b\xa2>>\xf8\x03\xa3kq\xb9\xf8\xb9\x9d\x8fퟢ;A\x88\xa7%\xef\x00aCT\xb1\x93\xb0&\x9fC\xe9\xe8Z\xfe\x91k\x8c\xbf\xbe\f\x9d\x83\x98\x82\xde\xd1+zK\xcf\xe9'zI\xdf\xd3gp\xc4a)6Lpr\xf0B_CjN\x81\xd9\x1b\xf6Oyf\xbe k\xd0a\xfd\",\xbe8\\\xf0\r0\xf6K\xd8\xfd\xa6,(\xa1\xeb\x89}\x9cZZi\x97\n&Q=\xa7\x9b\xe6\xbc\xe9\x92#'\xeb\xc0\x1b\x95E1\xc9[\xc9m\xba:v\x84\x89W}TKι\xacϵ\x03\xb8\x9f\xde\x01J>?~Y\xe06\x93\xf1\x89}bǧ\t\xd9\xedl{)\xae\x02\x03\xfbm`g\x8c\xcba\xe2z\xd7\xcd\r9\xeb\xa2_o\x97\xd0婫~\xfalc\xa2\xc0Ʋzy\x8aN\xc0\xd4\\n\x8f\xce%-\\\xb9p\xa4\x91\x99\x8c\x96\x9c\xf9N\x1d\xe0Z\x9dŮ\vק\x89x|\xcas%\xb2\"z\x16\x87\xa0\x1a\xa5\xe2%\xa6\xaa\xd6\xeb'w\xbb\x9d\xfd\fcB\xdfI\xf4\xd8\xcc \xfaX\xfe\xf4\x90\xa9.)t\x9f\x16\x86\x19\xe8\xc1\x84e\xc4\xfb\x99u<\x8a\xbfc\x96)|}\v\xb8;F\xf2*_\x8b\x18h\xe7\xdd.\x1fg\x8c_\xfaN+\x11\xc6ȹz\x0f`\x05|}\xf0X \xb1\xff\xa7\xd3\xcb\xf1'qӟO?\x15n\xfa\xf7\x85\x9b\xfe}n0\xba&\xf79v\x88Q\rP\x14S\x86\xe4\x127\x18Yx\xceOX\xf5I'\x05*\xbaj\xc5\xd6\x13\xebЅ\x9bU<^G\x97\xf2J\xac\x9f\xc4\xc51}V\xafW\xb8ːOj\x01\xbcm\xa9X\x985\xda\xf8R/\nh\xdbZ#E倶\x82\x92\x03ƕ\x8a\x99fI)\xd9ϓ\xb4\xdbK\x01\xccFI\x95\x984X\x9b\xecmNo>C\xe2\xcfL=\xc5\xc7<9\x8f\xe1\xcfy\xf9\x02\xde6\xbag\xf3\x02\xe2\x1e\xb9U;\xba-R\xf3\xa3ne\xa1\x86[,\xf6\xc8\xebB<\x1f\xb7\x8d\xf68=\x9d\x8fS)\"\xaa&\x1f\xd3\xd2@:G\n\x15\xa0/\x05\x91Q\xa3\x8dnzS\x10G̵\xa1k\xa9f\xa3\x91\x12uϧ\x8dFY%\x12o\xffB\n\xdaڗ\xd2Z\t_V\x14\xc5d,\x1f\x16^\xdc\xdbF\x9b\xa6\xcdvu3\xca*\xbf\xe9~FS\xae\xa4<JQ\x7f\xd0\v+/#\x13\xe7\x14߷\xc1q\xb4]\xb8\x87R\xd2L\v\x0f\xaa\xd4Ĥ\r\xd6-\xa1\xef\x1cx\x8d&Mt&\x8e\x8e\xcai\x94\x9fu\xaa\x9f\xa6\x99A>s\x1f\x18\x03\b\xcc\xe15qx\xf9\xcc\xfc\xc2L\xe2G/\xf41\xe4D\x84-|\xe1<\vjY\xb8\x14\xfd\x02\x92\xb9pz\x0f\xc8\xe7\xf4\x8c\x15\t\xe6N\xef\x18\xc1\fl\xbebqI$Ӹ\xfa\x14\x8c\xd1[s\\\xaf\xa7\xa7a\xbd\ue7f2\xfe\x98\x80-\xf0\xb1\xe2\x9aP>\xeb\xefvX\a\xa9\xeaX\x9d\xa6\x90\x95\x84\"vH;m\x9a\x12j\aUY=\x9a\x12r\xa6L\xb0\x0f\xfa\f\x9a\xe8v;d\x01\xa1\x9f%\xc8\r\x17\xb0\x16\x15\xaeZ=\xff\xf3l\x17\x9c\xc5\x17\x98\x83\x88\xe3\x9cҰd\x06\xa2\x0e\x9f{\xd8e\xc1&\xe4\xe1\xd1Khˇ\xffe/\n\xf1\x948\x02\x81\x9a\xb1\v\x10\x88|\n\xb5\x9d\x81`\xe7\x99\xdc٠H\x87\x943\v\\8\xe9\x83\xdd6pN`\x00u\x80\xec\x0e\x83\xb7\x1d\xcc\x10\xfd\xda\xdd\x1e;\xc2\xd2\x06@\xee\vK^\xf4_a2\xf8b\x1a\x11zb\xebԜ\xc7}\xea\xab\xd2\xd5GK\x82P\xf0E $\x861q\xbd\xf6\xe7a\xe9\x8b\xc1(\xa6zQ\xb2\xd9c\x01\xaa\xe9\xfe\r eN\xe7\x80\x01)\x1d\xd8#\x16S.\xfc\xbe\xfa\xe9\x8f\x14\x8a^|\xc4d\r\xb6\xa5'9\x99\x00}\x140\xdb\xf6\xac\xb3ۥ\x8dv\x19\xe3\xf5\x9d\x93\xcfS\t\x9d\x0e;\xc6Gp\x11\x9e:h\x81|_\xe0>\ued47\x95\x81\xe0\x12\x9c\x1c\x9a\x90v\xda;\xae\xbdܟ\xb1Ѡ^\xcfN\x99\xdbn\xef\x01\xe8\n\xac\x8c\x81\xee\xe2\xc4\xf6\xd9\x01\xc2@\xffI\xf6_\f\xdf5\x9a\xf0~\xa0\xfc\xbf\x94|ݜ\xc0j\xfc~\xea\x1fxϟ\x1da\xb1\xee\x85q\a\x9c\xf8\xe0\x94+9?\xb8&\xd6o\x90\x92\xb3\vuR\x8e@V\x0e\x1b\x05\x18\xaa\xc0|U\x92\x99\x80:\x88\xc6\x1eC\x0fK\t\xe2\x17\xf0E`\xeaY\xa3}\x96\x94\x84%\xf2\xe2\x10\xf0\x18\x16\xb1ہ\xa4D\x82th\xe0\x8fpf>\xca\xc3YIz\xd3=r'\a&\v\xab7,\xb2\xb0PG\n\x85\x8e\r\x97Г\xa7?O\x9bޮ6k|\xf3Thr\xfaō\xb7A\x8f]\xea\xed\xef,BNY\xbbx\xd3o@M,e\xce8=\xddj\xbdnIi\x9f\xd8K\xb6\x9d\xa63%\xf6\xc1D\xf0M\x92\xeev)cl\xabՕ\x88R\xc9\x1ak(\x85\xfaO\x7f\xf6&͆7Q\x83[\x1a\x83\xeb\r\x19cKs\xde˼\xb9\xc9F@\xcfa!\x87L\xac9\x8f2\x9eX\x9e\x95\xc0\x8b\xc9\"^e1ل\x15\xf2%\x94Q\x9f\x16\xd9+\xdf\x02j%+\xe4Z;\xab\xb8\x8e\xb0\x8cZ\xbb\xf8\xe7\x8b\xdd\xeeb\xf7\r\xa81[\xc4X]\xaaX\xea'\xf9\xda<$¨<)\xb9\xafh\xc5rY=\xc0I\xaej@;\x856؍`w\xf7\xe8\x06\x92G֮\x00\x9b##\x92\r\nOǛi:\xfb\xba\x91\x95\xefE\x038\x1fj\xf01W_\xb6:J\xb6?\xb0W\xc9C<\xf9\xa3{\xf3\x85u\xb43n\xb5#\v\x06\xc13\xaaA\x02\xb0V{\x1c\ni\xd21\xe4U\xaf7\xdd\x13\r\xffa5\xfc\v\x9dL!\x1fy\f\xf0\x7f\x06\x96\xbe\x1ez\x16\x15\xd0s\xd0\xda\\`\xaa<\xf6\xa6\xdb\xee2&\x12\x8bx\xc1\xf5\x1cZ\x9d\x89\xdf9\x0e*|zƗ\xa6\xba\x1e\x05\xa4\xf3\xaf\x00\xc6\xe302\xfeR@|\x88\xd3f\x19\xde\xdb\x1f\x0f\x8f\xb9\x87\xfa\xaa:+`\xc0-\xe0\x9fPQχr#\xbc\xa8\x17|\xf9\x95\"\x8c\x02.\xb5\xb9VH>\x85\xb8ABu\x06\x8c*@kB;\xce\x14D˜\x80G\xbcy\xe9\xaa\xd7\xe7d\xfe\xc0%\x1f3hy\x8elί`fm\x0f$\x1a\x95\xab\xbb\b\vG=<\x8a\xfb\x03\x96Q\x9f͕=\x85\xe4\xfa\xde\x00\xff\xf0\x1aX\xbfխ\x17\x05\x13Ӏ\x06_\x8d|\r\x02-\xa0\xe2\x81d\xa8θ\x9f{\x9a`\u05cf8\v\x8b\xe3\xcc^\xa9\xfe[]\xadx\x05\xa6l:\xaf^ǵ\x16T,Y\x0eI(\xbdϧ\xb3\xc8\xdf%\xfe\f\xcd?\v\xcc0]\xd0h\xc1\xac\xd1hS\xe3\x8bŹ\xa0ß\x1d\x17m\xe8\xf3Q\xc5\xcd\xf2\x0f\xa4\x1b\u05fb\x1dl\xbfKPx\x80\xaf>\xff\xb8t#\x1f\xcdB\x8f`]\x1c\xc0\xaa0\xb3\r5\xe6vDd\xb4x\xe0n\x03\xe3\x116\x97#\xa3\xf33\xa5k]\xe3\x0f\x9d@\xa3H\xe1\x00b\xfa\xd8gs \xe6\xf2Ơ\\\xc0\xe6\xd4|\xa5\xe49\xc78\x15\xba\x00\x98\x1f4\\Rh.o\nX\x05\xc0\xe9\xf2K\xf9z)\x8f4*\xc6\xed\x1b\xa2\x93\x87P\xf2\"<\xbe\x86!\xae\xa1B\xabsz\xf3?\xb3pcK\xaa\xaf\x85\xfc\x93\x1e\x99\x9c\xba\x18\xc4\xf3ti\x87\xa7\xa8\xbd(\x1f\xa9\xa4\xf0J\r\t\x11b\x84\xa3\xf2ռ?\"\xe9&\xb36RAE-İ\xcc\xca\x12\xa87f'\xca\xf0M\xad/X\xb9\x8d}\xd1\x00\x9cu \xb6\x94\xc0-.\b\xdc\xe2\"dD\xcc\xc0\xae\x19\r\x8f\xe1V}\xc1\xe6\x9e<\xaa\xd1\xe1qO\a\x7f\x17\x06U\xf4w\xe1h~\xce7B\x81\xa7Y0\xf1ʸ\xe90\xbdڦ\x8b\U000c733a8\x97V]\xe2\x87;\xa3\xf7~\x98y2\xbd\x8d\x8e\xb2\x88\n\x8f\xbd'\xfb\x8c\x1b\xaa\xf5\n/\x1f\xf7tI\xe3c\x86\x1eh\xd2()\x02\xb8\xd3S\x88\xcf\x1d\x00\x1c*\x85\x12\xc5\x06\xcfM2\xec\x80Pɂ%\x05:?\xa8\x14\x05\v?\r~\xbd~\x12O#\x00\xc2\x00\x80\xd0%\xe8\xb8A\xe20q<SZq[\xa0\a\n\xb8k\x90\xcf\x01\x89\x04\x86W\xc0\xb1\"\xee܉C\x1b\r\x1c\xbb\x90\xcb#\xb6\xc8o\x94R\x1d\x81!\xd5$\xf7{\xa18\x9fp\xf6tz\x11]d\xe0\x1c*\xe2\xec\xe9E\x02\x9f[\xc7\x19\xf6f\xbb\xe9Ŷ\xddmw/\xb6m\xa7=\x94\x89O\xafi\f嶎\xe3;f\x04Q\x9f\x03G.\xbd\r\xa4\x1b깟\xf2\x9a\xebx\xf8wԖ\x7f\xfb\xe2oW}\xf7\xe4߮\xfc\xebʿ\x1d\xf1\xb7\xa3\xfe\x0e\xc5߾l\xaf/\xeb\xbb\xed\x8e\xfa![\xea\xc8\x1e:\x03Y\xb2\xab\n\xa8\xaee\x8d\xbe\xec\xaa7\xf44\x9f`\xc1\x97\xfe6\xcc<\xcdW)B\x1e\xe2\xb4\xe3\x16\x1d\x88\x8d\x1c\xe1\xf8I\x92\xa2\xd3\xd9\xd8\xe6\xcc\xe6,\xf7\x0e\x16s\xf4Q\x95\xdbOG\x9cB@\x02\xf3Ax\x91\xa1\xa6o\xbd^\xa8\x99p\xc3\x02݈\xd0/ј+\xdcv\x1bO\xac\x84\x80\xf7\xf8\xa4\x01\xfe]\xc1\xa8߳#f\xd5j\xb5\x9a%ϖ\x9d4\xfdf@~\xd7\x05\n7i\x82\x13\xee\b\xfcX\x80x\x12N5\x9aX\xa2\vT\x9c\xa8a\x1c\ttQLcA_\xc4\xfa6\xc8CY\a\x18: \x05l\x90\xf2\xd3v\xaf?N\x01\x13\x04\xb9\xef6\r9!7\x1dj\b\x9b1\x8c\x1e\xbb\xdbA\xd8\xd8\x1e~\x8d\x1c\xfc\x1a\xc9خ\xedv\xae\xbe\xbf\xe5\xd5>\xcdO@\xfb\x14]\xf6h\xa7\xd7\xdbJ\xa7\xd7\xf2\xd2>s&\x87\xb9M\x97x\xe0\x9d@H\xa1J\xb9>(@oQ$\xa7v\xc1?\xc3\xf0\xfe\x01҆v\xcc\xfc&<\x8f\xba\x93\x80\x01\xae\xf1l\xe1w<b\xfei8\xa9h\xcfC\x14\x806\x95 \xaf\x13\xcd`\x9dD&' \x14\xc7\xe4\x11\x88g\xb6 \x7f\xe36\x8a\x87D\xe9\x90\v\xaf\x03X\x88\x10z?\xf7#\xbc\x88@\aڏ\x04\xba\xf7R\xba\xe0a\xb0N\xbdx\xbf\xb7..N.\xccx\xc1:\\\xb0\x8e\x16l\x19\xfeH*\xcd=\x02>-\xb9Ğ1W\x19\x7f̹\bK\\{l\\b\xa8\xb4\xf9\x9aJK\xce\xc0j\x02\x8dd\x161\x18\xcc\xfc\xe2\xdf\xf8\xda\x04\xc6\xf7}\xf1/\x18\xc6\xf8W1Z\xcd\xf8s,.\xff\r\xc0\xbce\x9e\xe0\x9f\x85\x9f\xf9\xf8\a\xed\x7f\x02\xb4\xb8YD)\x9a\xf8\xa0\xe1\xcc2\x83:\xd7<\x86\x7f\xc5?\x9b\x95\xb0\xe1\xe9\xb4\xd1\xc4'\xcb6\xf2\x0fT\v\xfcO\xf0\xaf\xe8*X\xe3?\xe2w\xb4\x84\xea\xc1\x06?\x92 \x95\x7fZW\x9c\xab\xa4֧\xcd\xdc\xf8\xa9\x8b\x84\xb7\xbf\x81\xaa\xe2\x02\x1bZ\xfbA\x98ASk\x9c\xc1:M6\xf2\x0f\x94_g\xbf\xe2\xe7v\xb3\xf0Q\xc11ⷐ\x1e-\xf1\xdf\x00\xff\x01>\x7f\x14\xe1\xb8\xe3\x8d\xff\xeb\x96\x03\xcf\x1fO=\x18\x16\xc5\xd2\xc8\b*$Y\xbaAâ\xe4&\x98s\xfc\x95\xa6\xd2\xc4H\xce<\r\xf8\r\xe6\x04\xe2\v\x87\x91\xae\xf1\xdfh\x8di\xb1\xbfQ\xd3ԿS\xb4N\xba\x86\x7fy(\xfe\x8d\xd0\xd0*\x13K\x0e\x98h\xcd\xd3Կ\x86Ƴ\xa8\xd3\x1e8\xf0\x03{\xc9`\xbb\xc0\x92\x8eZ7|\xbd\x86Y\xe1,o\xd1\x1e\xea\xd3<\x8e\xe4\x9f\xe66\xe5b\xa7?\xad\xc3d3W\xc30\xbe\xb0\xc6\x1a\xb7\xe5\xb7Ψ\xd5s\x12\xfd\v\xb2\xfcE\xb0]\x7f\xca\x12\x04\xab\xe5\x06\xff\xc5t\xdc\\_\x00]\x96\xf9\xf3՚G\xf8q\vR\x14\x9e\xae|\xb4Ӻ\n\xb2y\x1c\xa0\x85Z\x1c\xc6\b\x85\xa1ؿ\xf9*\x89\xd7\\\xffh\xf2O\x19\x8fRe\xa6\xb6n\xf2\x1b\x1eeWI\x90\xf1\xa6\x9fe<Zp,,hD\xf8u\x93\xa2\xd1Z\xe477\xa1\x7f'|\x02\x98)P\xd4\x0f\"\x84\xd6E\x06\xcd.n\xae,j\xf1E\xfb#\x80\xb6?\xe7Y\x80#Xri\xee\x16]c\xe9e\x90\x02\x8c\\\xc3\xf6\\\a\x19\xfe\xfb\xdb:\xde$\xb1\xb4J\xbb\xce\xfc\x10\xdaX\xcd\x11vł\a\x1b\xfc7\x110<\xef\x8b?\b\xc5\x19\xc2\xc3/>4\xfe\v\xfe\xfe\xc8\xef6>\x16\r\xfd4[\xae%\x80#\f\xfb\xd7\x02\x14\xd6\xea;\xf9(\xbe5<\xac\xb1\x8du\xda\\\xf1P\x80\x7f\x84\xfb\xb1ޮ\x85\xc1\xdb\xfa\x06\xc6\"\x82]Q+F\x10\xd8\xf8\xe1\x1a\xff\x00e\xfc\xdbo\x005\x9b\xd0Ϥ\xe9\xde&\x89?\x81\x89\xdc&\xbd\x83Q\xfd\xba\xe5\t|\xcas\xc0\xd3x\x9b\xe0\tH\x10ܒ\xf4.\x82bI&@\x9c\xcf\xe3h\x11\x06K<\t\x02\x82\xd3k<&\x1f\xef6\x98\xb8\x86\xa5O\xe3p\xe1\xc3T\xd2M\x9c\x05K\xe8 ťN3\xee\xc3(R\x1c7|\xa4\x1b\xee\x7f\x14\a!\xba\x861l\x17\xd0\xea6J\xb8\x0f\af\x9b\xb5\x1d\xa7\x8b' ʒ\x00a\xeb&\xe0\xb7M=\xd2[~5Ǣ\xb7\x99\x8fG\xe4n\x1e\xdc\xde\xc1\x9e~Z\x06\b\x9c\x9f\x12H\xbf[\xa7\u05c95\xa3+n\x9a\xf09\xcdQ\xeb\x04\xb1\U000b734b\xa7l\xa21q\xe3\x1f\xf3\x123{\xe2\xe5_\xcdٽC\xfb\xee\xde\xc8'\x13{\xe2]\xb4\xbe\xa8\x06\xf9\x96\x9c=\xa5\x8b\xc2hZ\x17M\xb0\x19l\xf7\xf6ĳ\xa7?\x9f\x9e]|r\x9c\xe6ŧ\xb63\x83\xd29\xad\xb1\xe6\xc5\xe0u@\x0f\xe0\x82Ќe\xbb\x9d\x95?\x83k\x89\x8d\x9e%\xf2@<1\x8be\xe1\xdd.\xa6&e\x06\x05iB<\xc3\a\x17\xf4\xb2\x17\xf1\xcb9[\x83\x97\x9c\x89\xb7\x8dPr\xba؉\xc3x)\xbf\x16\xf1\xf6J\x7f\x91\xa7ĶTA\x8b>\x9d\xfel=a\xa7g\x7f\xcd'Ԁ\x12\x85\x16,\xfa\xf4\xc9\xf4\xe7'\xb3o\x9f@V\xa19\x8b>\xb5\xa6?[\xb3o\xad\xa7\xc4&\xf4F\x8f\xe5\"m\xf8Y\x96\\F\xfe\x9a\xe3\xe7\xb7\xec\"\xfd\x16\x93n\xfcp\xcb\xc9\x04G\xa2\xcb\xc0P\xc4Z_z\xb3|?\xbc\xd6es\xf6\xad.\x89U-z͡\xb3;\xd1\xd9\xe9\xf4Y\xf3_\xfc\xe6o3\xf9\x17v\x11;\n\xae\xb6\x19\xff\xf6\"\xfd\xf6\xe2\xe9\xe4L5\x81\x89\x16\xbd\xc1\x16\xaeD\v?\xdb\x13\x0f\b\x98\xcb̿\xde!\xf9\"~\xc5k@\xaa;i\xff\x1fD\u05fb\x05\x9f\x87~\x82.\xbbvs\xb8\xbdq\x12\xaa\xaeE\xef8\xb1-݂E\x9f\x9e^<\xad\x1a\xdeE\xfa-\x8eHv\x01\x05O\x9a\xcdf\xf3l\a\x7f\xed\x89לL\x7f>kΈ\xfcٜ\x91o\x9bM\xac\x92\x8f\x06jM'\xb3ַ\x93\xe9d\x86y\xc6\xf0\xb0I\xe8rָH\x1bӟ\xcff\xb2GAs<==\xb9\x98>\xff\xfeهg\x17S\xb0\x92}?\xfbvr1\xbb\xc0f\x04Mu\v\a\xa0\xfe\x0f\xb6=\xf1>M\xfd\xe6\x12\xc6}\xef\xd2\xe1~7\xd5?\t\x19?\r\xe89\x96ģ\x82;'\xb3;\xee\x1e\xb2\xb1\xb1Ohm*\xbc\x87\x1d\xb3\xcbG\"z\x9c\x9c*b\xba^?\xf1\xf9\xa1Q`\x02\ue112\x9c NP5d\x13\x83G\xce\f\xf51\xa5\xa3\xe8FA\x9f\x02\x8b\xd0\x04\xcd\a6qʐq\x83\x9a.\x11\xbf-\xbb,-s\xdf``\x18\x8d\xa8J\r\xa5\xa4\x03\x920=\x02-oBf\x9d|\"\x9cd\xc0@J\xf0\xf9%\xb5\xdeT\xf1\xe2,E)\xf7\xc1R\xa0\x82\xad\x97\xd0gI\xb3]\xf0s&}\\T\xd4\xf4\t\xb9\xd7\x19F\x91\\\xe4\xe6P\x88\t\x93\xc7\x103\xf5?T|R\xab\xcc\xcb\x11\xea,\x95\rK\xd7o\x82am\xb6\xa6C\x95\x1e\xb46V\xad=\xae4\xf2\xed\x1a\x8dq|\x1a\x1dS(\x8cɘĦ\x9a\v\x80B\xac\xac}y:\xf77\xc7\x00A\xec\xa0\x11\xe1\b5\x93\x8f8\x11)@D\xa3\x11\x9d\xc6GC\x17E\x84\xc0\xf3\xb5^\a\xe0\n88\xbb\x95\xa3\xab\x82\xe6i4\x93\x10\xdc`m*\x8c}\xf0a.\xf9S\xd9nW\\\xaf\xe3{E\xc1.[\xbaBa\x95\xb3\x18\xa3\xe9va\xad\"X\xab}\xf5\x00\xad\x8b\vK\r\xae\xa1\xd6\x14\"Kf\xc1\xfcc\xfa\x19\x06p~\xca\xd0\xd4\xf9Ae/\xf1\x9eN)t\x93\x9f\xacq\n\xfb>\xeaW늏I\xdahH[u\x13/$\x14]\xac\x06,\x1d+1\xba\xc86\xac\xa2i@\x14\xf7\rB!\x01G\xefhOJ\x91\nd\x17M\x1f\xe3\n\x15tGj\a;T\xb6a7u\xc0|\xc3\r\u07b4v\x11\xcd\x1a%\x17\xe4Ңݭ\xe0\xf4\xc2v\x05\xb0\rջ\x15k8R#T[\xb6\xe0\xe1\xa36\v\xb0Yh`\xc7v\xe5ƅ\xc5\xc3P\x94Æ\x8d\xee\x19K+\f\xe1\x0f\x9b\xf9J\xb3\x03T\xb1\n\xabX/\xa1`\xbdР\xaa\xb36\xa1n\xbb\xcf\n\x81\xc9\xc4\xe0XшP\x9a0\x042&UP\x84҈\x81\xd0&\xc2\xd0Q\xed\xfeC\xa7\f=\x1f\x85\x8dN!\x84Y\x83E͐>p\xab\x854\x12\x01\xcc\xf2ؒa\xa3Mc\x15L\xb2ឦ2\x92^u\xf7X\x8a\xd4\xeb\x0fe7\x90k\xec\x1f\xc9\x05\x84.\xf6̖a\xfe\x1a\xed\xd3tr\xa4\xa9\xb6\xe2@a\r\x1f\x03}\x89\x1f\xc2\xcf\xd7$n6=L\ndRP\xafǠ\x91~\n\xb6\x8a\xe4>yd`\xcbdb+ \xd5\xf1-\xd5\xf2\xc8\x055\xe5t<<\xaa\xdaR!\xb0%\xf4\xb0\xfaq;\x01ѱ\x1a\r\x8a\x8f\xe5\xc0R B<\xb5q\xc0ŗ>\xd4ӯ9\x80\xe8\xef\xebo>\x7f\xdd\xce\x7f\x8d\xe3\xd7\xed\x1c\x9c\xben\xa7x\xc2\x1e\x7f\xf8\xba\x9dϝ\xbd\x13\x06\xa5\xff\xa3N_u\xff\xea\xf0u;\x7f\xc3\xd9\xebv\xbe\xf0\xe8u;\xff\x95N\x9e\x8a\xff\xff\x95'\x0f\xaa\xff\x1dO\x1e\xf0z\xbe\xe6\xe8\xf5ݿ\xc7\xd1\xeb\xbb\xff5\x8e^\xdf=8z}\xb7x\xbe\x1e\x7f\xf4\xb0\xe6\xff\x86G\xaf\xba\x7fu\xf4\xfa\xee\xdfp\xf4\xfa\xee\x17\x1e\xbd\xbe\xfb_\xe9\xe8\x01\xb0\xff-g\x0f\xeb\xff\x1d\x0f\x1f_oV~\x1a\xa4\x9fU\x15\x11v\xe7\xe2\x10\xce\xe5D7U\x8e\x93\xf09\x01\xba\xf7\x9bz\x1d\xddLl\x1e8\x90\t\xb3C\x86\xb2\xbe9!-!\xbd\xa2'aK\x89\xb7J\x00\x9a<\x04\x9es1Y\x01\xa2_f#+Ve\xdeH\xc0\xb5M2\x93A\xc1\xb7\xd5\x1e\x92D/8\xb1J\xc0\xd0^\x1f\"=9905A1?\xdcE\xf1\xc2\xf1\x99\f\x19A\x03\x16\x8d!\x84\xb0\x8c\xc5\x1c\x9c\xfa\xe4^\x85th\x06$\xd7w\t\x9a\xcc\xc7\xc8\xd6JUFk\x03\xe0J\xf9T\xb7\xb9W6\a\xaa\xe0}\x82\xae\xa2M\xf0\xc6ӟ\xbb\x85\xcd7@;\xa3\x8f\xf4\xd3E\xbb\t\x8b\x8f@\xfe\xbc!\xf6IJ8\xa5G\xd4\x12K!Kbm\xf5ux\x12\xdc\a\xaa\xe6\xfe\x14\x1ew\x80>\xd3\xd6Q]\xb1G\f\xff\xd1\a1\xc9\x0f\xe2\xd6<\x88s}\x10\xd3\xed\xd5g\xb8a*\xc0\xf3\xc3O?\xff\xa1\xfb\xcf\a3\xaf\xf8k-\xc9Ő\xe1-.\xceG\xfc\x88W\xce\x17\xe0Q\xd0\rt\x15cqbG\x85\xc3\xed7\\}\x8a\x84\x85\x96pQ\x0f\xe1\xd7싋\x8b\v\xf2\xedE\xfa\x94L\xd4 E,\x9eJ\xf0\x84\xa6\x0e\x18\x02\xb8\xfc%5s\xa5\x9a\xa5\xd9\x01s\x0e\xde\xef-\xb2?\xd8_7\xdf\xdf\xd8\xdc_\xdf\xd8\xdf͗\xed\xef\xa8\xfb_c{G\xdd\xff\x0e\xbb\xbb\xf9\xfc\xeen\xfe\x86݅\x90\u05cf\xb9C\xe9\x1ct\x1c7r\xc4\xcb|\xe3W2\xa9»ϊhK\xcb\x05\xba\xdc?q\xaa\x8a5\x1a+B(:\x99X|%\xe9\x9a`\xe8\x01;b\xcfmNW\az\xfavʢ\x86KN\x97\x10?\xfe\x88w\b\x11\xff\xa0\xd1\x18\xa7PLF\x14\xdf\x1e1\x9bG*vK\xd0rEZ\xdd/\x8b0\xb9b)}as\x9a\xa2\xd6S!\xb0\xbcz\x15\x10\xcfg\x96EW,\xfd\xba>\xa1\x0e؛\xa5\x10\xc4\x1cz\"*,WEg_ޅ\xb05e\x96\xa5&\xb8\xdbu\xdd\a\x99\xad\x92뻡'.4\xa1\xadeŀТ\xf8\xcc).\xd3\xd7\xcf|\xe4Vod\xbd\x0ek\x0fА\"4\xa4\xe8\xb8jR\xe4㮀\x1bL\xbc\x94\x81\xb5\x0f\x8dA}^\x05_\x8f\xebu\t.\xb4\xcc\xfb\xc5G\x04z-(\x06\xfa\x9b~\xb0c2\xabZ\x05\x9f\x85\xadU\u00974`a\v\xc3\xf9\x94\xf8\xab([\xd2g4\xa2\xf3I\xe9źF\x1d\x824\x99{\xbe\x8c\a\x14`\xdc\xe0\n\x17\xb2e\xae\xaeW\xa2\xe0a\x13\x94\xb5z\u0097F\x83U\xf4\x88\xde2tyRI\xde\xeb\"\xcd&\xad\xe8\xea(\x8d\xa1\xd0T\x9a\xcf|\xa9}\xa8*\x9f\x1a\x87\xa1\x01\x0f0S\x8e\x84\x14\x90+\xbb \xb4\n\x0f\x00\x16Fݣ`r\x04\x82\xc4K\xee\xe43\xd8\a\xe2Q\xb0\xb4\xd1\x06\xdf\x02\x02\xed@E\x82fHR\xb2\xf85nb\xc2 \xad\xa8\x8c\xc9\x10\xc2\x0eA\xf20+7+:\x00(~\xd4c\t\x86d\x8b+D\x01\xe6\xce\xfb\x15\xa6K\x95\xb0p8(p\xb8(\x02\x82\xa4\xb9\xcf4\x19\x95\xdb'\xfb\"\xfc(\x90\x88\xcc++PN\x06\x8a\x90\x81c\xff\"\xb0@o\x02\x9d\xb3\"G\xe2\xe4`\x87N\xaa\xbc\xed<\xc2\x13R\xfa\x18OH\xe9\xdf\xe0\t)\x02H\x1bG\xa7\x01\xaam\x1f\xf7\x84T\x12%\xba\xce#\n\x8d\x8e0;KV\tp\xcfHwI\x91<ZQ\xa3A\x93\xa2\x1b\x02\xb04\x01\x8c*\xb0).\xc3\x11\xffEɬ\xfa\x9c|\xf6\x04<\xd0\xe2\xa93\xb1?w@\x8e\xc0\xa9p\x8d\x94P\x11\xdf\xd0\xd9?\xd4\r\x8b\x89\x17?43\xea\x1f\xe4ʎD\xfcD\xfa`n\xa3\xf1\xf9C+\xe2(V8|Qr\xd7\xf2\x19\xc2\x13\xe4o\xb3\x18\xce\xdcㄫr\xe7\xfb\xceQ\x04j\xc4ڐ\xfbo\xaa\x8d\x9fY\x12\x1bb,(A\x1c/8!\x93\x13{\xc9u\xb1\xe8\xc0/\x85\xac\x15\xb0_l\x9fESg&\x1bw)\xfas<9\xd1\x18\bc\xae\xfb\x19\x7f\x19D\x1f\xd1D\xcb>d[\x95/\xbd\xa0J\xf6y4f\x9d_6n9(\x7fxӕw\xa3!&\xa1\xa5\xa6\x84x''v\xac\xd7dŉ\x9a\xafԗ\xf5\xac\x06\x06-,M\xfd\xf8܃\xff\xbas\x8fKs7\x1cƠ\xce\xd3QH,\xde\xe8%?0\xd2q\x8fR\x929\xe2\xf2\xc5'\xbb\x9d|Y\"\xb4v:GB\xe1\x80\xee\n0\\;2\x14\xce@\xfc\xfd2\xef.h\x90c\x9f\x9c\x94_\x88\xeaax%\xf6\xf9P\xe7B-E\x95Pߧ~\xc3\x00\x1fR-\xb9?\x041\xb9\xcc<ʂ\xec\xee\xd8*\xcb56nL|\xaf\r\x1f\xf1|\xf7\x1b\xeei\x80JC=Ǝ,\xa8\xe0$V\xae\xc5m\x1e\xb5\x04\xc06a\xd6'\xb0\x18\x02d0uJ\xf8`\x82\x10\xff\"\xca\x04\xae\x90'\x82P\xb7O\xbcB\x16u\x85\x8b7\xc5b\x9d\x83\xc9\xc9\xc6N\x88\xb7\xb1\xfb\xbd^\xa7s\xecDj\xb7:GF{ΉX\xb4\x94\xc5\xd8\x13\xbeB\xe0\a܊G\x14p\xd2c\x9dU\x17\xb7\xea%m\x18\xc3\xc4\xed\xf2\x11&n\x9f\x1e4q\xfb\xa4L\xdc>I\x1372\xc6\"&\x16a\xefyn\xc5\xf2\x9e\xe70ϥ\xfaHq[t\xa0$\x11w{mg&\xf2\xf7,\xb2\xdba\xdeԺ\xb9\xd2\x16\x0f\x05\xf3\ai\xb5\x80*\x863]5S\xb6\x1d\x9eE N\xdd\xfe\x92\x97b\xe8 \x87\xa6:\xbe\xd2QK\xbb\x98)3I\x93S\x85\xb8`\xee\xcfW\xfc\xf7\x1cA\xfc\xcc!\x92\x86\x16\xbcp\x19\xaei\x9c\x9d\xc6*\x9e\xa2\bƄ\x87L\xee$\x12;\xb2\x9d\xf7Ў\xe4\b\x8d\xd5vV\xe4\xed\xf7\xf4\xf23f\x84F̣/\x9c\x1a\x1cd\xfcq\xeaKE\xa4\x849\xe3\xe4\x14\x83#d,\x9a&8\x03\x97\x10\xb4\xff\x15\xec8\xf1P\xdf\xc4\xe9\x19\xf3M7\xaf\a:erV\xb3\xbd\xce\x01[$\x00\xb3\xb7\xe2\xd3&\xa5\xc9=h\xa9\x86\xd0\xfc\xb1h\xda5.\x99v\xc9\xc0:\xcf8\xbbWFq\xf7\xf2:\xf0\xee\x01\x7f\x82N\xd3'\xf8\xf1f\x8bF\xa38\xfe\x14~\x85~t\xfd6\xe1\xcb\xe0\x93g\xc1\xef\xad\x7f͛\xe8\x1a\xe0\xe3\a?\xb9\xe6\x18\xc6\xdb\b\xd8\tuD\xc4C\xcf\xfa\xf7\x7f\xfb\xbf\xfd\xfb\xbf\xfd\xdf\xff\xfd\xdf\xfe/\x10Z\x90\xae\x82\xebU\x88V\xa9\x18\x1d;\xa7ս\xb6\xb3\xa7\xf3x\xbd\x89#\x1ee\xa9w?\x8f\x13\xee\xdd\xc3V\xa5\x9e\f\xc0\x80F5\xe2Ak\xe5\x8c\x03\xfc0c\xd8\x16Ð\x16K\xca@ե\xe0\xf4\xb3\xbd\xd4\xea*\xf6\xa7\\\x86\vu1\x1d\x99\xc4ʝn\xe6\x9e'E`\x1f\xc3M\x97\xe1\x13P:Y.x\xdd\x12^\xb6f{*f\x94\xf7\x9cӗ\xa6\"\x9f\xd0\x103\xe4u\xfaV\xd2*\x94%\x127\xbf\x12\x15{2W\xbb\x15D\xcal\xbf\xdf\xd3\xe56\f\xff'\x80\x03\xbdA\xf9\x82\xc1\xe8A\x99;\x8e@*z0\aǘ\x83\xf3?\x11,\v\b\xfdB\x88\xfcJ0|<\xf8=\bx\x8f\x82\xb4}~\x15\x7f\xe4ʾ[G\xf3\xcc\xf2\x80\x9dI1\x94\xa7\xa6Y\vq<UlN1\xfcW\xf1\x82렛\"\x89q\xf9C\xc5\xf0\x8c\xe7\x1f\xd1%I<\xff(\x92\x12\x1e-x\xc2\x13\xc6\xf5O\xd9E\xbe\xf9\x8c\x9b_\xf9\xcd\xfe\\ڜXBs\xdb:a\x80\xa3\xe3e\r\xbc9d\x8cS\xe1~\x04\x90\xacEhV\xaf\x03\x84\x9c\xb0\f\xb95\xc1\xf2\xae^\x9f\xc7Q\x1a\x87\xbcu\xeb'\x91m\xc9䚘j-Hk\t_\xc77|Ѫ\xfd\x98\xf2\x9a\xcaބ\xdb\xeb \xaa\x05Q\x9aq\x7f\xe1]D\x17Q\xb0\xde\xc4IV{\xc7\xe1\x10\x00N\xa9-\x93x]{\x92\xe8\x84'c]J\xb5S.\xf2Tf@Qa\xf0\xa2rl\xd2ڦܖ\xf9\x04\x8c\x9a\v\xab\f\xa5/\vk\f)\x99L\x01\xb8Ǆ\x9fJK\x0ei\xef\x0f\x82\xc8\xd2\xca(\xb3\xf38\x12\xd1\xd7\xecg@w\xc9\xfeS\x9e\xc1+\xe0~O\xf6\xcf\v\xb4\r\xcf\n\xd7\x7fj\x9b\x8dRN\xf6\xb4P^\xb7~H\v!\xb1 m\xbf\xb3U\x12\xdf\xd6`\x94\xe7I\x12'\xb6\xf5\x13H\x80k\x7f\xcd\xd7\xf0\xaf\xb5M\xc2S\x9e\xd1\xda|\xc5\xe7\x1fk`a\xf3T\xd9\xee\x01\x19#\x87P\xafg8x\x9d\x00\x14k\x8e,\xea\xf57\x18\b\xae\xf5\x91ߥ\xb6\x99Se\xa5\x9b\x90{\xb3\b8\n\xc1\x83\\\xafg\xeaw\xd2\xe2\x11\xeedeA\xe1\x15\xb2\xbc(\xdb\x12\xa5\x91\x1bWق\xc0\x10\x9bP\xaauH\xa1\xa8\xb8nH\x9dȺ\x90<6cl\x02\x90\xa8\x80\x9fvB\xa8d\x8a\x96[\x17\xb0S=,4\xf4R\xf0\xa2\x80L\xfe\xb0\xf30\xc1X\xa7\x00d`\xd1U1\x89\x17\x02\xb8\xbfd*I\x01\x159\xf4˧V\xd9\xe9\x97MP4Q=\xcd=\x1d\xf5\xbb\xfd\xae\xc7\xd9ٽ\xb5My\rP\xd7<\xb3ƒ8\x06\x19\x15o\xf1O\x80(\n1.#\xa4j\x0f1\x9dq&\xc09\x85<\x17\xfcӆ\xcf3\xbe\xa8\xf95Y\x03Ic\xe1XX\x87\xe5\f\x96v\xbb\x90\xd2\xe0cAȪ\x17ط\xd1XF\xec仝\x96+e\x04\x90\xab\x18\xab|n&\xb2B.\xfa\xae%J\xa4\xe3\xd0Xب\x8c\xe3\xb3D{x\x89\xce\xdc1q\xeb\x11H\x1f\x1a\x8c\x13\x1a\x9d\x9d1\x97\xf2\x06\xd3\\\x89\x84\x89,\xb3\xa1\xfd\x9ev\a]wx\xb8\x82\x95\xcb&\x1e\x03\x19+=\xc5\xe8\x89\xcdY\x83\x1b\x8c\x00\xe9_#\x13\xfe5\x84y9\xfa\xb1\x00\vg鷢6D\xde\xccX\x97Hu\x91\xbcL\xb7\xdb\xc9\v\x81E\xaa\xcah\xbby\xba\xb4fWY\x03\xa3]|J\xe6\x8e2d\t,\xb0\xdfӶ\xd3\x1bt<yq\x97W i-\xec\x8c\u07bfu=\x9b\xb03\xb0\f\x82\u074c\x98\xf5\xfa͇\xcb\x1f\xde\xfc\xf8\xfa{K\xeeo5Z\x81\xad5\b\x04\xdfd\xb1\x800BE\xc7d\n\xfa\xb2I\xe6\xdd\xf3_\xb7~\x18dw\xcf\x01\xe1zٞ\xa6,h\x15\x12i\xc84줓\xd8K\xe9\x96\x05 !x\x1f\xfc\xc6\xe9<τ\xa8s[\xbaa\x81\f\xbf}^heY\x15 \xb8\xe8\xb7\x03\x1e\xb5\xdb0\x84\x89\xecv\xf2W\xb2\xdbe\xb9G\xd3\xe4\xc0\xa3\xa9\xf6n\xc3T1\x8c\xcd\x1a\x9fF*2\xd9\t\xb7\x81\xa7M\x13\x8cW\xa6+j/\xc3{;$t\xc5\xe0t\xcd'v\u0096\xf4\x1e(؊\xd1\xfa\xf5zb\xfbp\xabPN&~\v\xcd:\xbdhO7[\xb3<,\xbb\xcf\xee?\xf2;\x8fSQ&\xdb\xef\xe95\xcfΣ,\tx\x9a\x97͛\x9eL\xfd\x19\xb8%\xa2\xf3\x90\xfb\x89Y\u0097+\xbcߓ<\xd9\xc0\xa6S\x83A\x13\xab\x8b\x17\xb8\xba\xcb B_i\x9f\x8a\x97\x9d\xec1\xb39M`.pw\x01\x9e\x88Ϛ\xae\xa8\xec3X\xab<\x90'x\\I\x94\xc4,\xa6. \xe1m\x94\x82\xb7\x1f\xe0UP\xb9\x12ZD#\x7f\xe02\xc6\xc5\xc5ɨO\xeec;#\x80\xb9\xb0Y\xd5\x10\xaeW&\xd7\xcb\xdfC\x1f\x12)\xf1z=\x11zx\xe43\xab\x98T,\x1f,\xd0~\xbf\xb7\xe7ti8WY؊@Y\x01\x13\xc3\xf6\x93\xeb->}q)2ī\x02\xf7\xf0\x96\xbfلw\b\x994/F7j\xfdW\xad|L6\xa1j\xe5\x8b~@\xe4\x0076\x17k\x05\xb7\t\x04\xcfCR7\x16id\xbfjm\xb6\xc6X\xa0\x90\xda-\xf5c\xd1\xc2\x19>\a>\x0e;\\\x81\x95\xc8\x06\xce\xc7\"'\xb5\x83\x9c\x1e\xc3\x18ƭ ſ6\a\xc6\xd6\x04\xfe\xf5\xf0B9\xc9Z\xfc\x86'wU\x83\xb7TR\x8eA8\xc8\xd0\xe52d\xad\xb5\xbfyl\xbd\x89N\xacY\r\x9b\xb7\x80\xd0\xdb\xed\xacm\x04?\x16\x16iX6\xb1<\xa3\x17\x19٘\xd6,2\xce\xefLy_\xce\x13\xeeg\xfc=\x0f\xf9<\x8b\x93\x9a\xb8>Ӛ\x1f\x86\xb5 \xdal\xb3f*\xb3\xd2Z\x16\u05ee\xb8F;)\xad]m\xb3Z\xc2\xe7<\xb8\xe1\x8bZ\xb6\xe2\xb5e\x1c\x86\xf1m\x10]נ\xfbԫM\xadFҰf\x96\xb1\x17z\xf0i1\"\xb8\xde9\x85\x8a\x123n\xf4\x99;ɚ\x18\x983b\x10s6C!j2\x8d\x9a\xee,\xaf:\x8dfe4\xff`\x17\x91\xd9\x05\x91\xc8/C\xe4\x17\x81D1o8\x16\r\xfb\xe0\xf5\x96\x86\xec~\xcd\xd7q\xf0\x1b\x7f#\xdf\xfc\x12\xcb\xd0-\x8b\xc4i\x03\x808\xb84б\x0e\xdb\xe6\xa5\b\xcd7X\x136[\xf2\xe8M\x8aj\"Ns-\xbf\xb9\x96\x19Op/p\xf7J\x9b\x84\x1b\xa2\xba\xc1}\xc1Y\xcdYH7l\xde*N\x8a.\xf3\xebi3I\xbc\r]\x95N\xc0\x92L\x96\x1e\x84\x1f\\\xb0\xc0\x8e\x84+Nq\xe0EE:=<d\xc0J\xddV\xa3\x85=\xc8N\xa3\xb9\x0f:g\xe8\x04Ӷ+\xb6\x11\x9d@el\x91\x83\tpE3\xe9\x04\x11\x19\xe5\vx^Tv\xa1\xc9e\x9f\xad\xcd\x12\x9c\xec\xf3<\xf9\x00\xf2\xd34\xb8\x8e\xeckz/\xae\xe4\x1f\xb6\xd1\xdc\xdbR\xb9H\x8bwy\xe2\x9a.8pQy4\aܺ\xa0\xa1\x9ff\"\xbf\xea\xba\xdaӄ\xc3Sh\x9b\xf9\x02z*V\tʤ<{\xf7ق\f\xee6z\xbd\xd77\x0e:\x88\bY\x8a\xea\x19}\xa7?\x1a\x18D\x13\xbc\xfa\xb3Z\xc4\x12{\xd8ow{\x88q\xed\x8e\xd3s\xba`\x11\x9bأ\xeeh\xd4F\xcf]\xf6\xb0\xddu\x06Ġ-\x81\xecT\x8cly\nP\xf6\x15\xb7\u07bdy\U000c1999?\xff\x88\xb7\xf0\x96\x85t\xce\xc2\x16\xa6PtP\xbfd\x19;\xbb\x8fZ\x1c\xe1\x99S\xebu\x8c^F\x00\xa7$|\xc3\xfd\xac\xe6g\xb5y\x1cn\u05c8\xd5\xc0G\x16\xd9\xd3\x15\x8bZi\x96|\x88E\x18\x10.\x88i\xb8w\x94\xd3\xe9\xd3lL$\x01\x9b\xb0\xd5\x14Xڒ\x8e\xbd\xb8\xb0\xbc\xea\x9c+˛\v@\tZ\xb7q\xb2\xf8.\xdeF\v?\xb9\xb3\x89Tp\x17\xe4\xe8wF\xb1(\x8e~:^\xf2V\x97\xf4\xb1\xc1\xb4\x94\xff\x93\x91\x1f\xc5\xd9O\x15E\x16F\x91 \xca\xca\xd9\xdf\x17[xqX\"5ǰ\n2\x9en\xfc9/\x15z_\x1aHE9Ś\x7fz\xb1\x90N\xff\x132\x99\x9b\xb2θ\xf5\xee\xfc\x87\xf3w篟\x9fK\x8aC\v\xf0\x12\x90\xde\xed\x89W*\xff\xfc\x0f\xcf\xdeɢIѡמ\xec\x8d\xe1\xfdl\xac\xf8\x15\xbf\x0e\xa2\xd2\xf0\xbf1\xf2y\xb4(\xe5N-\x0f\xc0s1\xb6~\x061\xe4j\x9a\xce&\x10/\xd7\x11j\x82\x109\x17\xb1ݚEZ*\xf1<\xf4\xd3\xd4^i\xcd\a\xcaAA\x91\xadA\x10Y\x9a\xc4\xfb\xf3\x0f4噷\x86GT\x14g\xdeb_\xe8\xbee\xac\xad\x1f\xdd\x01Ė\x06h\x8b\x01^\xebs\xf3\xfbwo~|\xab\x0f\x0eM\xf8\x9aC\x10L\xef\xc4ُ\xad\x89%\xa2\xba\xafD\x18m\xf1\v\\\xe3\xa3\x1b\x00\x8bAv2\xb9n\x89;\x97/\xbe\xbbc'\x8eg\x9d\xa8\xf4(\xce~(fy\x96\x90\x88\xe7\xc7\xf0\xaf/\"\x14\x1e֮\x93x\xbb\x01Α\x9f\xf8s\xb8A\x9e|s\x9f\xec\x9f\xc8\xeb\xe4\xc9\xe4\x89q<\xffڰST\xe2\xb8n\xa9\x11\xa3\x9389\xfbkBe\xa8\x86-\xa1[vM\xe7\xecZ\xa0\x02s-\x88\xe5ὒ?\x8as\xdc\xf0c\x84\x12[\xbe\xa8\x91\"V\x10\xdd\xceA\xc7t#/P\xc5a\x98lկ\xa9\xfe\xa5}q̼\xed\xe1\bv\x96\xa7K\xeev\xb6\xfeͦ\xb2\xf0\x8c.x\xc83^\x93\xdf⮼\x817C\xde\x05N\xf4\x06\x06uc6~/\xb6\x1a#\xb5\xb2\xa7?\xdb\x17\x8b\x06\xb1)\xfe\x99\x90\xc9\xc5\xfei\x8b\x7f\xe2s\x03\xf0\xc8Xph\xd9\xed\x04\xade\xe6za\x96\x00\x96wL\x9f\xb1[%$\xbfb\xb7\xd3\xf6lr;\xed\xcc&Fn\as=\xf7\xa9\xe3\xdd\x01\xac\xdc\x1a\x82\xeb\x83s\xfc\xf6\xfcË\x0f/\u07bc\xa6\xeb \xf2\xee@\x84\xe0]ɓ:\x97\xe6;\xe4\xa1\xe3\xec\xb6;\xc5S0\xb1\xbc\x8a\xe1?ܯ\x83\xfd\xba\xe5~\xcdf\x1b_Ѭ+\x9a}\xea<\xd4\xf0\xb7_?ޣ\r+\x14\xfa%X\xd0`v\x1c9\x13\x19O\xd6A\xe4g\\\x9eV\x8b\xd0pO\xf5\xf5\x8c\xae\x9cS\x16\xef)^ܕw\xbe\xb8\xe8\xc7Y\xe1\xd6c\xc01\x91\x83\x8cZo\u07fc\x17\x13\x15\x03\xb5\xae\xac=\xa1Y\xf9\xfe{\xb8\xcew\xa2\x0eb\xf0\x87K\xfe,J\xf2h\xf1p\xb9o\xac=\xd9S$N\x1e\x98\x18\x8d\xb1\x95\xa9j\xe5ݳ\u05ff?\xa7 k\xf0\xbaC\x9a\xc5^o\xb0\a\xb5\xbeB!cWF\xbd=\xad\xa8;\x1a@]\xd0\x0e\xaa\xca\xed\xf7 w\xe4\xe4\xc4k\ft}p\xbc\x97=\xadHu\x9d\xead\xb7:\xb9]\x9dܩL\xee\x1c)ݯ\xee\xb37\xe8;\x95S\x1d\xba\xa36Lv\xd8v\xaa[\x1c\xb6;G3:\xc72FG2\x86\x83#So\x0f\x87\x959\xfd^{0\xda\xcf$x\xa7E\x88R\xb7\xb7o\x13\xbc\xbcO\\\t\xd7\xd9O\x8f(\xec`a\xa0˪\v\xc6\a\xad\xbe\xf8|Y\xd1hN\xabU\x17\x0f\x0e\a\xfc\xd8\x1a\xa2\x03I\x8bT\x97\x9d~\x01(v\xbeb\xcfgz${\x8a\x87\x14\x99\xd7\xf9\x9b\xe2\x1e\x9e\x0e\x9eC\x91\x12\xf2\\\xaa\x0e\xbeצ\xef\xcf?x\x1d\x8a\xe0\xe7ui\x8e\x82\xbd\x1e\xd5\xf4\xa7קУ7\xd8\xef)>h\x1e\xc6\x0e\xfa}\xe3\xb3{\xc7sh\xe6\x8dh\xe4\xb9\x0e\xbd\xf1\\\x97.=\xb7M\x13\x98\xe783^\x1dU\xacW\xd3Q\xdfS\xfbbzqqu1#;\xfb\xe2\x82L..\xc0-\x9f=}\xd6\xfc\x01\xbd\xa2u\xf7d\xf7)\xffl\xef\xc9\xcev&S\xa79\x10\x1fs{\xfa\x8fϚ\xff2\xbd\xb8\xb8\x98\xfd<\x81V\xa6N\x16\xdd,\x93\x19!O\xaf\r\xf7\xccR\xe3\x86\xc6\u009a\vYlI.\xd2\x00zc˲\xc9Ћ\fe;T\xb0\x8b\xf3\x84\x18\x13\x82<!\xa0C\xe2\xa5\x13\xeb\x1f\x9f}\xf7\xfc\xfb\xf3\x1f~\xff\x87\x17\xff\xf4Ǘ\xaf^\xbfy\xfb\xcf\xef\xde\x7f\xf8\xf1O?\xfd\xf9/0\xb4\xd9ϵ\x89\xa5\xb5\xbb\xc0\xe8i\x1aB\xd4\xe1\xf7(bi\x01zx.o4{\xab\x1e\xd2O\xa7Ӌ\xd9\xfd\xfe\xe7oZ\xbbɷ\r\x9b\xcc\xe4\x83\x02b\xb8\xd8s\xf4\x1d\u0558\x13:G\xf9_V\xa4ə\xcd\xc56jOg0kxJ\x86\xe8\xc2w\xe2ٷdg/\xc8\xceN\xc9\xce\xfe\x89\xec\xec\xef\xc9\xce~O\xc8\x0e\xdcׁ\xfb\xc1\vb\xb7`5\x7f\xbe\x98\x81!\x1fi\x8a\xc4\t\xa6̠ \xee\x99ND\x87\xbf\x82\n\xb3\xc1\xe4\a)4\x0en\xa0@U\x12\xd4\xed$\xb9\x17\xe7\x8f<%\x04\xf2\xa7m#[=\xe0\xf2\u070eY\xb9\xf0\xea\xca\xcbt\x8d2\xe6+1/\xd1+\x96xq\xd0M\xbf\xd4đ\x9e\x06\xbaX\x05\x96\xb7\xfd\xe9p\xb6\xdb\xf9\xd3ь\x14\xc9\x14\xc0\xfd\xfe\xd4ufe\xeaE\x9b\x9c\x9f\xd8\x01\xf3\xa7n[\xcb\n\xa6)\r[\xc0\rA^\xfal\\\xea\xd6@\x17\xc1\x01I\x94I\xea')Q?\xf9#e\x0e\x90b!\xf0`Q\x86\xac}vv\x9f3\xd0\xde\xdfE\x99\xffI\xb2\xd1\xd4k'\xe1\xd7\xdb\xd0G>Z\"\xbc\v{\xb5\xa7V\x837\xac\xa7^\xcdj\xa0\xb0\xb2\xef8\xbd\x8e\xa7\x1a<\x10XJ\xfd\xe6J6߆'\xe0\xe1Տ\xe6\xbc^\xaf\xe0\xe0\x1a\xf9\xad(\xbe\x15L\xe0\x80\x19\xc9\xe3\fX\xfb\"PS\x14\xdfV\xf0\xaa\x81%qk\x93\xbd0\xcf\x13j\xad\xdf\xfb\x19\xa7!KE\xd6\xe7ې\x05\x9b\xe1\x1el\xf4\xadm\xb4\xe0\xcb \xe2\x8b|\xa4\xb7A\xb4\x88ow\xbb\n.\xe5+\xe1\x19\xf7\xf9ʏ\"\x1e\x8a9l\x192\xd3\xe6\xe2\xcf\xc6\xecR\x8a\xa7 \xb0\x1eɒ\xbb{\xc1\xc9+\x0e\xd1&\xe3\xad\raG\xe0\xdd\b\xa5\xf7s_z\xfe\x17\x1b\x9a\xf2\xecC\xb0\xe6\xf16\xb37\xd4!\x94\xef\xf7㤀\x9fU\x1f\x13\xa3hB\xa1I\x0f\f\x13i\xa9\x05\xb2\xa7QI\"8gF\x19\x8e\xf2\xf9\x027\x19E\x04*\x7f\x8e\xb0\xa7琮\xe2m\xb8\xf8K\xc0\xc3\xc5\xe1r\x9f\xb8{\xea\x9b3^\xc6ɜ\xff\x90\xf8k\xfe\xce\xcf\n\xf2\bcW\x97L\xecA+\x1f\x15]\xa94s,\xe3\xe2\x16\xea}\x92z>b\x83\x16\xba&@Y\xf8,\n\xd6\xc8Y\xc4Q\x8c+vY\x16O\xf8\xaf[\x9ef\xc5\xf2\xb9\x0e\x918\xa7ևU\x90֮\x92\xf86\xe5Im\x11\xf34z\x92\xd5\xd2\xed\x06U\x7f*\x9bh\xd5^\xf9\x1fy-\xdd&\xbc\x96\xad\xfc\xacv\x17oka\xec\x83\xf4~\x13\x87w\xcb\x00\xc5\x10\xb58\\\xf0D5\x9d\xb6j(\x81\xf6\x9e>M\xb8?\xcf~I[qr\x8d*D\"\xa1\xa9\xaa\xa6V%\x83}\xf1e#\xafZ\xab\xff\xf8\x81\xef\x05\xab\xeaĥ\xd7\xe28\xdd@\xa8\xf8;֣W\xa0\xca\xfcH\xa0\xab\x95O\xd8\x19\xbb\x0204\x81\x8d>\x02&9\xb9w\xce\xf8n\xe7\xb6{\xa7|RZ\xbdb\xa5Z\xe6\x7f\xe4)\xaeC\x1ad\xc1\rH\"\xb2\xda\x15\xcfn9\x8fjN͏\x16\x10Z\x83֠\x1a\xb0\x80\x97P\xb3\x96\xf8\x19Ok\xa0҈\xf2\v?\x82B\xb5\xe5&\x05e\xb4(\xd6\xdb\x01\x12.\xef\x8e9\xa7|\xf2\xca\xcfV\xade\x18ǉ\xed\xf2\xceSN\xbc\x9eP1\xbeE\xb1N\x11C\xd1svۂ\x06\xdac\xf1\xd7mőt\xef]\x8d\xa8\xae\xc91,u\xc5x\xe3n\fh\xecZ\xe0\xab\xc99\xa8Sg\xb2Gl\x80x\xb6\xb1{\xa4\x8c\xca**P.\xce=\xee\xfa\x9e\x16\x11\xdb5\xe3t\xbd\xdbA\x9b\x0e\xad\xa8\\\xc6d\x10Ƀ-\v\x02\x14n\x97'B@R\\Fp\xc8\xce\x02`\xdb確O\xa6\xcc<\xb7Q\x10\x97yF\x8c\x18vB\x9d i\xbaggg\x18\x82\x1eDrH\x19hk¸^wN\x9f\xd91\xcd\b\xd1\xf1\xc1\xa0\x1c\xcb(\xf8Be1MXd\xf4~iP\xdeZ\"es&\x04\xb00}\xcf4z0l\x1e\xa6\x0ev\xae\xbb6栥t\x89H\x87\xb2,\x913\x11\xb3ph\xae\x0e\x04^S\xc7J\xc2\xdf\xfe\xd6\x06\xd3r\xe1N\vB\xc0\t\xbf\x0f!\xe3Ӵ\xd8aP\xaf;g\xcf\xec\x80&\x84\xe8\xc4P$\x864\x00\x97\x120\xf1\x90BM\x96Ј\xa5\xc4\x13i\x01\x85\xa61\xcd7)\xac\xc3f\x92\xf2:\x9a\xcd\xed\xf7\abp\xbcZ\xf5z=+\xeem\x1a'\x82Xkf\xf9\xef\xb1\xc1\x9eJ&\x89\xc7[\xc1\xa2\x99\xb5\x82\x05b\xa9\x8f@\x96?\x87\x7f\xde0\x97\xfe\"\xd0\xd5\v֡\xaf\xe1\x00|\x80\x7f\xde\x02\xf3]w\xf9\xae(\xf9\xbd\xb4\x9fk\xf6g6.\xa8\xb2\xb4 v\x01\xe8\x01\x93\xf7\xf6ss\x15\xe0\x85\xe6'x%\x9e2\xaeb\x11A!j\x8c\x9be\xf0\xd2\f\x84{l(K?\xd9\x1f\xe1N\x17\x9d\x1a0\xf6\n\x86\x14,m\x18(\x85\xf1ѓ\x0f$\xc7\x05\x97\xf6GB>\xc0\xd9K\xec\xef\xe58*\x06_\xafG\xf6+j\x8c\xad\xc9\xcdN\xbe\x17\aS\xacH\xbd.:Cv\xd1k\xf0c\"\x80\xeb\x05\"\x16X\x9dwvB\xe8/ط\xea\xe1\x174\x81\xfb\xa54\xab\xb3\x84\xecv\xbc^?\xa9\xbe\x16lte+\x88\xcc_\xf4\x8a\"\xc1pH\x9c\x06\xe4>/\xa3\xb6\xf2\x97\xd6&\t\xe2$\xc8\xee\xd0\xd2{,\xe8\xcd\xe0` \xa7,!\xe3\xe4\x00aҊ~҉\xd1M\xea\xfd\xc2\xc4\"\xd7\xeb\xef폄\xc2\xd4\x052\x84ϱX\x84}\xbe\x1f\xbf\x10!\xe5<q\xf2\xbd\xd8\x16\xf6b+\xf6bk\xecEBh\xc8rŲp\xbf\f\"?\f\xef\xee5\xc8\xfa\b\xb2\xc2\xc7\xfeK\xe6\x9b\xd7\xec\x8bE\xc8\xdf\xcaE`=\xf3\xce|\xb1^\xf3E\xe0gy\xb6kf\xbf\x8couF\xd7\xccx\r\xc4~\xa8\xf3:f\xde\xdb$^\x06!\xb8\xd3Ƒ\x199?\xa6<\xf9\x0e\x14\x9d\x83\xe8Z\xd7m\x9b%\x04\xbd\xf2\\\xad\xady\x8b\xf0\xe2\xce\x16\xae~\xe5}\xeb\xfc\x13\x9fo\xa1\x82y)|\xd8\xed^\xefv\xb6>\x01E\xba\xf7\x9agϷI£\xec\xad\t&\x15\xe4ȋr\xbd\x1f\x82$\xcd\xd4X_\x83\xee\xeaa%\xdc{\xb3^\xc4?\x95\x94\xac\x85\x9c\xf7\x85\n\x11&\xc2pɀ^\x1dOZ}\x96\xd8\xf3\x19{\xb1\x17X\xef\xc5\xf8\x05\xcb\xf0\xd8i5c\xa2\x81\xe3\x05K\xf6\x85\xde7\xfe6\xad^\xa5B1I\xf0\xbe\xf5\x83(c/\v9\xdb\xe8\xa7 [\xe9\xdd+>@\xca\xe1\xceJs\xc1?2\x00Y\xcf+Έ\xb3\x8e1#n\xce({hF)\b۶!\xaf\x80\x19\xea\xd3@\x99M\x1e\xd0Ar\xa8\a\x0f\xe0@\xd9\x1b\xb0`\x120+ڂ\x8cPg\xdb\x01\v\xc0\x15\x9d\x7fG\x80\x12\b&i#\xf0R/`)\xcd'-\x8ew\xd35d5\xb5\xb6\x17\xb2v\xcf1\x93z^\xc8\\g\xd0\x01\xbd\xd8v\xc7\xcc\xe9B\x0e\xef\x96\xf6<d=\xde\xd9k.\xde}\xb0\xf0\xde4\x1aT\x9d\nϧ\x05D\xe7q\xaaч\x17\xd0\"\xb2\xf3B\x164B\xaa/\x1b\xaf\xe9\xeeip\x96\x82\x9b(\x9d\xc8\x02\xfa\xc9~N9\xa1\xf2J\x13H\x8e\xe3\xaf\xe7\xc0\b{;\x89m⽅\x93\x05\b+h\xa6\x84\x10\xaf\xd0F\x88\xd7\x16'\xf4\xe0\x18\x02\xd1h\xee\xe4m\xe2o*O\xbe8\x02/\xc6e\xddQr\x9f\x03L\xf1\bH\xf5\x18T47tt\n@\x04|\x92ΰ\xeb\x1cS\xcb\xcd\x19\xbd\x89\x8d\f\x15\xb2\xa7\x83\x81{\xb4\xbc\xa4\x1e\xed\xeep\xd0\xef\x92\xd6w\xdb\xe5\x92'cd\xf3\xd4\xe2\x1a\x86+Z\xa4B)J\xf0w\x93\xed<\x8b\x13D\x01\xdb\rO\xec\xb8u\xb9I\xf8\xc6O\xf8{\xf8V$2'\x84J\xad\x1e\xf1@~\x9b\xc4\x1b\x9edrz\x96\x88Fr/\xa5M\xaf\xe3\b\xbb\xb0\xa82\u0380\xb5\x05+\xae\xdb$\xc8\xe4\xef=\xa1X\xa85\xf77\xd96\xe1\xefAD\xfc!\xf1\x81\xe7s$Ct\x16\x93}\x9a\xf9Y0\xaf\x1d\x19꽱\v\xff\xf4\xfe\xcd\xeb\x96\xd0c\x0f\x96w6\x97\xef\b\x95-د\x90\xbc\xdf\v~\xb7Ϧ\xf7\x1b99O\u038bG\xdb5\x97s\x00yR\x9e\xaf\xc3\x1b\x1d/\x82\xa2\xef\x87\n\bS03\x1f|\x00\x05\xec\xfd\xdd\xfa*\x0em\xab\x95\xc50\x8b\x1a\x9c1x\xbcє\xd9\xf7\xc8i\xe44\xe5<\xf22\x9aŗ^B\xf1\x19y\x9e\xb7\x13\x83\x98\xf5{\xbe\xc9V^\b\nV\xd9\xca\xdb\xees\xce\xfe\x1c\xc3֕T2\xc9d:\xf3\xee\xf7B\x11U\x06\x06%t{\xc6B\xc5(\x9f\x1f\xa1{\xb8\x1cg\xbd~\x82\x1a\xe7\xd3@\x05e\xb0Qd1\rf@m\x88\xbe3\xa6\x8ak\x13\xea\x9aT\x16\x80\x824\xdb\x13\xa5\xac\x84\x15\xa6\t\xf5g\xf1Ri\x96q\xa9\xf0\xca\t\xa9\x18I\x04\x82\xdf \x15\xc0o\xfbd2\x87G\x915\x15X\xb6&\xd2g\x96W\xc1\xd9\x00?\xb3~\xbd~\x80\x90\xfd\tH\xaf\xe6\xe1v\x01\xbdB\xbc\t\xd5\xe8\xf3 \x99\x03/tfy\xf6\x160!$\xa7j\x83\xa6\xc9L\ue44a\x9c\xfa\xc8]\"\x1e6\xe4\x1bk\x90\x83LfBK\xb2\x87\xf1\x11eޑ\xef\xc74\x9b\xd5\xebՇvN3uX\xa1X\x01\xf8N\xe2\xdd.y\xf8\xe0\xaa\x1d\x9b\xef\r\x04u\x9f\xf2$\xf0\xc3\xe07aH\x82\x18\x8a\xdd\xe7\xf0v\xaf\xa7\x99\xb0\xd7x\xa1I)\xf5\x9f\xce/_\xbc\xfe\xe1\xc5\xeb\x17\x1f\xfe\xb2g\x99l\xfb`\a\xb8\xbe\x12\xf9$-\xc2\xfftv\xb0\xa8'N\xbe\xaa\x89\\UgO\xbcJu\xe0\xbfN\x7f\x90\xa9^\xed\x9b{\xad\x0f\xecGqt\xb7\x8e\xb7\xa9\xb5\x9f\xfd\xd5\xe3{\xba\xe0\x7f\xa7)\x82\x8d9\xda\x00\x02\xa1\x19/\x05>.\xd8\xd6<4\xfdz\xfd\xa4|hɽ:VZ\xe7U\xed\xd1\xe1Z\x01\xb2\xc8*\x97\x87\xe6/\\~\x8b\xea\xfcpA\xb5\xbb\xeepd\\8\xfa\x8eq:\xa3\xbe\xbecL+\x00\xa0\xc2\xd0z\xe9RZ\x14\xb6\xfc0\x8cA\x16$\x8c\x9a.\xf1\x0e\x04\v\x0ee6*\nb\x8a\xb4<\xbc\fyĜ}l\x1a\xb5a\x80\xc4\x12\xb5w\b\xf7\xa8\xd5\x0eqö\xd9rhQ\xce\"\x14\xafaq2\xcec'\x19\x1d\xd3\xd8\xfc\x82aP_s.h\xc0\xf4\x88@os\x9c;\x14\x10DV\xf0\xbb\x98n\x192\xd3\xd6Ad\xfb͔\xc6\xcd\x10T\x9a\x9c\xf1\xfct;\x9e\xa3\xa2uؘπ\xbfј\xcf@CnK\xed\xa0\xc1\xb6\xe4w1\x83\xa8F\xa2\a1Cx\xba\x99\xf6u\xd01x\x04\xce\xd0b\xcf\\\x91Ep\xcd\xd3\xec\x88\xe1#\xd6\xfb]i^c\xe3{\x9a͘\xdb\x1e\x9a;\xd0\x02\xe6\xa9\xed\xd0\f\x1c\xffeg\xac\xb4]\xb0\xb4\xe6@\x8d\x9a\xa4\xa2\x19\x19i4a\xc3o\xf5\x80\x90ctʺ\xedQw\xd4\x1f\xb4G=b\xd6\x034\xc3\x7f|\x11e\x9d\xf6w\xe7vR\x06\x8ef\xd7`\x1dD\xcc\xce[\xa9'\xe4\xec\xec\f\x18NvҌ\xc8S\x9d\xd3\x1f\x1fo?>h\x7fH\xe8\xf1\xe2Q\xc5p\xf6G\xd7Cr#D\xd2\xcaOW\xf9M\xc7'~+\x8b5\xed\xe1\xf9\xc5]\xbd,\x03:\xb9?Ph\x97ej\xebm\n\xbc\xe1Z\xb0ބ\xe8>\x81/jWw\xb5t{\xa5e{9\x8a\x06ݦ\x913h\x1f\x1c\xe6*\xfb7r\xcf\x19/9;\x11\xc0\x15M\xb9`If\a\x8a\xf6\xbca\x1d\xb0\x9bk\xf6-\xaf\xf9\xf39\xdfd\xb5\xcd6\f\x95$#%\x96^\x11h!ۏ\xa3V\xba\xf2Yb\x0f\xba\xdd\xee\x90P\xfctYb\xbb\xc3N\xa7/\xbf\xdb\xed.\x12\xb8\xddN[\xa5\xf4\xfa@\x1e\x0f\xba\xa3\x91L\xe9\f\xa1L\xcf\xed\x0fU\xad\x9e\xdb\x06\x95\xec\xc1\xd0\xed\x03\t\r\xedW\xa0\xb4No\xe0\x0e\x84\xa2\x03b=\xa1\xd3]@t4`S\xb7\xe7\x0e{\x8e\xd3\ue3a8;\xec\x8d\x06\x83^gԡMw8\xea:Π7\x1c\xd2\xe6p4\xea\x8e\x06=\xb7\v\xbc\xcd\xdcFbX\x88\xb1\xac\xfc\xd9\x04Q\x90\xd9\n\xf2nYJcd3\br\xb7ߥ\xbd>1\"+\x9bj\x14\xa7\xa7\x1dg\xc7\xcf\xceΌ\xd0\xcb\xf3ܵ\x88,\x86\xbe\xcb&Y=\xd9\xfdkV\x8f\xbc\xb6\xfe\xcc\xea\xd1.\xa9G^\xf6s\xf2s\xb4\x8f\xec\x90Ƅ\x86\x06(\xc2Ȫ\x84\"8R\x9f\xb9\x83N\xbb7캣\x8e:\x1a\xac\xeb\xb4;\xedN\xa7\xeb\x0edҜ\xb5{\xfdvg\xd8q\x9d\xb6LZ\xb0\xf6\xc0\x1dt:ÁB>\x9cu\xda\xc3^g0\xe8\xb5\x1d\x89\xe7\u0087ND\xd1-\x8c<d\xb74b\xceN\x8e\x8d\xc6\xfa\xf7\x15\xf5\xf5\xef9M\xf5\xef\x05\r\xf5oN7\xcc\x19oN\xdd\xfe\xb8\xd1ؐd\xba\x99\xa1\x8a\x8a\xbfP\xa7\xbf\xfb\xedF\x9a\xa2nN\x87N^*\x99n\x9a\x9d\xd9\xcf\xf0g(\xfe\xb8]\xf9\xb7?\xd3\xd7͒9㥨\xb7\x14Hz\xc5\xfe\xf5_\xed\xe5ӶC\xe8\x829;\xdb\xceXDNO{\xbb\f\xb6s@\x1as{\x85\xbe\xe8R\xd2\b\x1b\xc9t9k\x04\xd3\xd5l\x1c\xb2\x14\xd8\xe5\xd4g[;\x06X\x8dh\xc4\x16{\xb5%QC\xfe\xda9zOb\x99v\xa5\xd3\xe6̗is\x9d\xb6`\xa9L[\xe84\xceB\x99\xc6wNiO\x00\xa7\xb1\xd2;\x983_\xdc\xf5?F\xa9\xbf\xe4v\xdbɑ\x9e\xc0\xa6j9\xf3\x8dBwU\x95yW\xb4{4oN\x87G\xf3\x16\xd4m\x1f\xcd\xe4\xa0\xc0\x03\xaf\xfe\x1c\xe9\x85{\x8a\x18\xe6\xbf\aF\xe8\t\x840(`\x84\x87Q\xc6\xe6\x7fG\x19_\x832ġF\x94\xb1$pB\x0fP\xc6R\xa2\f}\xf4\xb1\x94\x9d\xb1d\xba\x14Xc)\xb0\xc6Rb\x8d%`\rrz\xea\"\x1a\xe8\xe4&\xc6+\xe6\x8cW\xa2\x95\x95\xd2E\xf8\xd7\x7f\xb5W\x88@\xd6lkG\xa4\xb1\xb1\x17&\xc6X\x01\xc6X\xccv\x8e\x813\xe69\xceX\xff\xef8\xe3o\xc3\x19H\x83<\x8c3$Q\xe2\xe7\xe8#8@\x1f&6\xe8w\x1f\x85\r\xfcCl\xf0\xe5'\xb1\x03'l\xe0:\x9d\xb6\xde\xf4\x91\xdbu{N\xbf\xdf\xd1[>t\xdb\x03\xa7=\x1a\x8d\xf4\x86w\xddnw\xe4\xb6\xfb\xa3\x81\xde\xefn{\xe4\f\x06\xbdaO%-\x99;\xe89}\xa7\xe3\xb4{2隹\xfdQ\xd7\x19\xf4\x87\x1d\xd5֊u\xdaN\xd7\x19\xf4\xba\xf2\x11\xf2(`\t\x8a\xc02<\x06,\xc7A\xe58\xa0\x1c\a\x93\a\x80\xa4\x00\"UYK\xda>6\x88k\xda\xee\x1e\x02\x16B\xcd\xdfp\x19\xb9n\xbf\xd3kw\x9d!u\xe1\xd6\xe9\x0e\xba]\x97v\x9c\xee\xa8\xd3\xeet\a.\xed\x8cڮ\xe3\x8cz\x83\x0e\x1d\xf5\xdd\xd1p\xe0\xf6;\xd4\xed9\xc3\xd1\xc0\x19\x8d:\xb4\xdd\xedu\xfa\x9dޠ;\xa4\xed\xe1\xc0\x19\xf4;\xed\xb6K;\xfdv\xb73t\x9d\xa1C;\xae\xd3\x1b\r\xbb\x8eK\xfbΠ\xdd\xee\xb5\aC\xeav\xdb\xfd\xe1\x10Z\xa3\xee\xa8\xdds\x06\xc3\xcepH\xdbn\xbf\xed\f\x86m\xa7O\xdb}\xb7;\x1c\x0e]\xa7C;\xed\xee\xb0\xddn\xf7\xa0\xa9a\xa7\xd7\x199\xd0V\xd7i\xb7\xdb\xed\xee`Х\xed~\xb7\xd3\x1d8\x83!\xed;ݡ3跇t0pڽ\xdehءn\xbb;r{\x8e\xdbnS\xb7\xd7\xeb9C\xb7?jSw4\xea;\xfd\xeehا\xed^\xaf\xdbn;\xc3a\x9b\xb6\x87mw\xd8\xe9v\xba#\xda\x1e\xf5ڣQ\x7f\xe8\fi\xa7\xed:\x1d\xb7Ӈ\xc5\xe8t\xfa\xbd\x81;\x1c\xb9\xb4\xd3\x1bv{\xed\xe1\xc0u\xa9\xebvF\xed>,F\xa73\xc4\xc5\xee\xd1~\xbf\xdfq\x06m\xa7G\a\x83\x0e4嶩\xdb\x1eu\a\xbdAgЦng\xd4w\x87\xed\xf6ȥn\x7f\xd4s\x87\x9d\x81\xe3Pw4\xec\xf7\xfb\xae\xd3siۅ)\xf4;=\aV\xb8?\xea\xf5\x9d\u0380\xb6\a\x1d\xa7;\xec\x8d\xda.\x8c\x15\x8eL\xd7ui\xa7\xdd\x1b\r:\xce\xd0qh\xa7\xd3\xed\r\xfa\xdd\x01\x8c\xb5\xe7\xf6\x9d~o\xe8\x0eh\xa7\xef8\x9d^{\xe8ti\xd7\x19u{\x03w\xe4\x8ch\x1b\x0eS\xa7\xd3\xed\xd2n\xc7i\xb7\a\x83N\x97\xf6\x9c\xfe\xa8;\xec\xbb}\xda\uf35c\xbe\xd3\xeb\xf5\xe9p\xd8\x19\x8d\x06\xc3\xc1\x80\x8ezC\xb73\xea\r\\\xeav\xdam\xd8\x15wH\xdd\x1e\x8c\xbd\xed\x00X\f\xba\x83\xe1\xa03\x18\x8c\xa8;\xea\xf5z}\xd8#چQ:ݡۣm\xec\xc6\xe9\xf6ڴ\xdd\xe9\xbb\xc3^\xbb\xdb\xee\xd2v\xb7=\xecv\xfa]\xd8\xcbA\xaf?\xe8t\xdd\xe1\x80\xe2y\xef\xb8\xdd\xc1\x88v:\xedQ\xa7\xddk\x8f\x86\xb3\xaf\xc1\x80\x9f\xa1\x87\x84s2\x89\x18\x92\x9fy\xdd\xce~N\xc8\xc1\x1bI\x17\xe1\xf5l\x97\xd4m\xbe\xcbH\x81*R\x05l$\x98vH<\x91\x9f\xf1\xcb\xed\xc0\xa7;\x92\x9fm\xccu\x1d\xa3\xfe\xb2T\xbf\x0f%\xda}U\xdf\xc5OW\xd5\xef\xc1\xe7\xc0\xa8\xbe*U\x1f`\xf9\x9e\xaa>\xc4\xfe\xba\xe4g\xf8\xea|\x15%6\x189\x9d\xce\xc0\xc9)\xb1\x8e\xdb\xed\xba\x9dn{\x90Sb\xae\xe3\xc2\xf1lwMJl\xd0\x11\x87R\xdf\x00n\xa77\x1a\x8e:\xae;\xd27@\xbb\xef8p\x1a\xda]}\x03\xc0\xd9\xeat\xfb\x9d\x9e\xbe\x00\xdc^\xd7\xed\xf6F\xedv\xaf\xea\x02\xf8O \xe1\x16\xfa\xf7\x92\xae\xf5\xefkz\xad\x7f\xaf\xe8\rs\xc67\x82̻!\xc9\xf4\xe6\x90̻\x91d\xde\xcdi\xbf\x9b\x97\x82G\x1d\x92z7\xcd\xf6\f\xd8S\xee`\x97\x9d\x9e\xba\xb0\x85@ݹ#\xfc쐟\xf1\xcb!\r(:\x985V6\xfcp{3\x91b\xbe#\xef\x983\xbe\x13\xbd܉\x1b\xf1\x8a]7\x96vH\x1a[;\xa4\v\xba&\x8d`z7k$ӻ\xd9Ρ\xb7l\x03\x94\xe1\xdcFmk\xb2s\xc6\xd7lM\xd7lA\x17,\xa4!K\x1b@\xe0\t\xda0\x96\x84\xe1U\xe3v\xe7\xfcg\x10\x87\x1aV\x162m\xa9Ӯ\xd9Z\xa6]\xeb\xb4\x15\xbb\x96i\xab\xaf\",;\xed\xff\x0e\xb4BU֊\xb6\x87\x87d\x04\xf2\xbf\x1e&#\x04C\xec\xf1\xf4\xa9\xdbw\xbe\x98@\x05\x16\xb3붿\x82D]1\xb86\x9c\x81\xd3\xe9+\x94q\xb5bn\xbf\xd7\x05\xa2\xb4\xa7aoŀ2\xe9\xb5G\x9d\x81\x86\xbd\x15\xeb\xf4z\xdd~\xbb\xd3WI|\x05\x8fO\xb7\xeb\xf4\xba\xaejn\xb9b\xed\xce\b:iw\\\x05z+\xd6\xe9\x0fz\x8e3\xeci\xeau\xb5b.\xdc\xcd\xfd\xf6\xd0U\xe8\xd2\x0f+H\xe8\xf0\x90\x86\x0e\x0f\x89谂\x8a\x0e+\xc8谂\x8e\x0e+\b\xe9\xf0\xef@Iw\x87\xc6\xcef\xb6d\a\x94\xc1\x8dF\a \x98Ш\xd1\xcd%\x16\xea0\xad\xf42\xc1\xb1R\xc9W+\xbdRt\x98'\xcfWz\xb5\xf0l\xa8\xf4\xc5J/\x19B\xbfJ\xe7+\xbdl\xb4\xd3\xceӗ+\xbdt\xb4\xeb\x1c\x1e\t\x84\xf7\xbf\ve\xdd\xe9;\xa3A\x7f\xd0\xed\x15\x88\xec\xbe\xd3\x1e\x8e\xdcA\xbbW$\xb7\xfb]x+\x8eF&\xe5\xdd\x06NȨ\xd7\xeb\x0e\r\"\xbc\v\xb4l{\xd8\x1d\xb4Mz\xbc\xe3\xf4:\xc3N\xb7\xdd\xef\x15H\xf3Qg\xd0\x1f\xb8\xbd\xc1\xa8H\xa5\xf7\xbb}\ahK\x93`oÍ=\xectF]\x83vw\xdd~w4\x82\xd3d\x92\xf1\x1d\xa0\xe2\x9cA\xbfkR\xf4\x9d\xdeȁ\x19\x8d\xba&q\xdfu\xfaCP\x1b\x1avL:\x7f4r;\x9d\xbe\xebvL\x8a\xbf\xdf\xe9\f\x9dN\xc7\x1d\x14h\xff\xee`4\x18t\x87\xfd\xa1\xf9\fh\xf7\x81`\xee\xc0\xca\x1a/\x02Xa\x17\xa8r\xe3m\xd0\xeet\xddv\x1f\xc8\x11\xe3\x99\xd0v\x9c\xc1\xd0qF\x9d\x8e\xf9b\xe8\x8ez\xa3\x913r\xdc\xc2\xe3a\xd8\xebw;n\xbb\xd33\xdf\x11\x1dw\xd0k\xbbC\xb7S|R\xb8\xa3\xe1\xa8\xe7\f;\x03\xf3u\xd1\x19\x8dF\x03w\xd4\xe9\x8ċƠ\xdf\x1f\xc0\n\xf7\xcd'G\xbb\xd7\xef\xf7F\xdd\xe1`Tx}\xb4\x9dN\xa73\x18\xf5\xfa\xe6C\xc4u:\xddn\x0f\x1e\v曤\xdd\xed\x03%?r\nϓAo\xd8i\xf7;C\xf3\xa5\xe2\xf6\x87\x03w0\xea\xf4\x8d7\x8b\xeb\x0e\x87\xee`4\xeaw\xcd\xe7K\xaf\xdbw\xba=x&\x98/\x99^\xbb=tz\xdda\xcf|\xd4\xc0\xba\x0f\xa1\x8f\x8e\xf9\xbeiw\xba\x9d^{\xd0\x19\x15\x9e:\xae\xe3vaۆ\x8e\xf9\xeaq\xdbN\x7f\xd0\x1b\xb9ݶ\xf9\x00\xeat\xbb\xce`\xd0o\x17\x9eBn{\xe4\f\xfb\x9dn\xdf)\xbc\x8a\\X\x8dn{\xd0)<\x90zNo\xd4k\xf7{\x03\xf3\xad\xe4:\xfd\xb6;p\x9ca\xe9\xd5\x04d)<\x90\x8c\a\x94\xdb\xed\xb4\a\xed\xde`\xd07\xdfRn\xb7?p:no\xd45\x9eUÞ\xeb\xf6G\x83\xb6c<\xb0:.P\xba\x9dA\xafm\xbc\xb5\xdcN\x1f\x18\x9d\xee\xa8g<\xbb:\x80͇\xbdިc\xbc\xc0:\x83a\xcf\xe99\xed\xa1c<\xc6:\x1dw\xd8q\x06\xdd\xf6\xc0|\x97u\xe0&iw\xbaN\xc7|\xa2\xb5\x1d\xa7\xe3t\xba\xa3Q\xcf|\xadu\xfaN\xdb\xe9\xf4\x87\xa3\xc2\xc3\xcd\xed\r\xe0 8n\xe1\r\xe7\xbapN\xda#8>\xc6sn\xe0\xf6GN\xb7\xe3\xf4͗]\x17\x9e\a}\xa7[x\xe3\xf5F\x9d\xfe\b\x97\xd5|\xed\r:\x1d\xd7E\xa6\xb8\xf1\xf0\x83{\xb0\xd3s\x01\x05\xe6o@\x98Yo\xd4v`\xbe\x9d\xce\xc8\xed\xf5G}\xb7\v\xe8r\xd8\x19vF\xb8\xc9n\xaf\xdd\x1f\xb4\a.\xed\xf5\xfb\xed\xa1\x03'\xa23\xea:\xeep\xd0w\xfa\xb4\xd3\xedu\x9d\xfe\xa8\xd7\xe9Ү\xeb\x0e\xfb\x1d\a\x8av\x1d\xc7i\xc3!m#\xb6s\x870X\x17\xae\xe8ΰ\xd7\xeb\xc2z\xc1\v\x05\xde\xde\x03@\x8c\xbd\xf6\x00\x00n\x04\xc8\n\xf6\xab\xedtF\xa3\x8e\xe3\xf4i\xb7\xeftF\x9dv\x1f\xe7\xd2o\x03`\xd0\xfe\xb0\xd7E\xae4\xed\r\a\xddQ\x7f\xd8\xe9\xd3a\xaf\xedv\xdb#\x04\xc0a\x7f0j\x0f\xc5q\x00\"\x06'\xda\xef\xf5\xba\x001\xb0\xe8}\xc7q\x00\x11\xb6\xe1\x8c\xc1u\xd2\aj\t\x10ew\x00\xe8\xd1\x19\r{=\x17\xae\x98\x9e\xe3\x02\x94w\x87\xb4\xdb\xeet{\x0e\x1c5\xea\xf6\x9d\x81\xdb\x1f\x8c\xdc\x1e\xc5\xfbc\xd0\xed\xc3m\xe4\xf6\x81\xa3\x0e0\xd8\xee\xf6\x01\x1f\x8c\xdc\xd9WQqq\x15\x15\xf7\x1f\xf1\xcc\xce=\x89\x8a\xb7\xf0\x10\x1eF]\xf9Lʟݙ\xf1\n.<\xb3K\xf5\xdd.>\xac\x86\xf9S9\x13Oela\x84-t\x8a/\xedr\vP\xa3\xa3\xde\xe6X\xbf-\x9fچ\\e\xf1\xd8z\xf2\xfd\x9e\x95F\xbe>\xac\xaf\x9f\x84b\xea8֎踟W\xbc~tE\xc9xȐ\xf1\x907pS\xf4\xde\n\xaa\x13\xa7Pϙ\xb8\x9e\xf35\x84\xfa!3aU\xc1MXU\xb0\x13V\x15\xfc\x84U\x05CaU\xc1QX\x1d\xb2\x14\x0ex\nH\xa8w\x9d\xe1\xa8\xdd\xe9\r\xdaNN\xa8\x03\xa6\x1b\x0e:\xbdQ/\xa7Ի\xed\x81\xeb\x0ez\x83v''\xd5\xddި7\x00\x82a\x94\x93\xea\xed\x91;\xe8\xf5{n\xc7 \xd5\a\xed^\xcfu]M\xe5_Csn\xaf3\x1c\xf5\xba\x03\x93R\x1f8\x9dN\xdb\x19})\xc7C\xf3;\x92\x9c߱2\x98\x1fW+\x83\xfb1_\x19\xec\x8f\xc5\xca\xe0\x7f\xf0\x95\xc1\x00Y\xae\xe8\x9d\xfe\xb8^\xd1+\xfd\xb1Z\xd1ۼ\x1f\xb0\xfe\xd3\xfd\x84\xf4S\xdeOH/\xf3~B\xfa>\xef'\xa4\xcf\xf2~B\xfa1\xef'\xa4\xcf\xf3~B\xfa\x869\xe37\xa7\x9d\xf6\xf8M\x83\xb5I6}s\xc8`yCh6}\xd3p+r\x1a]\xc9|ys\xea\xf6\x1d\xd1\x06\xd2\xf5\xbf\xb0l\xfa\xa6\xd9qf\xf4\x85\xfc\x05ο^\xb3\x95\xfd\v}A\xe8\a\xb6\xb0_\xd0_\b}\xcbֶ(\xdbUE\xbb\rwF\xe8;v-J\xbc\xc2D\x90\xdc~/\x7fAK/E\xab\xed\x19\xfdN\xfe\x82\xd4\x1f؇\xc6\xf7;\x87\xfe\xc6^7^5n\xec\x1f\xe8\a\xe0\xba\xfc\xc6\xec\xdf\xd8o\x8d\xb7\x90\xc2~h\xbc\xdb9\xf4\x1d\xd99\xa4\xf1R\xa6|\xb7s\xe8w\x04x\x1d0\xff\xdf\xd4t\x7fث\xfd\xff\x919\xe3\x1fq\x8a?\xe2\x14\x7fc\xd9\xf4G\xe8/\x9b\xfe\xd8p\x85w\xcd_\xd9\xdcF\xc3zB\xff\xc4\xe6\xf6-=\xa7\x9f\b\xfd\x86m\xec\x84\xde\x12\xfa\x13\xdbط4!\xf4\x0fli\xa7\xf4=\xa1\xffĖ\xf6{\x9a\x12\xfaG\x16@k\xbf\x87?0\x8d\x7fa[;\xa5!\xbd#\xf4/lk\xbf\xa7\xcf\xe8GB\xff\x99=o\xfc\xd3Ρ\x7ffW\x8d?4n\xec\x7f\xa6\xcfar\x7ff6\xfe\xf7\xe7ƿ@\"\xfb\xe7\xc6_v\x0e\xfd\v\xce\xef\x8f2\xe5\xf7;\x87\xfe\x1eS~\x93)?\xec\x1c\xfa\x03T\x87\x91s\xce~j\xfc\t\xa6\xcf\xd97\x8d_\x1b76\xe7\xf4'Ƚbw\xf49\xfbH\xef\x18\xc0\xd03\xe0Y\xd1g\xec=\x9a\f\xfe\xb9qc\xbfg\x97\x8d\x7f\xde9\xf4\x12\x16\x0f\x98X\x97\xec\x132\xb2>\xb1s\x1a\xb1\x04\xecVi\xc2\xfe\xdc\xc8x\xe3ƾe\xff\xdc\xe0\xc0z\xfag\x92\xf3\xb8B\xa6~\x00\xe7+\xc7\x10\xeaG\xe3\\\xa7\xceU\xea<l|ҩ\v\x95\xba\b\x1b\x97:\x95\xabT\x1e6\xde\xebԥJ]\x86\x8dg:\xf5Z\xa5^\x87\x8d\x8f:u\xa5RWa\xe3\xb9N\xf5Wj\xbc\xabFҸ\xb1\xf5\x19\xbd%\xf9\xe8U\x99\xabU#\xd2e\xaeBz\x9e\x97\x99\xab2\xf3U#\xd6e\xe6!\xfd\x94\x97Y\xa82\x8bU\xc3\xd7e\x16\xa1Xn\x8d\xaeՏF\xaa\xcb\xf0\x90\xbe\xcf\xcb,U\x99\xe5\xaa\x11\xea2ː>\xcb\xcb\\\xab2\u05ebƝ.s\x1dҏy\x99\x95*\xb3Z5\xaet\x99U\x88\x80\xf8\x15<\xc1~\xf7\xbf!\xd7C\xa5_\xaf4tѮ\xd1\xefj\xa5\xe1\v$'\a\\\x92\x0e\xbc\">kb\xe2\xb4]G\xb2L\xdcQ[\xfa?\x1d8\xfd\x8e\v\\\xc4ȶ~\xa7\xa3\n\xfc\x0e\xad\x16 \xe9'\xee\x7f|\xe5o~gA\xdc\n\x1abZ\xfe\xbde\xb1m\xc9\"\xc6N^\xf3L\xe4ϫ\xf3S\x95\xbf\xa9\xce_\xf9\xa9\xc8_B\xfe\x91\xb6W\x87y\xba\xdd\xc5a\x9ens]RH\xd6\n\xc64b\\\x19S\xda\t\x8bZ`uG\xc6\x11K\bF=\xf8\xc8\xef0 \x82R\x81\xc4|\x96\xe0\x1f*\xfe0.\xbe\xb8̣ɾ*V\x81\x04m\xa1\xc2\xc3\xee\xfd4\xe5Iч<hkF0f\x9b\x13Ci3\xb0\xad\xf7\xc1\x82\xd7\xe6\xc2\xc4\x1f=7\xa0\xf2&\xd82\xfa\x01\xf8\x8c\xf4m.=\x9f\xe7\r\n\xef\xe4i\x1d#0\x1c\xa8\xaaG\xa6\xbb\x93<UDB\xe4j\xba[\fL\xa1c\x0e\noLz1\x96vf\xe6j\xe5\xf8*\x17\xf4\x82\x8aց\x1f\xc0_\xbb\xf0\b\x0fg\x14|\xe0\xfa\xe9\xdfw\xe8\x9b\a\x87\xbex\xd4\xd0U@O1x1\xd4q\xee\xe7$-,7\x8d\xc9\xfd\x97\x0eybs\b\xad\x88\xaf\xbe\x94Px\x89A;\xc4\v'\x18#U\x98\n\x84\x84\xae\xecL\xe6\xd8\t\x06\xa1D_\xf8\xf7{\n \x87\x81\xb1\xf6\x84\x16}h)/\x05r\xe5\xa3I$V\x9c\x81a;B*\xb6\x91\x89&D\x92rܸ\xdfK\n\x85\xec\xb5\xcb\xe5\b\"s\xb4\x87\x1dӬ-\a\xf3X\xa3\x1cw\xe0\x0e\a\xa4u~ã\xec|\x1ddY\xd1\xee\x80\xdcG\xf9\x83\x95\xec\x15W\u05ce\x01}ǭw\xdc_\x80q\b`\xa9Qw\xe8B\xdaOҬ\x05Tu\xba\xed\xf6\bҾ\xdfnB\xfe\t\xf4\x87\xfb\x83^\aR>$~\x94\x82k!\xa8\xda\xed;\xe0\xf6\xb9\xf5\xd6O\xd3\x0f\xab$\xde^\xaf\xd0\xd9\xf3\xa0\x8d\xc9\xcb \n\xd2\x15_@Z\x1f\x11dk\x13l8F\x86I\xec\xdeh\xd4\xedC\xda\xfb,\xe1\xfe\x1aDnf\xe8\x9a`S\x1d\xb3\x06&d\xce4SW\x14\x8c\xbd^?\x11!\xdc!\x81\xdb\x19\xc1\x88\x05`c\xab\x7f\xd8\xc6\xd3χ\xd7\x1b\x12Ѣr\x82\x013\xd6\xc6/\x9b\xec\x93V\x1c\xd9\"&%\x10\x93\\|&~\x10Yԇ\xef\xcb }\x9f-\x82x\xb7\xcbd\xff\xe8\x85\x12\x00\b\xcb\xf2ha\x01])\xbeTt\\i\x10\x10\x14\x9c\x19\xa4\xe04d\xb7\xb3\x030\xca\xe4\xd2/\xf1\xbe\xc0\x9e\xd0ٕvg\v\x9efI|W\xaf\xeb\x9f6)\xabG\x06K{n\x13\nj\x8d\x11\x06\x9a\xe6\x11O\x9e\xc7\xdb(\x93\x16\x8c\xe8\x8a\xc5Rȑ\x9b<\f\xb1^\x10\xcd륬XX\x9a\x83,\xbdL\x87\xd5\xf2e)\xe7\xe8%\xaa\xaa\x85c\xa3۪\xde\xf2\xbc#\xbd\xcd\x1f\xe8m^բ\xce\xdb\xebh<qdv\xc4K\xdfƎ\xcfK;>W\xa5\xcdo\xbe\x0e2\xdb\x02`\xb7h\x82^[\x80\x9b\xdb\xeb|\x9e\xe20\xc511\x03#\xbf\xf3h\x1eCp\xbcݮ\u009c\xdd\xe6̲\x1a\x1c̅K\xc1\xb5e\xa4\x1e\xfeIF\xe1AS!\xfd\xb3\xa9~\xfb\xe9<\b\xe4\xef\xab \xf2\x93;\xf5᧼\xdfU5\xe6i;\xff\xd9l\xe7\r\xb9\xfd\x90\xe7_M\xe33\xf1ou\xa4\x1eG[W\xeb\v\xa0\x18JGZ\xf5\xe8@C\"О\x98x\x85\xf1O\xf9\xe2W\x17\x97\x98\xa5V%\xc8\xc6\xda\xf3\xbb4\x1b\xafX\x86Bͯ\x99m\xde\x00~\x8b6B?\v\"\xb7\xb4\xb0\xb2\xa4\xcc\x1b\x1f\xae\xb3\xb9\x1b\xb8u\xdabN\xad\x9fq\x0f\x8f9\xb3q\xf3\x8b;O3\x86Qw\xb8\b0Q\x8e\x89\x95A@\x7f\x03\xac\x18c\xf1nw\x12\x03\x11t\x18U\xe2\xc7\xe8c\x14\xdfF5\xb5\x17\xe0\xfb\x8e\xe7\xb1\xd1v;\xbe\xd76o\xaaL\xbe\xd2b}0\x13b,\xb2P\x15\\\xb0-\xcdX\xd7\xf4\xb2,6\x06\xf3\xc1\xb4꥟f,-\x17R\x8b\x9579ϛ\xdc\xd0C\a\x0e&\x13\x0f/\x0f\xb6\xa4\xe0\x1d\xc7ֵV\xd2\xc0)\xf4\xd3\xec5\xe7\v\xe6P\xfd\xfd!\xce\xfc\xd0L@/\xa9Q\xe1y\x95\x91R\x1c\x18\xad\x85\xce\xdc\xf6`\xe2x\xfc\xec\xac\xc7X\x7f҆_]\xc6\xdc\xee\xa4\x03?;\x8cu\x9cI\x17~\xf6\x19kO\x9a\xae\xd7l\x97B\x9f\x18\\1=\x9efa\xb84ae\xca\x05B\x99\xb5\x87'\xccvG\xedz\x06\x0e\x90\xb4\xe1\xa59K\xeb\xff\xf7\xff\xfd\xffXc\f\x06\xacR\xcf\\\x88\xfc'\xc3\x02\xb9\a-\xb9\x95-\xb9\x95-\xb5\x8d\x96\xda\xf5\xba\xd9L\xbb\xb2\x99\xb6hf\xbf\x17\xb7U\x0edڙ\x11x\x15*\xcc\xfdT\x1b0N0r\xe0\xe6\xce\xd6\xf9\xb0S4\xa3\x0e-\xd4 ŭ\xccM\xd4\n\x00L\xcb  \x9c-\x1c\xe9\x80\xeb\x90\xed9`\xa9\xfe\x9az\x84\xc5\xfb^\x85^\xb3Uv3#\xbfk3\xe6\xe4N\x96\xf4\xd0\xf4A\x02B\x14\xc9m%\xd37\xdda\xaahNM\x17\vEg\xac\xd7k\x8f\xfa\xf5zt\xcaz\xfd\x8e;\"\xe6Q0V\xbd\x04\xec\xdd\xe2\n\x81\xc7+>գl\xcfJ\xd9n!\u06dd\xd1DZ\x81;\xb4\xe9\xe6\xd7뾲s\xb7\xdcy\xfb\xc1\xce\xdd\x19\xad^\x16\x9a\x17)\x13E\xe2\x00\xf1z]\x15\x99\xe4\xa8\x00\xec\x12-\x84\xdb\"\x8c\x18\x14\xe9\xb1S\xa7\x11`\xe3\b<\xe5\xa3shR\x15\xd9hn\x12\xbf\x050\xe8\x8c\r\x03\x95dbNY\xa2?\x9a\x11\xaf8f\xd6i&\xe5\xc5\xecP\x17\x1bxxI=\xfbo\xdaoB+Ǘ\xefHB\xcaꦏؒ*h\x99\x1c_kխC;\xc5m\"^V\xa9\xabZ\xe3GN~\xb5nj\xed\xa1\xc1\xee\xb3\x16\xea\x16\x1a\x8f\x1cy\xd1\x14i\x14\a\x1f0\x85\xf0z\x96\x0elY\x01\x84\xdaE\x9c\b\xbb_\xb8\x18\xf1\xae\xd6m$\xacP\x95\x96\xae3\xf1N\xc7\x10H\xf24\x9e\xe6\x93Q\xab\nw)z\x1e\xf3ʟ\xbb\x9de\xed\vӃ[\xf3\x90$\xfb\x9a\rE\x8c\xefe\xc5波\xe7\xa6\xfc\x94T\xbf\xd03\r\x8c\x88\xf7N57\u0091a\xbe\x02;\x9bF3\x19\x82\x8f9\xa4\x10vϸ\x80\xe2&\xbc\x97\xa1X\xb3\x19\x9d&\xbb]\x13L\xc2\xe2\xbc9\xc0\u05fa9\xf2pS\xed\xbf\xa9),=\x89\x99\xe3\x15\x1a\x85\x97\xbaF\x0e{#\xbe+Z*\x17\xc0\xe7\x10Υ\xaf\x80\x8c\x8cuI\x81'\x92\xb1\xb2\x90\x96\vi'\xa5S\x94\xab\x94V\\\x81\x8e\xe0\x1c\x1f\xf4\x83\x8c)s_5UW:\x18G\xaet\xf2P\xa7\x0fRD\x7f\xa7;\x7f\xfc5=\xeb\xd1\xd3#t\x00\xf0\x81\xe0\x91W\xe5m\x02=\x1a\u1aef\xecq\xc2/1^\xc1o,'\x10?\x99\x81\x03\x96\x02\x05ZPG\x88\xe5\xf7>F\x0f\x11\xf5z,\x88W\xfdCP\xb1\xa5\xcf\xf7a|;\xc9\xd9S\x91g\xfbv\x84\x01{\xe5\xc8X@h\x90\xef-\x93>XD\f:\xdb`\xf8\x10\xea\xdb1\xc5\xd2\xd0\x7f\x15\xadZ\xf6\xfdu,j\xef3\xe9`J8\x04\x00\x16\xee\x15\xaf\xf95Y\x9b\x8c\xcb3\xa6R\xf9\xf3\xa1>O\xbe\xa8\xcfb\x7fb\xdb\xe0\xddt@\xa9f\x93\x03\x9f\x1d\xc9$\xc2\x13\x80\x91X=\xf5[\xffr\b\x8d\xf4\x88\xc5&\x94\xcf\xc9\xdfc\xcc\xf9\x1a\x91Ro\xb0\xe5\xff\x81=F-\xe8@\x00\x0ft\xbe\xa7m\xd0;\xfbL\xac\xde\xdf\xfa\x18\xabwM\xaf\x7f\xc2\x1f\xd74\x9a\xe3\x8f;\xfaM\x82?\xae\xe8\x1fE<\xdf[\x1d\xcf7\xb1;\xa0\x85(\"\x85\x02;\\\xe8~;\xddA\x17u\xbf[\x91탬&\xb1\xdb\x03\xa7\xdb\x01\x11\r\xa4\xa5 \x9bI\xec\xce\xc8i\xb7A\f\x03i[\x90\xb7$vo\xe8\xbaC\x10\xad@\xda\x06\xe4(\x15\xf1\x1ed\x98\x03\xe3\x1e\xcb\x1f\xe5\x82g\vk\xbe\xa8\xaaj\x04\x8a\x98\xfe|q;{z\x1dP\xeb\xd2\"\x06\x8bdm\\\xb4\xadx\xc3#\x7f\x13(f\xfaIV\xaf\x876\xb13\"\xfaɨձȁ*\x8d\xb8D\xcb\xf1-\xe1q\xa6\xe1VgB\xd0(\xf3ó,\x1a\x1d\xd6\xedT\xd6\xed\x98u;\xe0k\vq\xdaM\xfb͆\v\xd7x/\x16\xcf\xe3\xf5\xc6ς\xab\x00\x02\x18C<r\xbc\xc3\xc0'\x91\xe4\xfe\x83c@\x9b\xd8:\b5z\xa4\x95\x0eG0.\xbdn\n\b\x14\x92\xaf\xe0E\xfa\xf4\x9aZ9\xe4\xf9\x8a(Y\x14\xab\x11\xef\x06e\xa1\xf7\x0f\x8fˋ\xf7\x87JEJ\xf8\xf5\x95\x8b\xf9\xe8\x05\x89\xc9}\x1e\xd7bn\x13\xdbg\x96\xa5b\be%\xb6\x0f@\x8c\x84\x00\x9frcE\xa6\x17\xe9\xc9?\xfe\xc37\xbf\xfb\xb9\xfe\xadM.\x1blz\x7f1ۏ\xbdӳ]\xeb\xe9\x84^\\<\xb1\xac\xe6\f\x16\xedR\xaf\x9a\x9d\xb2t\xb7\x83\x1e\x03\xa3G.C\x8d\xc3\xe5\xe9\x16:\fhF\x8c\x1em\xfb\x92ܷ\xe9\x9e\xc8V\xf3\x9c\x9f\xedK\xf2\xad\xd8 \xa3\xf8\xf4rF\xbe\xfdF$\xab\xb7\x12t\x9e\x18\x9d\xaf쌨\xee\x12\xba@\x99\x9dޗ;c_\xc6\x0f4@\xad\xa6\x957\u008d\x16\xae\x8a\xeab@\xccn\xfcl\x95N*\x83\x81W\\)\x95\xc0\xbb\u06dd\xc8v\x0e3E\xfa!|GLfi6f\f\xd7}Dԧ\x8f\x9f\x10m\x1bp\xf4\xdbg\uf7bd:\xffp\xfe\xee=\xb4췲\xf8\xc7\xcd&\xe7\x02\v\xb7\x9cPz\xea\xa3K\x9b\xd4\xf4\xfd\x86#Ie\xb1\x90ݧ\x1b>\xf78\x85\xee_\xfbk\xf4\xdcƳU\xbc\xf0J\xedR}\x90\xbc\x14\x82yfv(x\x13\xf5\xfa\x96h߹r+\xf7\xb8J'\x0e\x11A\xc7\xe1\xd3.\xbf\x1f\x129i\xe8\x95\xc2\n\x88~ѻ\xb6\xee\vqD|\xb0\x92\xb1\x11u\\ \x88\xd8<\xe8\x12\x1e\xa6\xd7v\x8c\xaa\x0f\xf4\x0eE\x91ԟ\xb5\xd2x\xcd+\xe3Hs\xe1~3\x83\xb03\x84xEoط&.\x86\x15\xa3\t\xcbĖ\x81lz\x8f\xc3ĸ\xea\xdf|\x13\xa1\x03_\x88\x05\x9b;?+\xec\xa3|Ǥp\v\xc9\x1d\x12\x02\xf5\x10\xe2\xcc\x11;eS5]C44\x930\x9cRX\x80PE\x89\u07b2\xb0\xb5\xf1\x13\x7f\xcd3\x9e\xa4ft\r\xb5\xcc4e\xe1\x94\xebn\x18\x1c\xf3\x93%\x9e\x94\x87\xfaI\xa8\x04\x14\xf5\xeaT\x9e\x80\xc5\vvî\xed\x94\x02ց\x867\xe4>\x9anf\x13\xf8G\xf8WL\x89\a\x1f\f\\\x9f\vO\x0f\xf0\teW9\xb7q\xd5Z\xc6ɹ\x0fB\x8d\x8a\xd7ߘ\xb7./\xe3$\xb8\x0e\"?4\xf0&;\x92\xbe\xdb\x15\xb0=-|\xb12n\xd8\xe4H\x01\xfc\x82\xed\x8dP8\x1a\x9f\xa7\x85\xdbC\xfa\xa7X\x81\xfb\xf8ő\xa1-\x8e\r\xad\xd0\x14]\x14\x86\xb6\x11\x91V\xf2m\x040\x97۷\x06\a\xea7\x00b\xdaX\x12`(#\xd6&\x89\x17\xdb9\xc7\xc2w\xf5:\xec\x0f\xc89\xf5w\xca\xe7\xdb$\xc8\xee\xf0{\xb7\xb3o\xa6w3\x96M\xefft\xad\xe2S\xe2\xdeA\xf8\xe9\x1b\x03\x86\xd8\xd6(@\xd7\xeau#\xcc2\xe9-\x8bmb\xaf\x89vG~\xdbJm2>\xb1\xaf\xd8m+\xb2\ti-\xe2\x88K\xc7\xe2\xe7\xecJ\xc8\xd0\xf5\xe0?\xc1\xe0\xcf\x01\x8b\xa5\xd3O3Ar\x1a\xf3f\x8c}\x12U/\xe9{\xec\xe9\x1c\x8a\x8dUl\x98g\x87\x9aO\x97\xb2\ah\xaf|\xba3#\xe0E\x84\xb1I\xc4_\xe4\xcb\b\x87\x88Y뛄/\xebu\xf1\x173\xe0\x878\xca*'ϒy\xf0\xb1'd\xb7\xc3^\xa5CQ\xb1E\xef\xe5z\\\xb2\xf7\xe6z<\xb3\x8d`\x0f\xef[\x1c\xca+\xa7\xb9\xef[K\x88\xd9#\xb86\xd0\"\x83Y\xef\xf3\xf2\xb7\xc5\xf2\xb7\xb2|\x0e\x13+X\u0590l\xec\x15\xc9\x1d\xfb\x17p\x11\x8a\xa1\xf7{\xea\x82q@)\xdc\xda\xc1\x9a.\xe29\x92.\xa0G$\u008bc\xae \xd8Z\x89\x1f]s\x942\x93C\xbf\xc1\xf9\x98\xb2\xbc\x19\x7f\x9e\x057\xfc\\\xf8r\xa3\t\x80tĜqtj6&B\xb7\xcbń\x9e\xdfAֳ̎\x88\xf6)\x9d\xb52\xff\x1a.\x8c\U0009d1e2\xa2\x17\xaf\xdf\xfe\xf8A\x8a\xd2>\x9c\xff\xf9ów\xe7\xcf,/k]\x85\xdb\xc4&\a~\xbe\x11\xc5\x1b\xf48\b\x8c\x9f\x85!v\x9bچz\b\xb9\xb7\x9e\xfb\t\xc7ۓcD\xcdz\xbd\xaa\x829\x9b\xdd.\xa9@n\xa8\xe2\xe0/\x16X\x05\xe4H\x84\xd0\f@l\x19Ϸ)n*\xd8،\xc4\x0e\xa9\v\xa7ʍ\x9d\xe1b.\x11\xb0'3\xf1S\x98>\b\xf5\x15C\xea_\xe2\xa3M\xc1!\xef\x14\x02\xb6\x9d8e\x17\xd05noD\xb9%K&\xb1\xbd!\x18\xe9\xfd\x1e\xde3\xe0\x87\x94\xddG@*/)\xfc\xb9\xf46H@xS\x1d\xee2\"t\xe3'<ʼ`\x1a\x18\xec|\x91\x98z\x01\x05e\x9aH\x8a\x0e\x9a.\x81\xf8\xc9A\xfa.\x8e3O\xea3`\x1d\x1a\xa2\xc7m\xfd9\x97\x9ei\xf1\x82\xa6B\xb3\xdc+^\x1e\xeb\x96h\a⢴D\x7f-\x18\xe5t\r\xdai3\xc6\x01\xabA\x02\xe3\xb0\xf8\x10\fڅw\x1az\xea-(\x98\x89\xa4ZU+\x94\xe75\x05$\x14j\xa6Ůɤ\xf0\xd9J78ml\x8a\xba\xc4{dG\x1f\xf9]*&~ŗqR\xecr\xd5\x12\x89\x8c\xef)\x06h.\xe5b\x1adn\x0e*nD\xadM\x9cf\xe5\x9c8\xcd +\xcd\xe2\x8dgƻ\xc2`4\xe8\x13\xd2L\x86q\xee\x0554W\xc8a=6\x9e\xa5\x02\xe3\x97ս\xc4vhg\xb0\xe2\x136\x12&\\\xaf\x8b\xefK\xa6rpc!\x8b\xf9\xb6,\v[\x1a\xa4/\xb9\xbfd\x0ec\"W\xc5hQ\b\x893g\xccO\x150\x8ey\xa3\x01\xf7P0\xe53\xdd\xfe\x06:U \xc6 K`\r\x15\x82G\xf5\x01'@\x8c\x00ix\x18D&2T\x11\x04\xb1\f\xc0P\xa4\xc1\xaf\xfd\xb5\xad\xa2E\x8b\x10&\xf6ZB\xe2!?\xe9\x06\xa6-\xc0;\xff\x051x\xd46\xd7\xeb\xeaW\xa9)\xba\x98\xd89\xb9\\XaA\x8d\x1b\xeb\x98O\x16t\x91\x04\xee]\x13zm\x13\x1a\xca56\b\xf7\fT\xf6\"Q*\x83\x91l\xc406\xe51\x80\xf7\xe5L\xf9\n\xfd\xffs\xf7g\xebm\x1b[\xc30x\xd8\x17\xd1'\x14\xb67\x8d\n\x8b\x14\a\x8d\xa0\xca|=ȉ\xb2=Ų\xe3$\x14\xa3@d\x91\x84\r\x02\fP\xd4\x10\x91\xdf\xd3'\xdd'}\xd6W\xd0\xd7җ\xf2_I?kՀ\x02\bJ\xb2\x93\xf7\xfd\xbf\xee<{[D\xa1P\xe3\xaaUk^\xdc5\x85\xa4\v܂\xaa\x8b\x85\x14\f\xbb\xb2\n\xccWs\xf0a\x19Ar\x1c\x17v\xb3\xde\xc2w\x98 \x02\xb6:\xa6\x12Hq\x1cqj\xd6\xd5'42\xa9\xaai \x7f\x12\xaa\x8eA\xb5\xaa~\x14\xd7nF\xbc\x19\x987\xe0\xe3\xca\x0e\xff[\x0e\xb9\x19\xd0\x1a{\x16\xa0m\xd0 \x15R\x82k\x92\xd2\xc4\xe9\x86l|\x03Ɇa\x1d@\xe0P&\xaf<H \xd03\xbf\\\xe2\xf1\x8c*]\xc7\xfe\xa6\xd1\xf7|r|=7ͮ\xb2\xa6\xe5\x1b\xf7a͠l\xaf\xd8ʭ\x8a\x06\xef\xf1\x86\xfa\xb5zH[\xcf\xe28\xe4~T6&\xf5ꁃ\x92!\xa7\xcbڑo\x1e،\x94Օ5c\"֚fr\xa2m\x13m|\xc2\xc5;-\xe9{;&\xa2 \x01/\xad\x05\xbd\x98fy\xc3JN\xc0\x98\xfa\x1e\x96x\x95\xc5\xffMX\xaeZ\xb5\x9a{\xccd\x8d\xc0윟\xe3\xe3\xf9\xf9ry\x9bK\xf4\x05dX\x94\xd5e\t\x95S\x8dL\x92\x1b\xd7w9\xb1\x8evBn3\xd5\x02\xb1bf\xf3U\x92\xb7+\xbfˍ\v\t\x0e\x8azG\xa3p\xec&\xb5\x9a&8\xa0u\xa9\xb9Z.\xb7\x16Zt\t\x96ȂI̧Э`\xa0&\xcbt\xe84\xc9\x1b\xa9\xff\xb7\f\xc2H\x14r\xbdo5\xf3\xbd\xa7\\l\xb2\x8d\xb7\xfb\xd7\xf4\xad\xd1TFz\x04*\xfb\xd9Bs\x9f1\x01\xfb\xd2~<\x80\xa0\xeb4a\xf0S\xcf<\xe9C\xdd\x01\x13\xb4\xb0\x063\x7f^J\x0e\xba\xd6\x108\b`\xf2\x9f)\x8a\xb4\xec\xd3\xecCVl\xa5EhV\x90o0\xe1\xc0\x83\x96\xean\xc1\x14\xa1(-\xa5\x91\xb6N\xc0\xb6<\x91S\x13\x97\xd2\xcb\xf8Fޟ\xd5*\\S\x11\xe3\x96\ao\x04bH\x80\xd8(?0\x94ͬs6\xfd\xc1\xfd=\xea\x04vP\x01\x9a\xc1\xe6\v\xf3\x86\xeb\xe1\x1fh\x1e/\x9d\x92\xe6\x87a\x1c\xf1\xb2橰\xfa\xb0H\xfe(\x03À5\xbbA\x06\xf9\x81$s0S\x05c\x91\xb1\t\xea\a\x83Ҙ\xfc\x91\xb9\xd1\"-J\x8c\xdd\xc8R\b\xe3\xe8#P\f*A\x0f\x05l\x12\x91\xbc\x84/\xed\xf3\x01K\\\x88\xaa-'(o`*\xd4\xdfte\xcc\xe4-h#+E4(l\n\x17\x7f\xde\bV\x1e\xf4\xfe\xa0[T\x8c\xca\xd1$f\xa0\xc2b\x89l#=ղ\xad\xb4Qz\x1c\xad\xb1\x91\x93\xcee#ȏ\xa1p\xb5`\r\xbcY\xeeo\x9b\x86\x85\xb3\x82\xf7\x82\x82\x93L\xff\xad\n \x89b\x86]\xd6\x10\x9a\xc0\xa4\x1d\x14\xa5\xda]\u0604\xc4\xd6\xc2\xe6\xb6C\xc0v\xd8P\xa89@ɀ\xc9\xf1\x99\xd3J[\xa8\x93F\xde2sB\x89\x81R\x96\xe9\x7fbP\xf5\xaf\x94\xe1\xfbB\xcf{\xea\xa7o\xaf\"\x9d\x8fc\xb9\xcc\xcfT\xef\v\xee\x17df\xdf\xd9\xdd۹\xd7:\x1aR8I\x7f\xac\xdd\xfdV\x1bC\xd8l\xff\xde?\xbbn6\xebg\xd7\xed\xe6٢\xd9\xf4\x9bg\x8b\xd6\xdeA\xf3l\xd1nB1\xfc\xf1\xe1\xdf\xf6\x01\xfe{\x88\xff\x8e\xe1\xdf\xdd\xf1٢\xd3l6\xcf\x16c>\x1e\x0fj\xdb4`\xdb\xfd\xb3\xe8,9\x13\xa0\x0fI\xa1\xf1\xa7\xf5\xdf\xfc\xfa_\x03\xf5\xb7Y?\xac\xd5\x1b\x83Ｓ\xed\xb3\xedm\xc83\ue74dj\x8f\xb6\xe9\x82m\xff\xee\xf6\xb1\xa6\xac֨\xd5\a\xdfy\xa4\xe7BM\xc8\x1d~v\xb6=\xa8\xe1\x8fӳt\xf0\x1d\xd9\x0e\xe8\x10:\xf0\xeb\x7f=\xad\xff6\xf0\xb6\xbb\xe5Q\xf9\xa4z\xcb\x18-dj\x13\x1f\xb5%\x92)\xef\xf7\x9d\x7f9\xd4\x01w@g@\xfbNϡΟ\v\x9e\xdc8\x03Z\xba\xf03WA\xe60\x0eI\xcfR@\x9e\x9d\x81\x1af\xdb!\x90\x0e\xa4\xefl;\xd4\x01\xb4\x87Y\x82\xa0\xe5\xffr\xa8\xe3/\xc4ԡ\xad\x01\xed\xbf\xf1\xdfPg\x1a\xa7¡\xf2ʦ-,\xdf\xf6ܳ\xd1w\xe4\xd16u\xe6qb\xbd\xb5\xbf\xc16\xed\xef\x06 S\x809x-\x8a\xa3\xf7Zֹ\x1d\x99\x13O#V\x9a\xc7Xf\"\xee\xc9?^f7ژ\xf4\x92\xc6\xc4+\xfb$\xe5\xe1\xb8\a\xff@F\xa0F\x18\x0fQ\f\x8b$T\f\xf2\r_Ut9\x03\x86I\x9aT_\x84\xf1\x85'\xe5?f\r\xe5\t\xb9t\x17\x11O\x87\xfe\x9c+\xfd\x0ȇ\xd0\xdbUF\xf8e\x96\x01\xccG\x95\x0e\x9e\x01\xfd9\x87\xbatJ\x94\f \xee\v\x8bm\xc8\xd46>\xb95\x9fr\x82\x7f\xa6˥\vՙ䭌\xedX\xdcHC?\x9d\xf2\xb4Zu\xcdo\x96\xca4\xfb\xbc1M\xf8\x98\x18\xa5[\xbc\xcak\x9ce\xb13\x0eB.'\f\xaeXbn~OE\xe1!5OW\xf6O\xf5{M'͙\xcb\x19\x80{\x06\xd6\x01\x805\xc5\xf4&\xb7+\x9dG\x9d-t\xaa}@E\xfd\x16(\x17Z\x83\xbc\x02\x14t\xd4>\xdbڊ\xfam\x88\x05\x02?:\x03\b\x04`4\xc1\xbd\xb4\xe7&\f\xde\xd7\xe0]-\xea\xef@\x05(P\x98\x14\xcb\xd5o\xf0\x16ӕ\x8b\xf5\x88\xa7\x9a\xb2[\xb1\xbe\x84W;\x03\x9a-\\\xdc\v\x9f\xb0v\xb5\xea&L[ȶ\t\xf1fnLz\xb2\xb2\x17\xf7\xfc\xb5\xf7\xf2\xa3\xdca\xc5:\xf0\x01\xa1\xb7\xbaЋ\xa9\xdaX\xcf_.\xa1Q\xfd\x8c\xe2G/\xa4\tO\x05x\xa6\xe55\xd9Ju\xb9q\x17\xb6\xf06\xb6\xf3\xf8\\f\xfaJ~e\x1a\x91\xf74\x05o\xfa\x05\x9d\xd2\x1bz\xc1\xc6&\x1bԕ>k\x82\x1e#Q\f\xb1\x15\xf0&\xb3\xf4xWժ>\x18\xf2\xc9M\x80Ε\x89\x91ib\xe7\xc87g7\xc1Z1\x88\xc9R\x90\x12\xb0-7e\x13\x891\xa9`#\xa90\xd6kT\xadn\xa5\x1a\xfc\xe9\xb1u\x10ԯ\xe5\xd2\a\xd9kVC\x7f\xc8R\xf3\x13\x14\x01\xd9oǡ\x9c\xa5\rXZ\xeaf[\x9dZ]\xbamT\x1cٛ\xb1\\\x0e\xd5\xd9\x03\x95A6\xa6jյ;\xca\x7ft\xd4^.\xb7fn6(B\x00\x10.\xfa\x9d\x01\xebo\xbb\x8d\xefȶ\x8d\xa7I\xf7\xfa\xe8B\x13\a\u05f5\x1aY_=w\xc1.@\x9b\xd2sC\xb6\x00q\xeb\r[\xf4[\x03\n\x9a\xc7\xde1h\x87\xb8\xb7f\xc4\x14\xf6\xfe\x97;e\xce\x7f\xc14Þ\xb4M\xc4\xf4\x83o\xc1G\xd4\xe3\x8d\xc0<\xc0\xf0\xd6,\xbb\x16`\n\xe1\xca֍\x9d\xf8\x94Pn\x1e\xa7\xb5\x05\xfa\ax\xf9Z\xb9:\xf0\tԘ\xb2Pc\x05\xe8\r?\x98\xc2\x1crU\xe5\x98\b\xa1\xf8\x1e\xfe\xc1\x9d^\xf4;\x83jU\xe0\xa3\xe3\xd0E\x7fg\xa0ۀ\x7f\n\xceT\xc4\xe3\f\xdcy\x8fQh\xe5\x1e7\xf0zb\x89\xfeE\b\xb5\xa1\xa7Zu\xb6\x01\x8e\x8f\xcd\x1d\x80\xa6\xfaO\x85\xdb\xc4uɿ\x83\x01\x80ј)\xc0٘\xa7u\x1a\xd1Adj\x88w\x8b*\x04\xbfW\xb8\xbcQ\xa6,\\\xf93\xb3Ƿ\f9\xcc{\x02l\x98\xe6\xc8b\x96\xf4#\x90\xcc\xfb\x90v\x18\x98\x87\xa8^\xef\x12\xa7\x01=&\xfdh\xd0K\xb4\xb8:\x02Q\xb5\xd3\xc8\u07b8\xb9W\x14X\r/\xa8V\xd1\xf0\x19\xfcz}\x06\xbe\xe4\x85J\xf5zf\xa8\x03\ue64b(\x9d\x06c\xe1\x02\xf6q\x1a\x8eL\x05\xee4\xe4/P\xa3 a\x0fo\x93\xc6\xe78\x88p\n+k\xb5\xa8\xb5\x8c\x84\u07b5\r\xb9\xe3\x94_qg۩e\x8f\x84F\xf02N\x84\x85\x15\x80O?n\x00\x19\xc3\xe4\x1f\xec\\Vc0\xbe\xe3\xc6\"\xe5\t6\aM\xa5\xe9U\x9c\x8c\x98\xe3\xd0\xe3\x06\xd0Oժ\vgI>\x98s\xe3x\x0e!=7\xf7-V\xb0\x0e\x8b\xf5\x12\x8dW\xf9\xc7\xf7'`=\x14G<\x12\ue22f\x15e\x1f\xc0)\xc8ƒkzZk\xe5^>\xb4i\xfd\x01\x9c\x91o\x18\x19\x8c\x00G\x05?\xac\x85\xeaem\xd5\x1cϩeo\xac^\xe0;\xa9_g\n\xfd\xca\xcd6跰\xc5r\xa3zYY\xcd\xd9Ɲ\x86bρ\xbb\x06\xb6\aH\"vl\x91ܫKK|v\x9bs\\\xb7Th2\x11V\xb7\xe0o(\xa9\xf0uT*2\xff(\xcc\xe6\x06\x9e\xe9\xfa*\x83\x8b\x8b\xfa\xc0\xa4\t\xdb\xf9\r)iO\x96\xd3\xc8\x15\xd4\xcf&\xd7\x03ݕ/\xe1\xd17\xf0\x88K\a\xce\"kod\xf3`&gu\xa0_\x9aN|\x04g\x18_\x8dAS\xf2\x19\x04\xf2\u061c(~l>\f\xe5\x15'H\xcf\x15Lhl\xe39D5\xc94矍\x88\tu\xa0\xe1\bx\xae\xfdB\x7fT\x18\xae\x9e\xbc\xe3e\v\xc1\x8aVq\xbe\xb9鷒\xdc\xc7\xfa\xb2TΖ\xc09I\xe7J\x95\x05=\xab\x81\x89a\x9cm\xc7s\xfe\xe5t\xe5\x043<\"\x13\x18ׄ'\xa4Vh}\xdb4\xb4\xaa\x9e4\x1c\xab\xb5*9(\"7Kd\xb6<\x9d]\xd9F\x14\xdd\xff\x95\xf6\\?;s\xc2`\x89\x94P\xab\xfcagѷ\xb1\x84\x9f!\x02\xddjZk\xe5\xca\x1fڪ\x8d \xbezP \xceӷۂ5\xbb\x8b\xa3\xb1&k\x16Z\x84:d\xe3\xfeb\xd0\x1d\xca\x1b\xdc\xef\x0f\xfb\xad\xc1\x80\xa9\xbf\x85{|e\x8cB\x11\xdfd\xa3\xeb\xf9y|\x93\xbd\xb1\x06M\xfdut\xe3\xe7Ѝo\xa3\x1b\t\xc2=\xbf\x80n\xfc\x1c\xba\xf1%\xba\xb1\xb2\xe5\x11ꯨ~\xcai`\xb9M\x06gZ'\f\x82\x11g)\x80I\xd7\x0e\x1f\x8f\xd7;\xf4H}\x96\x98\xa1t!\x05\xac\x9a\x80\x82e?s\xa2\x83u\xc4\x13\xaf\x83\x19\xf857\xb1&\x9adD\xf3\xcczAz0A\xcf2\xbeM\xcc\xda\xf5 \x17d\xf6H\x13\xb3\xc0\xd5*\xbc\x82E\xcfʀ|\x00:\x93xYYo\xbd\x9a\xa9\x95\xedG\x92ۏ\xc4ޏ\xadH\xd3e\x89\xb9\xdbU\xe7\xff\xe5\x10\ng\nH\x95~\x94Y(,\x97\n\x93%\x06\x1dAC\x89ă\xe0I-\x97\t\x06\x12ղf\xa9+ؚ05\x91\xb4b\x8f\xbb\xea\x17\xf1\xf4\x0f5\x8c\x9e\xa4\x03\rr\xe99=\aP\vP;\x80\xa1d5\xf9\x9b\xd0`\xb5\xa2\x97\r~-\x12\x7f(\xdei\x048\xa1\x97F\x8e\xc1F\xf4\xb2!\x92`\xf6\x8a\x8f\x05\x9b\xd3\xcbƟ)\x8b\xadHH\x97+\xba\xdb\xdem\xb5J/4p0O\x1a\xd1\f\x04/\x99\x14)&\xb7xw\x81\xb4\x1a|\x00(ڿ\xe8'%\xb1-\x99\xfb\x04\xc0f\xd2\xf5\x1b\x930\xbe\xf0A\xa4\xec\xc3\x11\x91\xc2\x1a\xfd\x04\x92\x18\tr4e\xed\xd6\xce\xfe\xce\x01D_\xa5!\xeb졌\xed:\xaa\u05f7Ap\xd6\xff\x1d$~\xf5\xb3\xeb\xfd\xe3\xc16\x9d\x83\xe0\xee\xba}\x8c\x02\xbd\xf6\xd9\xe2\xe5\xcb\xe61\xfc\xbb\xd7\x02Aޘ\xddƗ<\x19\x87\xf1\x95\xe7\xbcտ*A4_\x88J\xc4\xf9(\xad\\\x05#\x0e\xc2c\xc1'<I+\"\xae̓x\xc8\xd3ԡN\x14\x8b\xfa\x85\x9f\x06C\xc7sN\u0090O\xfcP}\xfb\x84U\x9a\xd7\a͊\x1bŢ\xe2W\xb0R\x05\x90Xe\x1e\a\x91 \x0eu\x82\xe8\xd2\x0f\x83Q\x1d?\x80\x06\xe4\xb3l\xc0Yѩ̪:\x0e\xe38\xa1#\xa6$\xc4\xe0+\xf3\\9\xf7\x16\x9c\x00\xa4\a\x06\xda\fI\xf7\x8b1JՋ\x92\x95\x8c'\xe0\x99\xba\x05\xb4\x05@\xcfG\xa0ݓ\x02c\x92E\xa8)\xb5rg\x86W\x803\x12\xb1̙/1\xa6\x9bp\x0e\x18\xb8\x8e\xd6@<\xc8\x19\xb8\x89\x12\x1a\xd5&\xae\xcbY&[\x9c\x03EO\fo\xd2\x001\x0fQ\x97~\xc3\xc9[s\xe7\xd2`\xe0\xc8!\U000a3572\xb6\x1bC\x8aZW0n{Aǵ\x1a\xb8\xd3)\xd7g\xa1\\\x9f\xab\xd5\xf8\xc8\xef\xed\xeeu\xdaM\xc6ܽ\x9d\xddV\x1b$\a\xeb_\x92\x9e2hp\xddV\xb3ݩ\n\x82\xf9Nj\xf2)!\xb5\xbd\xdd\xdd\xce\x1e\xf1\xdc\xcc\xee!\xae\u05c9g\x1eK\x16\xf3\xc2R7L\xcaL\x9f\x85\xb5\xa6\xfc\t\xf4\xb0+)\xae\x91\xeb\xf2:\x93]b\xf6\x8c*\fc\x89\x93\x03~\x18'\xb4ġqB\xf1\x03\xd4\x1f\xa9%\xb5W\xf4*o\xdd^k\xb7k\xfb\xbb߹\x1cB\xa6\xd6]\xb7\xb9\xc5`\xaav\xe0\xd6\xe3\xbcu\x98\x14\xd8p\x96\xf4\xa6.\xdf\xdeo6\t\xc4-hQ^cP H\x97?\xd9\xd9\xdd\xedF5\x16\x12\x8ee\x9d]\xb3\x1cS7\xaau\xf6\xbe\xe3\xdb.\xafu\x0el\xc3\xfd\xebL\xa8\x8b1\x93(\xf8:,\xe8\x90\xce阎\xe8D\x1a\xbc\x1a\x10\xbea\x90\xef\x03\x02\xf5^\xb3\xfd6\x8e\t7\xd2\x16D\xa0\x89\xffQ\x13\x85C\xe0V\x85\xbaפ[\xabE$\xb7\xe3\x11y\x02-\xc15a\x1dqB'\xda\xea0WW\xea^b\x96<i\xf6\x92Z\xcbkv\xe3\xa3K\x95#\xd9g74`-\xba`a7~\x82\xd6:n\xe1\xe0\x13\xea\xbaC\xe6\x8eJ\x80\xae\xbesp\xd4j\xf6F\xf5v\xdb\x1b\xd5\xf7v\x8f\xda{=\xf8\xeb\x8d\xea\x87\xfb\xf2\xe1p\xdf\v\xc9\x13\x16.\x97\xc3'S\xd7M\xeb7d; \xc8\xc3:\x1a\xb39\x84\xde\xd4\xd8\xf0\xbb\x80n\xb9\xc3#w\xce\x16G\xec\xba\xd7\xf2\x16O\xd8u\xad\xbd\xd7k\xefy\x8b\xfa5!\xdd\x05lQ\xf0d\xea\xa6\xdb\ue605\xf5\xf9zC\xc1wlܽf\xc7\xeeMݧ\x82M\xb4t\xb4\x05х|\b\xa8u\xb3-ȓ\xb4~U\xfc\xf2\xaa\xc6\xe4Kz\xf3o&\xe8Ds\xfb7\xb5\x1am\xd2+C\x80]\xb8\xb6\xaf\xf5\xf9]P0\xa1\x97\xf4\x82^S0\x02֪>\x10XbVp\r\x18\x02\x81\"A\x14\xb1ߦR\xedy٭\xd5\x02\xe2Nd\xa6\xfe#\xdc\xecS\xb9\xbb#w\xa2\xb64b1;\xd5\xcdĦ\x02@Q72[\xbc`ii\xa3O\x98\xa8V'G`6\xbd`\x13\xd9\xe2\xa2.\xe4.%d۽`Q\xad\xb5\xbe\xc2I\x8dA=\xf2\xdd\x05\x15l\x91k\x1a|\x83\xf5\x90E\xb5Z\xab%O\xd2\xe2\xe7\x13\xc6\x14\x8e\x1f\xb2\x84\xceY\xd8\xc5M\x1f\xb3\xf9\x11\xf3{-o\xfe\x84\xf9j\xd3\xe7u\x9f\x90\xee\x1c6\xfd\x9c\r\xebcz\xcd\xc2\xfa\x98\x9au\xb8rǵ\xf3\x7f_\xd3&!\xe0\xf66uϷ\xafI\xd7z=\x94\xaf|\x06\x11!/h\x04\x8e̸ҵZ\xb4\xaa\xd5\x12Z\xab\x19\x03\x91\xd3\f\xf7\x04\xec\xf6\x92')\xb8\x888\xadF\xa7\xd1v(\xc4\xd5\xf1n%\x99\xef\xddP\xc9\x02x\x17`m\x89\xbf\xaeu\xd19\x15\xf1\xd3\xd3\xe7''^\x89m\xc2e\x11\x89\xaar#V\xed9@%85\x80*\x0f,\xb3\x81\x9c\xfe\x18\x05\xd8\xf4W4\xb80\r^\xbbZ\xa0\xb8S\b\xb6\xa3:X\xd1\xccW?*\t\"\x1d\xac\xb4iIB\x05Eɯk\xf9ג\x95KV\xf4`w\x7f\xf7>\x15(Rk*\xf1b{\xb7\xd9&\xdd\\\xd43i\xa6\xa0IA4\x0e\xc5\"\xcd\x03g%\xc8\xffd\x8f\xc8\xcfg\x8f\xc8n\xe7\xdf\"\xd7f\x15A\xe4Q\xab\x03\xee'C\xbb@\nH\xad\x16\xb5l-_d\xb7\b|\x10<\xae\x84\x94\x83\xb0\v*\x1a\tO\xe3\xf0\xb2(\x045X\x04\fR\xc0\xb2E\xd7\x033\xea\xec+\xa9\x8a.\xff\x96\xf7ֿ\x96\xf5\xc1-W@+\x10\x0f\xcf\xcf\xdb8ō \x954\x9a\x8bbZ\xce.ЬK7j\xebJ\xfc\x1eWM\xb8\xc4\xf3\xf3\x8673_df\x00\xa2\xf11\t\x99\xafx-\xa5HV:\xe4\x9a\a\x9a\xe2\x94m{\xfdf\xfdp\xf0\xdd#\xd4:\xff\x8ej\xe5\x9e\xdb\xdb:\xdb&\xfd\xdf\xcfz\xa8Rv\xcfz\xfd\xdf\xf1W\x0fU\xd2}\xe7֡\xceʡ\xceҡ\xceٙC\x9d\xdf\x1d\xea\xfc\xe1\x18\xc3\xec\xbes\xe4P\xe7\x89C\x1f;\x8f\xe1\x05u*P3\x81\x7f\"\xf8G8\x03\xc0\n}\xe7q\xf6\x11z\xc5\xf6\x9d\x7f;\x14\xd5àk\xee:\xd4\xf9WVc\bβ}\xf3\xf6_\x0ehv\xb7\x7f\xef\xd7伞\xd6\x7f;\xaf\x0fn\x9bt\xaf\xb3z\xb4MG8\xe5\x92w\x04\x14\x1b\x8f\xb6\xc1\xbe\xfc\xb3\x7f\xe9\xa7\xc3$\x98\v\x0fb\xe4Y\x8f\x8e\aFY\x93\xfb\xab\\\xb2[TWn5\xa9\xd4Tn5\xe9X>O\xe2\xf9\x94'X\x00|$|\x8eU\x1d\xf33U\xbfǦT}\xa3\x8a\xe13\xd9\xcd\rFq\xdc\xdb\xefXG\xf3\xc2V\xb6\x81\xaf}\x90*P\x03 \xca\x03M\xe6'&\xcf<\xe8\xd9\xfc̍\x1aO\x86j\x8eF+?g\xe5\x04\x87\xa6\xcc51\a\xb5\xe5>\xdc\xef\xb4\xdfM\xe5\xf1\"\t\x1f[\xae\xdcR\xae@\xd1\xe3ީ\x19OpmЛ\x89\xa4z\x18\x02\xb7\xdeR\xccܑ\xf5\xea_\x0e\xf2\xb2 A\xa3\v\xc3R\xa4\xa4\v\xaa'\xd4?\x95X te\xee7\xce\x16\xf2VIQ\xfb~\xc1.\x90\xa5u\t\xddJ\xaaU\x19\x17R\xb6\a\xdd\xe4<\x94\xae\xb4\x86\xe8\x02?\xbd\xca\xc5@B\xf4saឋ\x02\xa6\xba\x02]\xd2\x15\xaa\xacl\x1c\a%6\x8e\x13\xbd\x1b\xb5+V-\xe5)\xeb\xb6\b\xf16\x15\x03\x95o\xb5\vJˬU0\x03\x80Ǖt\x9d\n\xecy\x1c\xcb\xd9]3\xf7\x98\x1dC|\xaf\xfc\x9d\xd4\xcd\xdf\x02\xd7\x14\x96Lu|\xac\xd7\a\x9c̒\xe5\xf2x\xb9\xbch\xccеh\xfbw\xc0)\xfd\xdf\xff\v\fU\xfeK\xfd\xddV\x9e\x85\xe7\fD;\x8ce-5i\x9bt\xb7Η\xcb\xe3ju\xd2?\x1e,\x97\xae\xd5O\x9b\xe4/\x1e0>\x04H\x84\x9aժ+?ۺ\xec\x1f\x0fH\xc6\xeaa\bvVoA\xec\xfc\xee\xf3L\xd4\xf7\x1cD}\bW\xee[va\xa0j\xdc\x7f>@\xcd_\x1d\x80\xe0\xcbr\xf9\xf6\xe8\v<~ao\xc9JV\x7f\xca\xe4\xbb\xdeE\x9eE\x00\x99\xd1Z\x11\xfd\x82\xad\x9d\xb2\vu\xd97\xe9SB/\xcc\xe3\xd3Z\x8bX\x97g\x89\xb8\xf2\x94\x10\x9c\x00\x92\x81r\x12\xf3\xdc$`\x96o\xbb\xebS\x99\xdf=\x15(ç\x8b|x\x11\xbc\xb5\xb3\xd1~\xb1G\xfb\x85hpNR\xfeC\x9c\n\xd7\xfaHʋ\xed'PBveN\x01\xa7\x0f\x1b\x9d{\xdbo\x0e\xaaUg\xb0^\x9e{\xca\xe4fhR\xfb\xd9x,\x9f\xe4\xbfR\xc7u\xfb\xac\xb1M\xe8\x1b\x06+EO\xf4̺Ϗ\xded\x8b\xf5\x81\x9d\xf4\x9fcs\x1f\xaaխ\x0f\nT\xa7\x16м\x83\x83\xf3\x9e5\xe9k\xf6A\xaf\xf5\xfb\xa3\xd7\xdd\xf7\xb5\x1a\xf9`3[\xef\xc9\x13\b\xc8\xf7\xaeƜk\xc7{Wc\x1f\xfa\xef\xe5@\xdfY\xcdB\x93/؉Y\xd3焾2\x8f\xcf\x01\x02\x9e1=\x8c\x11\xe9>\xabV\xdd\x17\x92V~\x86R\x8fWF\x85\xf9\f\x15\xd9\xf4U\xa6\xe5\xb9@\xc5\xe2\xabL\xe4Q\xbb(\xeeɋ\xec\xa5v6Y\x95\xad\xf1\x93\xf6\xeen/\xff\xa9\xe3x\xf9\x82\xfcwy\xb5\xc8g\x88\x8f\x9c\xab\x1d5\x14͝/Wf}/\x99\xa1\v{\xa8T\xd2O`u\xf3W\t(\x99\x12\xf6W\xede\x86dkYU\xfaY#\xc0\r#ָ\x92\x96\x02Y[kw/\x108\xd5\xe2^\x10\x89gf`۠N!}Æ\xe6\x14\xda\xc0\xf5\x91\r\x15p\xe1\x89̎\xe3G\x05\b\x7f\x96\xa9)>\x92\ue7cc\xb1\x8fժ\xfb'S\xc6^\x1f\x89:z\b\xd8\x1f\x95\b\xe6O\xb2B\xf4\xfd\xb3\xd54\xdcQ\x12\x01\xfcl\xa6\x0f\xa4\xb4\xc1\x9c?ۇ\xb8I\x7fV\x1b\xf0\xc8n\xa3\xe7\x103\xeaG\xf9\xdbɴ\xf3\x88\xd8\xd7IV\x8cHLw-_\xe6\xee/m\xcf`\x0f\xe2у\xae\xab\v]\xc3ܠ\x17\x84^\xf6\xaf\xc1\xce\xc2\xde\xc1ju+Wm\xed3\xb8\xfc\xf3w\xf1riuMn_\xb2\xc2[\x85\xbb>1\xabZ\x06\x86P\x8f\xbd\xac}\xcaE!\xc4+_\x1bf#\x8d\xae\xb2լS\xea\xeb\xb6\xd7\xe6\x16\xc0N82\x02n\xa9R\xcb6\xaa\xda\xfew\xe7\xe9v@Qa\xc0\xa5֡k\xf9*\xe4,\x8b\x92\xf5)҈\x19x\xc1ge\xa4a\x9f\xb6\x9e\xcfx\xcd<y\x85uw᭼crorj\xc5<Z\xf1\x9c\xbeS˕Ԝ\x81\xd9\x1e\xa9\x1d\x96\xba\xa2\f'\x10\x1b\xf4r\xe4nVL\xaaUˬ\xdb~\x91\xe1ˀ\xdddZ\xad\x1ctv\x95>\xd4\xde\xed\xa0Z\x05uI\x80[\xa2wZk\xb9\x84\x86\x7f\xa9\xdd\x12Jmc\xd3(\xcb%z\x81\\\x82\xf9$\x04\xfc\x062\xb6\xe7\xfaH\xf8\xd4\\\x1f\x9a\x95\x96hJ\x85d[\xf4$\x88|\x12Pq.\x97\xf0\r\xc8ʫU\xe7_P5\xcaU\x8d\x98\xf3/\xa7\x16\x11\x9a\xe2x\xd1>\xcc~\x9f\x82\x1a\xa8\x96\x82@\x17\xb4n,\xb1\x02\xb9\xf4\xfe\x05\xea\x8cҀ\x15e\x90\xb7\"\x84\xd4ܔ\xa5\xa6\t\xb4\x12\xfew\xbb\xe3\x10R\x8b\xf2\xa0\xbe\xceh\xe7\xbdA\xf2,r\xc6;\x13s~J\xdb[c\xc1\x91#\xc93$J\f.9\x1daX\x1c\xd9>\xe5L\xac2m\x06V\xa2\x11+\x02\x0fQ2\xb4\xc8vu0\xd1O\x82A7\xe9\xa7\x03\x04\x98~:@\xe2W\xe2]\xf4 \x9aRiR%\xada\x8d~\x03\x9eX\x92\xe1\x87DƸ5F^[\x96\xfd\xaf\xa1G\xc2\xdc\xd08\xa1Ro\x1d\x96\xe8\xadC\xd0[gf\f[\x8c\r\x01\x96\xfaC0\xe2\x1d\x0e\x8c\xb0\xf4\xb2\x9f\xe91\a\xa0F\xb30iN\x95)\x1f,\xf5\xa6D\xa5%3Y\xe1L2Ui\xf6;\xa7C\x95\xec\xe3e?{=\xc8f:/\xcet\fj\x82\x8c\xe2\x1d\xeb\x99Nټ?\x86\x1d\x98\xc2Ħ\x99CS\xf9\xb8\xb2\xee-\x03k\xcaq\xd6\xcb\xe5$7\x18k\xaa\x99\xc1\xb5t\xa0\xd3\xc3\x1c1\xcb\x16[Z\xd3g\xd6oݑA7[\xae삍\x1a\x92\x80#\xa4K\xba\xb2\f\xc5u\xf8\x12N6\xb7H\x1d\x97\xdb\x14\x18\xa1h\xca7B\x92d\x94\xb3f\xd3\x1d\x1d\xb5\x8bo\xac9\x8c,\xa36\\\tu\xd9r\xf5\x83*\x851\xe3\xf2/\x95\xb0\xc0\xf4(\xe1\xe2\x90\xdc\t\xc7?4\x83\x15f\x8fZ\xfe\xa6R\x8f\u03784nK\xacUJ\xcc]+\xe3\x8e$\xf9\x9b\xe8\x86%\xf6-+߲Y\xed&\xdbZ\xcd\xf9Y\xb6\x02\xe6\xe8\x94B\xa4\xe4\xf6mxv\x90\xe3LJ\xac\xf7蕙2_\xfb\x80\x97}p̮\x96\xcb\v\x98\xd8\x14\x1d\x84\xb3J\x98E\xeb<ױ\xd5e\x06*\xcbe\x7f@O\xa5\x0e'\xab\xca7V\xb5-\x11\xb6rG\x18\x89\xb7S<\xaf\x16\xec\xe8\xdd@\x89\xa8\x1e\xa6\xb4\xf5<\xef7\a=\xf8G\x99rx\xe7\x06\x80d\x01!\x1a\x12\x1c\x87\xda\xe7\xda\x06Ol\x98[\x9d\xf0\\'\x00\xb4=\xf8G-\xad\x97A)ם\xf0LP\fKzl\x7f\xbb\\\x9a\xb1\x12B\xaf\xc8\x1ahJ\f\vD\x8a\xea \xd1PX\n\xa3\xd6\aP\xd0\xcb~z\xd9\x17\xf4\xfe#r\xceFƅB\x1fBr\xbe\\\xba\xe7\xac? \xf4\\Y\xa2\x9d\xb3s-\xbe\x1c\x91\xfb\x9b5M\xa2p\xed\xcd\"\f\xdf&\x1f\xb5\x7f\x89\xab\xbf\x92iuN\x895E\xf9\x93\x9dk$C\xdd\xcflk\xcb\xd5\x1b.\xfff\x04\xd9\x7f9\xe4I\x93\x98\xf2L\x17\x8fd\x87<\xeb\x9fM[\xaaq\xab\xbb\xcf\x192ˎ\xe6=+\xa6g\xe4&\xb6I\xb2U\xaa&\xb7\\\xaa\x1a̪\xd9\xcb~\x82%P\xcdT\xef\xe9\x1fP\xbc\xf1R\xda:/\xc4\x7fM\n\xaa\x03\xab9\xec\x1a\t/\xddrb)\x15\xca\xeeo}'<e犹Q\xc1F\xbe071\bE\xfe=\xcfBS\x01\x98\xa3\xa1\xf3\xd3\xe5R\x99<?%\nB\x9f\x82\xb4\x8a\xbee\xbaz\xf7\xed\x13H\xf6X\xaf+\xabi\xf7);\xef\xbf\x1d\x90\u07b9օ\xbe\xb5,\xa7\x9f\xf6\xdc\\9\x05\xe6\xd4{^\xad\x16\x8b\xc1P\x1a\x96\a\xc4f\xd7\xc8\xd9v\x9f\xd7\xeb\xdd\xe7$C\x05\xd0$\xe9n\x1d[\aq\xb9<\x97\xa2\x9bm]\x92\xa1\xc4\xe5\xd2\xfa\xd4!\xf4\x8b&mϳK(G5\x9f\x1b\xe3k)(\xa2'\xecA\x1du\v\xa8N\xfed'=\xc7\xf1\xf4\xaa\xf5\xccy\x00\x81\xc2\xffԑ\xd0g\x02\xe4\xa8\xd9\xd5` \xb0Z\xdd:\xa9V\xf3\xabd\x06l\xc1\xa5\xb5`\x9e\xbb\x0e\xaf\x06$\xc9\xff\xd4ɲ(\x00\x98\x96\xa2\x04\xbe\xfaF\xa6k\xda\x05\x90#n\xe0\x86\x11\x95\v\x96j\x97\x8c.^,\xc8|\xb9\xe0C\xdf\x1c\x10\xc3\xe8K\v\xe0L\x16.=6\xb4\\9\v\x86\xaf~@\x855Y\x11\x06\x91E\x1d\xa8ǋ\xda\xd2L\xb5z\xab\xf9\x8c\x12\xc5\xef\x9aM6_Qͩ\x96U\xbf#\xdcǊ\xca\x1d\xf4\xca\xdc\xf0e\x94\x15SǺ'6W\xe7\x10\xfc\xe9p簽oi\x84\xed\xd0M\xe4\x16\u00a0\x01FH\x1a\x134\xf8\vOE\x9c\xf8\x13nB\x17d!\xc3L\x89d\xb4\x8a_@d\x1b\xab\xf7-\xb0ipD\xb2@\xd3g\x1d,\xbb\xa0dX\x95E\xa2R\xfe)\x91\xebD\xf1\v>O\xb84CtH^\xa9\x95@r\xac\xf5ha8\x19\xdd\x00*\xa9\xf2m\x14\x93\xd3\b҅\x8a\x89?乊\xbda\x1c\xa5q\xc8\x1b\xf8\n\xb4\xb9\xba\xe0\xcaO\"W\x10\x9a@\x86\x1c=$\xe5Ō\xb6\xb2\xc6\xe9\x19\x83ouZ\x9d\xd6}\n\xf9ý\x1d\x19\xb4{}+\xb7\x8fζk\xfdߟ\fjO\xb6\xb5)\x01x\x94\x96\xd5\xc4jg\xdbV\xc5r\x87u#;w\x8fζ{\xb2q\xb2=!\x10EZ\xf0\xa4,\x98%r$\\\xe9\xcc\xc0<l\xe6\xcf\xcb\xea\xdd\xca(\f\x9c\x02x{\xc0ЁSw!!\x8d\x15@\xba\xe7<\x0f\xe34\x88&\x1f\xfc\x89S\x06\xc9\x1b湕[\x0fԀb\xf6\xa1ju\xcbW%=\xe7\xed\x9cG\xbai\x1fKNy8\xb6;t>\xf0k\xe1\xac\xca\x03\xa2I8_\v\xcf\xdb*\r\xcf۲\xc3\xf3\xb60<o\xa2L\xed#\x11'4f\x02\x93\x12\xbc\x810\x0fo\xa3S\x7f\xc6_\x05\x11\x87\xec\xd34E\x9bJ\x96,\x97N\xa5R\xa9H\xb8\b1\xaaLq\x9d\xa9\xa0\x81\x0e\xb4\xcaU<\x8d\x85\n!\u05f5ג1\xb6\xa8V\xfdz\xbd+\xc5\x04\x91\x9bP\x1ft\xee\xc3Z\x88\xfe\xc7\xd6⨺\xb5\x1a\x8du\x80\xb6\xa0/\xc0\x83k\x8a?ڃ\x92\xa6\xe5\xd21\xc6\xc6*~]\xa1ũ*v\xe7\x90\\lX\x9b\xca\xd1\xd6\xc6\xeaoHSe\xf0XoSQoev\xf5\xf3UFg\x96E\xbcȀ\x03\xa2\x90Jk\x0f\x02i\xd87\x83\xef\xd6\x16\xcfl\x1a\xcf\"\a\xd0\xfeA\xb3\xbd\xbbkEŻu@\xccW\xf5g\xf3.\x9a2xN\xf5\xcfE,\xc0*\xe11\xbe\x98\xc7)<\x1c\xc1C\x88\xe5O\xe0\xe7Dt\x9d\xd2t\xa3\x85\xd4\x1fJ|f\xfbɻ\xfd\xaas\xf4\xe4\xf1\x80\xe4\x05rV\xe8}!\xa3\xe7x\x10\xde`\xb7\xb3\xb3\x7fX\x12\xf2\xbf\xb3\xd3\xdaݕ\xe6<8'\x19\xab\x1c\x13E\x12\x95@\xb1[\x12\xdb\x1f\xe0M\xa6\xb7\x88\xa8OS\xe6FLP@\x8c\x18}\x02\xf4\xbaM\xb5b\x11\n< \xc69\xefn\bY\xe5\xba!\xe3}\xbf \xcc\xe97\apa\x87\x90\x89\x97\xcf\f\x02W\xcf2p\xa6O\xf5c0\x8c\x17\x91`IV\x80\x87\x87\x89BA\xcaR\xabD\xf0$Y\xcc\x05\vu\x19Ni\x01\xf6&\x0301\x19t\xf3\xc9[\xf2#,\x0f\xa82\x94\x90Yn\xf2S{̜ǵ\xd8\x15\xa4\xf6\xd8y\xbcr\x05Egy\xc0\x8a+\x1dTRy\xba*\xf7-\xb5^\x9e\x89\x97\xcbB\"\x9ds\xc2ƹ/D\x02Qz\xd5O\x80\xe3\xf3!\xa4M\xacV\xe7r\x18\xaes\xb4\xd5\x7f\xfe\xe2釧}\xa7\xa6\xdf\xda\xc1\xca\ag\x83'`\xee0\x18\f\x06OL\xdd'\x0e\xa99\x83\xc1\x13\a\x8f\x86\x9c&Z\x18n\xb5\xe8<s\x81\f\xcbO\xd8\xfa&\xf7\x1c\x1c\xa0\xc3\xd67\xb9\ar@9|O5\xad\xe0\f\xcc\x16=w\xae\xd8\xe3\x05\x84\xb9S\x15d\xae88\xb8\x8b\xe52\x1bO1\xa8\xa6\xa9\x1d\x1a\x04q\x8bīO\xcd\xde{[-\n\x9d\a\x17\v\xc1SoH\x87q$x$\xbc9\x950\xe5%T\x81\x8e\x97\xaa_\x9eX\xe5n\xa5,\xabC1Н\x89I\xcaݭ\x96\x0eB\x17!nW\xfd\xf7Z\x9eh\xa8>\x8d\xdc\xd2η\x8a\x9c\xd6Z\x15\x1d\xbc){\xa1X\x8a\xae\x1d18\xc6Q\xf9Y\x80\xea.\x8c6&\xab\x15\x8eǍ\x9e\xb4z\xfa\x9eI%I/C\xd2\xf6\x9c\xa3m\xa7&\x7f\xd7\x10U\xc9w\xb2*H}{\x80\n\x15\xb9_\xad&\xf9\xec\xa7V\xf2]k\xaa@AgO@\xf5\xf0h\xc48\xb5\v\xa1$\xb6K\x00\xe2\xb8\vbxe\xc1!WR\x0f\xda\x1a\xb0\x1e\xaf\x1ek\xb6\xab\x9acr\xc0|\xc8.\x978\xbd\xe2\x10\xf9M\xd4Ӎ\xe1\x8c=\xfd\xb4\xbd\xbe\x00\xb0r\xd9\nlE\xf9}V\xd5L\x8d.,\xc9r\x19\xdfA\xb5\xae\x83\x0e,\x17\xbb5\x10GT(\x8a\x98\x82\x8fa\x8a\xa8\xb9\x87\xca\b\x15|\x14$oC<\xa0\x86v\xe8m5\x19˞$\x85\xe0\xe9g`t!xd7\x9frjܓi\xb2?\x04\xc3/h\xc0j\xef\xed\xc8\xd0\xd8\x06\xc8`\xa0\x8b\x1a\x13\xc8#m\x81\x02!\x04Cq\x1c\x1a\f\xa8\x89/\x86ڝcѵ\xb1\x05\xb9\rU\xaeR\x99\xed5\x91\xa7\x9a9\xce*\x17\t\x04:M\xdd\x11\x05\xd40\xa7\xf3^˃\x1c\xec9\xaf\x13\x1cU\xa8Y»\xbb\xe1\x84\xea\x02\x1e\x8d\x10\x93\xe9l\xbd\xb0\x82\xfa\x9d̨\x8a\xd8ye<\t\xecV\xc7\x10a\x14\xb4\x8d\x8d\x11\x1f\x86\xbe\x8c\xa1-cG\xd8%\x10\xcaŲEn:Ԥ\xb3LL\x02\xa0\xe5\xd2\xf9\xf8\xe1e\xfd\xc0\x81\xe0[\xa9\xf0\xa3\x91\x1f\xc6\x11\x97QS\xcc#\xb3\xdf\x11:so\x9d\xde\xf5,t\xbc[ġ^\xbcZ\xc1\xfa-2\x8d\xdb\xf6\x13\xb0\x82|\xe2H&\xd6Ds\xea\xf1\xb2\xfb+s\xbdJj-+a\x18\xea\r'\xd0\x1f\xe6U\xc2\xc8!\x9cN\b\r{\xae\xbdvM\x1a\x12oa\xe5\xbaop\x19g9\v\x1c\xdcP\x91\x97\xd7\xd9x\x19\xe4\xca\nnW\x12\f\x8aP\xc1n\xf1\xae\xf6\x80ƥ\x80\xe1\xd7ҰK\xed8\xe2\x97\xf5\x8c\xa2\xe8*\x95\xa6\xc1$\xe2#\xf0\xb3\xf2+2\xcc\xedVN\x19\xae\xf5\xdf6\xf1\xd0M]\xabe\x84ńڵ\xf0¨\xb9\t\x82\xa7\x1d\xf3\x8a\xdcj|\a\xa8\f-\xc2\x11\xb6r#7'\x8a+{\x05\x15\xc9\xdb$\x18U\xc5Њ\x95\xd9[@F\xa7\xdd\xd6~\xdb+\x89{G#\x1aw\xd1{)\xb1\xd7{\x8d\x93\xe4\x1bHOg\xc2\x05ht\x17\x82\x8fN\xc5Mȝ Ra\x8e `\xca,\x8e\xc5\x14(vY\xd4(ֆ+\xdeI\x87I\x1c\x86\xf5\v>\xf5/\x838q\x06\x18\x92\xd7\n\x86\xc4\xf2\xf1\x93\x96\xcb-\xd7\xd1Ѻ\xb3\xfe\xf4\x1d\xa6\x82\xf2X\xf0\x83N\x1b\xeaF\x94\xbc\xd0\xe1\xe1!\x8d\x96K\xc0~\x11\x06\xe9;\xd4\xe6\xa2\xf64c\x94\x89\xd8\xeb2\f\xb9\x9f@\xb0\xd3x!ܘP߅\x98\x85\xa5t9z\xcd\xcd\xfck\xb7I1\xce\xe6\x87x\x8e\x116\xebQ>\xaeZDc\x9a\"X\x06*9v\xbc\\\xc6\xe0\x11\xd6\\.\xb9+\x1a\x17\xf1\xe8\x86\x10\xd1\x10\xf1\xaf\xae\xd4\xcaC\xc8v\x13\x82\x13\xb0\xfd\x84\x8b_\x91\x04\xb2:\x8dH=\xa4C\xe6ꐭ$\x8b\xd3ڍ\xa1\x0fY7\x88\\\xfc\xe1_\xa4`7\x9dd\x8e\x94\x15\xee\x92[\xdfM\xb9\xd0\x13v\v'2a\xa6\x8d\x16u\xcbz\xaa\x0f\xc9vLhd\x0f,s't\xc3\xda\xe2;79j\xec\xf6\xda\xdf%\xdf%^\xf2\x9d\xbbSo\x7f\x97\x10`\xd9H\xd7\xcc99¼\xaf\x13.~\xe0\xc1d*\\R\x8b\x8e\xe4\xca4$\xf4\xc8\xf2\x1ew\x89g\x8f8\xa0\x87\x87z\xc9Ȋ\xd0C\x82\x9e\f\x90\x11\xa4h\x86\x1cb\x1c\\\x95\x99+\x97\x93+Ѭ\xab\xcf0\xa6\xe8\xb3x\x11\x01B~\x1e\x06<\x12\xef\xf9P\xb8\xa41\xc5\x01Ѐ\xe56\xbb\x067\\n\xe0t\x9e\xedט\xcdkC \xb9\xc8\xd1|\xb9\xf4kѓao\xa1:\xf4\x82Z\xf4d\xdc\vݠ>\xacE\xb2\b\xa0\x02\xac\x18\xe6\xf9\xd1E0\xbe\xd0\xdd\bp\xb9\xfe\xb7\xdb5\xe0\xb9\xee\x9b\xc8v\x1b\b7 \x05\x15\x1a\xb9M\xb9X̽2\xb6Ef\x8e\\.\xb944\xe1\x84b\x89X.\xa5\a2\\\xfd\xb7\x8a\xe6~\xb1PYY\x12\xcaG\x13\xfev<N\xb9\xf0\xa2\x15x\xc0x\v*\xe2_\xbd\x10\x88\xef\xf8\xe7\x80_\x01\xb5\xcd#\xc1\x13o.Cz\at\x16_\xe6d\xa5\x19!\x19\xaf(,\xab'W\x97\xea\x05\xf0\xb2\xb5X\x81\xd8\xc4D\xf9\xd7?t\x9c\xff2\xaf\x18\x85\xb5$\x88\xfd\x8aJg\xfc\xf9!\x9eC\xf85\xe1\xde\x02\bz\xa6M\xf96\x88&\xaa\xd1\xe5Ҽ\x82\x8a8=\x1bU\xe4\xda\xff\x10\x83h\x99\xa8iD\xd4l\x9a\xb7q`A\x14\xf1DV\x82\xc1\rq\x1f\xe5\xf3*[\x81҄^\x9b\xb6_\xc4\xf3Z\xe4\x92z҈qo`\xaa+\x99\x9bR\xc5\x0e>\xc5\xd1\xf2\\\xbaK\x04Aն^\x15\x9e\x9f.\xc6\x125\xcbǄ\x9a\xe7\xfaܸ\xbd\xc8w-\x82A><7sz\xff\xe2\x90\xfbVǚ;\xcemE\x1d\x7f4:\xbe\xe4\x91\xd0I\xfa\xed\x8bnKu\x19ſ\xf1H^z`|\xe3\xe6v\x9fh$\xe2L\x83T\xc4\xc9M\uea84\xbb\xfcT\xf8\x02/PU\x81\x06ৠ\xee\xc7\xf7\x1cʤ\x049\xab҅\x00F\xeawc\xad\"s\xfc\x85\x88\xc1\xebQ\x8e\xaf8\a\xd7\tc\x7f\xe4\xe4\b\x11hp\x03ο\xa3\x9f\x99\x1f-\xfc\xd0A\x14\xbb\xb9\xb7y<Oq\x8ey\x131\xde\xc0\xd2j\xd5\xf9\x8bG\xea\xb0\xc1\x1cMy\x8c\x17\x81zld\x95\x00\xa5o\xb5\x88\xe9Q\x87\x15P\xb1\b\uee3a0\x1e\x05`4\x9742D\x04`n\x84\xb3vZ\x11u\x9e\x9fݜ\x8cܵ\xbe\x12>\xb6\xfc;\xc0Ⱥ\x9b\xc5iI\xec\xab/\xce\xf0\xb2 u\x0e\x17c\xac.\x83z\xd2m\x1eA\x18\xaa\xe8\xe8\xb0Z]G\f\t\b\xfcau唕}\xa1\x154\xddq\x7f_\x9e\x9d\xa5ĂA\xf7\xec,]>\"\x0e\xe9nܑa\x18\f\xbf\x14\xb6#\x8bP\xcd\x1b\xc2O&\\\x80\xaa\xccy*\xcd\x14UJ\x93.\xc1\x189H\x19\xbf\xd1\xe9\xf1\xd0>\xb1\x85\xd2\xfc\xabi\x00\xb67\\\xca\x1d\xfe\xc3o\xe0\xf7\x8c\v_\xfd\x1c\x8a$T?\xfd\x10\xdeKK\x84@\xaf\x9a\x815\x05\x19E\x91Q\xee}/\xf7\xe4ݮ\xba\x89\x05%f\x8d11\x8f\xae\xaa\xf8\x1f<sn\x82\xa1J\x8d:j\xb5\xd2r\x98\t\x17O\xb5<\xc0u`\xab\x1dԫwU\xc2\xe4(g7\x8d!\xfcd`\xa1\xc60\xf4\xd3\xf4\r\x1a\xa8+:\xadI\x17\x1b\x81*\xcae\xaeC\x00R\xb6\x99\x92c\xd1I˺!\xb3\x80h\x01\xf2\x82y\xc2aO_\xc8\vV\xe5l\x18ڷY\x01bY\x04$D)\xfcϑ;\xb7 \x16\xbc\xaf!r\x9c\x9bkP\xaf\xa1AZ\xee\xed\x8a:\x8e\xe4\xfc\xa8<\xad!E\xb1\xc3P\xc2\xedV\xcb\n\xe1\xe9\x12\xcb\x1b5f%!c\x92^\xa2\xb5^4\"^\x92wG\x05||\xb8\xdbj\xb6\xd7\x04ַNc\xdb\x0f\xc3\xc6\xe7\xd4\xf1vv;\xcd\x03\n\x05\v1\xdd\xf6\xb1\x87\x14\xdf\xec\xee\x1e\xb4\xda\xe6\rn \x96\x1fv\xf6\x9b\xbb\xa6\\\x06\vO\xe4';\x9dý\xec\x93\x14\xb3\f\xc5\xea\xdd^\xb3\xd9\xc9>K\xe7|X\xbfJ\xfcy\xdd\xeer\xe7\xa0\xd3\xc4\xef\x87q4\x0e&in<\xfb\xcd\xfd֎\xfdr\xcaù\xee\xf9\xb0\xdd\xdeݳ_f\xe3m\xed\xed\xb5\xec7\xb9\x11w\xf6\xf7w:\xf6\xdb\u00a0\x0f\x9b\xad\x83\xdck\x18\xb7=\xaav{\xef\x10k\x8c8\x9f\xd7\xc3 \xfa\x12D\x93\xdc\xd0:\xad\xc3\xfd\xe6Z\x8dl|\x9d\x9dÃ\xf5\xf7\xa1\x7f\x13/\x84\\\x95\xd6\xee\xe1\xe1Z\x05\x93l\xac.\xfc\t.\xe5\x9c'\x8d\xcf\xe95\xb4\xb8{\xb0s\xc7\a\xb9\xca{̓\xfd}\xac\x1c_Ep\xd1\xd5\x17\x89\x02\x8d\x83f\vW\x8e'In':;\x87{{\xfa\x05O\x928\xa9\x8bďR\xb09\xe0I\xba=\x8d\xe3/\x12\x84\xf6\x0e\x0f\xdawT\xcc=D\xb1\xa8\xc7\xe3:\xca,pe;\x87\x0f\xfe֤\x1b\xab\xc7\x11\x8f\xc7\xf2\xfb\xd6AgW7\x90-\xf7\xfe\xfe\xfeaG\x17\xe7`ᰳ\xdb\xde\xd7o\xf2pp\xb0\xbf\xb7\x87\xaf\xa4\x82\xcbjn\xe7\xf0p\xff\xc0z\x13\xcfӗ\xf8K\x1d\x88&\xee\x9c\xdc\xcd\xdc\"\xb6ww\xf6w\xacwY\x93\xed\xbd\x83v\xcbz\x93\x1b\xe4\xee\xde~\xdbz\x97\x1f\xe6\xceN\xb3i\xbf\x04`\xe5ׂG 3K\xb7\xf1\xbc\xe9/dW\a\x87\ar\x80q\xee\xcc\x1c\xb6v\xb1\xa1\xd8O;\xb9Q\xef\xed7\x9b\xed\xec\xcdBL7v\xa0Nmg\xbf\xdd1\x1f\f\xb5Y{\xba\r\x02\xa9\v\x7f\xf8%U0\xdb\xd9i\xef\x97՛\n1\xafCG\xb2\xde\xc1\xde\xfe\xfenY=k\x87\xf7v\xf6J\x9b\xcaN\x00\x9c\t\xd9^kw\x7f\xf7\x9e\xca)O.\xe5\xf2_;\xde\xe1\xde\xfe\xe1^Y\xfd\x84\xff\xb9੨\x03\x11[\xe7\xa3@.\xf15b\xd8\xf6\xfe}_\xa8\xaa\xed\x9d݃\xb2\xaaj\b\xf5a\x1c\t?\x88\xf4\xd9=<l\xdfU]V\xda\xdd\xdbke\xfdg\x98\xe9Z\x9e\x05\xf3\xc6\xda\xfd\xfd\x9dݖ)ρ\xdf^\xbb\xd5\xcc>\xc9o\xf5ns/ۙ\"\xec\xe5\xab\x1e\xb6\xf6wZ\x1b떀\xd1a\xbb\xb9\xb3c>\xc0\n\xd6|\x11\x0e\x03\xc1gjZ\xcd\xce\xee\xde\xc6\xca\x16\xe6\xdd\xdf\xdfkm\xac\xf79\x85\xbd\x1fN\xf9̯K\x9aC-z\xb3}\xb0\xbf\U0006b65f|\x01L*\xeb\xb6\xdb;{\xcd\xcdu\xe3\x11\x0f\xd5&uv\x0e\x0f7V\x8c\xa30\x88x\x1d#\xd1\xf8\"N\xea\x17\xfeh\xc2բ7w\x0f6~\xa8\xc4\xe5\xf5T\xf8\xb3\xb9\xc2\xf7\x87;j\xf8\x11\xd6\f\xb9\xe06\xf29ؕ#\xd6ЙF\xc1|\xceE\xba=\x8e\xd4N\xb4:\xbb\xa5\x15\xb2F\x0e\xf6v\xf7\xcb\xeb\x14\vԡ\xdei7\xf7J\xeb\x17\xf0\xdb\xde\x1e.R\xea\x8fy=\xe1ш'6\xd8\xcb[\xe2\x02\x18v?QG\xaa\xb3\xd7:ܽ㛱BCj\xbf\x0ew\x9a\x9db\xedq\xa4\x0fR\xeb`\xad{k\xe5\xf6\xf7$\xdaN}XU\xb3`\xbb\xfb\xcdݦ]n\xad\xd3\xc1\x81\xecm·yګ\xd5n\x1f\x9876\xc46;Yy\xeed\xb6\x9b\xcdNۼʯ[\xa7sp\xd02\xef\xd6Ȯ\xfd\xfd]I\x03\xa6W\xfed\u0093\xfa\xe7T\x13<\xeb4Zg\xe7`\xb7]\xa8l]\x87\a\xfb\xf2\xd6^\x88 \xb4Q\xca\xc1n\x1b\xb7\xe12\xe0Wzev\x0e:;\xfb\xa6\xd0B\xe0\x9d\x9dvӔ'q,\xeaA\x04\x8c\x8d\xc6h\xbb\xfb\xfb{\xd4\x19\xc6\tߞ\x87\x8bI\x10\xa5Ez6\xffn\x03i\xbb^i\x8d\xca]\xafRF\xf0\xae\xd7*\xa5}K\xaa\xddE\x06\xe7\xaa\xdfA\x11\x97\xd6+!\x8eK\xeb\x15\xe9\xe4\xd2Je$si\xc5R깼\xe6\x06B:W\xf9>\x9azs\xe55\xf2zs\xd5uJ{s\xdd\xfb\x89\xee\x87|[F\x7f\xe7\xbf\xdb@\x8a\xe7*m\xa0\xca\xd7\xea<\x80@\x7f\xc87\x0f\xa0տ\xba\x99;\xc8\xf6\xb5\xb6\xd6(\xf8\xb5\x1ae\xc4\xfcZ\xa5R\xba>Wk\x13\x89_V\xa9\x8c\xda\xcf\xd5\xdbL\xf8\x97U[\xe3\x01\xca*\x95\xb0\x03e\xd5\xca8\x83\xd2z\x0fe\x12\xf2\x1f\x97\xf1\v\xb9\x1a\x9bX\x87\x92J\x0f\xe6\"ֿ\xbd\x97\xa1\xb8\xf3\x93r\xde\xe2\xceO\xd6،;k\xdf\xc1q<\xf0\xbb2\xe6\xe3\xceO\xef\xe3C\x1e\xfcq\x8e%\xb9\xf3\xab;\xb9\x93\x87|\x99cT\xd6?(\xe1Y\xd6+\xad\xb1/\xebU\xca8\x99\xf5ZeLMI\xad\x87\xf07\xf7\x7f\xb6\x99\xd5Y\xff\xf6a\\\xcf\xfd߭1@\xf7\x7fr\x0f/t\x7f\x03\xa5l\xd1\x03>[\xe3\x90\xee\xff\xe6~f\xe9\xfe66\xf3M\xf9o\xefb\xa1r5\xef\xe1\xa6\uebbb\xc6X\xdd]\xfdN\x1e\xeb\xeeO\xcbح\xdc\x17_\xcfy=\xe4\xf3R&l\xe3\x87\x05~lc\xbd5֬P\xb3\x8cK+\xadRd\xd8\xf2\x956\xf0n\xeb\x95\xd6ظ\xf5*e\x1c\xddz\xadR\xe6n\xbd\xda&>/_\xf3\xe1,ߦ\xefָ\xbf\\\xc5rF0W\xa5\x84'\\\x7f\xbf\xc6\x1e\xaeW\xd9\xc0)\xae\xba\xf9\x9c\xcfʫ\xc4\xcd2t'\xae\xc8Y\xde*\x03\xb8\xa4\x11\xbb\x11\xcdG\xb6P\xe6o\xcf\xfd(\x8aEe\x1cD\xa3\xca,\x1e-B^y\xec\xd4x\xcdy쐮4\x96\x13\r\x88\xe5\xc1\x9c\xd7o_||u|\xfe\xe6\xed\x87\xf3\x97o?\xbey\xe1d\x89x!\x93\xe6*\x96I\xc7\xd75\xf7\xb6\x917\x98C\xc5&\xb8\x87o\xc5~\x8f\xc1\x1bq\xc4Pӳ\xa2\xed\xdd\xd6\xfe]\x8eth\xb9\xe9\x053\x7f·\xd3\xcbI\xedz\x16v/\xfc\x94\xef\xed\xd0w?\xbci\xffv\xf3\xac\xe3\x7fz\xdf\xf4_4\x83ן\x9f^\r\x7f\x98\x04'ϟſ}\n#\xff\x87\x9f\x0eO>\x9f\\\xbd\xfe\xe1\xd9\xceI\xf0t¿o\xa5\x17\xd1\xebÓٴ9\xfa\xe1\xe9ޫ\x9b\xc3Ψ3\\\x8c\xfez\xbd\xb8\xe8\xfc\x18\xbd\xfa\xeb\xe4\xea\xf5\x8b\xa7\x97\xc3\xceo\xd1I\xf0\xac\xed\x7f\xfa\xb9\xf3\xd3\xecp\xe7\xdd\xe9\xc9\xd5ɋ\xa7\x93\xd7\x1f\x9e^\x9d\xbc8\xbez\xfd\xfcd2\xfc\xe1\xc7p\xd8\xfe\xf9f4\xfb\xf9ٰ\xf3,\xfc\xb5\xf3\xfe\xf4\xd7_\xde\xcf/\xfej\x06\xfc\xb85\xff\xede\xf8\xc6\xff\xf4Sp\xf2\xfd\x9b\xf4\xd7_\xde\xfc\xf5\xee\xf4\xc7\xf4\xb7\x1f^\x8b\xe1\xec0\xbd\xf8>\\\xfcvs2\x19v\xde\xef^|\xff\xf1\xf0d\xf6\xe3\xf4\u05f6\x88\x86\xb3\xc3\xd6\xc5\xec'\xe1\x7fjM\x7fk\x7f\xdc;\xf9~\xf7\xf2b\xf6q\xff\xe4\xfb\xfc\xfb\xe1\xf7\x87\x7f\xf9\xd0W{g\xef\xe4\xfbp\x01\xbf\x7f\xfdt5\xf1?\xed\xceG߇Ӌ\x17\xe9\xe4\xd7\xd9\xcb\xcf~{ts\xd1\xf9y\xf1\xdb\xf3\xd6\xcdo\xbf<\v\x7f\xfd\xe5\xa7M\xf5\x83w\x9f\xaf?\xfb\xbf\xfc\xf8\xf9\xe2\xfb\x8f\x93_;\x93Ó\xcf\x1f\xafN\x82g\x9f\xf9\x87f\xf0\xe6\xc3\xd3\xe0\xe4\xfb\xdf\xe6\x17\xdf_\x1d\x9e\xccpL\xc1\xc9\xf7\xbbѫO/\x9b\xa3\x1fNİ\xf3\xfe\xe6\xa2-\xc2w\xa7?\xee\xf3\xf6\x9bˋ\xd9o\xf3\xdfnv?_\xb4\xaf/\x87Q\xeb\xf0$x\xb6\xf8\xed\xa65\x1d\xfd\xf0\xfe\xe6\xd5/o\x9a\xc3\xd9a\xf2\xdbi\xcb\xec\x1b\xef\x88\xcf\x17\xedݙ\xffi\xb8\x18\xb5\xc3/\xa3劉\xe3ӓ\xc9\xc5l(~\xfd\xe5}s\x18\xb4n\nm\xdf\xfc\xfa\xe9\xfd|\xf4˛b\xbd\xbfF?\xfcx\xe9\xb7?\x8a߾\x7f\xf9\x97\xff\xfd˛\xe1\xec\xe5n\xe1\xdb/\xbf\xfe\xf2&\xfe\xf5\x97\x1fo~\xfd%\xc4\xef\xb3\xf1\x9f|~\xf3\xe1c\v\xfe\x7f\x12<3m\xc91\xc1\x9a\x1cÚܼ;=\xf9\xeb\x8d\xf5ݫO\xef\xa7\xc3\xf6t:\x8c~\x9c\xc2z\xbd\xfe\xf0k\xf3\xd5\xe7/\x7f\xbd\xfe\xeb\xd7\xeb7/^_\xbf\xfe\xeb\xa7\xf6\x9b\x17ǭ\x93\x17\x1fۯ>\x7f\xe9\xbc\xf9k\xd2y\xfd᧿\xde\xfc5\xb9y\xfd\xe1\xf5\xce\xc9睃_?\xed\xce/>\xbdl\xfe\xf6\xf3\xfb\x9b_?\xed\xfe\xf5\xdb\xec\xf0\xe6\xe2\xf4\x19\xae\x99\xff\xe9\xc7\xd6\xe8\xfb\x9f\xdf\xfe\xfa\xa9\x05k\xdc\x1c\xce^.\x86\xed\xdf.\x87\xb3fp\xf2\xc3\xfb\xdd!\xc0P\xf4\xe3\xe5\xe8\xfb\x97\xcd\xdfNO&\xbf\xb6_\xa6\xbf6[\x97\xbf!l]\xcf/f?O\x87\xc1\xc9d4{\x99\x8e>\xfd\xfc\x97\x84\xe7\x8f\xf8\xff\xb7\x7f\xbdn\xbf~\xfe\xb4%\xff\x7f2\xf1\xdb?\xef\xfe\xfc}(~\xfb\xe5\xf5\xe1\xc9\xe7\xa7\xfb\xafOO&\xbf\xfd\xf03\xcc\xf9zxs2\xf9u\xf6s\xe4\x7f\xda9<\xf9\xfc\xec/X\v\tW\xef_\x00\x9c\x8d^4\x03\xff\xd3\xee\x97\xdf>\xfd6\xbf\x98\x850\x96ڻ\xe7\x87ӋY\b\xfb\x13\xfe\xfcÏӋ\xe8\xcd\xec\xa2\xf3\xa3x\xf7\xf9\xea\xf2\xd7vx\xf3k\xfb:\x84\xdfp\xe6\xdeMb\xe6\xachk\xb7\xb5\u05f9\v%<\xae\xd7\xebg\xd1\"\t\xbd\x8a\x8a\x91\xba\xbd\rĀ\x88\x13\xdePh\xbe\x11\xc4ۗm\x8d\xf4\x1b@\x0e:g\xd1(\x9e\x9d\a#\xaf\xe2\xfcK\xdf\x06\x8b\xc09\x8b\f\xe5\xf51צ)\xce5\xaa\v\x9d\xb3\xe8\xf1\x8a\xb6w\xf6vw<\x17TՐҢ\xb3\xd7\xd2\x0f\x87;{\xad=\xfd\x00ѓ\x9b\x96Z;\x9bL\xe2\xb6w\x0ev\x0eȊ\xee\x1et\x9a\x87\x1b\xea\x1ct:{\x1d\xb2\xa2\xfb\xcd݃\xbd\ruv\xf7\xf7\x0fvȊ\xb6Z\xad\x83M\xed\xb4\x0fZ\x87{dE;\x87\xcdv{S_ͽ]\xe8j\xa7sGW;8\xe4v{w\xe3\xac\x0ewv\xa1\x99\x9d\xfd\x9d\xfd\xdd\ru\xf6\x0e\xf7w`V\xad\x9d\x9d\xd6\xc1\x86:\xad\xd6!\xb6\xd3jw\xf6;\x1b\xea\x1c\xee5\xf7`滭\xbd\xfdM3\xdfk\xed\xee\xefC\xb0\xebMs\xda\xd9k\xef\x1f\xe26\xb46\x0e\xa6\xd3\xd9\xdf?\xc0%>8ش|\xad\xc3\xce>L\xea`\xbf\xb5\xa9\xca~砅]\xed\xb7\xf7\x0e7λ\xd9n\x83A\xc4\xfe^so\xe3\x9c\xf6\x0fq\x1bv\xda\x1b\x87\xb3\xdb\xdem\xef\xe3\xfa\xb5\x0e7\xb5\xd3\xd9;\xd8ݗ\xed\xeco\x9a\xfaA\xbb\x89\xd3:l7;\x9bƼ\xb3\xdb>\x00\xe8j\xefnlf\x0fx\r\x04\xc0Ý\x8d\xa0ӑ\xc0\xb5\xdf\xdcٴ\xe3\x9d\xdd\x16n\xc4Ak\xaf\xb9\xbfi\x95\xf7:{-\x84\xc0\xf6F\xc8\xd9o\x1d\xb4\x00\xba:\xbb{\xedM\xed\x1c\x1cv\x10H\x0fv\x0e7\xee\xf9\xee\xc1\x1e\fg\x7f\xefp#|\xedu:\a\xb8\x80{\xfb\x1b\xe1k\xa7u\xd8\x02\xcb\xf3vsc\x95\x83\xbdv\x13`\xa7}\xd0\xdex\x82\u06dd\xe6.\xd4\xe9\xec\xedm:z;\xfb\xfb\x870\xf1\xbdv{Ӏ\xdb\xfb;{M\tȇ\a\x1b\xeb\x1c\x1e`O\xfb{\xbb\x87\x1b\xa1\xfd\xb0\r[\xbe\xd7\xd9\xd9knD({;-\x9c\xd5\xc1\xc6\x05\xdco7qqZ\xcd\xce\xceF\xfc\xb6\xdf9\x80i\x1d\x1e\xb4;\x9b\xa6~\xd0\xdaœ\xd5:\xeclē\x9d\xb6\\\xe5\xc3v砵\xa9N\xf3\xe0\x006}\xe7\xa0\xdd\xda4\xe6\xd6N\v\xfb\xda\xeft\xda;\x9b\xb6bgg\aO\xfa\xe1\xee\xe1\xee\xc6\xf5i\xed\xb6\x11\xc3\xed\x1f\xb66bӝ\x1d\x89\xe1v7\xeeik\x7f\x17\x91\\gw\xbf\xb9\xb3q}v\x0e\x01P\xf7\xf6ww7A\xd8^\xb3\xbd\x87\xed\x1c\xec\xb46\x1e\xbf\xe6\xe1\x0e\xde\x11͍U\x9a\xbb\x88S\xe0:\xd9\xdd\b={\xb8\xa3\a\a\xbb;\x9b!u\x17\x8f\xe8\x1e\x88C7\xed\xd6a\x13q\xdcn\xbb\xd9\xde\x04\x85\a;\xfb\ba\x9d\xc3\xf6\xfeFܽ\xd3ib_\xed\xe6ƻ氳\x7f(\xa1\xe7\xf0`\xd3*\xef\xeeJ\xc8\xe8\xec\x1e\xb47ߡ\xbb\x9d\x16\x8eyg\xe3x\x0e\xf6\xf6\x9aM\x84\xc2\xc3\xc3M}\x1d\xee#:\xd8\xdb\xd9\xed\x1cx<\xf72o\xf8\xaf|\x8d\xc1\xc4\xff\tϢ\xd1`\xb6#\xf5d%}mR\x19%^:\x7f\v\xd2M\x8eD7\xa9\xd5d\x9a'H\xf2\x94\xa5%\xb2<\xc3\xce\xcfy\xfa\x1a9P\xf0\x1b\xcbʕ+A\xe61\x06\xa0\xbc\x9f\xc3`&\xf8\x05\x1co\xb2\xc1\x81\x1e\xe3x\xf0,\xf4\xc77\xf6\xdd\xda9\xd8;\xdcط̄\x01\v\xfa\xc0a\xc4\x18\x84\xe3\xdbV\xa1\xd9<\xec\x949\xf1\x03萜\xac\x00\xaa\xa846\xa9\x8cԢ\xe2>\U0010305b\x12\xba`\xa1\x8c\xa5P\x8c\xd1RA\xcb\xcd\x04\x06\x196Fq\xc4{\xc2]@\x82)\x9d\xdfbA\x1ab\xca#7\xa6>Y\xdd\x19\xb9\xa0`\x92m\xfc\xea\x8c/_\xd7\xca\xe4\x1dY&\xdc\x11\xf5\xe5'\x013\xc1Q \xc9w>\x12H\xec\x06\x14\xa3\x0fА:\x11\x04\x94\x00\xef\nS'\\\xab\x83\xc2\r\xac\x94*\xefU\xe9\xd9\xf9m\x9b\xd1\xe9\xb4Z\x87w\x1e\"\xb4\x98\xce\xe5V\x10\x1br\x1f(\x99\f\xe8\x81*~\x05-\x8b+~Z\xf1\xcd\x12:\xdf\n2\x87\xed\xbd\xbd\x9d\x12\x90AL[\x04\x19;i[\x13\x0e\xb1\xf66Ot ͘AP\x89n\xdc\xe0\xd1b\xc6\x13\xf4\xf9\xb4\x1f\x96˭\x16\x8d\x1bR\x1e\xb7H\xb4O\xa8\x83\xa0\x06&\xff1\xba\xb3^%\x81P\xef\b\x85\xd5BI\x92tL/]L;\xaa\x058I\xf1\xccK\x94\n\xf4@\x8f1\xf4\x056\xe6\x98w\x0e\xbd\xd5]y\xe0\xa8K\xbf\x15\at\xf6v\x0fK\x97\x11\x10\xb2\xc4\x01HE\xc8\xf0\x19\n3\x04p4\x0f\x0e\x0e\x0f\xcb1C\x96\xe1\xce8~F8\x0f\xb2\\\xf2\xbe\xf3_\xff\x15\b\x9e \x83)\x83\xc4'ʙ\x9fcp.\x8c\xf0B\x96K\x88$Y\xad\xae%:7h\xfa6\xc1\xd8Љ\xf6(hھ\x89\xe46s\xff\xf2B\x1a\x958\xe1\xa4O\f\xca\xef\xdd\x02>\x80\x1c#\x9e\xfaբ*dO?\xad\xd5\x06p\x92r\xee6\x12\xd6\xf9\x8a\x8e\xbdp\xb5\x92O\x05\xc8\xd7\xd9\b}!\xf8l.*\"\xae\xc8i\xf3J\x14Gu\xfc}\x11f\x87\xa8q\x16\x9dD\x958\x19\xf1\x04\xea^\xf0\x8a\xaeB\xf1\x03\x1f\xae\xa0\x8at&He\x0e\x91\xa9\x7f\xc9+~\xa5\x7fz3\xbb\x88Æ^ՁK*3.\xa6\xf1\bR\xff\xe9\xb8\x1f\x10c\"\v\x0eu\x9b\xe6V\x84%Yʜ\xdcZ]\xa2\xc7I\x82n\xf3\xae\x91\xcd\x0e\x19G\f\x8aP\x97[\x989\xf4\xb3`\xb82V3\x80\xa6\x87˥\xbc{\x93\x86lf\xb9Կ\xc0\x0f?\x88\xfc0İ[s\x85J\x16\xabշ\xa2\xb0\xbdV\xab\xbd\xbb\x199\xdc\x7f\x14+\x01\xa41\x92\xc5*zSB3l\x00\x99ilT\x00\xcf\xd9yl\xae\x88\a\xd1OX\xf2\xcdǲ\xddi5[%\x13@\x8aU\x1eK$\xca\vI\xaa\xa0\x9a\xe8\x1ag0=K\x9fE\xbd\xd8\x15,\"r\x9b\x05\xf1\xca\xddfZ]qT\f\xed\xd4\x15\x1aGZ\x17\\_\f\f\x99\x14\xc1b%D\x89\xc63'\xf7\xa9\x9f\xbe\xbd\x8a\xde%\xa08\x177\xb2\xe7\x84F\x98۩\x1f\r0\xb5\xbd\xf1\xad\xf8\xb6\x85\xa2\xfe\x86\x10c\xd6ܿm\x03v;\xad\xce\xfe\x83\xf1\"2lw\x90I6\x1e\x94q\xe0$6\x94\xbf\v8Q\xd3S\xfe\xb7\xd3S;yI\xc8\xc6\xc1߃\xbe\xe5y\xe5=\xf8\xeb=\b\x97\xcb\t\xe9\xb4\xed@\xa3\x81\x87~(\xb1\xc2V\v\xfd\x98\x00n,\x8c\xd3\xddrC\xe6\xfa\x06\xc9\x10D-\x00%*N\x80/\xe99B\xc1GKC\xe5\x16c\x82t\xa1a\xd2\xcd(=\f\xa5\x130n\xd0\t\xf4\x17>\x14\xf1,\x14\xe2\tLL\x8c\xf4[\x11\xd0\xee\xde\xc1\xc1\xce&\x1a\x8a\xdc>\xf4\xc6\x18\xf1T$\x8b\xa1X$\xff÷\xc67\xce\x1a\x04R\x7f\x7f\xd6\xe9<\xe1\xfe\xe8\xff'&|p\xd0.;h\xc8:˃\x86ܸ\xc4\x12(\"\x91\xd4\x13\xf2\xfa\x84\"\xeb\nB\x11BC\xf8\f\xb8{`a\x12\x17d\n\x84\x0e͝E\xe7\xf0\x1e\xae5\x92\v\xdde\x1dV\x97KogY\x90\"\x8a\xc1P\xa1)\xf3\xddT\xa1\xfe\x94\xe6bz)8ǸT\xc4\"w!\xbf\x01M\xf0\xfc)\f\x9b\xd0\xd4 \xebd\x03{\xf4\xe0\x8b\x84F4f\x12W\xd8WJ\xcf~\x00gH\xf1\xefv/u\x136vUr\x84\x98\xc8\xc4\b\xea2\xc9\xcde\x8e\xfb\x10C\xd81\b\xe3\x12bD\x82Ѝ\t\xf1R7\xb2\xdb\xd0\rD\xf9\x06\x86\xd8@\xe0\xc6T\xc8\xc0e\x7f\x93\xbf>8\xd8锣a\x04\t\x84\x0e\x90vI\xe88hv\x9a\xcd\xcd\x18لI\xe3\xf9h!\t\"Y\x1f+!\x06\xd6N\x9b\b\x0f\xb0#2{ChgoHX\xd8\x0f\x064v\x051\xd93\xc9\x13\xd6\\.\xd7n\U00079ebfO\xd2c\x03\x1d\xf2\x1b`K\x00\xba\xb4(\x84dX\xf3[\xa5\x00\x9df\xf3\x9eӄ\xeb\xf5\r\x8b\xe4Ӏݮh\x9a-\x8bϚ]\xff\xc8\x00\xa7\x8f˒\xf6\xfd\xd2eq\x033ˮI<\xfa\x8d8\xa3\xb5_FX\xb4\x9b;\xfb;D\x7f@\xe3\xec\xba\xf63$ \x19\xaf\x9d\x96\xc4\x1c(ē\x98C!\x11\xc0\x1c(\x92\x93\xa8C\x8aO\x10u\xa0Ȕ\xd01\x96\xee7w\x88\x1d\x1b\x8bl\xd2CNKL \xc47\xcd[E\xb6\xc1\x00\xa0E\x18\xa3#\x96\x14\xa8E:+s\xae\x8d{1\x84\x10\x9d\xb0\x99\xc1\xe2˥M\x83\xd0K6k\xf8\xe9M4<\xb1\xdf\xe7J \xc5¬!b\x19}\xf7\x83?\xc1*ֳS\x92]2#ɾ\x91!\xc0p\x88+ G.\xa4˱\xe5\xb4}\xb1\x89\r\x91LĪ\x90l܊\t\x04!ʬ\x85\xb4%2\xe7=\xe1\x9dS\x9f\x05n\x9cU\x00\xb8A\xcf{\x88\xa4\xd2\xcf`\xd9o\x9c\a\xd1e\xfce=ǥ\x04O']\xa4\x10\xe3\t\x82\x1f\xf9\x89p\xd6B\f\x83\xcc\f\xe3\x04\xf2k>\\\b\x19\xfb\x99E\xeb1\xaa\xbe\xe7\x91܆J\x90V\xfc\x10.\xfa\x9bJ\xb2\x88\"\xf8Dz\x8fk\xf3\xbd\x91l\x02\x9b\xc5v\xe09VM\x9a\xbc\x9d/\x80\x86\x03\x8a\xb2!/s\x16Ӥ\xe1'\x13\xe6w\xbbZ֖4F<\xe4\x13_\xf0n機\xb27n\x00·`\xec\xca0F)c\xec\x9a\f\xe3H\x04тw3\x1a\x10F\x10\xa9\xc0\xae\xba\x1f\x924R\x1e\t\x964\xce\xd5_?\x99\x98\xd4\x05ـM}\x9cGa\x1d\xed5b\xd6\xc4\xe5\x14\xbaIc\x14\xa4s\x00\x93\xe3\xeb!\x9f\xe3J\xe3\x1b\xb2\x82\x8e\x1c9B\xbb\x17\b\xdd\xee_$\x8b\xb9p\xf5[\xd9\x16\xe9F\xcc\xda\x1c\x15LW\xa7\xa2\xc7u\x8f <y\b\xad\x85\x18\xa5V\xa2S\x96Ha\xa95:/\x9bƯ\x01\x0fG\x0e\rq\xc5KVO\x1d\x15|OQ\xb4\"\x9b[\xad\xb2\x15\nuL\xdc\xe2\x12\xa8\rU5ն\x86r\xfe\xab\x15F\x10J\t\xf5K2\xeb\xc39S\x03\x80\xb6==7`\x11=\x9e\xe1\xf6UQH\xac\xaa\xab\x1e\xb16\xa4\tl\x80\xfd\x1d\xbb\xea\xcaܠ\xb7\x96\xf5\xda9\x88\x9a\xcc\xd3i\xee\xe9)<\xc1'_\xe0\x93\v\xf7\v\x9d\xe4\x02\x96X9\xa0V*\xf1\xd6s\x86!\x9fR\xf7\xb5\xdb\x1f@$\xa9\xe7\xd5\xea\xf3-\xc6\x12\xc8l\x83\xc3~N'2}\xe5s\xf9\xc5[\xf64;\xdf\xec\xdc\xfa\x1d\xb8_,,\xff٘\xd9uCW\xb0\xbe\x12,\xeb\xa9jh\x19\x98\x9b/G\x1aID\xe8n\xca`\xa5\xb0\x87+(\xa6\xc6\xca\x05\xb7>Q\x02X\xfd\x9c\xb8Fzo\x80\xb0\x1f\x0f(\xa7\xbe\x84C9\xa2\xad\f\f\xa1\xdaBn<\x1d\xb2\x85d\a\x8d,\xca\x0e\xb1\x11\xb9CbVjH\x9d\xf3s\xff\xca\x0f\x04d^3b\xfeaC\x95*q\x7f~R\xea\xa0S\x8e#\\\x11Z|\xad\xd6K\xbf'\x9e\xd5rY\x8bj\xb8\x8c\xd3\xc0]\xac7\xa8\x8d\x1d\xd7\xda]\xa5\xae\x02u\x19\xfd\xcd^g\x1bKG\xe46'\x02\xb24\x0f\"\x17\xff0\x86\xe1s\x1a\x01\x8dlS\xb71\x8b{\xb1\x1c\xb8O}\xe2\xf9\x10f\xcc4\xf9\xc6f1\xb8\xb9v\xfbB\x9d\xceA\x16\x96\x951&%\xb9\u00a0[\x99\xa7!;\xea\xc2F\x86Yk\x8a\x1b\xc70\xaa\xfa\xd0k\xec%\xf0\xd4\xcb\x1e\xa8\x1cMY\x83Zhr\xdd\x15E\xbc![(\xb0\x9d\x1f\xa6\x8as\x84\xabh\x14\xf3\x14\x930ϓ\xf82\x18\x01\xb7\xf8\x18?~\xac8DǬֵ\n\xb0\x02\x19\xfb\xb3\t\xc8>l\xf0\xc5\b+\b\xbe\xea\xc3MÂ\x7fiqŮ\xbb\xf2z\x8f\xe45\xa06\xaa\x17KT\xec\x8a>&\x81[\x84\x02´\fX,a\x8c\n\x14\xa10\x8e\x7f^\xc5Cs\xaa\xb7\xb2\x95\xca-\xb2\x84u{\x89\xc9\xfaX\x88\x17{\xee\xc3Vլ\xa8\x1c]%\x90\xcb\xeaG\x8a#wʚπ\xedCf\n\f(\xfcU<\xf4 ?\xee\xaa\xdbBy,\x0e\x1d\x11\xf6\xabx\xc88&\x85mgo\x94$G\xbe\x83D\xd2\r\x7f,x\"\x9f;\x03\x15mR$7ǑH\x02\xae\x03\xad[\xfd\xbf\xcb\xfa\xe7\ru\x15\x05q\xb4\\\x02+\x8a\xbb\xc9\xccM\x02\xb3\x10\xbc\x82\xcb@\xed\xdaLd\r\xbe\x97ʂ\\\xb7\xac\xaf\xe7\xe6$q,\x9cՀ\x82\x18L\xf3T\x1f\xa8̹\xa73\x03r\x81\x815M\x93\xaf\x95H1\x1bi\x7f2\x90a\x9b\f\xa4i\xc9\x1a\x80\xe3:\x15-\xc1#\xcb\x1b\x01*\x98 }\xe3\xbfq\x8dnE\x1f\xf8zˊ.W\x11:hs\xad\x96\x1cq\x13\xb0\x19\x93?\x19\x8e0\x1b\x85\xc2{\xfd\x04\xb6\x02\x00\x17\x02Њn\xe1\xbd:ٺF\x93\n\x13\xffSF\xebe\xd1ʄ\xb8\xe6\xd7\xc2{aa\xa7\x17n!łjM\xabs\xf4\xa9=\xb5\xaeŧ\xf4\xc2}\v\xa6ꑔ\xad\x01G\x00ɨݧ\x85\xc2SBO\x91\x00\v\xfd\x9b7\x98s\xd5}JohF¾4:L*\x1aA\xbaV^\x927\xa1l; t\xad\xd5q\xd7\x04\x98\x06\xa8\x864!\xcbeI\x9f\x8c1W\xd8\xc3[.eTg\b\xc1*\x1a\xe0\xdcS\xa6\xba^\xa0T\xe4)\xf1 6\xf99\xae\xca\xf99\xae\t\xdf07By\x8e\xa8x\x8b\xcaF\xd1\xf0\x91&Z\xef\xe2V]\xaf\x18\x9d\xff\xb3{bs\x1f\x17\xf6#\xbd\xbc\x83\x1a\xa2\xa2\xf1\xd4\xe6\xda\xd8\tt\t%k!$Q\xa7\xaeo \x1fS\xb1\x0e%m\x14 ~:q\xaftU\x024\x86\x01\xc0\x92-s\x13\xd2\v\xbc@\x89\xa2\xcb\b\x04\xad[AL\xac\x92M\x98\x0f0\xfe\xedgX\"\x801{=\x1dYt\x17\x01(\xe1R3\xa2NIM\xa7/\xd1h\xc54;p\xe4Z\xe5\xdd\x15\f\xb8\xf53-M\x82\x88\x92\b\x89\xf5\x12\xb3\f\xf3L\xd8aEPM\xb2\xf8칸\xec\x11\x132j=\x8a\x9ad\x93&\x9b\x98<\xd0\x11M\xcciO\xb2\xa4\x85\xfax'\x101SVM\xd9k\xfaނ\xad[\xeb\x14x\xef)\"?omRгJ\xea\xcb/YS\"JD\x14\xea7\xb2c\xf8Krf\x1a\xc3@\x89AC\xf8\x90\xbb\x88\xb0\xa8p1B\x91E~\x00\xe1\\\xc0\xe6\x86\\~G\xe8\x16'\xb9Ն\xaa\xc4Q\\\xa3\x95\x88VaK\x81F#\x10zMb\xdf\xda\xd8M\x8cd\xb3EtN'\x10<i\xa3\x0e\x19L4'R7\x93jv\xad\x94Q\xd9\x001_\x98\xb9\x9c\xf2\x14\x8a̐\xa2\x18Pn\xd3\x1a\xd8Hr\xe9\x87+\xbaƂ\x16\x83R\x9b1膬`\xd3]\v\xa2Pla\x04\x0e\xf26Ռ\x16\xae\xb2!`\x12\x1a\xddK\xa6lmE&wmT\x9c\xb4I\x97ߍ e\\\xbd\x9e\tL\n\xab\x13\r\xa8\xcf\xe2\xe2\n\xe1\xc5\xcc\x18\x8b\x1b\xf2\xaa&\x19\x9d\x8eQ\xd5eTQ\xf9\xee\x88\x19h\xd4\xf2\x06\xb5\xc11u4\xb5\xe2\x80\xe4%+\xceH\x15\xd9VP\xad\xa6\xd9ZBSG\xb1\xa1t\xb2γ2\x90\x82w\v\xf5\xb3F\xed/\xacҕ\x96N\x04_\xdd\x17~\n\x1fm\xa5\xeb2\x1d\x91\xdcT0~!\x88\xee+W\x81\x98\xc6\vQ\xc1\xcf+qRQ#p\xbea\xc0`\x00 \xc5\x19\x85\xf8\xbd\xe6\x9cm\xde\xf9D\uef11a\x15w>\x91\n\xcb\xf5]4\a4\xcaoT\xb5\x9a\r>\xb2\x87\xa9@+\x92I:V+0\x05r\xf0\xb7#\xe3\v;Z*\x82Ϙ\x96\xce\xf4Z\xad\x8a#f\xcf\x1a\xec\x88dN;\x15\xd8;\xee\xd9\xf0\xe9\x19\xcb\x16}\x88\xb8:>\x82\xc6*a}\t\x16\x83\x9f\xb9n\x80\xae\xc77\xaai\xee\xfa\x10>Z=xkr\xf5\a`\rk\xceX\xa58q,\xece\xc3\xc1o=K\x8a\xa5j\xb8\x06\xfb0\x83\x81\xb1n\x1eG\x1b\x0e\xd14(\x0f\xa7gI\xb2\xb8\x92-\x99\x14\xfbXO\x10z\xbd\xa2\xe3 \n\n!\xf63\x05\xd6F\xb0\x12\x12\xac\fO\\\x04+\x81`\x95\xd8\xdcH\xa6\x8e\xa8\xe4W<\xb1\xf6\x95&\x86]!\xf4\x9d\x9b\xc0\x10W\x14\x0f\xd1\x7f\xd7\x10Eb\x86\xa7\xd5\x10\x1b\xaf\x8aȒ\xc5h\xbe\x14F\x99\xc5\xc6\\\xad\xe1\x85 \x84\xfb5T\x98@)xAת/^\x94\x1ez\x9blprW\xf4\xadf-=`\x80h\xc6\xfdz\x82*n\xd7KVԈi-H\xd1[o\xdf\x1e\xb0\xb6\xc2\xd2bN\xbf\xd1\xceh\xbf\xb3_fg\x84V\xbd\xcaL\aܑ\xa8o,\xe6P\x87\x83\xe6\x01\x9b\xd5X\x9a\xffA+\vi\xc0\xb8\\\xfa\xeao\xe0~\xab\xb6\xba\xb5\xbbwP\xa6zB;`\xa5_\x03\xb3\x97\xf5\xe1\x82^\x9fܝ\xcd\xcb\fV\x0e\xf5o\r\x14uawۯ\xa01x\xce\xfaIX\xc4yf\xf8T\xc2pE\x10\x99\x1am\x01l\xcdRɔ\xd4;\xbe\xf26\xa4\x90(m;\xc7\xcc14\x97\xe1[p\x80\f\xa1\xdb\xd3\xdd{\xa6\x87o3~\x02\xe1\xc5߶t\xc2}.\xb5\xbd\x065!\x8d\x8dy\x13\xf5\xef6\b7rB\x15\x8c\x1a\xf5\x1d\xc5<\xa0\xc4֤\x19.\xcd]W\n\x1a\r\x9d\x96f\x18\xd2\xf8\x80\xd6[\x9a}q\xdej\xd1/\v\nK_\xad\xba\x01˕H\xf6\x98:\xaf\xfd9~\xb0\\:\xa7\\~\xdb\x03\xc0\xf5\x9c\xa7\xda\xfe@\xbd\xdf\xfe\xdd\xedy\x1f\x83\xe5\t\x89\x84\xdb\xf3\x0e\x96\xad\xbde\xa7Mܞ\xf7<\xf4gs>\"=t\rx\xa4\xb2.\x06\xa4''\xa6\x04\x11\xdfl\x82\xdd̻>\x99\x03\xd0\xda\xdf'\xae\xbd\xfcQW\xea:&\x9a#|\xbf\x88D0\xe3,\xcaT\x1bk!\xa8'a|\xe1\x87\x1f\xa6A\xda\xcb~6\xca\x1a\xf1\fc\xec$\xa8$(V\xa9\xb0J\xe2\x10\xf0\x85_ѝÝ\xf6\xa6\xa4\x9ev\x82\xd52SK\xb5\xfd2q\x8a\xd6\x02\xff\x03\xe6\x97Ic\xe4\nz\xfb\x1b\xba\xa9F+t\xf6\xda\xd9{\xc803̦aS\xe6\xd6Y.\xff7\xb4\x9d\x8c6\xd8A\xaeO\x7f\xaf\xd3\xd9\xdb{\xd8.\xddeO\x81\x99\x9fV\xb4\x98\xdb\x10'\x16\xb1f7:\xf2\xf5\x1aDhY\xe1\x03\xab\x95\xa5\x9a\xd6v\x15\xf1\x9a]E\\2\xe8V\xde[i\xe3\x98\xd7R\xfd\xea\xa6\xdea[#A\x9f\x1e\xc8Vuڱ\x98\xdd\x06\xe9\x9bXL!9G\xf4\xedI\x8aE\x8c\x88\xa0\xe4;\xe9<\xd4\bR\xfc\v\tW\xb9\a\xaf{\xfd\x81\a\xb1&h\xc2\xe7\xdc\x17\xe59\x8e\x98\xe3t\xa5\xbddS\xf9\x1e\xb1\x16\x89j\x8c[~GA\xfa\x06h\xa4\xe0\x92\xffƓ\xb8l\x04\x98\xe6\xa4Z}\x83\xa6\xf4\x8d7\xc7\xdf?\xfdp\xf2\xf3\xf1\xf9ɛ\x97'oN>\xfc\xca\x18km\xc3\x1dt-x4ڔl\x89\xfaRʬ\x86C#\xe6\xe6w_\x10\xa2\xb7<9\x8a\xe4Py?f~?\x19\f\x98\xe8\xc7\xc6Y\x8a\xafV\xf6͝\xf5\x03\x99\xde\"\xc6\x1b\t\xf7S\x90\xbb;\xee\"\xfa\x12\xc5WQE\x96\x10cy\xc0Q\xc2\xd9s\xe5_\xc4\xec\x90å\xc6\x1e\aQ\xc5y\\\xb3\xcak\x8f\x9d\xcacB\x93\x1as\\\xa7\xa6\xbf\b\x83\x88\xd7Z\xa4\xe6xY\xd90\x0e\x17\xb3\bK\x89CA\x00\xab^\xa8`<\xb2\a\xe7,:\x8b\x9cZ\xfe\x15\xa1Q\r\xb2\xf0%ċV\xf9\xbc\xa2\xe4\x16\xe9\xe1L裄\xfb06\xe6\xfc\xfa\xf4\xf5+#ZQ̌\x9c,\xe3\xf2\t\xbaaB\xfd\xe6i\xeaO8\xf3\xe5I\xdfj\x11\xaa\x1b\x9f\x8bE\x02I\x01\x86_>$\x90QuC\xb9\xfcP\xf1!\xe6jT\xbc`\n\xd5d\xe6%\xfc\x9c\xc8\x12\xc8\x1b\xb0\n,I\x9d\xdav\x99Vŕ=Yb^\xab\xaa\xdd\t\vhPr\xc1\xb3MZe\xdc<ǫ855]\xc8\xdd,M7\x82\xae\xed\x0e%mb\xb2dG\x8eC\x03\xf8'eVʦx\xbbM\xea&9vT\x17OR\x14\xb0G\xf5\xb4\xe6\xfa̩4\x1a\x8d\x8a\xa3A\x98Ф\x1ea\x8d\x84E\xb5\xb4\xee\x06\xb2\x86U\xe16\x15\x89\xe7\xd7T67\xb4'\xb0ҏ\n\xc8=\xfa\x7f\xfc?\xfe_\x0e\xa9\x05t\x1e\xa7^T\x175\x8d\x13-\x15\xc6\"G\xf2\xc7\r\x89\r w#\x15u\xa3\x90\xa9\xf1U!9\x82\xc6Ϣ\xb0\x19BZ;\x834\xb2q\xb1\x18\x8fyB,\x9c\b\xdaQ\xff\xfa\x156\xba\\\xba\xd6\x13\x03\x13;\xedm\x93\xe5j4y!M\x8aH\xd6*\xad\a\xe7)}\xc6\xc7q\"\xb5q\xd63\xebl\xfe\xe2)\xf0\xbc\xd9\a\xf8\xc8ږ\x0f&\x8d\xd8\xf6Y\xd2;\x8b\x96g\xc9\xf2\xac\xb9=\xa1>\xeb7\a4\x00\xc3\xf2\x94\xc1\x8e\xb2He\xc6\xd7\x13\xee\x92@\x89\xb9\xe5}C\xa8\x9f{\xae% \x15\xd5\xfb\xc8\x1b\xf38\r`Q\x8f\x98\xaaP\xad\xa6GMi9\xab\xd9\xea6鮕\xa9l&C:\xa7c\x80\xb7)\xb32\xfb \x82\xb1\xa7E\x03ݥ\x01}WC\x13\x1d1k/\xeaf\xb1k\xd3ZG.Ɛ\xb5\xba /\xca/\xf4\x96\x9bևGM\xd2\x1d\xd6jd\xceB\xb3\x06\xd4\xef\xa7\xf5\xe1\x80\x06\xf2O6Ǻ\xeb\xf7\xd3A]\xbe&t\x046\x81y\xa8S]\x93\xda\xc2UӨ\x0fk-{\xd0tJjNeYqj\xf3F*\x92\x1ad\x03\xad\x8dq\x98\xc51\xe0\b\xec\xfe\xb1\xc7ڽ]\xde\xdfa\xbe\x95\xbaC\xed5\xabArݔԜߡf~\xed\x14\xc8m\xb9im\xf8\x84\x99M)]\u009a\\\xc2چ%\xac\xe9%|\xc0\x84\x1e\xb0\x86Ƭ\xce\xc2#ѣm\xb4\x16\x84\xac\xcdΗ \x1a9ԙ-B\x11 9\x8e\x86'\x8e\xa5\xb8t\xa8\xa3\x8d\x00ߎ\x1d\xf0\x18\xe4\xa3`\x88\x99\x8b\x9c\x84\xcf\x13\x9e\xf2HؿA\x86\xe2PG1!2\x13\"uR\xf8\xfb4\f\xfc\x94\xa7\u0380\x8e\x19$;\xf4C\x1f\x98\x80\x14\"\xd4EC\xa86\xf3\xe7s\xe0\xef\x06x\x0e\xa6e\xd8I\x80\xf6\x9c\xe6\xe9\x84\xf2\xfc\xd2\xc1ح\xb7\x18csC$\xe6\xbcJS\xf7\xf1GE\f\xc4xY\xc25/\xe0r\x0fR0\xd3\x00J\x1a\xd9\aܣJ<\x86\xf7x\xf9\xc3\x15\x8b\xcc|\xe31\xa6y\xc1\xcbE\xb6\x91\xea\x8bU\xf8\x13}\xdf\xc2\"3\x81\x7f$.5\xbaxXlf\xec},F\xc0$sk\xae\nW+\x13\xd9\xef<\xe7\x90\x11\xef\xf8E\xb6kLX\x0f\xf6\b\xcc^2\x91\xfd\xce\x0fQm*\x13\xd9\xef\xd2\n\xb0\xebL\xe4\x9f\xed\x8a680\x91{\xb4\xab! 2!\xff\xa2\x8b\xac\"#2\xe8)\xd1Jf\x12lM?W\xab\xf7& O\xc8-\xb0\t\xe5y\xb9E_\x1d+N\xc0`Vf\xd0\x16+W䆢oF\x84\xb2q\x06ez\xd37@\x1b\xbcBX\xd3\xf5\x14̥s>\f\xc6\x01\x1fU\xc6qR\x0el\xddbzaI\xe5\xf63J\xb8/6LI.Ub(\xea\xb2%A\x85\x1a\x82.J\xba\xfd\t\x98\x7f\"\xf8\xc23\xfc\x80\x02\xb9IP\x82\xbf$у+\x94\x00\x97\xcc\xf1W.'q\x9e\f\x93l\x018\xa3\xcd,\xeaM\x96\x96\xea\x9c)\xce\x10\x84@\xb6A\xf2\x94$:\xef\xab1\x93-\xb2F\x04\xfd\xc2\xe2h\xe8\v]\r\x95N\xe0~]\xa8\xdb\bf\xf30\x18\x06\x90\xa9q\xed\x1d\xbfV\xefr;\xea\x9cb\xb4T5\xf4\x8af\x93+\xe94^\x84#\xf0%\xf2ј\x89V\xfa\xf8\xb72\xa0\xa0\xbf\xf2+2ʪ\x8d]\xdcۊ\xee߫\xf4\x1b\x8dƀV\xf8u\xae\xa0\xb2\x82\xfce\xd98q݅\x99]6~B\xb3\x01#\xbd\x99-\x815\x91\x95(\x87\x93u_\xfciq\xd6\x06N\xc3 \x15\x80\x18\r\x8c\xa6\x15WN0\x88&!\x97\x93\x96l.\xa9\xa8\xc8\xc1\xe0\xac\x0f\x8eUֻ\x86T\xe5\xf1\x06\xc4C\xff\x0fB\x99\xbe\x1d\xe00\x9b\xe2\xfc0>Ly\xc2+\x81nO~PQf\xeb\x15\x01\xf6xjE\xcc8\xf5\xca7*'\xfa\x95ĽA4\x81\xf7\xe9b8U\xf3P\x96f\xe9b\x0er1>2cD\x98\xdf8\x12|\xfb\xd0A\xbc\x96\x95\xfdIZ\x19\xfaQ%\x8e\xc2\x1b\x00\x1a\xa8\xc8G\x15?5\x10\xd0p\xe4\xf1\xfa\xdfiǴ\x85qT\xe0\x17\xac3M2\xc3+\xbd\nLbG\xfd\x88N\x03\x1a:\x81\xe75\x00\xaa*\xf2뒊\tT\x04\xc5R\x10\xf2\x91\xdeI6\x02\xfd\xaanر\xab\x1c_\xdbU\xf8uI\x15\x98\xdbk\xdb\xfcH\xb9\xaa\x03'\xc8n%l\x81\xab\x88\xa6U\xe0\xb7\"V\xe0\xa7\x0en\x8bŰ\xab\x9e\xfe\xa6?Ⱦ\xe9\x0f\xcc7\xfdA\xf6M\x7f`\x8b/T\x1eHl\xa5\xe7*lۗ\xd8w`\x12]\xab\xf2\x86nD\xbf ^\xa2\xeb\xf6\x11\x83\x0fXb*\xe9\x12\x8e\x86\x0e\x1c\xec[ؚ\x84\x91\x1f\x89.\aQ\x8by\xd3\xe7ٕ\x12\x99=MV\xee\xfa&\x94,:\xa1\x91d\xb2'lF/рj\xea:\u009fx7\xfe,l\xc4Ʉ\xb6\x9bͶ\x97\x8aġ\xb70rϐ\x86\x86\xd2)\x13B黾\xc7=ȁO\xe8\xcd\x1d\x8d\xf3?\xb3\xc6\r\xb9\xf9\xd0\xe6a\x83\b\xbd\xd8\xdc\xfc̟\x9b\xe65\t\xfb\xe0\xd6o1-\xe0\x15\xb6>qo\r\xd6\xef_\xd2\x1bz1Pr\xc5\xe3ͽC[kK\xa7hʢm\x8d\x94c\x1a\xf1\xab2\xf2ɂ\x15\xe9\xdd\x05\x8a\x06R]\xfe/eq\xb0#\x9f]\a;\x93e\xce\x1b\xfb\xf7\xc7W\xaf\xf07Y\x95\xcd<7\xf1\x155\xd4榵a(\x045\xe4\xa4w;\xf4\xa38\n\x86~\xb8ަ\xf3\xbf\x9c\x15\r\xe3+\x9e\f\xfd\x94\x97\xbc\xc71\xaf\xe8b>\xdfX\x05\x87\x0f\xea\xf1\x19\x0f7U\x91\xad\x80\xce\xf9\xa6䵳ZQ\x9b\xac\xf5\x1c3$G\xed\xe1\xf5\xe6=\xbc\x88\xe3o\xde\xc3ֆ=4{&\x12e0\xb1\\:\x1f\xec\xdf\xef?\x1e\xe3o\xb2\\\xee\xea\xcac\x1f<\x81T\x8d\x97\xb9\x87\xa7\xafN\x8f\xef\xd8\xe3L\xb2}o\x87wC\x801K|\x16\xc7!\xf7\xa3\x01|s\xaf>/\a.%\xe0`\xb1H=9BOM\xb6\x144r\xd5q\xe0\x9eZ\x81R0\xc9W\x97\xad\xcbջ\x1b.,ǟ\xac\x89\x9d\x83#\xe0a\xf8\x11\xdbݵ\x9d\x81Jk\xec\xa3$\xef\xe9f\xd8\n\"\xf17A\x8b&42\xe0Ec֤>\x04_\x00\xc2#ʪ\x82~\xb6\x0e\x14\x9b+\x18x\xe0\x90jթ\xc1\xb3\x00\xc9 \xe3\xfdZ-\x1e\x10\xea4a;%\x7f\x1e\xd7Z\xe8\xb2f\xb0\x11\xb4q!m\x91\xf5\aR'\x16\xd7j\xdd\xf8(\xeaƵ\x1a\x18\x88;\xe7vGؖ\xd3ľ\xaaU\xa7\x85?\xb2\x81\xf9l\xab\xa9u[\x90F\x1a\xbf\x15\xe8\x85w\xad\xc6\xf2\xb0.\xb6\\Xw7a\x1c\xcd/\x9f\xc7#\xfe\x14\xfd\xbf\xab\xd5\x04vb\xb9\xdc\xdb=\x02ߪ\xe4\x88\xed7\x97\xcb\xc3}\xfd\xd4j\xb6Ƀ\x06\x14\x7f݀\xce\xdd\xe2P\xee\xede%\x9bb\xb9%\x82\xee\xee\xeb\xeb\xf4a}m\xb9[\xfer\xa9{\u0604)\x14\\1N#\x86\xa0S\x87l\xc9I\x96D\xf8\xdc!\x8ay1\xb2\xabs\x10\x81\x83\x01\xb9\x013\x90\xbb\xe6\xc0̩;\n\x91E\xac\xde\"T0lBJ\xd4[\x84\xf4\x9b\x06\x02\x8d\x1c[B\x9d\x05\x95\x12\x02\x13\xf0\aQU\xa2\xef\xe6~\x92\xf2\x93H\xb8\xba\xb16\xa1m\xd250t\x7f\xed֞\xac\x1e?\xac\xfa\x01Y\x95\xbc\xa7\x18m\xf1A\xc8Sj\xe7\x1e\x86;\xabU\xfe\xef\x16c\xcdju+n\xe4\xf5\x7fE\xccz\x11D~R\xaa\x97\xe4OX\xb3\xe74/\x9cZց\xdb&\x9eS_+3\x1b\xb2\xa2\xf1P\xf8\xe1\x1d\xcdŹO\x0f\xb0\xb9b\x99\xd5܈\x0f\x83ن\x06\xb3Op\x15\xa7\xfcڿ\xab\xba\xec\xff:\xd7Wk\x0fd\xae\x1f\xe1\xbax\xee\xa7\xdc\xc5\xe1\xdcS%\x1b]\xf1&P\xbd;Ԗ*\x99%\uede9s\x11D\xce@\xadQ\xff\x80:\xf1P8\x033\xc9~\xab\t\x92֡3\xc8M\xa6\xdfڣΔ_;H\xb7~\xc9\xe5F\a\x03\x94~\xbd6\xe8\xc1\xdff\xfdp\x00\xff\x9c\x0f\xbe\x03\x1b\x94\xb3\xb3\x86~\xc2\xd7\xfcx\x80U\xb1^\x8d\xf4\x96\xa6B\xad\xf45>\x9d\x9d5ܞ\x17D\xe3\xe5\t\xfc\xff\xcdK\xb2\x94E\x91\x1f-\xdf\xf8o\x96o\x9e\xbe!䑣\xfdS\xb7\x7f\xb7\x1a\xe1\xdb\xca\au\xe3e6\x0ec\xffa\xd7ٚHp\xcb\xdd\xfa\"\xcdj8\xd1\b\x8a\xf7\xb9Q\xbe\f\xeeCV\x86\xf5a\x12\xcd`\x90\xcf\"vj\x88\xf8U|\xa5\xf7\x1e\x10N\xaf\xde\xf2Zԩ՝LD\bx\xe8\tk*\x19\x8eAO\x14\xaa\x8c\xa1mу\x9b1\xe9)\x1d\xfb\xbb\xb7\xa7'9\x1d\xbb\xb7I\xf9\xee9\x8dȏd\x13o\xfc7^\"\xb1\xc7KX7W\xfc\xb7\xe1\x0f\x97\xff\xbb\xb5\x05\x16\x18%\xf8#\x87@6\xd8w)\x871Bҫ\x00̌\x04\xb9E\x12)#\x96<5>\x9c^\x17\x8b\fٖ\xbd|\xf3\xf4\x8dzi\x884\xeb\xa5\xff\xc61F\xeb\x9bV\x16\xe9\x9f\xfbG\x01\xfb\xb4q\x14'o^n\x1e\xc5I4^\x1bE\x99\r\xc5\x03FQ\xbfs\x18\xf5;\xc7Q\xcf\x0f\xa4l\xdft\xcdf\xa3\xe9d\xc0\x9fǣ\xf4\xb9<S\t\xe9eW\xb5\x03ʜ\x06w\x88\x97\x14q\x9eM\xfd\xd2\xcf\xecJ\x8b\x83o\x8d\xfc\xb3\x7fL\xaf\xe9S\xfav\xb0\"\xf4\x84}\xa6o\n\b̠-\xeb\x1fRw7=<r\b\xfd\xf0\xb5M\xf4\nO\x80\xef>\x88\xc1\xb2_9;\x13\x83\x1aɽ\xf4\xec\xeer\x0f\x12\xa9ʒ\xef\x88Ī\xd8\xc2w\xeeoK\x17\x10\x1f)v\x93\xfb\x9e\xf4\b\xe9i\\\xf9n3V\x04\xcb9\xccc\xf5m\x98Q\x85Yco\x94\xde\xdbD\x92c\x1ft\xc9}\xb8QZ\xf4\x80\xb39\r\xe9\x825\xe9\x10}\x0e\xba\x19Sኬ}\x19!9k\x9c\xaa:b\xdd\x19\xe4\x85/\xb8\x92\xd2\xf2\n栒\x92\u0604\xd5D\xbf5\xa0\x11\xfcm\x0f\xea-\x1aï\u0380n\x89\xfeNF\\\xf1\xab\n4\xe1\xc2?\x8d\x8f\x1f\x9e\xbb8T\x82m\xf8\xf0\xc5\x0e\xe8\xfek\xa2\xbf\v\xea\xff\x9a\xe8\xef\r\xa8\xe8\xef\x0f$\t\xbe`\xf0[!\xe8&\xed\x90\xeeB\xdd\x17G\x9d.YԘ\xd3t\xba\vV[hbM\xf4\x0f\aժ;d{|\xe7;w\xaf\xf9\x1d\x8c\xb29\xa8Uj\xae\xe8\xb7Z\x83\xe5\xb2)\tX\x98\xad\xae[\x1f\x12BC\xb6a\xacjY\x17\x84\xd0a\xb5\x1a6R.>\x043\ue18d\x89\xfaE\xeaCB\xc3\x15\xcd\xd4|\x1e4Q\x8aus\xe4\xd0\xc9\xe9[\xad?^)!\xc5\xfb;\xc4\\<\x99\xf0\xaf\x800\xe7\xe8Hq\xfeF\xa4\xb3\"\xf45s\x9e>{\xfe\xe2\xf8\xe5\xf7?\x9c\xfc\xf8\x9fW\xaf\u07fc}\xf7\xd3\xfb\xd3\x0f\x1f\x7f\xfe\xf4˯\xbf\xf9\x17\xc3\x11\x1fO\xa6\xc1\xe7/\xe1,\x8a\xe7\x7f&\xa9X\\^]\xdf\xfc\xd5l\xb5;;\xbb{\xfb\a\x87\xb5mv\x16\x9d%2\xe6ȋ;d*HF\xfd\x13\xaco\x93\xc6\x19\xfb\xeb\xb3ז\t]\x8c!\x7f\x917t\x05\xf3\xcd\rϵ\x83^B\by\xb2\xb7#y(q\xd4\xccڏjl\xcf\xd0\xf8\xff>`\xacy\xef\x19\xb3\xe9\x8d\xfeYr\x16\xb1\x81\xa4:h\xcc\"k\x804`M\f\x95\x88#\x15\xac\xd9\x15G1\x1a\x8f\x8a\x7f\xef \x9d/\xb2x\x88\xc1\x93'\xad\xbdj{w\x97Ь\xe4 W\xd0\xdeݭ\x06\x84Ѐ\x05GG{\xcbl\x9a\x91\x9e\xa6 \xa4kY\a\xba\t\x8b\xff\xbd\xf3\xdd\x1e\xe9}}/^\xeb\x00I\x1f\xfb\xcbf\xf1\xcb6\x16\x10\xaf\xd5f\x18\xcd${\xb3#\xab\x02X|\f\"q ux\xe9Cɞ웯\x17;\x95\xef\x99\xe3(\x91\x89\x01\xa1\x80\xbd\xb66\xc6W\x1b\xd31\x1b\x13\xd5XЏ\x9f<i\x1dT\xf7:\x03j\x1e۹ǽ\xeci\xafS\x05\xd1J\xcc\xdc\xf8\xe8\xe8\x80\xd4@\r\\\xd8\x0e\xff\xdf\x1d\xd2\xfb֖\xbd\xb6\xdc\x11S\xbf\x99\xab\xbfc=\x1d\x1dYm\xed\xed\f\x88ג;d>n\xe7j\xef\xe4jg\x9f\xd1\b\x90\xc5+v\x8f)3}\xb6y\x8b\xf0\x04\xbf܌ b[n\x9f\xa9\x05\xbeNt\x9e]}Ҁ\x8c띥\t\xcb̶\x8f\x92\xae\x00\xa5\n6\xc4\xc0d\x1bEhԀ\x9d\x9c\xc4\x00\xe4\x18\xcf$hE$/\x9e\x89A\xa3\x18\x01\xaay\xa5*\xc05\x06\r\xfa\x059\f`#?'\x97C\xe1J`Nml5\xad,\xdbb\xb2\xcaLN\xbeN1\xf2\xd7=;\xf0q\xf3\x0e\xcc\xfd I\xff\xc9-\xe0*\x14_\x96d\xc1\xd8cQ\xb9'\xc1\x86=\t`Oʶ\xe3\xaf\xf5\xed\b\xc6.,\xa8\x1b\xb3|\xfe>c˙\xed\aXD\xf4c00\x8c\xf0\xcf`p\xef:\xafO\xb4?\xf8G&\xaa&Y\x1c5-\x19\xa4q\t\x85\r\xfe\xf3\xbeC\x88\xa3\xfb\xf9.\xf5\x9bX\u05cf}\xed\x1e\xeb\x93%=\x1c\x82\xb1\xfb\xa7vc\x00\x93\r\x05\x95`\x81\x92\xad\xfe\xd7C\xb4T\xc6=b'%l\xc9;\xfa~@3\xf5\xdc\v\xfa\x92~\xa4?\x03\x97\xf2\xe9^,\xf5\x03\xdb\xee\x9f]7\x9b\xf5\xb3\xeb\xe6\xc1\xd9u\xf3\xd9\xd9u\xf3\xf9\xd9u\xf3\xb8~v\xddzyv\xbd\xff\xb2~v}\xb0sv}\xb0W?\xbb>|y\xb6x\xf9\xf2\xe51\xfe\xfbr\xb0\xec\x9f-^\x1c\xc0ǋ\x17\xcf^\xbe\x1c\xb8\xbd-(y.K\xa0\x06Y\x02g\xf1{\xbe\xda\xf2wR\xa8\xb6M\x7f\xc4q\x1c\xec\x9e-\xda\xcd\xf6\x01\xfe{8ئ\xffa\xdb}z\xd6?\x1b\x9cݞ\xad\x06\xdb\xf4{\x86\x9eJ[˭\xad\xe5V߯\xffuV\x1fԶȣ\xed\x80\xfe\xa6_\xf5\x7fϾ\x00\xc6\xe5\xdf\xc0\xb2\xf8\xf5\xf1ලZ\xca\xdf\x7f\x9d\xd5\xff\xd5=\xdb>\xeby\xffUeg\xb5\xb3G\xf4\xfc\xac\xb1\xf5\xbfξ{|\xe6\x9e\x11\xf8z@\xbe{\xb4m\x19_\xffjm\xcb\xfdW\xaf\xf9\xec'\xeb\xb3VSҜ\xad\x0e\xfc\xcd\xea\xfcb\xd59\x94U:\xed|\x15\xce7\xd5Y.75+\xecovvd\xa5Ö\xfa\xdbQ\x1f\xb5͏\xdd\xfc\xe7\t\xcf\x02Q\x94\xe8vz\xbc\xbes\xe0\x81\x1e\xc1\x15\xac\xd3^\x82\xa8E\xa0:\xa1'\xea\x87\xfb\xb5Vӫ\xb7\xb2֢\xdc`\x80\x8c\xe2=\xe7\xac\t\xf9\xb2\xe5\xef\xff\vdgU\xc5\x17\x8e\xd7j\xed\xa9\x81\xaa2\x01eM\xf5\x10\xc1\x83\xae}\xe9x\xadf[=\x8c\xe1͎zH\xe0MK>\xfc_\x1d\xaf\xa3*U \xa9,\xfe|\xec<\xf6v\xd4\x00\xb6!\x1f\xb2\xfa\xf0\xcc\xf1\xf6U\xf3\xff\x9f\xff\xbb\xe3\x1d\xee\xaa\xdf\xffoH\xbc\xae\xea \x9c:\xdeA\xd3z>t@\xe3o&\x1dۓ\xe6Glow\xb7\xb3\xdbS\xa02N\xe2\xd9s\xa5\xb4\x00\x97\xbc\xb2\xe2\xdd\xdd\xf6\xe1^\xcd\xe5u\xf8r\x0f\xa8\x1bBw\xf7:\xed\xa6)\xab\xb6\x9a\xed\x0e!&@\x84\xcf-\xc4\xdb\xde\xdd#4X+I9kvS~\xd4\xde\xdd림V#>\xef\xa7|\xc0\"\ue99c\xf4Z^\x93\x06vIv\bB.\x85cʎs\xbe\x10ڔt\x1c\x84<\x92\xa6\x96\xfa\xa7m>)\r\x8b\x98P?\x96\xcbG\xb2<\x8e>\xf9I\x04\xae\x11\"\xfbm\x7f\bN\xd1\xc3\x1b&ԏ\xcc\xf2\x12\xb2@2\x81\x7f\xb2B4P\x8ax\u0084\xf9i7\xa61&\xd8Ԥ\xcc\x1aغ\xb9\b\xbe\x14\xca\xf6\xa6\xac\xa6\xb2\xcbѣD\xc7\x02CFc\xa16\xa2\xd6\xd1Z\xc2 \xe2\xf6o\f\x8ci\x17\x9cH\xaf\x83\xa6^\xd1$\x15\x1f\xfc\x8b\x93\xe8\x15|Xo\xe9\xb8.Ci\xfc\xc2\xfa\x83\f\xd2\x16ܶ\xba\xbc\x85\xe5\xf7\xb8\xd9\t*ͼ=.\xf7\xcc\b\r@\x0f\xa5G\xe9Y\x86\xeb0\x18O\x1asS\xe9)d\xbd\xad\xf3l\xf4Ƽ5\xd1\xdeAl\x88FPh݅q'\xcd\x10\x87\x06v0\xe5\x85|\xca^\xcf\xd5k\x9e\xc1A\xb5j=(Z\a\xf6R}+\xe3\x05\x8e9\xbb\x05\x9b1\xaf4\x9e+:s\xe9[\xb4\xa1\x12\xabW\xab8\x16g\xb4\x80\xcd\xf6\xb5\x11\xf5\xbf\xa1\x99\xca(H\xf8\x10D\x8d\x0e\xa1R\xf1'\xf7T\x7f\x94\xafT\xf1\x87C>\x17`\x10\xe7\x0fExS\x89#n\xcc-\x1d#=r#\xb8\x9b\x94:B\x8b\xddj\xe4Ѷ\x943\xa1\xb2\x90\xe8\x1e\x820\xac\x8f\xe3d\xc6-\xc3Mp#\x99\xf2\xca\xda\bcf4p\x11ȜZ\x18\x80\xdd*k\xcb2\x98I\xac;XDr\xd4\x18n\x1e\x9bT\v\xa3\xbb\xd10\xe6\x10j\x16\r5\x9a\x14\x84\a|\xf8\x05 \xf2\x19\x84\xc0H\x99\x7f\xd4\xc6\xd6\xfdj\xb5-\xff\xccU'\xc6B\xf1\x9e>V\xf4\xc3\xd3\xef7m_\xb7]\xb2\a\x1f\x9e~\x7f\xc7\x16\x88\xab\xd8,\\\n{ \x87\x1e\xa3j\x93~/\x85\xc2\x11Y.ז[\xf8\x93\xcaԏF!\xaf\xb8x\xfcL;D\x0f;\u05f5C\xe8'u\xe5\x83\xfd\x1a\xa0\x83Ho\xe3c\x91\xd9^B \x95 ^\xa4\xe1Meć\xa1\x9f\xf0Q%]\x8c\xc7\xc1\xb56\xa8\x8e\xc0\xa0:\xeb\xfe1\xa1\xbf\xc9qƛ\xc69O8|\xef\xa6|\x18G\xa3{\a\x8a\xce\xd31\x1bqH\v\xfe\xf1\xfd\xc9s\x9d\xff\x1e\xf8\xab\xa1\xaf\x85\xf9ؓ\xd5<8\x1c\xf8\xa1\xec\x16\xdc\xd3b\xb2\xd2S\x05\xe7\xdcض\x0f\x9c\xf2B\xccf)\x1eD_ʣD\xc5\x1dΣ \x81\xf9\x9e\xd0\xd1R\n\"\f_\x1a\x1f\xf9\xdd\x18X\x03\xb8\xff݀\xa5\x05\x05?\x90?G\xe0\t\x1f\x1c\xb1V\xab\xb5\xd3j\xb5\xf4B\xf1\xeb9\x1f\x02\xd8\xc9t\v?\x9e\xbe}S\x81\x8f\xfd\xa1\xe0 \xa2E\xbd\xc2\x0frySs\xea`\xcdR\x91p\x7f\x96Y\x97\x82m\xe9<\t\"yRL\x1b)4\xa2\xc2R\xd6Xj\xb9\xb8\x8d\nK\xa0\xc4ΒKnh\x97[71\x9b:\x94Y\xc3P~YQ\fH\xda\xc5\rT\x11:G\x954^$Cm\xdf\n;b\x9f_\f\xb7\x04\t\xa1\n.\xaaI梚\x1e\x85\xdd\x14\x962`\xe8\xa6\xf4I\a\xf2\n \v\x95\xe8\a\xe0d\x1d\x00\x87\x15\fX.\x02\xe3\x8cg\x1e\x87Z\x84.'\xb6\xa0\xc3\xee\x9aU{L\x88\x94L\x83\x8cݍ\x99|\x9bQɸ\xe7\xb2\xf38\x1b\xde\xe2h\xd8]\xc0\xf0\n\x8d\xf5\x17\x03\xb39\x11\x97\x86\xc7\xf0&\xad\xf8\tϛ@W\x82(\x85`\xa60s\x87\xd05\xff\xe5\xb8Z]\xe3\x9f\x19c\xbf\xeaN\xf0/[\xab\xa2\xac\"\x1e\xdc\x18\xb6T\xd2\f\x8d\x99\x92eǙ>\x01\xf5\f\xb7+B7\x8a\xb3\xd1\xfehm\x91}{\x91\xfd\xb5ET\x10\xe8\xf7\x17\x03\x9a(X\xd7e\xa6\x80+\xb2\xe9\x93\xe6S\x01\xcbl\x19\xb8\x80'\xe5\xcb\x05\xa1!\xe4/j]\xfb,].\xadG\xcba\x8c\x85\xcbe\xf6D\xf3\xf7,\x1fi\x10\x87\x9dBs\x19\x13\x98\x11f\x1bo\x88\x8d hLo\x8bQ\x10\xf21\x12\xac\x98\b*\x9f\x99\xbf\"\x1exb3_\a0M,\xbfl+r\xe9\xc4\xe2sZM\xad<\x97X\xca\xc29٬\b\xe9e\x0f\xb5\x9a\x87|\x97\xe8\xb9v!Ŗ\xeen\xa6Z\xcd\x1ej5\xe2\xc9\xc5\xf2+\xb0\xae\x15\f0\x05\x87]c3\xbc\x8b\xe1U\x8d\xb5r{a-7_'\x1a\xb3y^r}\xb5f\xc1\xe4\xa4\xfa\xe0\xaeAv!\x0fRܕ\x1fu\x7fqc\xd2E\x94\x1cW\xab\xe8\\T\xec\x12\xf4\xed\xc5QX͕\xf7W\xab\xd9=\xc2}Q\xadv\x80\xe7\x8a\xc9(\xbe\xbd\xff\x93\xd5\xd54\b\xb9\xdbjJ*\xa7Ց\x7f\xf1\x11\xdb\xdb\xfa\t0\x0e\xae)\xe2b\xdc\xf3{\xe7Na#y\x8e4\xef\"\x0f\x19w\x89]\\\xab=`ZJ\xb8&\x8d\xc9\xe4\xd80\x84N\xd6\xccQ\xa2i'\x80\xffa\xc0#Q\x91Ε\xbe\x8a\xbdj\xf9\xdb\xdf\U0001cb5a\xe9GK\x96ܝݭM\xa0\x9c\x00\xad\xb9\xb3'\r\xd3\xc4V)\x9c&\xb5\x16\xb9\xe3e\x1bPDRc\x1dڼ\xbb\x9b-\xceA\x03c]*\x17\x8a\xd0o\xe1\xa9\xc9\xeeQ`\xcbœ\x16\x02\x90.\xcb\\M\xc1\xb9U\xd4[vCW\xbc\x10\xb7Az\x86\xa1:Ï\x86\xd38ђoK\xd4\\\x04μ$\xdbp\n\xf2{\x1c\x8b\xfc\t\x04\x8f\xfe=`\x01\xa1Qٔ\x8b\a\a\x82;\x96v[\xadZu\xd7\xdeRM\x87]XD\x87L\xf1\x04\xf7\xde\x05\xaf,R\xbc\xf5\xf2\xf0A\xe8\xce.^\x1a\xd5*\xe7\xee\x9dë\xb5\b\xc1xƩ\x8c\xd0ca.\xc4\x13[\xc8\x1c\x16\xe0\x93\t\xedm.\xbd\v\xef]\x02m\xc1\x910\xd9\x0e=\x978\xa8C\xb7Z\x10k\x91\xaa\xe6\xf4\x86\x13\xbb\xf3{\x9b\xa7\xfa\x8eb,Y.\xed\x91>\x01\xb9+\xae?\x91+y\xe1\x8f\xec\xa5R\xceNJ\xa8_\xe1\x91Hn\x9cl\xb8\xb9I\v\x856t\xac\xe2\x14\xb7\x0e<\x11c\xaa\x01\x02\x03R\xa17\xa2\xa5)Гb\x01\xcd\x11U\xc7|\xcd8\x01\xf4-\x81\x82\xd2NG\xca\xef﹂r\"\x7f\x03\xb6\xe8\x18Y\xca\xdc\xfa\x8ai\x90W\xaaC\xe8^\x93m\xea'\x87\xb7H\xcf\x05\xd5\xcd\x030\x1c\xf1:\x1d\xbc\xc5\xdd\x00>H\x98\xb3\xb5\xe5<\xe8;\xa8\xea\xd0\xdcU\xe6\x93ۯA\xfd\n\xf3\xef\xb5%\xca\xcf*\x98\xe8\xdd=7Zc>\xbe\xeeb\xd27\xf4\"2\x1c\x068W*~K\xf1\x0e\x10\x134\x88*>\xb0\xbb\x17\xbe\bf\xb0\ue38a*\x8aw\xa8\x1a* F\xb8L\xe5\x9aA\b\xaf^\xc6})\xe6Pq\a\x8a\x1f\xa9\xf0\xeba\xe8\xcf\xe4\x96B\xa8\x94\xd4!\x9e\x9b\x98q\xabY\xd5[4w\xce5Ǜ1\x1d\x91\xaf\x99H\xc5\xec\x16\xfaAgC\x9bۡ\xb8\xa1\x82\xe5\xf1\xc7C\xee\xf2\xbb\xd7\xfc?\x9a\x17\xafV\xef\x9b\xfb8\x84t\x85\x11j\xc9\xe3$7\xbaUT\xadn\xfdV\xd6\x14L\xf4\x9e\xc9\x01S\x1bI\xee8*㎣R\xee\x18\x1b.\xf2\xc6掯\xf8=\x89!\"\xaf(\x1eH\x88ze\xb8\xe8dP\x8b<g\vM\xaf\xd5;g\xcb\xc1\xb2|\xe1:\x9b\x00\xb5\xa4\xaca\x11\x19\xb9\x82\xb5\xad\xce\xe3ZR{\xec<\x86Lr\x19\xfe\xb9\xb6\xf1\x0fb\x9c\x83-\xc6,8\xba\x17\xe3\x94ޔ\xe5X'\xaa\xc8\xf76\xe6I\xee\x05\x9b\x1c\xa8\xe1\x81I\xe4\x81\xc1\x18\xd2\x02\xfev\xc9\xfd\xcdd\x11\x94t\x91d\xbc\xb2S\x90\x1fc\x14\x8f\xb8\xbce5\xb0\xf8\xa2\x12r\x1f\x1cS#n\xcb\x102\xcc\x7f\x17x۫~\x9e\xb1\xd2:1\xba4H\xa3\x18S\x85N\xe9\x88\xceX\x8bN\xe0.\xb8d\x05\xbc\xae\x85\xd9\xd5j\xf6\xdbu\xe29\x8f\x1c\xca\t\x95\x10\x02\xb5\xb3\x81\xa9'\xbc\x95\xd4ou\x1f\xe1S\xc0R\x16\xb2\x1dyw\x02\n\xc2HԹ\xbbߝH\xda\xc0\xbeW{3\xd6\xf2\xec\"$\xe2f\xac\xe9\xe5/\xcdj՝\x81\x9b\x03\xa1@\xe6͐e\xed\xe2ͷ\\\"\x00v\x89\xd5WOv\x15\xb2\xe0\xefv\xe7\x85j\xe9\xc2j\xd5\r\xd9d\xb9\xf4\xa5\xf0sV\xad\xeel\xe1d\xdd)k\xc9iK\xd3\x11\xe1\x89Z\x8b\x8eX\xb9\x84[\x8e\xbf\a\xcd!\xbd9\"vl\x8b\x82\xa4\x99\x9a=U\xa4\xe8<#E\xc7\x10DnZpI\x96t\x94ڠ\x99\xfc\xa36\x12`\x80\xde\xfc\xf3\xa4\xeb\x98\xd0\xc5CH\xd7EW\xe6\xf3].K\xf9\xbc\xe5\xd2\xfa\xe2\x9f#`\xef\xa1\xf9j\x98AR\x11\x93{@)-\xaa\xd5]\xc0_\x8b\xe5\x12\x10D$\xedN\x02]'e\xf6^\x866\x85\xb1\x85G2\xa1mE\x8c*:\xcf\xd0\x7f\x8c1_\x9bZ\u07b3^\xbf\xb8\v\xd2%\x8b\a\xb1\xb6\xbb\xa0W\\\x10\xce\xdd\xfb\xeb\x9b\x1bۯ\\M\x03\xc1ӹ?\xb4\xf0\x90-\x1e\xa8`He\xa4D\xbe\xf0\x9b:\xca@*)\x9f\xfb2K\x8d!K.\xc2x\xf8E\x8ba\x1cB/\xe1\xe8@\x1f\n\x05Q\x89\x1dP\xd2GGl\xc6$8\x82\x0f\xf3VS\x02e̶\xe4y\x01\b\x9f1\x8dW\xb2\x18\x167$3\xe4\xf4'l\x98!\xa59\xddjv\x8d\xe4\x13a\x01\x13\xe0\xfaQ\x16\x8d@\x8b\x88\xc0\x02\xa7[\xf1+\xc38\x8c#\xbcl\x834\x059\xc8j\xf5wzʯ\x80\xa4\xfd\xbb:>\x02\x8a^\xbe\xf0\x9b\xca̿ѐj\x8f\r\xc5V\xb2\xff\xca\x1e`\xcdE\xcf\xfd\xea\x15\x04Zz\xabI\xbc˞\xab׳\xa9i\xcb \xd2!\xb3M\x88\x85\xb5\x05\x81\xf1\xe1Ue֤\x8b\xc1\xe7\xe3\x10,\xd8G\x95\x8b\x1b\x15\x16A\xf8\x17\x8b\x10\xc5n\xe8\x1a\x8c\x82%ǎ\x02\x06\xa2\xa4\x05\xc3\xf8\xd7\x16З\xf0R8\xc9\a\x1c*\xa29\xbc\x1d\xc0\xec(\x10\xbd\xece \xe2M\x98\xc5\xea-\x97\xf9u\x9b\x94-\x9a\xcd\x10\xdew\x0e\t\xbd{\x16\x88\xd6\xee\xe2\bs@q\x0fC\xa8i\xbd\xcbju\xe3\xee\xdf\x18^1\a\x99\x9aW4\x06G\xe6j\x1e\x13z\xb3\x82[\x86NI\xe1\xa2\xc9ŧ\xa4k\xc4\x03\x80\xd5L\x9d\xc9Iv\xeb\\\x96]8\xb0\xdfh\a\xe2N\xef%\xfa\x02v\xd8\xc1\x84\xe6\x10\x8cn`\xcex\xab\r\xc8\xd72'\vX\xab\xbdK1+\xb9\xcfnW\xab\xaf\xb9\x92|B\xa7\xf7\xe3Nؽ\xa9\xba\x94\x14P\bB\xef\x9f\x02c, k\x94 J\xf6`gf\xd9\xceL\xf4\xce,zfo\xbc2\x8e\xde\a\xe42\xea\xa1M\x8dQJ\x1aL\f8\x18\xcf'P\x91<\x12\xb4r\xb1\x10\x95q\xbc\x88F\x95\xc7\xf4\xb1\xa3Ϻ<\xbb\x95a<\x9b\xf9\x95\v.\xae8W|\xce0\x0eC\xae\f\x7fd${\x87б\xa4\v\x80l\xdbjQ\xc4>Ӈ\tz0\x94_\xb8Q\xd0#\x88\xa4\xc9\xf1lG\xb9\xb3\x1d\xdb\x17\xa6:\xda--\xbc\x99+p\x1b\x9aCm5I\xef\xdb\x17\xba\x15j\xf1\x92\xa4\xcb\xf0\x1a\x87P\x8d(\x92\xba\x1f \xecΊC\x1b[xf\xd1\xc3\xf3\xe9\xd3K:\xa7C:V\x014\xbd\xb4\xa7B%\xe2kIs\xe5j\x10OU\x18\xe6\xfa\xc2]\xbf\x1f\xecz\xee\xe8a\xf3 ވm\xb5V_#[(\x00\x89C\x00gLI\x0f.\x17\xcfM\xab\xd5rġ\xd0\x06\"\xfd\xad\x16\x1d\xc2?s&\xe8\x98\xc18\x95\xf5h{G*\\\xefC\f\x11|\xa0\xd1\x01\xca]\x82\f\x1bD`\xec\v(\xc0\x88Ŕ\x8b\x839B\x8e\x83\a:@!\xe4Ngc\x9fEz\b%\x9c\x01\x01\xec\x15\xf6B\x86_\x06\xbd\x8e\xd7V\x87Jʋ%:\x1fN\xc1V'\x9aTfx[\x02\xf6\x0eƁ\xd1\x01K\xbfy\xd7g\xe8:\x9f\xb2\x80@0L0mK\xc1\xb4\xad\xde\x02?HM\x16bƭ^vw\x98\xdbپD\xae\x82\x91\x98ʾ%\x95!\xa7ݭ\x04B\x8b! \x8a\x12Oӊ\x98b\\%pD\x1b\xf6ֆ\x1e\x954k\x8f\xdfs\xe7L\xd4\xfc:nb\x93\x80]\xf5/n@Pf\x16<Tf\x86_\xa0\x00@\xae\xe9\xd7|\xbb\xf5\x93\x1b\xa8\x1b5\x90\xc6gz;o3\xfdJ^uB\uf0e9\xae\xbb5\xcc\xdf\xdaGsR\xad\xa2\xc6%XӸ\x04\x0f\xa2\xb6\xb7\x86y\x19\xf6\x93y\xb5\xeaΙ]D(̅\x8ck5\x03\x15\x851\xdcv\x10\xda6\xa9%\x16\xbdVm썥;AX\xad.r*\f\xa8BT\xbe\x1c\xbc\x14{\xb0\xee=w*\xf1\xf1\xddM\x12o\x8a5[\x1bk\x8e\x01\xc1{\x00\x9d\xe3^\xa1\xe3\x8aC\xbc\x8d\x9f\x11ﾾ\xe5e\x0e\xf0\x85\b\"\xa7d\xb2\xb7\xbfK\x1e\xb0\x15S\xc9k\xd9\xec\x17x\x9d\x1b3h\xc4_\x1b\t\x1e\x04\xd2\xc3o\x90R݁~\xf2wa\xc4\xe2\xa2\xc8\xe9\xfe\xbe\x10wu\x0eM6X\x9cd\x94\x9bd\x93\xd0;\x06^\xab\x95\r\xbd\x89RSK\xa9k\x8d\xd3\x1e\xa5dC~\x02\xffV\xddu\x8c=\xa2\x86M\xdeX-\xbc\xdd\xf3\xb3#^N f\xdd\xf7\xd5*\xaa\x15{w\xddE\xdan+\xbb\x8dT\x80\xb6?\x171TUK\x8di\x177\f\x9ct\xbf\xe6\xb2+o\xfe\x1e\x88\xd1w\x1dB\x0e\b~ܴl\x03\xfe\x01\xc8IX\xb4\x069\xe9\xfd\x90s\x8b\x03c\x8c\xa5\x9a6-9\"M\x92\xefK\x86\xa1A+\xe54\x83\xb8\xb5o~r\xd3\a@[\x06 \x86\xcfI\xc1$\xb8Z\x05s\xe0\x01\xc9\xf0\x03\x18\x03\x0fr#1_\xb8@\xf7\xa3&)d)鵽Vk\x1f\x91\xe5\x8ew\xb0\x8b?\x0e\xbc&y\xd2TacX@}\xd6\xec\xc6O\x9aݸ^'n\xc0\x12\xfe\xa0\xc1B\xc4\v\x9f\xb9\xfe\xd1\xd1\x0e\xa9\x05^\x81\xe2\xb6BL\xe4-\xbd2\x14\xc7]?\xbf\x98\xf2\xf4h@\x94\xf1Oy:\xf4\xe7\xdc(\x06\x1d\xd2\xcd\xef\xae>q\xa9>q\t\x95+\xbev\xe2\xf2\xdf\xfd\xd3'n\x14/.\xee=q\xb9\x01|\x15yY\u07bcMcn\x95\xfa\x13\x02p\xee\x00%\xe8F_\xa9L\xf8\x06\x8d@$5\x02\x91\xd2\bD\xa4K\xa2\x7fP#\x10\x06~\xfa5\n\x81\xbb5\x01F\rd\xd8_j\xd4q\x8f\x17\x91!\xedF\xaa\xe3Lsc\xf0\x8e\xcd:'\x83\x9c\\\x04\xefO\xd2{\x80X\x1ay6@l\x16\x87\x04\xbbƹ;\xbco˖K\xc1\xdd!\x18^\xc2\xc1\x1e.\x97\x9d\x03\xf9w\xa7\xad\x9e;\xf2\xafd!\x86\xcb\xe5\x9e~q\xa8\xfe\xaa\xf2ξz\xaf\x9e\x0f\xc1\xa5b\x98\xd3h\xbb{\xaa1\xa4\xf7!\xd5=\x8c\xf1~\xe90Y.\x93j\x15\xe1\xe1+\x10z\xcc|\xfb\xc6EU<\xc0\xd8P\xe2i\x14\xd9\x0e\xf1\xe7W\x0fB\x12~\x1a]vv\xed\x96\xeel\a\xb4\x19\xd6\xd7\xf8\xc9\xddHD\xf7:\xb4D\xd9?\xc1\x93\xb6\xbc\x85\x0fh\xa8~\xe0\x97t\xa1\x9e$\xadkP\x18@U\x8el\xc6\xf8S\x92\x1e\xbc\x87\x88\xd7y\x10WY!\xf3Uc,\xcdY\xae\x85\xb9>\xd8Bg\x95\x84\xc0͈^\x01v\xb7Z\n\xbdʺ\xf5\x94\x94m\x17\xa1\xbf l\xba~^IM\x87\xf7ℕ}\xff\xea\x1e\xb7\xb64|,\x97\x1at斜\x0e\xc9W@\x88\xa8\x1fJP\xd0\tˣ}\x04\xa5%\x86\x12\xfe9=\x87\x10\xaf\xac\x82y\x92gۈ\xfb3\xec\xa3\"6\x03\xeb8\xf5\xc1\xd8=\xbaѺT\x14\v\x11\xfaP)\x9b\x1e\xbcb\x17f8\xe4\xb0ZUj-Brc#_ݬ\xa1\t\x9c\x9ecZ\xb9\xcd\xc9\x01e\xcdB\fe\x19\xbc[\xa3B\xcb3\x01\xe7\x0fo\xd1H~\xeb\xa8\xf7\x04\x94\xdb\xc8Lgq\xac\xcdy\xc6%\xc2t8*p\xf9c\xa2\xa2}\xf0\xbc\xdfϚ\xc9,\xa0\x9bq\xb1\x16\x18\x06\xeb\xf0\xf7\x99}\x12\xb9\xd5?\xd98\x8bv\x9f\xbdW2\xc51\xfc\xfb\xf5\x1b\xa3\xe1\xdf,䖳e\x162\x18\xbb\x991\x81\xf4=R\xf1t\x97KG\xc7\xd1u\x06r\x04\x84\x8cٝ\xd5T\xc0]\xb9g\xb0GJ\xba(W̝g_\xe7\xc2\xfc\xe6\xda e+\x89\xed\x1a\a\xa3y\x7f1\xc0\x02Y\x930\xc6t\x11\xb9\x1d\xe3o\xcd\x1a/\x97yZ\f\xec\x18\xb6\x8e0~\x99?\xa99O\x1c\v\xd25\x1c\x8dqPE(r\xee\x84\"\xdd\xe2\xe3Rhz\\\x1bk\xf8)\x85\xa8\xf1\x1aL\xa8\x05\xef\xb9wB\x86\xaa\xf5-\x875\xe7(\xa0zG\x05ψ#ɖ[\xa5L.%͎\n~\xc4\x1b\f\t\x86a\x9cr\xb4$\xb0-\xc9L\xa8\x9d\f=]Z1?K\xcc؊w\xa8\xbcw\xb5\x03\x91\xb2B(:\x10q\xe5\xdaG\xb5IL\xa9\x8e\xdcZ\xa6\xb2\xf7\x92\xef\xba\xd7j\x0ep\x9eE8\xc5\xf7ʨs\xa2\xa0'M\xa0]\xd0\u008c(AW\xf00Ӹu\xf2ղ\x00\xbb\xffsE$\xf7\aԽ\x93\xce\xd4'\xf2\xa8e\xecq\x8c\x97\x14\x12\xb7\xb6\x06>'\x86\xb4\x95\xcaQE\xb6\xe2\x94\x1a\x7f?̀[Yo\x7f\x8b\r\x9f\xb4\xd5Vh\x01I\x98\x9c\xe9\xf6\xdf\\\xc9H[\x9cn^ĕjU\n1\x15\xd2\x1ds\xb0\xdd\x1aCb\x0e\xc9\xf7\x11o\xae\xae,\x89\xaf\f\x97\x96-\xb9\xa1\xe2W6\xd05\r\xf1v\xa2\x12N!\x8d{\x9f\xaf\xc0\xfd\x95j\xad\x87Uk\x93\x9c\xaf\x02\xeb\xd8\xcc\x04\xf1\x825\xd8I\x91Y\x04\x8bÂC\xc2yF\x97ɹ\xd4[tG\xeb`\xec9\xaf\x9d\xfaj\xf5G\x15\xf00\xb7\x11>͟Vi\x0e\x0f\xba짧\xcfON,\xd7\b\xe9\x05\x14D\x82'\U000c4aec\x04J\xe1\x86:n\xbd\x1fi\xd1\xc4\xf8>V|g\xef\x01\xbbq\xd7\x02\x96\x18\x9f\xd6́\\g\xbb1Ɂ\x81\x9e\xccd#\xb7\xd4\x19\xde}\xaa\xddhU\xbe!D|\x9c\x99\xac0\xc4\xf8JJ\x7f\b\x9e\x9f\x83\x1e\x0fQn\x12\x9b_\xbb\\\t\xaf\xe9\xden{\xff\x90\x15\xea6\xb1\n\xe3Y\x88H\x19\xa5+Aos\xe5(\xae\xecyT\xe0ܳ\xa6#QY\xbd\xa5l\xe3\x93l/\xd0Y(\xa1\x18\x12\xbdrq#\xb8Nt\xe1+\xb3\x064\x16\x9a/\x84\x83\xf9&\xf0'\f\xb0\xe9H\x9f\x8cd}\xc7\x12\xebܓ\xc4V\x1f\xb0\x16Ml+\b\x1cU7+:J\xb2\x1c\xe3\xe4\x14\xed\r\x8d\xa3\xb3\x01,t?\xfe\xc2\xd9-d\x01y\x1a\x86k.\xac\xea\xfa\x04\x82\xb7\xe8D&\xaaU\x997WE J\x98\xa0\xc2ʅ\x1f1\xdc\xe7D\xba\xa0醭\x9cr&\x8a\x9c\xc9\x1f'\x1d(\xa35\aJ\xf0\x02\x8e!\x82(\f\xb3,\xc8%S \x05]5-o[\xa49[\xcc*\xd0k\xd0o\x0e\xba\xb94\x1e\x99\x15\x92\x16\xd7\x1a\x80\x0e\"\v\xd8mU\xf8,N8\x18Ӭ\xe8s\xbe9N\x0f}\xcb\xef\ra\xf2\x993\x04Qz\xc2\xd9m\xd3s\xceΚ\x0e݇\xbf\xbeC\x0f\xe0\xef\x85C\x0f\xe1\xafph\v+D\x0em\xb5\xe0ǥC[m\xf81vh\xab\x03?\x12\x87\xb6\xf1c\xee\xd0Ύ\xf7\xf8\xec\xccyL\x0f\xb1\xce\xd9\x19T\xc2Zo\x1c\xda\xdaæ\xce\x1dz\xd0\xee\xe0\xfbW\xf8\x13߿sV\xf4\rg}\xe7ơί\x0eunx\n\xbf\xe4\xbfǧ\x0eu\xe2ȡ\xce[\xfc\xe7\x8dC\x1d\xf8\x81\x7fc\xf8\x81\xff\xbc\x85ZcHn\xf6V\xfe\xfb\xf2\xa53\xa0\x1f\xb8\x1d\x9aV\x86\xbc\xf5\xd4O\x02\x0fV\xb0\xdcGۙ\x7f\xee;\xbe&\xad\x13\xec\xae\xf0\xc0\x94\x1f1\x88X\x960\xe7ڡ\x11kg\x061*\x96\x05\xbcZ\xc0\xab\x1dK\xa3ʏ\xd8N\xfbp\xe7po\xbf}\xb8[\xc8\xf72\x04\xaau\x1e\a9\xfd\x01vo\x9b\\M\x90\xb2K$uҼ~\xa9\xfeCy\xac\xf3\x11\xfa;P\x94-\x84\xea\xa8%\xb5L\x83\xd5thT\x17&\x99\xa4\xe5\xe7\xf7\x1e\xa7oG\xa3\xe0\xc5h\x14*\xed\xa3Lm\xe8_\xbb\xa0v\x93e\xcbe[\xe72\x95\xe9o\x95̂短\xc4`_\x82\xf9I\x84\x1eȌ\xdbOY\x15\xd0\xe2\xbf\xe2\x97<dq\xc3d\xe6uyVN >\xaf\xf5l\xa5\x1c\xcbe\x84٬\xfa0\xc1+MNc\x19\x1f\xf0v\xa5\x02\xbf\xb9ѦĶ\x06\x7f\x04\f\xf0\aM\xf5\x15\x03\x0eÄ*\xeb\xf4\xc0\xb0zm\x82&b\xa5f\xea\x81\t\x1eN\xa8\x1bb\xaa\xf2\\\x10\x8d,\x0fL\x00\xee\xb8o\x95\x93p\x98\xcbiFSeȒ+\x05M\x05\x05\xf7e\x96Z\xf9_\xac]\xa6\\\xd679\xd1\xe4\xbb8\x11\xff\xe17)\xe3\xe6\xa7\x1dE$\xe2\x9f@\xe7\xcex\xf6{\xb9<hj\bx\xcf\xc7)\xe3\xeaG\xf6]\x14\x83߀/^\xc7#\xcex\xee1\xab\x04\xde\xfa<°\xc7W\x8c\xe7\x1e\xb3J z\x0f\xa2\t,\x0f{\xec<ƻ\xd7*\x03=\x8b\x82\xa18\x19\xf2\x9f\x16\xb1\xe00 \xeb)kK\x85eLXI\x12\x7f\x13\xb41\xe9e?\xbdo\x0f\x98¯\xef\xab\x7f|m\xd77f\xebj\xa4Z \x8b\x8f\xc6m\x18B\x9dȢE\xcaG/\xb2b\xf84;\xe0\xaf\x15ed'T\xcd\xe7\xaa$\xa0}\xa2\x01\xc4RI\xa1\x9b0\xcbL\xe2\x1f\x85]Roev(\x9af\x89\x1c\xea\x835ObH\x1d\x1f\x9a\t\x89g\x17\xd1@\x9a&\xe3\x9f,\\\x05|.\xbd\x17ܴ\xc6\"B\xd3\x1a3\xc1\xba\xd3l\xe8/\xb8\x9d\x1b\x17\xbe\xaa\xe5F\xae\xd1\xd0wvĔWvt!\x1d\f\xea0\x1f\xc4\xe9Y\xbe\x8e\x0e\xdf\xd4j\xef-\x97\xad\xbdV\x96̣}\xb8[\xad\xc2=&\xe3\xee\xc25\xb6\x85\r\xee\xeewvvt=@\xfa\x9dj\x95o1\xf6\x99CN\x89\xddΞiSFa\xc8\xfa~i\xf7\x8d\x03\xd1_jڳZ\x95$j\xf6\xcd_<\xa7\xbd`/\x95\xa3.h{p\xbej\xf1ܤ\x17yQ\xb5\xba\xb3#\xdb9l\xa9\xbf\xbaݶ\xf9\x01~\xb0\xd0ugW\a[Gy\xbe\x80\x8c\x02d\xb9|\xc9]L\xac\xf7J\xfeE\xc6\x15\xa6\xad\xea\xc4\xd9\xd8>\xe6\xfdM\xf3\xe4\xb0\xc8R\x8a=\xc1\xe5ܫV\xa3#\xb6\xbb\xd7i\x1dV\xab\xa2\xd62\xbc\x00R{\xf9oAg\xf0\x84a`'\x95\xaf\xa3\xb3\xd3鵚\xed\x9d\xefܨ\x8e\xad\x91ZR\xc7\n5\\t;\xf5\xf6\x9f\xd6:o\xff~\x16}W\xd9\xd6\xc1\xe4\xb3J?\xaf\x85r\x90\x13\x91\xde\nM:\x97\xe7p\f\xb6&hp2b\x92J\xa7\xe0XA'\xec\x19w\x17\f\x97\xa0\t\x9c\xd9Bm$\xac\xdb\x02\xf9Ni\x8c\x9f7\xcaW\x1b\xb4P\x1b\xb4P\x1b\xb4\xd0\x1b\xb4\xd0\x1b\xb4P\xfb\x03\x7f\xf5\x97m\xf5l*\xaa\xa6\xf6TS{\xba¡\xfa\xab\xdew\xf6\xd5{\xdd\xf5\x9e\xfc\xbb\x1e_O\x82\x94\x1a,_\xb9\x1f\x15?\xab\x99 鋾\\\x06\xe8\xbf\x12\xb2f74\xdb\xd8\x1d>\xc1\xe3\xb0\xd7\vk\xac텵\x9a\xccE\xf2\x8c\xbbC\xb9N\xa1QAUv\xbb\x136\xa9V\xff\xe2.\x18\xec\xa6`;9\xcc\\\x02\x1f\u0530\fM`5=\x06\xd1\xd3\bT%`5\x19\xd6g\xf5֓\xa8Zu*(4\xee\xcfj\xad\x01\x9d\xb10\x93\xcb\xe3\xd0\xee\x1e\x1265\xaaVK[\xd3\xc2\xc4\xf1r9\xed%O\x0e\xabU\x84\xbcޮ\x17\xf4\xdah\x1e\xb7뵽io\xc7\xebx[\x93\xe52X.cx\x9f\xbd\xb3\x90\xc3#n'3\xe7\x8d\xd1b\x96\xcbq\a\xbc\b\x9c\xc0\x02\xeb\xd1^\xbb\n\x1f;\xcec\xcfy\xfc\xd8As\xaf\xfc\xad\xab\xbd\xaf\xdfp+\xd1\xeer\xf9\x81\xcb\xf3!\b\xb9\xab]\x99s\x17\x1awj\xa2\xe6<\x96a\x9d}f\x90\xb1E'&\x04\xaf\x15\xc6l\x92\x01\xc87S\xa7\x90/\x1b+Н&\xa1\xd6s\xdd\xc7`\xdb˥E\xf4=\x01\xd9A\xf2\x84YE]\x15}\xffg\xee\n\x1a\x9aˁ\x064\x97eXoW\x19\x99\xa8\xe3DK\x06\xbdL=\x92\x1cE\xdd\xc4\b\xf5\xf3\xba\x91d`\xc4\xe0\xc22[R?Z+\xecjEhnEi\x8e>\xa9V\xb7d\x94ء\x9f\xf2JK\x85\xfe\xaf\b\xcc\tPi\xabg\\\xfa,\xb0\xf4c\b)\xfd\xf8\xb1Cp7\xb0fG\xd7\\:\xb5OR\x92\xa7\x13R\xff\xc0\xdd\xd7P\xe2\x13\"\xeb\xee\xe8\xbaO6\xd4\xdd@Q\xb3m\xf7,\x82\xc8\xfa\xbf\x9fE\x83\xef\b&fWV+\x19\x95@0E\xfb\x16ci/\xf5\xb2\x8cT\x8d\xd0O\x050\a\xd7,\xa5?r\x97\x1b\x829%h)\x12\xa0\x90\x05\x13~4\a˥Sѿe\xfe\x7f)\x99\x00BFE\xa4\xef*\xbc\xcd0\xdeׂA\x88/`\x8b\xf0\xbb\x05\x04\xba\xf2k,\xac\xb9\xc1r\x99,\x97\x0e\x96\xf6\x1c\xc7\xc31\xd6~\xe4\xee\x02蠀%\xc6Yu\x85\xa1\x80\xb2e\xdaU\xcb\x04'\xc0ƙ\x9a\xae\x12\xe0\xda\xed\xa8\xe0\xe3\xcdn\x9ca\xaeHc\xae\x180\x17$l\x8a$\xbe\x8aA\x94.\xd8\t\xefG@\xe0?\xe3n\x04$U\r\x938Q\xfdY\xb5\xaa\x8aj\xad\x01x\x85\xd7@\xc4\xf5\x8e\xe7S;\n\x02\x87\xb2\xabR7x9\xee2\x98\xcd\xe34\r.B\x15\x8f߫\x04\x92\xe9RF,\x15\xe4\x04@\xe8\xe0ZW\xe3'\xfb^g\x12\xa7i^\x87x8S\xb3EVN\x163\xa6\x9a\v\xa2\xac\xf5*m\xd8NUJzN\xcd\xf1\"܈:\xc0\xefYdŉ\xfc\xc1\xba\xc1˺\xeaq;d\x9fg\xd1K?r\x93\x80\xdcѹ\xec\f\x04i\xe4ƻ\x16I\f\xe0\\\xe9\xff^\x19lO\x145\xdcT\x11\xa4\x1c\xa7\x9b\xd8p\xe6\xa6:\xeb\x15\xa9\xfbOd\xe6\xaa\xe0\x89\xdf\v\xbc\x94\x86R6X\xcb\b\xe0\b\xc8_T\xb9\a\x19+\xa6\xaaew*\xb6\x03\x8d\x845\x9bx&\xb5\\k@E{v\rB\xc3,W\x91\x99\xfc\x7f\xca\x03\x8ei\xca\x1e\xd4{Yd\xb1\xa4\x84\xb1\x85\x88@4c|\x94\x8f\x93~\xd4\xc1\x91\xb2\xd8M\x01\xf0\xaf\xbfa\xaf\xb5\x16\x85\xb8\x0e\xf8?\x94w/\x97F\xa0\x17T\xab\xa6\x12RUv=`b\xe1b\x85MJ\xc1ۡ\xc6\x14\xf5\x8fR\xeb\xc5l\x0eT1^$\xf0\x90\x17\xb9\xf6\xd2\x1ad\xf8\xf1\xf0O\xc5\x01vBV\x03[7`\xa6B\xd5\x064\xed\xf4\a\x16\x8c}\xcf\xcb\xec\x82B\xbaP\xe1\x9a\x01\x12\xdc\x18\xdd\xcds\\\x9cW@\xfe$㘂\xae\xaf\xf5\xe7n\xc8\xe2\xbe? \xb9|\xeea\x96\xbb\x1df\xbd\x15\xe6ޖ\xc9D\x85\x9d+خ\xae\xbe\xb7r\xc1[\x0fx\x91\xc3\x11Hz\xa1\xce\xfc\x1d\xe63\xbe+'\xfaB)\x9co\xfd\x02\xe4\x16\xc6\\\x83Z\x15\xb1\xe5\x05\xe3F\xf0\xd2\xc7\xca\x03\x18\x82\x9d@&\v\xd1\xfdR\xad9\xc6\x05{n\x84\x19Y\x8b$\xb2G\xe2\n\xba\xb0<\x12ޮ\x7f@\x17\x051\x1a\xa8|CK\x8d\xad\xf5\u0089\t\x86\xa8t\xd7\v\x88+\x88\x03\x7fL\xba\xb9^\xfb\x8b\x01v\xbcR\x00\x13e\xc6\xd8\xe6\x1e7\xc0\xf3[\x81{ \xb7r\xa9\x94J\x17\x1bH\xe8\xf7\xd2$r\v\"\a\xe9\xdfM)\xcf\x0e\xe9¬\x84\x82X:dQ7B\xacb\xd16G\xcd<\xf5#\xe4\xf7\xe8\xe5\xc6\xca\xe2\xae-\x96KS\x9c%GX\x005\b\xac\xd6X^\xcahѐ\t\x14\xcc͝\x80\xddK!~\x8a\xd3s2\x15\xf8x\xb9D\x96XQ\nժxҔq\xde\xc0Lh\fz\xf4\xbcP\xa2?\x1f\x109C\xe6|\x97\xf0\xf1\xb9S\x9b\x9b͝V\xabcPW\xae\x7f\x83*\xa5\xb5Rp\ue825\xb3&\x914\xbf\xb7\xa5wjaM\xc0\x93\xad\xbcB\"\x8f&\xb5=\xa0\xe3\xa0- \x18\xacL\v\x11\x04\x91\xa2\x96\xa8H\v\xc9\xc8\x14\x7f\xba\x96\xb5O\x99T\xa9X\xdd*1\x1fZe9\xd8֥RY\x8d\xa9\xea/d\xb6U\xa9*3\xfd\x11\v\xbbOװ\xfb\x10f\xa6\x11\xed|\xb9t\x87\x16\xa2\r!\xe2!\x9b\xf6\xe3A\x01\xff\x87%\xf8?\x00v\x8b\xae\xa3}\x84\x03w\xc1\xee\x80\x1e\x8d\xd0\xe5_5\xc6'\xc0\xd8K\x1d\xe2}\xe8~X\x03l\xe4៊C\xe8P\xe3{\n\xbe\x1e\xf6\x8c\xcc\xf0\xf0\xb6Y<\xbcyO6\xefU\x1c:\xaf1\xd3\x01\xd17\xcaX\x9f\xef\xf9r\xe9ܮ\x1c$\xeeU\x19\xd0uc\xd3\x13s\xaa\n\xdek\xba\r\xaf\b\x83kV\xa9\xb0K\v\xe3HX\x80?i\xf6\x00d\xe6А\x98G1\xa4\xa7i\x11\xbc\xe6\x1dX\xebPJ\xd7\x1cZAů-Q\x95o\xd0r\an{\x9f\r\xfb\xd1\xe0\x01W\xbeO\x03\xb3\xa6h\x11\bH\xcdL\xd4\xdeG\xd57\ue3b9\x8dkn~\x18\x18g\xdc\x01\xb2\xcfs\xd6\xde\x01IXqLoA\xd6[Xc\xe9\xda~,\xf4~8\xb7N-\xac9\xf9\x1dٰ\x1fNũe\xed\xe8\x93[\x8265Z\xc9M\xb4\xe7\x16\xd4\x1c\xd5\xeaV\x80\x98\xb0'I0\f9\xa4\x00\u0093E\xff\f\x84\xc0\x1e\xa7\x16)'\x81!Y\x03\x06\x9f%\xfd\xa8\xb0\xaf\xfefR.Bn\x87\xba\x85\r\xb6H8_\x93p\x8a\x80\xc3\xf7\xb0'\bq`\xa0\x1a\x00\xc4m\xdcMB\x83\"i\x96\x9a\x8d\xeb;\xb5\xa0\xe6\f\xbei\xe3n퍓\xd3\xc1\\ \v\x92{\xf31\x9214Gj[m\xdb\xea\x9c:*{\x93C\xc29\x13:\xb4\x9e\x93v\xf1\xaay\x11W`L\x15\xa7\xb6 +\v\xe3U\xab\x8f\xa4\b\r\xf7\x1eVvHV\x1b\xf1\xa3ķ\x91\x8a\xb9\xe4\xca H\xf8\x06rSږ\x84-E\xa7\x91L\x16\xb0\x05\xb2\x80\x7f\xb7[\x0e`\xf3§\x10@)\xf4\xcaTQ\x8c\xb1\xd0\xf0R\xad\x03\xd2\x03=VM\x17\xb5 \x89+\xd2V`p\xa8\xf7+\xb4w!\xa3\x92\xb2\xcc\x10kq\b\xfb\x03\xe9-\x0f \xfb\x932+&T\x8a[\xfc5\x01\x8b\xb0\t\x13\x99\xf2\xa6\xef\xf7\x93\xc1\x80tŚ\xc6\xc3D\xf2\x8f\xec$\x13봽\x1d>\xa8\xc4\xfe\x80\x13\x1dUƍ\x990\xe4\x10'D*A\xb2t\xc7\x10\xc4!Q\xc3\xcap\x87\x90%\x9c\xd0|tZN\xac`\xce|\xedR\x86\x81\x02\xcf%L(ڬvA\x15\xc9\t)\xff<\xc2[;\x1f[\xfe\x97\x9c\xfe\xa4b\xc9\x11ײ\xf6i⼂\x90\xe1\xd4x\xcd\x01K\x93\x84\xcf\xe2Kie\xf29\xadû\xcaN\xa3\xf21庞\x80zQ*\xb8?\xa2\x10\x8de8\x95\x06*W\x95\xd4\x1fs\b\xba\xa1\x98\x81\x06\xc8\x17`\x1f\xb8`S*\x04\x9b\xd0D\xb0+\x1a\t\xf6\x99Ƃ\x9dP_\xb0G4\x10\xec\vo\x80M\x06M\xcdϧaHC\xc1n\x01\xd4JM5d\xb2HW\x19\xff\x90nb\x94\x90\xbf*{\x11\xa97ᙽ\x8a\x85\x12#\x96\x14P\xe2\xad\xe3xъ\x02\xa9$/\xbc\x846i\xa4ț^\"o3̷\xe18\xab\x95\"=\x04K\xe9P0\x9d\r\xf8\x05\xc5Է\xde[:\xf3\xe7\xde\x05bL\xef\x98b\x06%\xef#M\xb9\xf0~\xa6&\x0f\xa4\xf7\x8e\x029\xe7]\xd3 \x12\xdeS\x8a\xb1\x8e\xbd\xf7\x14R^y/i\xca\xff\xf4nh*\x12\xefrE\xe7\x82\xfd\xc2]\a\x16\xf8U\xec\x8f\x1c\xea\xc0\"A,\x84\xfc\x8b\xa7a\xa8\xde\xc1/B\xa7\xd9\xeb\x17\vH=\xe9\xc0\xc0\x1dtNHEe$\xd8-\xf0\xb2\x1e\x17\xf4\x14Փ\x9e\x10\xf4\xe5ӓW\xa7O_\x1e\x9f\x9f>\xff\xe1\xf8\xf5S/\x11\x14B\x87\xeb\xc7H\xd0\xe7oߛ\xb7\xb1\xa0/\x8e_>\xfd\xf8\xea\x83.\U00045d30\t\x04\xd5\x06A\xa9\xa0\xb8\x93\xa1\xa0\x10\x02\xff\xf8\x1aP*\xe4ZX\b*\x90\xb9\x1e\n\xaa'\xe1ͳ\xdf\xf0\xf1XP=\x01o*\xc0N\x06\xe2Eg\xe6\x1c\x89\xab\xe9y&\xfa\xd1\x00\x0e\xbc\xbc\xbe\xd0@Um?\x88s\xe6q\"R%\xbb\x86\x9a\xec6\x18y\x11\x0e\x92\x8f<\xf0-\x96U Ő\x06\x1b\x10\xd4I\b\xf1u\vԧ\xd9\xef\x84P\xbf![\xc0\x90\x1f\xfa\xc5*iD\x8c\xb3'80\x81\x9a\xc1\xc6\xf99O_ǣE\xc8{.aO\xb8\xe6\x9a=|\xb2\f\xab\\Ao}O\xac\b\x15+\x9a4F\f!\x9f=\xc9\x02\x16\xc3\xf1\x14$iĮ\xc0H\xfb[\xf0\x93\xe3\xcf\xf2\xd0ќF\xf46\x1f*z\u0085\a˰\x82\x04y\x8dIA鰆)'a|\xe1\x87\x1f\xa6\x81q\xd4\xccJ0l\xa0*\x05\xfd\xf4r\t\xe7Sc\x18ױ^9\xc4Ց\x04yyGWA4\x8a\xaft'\xf2\t\x04\x954i\xc4z%\uec44\x92;\x06Ui\xd2Hp\x1f\x9c\x85\xa6\x042\xe3\xb1ӛ\xd9E\x1cV\xab\xf2\xaf1\x00\xfa\x007\xf2\xa6e\\\xafKoe\xa0mG\xee-$\xcd\xdd\xf4\xb1\x93\x81\x80\xa3?\xdbj\xca\r\x88f#\x18\xa8\xcb\x1bs_LQ\xd5\x0f\xca\xd1 \x1c%<B\xe7\x1b\xfd\xc0\xfa\x03B\xb9\xc6p\xb7\x1aV]\x00\xa3[g\x91\xa2\x91Y0\x14N\x17`)\xa2\xb76\x9c]E*\x8b(\xc7/\x1b\t\xdc]P\x8f\xd3\xdbg\v!\xe2\b\xab\x05\t}\x1e\x87\xf83Ɵ\xa1?O9>\x8f\xe1\x19\xbd\x00y\x82\x05IBO\xa2\xf9B\xb6\x1f&\xf4U\x10}\xc1\xdfÄ\xbe\x8f\xaf\xf0\xa7\x9f\xd0S\x1e\U000a1b34H\xe8\a~-\x9e&\xdc\xc7\xe74Q\x83\x12zPB\x0eJ\xd0\xdb\x1f\xd38\x92\xc8I\x12ނ\xcf^\x06\xa1\x1c\x8a\x1fђ\xd7д\x1c\xb9\xfd\xfae\x9c̰Tإ\xe7\x18J\x1fˣ\\\xb9b\xb5\xe5\xf0ro$\xbc\xcay\xe4^H\xfb-\xb9$z\x95c\x96\xb8\xbb\xfb\xed\xbdC\x82\xa2\xd7\b\xa5\xa7,q[;;\xad\x03P\x95@Y\x80\x8c\xb8\xdb>h\xb7ۄ.\xb0,\x04\xb9P\xe2vv\xf7\xda\xfb\xa0\x93\x84\xb2!\xa1c\xa8\xb7\xbfwx@\xe8\x14\xcbƄ\x8e\xa0\xbdV\xeb\xe0\x90\xd0\x19\x96\x8d\b\x9d\xb0ąj{\x84^b\xd9\x04\xe2n%n{\xa7\xbd\x7f@\xe8\x05\x96\xdd\x10z\x05\xe3;\xe84\x0f\t=Ʋ+B\xafe\xbd\x836\xa1\xe7XvM\xe8)K\xdc\xc3\xfd\xbd\xe6\x1e\xa1O\xb1\xec\x14\xd2\xd6'n\xe7\xb0\tc~\x8ee_\b}\xcb\x12\x17\xec\xddv s4|\xb2\xbf\x7f\b9\xa2\x13w\xa7\xd39\xec\x10\xfa\x06k\x9e@\xc2\xe7\xc4\xddo\xefw\x0e\t}\a?\xf7!=\xcb{\x96\xb8\a\xed\x9d\xc36$\xa1\x85\x8a\xef\t}\x01\xdd\xec\x1c\xee\xedA\xaeI\x98\xfdns\x87\xd0g\xd0z\xb3\xbd\xd3֗\xd9K8=\xbc;\f\xfd4\xad\xfcuk\\O\xe2ĕ\xd7\x03\xef\x86\\T\x043)Q4\xfb\xda\xd46\xa8[,{\t\xe4\xb3\xfd\xe0ݮ\xbaY\x1c\x86\xa9Kж\x8aަ\xc2\x17\x90\x94\x8e\xce\xc3\xc5$\x88R\xaf?\xd0?\xdf\xe2\x1d\a\xd7\tMoR\xc1g\x9e\nޏE\xe3\b\xfe\x1d\xea\x00\xabX\x96ı8\x89\x00\xbc\xe4W\xd0\xf6;\xd5\xee\xedjE/\xc0`\xf4T\xb5\xb5\xa2\"\x8eË\xf8\x1a_\te\xd05\xe1BV`3\x97\xb8\\\xda\x1e\x9d\x9bRbP# rmH\x17'\x9c\xb9\t{I#\xe66\xe9\t\xe6\xde\xfa\xf1\x94(\xf31\x18\x05\xa11˷O\x8b\xec*\xacn\xc4\xfan\x93>k\x9c\xef\x127!\x03\xb551{\xd5\xf8\xadq~\xfe\xfe\xf8\xc5\xc7_\xce_\x1c\xff\xfc\xe1\xed\xdbW\xa7\xe7ǿ|8~sz\xf2\xf6\xcd\xf9\xf3\xb7\xaf߽==>?_.?7\xfe|\xae\x11Z\x93~n\xbc\xfe@\xb0\x83\xd8\xc5\xc7و\xb8\x8dF#\x82H\xea:O\xb4\x9c\xc5\xc5\"\b\xd5ڸ[-U\x98\xf0I\x90\n\x9eș\xa8}!+\x98\x04L\xda%\xf6\xad%\x17be\xbe\xe1rN\x82m\xb9k0\xd3*\x85\x99ր,\x97\xf6\xa3\x04\x19\xf6Q-x\xb6z.\xa1\xf6\x90\x14\xa8\x90\xee\xcfj\xcd\xe5\x12'\x84\x82\xa4\xb58=\xd2U\x89\x15\xed\xba\xeb=\x90\xb2OW\xb9'\x9c /\x9b\xe0\xa6C\x91\x9b`s@E\x06\x16rE\x1b\xa3 \x9d\xc3\xfdN\x93\xb5W\xf8\xd3\x17\xbc+ǕA3\xbbt\x89\v\x10\xad>x\x9f\x1d\x047\x03\xecO\x89?\x9f\xf3\xd1\xd3h\xf4\f>}\x8a\xe0\x97\xbabc\ry\xe5\xc4I\xea&\x85ձN\v\f\xe8\xc3t\x11}\x01Q\x9b)~\x19Y\x1d?\x97\xc7\xd6%\x84r\xb5\xa8\tǅ|\xcfG\x8b!O\\\xb2:\xb7V>\aU\xd64WũI\xc4\x04\xe7G\x93\x7f\xb8\x10\xa6)\xafp\xe6\xf4Zz\xd6\xe1\xd6e\x85\xa3\x8d\xc36\xc8\x05\xeag[\x95\xbdP\x1f\x89\xec#\\\x0fo\xd3\xce\xd1l5\xb0\xcd$C0\xaa\x98\x98\x9c\x9f\xd8\xe2\xc9\xcc{\xe3\x12\xfa\x9e\xfbC\xe1\xbd]Q\vd\x1b\x16\xbaC.nun\xafu\xfeh\xca/\x14\xfe\\\x95\xd43\xb8\xb5\xac\xfe*\xcd>0\xc6Ϲ*\x8c\xaf\x8a[\xaan\x8d\f5h\x9eQ\xd7\xd0;\xa0Z\xb2\xd15]\v\f\x81\xb7O\xc2\xceq#\x16\xb0}\xc4,\xbe\x8b\t\xa0\xd8\x13W\xf4!2\xedz\xfeS\x8b6\xff[\x97\x18\x90\xe5'\r\f\xbb\xb0\xdeD\xabg\xa3.O6'\xad\x89\x881K\x91X\x9e\xf7\x13\xf4\x8bEn+\"\xf2\xb6\xadp\xf6ȍ\bΥk9\\b^H\xe1q\x93\x80\x7f\xb5r\xc1\x7fL\xda} \xf7._\xc0\xaa$\x99ڦI?4>B\x89\xf7r\xe5\xe2\xb5\xf2\xf4\x06.\x03\x17.\xfaF\"7!%\xf0\x1f\x00\x04p\xb2\x19\xce\x06\xbd\x7fފ\xbfv\x81\x8b\xaeOI\x8bd\xd7̳\xc6Om\xe2n\xdcK\x17n\x1a \xae\xa1iH\x9f&筙\xcb\xdb~T\x13\x03/^\xad\xe4@2\xac\x93\x87a=F'\xd5\x15\x1c\xac\xaf\xf1\x98\xc6\xc6\xf9ʾ|\xeb䆛-\x84\x19=\xa7\xaef\x13A\x11\rů~B(ӣ\x14\x03\x0f\xd2\xfb\xab\xe5ڀL5\xb8\xe2(\x14\xcc\xea\x01\x15*\x16\a\x94\xe0\b\x12\xbdP\xd1\xc6\xc3ѿ\x90\x1b\xad\x10E\x93\xd6\xf7ɠq\x95\xf8sո\x81\x9e\x9e=[\xbb\xf1\x98E\xfdĘ\x9e\xc4=\xf7\xd8%\x98#\u038d\x19x\xf9\xd0s|\x96]\xc4ŁY\xc7I\xb5\x90\xc0\xda\xe5.O 3\xccy +<\afU#[\x0f\r\xfb\xa4\x84j\xd6\x1c*\x11\xe7\xa3\x14$Ī\x8bLc\a\xfe\x1cB\x15CZ<hļs\x03\x8e\x8eBWr\x7f*r\xff\x89\xd9\xff\n\x9c1\xb0)[.5\x83\x9d\xf1\xc1\xe0u\xb7R\xff\xac\xee\xba\x0f-Q\x9a\xd9\xe8(\xbf\xd1\xf9\xca\xc5͎\x10iY\xa7\x0275\":\xf1y\x93\xd6\x0f\t\x88\x7f7\x02A,\xb7\xdct\xd35I\x03L'\"\xdf\t\xe4m\x8b̦\ar\xd31y[\xc0\xfa\x81\xda\xf4@\r!\xc8\x7f\xebۛn\x92Į!A\x1aX\xa2e\x1fs\xcau\xd3#\xbf\x9b\xd6j$\xe8\xa7\x03\v\xb7\xa6f$\x11\xa0\xbc<\xf0\xa8;\xf3\x04\x19\xbeF\xa3\x11\x14!ȿ\x03\x82\xf4\x92\xfc\xb7\x81\x90\x0f\xa2\xa5\r\xf0#Vꟕ\xbe\xf3ӵ\xfc\xc7\xd9%\xb6iw7\xddm\x1cV\xc5M\f\xea_\x15(\xb0\x7f\xae'\x14\xaa\xb9\xa4\xa4\xbf\x97ٹ\xbf\x1dG9za\x1c\xadV9\xfa\xc8\xe5\xfar\x13,O5\xe8\x1ap\x19\xa8\xc1\x028\n\xd2\xc31[\xa3\x92XY\x94\xf0\x00\x84\x10\xcf\xdcڼ\xb7\xb1\x03\xaf\xfcͪ\xfc\xa8\xde\x16\x8e\xaa\xe9\xd5\xd4\"\xc5+\xad\xf4\xf0*\xeeͷ\x97\x12\x01\xba\x04\xf1s\xf6\xa4\xe4|\xad\x13\x194\xb2\xceWB\x94\xe1d\xd2E\x1bI\xccRg\xceW<\xe8\xcaC\xff\bnm\x7f>\x0fod\xda۾\xef♊\x06z$%\xb6\x17\xa8\x9ad\x8f܀\xb8\x02\x16\x9a\x06\x00҄\x985\xb3.<\x0e悛ؘnn\xfb\xed뚢d\xb1\xc4)\x95\xf7\n\x97t\x82\n%\xcfZ!y\xc7\xc2tPv*\x18/\xdc7FD*\xd8-4\xeb\xbdh\xbc9\xfet\xfe\xe1\x87\xf7o?\xbd9?~\xff\x9eJ\xcbέ&\x9d\xfb7(pw\x9b\xf4]#\xe5I\xe0\x87\xc1_\x12\x9f\xc0\xfd\xbfZ\x8d\x83\xc8\x0f\xc3LBk\xc4\xdb6\xa2\x15HK|n\xbc8&.\xca߸\"\x14^\xfbsyBc\x90dfD\r@E\x81m3p\xad\xbe{\xa1\xd6P\x7f\x9a\x11\xb4\x82=\x99濽\x9f\xb9C\x96\f\xe7c<Q\xb4\xecAS<\xff\x89\b:2H<\xfb\xe5G\x8b\xfe\xa9\xbc\x96\xfdq4\xe7\xc1\xf7\x9fB\xfb\xfdG\x97co4\xc9j\xa8\x16p\xbb\"#\xcdp\x86S?\x880)\x8db\xe0AW\x81\xd6\xf2\"\xcf^\xb9Ļ]ٸ,bOsT(@\x87\x9e\x05!\xe6\x00\xfeLc\xada\xbd\xb5\xe6\xfb\xa7<\xdf!\x17\xb7S?}%\x15\x17\xc9j\x9d\x94o\x97r\x03m\x9b\x1bh\x83H\x8bF\xc6\rk\xe3\xfaU\xab\xa5\xa6M\x98g\x01\x86\x80\xfa\xb4\xad&}\xe4Z\x85$\x13P \xea\xb5ֻg\t/\xa8Zrk:ъxV\xef\xbd\xf5\xf5\xca}\xbf\xf61!\xb6O\xd0φ\xaa\xfef\xb1\x1f\x15\xec\xc1\xe2\x9f<\auk\xdd\xf9\xff\x89,X+\xbe0\xce\x03\xc0n\"U\x94\x81\x10\xd8V\x99cZx\x97\xa1q\r\x9aܺ\x1e\xaaU\xfb\t(\xa7\xb8Z\x95tr\xcf-\xbcb\xcfs\xf42rg*]h\xb1S0]\xf7\xe2ju\xad\x89~L\x93\xc1]_\xad\b\xa1x\x95\x17\xdei\x96o\xb9\xdc\xf0\xb1\x92\xf1\xde\xe6\x04\xa2Ɋ\xf1\xae}\xf0\x13\xa9s\xc7\xed\x06\x05Y\xa2\xafn߰lْ\xfb\x84\xe8\bm\xaaq\x8bv\xf7\x02\x9a#M\xbdt\xc5\xfc\\_A\xd6\x17F\x8a\t\x88\xbaI\x03`K$\xba\b\xc6\x05\xae\x84\xc2K\x16K\xa1^\x8e\x88)>\xf7\xf9\xa0\xa4\xc8f\x91\xeey\r\x1bx_\x05\xdc\xf3\bGl\x10Ͻ\x8d\xae\xecUH\v\xab\x90j)p\xaaW!֫\x00y\xc6!tO4\xa0\xd8w\xf4\xf7V\xc1lͽ\x156\xaf\x84]E\xc2?K\xb3\xb5\x88\xe9\x03\x1a^\x99\xa0y.)\xe4\xf1\x7f\xa4d\x11\xb7a<\xc1\xbb8\xf5\x04D\x83\xff\x1bȤH\xecX\x04\a\xb7\x89\v\xa0(\xfe)\x12\xcc\x04\n\xcdp.\xca\xdf3\x02EՀ\xe8\xa1q\x94\xc6!o e\xe2\xaa(Z+i\x04\xf2\tu<\xfb\xa0\x02\xfa\x01\xb48{\a\xed\x16\xa1?\x82ng\xbf\xd99 \xf4?P\xa1\xb3\xd3n\x12\xfa=h\x81\x0e\x0e:\x84\xfe\x06\xbf\xf6v\xf7w\t\xfd\x15\x14>\xad]\b\xeb\vڤ\x83}P\x12\xfd\x02\x85\x9d\xfd\xe6.\xa1\x9c\xc3\xef\x83\xdd\xf6.\xa1\x82c\x95f\v\x9c\x90\xe1wko\x0f\x12ir\xa9J:h\x12\x1ac\x95\xc3CІ\xf9\x1c\xb5o\xbb{\x90\x89\x93K\r\x1b\x8c.\x85\xdf{\xad\x164\x19r\xd4G\xa5\x9c\xd0\x05\xb6\xd8:\x00\x05\xd9P\x16/8\xa1s\xce\xdc\xc4mw\x0e;MB\xe1Gk\xf7\x90\x10\xa5\x90\x1aC\x1e\x1b\xc1\xa3QZy\xdbx\xb7H\xb8An9M\x15^\xef\xe9b\xce\xe5O\x1ar\xadhrD<\x99\x84\xfct\x1a_E\x0e\x95\xba^\x84.\xff&^h\x9a\xd4\xe3\x14l\xbd\x04\x05\x8d3\xc6\xcc?\x19y\t\rR\xfc̋V\x92\x88\x85\xb8\x89\xa9\xa1f\x8c\xcc]\x9aΏN\x17\x17\"\xe1\xdc%\xddh\xb9\xcc,)\x14\x85\x9c@L\xe0t\xbd2\xe5\x8dt\x1a_\xb9}\xc7\xf4\x9d:@\xd6\f\xe8V\x84\x98ߚK\x1c=\xf7\xa3!\x0f\x9f\x87\xc1\xf0\x8b\x9e\r6\x9f*v\xd0\x05 >\x11o\x17\xe28\x02\x83\x85\x91\xb7\x95)\xa3\x1a\x85w\xab\xf5\xf6?$\xb0,\xff}\xed\xbf\xe7)7\xcdss\x01\vk\x81\x1b\xb1\x9fv\f\xb6hH\xa1\xdf\v\xa9\x8a\x7f/W\xf1Y<\xba\xf9\xd9\x0f\x17H\xfcs\xd2-|\xacv\x15\x06\xbd\xf6\x81\xb2\x1e\x10\x14\xac\x05^s1\x8dG\x1e/\x19\xe8\xf15\x1f.\x04߰\b\\\xbe=\x01ˉI\xc2\xd3T\x9a#\xe4\x1bY\x87\rݚ\xba\x95\xe7|\x98]\x95\x1cG\xe4\t:\x93\x83J(\xbc\x7f\aey\xf832>ހ\x1aE\x88\x8a\x1a\"\xfe\xf1\xd4\xc58Re\xef\xfb\x0e\xf4\xa3`\xac8\xe4r(]\x1fvvn\xbe~\xd0\x1bN\x82=\xee\rUֆ\xae(\x90\"H\x02\x85\x93S?u3\x18e\xeb\x00ܔ\xd9\xfc\x1c\x91,0i~BK\xb6\xb7\xb5Z\xcd\n\f\x1d\"\x1d9\x82x\xee%T\xa2\x94lG#[\xe9\x13\xaf\x98\xa0\xb7\xa3xx|=\xf7\xa3\x14\x8c\xb8|:\xe2|\x0eV\x1f`\xfe\x10P\xe0\x9cC\xff歅\x83R]\xa8\xe0\xf8\xc5B\xbe\xf2B\x9a.\xe6`1\x85\xab3\v\x84\x04\xe5\xd4[\xacX\xec\xa2o\f\xe2\x95\xd3\xc5l\xe6'7\xae4\x85\x90\x82\b\v\xd58`Z\x13'\xc1\x048]\xabc\a\xf4\x95\xe5\xf5\xe3B-\x88\xd2\xc9\x1b\x93O\xc4\xc5\xfa\xaeU\x99P\x81F9T4$|\xe8F]'@+<V\xc0z2\xe7\xe1\x80N\xc1B\x1a\"\x85\xa6\x1cͥ\xe9\x88\r\x01H\x17\x8a\xc6Xd->aM\bʨ\xed\xaeE\x03\xc3}}P{\xdc\x13\x8d\xdc)˿}\x19'nq\x84^\xa1\x05i\x1bR\xb6\x10)\x1f.\x92@\xdc\xe0*\x14\xfb\xd1/]-j\xb9\xb5\xaf\x969\r\xd2\x17\xd9\xdekH\x9cRk˼\xe1W\x02Dn\xd8ވ\xea!x3\x1a\xa4O\x17b\x1a'\xc1_|\x04\x13\\\x88i6T\xfb\xa5;#٭\xd7P\xbf\xdc1uƋ0t0\xa3!\xfd\xbc\x98\xcd?\xc4\xff\xe17\xde\x1fx\x1c\x1b\x8fn\xe5\"\xae\xf0\x97\\\xc7\xd5\x1f4\xe1\xe9<\x8eR\xee\x15\xd7F\xbf([~\xaa\x8e~\xc9GX^\xf6\xcdje\xf8\xa9\x17\xc1\xe8u\xbc\x88\x84\xab\x0f\xa6\x9e\f\xb7\xb1\x91\xa5|_\xbf\xbc\xb9\x15YM\x18\xa5u\xf9\xe5\xbd\xfa\xf8\x06->M\xff\x9f\x820|χ<\xb8\xe4Fr#\a\x92\xad\x86Y`@S4\xbac(b\x8b\xd9\xf7\xa2n\xa2Z\xbd\xff6j\xad\bM\xac\x99D\xf7\xcd$\xe1\xd1\b\x95\xb7@\x1c\xc5sC\x11!~O4~\x8f2\xa8\x8a\xf3P\xe5\xe7h\xa7 \aɩ\x99rhAϢ\x00\xb1\xc3\fb\xe6\x06\f\xc6e\x87`\xba\xe9\x10\x8c\xca\xcf\xd5,\xbb\x95&4\x7f\xeb^R\xfb:\xbb\xc9i\xff\xbd\v\x1b\x81_\xad\xe1\xf7c\x9a'\"\xaf)\x1c,\xfdtNs\xc7\xcc;\xa5\x16i\xe2=\xa59*\xc7\xfb\x02vM\xcfK\xe8\xcc\xcf\xec\"\x8fR\xdfl\x06\x18Dƨ\xa6&.\x18\x8a\xe5l\x92`S\xdf\xe475U\xfb\xc3\xcb\xf1\x9b|\x8b\xe8\xcdq\xe8\x88\xcf\x13>\xf4\x05\x1fyo$\x0e\xcfJ\x1c\xb2\\\x967bՁv\xb6Z_\x0fI%ד\xf7\xa6\xb4\xb3MW\xd97\x02\xe3?\n\x81\xebgԢ\x9b\xd7^\xd2\"\x91r\x17\x91\xad\x89\xab\xb7*\xa2\xf1qȁ\ru?\xd3\xec\xd2\xf1>\x94\x9f\xael-,>I\xf6f\x15\xd0\x1cc _犨M\xd9\xeb\xf7Y\t\xcd1.\xfa\xbdUD\r\xc1\xad_\xaa\xc7\xe2\xc9\xcdNj\xf1\x1c\xdf}\xba\x8a'u\xfd,\xdf}v\xef\xc2\vxr\xc9j\x85t\xf4\x98\x03\xd0#\xb3\x827\x80Com\xe8\xdbjf\xfb\x80J\x99<\xd8m55`\xd9ж\xd5ҥEp\x03<\xaf8\xe5\xa9\xcd)g\\\xf2\x84\x8bW8W\x85\xdas\x13\xe1k\xeb JPP\xc2Dc\xb8H\x12\x80)\x8c\xc8\xeaJ\xbflM\xd3/\x97\xc8\x1e\x14\xc1ϙ\xb6\x1c\xe9\xfd\xf6\xb8\xf2&\xaeȞ*ʘ\x1c\x83\xa9;\x8fiB\x1f;\x95\xc7ĺ\x7fd\x9f\x995\x87\x1e\xfd&(\x97\xa9\x17\xc9j5\xe5\r{\xe1\xc1\"Z\xaę|e\xf2\x96\xaeRx\x90\xd3%ٌ\x91\x8ap\x9e\xc9\x0flp\xc9\x11\x17]\xc9п\x80yb,\xd8\x14\xcc*W\xf6\x14\x8dU\xedm\x1e\xccD\x0e\b\x93<\xd0E\x94'IV7.\x1c\x00\x1f\rc\x9f\x9e~\xf0\x02H\x9f\x9a#wR&pL\x91=(m\xff\x1b\xb2\xc8u\xa0\xdf\xd4ٸ\xc6\xce(\xb8t\xe8-.'\xc4=\xf0\x9cQ\xe0\x87\U00064fb8vV\xf4\xfe\xda\x10\x9aq\x94\xc4s\xacO\x1e\xf0\xc1,\x1e\xf9\xe1C[\x97\x95\xbfnD\xba\x83z\x10E<\xf9\xbaO\xa6\xdc\x1f\x95\x7f3\xed(\x88w\x9e^\xfaA\x88Ώ\xbe\xba\xd9\x14\x93S2\xfb\vt\x1fp\xa8\xd4W\x9aG\xabk\x84\xbe:\x0e\xc0\x01\\\x9aaQ|S2\x92\xf4r\xe2\xd0[L\xfc\xe89\xed\xa6C\xa7<\x98L\x05\xfe.\xa9\xbe\x00ྜྷ&|\xec9\xffR\xb0~\x1d\x06ї\x1f\xec\"\xb4\x81\xfa\x9a\x95\xd2\xf1\xb4W\x14\xb5Q,m\xa0\xf4\xe5\x94\xff\xe9\x92L5\xe5r\x9a\x96\xe0\x8f\x90\xde~\xe1p_#P\xd3Q\x06\xbb\x1e\xbf\xe7h\xdc}\xac\n\a\ag\x05\xff\xadV\x12_\xcc\xca\xf1E\x8eB\xceQ,\x1c\t\x8cw\xf1|1\xf7\x84ٟ\xe2\xf9-\x15\"\xaa\xb3g\x00\x04\x1bql\xf4z\xffrC\x03ui\xb3R\n\x95\x06\xbe\xb2oxϹ\x10\x91\x81M^\x81l\xa3|\xe4x\x85\xe2E\xa4^d\xb3*\x03\xb6\xb9\x1f\x19\xc0\xd7\xdf:䟄J\xdes\xfee\xc6\xf8\xaflX\x19\x90n\xa8\x01\xbb\v\x1a\x93b㱼6̦O\xee\xd9t\xc9\xc5\xe5\xb0\xfe\x1a\x98\xe5\x01\xeb\xae\xfdG'tM\x00\xe7\xb02\xf5\x99r\x80\x92%\x1fb\xb3\xa2.\xb8\xc0X\x00ß\x89(\xc3\xd9q\xaf8ŀ\xde\xea]\x93~s\xc5{\xc9'y\xca{kK\xca\x06\xe4\xa3K\x1ai\xf0\x97\r\xdb\xf0~\xfd\x1e)N\x93xR}!\xd7\xf5\xf2\x1f\xb8|\xe3Ȗ\x18\xf3F*\xe29\xdc\xf1\xfeė\x9a\x1b\xbcK\r^\xcc\x1d4`\xe1\xdd\xfc\xf5\xbb~~\xcbD\x95\x0f<F\xb9\xd3{~\x0e\xc7\xc7@aɻ\fr\x1d?\t\xfcz\xe8_\xf0\xd0Yk\xa8\";\xdbД~\xbb~:5e\r\x0f\xab\xffmΟ\x86\x85\x9b\xfba\xe1\x0eEN\x1c\xc1\x96=\x9f\xfaф+P\x80\x9d\x8c`#\x04\xe8\xb4\vR\x11i\xb7[\x94p\xa7(-\x85\x96\f8\xcd\x13~\t\xb2#I<*h\xb2Oz\x01\xa2\xb23\xf2)\x10\xd3w<I\x83THO\x14\xdb\xf3\xa7\xd0q\x18O,\xcd\xcaC{\xce]{I\x0e\x85H\x93\x98\xcc\x1c\xd8\x18\xcc\x11\xd2\x102چK\n\x8br\x9e3W\xd3_\xb8\xbc/\x06\x98\x98T\x1a\xfb\x81\xdcV\x0ew}\x82k:)M\x19\x7f\xe3Z\x96\xd3\xca\xd4\x16د\xca(g{]D\x1e\x05%\x05\xc4\\$\x0fJ.b\x9f%.^]\xe0\a\xe9HWC'\x86f\xdax\x19\xa3\x9f\xa1#\xfd<\x1d\x9cTe\xc1\xa2\x1c\xb2\xa4C\x96\x96\x1a0nm-P^\"\xe0&\x9a\x17+\x81%\x9c\xea\b\xc3Z@M\a\bA\a\xaa\x8f\xef\xa8\xce֪\x7f%\xc90\xd4ިΊnm\xcd\x11ۯ_\x94\xce8Nf\x0e\\%RѠD\x11\xe6\x18I\xdan\xae\x8687\xd3.\xb6\xe3KrNP\x19\xd0\xdb\xe3T\x9e\xdc\xe2\xde\xd9\xc7\\㳬\x84fK\xee-\x8a\xfbJlȧ\x0f\\\x85\v\x11Yē\\\x05H\xb2\x88?z%D\xa9\xcd\u05c8\xa8\"\x89]M6\x15P\xb1u\xe8W\xd4y\x85O\x0e\xf1JZ\x95\xb4\xbf\\\xd6\x1c\xed\xbf\xdeGF\x9f9\xab\xbb\t\xae\xfbF[\x81ɏ∗s\x15\xces\xf8+\x01\x11\x92\xa5\x95\xae\x88\\V$\xfe\xee_\xf1t\x18\xcfy}\xc4\xc7e\xd7\xcd\\5\xe3\x9cB-\x99\xa0g\x91\xf2\x11\x18rO\x12?\x12\x98Xu.c\x99\xc058\n\xc6c\x9e\xf0HTB~\xc9\xc3\x14c\xcc\f\x87\x98\x97*\xae\x8c|\xe1W\xe0\xb2\xe4S?\x1c\xeb\xa49<\x1aA\xa3I\xa3r\xec\x0f\xa7\x95\xa7\xefN0qĈ\x0fC\xe8/\x8e8D\t\x9b\xc5\t\xaf\xe0`ӆC\xee\x18*|\x0fҴ \xe1)\xb6?\x8eA\xa0\x03\xf9(\xd4\xe7\x15\t\xa1*\xe4G\x1c\xf1\xb4r\x13/*W0\x1f31\x11WN\xaf\xfcɄ'\x95\x8f'\r\x87\x10\xcd0}\xc5\xe9\xcf1Te'Pm\a\x9e\xc2\xf5\xe5\x0f\xe8m\xeel\x15\x8e\xe9\x8a\xe4N\x97$\xf2\xcc\xcd~\xf1\x00\x96\xe9k\xce}d\x9f\xf38\x7f\xce\xfd\x12\xfc\x8d\xf8ڟ\a\xff\xe17x\xb5\xa3\x87\xb8\xeb\\\xf8i0\x94\x05\x88\xb3CU}\x91_;\x1d\x0fy!\xa3\t\xab\x86\x1c/d%\x8bT\x8e\xc4\xf2#̏~m\xa2z\x92+\x95\xa5\f\xc3\xe6\xca\xc1\x96u\x9a\xfe\xf7t\xaa\xa3\x1b\x84\xecn@q>\xaa\fe\x9a_\xa9dwo\x05\x16\xb0\xe2@\x18Ȼ\xef\x1eh\xeb\x8fG\xb7bU\a\x99\xfb\x1f+\x9a\xc1\xce\xd5\x03`G\xdal\x17\x95xj\x13\xf1\xf0\xab\x14\xcaX0\xe3i\xeaO\x00!F\xba(\x8d\x17\x89L\xc8\xfd\xd0+\x12\xbbLK\xf9iu\xf8#\xaa\x92B\xdc\xc5\x10'\x19\xf9{\xfc7\xc9\xdf5\xd2\xd7lj\x8e\xa8\xc2u\x10~2\xe1B\x8a[h\x94s\x13F\xaaJGр\x00\x12y\x121\"TH*\xafk\xc8\xebD\x83^\x91\x89\xd5BZi[\x93\xb7\xb3(|Ie\x87\xf1j\x95\xd5\xcf\b\xf8\xdc\xc5.\xca\xf81\xb4zTZ\x1fN\x1dl\xcd\x19\x14\xa5\xaaTt-\\s\x9f\b\x15\xbb.\xc7'\x91\xeb\x9c\xc8la\x14%\xa4\xef\xe3+\abLD\xae\xf3<\x06h\x1bjV\x1c\x8d\x14\x1d \xed\"\xd7y\xed'_Fhj\x06T\xe3\x18\x8a~D%\x13\xe80\xb0\x10\x11Ѵ\xb8rt\x84\xb8\x9e\xb3\xb8\xe1\x87!6\x99\xda22t\xddDH\x86.OF\x0eAe\xfc=\xf0\\~+Ow6\xbd\x19\xc6#\xae\xde\xf9\xc6T\x03\x16\tn$\xe7\xff\xf3\xffv%f$\xceڧcz\x8bJžS\"\xd5p\xa8?\x00\xa2~ZB]N\xf7\xd6$G\xa3RJ\xa6t\xc4sz+O\xb6\x97h\x9dd:L\x82\xb9ԕ\xaeȃ\x1b\xcant<\xfd\x15\xe7\xce\xc5ɯ̷\xf4r\x12=\xb0\x8f \xfa\xba\x1e\xa4@A\xf5\x82\xc0\xe5A@\xab\xde]]9\x95\xef\xf0\xbfJ\ti\xba(\xef'\xd0\x14\xab\xe0\xd7\xc2ɮ\x17-\x800\xc4z\xfc2\x1e.\xa4y\x9c\xa2j\x04\x1b\xad\x8b\x81\xc5F\xaaeH5\xf6\xa7\xf2B\xb2E\xb5\xd7\xffC(UcK\x891\xa2\x95\xc1\xb0\x1a\aJ\xd5,\xd6\xea\xc6`ԟ\xd0<Z\xbd\xd5\xe8\x0f\xd0k^J\x90\xc7X\xba\x87\xbb\xf0l\x03(X\xa8\xb8\x8ep#jZR=\xf6num/^A̓\"\x02\xf6mI\xb6\x16\xa7\xac#`\x8cť\x10\xb0\xc8\x100D \xf8:$,\x87\xf2\x10\xa2\xee\x1b\x91p\x01\xe3\xd2\xf1\x1af.G\xc2fY\r6\xf6\xbf\x02\x1b\xc7\x7f\x13\x1b;π\x00\xcc닜2\x8cw\x17\xa2\x8d\xff\x9b\x10\xed\xf8\x1fC\xb49\x04\xf5QC\xe6\x03p\x94C\xa7\xd4\xf9v\x14\xa5\xb8\xb4\x91\xe7\xe8_\x8e\x84DG\xef\xfa\xc3\xd1\xd87\xcd\xf5\x9d\x9f\xa6Wq2\xfa\xef\xc2\xc70J8f!\x17\xdcs\"~U\x9f\xab\x1e\xf5D\xb3g\xb90\xd9s\xf9\xc4\xffa\x94m\\<\xceyf\x17ǯ}\x182p7\xd3\xf8\xeag\x85f\v\x18#oG˩\xbfNj!\xb2\x98\x06\x93i\b\x12m\bQ\x9d\x11\xfb\xa2\xf7\x00r_\x8e\xc3YQQ\x02\xe0\xeb\r\xa4\x1c\xe7R\xda\xc8\xf9\xb9~\xfb\x10\xbdr\xf1\xa3L\xbf\xec\x1c\xcbW\x95\x17\xf6X6S\x15%\"7uhE\xf9\xa1\x95\\<\x18\n\x8a\xc6\xd4O]\x85\xd4\xffϞ\xee\xcfr\x14e@nC\x82\xba\xe0Ы\xea\xdd_\xc4U\x93Ts +5=\xf9/:Ӝ\xa2\a\xca\x01\xc6I{*=PN\xb96\xa0\xf9\xf20W\x13-\x84.\xd3YI\xab\x15\x9b\xc48\x8f#y\xc99\xf9\xdcJR\auz\x13\x89)\x17\xc1P\x9d\xbe\x84m\xb5\xfe\x8e\xa3S\xa9é\xf2\x8bP\xe3\xa8V\x8b%\xae\xa0eCYӜ\x9c\xc7ыxff\xc3e\xc0\x94\x92.mo\f\xd5G\x16\f\xc0\xb0\xa6ү\x83\x8fT\b/\b;\x03N\xe2B$\xc1\xc5Bp\xb3\x8f\x92\xc49\xbf{\xb4`\a\xb5Zw\xbfx.\xad\x96\x14\\\xe5\xdd\x18\x14\x1c\xa6\x1e\xa7\xc3\\\xb5\xff \xca*2\xd4R\x82\x8f\x02\x85/\xfc\x06\xf1`c\x1c$\xa9p\t\x8d\xd5{+\xcf\xd0r\x19/\x97O9\xb2ݨ}\xdcl\xa2\xacg\xe6qjƔ'\xc0ʗ\x99[\xb6Rf(`\xab\xf5\x85\xdf`(\xe6\xe2\xca%\xa5+\a~+_a\xc7\\\xb2Vٰ\xb5ki\x927\\\xd6\xef1\xde*\xe0\x19A\xb2\xb1'f\xec\x82%\x1b\xc7.6\x8f\xbd\xa0\xa2\xbf{_i\x90\"vy\x1d\x8f\x82q\xc0\xa5\x97\x97~\xc07\xc6r\aH\xe9i|\xf5\n\xee\xf0\xa2\xd6\xe8\xe1R$5\x9a\xba\x04wgE\xe3\xde\x06q\xd1\x1d\x9fe\xc8RR\x14\x19\xaeL\xbd\x8a#\x11\\\x89\x14J\x1d\xd5;\x1a\xaesY\xb9H\x01\xd8g]\xa1٨ZMz\xce\xf9\xf9\xeb\xb7/N^\x9e\x1c\xbf8?\xff\xf9髏\xc7\xe7\xe7\x8e'\xc0@xE\xa3\xf5\x99\xc5suk\xc86J?_Q\xa7\xafw\xa0\x82\xf5\x06zJ\x05\x1f\xf6MrmӋ\x14\x93ʾĊjٟ2d\x067\r\x10b[\x94\x8c\xb6\xda\xfc\xb2n\xb5i\xe0\xe8\x8dK\xc0\xa4\x1a\x0e25g\xb5$hǺ\xab<\x15\x96\xc7(\xc7\x00\xee\xdd\xe4\x88w\x93Z\x8d`\xac\x95\f\x7fg1\x99\xb43h\x18O\\\xe7\xc5\xf1\xb3\x8f\xdf{\x15\xbd\xd9Z\x95\xe0\xcb\xfc<\x93\xe0\x92G\x18U_\r\xab2Ty\x90\x1dp6\x15dUr\x04pa-\xc0\x86C\xa4\x18\xae\xe7\x1c\x02s\x9c4^\x05\xa9h\x04)\xfc\x81H\x02\xd6\x15k.˷\x0f\xbb,MX\x1aŀ\x13\xe5z\x91\xbbWt(\x9b\x97q\xa2\x106\xc0j:\xf7\x87\x05\x94=,\xbc-5ܰ\x98\xeb>\x1f\xe4L\xe2A\x7f!C\f\xbbk\x97[z\xe7 \xf8\xe61\x94\v+\xf1\x10Ym\x9a\xfa\xae\xa0\xfc\xae\xcesSWqɴ\x19\xee\x9dS\xc38\xe8`zn\xa5\x92-ZD\xf0Aٽ\x1e\xa4j\xc6\xc0\x8d!\xbf}\xea\xcf\xf8\xd3T\x01\x8d\xa4\xc6\xca6\xc2\xd4\xc7*\xe5f4Y\x98\xc3\x1c$*\x8e\x1b\x93\r\x17\x86\xa3\xf9\xf1\x97qb]\xdc\xf6R\xdc\xda\x17\x8eX.\xd7{}\xce]7\xb1\x17\b\xac\x1aJ\xa4Ǥ\xa4\U000d2056A\x80\"\x13xY\xf7f҅\x89\xc0\xe6\xdb\xf5KH\xac\xfcQ\xb7\xa9F\xbe\x91j\xfc;$c\x81\x06\x89\xe8b>\U00085736\x17\xd3\xf2\x9d\xf6)\xf0\xcc?\xf8\xe9\xf1(\x10|\x04\u07b5^\xb0bBK\xcbB?\xc5O\xe4k\xf9M\n\xef\xef:\xeb.\byD\xe9\xb2\xc9\xd83\xa5\xb7\a@\x90\tV\xef>\xe7n\x8a\xe6+w\x9dg\xf7\xb6p\xe5\x9f*RT\xe2\xc2rj+\xca\x10\xfdb\x1d\xd1\x0f-D\xbfx\xd2\xee-\xeam\xaf\tҨvw~\xb4\xe8\xcek52\xec\xcf\xebm\x1b\xe5\xcf\a\xdd\xc8\xe5\xe5\xb47\xa0\xef!Y\xdd7\x0fX\xe7\x17\xf1\x15\xe0[\xee\xcf\xe4:\x87t\xd3\xec\x92j5X.\xb7\xb6\xfcjՇ\xb4G\xe0\"\xb6\\\x96\xcc5\xaeVq)C\x929\xd9V숥eG9'\x8a\xec\xf3F\x11Q\x0e<\xeb4\x96\x01\x88E0\x96B\x1d-\x9bm\xb2q\xb6Vsk\xc0\xba\\\xde\xd7\x19$]_ٞ\x85@\x11\x7f\x8cf\x8ar\xb7>G's\xe1\aQ\xd1\xd5\xfce\xe8OМ髉\xeb\xc2i\xb3\tlj\x9fԵ3\x88\u0091\xd2\xc5\xf5KW/Xe\x9bz\xf7\xb1܄\xd0\xcc>\xff\x87\xdfP\x0e\xb2\xd94o\x94\x96\x89J\x15?\an\x95\xcbeFQ\xe4^\xe2[\x996{\x81\xe6&*,iW\xb0\x05r\x0ev\x7f\xa4g?y\x8bu\xc6,\xba\x83o\xc0\xec1\x05\x0f\xcb\xd2\xf5\xafV\xa1\x96/\xff@x\xb4\x87o~S\x19\xb2\x95S\x02\xebg\xa4|\xef\xf8\x86\xf3\xb0\t\xf2!\xe4\x84<\xdfE\xb7\x9aM\x17\xb7\xc5xRkE\xd7\xe4p\xa5\x10g\xabIʀL\x82^qR\xc1\xc6\xe1\xa7\x0f\x05\xca\xc8u\n\xb7\xe5F\x01|Hoקh\x11\xc5ֹ\xd2\xfcO\xbe\xe9\xcd\\\xe2\x16\xa42\v\x00B\xd6xKs\x03\xf3j\x15\xa2Jm\xb1lf\xa58t\xb9\x8c\x8d#\xd9\xdbu\x96d}\xf91\xf9\x8a\x9aW\x8eءkt\xaas~\xae\x13Μ\xbfy\xfa\xfa\xf8\xf4\xdd\xd3\xe7p\x81\xd2;a\x18-\xc7oW\xff\xe3\x8cO~\xf5q8r\x90<\xf1*Q\\\xf9C\x0f\xe8\x8f,|'\xb0E\xf3$\xbe\fF|d\x18 \x9b\x9c\xf9\xdfe\xec֘\xee\x19\xbeʆ\xf1\x19\x83\xeb\xec\xb6 \xd5É\x94[~愾\x81\xe2\x83\xfdV\x9b\xd0\x0f\xb2\xf4\r'\xf4\x1d\xc7\xdc\x14-ȓ\xf1^\x16\xbfㄾ\xc6\xca;\xbb{;\x84\xbe\x90ů\r;\xf7\xeao\xe9Q\xff{L\x80\xad\x0e2\x83\xc7M\x1ex\x94'\x89i\xdf\xd6\x17\xac\x1b\x03\xe7]C\xf3\b\f\xec\x80Q\x97P\b\x1f\xd2\x18\x86\xdcO\xdc[\xa9\xee\xf3PG(u(\xd2\xe8S\x89\xd9\xe5ӊ\xd0\"\xd1\x0e\xe5k~H\u0590#:4Rm\xa8\xa4G\xef\xb3[\xc3ß\xf2\xe4\x92'pgs\xaau\xab)\x95V\x86^(U<\v:\f\x03\x1e\x89\x93\x917\x84\x80#s\x96\xca\xfb\x15\x12\xe2ʈ\x1b\x03m\xed6W\xd6nF\v\xa4s\xe8#\xdaJ2\xe3f\xad\xb8\x02\xc6R\xd9\xc7\x19\x1bL\xc7\xc3\x12\xd9\xeb\xf3\x84\x8fx$\x02?Ls\xe5\xe7C\xfbņ^\x9ef\x8df\x1d\xa11'*sd{9\xe5\xe8\xa6\xf2sT\xa5yc\x99b\xce\xd1\xfe\xb6\xe7\xb0al(5C\x96ݝ\xceN\xbd\xe9\v\x11\x7f\xe1\xa0.qd\x9e\x9a\x8cL\x1eV\xab\xfa\v5\xcf`Ĝ\x9aIHhΑ;$Z\xe3\x1c7\xa4\xfd\xe6{>\n\x12>\x14\x1f\x930K\xbc\xc5\x18\x9b\x12{y\xa2Fį\x9eJ\xf5\xb6\x01\xbe\x85\x816L\xc0\xa8\xf4\xc4h\x04\xa7\xac\xd6\x1c\xaa,\xe0<g\xad\xb7\x8a\x044\xe5@,SЉ\n\xc0\x00\x1f5*o\xb1z^\a]\x19\xfa\x11Թ\xe0\x959O\xc0\x1a\x9c\x8f\x1aΊt\xb3咭\x9f/\x92\xa0|\xfeS5\xff\x11\x80\x9f\nl\x17\x92ވ\x85(]\xb3\xe5M!$\xc9\x1c\xb1\xd025\xa5#\x13z\xd2\x04\xa5l ܟ\xf2\xb9\x9f\xf8\"N0k\xbd\x19\x10\xbe+\x1fɨ\xf19\x0e\"\f+\xbb\x82\xa6f\f)ѤC\\\xc0\xfa/|!yM\xd3\x14\xf23\xa5M\xcd\b\xa1\x19\x8f\xddH\xb8\x1f\xce2\x88\xc0\xc7\xf2\x0fU]B\xa8[\x02\xd02\xdbp\x19D\xeb7١\x80\x12R\xad\xfa\xc0\xe1\xbc\xfb2D\x7f\x94\xa7\xc5\x16\xbfO\xfcHd\xf2v\x9c\xef\xc7\xcf\x04\x95\x05\xf8\xf0\xcb\x05\n\xf6\f,\xc7#~>\x9c\xfa!,:\xcc=!\xb4\xfcݹ\x8c\xe5\xc0Nۻ{\x18\xec\a^\xff\xcc\x13\xa0~\x12\xc6W\x88\xf9F#D\xeb~\xf8ӂ'72\xfb\xd7;?\xf1g\xa97\x81萹Г\x13)/\xbc욅\x9d`0C\xd5?ȃ/Y\x9fS(\xd5\x01\a/\xe9\xfa\x1a\x13\xb9\xcd\x0esP\xf5\x023\xbfѨ0\xb7\xb2\x1f\x93P\xd9\x06_t/X\xd0{\x01\x97\x0e\xaeʛ\x19\x81DO\x90\xad\x99\x98\xb4e.\xf1\xac\x97\xf8\xdd\x15\xbdf\xfd\v:V=V\x1d2\x90?1\x0f&\x86-\xbaQ#\xbd\xa1N\xcf!=\xc8\xc8\f\xf5\xbaW,C>\xb0\x95\xbd\x04.O\xbd\x81\xfcD\xbd\xf3p\x7f\x9fi[f\x1e\t\x85'q\xbb\r4\xe0>\xf7l\x8cj^AŒｍ\x95!\xe9\x97\xdc$*\xeb\xa0\x03\xa0{M\xf5M\x86\xe7\u009b\xd1$\xc3,ޔj\xf1\xb3w\x05\x97\xdb\xf3\v\xcfF`+\xb2r\xd5\xe7\x99\x19j\xfen\x8b\x1b)\xfe8\x1e\x8f\xf9P\x04\x97\x8a.\x8e\x8d\xb2P\xd6\x03\x9cp\xc7\xed\xaf\xafR?w\x95\x06%A\xde\xd0\xd1 g\x03\x86\xd2j\x9a \xcd\"\x15\x95h\xedEo\x87S\x0e\xfel@/D\xd4gQc\xe4\v?\xd5&\xb6\x98֢ZͶ\\\xd8fa\xf2\x8e6f\x13\xbe\n\x8c\x1ct%2\x83\x10\x9a\xc1\xc6\xfa\x01\xed\xfb\x83\xa2\x91\ueb6c\xe2q\xcd\xc8B\x8cָZž\x93\x8dm%\xd4'O\xea-\xd9}\xb8\xa1M`\xe0ÍM\x84\xb8N\xc0\xca\xf8\x84\xac+]\xe3\b\xf9\xca5\xbb:\xb5\x96\xb7j\xd5<\xed\xb6G\xb5\x19\xb2\x8c\x85\x84N{\xc9j\xcd$\xb9Ћ\x04\aܽ\xd4\u07b8\xae\xd1.\xeb\xdd\xf1ðW>\xcd\x13h\ue0f5W\x8a\xa3G\xc2Ja\np\xe5\xe0#\xd5\x0fY.7\xd4KU\x05\xbdð0^y\xaf\xfd\xc1\xaa\xdc1\xf0k|\x02-h/\xb3\x17\xec&Er\xf5NZu\xb3\xaf\x9f\x8a=\\f\a\x9ew6ȓ\xd89[>\x1a0\x10'\xeat\x02!\xf3s\xa45X\x0f\xd2\x05\x88U\x02\xb9\x96\xc6\x18KF\x1d\x1aZ\xaf4i\v\xaf\u0086~\xc2j\xf3\xb5j\xa7|\x98paW\x95%X}lU\xd7\xe4\xef\at\x05Y.\x95\x1f\x06\x9dZuR\x03\x01\xa1:\r\xcbe\x7f\xd0]\xa3\b\xa7ժ;e\xd3F:\x0f\x03\xe1\x86eDJ\xc1\xb7џ\xcfQ1\x1b6\xd4/Z\\h\x058S\x8b\xb2\xa7\xf6\x84\xbc9\xd5k\xe6-\xe8\xdc\x18\x999Ԟ\x997\xfeJ#\xcd\xfc\x96\x16\xad5\xe5(\x83\x82\xc7{XbĹ\xb0\x8d8\x87\x99\x11\xe7<3\xe2D\xf3L\xed[I\xa7E\x93\xceQ\x99I\xe7l\xdd\x02l\"{\n\x84\x8c\xe3?ҝ\xde\x06\xe9ۧ\xa7\x1d\xefr\xc5Bĸ7\xe0\x0e\xd1ˢ\x15F'\xa3\xe7q\x14\xc9[L\xa9\x9e\xd5\xe8/\xac\x1b\x9a^1\xcbb\xee\x18\x1b\xb9镲\x1ee|\x8aM\xb7\xd1k\xfdu\x19\x83T\xc6M\xe5x.\x9c\xc59\xdb\xdar\xe3\xb5\xc3D\xee\xa7\x05!)e\x8e+\xfc\xc2N\x19c\xc7\xd5\xeay\xef\xb4\xe6T\xae\x021\xad\xbc\xfb\xcf\xf3c\xc7;\xa5\xcfu\xdd5d\x98\xe4\xcf\x06\xfd̶\xb6\xe2|\xfc\x00\xa8\x10@\x1a˯\xb7\xa6\r\b\x84:ۂ\x0fOT\xd5\x13\xacjs=\x99\x13\x9c\xf6\xef!*h\xc1\aVf\xa7\xfa\xb7\xecs\x03\xeaTܷ\xb0\xa4mZq\xe8\x17\xea\x90\x12\x13\xfe\xd1\xdd\xf6\xb9\xc1 \xefݬ\x8f\xfd\xba\xe9\xc4t\xd7\xd8\xebf[\x0fN\x03\xeb\x1fK\xb3X\xac\xfca\xdd\xf2wv\x8f\xe9.\xfd\xfcpk\xe1\x9bju\xa3\xf5\xa1\xf3\x16\x8eҋ\x8a:K\x95\x8f\xef_\xdd\xe7\xe3p\x03<\x10\xc0\xde\xc5r\t\x7f\x8e\xc9]\xed\xe7\xa0\xf9!\xcd'\x9b\b~\xdd\xed\x95\xeaV\xfe\xb9\xbe\xb3\xf7\x0f \x06\xc0^\x1dz\x9f\xa1\xb2\xea\x18%\a\xbaò\x96m\xcb\x1c<\x8b+\xea\xbc\f\xe3\xab\xfb&\xf6\x85\x10z\xba\xc5\xd8U\x0f\x1e\xbdu\xfb\xdbRP\x1e\xdem\xa5|;\x15\xb3\xf0e\x9c(\xa1\xc1\xb9\xb9\x89W\xd4\xdc\xca`\xbb\xfc\xf9^;m\vB\xf5\x87\xe5\x96\xdbsz+@\x88.\xbcV\x93\x8ex\xfaE\xc4s\xaf\xd5,1#\r\x10\x99S\xc8<^\x1c\x1e\xb5\xad\xbc\x1d \xfc\xeaX~\x97a\xb7E\xa4\xdeo\xdd\xfd\x95\xebf\xee\x88\x15u\xe6\x96\xcd\xf7\xe7o\xb6\xf9\xfe;˴\xd9\xe6;\xb7T\x9bM\xc1\xad\xa5\xfa{\xab\xa3\xbb@\xa9\x9a\x83\xde\xe5\x01\x8fDź\xfb މ\xc4s_\vg6\xad\xf3wa\xcd\xd8\xcd\xc1*\xe6G]\xbeh\x1f\xf0\xd5]\vG﵌\x93T窈\xe5\x94q4\xb9\xbf\x01\x15ӱ~\x11\x8fn\xa0\x1d\xa5ͩ\xe03!\x06\xe7]/\x976ƕ\x7f\xae@\xea\xb6\xf5y\xb9\xfc\\\xadZ\xab\xaaI\xce\x12\xc4\xf8\xe0m7\xa2Q\x18\x94y\xf8\x1f:\x10\x13\xb9\x89\xd9\x18h\xa9K\b.\x01\r$\xedhY#\xe4W!\xbf\xf9\xba\xf4\xfe\x13c/\xbb\xb5\xde\x7fwES\xc9\xe1\xach\xbe\xe0\xff\x94\x95Uc\xb9g\t%\xc3r7\"\xb2k\u07bf\xb4[\x9f\xab\xd5\xe7\xd5\xea\xf3\xbbBS\xacş(uꞶ\xf5\xeaH\n\xb7\xec\x96\xf7\x9d,\xa2\x96\x9c\x9c%\x87P\xd3\xf0\xc3Ё\x8bD\v)*P@\xbe\xb2\xad\xec\xeb(\x8e\xb8\x0e\a\xf1\\\xd1\xc1\xcf3s8\xccT\xb2\x89\xaa\x1dn\x8c\xf5\xb0\xbe0(\u07ba\x88˂'.譜\x9a\xb4\xd1\xf0\x04\rFʗ\xff\xd1\xed骮?\xad?\xba\xb5v\x1c\xb6s\xf5\a\xc4-\x95\x01l?S-A{\x7f\x9f\x8cJ\x10\x05\"fPkp`\t\xeeV\xe4\xfe#\xf3\xc0\xd1n\fmg\xafT \xf8\xecaA,\x11ɬ\xee%\xfc\x14\x8d%\xee'\x11m\xc2}\x85y\xe6\xd0@\xab\x18\x95C\xb9l\x9d|\x8d\xcbִ\xc4e\xeb\xdb\"輩V\xddu\xfc3\xbe/\x12\x8d\xa5\xe2-\x89\xa0sW\xf0\x9conڔ\xe7\xa3\xe8\x10\xfa\xd5=<4\x8c\x8ev[~\xf6\x8f\x06\xc53\x1a\xf1;3s\x14bԢ\x84\xf0\xbdRtb\x02Pj\n\x91p\xc0\xb2\x9c\t\xd1W\x04\xc6\xc3E\x82U\xc1\x16+\xf1\xfc\x02\xc2\xc1aĩ$\x0e1\x14ަ\xa0u\xces\xf8ā\xb5B\x11\xccK4J\xc7\xf9Ib(\x95\xd1\u07be\x85\x957,\xb5\x9e\xb8\xa2\xafJò\xce\x13\x9e\x9f\xd3,\x18&1\xba\x17\xe2q%+\xfaW6\xb6\x91\x0e\xc9\xfcO\fNRn\xba\xc9o\x18\x1cu*3\x10H\xeb\x10\xc8\x1f\xcb\x01.\x9dƋpd\x9e?\xa2]\x8a\x9d\x9bj=\xf9\x00\x06)\xd3\x0f9\x898\xc0\x1b\xbe\x85\x1f\xb97\x12\x04\xf1\x9d\xfc\x99{[\x1e\xda\x1ak\x97\xbf*\x1a\xb5\xe9\xd1\x14\xc3\xc2\x16\xec@\xca\x1b\xf3\xa2\x82\x103\x96\a\xc7\xd7\a'\xc8[\xb8\x81\xb1\xca\xeb\x85\xf0\x05\x1f\xa9\x96\xbcT\xc7S?\x8d\x82\xf9\x9c\x8bT\am\x0fWhN2di/n\xccr\x1fA\x1e\v\x9f\x06ċ\x1bI\xbe\x84\u038dLK\xf8b\x91\xa2\x80t(K\x16 K\xa0S]\xc1\x00\xafJ\x9dCG\xfaM\x14\x8b\x17\xf1\x10\xb1\x06\xcamf\xfa\x05WBՉ.\xc0K\x8a\xd0K\xfdl\x01\xdd\r\xe6\x0f\x9e\x12z\xc1\xa6}G\xc5\xf2\xad#\x1f4X.\xa7}\xe7\xb9*B\xfeg@\xaf\x98\xc8l'\x9e!\xdbA\xaf\xd9Ӝ\x024\xd3\xd500\x02\x98\xf6\xf9\x80\xf4\xe0_\xa93%\xde\xd4\xca\v\xfc\x80\xdbX.A\x18\x00\ue14b\v\xd0mš\x9c:(*\x83\aԷ\x9c3P&_k\x1b\xafS&\x8a\xe2\xe3/P\xf4>\xbf\x91\xf2\xcdsx3ĵ\x7f\xc0\xc1\x1e\x02'\x05\xa6\x1c\xa1\x95R(\\\xbb\x10\xbf\xd0[\xb5\xf1\xdep\xb5~\xa9=\xb7^\xe7\xc0x%\x83\xb1\xfd\xad(l\x9aU\x84)\xad\xee\x8aH\xa01\xd1\xc7\xf7\xaf\xbe\x1e\t\x8dKI\x88\xacq\xa9˭h\x80)\xeb\x00Y\x91\xe2\xd8e\xf5\xb4\x8e/+ap\xc9\xeb\x85²I\t\x80\x94M+$\x92M\x9dl\x8e\xf5-F\xf9o\x86qx.k\x9b)աL\x9db\xb8\xd8\xd0\xf4\xe8\xdb[ʓ\x7f\xce\v.\xfc L˅\x8b\x02\xd9\xfe\xaf\x9c\xeeC\xa6Y>\xb79\x1d\xf5\x1e\x02w\xea\xdbE4ʰSI\xa7\x81\xe1T?Z5+\x8e\"p\xc9W\x0e3\xbfp\xb3\xb5\x91\x9e\x1a\t\xf9\x1f\x8fn\x1d+\x0e\xa7TD\xf6\xfext\x9b+Xy\x95?<\xc7Y\x99b\x13pl\xf5\x87\n\x7fL'k\xbd\\\xd1[\x85C\xbd\tU\xbfP5wA\x17I荩&o\xa6\xf9{+\x7f\xa7\xe9\xf6\xcf\xd7\xda\x7fɩ\xa1\x90\xaeu\xb5\xa8Z\xbd\\\xab\xf9\x17\xa7\x19\xbdr\xa9\xaa\xaa\x10耛\xffD\vҽvG\xfb~\xfc\xccYߙp\x90\xaf\xa2|љ\xc7)\xfc\x91ig1)\x98\xd2o8\xf2\x989\x98\xbe\xda\x19\xd0GҼ\xe1g\xed\xc5\xf93\xa7}G$\xfe\x10\xbc\xaf\x14q\xf2\xe9\x1f\xa0\x86%I`\x92f|\xf0'kNcwG\xe7.\x9a\x85\xaee\xc6\xf0\v9D\x02{\x97\xd2\x1c\x91\x80\xa6\xe9f,\xcfM\x98U\xbcM\x16\xb9\x970P\xa0\x10x!w\xda\x1d\xc9\x18\x16\x92\xad\xcf\xea֝\x9a\xa0\u009f\xbc\xbd\xf8\x9c%\xb2\xfc[\xb3)\xae\r,\x1dX\xf8$\x8dE\x12\xba\xe4!\x02\x85lt\u009f\x14\x12\x01\f\x150\f\xed\x14\x90&T\x1f\x90_N\xe6T\xefH:\xcc!\xd4g\xdaX0\xcb\x04\x18\xd1x\x80\xe1z\x1bR\xcb\xeb\x92\xde#\xee\xfd\xac)\xf0\xcc '0\xe641)ך\x84&Ja\x04\xb2\x82x\xf5G\x96|Ƨ\x98\x12\v:\xf5\"M\x19\xc6r\xadW\x05\x1e|=\xd8x\x1e\xf2x\x89\x96\\\xc6E\x98L \x1c\x82\x86\x80,\xe5\x89LlW.\xe2\xcaRN@\x92\x95\f~L\xa2\x95 \xaa@\xff[%\x9c\xb3E4<\xcd\x05\x1aU\xa9\u008aGʚ%\x95\xe39j\xfd\x8d\x11i\xa5\xa5\xc2:?`\xd6\xd8\xce\x0ed\x93\xfdQ\x1a\xa8\xff\x00f\x89\xda>\xfe?6g\xc2\x1b3̣\xbb\xfd\xbb\xdb\xf3\xfa~\xfd\xafA\xcd#\xbd\xb3\xed\xb3\xed\xed\xc0J&\xfc=\x97v\xeb\xfa\xab\x1e6\xd2s\x13ƉiA}\x05h\xfe*\x88F\xf1UC\xab!`\x8fD<\x8c\xc3գ\xdbd\xf5\x87\x97x\x11\xbfr\x7f\xe4.\x91Y\x8b\x1b\x18\x1b]\xa0\x8d~\x92\xf5\xfa\x1b\xcf\xf2\xcd\xe7-\u05fc\x849\xce\xdf\xc8;\x8f\xb9\xb8\xb5\xbf#<\xe0|\xb2$\xe8\xfa(}\xcfQ\x8e\xa6\x01\xe8?܍H\xcf\x1e{\xa4\xc6n\x97\x15'\x0f\x15d\xb5lj\xbf\xfe\xf7M\r\xb2/\xab\xf1\xca\x05\xa4k=\xacT\xfadUO\x8bS~zP\xee\x86\x02\x86\x1cN\x83p\x94\xf0\xc8K\n\xc82ZC\x96q\x01Y\xfa6\xb2\f\xf2\xc825\xc82o\r\x832\x02;\xfb\xe7\"\x97\xfds\xb8b\x81I\xc93gC;\xfd\xe5\x10À\x83\xb1L\xe8ϑP\x9e\xb2\xb4Ⱦ@\xc42Gg\x1eC\x8e/u\x1d\xf9\x1b\xfa\xaeL4\x8b\x87Yӄ?\xd1\xe4#͉\x15\aT\x12X7\x9b*\xc3B'\x91\x1f\xbe\x88\x87k\xdf\x02\xa7\xf8\xb0π\xf3\x18\x90\xeeD\x1a \x7f\n\x89\x1b\x81\x06\xca<\x14\f>I\xefW\xee^\xd0p\r\"\xa25\xcb\xd0\x15\xf1.\xa4]n.\xb9(\xdcB\x0e\x15\x03z\xccb\x93\xd7\xf2*\xcbk\xb9X.\x9d0H\xd1\x0ew\xf1\xf0(\xb1\xc7=G˶\xe0\x9aSQ:*AZ\x8f\xe7<r\xbc\xb2\xb7\x9b\x12\a\xe5\x92u\xf82+\xf4\x15\xdd:&V4\xf0\xcb\\\x7f\xf9\xf6+Q\\\x87\xedp@>\x0f\xf8\xfcJ\xe1\xf3+\xbcfqq\x7f\xdc#h\xfb\xae\x8c\x95\xeb\x0eQj\vlM\xa8\a3\xfc\xe3U\x99}\vW⍹\xc9\x1ew,oF\xec\"\xfe\x11n\x12\nr\x05\xb8\x1b\xe9:\xf9\xe9\xa43P\x8a\x943'SC\x8b_B\x00\xa7\xbb\xbe%%D\xf6:!\x12D\xe3\xf8\xfc\\\xc3\xdf\b\xe0oE\xefj\x96\x96\x18\xd0 \xae4\x96\xdf\x13bd\x97h\xbb\xb4\x96\x8f\x84*sW\xe7\xfc\"\xf4\xa3/Ί\xde,\x97\x13\x13ok\xb3\x04U&\x05\xe1\x80$\xc0\v\xcc;\xa6\xb9\xd8-P\\\xb72\x1e\x8a@\x84\b\x84\x1a7d\u05ed\xe39\x88kFV\x11\xdd\x04a\x1bS\x85X\xbd\xfbI\x12_9\xb4<y\x88\xcaf2\rF#\x04{\x94{\xd01XU\xa0\x93\xa4Bfw\xe7\x189\x86\f\"\xb0pu쫾\x98;^\xbeD\xa2\xbb,\xe7\xc8\x03\xbf(\xcf[5F\xcb\xc09\x8f\xf8\b\x00=1\xc1`~Z\xf7\xbcTw\aП2\x9f&\xfaX\xc2E\xe28&\x03\xdf/\x9bc\xa2\xac\x91\x83HUr+G\xae\xc9\xc9\x18\xe521ƅԋ~>\xd3bPȬ\x98Z\x89\x14C\xc8\b\xb7\xc8\xdfN9Y\xd2<\x97Qq\\\x90\x83Ns&\xf6\xa3\x82A\xe8,\x97oqR\xb8B/s\xecЍ\xf5\xd00\xd0Ho\xad\x8c\xa2\x17\x06\x95\\ITr\xac\x89\xeck\xa0\xbc\xcfq\xa9Os\x89A\xbf\x14R'>\xdf$\xe0\xfd\xbc\x96L\xf3\xa4$\x1b\xe7\x9b\x15\xbb\xd1b\xd4[\xebV\xf3>P\xfb\xee\xf2\xdeI\xcb\\\x9ez\xefW\xec\\]د\xd9;\xb8\xa1\xde\x01{D\xa7\x92IZ\xbb\xab.\xcb\xee*G\x1as\xbe`7VFS\xb8I_\xb1\x17\x92\xfd1\xb2(\x87З\xf2\xbe\x9c̉\xfb\x82\x02\x0f\x94\xf83.x\x92\xc2\x17\x7f\xb1i\xb6\xba\xa78H\xf7\x98^\x13\xfa\xb1\x90f\xfb\x94~\x19\xd0?eS\xd1/\xc4}a\xd8|6\xcc\xf7\xf7\b\n\xac^\b\xfd\x04%j\xf5\x1cB\x7f\x80ǡT\xd8\xd0\x1f\xe1\xc1\xa2R\xfeÆE*\xe5{(R\v\xe8\x10\xfa\x1b<\x1a\x0eC.\v\x94\xff\x9a+?F\t\xf5O\xf9\xba:\xa6\x13\xfd\x85\r5\xa1#\xa5\xf3\xc7p\x06S\x84K\xceWl\xee\xa2C\u05ebj\x15#\x88\x03ג\xf9\x91m\xbd\xc2EV\xce=\"/w'\xa4ZU\x154>\x80pt\xc0\x8a\xad\xcb\xd8)\x97\x0ee\x82\xb3\xfe1\xbd\x1e\xa8%M8\x9b62\x8bUe\xfc\x8a5\x1eNe\\\x98[\xdfhҲ\xb3\xe3xW\xbd?\x8a\xaf\x1f\xdd^\xaf4\x15\xf2\x87W\xfa\xfa\x0f \x13\fQ\xf01\xa3\bJn\x83\x9f\xacL\xb2\x88\x12\xbd\x1b\xeb\xc0\xe6\xf1U\x01\xdf܍D2TX\xa2Q\xff\xd1B\xd0W\x0f\x939\xc8\xe9)\x13\xa8\x17\xd5\xea\v\xdc\xef\xe5\x12\xae]\xc6؋r\xce\xdf\tfp\xd9\xe9\xfb\xacӞ_\x9b\x8bN>\xa4\xc9\xd0C\x0f\xec}BK:\fc\x7f\x14D\x93\xba\x1f\x053yծ\b\xbd(3\xab\xdd)\x1f1^\xe4\xe7Q\x9c\xcc|\x8c\xeeV\xf9\xe4'\x11\xf0\x05\x95\x17\xd9F\x932\xeb\xdeͫ`!\xb1\xbb\xb2\x03>\xe4\xfb\x92\xef\xfec\xe8\xb4\x0fh+\xf0\xba\xf7\xf0\x965B\xad\x035v\xd7\xd8\x1e\xba\\/\x83h$s\xb6\x8c\xb4P\x9c~\xe3p\x9c\x15}װ\xa6^\xad>@\vU\xda\xd2\xf9\xf9CW0\xd7aY,\xe5_\xe8m\x81\xa2\xa4\xf7\xf7\x0eT\x92C\xf3\x94\xebk\xb2\xa2\xaf\r\x1dj\x0e\xc8\xda\xde=\xa2\xb7\x19\xda\xf7^Z\xe7T9\x82\xe6.\x85,\xcb\xf4\vc\xa9\x83\xe1\xb1\xf8\xd7R/\xeb7u\xf1\x92/\xa3i\xee&c\x80\x9ex-\x89\tĻy\x12\xe8n2F-\xd4\xc9\xda\x02\xfdFo\v\x83X'[\xf2ך\xa7\xee\xf3\xd4\xdcr\xf0\x85~9E\xady\xea\x9a e\xc7\xd4\xd4\x1c`\x8a\xc5ӻ\xa9\t\x9a\xaeU\x994\xd6\xcad-\xf8\xf53\x10\xec\x17*\U00088bba\xf6\x82N\x8a\xc5\xd8qi=㴩?\b\x17Y\xed\xbcG\xa7YW\xb4\xde{_\xad\xbe\x7f\xa8\x01\x9f\x86uMC\xac\x1f\xab\xef魡\xd0\xd66%\x0f)\xda\xf9\x14\xab{\x7f\xe9\x80\xc1t\xebd\xb9\xdcz\xbe\\&\\\t\xb1\x8eXs\xc3ͱ6\xc0쮯\xcb\f2\x15\xf9\xc7Bsλ\x90\xfb)\xaf\f\xe3$\xe1CQH\x1b\x955\xa0\xbe\xac\x00\x13'\x92\x9b\x8a?\xf1\x83\xa8Qb\x97\xb8\b-)n\xa2U-\xc9\x1d\x113\xc3\xc0\xca\xef#\xf5\xe5\x15e$|\xef\x14O\x90\x92z\x8e\x19d\xeb\x93$F\xa6K\x91\x84\xd6,ao\xd76\xf4\x93\x9d\x92\xfe\xc5=\a\xb7p\x18\vGu\xed\xbcY\x8c\x8f\xb1:|cA\x9a(\x1b\xd0\x0f\xf46?\x88B\xb3+\xadq|\xf3\x00\xe0\xd4d\x80\x9dX\xef\xc1_9\x06\xfc^\xadu\xf535F.\xa9\xf7\xcaf\x15\x15b|\x9f\xf1\x91\xf7\xf1{\xc5\x15ވ\xfc\n\xbb3O\xe2\xd1b\xc8\x11U\xa9\x9f*t3X\xaeH\x8a\xd6T\x92\x87\x7f\xaa\x03W\xbdS\xc5V͵\x1b\xc5\xe6;\x8a{\xbb\x91\xb3\x83\xdb@o1\xe7\xd5\xea\x9f\xe5x\xe4W\b\xffd\xb8\x82?\vk\x94)@\x95\x00\xe0\x97u\x01@\x06\xb5\xd8W>\x87\xbf\xd9\x0fx0\x13\xc3xL\xa0\xad\".\xa1*\x06\xad\x14\x17\xa0\xff\xa5`\x89\xbb\x7fx\xd0\xe9\x10*\x04j;\xb8В\x84D<L\x92\xa0ip\x9e\xa3\xc1K\xd3af\x91f\x8a\x9e\x9d\x05\xca\xde\xcffP4}RsH\xf3Y\x83C\xbdO\v`Շ\x18[V\xb1g\xde\\n\xe58ǹOi\x9c\x04\x93 \xf2÷V\xe9H\xef\xb2]8[1\xdfp\xe5\xba\xffɊ\r\xe9%\xd3\xce\xd7\xca\xcd\xce\xd1\\\xec\r\xe6\x82\xd3\xe33\xada\xb2dz\x01/\x8b\\\xe4k\xada\xbc*{\xfbN\xea#\x8fY\xb2\xee\x86z\r\x85\xcf\xe3\xf9͇\xf8y\x18\xcc/b?\x19AG\xf8\xf2\x9c]V\xab[[\x97\x8d\xa1\x8c\x1aHO\xd9y\xb5\n\x1a\xc9K\x95k\xf3RG\xaak\x04\xe9\xf1l.@\xb1\xf6\x94m\x9d/\x97\xa7\x0ff\x0e\xff0W\xa2\x1cn\xa5\xf0\\\x7ft\xbb(5m.\b\x1dU&\xe2?\xa0z\xe5\xd1\xed\xb8\x91\xf0y\xe8\x0f\xb9\vڱ\tu\xfe\x8f\xff\xdb\xffs\x1b\f\x1chQF\xc9\xcbhQݹ\xb2\xf8\xb4\x8c=\xd7GrAo5\x04\x95p\x81W\x05J+\xb9\x13`\t\x9d\x7f\r\x15\xa1F\x99'ԅp\x89;Y.S\x8d\x90g\x10\x92f\xb9\x9c\x92\xdeW\xf0\x02\xba\xedL\x11\x8e.0\xd8Φ\x80\xdd\xff\xf32W\xfe\xd52W\xfep\x99+}ZN5\xdd\xd0\xdb\x02\x06\xb1\x05\xd3&RMl\xe7A\x7f\x19\xa3ar\x90`\x13\xa9{I\xba\xd1Z\xe40\x0e\xf1(\xd6\xe6{MoA\x1b\xf1!\x86\x83\n\x00.\xbd\xfa[h\xad\xb3V\xfbX9\xf0\x06+s\x1f$b\xf3}\x80\x05\x0f\xc6\xfc\x12\xbdG\x0fD\xef\xeaT<,=\xf9\xfd\x90(\x9bsV\x947D\xfc\x11ȴ\xe7~\xca]3\xcd\xe8A\xd3T\xf7W\f\xf7W\xe7\xf0p\xa7I\xa8/\xef\xaf\xd8\xdc_\xc1\x03'\x98;ؼx\xb0\v)\xb8,qqb\xa4O\x91\xbc`\xa4)\x86\x9f\xbbf\x02\x1a\xa4/2\x15\xabf)1\x0e\xb1\xbcRԅ\x11\xb2XEl\xd8v{\xecl\x9blOH\x16\x96\x88\xb5\xba\xfc(T\x8c@\x97\xd7X\x9b\xf8\x02\xe3W\xe9\x80(\x9c6\xd7\xcf\xd8\xd5E\xa2Hl\x9e\xc5\xd0]0n+i\x1f\xbe\x97Iom7a\xde\xe7\xe7٢8^i\x15\xed^\x85\xbf\xbd\xb8ԽG+\xf4\xd2\xe2\xb2\x1a\x85\xde\x1f\x8fn\xfd\xd5\xf6\xa3\xdb`\xf5\x87R\xedatQe\xa2\x9f\n\x96\x85`\x81}\xb5\x88\xad\xa2\x9dՊq\x1a\xe7L\xa0\x8e\xaf\x05\x86\x84xxR\x053ϯH;S\xf8\xe6\x0e\xc3\xce\xccB5\x93$;\x0f\xf2\x86A\xe3ӻ\xa9\x7feѺ\xc1.\xf3>\x1b\xd5\ro6ڏJ\xd9\x18\x0fG\x0e\xf9\xda\xcfT\x0e\x9e\xfblK\xa5\xabQ\xd2\xe0\x91Hn\n\xaeF\xca5\xa2/h2\xd8\xec\x13\x11\xdb\xc9a\xd1̆^\xcbT\x1d\xd7?\xfb\xa1\x8aW\x0f\xff\xadh(\x8c\xbbE\xbe\n\xcb,_\x92^\x82\xa7[\xfdq\x89\x97Ȁ\x1e\x9b\xa0\xeb\xeeu\xc57\x82\xdc\xf1r\x8e)\xf5\xc1\xd5\x02c\x83\x03Z<\xd8#t(q\xe2B\x10:\x87\xb2Ý\xd6\xc1\x0e\xa1cY<\x17\x84N\x11\x83vv\xda;\x84\x8e\xe0w{\xbf\xb3\xd7\"t&\xab\x8c\x04\xa1\x13,\xee\xec\x82Iԥ,\x9e\bBo\xf0\xcb݃v\x87\xd0\vY|#\b\xbdB\x96b\xe7`wW\xe3\x9b\xe3l\xc1TR\f:\x0eB.Q\x8aE\xb6E\x14\xeel`D\x91r\x88m\xaeѧC?\u009b3\xa0\xa1\x1fM\x16\xfe\x84{a\xb6\xe4\vv\t\xe8\xd0'=\xdfU\x84͐m\xb5\xb6\x18\x9bA\xf9\x82:\xe9M$\xfc\xeb\x1ftn.\x87T\xab\x1b^5|\x90\x11!6C\nz\x0eJ\xaa\xb7\x8dE\xca\xdf\xf31q\xa1q\xd2\xd5ER\xa4D\\\xe9\xff$\x932ik:\x19o\x04C<\xcd5\x13\xda@\x8b\x9f7\xf1\x88\xa7\xf9\xe0#[[\xbc\x11\xc5#\x0eƸ\x90\x1e\t\xd7\x05c\x12\xaa\xa3\x9c\xba\xb6e\xbb\x95h\x7f(\xd6\xf2s\xf3\x86?\x1a\x1d_\xf2H@\v<\xe2\x89\xeb\xcc\xe2Eʯ\xa6\x9c\x87\x0e\xc5D\xa9i\x1a\\ʤE\xe0\x1e\x8c4OYK\t\x9fŗ\xfc\xaeƐg%\xb4/hDÁ\xde\xf61˲\x15(\xb9\xb1\xa0#\x1e\n\xffW<, !K\xe20\xfcA\x12\x93\x11\x8d\xc7\xe3\x94\v\xf5\x18S\xf9\xf6C<\xc7PN\xdd\xe8I\f\xb69\f\x83`'G\xcd\xe52\xae\xf9O\x18$\x84y\xd2$\xd5\xeaz̪\xd5\xc3q\xb9I\xd9V\x97\x1e\xca@b\xceK\xb3\xe4\xac}\xaaaV[\x97\xa6y\x03\x87\xdb\v\\S\xf0b\x03kyUY\aJ\t\x1e\xa2J\x19\xc6\U000dbe88\xebC\xcdە\xa0\xf4+\xd1(0\x80\x92\xdc\xf4\xc4\x1d<\x97\x927\xd0\xe1\xda4\xa7\xa21\xea\xd0\xdb\xec\xa4Y\xc7t\f\x13\x8ah\x0e\x18i*nB\xcc\xd02\x15\x8d\xe7m\xe2n:Zb\xcag\x1c\x98\x86\x89/0=\x1a\x15Ļץ\xa3\xacK\xe9\xfc\xd6=\x16\r\x9bZd\xb7\x06\xb5\x18YNC\x80\a\xac\x82\xcak\xc1\x8e\x85\"\x10\xcf\xc5?\xe2\x00\x89\x9a\x06-\\\xfa\xa4D\x8fxx\xecHm\x99\x18\xab1\xcc}!\xa3\v\xf6\v>lt\xcdsm\x80\xbe\xb6\xf9\xbe\xb5\xc4\xedyfȿ\x16\xf4N1\xbf)\xc4V\x9c\x8b\x1f\xf0n5\xf9\x89\xb2[\xeb\xd6\x16\xc3\x19\x82Vv]H\x8c*\xaa\xd5H\x06\x9d_\xeb\xdd59j\x8b\r\xe4\r\x92%\xa2\x84\xe1e\"E\xb1.H\xbc3\acA\x90\xe8\x83\x1c.(\b\xff\xd2MR;\x8bQZȱ\x0e\xf5X\xe7\x05\x19\xe48'\xa1\x9c\xe6\xf8\x81\x914`\b~\"\x18.\x18A\f\xa3\x82Y\xae\x15\x8e\x8a\n\x06NB\xef3?\xa3K(\xcb\xfc\x8eTP0\x1b\f\xd4L\xaaՒB)b,y\xe1\x9d\xe7O\x84ya\x82\x89\xf9\x99\x95:\x0e\xfe\xa7\xefa\xf0\x12!]\xb1B\xd0\xe8\x8a\xf8ִ.ι\xd35v\xd2F\x8c\xd3\xff\xfd\xec\xaa>؞PAV@\xd0\xcfW\x8fn\x87\xabs\x03\x05\x7f\x80\x8c\vl\xa0W\xe7R\xb5\xf4\xc7ñx\xe60\xf5-\x9a毡\xc9\xdf[bbk9˵3\xc58\x00ǫ;s\xf5\x9b\xc6+\n\x86*B\x02Q\x89E\xe0\xa5\x02r?\t\xfc\xe7\xea\x9c{W\xf8\x88\x89\xb5\xbc\r\x8d\xe5\r\xfa\xa4\xd2$\xe7Zi\xbb\x06\x81\x9d\x85B\"'#\xefxC\x9a\xd6\x02\x02|\xa0\xeb~\xb6eA$\x99\x95\xa4\xf7\x15^\x85\x93L%\xf1\x95\xb8\x02\x8f|\x11\xe7*\x04\xb0\x86z7\"\x91\x15y \x94l\x12\xb6i\xdfB%\xfd\f.1\xa2O\x1c\x06\"\xbfIE\xdfB0\x9b\xb9\xa2I\x1c\xe2\xbb\xc9\x06\xfe\xf3\xff\x1f\xdd\r\xb3\xc7\xdc\xd1{H\a\x95M\x9d\x80X\x11G\xfb\n\x7fhM\xcc\xfdL'gb\x9d\xe9\xe46\xd3\x19\x0e\x18\xa73\x96T\xabIޮ\x8b1\xd1\xcbb\xbc+\xee\xc0\x01S\xbc\r\xf8\xeeRg\x16,\xdeV\xd9E&\x95X\x82\xa0\xcc\t\xaf\x00o\x04^;\xf2f\xcc\x16cF\x81\xccEsOuxµ\x03RJ6\x80\xf9\xd2\x05\x95\xded9zCろ\x04I\xce\xd70͟M䷸NX\x01<\xf5\xb8\x91/{\xcdg\x17<q\x87tN-\x15\x1d\xb0Ĺ\xab\xb9(\xe5)\xfaD\x19!\xe3\xf9\xba\x90q\x8d\xf6\x90|\xb5\xbeUQ\x9a*\xadn\x89۷\x03an\x7fN\xa5\xdb\xc1\x06$\x01\f\x16r\xe5\xa7\xc0\x19\xef\xec\xb7\xf7;\x84>\x95\f\xf3\xa9 \xf4\x8b\x90i5\x0e\xb4\xdc\xf2\xb9\xf8;i0\xce\xe3h\x135\xa8\xb3\xac\x95\xec^\xf9v\xe7\xc3u\x14b\t'\xeb[\x8d\x06tBӀ|S\xa3d=G\xee\ad\x10-\x10ȧ\xfa\xb3\x82+\xd8p$J@'7f\x1a\xd9\x11~JF\xbc\\\n\xed\xe3\x87f\xaf\xaau\x87F\x03\xba\x9e\xb7\xcf5\x99K\x15\xf3\xb4\x96\xec\xc9N\xbd\xbb*D\xd9-[0\xc7\xd9\x10\x11\x17\x0fy\xa2\x0fy$\x0fl\x9c\x1dX\xdf:\xceA\x86\x02R8\xeaa\xfe\x1c,\xec\xd36,\x1c\xf4ynA\xc7\xe5[\x96\xd7я\xf2\x12\xf1 \x1a\xf3\xe4T\x06\xf0\x9d\xadXH'ln\xf0\xb2\xe2-\n\x16\xb1\x97+6t5\xc5{\xd936\xc0\xbe\xba&/\xb4Z5\v\x8cr\xa5\x8b$\xae6\xb2%\xb6p\xcd=kz\xc0\x90\x0f\v\xfb\xf3s|\xcc'f\xa7\xa7P8\x8bG<T\x00\x84Qh\x17Es\xe1\xe7Pdd\xfa\xca\xc8\xf73\x14\xe6\x89\xfc7PT\xcc\x05E?X\xa5\x8eD\x04\xef\xe8{c\xb2}\x1fx\x8e\xe9\v毃\xe7\xeb\"xf\xa6\xda\x05\x10\x05\xb3\xe3I\x96xᅎ\x97\v\xfb\xe5\x90\xee;\xc6{3\x97+1\xa5Z\xff\xf7\x8c\xf7,\xf5\x91\xd5\xef\xddå\xba\xdd\x01\xf1R\x19\x12\xfe\x9dѐ\xab\x1e\xe9{\xe6\xcb4ﺤ\x97\x9a\x8c\x19\xb2\xc0CG\xb5\xcaK\xfa\x17\xfdȶZ\xf4Ov\x1bD\xc3p1\xe2\xef\xb9?z\x1b\x857\x10\xdbLM\f\x16\xf4g\xf8\xfd\x17S\xf6\xb5\xee\xcf\xc5Y\x92\xe5\xd2\xe46\xf9\xb9'\x7fz?\xab9\xd3W\xd9\xea\xe0\xf4\xca\xd0\x11&\x86^\xcb\"\xd7}Ʉ+m\xb3ya?tV\x0e\xc6\xd8\xcbjՅ\x8aOA\x8a\xf1J\x11\t\xafH#\xe2נtǶ\b\x18\xc8o5\xe5\x9a\x19\xe6*\xbf\xa3 \xb2t_\xae\x15\xe2\x872\x00\xff\xed_\xec\x1d\xacV\xa3\xd1\xf8\x93\xaa%\xfb\x94\x04\x82\x9b5\xd3\x13\xcd@*\x03\x97\xd7\x03\xd2\xe5\xd8\x05Wm\xc2.<bh\xbfE\x13\"S\xad\xc3\"o1\xc5\x1cF̒j\xbbM\xfaE4\xde\x12\x97\xc3@#\xe6\xe0Ÿ\x89\v(%JEY\x9ah'\x1f\x89\xc0\b\xa5\xa2b\xea}\xaed\f\x88\x81\xa5\x96PfѸ\x0e\x88\xfb\x17}M\xff\xa4\x1f{/UB4B\xcf\xe9\x90\xdc)\x90/\xa3\x8e+N\xcd\r |\xbb\xf6Y\x93\xb1\xb6\xe3o\x0eZ\x11\xff\xbd8\x12_\x11\xef\xa2\xf0\xf1\xf9\xb9f\xb9J\xa2\xc2(\x9b`\xbf4V3\xa1\x97\xd5ꍔ@H*\xf8\xe6\x1e*\xf8.\xd5\xcb\xf1CT/*\x9cE\xb5\xaaF\xa4\x11R\x991\x84\xd2\xc7mZ\x04y\xbf=d\xe9P\xec\xb8\xf6i}\xc6G\x81\xaf\xd8\xe4ۻ^\xd7\xeb>ޢ\xfaU\xc8\x13Ǜ\xae\xc8fϿ\xbb\x86l5|~\x8e\xb6\xe7\xc0\xb1\xbc\x86\xc2M\x12\x82\xcfZBp\x0f\xda\xce1\xfbk+\\,0D\x8f$\x8aO\xf9\x9f`a\xb0\x96\xba}\x9d\x05\xb0\xc4\x12r\xd8\xf0\x0e\x9c\x14\xa6\xbd\xbf\xbd j\xa5u\f\x14\xe4<\xe5N\xdf\x13\xc5ڑ\x94\x0e\x9cg\x15\x87\xad\xa1\x19\xc0\x12\x1b\xc8;\x8f\x97\x1c\x95A\xa8߸\xcd\xfa{k\x93\xf5ET\xb2\xc5o\xac\x9c\x92\xafJrJ\xdeu\xa3\x99t\x8a\x9c=\x195R.\x9e\xe6\xa8i\xc5tɤ\x1b<g\xc1\x9e\x00}\x8c\x00q\xad\xe8X\x8b+S\xe58\xbdxEri\xde[\xfa,\xcb\x7f\xe9\xa3\xe5\xf2]i$\x1cMо\xff*ZV呐\x16\x03\xdf\x13\xf7\x1dљ)\xbdG\xb4\x84\x86\xc8\xf0\xca\xfaV\x7f0+\xeb\xc9+\xfe\xae\xa5,\x10cw\x8d9\x9e\x05*\xe3i6\x80\x8b^\x89\xb9\x90\x0e\xa3sQh\xceX\x03Oz_wsh1\xc7UO*̯ \xa3\x14r/߮8\x7f\xae\xa5\x12\b%\x82B\x1fEQ\xdcB\xa2\xf0\xcd\x01\x96\xde\xc4\x15E֛X\x1c\xc8\x1e>_g\xd3\r\x03\x94c\xc8\xc1\x0f\xb6\x8c\xaf\x95yCW\x9a[x{\x87\xea\xfe\xab\xa5\xccҝ[\xf2\x1b\x18\xec\x10b\xbd)\x1f\xbeDk\xe2?\xa3\x92\xbc\xd5i\x11z\"Y\xfeς\xd07\xa8#\xeftv\b\xfd K\xdf\x18\xab\xa5w\x9b\xad\x96\xbeJ1\x85\xd8\xdeA\x0f\x9a\x94\x8f\xd4\xd2(\xdb)\xbb\x9eL\x03\xfaή\x95O4\xaf\xbe\xcc\xe7\xcb\f\xc6.&\x92%\xc1\xd8\x05\x8f\xc6J\x10\xa5\u008f\x86\x90\x7f\xe6Y\x18_H\xba<\xc1$\xa6/\x83\x10\x0f\x1dO\xbaI#F\xfd'Í)\b\x14\xf2#M\xe0\xa6Z\x84\x10؆\xc2o\x7f\xf44\xfd\x00\x14\xb3\xd0Y\x93\xef\xfaZX\xc9Ҥ\xea\xc9$\xbe~\x11\x8c^\xdb\t\xb4K\x16@\xea\xf8s\x9fdQ)7~\xc4\x1bj\xad\n\x81w\xf4\n\x16e\x17\x10o+1\xf1\xb6\"H\xb2\x99\x13\x8b\xe5\xceP\xb0\x1e\xaf\xa70e\x1d\xfd\t7\x9e\x86,Xgx\x17,\x83^\xa7\x96\xe5\x1aD\xac\x16̸\xe2ɇt\f\x1b\x9c\xb0\x04\xf3\x14m\xffnI\xbbζ\xe3\xa1\xe0\xa2.s+o\a\r\x81QZ\xc9r\x19e\xa1\x18_\x04\xe9<N\x03\x19\x8f\xa3Z\xdd\xf6\x85\xf0\x87S\x80Q\xfd\xc1\xa6ʲ\x1d\xad\x8a\x18=\xa4\x9d\xd2ʅ\xf1\xd8\xf1A\xaa\xd5m\x80\xc8ʇď\xd21Oʆd\xd7/\f\xe9\x81M\x95\xd6'p\\\x1c8\x1fN\x10Ud\xa4\x1b\xc9HŐ\x8e\x1c\xc3`n\x83\x9aȡ\x01\xe3\xc53\xd5\xe3\x1e\xec\x18\xfct\xfb|@o1>5ް\f\xa2\xe7(\xcc\xf5\xf6\xe23$\x11z\xff\xca\r \xd3U?\xa6I#]\\\xa4\"q\xfd\\Vtg\xdb!\xb5\x16\xa1\xa9\n{\xe9x\b\"\x9b\x96t\xf3\x0eg\xb9C\xb7 n\x89\xf2j\xc6K\xf8\xc5{\xe2.HW2\x8d\x90\x81\xda\r\x19'\xab1{\xd5\xf8\xad\x11\xf9\x97\xc1\xc4\x17qR\xad\xe6\x1e\x1b\xb3\xf4Կ\xe4o\x93\xb7s\x1e\xe1̿&Ф\xaf\xad\x8aӜ!\xc6\xdd=\xb8\x01\r\x89e\xa0Q\x013\x02\x87\x10\xef\x1b{\xd6V!^XҨ\xc4_c\xf6\x95\xa1,\xb3v\x14\xce\x00?St\xab\xab\\,D\xe5&^$\x95\x8b$\xbeJyR\x19\xc5\\\xe6TM\x17\xf3y\x9c\x88\x8a\x1e\x108w]\x04\x11\x98\xe8_\x06~\xe5\x97\x1f\xdeW\\D\xd8\r\x87tu\x12?\x14igg[+\x82\x91\xd7\xcfs\xf9Bs\xf9\x188i\b~\xee\ue3e7o\xdf4\x107\xb9\x9c\xe8 \\\x15GEMr\x05\xb9\x1d2g\xe8G\x8fE\x05kU\xf0\x83J\xe5\xbd\x7fU\x918\xdf;\x8b\xce\"\xa7\xc6W\xebk\x14ZV)\"o0\xb6\xd5\xcc\xec\xcaЁ\xa0\x01C\xfbCI\t\x86y\xe4\xaa\xcd\xc9L\x16\xfe\xed\xebY\x98M\xb9\xe7\x0e\xd9\t\xb0\x7f\\\xdaѠ\xc1\xd6\xdb\xe8ԟ\xf1WA\x84}\x05шG\"N<\x98\x1cd[.\x1b\xeb=\x03\xbc\x9e\x85\x0f\x18\x1f\xf1\xc6\xcc\xc2\r\x8c\xb1\x0f\xd2Pk\xb9܆\xe2\xb3\xedy\xe8\aѶ\x19\xfc\xd7\x0fd*\xb2\x91\xf0\xcd#\x91\xe1䷇\xe9e\xd9(\x86\xe9\xe5\xdf\x19\xc30\xbd|\xc8\x10\xb6\x7f\x0ff\xfe\x84C\xb44\xd3\xd9{\x9e3\\C\x1f\x06r\x17\xd6\xc8\xdc\r7\xf9ჳ})V\x05)\x93\xb7\xfd\xbb\xbf\x18\x05qn\x10_y\x9cתc\x8b\x0e5\x06;\xb0D_P\xa3\xb1^We\x1a\x93\xc3Ld\xa6\x02\f\x97ﭥ\x03\xe4߰\x15\xe2Z<d+\xb8\nd\xd1K\xbf\nC\x17\x12\n\x04\x80\xdb>F\t\x1fƓ\b\\1\x8cr\x16\xe5\x1a݊R\xac\x01\xea\xd2V\f~Z\x01\x98k\x94p\xc4_5\xbb\xf4\xaeS\xf7\xb7\a\xbe@\x93\xf6\x8a\x88\xf5\x14\xb4hAs\x1b\xe3\xdeߌ\x1c/\xf3\xeaбlV\x860|\x8f<\xc7\xde\xde.\xa1\xaf%w\xf1\xdep\x17/\x1e\xa0[4\x9a\xc5RC7\x87Z\xf2\xe0b\xbc\xff[e\xda\xe6'\xfe\xec\xd9\xcd\tfT\x127^\xb4\xcay\xe4\xe7\xb2\xddw#7\xa6\xb2\xc1\xb5,\xae\xf2\x93\xe7q\x94.f\x05ӺM]\xeb\xca*\xf9K\xbe\xe3\x82>\xd3M(/v*]\x18?\xf8\x17\xb2\x1b;\xc4\x00\xe4\xf9\xeb\xads\x1d\xea\xf5\xcfA\x1a(p\xd3\t\x88MQ\v0\xa7.-oh\xed\x9b&-i\x1b\x1aR\xe2\xebҥB\xb1\x9aL\x04\xe5\x16m\xae-\xf9\x8d2\xbf\xb5Vo=\xf2\xac\xad\xf1\xf3K\x82\x85\x06,\x065\xceǔ'ǣ@\xf0\x11\xc4Z\av4\x01z4nȨ\xfe\xef\xb9\xf0\x83H\xa9ġ\x06\ue2ec\xd6\xf5\xa5\x11!\xbe+\xb1!,\f\x19\xcd=\xa2@\xe4\x1b\vFpB\xc0Eݽ-T\x0f\x96K7].\xed^\xb2\x11\xa8>\xe4b\x16;\x8a\n\x89)䬢|f\x8a\\\xa1\x1e\tB~\x8a\xfc\x7fA\xfb\xbb\x0e\x14e\x1b\xdc\\\x95\xd9H\xe6\xe3W\x88|\xfc\x8a\xc4jǋ\n\xa1*\xe2\xb5`\x16\xb6\xa3\"(\x8b\vavC\x1b!.\n\x02\xb6|\x90\x8b\xb9\xbdl\x05#\xc9\x028\x8d\xac\xe8\x1c\xb3\x12`\x9a\xb0Њ\xe6!s\xb3^B\x99\x9e\x88I\xccz\x03\xa5y\xbd\xeb\x15\x14=7\xa7\vu\xb6\xc7Pfm\xbav\x94\xf5\xab\u0558\x9e\xb2\xa1QP\xd3/l\xa6C\\e\xb5A\xbf{\x8e\x9a\x8d\xd7@㜣w\x85\xa4.\xa2BD\xe7J\xc2TĦ\xc0\xca\xed\xc9\xfbɠ\xd7s\xe1\x0f\xeb\x0f\b\x85\x1f\xc6:\x87\xaf\bE\x1d\xa1є\xa8\x16!H57\xa5\x82\x10\xda\x1f\xfc\x9f\xe5\x91t\xda{\x90\xab\xd1\x1d\xc6b\xb2\xba̓\xe1\xbe\x1b\f[\x88\xdfb9\x13C\xbb\x81\xe0\xb3J.=\xd2\xdaq\xa9V\x1di\xffᔺ\x16\xdf\x19\xb7ǹ\xc7\b\xf3\x9d54B\xa8\x82\x91\f\x87\x93\xde7\xcc\xd7\xfa\xfc\xfe\xe9\x16\x10\xc6\x7f\xe7d\xb3\xc3C\x8cx\xfe\xef\xee\xfe}\x03ʯp\x89\xabťəl\x9dֵ\x1b\xc7\x1b\x95\xdfBcB\xb9\xc1x\xf9\x00?v\x1c@\xfb\x05\xbd\x13\xcd\xc2~&\xee\x98\x18\xe9\xfb]\x90\xf9\x80\xb3\x93}\x93\xf3\xd5\xfb\xac\x8c\xac{\xff\xa4\xa3_y\xbf\xdfd1\xba\xe1\xcdt\xa35gn\x9e\xe1\xb9\xce\xe5\n\xf5\x1c\xf2wZ\xba\xcb2\xf4!\xb6\x9b\x9f\x15\xa2\xfd\xbc1@̈́\xde\xe2\x05\x99]\x98\n\x85[\xc2er\xe7\xf5\x99\xf8W\b\xe6\xa8>\x83\xbf\xc3\fV 5\xf6k.\xfc\x8cNvǔ\x13\xaa\x94\xe1<\xbbTV\x8db\xea\x87?6\x18a\xd3\"͜\x7f]\xa0\xa4\xef\xb9\xdd\xef\xbe\xd0sw\x7f\x90\xea\xb07\xd7\xcaO\xd2\xfb\xc7C\xc4\xcd-m\xd1<\x87\x9d\xa5\x0em3\xea|\xc0Y\xd2_X\x87\xb1\xf2u\xe3\x83\xc4\x1a\xba\x11/S\a\xae_\x1cVԙ\x8b\\J\x80&\xad\xb7\x882\x85\xb2\xea\x1b\xeb\x87\xd3j\xf5K.1jɅ\xf8\xd5\x17~\xa5H\x00\x142\xb8\xfe\xd3\xfe\x13e1C\xf0J\xc8\xf6\xf4\x1c\x91D\xe5\xd1헌0\v\x12>\x02\xfb\xa7\xec\x01n\xc1Bzٍ)\x18K\x91֍6\x8e\x185\x92u\x1eD^!9È/y;\bj\aXȌ\"\xb2\x93ɵ\x8ak\x8d;\xcb,d\xedS\x04\x9a.;y]<\x02\xe7\xf9ğ\x15\\3r\xde\x1dr\xfe9\xe7\x8e\a\xba^|\x1dx\x1f\xd3[d\xa3\xcaع\x97\xa1?\x81\xd9N\x1bwV\xd94k\xbaHy\xf2\x83\x9f>\xe8R\x7f\xc0\xd9\xc9\x13\xf2֓\xf7\x85&\x85qe\x9b\x9fcNǹ\xefN\xc0p \xc5 zB\x04\xd1$\xffQ\xf1\xed\xfa\xf72\xdej\xfe+Y\xa6\xeaZ\xe83\x7f\x7f\xac\x9b7\x8f\xca-\xe3\xa1\x1d\x9a\x9b9-\xac\x83\xd4X>]k\xd0@\xa9\xa2\x882\xa4\xff5\x16!\x05O\x1aYZ\xb4\x10\xb1\x06gۈ\xe4\xde\xc0!0'H3Z\xa0d&\x86\xddڸe\x11C\xa4\xdb\b\xd2\xd7\xfe\xdcMH/\xb1\x10\xb1ᣦ\xe5\xf2\x80\xdc}&\x01\x15\x9d\x1cO\"W\xa0\x98h5\xbdK\x90\xb0~\x94\xf5,N\xa4\xd9\tFV23*\xb6e\x80\xa8t ʺ\x82#\x86\xb0tț1\xd7\xcarvxQ\x12Q%G\xe9\xbeT.\x7f2\xd1\b\xe0\x90\x02\xc5\\R\xa1(V\xd8j\x15$\x0f[͜\xe8\xad?Ȏn\x7f`,2^\xfd\x13\x16\x19\xd6\xcdq\xb7I\x06}&\xd8m\x06]h\x1cB\x83T\xed\xd0HE\x92\xf3nW:c\xe5\xcb\x7f\xc8CXf\xfb-wƐ\x83\x11\x05\xd1$oH\x9f\xf9\x86JX\xbcцA\xb6\xb3>\tN\xcbۦ*\xdf&hpA\x1fw\x12\x05\x02\xf2RJ\x00\x91H1\xc2\xe5\xafV\x85\x1b\x15l\x19\xb2~<\x0e^F:ġxPt\xa0;d\xdb\xdaI\xb2h}i\xef-\x9c\xa0s<\x11璩v\xe8\xed(\x1bA\x99]e\x10a\xaa\xb0\xdbb*\xe7\xec3\x93\x11zKT\xab\xbc\x84\xae\xb6\xf7nE c`4\xaa\xe0X*\xca\f\\\x1f\xb4\x97k\a홐\x16\xff\x7f\x81H\xbeu\xd8\xdc;Ԃ\xf8\x8f\x0ft\U00091e75-W\x1f\x99\xb3\xbaL\xf8\x9b\xc9\xc7\xd7|xi\xf4p/\xdej\xd5~\xa2\x19\x88\xc6\x19;\xe3\xafX\x92\xdfk\xc1\x1c\x94k\xff\x7f\xb9{\xf3\xed\xb6q,a\xfc\xff\xdfS\xd0\xec\x1c~\xe4\x04bDyg\x8a\xad\x93r\x92r\xaa\xb2u\xec$U\xe5\xe3c\xd3\x12d1\xa1@\x15\b\xc9v,\xbe\xcb<\xcb<\xd9\xef\\l\x04\x17-N\xa5\xfb\xfb\xcet\xcd\xc4\"v\\\x00\x17\x17w],\xb0\xe3\x80ֻ\x90\r\t\x93X\x8c27F\f\x91:\xb7\xfd\"#\xf2n\x91\xf6\x12\xe2l\xfcG\xee#\xe3\rQ\xb9\x8e\x94\x9a\x1f\x9f)\xd7\xee+\x96I&LԾD,\xc1\xe4Ï\x9a\xa3#-,\xd0,\xa2\xe6\x13\x0f\xc5\x11-_\x80\x1a\xcaR\xf1\x88\xf7\xc8\aX^\x1c\x04e(n\n4r̞\x1bg\xbb\x1e\xa9\xb9|\xd2U\xe1W\xaeu#2Q\xeb\xe0\xf1ʇ-Hw\x16\v\xe32\x96\xeeqc\xd8In\x17}c\xfe\x9f\x1e8\xe1\x91\x1c\x1f\xac9>\xc0Ǐ\xb26\xca[4\xd44\xc1By\x14\xf7\xb5ڿvܘ\xa0\xfb6\x8b\b)\x8fKFn\xa6\xceC\x14\xa9\x0e\xe5\xf9v\x1cN\x8c\xdb[:\x03\xd6D\x1c-\nUa\xb8'7\xf1\xf55\xa6=\xd7\xf3h\xa4\x0f\x96,\x7f\xdb\xd1\xe6\x1a\xfdfRX-\xce\xcd2\x04xx\xcc\"Q\xea\xdc\xeb\xaf\xcc\rc\xa9\x1c\xcf\v\xa8H\x01\xe7\xa5RG\t\xd3\xd2ޅ,\xa5&\x19\xaa\x1c\x90\xe6\xa1\xf0\x9e֧Y3'\xc1H¯2\xf2\xd6\xfc6\x00\xe8\xc5N\x96\xc0\xa0\xbd@X\a}\x03\xf0-`wcmX\xa0s\xbd~Kbk\x15\x05\xebJ\x15\x9d\x18f\xb5\x84B\xef2\xbaX\x88\a\xa4\x9fpO?.?$4\xd2\x06-T\x9b\x11mE\x11\xedW\x1e\x93\x12ۻ\x94\xaf\xbc\x9dq\xfd\x04@\xc1r\x00\xc2$\xc0qr\xc7\xd9\xcaj\x96a\x9e㴶U\x1dM\xee\xf5\xf3Ҹ&\xe7N~\x14۳\x8aT\\\xaf\xf8\xf8\xf6\xe4\xd9\xcb\x17\x17\x9aB\xf9\x9c\xa4\xe9\a<\xc0\xc9\x1c\U000fbc3c\x8a\x1a!0M\xc4X\xa2\x9d\fd\x94\xb1\x11R\x91\xc7W\\\x85d\b\xca@W\x0f\xdfX\x1c9\xc0\xc1L\xa2\xa4tj\xda\xcf\xc2\x04\xc52\xfa\x8d\xc0>\xd8\xc0>\x89\xc6>q\x01\x016p_E\xab&\xb3\x89^z\xa9{\x1a%\xfd\xa4%\x97\xabOB\xe8M\x9d-Q\x88\xca\u05eb\x99\xf6\xf3(U{\xc3\xe4p\xe8\xf8 \x8e\xe3\xe6\x11SX\xcd\xd8\b\xb9\xe3\xe4\xd0\u0092E\x14zw\x8f\xf8\x8a-[\xad\xcaI\xbe\xd7ڤ\xf1\xa4\xdd\xcd#n\x06\x9f\xedT9\x94\x97B\xff`\x89)\xab\xb8F\xf4Ғ*ϴ\xe6\xe8\xab|\fK\xf9d\x83\xa7Y7V\x9f\x99[hP\xbe3\x1a\x8e\\*t\xf08\x9a\xad1S\x1drӅ\xa3l2Ɉ\x91\f\xee\x80E8\x17ʏ+\xf1\xd0\x16\xf1\f\xb33-\xd4\xcc\\\xfb\xd7\\\x86\xc1\x89_ft\xc2Ś\x99+d\x1f\xe2a.\xad`\x8d\xcb\x1e]E\xfa\xbe\xb9k\xf7)2G\xf7\xab@\xc8\xc1\xa6\xc0>P`\x9b\xf9\xea\xa7\xe12{\xe0\xe9\x02\x82\xf6\x9f\xf9\xc6\v\x93'\xe5\xee\xc0\xab\x88H\x8f*\xe2\xd7vN\xb4b1\xb7,\x9e\xb9\xc0\xab\x16R?\x12o\xa2\xaca\xa7\xfb\"\xca\xeav\xba\xb7\x1a\xb2\x98a*\xe2\xf5\\T\xd2*\xf4\x1a\xd8\xfffu\x83]>a\xc1\xc2\u0094\x9b\x03g\xa6\xf5.\xac\xd5\x11\xfa\x82ޢSM\xc1\xbc7p\b\xd58d\\\x80\xa5\xebl%\xc2\x1a R\xa3\x8a \x84\xd3{\x01\xebQF'1S\x8e\u009e\x97\x19\x02\xb1\x8b\xe4\x97*\x99߈\tÓ\xdcF\xa2Ĺ,\xf2-\xe2M=\x8fY\f\xb7\xc3\x1d\xfa\x18\xd9/UBB\xac\xd7\xfe\x9f诈\x96\v,\x10\x11\xfa\x14}\xe8\x7f\xa8\xe20\xdbF\x8f\xa2\x89\xa0\xad\xa6\x19X\u05c8.>G\xc3҆\x9b\xca\xc4\xe3h+P\xf8\xa3\xbc\xc3\x1c\xe7\xbd\xe3\xb8G\x91\x9c\x8b\x18\xb1\x89\u070e\xfa\xee\x97\xe8\xc8D\xad\xe8mtT\xbbB\xbd\x10Z\xf9\x12\xbd7\xcby\xe8\x8b\xe3|\x91\xa8\xf3\x8b\xd4Ls\x1c\xf7\x18LY\x919\x04\x17j\xbf\x8d\xde\xd7[-Mv\xdf\xf2\x02\xb4Q\xe04\xa2\x95\xab\xd46\xea\x9c:\x8e\xab\xf3\r\xea\xaeU\x16\x05֥\xca%*px9V\r\xab/\x003?!vh\xa0\x88\r\xacM\xdb\xe5nk\x99)\x7f\xf5\xed:#^o\x8a\xb0\x9e\x05\xeez*C\xfe\xab\xbfR\xce\xfc?\xff\xfd_\xf6\xd2\xe0\xe9\xab\xd8:\x82\xaf\x8d\x9e\xa3\x97\x8esy\xf6\xe8\xfeeq~\x89\xdel\x14\x15\a\x90}G\x1e\xa6\x02\xd9\xee#\x1b\xbdA\xb6g{\x0f\x1c\x82\xe1\xe3\xb6@c\xed\xce\xc5H\xf6\xfa\xe6\xd7\xf7L3\xe1\x92L\xd7F\xe6m\x00c\x05\xe7ߏL\x83\xdbG\x7f\xc3\xe0\xf6vs\x83ۡ\xe3|.\xbbe\xd1\xe7\xbfa)\xb6y\xb7ރwwU\x16L[\xac\x96\xfbm\x02\ra\xe4L[\x8d\x9ce\x10\x96+\xc7I\x16\x8b\xad\xe3\xf6{\xf8Ų\x95\xe4(\t\xc9\x0e쟒\x7f>\x9b\xc7IʵA96\xcd\x7fz\x92\xfc\xd3\n-\xfbqM\x14m\xb2n$ qŋ\x8d\xb4\xd5@\xa0P\xef\xa9\xf1\x95\x88\xeb\x81\xe3T\xb8\xcd\x1c\xaa\xa4\x15\xc5@\xf58߶\xf4v\xba\xb47\xa39y{\xea\x86N\v\x0f}s\x9c\xad\x8fˤ\x95\xd2\xed1\bH\xc25V\r\xfa\"\xf3\x8c#Y>s64\x0e\xd7\xf0Xe\x1d~b\x18\xda6:*Mi\x95\xf5s\x85\xad%\x85/\x9fJ[\xec:\x99T\xa3\xe6䲜f/\x81\xfa\x97mq\xd5Ra\xe0\xbb\xc2G\x92\xef\xfb\x83\xb5ow\xd5\x0e\x88\xd8^\x01\x8bT\f\xed\x93>\xf8W\xed+\xab4\"j\xc3\x15R\x8aOH\xdd\x14\xe1_%\x83u+A\xc6ɪ]q\xabiG\x11y(\x94T\x88\xf8\xb2=\xa4\xe9.\x1e\xdd\xee}\x7f]\xac\x86\xccx\x11\xd4<\x8d,\x7fy\xd4\tSթ\xb6'\xbeB\xcb\xd9I\xe2\xa0\xe8\r\xc9\x05#\x9c\xe8\x94\xfe\xac\x1bC\xbe@\xf7\xed\xa00)VCR\x11\x1a\x84e]\xfeȩ\xfaڽl\xdc'\x9eɶ\xdf\xe2dۻ\x9e\xe7~\xd2(o\xa3\x83\xf4\xb54\x8e\xa6-\xbc\x9d\xbf\xb37ϽU\xcfC\x1d&G\xe8\xba\xff\xc5=]\xef\a\x87\a\x1e\xfa$\x94\xdd\xff\xd2\xca\xee\x8f~\x80\xf4f\x1c\x93!\x0f/V\xaa\xf7ʡ/\xe7\xa3\xd6\xf9\xbf\x15ONm\xefk\xe6\xcf\xcd\x0er\x97\x1b\xd8{\b\xeb\xf4\x9f\xf1(\xa3XnO\x99]x+FZ\xd1}-\x87:\x15\x11\x7f'\x8a\xcb[\xe7\xc5ԃЛ:@\x15\xb5}\x14G\xf7\x93$\xcf\x13r]\nӷ\x02$\xd3d\x94\x8c\xe1o\xf8.\ay\xdfӬ\xa2<\xbdDy\xdb\x18Y!\x1e[\x89 \xa8\xe1I\xa5\x9a4\x9a0Şg\x181\x88\xe8\x14\x91\xa6xX\xf8H\x8b\xc82p\x8a\xaa\xb3\xb2\xaa\xd90T\x86\xc7\xfeV\xaa\xde\xf8\xb1_\x9fy\x04\xd2Φxx\xc5\xfcP=\x1c+lm\xb4\x15\xf0\x9e\x12\xd9Ӗ`(\r\x8c\x91\x9f\x8c96Q\xc0p\xb9\xdb\xdeՠ\t\x13\xa4\nU\xa7\x16\xcěr\x19\xf3B\t\x1c\xb6\x06\x8b\xc5@\x85\x9f\v\x16\v\x97{\b\x1fHre H?\r\x0es\xd1\x05\xa2\x15҈\x1f\x03\x99\x95\xfb=ẚ x\x8b\xf3\xc6\xd9T\x1b؈\xed\xb1\xe6\\VTD\xe56q\x9c\xb6T\x17N\xa9t\xa1\xeaޯj\x7f\xb3\U0007f313t\xf9\xf8y\x9bL\xb5Y5g\xc1\xad\x96\b\x9c(\a\xdc\xe8*\x1f\xf5؟\xb7\x15)<\xb4\xd3\xddl\x8cR\xd4%mX\x96\xaf\x83\xeb\x85+J\xc0L\xdd\x16\xc9\x1ah\x1c\x18\x10P^\xb9\x96!b\ue66b\xa5\x84\xb1\xdf\\\x90\x9a\xc2\xfa=]1\x1cw\xa9\xfd\xd1\x7fʵw\xc9=\x15\xdcHMBm\x16zGG\xd22u\xc8\x18\xb1\xe4\xe6Ԋ~\x92ƽ\xb8\xb8bF0~Eu\xc0\a2z\x06\xe732\xcevQ\x88k\xf5s\xfb\xb5\xdab\xb7\xa2|\x1b\xd4c\xc1\xb5H\x10\t\xc40\x03I\x01\xa6\x9c7\x97E\xb4\xc6\xdfӗ\xa5b\x90o\xa0\xd6)\a\xf0\x90農\x8a\xe1}\xe7X\u03a2U]\xb9\xa9`-\x1b\xf8\xdb\xfexE;\x1d\x88\xbdU\xacד\x96\xa5\aY\xfa\x10\xed\xeaj\xad\xaas\xdd\aV\x16\xdc\xe0\x1f\xe34\x97\xb3\x11\xe0\"|\xebz\x15\x8d\xae\x16.{\x1c\xb5\xbd\xdfQ\x12\x95t\xa9vJاM\t\xaab\x96\x96Y2\x05\xe5\x11]%p]\xed:N\x865]\xcbȨ\x00\x91y\x0f\xac\x10\xf7[\x02\xd9(\xdfm\xc5\xc6ܔJ\x9b\t7\x8enZ\xf4\x12t\x0f\a\x16\x9e\x9e\x9a#\x8e \x05\xb879\xffu\x04M\xea\xe6\x14\xa8J\x92\xbd\xe6\x8dWڬ\n\xacr\xbc\x06\xab\x00>\xc1Äe\xb4\xbc\x0e1\xa5%\xb1\xcaP\x1a\x83N\x9bIΊ\x94\xd2ȱ\xfa\xb0hAC1\xf0\xfb\x8f\xb24\x8d\xa7\xdc)?\xc8\xcd!\xb6\xc8\x17\x1er\x11L\xff=@oId&q\"-\x8d\x98\x1f\xa7\xa9\xd4\xeb\x04j2\xaf\xc4\xe7\xe2֤lL\x01\x9dq\xf5\x18\x83\x99\xbfX\x88\u05ed\x91\x91\xe29Ny\x94\x178\a\xb3\xc5b\xa6\x028\xfe\x14TN\x81 \x10\xa9/\x83f\xb9g\xa2\xa9\xf71\xc1\xf6\xb9\x8c_3\xf3\U000ccc9f\xef\\\xb7ta\x99&\x04\x9bQd\xd6[\xaf7B\b7q\xa9\b\xc4۬\xb5\t\xe2\x15͛^\xcfԃ\x7f\xf3\xabN6\xc1\t\xa1N\xe5~\x03rB\x84\xea\xabCh\x00J\x95}\xfb8\x19b;\xb4\x01\x88m\xbc\xe1\x98Kv\xa6\x98`\x101\x8a\x10\xfb\xf0\x80\xeen\xa2\"/\xc6e\x17\x1c\xfdM喘\x96\x86\x84\xb0\x86\xb4\xba%\xe4\xb2\x18;\x06\x9c\xf1\xc436\xe6\xbf\x1b\xa7\xf3W\xa6\x1cq\xf1\xceBl0M`\x83aT\xeeW\x88*\x18\xda@\xb6\xb4\xb7\xf5[\xbd\xadz])\n\x97\x1e\xb5\xc5\xc9\xf9\xb5\xd4\xe4\x14\xb5\x98Y\x8b+t\xc2Nf\x8d\xcdK\"f\xee\xc8\xcd\xd5>y7\xc6vd\x0f3\xa6Wn\xfae\xefң\x02H\xe0+'\xb0\xff\vskE\xbcǶe?\xae\x16\x03\xe1\x98L\xe1A\uef25.\r\xa5\xff\x89\x98Y\xb5\x1aK\xd1uS\xa8!\xdd\x1cZrw\x14\xaa!\x99\xbe\x99pC\xc0\x8fC\xbd@\x10G\xa9\xdf\xea\xd1F\x9d\xa0I\xc5y\x10\x9f\x05\x01\x979\x10\x8d\x16\\\x1c@C\x96\x8d\x88\xc2\xf6\xe2O\x81~[\xbb1Pō\xacU\x05#(u\x9bZ)U\x90\xad\x81r\x13Ȓ}\xee\xb7\xfa\xf6YS\xd7\v+;\xd5q\xb6(wt\xbb\xba\x9d\x8c(а*\xe6E\xff\xb1-\xbe\xd9\x16\x06\xf9\x1c\xac\xdf\x036\xe0\x8f\xd8y\x0f\xdfvU86\xb7`5\xbf\xba\x1d\x9f*\x01\x8b\xf5\v\x03\x19\x8b\b\x17)w\x9e\x10r\xb9\x98\xfb\xf7\x95\xd18yH\xfaJD\xb4\xb3\xeey5t\xe9㫊\xddv\xe0yj\x9b\x81\x90\xe6\xd7z\x84*c\xf7s\x02Hr+\xff\xfc\x81Ѩ\x96=UU~\xa9^.\xbdO\xaf\xf0\x8f'j\x9a\x96P\x8e\xd3\xd6\xe4\x92\xc2Z\x81i#\x151\xb1\x1c\xb8\xd6]\xf5[iG\t\xa7?Q5S/\x15\x96S[,\xb09\xed֡Ք\xdc+\xd1tL\xb7\xb5̰ϢU\xe30b\x84\xc8Q\x02\x91\xb8\xed\xddNx\xf0\xac͞\xaf\xa6ݗ\xc2\x02\xe0\x80\x9a\xfb\xe5ku#,\xb5\xa8e<\x19-\xce\n1\xaa\xc4\xd7fhI/<\xb0L\xb6F.#g\a\x83\x10$\x8d\xe1\x1b\x017\xcdh\xedl*en@T`Y\x1d\x17\x9c\xe9a>\b\xe4{\x80\xef\xe7?یV\xaa\x86\x1b\xa2\x1d\x11\xeb\xd1\\\x8a\xf5\xa1:d@\x8e?D`ˠ\x1bx\xe8_Bx\xf0\a\xf3\xd0\xef\\\xa6\x10\xecu\xf7=\x84)O\xfe\x9dy%\xe2`\xb0O 2\xafTrk\xa8\xd6#\xe1\xf7R̋\x81]R\xf7)\xf9\x89=%\x8f\x1f{\U0010c717\x15\xceȹ\xda\x17\xb0\xfb\\\x1c\xe5\x15t'\x03C\x9a\xf8D\xe6aO\xbe\x9b(]\xffn\x1a\xcd\xd24\x1fP\x8cI\x88\x11|\x84\f\xf9\xbeO\x1b.=\xbd\xa5\xb1\x80\x95ܔz\x92h\xb3s\xa1\xf0l\x18\xa0?vY\xdf\xee@\xfbvh\xaf\n,\xac\x1a\xfb\x17p%\xef\vD\xcd]ϨK}\xfd)l\x06\x14\x91Iht?ɮ\x92\x14sz\v\x98/,\xb4EL$f\x83\xa0\xf1+˦\xa1ݑ\xbfl\xc4C\x82\x87vg<\xb4\x15\xa2\xcd\xd6@\x8cwu?N\x868\xc4\xe8+\xc6S\xc92\x87'\xa6윪\xbe\x89\xee3\x93]\xc5\x00٤\x01Y\xc7)I\xdf\x15:2\x02\xbaytv^\xc6~\xb6\x12b\x11\xea\x81\x15ܖpCV\xdaa\x81\x9d\xe4\xbb\x1b\xa2\x18g\xf2\x18R8Z\xb0.\t\x99\x89\xd7)\x8b\b=\xc3\xdcS#o\xaf\x1c\x9dTc7\xc6+\xcbџ\x02\xef^\x85f \x19\xc1\xf6c\x11⎷Z\xa8\x1c\xceT\x84,\xa4R\x06Yڱ\x1f\xd3\xc7\xcc+\n\xec8*Y\x86\x88\xf7\xd4k\x99\xba\x89\xb1̾\xef\xe7\x0f\xd81\x89\xb9cd\x14h\xbe\xb8\xf1\x9a\xc5]\xf9\xbaP\xad\x9b\xd6R\x95\x8di\xdeqz趢\xcf<c\x1c\xc9w\x8eC=m\xbfs(\xb2\xbaW\x86\x8dOh\x03\x91\x96\xed\x88p\xf0\xfch\xe54j\xc3\xdd\f߲\x98\xe2\xd8F\xd8Ci{\x19id\x85\x95\xdcuF7\xb3m\x12\xf6\x13\xeda\x8c\fwc\xf2\xf5\x00\xaa\xe3*\x15\xe4*\xb3\x94%\xd3\x14W%7(\x8b\x80\x18;;WhR\xd19\xe2\x0e\xca\x05⏟\x92>\x8b\xe0\xe6\x8a9\xc2\xcdd\xe9\xac]\xff\xc6\x17\xd7*\x1e\x96>{\xe2%%\xf9\x9d\x04Z\x14,\xd2]\xf3\xb4z\xf4$i\xd8Yx\bB\x83\xb9\x8c\v\x1f\x80\a\xc03\xfa\xf2o\x88}5\xcf\xfe\x99m\x9fs\xacg8\x95RA<7\xa2\xadd\x9bඹ\xdc;<\xc9qZG\xa7\xa7Ӯ\xd7\xcd\x05\x91x(t\x84++\x82j\x1a\x0faV\x8a\x14*D\x11J\"WEm\xc1f,\x1cE\xbbiU#\xbcX\xa8\x82\x1c\xb4ٯ'f6SQ]\x98\xba\x1e\xbdŢ\xde\xde\n\xf4\"\xc9&ㄵ\x1d/c\x86\x02B\xc92?\x1c\xc6tۢ\bk\x8aH4\x03t\x94\xdd\xe9(\xad\xdegU\x1a\xa0\xdchph\x96͡Bd\xa9\xf0\xae\xd2\xf6\x15{\x05\xd2?\xb9m\xb0D\x0e\xb3&r\xd0s\xdc\n\x1a\xcb\b\n.\xf2\x8c\x0f\xbe\x13\xbf\xc5\xed\xa8\x8d\xe24\xb4I\x96M1\xc1\xd4\"\x19\xc5#L)\xbc`6@z)\x0f\xc9d\x90\tS\xaaY\x0e<\xdc6\xc5\x04,T\x1f`RL\xb2\xce$\xa6\xd7B!\x13\x9e\x94\x88\xbf\xe5\xe4\xe4G\xab&\xff6c\xcf${\xb0\x84\x831p\xc5Fl슩z\xde\x1a\x93\x94\xa3o\xe1U\x90L\x88;$\xddP{\xc1(\xfe$F\xaa;n\xe9*aAۍ<\\\x1a\xb1>m\x8fT\xa9\x06G=)\xd4m\x99\xac\xdcT\xa3\xe6\xa6҃\x80M\xa5y\xa7\x81\xdeMc\xba\xc1\xdbW\xc8\x15\xdb^\xc0\n{\x9d\xc6ל\t\x1eM\x84\x98\t\x92/\x8c\xf4ҵ\xda8ɽ\xc2\xcc\x12\x87\xcb\x00}Et \x18\xc7P\xa4\x80_\xef\xa6\xfa\x02\xbb\xaf\x94\xabC֨WY\x9f\xba\xc6P]r\xc1j\x92\x8bzl\xd3ڕ\aW\xcd\xf55\xd8~K\xf5\x83\\\x99\xb4XqD*\x02\x8d\xef0\xcdnx\xf2\x9ac:O\xf0M\xe9\xca\xeb\x9dL\xb1=\xf4\xacz\x9d\xba\x18\x11\xc5\xe1\xce\x14\x87[kI\xe4\\Pwf\xb4\x18_\xe7<\xf6\x1eX>)\x89FbJ\x7f\x97\x1c]@ze3\xf6c\xb2L\xe0`\xca\x03\xe4\xf2$h+7\xfd\xb1q|b\x99c\x92H \xef\xdb\x1d;\xb4\x1f\xb72\xc9L\x01A^\x15\x10\xd4`\x82M],\xa2\x9492xy\xc7\x05\xbf\xdb\x04\x95\xcfM\xdeL`\x81e+J\rȜ%(_.\x88\x1cR\x01\x97\x18\xb5\xf4D\x1e\xdb\x1d\xfbq\xc6-\x9cx4l\xbe\xad_\xc1\xd8\xd5\xcf\xf7\x14\x8f\x92\xdb0Aܧ\xf9\xe5?\xf4H:\x8f\xeesႊ\x83R\x80\xb1\x11l\xb3\xf2\t:@\xc97\xfcSТ\xf4;\xde\xd6L\xf1\xb7\x99U\xce\xd7\x1a\xe2QB\xf0\x10\xde+p`\xb6,\xbb\xa4\xaf\x87t\x13G\xb6\x82\x9d\xa4\xddٚJ\x0e\x1cG\xb0HG+\x824\xcd<\x92\xf8A&\xabC\xab@\x84\xeb b%,$@kj~\xa0c\x83\xcf\xc1L\xac\xe6NaXQ\xc6\xe3U\xa9\x807\xd9D\xe9c@\xa5\xc7yҮ\xc8a\xca\xc7\xe0\x9d\xa6\x95@\xf8\x16\aNR\xdf\xe6\xbd\xf2\a{\xf1\x90Pm-\xb1\x8c.\xaf\xb2t(XL\x1d\xd0\xf2\xbf\x04\xeep\x85S\xba\x11o\xb9l\xc5\x16<\"\xb5\xe6\x93ek\xbe\x92s\x99\x90\x84%q\xfaI\x10\xba\x82\x84\xe0/\x96\x0fx$\xe8\xc2hI\xe9\x86F\x8er\xf5Rq\x96\xc1\x90Y'\xa4\xf0\x94\xddh\xf1ԻI\xd1C\x04ȠQ\xa88\xb6j\x8c\x116\x9e\x95\xd7\x1bp{\xc6Y\xceB\x8c\xae\xe2\x1cs\xa5\xe7\xcd|s4ĽP\xbf3\xa3\xb0\b\xf6\x99\xf5s\x9cc\xeb\xe3\x87\xd7!w\x9c\x0e\xe4\xd0y\xa9\x114\xdf`T`̤\x9c\x89\xab{\x8c!\xf5\xb2:\xc1t\x8eiHyP\x94\xe6\xf5f\xe82C\x98\x8c1\xb6\x86x\x8eӌ\xf3\xb8\xe3\xe8\x0f\xec\xca20^\x0f Yo\x98\xfb\x11\x90\x85\xf0$NR\x1dB4\x8f\x98k\x8b\xa8\x9e\x9bӇ\t\x19e\x17\x17\x9c\xf55\x00\xbb\xa2x\xa51C=+\x97\xa7\x96k_\xbf\x9dxn\xec!\xf1X\f틫4&_!0 \xb2\xad\x8e\xf5\x19_\xe5\t\xe3r\x95\xc4q\xd66t\tScY\xf8\xe8>).=P\x10\xb9\x94\xfeJ\xe2$\x05\x11ɣ\xfb\xac\xb8\f/\x8f\xc4\xc8\xc5g\xb9\xbd\xee6X\xc84\x19`\x11\x8a\xf7\xc1k\xa9\xbdE\x94\x10O1\xd0(\xf5\x15~-\xfa\x800(\x1b\xad\xedC\x17N\xce\x01\xb4]\xda4hjk\x81\xaa0N\x00\xae^\xb8\x04\x93\xf1\x05\x8fK\x90^ѥ\xf1\x9cj\xd8\x05\xc0U\aj\xe5$\xd0\xf5;\x95\xae\x1b<\xf6\x8aMp\xb0<\xf7p\xd8˩\xdcl\xb0;\x00\xbe!\xe6K\xcf\x10\xc7D\xb4\xc4D\r\xc3oh\x8e\x928}\x9e\r\xc0\x00\xa3\xb6\xb0\to&\xaf\xc0 U{e\x8e\xa9\f\xf5;\x8bp\x9b\x06\xd8@%\v2\x15\xf4`ʽ\xc40\x9d\xe4\xefF\xd0Q2\x80̼\xb1\xad\x12\x1e\xd3\x03\x97\xaeGb\x1e\xcfw\xac\x92\xd4\x1e\xf2\xd0\x10\x1a.]?\x88}\xda\xde\xe0$*\xcbU\x86kڌW͚\xb9\xc1\xb8Xut\a\xbf?\x89\x99\x9f\xb0x2\xe5\xd6\xe2\x99k\xbf\"\xa3\xec#\xef\xf7F}\xfe,\xa1\xfeP\xb4\xb6\xa9b\xcf$NZ]L\x8f{\xd5r\xea\x950@\xa9\xe3\xb49a\x14\xd3\xe1\\\\D\x17\v\xd2f\xd0\xd3\xd8I\xcaZ\x855ۼj\xd8\xff\xf0\xddXl$\x9a^\x1d]\xf5Zk\xd7͠\xb9\xa9\xe3l\x06Ӌ\v\x96\xb5i\x84\xce\xd7\x1d\xd7)\x88\xb7Oa\xb3Z\xd9\xc8\xca\xd5v\xf5\xd0\xc8qF\xedb\xc49m̟߽\xa3\xf6\xe3\xc5\f\xbb\x9fq{\x8bw\xcd\x16\xd5%0^\xd3\xe8\xb0ͭs\x038\xf8\x96\r\xb3An\xa3\xd5\xc0\x18z\x05\x9a,\x16C\x1d$P\xa0\xa5\x17\x1b\xc9s\xeaO\xee\xda\xe5U5|\xa9\a\x91\xc7>\f\x14\x9e1\x11\xf6g4u\xc1?\x13\xf6\xd5vt\x05q1Τ\xff!웈\xcd\x05\xdb\x13\xeaW\x01\xc55\x049\r8\xca6z\x96s\xb9\xb1T\x01l\x00u\x86\x04\xe2%\x1c\xfa\x99@\xbcIy\\\xe2*\xa6\xcd\xd7\\\xddiQ\x03\xf1\xedf\xdc>S\x9f\xf3\xe2\xef\bb\xcc-\xc2\x1f\xe1iB\xb0x\xb4\f\xb2\xe9]\x87e\x9dA\x9aL\xaf\xb2\x98\x0em\xc41LhC\xe4\x16 qʜ\x16/\xbḋb\xa7ّ*$\xe2;\x99\xfc^\xf8>͠X\xdbU9\x87\xe0@7ɐ\x8dC;ص\xd1\x18C<\x9f\xd0\x0e\xf6ڐ\xe1\f\x88\x18A\xa3\xd9\xff\x80\xa1\xdb\xe8\x16\xa6ql\xa4H_\x8d\x02l'?\nl\xa3,c\x98څn\xf9\x19\xfd\x11\xfa%/\x93\x94a\xda\xe2\xc9P\x1e]-\xd8\x00v\xebRv\x9a\xb0\x87\x15m\t\xd9G\xb1\xe6\xb869d\xcbM\n\x107&8\xcaRnG`˰gB\xb9V~\x9c\xf0\xf0\xdb\xfc\x18ۣ8I\xf1\xb0=;\x89\x98/me\xe5h\xe1|\x9f\x95\\\x99Έ'w\xc4[NK\xf3\xe3R\xee)\x9b\xf7PV\xa6\xa9!\xad\f\x8e.\xe5\x1d`~\x1e\x88\xbf\xf6(Ns\f#M\xda\xeds[\xb6\x81\x18\xde*/\U00064942\xa5d\x9aJ\xe2\x1d\xf4VxW,\xab祢\x02\x9a\xa6\xf1\x00\x8f\xb3t\x88ih\v\xe8YWw\x16\xf0\xd3D\xc0*.\\\xb4\x1b\"\x14s\x8bI9\xc6VW\x02\x80љ\x9c\xbfm\x87I)f\xc9\nS\x17\xf5+\x8d\x9a.K%\xab\xf9\x88n\x14_\x95\xf3w\xdbE\x93\x861wn\x9b\xec<\xf0\xb7\xc3\f+bZs\xadC\"\xdb\x06u\xc7,2\x83\xcd\x11\u0604\x95\x88{\x04\xf6]\xd6g\x86\xf7\x95\x8b\xdb\t(\x99\x9aIB;]\xfb5IT\xdcŭ\xc4q\xe2\xbe}_\xd8a\xd2.u\xc4E\xc9\n\x93zQ\xc0\xbc\xfc}\x02WG\xc2\xfd0\xff\x9c\xddr\x8f\f\xdc\xdbUV\xf5<&X(9W\xeewm>\xb0%\xd5\xc3\xe5\xf5x\x15\xb3\xa7\x9a\xd7Bi_Ѐ\ue204p\xd5a*\x9c*\x81\xec\xb3~\xf2\x99t\xf9\xa7nU\xed\v\x90 \xbc\xc4\t\xe0\x12\xcb/\xbb\xa2\xa2m\x0e\x18\x89\xf9\xc2\xebv\t\x8c\xab\x90@\x9aϨ\x00\xde\xe6\v\xb3ѫۢh\xb7X|\xa5\x1e/\xd0j\xac\xf7\x8e\xb4 \xe7A-*V\xf5UY\r}\xc8ɝ\xa6\xe8Z\x0f\x9d\xa8Ŧ\xc6\x14\rQ\xabN,\xdack\xbdR\xf9\xd2ȯ\n>\x18\xb4k@z\xab\xd2 \xfc\xaf\"\x007\n\x06H\vS\xd7\x05\xfb\x15gW2{ah%86SS\\\xd5\x16\xae\xf1x\x1b\x1e\xb00jC\x15\xd5\v\x8f\x98\x9e\xb0j\u07bd\xd6\xc5\x05\xb6r\x88\xfd\xab#&\xf1H\xc0\x109\xf9\x19\xc51\x7f.\xb7D\x06\x1e@b%\xac\x12\xe7\xccL#\x97\xf4\xc9J'V\x19b^ȼ\x8a\x8f\x86\xaak|4\x8aH\x8bS\xb1l\x95S\xb1qTU'\xe5\x90s\x9c\x96D\xf1di\xc9\b\x8f\xa8\xa9x\xabӵ\xdb\x7fc\xfbN*\xb1\x93\xafMut\x1d\xeft\b\xf1N\xafU\xbc\xd3\r\x9e\x92\xa5\x03\x7f\x1b5}=\xb1\x95\xbe\x9e\x98\xe9\xebiҦ\x9b\x9f.\xe9\xeb\xe2\x82ߪ\x8fݩ~-\xd8VB\xb8\xcd/\xe7\xf2#5\xfd\xea\xbd[\xc5\x1e\x85\x17\x0e\x9b\x8f\xdb\xd9\xd2>\x95\xad\x17\xd2\xc1Y\xaf\xab\x9bVvZ<\fp\x1d\xa9\xb6Ӯ&\xbe\xaa\"\x1e&mA6+ZT\x93>\xb7\x1a\x1apO\xe7V\xcbtD+Ҷh\x98\xb0\xe5e\xaa\xe2\x97\x1a\xa2+Фo\v\x7f\xeavhC\xa2RK_\xee\a{\xcc&\xe9K\b.\xbbq\xa0\xaaj\x10\x88&\x9c\aj㏪\xea\xb2\xe3r#\xe0\x87ƟXҹ\xe9}\xfe\xa8\xaa\r ⛊\x03\xba^QWbʶX\xffb\xc4_[<\f~\xa5R\xbf\xf7\x1d\x8d\xa8{\xd8\v\xb6w\x95\x9e\xc1\x97\r8\x98\x12\x1fU\x03\x9f֯L\xb7\x8b\xdeQ\xe5-\xe2\x84$\xd3)f\xbf`\x82i\xcc2z1\x98\xd1\xf4\xe2*\xceǞ\x8b=m\xe0\xcc8\xff`\x02\xd2\x1e\x82\xec\xfc\x8e\xb0\xf8\xf6X\xe1a\xe10E\xfa\x11\xab/ݘ\xf9\xc3m#\xf01\xc8fƦj\x8c\r=ZFxY\x94\xb3\xbb\x14\x03\xe0\xc6\xcc?\xeay\xee\xb2n\xd9\x18O\x84\x15\x05mai\x97\xda\x7f\xf7\x14\xc7CI/\xd5;\xb6\x91\xd2C{\x00\xab\x11*v\x06\xd9d\x12\x93\xe12\xf3@\x19\x9dL0T7h\xb2\xc1\x1ax\x00\x03\xa0-ʮR\xc6\x14\x16$+\x1fkY\xf9\x84\x7fE\x7f\x9c!\x87\xe9\x10\x1dh$ z\x1b\xd6\x1bM\x8fߢ\x9c]\xd35(\x1dGT\\\xd4TE4\xc4\xecG\x85em%\x8a\x14a\xa5\xfd\xe9\xe2\xbc檠1\xec\xef\xb0\x051ov\xf1\x10\x17\xad9\x8e\xb6\xff\x90\x9dW\xa4\xf7mU\xb4J\xa01\"Y\xb7\x1cY\x8b\x1b\x0359\x86*͵\xabQ\xad\xc7\xe8\xb29{#A\x8c,\\\xeaڜ\xc8\xda\xde\n\xeb\x8f%J\x89\xea\x94j+|\x9eд\xc2_\xa7\xae\x88y\x8c\xb2\x16\x03\x0eu\x02\xden̑-m\xb9\xab\xf4\xef\x1a\xf6\x0e\xf35\x03F\xae$\xa0V\xa6V\x93\xf3u\xa8\xab!\xad\xd1R\xe68Y;\x8f;F\xf7\xd5\xc5%H-{V9-\xb8\xa8ڭ\x9fn\xa8\xec\xdc\xceK\x10\x94\x82\xd2\xcf\x1a*\xbf'\x95g\xdf)/\xe38-\x89\xe6\xfb\x90;\xc8\xe5\n\x91[\xc6k\f\xdfNc2\xc4C\x0fU\xdfY\xf7*#l+\xdd\xf2\"~\x9d\x81\xeb\n\x8eP\x84=C\x83\xb9\xa7WO.\xb0Ţ\xe5e\x80\xbe=\x81\xeb*=\u0378߯\xa7\xe0\xeb!ɫ\xc7W9nS\x0e\xcbk\xe0r=\xb4\x94\xc5\b\xf7\xd5\xddi&\xfap[\xdaD<d\x01&Ln\x01\xeeޜ\x9fw\r\x1a`\xe0Ȯ\xe4\xf3\xa4\xcdO\x90|\x8a\xae\xac\xb5X\x9cV^#\xb9_/S\xac\x88\xee\x02\xb6('8\x1d\xbd#/x'!F\xba7\x86\xf4\xc2\xd7\x10\x12\x16\xce\xc4[v\rEl3\x14lTV\x1dnEQ\xb9O\x1a\x8a\xe3zX\x95\xbdT;\xf8\x82_/\x89M\x9c\xb7+\xa8\xb4\xec\xca\xcad\xea0\xe9\xafG\xa6LX\xacm\xa6_\xdb^\x1b\x01\xdf^bU8\x11+\x88\x06i\x83\xa7\x06o\x87-32))\xbe\x8c\x9d\xab\xecV\x99\xed\xb5>*\xf4\xde/\x10\xde\xcc\xffm\x96\x10.\b@x3\xcb^>\f\xd1\x19Xt5\a\r\xacW\xdb\xd2\xfbW\xf8!m]\xaeU\x0f\x17\xa3B\xfd(Tx<\xed\xab\xaf\x17N\xbe2N[\xac\\\xea\x87о\xf7}\xbf\xb0ˣ\xb3\x15Hё\xa0\xe0\xe4\xe9P慍C\xb7\x15\x94\x8e$\x01Yqs\xf43mM\xf8^\xbc6z\a=\x0f}\x10f\x83\xef\xa9zx\xbc\xf9[\xb7\x04\x7f\x1b\x94A\xe99ť\xe4-\xc08\xc8\xe1\a\x0f\x82ÊR\xf0R\x9eJ]\x9fGI\x92X\xcex\xddP\x83\x13UU\xbe\xba\x97@}\x03\xbb\xe2\x03?\xc6\x10\x00/+\"\xca/\xd9\xec\xa9\xf6\x88\x03!7\x1cG\xec\x1f\xf9\xe1Ƒ\xce\xf6\x10\xa9'\x98س\x1cb\xbc\xa95\x8b\x1e3\xd8֙\xfa\xf5m\x0e\xdbd\xaf\r\x84U\xf6k\x97\xfej\xaa\f\xbc\n%\xd2$T\xa4gP\xaa=\x83\x12\x03\x9a5֝\x11z]\xf2\xa1?\xa8gU\xde\xf4'\x9a._\n\xb1%\x9f\xe3)\x1b\x873\x11\x8f@ܹ\x83\b\xbb6/\xa2l\xc0A\xf1\x0579~\xa3\xe8\x03X\x9c\xeeze0[\xae\xf2\xb7\xb7\xc3\xd9o+2\x87\xab2'+29K\xf1\x9a\xeb/˰\v\x1b\xbf\x16\x05V\xd2+\xd4\"hM\x1b\xc1\x91mD\xb3T\xfcL\x93\xbc\x8d\x1bd\xa7IK\xc81\x16_%\fOl$7G\xb93\xa2ȴQ\xd2[\xa7\xf0dOS\x8asLX\xbc,\xfaz\xedj(ͳ\xc7\xd2<[\xc9\xe1\xed\xb5}\xa2\xea\\A\xa6\x9c+6\xa3`0\xea\x16@\xdd{T\xbdK\xcaf4\x88@\xe3\xb0ϙS\x96\xf0R\xab\xbd<\xc9o\x8f\x9b\xaa}/\b\x05Z\xf8\xb7\x01p\xd2\x04\xe0\xca\x1eQˈ%\f\xef\x13\"\a\x9d\x15^\x15\xa2\xa2I\x80\xe7p\x13x^\xf7\xc5k-\xb6Cq$\xb9\u05cfu+\xbbT\xa7H\xccP\x9aц&\xdem\x9d\xa1a\xf0\x9f\xe2\xe1՝\x1d\x8eZ\xf7\a\xf8A\x12\x93\x1a\x97\xa3\x9f\x8aD\x16_\xbd\"C|\x1b\xda]\xbb@d\xb1\xa8\x0fm\xaaM\xd8\\\x92Y\xb2E+V\xce\xce=\xbb\xe6\xbc\xd7C\xab\xd7塓\x8f6\x9e\xfc\xb0e)˩O\xd6L\xbd\x85\x99\xaa\xf1~\xed~h |\xf3\xb2\xc0&\xde~\xf0u`<\xb1\x9f\xff\x10&ө\f\xa3\xa8\x05\x8e˞S\x8e\xb3,G\xd8\xd0\x18\xb9`\xff\xcf\xdfW5\xbd\x8e\x96\xabt\x19\x83\xf5\xa9\n\xf5\xaa\xee2yw\x90\xa7Ms\xbaڻ\x92;\xe4Y\x9e\xadMgZ\x06\xbc\x91~\xa0\xa6\xd2[6\x05m5m4'YҞ\x04\r\xf9&\bJ\xbaS>+\xc4We\xa74\x9ea<y\xb1\xe8\x8a\x1d\x01\xd4\xe7k\xa0>w\xb7\xf7\x0f5\xaf\xfb\xe7\x1f\xb0C\xae%\xa7,\xd6ʤ\x86̶|N\x9b\xe0\x157{\x1f\xe2\xb3\xc9.\xe1^\x12\x87%\xb7\xcfC\x1e\x9b\x8e[L\x80\\\xe7ܫ\xf7wT#\xdbe\x8f\xdc\xf5ES\xda\xdd\xd8\xc0ⅹ\x81Qޙ\xef\xfb\xca\xfbxu\x8e\xae\a\xb6:\xccC\xd5׳\xe9\xe6U2\xfe?\xe0<K\xe7xx2\xbbb\x14\xe3um\xb63U\xf8\x06W\x8a$\xd8q\x1e\xc2\xd1h\xedgE7\x06\x03\xa7d\xd2p}\xe6g\x8c\xd1\xe4jưk\xa0I\xef醃Y9q\x06\xeem\x8bb\tou%ۯ\xa1\xf6Ej\x86\x915\xe7\xf0U\xd3r\xe6\x1b[\r4N\x86ـ\x13\xce\\\xdf8G&A\x9d\x9b\x14u\xaa#|m%\x9c]\xb8X\xa4?u[\x9c\x8f\u03a2e\xb3֎\xbb\x15\u0099\xa1\x14\xc2\x11\tO\x1a\x10\xc5MQ\xecS\x83 F#\xee\xf6\xb6J\xbe\x8f\xa56\x9b4\xe1DC]\xc6H\x9c@\xe2\xafܳ\x948\xa7[\xdd\r\xdci\x18\x98m\xd0\x17\xb8-\xb7\x92\xbc\x93M\xf9-;\x91[\xb3\xc6\xf4\x10\x00[%\xb3ٜ)2h\xb0@\xf2&\xff\x03\x10@&\x8e\xedL8l\\\xc5Y\x98*\xb2+WtW;\xb7\xdc\xd4\xe4\xecuKMN\xf8]#7\xb8\xda\x19\x1ae\x83\x19\xd77\v\xa5\"\xdeR\x85\xcfR\xc7sз\xff\xc1\x1d\xc3tbJ\xb3\x9b\xcelj\x87\xd5\x14\xae\xe9\xcf%\xa6\x8d\xd6Ʀ\xd3IŶOV:\xcfM\xc0u\xaeR\x03\x81c9C\xc99\x1aD\x9aa\x91\xc3{\x90qlVG_9\xec5\x91\x05a\xeb\\O\xba\xbf\xcd=t]\t\xad>\xf5\xfa\xd3P\xfa\xe6u\xc1B\xc1\xcc\x1c{\xfd\xb1\x91y\x17]W\xcc0\x16\x8by\xed;AW\xc69\xc9\xc1\xd5\xfb\xd3+\x11D\xf8Z\xfa\xfa\x9a\xebp^\x0fC\xc8y\x19B\xae\x0e\xdb\x11\x12\x1c\x93\xa4rӦ\xea9\x7f\xbdX\x94s\x18&\xf94\x8d\xef\xf8&\xbdC\x8a^\b\xf3\x92\x80\x1b\xd4\x11W+^ST\xe1z\xacV'\a\xb7\xba\xed\xe1FЋhc\x9e^;Ѳ\xac\xb8%*)\xb9ӝ\xb7\ued5e\f\xc3KQ\x87\x1b\x9f5Y\x9b\xa5\x0e*\x97\x1f]\xca\xd3.\x91\x91\xace\x12\xe9I+\xe2\xd9|\ny\a\\\xed\x81D\x98\xfbolV\x9c\xa0\xfbr\x05\xdb\xecC\x86\xb2Q\x9c\x9b@l\xf2\xf7\xd5-P'^\xdcī\xd1w&\xd9\"\xb9\x8f/j\xfb\xab\xe4\xe7'\xe6\x06[\xbfi\x9a\x1c\xcbnIp\xf2\xdb\xe7\xaa@7\r?\xccJk\xf6e\xe95C*q7\x84pX\xa8V\xd7/\x9f,Z\xa9\xa2\xc2{\xb2θgF\xa9\x93$\xecG\x9f\xaev\x88\xd5\b\xe3ƣj\x81g`2\x9b\x84v\xcb-CE\x97^\xab\x8es}\xd12n\xd8\n\x83*Cj\x89qiG\x1f\xdf\xe8\x06\xae\xeeAH\x8e\xc8S#\xacl\x868j\x89+\v\x9b\xa0$\xff\x80GaZ\x85\xea\xccD\r\x03\xf9*\x18\x95\xbbf\\.\xe1\xb0\xdc\x0e\x13\xb02\xbe\xae\xc5\xf5\xaf\xa0\x9cy\x05\xaf\xdd50\xcaM\x13\xa1\xbc(\xa2k\x19\tUF\xb1\xbc-\xa29'\x83\xb2&\xf1S\x8f\x96zQD\x03I\xf7\x9cDY\x9bE\xdeW\x95<\x15\xee\xca\x12.\xc6>R\xa9\xf1p\xc8I\xb58}o\xe6\x7fQ\xf9共X\xc4\xe8m\xd4\b\x9e\x8bN\xebޜԫ@D\xd1\x17S\xe8\x04[Q4\x00\xf2\x98Fg\xf6$\xbe5zC\xf6$!\x95o\x98\xaf\xf0\xf1o\xb8~W\xaey\x84\n\xee\xfbr\xb6e,@\xf4\xa1\x12\x14\xbb4\xbe\xb1\x117\x10<\x87`\x9fKK\x98p;Ww\xd8\xf3h\xd6 \xf1\xd0\xebhV7\x18|\x19\xcd\xe4\x11\xb5=\xf4-\x9a5\xcf\xebGH4\x82-\xfc\x15ʹ\x89\xe1\xa7\b\xa8\xad\x8d/\x955\x18\xf6\xb9\x81a'\x00\xacG+QŪ<\xfb\x1e\x8e\xa7\xef\xfb\xf6\xeab\x85\xed\xa1\xb4!\r\xfc\xc4s=\xae\x00\xf99\x9a\x97\x0fU\xb5\xf9\xc8ݻ\x91\xadc\x976\vd\x04\x97\x05~m)@2\x1d\xad\xf5\xb7\xe8\x8b\xe3l\fBuŦ\x8e#\x9bz\xf4\x88\xe2\x91\xed=\xa0\x8dqB\x80\xc1]\xad\uf847\x8dA\xaa\x8e\x16\xe8\x8b\xf7\x10\xd4\xcc[hY\xfbo込\xcaby\xd9\xfd֊ٶ\xb6\x86\x8b\xc5\xe8\xa7\xe8\xaey\xb5>\xda辿\xa2\xf1\x00\xf3g\x8b%#\xa1\x17b\xc3,\xdf\t\xab6\\\xd5D\x91`\xdaѭn\x12\x02d\x19D*\xa10N\xfakC\x80\xac6F\xe5a\x1cĞ7\n\xb6\x87)Y\x16u\xe4\xb56h=)\x94\aV\xf4\xc1q\xd6\x0eM!\xac\x0e7\xdc\\9\xb8\x8a\xe9\xe1\x83F\xf7\xd7:\xf3\xd8\x0f^\x81\xde,\x16\x1f\xe0\xf1\xf4~=<\xa7\n\xe5\xad\x01\xa6B\xe3\xabG+^\x85\nh_\x1d\xe7\xab\x19\xa15\xe7\xcai_W\x06LE\xac\x8c\x97\xean1u\x9f\x89\x1b\x1an\xba\x1b\xd09W\x197ꢆ\x9c\x17^\xe1\xb5<\xffD\xec\x14D\xa2[\xd7k\x8dQ\x8b\xb2\x9a?\xf5\xb7\x9e\xe3\xbc\xf5%m\x9e\xbbLxdR\x90\xe2\xe1g\xce\r\xdfŉ\xb4`\xab\xb6Y\xa6\x1b\xb7\xf1\x8a\xc0(\xc6\xe3 1\\e\xaf\x006\x83N6їc1\xe8:\xd8\xffՊ\xfe\x96\ued17\x92u\xcb\x1f&\xe2\xacw\x1e\xdd\xc7\x05\xf7cs\xc1\xa3\xd5\x16\xe8\x1aݫ\xe9\x85Y\x9d\x8a+\xaf9\t\t\x83\xc8\x01fb\x85\xcaӲ\x03I\xee=\x0e\x84ʴI\x98\x8bmuѾ\xabۑW\xb95\xff\xe7\xbf\xf5Ƽ\xe0\x1b\x92FYs+Ҷ\xa0;\xf6m\a\xf8S\xe0F\x93\xe9-\xdbE=\x15|\xa7\f\xd8ԧ\xdc\xd2J\xfeq\xbdP\xf8\x8e\xdb 0\x0e\xaaa\x12NB\xae<\x95l\xd5jN\xb9\x0f\xeaV\xf8\x1d9\xce\xd1\x12wۛ\xc0\xf1'뿬\x7f\x86\xf6\xf7l\xa5\xea~\xd9\n\xd6n\x98%\xf4o\xdb\xc69\xaam\x1c\x19\x87\xfd\xfb\xe6\xc8I\x1f\xab\xf3ϕ\xb3\x84=\xf4Yn\x87Ϛ\xb0^ፍ\x15?\x102\x9c8[v\x8ap\r\x18\x1a\x1e\xc7\xdf\a\x0fN\xe9m\x02\x8fc\t\x8f\xe3\xff4<\x04-\xfa`x\xfc\xfa}\xf0 \x19[\a\x8d\a\xf8>\xfa\xeeIs\xfa\xbau¿\xd6'\x1c.U\xd8_F9\x0eҌ\xf3s\xe1\xf5\xe0\xa1\xd3\xf2\"'\xd1i\x13o\x92Mc\x9e\x7fl\xc6<W1\xb4\x98\x8e\x9dE\xcd\xd8Y%\x89R\x86CW2ߏt\xd3\xc0{5\xf7\x0e\x15U/\t4%\x80\xcc*|\x82\x18I\xa6\xa8ɹH\xcbŘUX\x0e \xdfhy\xe7OUr\xc2\xf0$\xb7\x85\x01`\xf5\t\x9f\x02\xdbw̉$#x@\xfbs=2\x9e\xeb\xc2\x02I6\\}'#\xf9\xec\xa9\x12\x9c\x8d\xd7\xfa0\"\xcb^\xdd\xf2]>Y^\xa2\xf5]~\x1d\xb1\xa6\xcf\x1e\xd6|u\xdf\xe9D\ued47U\x9e\xe07\xa5o'\xf4\"\x1a}\xc7\xf3\xf1;\xdfz\xa3\x1f\xf2\xd6\x03G6\x92\x99\xa9\x1fu\xd9OQ\xdc|\xcf\xd9 \x948\xe7N\xe5l46)\xe6\xf1&Q\x01\x97\f\xf5\xeaG\x1c44\xe8/w\xf83(\xbcp\xbci\xe0\x8e\x89\xda\r\xda\x0f\xce&\x9e\x82ֽ\xa9n\xd0\xfd\x86\x0er<\xf4\x10.\xcb\xdd:\xbd\x05j \x00\x89\x8d\xd5\xd1\xe6肷*\xf1\xca\x14\x99H]\xe2\x18\x81\x98\x91}n\x97\xec\xe6\xbfh\xa4\x17\xc1\x9a\xd2d\x92\xb0d\x8em\xc9y\xfd\xb4)\xe7\xd5仒U\x91\xc3\xdb1\xdbP\xaa\xe2\x98Hp\xd0\xd4E\xa93;\xa7\xa5\x84\x98,\x16[\x1caxk\x9d\xf8\b\xeeh\x89\r\x95\xad\xb3\xfc\x1eet\x123[\xe3(\xe5\\a\xa2>9\xff\x1b\x84q\xad\xe8tގ\x8d\xef\"\xbe?\xc8\xef\x80j\xd1\xd5\xc3q.\xef\x15)\xd4+\a\xf9=\xb8\xd7\x17\xbeE\xdef\xccU\xdd\xdeA\x00\v\x97\xc1\xe6\xb8Y\x8b\x98_<\x101\xdf6\x9d\xa9]@\x12\x88\x0f\x14\x1e>\x89\xb2\n\x1e\xfe\xca\xeb\xd40\xf7\x91\xe1\x82\xedKt\xfd\x9fC\xce\xd7?\x049\x7fU\xc8\xf9K\x89\x9cg?E\x83\xa5ȹ)I\x1alE\xd1l\xa3i\xc0\xa1\x01\xbf\x8a\x8e3\xfbg\xe08\x1b\xd6\x10\x12?\x98\xf0\xa6}\bcd\xb8\xbf\xc0s٦\x95\xe4\xee-\x90\xed>\xb2\xd1\x18\xd9\x1e\xdc\xc5\xe6-t\xf57n\xa1\x93\x87\xdeB\x7fQ\xe3\xf6\x99:\xce]9\x12\x16ݭ\xe4\x1d\xfd;G2o\\q\xb7\xfa\x1e\x9c\xab+\xed\xe6G\\iG뮴\x1b\xaf@/\x80\x11\xe6\xc1\x1d:\\r\x01\xaf\x12!,\x17\n67\xc9_\xb4@\x80tC\xe9ɜF\xc3M\xb8&k\x8eh}%\xaa\xfd\xad\x18\xb0\xfd?\xff\r\xff\xf1\xf0\x03\xe0xW*\xd4Ӻ\bW,Ȥ\xb9 \x17J=wR\x0f}k\xbaKzT\n~Wo\x12R\xac\x9fl99\xb2rn\xcd)ɛ\xff\xf3Fƣ\x199\xa5 \xfd\x16\nB\x18T\x85\xb8\x83\x05\xf1\xcd\x10\x0f\xeb\x8ee6E\x98\b?Q\x04\x8d\xe3\xfcc\x8e)(\xba\xe3!D\x87\xe7N\x8b\xb8\xb4\xb3\xae?\x16;N\xb6\xb1\x89Bҷ\x19\xbd\xebd3f]1\xd2\x11\x0e3C\x95\x06\xda\xd3\xfd\x8d\xe3\xd8\xcaJ\x17\x17\xa5{\n\xc3\x18\xac@ʙ\x84\x17~W\x93e[\x18\xbcK\xd2;+a\x16\f\xdcnu2\xbc\xd90)\x80\xbbl\x19\x0e\x12_\x01[{\x82\xf8L[B\xba\x99\xab\xd8\xf4\xd9U[\xd6\xd6\x02\xc6:\xb7䫅\xdf\nZV~+PKoĢ8\xde\xd8a\xef\xd5\xdd\x14N\x05FI~\"\x82\xa1\xf5B\xa6Z\xa4(N\xf3\ft\x9a\xda]\xb9\xe3U\xa1<[M\x14Yk\x88֦\xbfD\xe1O\xb53\xa5\xf1\xf5$\x86\x8d\x87\x1eZ\xe9\xe2B\x05\x98]\x92\xde\xe9ē\xab\xe4z\x96\xcdZe?\xab\x82Ihg\xff\x1f\xf9ʀ\xc3F\x01V\xae\xd4m\x95\x1a\x9bm\f\xa7\xe9\xb2f\a\xd9\x10\xab\x86e`:\xae\xcc\x11\x93\xa1e\xaf.\x9eM1\x89\xa7\t/>Jp:\xcc\xe1\xc8\x01\xd3\xeb\n[\xd2 E\x84L\xc3V\x1eO\xb0%\xd7\x1a\xe2Ƚ\x9bb\xf2\xec\xfd+cԾ\xf5>\xc5q\x8e-\x8a'\xd9\x1c[\x19\xc1\xe0\xb9\x15*\x8b\xc6\xfdU\xf3\xb2Of\xd3iF\x19\x1e*ȫ!\xc5\x14[\xf6F\xf3\x06\xac\xfa\x7f\xec\x9eߵ\xff\x8f\x82\x00\x1bg9\xb6\xd88f\xd6$f\x83\xf1\x86 \t\xadm\xbf\xeb\x13\x0e\x19w\xc4\xc3\xe6q\r\b\xf4\xa0\xfa]\xa8\xef\xf96g̱ł>x\xe3\xff\xbf\xb2\xe7'I\x9e\x83\xd7\xc6\xff\x1b;\xde>\x1d\xc3n\xcc\xe6\xc9\x10\x0f\x8d:\xd60ù\x05\xbb\x15\x9e\xe8\xc9\xe8Ί-\xee멺\x81V\xef:\xb9e\x132L\x061ú\x89\x96\x9d^m\xd5\xfa_\xbe_\xe5\xcduܼ\xb9J\xe4\x0e-\xe8\xc8J\xfcK\xde\v\xe2N\x11\xc1\xd1\r\xd5:\x01\xa7\xd5\xf1\xa8\xcc\x00\xd2\xeb#5\xc8&+\xa1\xaa \xf0v٩\xba\x05\x99\b\x05C\x11wy\xb3\x92\x9c\x8b\xeb\x01\xb1\xb8G!\x83\f\xe9C,d\x7fJ\xf1\x1c\\\x16\b\xe8(\xa9\x1b\xa7\xe1Y\xff\xf2\x1fO8\xc5\xcb\x13W+m\x13\x18\xf1/\xb4]\xf9h\xc5\xe9\x12Zܷ\x93\x94\xe4\xa1=fl\x1a>yrss\xe3\xdfl\xfb\x19\xbd~\xd2\xebv\xbbOx\x19^\xe4w\xd0\xcbn+\x17\x1c\x1e\x1e>\xe1Z\xdb\x15\xb7J\xf9\xfc\xba\x13\xe79f\xed\xd7\x1c\x1e\xe5K\xc7u7\xb9\xca\xc0&\x15\xdc\x17\x83\x8b9\xbbku\xad\x1e\xfc\x1f78\xb3g\x04\x02\xb1\xe0V\xdfGS\xae\xdeu?\f\xed7\xc1\xae\x7f`\x1d\x1c\a;\x9fv\xfd\xbd\xa3`\xc7\xea\xf9\xfb\xddm+\xe8\xf9{{\xbbV`\x05]+\xb0\xf6\xfd\xed\xed\x1d+\xb0\xf6d\ue7b5\xeb\xef}\xda\x1b\xf7\xe6\x1d\xff\xa0\x1b\x1c\x1dX\xdb\xfe\xfe\xee\x8eu\xe0\xef\x1f\xee[\xdbPi{\x10\xf8\xbd\xee6\x8c\xc9\xe2y=\xab\xe7\a\x87\x87\x9f\x0e\x8ew\x06\x1d\x7fww\xdb\xeav\x02\xcb\xdf\xdb\xd9\xeb\x04V\xc0\xb3\x82\xfdA\xd7\xf2ww\x0e\xfd\x9d\xde\x01\xa4m\x1f\xfa\x87\xbb\x90\xbb\xdd\xddO\xa1̾\xbf}\xb0\x7f\xb4\xeb\xef\xed\xf7\xac\xe0\xc0?\xd8\v\xac=\x7fw\xd7\n\x0e\xad}?\xb0\x82\xc3\xf1\xae\x7f0\x80&\xac\xae\x15@3\x1dh\xc5\n\xa0\x9d\x8enf\xaf\x03\xed\f\xfc\xdd\xdeN\xc7\x0f\xf6\xf6\xfd\xc3\xdd펿\xbf+~@w{\x9f\x0eaHG\xc1\xbeu\x00c\xb4\x82=\x7f{\xb7g\x1dX\x02`\xdf\xecVw\xefkW\xe5߽&\xffK\xa0\xfb&\xe8Y\a\xc7\a\x9fvy\xb1\am\xaf\xef]\x19%\xa1[\xb90;\xfe\xf6\u0381\x15\xec\xf8\a;\x87\x83\x8e\xbf\xb3w\b\xff\xdf\t\xfc^O\xfd\xda;ܷ\xba\xafa\x89\x02\xff 8L;=\x7fo7\x00\xcc\xdf[Y\x85g\x19\xff\xf0\x02VWd\xa7=\x7f\x7f\xf7\xa0\xb3\xed\a\xbb\x1d\xf8y\xc8\x7f\xf6\x06m\x95\x0eT%\x9dl\xf1d\xf5S\x0f\xf0\xc0\x0f\x0e\xb6S>\xbcζ\xdf\xdd\x0e\x06\xabjXj\xe8:_\xec\x02\x18\x1d\x1f\x13\xacR\xb0۳\xca߃\xa5U\xbe\xff\xfc\x94\x86-\xeb\xd6j\xdb\xef\xedZA\xf7\xf5\x9e\x1ft\x0f\xad\x9e\xbf{0\xe8\xf8\xbd\xbd\x83\x8e\xdfۗ?\xf6\xbb\x1c\xf0\x87\xfb\x87*\xc3\xdf\xef\x06\xfc\xef\xe1ޡ\xd5M\xf7\xfd\x83mk\xdf?\xec\x1e\f\xa0\x84\xdf\xdb\x0f\xf8\xdf\xfd.\xcc\x04*\xa6\x1d\xa3LG\x15\x82\xa6\x03\xde\x0foG\xf5\xbbw(\x7f\x95\x1d\xbfV\xe3\xfc\x01\x10\x91\xa6>\xab\xc1\xb2\xef\xef\x04\a\x16\a\xca\xc0\xef\xed\xf7:jB\xe2\xc7\xe1\xfe\xa1\xd5\xcd\xf9D\xf7\xbb\x01\x9f\xe4\x1e\x9f\xe4a\xf7\xc0\x82\xa9\x0e8x\xd4,\xc4\x0f^I\x16\xea\xe8B\x06\xb4yS\x1c\x18\x02:\xcd.\xf9\x89\x01P\xa4|\x80\x9d}?\xd8\t~\x04Lf\xd35\x10\xb1$H\x82\x1d>\xc7#\xf8\x86\xed\xba\xeb\a\xfb{\x80\a\x83\u07be\xf1\xb5}\xb8o\x14=\xf0\xf7\xf6\xf8\xf7ގ\xf8\xe0\xed\xf4\xba\xfb\xba\xe8\xb6\x7f\xb8}h\xbd\xb6\x82\xae\xbfsp(\x00\x0f5\xbb~/8\xb4v\xfd\x83\x9d\xc0:\xf4\xf7\x0fz\xfa\xf7n K\xbd\x86\xb3\xd2\xed\xa96\x8e\x00Uo\xf7t\a\xeacoG\x95\xd3\xc3\xf2\x0f\xf6\xb7\u0558{\xfev\x10\x94\x1f\xbb\a\x81*\b\x83\xb2\xf6\xfd\xfd\xbd}\xebu\r\n\x7f>\b\xf2;VoG@^\xaak\xafۄ\x87\xd6\xfe|\xe7x\xd7?\xd8N\xb7}\x8e\xd8v\x0f_\x1fX{ig\xcf\x12\xff\x05\xfeNЁ\x7f^C)+\xd8>\xee\x05\x9f\xf6\xbf}簄Xbݨ\xbaVp0ޙwz\xe3\xceμ\xf7\xedͶ\xb57\uf343\x83O{\xc7\xdb\xdf&\xdb\xd6\xfe8\xe8\xcd;\xbd\xe3\xbdy\xefA\x03\tv\xad`O\xde2< C\xb3\"\x10\x99\x8c\xc6$\a\x01\x02p\xf5b\x92\xa71\xc3n\x0fY\x9d\xc0[1\xf2Q\x92\xa6\xa1\xfd\x8f\x11\xff\x9f\x8d\xe0\xf3\xc3,š\x8d\xe7\x98dá\x8d`n\xb0\x89\xc6;\xf3\xe0\xb87\xef\x04\xdf&\xbb\x9d\xbd\xe3\xde<\x18\xef~\xda\xff6\xe9Y۟\x0e\xd2ζ\xc5\xff\x83\xe9\xef\xc2,\x0f\xbf\xbd\xd9\xf1w\xadC^\xb0\xe7\xef~:\xfc\x06\xcd\xf4\xe0\xf7\xbc\x03-\x05\xdf&\x87V0\x0e\xe6p\x11u{>\xa7\a\x02\x7f\xb7\xd7\xf1\xb7\xfd\xfd\x8e\x1f\x1c\xfa\x01\\\"\"g\xdf\xdf>\x0e8q\x02\x17T\xc7\xdf\xd9\xed\x04\x9d\xe0\xd3Π\vi\xfc\xd3\n:\xc1x{\x00\xf7\x17ܞ\x87\x9d\x9e\xd5\xeb\xf4\x80V\t\xc4M\x7fp\b\x17\xfdx{\xc0[\xb1\x02\xcb\xdf\xe1\x04\xd1|w\xdc\t>\xed\x1d\a\xf3\xc3qНwz0\xd4\xdd\xf1\x81h[\xf5\xd5\t\x8e\x0f\x1a\x03\xc8\xcb\xdcN`\xa9a\xf0v\xe1\xd7\U00076ba12\xbf\xd9R퉻e\xfa\x13\f\xe3\x0f\xf6\xba\xc1\xa1b\xf0\xfd\xb1\x81\x13XL\xa9\x19}\xe2A\xce\t\xa9k\x9f̯\x9f\x89g\x03(\xdcR\x11\x90騴\x7f\xdb\xeaJ\x0f\x852\x96\xd3{\xcej\x10\x81\x0fxLTZ\x89\x92\xca\xcb\xe7\xda\xf2J&\xa4\x90\xf0!\xbb\xe1>\xbdu\xc0\x8bADK?\xdc[]\xf5\x02\x9d\xf2a\xf1\xf0.ym \xdc\xe6X\xba\x95\xaceq\xd3\xe3g36\xceh\xf2\r\xff\xccH-\x9f\x9b\"\x8baWs\xb8\xa0{\"B\x95J֨\v\x82k\xc3\xf7\x8f\x18\xd7<\xda\x12\x16\xa7'\x8crkQV\x8f\xc1\xc1\x9b\xba\x12.\xb9A=\xd5\b\xe7q\xe78\xeeU\xf4\xfda\xad\x1a\x05e\xdb+\xc3e,\xad%\x8dx\x8dx\"\xffw\xc6W\x8f\u05eb]\x87\xbe\xe4\x03\x03\xfe\x13\xb4c\xd5\x18\x97\xad.\xa3\xa5\"\x9d\x9e\x95Ц\xe0s3\x1d\b\xa4q\xce^\xc0\x9es=\x04A\xa4\xa5ƺ\xe4\x9c\xd9`v\xf3tS@X\xa2\xa7\xce@t\xf5\x1f\a\vœ\x8caKt?\xa3\xf12\xe0h\xf6\x19\x05F\x11h\x82\\9μ}\xc5Kg\xc6o\xb3\x1a\xdc5S\x0f8N\xe8\xca\xdb\xd8%\x96d\x9fuf\xc9w\xc3\xe8\xca+\xed\xa3\x99\x9f\v\xec\xe0\x82\xa2\x85\xe9J\xf56\xbaq\x9c\x1b.yE\x17\xd1\v\xc7y!~\x9fD[pv\xf1`F\x13v\xf7\xdct\xb5\xf0\xf4\xc7̂,\x11\xdc\xf2X\xcbZ\xe42Q\"\x97\xebR\xe4\xb2d'\x17˶8Z\xed^?!Bq\x00\xd8«6\xd9\fݯ\x88\x97\x93\xa9\xe3\x84n\x17\x8b\x8b\xc5\xe2d\x03i\x8eX\x86u\x9d6*\xe4\xad\x01|n\xfbM\xffM\x861T\xd3\xd6`df7\x87;6\xb2\x97y\xb4\x1f.\x05pkq\x13\x82h\x88\xf3\xaf,\x9b\xb6\x023Y\xea\x95\xfbﶜk\xac'}\xeb\xfc\vH\x88\xfd\xfd]\x0f\xfd.\xfc:\xfe\x8b\xaas\x83I\xa4\x1c`\x19\x81\x8c\xa4\aI\xa9\x95v_\x80\xbd\xbbX\x1d\xbb\xa2\x1d'\xae\xe9Ќ\x96\xa1\xa4\xe0\x8cl\x1c\xebX\b#A\xad\rx\xe5\xaf\xcc`\xc4\xca\xe73+\xc7V\xf3\x11ۧ.\xf3B\x1e`\n;\x0eu!\ft5T\xbdR*\x97\x83e\xb2\xc9\xd2\xf1~S\xednD\x84\xed\xb3`:\xb7\x87(\xc1\x8e\xc3\x1d\xcf\xf4qU\xf9M좴\x91/\x94\xe5x.\xa7\bf\xc0\xdc\xce\\\x8c\xb6\x02t\x0fW\xc6I\x92b\u0094_\x84A\x94\xf6gn\u07bf\x04O\x16\xc2\x1d\xc8ţ\xfb\x14lyr\b\x80[K\xbe\xf4\xc2̵\x8dĜ\xeb%\x94&\xea\x83\xc5\xc2\x1dD\xede\xda.\xce6\x8dJ\rA\x0e\x9f\x1a\xccZ\xa0\xaaAo\x80R\xcb$\x18\xa9\xcb$0Q\x14.%?\xdc\x03}\x8bGg1<ǁ\x90a\xa5'63\xcf\\\xb9\xbevZ\x0f\xc5\xf3\xb3\xeey\xd8\x16f\xa8\x1a\xeb\xa8\xea\rZ\x9e\xa2\xa6\x7f#P\xe83G\xdb\xd6\x12\x0f$\xbe\xca\x19\x99:)\xa5?O\xb1\\\x04\x19F_\xa6\xa1\xe5\xda-N\xa5\x05^\x9f\x9a\xea\x9bj\x83\xd7s\xab\a`\xd6\xc87\x0e\x00\x1a4r\x13\xa2\x0eG2r\xd9b\xe1\xb2\bL\x9dID\x84\x8d\x16Q6Zg\xe7H;\xe8\xe6\x0e\xd6ĻfeH\xe1\xf2z!~\x8a\xc95\x1b\xf7m#\xa4\x8c\xf2\xa2\xab\xf2\b\xa4\xc5i\x9a\xdd\xe0\xa1\b\xf6\x13\x82^a~\xaea\xcc3_L\xa6\xec\x8e\xe7\x87[\x99\x01\xcbF@\xb8ry\v\xafPϙd\xb1\x18\xc0\xde\xcb\xe8\xe4y\xccb\xd8\x7f\x03\xc7\xd9r\xed\x97*%!\xd6MB\x86\xd9\r<q0\xbc\xc1\xa63c\x9e3c\xe7\xceZn\xbe{\x11\xa3\x8e\x17A\xdf\x03\x81%^\xf8\xf5D\xa7ES\xb4\xfd;PТ\xe7\xd4ql\x90#\xded\x94?&\xd2~\xf9\xa9B\xe7}ϰ\xd4\x1aL\x12\xf2\x9a'\x87]4\xc4Wٌ\f\xf0i2\xc1ٌ\x85ۻ\xddJ\xf8\xbex\x93\xc9H\xc4DW &B\xfen\xf0=3HZ\xc3S~%\xce\x1c\xf7\b\xd9\x1a ##\xaf\x18\x9e4\x82\xad\xd5\x02\x91ъ/\x14RDJ;Z\xa5@Y\x97\t\xdff\xd5xz\xf5\x0e\x85.\xc8+\xee\x11\xd5\xc5ͮX\xa5+\xb8\xa1\xab]Q\x7f\x88S̰\xbbA_\xf1p(;re\xdc:\vG\x03\xd2\x04J\xcdI4/\xae\xa3\xd7\t-\x7f\x1d9Ϩlbve\x04\x00Wp\xab{\">\xb8\x95\xc3m\"\xef\xfbU\xe3jo\xcft$-\n\x0e\x88\xab橯Q9\xf4\xcd\xdcK\xab\xfb\xceh\xe8)\xab\xbaZ\xe5\xa9\r\x87\xd2*\xfe\xa9\x87T\x87\xd5Z\xea֬U\xab\x0f\xb2\xd5Y^ͬK\xdfK\x86Q\x97\xbc\xb4V\xd3`Y\x94\x89[!S\xb7\xc2\v\xee7\xa5\x9f\x85g\xe7\xf2\xfaJ\xeb\xbeTp\xf4O[R<Q\x04\x18*\x1bY\xd8\x03\xdeSi\xf0^-\xaecSb\x9f`<\xfc\xc0OA\xcdj\x1d\xfb|\xc4\x1ePluآi\xb4\xb5\xe5\x0e\x1cg \xdc\x06\xe9\x1f\xae\xf7Ϯ\xb6*\xe3\xe6\x00\xca \x8c_\xb1\xe7ڦ\xa2\x92\xc7/\xd0\xf3\xaa\x05\x98ʓ\x97\xef\xb9al!\xb2\xa4\xd3j4\x8f\xb6\x02t\x17\xe9\x1bc\xbcX\x94ЈƎc_%$\xa6w\xf05\x84;x\xec8\xc3>\x98\x86UI\xcd1P\xa0C\xa07\xed\xab,KqL\xec-Q?\x06e^\xf5!\xddMl\xf1\x8eܶf.=\x0f]/\x16w\x8b\x85;\x8f8\x1bOmZ\x1c\xb1\xf5\xb7:6o\xf5l\xc5\xe5\xa1\xf32H\x9b\xccR\x96LS\x88\xc8*/\x92\x81ysW/\xfdQ\U000de9db\\\xee\xdc6N\xc5/ܜ\x95\x001\xc3:\xe2 t\x040\v4\xe5\xba\xf4\x03\xb9\xe5\x06\x1c\xd9Se\xeeB\xf4V\xafF\"\xf3}_\x98~6\xb74\xf6\x132ķQ\x14Q\xafj\r\xaa7\xf2\xf9\xba!\x83:8EK\x06\x0e\x1b\xb1\x03[\x0f|\xae5\xa3Ԑ2\x06\x8f\x06\xa4\"x\xcdk\x8dO\xd3\\\x19\x89\x17jΧX\xe1\x85Me\xff\xeco\xf7\xd2B\xd9\\\xb7>\x89\xfe\xe6d$ګi\xb7\x8b\a\x16\\\fK\xc2$_\x99\xfb\xe6\x12\xb4\x97Ae;\x9fX\xad+ё\xaa\x9c\x8f\xeeg\xcds\x02\xed\x17\x97\xf2\xac\xe8\xfcYh\x97\x9aR\xdaanI\x06\xb8\x14\xe2\xfa[\x1d\xae\xa8\xa58'?f\xb0\xf1ph=\xbaO\u05ccT\xe7\xa7\xe6H\xf9(%\x01Q \xfb\xd9\x10\x94g\xc7\xfdK\xc07\xd6%\x94\xe4X\xb1T\xe9&+h\xbd\xec\xdf\xf7\b\xfd\x1b\x0f\xc7\xea\x13Pm?\xfd27\xdfw\xb4\xdcr\xed\x1a܋\x85\x8b\xf9+\x8bE\"\xe6p\x9f\x19\xaf\xac\x15\xa4}\x83zg+\x10\xb0\xcec%\xf5\x8e\x1fD\xbdӵ\xd4;\xd1\xd4{\xb6bEc\xf2\x83\"\xc8\xe3ի\xaa\x18\x04\x7fcako\xfbr}Ռiˋ\x9d\x18\xafD\x10\xdb-\x16\xed\xcf\xc9e\xf8\x9d,{3n\xbe\xbc-\xb1\xd0q}\xb12\xbdX\xf1\x8a\xc5\xca\x7f\xc8b\xfdPΊ\\\x05\x83Z59+jzq\xf5\xacEu'3g\xe7\x9c$K\"\xe28\x9cT\xeb\x93&{%\x8f\xb6 n~\x86R\x1e\x0f\xfdLz\xf0\x95~{ϵ\xfb\xe6\r\xf8\x1f\x15\xf6:]\xb1\x92:\x8f\x96\aU\x9a71\xe3\xfa\x8akTR\xd2\a\x82#9\x0f\xd3\x06\xb9\x94\xaf\xa6\x96\xc4&\xc8\xdb7\x81\xa0l\bpI9}^\xb5eTg\xce ѥ\xe9W_\xff\n%\xb1\xc3\xc1M\xa3&\xf1\xdf\xc7a3Q\x90A}\xf9W\xb3\xa4\xb6\xc0zE\t\xb7\xb8\xd16\x1c7Q\be\xd1\xe5\x93G\xf7\xba\xe3\xe2\xf2\xe9(\xa3\xeeSE\x05\xeb\xc6\xc9Ӓ\xc6\xd5#'z\xe4\xfaWH\xe4\xc8u\x80|`o{W\x14\xc7_!-{,:,.\xd1\x16\x91t\x9b\xc8$\x91\xfc.\xc4P/\x1f\xddgEh=\xba'\xc5%\x04N\x12gk\xb6\x19\x1bC\x9d\xaf\xb5\x91=\x1b\aj\xe3\xc8\xeeK.C\xdd\xd4\xfa\x18\f\x8dù\xe4\xd2\x13\xdde\x116\x83\x89\xab3\xd3<\xa5\xf0\xa0\xa4^\x9f\xb6݄+\xf4\xb9\xb3F,\x99{y\xd0\xf4\xf1*\xbc\xfayK\x89K=Éky\xfc8\x03\xe3\xfd7\xafr\x00ɚ\xc8\xd7^Q\x8c\xa4!\x995 \xc0\f\x90\xb3\xac:N\xc3^\x1f\xf3ib\xaf_y?`\xaf*\xd1эM\x89X\x02\x8bF\xa5 '\x0f\xef\x9fM\xa7\xe1\x18\xa3X\xeaYp1\xe3\xfbl:\x9b\x86\xc32\x15\xb4/\xc2\tF\xad\xda\x18\xe1\xb5Q\xf0\x9dR\x1c\x81\x1as\x91\x91\x87w\xa2*\x90v\xe1\x95H\xe4\x12\xfb\xf0\x06\xa3\f\xbez\xe1k\x8c\xe2i\xf2\x1b\xbe\x83\x82\xe1\v\x8c\xae\xe2<\x19\xf0\x8f[\x8c\x06)\x8ei\xf83Fi2\xc7\x1fp>\xcdH\x8eÏ\x18I\x89S\xf2\r\x0f\xf9\xe5\x19N(\x02\x81ixCQE\xef%|AQ\xe9\xbf4\xbc\xa5\xa8\x16\x8a\x17\x06|\x01\xd4J\x9a\x10\b\x13\x9f\fc\x96џ\xe3\xe15\x0e\xff\xc2\xfe\x9f\xa8ԉ\t?\xe3\xf2+\xfc\x1d#=\xeb\x93\xd9d\x12ӻ\x90\xb2F\x9a\x8c\xa7O\x9a9|@\tC\x95(Y\xe1-CTN4\x0f/ʏ\xf0\x88!\x05\x01\xed\x14\"|W\x16\xe0օ\xef\x19ҁ\xf3\xf3\xf0\xb9\xf1\xf5!\xbb\t?2\x84e\x90\xb0G\f\x8dq<\x84R\x9f\xf5A<ff\x98\xee\xf0O\x86\xb29\xa6\xa0\xb7\x16\x8e)\x1ae\x19\xc34<\xa1\xa8\xa6}\x13>\xa3\x88G\xe5\xe6C8\xa2h0\xa3i\xf8\x85\xeah\xa6\xaf(\xaa\xeb\xfa\x84o\xa9p:-\x03>\x85o(2c\x10\x84\xcf)\xaa\xb8D\bOeB\xf8\x9a\xfa\x7f\x8a\x9fy\xf83EڳB\xf8\x92\xa2w\x1c{\x8b\xcfo\x14qce\xf1\xf5\x91\xa2\xf7\xca\xe1\x87H\xf9\x04)\xc2\tC\xf8\x88\xa2Sz\xf7\x8a\xbd\x9b1\xc1\x04\b?S\xa4\xdc8\x84\x7fB\x8f\x10g\xe15\xf7~\x1d\xfeAQ\x8b\x12Ux\xac\x93OX<\x99\x86\xbf\xd2r\xcd_ܲ0g\x95oX\x91\x94!\x1d\xcf\x1c\x8a\xbc6\xbe_\t\xee&\xbf\x9b×F\xdd\xd3\xf8:\xfc\x97\xb1\xfbJ\xa0\x8e0z\x8e\xf1\x14\xdcF\x84\xbf\x89\x93\xf0\x91\xa6\xe1\x95\xf8\xa9\"E\x84\xd7\x14im\xb1\xf0\x17\x8a\xd4\x1a\\`\xf53\x17\x14J\xf8\xb5\x9e\u0089\x84\x0fX\xf6\xf7\x0e\x17\x05\"\x15̂\v\x94U\x12X!1\xf6\x19\xc5*\x1c)\xc2\xe5\xcf?\xf4\xaf\xdf\xf4\xaf_\xf5\xaf\xcf\xfaױ\xfe\xf5\x8b\xfeE\x11A\xff\xd2_\x19\xfa]\xfffe\a\xa4\xfc\x99\x95?\xe3\xf2\xe7\x9f\xfa\x97\xdbE\x89N\xf7\\\xef\\\x90;\xb3Vr\a\xd89#\x02\x01\x18\xf7wv\x03\xef\xa9ƹc\xc0\xb9r\xceS\x82FD\xb5x\xceE\xfbC\xa8\xb3\xb3\xbb\xdd=\x90$\xd3\xfd/\xafN/\x9e\xbf\xfap\xfaG8!\b>\x8e\u07bdy\xf3\xea4\xbc&\xe8\xfd\xb3\xa3ߞ\xfd\xf2\xe2\xe2Ӌ\x0f'\xaf\u07bd\r\xe7\x04\xfd\xfc\xf1\xd5\xeb\xe7\x17\xa7\xaf\u07bc\b\xefH\x11\xdd\u05cb\xd8;~\xb0\xeb\xef\xdafK\xf6\xf5\xe0`\xf7 \xee\xed٨\xecl\xabk\xb6e\x7f\xc6Cdu\x0f\xad\xb7\xd9\xdc\xeau{=\xab\xbb\x17\xeen\x87ݮ\xf5˛S\xbb(\xe7wE\xcaPٯ\xfd?}i\v\x96G\xe6\xc7bq_ 3\xc1\x97j7\x1f\x93H\x1b\xa4\xcd\t\xbaN\xd8\a<O\xf8\xe75\xff|\x9e\xc0\x89\x9c\x10t5K\xd2!<0s~\x9c\xeeH\xa1\x834\xdd\x0f\xb3\xc9E2\x94\x8e\x8e\xb2\xc9[@\x9d\xfc#\x9f\xe2\x01\xa8?\x00\n\xb2m\xf8\x93\x8b\f\xe1\xb9>\xb4\xcbsl\xa3Jp\x16\x9bG\xecC\x93\xf8\xf6\xb9p׃\x87\xa7\xf1\xb5\xac-\xdcȈ\xdfsuK\xc0\xd9\xe2\x86]y\xf8\xe4\x89NU\x13\xf5\x93\xacL\xb4\xe5\x85\xf7\x01\x0f\x13\x8a\a\f\xaa^>\xba\x17\xaf;?\xcd\x06qiD>\xc8\xd2\xe2ɓf\xe68\xcbY\xd1R'fc\x12O\xb0\x9fϮ\x04i\xecvQ\xcc\xddy,+\xaa]{\xd8O\x80\xb1\xf2D\x8c\xadC\xe5\xe0|\x88\x1b~\x89\xa6\xb0J9{f\xd2\b\xa0B2\x90\xee\x8d\xee\v4\x98\xe5,\x9b\xc0/\xe9\xe2H#\xa5W\xc2\x17\x93H\xfd Be<\x97\xaam<G⩄\\\xc3'\x93\xd8\xf7Ei;/\xe3k\xbc\"\f\xd3\x01\x9e\xb2\x8c\x02\xa7\x0e\xeb\v\xaf\x91\x01\xfe\x92\xde\xccX̀\xef\xcf\xeb\xc2\x16o\x8f&ZF*\\\x16\xe22X\x1e\xab'@5\xd7L[\"\xe5(\x9bL2RM\xbfI\xd8\xf8\x88\xe2!&@\xb0\xe4\xa1x\x1b\xa8ɝ\x90d:\xc5,oN[\xe5\x84\xf7ט\x00Lႆx\xe1\xe9\xc5U\x9c\x8fC\xe9^\xc7\x1e|\xfc\xf0\xdar!ɳQ~GX|\x1bB\xf4K\xf0\x8c\xceKO\xb3\x1bL\xf31N\xd3Z\x9d\xf7\x90q\x02\x19FͲ\xb4\xaa?\x98\fk\x15\x8f\xde<o\xf4U(h\xbd\xd0\xfe\xb5\xbb(\x8d\xc9\xf5,\xbeƹ4\xc6̕\xe5\xee\xc9\xecj\x920A\x1a\xe5\xe1\x99}\x8d\x99\x8dl`\x82 {\x9a\xc1)\xb4\x85\xf4\xd1F2\x10<\bL\x80`\x81\x02\xa0r\x04\xb2\x15\x1a\x0f\xb0}\x8e\xfe\x9aaz'\x94D\r0r+z\x96\x87gcr\x8e\xa6\xe9\xec:!yx\xa6\x7f\xbe\x13\xad\x86\xf7\xe2\x1b\"}p\x9a\xc7N\xf1u<\x006~\"HL.+\x83\r>\"\xf0\xafIC\x17\x12\x06Ǌ\x80\vE\xe8ɘ\x89\xe93\x1e\xd4ݎ\xafc\x86\xed\xa2\xd0\x0f\xd0\xe6\x80\xfb\xfc\xd1\xf0\xf1\x17\xcf\xf5\xc2\xfb\xa2|\xf7\xf8\x12\xb5=\x15а\xca\x04Q$\x89Ƃ\xbfM\x11F\x04Գ\xef\xf3\xbb\x1c\xc8\xed{uH\x13_\xfe*4\x14\x12_\x02\xa7\x0e\x8cį& .\x18\vy\x1f\x12}\xaa\xbf\x89\x8cͥ\x10#\x88-\x12\xaf\x90\xe8\x97\xff+\xb0o\x98\xf83\x9a\x16\x8dm\x9d\xf8\xb5\x94\x02%\xbe\ts\xaf\x80gs-\r\xde\xe8p\xf1\f\xc0;B-O\xd0~\xa5\x1f\x0ep\xf3\xf5\xeeF\x85V\xb8\x13(\xafZ\a\r<\xc7ѯ\xf5j\xde\xd9\xe0\xdcq$\xdcS!\"<\x1b\x9c\x8b[?\"\xf8\xc6\xfa\xe6\xa6\xdeӑO\xf1u\x923L\xdd3\r>$\xe4\xc7#\x12&\xfe\x88\x98{&\xf1\xcb\x0f\t\xddD\xb4]\x80\x10\a\x1a\x1fF#\x1e܋\xaf\xa3\xd6Y\x9f(\xbbj\x8bE\xc3Z\x00\xbck\xcc^g\x838\x15[\xaa\xbf:\x9b\xef0D\xd5\xc6a(A\x98\xdfՄ\xbb\x96\x83\b\xcaT\xed\xb2(\xf3\xd0\xc8ϵC;\x17D\"j?\xa90H\xa0\xe4\xcb\xc3\xe2\xc3\x19\xdf\xe2*}\xee\x16\x81Ew\x9c\x067\x85\xf2\xb19\xce\f\x9e\xea\xfc\xb7\xa7^\xd4\xee\xb0\x12]i6\x1d\xc6\f\x7f\xa4)\xa8\a\xa2\xb6\xbc\xd7\x15\x9d};\x9f\r\x068ϗ\x14>\x99\xe2\x81;-{\xf5\xbc\xb0Z\f\b}\x98\n\xe88\xf1\xb1o\xf1\xbf\x10\xdcq\xd9\xc0x\x01\x0f\xb5\xb7#s=\x0fipzC_rEt\x12\xb2\x9fM\xa7\xb6\xf7\x14\xa79\xb6\x92\x91ȸH\x86\x9eT\xa2\x18f\x03\xce#\x158C\xadiY\xec\xa9nR5U\xf0\xa6`-@`(\xcb-\x16f\x02t\xbcX\xc0\xbe\xcaR\xc9\x0es퓯p\f\x87\x16\xd5W\xa4E2\xebRԿ\x04w\f\x97\xb2\xea\xa5u\x13\xe7\xd2\xf5C\x82\x87%GfXp7\x81b\x7f,\x16\n\xf3|\xa4\xda=\xf7\xb5\xe3T\xa0U\xfb\xf4\xb5\xefğ\xef>Ҵ\xef\xae\xcau\xef\x01\xbb\\#\x00\xf6\a\xae?/2\x01\xf3\xb6\x90\x0e\x89\xdfLl%%\x12\xbf%\xb5@\x13\x0f\r\xbdp\xe2z\xc5\x15Q\xf83\xba\x8f\xa7I\x1e\x8eI\x81\xae\x88:\xfc\xd1P\xd3\xfbJѝDW\xa4\xf0\\\xa1\x1a'\xf3\xe0\x1b\xb8{\xffߓ'\xff\xb0\x84\x9f\xb37\xf1t\x9a\x90\xeb\x8f\x1f^G\xa5\x8az\xe7jF\x86)\xf6\xbf\xe4\xfe$\x9e\xfe\xff\x03\x00*\xa3s\xec\xcb2\x10\x00",
+}
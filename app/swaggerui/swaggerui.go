@@ -0,0 +1,39 @@
+// Package swaggerui holds the scripts and styles of Swagger UI, taken from
+// swagger-ui-dist Version (Apache License 2.0, see LICENSE), so the API
+// documentation works without reaching a CDN. To upgrade, regenerate
+// assets.go from the new release and update Version.
+package swaggerui
+
+//go:generate go run gen.go $SWAGGER_UI_DIST
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+)
+
+// Version is the swagger-ui-dist release the assets come from.
+const Version = "4.15.5"
+
+var assets = make(map[string][]byte, len(compressed))
+
+func init() {
+	for name, gz := range compressed {
+		r, err := gzip.NewReader(bytes.NewReader([]byte(gz)))
+		if err != nil {
+			panic("swaggerui: " + name + ": " + err.Error())
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			panic("swaggerui: " + name + ": " + err.Error())
+		}
+		assets[name] = data
+	}
+}
+
+// Asset returns the named file of the swagger-ui-dist package, e.g.
+// "swagger-ui.css", reporting false when it is not included.
+func Asset(name string) ([]byte, bool) {
+	data, ok := assets[name]
+	return data, ok
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// synonymFilter is the token filter holding the synonym list. The list
+// lives in the index settings, so the index is the source of truth and a
+// reindex carries it over.
+const synonymFilter = "document_synonyms"
+
+// currentSynonyms reads the synonym list from the index behind the write
+// alias, which is a single index even with rollover. An index without
+// synonyms returns an empty list.
+func currentSynonyms(ctx context.Context, client *elastic.Client, ec config.ElasticsearchConfig) ([]string, error) {
+	index, err := aliasIndex(ctx, client, ec.WriteAlias)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.IndexGetSettings(index).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	synonyms := make([]string, 0)
+	settings, ok := res[index]
+	if !ok {
+		return synonyms, nil
+	}
+	raw := lookup(settings.Settings, "index", "analysis", "filter", synonymFilter, "synonyms")
+	if list, ok := raw.([]interface{}); ok {
+		for _, s := range list {
+			if str, ok := s.(string); ok {
+				synonyms = append(synonyms, str)
+			}
+		}
+	}
+	return synonyms, nil
+}
+
+func lookup(m map[string]interface{}, path ...string) interface{} {
+	var v interface{} = m
+	for _, key := range path {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = obj[key]
+	}
+	return v
+}
+
+func adminGetSynonymsEndpoint(c *gin.Context) {
+	synonyms, err := currentSynonyms(c.Request.Context(), elasticClient(), currentConfig().Elasticsearch)
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to read synonyms")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"synonyms": synonyms})
+}
+
+// adminPutSynonymsEndpoint replaces the synonym list. Elasticsearch cannot
+// change the analyzers of an open index, so this reindexes into a new index
+// carrying the list and flips the aliases, like /admin/reindex. Each entry
+// uses the Solr synonym format, e.g. "laptop, notebook" or "tv => television".
+func adminPutSynonymsEndpoint(c *gin.Context) {
+	type request struct {
+		Synonyms []string `json:"synonyms"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	for _, s := range req.Synonyms {
+		if strings.TrimSpace(s) == "" || strings.ContainsAny(s, "\r\n") {
+			errorResponse(c, http.StatusBadRequest, "Synonym entries must be single non-empty lines")
+			return
+		}
+	}
+	res, err := reindex(c.Request.Context(), elasticClient(), currentConfig().Elasticsearch, req.Synonyms)
+	if err != nil {
+		log.Println(err)
+		if e, ok := err.(*elastic.Error); ok && e.Status == http.StatusBadRequest {
+			errorResponse(c, http.StatusBadRequest, "Invalid synonyms")
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to update synonyms")
+		return
+	}
+	invalidateSearchCache()
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// Tags are matched exactly but case-insensitively, so they are stored
+// trimmed and lowercased.
+
+// addTagsScript adds the missing tags; documents in the trash, or that
+// already have every tag, are left alone.
+const addTagsScript = `if (ctx._source.deleted == true) { ctx.op = 'noop' } else {
+	if (ctx._source.tags == null) { ctx._source.tags = []; }
+	boolean changed = false;
+	for (t in params.tags) {
+		if (!ctx._source.tags.contains(t)) { ctx._source.tags.add(t); changed = true; }
+	}
+	if (changed) { ctx._source.updated_at = params.now; } else { ctx.op = 'noop'; }
+}`
+
+const removeTagScript = `if (ctx._source.deleted != true && ctx._source.tags != null && ctx._source.tags.removeIf(t -> t == params.tag)) {
+	ctx._source.updated_at = params.now;
+} else {
+	ctx.op = 'noop';
+}`
+
+// normalizeTags trims and lowercases tags, dropping empty and duplicate
+// ones.
+func normalizeTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// validTags returns a message describing why tags are rejected, or "" when
+// they are acceptable.
+func validTags(tags []string) string {
+	for _, t := range tags {
+		if strings.TrimSpace(t) == "" {
+			return "Tags must not be empty"
+		}
+	}
+	if max := currentConfig().Documents.MaxTags; len(normalizeTags(tags)) > max {
+		return fmt.Sprintf("More than %d tags", max)
+	}
+	return ""
+}
+
+// addTagsEndpoint adds tags to a document. The tags are merged by a script
+// in Elasticsearch, so concurrent changes are not lost and no If-Match is
+// needed.
+func addTagsEndpoint(c *gin.Context) {
+	type request struct {
+		Tags []string `json:"tags"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	if len(req.Tags) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Tags not specified")
+		return
+	}
+	if msg := validTags(req.Tags); msg != "" {
+		errorResponse(c, http.StatusBadRequest, msg)
+		return
+	}
+	ctx := c.Request.Context()
+	doc, _, err := getDocument(ctx, c.Param("id"))
+	if err != nil {
+		documentError(c, err, "Failed to tag document")
+		return
+	}
+	tags := normalizeTags(append(doc.Tags, req.Tags...))
+	if max := currentConfig().Documents.MaxTags; len(tags) > max {
+		errorResponse(c, http.StatusBadRequest, fmt.Sprintf("More than %d tags", max))
+		return
+	}
+	updateTags(c, "Failed to tag document",
+		elastic.NewScript(addTagsScript).
+			Param("tags", normalizeTags(req.Tags)).
+			Param("now", time.Now().UTC()))
+}
+
+// removeTagEndpoint removes a tag from a document. Removing a tag the
+// document does not have is not an error.
+func removeTagEndpoint(c *gin.Context) {
+	tag := normalizeTags([]string{c.Param("tag")})
+	if len(tag) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Tag not specified")
+		return
+	}
+	updateTags(c, "Failed to untag document",
+		elastic.NewScript(removeTagScript).
+			Param("tag", tag[0]).
+			Param("now", time.Now().UTC()))
+}
+
+// updateTags runs a tag script on the document and responds with the
+// document as it is afterwards.
+func updateTags(c *gin.Context, msg string, script *elastic.Script) {
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.WriteAlias)
+	if err != nil {
+		documentError(c, err, msg)
+		return
+	}
+	res, err := elasticClient().Update().
+		Index(index).
+		Type(cfg.Elasticsearch.Type).
+		Id(id).
+		Script(script).
+		Do(ctx)
+	if err != nil {
+		documentError(c, err, msg)
+		return
+	}
+	if res.Result != "noop" {
+		invalidateSearchCache()
+	}
+	// A document in the trash is a noop too; getDocument reports it as
+	// not found.
+	doc, version, err := getDocument(ctx, id)
+	if err != nil {
+		documentError(c, err, "Failed to get document")
+		return
+	}
+	setETag(c, version)
+	c.JSON(http.StatusOK, doc)
+}
+
+// popularTagsEndpoint lists the most used tags with their document counts.
+// The search filters narrow the documents counted.
+func popularTagsEndpoint(c *gin.Context) {
+	filters, err := parseFilters(c)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	cfg := currentConfig()
+	size := cfg.Search.DefaultPageSize
+	if i, err := strconv.Atoi(c.Query("size")); err == nil && i > 0 {
+		size = i
+	}
+	if size > cfg.Search.MaxPageSize {
+		size = cfg.Search.MaxPageSize
+	}
+	result, err := elasticClient().Search().
+		Index(cfg.Elasticsearch.ReadAlias).
+		Type(cfg.Elasticsearch.Type).
+		Query(elastic.NewBoolQuery().Filter(filters...)).
+		Aggregation("tags", elastic.NewTermsAggregation().Field("tags").Size(size)).
+		Size(0).
+		Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to list tags")
+		return
+	}
+	tags := make([]FacetBucket, 0, size)
+	if items, ok := result.Aggregations.Terms("tags"); ok {
+		for _, b := range items.Buckets {
+			tags = append(tags, FacetBucket{Key: fmt.Sprint(b.Key), Count: b.DocCount})
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"tags": tags})
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// Search templates are Mustache scripts stored in the cluster, so the
+// queries behind GET /search/template/:name can be changed without a
+// deploy. The client has no API for stored scripts or templated search,
+// hence the raw requests.
+
+func templatePath(name string) string {
+	return "/_scripts/" + url.PathEscape(name)
+}
+
+func adminPutSearchTemplateEndpoint(c *gin.Context) {
+	type request struct {
+		Source json.RawMessage `json:"source"`
+	}
+	var req request
+	if err := c.BindJSON(&req); err != nil || len(req.Source) == 0 {
+		errorResponse(c, http.StatusBadRequest, "Malformed request body")
+		return
+	}
+	body := map[string]interface{}{
+		"script": map[string]interface{}{
+			"lang":   "mustache",
+			"source": req.Source,
+		},
+	}
+	_, err := elasticClient().PerformRequest(c.Request.Context(), elastic.PerformRequestOptions{
+		Method: "PUT",
+		Path:   templatePath(c.Param("name")),
+		Body:   body,
+	})
+	if err != nil {
+		log.Println(err)
+		if e, ok := err.(*elastic.Error); ok && e.Status == http.StatusBadRequest {
+			errorResponse(c, http.StatusBadRequest, "Invalid template")
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to store template")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func adminGetSearchTemplateEndpoint(c *gin.Context) {
+	res, err := elasticClient().PerformRequest(c.Request.Context(), elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   templatePath(c.Param("name")),
+	})
+	if elastic.IsNotFound(err) {
+		errorResponse(c, http.StatusNotFound, "Template not found")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get template")
+		return
+	}
+	var stored struct {
+		Script struct {
+			Source string `json:"source"`
+		} `json:"script"`
+	}
+	if err := json.Unmarshal(res.Body, &stored); err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to get template")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"name": c.Param("name"), "source": stored.Script.Source})
+}
+
+func adminDeleteSearchTemplateEndpoint(c *gin.Context) {
+	_, err := elasticClient().PerformRequest(c.Request.Context(), elastic.PerformRequestOptions{
+		Method: "DELETE",
+		Path:   templatePath(c.Param("name")),
+	})
+	if elastic.IsNotFound(err) {
+		errorResponse(c, http.StatusNotFound, "Template not found")
+		return
+	}
+	if err != nil {
+		log.Println(err)
+		errorResponse(c, http.StatusInternalServerError, "Failed to delete template")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// templateSearchEndpoint runs the named template against the read alias.
+// Every query parameter is passed to the template as a string parameter.
+func templateSearchEndpoint(c *gin.Context) {
+	params := make(map[string]interface{})
+	for k, v := range c.Request.URL.Query() {
+		params[k] = v[0]
+	}
+	result, err := searchTemplate(c.Request.Context(), c.Param("name"), params)
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		if e, ok := err.(*elastic.Error); ok && e.Status == http.StatusBadRequest {
+			errorResponse(c, http.StatusBadRequest, "Invalid template or parameters")
+			return
+		}
+		if elastic.IsNotFound(err) {
+			errorResponse(c, http.StatusNotFound, "Template not found")
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Something went wrong")
+		return
+	}
+	res := newSearchResponse(result)
+	writeSearchResponse(c, res, 0, len(res.Documents))
+}
+
+func searchTemplate(ctx context.Context, name string, params map[string]interface{}) (*elastic.SearchResult, error) {
+	cfg := currentConfig()
+	res, err := elasticClient().PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   "/" + url.PathEscape(cfg.Elasticsearch.ReadAlias) + "/_search/template",
+		Body: map[string]interface{}{
+			"id":     name,
+			"params": params,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result elastic.SearchResult
+	if err := json.Unmarshal(res.Body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
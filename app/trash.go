@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/awesomeProject/homie-search/app/config"
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// Deleting a document only marks it deleted: it is left out of searches,
+// counts and exports but stays in the trash, where it can be restored,
+// until it has been there for Documents.TrashRetention.
+
+// trashScript moves a document into the trash. Documents already there are
+// left alone, which the update reports as a noop.
+const trashScript = `if (ctx._source.deleted == true) { ctx.op = 'noop' } else {
+	ctx._source.deleted = true;
+	ctx._source.deleted_at = params.now;
+	ctx._source.suggest = [];
+}`
+
+// restoreScript takes a document out of the trash, giving it back the title
+// suggestion trashScript removed. Restoring counts as an update.
+const restoreScript = `if (ctx._source.deleted != true) { ctx.op = 'noop' } else {
+	ctx._source.deleted = false;
+	ctx._source.remove('deleted_at');
+	ctx._source.suggest = [ctx._source.title];
+	ctx._source.updated_at = params.now;
+}`
+
+// notDeleted filters out documents in the trash.
+func notDeleted() elastic.Query {
+	return elastic.NewBoolQuery().MustNot(elastic.NewTermQuery("deleted", true))
+}
+
+func newTrashScript() *elastic.Script {
+	return elastic.NewScript(trashScript).Param("now", time.Now().UTC())
+}
+
+// writeTarget is the index or alias operations on many documents go
+// through: with rollover, documents are spread over every index behind the
+// read alias.
+func writeTarget(cfg *config.Config) string {
+	if cfg.Elasticsearch.Rollover.Enabled {
+		return cfg.Elasticsearch.ReadAlias
+	}
+	return cfg.Elasticsearch.WriteAlias
+}
+
+// trashEndpoint lists the documents in the trash, most recently deleted
+// first.
+func trashEndpoint(c *gin.Context) {
+	cfg := currentConfig()
+	skip, take := pageRange(c, cfg.Search)
+	result, err := elasticClient().Search().
+		Index(cfg.Elasticsearch.ReadAlias).
+		Type(cfg.Elasticsearch.Type).
+		Query(elastic.NewTermQuery("deleted", true)).
+		Sort("deleted_at", false).
+		From(skip).Size(take).
+		Do(c.Request.Context())
+	if err != nil {
+		log.Println(err)
+		if timedOut(c) || elasticUnavailable(c, err) {
+			return
+		}
+		errorResponse(c, http.StatusInternalServerError, "Failed to list trash")
+		return
+	}
+	docs := make([]Document, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		var doc Document
+		if err := json.Unmarshal(*hit.Source, &doc); err != nil {
+			log.Println(err)
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	setPageLinks(c, skip, take, result.Hits.TotalHits)
+	c.JSON(http.StatusOK, gin.H{
+		"total":     result.Hits.TotalHits,
+		"page":      pageNumber(skip, take),
+		"page_size": take,
+		"documents": docs,
+	})
+}
+
+// restoreDocumentEndpoint takes a document out of the trash. It answers 409
+// when the document is not in the trash.
+func restoreDocumentEndpoint(c *gin.Context) {
+	cfg := currentConfig()
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	index, err := documentIndex(ctx, cfg.Elasticsearch, id, cfg.Elasticsearch.WriteAlias)
+	if err != nil {
+		documentError(c, err, "Failed to restore document")
+		return
+	}
+	res, err := elasticClient().Update().
+		Index(index).
+		Type(cfg.Elasticsearch.Type).
+		Id(id).
+		Script(elastic.NewScript(restoreScript).Param("now", time.Now().UTC())).
+		Do(ctx)
+	if err != nil {
+		documentError(c, err, "Failed to restore document")
+		return
+	}
+	if res.Result == "noop" {
+		errorResponse(c, http.StatusConflict, "Document is not in the trash")
+		return
+	}
+	invalidateSearchCache()
+	doc, version, err := getDocument(ctx, id)
+	if err != nil {
+		documentError(c, err, "Failed to get document")
+		return
+	}
+	notifyAlerts(*doc)
+	setETag(c, version)
+	c.JSON(http.StatusOK, doc)
+}
+
+// purgeTrash runs every interval, deleting documents that have been in the
+// trash for longer than the configured retention. One replica purges at a
+// time.
+func purgeTrash(stop <-chan struct{}, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			runLocked(context.Background(), "trash-purge", func(ctx context.Context) {
+				if err := purgeExpiredTrash(ctx); err != nil {
+					log.Printf("cannot purge trash: %v", err)
+				}
+			})
+		}
+	}
+}
+
+func purgeExpiredTrash(ctx context.Context) error {
+	client := elasticClient()
+	if client == nil {
+		return nil
+	}
+	cfg := currentConfig()
+	expired := time.Now().UTC().Add(-cfg.Documents.TrashRetention)
+	res, err := client.DeleteByQuery(writeTarget(cfg)).
+		Type(cfg.Elasticsearch.Type).
+		Query(elastic.NewBoolQuery().Filter(
+			elastic.NewTermQuery("deleted", true),
+			elastic.NewRangeQuery("deleted_at").Lt(expired),
+		)).
+		ProceedOnVersionConflict().
+		Do(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Deleted > 0 {
+		log.Printf("purged %d documents from the trash", res.Deleted)
+	}
+	return nil
+}
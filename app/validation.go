@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/go-playground/validator.v8"
+)
+
+// Request types declare their constraints in validate tags, e.g.
+// `validate:"required,max=512,utf8"`, checked by validateRequest. The tag
+// is not gin's binding tag, so BindJSON leaves them alone. Besides the
+// validator's own checks there are utf8, rejecting strings that were not
+// valid UTF-8 (encoding/json decodes invalid bytes as U+FFFD), and
+// maxbytes, limiting the encoded length of a string.
+
+var requestValidator = newRequestValidator()
+
+func newRequestValidator() *validator.Validate {
+	v := validator.New(&validator.Config{TagName: "validate", FieldNameTag: "json"})
+	if err := v.RegisterValidation("utf8", validUTF8); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("maxbytes", maxBytes); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func validUTF8(v *validator.Validate, topStruct, currentStruct, field reflect.Value, fieldType reflect.Type, fieldKind reflect.Kind, param string) bool {
+	if fieldKind != reflect.String {
+		return true
+	}
+	s := field.String()
+	return utf8.ValidString(s) && !strings.ContainsRune(s, utf8.RuneError)
+}
+
+func maxBytes(v *validator.Validate, topStruct, currentStruct, field reflect.Value, fieldType reflect.Type, fieldKind reflect.Kind, param string) bool {
+	max, err := strconv.Atoi(param)
+	if err != nil {
+		panic("maxbytes: invalid limit " + param)
+	}
+	return fieldKind != reflect.String || len(field.String()) <= max
+}
+
+// validateRequest checks the validate tags of the struct req and returns
+// the fields violating them, named by their JSON path.
+func validateRequest(req interface{}) []ErrorDetail {
+	err := requestValidator.Struct(req)
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []ErrorDetail{{Message: err.Error()}}
+	}
+	details := make([]ErrorDetail, 0, len(errs))
+	for _, e := range errs {
+		// NameNamespace starts with the name of the struct type.
+		field := e.NameNamespace
+		if i := strings.Index(field, "."); i >= 0 {
+			field = field[i+1:]
+		}
+		details = append(details, ErrorDetail{Field: field, Message: validationMessage(e)})
+	}
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].Field < details[j].Field
+	})
+	return details
+}
+
+// validationMessage describes the constraint e failed.
+func validationMessage(e *validator.FieldError) string {
+	switch e.Tag {
+	case "required":
+		return "is required"
+	case "utf8":
+		return "must be valid UTF-8"
+	case "maxbytes":
+		return "must be at most " + e.Param + " bytes"
+	case "max":
+		if e.Kind == reflect.String {
+			return "must be at most " + e.Param + " characters"
+		}
+		return "must have at most " + e.Param + " entries"
+	}
+	return "must satisfy " + e.Tag
+}
+
+// prefixDetails prepends prefix to the fields of details, e.g. the index
+// of a request in a list.
+func prefixDetails(prefix string, details []ErrorDetail) []ErrorDetail {
+	for i := range details {
+		details[i].Field = prefix + details[i].Field
+	}
+	return details
+}
+
+// validationFailed answers with 422 and the invalid fields.
+func validationFailed(c *gin.Context, details ...ErrorDetail) {
+	status := http.StatusUnprocessableEntity
+	errorCodeResponse(c, status, statusCode(status), "Request validation failed", details...)
+}
+
+// bindJSON decodes the JSON request body into obj, answering 400 when it
+// is not JSON and 422 naming the field when a value has the wrong type.
+func bindJSON(c *gin.Context, obj interface{}) bool {
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return true
+	}
+	if detail, ok := typeErrorDetail(err); ok {
+		validationFailed(c, detail)
+		return false
+	}
+	errorResponse(c, http.StatusBadRequest, "Malformed request body")
+	return false
+}
+
+// typeErrorDetail names the field of a JSON decoding error caused by a
+// value of the wrong type, reporting false for other errors.
+func typeErrorDetail(err error) (ErrorDetail, bool) {
+	e, ok := err.(*json.UnmarshalTypeError)
+	if !ok {
+		return ErrorDetail{}, false
+	}
+	return ErrorDetail{Field: jsonPath(e.Field), Message: "must not be a JSON " + e.Value}, true
+}
+
+// jsonPath writes the dotted field path of a decoding error, e.g.
+// 0.tags.1, with indexes in brackets, as [0].tags[1].
+func jsonPath(field string) string {
+	var b strings.Builder
+	for i, part := range strings.Split(field, ".") {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if i > 0 {
+			b.WriteString(".")
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
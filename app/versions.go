@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/olivere/elastic"
+)
+
+// Documents carry their revision as an ETag. Writes send it back in
+// If-Match so a write based on a stale read fails with 409 instead of
+// silently overwriting someone else's change. Reads send it in
+// If-None-Match and get 304 without a body while their copy is current.
+//
+// Elasticsearch 7 only conditions writes on the sequence number and
+// primary term of a revision, so the ETag is made of both, e.g. "42-1".
+// On 6.x it is the document's version, e.g. "3".
+
+// docVersion identifies a revision of a document: by SeqNo and PrimaryTerm
+// on Elasticsearch 7, by Version on 6.x.
+type docVersion struct {
+	Version     int64
+	SeqNo       int64
+	PrimaryTerm int64
+}
+
+// anyVersion is returned by ifMatch for "If-Match: *" and, when the header
+// is optional, for a missing header: the write is not conditional.
+var anyVersion = docVersion{Version: -1}
+
+// newDocVersion returns the revision a response reports, keeping the
+// sequence number and primary term only where writes are conditioned on
+// them.
+func newDocVersion(version, seqNo, primaryTerm int64) docVersion {
+	if !esHolder.seqNoConcurrency() {
+		return docVersion{Version: version}
+	}
+	return docVersion{SeqNo: seqNo, PrimaryTerm: primaryTerm}
+}
+
+// params adds the parameters conditioning a write on v.
+func (v docVersion) params(params url.Values) {
+	switch {
+	case v == anyVersion:
+	case v.PrimaryTerm > 0:
+		params.Set("if_seq_no", strconv.FormatInt(v.SeqNo, 10))
+		params.Set("if_primary_term", strconv.FormatInt(v.PrimaryTerm, 10))
+	default:
+		params.Set("version", strconv.FormatInt(v.Version, 10))
+	}
+}
+
+// documentPath returns the path of the document id of type typ in index.
+func documentPath(index, typ, id string) string {
+	return "/" + url.PathEscape(index) + "/" + url.PathEscape(typ) + "/" + url.PathEscape(id)
+}
+
+// conditionalWrite sends a write to the document at path conditioned on
+// version and decodes the response into res. The client's services cannot
+// send if_seq_no and if_primary_term, so the request is made directly.
+func conditionalWrite(ctx context.Context, method, path string, version docVersion, body, res interface{}) error {
+	params := url.Values{}
+	version.params(params)
+	resp, err := elasticClient().PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: method,
+		Path:   path,
+		Params: params,
+		Body:   body,
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resp.Body, res)
+}
+
+// scriptUpdate is the body of an update running script.
+func scriptUpdate(script *elastic.Script) (interface{}, error) {
+	src, err := script.Source()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"script": src}, nil
+}
+
+func setETag(c *gin.Context, version docVersion) {
+	c.Header("ETag", etag(version))
+}
+
+func etag(v docVersion) string {
+	if v.PrimaryTerm > 0 {
+		return strconv.Quote(strconv.FormatInt(v.SeqNo, 10) + "-" + strconv.FormatInt(v.PrimaryTerm, 10))
+	}
+	return strconv.Quote(strconv.FormatInt(v.Version, 10))
+}
+
+// weakenETag turns a strong ETag weak, for responses that are not byte for
+// byte the representation it stands for, e.g. compressed ones.
+func weakenETag(h http.Header) {
+	if tag := h.Get("ETag"); tag != "" && !strings.HasPrefix(tag, "W/") {
+		h.Set("ETag", "W/"+tag)
+	}
+}
+
+// notModified responds with 304 when If-None-Match names version or is *,
+// the client already having the document as it is, and reports whether
+// it did. Weak tags match too, as sent back for compressed or
+// converted responses.
+func notModified(c *gin.Context, version docVersion) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" {
+		return false
+	}
+	current := etag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == current {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatch returns the revision a write is conditioned on. It responds with
+// 428 when the header is required but missing, 400 when it is not an ETag
+// and 409 when it is one of the other Elasticsearch version, which cannot
+// be current, returning false in all cases.
+func ifMatch(c *gin.Context) (docVersion, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		if currentConfig().Documents.RequireIfMatch {
+			errorResponse(c, http.StatusPreconditionRequired, "If-Match header with the document ETag required")
+			return docVersion{}, false
+		}
+		return anyVersion, true
+	}
+	if header == "*" {
+		return anyVersion, true
+	}
+	tag, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err != nil {
+		tag = header
+	}
+	version, ok := parseETag(tag)
+	if !ok {
+		errorResponse(c, http.StatusBadRequest, "Invalid If-Match header")
+		return docVersion{}, false
+	}
+	if (version.PrimaryTerm > 0) != esHolder.seqNoConcurrency() {
+		errorResponse(c, http.StatusConflict, "Document was modified since it was read")
+		return docVersion{}, false
+	}
+	return version, true
+}
+
+// parseETag parses the unquoted ETag of a revision.
+func parseETag(tag string) (docVersion, bool) {
+	parts := strings.Split(tag, "-")
+	var n [2]int64
+	if len(parts) > len(n) {
+		return docVersion{}, false
+	}
+	for i, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || v < 0 {
+			return docVersion{}, false
+		}
+		n[i] = v
+	}
+	if len(parts) == 1 {
+		return docVersion{Version: n[0]}, true
+	}
+	if n[1] == 0 {
+		return docVersion{}, false
+	}
+	return docVersion{SeqNo: n[0], PrimaryTerm: n[1]}, true
+}